@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/output"
+	"github.com/keanuharrell/a9s/internal/services/iam"
+)
+
+var accessReportFlagged bool
+
+var accessReportCmd = &cobra.Command{
+	Use:   "access-report",
+	Short: "Report console logins, access key use and MFA of every IAM user",
+	Long: `Read the IAM credential report, generating it when needed, and list
+each user with their last console login, the last use of each active access
+key and whether MFA is enabled.
+
+Users are flagged for review when they can sign in without MFA, have not
+signed in or used an active key for longer than services.iam.unused_days
+(90 days by default), or when the root user has an active access key.
+
+Use --output csv to export the report for an access review.`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runAccessReport()
+	},
+}
+
+func init() {
+	accessReportCmd.Flags().BoolVar(&accessReportFlagged, "flagged", false, "Only list users flagged for review")
+	rootCmd.AddCommand(accessReportCmd)
+}
+
+func runAccessReport() error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	factory, err := awsfactory.NewClientFactory(cfg.AWS.ToCore())
+	if err != nil {
+		return fmt.Errorf("failed to initialize AWS: %w", err)
+	}
+
+	dispatcher := createDispatcher(cfg)
+	defer cleanupDispatcher(dispatcher)
+
+	service := iam.NewService(factory, dispatcher,
+		iam.WithUnusedThreshold(time.Duration(config.ServiceInt(cfg.Services.IAM, "unused_days", 0))*24*time.Hour),
+	)
+	users, err := service.CredentialReport(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to get credential report: %w", err)
+	}
+	if accessReportFlagged {
+		flagged := users[:0]
+		for _, u := range users {
+			if len(u.Flags) > 0 {
+				flagged = append(flagged, u)
+			}
+		}
+		users = flagged
+	}
+
+	data := output.Data{
+		Value: users,
+		Columns: []output.Column{
+			{Name: "user"}, {Name: "arn"}, {Name: "created"}, {Name: "console"}, {Name: "last_login", Title: "LAST LOGIN"},
+			{Name: "mfa", Title: "MFA"}, {Name: "key1_active", Title: "KEY 1"}, {Name: "key1_last_used", Title: "KEY 1 USED"},
+			{Name: "key1_service", Title: "KEY 1 SERVICE"}, {Name: "key2_active", Title: "KEY 2"},
+			{Name: "key2_last_used", Title: "KEY 2 USED"}, {Name: "key2_service", Title: "KEY 2 SERVICE"}, {Name: "flags"},
+		},
+		Empty: "No users.",
+	}
+	for _, u := range users {
+		row := []string{
+			u.User, u.ARN, iam.FormatReportTime(u.Created), strconv.FormatBool(u.ConsoleAccess),
+			"-", strconv.FormatBool(u.MFA),
+		}
+		if u.ConsoleAccess {
+			row[4] = iam.FormatReportTime(u.LastConsoleLogin)
+		}
+		for slot := 1; slot <= 2; slot++ {
+			active, used, service := "false", "-", "-"
+			for _, key := range u.AccessKeys {
+				if key.Slot == slot && key.Active {
+					active, used = "true", iam.FormatReportTime(key.LastUsed)
+					if key.LastUsedService != "" {
+						service = key.LastUsedService
+					}
+				}
+			}
+			row = append(row, active, used, service)
+		}
+		data.Rows = append(data.Rows, append(row, iam.FormatFlags(u.Flags)))
+	}
+	return writeOutput(cfg, data)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/keanuharrell/a9s/internal/approval"
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/output"
+)
+
+var (
+	approvalsAll    bool
+	approvalsReason string
+)
+
+var approvalsCmd = &cobra.Command{
+	Use:   "approvals",
+	Short: "Review two-person approval requests",
+	Long: `List, approve and reject requests for dangerous actions when
+approvals.enabled is set.
+
+Running a guarded action (for example terminating an instance) files a
+pending request instead of executing it. A different operator approves it
+here or in the Approvals view; the requester then runs the action again.
+Operators are named by approvals.operator, $A9S_OPERATOR or the OS user; a
+request cannot be decided from the OS account that filed it.`,
+}
+
+var approvalsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending approval requests",
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runApprovalsList()
+	},
+}
+
+var approvalsApproveCmd = &cobra.Command{
+	Use:   "approve <id>",
+	Short: "Approve a pending request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runApprovalsDecide(args[0], true)
+	},
+}
+
+var approvalsRejectCmd = &cobra.Command{
+	Use:   "reject <id>",
+	Short: "Reject a pending request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runApprovalsDecide(args[0], false)
+	},
+}
+
+func init() {
+	approvalsListCmd.Flags().BoolVar(&approvalsAll, "all", false, "Include decided, used and expired requests")
+	approvalsRejectCmd.Flags().StringVar(&approvalsReason, "reason", "", "Reason shown to the requester")
+	approvalsCmd.AddCommand(approvalsListCmd, approvalsApproveCmd, approvalsRejectCmd)
+	rootCmd.AddCommand(approvalsCmd)
+}
+
+// loadApprovals returns the configuration and approval store for approval
+// commands, with audit hooks attached.
+func loadApprovals() (*config.Config, *approval.Store, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	dispatcher := createDispatcher(cfg)
+	cleanup := func() { cleanupDispatcher(dispatcher) }
+	return cfg, approvalStore(cfg, dispatcher), cleanup, nil
+}
+
+func runApprovalsList() error {
+	cfg, store, cleanup, err := loadApprovals()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	requests, err := store.List()
+	if err != nil {
+		return err
+	}
+	if !approvalsAll {
+		pending := requests[:0]
+		for _, r := range requests {
+			if r.Status == approval.StatusPending {
+				pending = append(pending, r)
+			}
+		}
+		requests = pending
+	}
+
+	data := output.Data{
+		Value: requests,
+		Columns: []output.Column{
+			{Name: "id"}, {Name: "action"}, {Name: "resource"}, {Name: "requester"},
+			{Name: "requested"}, {Name: "status"}, {Name: "approver"},
+		},
+		Empty: "No approval requests.",
+	}
+	for _, r := range requests {
+		data.Rows = append(data.Rows, []string{
+			r.ID, r.Service + ":" + r.Action, r.ResourceID, r.Requester,
+			r.RequestedAt.Format("2006-01-02 15:04"), string(r.Status), r.Approver,
+		})
+	}
+	return writeOutput(cfg, data)
+}
+
+func runApprovalsDecide(id string, approve bool) error {
+	cfg, store, cleanup, err := loadApprovals()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	r, err := store.Decide(context.Background(), id, approvalOperator(cfg), approve, approvalsReason)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Request %s %s: %s:%s on %s requested by %s\n", r.ID, r.Status, r.Service, r.Action, r.ResourceID, r.Requester)
+	return nil
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/crash"
+)
+
+var (
+	bugreportOutput  string
+	bugreportReports int
+)
+
+var bugreportCmd = &cobra.Command{
+	Use:   "bugreport",
+	Short: "Bundle crash reports and diagnostics for an issue",
+	Long: `Bundle the most recent crash reports together with version information
+and a configuration summary into a tarball that can be attached to an issue.
+
+Secrets in the configuration (passwords, tokens, API keys, webhooks) are
+redacted. Crash reports are read from the state directory
+($XDG_STATE_HOME/a9s or ~/.local/state/a9s).`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runBugreport()
+	},
+}
+
+func init() {
+	bugreportCmd.Flags().StringVarP(&bugreportOutput, "file", "f", "", "Output file (default a9s-bugreport-<time>.tar.gz)")
+	bugreportCmd.Flags().IntVar(&bugreportReports, "reports", 3, "Number of most recent crash reports to include")
+	rootCmd.AddCommand(bugreportCmd)
+}
+
+func runBugreport() error {
+	if bugreportReports < 0 {
+		return fmt.Errorf("--reports must not be negative")
+	}
+
+	paths, err := crash.Reports(config.StateDir())
+	if err != nil {
+		return fmt.Errorf("failed to list crash reports: %w", err)
+	}
+	if len(paths) > bugreportReports {
+		paths = paths[:bugreportReports]
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	system, err := json.MarshalIndent(map[string]string{
+		"version":    Version,
+		"build_time": BuildTime,
+		"go_version": runtime.Version(),
+		"os":         runtime.GOOS,
+		"arch":       runtime.GOARCH,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	summary, err := json.MarshalIndent(crash.Summarize(cfg), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	output := bugreportOutput
+	if output == "" {
+		output = fmt.Sprintf("a9s-bugreport-%s.tar.gz", time.Now().Format("20060102-150405"))
+	}
+	f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", output, err)
+	}
+	defer f.Close()
+
+	files := map[string][]byte{
+		"system.json": system,
+		"config.json": summary,
+	}
+	if err := crash.Bundle(f, files, paths); err != nil {
+		return err
+	}
+
+	fmt.Printf("Bug report written to %s (%d crash reports)\n", output, len(paths))
+	return nil
+}
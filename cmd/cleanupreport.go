@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/cleanup"
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/output"
+)
+
+var (
+	cleanupReportServices []string
+	cleanupReportTags     []string
+)
+
+var cleanupReportCmd = &cobra.Command{
+	Use:   "cleanup-report",
+	Short: "Report cleanup candidates across services grouped by owning team",
+	Long: `List the resources services flag for cleanup, such as unattached EBS
+volumes, orphaned snapshots, unassociated Elastic IPs, orphaned network
+interfaces, unused secrets and untagged S3 buckets, grouped by the team
+named in their Owner or Team tag.
+
+The report is a Markdown section per team, costliest first, with why each
+resource was flagged and what it costs, ready to paste into Slack.
+Resources without an owner tag are listed last, as Unowned.
+
+Use --tags to read owners from other tags, and --output json, yaml or csv
+to list the candidates with their owner instead.`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runCleanupReport()
+	},
+}
+
+func init() {
+	cleanupReportCmd.Flags().StringSliceVar(&cleanupReportServices, "services", nil, "Services to report on (default every service flagging cleanup candidates)")
+	cleanupReportCmd.Flags().StringSliceVar(&cleanupReportTags, "tags", cleanup.DefaultOwnerTags, "Tags naming the owning team, in order of preference")
+	rootCmd.AddCommand(cleanupReportCmd)
+}
+
+func runCleanupReport() error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	factory, err := awsfactory.NewClientFactory(cfg.AWS.ToCore())
+	if err != nil {
+		return fmt.Errorf("failed to initialize AWS: %w", err)
+	}
+
+	dispatcher := createDispatcher(cfg)
+	defer cleanupDispatcher(dispatcher)
+
+	regs, err := cleanupRegistrations(factory, cfg, dispatcher, cleanupReportServices)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	candidates := []cleanup.Candidate{}
+	for _, reg := range regs {
+		found, err := cleanupCandidates(ctx, reg.Service)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	if format := core.OutputFormat(strings.ToLower(outputFormat)); format == core.FormatTable {
+		title := fmt.Sprintf("Cleanup candidates in %s", factory.Region())
+		if profile := factory.Profile(); profile != "" {
+			title += fmt.Sprintf(" (%s)", profile)
+		}
+		return cleanup.WriteMarkdown(os.Stdout, title, cleanup.Group(candidates))
+	}
+
+	data := output.Data{
+		Value: candidates,
+		Columns: []output.Column{
+			{Name: "owner"}, {Name: "service"}, {Name: "id", Title: "ID"}, {Name: "name"}, {Name: "region"},
+			{Name: "reason"}, {Name: "monthly_cost", Title: "$/MO"},
+		},
+		Empty: "No cleanup candidates.",
+	}
+	for _, c := range candidates {
+		cost := "-"
+		if c.Monthly != nil {
+			cost = fmt.Sprintf("%.2f", *c.Monthly)
+		}
+		data.Rows = append(data.Rows, []string{c.Owner, c.Service, c.ID, c.Name, c.Region, c.Reason, cost})
+	}
+	return writeOutput(cfg, data)
+}
+
+// cleanupRegistrations builds the services flagging cleanup candidates, as
+// the TUI builds them with their settings, by name: the named ones, or all
+// of them when none are named.
+func cleanupRegistrations(factory *awsfactory.ClientFactory, cfg *config.Config, dispatcher core.EventDispatcher, names []string) ([]core.ServiceRegistration, error) {
+	registrations, _ := serviceRegistry(factory, cfg, dispatcher)
+
+	var flagging []string
+	var regs []core.ServiceRegistration
+	for _, name := range slices.Sorted(maps.Keys(registrations)) {
+		registration, err := registrations[name]()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s service: %w", name, err)
+		}
+		if _, ok := registration.Service.(core.CleanupFlagger); !ok {
+			continue
+		}
+		flagging = append(flagging, name)
+		if len(names) == 0 || slices.Contains(names, name) {
+			regs = append(regs, registration)
+		}
+	}
+
+	for _, name := range names {
+		if !slices.Contains(flagging, name) {
+			return nil, fmt.Errorf("service %q flags no cleanup candidates (expected %s)", name, strings.Join(flagging, ", "))
+		}
+	}
+	return regs, nil
+}
+
+// cleanupCandidates lists a service's resources and returns those it flags
+// for cleanup. Resources the listing leaves to enrichment, such as S3
+// buckets, are enriched first. Parts of a partial listing that failed, and
+// resources whose enrichment fails, are reported on stderr and skipped.
+func cleanupCandidates(ctx context.Context, svc core.AWSService) ([]cleanup.Candidate, error) {
+	name := svc.Name()
+	lister, ok := svc.(core.ResourceLister)
+	if !ok {
+		return nil, fmt.Errorf("service %s does not support listing", name)
+	}
+	resources, err := lister.List(ctx, core.ListOptions{})
+	var partial *core.PartialError
+	if errors.As(err, &partial) {
+		for _, f := range partial.Failures {
+			fmt.Fprintf(os.Stderr, "warning: %s %s: %v\n", name, f.Shard, f.Err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to list %s resources: %w", name, err)
+	}
+
+	if enricher, ok := svc.(core.ResourceEnricher); ok {
+		enriched := resources[:0]
+		for _, r := range resources {
+			if analyzed, known := r.Metadata["analyzed"].(bool); known && !analyzed {
+				if err := enricher.EnrichResource(ctx, &r); err != nil {
+					fmt.Fprintf(os.Stderr, "warning: %s %s: %v\n", name, r.ID, err)
+					continue
+				}
+			}
+			enriched = append(enriched, r)
+		}
+		resources = enriched
+	}
+
+	return cleanup.Collect(name, resources, cleanupReportTags), nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/hooks"
+)
+
+func TestCleanupRegistrations(t *testing.T) {
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+	factory, err := awsfactory.NewClientFactory(&core.AWSConfig{Region: "eu-west-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &config.Config{}
+	dispatcher := hooks.NewDispatcher()
+
+	tests := []struct {
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			names: nil,
+			want:  []string{"ebs", "ec2", "eip", "eni", "iam", "iamcleanup", "nat", "rds", "s3", "secretsmanager", "snapshots"},
+		},
+		{names: []string{"iam", "ebs"}, want: []string{"ebs", "iam"}},
+		{names: []string{"lambda"}, wantErr: true},
+		{names: []string{"unknown"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		regs, err := cleanupRegistrations(factory, cfg, dispatcher, tt.names)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("cleanupRegistrations(%v) succeeded", tt.names)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("cleanupRegistrations(%v) error = %v", tt.names, err)
+		}
+		var got []string
+		for _, reg := range regs {
+			got = append(got, reg.Service.Name())
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("cleanupRegistrations(%v) = %v, want %v", tt.names, got, tt.want)
+		}
+	}
+}
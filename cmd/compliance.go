@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/output"
+	"github.com/keanuharrell/a9s/internal/services/baseline"
+	"github.com/keanuharrell/a9s/internal/services/ec2"
+	"github.com/keanuharrell/a9s/internal/services/iam"
+	"github.com/keanuharrell/a9s/internal/services/kms"
+	"github.com/keanuharrell/a9s/internal/services/s3"
+	"github.com/keanuharrell/a9s/internal/services/securitygroups"
+)
+
+var (
+	complianceFramework string
+	complianceServices  []string
+)
+
+var complianceCmd = &cobra.Command{
+	Use:   "compliance",
+	Short: "Report failed checks mapped to CIS and FSBP controls",
+	Long: `Run the built-in checks against the current account and list each
+failure with the compliance controls it violates.
+
+Checks:
+- s3-public-bucket  Buckets not blocking public access  (CIS 2.1.4, FSBP S3.8)
+- iam-full-admin    Roles with full "*:*" admin rights  (CIS 1.16, FSBP IAM.1)
+- ebs-unencrypted   Instances with unencrypted volumes  (CIS 2.2.1, FSBP EC2.3)
+
+Account baselines (--services baseline):
+- s3-account-public-access  S3 Block Public Access not fully on  (CIS 2.1.4, FSBP S3.1)
+- ebs-default-encryption    EBS encryption by default off         (CIS 2.2.1, FSBP EC2.7)
+- iam-password-policy       Password policy below the baseline    (CIS 1.8, 1.9, FSBP IAM.7)
+- root-mfa                  Root user without MFA                 (CIS 1.5, FSBP IAM.9)
+- root-access-keys          Root user with access keys            (CIS 1.4, FSBP IAM.4)
+
+Security groups (--services securitygroups):
+- sg-open-admin-ports      SSH, RDP or WinRM open to the internet  (CIS 5.2, 5.3, FSBP EC2.53, EC2.54)
+- sg-open-high-risk-ports  Databases, file shares and other high-risk
+                           ports open to the internet              (FSBP EC2.19)
+- sg-default-open          Default security group allows traffic   (CIS 5.4, FSBP EC2.2)
+
+KMS keys (--services kms):
+- kms-rotation  Customer managed key without rotation  (CIS 3.6, FSBP KMS.4)
+- kms-public    Key policy allows any principal        (FSBP KMS.5)
+
+Use --framework to report only the controls of one framework.`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runCompliance()
+	},
+}
+
+func init() {
+	complianceCmd.Flags().StringVar(&complianceFramework, "framework", "", "Only report controls of this framework (cis, fsbp)")
+	complianceCmd.Flags().StringSliceVar(&complianceServices, "services", []string{"ec2", "iam", "s3", "baseline", "securitygroups"}, "Services to check")
+	rootCmd.AddCommand(complianceCmd)
+}
+
+func runCompliance() error {
+	var frameworks []compliance.Framework
+	if complianceFramework != "" {
+		framework, err := compliance.ParseFramework(complianceFramework)
+		if err != nil {
+			return err
+		}
+		frameworks = append(frameworks, framework)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	factory, err := awsfactory.NewClientFactory(cfg.AWS.ToCore())
+	if err != nil {
+		return fmt.Errorf("failed to initialize AWS: %w", err)
+	}
+
+	dispatcher := createDispatcher(cfg)
+	defer cleanupDispatcher(dispatcher)
+
+	checkers := map[string]compliance.Checker{
+		"ec2":            ec2.NewService(factory, dispatcher, ec2Options(cfg)...),
+		"iam":            iam.NewService(factory, dispatcher),
+		"s3":             s3.NewService(factory, dispatcher),
+		"baseline":       baseline.NewService(factory, dispatcher),
+		"securitygroups": securitygroups.NewService(factory, dispatcher),
+		"kms":            kms.NewService(factory, dispatcher),
+	}
+
+	ctx := context.Background()
+	findings := []compliance.Finding{}
+	for _, name := range complianceServices {
+		checker, ok := checkers[name]
+		if !ok {
+			return fmt.Errorf("no compliance checks for service %q (expected ec2, iam, s3, baseline, securitygroups or kms)", name)
+		}
+		found, err := checkCompliance(ctx, name, checker, frameworks)
+		if err != nil {
+			return err
+		}
+		findings = append(findings, found...)
+	}
+
+	data := output.Data{
+		Value: findings,
+		Columns: []output.Column{
+			{Name: "service"}, {Name: "resource"}, {Name: "name"}, {Name: "region"},
+			{Name: "check"}, {Name: "controls"},
+		},
+		Empty: "No failed checks.",
+	}
+	for _, f := range findings {
+		refs := make([]string, len(f.Controls))
+		for i, c := range f.Controls {
+			refs[i] = c.String()
+		}
+		data.Rows = append(data.Rows, []string{f.Service, f.Resource, f.Name, f.Region, string(f.Check), strings.Join(refs, ", ")})
+	}
+	return writeOutput(cfg, data)
+}
+
+// checkCompliance runs a service's checks on every resource it lists.
+// Resources whose checks fail to run, and the parts of a partial listing
+// that failed, are reported on stderr and skipped.
+func checkCompliance(ctx context.Context, name string, checker compliance.Checker, frameworks []compliance.Framework) ([]compliance.Finding, error) {
+	lister, ok := checker.(core.ResourceLister)
+	if !ok {
+		return nil, fmt.Errorf("service %s does not support listing", name)
+	}
+	resources, err := lister.List(ctx, core.ListOptions{})
+	var partial *core.PartialError
+	if errors.As(err, &partial) {
+		for _, f := range partial.Failures {
+			fmt.Fprintf(os.Stderr, "warning: %s %s: %v\n", name, f.Shard, f.Err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to list %s resources: %w", name, err)
+	}
+
+	var findings []compliance.Finding
+	for i := range resources {
+		checks, err := checker.CheckCompliance(ctx, &resources[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s %s: %v\n", name, resources[i].ID, err)
+			continue
+		}
+		findings = append(findings, compliance.Findings(name, resources[i], checks, frameworks...)...)
+	}
+	return findings, nil
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/services/ec2"
+)
+
+var daemonInterval time.Duration
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Run background jobs such as EC2 schedule enforcement",
+	Long: `Run a9s in daemon mode, executing background jobs on a fixed interval
+until interrupted.
+
+Jobs:
+- ec2-schedules  Start and stop instances tagged with a9s:schedule
+                 (office-hours, weekdays, nights-off)
+
+Changes go through the same checks as actions run from the TUI: a change
+held back by a maintenance window, policy or approval is logged as failed.
+Use --dry-run to log planned changes without applying them.`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runDaemon()
+	},
+}
+
+func init() {
+	daemonCmd.Flags().DurationVar(&daemonInterval, "interval", 5*time.Minute, "Interval between job runs")
+	rootCmd.AddCommand(daemonCmd)
+}
+
+// daemonJob is a unit of background work run on every daemon tick.
+type daemonJob struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+func runDaemon() error {
+	if daemonInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	applyFlagOverrides(cfg)
+
+	factory, err := awsfactory.NewClientFactory(cfg.AWS.ToCore())
+	if err != nil {
+		return fmt.Errorf("failed to initialize AWS: %w", err)
+	}
+
+	dispatcher := createDispatcher(cfg)
+	defer cleanupDispatcher(dispatcher)
+
+	ec2Svc := ec2.NewService(factory, dispatcher, ec2Options(cfg)...)
+
+	// Jobs run actions through the same guards as the TUI, so maintenance
+	// windows, policy and approvals apply to them
+	registerActionGuards(cfg, factory, dispatcher, func(name string) (core.AWSService, error) {
+		if name == ec2Svc.Name() {
+			return ec2Svc, nil
+		}
+		return nil, fmt.Errorf("%w: %s", core.ErrServiceNotFound, name)
+	})
+	jobs := []daemonJob{
+		{name: "ec2-schedules", run: func(ctx context.Context) error {
+			return enforceEC2Schedules(ctx, ec2Svc)
+		}},
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logf("daemon started (interval %s, dry-run %v)", daemonInterval, dryRun)
+
+	ticker := time.NewTicker(daemonInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, job := range jobs {
+			if err := job.run(ctx); err != nil && ctx.Err() == nil {
+				logf("%s: %v", job.name, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logf("daemon stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func enforceEC2Schedules(ctx context.Context, svc *ec2.Service) error {
+	changes, err := svc.EnforceSchedules(ctx, time.Now(), dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range changes {
+		switch {
+		case c.Err != nil:
+			logf("ec2-schedules: %s %s (%s) failed: %v", c.Action, c.InstanceID, c.Schedule, c.Err)
+		case dryRun:
+			logf("ec2-schedules: would %s %s %q (%s)", c.Action, c.InstanceID, c.Name, c.Schedule)
+		default:
+			logf("ec2-schedules: %s %s %q (%s)", c.Action, c.InstanceID, c.Name, c.Schedule)
+		}
+	}
+	return nil
+}
+
+func logf(format string, args ...any) {
+	fmt.Fprintf(os.Stdout, "%s "+format+"\n", append([]any{time.Now().Format(time.RFC3339)}, args...)...)
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/keanuharrell/a9s/internal/config"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/notes"
+	"github.com/keanuharrell/a9s/internal/output"
+)
+
+var notesExportFormat string
+
+var notesCmd = &cobra.Command{
+	Use:   "notes",
+	Short: "Manage local notes on resources",
+	Long: `List, set, remove and export the local notes attached to resources.
+
+Notes are kept in $XDG_STATE_HOME/a9s/notes.json (or ~/.local/state/a9s),
+keyed by resource ARN, and are never written to AWS. In the TUI, press [n]
+on a resource to edit its note; detail panels show it.`,
+}
+
+var notesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List notes",
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runNotesList()
+	},
+}
+
+var notesSetCmd = &cobra.Command{
+	Use:   "set <arn> <text>",
+	Short: "Set the note on a resource",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		n, err := notesStore().Set(args[0], args[0], strings.Join(args[1:], " "), approvalOperator(cfg))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Saved note on %s\n", n.Key)
+		return nil
+	},
+}
+
+var notesRemoveCmd = &cobra.Command{
+	Use:     "rm <arn>",
+	Aliases: []string{"remove"},
+	Short:   "Remove the note on a resource",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return notesStore().Delete(args[0])
+	},
+}
+
+var notesExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export notes as JSON, YAML or CSV",
+	RunE: func(_ *cobra.Command, _ []string) error {
+		list, err := notesStore().List()
+		if err != nil {
+			return err
+		}
+		return exportNotes(os.Stdout, list, notesExportFormat)
+	},
+}
+
+func init() {
+	notesExportCmd.Flags().StringVar(&notesExportFormat, "format", "json", "Export format (json, yaml, csv)")
+	notesCmd.AddCommand(notesListCmd, notesSetCmd, notesRemoveCmd, notesExportCmd)
+	rootCmd.AddCommand(notesCmd)
+}
+
+// notesStore opens the local notes store.
+func notesStore() *notes.Store {
+	return notes.NewStore(notes.DefaultPath(config.StateDir()))
+}
+
+func runNotesList() error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	list, err := notesStore().List()
+	if err != nil {
+		return err
+	}
+
+	data := output.Data{
+		Value: list,
+		Columns: []output.Column{
+			{Name: "resource"}, {Name: "note"}, {Name: "author"}, {Name: "updated"},
+		},
+		Empty: "No notes.",
+	}
+	for _, n := range list {
+		data.Rows = append(data.Rows, []string{n.Key, n.Text, n.Author, n.UpdatedAt.Format("2006-01-02 15:04")})
+	}
+	return writeOutput(cfg, data)
+}
+
+// exportNotes writes notes in the given format, with every field so the
+// export can be restored or processed elsewhere.
+func exportNotes(w io.Writer, list []notes.Note, format string) error {
+	data := output.Data{
+		Value: list,
+		Columns: []output.Column{
+			{Name: "key"}, {Name: "resource"}, {Name: "text"}, {Name: "author"}, {Name: "updated_at"},
+		},
+	}
+	for _, n := range list {
+		data.Rows = append(data.Rows, []string{n.Key, n.Resource, n.Text, n.Author, n.UpdatedAt.Format(time.RFC3339)})
+	}
+	return output.Write(w, core.OutputFormat(format), data)
+}
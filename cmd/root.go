@@ -3,21 +3,76 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
+	"github.com/keanuharrell/a9s/internal/approval"
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/batch"
 	"github.com/keanuharrell/a9s/internal/config"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/crash"
 	"github.com/keanuharrell/a9s/internal/hooks"
 	"github.com/keanuharrell/a9s/internal/hooks/builtin"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/metrics"
+	"github.com/keanuharrell/a9s/internal/output"
+	"github.com/keanuharrell/a9s/internal/ownership"
+	"github.com/keanuharrell/a9s/internal/policy"
+	"github.com/keanuharrell/a9s/internal/preflight"
 	"github.com/keanuharrell/a9s/internal/registry"
+	"github.com/keanuharrell/a9s/internal/runbook"
+	"github.com/keanuharrell/a9s/internal/services/accessanalyzer"
+	"github.com/keanuharrell/a9s/internal/services/apigateway"
+	"github.com/keanuharrell/a9s/internal/services/approvals"
+	"github.com/keanuharrell/a9s/internal/services/autoscaling"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/services/baseline"
+	"github.com/keanuharrell/a9s/internal/services/cloudformation"
+	"github.com/keanuharrell/a9s/internal/services/cloudtrail"
+	"github.com/keanuharrell/a9s/internal/services/cloudwatchalarms"
+	"github.com/keanuharrell/a9s/internal/services/cloudwatchlogs"
+	"github.com/keanuharrell/a9s/internal/services/cost"
+	"github.com/keanuharrell/a9s/internal/services/coverage"
+	"github.com/keanuharrell/a9s/internal/services/dynamodb"
+	"github.com/keanuharrell/a9s/internal/services/ebs"
 	"github.com/keanuharrell/a9s/internal/services/ec2"
+	"github.com/keanuharrell/a9s/internal/services/ecr"
+	"github.com/keanuharrell/a9s/internal/services/ecs"
+	"github.com/keanuharrell/a9s/internal/services/eip"
+	"github.com/keanuharrell/a9s/internal/services/eks"
+	"github.com/keanuharrell/a9s/internal/services/elasticache"
+	"github.com/keanuharrell/a9s/internal/services/elb"
+	"github.com/keanuharrell/a9s/internal/services/eni"
+	"github.com/keanuharrell/a9s/internal/services/eventbridge"
+	"github.com/keanuharrell/a9s/internal/services/expiry"
+	"github.com/keanuharrell/a9s/internal/services/exposure"
 	"github.com/keanuharrell/a9s/internal/services/iam"
+	"github.com/keanuharrell/a9s/internal/services/iamcleanup"
+	"github.com/keanuharrell/a9s/internal/services/kms"
 	"github.com/keanuharrell/a9s/internal/services/lambda"
+	"github.com/keanuharrell/a9s/internal/services/nat"
+	"github.com/keanuharrell/a9s/internal/services/paramdiff"
+	"github.com/keanuharrell/a9s/internal/services/rds"
 	"github.com/keanuharrell/a9s/internal/services/s3"
+	"github.com/keanuharrell/a9s/internal/services/scheduler"
+	"github.com/keanuharrell/a9s/internal/services/secretsmanager"
+	"github.com/keanuharrell/a9s/internal/services/securitygroups"
+	"github.com/keanuharrell/a9s/internal/services/securityhub"
+	"github.com/keanuharrell/a9s/internal/services/snapshots"
+	"github.com/keanuharrell/a9s/internal/services/sns"
+	"github.com/keanuharrell/a9s/internal/services/sqs"
+	"github.com/keanuharrell/a9s/internal/services/ssm"
+	"github.com/keanuharrell/a9s/internal/services/topology"
+	"github.com/keanuharrell/a9s/internal/services/vpc"
 	"github.com/keanuharrell/a9s/internal/tui"
+	"github.com/keanuharrell/a9s/internal/tui/theme"
+	"github.com/keanuharrell/a9s/internal/uistate"
 )
 
 var (
@@ -27,12 +82,14 @@ var (
 	BuildTime = "unknown"
 
 	// CLI flags
-	outputFormat string
-	awsProfile   string
-	awsRegion    string
-	dryRun       bool
-	configFile   string
-	verbose      bool
+	outputFormat  string
+	outputColumns []string
+	awsProfile    string
+	awsRegion     string
+	awsEndpoint   string
+	dryRun        bool
+	configFile    string
+	verbose       bool
 )
 
 var rootCmd = &cobra.Command{
@@ -52,6 +109,11 @@ Usage:
   a9s tui      Launch interactive TUI explicitly
   a9s [cmd]    Run specific CLI commands`,
 	Version: Version,
+	// Reject an unknown --output before a command does any work
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		_, err := output.For(core.OutputFormat(strings.ToLower(outputFormat)))
+		return err
+	},
 	Run: func(_ *cobra.Command, _ []string) {
 		if err := runTUI(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -87,6 +149,11 @@ func runTUI() error {
 	// Apply CLI flag overrides
 	applyFlagOverrides(cfg)
 
+	// Select the UI language before any view is built
+	if err := i18n.SetLocale(cfg.TUI.Locale); err != nil {
+		return err
+	}
+
 	// Create AWS client factory
 	awsCfg := cfg.AWS.ToCore()
 	factory, err := awsfactory.NewClientFactory(awsCfg)
@@ -97,8 +164,33 @@ func runTUI() error {
 	// Create event dispatcher with hooks
 	dispatcher := createDispatcher(cfg)
 
+	// Remember recent events for crash reports
+	recorder := crash.NewRecorder(crash.DefaultRecorderSize)
+	dispatcher.Register(recorder)
+
 	// Create registry
-	reg := registry.New()
+	reg := registry.New(registry.WithShortcuts(cfg.TUI.Shortcuts), registry.WithSummaries(cfg.TUI.Summary))
+
+	// Cooldown, maintenance windows, policy and approvals
+	registerActionGuards(cfg, factory, dispatcher, reg.GetService)
+
+	// Local notes on resources, edited with [n] in every view
+	base.UseNotes(notesStore(), approvalOperator(cfg))
+
+	// CloudWatch charts of the selected resource, opened with [M] in every view
+	base.UseMetrics(metrics.New(factory))
+
+	// AWS console pages of the selected resource, opened with [W] in every view
+	base.UseConsole(factory.Region)
+
+	// Runbooks run on the selected resource, started with [K] in every view
+	if len(cfg.Runbooks) > 0 {
+		books := make([]runbook.Runbook, len(cfg.Runbooks))
+		for i := range cfg.Runbooks {
+			books[i] = cfg.Runbooks[i].ToRunbook()
+		}
+		base.UseRunbooks(books, reg.GetService)
+	}
 
 	// Register services
 	if err := registerServices(reg, factory, cfg, dispatcher); err != nil {
@@ -108,16 +200,35 @@ func runTUI() error {
 	// Create and run TUI
 	app := tui.NewApp(reg, cfg, dispatcher)
 	app.SetFactory(factory)
+	// Swap in fresh services after a profile or region switch so caches
+	// never leak between accounts
+	app.SetOnConfigChange(func(_, _ string) error {
+		regs, err := serviceRegistrations(factory, cfg, dispatcher)
+		if err != nil {
+			return err
+		}
+		return reg.ReplaceAll(regs)
+	})
+	if cfg.TUI.Preflight {
+		app.SetPreflight(preflight.New(factory, preflight.WithDispatcher(dispatcher)))
+	}
 
-	program := tea.NewProgram(
+	// Reopen the last view where it was left
+	uiStore := uistate.NewStore(uistate.DefaultPath(config.StateDir()))
+	var uiState uistate.State
+	if cfg.TUI.RememberState {
+		uiState, _ = uiStore.Load()
+		app.RestoreUIState(uiState)
+	}
+
+	crashed, err := crash.Run(
 		app,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
 
-	_, err = program.Run()
-	if err != nil {
-		return fmt.Errorf("error running TUI: %w", err)
+	if cfg.TUI.RememberState && crashed == nil {
+		_ = uiStore.Save(app.UIState(uiState))
 	}
 
 	// Cleanup
@@ -126,9 +237,30 @@ func runTUI() error {
 		_ = svc.Close()
 	}
 
+	if crashed != nil {
+		return reportCrash(cfg, recorder, crashed)
+	}
+	if err != nil {
+		return fmt.Errorf("error running TUI: %w", err)
+	}
+
 	return nil
 }
 
+// reportCrash writes a crash report for a recovered panic and returns an
+// error telling the user where it was saved.
+func reportCrash(cfg *config.Config, recorder *crash.Recorder, p *crash.Panic) error {
+	report := crash.NewReport(Version, p)
+	report.Events = recorder.Events()
+	report.Config = crash.Summarize(cfg)
+
+	path, err := report.Write(config.StateDir())
+	if err != nil {
+		return fmt.Errorf("a9s crashed: %v (%v)\n\n%s", p.Value, err, p.Stack)
+	}
+	return fmt.Errorf("a9s crashed: %v\n\nCrash report saved to %s\nRun 'a9s bugreport' to bundle it for an issue", p.Value, path)
+}
+
 // =============================================================================
 // Event Dispatcher Setup
 // =============================================================================
@@ -216,9 +348,16 @@ func defaultConfig() *config.Config {
 			Theme:           "default",
 			MouseEnabled:    true,
 			AltScreen:       true,
+			Preflight:       true,
+			RememberState:   true,
+			FrameBudget:     16000000, // 16ms in nanoseconds
 		},
 		Services: config.ServicesConfig{
-			Enabled: []string{"ec2", "iam", "s3", "lambda"},
+			Enabled: []string{"ec2", "iam", "s3", "lambda", "accessanalyzer"},
+		},
+		Policy: config.PolicyConfig{
+			WarnManaged: true,
+			Cooldown:    2000000000, // 2s in nanoseconds
 		},
 		Logging: config.LoggingConfig{
 			Level:  "info",
@@ -240,73 +379,602 @@ func applyFlagOverrides(cfg *config.Config) {
 	if awsRegion != "" {
 		cfg.AWS.Region = awsRegion
 	}
+	if awsEndpoint != "" {
+		cfg.AWS.Endpoint = awsEndpoint
+	}
 	if verbose {
 		cfg.Logging.Level = "debug"
 	}
 }
 
+// writeOutput renders a command's result to stdout in the --output format,
+// keeping the --columns selection. Tables take their colors from the theme.
+func writeOutput(cfg *config.Config, data output.Data) error {
+	format := core.OutputFormat(strings.ToLower(outputFormat))
+	if len(outputColumns) > 0 {
+		selected, err := data.Select(outputColumns...)
+		if err != nil {
+			return err
+		}
+		data = selected
+	}
+	if format == core.FormatTable {
+		th := theme.FromConfig(cfg)
+		return output.NewTableWriter(
+			output.WithHeaderStyle(th.Title),
+			output.WithEmptyStyle(th.Muted),
+		).Write(os.Stdout, data)
+	}
+	return output.Write(os.Stdout, format, data)
+}
+
 // =============================================================================
 // Service Registration
 // =============================================================================
 
+// ec2Options builds EC2 service options from the ec2 service settings.
+func ec2Options(cfg *config.Config) []ec2.Option {
+	opts := []ec2.Option{
+		ec2.WithIdleCPUPercent(float64(config.ServiceInt(cfg.Services.EC2, "idle_cpu_percent", 0))),
+		ec2.WithComputeOptimizer(config.ServiceBool(cfg.Services.EC2, "compute_optimizer", false)),
+	}
+	if raw, ok := cfg.Services.EC2["default_filters"].(map[string]any); ok {
+		filters := make(map[string]string, len(raw))
+		for k, v := range raw {
+			filters[k] = fmt.Sprint(v)
+		}
+		opts = append(opts, ec2.WithDefaultFilters(filters))
+	}
+	if tz, ok := cfg.Services.EC2["schedule_timezone"].(string); ok && tz != "" {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			opts = append(opts, ec2.WithScheduleLocation(loc))
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: invalid services.ec2.schedule_timezone %q: %v\n", tz, err)
+		}
+	}
+	return opts
+}
+
+// paramDiffOptions reads the comparison the parameter diff view runs first.
+func paramDiffOptions(cfg *config.Config) []paramdiff.Option {
+	if len(cfg.Services.ParamDiff) == 0 {
+		return nil
+	}
+	filters := make(map[string]string, len(cfg.Services.ParamDiff))
+	for k, v := range cfg.Services.ParamDiff {
+		filters[k] = fmt.Sprint(v)
+	}
+	c, err := paramdiff.ParseComparison(filters)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid services.paramdiff: %v\n", err)
+		return nil
+	}
+	return []paramdiff.Option{paramdiff.WithDefaultComparison(c)}
+}
+
+// registerActionGuards sets up the checks core.ExecuteAction runs before
+// every action, wherever it is started from. services looks up the
+// services of the resources the IaC guard inspects.
+func registerActionGuards(cfg *config.Config, factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, services func(name string) (core.AWSService, error)) {
+	// Refuse running the same action on the same resource twice in a row
+	core.SetActionCooldown(cfg.Policy.Cooldown)
+
+	// Block or hold back dangerous actions during maintenance windows
+	if len(cfg.Policy.Freezes) > 0 {
+		core.RegisterActionGuard(actionFreeze(cfg, factory, dispatcher))
+	}
+
+	// Warn before changes that would drift from CloudFormation or Terraform
+	if cfg.Policy.WarnManaged {
+		core.RegisterActionGuard(iac.NewGuard(services))
+	}
+
+	// Confirm or block actions per environment
+	if len(cfg.Policy.Rules) > 0 {
+		core.RegisterActionGuard(actionPolicy(cfg, factory))
+	}
+
+	// Require a second operator for dangerous actions
+	if cfg.Approvals.Enabled {
+		core.RegisterActionGuard(approval.NewGuard(approvalStore(cfg, dispatcher), approvalOperator(cfg), cfg.Approvals.Actions))
+	}
+}
+
+// approvalStore opens the shared approval request store.
+func approvalStore(cfg *config.Config, dispatcher core.EventDispatcher) *approval.Store {
+	path := cfg.Approvals.Store
+	if path == "" {
+		path = approval.DefaultPath(config.StateDir())
+	}
+	return approval.NewStore(path,
+		approval.WithTTL(cfg.Approvals.TTL),
+		approval.WithFileMode(cfg.Approvals.FileMode()),
+		approval.WithDispatcher(dispatcher),
+	)
+}
+
+// approvalOperator returns the name approvals are requested and decided as.
+func approvalOperator(cfg *config.Config) string {
+	if cfg.Approvals.Operator != "" {
+		return cfg.Approvals.Operator
+	}
+	return approval.CurrentOperator()
+}
+
+// actionPolicy builds the confirmation policy, evaluated against the
+// factory's current profile and region.
+func actionPolicy(cfg *config.Config, factory *awsfactory.ClientFactory) *policy.Policy {
+	rules := make([]policy.Rule, 0, len(cfg.Policy.Rules))
+	for _, r := range cfg.Policy.Rules {
+		level, _ := policy.ParseLevel(r.Level) // Validated when loading
+		rules = append(rules, policy.Rule{
+			Environments: r.Environments,
+			Categories:   r.Categories,
+			Actions:      r.Actions,
+			Dangerous:    r.Dangerous,
+			Level:        level,
+		})
+	}
+
+	return policy.New(policyEnvironments(cfg), rules, awsContext(factory))
+}
+
+// actionFreeze builds the guard enforcing maintenance windows, evaluated
+// against the factory's current profile and region.
+func actionFreeze(cfg *config.Config, factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *policy.Freeze {
+	windows := make([]policy.Window, 0, len(cfg.Policy.Freezes))
+	for _, f := range cfg.Policy.Freezes {
+		schedule, _ := policy.ParseSchedule(f.Schedule) // Validated when loading
+		var location *time.Location
+		if f.Timezone != "" {
+			location, _ = time.LoadLocation(f.Timezone)
+		}
+		windows = append(windows, policy.Window{
+			Name:         f.Name,
+			Schedule:     schedule,
+			Duration:     f.Duration,
+			Location:     location,
+			Environments: f.Environments,
+			Override:     f.Override,
+		})
+	}
+	return policy.NewFreeze(windows, policyEnvironments(cfg), awsContext(factory), dispatcher)
+}
+
+// policyEnvironments returns the configured environments, sorted by name.
+func policyEnvironments(cfg *config.Config) []policy.Environment {
+	environments := make([]policy.Environment, 0, len(cfg.Policy.Environments))
+	for name, env := range cfg.Policy.Environments {
+		environments = append(environments, policy.Environment{
+			Name:     name,
+			Profiles: env.Profiles,
+			Regions:  env.Regions,
+		})
+	}
+	slices.SortFunc(environments, func(a, b policy.Environment) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return environments
+}
+
+// awsContext reports the factory's current profile and region.
+func awsContext(factory *awsfactory.ClientFactory) func() (string, string) {
+	return func() (string, string) {
+		profile := factory.Profile()
+		if profile == "" {
+			profile = "default"
+		}
+		return profile, factory.Region()
+	}
+}
+
 // registerServices registers all enabled services.
 func registerServices(reg *registry.Registry, factory *awsfactory.ClientFactory, cfg *config.Config, dispatcher core.EventDispatcher) error {
+	regs, err := serviceRegistrations(factory, cfg, dispatcher)
+	if err != nil {
+		return err
+	}
+	for _, registration := range regs {
+		if err := reg.RegisterServiceAndView(registration); err != nil {
+			return fmt.Errorf("failed to register %s: %w", registration.Service.Name(), err)
+		}
+	}
+	return nil
+}
+
+// serviceRegistrations builds fresh services and view factories for all
+// enabled services, in display order. Each call returns new instances, so
+// nothing cached for one AWS context is reused for another.
+func serviceRegistrations(factory *awsfactory.ClientFactory, cfg *config.Config, dispatcher core.EventDispatcher) ([]core.ServiceRegistration, error) {
+	registrations, enabledServices := serviceRegistry(factory, cfg, dispatcher)
+
+	// Build enabled services
+	regs := make([]core.ServiceRegistration, 0, len(enabledServices))
+	for _, name := range enabledServices {
+		createFn, ok := registrations[name]
+		if !ok {
+			continue // Skip unknown services
+		}
+
+		registration, err := createFn()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s service: %w", name, err)
+		}
+		regs = append(regs, registration)
+	}
+
+	return regs, nil
+}
+
+// serviceRegistry returns the constructors of every known service by name,
+// sharing the settings and clients the services depend on, and the names of
+// the enabled ones.
+func serviceRegistry(factory *awsfactory.ClientFactory, cfg *config.Config, dispatcher core.EventDispatcher) (map[string]func() (core.ServiceRegistration, error), []string) {
 	// Determine enabled services
 	enabledServices := cfg.Services.Enabled
 	if len(enabledServices) == 0 {
-		enabledServices = []string{"ec2", "iam", "s3", "lambda"}
+		enabledServices = []string{"ec2", "iam", "s3", "lambda", "accessanalyzer"}
+	}
+
+	// Access Analyzer findings are shared with the IAM and S3 services for
+	// external access enrichment when the findings view is enabled.
+	var iamOpts []iam.Option
+	var s3Opts []s3.Option
+	findings := accessanalyzer.NewService(factory, dispatcher)
+	if slices.Contains(enabledServices, "accessanalyzer") {
+		iamOpts = append(iamOpts, iam.WithAccessFindings(findings))
+		s3Opts = append(s3Opts, s3.WithAccessFindings(findings))
 	}
 
+	// A single resolver paces CloudTrail lookups across services
+	ec2Opts := ec2Options(cfg)
+	var lambdaOpts []lambda.Option
+	if cfg.Services.Owners {
+		owners := ownership.New(factory)
+		ec2Opts = append(ec2Opts, ec2.WithOwners(owners))
+		iamOpts = append(iamOpts, iam.WithOwners(owners))
+		s3Opts = append(s3Opts, s3.WithOwners(owners))
+		lambdaOpts = append(lambdaOpts, lambda.WithOwners(owners))
+	}
+
+	// Batch deletions of every service are saved to be resumed
+	batches := batch.NewStore(batch.DefaultPath(config.StateDir()))
+
 	// Service registration map
 	registrations := map[string]func() (core.ServiceRegistration, error){
 		"ec2": func() (core.ServiceRegistration, error) {
 			return core.ServiceRegistration{
-				Service:     ec2.NewService(factory, dispatcher),
+				Service:     ec2.NewService(factory, dispatcher, ec2Opts...),
 				ViewFactory: ec2.NewViewFactory(),
 				Priority:    100,
 			}, nil
 		},
 		"iam": func() (core.ServiceRegistration, error) {
 			return core.ServiceRegistration{
-				Service:     iam.NewService(factory, dispatcher),
+				Service: iam.NewService(factory, dispatcher, append(iamOpts,
+					iam.WithUnusedThreshold(time.Duration(config.ServiceInt(cfg.Services.IAM, "unused_days", 0))*24*time.Hour),
+				)...),
 				ViewFactory: iam.NewViewFactory(),
 				Priority:    90,
 			}, nil
 		},
 		"s3": func() (core.ServiceRegistration, error) {
 			return core.ServiceRegistration{
-				Service:     s3.NewService(factory, dispatcher),
+				Service: s3.NewService(factory, dispatcher, append(s3Opts,
+					s3.WithBatchStore(batches),
+				)...),
 				ViewFactory: s3.NewViewFactory(),
 				Priority:    80,
 			}, nil
 		},
 		"lambda": func() (core.ServiceRegistration, error) {
 			return core.ServiceRegistration{
-				Service:     lambda.NewService(factory, dispatcher),
+				Service:     lambda.NewService(factory, dispatcher, lambdaOpts...),
 				ViewFactory: lambda.NewViewFactory(),
 				Priority:    70,
 			}, nil
 		},
+		"rds": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: rds.NewService(factory, dispatcher,
+					rds.WithIdleConnections(float64(config.ServiceInt(cfg.Services.RDS, "idle_connections", 0))),
+					rds.WithUnusedStoragePercent(float64(config.ServiceInt(cfg.Services.RDS, "unused_storage_percent", 0))),
+				),
+				ViewFactory: rds.NewViewFactory(),
+				Priority:    65,
+			}, nil
+		},
+		"accessanalyzer": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     findings,
+				ViewFactory: accessanalyzer.NewViewFactory(),
+				Priority:    60,
+			}, nil
+		},
+		"securityhub": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     securityhub.NewService(factory, dispatcher),
+				ViewFactory: securityhub.NewViewFactory(),
+				Priority:    59,
+			}, nil
+		},
+		"exposure": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     exposure.NewService(factory, dispatcher),
+				ViewFactory: exposure.NewViewFactory(),
+				Priority:    57,
+			}, nil
+		},
+		"eni": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     eni.NewService(factory, dispatcher),
+				ViewFactory: eni.NewViewFactory(),
+				Priority:    56,
+			}, nil
+		},
+		"eip": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     eip.NewService(factory, dispatcher),
+				ViewFactory: eip.NewViewFactory(),
+				Priority:    25,
+			}, nil
+		},
+		"topology": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     topology.NewService(factory, dispatcher),
+				ViewFactory: topology.NewViewFactory(),
+				Priority:    54,
+			}, nil
+		},
+		"vpc": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     vpc.NewService(factory, dispatcher),
+				ViewFactory: vpc.NewViewFactory(),
+				Priority:    41,
+			}, nil
+		},
+		"elb": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     elb.NewService(factory, dispatcher),
+				ViewFactory: elb.NewViewFactory(),
+				Priority:    39,
+			}, nil
+		},
+		"ebs": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: ebs.NewService(factory, dispatcher,
+					ebs.WithCleanupAge(config.ServiceInt(cfg.Services.EBS, "cleanup_days", 0)),
+				),
+				ViewFactory: ebs.NewViewFactory(),
+				Priority:    38,
+			}, nil
+		},
+		"ecr": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     ecr.NewService(factory, dispatcher),
+				ViewFactory: ecr.NewViewFactory(),
+				Priority:    36,
+			}, nil
+		},
+		"snapshots": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: snapshots.NewService(factory, dispatcher,
+					snapshots.WithCleanupAge(config.ServiceInt(cfg.Services.Snapshots, "cleanup_days", 0)),
+					snapshots.WithBatchStore(batches),
+				),
+				ViewFactory: snapshots.NewViewFactory(),
+				Priority:    37,
+			}, nil
+		},
+		"secretsmanager": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: secretsmanager.NewService(factory, dispatcher,
+					secretsmanager.WithUnusedAge(config.ServiceInt(cfg.Services.SecretsManager, "unused_days", 0)),
+				),
+				ViewFactory: secretsmanager.NewViewFactory(),
+				Priority:    35,
+			}, nil
+		},
+		"ssm": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     ssm.NewService(factory, dispatcher),
+				ViewFactory: ssm.NewViewFactory(),
+				Priority:    34,
+			}, nil
+		},
+		"kms": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     kms.NewService(factory, dispatcher),
+				ViewFactory: kms.NewViewFactory(),
+				Priority:    33,
+			}, nil
+		},
+		"securitygroups": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     securitygroups.NewService(factory, dispatcher),
+				ViewFactory: securitygroups.NewViewFactory(),
+				Priority:    40,
+			}, nil
+		},
+		"sqs": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     sqs.NewService(factory, dispatcher),
+				ViewFactory: sqs.NewViewFactory(),
+				Priority:    53,
+			}, nil
+		},
+		"sns": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     sns.NewService(factory, dispatcher),
+				ViewFactory: sns.NewViewFactory(),
+				Priority:    32,
+			}, nil
+		},
+		"ecs": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     ecs.NewService(factory, dispatcher),
+				ViewFactory: ecs.NewViewFactory(),
+				Priority:    52,
+			}, nil
+		},
+		"eks": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     eks.NewService(factory, dispatcher),
+				ViewFactory: eks.NewViewFactory(),
+				Priority:    44,
+			}, nil
+		},
+		"cloudformation": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     cloudformation.NewService(factory, dispatcher),
+				ViewFactory: cloudformation.NewViewFactory(),
+				Priority:    51,
+			}, nil
+		},
+		"cloudtrail": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     cloudtrail.NewService(factory, dispatcher),
+				ViewFactory: cloudtrail.NewViewFactory(),
+				Priority:    24,
+			}, nil
+		},
+		"scheduler": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     scheduler.NewService(factory, dispatcher),
+				ViewFactory: scheduler.NewViewFactory(),
+				Priority:    49,
+			}, nil
+		},
+		"autoscaling": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     autoscaling.NewService(factory, dispatcher),
+				ViewFactory: autoscaling.NewViewFactory(),
+				Priority:    26,
+			}, nil
+		},
+		"cloudwatchalarms": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     cloudwatchalarms.NewService(factory, dispatcher),
+				ViewFactory: cloudwatchalarms.NewViewFactory(),
+				Priority:    28,
+			}, nil
+		},
+		"elasticache": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     elasticache.NewService(factory, dispatcher),
+				ViewFactory: elasticache.NewViewFactory(),
+				Priority:    29,
+			}, nil
+		},
+		"apigateway": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     apigateway.NewService(factory, dispatcher),
+				ViewFactory: apigateway.NewViewFactory(),
+				Priority:    30,
+			}, nil
+		},
+		"eventbridge": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     eventbridge.NewService(factory, dispatcher),
+				ViewFactory: eventbridge.NewViewFactory(),
+				Priority:    31,
+			}, nil
+		},
+		"dynamodb": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: dynamodb.NewService(factory, dispatcher,
+					dynamodb.WithCapacityUtilization(float64(config.ServiceInt(cfg.Services.DynamoDB, "capacity_utilization_percent", 0))),
+				),
+				ViewFactory: dynamodb.NewViewFactory(),
+				Priority:    48,
+			}, nil
+		},
+		"cloudwatchlogs": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     cloudwatchlogs.NewService(factory, dispatcher),
+				ViewFactory: cloudwatchlogs.NewViewFactory(),
+				Priority:    47,
+			}, nil
+		},
+		"cost": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: cost.NewService(factory, dispatcher,
+					cost.WithMinIncrease(float64(config.ServiceInt(cfg.Services.Cost, "min_increase", 0))),
+				),
+				ViewFactory: cost.NewViewFactory(),
+				Priority:    27,
+			}, nil
+		},
+		"coverage": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: coverage.NewService(factory, dispatcher,
+					coverage.WithMinUncovered(float64(config.ServiceInt(cfg.Services.Coverage, "min_uncovered_monthly", 0))),
+				),
+				ViewFactory: coverage.NewViewFactory(),
+				Priority:    55,
+			}, nil
+		},
+		"nat": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: nat.NewService(factory, dispatcher,
+					nat.WithHotspotMonthly(float64(config.ServiceInt(cfg.Services.NAT, "hotspot_monthly", 0))),
+				),
+				ViewFactory: nat.NewViewFactory(),
+				Priority:    58,
+			}, nil
+		},
+		"expiry": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: expiry.NewService(factory, dispatcher,
+					expiry.WithWarnDays(config.ServiceInt(cfg.Services.Expiry, "warn_days", 0)),
+					expiry.WithCriticalDays(config.ServiceInt(cfg.Services.Expiry, "critical_days", 0)),
+					expiry.WithAccessKeyMaxAge(config.ServiceInt(cfg.Services.Expiry, "access_key_max_age_days", 0)),
+				),
+				ViewFactory: expiry.NewViewFactory(),
+				Priority:    45,
+			}, nil
+		},
+		"baseline": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     baseline.NewService(factory, dispatcher),
+				ViewFactory: baseline.NewViewFactory(),
+				Priority:    43,
+			}, nil
+		},
+		"iamcleanup": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service: iamcleanup.NewService(factory, dispatcher,
+					iamcleanup.WithUnusedThreshold(time.Duration(config.ServiceInt(cfg.Services.IAM, "unused_days", 0))*24*time.Hour),
+					iamcleanup.WithBatchStore(batches),
+				),
+				ViewFactory: iamcleanup.NewViewFactory(),
+				Priority:    42,
+			}, nil
+		},
+		"paramdiff": func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     paramdiff.NewService(factory, dispatcher, paramDiffOptions(cfg)...),
+				ViewFactory: paramdiff.NewViewFactory(),
+				Priority:    46,
+			}, nil
+		},
 	}
 
-	// Register enabled services
-	for _, name := range enabledServices {
-		createFn, ok := registrations[name]
-		if !ok {
-			continue // Skip unknown services
-		}
-
-		registration, err := createFn()
-		if err != nil {
-			return fmt.Errorf("failed to create %s service: %w", name, err)
+	// Approval requests are reviewed in their own view
+	if cfg.Approvals.Enabled {
+		registrations["approvals"] = func() (core.ServiceRegistration, error) {
+			return core.ServiceRegistration{
+				Service:     approvals.NewService(approvalStore(cfg, dispatcher), approvalOperator(cfg), dispatcher),
+				ViewFactory: approvals.NewViewFactory(),
+				Priority:    50,
+			}, nil
 		}
-
-		if err := reg.RegisterServiceAndView(registration); err != nil {
-			return fmt.Errorf("failed to register %s: %w", name, err)
+		if !slices.Contains(enabledServices, "approvals") {
+			enabledServices = append(slices.Clone(enabledServices), "approvals")
 		}
 	}
 
-	return nil
+	return registrations, enabledServices
 }
 
 // =============================================================================
@@ -314,9 +982,11 @@ func registerServices(reg *registry.Registry, factory *awsfactory.ClientFactory,
 // =============================================================================
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "table", "Output format (json|table)")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", string(core.FormatTable), "Output format (table|json|yaml|csv)")
+	rootCmd.PersistentFlags().StringSliceVar(&outputColumns, "columns", nil, "Columns to show in table and CSV output, in order")
 	rootCmd.PersistentFlags().StringVar(&awsProfile, "profile", "", "AWS profile to use")
 	rootCmd.PersistentFlags().StringVar(&awsRegion, "region", "", "AWS region")
+	rootCmd.PersistentFlags().StringVar(&awsEndpoint, "endpoint-url", "", "Endpoint of every AWS service, e.g. http://localhost:4566 for LocalStack")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Simulate actions without making changes")
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Config file path (optional)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging")
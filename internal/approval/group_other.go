@@ -0,0 +1,10 @@
+//go:build !unix
+
+package approval
+
+import "os"
+
+// fileGroup returns -1: files have no group owner on this platform.
+func fileGroup(os.FileInfo) int {
+	return -1
+}
@@ -0,0 +1,16 @@
+//go:build unix
+
+package approval
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileGroup returns the group ID owning a file, or -1 when it is unknown.
+func fileGroup(info os.FileInfo) int {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return int(stat.Gid)
+	}
+	return -1
+}
@@ -0,0 +1,92 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Guard is a core.ActionGuard that requires a second operator's approval
+// before guarded actions run.
+type Guard struct {
+	store    *Store
+	operator string
+	actions  map[string]bool // service:action; nil guards every dangerous action
+}
+
+// Ensure Guard implements core.ActionGuard and core.ExecutionObserver
+var (
+	_ core.ActionGuard       = (*Guard)(nil)
+	_ core.ExecutionObserver = (*Guard)(nil)
+)
+
+// NewGuard creates a guard requesting approvals on behalf of operator.
+// actions lists the guarded actions as service:action; when empty, every
+// action marked dangerous is guarded.
+func NewGuard(store *Store, operator string, actions []string) *Guard {
+	g := &Guard{store: store, operator: operator}
+	if len(actions) > 0 {
+		g.actions = make(map[string]bool, len(actions))
+		for _, a := range actions {
+			g.actions[a] = true
+		}
+	}
+	return g
+}
+
+// Requires reports whether an action needs approval.
+func (g *Guard) Requires(service string, action core.Action) bool {
+	if g.actions == nil {
+		return action.Dangerous
+	}
+	return g.actions[service+":"+action.Name]
+}
+
+// Check implements core.ActionGuard. It lets the action through when it was
+// approved with the same parameters, reserving the approval so that no
+// other run uses it meanwhile, and otherwise files a pending request. The
+// approval is used up by ActionExecuted and given back by ActionAborted,
+// so that an action the service asks to confirm first can be confirmed and
+// run again.
+func (g *Guard) Check(ctx context.Context, req core.ActionRequest) error {
+	if !g.Requires(req.Service, req.Action) {
+		return nil
+	}
+
+	reserved, err := g.store.Reserve(req.Service, req.Action.Name, req.ResourceID, g.operator, req.Params)
+	if err != nil {
+		return err
+	}
+	if reserved != nil {
+		return nil
+	}
+
+	pending, _, err := g.store.Request(ctx, req.Service, req.Action, req.ResourceID, g.operator, req.Params)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: request %s is pending; another operator must approve it in the Approvals view or with 'a9s approvals approve %s'",
+		core.ErrApprovalRequired, pending.ID, pending.ID)
+}
+
+// ActionExecuted implements core.ExecutionObserver. It uses up the approval
+// of an action that ran. The action already ran, so a failure to record it
+// cannot be reported; the approval then stays reserved until it expires,
+// which keeps it from being used again.
+func (g *Guard) ActionExecuted(ctx context.Context, req core.ActionRequest, _ *core.ActionResult) {
+	if !g.Requires(req.Service, req.Action) {
+		return
+	}
+	_, _ = g.store.Use(ctx, req.Service, req.Action.Name, req.ResourceID, g.operator, req.Params)
+}
+
+// ActionAborted implements core.ExecutionObserver. It gives back the
+// approval of an action that did not run, such as one the service asks to
+// confirm first, so that it can be run again.
+func (g *Guard) ActionAborted(_ context.Context, req core.ActionRequest, _ error) {
+	if !g.Requires(req.Service, req.Action) {
+		return
+	}
+	_ = g.store.Release(req.Service, req.Action.Name, req.ResourceID, g.operator, req.Params)
+}
@@ -0,0 +1,387 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// fakeExecutor asks to confirm terminations, as services do, and counts the
+// executions that ran.
+type fakeExecutor struct {
+	runs int
+}
+
+func (f *fakeExecutor) Name() string                                      { return "ec2" }
+func (f *fakeExecutor) Description() string                               { return "" }
+func (f *fakeExecutor) Icon() string                                      { return "" }
+func (f *fakeExecutor) Initialize(context.Context, *core.AWSConfig) error { return nil }
+func (f *fakeExecutor) Close() error                                      { return nil }
+func (f *fakeExecutor) HealthCheck(context.Context) error                 { return nil }
+
+func (f *fakeExecutor) Actions() []core.Action {
+	return []core.Action{
+		{Name: "terminate", Dangerous: true, Parameters: []core.ActionParameter{{Name: "password", Type: "string", Sensitive: true}}},
+		{Name: "describe"},
+	}
+}
+
+func (f *fakeExecutor) Execute(_ context.Context, action, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	if confirmed, _ := params[core.ParamConfirm].(bool); action == "terminate" && !confirmed {
+		return nil, &core.ConfirmationError{Request: core.ActionRequest{Service: "ec2", Action: core.Action{Name: action}, ResourceID: resourceID, Params: params}}
+	}
+	f.runs++
+	return &core.ActionResult{Success: true}, nil
+}
+
+// newTestGuard registers a guard acting for alice, from her OS account,
+// backed by a store in a temporary directory.
+func newTestGuard(t *testing.T) *Store {
+	t.Helper()
+	t.Cleanup(core.ResetActionGuards)
+	t.Cleanup(core.ResetActionExecutions)
+
+	store := NewStore(filepath.Join(t.TempDir(), "approvals.json"))
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	runAs(store, "alice")
+	core.RegisterActionGuard(NewGuard(store, "alice", nil))
+	return store
+}
+
+// runAs makes the store act from the OS account named account.
+func runAs(store *Store, account string) {
+	store.account = func() string { return account }
+}
+
+// pendingID runs an action, expecting it to be held for approval, and
+// returns the ID of the one pending request.
+func pendingID(t *testing.T, store *Store, exec core.ActionExecutor, params map[string]any) string {
+	t.Helper()
+	if _, err := core.ExecuteAction(context.Background(), exec, "terminate", "i-1", params); !errors.Is(err, core.ErrApprovalRequired) {
+		t.Fatalf("unapproved execution error = %v, want approval required", err)
+	}
+	requests, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range requests {
+		if r.Status == StatusPending {
+			return r.ID
+		}
+	}
+	t.Fatalf("List() = %+v, want a pending request", requests)
+	return ""
+}
+
+func TestGuardUsesApprovalOnceActionRan(t *testing.T) {
+	store := newTestGuard(t)
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+	params := map[string]any{"count": 2}
+
+	id := pendingID(t, store, exec, params)
+	if _, err := store.Decide(ctx, id, "alice", true, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Errorf("self approval error = %v, want ErrSelfApproval", err)
+	}
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, id, "bob", true, "ticket 42"); err != nil {
+		t.Fatalf("Decide() error = %v", err)
+	}
+
+	// The service asks to confirm first; the approval must survive that
+	var confirm *core.ConfirmationError
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", params); !errors.As(err, &confirm) {
+		t.Fatalf("approved execution error = %v, want a confirmation", err)
+	}
+	confirmed := map[string]any{"count": 2, core.ParamConfirm: true}
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", confirmed); err != nil {
+		t.Fatalf("confirmed execution error = %v", err)
+	}
+	if exec.runs != 1 {
+		t.Errorf("terminate ran %d times, want once", exec.runs)
+	}
+
+	requests, _ := store.List()
+	if len(requests) != 1 || requests[0].Status != StatusUsed || requests[0].UsedAt == nil {
+		t.Errorf("requests after use = %+v", requests)
+	}
+
+	// Unguarded actions run without approval
+	if _, err := core.ExecuteAction(ctx, exec, "describe", "i-1", nil); err != nil {
+		t.Errorf("describe error = %v", err)
+	}
+}
+
+func TestGuardRejectsReuse(t *testing.T) {
+	store := newTestGuard(t)
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+	params := map[string]any{core.ParamConfirm: true}
+
+	id := pendingID(t, store, exec, params)
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, id, "bob", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", params); err != nil {
+		t.Fatalf("approved execution error = %v", err)
+	}
+
+	core.ResetActionExecutions()
+	if second := pendingID(t, store, exec, params); second == id {
+		t.Errorf("used approval %s was requested again", id)
+	}
+	if exec.runs != 1 {
+		t.Errorf("terminate ran %d times, want once", exec.runs)
+	}
+}
+
+func TestGuardMatchesParams(t *testing.T) {
+	store := newTestGuard(t)
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+
+	id := pendingID(t, store, exec, map[string]any{"snapshot": true, core.ParamConfirm: true})
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, id, "bob", true, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// The approval does not cover the action run with other parameters
+	other := pendingID(t, store, exec, map[string]any{"snapshot": false, core.ParamConfirm: true})
+	if other == id {
+		t.Fatalf("changed parameters reused request %s", id)
+	}
+	if exec.runs != 0 {
+		t.Fatalf("terminate ran %d times with unapproved parameters", exec.runs)
+	}
+
+	// Confirmation answers are not part of what was approved
+	approved := map[string]any{"snapshot": true, core.ParamConfirm: true, core.ParamConfirmResource: "i-1"}
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", approved); err != nil {
+		t.Errorf("approved parameters error = %v", err)
+	}
+}
+
+func TestStoreExpiresRequests(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "approvals.json"), WithTTL(time.Hour))
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	req, created, err := store.Request(ctx, "ec2", core.Action{Name: "terminate"}, "i-1", "alice", nil)
+	if err != nil || !created {
+		t.Fatalf("Request() = %+v, %v, %v", req, created, err)
+	}
+	if again, created, _ := store.Request(ctx, "ec2", core.Action{Name: "terminate"}, "i-1", "alice", nil); created || again.ID != req.ID {
+		t.Errorf("second Request() created %v, ID %s, want %s", created, again.ID, req.ID)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := store.Decide(ctx, req.ID, "bob", true, ""); !errors.Is(err, ErrNotPending) {
+		t.Errorf("Decide() on an expired request error = %v, want ErrNotPending", err)
+	}
+	if _, err := store.Decide(ctx, "missing", "bob", true, ""); !errors.Is(err, ErrRequestNotFound) {
+		t.Errorf("Decide() on an unknown request error = %v, want ErrRequestNotFound", err)
+	}
+}
+
+// TestStoreRejectsRequesterAccount checks that a request filed from an OS
+// account cannot be approved from it under another operator name, as set
+// with $A9S_OPERATOR.
+func TestStoreRejectsRequesterAccount(t *testing.T) {
+	store := newTestGuard(t)
+	ctx := context.Background()
+
+	id := pendingID(t, store, &fakeExecutor{}, nil)
+	if _, err := store.Decide(ctx, id, "bob", true, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Errorf("Decide() as bob from alice's account error = %v, want ErrSelfApproval", err)
+	}
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, id, "bob", true, ""); err != nil {
+		t.Errorf("Decide() from bob's account error = %v", err)
+	}
+}
+
+// TestStoreRedactsSensitiveParams checks that the values of sensitive
+// parameters are not written to the shared file, yet still tell approved
+// executions from others.
+func TestStoreRedactsSensitiveParams(t *testing.T) {
+	store := newTestGuard(t)
+	ctx := context.Background()
+	exec := &fakeExecutor{}
+	params := map[string]any{"password": "hunter2", core.ParamConfirm: true}
+
+	id := pendingID(t, store, exec, params)
+	data, err := os.ReadFile(store.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Fatalf("approvals file holds the sensitive value:\n%s", data)
+	}
+	requests, _ := store.List()
+	if got := requests[0].Params["password"]; got != core.Redacted {
+		t.Errorf("recorded password = %v, want %q", got, core.Redacted)
+	}
+
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, id, "bob", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	other := map[string]any{"password": "hunter3", core.ParamConfirm: true}
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", other); !errors.Is(err, core.ErrApprovalRequired) {
+		t.Errorf("execution with another value error = %v, want approval required", err)
+	}
+	if _, err := core.ExecuteAction(ctx, exec, "terminate", "i-1", params); err != nil {
+		t.Errorf("approved execution error = %v", err)
+	}
+}
+
+// TestStoreSharedAcrossProcesses checks that stores sharing a file, as
+// a9s processes do, keep every request filed at the same time.
+func TestStoreSharedAcrossProcesses(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "approvals.json")
+	stores := []*Store{NewStore(path), NewStore(path)}
+	ctx := context.Background()
+
+	const perStore = 10
+	var wg sync.WaitGroup
+	for i, store := range stores {
+		for j := range perStore {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				id := fmt.Sprintf("i-%d-%d", i, j)
+				if _, _, err := store.Request(ctx, "ec2", core.Action{Name: "terminate"}, id, "alice", nil); err != nil {
+					t.Errorf("Request(%s) error = %v", id, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	requests, err := stores[0].List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := len(stores) * perStore; len(requests) != want {
+		t.Errorf("List() returned %d requests, want %d", len(requests), want)
+	}
+}
+
+// TestStoreSharedWithGroup checks that a store file shared by a group of
+// operators stays readable and writable by the group when another account
+// rewrites it, so that a second operator can decide requests.
+func TestStoreSharedWithGroup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shared", "approvals.json")
+	ctx := context.Background()
+
+	alice := NewStore(path, WithFileMode(0o660))
+	runAs(alice, "alice")
+	req, _, err := alice.Request(ctx, "ec2", core.Action{Name: "terminate"}, "i-1", "alice", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, _ := os.Stat(filepath.Dir(path))
+	file, _ := os.Stat(path)
+	if dir.Mode().Perm() != 0o770 || file.Mode().Perm() != 0o660 {
+		t.Fatalf("store created with directory %v and file %v, want 0770 and 0660", dir.Mode().Perm(), file.Mode().Perm())
+	}
+
+	// Bob's own store would create private files; he rewrites the shared one
+	bob := NewStore(path)
+	runAs(bob, "bob")
+	if _, err := bob.Decide(ctx, req.ID, "bob", true, ""); err != nil {
+		t.Fatalf("Decide() from bob's account error = %v", err)
+	}
+	decided, _ := os.Stat(path)
+	if decided.Mode().Perm() != 0o660 || fileGroup(decided) != fileGroup(file) {
+		t.Errorf("decided store mode %v, group %d; want 0660, group %d", decided.Mode().Perm(), fileGroup(decided), fileGroup(file))
+	}
+	if requests, err := alice.List(); err != nil || requests[0].Status != StatusApproved {
+		t.Errorf("List() = %+v, %v, want bob's approval", requests, err)
+	}
+
+	// A mode set on the file by an administrator is kept too
+	if err := os.Chmod(path, 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := bob.Request(ctx, "ec2", core.Action{Name: "terminate"}, "i-2", "bob", nil); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0o640 {
+		t.Errorf("rewritten store mode = %v, want 0640", info.Mode().Perm())
+	}
+}
+
+// TestGuardReservesApproval checks that runs sharing one approval, from
+// a9s processes sharing the store, cannot both pass, and that a run which
+// did not go through gives the approval back.
+func TestGuardReservesApproval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "approvals.json")
+	ctx := context.Background()
+	action := core.Action{Name: "terminate", Dangerous: true}
+	req := core.ActionRequest{Service: "ec2", Action: action, ResourceID: "i-1"}
+
+	store := NewStore(path)
+	runAs(store, "alice")
+	filed, _, err := store.Request(ctx, "ec2", action, "i-1", "alice", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runAs(store, "bob")
+	if _, err := store.Decide(ctx, filed.ID, "bob", true, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	const runs = 8
+	guards := make([]*Guard, runs)
+	passed := make([]bool, runs)
+	var wg sync.WaitGroup
+	for i := range guards {
+		guards[i] = NewGuard(NewStore(path), "alice", nil)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := guards[i].Check(ctx, req)
+			switch {
+			case err == nil:
+				passed[i] = true
+			case !errors.Is(err, ErrApprovalInUse):
+				t.Errorf("run %d: Check() error = %v, want ErrApprovalInUse", i, err)
+			}
+		}()
+	}
+	wg.Wait()
+	first := slices.Index(passed, true)
+	if first < 0 || slices.Index(passed[first+1:], true) >= 0 {
+		t.Fatalf("runs passed = %v, want exactly one", passed)
+	}
+
+	// The service asked to confirm: the approval goes back for the next run
+	guards[first].ActionAborted(ctx, req, core.ErrConfirmationRequired)
+	other := (first + 1) % runs
+	if err := guards[other].Check(ctx, req); err != nil {
+		t.Fatalf("Check() after the approval was released error = %v", err)
+	}
+	guards[other].ActionExecuted(ctx, req, &core.ActionResult{Success: true})
+
+	requests, _ := store.List()
+	if len(requests) != 1 || requests[0].Status != StatusUsed || requests[0].ReservedAt == nil {
+		t.Errorf("requests after use = %+v", requests)
+	}
+	if err := guards[first].Check(ctx, req); !errors.Is(err, core.ErrApprovalRequired) {
+		t.Errorf("Check() once used error = %v, want approval required", err)
+	}
+}
@@ -0,0 +1,585 @@
+// Package approval implements two-person approval for dangerous actions.
+//
+// When approvals are enabled, running a guarded action creates a pending
+// request instead of executing it. A second operator approves or rejects the
+// request from the Approvals view or with `a9s approvals`; once approved, the
+// requester runs the action again, with the same parameters. The run
+// reserves the approval, so that no other run can use it at the same time,
+// uses it up when the action succeeds and gives it back otherwise. Every
+// transition is dispatched as an event so the audit log records who
+// requested and who approved each action.
+//
+// Requests are kept in a JSON file that operators share, for example on a
+// bastion host. A new file is private to the OS account creating it unless
+// a group mode is configured; rewrites keep the mode and group the file
+// has, so that operators of a shared group can all decide requests. The
+// file holds the parameters of each request with
+// sensitive values redacted, and a hash of them to match the action run
+// once approved. Operators are told apart by the OS account they run as,
+// which names set in the environment or configuration cannot change.
+package approval
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// DefaultTTL is how long requests and approvals stay valid.
+const DefaultTTL = 24 * time.Hour
+
+// DefaultFileMode is the mode of a new store file: private to the OS
+// account creating it.
+const DefaultFileMode os.FileMode = 0o600
+
+// Status is the state of an approval request.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusReserved Status = "reserved" // Approved, held by a run in progress
+	StatusRejected Status = "rejected"
+	StatusUsed     Status = "used"
+	StatusExpired  Status = "expired"
+)
+
+var (
+	// ErrRequestNotFound is returned for unknown request IDs.
+	ErrRequestNotFound = errors.New("approval request not found")
+	// ErrNotPending is returned when deciding a request that was already decided.
+	ErrNotPending = errors.New("approval request is not pending")
+	// ErrSelfApproval is returned when the requester tries to decide their own request.
+	ErrSelfApproval = errors.New("requests must be decided by a different operator")
+	// ErrApprovalInUse is returned when the approval of an action is held
+	// by another run of it.
+	ErrApprovalInUse = errors.New("approval is in use by another run")
+)
+
+// Request is a pending, decided or used approval request.
+type Request struct {
+	ID          string         `json:"id"`
+	Service     string         `json:"service"`
+	Action      string         `json:"action"`
+	ResourceID  string         `json:"resource_id"`
+	Params      map[string]any `json:"params,omitempty"` // Sensitive values redacted
+	ParamsHash  string         `json:"params_hash"`
+	Requester   string         `json:"requester"`
+	Account     string         `json:"account,omitempty"` // OS account of the requester
+	RequestedAt time.Time      `json:"requested_at"`
+	Status      Status         `json:"status"`
+	Approver    string         `json:"approver,omitempty"`
+	DecidedAt   *time.Time     `json:"decided_at,omitempty"`
+	Reason      string         `json:"reason,omitempty"`
+	ReservedAt  *time.Time     `json:"reserved_at,omitempty"`
+	UsedAt      *time.Time     `json:"used_at,omitempty"`
+}
+
+// matches reports whether the request is for the given action, run with
+// the given parameters.
+func (r *Request) matches(service, action, resourceID, requester string, params map[string]any) bool {
+	return r.Service == service && r.Action == action && r.ResourceID == resourceID && r.Requester == requester &&
+		r.ParamsHash != "" && r.ParamsHash == hashParams(params)
+}
+
+// =============================================================================
+// Store
+// =============================================================================
+
+// Store persists approval requests in a JSON file. Changes hold a lock
+// file next to it, so that a9s processes sharing the file do not lose each
+// other's updates.
+type Store struct {
+	mu         sync.Mutex
+	path       string
+	mode       os.FileMode
+	ttl        time.Duration
+	dispatcher core.EventDispatcher
+	now        func() time.Time
+	account    func() string
+}
+
+// Option configures a Store.
+type Option func(*Store)
+
+// WithTTL sets how long requests and approvals stay valid.
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Store) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+// WithFileMode sets the mode of a new store file, its lock file and its
+// directory, such as 0o660 for operators sharing a group. The directory
+// also gets execute permission wherever the file is readable. An existing
+// file keeps its own mode.
+func WithFileMode(mode os.FileMode) Option {
+	return func(s *Store) {
+		if mode.Perm() != 0 {
+			s.mode = mode.Perm()
+		}
+	}
+}
+
+// WithDispatcher sets the dispatcher receiving approval events.
+func WithDispatcher(dispatcher core.EventDispatcher) Option {
+	return func(s *Store) {
+		s.dispatcher = dispatcher
+	}
+}
+
+// NewStore creates a store backed by the file at path.
+func NewStore(path string, opts ...Option) *Store {
+	s := &Store{
+		path:    path,
+		mode:    DefaultFileMode,
+		ttl:     DefaultTTL,
+		now:     time.Now,
+		account: osAccount,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// DefaultPath returns the default store location inside stateDir.
+func DefaultPath(stateDir string) string {
+	return filepath.Join(stateDir, "approvals.json")
+}
+
+// Path returns the file backing the store.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns all requests, newest first. Requests whose TTL has passed
+// are reported as expired.
+func (s *Store) List() ([]Request, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].RequestedAt.After(requests[j].RequestedAt)
+	})
+	return requests, nil
+}
+
+// Request returns the pending request for an action, creating one if none
+// exists. created reports whether a new request was made. The values of the
+// action's sensitive parameters are not recorded.
+func (s *Store) Request(ctx context.Context, service string, action core.Action, resourceID, requester string, params map[string]any) (req Request, created bool, err error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return Request{}, false, err
+	}
+	defer unlock()
+
+	requests, err := s.load()
+	if err != nil {
+		return Request{}, false, err
+	}
+	for _, r := range requests {
+		if r.Status == StatusPending && r.matches(service, action.Name, resourceID, requester, params) {
+			return r, false, nil
+		}
+	}
+
+	id, err := newID()
+	if err != nil {
+		return Request{}, false, err
+	}
+	req = Request{
+		ID:          id,
+		Service:     service,
+		Action:      action.Name,
+		ResourceID:  resourceID,
+		Params:      core.RedactParams(withoutConfirmations(params), action.Parameters),
+		ParamsHash:  hashParams(params),
+		Requester:   requester,
+		Account:     s.account(),
+		RequestedAt: s.now(),
+		Status:      StatusPending,
+	}
+	if err := s.save(append(requests, req)); err != nil {
+		return Request{}, false, err
+	}
+
+	s.dispatch(ctx, core.EventApprovalRequested, req)
+	return req, true, nil
+}
+
+// Decide approves or rejects a pending request on behalf of approver. The
+// request cannot be decided under the requester's name, nor from the OS
+// account it was filed from.
+func (s *Store) Decide(ctx context.Context, id, approver string, approve bool, reason string) (Request, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return Request{}, err
+	}
+	defer unlock()
+
+	requests, err := s.load()
+	if err != nil {
+		return Request{}, err
+	}
+
+	for i := range requests {
+		r := &requests[i]
+		if r.ID != id {
+			continue
+		}
+		if r.Status != StatusPending {
+			return *r, fmt.Errorf("%w: %s is %s", ErrNotPending, id, r.Status)
+		}
+		if r.Requester == approver || (r.Account != "" && r.Account == s.account()) {
+			return *r, ErrSelfApproval
+		}
+
+		now := s.now()
+		r.Approver = approver
+		r.DecidedAt = &now
+		r.Reason = reason
+		r.Status = StatusRejected
+		eventType := core.EventApprovalRejected
+		if approve {
+			r.Status = StatusApproved
+			eventType = core.EventApprovalGranted
+		}
+		if err := s.save(requests); err != nil {
+			return Request{}, err
+		}
+
+		s.dispatch(ctx, eventType, *r)
+		return *r, nil
+	}
+	return Request{}, fmt.Errorf("%w: %s", ErrRequestNotFound, id)
+}
+
+// Reserve holds the approval for an action run with params, if there is
+// one, until the run uses it up or releases it. It returns
+// ErrApprovalInUse when the only approval is held by another run. A
+// reservation left by a run that died lapses with the approval.
+func (s *Store) Reserve(service, action, resourceID, requester string, params map[string]any) (*Request, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	requests, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var held *Request
+	for i := range requests {
+		r := &requests[i]
+		if !r.matches(service, action, resourceID, requester, params) {
+			continue
+		}
+		switch r.Status {
+		case StatusReserved:
+			held = r
+		case StatusApproved:
+			now := s.now()
+			r.Status = StatusReserved
+			r.ReservedAt = &now
+			if err := s.save(requests); err != nil {
+				return nil, err
+			}
+			reserved := *r
+			return &reserved, nil
+		}
+	}
+	if held != nil {
+		return nil, fmt.Errorf("%w: request %s was reserved at %s", ErrApprovalInUse, held.ID, held.ReservedAt.Local().Format("15:04:05"))
+	}
+	return nil, nil
+}
+
+// Release gives back the approval reserved for an action run with params
+// that did not run, so that it can be run again.
+func (s *Store) Release(service, action, resourceID, requester string, params map[string]any) error {
+	_, err := s.update(service, action, resourceID, requester, params, func(r *Request) {
+		r.Status = StatusApproved
+		r.ReservedAt = nil
+	})
+	return err
+}
+
+// Use consumes the approval reserved for an action run with params, if
+// there is one. Each approval authorizes a single execution.
+func (s *Store) Use(ctx context.Context, service, action, resourceID, requester string, params map[string]any) (*Request, error) {
+	used, err := s.update(service, action, resourceID, requester, params, func(r *Request) {
+		now := s.now()
+		r.Status = StatusUsed
+		r.UsedAt = &now
+	})
+	if used != nil {
+		s.dispatch(ctx, core.EventApprovalUsed, *used)
+	}
+	return used, err
+}
+
+// update applies change to the approval reserved for an action run with
+// params and saves it. It returns the changed request, or nil when there
+// is no reservation.
+func (s *Store) update(service, action, resourceID, requester string, params map[string]any, change func(*Request)) (*Request, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	requests, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for i := range requests {
+		r := &requests[i]
+		if r.Status != StatusReserved || !r.matches(service, action, resourceID, requester, params) {
+			continue
+		}
+		change(r)
+		if err := s.save(requests); err != nil {
+			return nil, err
+		}
+		changed := *r
+		return &changed, nil
+	}
+	return nil, nil
+}
+
+// Lock file settings: how often a held lock is retried, how long to wait
+// for it, and when a lock left by a process that died is taken over.
+const (
+	lockRetry   = 10 * time.Millisecond
+	lockTimeout = 5 * time.Second
+	lockStale   = 30 * time.Second
+)
+
+// lock holds the store for a read-modify-write, within this process and
+// across processes sharing the file. It returns the function releasing it.
+func (s *Store) lock() (func(), error) {
+	s.mu.Lock()
+	if err := s.makeDir(); err != nil {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("failed to create approvals directory: %w", err)
+	}
+
+	path := s.path + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, s.mode)
+		if err == nil {
+			_ = f.Close()
+			return func() {
+				_ = os.Remove(path)
+				s.mu.Unlock()
+			}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			s.mu.Unlock()
+			return nil, fmt.Errorf("failed to lock approvals: %w", err)
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > lockStale {
+			_ = os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			s.mu.Unlock()
+			return nil, fmt.Errorf("failed to lock approvals: %s is held by another a9s", path)
+		}
+		time.Sleep(lockRetry)
+	}
+}
+
+// makeDir creates the store directory when missing, with the store's mode
+// plus execute permission wherever it is readable. The umask does not
+// apply, so that a group mode takes effect.
+func (s *Store) makeDir() error {
+	dir := filepath.Dir(s.path)
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	}
+	mode := s.mode | (s.mode&0o444)>>2
+	if err := os.MkdirAll(dir, mode); err != nil {
+		return err
+	}
+	return os.Chmod(dir, mode)
+}
+
+// load reads all requests, marking those past their TTL as expired.
+func (s *Store) load() ([]Request, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read approvals: %w", err)
+	}
+
+	var requests []Request
+	if err := json.Unmarshal(data, &requests); err != nil {
+		return nil, fmt.Errorf("failed to parse approvals %s: %w", s.path, err)
+	}
+
+	cutoff := s.now().Add(-s.ttl)
+	for i := range requests {
+		r := &requests[i]
+		switch {
+		case r.Status == StatusPending && r.RequestedAt.Before(cutoff):
+			r.Status = StatusExpired
+		case (r.Status == StatusApproved || r.Status == StatusReserved) && r.DecidedAt != nil && r.DecidedAt.Before(cutoff):
+			r.Status = StatusExpired
+		}
+	}
+	return requests, nil
+}
+
+// save writes all requests atomically. The new file keeps the mode and
+// group of the one it replaces, since another operator's account may have
+// written it; a new file gets the store's mode. The caller holds the lock.
+func (s *Store) save(requests []Request) error {
+	data, err := json.MarshalIndent(requests, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode approvals: %w", err)
+	}
+
+	mode, group := s.mode, -1
+	if info, err := os.Stat(s.path); err == nil {
+		mode, group = info.Mode().Perm(), fileGroup(info)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, mode); err != nil {
+		return fmt.Errorf("failed to write approvals: %w", err)
+	}
+	// The umask applied when the file was created
+	if err := os.Chmod(tmp, mode); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write approvals: %w", err)
+	}
+	if group >= 0 {
+		if err := os.Chown(tmp, -1, group); err != nil {
+			_ = os.Remove(tmp)
+			return fmt.Errorf("failed to keep the group of approvals (is %s a member?): %w", osAccount(), err)
+		}
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write approvals: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) dispatch(ctx context.Context, eventType core.EventType, r Request) {
+	if s.dispatcher == nil {
+		return
+	}
+	params := map[string]any{
+		"request_id": r.ID,
+		"requester":  r.Requester,
+	}
+	if r.Approver != "" {
+		params["approver"] = r.Approver
+	}
+	if r.Reason != "" {
+		params["reason"] = r.Reason
+	}
+	_ = s.dispatcher.Dispatch(ctx, core.NewEvent(eventType, r.Service, core.ActionEventData{
+		Action:     r.Action,
+		ResourceID: r.ResourceID,
+		Params:     params,
+	}))
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// CurrentOperator names the person running a9s in requests and the audit
+// log: $A9S_OPERATOR, falling back to the OS user name. The name does not
+// decide who may approve a request; the OS account does.
+func CurrentOperator() string {
+	if name := os.Getenv("A9S_OPERATOR"); name != "" {
+		return name
+	}
+	return osAccount()
+}
+
+// osAccount returns the OS user name a9s runs as.
+func osAccount() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "unknown"
+}
+
+func newID() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate request ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// dialogParams are the parameters describing the requester's dialog rather
+// than the change being approved.
+var dialogParams = map[string]bool{
+	core.ParamConfirm:         true,
+	core.ParamConfirmResource: true,
+	core.ParamConfirmManaged:  true,
+	core.ParamConfirmPolicy:   true,
+	core.ParamOverrideReason:  true,
+	core.ParamIdempotencyKey:  true,
+}
+
+// withoutConfirmations drops confirmation flags and other dialog parameters
+// from the recorded parameters.
+func withoutConfirmations(params map[string]any) map[string]any {
+	if len(params) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(params))
+	for k, v := range params {
+		if !dialogParams[k] {
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// hashParams returns the hash matching an approval to the action it
+// approves: that of the parameters once encoded, without dialog
+// parameters, or "" when they cannot be encoded. Encoding sorts keys, so
+// the hash does not depend on map order.
+func hashParams(params map[string]any) string {
+	data, err := json.Marshal(withoutConfirmations(params))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
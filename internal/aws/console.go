@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Console Links
+// =============================================================================
+
+// ConsoleURL returns the AWS console page of a resource. The region of its
+// ARN wins over region, which is used for resources listed without one.
+// Types without a page of their own link to the console's ARN resolver, and
+// resources with neither a known type nor an ARN fail.
+func ConsoleURL(r core.Resource, region string) (string, error) {
+	arn, arnErr := core.ParseARN(r.ARN)
+	if arnErr != nil && core.IsARN(r.ID) {
+		arn, arnErr = core.ParseARN(r.ID)
+	}
+	partition := "aws"
+	if arnErr == nil {
+		partition = arn.Partition
+		if arn.Region != "" {
+			region = arn.Region
+		}
+	}
+
+	host := consoleHost(partition, region)
+	page := func(service, fragment string) string {
+		u := host + "/" + service + "/home"
+		if region != "" {
+			u += "?region=" + region
+		}
+		return u + "#" + fragment
+	}
+
+	name := r.Name
+	if name == "" {
+		name = r.ID
+	}
+
+	switch r.Type {
+	case "ec2:instance":
+		return page("ec2", "InstanceDetails:instanceId="+r.ID), nil
+	case "ec2:volume":
+		return page("ec2", "VolumeDetails:volumeId="+r.ID), nil
+	case "ec2:snapshot":
+		return page("ec2", "SnapshotDetails:snapshotId="+r.ID), nil
+	case "ec2:image":
+		return page("ec2", "ImageDetails:imageId="+r.ID), nil
+	case "ec2:securitygroup":
+		return page("ec2", "SecurityGroup:groupId="+r.ID), nil
+	case "ec2:network-interface":
+		return page("ec2", "NetworkInterface:networkInterfaceId="+r.ID), nil
+	case "ec2:natgateway":
+		return page("vpcconsole", "NatGatewayDetails:natGatewayId="+r.ID), nil
+	case "ec2:vpc":
+		return page("vpcconsole", "VpcDetails:VpcId="+r.ID), nil
+	case "elb:loadbalancer", "elbv2:loadbalancer":
+		if arnErr == nil {
+			return page("ec2", "LoadBalancer:loadBalancerArn="+arn.String()), nil
+		}
+		return page("ec2", "LoadBalancers:search="+url.QueryEscape(name)), nil
+	case "s3:bucket":
+		return host + "/s3/buckets/" + url.PathEscape(name), nil
+	case "iam:role", "iam:user", "iam:group":
+		kind := strings.TrimPrefix(r.Type, "iam:") + "s"
+		return consoleHost(partition, "") + "/iam/home#/" + kind + "/details/" + url.PathEscape(name), nil
+	case "iam:policy":
+		if arnErr == nil {
+			return consoleHost(partition, "") + "/iam/home#/policies/details/" + url.QueryEscape(arn.String()), nil
+		}
+	case "lambda:function":
+		return page("lambda", "/functions/"+url.PathEscape(name)), nil
+	case "dynamodb:table":
+		return page("dynamodbv2", "table?name="+url.QueryEscape(name)), nil
+	case "rds:db":
+		return page("rds", "database:id="+r.ID+";is-cluster=false"), nil
+	case "rds:cluster":
+		if arnErr == nil {
+			return page("rds", "database:id="+arn.ResourceID()+";is-cluster=true"), nil
+		}
+	case "sqs:queue":
+		return page("sqs/v3", "/queues/"+url.QueryEscape(r.ID)), nil
+	case "sns:topic":
+		if arnErr == nil {
+			return page("sns/v3", "/topic/"+arn.String()), nil
+		}
+	case "cloudformation:stack":
+		if arnErr == nil {
+			return page("cloudformation", "/stacks/stackinfo?stackId="+url.QueryEscape(arn.String())), nil
+		}
+		return page("cloudformation", "/stacks?filteringText="+url.QueryEscape(name)), nil
+	case "logs:log-group":
+		// The log groups page escapes its path a second time, with $ for %
+		escaped := strings.ReplaceAll(url.QueryEscape(r.ID), "%", "$25")
+		return page("cloudwatch", "logsV2:log-groups/log-group/"+escaped), nil
+	case "ecs:cluster":
+		return host + "/ecs/v2/clusters/" + url.PathEscape(name) + "?region=" + region, nil
+	case "ecs:service":
+		// Service ARNs name their cluster: service/cluster/name
+		if parts := strings.Split(arn.ResourceID(), "/"); arnErr == nil && len(parts) == 2 {
+			return host + "/ecs/v2/clusters/" + url.PathEscape(parts[0]) + "/services/" + url.PathEscape(parts[1]) + "?region=" + region, nil
+		}
+	case "eks:cluster":
+		return page("eks", "/clusters/"+url.PathEscape(name)), nil
+	case "ecr:repository":
+		if arnErr == nil {
+			return host + "/ecr/repositories/private/" + arn.AccountID + "/" + name + "?region=" + region, nil
+		}
+	case "kms:key":
+		return page("kms", "/kms/keys/"+r.ID), nil
+	case "secretsmanager:secret":
+		return host + "/secretsmanager/secret?name=" + url.QueryEscape(name) + "&region=" + region, nil
+	case "ssm:parameter":
+		return host + "/systems-manager/parameters/" + strings.TrimPrefix(name, "/") + "/description?region=" + region, nil
+	}
+
+	if arnErr == nil {
+		return consoleHost(partition, "") + "/go/view?arn=" + url.QueryEscape(arn.String()), nil
+	}
+	return "", core.NewValidationError("resource", r.Type, fmt.Sprintf("%s has no console page and no ARN", name))
+}
+
+// consoleHost returns the console address of a partition, in region when
+// one is given.
+func consoleHost(partition, region string) string {
+	switch partition {
+	case "aws-cn":
+		return "https://console.amazonaws.cn"
+	case "aws-us-gov":
+		return "https://console.amazonaws-us-gov.com"
+	}
+	if region == "" {
+		return "https://console.aws.amazon.com"
+	}
+	return "https://" + region + ".console.aws.amazon.com"
+}
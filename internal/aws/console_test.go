@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+func TestConsoleURL(t *testing.T) {
+	tests := []struct {
+		name string
+		r    core.Resource
+		want string
+	}{
+		{
+			name: "instance in the current region",
+			r:    core.Resource{ID: "i-0abc", Type: "ec2:instance"},
+			want: "https://eu-west-1.console.aws.amazon.com/ec2/home?region=eu-west-1#InstanceDetails:instanceId=i-0abc",
+		},
+		{
+			name: "region of the ARN",
+			r:    core.Resource{Name: "resize", Type: "lambda:function", ARN: "arn:aws:lambda:us-east-2:123456789012:function:resize"},
+			want: "https://us-east-2.console.aws.amazon.com/lambda/home?region=us-east-2#/functions/resize",
+		},
+		{
+			name: "global IAM role",
+			r:    core.Resource{ID: "AROAEXAMPLE", Name: "deploy", Type: "iam:role", ARN: "arn:aws:iam::123456789012:role/deploy"},
+			want: "https://console.aws.amazon.com/iam/home#/roles/details/deploy",
+		},
+		{
+			name: "ECS service in its cluster",
+			r:    core.Resource{ID: "arn:aws:ecs:eu-west-1:123456789012:service/prod/web", Type: "ecs:service"},
+			want: "https://eu-west-1.console.aws.amazon.com/ecs/v2/clusters/prod/services/web?region=eu-west-1",
+		},
+		{
+			name: "unknown type resolved from the ARN",
+			r:    core.Resource{Type: "scheduler:schedule", ARN: "arn:aws:scheduler:eu-west-1:123456789012:schedule/default/nightly"},
+			want: "https://console.aws.amazon.com/go/view?arn=arn%3Aaws%3Ascheduler%3Aeu-west-1%3A123456789012%3Aschedule%2Fdefault%2Fnightly",
+		},
+		{
+			name: "China partition",
+			r:    core.Resource{Name: "logs", Type: "s3:bucket", ARN: "arn:aws-cn:s3:::logs"},
+			want: "https://console.amazonaws.cn/s3/buckets/logs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConsoleURL(tt.r, "eu-west-1")
+			if err != nil {
+				t.Fatalf("ConsoleURL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ConsoleURL() = %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ConsoleURL(core.Resource{ID: "x", Type: "paramdiff:key"}, "eu-west-1"); err == nil {
+		t.Error("ConsoleURL() without a page or ARN succeeded")
+	}
+}
@@ -9,27 +9,35 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	"github.com/keanuharrell/a9s/internal/core"
 )
 
 // ClientFactory creates AWS service clients with shared configuration.
 type ClientFactory struct {
-	mu      sync.RWMutex
-	cfg     aws.Config
-	profile string
-	region  string
-	loaded  bool
+	mu       sync.RWMutex
+	cfg      aws.Config
+	profile  string
+	region   string
+	endpoint string
+	loaded   bool
 }
 
 // NewClientFactory creates a new AWS client factory.
 func NewClientFactory(awsCfg *core.AWSConfig) (*ClientFactory, error) {
 	factory := &ClientFactory{
-		profile: awsCfg.Profile,
-		region:  awsCfg.Region,
+		profile:  awsCfg.Profile,
+		region:   awsCfg.Region,
+		endpoint: awsCfg.Endpoint,
 	}
 
 	if err := factory.loadConfig(context.Background()); err != nil {
@@ -62,6 +70,9 @@ func (f *ClientFactory) loadConfig(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("%w: %v", core.ErrAWSConfigFailed, err)
 	}
+	if f.endpoint != "" {
+		cfg.BaseEndpoint = aws.String(f.endpoint)
+	}
 
 	f.cfg = cfg
 	f.loaded = true
@@ -83,6 +94,14 @@ func (f *ClientFactory) Region() string {
 	return f.region
 }
 
+// Endpoint returns the endpoint overriding every service's, if any. The
+// SDK also reads AWS_ENDPOINT_URL, which this does not reflect.
+func (f *ClientFactory) Endpoint() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.endpoint
+}
+
 // Profile returns the configured profile.
 func (f *ClientFactory) Profile() string {
 	f.mu.RLock()
@@ -110,6 +129,34 @@ func (f *ClientFactory) UpdateConfig(ctx context.Context, profile, region string
 	return f.loadConfig(ctx)
 }
 
+// ProfileConfig loads the AWS configuration of another profile in the
+// configured region, for views comparing accounts. The factory's own
+// profile is returned as is.
+func (f *ClientFactory) ProfileConfig(ctx context.Context, profile string) (aws.Config, error) {
+	f.mu.RLock()
+	cfg, current, region, endpoint := f.cfg, f.profile, f.region, f.endpoint
+	f.mu.RUnlock()
+
+	if profile == "" || profile == current {
+		return cfg, nil
+	}
+
+	opts := []func(*config.LoadOptions) error{config.WithSharedConfigProfile(profile)}
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	} else if cfg.Region != "" {
+		opts = append(opts, config.WithRegion(cfg.Region))
+	}
+	other, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("%w: profile %s: %v", core.ErrAWSConfigFailed, profile, err)
+	}
+	if endpoint != "" {
+		other.BaseEndpoint = aws.String(endpoint)
+	}
+	return other, nil
+}
+
 // =============================================================================
 // Service Client Factories
 // =============================================================================
@@ -128,11 +175,59 @@ func (f *ClientFactory) IAMClient() *iam.Client {
 	return iam.NewFromConfig(f.cfg)
 }
 
-// S3Client creates an S3 client.
+// S3Client creates an S3 client. Buckets are addressed by path under an
+// endpoint override, since emulators rarely resolve bucket subdomains.
 func (f *ClientFactory) S3Client() *s3.Client {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	return s3.NewFromConfig(f.cfg)
+	return s3.NewFromConfig(f.cfg, func(o *s3.Options) {
+		o.UsePathStyle = f.endpoint != ""
+	})
+}
+
+// AccessAnalyzerClient creates an IAM Access Analyzer client.
+func (f *ClientFactory) AccessAnalyzerClient() *accessanalyzer.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return accessanalyzer.NewFromConfig(f.cfg)
+}
+
+// CloudWatchClient creates a CloudWatch client.
+func (f *ClientFactory) CloudWatchClient() *cloudwatch.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return cloudwatch.NewFromConfig(f.cfg)
+}
+
+// ComputeOptimizerClient creates a Compute Optimizer client.
+func (f *ClientFactory) ComputeOptimizerClient() *computeoptimizer.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return computeoptimizer.NewFromConfig(f.cfg)
+}
+
+// STSClient creates an STS client.
+func (f *ClientFactory) STSClient() *sts.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return sts.NewFromConfig(f.cfg)
+}
+
+// CloudTrailClient creates a CloudTrail client.
+func (f *ClientFactory) CloudTrailClient() *cloudtrail.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return cloudtrail.NewFromConfig(f.cfg)
+}
+
+// CostExplorerClient creates a Cost Explorer client. Cost Explorer is only
+// served from us-east-1, whatever the selected region.
+func (f *ClientFactory) CostExplorerClient() *costexplorer.Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return costexplorer.NewFromConfig(f.cfg, func(o *costexplorer.Options) {
+		o.Region = "us-east-1"
+	})
 }
 
 // =============================================================================
@@ -146,6 +241,13 @@ const (
 	ClientTypeEC2 ClientType = "ec2"
 	ClientTypeIAM ClientType = "iam"
 	ClientTypeS3  ClientType = "s3"
+
+	ClientTypeAccessAnalyzer   ClientType = "accessanalyzer"
+	ClientTypeCloudWatch       ClientType = "cloudwatch"
+	ClientTypeComputeOptimizer ClientType = "computeoptimizer"
+	ClientTypeSTS              ClientType = "sts"
+	ClientTypeCloudTrail       ClientType = "cloudtrail"
+	ClientTypeCostExplorer     ClientType = "costexplorer"
 )
 
 // Client returns an AWS client of the specified type.
@@ -157,6 +259,18 @@ func (f *ClientFactory) Client(clientType ClientType) (any, error) {
 		return f.IAMClient(), nil
 	case ClientTypeS3:
 		return f.S3Client(), nil
+	case ClientTypeAccessAnalyzer:
+		return f.AccessAnalyzerClient(), nil
+	case ClientTypeCloudWatch:
+		return f.CloudWatchClient(), nil
+	case ClientTypeComputeOptimizer:
+		return f.ComputeOptimizerClient(), nil
+	case ClientTypeSTS:
+		return f.STSClient(), nil
+	case ClientTypeCloudTrail:
+		return f.CloudTrailClient(), nil
+	case ClientTypeCostExplorer:
+		return f.CostExplorerClient(), nil
 	default:
 		return nil, fmt.Errorf("unknown client type: %s", clientType)
 	}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+// Throttled reports whether err is AWS throttling requests, either directly
+// or because the client ran out of retries doing so. api is the service the
+// throttled call went to, such as "CloudWatch" or "IAM", since each has its
+// own request quotas; it is empty when the error does not say.
+func Throttled(err error) (api string, ok bool) {
+	if err == nil {
+		return "", false
+	}
+	var quota ratelimit.QuotaExceededError
+	throttled := errors.As(err, &quota) ||
+		retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+	if !throttled {
+		return "", false
+	}
+
+	var op *smithy.OperationError
+	if errors.As(err, &op) {
+		api = op.ServiceID
+	}
+	return api, true
+}
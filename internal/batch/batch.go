@@ -0,0 +1,298 @@
+// Package batch runs bulk actions, such as deleting marked snapshots or IAM
+// policies, one item at a time.
+//
+// A batch retries items failing for transient reasons (throttling, server
+// errors, dropped connections) with growing pauses, and slows down while
+// AWS throttles it, since bulk deletions quickly run into API rate limits.
+// Its state is saved after every item, so a batch interrupted by closing
+// a9s or stopping it can be resumed where it stopped. When it ends, each
+// item records whether it went through and why not.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+)
+
+const (
+	// DefaultMaxAttempts is how many times an item failing for transient
+	// reasons is tried.
+	DefaultMaxAttempts = 4
+
+	// minPause is the first pause after a transient failure.
+	minPause = time.Second
+	// maxPause caps the pause between two attempts.
+	maxPause = 30 * time.Second
+)
+
+// Status is where an item of a batch stands.
+type Status string
+
+// Item statuses.
+const (
+	StatusPending Status = "pending"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// Item is one resource a batch acts on.
+type Item struct {
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+	Status   Status `json:"status"`
+	Attempts int    `json:"attempts,omitempty"`
+	Error    string `json:"error,omitempty"`  // Why the last attempt failed
+	Detail   string `json:"detail,omitempty"` // What else the action did
+}
+
+// Job is a batch action and the state of each of its items.
+type Job struct {
+	Scope   string         `json:"scope"` // See Scope
+	Action  string         `json:"action"`
+	Params  map[string]any `json:"params,omitempty"` // Action parameters applying to every item
+	Items   []Item         `json:"items"`
+	Started time.Time      `json:"started"`
+}
+
+// Scope names where a batch runs: its service, AWS profile and region, so
+// that a batch is never resumed against another account. A scope has at
+// most one unfinished batch.
+func Scope(service, profile, region string) string {
+	return service + "@" + profile + "/" + region
+}
+
+// NewJob creates a job running action in scope on items, all pending.
+func NewJob(scope, action string, items []Item, params map[string]any) *Job {
+	for i := range items {
+		items[i].Status = StatusPending
+	}
+	return &Job{
+		Scope:   scope,
+		Action:  action,
+		Params:  params,
+		Items:   items,
+		Started: time.Now(),
+	}
+}
+
+// Count returns how many items have status.
+func (j *Job) Count(status Status) int {
+	n := 0
+	for _, item := range j.Items {
+		if item.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Summary describes how far the job got, such as "Deleted 8 of 10, 1
+// failed, 1 left".
+func (j *Job) Summary() string {
+	summary := fmt.Sprintf("Deleted %d of %d, %d failed", j.Count(StatusDone), len(j.Items), j.Count(StatusFailed))
+	if pending := j.Count(StatusPending); pending > 0 {
+		summary += fmt.Sprintf(", %d left", pending)
+	}
+	return summary
+}
+
+// Resumer is implemented by services whose batch actions can be resumed
+// after an interruption.
+type Resumer interface {
+	// PendingBatch returns the service's interrupted batch in the current
+	// scope, if any.
+	PendingBatch() *Job
+}
+
+// =============================================================================
+// Progress
+// =============================================================================
+
+// Progress reports an attempt on an item of a running batch.
+type Progress struct {
+	Item  Item
+	Done  int           // Items finished, whether they went through or not
+	Total int           // Items in the batch
+	Retry time.Duration // Pause before the item is tried again, if it failed transiently
+}
+
+type progressKey struct{}
+
+// WithProgress returns a context whose batches report each attempt to fn.
+// fn runs on the batch's goroutine and must not block.
+func WithProgress(ctx context.Context, fn func(Progress)) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+func report(ctx context.Context, job *Job, item Item, retry time.Duration) {
+	fn, ok := ctx.Value(progressKey{}).(func(Progress))
+	if !ok {
+		return
+	}
+	fn(Progress{
+		Item:  item,
+		Done:  job.Count(StatusDone) + job.Count(StatusFailed),
+		Total: len(job.Items),
+		Retry: retry,
+	})
+}
+
+// =============================================================================
+// Runner
+// =============================================================================
+
+// Option configures Run.
+type Option func(*runner)
+
+type runner struct {
+	store       *Store
+	maxAttempts int
+}
+
+// WithStore saves the job in store after every item and removes it once no
+// item is left, so an interrupted job can be resumed.
+func WithStore(store *Store) Option {
+	return func(r *runner) {
+		r.store = store
+	}
+}
+
+// WithMaxAttempts sets how many times an item failing for transient reasons
+// is tried. Non-positive values keep DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(r *runner) {
+		if n > 0 {
+			r.maxAttempts = n
+		}
+	}
+}
+
+// Run calls do on each pending item of job in turn, marking it done or
+// failed. do may append items to job.Items, which run after the others,
+// and may mark pending items done when its action took care of them.
+//
+// Transient failures are retried up to the maximum attempts, pausing a
+// second, then twice as long each time. Throttling also paces the items
+// after it, the pause halving with each success. An item deleted by an
+// earlier attempt, which AWS then reports as not found, counts as done.
+//
+// When ctx is cancelled Run stops, leaving the item in progress and the
+// ones after it pending, and returns ctx.Err().
+func Run(ctx context.Context, job *Job, do func(ctx context.Context, item *Item) error, opts ...Option) error {
+	r := &runner{maxAttempts: DefaultMaxAttempts}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	var pace time.Duration
+	for i := 0; i < len(job.Items); i++ {
+		if job.Items[i].Status != StatusPending {
+			continue
+		}
+		for {
+			if !sleep(ctx, pace) {
+				r.save(job)
+				return ctx.Err()
+			}
+
+			// Attempts are saved first, so a resumed job knows a deletion
+			// may have gone through
+			job.Items[i].Attempts++
+			r.save(job)
+			item := job.Items[i]
+			err := do(ctx, &item)
+			job.Items[i] = item
+			if err != nil && ctx.Err() != nil {
+				r.save(job)
+				return ctx.Err()
+			}
+
+			switch {
+			case err == nil:
+				item.Status, item.Error = StatusDone, ""
+				if pace /= 2; pace < minPause {
+					pace = 0
+				}
+			case item.Attempts > 1 && NotFound(err):
+				item.Status, item.Error = StatusDone, ""
+				item.Detail = "already deleted"
+			case Transient(err) && item.Attempts < r.maxAttempts:
+				if _, throttled := awsfactory.Throttled(err); throttled {
+					pace = min(max(pace*2, minPause), maxPause)
+				}
+				wait := min(minPause<<(item.Attempts-1), maxPause)
+				item.Error = err.Error()
+				job.Items[i] = item
+				report(ctx, job, item, wait)
+				if !sleep(ctx, wait) {
+					r.save(job)
+					return ctx.Err()
+				}
+				continue
+			default:
+				item.Status, item.Error = StatusFailed, err.Error()
+			}
+			job.Items[i] = item
+			break
+		}
+		r.save(job)
+		report(ctx, job, job.Items[i], 0)
+	}
+
+	if r.store != nil {
+		// Losing the state only loses resumability, not the deletions
+		_ = r.store.Delete(job.Scope)
+	}
+	return nil
+}
+
+// save records job in the store, if any. A batch goes on when it cannot be
+// saved, since that only loses resumability.
+func (r *runner) save(job *Job) {
+	if r.store != nil {
+		_ = r.store.Save(job)
+	}
+}
+
+// Transient reports whether err may go away on retry: throttling, server
+// errors and connection failures.
+func Transient(err error) bool {
+	if _, throttled := awsfactory.Throttled(err); throttled {
+		return true
+	}
+	return retry.IsErrorRetryables(retry.DefaultRetryables).IsErrorRetryable(err).Bool()
+}
+
+// NotFound reports whether err says the resource does not exist.
+func NotFound(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	code := apiErr.ErrorCode()
+	return strings.Contains(code, "NotFound") || strings.HasPrefix(code, "NoSuch")
+}
+
+// sleep pauses for d, returning false when ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,135 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestRunResumesInterruptedJob(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "batches.json"))
+	scope := Scope("snapshots", "prod", "eu-west-1")
+	job := NewJob(scope, "delete", []Item{{ID: "snap-1"}, {ID: "snap-2"}, {ID: "snap-3"}, {ID: "snap-4"}}, nil)
+
+	// The first run is interrupted while deleting snap-2, which went through
+	ctx, cancel := context.WithCancel(context.Background())
+	deleted := map[string]bool{}
+	err := Run(ctx, job, func(ctx context.Context, item *Item) error {
+		switch item.ID {
+		case "snap-2":
+			deleted[item.ID] = true
+			cancel()
+			return ctx.Err()
+		case "snap-3":
+			return &smithy.GenericAPIError{Code: "InvalidSnapshot.InUse"}
+		}
+		deleted[item.ID] = true
+		return nil
+	}, WithStore(store))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("interrupted Run() error = %v, want context.Canceled", err)
+	}
+
+	pending, err := store.Pending(scope)
+	if err != nil || pending == nil {
+		t.Fatalf("Pending() = %v, %v, want the interrupted job", pending, err)
+	}
+	if got := pending.Summary(); got != "Deleted 1 of 4, 0 failed, 3 left" {
+		t.Errorf("interrupted Summary() = %q", got)
+	}
+	if other, _ := store.Pending(Scope("snapshots", "dev", "eu-west-1")); other != nil {
+		t.Errorf("Pending() in another profile = %+v, want none", other)
+	}
+
+	// Resuming retries snap-2, which AWS no longer finds, and goes on
+	var tried []string
+	err = Run(context.Background(), pending, func(_ context.Context, item *Item) error {
+		tried = append(tried, item.ID)
+		switch {
+		case deleted[item.ID]:
+			return &smithy.GenericAPIError{Code: "InvalidSnapshot.NotFound"}
+		case item.ID == "snap-3":
+			return &smithy.GenericAPIError{Code: "InvalidSnapshot.InUse"}
+		}
+		return nil
+	}, WithStore(store))
+	if err != nil {
+		t.Fatalf("resumed Run() error = %v", err)
+	}
+
+	tests := []struct {
+		id       string
+		status   Status
+		attempts int
+		detail   string
+	}{
+		{id: "snap-1", status: StatusDone, attempts: 1},
+		{id: "snap-2", status: StatusDone, attempts: 2, detail: "already deleted"},
+		{id: "snap-3", status: StatusFailed, attempts: 1},
+		{id: "snap-4", status: StatusDone, attempts: 1},
+	}
+	for i, tt := range tests {
+		item := pending.Items[i]
+		if item.ID != tt.id || item.Status != tt.status || item.Attempts != tt.attempts || item.Detail != tt.detail {
+			t.Errorf("item %d = %+v, want %s %s after %d attempts", i, item, tt.id, tt.status, tt.attempts)
+		}
+	}
+	if len(tried) != 3 || tried[0] != "snap-2" {
+		t.Errorf("resumed run tried %v, want snap-2, snap-3 and snap-4", tried)
+	}
+	if got := pending.Summary(); got != "Deleted 3 of 4, 1 failed" {
+		t.Errorf("Summary() = %q", got)
+	}
+
+	// A finished job is no longer pending
+	if left, err := store.Pending(scope); err != nil || left != nil {
+		t.Errorf("Pending() after the job finished = %+v, %v", left, err)
+	}
+}
+
+func TestRunAppendsItems(t *testing.T) {
+	job := NewJob(Scope("ec2", "", "us-east-1"), "deregister", []Item{{ID: "ami-1", Kind: "image"}}, nil)
+	err := Run(context.Background(), job, func(_ context.Context, item *Item) error {
+		// Deregistering an image leaves its snapshots to delete after it
+		if item.Kind == "image" {
+			job.Items = append(job.Items, Item{ID: "snap-1", Kind: "snapshot", Status: StatusPending})
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(job.Items) != 2 || job.Count(StatusDone) != 2 {
+		t.Errorf("items = %+v, want the image and its snapshot done", job.Items)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		code      string
+		transient bool
+		notFound  bool
+	}{
+		{code: "Throttling", transient: true},
+		{code: "RequestLimitExceeded", transient: true},
+		{code: "InvalidSnapshot.NotFound", notFound: true},
+		{code: "NoSuchEntity", notFound: true},
+		{code: "InvalidSnapshot.InUse"},
+		{code: "UnauthorizedOperation"},
+	}
+	for _, tt := range tests {
+		err := &smithy.GenericAPIError{Code: tt.code}
+		if got := Transient(err); got != tt.transient {
+			t.Errorf("Transient(%s) = %v, want %v", tt.code, got, tt.transient)
+		}
+		if got := NotFound(err); got != tt.notFound {
+			t.Errorf("NotFound(%s) = %v, want %v", tt.code, got, tt.notFound)
+		}
+	}
+	if NotFound(errors.New("not found")) {
+		t.Error("NotFound() matched an error that is not an API error")
+	}
+}
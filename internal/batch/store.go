@@ -0,0 +1,115 @@
+package batch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// =============================================================================
+// Store
+// =============================================================================
+
+// Store persists unfinished batches in a JSON file, one per scope: a new
+// batch replaces the interrupted one of its scope.
+type Store struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewStore creates a store backed by the file at path.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the default store location inside stateDir.
+func DefaultPath(stateDir string) string {
+	return filepath.Join(stateDir, "batches.json")
+}
+
+// Pending returns the unfinished batch of scope, if any. A nil store has
+// none.
+func (s *Store) Pending(scope string) (*Job, error) {
+	if s == nil {
+		return nil, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return jobs[scope], nil
+}
+
+// Save writes the state of job.
+func (s *Store) Save(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs, err := s.load()
+	if err != nil {
+		return err
+	}
+	jobs[job.Scope] = job
+	return s.save(jobs)
+}
+
+// Delete removes the batch of scope. Deleting a missing batch is not an
+// error.
+func (s *Store) Delete(scope string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := jobs[scope]; !ok {
+		return nil
+	}
+	delete(jobs, scope)
+	return s.save(jobs)
+}
+
+// load reads all batches, keyed by scope.
+func (s *Store) load() (map[string]*Job, error) {
+	jobs := make(map[string]*Job)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return jobs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batches: %w", err)
+	}
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to parse batches %s: %w", s.path, err)
+	}
+	return jobs, nil
+}
+
+// save writes all batches atomically.
+func (s *Store) save(jobs map[string]*Job) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(jobs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode batches: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write batches: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write batches: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Package cleanup gathers the cleanup candidates services flag, such as
+// unattached volumes or unassociated Elastic IPs, into a report grouped by
+// the team owning them, so each team gets the list of what it should
+// remove.
+//
+// Services flag a candidate with the "should_cleanup" metadata and explain
+// why in "cleanup_reason". Owners are read from tags, the first of the
+// owner tags set on a resource naming its team.
+package cleanup
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// DefaultOwnerTags are the tags naming the team owning a resource, in
+// order of preference.
+var DefaultOwnerTags = []string{"Owner", "Team"}
+
+// Unowned groups the candidates without an owner tag.
+const Unowned = "Unowned"
+
+// Candidate is a resource a service flagged for cleanup.
+type Candidate struct {
+	Owner   string `json:"owner"`
+	Service string `json:"service"`
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Region  string `json:"region,omitempty"`
+	Reason  string `json:"reason"`
+	// Monthly is the estimated monthly cost, when known
+	Monthly *float64 `json:"monthly_cost,omitempty"`
+}
+
+// Team is the candidates of an owner.
+type Team struct {
+	Owner      string
+	Candidates []Candidate
+	Monthly    float64 // Estimated monthly cost of the candidates with one
+}
+
+// Collect returns the cleanup candidates among resources listed by
+// service, owned by the first of ownerTags set on each.
+func Collect(service string, resources []core.Resource, ownerTags []string) []Candidate {
+	var candidates []Candidate
+	for _, r := range resources {
+		if flagged, _ := r.Metadata["should_cleanup"].(bool); !flagged {
+			continue
+		}
+		c := Candidate{
+			Owner:   OwnerOf(r, ownerTags),
+			Service: service,
+			ID:      r.ID,
+			Name:    r.Name,
+			Type:    r.Type,
+			Region:  r.Region,
+			Reason:  reason(r),
+		}
+		if monthly, ok := estimate.MonthlyCost(r); ok {
+			c.Monthly = &monthly
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// OwnerOf returns the value of the first of ownerTags set on a resource,
+// matching tag keys regardless of case, or Unowned.
+func OwnerOf(r core.Resource, ownerTags []string) string {
+	for _, tag := range ownerTags {
+		for key, value := range r.Tags {
+			if strings.EqualFold(key, tag) && strings.TrimSpace(value) != "" {
+				return strings.TrimSpace(value)
+			}
+		}
+	}
+	return Unowned
+}
+
+// reason explains why a resource is a candidate: its cleanup reason, or
+// else its most severe issue.
+func reason(r core.Resource) string {
+	if why := r.GetMetadataString("cleanup_reason"); why != "" {
+		return why
+	}
+	issues := r.Issues()
+	if len(issues) == 0 {
+		return "flagged for cleanup"
+	}
+	worst := slices.MaxFunc(issues, func(a, b core.Issue) int {
+		return cmp.Compare(a.Severity.Rank(), b.Severity.Rank())
+	})
+	return strings.TrimPrefix(worst.Message, "Cleanup candidate: ")
+}
+
+// Group groups candidates by owner. Teams are sorted by the monthly cost of
+// their candidates, highest first, with the unowned candidates last;
+// candidates within a team likewise.
+func Group(candidates []Candidate) []Team {
+	byOwner := make(map[string]*Team)
+	var teams []*Team
+	for _, c := range candidates {
+		team, ok := byOwner[c.Owner]
+		if !ok {
+			team = &Team{Owner: c.Owner}
+			byOwner[c.Owner] = team
+			teams = append(teams, team)
+		}
+		team.Candidates = append(team.Candidates, c)
+		if c.Monthly != nil {
+			team.Monthly += *c.Monthly
+		}
+	}
+
+	out := make([]Team, 0, len(teams))
+	for _, team := range teams {
+		slices.SortStableFunc(team.Candidates, func(a, b Candidate) int {
+			if c := cmp.Compare(monthly(b), monthly(a)); c != 0 {
+				return c
+			}
+			return cmp.Or(cmp.Compare(a.Service, b.Service), cmp.Compare(a.Name, b.Name))
+		})
+		out = append(out, *team)
+	}
+	slices.SortStableFunc(out, func(a, b Team) int {
+		if (a.Owner == Unowned) != (b.Owner == Unowned) {
+			if a.Owner == Unowned {
+				return 1
+			}
+			return -1
+		}
+		if c := cmp.Compare(b.Monthly, a.Monthly); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Owner, b.Owner)
+	})
+	return out
+}
+
+func monthly(c Candidate) float64 {
+	if c.Monthly == nil {
+		return 0
+	}
+	return *c.Monthly
+}
+
+// WriteMarkdown writes a section per team, headed by the team and what its
+// candidates cost, listing each candidate with why it was flagged.
+// Sections are headed in bold rather than with headings, which Slack does
+// not render once pasted.
+func WriteMarkdown(w io.Writer, title string, teams []Team) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s**\n", title)
+	if len(teams) == 0 {
+		b.WriteString("\nNo cleanup candidates.\n")
+	}
+	for _, team := range teams {
+		fmt.Fprintf(&b, "\n**%s**: %s", team.Owner, plural(len(team.Candidates), "resource"))
+		if team.Monthly > 0 {
+			fmt.Fprintf(&b, ", %s/mo", estimate.FormatCost(team.Monthly))
+		}
+		b.WriteString("\n")
+		for _, c := range team.Candidates {
+			fmt.Fprintf(&b, "- `%s` %s", c.Service, c.Name)
+			if c.Name != c.ID {
+				fmt.Fprintf(&b, " (`%s`)", c.ID)
+			}
+			if c.Region != "" {
+				fmt.Fprintf(&b, " in %s", c.Region)
+			}
+			fmt.Fprintf(&b, ": %s", c.Reason)
+			if c.Monthly != nil && *c.Monthly > 0 {
+				fmt.Fprintf(&b, ", %s/mo", estimate.FormatCost(*c.Monthly))
+			}
+			b.WriteString("\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,68 @@
+package cleanup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+func resource(id string, tags map[string]string, reason string, monthly float64) core.Resource {
+	r := core.Resource{ID: id, Name: id, Type: "ec2:volume", Region: "eu-west-1", Tags: tags, Metadata: map[string]any{
+		"should_cleanup": reason != "",
+		"cleanup_reason": reason,
+	}}
+	if monthly > 0 {
+		estimate.ApplyCost(&r, monthly)
+	}
+	return r
+}
+
+func TestCollectAndGroup(t *testing.T) {
+	addr := core.Resource{ID: "eipalloc-1", Name: "203.0.113.7", Tags: map[string]string{"team": "data"}, Metadata: map[string]any{"should_cleanup": true}}
+	addr.AddIssue(core.SeverityLow, "Cheap")
+	addr.AddIssue(core.SeverityMedium, "Unassociated")
+
+	candidates := append(Collect("ebs", []core.Resource{
+		resource("vol-1", map[string]string{"Owner": "platform", "Team": "data"}, "unattached", 8),
+		resource("vol-2", map[string]string{"Team": "data"}, "unattached", 40),
+		resource("vol-3", nil, "unattached", 1),
+		resource("vol-4", map[string]string{"Owner": "platform"}, "", 100),
+	}, DefaultOwnerTags), Collect("eip", []core.Resource{addr}, DefaultOwnerTags)...)
+
+	if len(candidates) != 4 {
+		t.Fatalf("Collect() = %d candidates, want 4", len(candidates))
+	}
+	if candidates[0].Owner != "platform" || candidates[3].Owner != "data" || candidates[3].Reason != "Unassociated" {
+		t.Errorf("candidates = %+v", candidates)
+	}
+
+	teams := Group(candidates)
+	if len(teams) != 3 || teams[0].Owner != "data" || teams[1].Owner != "platform" || teams[2].Owner != Unowned {
+		t.Fatalf("Group() = %+v, want data, platform, then unowned", teams)
+	}
+	if teams[0].Monthly != 40 || teams[0].Candidates[0].ID != "vol-2" {
+		t.Errorf("data team = %+v", teams[0])
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	teams := Group(Collect("ebs", []core.Resource{
+		resource("vol-1", map[string]string{"Owner": "@platform"}, "unattached for 45 days", 8),
+		resource("vol-2", nil, "unattached", 0),
+	}, DefaultOwnerTags))
+
+	var b strings.Builder
+	if err := WriteMarkdown(&b, "Cleanup candidates", teams); err != nil {
+		t.Fatal(err)
+	}
+	want := "**Cleanup candidates**\n\n" +
+		"**@platform**: 1 resource, $8.00/mo\n" +
+		"- `ebs` vol-1 in eu-west-1: unattached for 45 days, $8.00/mo\n\n" +
+		"**Unowned**: 1 resource\n" +
+		"- `ebs` vol-2 in eu-west-1: unattached\n"
+	if b.String() != want {
+		t.Errorf("WriteMarkdown() =\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,243 @@
+// Package compliance maps a9s's built-in checks to the controls of the CIS
+// AWS Foundations Benchmark and AWS Foundational Security Best Practices.
+//
+// Services run the checks during enrichment and record the failed ones on
+// the resource; reports then list the controls each failure violates, and
+// can be narrowed to one framework.
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Framework identifies a compliance framework.
+type Framework string
+
+const (
+	// FrameworkCIS is the CIS AWS Foundations Benchmark v3.0.0
+	FrameworkCIS Framework = "cis"
+	// FrameworkFSBP is AWS Foundational Security Best Practices
+	FrameworkFSBP Framework = "fsbp"
+)
+
+// Frameworks lists the supported frameworks.
+var Frameworks = []Framework{FrameworkCIS, FrameworkFSBP}
+
+// ParseFramework validates a framework name.
+func ParseFramework(s string) (Framework, error) {
+	f := Framework(strings.ToLower(s))
+	if !slices.Contains(Frameworks, f) {
+		return "", fmt.Errorf("unknown framework %q (expected cis or fsbp)", s)
+	}
+	return f, nil
+}
+
+// Label returns the short name used in control references.
+func (f Framework) Label() string {
+	switch f {
+	case FrameworkCIS:
+		return "CIS"
+	case FrameworkFSBP:
+		return "FSBP"
+	}
+	return strings.ToUpper(string(f))
+}
+
+// Check identifies a built-in check.
+type Check string
+
+const (
+	// CheckS3PublicBucket fails for buckets not blocking public access
+	CheckS3PublicBucket Check = "s3-public-bucket"
+	// CheckIAMFullAdmin fails for roles granting full "*:*" administrative
+	// privileges
+	CheckIAMFullAdmin Check = "iam-full-admin"
+	// CheckEBSUnencrypted fails for instances with unencrypted EBS volumes
+	CheckEBSUnencrypted Check = "ebs-unencrypted"
+	// CheckS3AccountPublicAccess fails for accounts without every S3 Block
+	// Public Access setting on
+	CheckS3AccountPublicAccess Check = "s3-account-public-access"
+	// CheckEBSDefaultEncryption fails for regions not encrypting new EBS
+	// volumes by default
+	CheckEBSDefaultEncryption Check = "ebs-default-encryption"
+	// CheckPasswordPolicy fails for accounts whose password policy is weaker
+	// than 14 characters of every class with 24 remembered passwords
+	CheckPasswordPolicy Check = "iam-password-policy"
+	// CheckRootMFA fails for accounts whose root user has no MFA device
+	CheckRootMFA Check = "root-mfa"
+	// CheckRootAccessKeys fails for accounts whose root user has access keys
+	CheckRootAccessKeys Check = "root-access-keys"
+	// CheckSGAdminPorts fails for security groups opening SSH or RDP to
+	// 0.0.0.0/0 or ::/0
+	CheckSGAdminPorts Check = "sg-open-admin-ports"
+	// CheckSGHighRiskPorts fails for security groups opening high-risk
+	// ports, such as databases, to 0.0.0.0/0 or ::/0
+	CheckSGHighRiskPorts Check = "sg-open-high-risk-ports"
+	// CheckSGDefaultOpen fails for default security groups with any rule
+	CheckSGDefaultOpen Check = "sg-default-open"
+	// CheckKMSRotation fails for enabled customer managed symmetric keys
+	// without automatic rotation
+	CheckKMSRotation Check = "kms-rotation"
+	// CheckKMSPublic fails for keys whose policy allows any principal
+	// without conditions
+	CheckKMSPublic Check = "kms-public"
+)
+
+// Control is a framework control a check verifies.
+type Control struct {
+	Framework Framework `json:"framework"`
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+}
+
+// String renders a control reference, e.g. "CIS 1.16".
+func (c Control) String() string {
+	return c.Framework.Label() + " " + c.ID
+}
+
+var controls = map[Check][]Control{
+	CheckS3PublicBucket: {
+		{FrameworkCIS, "2.1.4", "Ensure that S3 Buckets are configured with 'Block public access (bucket settings)'"},
+		{FrameworkFSBP, "S3.8", "S3 general purpose buckets should block public access"},
+	},
+	CheckIAMFullAdmin: {
+		{FrameworkCIS, "1.16", "Ensure IAM policies that allow full \"*:*\" administrative privileges are not attached"},
+		{FrameworkFSBP, "IAM.1", "IAM policies should not allow full \"*\" administrative privileges"},
+	},
+	CheckEBSUnencrypted: {
+		{FrameworkCIS, "2.2.1", "Ensure EBS volume encryption is enabled in all regions"},
+		{FrameworkFSBP, "EC2.3", "Attached Amazon EBS volumes should be encrypted at-rest"},
+	},
+	CheckS3AccountPublicAccess: {
+		{FrameworkCIS, "2.1.4", "Ensure that S3 Buckets are configured with 'Block public access (bucket settings)'"},
+		{FrameworkFSBP, "S3.1", "S3 general purpose buckets should have block public access settings enabled"},
+	},
+	CheckEBSDefaultEncryption: {
+		{FrameworkCIS, "2.2.1", "Ensure EBS volume encryption is enabled in all regions"},
+		{FrameworkFSBP, "EC2.7", "EBS default encryption should be enabled"},
+	},
+	CheckPasswordPolicy: {
+		{FrameworkCIS, "1.8", "Ensure IAM password policy requires minimum length of 14 or greater"},
+		{FrameworkCIS, "1.9", "Ensure IAM password policy prevents password reuse"},
+		{FrameworkFSBP, "IAM.7", "Password policies for IAM users should have strong configurations"},
+	},
+	CheckRootMFA: {
+		{FrameworkCIS, "1.5", "Ensure MFA is enabled for the 'root' user account"},
+		{FrameworkFSBP, "IAM.9", "MFA should be enabled for the root user"},
+	},
+	CheckRootAccessKeys: {
+		{FrameworkCIS, "1.4", "Ensure no 'root' user account access key exists"},
+		{FrameworkFSBP, "IAM.4", "IAM root user access key should not exist"},
+	},
+	CheckSGAdminPorts: {
+		{FrameworkCIS, "5.2", "Ensure no security groups allow ingress from 0.0.0.0/0 to remote server administration ports"},
+		{FrameworkCIS, "5.3", "Ensure no security groups allow ingress from ::/0 to remote server administration ports"},
+		{FrameworkFSBP, "EC2.53", "EC2 security groups should not allow ingress from 0.0.0.0/0 to remote server administration ports"},
+		{FrameworkFSBP, "EC2.54", "EC2 security groups should not allow ingress from ::/0 to remote server administration ports"},
+	},
+	CheckSGHighRiskPorts: {
+		{FrameworkFSBP, "EC2.19", "Security groups should not allow unrestricted access to ports with high risk"},
+	},
+	CheckSGDefaultOpen: {
+		{FrameworkCIS, "5.4", "Ensure the default security group of every VPC restricts all traffic"},
+		{FrameworkFSBP, "EC2.2", "VPC default security groups should not allow inbound or outbound traffic"},
+	},
+	CheckKMSRotation: {
+		{FrameworkCIS, "3.6", "Ensure rotation for customer-created symmetric CMKs is enabled"},
+		{FrameworkFSBP, "KMS.4", "AWS KMS key rotation should be enabled"},
+	},
+	CheckKMSPublic: {
+		{FrameworkFSBP, "KMS.5", "KMS keys should not be publicly accessible"},
+	},
+}
+
+// Controls returns the controls a check verifies, limited to the given
+// frameworks when any are given.
+func Controls(check Check, frameworks ...Framework) []Control {
+	var out []Control
+	for _, c := range controls[check] {
+		if len(frameworks) == 0 || slices.Contains(frameworks, c.Framework) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// References renders the controls of the checks, e.g. ["CIS 1.16", "FSBP IAM.1"].
+func References(checks []Check, frameworks ...Framework) []string {
+	var refs []string
+	for _, check := range checks {
+		for _, c := range Controls(check, frameworks...) {
+			refs = append(refs, c.String())
+		}
+	}
+	return refs
+}
+
+// =============================================================================
+// Resources
+// =============================================================================
+
+// Checker is implemented by services running built-in checks.
+type Checker interface {
+	core.AWSService
+
+	// CheckCompliance returns the checks the resource fails
+	CheckCompliance(ctx context.Context, resource *core.Resource) ([]Check, error)
+}
+
+// Apply records the failed checks in the resource metadata under
+// "compliance_checks" and their controls under "compliance_controls".
+func Apply(resource *core.Resource, failed []Check) {
+	if resource.Metadata == nil {
+		resource.Metadata = make(map[string]any)
+	}
+	resource.Metadata["compliance_checks"] = failed
+	resource.Metadata["compliance_controls"] = References(failed)
+}
+
+// Failed returns the checks recorded on a resource.
+func Failed(resource core.Resource) []Check {
+	checks, _ := resource.Metadata["compliance_checks"].([]Check)
+	return checks
+}
+
+// =============================================================================
+// Findings
+// =============================================================================
+
+// Finding is a failed check on a resource.
+type Finding struct {
+	Service  string    `json:"service"`
+	Resource string    `json:"resource"`
+	Name     string    `json:"name,omitempty"`
+	Region   string    `json:"region,omitempty"`
+	Check    Check     `json:"check"`
+	Controls []Control `json:"controls"`
+}
+
+// Findings turns a resource's failed checks into findings. With frameworks
+// given, checks mapping to none of them are left out.
+func Findings(service string, resource core.Resource, failed []Check, frameworks ...Framework) []Finding {
+	var findings []Finding
+	for _, check := range failed {
+		mapped := Controls(check, frameworks...)
+		if len(frameworks) > 0 && len(mapped) == 0 {
+			continue
+		}
+		findings = append(findings, Finding{
+			Service:  service,
+			Resource: resource.ID,
+			Name:     resource.Name,
+			Region:   resource.Region,
+			Check:    check,
+			Controls: mapped,
+		})
+	}
+	return findings
+}
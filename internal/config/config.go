@@ -5,8 +5,13 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -15,6 +20,9 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/policy"
+	"github.com/keanuharrell/a9s/internal/runbook"
 )
 
 // =============================================================================
@@ -29,6 +37,9 @@ type Config struct {
 	Keybindings KeybindingsConfig `mapstructure:"keybindings"`
 	Plugins     PluginsConfig     `mapstructure:"plugins"`
 	Hooks       HooksConfig       `mapstructure:"hooks"`
+	Approvals   ApprovalsConfig   `mapstructure:"approvals"`
+	Policy      PolicyConfig      `mapstructure:"policy"`
+	Runbooks    []RunbookConfig   `mapstructure:"runbooks"`
 	API         APIConfig         `mapstructure:"api"`
 	Logging     LoggingConfig     `mapstructure:"logging"`
 	Themes      map[string]Theme  `mapstructure:"themes"`
@@ -36,10 +47,11 @@ type Config struct {
 
 // AWSConfig holds AWS connection settings.
 type AWSConfig struct {
-	Profile string        `mapstructure:"profile"`
-	Region  string        `mapstructure:"region"`
-	Timeout time.Duration `mapstructure:"timeout"`
-	Retry   RetryConfig   `mapstructure:"retry"`
+	Profile  string        `mapstructure:"profile"`
+	Region   string        `mapstructure:"region"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Retry    RetryConfig   `mapstructure:"retry"`
+	Endpoint string        `mapstructure:"endpoint"` // Endpoint of every service, e.g. LocalStack
 }
 
 // ToCore converts AWSConfig to core.AWSConfig.
@@ -52,6 +64,7 @@ func (c *AWSConfig) ToCore() *core.AWSConfig {
 			MaxAttempts:    c.Retry.MaxAttempts,
 			InitialBackoff: c.Retry.InitialBackoff,
 		},
+		Endpoint: c.Endpoint,
 	}
 }
 
@@ -68,15 +81,70 @@ type TUIConfig struct {
 	MouseEnabled    bool          `mapstructure:"mouse_enabled"`
 	ShowHelpOnStart bool          `mapstructure:"show_help_on_start"`
 	AltScreen       bool          `mapstructure:"alt_screen"`
-}
+	Locale          string        `mapstructure:"locale"`
+	Preflight       bool          `mapstructure:"preflight"`      // Check credentials, region and clock before refreshing
+	RememberState   bool          `mapstructure:"remember_state"` // Restore the open view, selection and filters on start
+	FrameBudget     time.Duration `mapstructure:"frame_budget"`   // Render time beyond which frames count as dropped
+	FrameStats      bool          `mapstructure:"frame_stats"`    // Show render durations and dropped frames in the footer
+	// Shortcuts overrides view shortcuts, keyed by view or service name.
+	// Views without a free shortcut get the next free digit.
+	Shortcuts map[string]string `mapstructure:"shortcuts"`
+	// Summary sets the widgets of summary lines and their order, keyed by
+	// view or service name, or "default" for views without their own.
+	Summary map[string][]string `mapstructure:"summary"`
+}
+
+// reservedKeys are global TUI keys that cannot be used as view shortcuts.
+var reservedKeys = []string{"q", "?", "r", "P", "G", "n", "o", "O", ":", "tab", "shift+tab", "esc", "enter", "ctrl+c"}
 
 // ServicesConfig configures which services are enabled.
 type ServicesConfig struct {
-	Enabled []string                  `mapstructure:"enabled"`
-	EC2     map[string]any            `mapstructure:"ec2"`
-	IAM     map[string]any            `mapstructure:"iam"`
-	S3      map[string]any            `mapstructure:"s3"`
-	Custom  map[string]map[string]any `mapstructure:"custom"`
+	Enabled        []string                  `mapstructure:"enabled"`
+	Owners         bool                      `mapstructure:"owners"` // Attribute resources to their creator via CloudTrail
+	EC2            map[string]any            `mapstructure:"ec2"`
+	IAM            map[string]any            `mapstructure:"iam"`
+	S3             map[string]any            `mapstructure:"s3"`
+	RDS            map[string]any            `mapstructure:"rds"`
+	DynamoDB       map[string]any            `mapstructure:"dynamodb"`
+	Coverage       map[string]any            `mapstructure:"coverage"`
+	Cost           map[string]any            `mapstructure:"cost"`
+	NAT            map[string]any            `mapstructure:"nat"`
+	ParamDiff      map[string]any            `mapstructure:"paramdiff"`
+	Expiry         map[string]any            `mapstructure:"expiry"`
+	EBS            map[string]any            `mapstructure:"ebs"`
+	Snapshots      map[string]any            `mapstructure:"snapshots"`
+	SecretsManager map[string]any            `mapstructure:"secretsmanager"`
+	Custom         map[string]map[string]any `mapstructure:"custom"`
+}
+
+// ServiceInt returns an integer option from a per-service settings map.
+func ServiceInt(settings map[string]any, key string, fallback int) int {
+	switch v := settings[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+// ServiceBool returns a boolean option from a per-service settings map.
+func ServiceBool(settings map[string]any, key string, fallback bool) bool {
+	switch v := settings[key].(type) {
+	case bool:
+		return v
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return fallback
 }
 
 // KeybindingsConfig holds keyboard shortcuts.
@@ -117,6 +185,98 @@ type NotifyConfig struct {
 	SlackWebhook string `mapstructure:"slack_webhook"`
 }
 
+// ApprovalsConfig configures two-person approval of dangerous actions.
+type ApprovalsConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Actions  []string      `mapstructure:"actions"`  // service:action; empty means every dangerous action
+	TTL      time.Duration `mapstructure:"ttl"`      // How long requests and approvals stay valid
+	Store    string        `mapstructure:"store"`    // Shared file holding requests
+	Mode     string        `mapstructure:"mode"`     // Octal mode of a new store file, such as "0660"
+	Operator string        `mapstructure:"operator"` // Overrides $A9S_OPERATOR and the OS user
+}
+
+// FileMode returns the mode of a new store file, or 0 when none is set or
+// it is invalid.
+func (c ApprovalsConfig) FileMode() os.FileMode {
+	mode, err := strconv.ParseUint(c.Mode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0
+	}
+	return os.FileMode(mode)
+}
+
+// PolicyConfig controls which actions need confirmation, typing the
+// resource name, or are blocked, per environment.
+type PolicyConfig struct {
+	Environments map[string]EnvironmentConfig `mapstructure:"environments"`
+	Rules        []PolicyRuleConfig           `mapstructure:"rules"`
+	WarnManaged  bool                         `mapstructure:"warn_managed"` // Confirm changes to IaC-managed resources
+	Cooldown     time.Duration                `mapstructure:"cooldown"`     // How long a successful action is refused again on the same resource
+	Freezes      []FreezeConfig               `mapstructure:"freezes"`      // Maintenance windows holding back dangerous actions
+}
+
+// FreezeConfig is a recurring maintenance window during which dangerous
+// actions are blocked, or run only with a reason written to the audit log.
+type FreezeConfig struct {
+	Name         string        `mapstructure:"name"`
+	Schedule     string        `mapstructure:"schedule"`     // Cron expression of the window's starts, e.g. 0 18 * * FRI
+	Duration     time.Duration `mapstructure:"duration"`     // How long the window stays open after each start
+	Timezone     string        `mapstructure:"timezone"`     // IANA zone of the schedule; local time when empty
+	Environments []string      `mapstructure:"environments"` // Empty applies everywhere
+	Override     bool          `mapstructure:"override"`     // Allow dangerous actions with a reason instead of blocking them
+}
+
+// RunbookConfig is a named sequence of actions run on a resource a step at
+// a time, each confirmed by the operator.
+type RunbookConfig struct {
+	Name        string              `mapstructure:"name"`
+	Description string              `mapstructure:"description"`
+	Services    []string            `mapstructure:"services"` // Views it starts from; empty for every view
+	Steps       []RunbookStepConfig `mapstructure:"steps"`
+}
+
+// RunbookStepConfig is an action run by a runbook. Resource and string
+// params are Go templates over the resource the runbook started on.
+type RunbookStepConfig struct {
+	Name     string         `mapstructure:"name"`
+	Service  string         `mapstructure:"service"`
+	Action   string         `mapstructure:"action"`
+	Resource string         `mapstructure:"resource"` // ID of the resource to act on, e.g. {{meta "volume_id"}}; the runbook's resource when empty
+	Params   map[string]any `mapstructure:"params"`
+	Rollback string         `mapstructure:"rollback"` // How to undo the step
+}
+
+// ToRunbook converts RunbookConfig to runbook.Runbook.
+func (c *RunbookConfig) ToRunbook() runbook.Runbook {
+	rb := runbook.Runbook{Name: c.Name, Description: c.Description, Services: c.Services}
+	for _, step := range c.Steps {
+		rb.Steps = append(rb.Steps, runbook.Step{
+			Name:     step.Name,
+			Service:  step.Service,
+			Action:   step.Action,
+			Resource: step.Resource,
+			Params:   step.Params,
+			Rollback: step.Rollback,
+		})
+	}
+	return rb
+}
+
+// EnvironmentConfig tags AWS contexts as an environment such as prod.
+type EnvironmentConfig struct {
+	Profiles []string `mapstructure:"profiles"` // Profile patterns, e.g. prod-*
+	Regions  []string `mapstructure:"regions"`  // Region patterns, e.g. eu-*
+}
+
+// PolicyRuleConfig assigns a confirmation level to matching actions.
+type PolicyRuleConfig struct {
+	Environments []string `mapstructure:"environments"` // Empty applies everywhere
+	Categories   []string `mapstructure:"categories"`   // Action categories, e.g. lifecycle
+	Actions      []string `mapstructure:"actions"`      // service:action patterns, e.g. *:terminate
+	Dangerous    bool     `mapstructure:"dangerous"`    // Only actions marked dangerous
+	Level        string   `mapstructure:"level"`        // none, confirm, double or block
+}
+
 // APIConfig configures the REST API server.
 type APIConfig struct {
 	Enabled bool       `mapstructure:"enabled"`
@@ -303,9 +463,27 @@ func (l *Loader) setDefaults() {
 	l.v.SetDefault("tui.mouse_enabled", true)
 	l.v.SetDefault("tui.show_help_on_start", false)
 	l.v.SetDefault("tui.alt_screen", true)
+	l.v.SetDefault("tui.locale", i18n.DefaultLocale)
+	l.v.SetDefault("tui.preflight", true)
+	l.v.SetDefault("tui.remember_state", true)
+	l.v.SetDefault("tui.frame_budget", "16ms")
+	l.v.SetDefault("tui.frame_stats", false)
+
+	// Policy defaults
+	l.v.SetDefault("policy.warn_managed", true)
+	l.v.SetDefault("policy.cooldown", "2s")
+
+	// Approval defaults
+	l.v.SetDefault("approvals.enabled", false)
+	l.v.SetDefault("approvals.ttl", "24h")
 
 	// Services defaults
 	l.v.SetDefault("services.enabled", []string{"ec2", "iam", "s3"})
+	l.v.SetDefault("services.owners", false)
+	l.v.SetDefault("services.iam.unused_days", 90)
+	l.v.SetDefault("services.ec2.idle_cpu_percent", 5)
+	l.v.SetDefault("services.ec2.compute_optimizer", false)
+	l.v.SetDefault("services.ec2.schedule_timezone", "UTC")
 
 	// Keybindings defaults
 	l.v.SetDefault("keybindings.global.quit", []string{"q", "ctrl+c"})
@@ -354,11 +532,116 @@ func (l *Loader) validate(cfg *Config) error {
 	if cfg.AWS.Timeout < 0 {
 		return fmt.Errorf("aws.timeout must be positive")
 	}
+	if cfg.AWS.Endpoint != "" {
+		if u, err := url.Parse(cfg.AWS.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("aws.endpoint must be an absolute URL, such as http://localhost:4566")
+		}
+	}
 
 	// Validate TUI config
 	if cfg.TUI.RefreshInterval < time.Second {
 		return fmt.Errorf("tui.refresh_interval must be at least 1s")
 	}
+	if cfg.TUI.FrameBudget <= 0 {
+		return fmt.Errorf("tui.frame_budget must be positive")
+	}
+	if !i18n.Supported(cfg.TUI.Locale) {
+		return fmt.Errorf("tui.locale %q is not supported (available: %s)", cfg.TUI.Locale, strings.Join(i18n.Locales(), ", "))
+	}
+	seen := make(map[string]string, len(cfg.TUI.Shortcuts))
+	for _, name := range slices.Sorted(maps.Keys(cfg.TUI.Shortcuts)) {
+		shortcut := cfg.TUI.Shortcuts[name]
+		if shortcut == "" || slices.Contains(reservedKeys, shortcut) {
+			return fmt.Errorf("tui.shortcuts.%s: %q is not a usable shortcut", name, shortcut)
+		}
+		if other, ok := seen[shortcut]; ok {
+			return fmt.Errorf("tui.shortcuts: %q is assigned to both %s and %s", shortcut, other, name)
+		}
+		seen[shortcut] = name
+	}
+	for name, widgets := range cfg.TUI.Summary {
+		for i, widget := range widgets {
+			if widget == "" {
+				return fmt.Errorf("tui.summary.%s[%d]: widget name is empty", name, i)
+			}
+			if slices.Contains(widgets[:i], widget) {
+				return fmt.Errorf("tui.summary.%s: %q is listed twice", name, widget)
+			}
+		}
+	}
+
+	// Validate approvals config
+	if cfg.Approvals.Enabled && cfg.Approvals.TTL <= 0 {
+		return fmt.Errorf("approvals.ttl must be positive")
+	}
+	if cfg.Approvals.Mode != "" {
+		if mode := cfg.Approvals.FileMode(); mode&0o600 != 0o600 {
+			return fmt.Errorf("approvals.mode %q must be an octal mode the owner can read and write, such as 0660", cfg.Approvals.Mode)
+		}
+	}
+	for _, action := range cfg.Approvals.Actions {
+		if service, name, ok := strings.Cut(action, ":"); !ok || service == "" || name == "" {
+			return fmt.Errorf("invalid approvals.actions entry %q (expected service:action)", action)
+		}
+	}
+
+	// Validate policy config
+	if cfg.Policy.Cooldown < 0 {
+		return fmt.Errorf("policy.cooldown must not be negative")
+	}
+	for i, freeze := range cfg.Policy.Freezes {
+		if freeze.Name == "" {
+			return fmt.Errorf("policy.freezes[%d]: name is required", i)
+		}
+		if _, err := policy.ParseSchedule(freeze.Schedule); err != nil {
+			return fmt.Errorf("policy.freezes[%d]: %w", i, err)
+		}
+		if freeze.Duration <= 0 || freeze.Duration > policy.MaxWindowDuration {
+			return fmt.Errorf("policy.freezes[%d]: duration must be positive and at most %s", i, policy.MaxWindowDuration)
+		}
+		if _, err := time.LoadLocation(freeze.Timezone); err != nil {
+			return fmt.Errorf("policy.freezes[%d]: invalid timezone %q", i, freeze.Timezone)
+		}
+		for _, env := range freeze.Environments {
+			if _, ok := cfg.Policy.Environments[env]; !ok {
+				return fmt.Errorf("policy.freezes[%d]: unknown environment %q", i, env)
+			}
+		}
+	}
+	for i, rule := range cfg.Policy.Rules {
+		if _, err := policy.ParseLevel(rule.Level); err != nil {
+			return fmt.Errorf("policy.rules[%d].level: %w", i, err)
+		}
+		for _, env := range rule.Environments {
+			if _, ok := cfg.Policy.Environments[env]; !ok {
+				return fmt.Errorf("policy.rules[%d]: unknown environment %q", i, env)
+			}
+		}
+		for _, action := range rule.Actions {
+			service, name, ok := strings.Cut(action, ":")
+			if !ok || service == "" || name == "" {
+				return fmt.Errorf("policy.rules[%d]: invalid action %q (expected service:action)", i, action)
+			}
+			if _, err := path.Match(action, ""); err != nil {
+				return fmt.Errorf("policy.rules[%d]: invalid action pattern %q", i, action)
+			}
+		}
+	}
+
+	// Validate runbooks
+	names := make(map[string]bool, len(cfg.Runbooks))
+	for i, rb := range cfg.Runbooks {
+		if rb.Name == "" {
+			return fmt.Errorf("runbooks[%d]: name is required", i)
+		}
+		if names[rb.Name] {
+			return fmt.Errorf("runbooks[%d]: %q is defined twice", i, rb.Name)
+		}
+		names[rb.Name] = true
+		if err := rb.ToRunbook().Validate(); err != nil {
+			return fmt.Errorf("runbooks[%d]: %w", i, err)
+		}
+	}
 
 	// Validate API config
 	if cfg.API.Enabled && cfg.API.Address == "" {
@@ -396,6 +679,20 @@ func (l *Loader) expandPaths(cfg *Config) {
 	cfg.Plugins.Directory = expandPath(cfg.Plugins.Directory, home)
 	cfg.Hooks.Audit.LogFile = expandPath(cfg.Hooks.Audit.LogFile, home)
 	cfg.Logging.File = expandPath(cfg.Logging.File, home)
+	cfg.Approvals.Store = expandPath(cfg.Approvals.Store, home)
+}
+
+// StateDir returns the directory for local state such as crash reports:
+// $XDG_STATE_HOME/a9s, falling back to ~/.local/state/a9s.
+func StateDir() string {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "a9s")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "a9s")
+	}
+	return filepath.Join(home, ".local", "state", "a9s")
 }
 
 // expandPath expands ~ to home directory.
@@ -1,33 +1,77 @@
 // Package container provides a dependency injection container for the a9s application.
 // It supports singleton registration, factory functions, and lifecycle management.
+//
+// Components start in dependency order and stop in reverse. Dependencies
+// come from factory parameters, which are resolved by type, and from
+// DependsOn declarations. Each start and stop is bounded by a timeout and
+// reported as a component event.
 package container
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/keanuharrell/a9s/internal/core"
 )
 
+// DefaultTimeout bounds how long a single component may take to start or stop.
+const DefaultTimeout = 30 * time.Second
+
 // Container implements the core.Container interface.
 type Container struct {
 	mu         sync.RWMutex
 	singletons map[string]any
 	factories  map[string]any
 	resolved   map[string]any
-	order      []string // Track registration order for shutdown
+	deps       map[string][]string // Declared with DependsOn
+	started    []string            // Components brought up by Start, in order
+
+	dispatcher core.EventDispatcher
+	timeout    time.Duration
+}
+
+// Ensure Container implements core.Container
+var _ core.Container = (*Container)(nil)
+
+// Option configures a Container.
+type Option func(*Container)
+
+// WithDispatcher sets the dispatcher receiving component lifecycle events.
+func WithDispatcher(dispatcher core.EventDispatcher) Option {
+	return func(c *Container) {
+		c.dispatcher = dispatcher
+	}
+}
+
+// WithTimeout bounds how long each component may take to start or stop.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Container) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
 }
 
 // New creates a new dependency injection container.
-func New() *Container {
-	return &Container{
+func New(opts ...Option) *Container {
+	c := &Container{
 		singletons: make(map[string]any),
 		factories:  make(map[string]any),
 		resolved:   make(map[string]any),
-		order:      make([]string, 0),
+		deps:       make(map[string][]string),
+		timeout:    DefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 // RegisterSingleton registers a singleton instance by name.
@@ -35,9 +79,6 @@ func (c *Container) RegisterSingleton(name string, instance any) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if _, exists := c.singletons[name]; !exists {
-		c.order = append(c.order, name)
-	}
 	c.singletons[name] = instance
 }
 
@@ -55,8 +96,27 @@ func (c *Container) Register(name string, factory any) {
 	c.factories[name] = factory
 }
 
+// DependsOn declares that name starts after deps and stops before them, in
+// addition to the dependencies implied by its factory's parameters.
+func (c *Container) DependsOn(name string, deps ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deps[name] = append(c.deps[name], deps...)
+}
+
 // Resolve returns an instance by name.
 func (c *Container) Resolve(name string) (any, error) {
+	return c.resolve(name, nil)
+}
+
+// resolve returns an instance by name. path holds the registrations being
+// resolved further up the call chain, to detect cycles.
+func (c *Container) resolve(name string, path []string) (any, error) {
+	if slices.Contains(path, name) {
+		return nil, fmt.Errorf("%w: %s", core.ErrCircularDependency, strings.Join(append(path, name), " -> "))
+	}
+
 	c.mu.RLock()
 
 	// Check singletons first
@@ -80,16 +140,18 @@ func (c *Container) Resolve(name string) (any, error) {
 	}
 
 	// Invoke factory
-	instance, err := c.invokeFactory(factory)
+	instance, err := c.invokeFactory(factory, append(path, name))
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s: %v", core.ErrResolutionFailed, name, err)
+		return nil, fmt.Errorf("%w: %s: %w", core.ErrResolutionFailed, name, err)
 	}
 
-	// Cache resolved instance
+	// Cache resolved instance, keeping the first if resolved concurrently
 	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, exists := c.resolved[name]; exists {
+		return existing, nil
+	}
 	c.resolved[name] = instance
-	c.order = append(c.order, name)
-	c.mu.Unlock()
 
 	return instance, nil
 }
@@ -144,40 +206,49 @@ func (c *Container) Has(name string) bool {
 	return false
 }
 
-// Start initializes all registered services that implement Initializable.
+// Start resolves every registration and initializes the components that
+// implement Initializable, dependencies first. It stops at the first
+// failure; call Stop to shut down what was started.
 func (c *Container) Start(ctx context.Context) error {
-	c.mu.RLock()
-	singletons := make(map[string]any)
-	for k, v := range c.singletons {
-		singletons[k] = v
+	order, err := c.startOrder()
+	if err != nil {
+		return err
 	}
-	c.mu.RUnlock()
 
-	for name, instance := range singletons {
-		if initializable, ok := instance.(interface {
-			Initialize(context.Context) error
-		}); ok {
-			if err := initializable.Initialize(ctx); err != nil {
+	for _, name := range order {
+		instance, err := c.Resolve(name)
+		if err != nil {
+			return err
+		}
+
+		if initializable, ok := instance.(Initializable); ok {
+			if err := c.run(ctx, name, "start", initializable.Initialize); err != nil {
 				return fmt.Errorf("failed to initialize %s: %w", name, err)
 			}
 		}
+
+		c.mu.Lock()
+		c.started = append(c.started, name)
+		c.mu.Unlock()
 	}
 
 	return nil
 }
 
-// Stop shuts down all registered services in reverse order.
+// Stop shuts down the components Start brought up, in the reverse of the
+// order they started, so dependents stop before their dependencies and a
+// component that failed to start is left alone. Every component is stopped
+// even if others fail.
 func (c *Container) Stop(ctx context.Context) error {
-	c.mu.RLock()
-	order := make([]string, len(c.order))
-	copy(order, c.order)
-	c.mu.RUnlock()
+	c.mu.Lock()
+	started := c.started
+	c.started = nil
+	c.mu.Unlock()
 
 	var errs []error
 
 	// Shutdown in reverse order
-	for i := len(order) - 1; i >= 0; i-- {
-		name := order[i]
+	for _, name := range slices.Backward(started) {
 		var instance any
 
 		c.mu.RLock()
@@ -192,26 +263,204 @@ func (c *Container) Stop(ctx context.Context) error {
 			continue
 		}
 
-		if closer, ok := instance.(interface{ Close() error }); ok {
-			if err := closer.Close(); err != nil {
+		if closer, ok := instance.(Closeable); ok {
+			if err := c.run(ctx, name, "stop", func(context.Context) error { return closer.Close() }); err != nil {
 				errs = append(errs, fmt.Errorf("failed to close %s: %w", name, err))
 			}
 		}
 
-		if stopper, ok := instance.(interface{ Stop(context.Context) error }); ok {
-			if err := stopper.Stop(ctx); err != nil {
+		if stopper, ok := instance.(Stoppable); ok {
+			if err := c.run(ctx, name, "stop", stopper.Stop); err != nil {
 				errs = append(errs, fmt.Errorf("failed to stop %s: %w", name, err))
 			}
 		}
 	}
 
 	if len(errs) > 0 {
-		return fmt.Errorf("errors during shutdown: %v", errs)
+		return fmt.Errorf("errors during shutdown: %w", errors.Join(errs...))
 	}
 
 	return nil
 }
 
+// run calls a lifecycle function within the component timeout and reports
+// the outcome as an event. A component ignoring its context is abandoned
+// when the timeout expires.
+func (c *Container) run(ctx context.Context, name, phase string, fn func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("%w: %s did not %s within %s", core.ErrTimeout, name, phase, c.timeout)
+	}
+
+	data := core.ComponentEventData{
+		Name:     name,
+		Phase:    phase,
+		Duration: time.Since(start),
+	}
+	eventType := core.EventComponentStarted
+	if phase == "stop" {
+		eventType = core.EventComponentStopped
+	}
+	if err != nil {
+		data.Error = err.Error()
+		eventType = core.EventComponentFailed
+	}
+	c.dispatch(ctx, eventType, data)
+
+	return err
+}
+
+func (c *Container) dispatch(ctx context.Context, eventType core.EventType, data core.ComponentEventData) {
+	if c.dispatcher == nil {
+		return
+	}
+	// The lifecycle context may have expired; events must still go out
+	_ = c.dispatcher.Dispatch(context.WithoutCancel(ctx), core.NewEvent(eventType, "container", data))
+}
+
+// =============================================================================
+// Dependency Graph
+// =============================================================================
+
+// startOrder returns every registration with dependencies before their
+// dependents. Ties keep name order so startup is deterministic.
+func (c *Container) startOrder() ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	names := make([]string, 0, len(c.singletons)+len(c.factories))
+	for name := range c.singletons {
+		names = append(names, name)
+	}
+	for name := range c.factories {
+		if _, exists := c.singletons[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(names))
+	order := make([]string, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: %s", core.ErrCircularDependency, strings.Join(append(path, name), " -> "))
+		}
+		state[name] = visiting
+
+		deps, err := c.dependencies(name)
+		if err != nil {
+			return err
+		}
+		for _, dep := range deps {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = done
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// dependencies returns the registrations name depends on. The caller must
+// hold the read lock.
+func (c *Container) dependencies(name string) ([]string, error) {
+	var deps []string
+	for _, dep := range c.deps[name] {
+		if !c.registered(dep) {
+			return nil, fmt.Errorf("%w: %s (required by %s)", core.ErrDependencyNotFound, dep, name)
+		}
+		deps = append(deps, dep)
+	}
+
+	// Singletons are already built; only factories take dependencies
+	if _, exists := c.singletons[name]; exists {
+		return deps, nil
+	}
+	factory, exists := c.factories[name]
+	if !exists {
+		return deps, nil
+	}
+
+	t := reflect.TypeOf(factory)
+	for i := 0; i < t.NumIn(); i++ {
+		if provider, ok := c.provider(t.In(i), name); ok && !slices.Contains(deps, provider) {
+			deps = append(deps, provider)
+		}
+	}
+	return deps, nil
+}
+
+// provider returns the registration supplying a parameter type, preferring
+// singletons like resolveDependency does. The caller must hold the read lock.
+func (c *Container) provider(paramType reflect.Type, exclude string) (string, bool) {
+	for _, name := range sortedKeys(c.singletons) {
+		if name != exclude && provides(reflect.TypeOf(c.singletons[name]), paramType) {
+			return name, true
+		}
+	}
+	for _, name := range sortedKeys(c.factories) {
+		if name != exclude && provides(reflect.TypeOf(c.factories[name]).Out(0), paramType) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// registered reports whether name has a singleton or factory. The caller
+// must hold the read lock.
+func (c *Container) registered(name string) bool {
+	_, singleton := c.singletons[name]
+	_, factory := c.factories[name]
+	return singleton || factory
+}
+
+// provides reports whether a value of type t can be passed as paramType.
+func provides(t, paramType reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	return t.AssignableTo(paramType) || (paramType.Kind() == reflect.Interface && t.Implements(paramType))
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // validateFactory checks if a factory function is valid.
 func (c *Container) validateFactory(factory any) error {
 	v := reflect.ValueOf(factory)
@@ -234,7 +483,7 @@ func (c *Container) validateFactory(factory any) error {
 }
 
 // invokeFactory calls a factory function and returns its result.
-func (c *Container) invokeFactory(factory any) (any, error) {
+func (c *Container) invokeFactory(factory any, path []string) (any, error) {
 	v := reflect.ValueOf(factory)
 	t := v.Type()
 
@@ -242,7 +491,7 @@ func (c *Container) invokeFactory(factory any) (any, error) {
 	args := make([]reflect.Value, t.NumIn())
 	for i := 0; i < t.NumIn(); i++ {
 		paramType := t.In(i)
-		dep, err := c.resolveDependency(paramType)
+		dep, err := c.resolveDependency(paramType, path)
 		if err != nil {
 			return nil, fmt.Errorf("cannot resolve parameter %d (%s): %w", i, paramType.String(), err)
 		}
@@ -266,36 +515,32 @@ func (c *Container) invokeFactory(factory any) (any, error) {
 	return results[0].Interface(), nil
 }
 
-// resolveDependency attempts to resolve a dependency by type.
-func (c *Container) resolveDependency(paramType reflect.Type) (any, error) {
-	// Try to find a registered instance that matches the type
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Check singletons
-	for _, instance := range c.singletons {
-		instanceType := reflect.TypeOf(instance)
-		if instanceType.AssignableTo(paramType) {
-			return instance, nil
-		}
-		// Check for pointer/interface compatibility
-		if paramType.Kind() == reflect.Interface && instanceType.Implements(paramType) {
-			return instance, nil
-		}
+// resolveDependency attempts to resolve a dependency by type, building it
+// from a matching factory if no instance exists yet. It picks the provider
+// startOrder assumed, so startup and resolution agree on the dependency.
+func (c *Container) resolveDependency(paramType reflect.Type, path []string) (any, error) {
+	var building string
+	if len(path) > 0 {
+		building = path[len(path)-1]
 	}
 
-	// Check resolved
-	for _, instance := range c.resolved {
-		instanceType := reflect.TypeOf(instance)
-		if instanceType.AssignableTo(paramType) {
-			return instance, nil
-		}
-		if paramType.Kind() == reflect.Interface && instanceType.Implements(paramType) {
-			return instance, nil
+	c.mu.RLock()
+	name, ok := c.provider(paramType, building)
+	if !ok {
+		// A factory declared to return an interface may have built one
+		for _, resolved := range sortedKeys(c.resolved) {
+			if resolved != building && provides(reflect.TypeOf(c.resolved[resolved]), paramType) {
+				name, ok = resolved, true
+				break
+			}
 		}
 	}
+	c.mu.RUnlock()
 
-	return nil, fmt.Errorf("%w: type %s", core.ErrDependencyNotFound, paramType.String())
+	if !ok {
+		return nil, fmt.Errorf("%w: type %s", core.ErrDependencyNotFound, paramType.String())
+	}
+	return c.resolve(name, path)
 }
 
 // matchesPrefix checks if a name matches a prefix pattern.
@@ -335,9 +580,9 @@ type Builder struct {
 }
 
 // NewBuilder creates a new container builder.
-func NewBuilder() *Builder {
+func NewBuilder(opts ...Option) *Builder {
 	return &Builder{
-		container: New(),
+		container: New(opts...),
 	}
 }
 
@@ -353,6 +598,12 @@ func (b *Builder) Factory(name string, factory any) *Builder {
 	return b
 }
 
+// DependsOn declares a startup dependency.
+func (b *Builder) DependsOn(name string, deps ...string) *Builder {
+	b.container.DependsOn(name, deps...)
+	return b
+}
+
 // Build returns the configured container.
 func (b *Builder) Build() *Container {
 	return b.container
@@ -0,0 +1,172 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// component records its lifecycle calls in a shared log, failing to start
+// when failStart is set.
+type component struct {
+	name      string
+	log       *[]string
+	failStart bool
+}
+
+func (c *component) Initialize(context.Context) error {
+	if c.failStart {
+		return errors.New("no credentials")
+	}
+	*c.log = append(*c.log, "start "+c.name)
+	return nil
+}
+
+func (c *component) Stop(context.Context) error {
+	*c.log = append(*c.log, "stop "+c.name)
+	return nil
+}
+
+type (
+	store  struct{ *component }
+	cache  struct{ *component }
+	server struct{ *component }
+)
+
+func TestStartStopOrder(t *testing.T) {
+	var log []string
+	c := NewBuilder().
+		// Registered in no particular order; parameters imply the rest
+		Factory("server", func(s *store, k *cache) *server { return &server{&component{name: "server", log: &log}} }).
+		Factory("cache", func(s *store) *cache { return &cache{&component{name: "cache", log: &log}} }).
+		Singleton("store", &store{&component{name: "store", log: &log}}).
+		Singleton("metrics", &component{name: "metrics", log: &log}).
+		DependsOn("store", "metrics").
+		Build()
+	ctx := context.Background()
+
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := c.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	want := []string{
+		"start metrics", "start store", "start cache", "start server",
+		"stop server", "stop cache", "stop store", "stop metrics",
+	}
+	if !slices.Equal(log, want) {
+		t.Errorf("lifecycle = %v, want %v", log, want)
+	}
+
+	// A second Stop has nothing left to stop
+	log = nil
+	if err := c.Stop(ctx); err != nil || len(log) != 0 {
+		t.Errorf("second Stop() = %v, stopped %v", err, log)
+	}
+}
+
+func TestStopAfterFailedStart(t *testing.T) {
+	var log []string
+	c := NewBuilder().
+		Singleton("store", &store{&component{name: "store", log: &log}}).
+		Factory("cache", func(s *store) *cache { return &cache{&component{name: "cache", log: &log, failStart: true}} }).
+		Factory("server", func(k *cache) *server { return &server{&component{name: "server", log: &log}} }).
+		Build()
+	ctx := context.Background()
+
+	if err := c.Start(ctx); err == nil {
+		t.Fatal("Start() succeeded with a failing component")
+	}
+	if err := c.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	// Only the store came up; the cache failed and the server never started
+	want := []string{"start store", "stop store"}
+	if !slices.Equal(log, want) {
+		t.Errorf("lifecycle = %v, want %v", log, want)
+	}
+}
+
+func TestCircularDependencies(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *Builder)
+	}{
+		{
+			name: "factory parameters",
+			build: func(b *Builder) {
+				b.Factory("store", func(k *cache) *store { return &store{} })
+				b.Factory("cache", func(s *store) *cache { return &cache{} })
+			},
+		},
+		{
+			name: "declared",
+			build: func(b *Builder) {
+				b.Singleton("store", &store{}).Singleton("cache", &cache{})
+				b.DependsOn("store", "cache").DependsOn("cache", "store")
+			},
+		},
+		{
+			name: "declared and parameters",
+			build: func(b *Builder) {
+				b.Singleton("store", &store{})
+				b.Factory("cache", func(s *store) *cache { return &cache{} })
+				b.DependsOn("store", "cache")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder()
+			tt.build(b)
+			if err := b.Build().Start(context.Background()); !errors.Is(err, core.ErrCircularDependency) {
+				t.Errorf("Start() error = %v, want a circular dependency", err)
+			}
+		})
+	}
+
+	c := NewBuilder().
+		Factory("store", func(k *cache) *store { return &store{} }).
+		Factory("cache", func(s *store) *cache { return &cache{} }).
+		Build()
+	if _, err := c.Resolve("store"); !errors.Is(err, core.ErrCircularDependency) {
+		t.Errorf("Resolve() error = %v, want a circular dependency", err)
+	}
+}
+
+func TestMissingDependency(t *testing.T) {
+	c := NewBuilder().
+		Singleton("store", &store{}).
+		DependsOn("store", "metrics").
+		Build()
+	if err := c.Start(context.Background()); !errors.Is(err, core.ErrDependencyNotFound) {
+		t.Errorf("Start() error = %v, want a missing dependency", err)
+	}
+	if _, err := c.Resolve("metrics"); !errors.Is(err, core.ErrDependencyNotFound) {
+		t.Errorf("Resolve() error = %v, want a missing dependency", err)
+	}
+}
+
+func TestResolveDependencyIsDeterministic(t *testing.T) {
+	primary, replica := &store{&component{name: "primary"}}, &store{&component{name: "replica"}}
+	for range 20 {
+		c := NewBuilder().
+			Singleton("store-replica", replica).
+			Singleton("store-primary", primary).
+			Factory("cache", func(s *store) *cache { return &cache{s.component} }).
+			Build()
+		k, err := Resolve[*cache](c, "cache")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k.component != primary.component {
+			t.Fatalf("cache built on %s, want the first store by name", k.name)
+		}
+	}
+}
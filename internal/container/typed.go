@@ -0,0 +1,99 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Typed Helpers
+// =============================================================================
+
+// Register registers a factory whose result must be a T. Unlike
+// Container.Register, a factory returning another type is rejected at
+// registration instead of surfacing as a failed type assertion later.
+func Register[T any](c *Container, name string, factory any) {
+	if err := c.validateFactory(factory); err != nil {
+		panic(fmt.Sprintf("invalid factory for %s: %v", name, err))
+	}
+	if out := reflect.TypeOf(factory).Out(0); !provides(out, typeOf[T]()) {
+		panic(fmt.Sprintf("invalid factory for %s: %v: returns %s, not %s", name, core.ErrInvalidFactory, out, typeOf[T]()))
+	}
+	c.Register(name, factory)
+}
+
+// RegisterSingleton registers a singleton as a T.
+func RegisterSingleton[T any](c *Container, name string, instance T) {
+	c.RegisterSingleton(name, instance)
+}
+
+// Resolve returns the instance registered under name as a T.
+func Resolve[T any](c *Container, name string) (T, error) {
+	var zero T
+
+	instance, err := c.Resolve(name)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := instance.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: %s is %T, not %s", core.ErrResolutionFailed, name, instance, typeOf[T]())
+	}
+	return typed, nil
+}
+
+// MustResolve returns the instance registered under name as a T or panics.
+func MustResolve[T any](c *Container, name string) T {
+	instance, err := Resolve[T](c, name)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// ResolveAll returns every registration that is a T, typically an
+// interface, ordered by name. Factories producing a T are built as needed.
+func ResolveAll[T any](c *Container) ([]T, error) {
+	want := typeOf[T]()
+
+	c.mu.RLock()
+	var names []string
+	for _, name := range sortedKeys(c.singletons) {
+		if provides(reflect.TypeOf(c.singletons[name]), want) {
+			names = append(names, name)
+		}
+	}
+	for _, name := range sortedKeys(c.factories) {
+		if _, exists := c.singletons[name]; exists {
+			continue
+		}
+		if provides(reflect.TypeOf(c.factories[name]).Out(0), want) {
+			names = append(names, name)
+			continue
+		}
+		// A factory declared to return an interface may still build a T
+		if instance, exists := c.resolved[name]; exists && provides(reflect.TypeOf(instance), want) {
+			names = append(names, name)
+		}
+	}
+	c.mu.RUnlock()
+	slices.Sort(names)
+
+	results := make([]T, 0, len(names))
+	for _, name := range names {
+		instance, err := Resolve[T](c, name)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, instance)
+	}
+	return results, nil
+}
+
+// typeOf returns the reflect type of T, including interface types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,35 @@
+package container
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResolveAllOrdersByName(t *testing.T) {
+	c := New()
+	RegisterSingleton(c, "d-store", &store{&component{name: "d-store"}})
+	RegisterSingleton(c, "b-store", &store{&component{name: "b-store"}})
+	Register[*cache](c, "c-cache", func() *cache { return &cache{&component{name: "c-cache"}} })
+	Register[*server](c, "a-server", func() *server { return &server{&component{name: "a-server"}} })
+	RegisterSingleton(c, "config", "not a component")
+
+	// Singletons and factories are interleaved by name
+	all, err := ResolveAll[Initializable](c)
+	if err != nil {
+		t.Fatalf("ResolveAll() error = %v", err)
+	}
+	var names []string
+	for _, instance := range all {
+		switch v := instance.(type) {
+		case *store:
+			names = append(names, v.name)
+		case *cache:
+			names = append(names, v.name)
+		case *server:
+			names = append(names, v.name)
+		}
+	}
+	if want := []string{"a-server", "b-store", "c-cache", "d-store"}; !slices.Equal(names, want) {
+		t.Errorf("ResolveAll() = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,67 @@
+package core
+
+import "strings"
+
+// =============================================================================
+// ARN
+// =============================================================================
+
+// ARN is a parsed Amazon Resource Name:
+// arn:partition:service:region:account-id:resource.
+type ARN struct {
+	Partition string // aws, aws-cn or aws-us-gov
+	Service   string // e.g., "ec2", "s3", "lambda"
+	Region    string // Empty for global services such as IAM and S3
+	AccountID string // Empty for S3 buckets
+	Resource  string // Everything after the account, e.g. "function:name"
+}
+
+// ParseARN splits an ARN into its parts. The resource part is kept whole,
+// colons and slashes included; see ResourceType and ResourceID.
+func ParseARN(s string) (ARN, error) {
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return ARN{}, NewValidationError("arn", s, "must look like arn:partition:service:region:account:resource")
+	}
+	if parts[1] == "" || parts[2] == "" || parts[5] == "" {
+		return ARN{}, NewValidationError("arn", s, "partition, service and resource are required")
+	}
+	return ARN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountID: parts[4],
+		Resource:  parts[5],
+	}, nil
+}
+
+// IsARN reports whether s parses as an ARN.
+func IsARN(s string) bool {
+	_, err := ParseARN(s)
+	return err == nil
+}
+
+// String reassembles the ARN.
+func (a ARN) String() string {
+	return strings.Join([]string{"arn", a.Partition, a.Service, a.Region, a.AccountID, a.Resource}, ":")
+}
+
+// ResourceType returns the type prefix of the resource, such as "instance"
+// in "instance/i-0abc" or "function" in "function:name". It is empty for
+// resources named without a type, such as S3 buckets and SNS topics.
+func (a ARN) ResourceType() string {
+	if i := strings.IndexAny(a.Resource, "/:"); i >= 0 {
+		return a.Resource[:i]
+	}
+	return ""
+}
+
+// ResourceID returns the resource without its type prefix, such as
+// "i-0abc" in "instance/i-0abc" or "cluster/service" in
+// "service/cluster/service".
+func (a ARN) ResourceID() string {
+	if i := strings.IndexAny(a.Resource, "/:"); i >= 0 {
+		return a.Resource[i+1:]
+	}
+	return a.Resource
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseARN(t *testing.T) {
+	tests := []struct {
+		arn      string
+		want     ARN
+		typ, id  string
+		wantFail bool
+	}{
+		{
+			arn:  "arn:aws:ec2:us-east-1:123456789012:instance/i-0abc",
+			want: ARN{Partition: "aws", Service: "ec2", Region: "us-east-1", AccountID: "123456789012", Resource: "instance/i-0abc"},
+			typ:  "instance", id: "i-0abc",
+		},
+		{
+			arn:  "arn:aws:lambda:eu-west-1:123456789012:function:resize:prod",
+			want: ARN{Partition: "aws", Service: "lambda", Region: "eu-west-1", AccountID: "123456789012", Resource: "function:resize:prod"},
+			typ:  "function", id: "resize:prod",
+		},
+		{
+			arn:  "arn:aws:s3:::my-bucket",
+			want: ARN{Partition: "aws", Service: "s3", Resource: "my-bucket"},
+			id:   "my-bucket",
+		},
+		{
+			arn:  "arn:aws-cn:iam::123456789012:role/service-role/deploy",
+			want: ARN{Partition: "aws-cn", Service: "iam", AccountID: "123456789012", Resource: "role/service-role/deploy"},
+			typ:  "role", id: "service-role/deploy",
+		},
+		{arn: "i-0abc", wantFail: true},
+		{arn: "arn:aws:ec2:us-east-1:123456789012", wantFail: true},
+		{arn: "arn:aws::us-east-1:123456789012:thing", wantFail: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseARN(tt.arn)
+		if tt.wantFail {
+			var validation *ValidationError
+			if !errors.As(err, &validation) {
+				t.Errorf("ParseARN(%q) error = %v, want a validation error", tt.arn, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseARN(%q) error = %v", tt.arn, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseARN(%q) = %+v, want %+v", tt.arn, got, tt.want)
+		}
+		if got.String() != tt.arn {
+			t.Errorf("ParseARN(%q).String() = %q", tt.arn, got.String())
+		}
+		if got.ResourceType() != tt.typ || got.ResourceID() != tt.id {
+			t.Errorf("ParseARN(%q) type, id = %q, %q, want %q, %q", tt.arn, got.ResourceType(), got.ResourceID(), tt.typ, tt.id)
+		}
+	}
+}
@@ -0,0 +1,580 @@
+// Package coretest provides a conformance suite for core.AWSService
+// implementations. Built-in services and plugins run RunServiceTests from
+// their own tests, against a fake AWS client, to check they honor the
+// contracts views, hooks and the REST API rely on: what List, Get and
+// Execute return, which events they dispatch and how they report errors.
+package coretest
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Fixture describes the service under test.
+type Fixture struct {
+	// New builds the service around the given dispatcher, backed by a fake
+	// client holding at least one resource. It is called once per subtest,
+	// so the fake must be safe to share or be rebuilt each time.
+	New func(dispatcher core.EventDispatcher) core.AWSService
+
+	// NewFailing builds the service around a client failing every call.
+	// The error subtests are skipped when nil.
+	NewFailing func(dispatcher core.EventDispatcher) core.AWSService
+
+	// ListOptions are passed to List, for services that list nothing
+	// without a filter.
+	ListOptions core.ListOptions
+
+	// ExistingID identifies a listed resource; the first listed resource
+	// is used when empty.
+	ExistingID string
+
+	// MissingID identifies no resource; Get must fail for it.
+	MissingID string
+
+	// Action is an action that succeeds on ExistingID with ActionParams,
+	// which include core.ParamConfirm if it needs confirmation. Only the
+	// declared actions and unknown actions are checked when empty.
+	Action       string
+	ActionParams map[string]any
+
+	// ConfirmAction is an action that needs confirmation on ExistingID; it
+	// is executed without core.ParamConfirm and must not run, returning a
+	// *core.ConfirmationError forms can answer.
+	ConfirmAction string
+
+	// ConfirmTyped requires ConfirmAction to ask for ExistingID typed back:
+	// core.ParamConfirm alone must not run it either.
+	ConfirmTyped bool
+}
+
+// timeout bounds how long streaming subtests wait for a channel to close.
+const timeout = 5 * time.Second
+
+// serviceName matches valid service names: lowercase, as used in config
+// keys, compliance checks and audit events.
+var serviceName = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// parameterTypes are the action parameter types forms know how to render.
+var parameterTypes = []string{"string", "text", "int", "bool", "select", "duration", "secret"}
+
+// RunServiceTests runs the conformance suite against the service built by
+// fixture.New. Capabilities the service does not implement, such as
+// core.ResourceGetter, are skipped.
+func RunServiceTests(t *testing.T, fixture Fixture) {
+	t.Helper()
+	if fixture.New == nil {
+		t.Fatal("coretest: Fixture.New is required")
+	}
+	if fixture.MissingID == "" {
+		fixture.MissingID = "coretest-missing"
+	}
+
+	t.Run("Identity", func(t *testing.T) { testIdentity(t, fixture) })
+	t.Run("List", func(t *testing.T) { testList(t, fixture) })
+	t.Run("ListStream", func(t *testing.T) { testListStream(t, fixture) })
+	t.Run("ListWithEnrichment", func(t *testing.T) { testListWithEnrichment(t, fixture) })
+	t.Run("EnrichResource", func(t *testing.T) { testEnrichResource(t, fixture) })
+	t.Run("Get", func(t *testing.T) { testGet(t, fixture) })
+	t.Run("Actions", func(t *testing.T) { testActions(t, fixture) })
+	t.Run("Execute", func(t *testing.T) { testExecute(t, fixture) })
+	t.Run("Errors", func(t *testing.T) { testErrors(t, fixture) })
+}
+
+// =============================================================================
+// AWSService
+// =============================================================================
+
+func testIdentity(t *testing.T, f Fixture) {
+	svc := f.New(NewRecorder())
+	ctx := context.Background()
+
+	if !serviceName.MatchString(svc.Name()) {
+		t.Errorf("Name() = %q, want lowercase letters and digits", svc.Name())
+	}
+	if svc.Description() == "" {
+		t.Error("Description() is empty")
+	}
+	if err := svc.Initialize(ctx, &core.AWSConfig{Region: "us-east-1"}); err != nil {
+		t.Errorf("Initialize() error = %v", err)
+	}
+	if err := svc.HealthCheck(ctx); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+	if err := svc.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
+
+// =============================================================================
+// Listing
+// =============================================================================
+
+// listed returns the resources listed by a fresh service, failing the test
+// when listing fails or returns nothing.
+func listed(t *testing.T, f Fixture) []core.Resource {
+	t.Helper()
+	svc, ok := f.New(NewRecorder()).(core.ResourceLister)
+	if !ok {
+		t.Skip("service does not implement core.ResourceLister")
+	}
+	resources, err := svc.List(context.Background(), f.ListOptions)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) == 0 {
+		t.Fatal("List() returned no resources; the fixture must hold at least one")
+	}
+	return resources
+}
+
+// existing returns the resource the fixture names, or the first listed one.
+func existing(t *testing.T, f Fixture, resources []core.Resource) core.Resource {
+	t.Helper()
+	if f.ExistingID == "" {
+		return resources[0]
+	}
+	i := slices.IndexFunc(resources, func(r core.Resource) bool { return r.ID == f.ExistingID })
+	if i < 0 {
+		t.Fatalf("List() did not return ExistingID %q", f.ExistingID)
+	}
+	return resources[i]
+}
+
+func testList(t *testing.T, f Fixture) {
+	recorder := NewRecorder()
+	svc, ok := f.New(recorder).(core.ResourceLister)
+	if !ok {
+		t.Skip("service does not implement core.ResourceLister")
+	}
+
+	resources, err := svc.List(context.Background(), f.ListOptions)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) == 0 {
+		t.Fatal("List() returned no resources; the fixture must hold at least one")
+	}
+	checkResources(t, "List()", resources)
+	existing(t, f, resources)
+
+	if !hasEvent(recorder, svc.Name(), core.EventResourceListed) {
+		t.Errorf("List() dispatched %v, want %s from %q", recorder.Types(), core.EventResourceListed, svc.Name())
+	}
+}
+
+// checkResources checks every resource has an ID and a type, and that IDs
+// are unique, since views and actions address resources by ID.
+func checkResources(t *testing.T, call string, resources []core.Resource) {
+	t.Helper()
+	seen := make(map[string]bool, len(resources))
+	for i, r := range resources {
+		if r.ID == "" {
+			t.Errorf("%s resource %d has no ID", call, i)
+		}
+		if r.Type == "" {
+			t.Errorf("%s resource %q has no Type", call, r.ID)
+		}
+		if seen[r.ID] {
+			t.Errorf("%s returned ID %q twice", call, r.ID)
+		}
+		seen[r.ID] = true
+	}
+}
+
+func testListStream(t *testing.T, f Fixture) {
+	svc, ok := f.New(NewRecorder()).(core.ResourceStreamer)
+	if !ok {
+		t.Skip("service does not implement core.ResourceStreamer")
+	}
+	want := listed(t, f)
+
+	updates, err := svc.ListStream(context.Background(), f.ListOptions)
+	if err != nil {
+		t.Fatalf("ListStream() error = %v", err)
+	}
+	var got []core.Resource
+	for _, update := range drain(t, "ListStream()", updates) {
+		if update.Err != nil {
+			t.Fatalf("ListStream() update error = %v", update.Err)
+		}
+		if update.Type != core.UpdateTypeAppend {
+			t.Errorf("ListStream() sent update type %v, want %v", update.Type, core.UpdateTypeAppend)
+		}
+		got = append(got, update.Resources...)
+	}
+
+	checkResources(t, "ListStream()", got)
+	if !slices.Equal(ids(got), ids(want)) {
+		t.Errorf("ListStream() IDs = %v, want List() IDs %v", ids(got), ids(want))
+	}
+}
+
+func testListWithEnrichment(t *testing.T, f Fixture) {
+	svc, ok := f.New(NewRecorder()).(core.EnrichingLister)
+	if !ok {
+		t.Skip("service does not implement core.EnrichingLister")
+	}
+
+	updates, err := svc.ListWithEnrichment(context.Background(), f.ListOptions)
+	if err != nil {
+		t.Fatalf("ListWithEnrichment() error = %v", err)
+	}
+	received := drain(t, "ListWithEnrichment()", updates)
+	if len(received) == 0 {
+		t.Fatal("ListWithEnrichment() closed without an update")
+	}
+
+	batch := received[0]
+	if batch.Err != nil {
+		t.Fatalf("ListWithEnrichment() error update = %v", batch.Err)
+	}
+	if batch.Type != core.UpdateTypeBatch {
+		t.Fatalf("ListWithEnrichment() first update type = %v, want %v", batch.Type, core.UpdateTypeBatch)
+	}
+	checkResources(t, "ListWithEnrichment()", batch.Resources)
+
+	for _, update := range received[1:] {
+		if update.Type != core.UpdateTypeSingle || update.Resource == nil {
+			t.Errorf("ListWithEnrichment() sent update type %v after the batch, want %v with a resource", update.Type, core.UpdateTypeSingle)
+			continue
+		}
+		if update.Index < 0 || update.Index >= len(batch.Resources) {
+			t.Errorf("ListWithEnrichment() update index %d out of range [0,%d)", update.Index, len(batch.Resources))
+			continue
+		}
+		if got, want := update.Resource.ID, batch.Resources[update.Index].ID; got != want {
+			t.Errorf("ListWithEnrichment() update %d resource ID = %q, want %q", update.Index, got, want)
+		}
+	}
+}
+
+func testEnrichResource(t *testing.T, f Fixture) {
+	svc, ok := f.New(NewRecorder()).(core.ResourceEnricher)
+	if !ok {
+		t.Skip("service does not implement core.ResourceEnricher")
+	}
+	resource := existing(t, f, listed(t, f))
+	id := resource.ID
+
+	if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+		t.Fatalf("EnrichResource(%q) error = %v", id, err)
+	}
+	if resource.ID != id {
+		t.Errorf("EnrichResource() changed ID %q to %q", id, resource.ID)
+	}
+	if analyzed, _ := resource.Metadata["analyzed"].(bool); !analyzed {
+		t.Errorf("EnrichResource(%q) did not set the analyzed metadata", id)
+	}
+}
+
+// drain collects the updates of a channel until it closes, failing the test
+// when it stays open.
+func drain(t *testing.T, call string, updates <-chan core.ResourceUpdate) []core.ResourceUpdate {
+	t.Helper()
+	var received []core.ResourceUpdate
+	deadline := time.After(timeout)
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return received
+			}
+			received = append(received, update)
+		case <-deadline:
+			t.Fatalf("%s channel still open after %s", call, timeout)
+		}
+	}
+}
+
+func ids(resources []core.Resource) []string {
+	out := make([]string, len(resources))
+	for i, r := range resources {
+		out[i] = r.ID
+	}
+	slices.Sort(out)
+	return out
+}
+
+// =============================================================================
+// Get
+// =============================================================================
+
+func testGet(t *testing.T, f Fixture) {
+	svc, ok := f.New(NewRecorder()).(core.ResourceGetter)
+	if !ok {
+		t.Skip("service does not implement core.ResourceGetter")
+	}
+	ctx := context.Background()
+	want := existing(t, f, listed(t, f))
+
+	got, err := svc.Get(ctx, want.ID)
+	if err != nil {
+		t.Fatalf("Get(%q) error = %v", want.ID, err)
+	}
+	if got == nil {
+		t.Fatalf("Get(%q) returned no resource", want.ID)
+	}
+	if got.ID != want.ID || got.Type != want.Type {
+		t.Errorf("Get(%q) = %s %q, want the listed %s %q", want.ID, got.Type, got.ID, want.Type, want.ID)
+	}
+
+	missing, err := svc.Get(ctx, f.MissingID)
+	if err == nil {
+		t.Errorf("Get(%q) succeeded for a missing resource", f.MissingID)
+	}
+	if missing != nil {
+		t.Errorf("Get(%q) returned a resource along with its error", f.MissingID)
+	}
+}
+
+// =============================================================================
+// Actions
+// =============================================================================
+
+func testActions(t *testing.T, f Fixture) {
+	svc, ok := f.New(NewRecorder()).(core.ActionExecutor)
+	if !ok {
+		t.Skip("service does not implement core.ActionExecutor")
+	}
+
+	names := make(map[string]bool)
+	shortcuts := make(map[string]string)
+	for _, action := range svc.Actions() {
+		if action.Name == "" {
+			t.Error("action without a name")
+			continue
+		}
+		if names[action.Name] {
+			t.Errorf("action %q declared twice", action.Name)
+		}
+		names[action.Name] = true
+		if action.Description == "" {
+			t.Errorf("action %q has no description", action.Name)
+		}
+		if action.Shortcut != "" {
+			if other, taken := shortcuts[action.Shortcut]; taken {
+				t.Errorf("actions %q and %q share shortcut %q", other, action.Name, action.Shortcut)
+			}
+			shortcuts[action.Shortcut] = action.Name
+		}
+
+		params := make(map[string]bool)
+		for _, p := range action.Parameters {
+			if p.Name == "" || params[p.Name] {
+				t.Errorf("action %q has an empty or duplicate parameter %q", action.Name, p.Name)
+			}
+			params[p.Name] = true
+			if !slices.Contains(parameterTypes, p.Type) {
+				t.Errorf("action %q parameter %q has type %q, want one of %v", action.Name, p.Name, p.Type, parameterTypes)
+			}
+		}
+	}
+
+	for _, name := range []string{f.Action, f.ConfirmAction} {
+		if name != "" && !names[name] {
+			t.Errorf("fixture action %q is not declared by Actions()", name)
+		}
+	}
+}
+
+func testExecute(t *testing.T, f Fixture) {
+	if _, ok := f.New(NewRecorder()).(core.ActionExecutor); !ok {
+		t.Skip("service does not implement core.ActionExecutor")
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		svc := f.New(NewRecorder()).(core.ActionExecutor)
+		result, err := svc.Execute(context.Background(), "coretest-unknown", f.MissingID, nil)
+		if !errors.Is(err, core.ErrActionNotFound) {
+			t.Errorf("Execute(unknown) error = %v, want %v", err, core.ErrActionNotFound)
+		}
+		if result != nil && result.Success {
+			t.Error("Execute(unknown) reported success")
+		}
+	})
+
+	t.Run("Action", func(t *testing.T) {
+		if f.Action == "" {
+			t.Skip("fixture has no Action")
+		}
+		recorder := NewRecorder()
+		svc := f.New(recorder).(core.ActionExecutor)
+		id := f.ExistingID
+		if id == "" {
+			id = existing(t, f, listed(t, f)).ID
+		}
+
+		result, err := svc.Execute(context.Background(), f.Action, id, f.ActionParams)
+		if err != nil {
+			t.Fatalf("Execute(%q, %q) error = %v", f.Action, id, err)
+		}
+		if result == nil || !result.Success {
+			t.Fatalf("Execute(%q, %q) = %+v, want a successful result", f.Action, id, result)
+		}
+		if result.Message == "" {
+			t.Errorf("Execute(%q) result has no message", f.Action)
+		}
+		checkActionEvents(t, recorder, svc.Name(), core.EventActionExecuted)
+	})
+
+	t.Run("Confirmation", func(t *testing.T) {
+		if f.ConfirmAction == "" {
+			t.Skip("fixture has no ConfirmAction")
+		}
+		recorder := NewRecorder()
+		svc := f.New(recorder).(core.ActionExecutor)
+		id := f.ExistingID
+		if id == "" {
+			id = existing(t, f, listed(t, f)).ID
+		}
+
+		result, err := svc.Execute(context.Background(), f.ConfirmAction, id, map[string]any{})
+		checkConfirmation(t, svc, f.ConfirmAction, id, result, err, f.ConfirmTyped)
+		if f.ConfirmTyped {
+			// Answering yes without the ID is the mistake typing it back
+			// guards against
+			result, err = svc.Execute(context.Background(), f.ConfirmAction, id, map[string]any{core.ParamConfirm: true})
+			checkConfirmation(t, svc, f.ConfirmAction, id, result, err, true)
+		}
+		if slices.Contains(recorder.Types(), core.EventActionExecuted) {
+			t.Errorf("Execute(%q) without confirmation dispatched %s", f.ConfirmAction, core.EventActionExecuted)
+		}
+	})
+}
+
+// checkConfirmation checks an action held back for confirmation returned a
+// *core.ConfirmationError describing it, asking for the resource typed
+// back when typed is set. The bare core.ErrConfirmationRequired is not
+// enough: views and runbooks cannot build a form from it.
+func checkConfirmation(t *testing.T, svc core.ActionExecutor, action, id string, result *core.ActionResult, err error, typed bool) {
+	t.Helper()
+	if result != nil && result.Success {
+		t.Errorf("Execute(%q) without confirmation reported success", action)
+	}
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) {
+		t.Fatalf("Execute(%q) without confirmation error = %v, want a *core.ConfirmationError", action, err)
+	}
+	req := confirm.Request
+	if req.Service != svc.Name() || req.Action.Name != action || req.ResourceID != id {
+		t.Errorf("ConfirmationError asks for %s:%s on %q, want %s:%s on %q", req.Service, req.Action.Name, req.ResourceID, svc.Name(), action, id)
+	}
+	if typed && !confirm.TypeResource {
+		t.Errorf("Execute(%q) asks for %s, want %q typed back", action, confirm.ConfirmParam(), id)
+	}
+}
+
+// checkActionEvents checks an action dispatched ActionStarted, then the
+// given outcome, and not the other outcome.
+func checkActionEvents(t *testing.T, recorder *Recorder, source string, outcome core.EventType) {
+	t.Helper()
+	other := core.EventActionFailed
+	if outcome == core.EventActionFailed {
+		other = core.EventActionExecuted
+	}
+
+	started, ended := -1, -1
+	for i, event := range recorder.Events() {
+		if event.Source() != source {
+			continue
+		}
+		switch event.Type() {
+		case core.EventActionStarted:
+			if started < 0 {
+				started = i
+			}
+		case outcome:
+			ended = i
+		case other:
+			t.Errorf("action dispatched %s, want %s", other, outcome)
+		}
+	}
+	if started < 0 || ended < started {
+		t.Errorf("action dispatched %v, want %s then %s from %q", recorder.Types(), core.EventActionStarted, outcome, source)
+	}
+}
+
+func hasEvent(recorder *Recorder, source string, eventType core.EventType) bool {
+	return slices.ContainsFunc(recorder.Events(), func(e core.Event) bool {
+		return e.Type() == eventType && e.Source() == source
+	})
+}
+
+// =============================================================================
+// Errors
+// =============================================================================
+
+func testErrors(t *testing.T, f Fixture) {
+	if f.NewFailing == nil {
+		t.Skip("fixture has no NewFailing")
+	}
+	ctx := context.Background()
+
+	t.Run("HealthCheck", func(t *testing.T) {
+		if err := f.NewFailing(NewRecorder()).HealthCheck(ctx); err == nil {
+			t.Error("HealthCheck() succeeded with a failing client")
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		svc, ok := f.NewFailing(NewRecorder()).(core.ResourceLister)
+		if !ok {
+			t.Skip("service does not implement core.ResourceLister")
+		}
+		resources, err := svc.List(ctx, f.ListOptions)
+		if err == nil {
+			t.Fatal("List() succeeded with a failing client")
+		}
+		// Partial listings keep the resources that did load
+		var partial *core.PartialError
+		if len(resources) > 0 && !errors.As(err, &partial) {
+			t.Errorf("List() returned %d resources with error %v", len(resources), err)
+		}
+		var serviceErr *core.ServiceError
+		if !errors.As(err, &serviceErr) && partial == nil {
+			t.Errorf("List() error = %T, want a *core.ServiceError naming the service", err)
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		svc, ok := f.NewFailing(NewRecorder()).(core.ResourceGetter)
+		if !ok {
+			t.Skip("service does not implement core.ResourceGetter")
+		}
+		resource, err := svc.Get(ctx, f.MissingID)
+		if err == nil || resource != nil {
+			t.Errorf("Get() = %v, %v with a failing client, want nil and an error", resource, err)
+		}
+	})
+
+	t.Run("Execute", func(t *testing.T) {
+		if f.Action == "" {
+			t.Skip("fixture has no Action")
+		}
+		recorder := NewRecorder()
+		svc, ok := f.NewFailing(recorder).(core.ActionExecutor)
+		if !ok {
+			t.Skip("service does not implement core.ActionExecutor")
+		}
+		id := f.ExistingID
+		if id == "" {
+			id = f.MissingID
+		}
+
+		result, err := svc.Execute(ctx, f.Action, id, f.ActionParams)
+		if err == nil {
+			t.Fatalf("Execute(%q) succeeded with a failing client", f.Action)
+		}
+		if result != nil && result.Success {
+			t.Errorf("Execute(%q) returned error %v with a successful result", f.Action, err)
+		}
+		checkActionEvents(t, recorder, svc.Name(), core.EventActionFailed)
+	})
+}
@@ -0,0 +1,64 @@
+package coretest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Recorder is a core.EventDispatcher that keeps every dispatched event so
+// tests can check what a service reported. Hooks and middleware are
+// accepted and ignored.
+type Recorder struct {
+	mu     sync.Mutex
+	events []core.Event
+}
+
+// NewRecorder creates an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Register implements core.EventDispatcher.
+func (r *Recorder) Register(core.Hook) {}
+
+// Unregister implements core.EventDispatcher.
+func (r *Recorder) Unregister(string) {}
+
+// Use implements core.EventDispatcher.
+func (r *Recorder) Use(core.HookMiddleware) {}
+
+// Dispatch records the event.
+func (r *Recorder) Dispatch(_ context.Context, event core.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+// Events returns the recorded events in dispatch order.
+func (r *Recorder) Events() []core.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]core.Event(nil), r.events...)
+}
+
+// Types returns the types of the recorded events in dispatch order.
+func (r *Recorder) Types() []core.EventType {
+	events := r.Events()
+	types := make([]core.EventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type()
+	}
+	return types
+}
+
+// Reset forgets the recorded events.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+var _ core.EventDispatcher = (*Recorder)(nil)
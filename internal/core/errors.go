@@ -3,6 +3,8 @@ package core
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // =============================================================================
@@ -33,6 +35,9 @@ var (
 	ErrActionCancelled      = errors.New("action cancelled")
 	ErrInvalidActionParams  = errors.New("invalid action parameters")
 	ErrConfirmationRequired = errors.New("confirmation required for dangerous action")
+	ErrApprovalRequired     = errors.New("approval by a second operator required")
+	ErrActionBlocked        = errors.New("action blocked by policy")
+	ErrActionInProgress     = errors.New("already in progress")
 
 	// Plugin errors
 	ErrPluginNotFound          = errors.New("plugin not found")
@@ -203,6 +208,56 @@ func NewValidationError(field string, value any, message string) *ValidationErro
 	}
 }
 
+// PartialError reports a listing assembled from several calls, such as one
+// per region or one per source, some of which failed. Listers return it
+// along with the resources of the calls that succeeded, so views can show
+// those rather than failing outright.
+type PartialError struct {
+	Noun     string         // What the calls list, plural, e.g. "regions"
+	Failures []ShardFailure // Failed calls, by shard name
+}
+
+// ShardFailure is a failed call of a partial listing.
+type ShardFailure struct {
+	Shard string // What the call listed, e.g. "eu-west-3" or "ACM"
+	Err   error
+}
+
+func (e *PartialError) Error() string {
+	failures := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		failures[i] = fmt.Sprintf("%s: %v", f.Shard, f.Err)
+	}
+	return fmt.Sprintf("partial results: %d %s failed: %s", len(e.Failures), e.Noun, strings.Join(failures, "; "))
+}
+
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// NewPartialError returns a PartialError for the shards of a listing that
+// failed, or nil when none did.
+func NewPartialError(noun string, failed map[string]error) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	shards := make([]string, 0, len(failed))
+	for shard := range failed {
+		shards = append(shards, shard)
+	}
+	slices.Sort(shards)
+
+	e := &PartialError{Noun: noun, Failures: make([]ShardFailure, len(shards))}
+	for i, shard := range shards {
+		e.Failures[i] = ShardFailure{Shard: shard, Err: failed[shard]}
+	}
+	return e
+}
+
 // =============================================================================
 // Error Helpers
 // =============================================================================
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// =============================================================================
+// Action Guards
+// =============================================================================
+
+// ActionRequest describes an action about to be executed.
+type ActionRequest struct {
+	Service    string
+	Action     Action // Definition from the service; only Name is set for unknown actions
+	ResourceID string
+	Params     map[string]any
+}
+
+// ActionGuard decides whether an action may run. Guards are consulted by
+// ExecuteAction before the service executes the action; returning an error
+// vetoes the action and the error is returned to the caller.
+type ActionGuard interface {
+	Check(ctx context.Context, req ActionRequest) error
+}
+
+// ExecutionObserver is implemented by guards that need to know how an
+// action they allowed ended, for example to use up an approval only once
+// the action succeeded, and give it back when the action did not run.
+type ExecutionObserver interface {
+	// ActionExecuted is called once the action succeeded.
+	ActionExecuted(ctx context.Context, req ActionRequest, result *ActionResult)
+	// ActionAborted is called when a later guard vetoed the action or the
+	// service returned err, such as a ConfirmationError.
+	ActionAborted(ctx context.Context, req ActionRequest, err error)
+}
+
+// ActionGuardFunc adapts a function to the ActionGuard interface.
+type ActionGuardFunc func(ctx context.Context, req ActionRequest) error
+
+// Check implements ActionGuard.
+func (f ActionGuardFunc) Check(ctx context.Context, req ActionRequest) error {
+	return f(ctx, req)
+}
+
+// Parameters guards read to tell whether the operator confirmed an action.
+const (
+	// ParamConfirm is set to true once the operator confirmed the action
+	ParamConfirm = "confirm"
+	// ParamConfirmResource holds the resource ID typed back by the operator
+	ParamConfirmResource = "confirm_resource"
+	// ParamConfirmManaged is set to true once the operator agreed to change
+	// a resource managed by infrastructure as code
+	ParamConfirmManaged = "confirm_managed"
+	// ParamConfirmPolicy is set to true once the operator confirmed an
+	// action the environment's policy asks to confirm
+	ParamConfirmPolicy = "confirm_policy"
+	// ParamOverrideReason holds the reason the operator gave for running an
+	// action during a maintenance window
+	ParamOverrideReason = "override_reason"
+)
+
+// ConfirmationError is returned by guards when an action needs a
+// confirmation the caller has not given. Interactive callers prompt the
+// operator and run the request again with ParamConfirm set, plus
+// ParamConfirmResource when TypeResource is true. Guards asking a separate
+// question set Param, so that one answer does not satisfy another guard.
+// When Justify is true, Param holds the operator's written justification
+// rather than true.
+type ConfirmationError struct {
+	Request      ActionRequest
+	TypeResource bool   // The resource ID must be typed to confirm
+	Justify      bool   // A reason must be written to confirm
+	Environment  string // Environment that requires the confirmation, if any
+	Param        string // Parameter set once confirmed; ParamConfirm when empty
+	Reason       string // Why confirmation is needed, shown to the operator
+}
+
+// ConfirmParam returns the parameter set once the operator confirmed.
+func (e *ConfirmationError) ConfirmParam() string {
+	if e.Param == "" {
+		return ParamConfirm
+	}
+	return e.Param
+}
+
+// Error implements the error interface.
+func (e *ConfirmationError) Error() string {
+	what := fmt.Sprintf("%s:%s on %s", e.Request.Service, e.Request.Action.Name, e.Request.ResourceID)
+	if e.Environment != "" {
+		what += " in " + e.Environment
+	}
+	if e.Reason != "" {
+		what += ": " + e.Reason
+	}
+	if e.TypeResource {
+		return fmt.Sprintf("%s: type %s to confirm %s", ErrConfirmationRequired, e.Request.ResourceID, what)
+	}
+	if e.Justify {
+		return fmt.Sprintf("%s: set %s to confirm %s", ErrConfirmationRequired, e.ConfirmParam(), what)
+	}
+	return fmt.Sprintf("%s: %s", ErrConfirmationRequired, what)
+}
+
+// Unwrap returns ErrConfirmationRequired.
+func (e *ConfirmationError) Unwrap() error {
+	return ErrConfirmationRequired
+}
+
+// NewConfirmationError returns the error a service's Execute returns until
+// the caller confirmed an action, by typing resourceID back when
+// typeResource is set. Services check the answer with Confirmed.
+func NewConfirmationError(executor ActionExecutor, action, resourceID string, params map[string]any, reason string, typeResource bool) error {
+	req := ActionRequest{
+		Service:    executor.Name(),
+		Action:     Action{Name: action},
+		ResourceID: resourceID,
+		Params:     params,
+	}
+	for _, a := range executor.Actions() {
+		if a.Name == action {
+			req.Action = a
+			break
+		}
+	}
+	return &ConfirmationError{Request: req, TypeResource: typeResource, Reason: reason}
+}
+
+// Confirmed reports whether params confirm an action on resourceID:
+// ParamConfirm is set and, when typeResource is set, ParamConfirmResource
+// holds resourceID.
+func Confirmed(params map[string]any, resourceID string, typeResource bool) bool {
+	if confirmed, _ := params[ParamConfirm].(bool); !confirmed {
+		return false
+	}
+	if !typeResource {
+		return true
+	}
+	typed, _ := params[ParamConfirmResource].(string)
+	return typed != "" && typed == resourceID
+}
+
+var (
+	guardsMu sync.RWMutex
+	guards   []ActionGuard
+)
+
+// RegisterActionGuard adds a guard consulted by ExecuteAction. Guards run in
+// registration order.
+func RegisterActionGuard(guard ActionGuard) {
+	guardsMu.Lock()
+	defer guardsMu.Unlock()
+	guards = append(guards, guard)
+}
+
+// ResetActionGuards removes all registered guards.
+func ResetActionGuards() {
+	guardsMu.Lock()
+	defer guardsMu.Unlock()
+	guards = nil
+}
+
+// ExecuteAction runs an action after every registered guard has allowed it,
+// then tells the guards implementing ExecutionObserver whether it ran.
+// Interactive callers should use ExecuteAction rather than calling Execute
+// directly so that policies apply to every service. The same action on the
+// same resource as one still running, or one that succeeded within the
+// cooldown, is refused with an InProgressError; one with the idempotency
+// key of an execution that succeeded on it gets its result without running
+// again, and one with a key given to another action or resource is
+// refused with a ValidationError.
+func ExecuteAction(ctx context.Context, executor ActionExecutor, action, resourceID string, params map[string]any) (result *ActionResult, err error) {
+	req := ActionRequest{
+		Service:    executor.Name(),
+		Action:     Action{Name: action},
+		ResourceID: resourceID,
+		Params:     params,
+	}
+	for _, a := range executor.Actions() {
+		if a.Name == action {
+			req.Action = a
+			break
+		}
+	}
+
+	e := executionOf(req)
+	if done, err := beginExecution(e); done != nil || err != nil {
+		return done, err
+	}
+	defer func() { endExecution(e, req, result, err) }()
+
+	guardsMu.RLock()
+	active := guards
+	guardsMu.RUnlock()
+
+	for i, guard := range active {
+		if err := guard.Check(ctx, req); err != nil {
+			aborted(ctx, active[:i], req, err)
+			return nil, err
+		}
+	}
+	result, err = executor.Execute(ctx, action, resourceID, params)
+	if err != nil {
+		aborted(ctx, active, req, err)
+		return result, err
+	}
+	for _, guard := range active {
+		if observer, ok := guard.(ExecutionObserver); ok {
+			observer.ActionExecuted(ctx, req, result)
+		}
+	}
+	return result, nil
+}
+
+// aborted tells the guards implementing ExecutionObserver that an action
+// they allowed did not run.
+func aborted(ctx context.Context, allowed []ActionGuard, req ActionRequest, err error) {
+	for _, guard := range allowed {
+		if observer, ok := guard.(ExecutionObserver); ok {
+			observer.ActionAborted(ctx, req, err)
+		}
+	}
+}
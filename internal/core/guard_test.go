@@ -0,0 +1,43 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConfirmed(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       map[string]any
+		typeResource bool
+		want         bool
+	}{
+		{name: "unconfirmed", params: nil, want: false},
+		{name: "confirmed", params: map[string]any{ParamConfirm: true}, want: true},
+		{name: "confirmed without typing", params: map[string]any{ParamConfirm: true}, typeResource: true, want: false},
+		{name: "other resource typed", params: map[string]any{ParamConfirm: true, ParamConfirmResource: "vol-2"}, typeResource: true, want: false},
+		{name: "typed without confirming", params: map[string]any{ParamConfirmResource: "vol-1"}, typeResource: true, want: false},
+		{name: "typed", params: map[string]any{ParamConfirm: true, ParamConfirmResource: "vol-1"}, typeResource: true, want: true},
+	}
+	for _, tt := range tests {
+		if got := Confirmed(tt.params, "vol-1", tt.typeResource); got != tt.want {
+			t.Errorf("%s: Confirmed() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewConfirmationError(t *testing.T) {
+	err := NewConfirmationError(&fakeExecutor{}, "stop", "i-1", map[string]any{"force": true}, "Stops the instance", true)
+
+	var confirm *ConfirmationError
+	if !errors.As(err, &confirm) || !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("NewConfirmationError() = %v, want a ConfirmationError", err)
+	}
+	req := confirm.Request
+	if req.Service != "ec2" || req.Action.Category != "lifecycle" || req.ResourceID != "i-1" || req.Params["force"] != true {
+		t.Errorf("request = %+v, want ec2:stop on i-1 with its definition and params", req)
+	}
+	if !confirm.TypeResource || confirm.Reason != "Stops the instance" || confirm.ConfirmParam() != ParamConfirm {
+		t.Errorf("confirmation = %+v", confirm)
+	}
+}
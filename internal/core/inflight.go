@@ -0,0 +1,187 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Duplicate Executions
+// =============================================================================
+
+// DefaultActionCooldown is how long an action that succeeded on a resource
+// is refused again, so that a double keypress or a retried request does not
+// run it twice.
+const DefaultActionCooldown = 2 * time.Second
+
+// ParamIdempotencyKey identifies an execution. Callers retrying a request
+// pass the same key so that it runs once: a retry gets the result of the
+// execution that succeeded with the key, for IdempotencyTTL. A key only
+// names one action on one resource; reusing it for another is refused.
+// Keyed or not, an execution is refused while the same action runs on the
+// same resource, or during its cooldown.
+const ParamIdempotencyKey = "idempotency_key"
+
+// IdempotencyTTL is how long the result of an execution that succeeded with
+// an idempotency key is returned to retries with the same key.
+const IdempotencyTTL = 24 * time.Hour
+
+// readOnlyCategories are the action categories that change nothing, which
+// run again without waiting for the cooldown.
+var readOnlyCategories = []string{"inspect", "info"}
+
+// InProgressError is returned by ExecuteAction when the same execution is
+// still running, or succeeded less than the cooldown ago.
+type InProgressError struct {
+	Key      string
+	Since    time.Time // When the running execution started, or the last one finished
+	Finished bool      // The execution is over and within its cooldown
+}
+
+// Error implements the error interface.
+func (e *InProgressError) Error() string {
+	ago := time.Since(e.Since).Round(100 * time.Millisecond)
+	if e.Finished {
+		return fmt.Sprintf("%s: %s just ran (%s ago)", ErrActionInProgress, e.Key, ago)
+	}
+	return fmt.Sprintf("%s: %s (started %s ago)", ErrActionInProgress, e.Key, ago)
+}
+
+// Unwrap returns ErrActionInProgress.
+func (e *InProgressError) Unwrap() error {
+	return ErrActionInProgress
+}
+
+// execution identifies an execution: the action and resource it acts on,
+// and the idempotency key the caller gave, if any.
+type execution struct {
+	target string // service:action on resource
+	key    string // Idempotency key; empty when none was given
+}
+
+// keyedResult is the result of an execution that succeeded with an
+// idempotency key.
+type keyedResult struct {
+	target   string
+	result   ActionResult
+	finished time.Time
+}
+
+var (
+	inflightMu     sync.Mutex
+	inflight       = map[string]time.Time{}   // Start of running executions, by target
+	claimed        = map[string]string{}      // Targets of running executions, by idempotency key
+	cooling        = map[string]time.Time{}   // End of recent successful executions, by target
+	completed      = map[string]keyedResult{} // Successful executions, by idempotency key
+	actionCooldown = DefaultActionCooldown
+)
+
+// SetActionCooldown sets how long a successful action is refused again on
+// the same resource; zero only refuses executions still running.
+func SetActionCooldown(d time.Duration) {
+	inflightMu.Lock()
+	defer inflightMu.Unlock()
+	actionCooldown = max(0, d)
+}
+
+// ResetActionExecutions forgets running and recent executions.
+func ResetActionExecutions() {
+	inflightMu.Lock()
+	defer inflightMu.Unlock()
+	inflight = map[string]time.Time{}
+	claimed = map[string]string{}
+	cooling = map[string]time.Time{}
+	completed = map[string]keyedResult{}
+}
+
+// executionOf returns the execution a request starts.
+func executionOf(req ActionRequest) execution {
+	key, _ := req.Params[ParamIdempotencyKey].(string)
+	return execution{
+		target: fmt.Sprintf("%s:%s on %s", req.Service, req.Action.Name, req.ResourceID),
+		key:    key,
+	}
+}
+
+// reusedKeyError is returned when an idempotency key names another action
+// or resource than the execution it was first given to.
+func reusedKeyError(e execution, target string) error {
+	return NewValidationError(ParamIdempotencyKey, e.key, fmt.Sprintf("was given to %s, not %s", target, e.target))
+}
+
+// beginExecution marks e as running. It returns the result of the
+// execution that succeeded with e's idempotency key, an InProgressError
+// when e's target is already running or cooling down, and a
+// ValidationError when the key was given to another target.
+func beginExecution(e execution) (*ActionResult, error) {
+	inflightMu.Lock()
+	defer inflightMu.Unlock()
+
+	now := time.Now()
+	if started, ok := inflight[e.target]; ok {
+		return nil, &InProgressError{Key: e.target, Since: started}
+	}
+	if e.key != "" {
+		if target, ok := claimed[e.key]; ok {
+			return nil, reusedKeyError(e, target)
+		}
+		if done, ok := completed[e.key]; ok && now.Sub(done.finished) < IdempotencyTTL {
+			if done.target != e.target {
+				return nil, reusedKeyError(e, done.target)
+			}
+			result := done.result
+			return &result, nil
+		}
+		delete(completed, e.key)
+	}
+	if finished, ok := cooling[e.target]; ok {
+		if now.Sub(finished) < actionCooldown {
+			return nil, &InProgressError{Key: e.target, Since: finished, Finished: true}
+		}
+		delete(cooling, e.target)
+	}
+	inflight[e.target] = now
+	if e.key != "" {
+		claimed[e.key] = e.target
+	}
+	return nil, nil
+}
+
+// endExecution marks e as done, keeping the result of a keyed execution
+// that succeeded and starting its cooldown when it changed something.
+func endExecution(e execution, req ActionRequest, result *ActionResult, err error) {
+	inflightMu.Lock()
+	defer inflightMu.Unlock()
+
+	delete(inflight, e.target)
+	delete(claimed, e.key)
+	if err != nil || result == nil || !result.Success {
+		return
+	}
+	now := time.Now()
+	if e.key != "" {
+		completed[e.key] = keyedResult{target: e.target, result: *result, finished: now}
+		// Forget results past their TTL, so the map stays small
+		for k, done := range completed {
+			if now.Sub(done.finished) >= IdempotencyTTL {
+				delete(completed, k)
+			}
+		}
+	}
+	if actionCooldown == 0 {
+		return
+	}
+	for _, category := range readOnlyCategories {
+		if req.Action.Category == category {
+			return
+		}
+	}
+	cooling[e.target] = now
+	// Forget cooldowns that are over, so the map stays small
+	for k, finished := range cooling {
+		if now.Sub(finished) >= actionCooldown {
+			delete(cooling, k)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeExecutor counts executions, which block until release is closed when
+// set.
+type fakeExecutor struct {
+	runs    int
+	fail    bool
+	release chan struct{}
+}
+
+func (f *fakeExecutor) Name() string                                 { return "ec2" }
+func (f *fakeExecutor) Description() string                          { return "" }
+func (f *fakeExecutor) Icon() string                                 { return "" }
+func (f *fakeExecutor) Initialize(context.Context, *AWSConfig) error { return nil }
+func (f *fakeExecutor) Close() error                                 { return nil }
+func (f *fakeExecutor) HealthCheck(context.Context) error            { return nil }
+
+func (f *fakeExecutor) Actions() []Action {
+	return []Action{{Name: "stop", Category: "lifecycle"}, {Name: "describe", Category: "inspect"}}
+}
+
+func (f *fakeExecutor) Execute(_ context.Context, action, _ string, _ map[string]any) (*ActionResult, error) {
+	f.runs++
+	if f.release != nil {
+		<-f.release
+	}
+	if f.fail {
+		return nil, errors.New("throttled")
+	}
+	return &ActionResult{Success: true}, nil
+}
+
+func TestExecuteActionRefusesDuplicates(t *testing.T) {
+	t.Cleanup(ResetActionExecutions)
+	ctx := context.Background()
+	exec := &fakeExecutor{release: make(chan struct{})}
+
+	done := make(chan error)
+	go func() {
+		_, err := ExecuteAction(ctx, exec, "stop", "i-1", nil)
+		done <- err
+	}()
+	// Wait for the first execution to start
+	for {
+		inflightMu.Lock()
+		_, running := inflight["ec2:stop on i-1"]
+		inflightMu.Unlock()
+		if running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := ExecuteAction(ctx, exec, "stop", "i-1", nil)
+	var inProgress *InProgressError
+	if !errors.As(err, &inProgress) || inProgress.Finished || !errors.Is(err, ErrActionInProgress) {
+		t.Fatalf("second execution error = %v, want already in progress", err)
+	}
+	if _, err := ExecuteAction(ctx, &fakeExecutor{}, "stop", "i-2", nil); err != nil {
+		t.Errorf("other resource error = %v", err)
+	}
+
+	close(exec.release)
+	if err := <-done; err != nil {
+		t.Fatalf("first execution error = %v", err)
+	}
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-1", nil); !errors.As(err, &inProgress) || !inProgress.Finished {
+		t.Errorf("execution within the cooldown error = %v, want refused", err)
+	}
+	if exec.runs != 1 {
+		t.Errorf("stop ran %d times, want once", exec.runs)
+	}
+
+	// Read-only actions are not held back
+	for range 2 {
+		if _, err := ExecuteAction(ctx, exec, "describe", "i-1", nil); err != nil {
+			t.Errorf("describe error = %v", err)
+		}
+	}
+}
+
+func TestExecuteActionCooldown(t *testing.T) {
+	t.Cleanup(ResetActionExecutions)
+	t.Cleanup(func() { SetActionCooldown(DefaultActionCooldown) })
+	ctx := context.Background()
+
+	// Failures start no cooldown
+	exec := &fakeExecutor{fail: true}
+	for range 2 {
+		if _, err := ExecuteAction(ctx, exec, "stop", "i-1", nil); errors.Is(err, ErrActionInProgress) {
+			t.Fatalf("retry after a failure refused: %v", err)
+		}
+	}
+
+	// Without an idempotency key, the action runs again after the cooldown
+	exec = &fakeExecutor{}
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-2", nil); err != nil {
+		t.Fatalf("first execution error = %v", err)
+	}
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-2", nil); !errors.Is(err, ErrActionInProgress) {
+		t.Errorf("execution within the cooldown error = %v, want already in progress", err)
+	}
+
+	SetActionCooldown(10 * time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-2", nil); err != nil {
+		t.Errorf("execution after the cooldown error = %v", err)
+	}
+	if exec.runs != 2 {
+		t.Errorf("stop ran %d times, want twice", exec.runs)
+	}
+}
+
+func TestExecuteActionIdempotencyKey(t *testing.T) {
+	t.Cleanup(ResetActionExecutions)
+	t.Cleanup(func() { SetActionCooldown(DefaultActionCooldown) })
+	SetActionCooldown(10 * time.Millisecond)
+	ctx := context.Background()
+
+	// A failed execution is run again on retry
+	exec := &fakeExecutor{fail: true}
+	params := map[string]any{ParamIdempotencyKey: "req-41"}
+	for range 2 {
+		if _, err := ExecuteAction(ctx, exec, "stop", "i-1", params); err == nil || errors.Is(err, ErrActionInProgress) {
+			t.Fatalf("retry after a failure error = %v, want it run again", err)
+		}
+	}
+
+	exec = &fakeExecutor{}
+	params = map[string]any{ParamIdempotencyKey: "req-42"}
+	first, err := ExecuteAction(ctx, exec, "stop", "i-2", params)
+	if err != nil {
+		t.Fatalf("first execution error = %v", err)
+	}
+
+	// Retries past the cooldown get the first result
+	time.Sleep(20 * time.Millisecond)
+	retry, err := ExecuteAction(ctx, exec, "stop", "i-2", params)
+	if err != nil {
+		t.Fatalf("retry error = %v", err)
+	}
+	if retry == nil || *retry != *first {
+		t.Errorf("retry result = %+v, want %+v", retry, first)
+	}
+
+	// The key names stop on i-2: reusing it for another resource or action
+	// is refused rather than answered with that result
+	for _, other := range []struct{ action, id string }{{"stop", "i-3"}, {"describe", "i-2"}} {
+		var validation *ValidationError
+		if _, err := ExecuteAction(ctx, exec, other.action, other.id, params); !errors.As(err, &validation) || validation.Field != ParamIdempotencyKey {
+			t.Errorf("%s on %s with a reused key error = %v, want it refused", other.action, other.id, err)
+		}
+	}
+	if exec.runs != 1 {
+		t.Errorf("stop ran %d times, want once", exec.runs)
+	}
+
+	// A new key does not skip the cooldown of the resource
+	SetActionCooldown(time.Minute)
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-4", map[string]any{ParamIdempotencyKey: "req-43"}); err != nil {
+		t.Fatalf("execution on i-4 error = %v", err)
+	}
+	if _, err := ExecuteAction(ctx, exec, "stop", "i-4", map[string]any{ParamIdempotencyKey: "req-44"}); !errors.Is(err, ErrActionInProgress) {
+		t.Errorf("execution with a new key within the cooldown error = %v, want refused", err)
+	}
+}
+
+// TestExecuteActionKeyedInFlight checks that a keyed execution is refused
+// while the same action runs on the resource, and that its key cannot be
+// given to another resource meanwhile.
+func TestExecuteActionKeyedInFlight(t *testing.T) {
+	t.Cleanup(ResetActionExecutions)
+	ctx := context.Background()
+	exec := &fakeExecutor{release: make(chan struct{})}
+
+	done := make(chan error)
+	go func() {
+		_, err := ExecuteAction(ctx, exec, "stop", "i-1", nil)
+		done <- err
+	}()
+	for {
+		inflightMu.Lock()
+		_, running := inflight["ec2:stop on i-1"]
+		inflightMu.Unlock()
+		if running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := ExecuteAction(ctx, &fakeExecutor{}, "stop", "i-1", map[string]any{ParamIdempotencyKey: "req-1"}); !errors.Is(err, ErrActionInProgress) {
+		t.Errorf("keyed execution on a running resource error = %v, want already in progress", err)
+	}
+	close(exec.release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	claimed["req-2"] = "ec2:stop on i-2"
+	var validation *ValidationError
+	if _, err := ExecuteAction(ctx, &fakeExecutor{}, "stop", "i-3", map[string]any{ParamIdempotencyKey: "req-2"}); !errors.As(err, &validation) {
+		t.Errorf("key of a running execution given to i-3 error = %v, want refused", err)
+	}
+}
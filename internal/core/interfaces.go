@@ -43,6 +43,40 @@ type ResourceLister interface {
 	List(ctx context.Context, opts ListOptions) ([]Resource, error)
 }
 
+// ResourceStreamer provides the capability to list resources page by page.
+// Each page is delivered as an UpdateTypeAppend update so views can render
+// large accounts before the listing completes. The channel is closed when
+// listing finishes or the context is cancelled.
+type ResourceStreamer interface {
+	AWSService
+
+	// ListStream returns a channel of resource pages matching the given options
+	ListStream(ctx context.Context, opts ListOptions) (<-chan ResourceUpdate, error)
+}
+
+// ResourceEnricher provides the capability to add detailed analysis to a
+// resource after a fast initial listing. Enriched resources have their
+// "analyzed" metadata set to true.
+type ResourceEnricher interface {
+	AWSService
+
+	// EnrichResource adds detailed analysis to a single resource in place
+	EnrichResource(ctx context.Context, resource *Resource) error
+}
+
+// EnrichingLister provides the capability to list resources and stream their
+// enrichment. ListWithEnrichment first sends the basic listing as an
+// UpdateTypeBatch update, then one UpdateTypeSingle update per enriched
+// resource. The channel is closed when enrichment finishes or the context is
+// cancelled.
+type EnrichingLister interface {
+	ResourceLister
+	ResourceEnricher
+
+	// ListWithEnrichment returns a channel streaming the listing and its enrichment
+	ListWithEnrichment(ctx context.Context, opts ListOptions) (<-chan ResourceUpdate, error)
+}
+
 // ResourceGetter provides the capability to get a specific resource by ID.
 type ResourceGetter interface {
 	AWSService
@@ -51,6 +85,14 @@ type ResourceGetter interface {
 	Get(ctx context.Context, id string) (*Resource, error)
 }
 
+// TagReader provides the capability to read a resource's current tags.
+type TagReader interface {
+	AWSService
+
+	// Tags returns the tags of the resource with the given ID
+	Tags(ctx context.Context, id string) (map[string]string, error)
+}
+
 // ResourceMutator provides the capability to create, update, and delete resources.
 type ResourceMutator interface {
 	AWSService
@@ -76,6 +118,41 @@ type ActionExecutor interface {
 	Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*ActionResult, error)
 }
 
+// DetailFetcher provides the capability to fetch the heavy details of a
+// resource, such as policy documents, user data or object listings, when its
+// detail panel opens. They are not kept on the resource, so memory stays flat
+// with tens of thousands of resources listed.
+type DetailFetcher interface {
+	AWSService
+
+	// FetchDetail returns the details of a resource, section by section
+	FetchDetail(ctx context.Context, resource *Resource) ([]DetailSection, error)
+}
+
+// CleanupFlagger is implemented by services flagging cleanup candidates,
+// such as unattached volumes or idle instances, with the "should_cleanup"
+// metadata and why in "cleanup_reason". Resources listed unanalyzed are
+// only flagged once enriched.
+type CleanupFlagger interface {
+	ResourceLister
+
+	// FlagsCleanup marks the service as flagging cleanup candidates
+	FlagsCleanup()
+}
+
+// FindingsProvider supplies security findings for resources so other
+// services can enrich their own listings.
+type FindingsProvider interface {
+	// FindingsFor returns active findings for the resource with the given ARN
+	FindingsFor(ctx context.Context, resourceARN string) ([]Finding, error)
+}
+
+// OwnerProvider attributes resources to the principal that created them.
+type OwnerProvider interface {
+	// OwnerOf returns the creator of the resource; ok is false when unknown
+	OwnerOf(ctx context.Context, resource *Resource) (owner Owner, ok bool, err error)
+}
+
 // =============================================================================
 // TUI View Interfaces
 // =============================================================================
@@ -110,6 +187,51 @@ type View interface {
 	Error() error
 }
 
+// InputCapturer is implemented by views that can temporarily own keyboard
+// input, such as while a parameter form is open. Global shortcuts are
+// suppressed while CapturingInput returns true.
+type InputCapturer interface {
+	CapturingInput() bool
+}
+
+// BadgeProvider is implemented by views that summarize their resources in
+// the tab bar.
+type BadgeProvider interface {
+	Badge() Badge
+}
+
+// SummaryProvider is implemented by views whose summary line is made of
+// named widgets, so users can choose which appear and in what order.
+type SummaryProvider interface {
+	// SummaryWidgets returns every widget the view offers, in default order
+	SummaryWidgets() []SummaryWidget
+
+	// SetSummaryLayout shows the named widgets in that order; nil restores
+	// the default
+	SetSummaryLayout(names []string)
+}
+
+// StatefulView is implemented by views whose UI state, such as the selected
+// resource and filters, is restored when a9s restarts.
+type StatefulView interface {
+	SaveState() ViewState
+	RestoreState(state ViewState)
+}
+
+// ResourceSelector is implemented by views that can move their selection to
+// a resource, such as when another view links to it.
+type ResourceSelector interface {
+	// SelectResource selects the resource with the given ID or ARN, now or
+	// once a listing contains it
+	SelectResource(ref string)
+}
+
+// ShortcutSetter is implemented by views whose shortcut the registry may
+// reassign when the one they ask for is configured otherwise or taken.
+type ShortcutSetter interface {
+	SetShortcut(shortcut string)
+}
+
 // ViewFactory creates View instances for services.
 type ViewFactory interface {
 	// Create creates a new view for the given service
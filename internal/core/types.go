@@ -1,6 +1,12 @@
 package core
 
-import "time"
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
 
 // =============================================================================
 // AWS Configuration Types
@@ -12,6 +18,10 @@ type AWSConfig struct {
 	Region  string        `yaml:"region" json:"region"`
 	Timeout time.Duration `yaml:"timeout" json:"timeout"`
 	Retry   RetryConfig   `yaml:"retry" json:"retry"`
+
+	// Endpoint overrides the endpoint of every AWS service, to run against
+	// LocalStack or another emulator
+	Endpoint string `yaml:"endpoint,omitempty" json:"endpoint,omitempty"`
 }
 
 // RetryConfig configures AWS API retry behavior.
@@ -24,7 +34,10 @@ type RetryConfig struct {
 // Resource Types
 // =============================================================================
 
-// Resource represents any AWS resource with common attributes.
+// Resource represents any AWS resource with common attributes. Metadata
+// holds what the table shows, sorts and filters on; heavy fields such as
+// policy documents, user data or object listings are left out and fetched
+// when the detail panel opens, see DetailFetcher.
 type Resource struct {
 	ID        string            `json:"id"`
 	Type      string            `json:"type"` // e.g., "ec2:instance", "s3:bucket", "iam:role"
@@ -38,6 +51,12 @@ type Resource struct {
 	UpdatedAt *time.Time        `json:"updated_at,omitempty"`
 }
 
+// DetailSection is a titled part of the details fetched for a resource.
+type DetailSection struct {
+	Title string
+	Body  string
+}
+
 // GetTag returns a tag value by key, with a default if not found.
 func (r *Resource) GetTag(key, defaultValue string) string {
 	if r.Tags == nil {
@@ -100,6 +119,59 @@ type ListResult struct {
 	TotalCount int        `json:"total_count,omitempty"`
 }
 
+// Badge summarizes a view's resources next to its tab.
+type Badge struct {
+	Count      int              // Resources in the view
+	Severities map[Severity]int // Resources with issues, by highest severity
+	Spend      float64          // Estimated monthly spend in USD (0 when unknown)
+}
+
+// Flagged returns how many resources have issues.
+func (b Badge) Flagged() int {
+	total := 0
+	for _, n := range b.Severities {
+		total += n
+	}
+	return total
+}
+
+// Highest returns the most urgent severity among the flagged resources.
+func (b Badge) Highest() Severity {
+	for _, s := range Severities {
+		if b.Severities[s] > 0 {
+			return s
+		}
+	}
+	return SeverityNone
+}
+
+// SummaryWidget is a named counter on a view's summary line.
+type SummaryWidget struct {
+	Name   string      // Key naming the widget in tui.summary, e.g. "running"
+	Text   string      // Rendered text, e.g. "Running: 3"; empty widgets are skipped
+	Tone   SummaryTone // Style of the text
+	Hidden bool        // Only shown when tui.summary names it
+}
+
+// SummaryTone is the style a summary widget is rendered with.
+type SummaryTone string
+
+const (
+	ToneMuted   SummaryTone = "muted"
+	ToneInfo    SummaryTone = "info"
+	ToneSuccess SummaryTone = "success"
+	ToneWarning SummaryTone = "warning"
+	ToneError   SummaryTone = "error"
+)
+
+// ViewState is the part of a view's UI state kept across restarts.
+type ViewState struct {
+	Selected  string            `json:"selected,omitempty"` // ID of the selected resource
+	Filters   map[string]string `json:"filters,omitempty"`
+	SortBy    string            `json:"sort_by,omitempty"`
+	SortOrder SortOrder         `json:"sort_order,omitempty"`
+}
+
 // =============================================================================
 // Progressive Loading Types
 // =============================================================================
@@ -112,6 +184,8 @@ const (
 	UpdateTypeBatch UpdateType = iota
 	// UpdateTypeSingle indicates a single resource update (enrichment).
 	UpdateTypeSingle
+	// UpdateTypeAppend indicates a page of resources to append (streaming).
+	UpdateTypeAppend
 )
 
 // ResourceUpdate represents an update to resources during progressive loading.
@@ -120,6 +194,156 @@ type ResourceUpdate struct {
 	Resources []Resource // For batch updates
 	Resource  *Resource  // For single updates
 	Index     int        // Index of the resource being updated
+	Err       error      // Set when loading failed; no further updates follow
+}
+
+// =============================================================================
+// Finding Types
+// =============================================================================
+
+// Finding is a security or compliance observation about a resource,
+// such as external access detected by IAM Access Analyzer.
+type Finding struct {
+	ID           string         `json:"id"`
+	Source       string         `json:"source"` // e.g., "access-analyzer"
+	ResourceARN  string         `json:"resource_arn"`
+	ResourceType string         `json:"resource_type,omitempty"`
+	Title        string         `json:"title"`
+	Status       string         `json:"status,omitempty"`
+	Principals   []string       `json:"principals,omitempty"`
+	IsPublic     bool           `json:"is_public,omitempty"`
+	Details      map[string]any `json:"details,omitempty"`
+	UpdatedAt    *time.Time     `json:"updated_at,omitempty"`
+}
+
+// ApplyFindings records findings in the resource metadata under
+// "access_findings", "external_access", "external_principals" and
+// "public_access", and raises a critical issue for public access or a high
+// one for other external access.
+func (r *Resource) ApplyFindings(findings []Finding) {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]any)
+	}
+
+	var principals []string
+	isPublic := false
+	for _, f := range findings {
+		principals = append(principals, f.Principals...)
+		if f.IsPublic {
+			isPublic = true
+		}
+	}
+
+	r.Metadata["access_findings"] = findings
+	r.Metadata["external_access"] = len(findings) > 0
+	r.Metadata["external_principals"] = principals
+	r.Metadata["public_access"] = isPublic
+	switch {
+	case isPublic:
+		r.AddIssue(SeverityCritical, "Public access")
+	case len(findings) > 0:
+		r.AddIssue(SeverityHigh, "External access: "+strings.Join(principals, ", "))
+	}
+}
+
+// Owner is the principal that created a resource.
+type Owner struct {
+	Principal string     `json:"principal"`            // Display name, e.g. alice or Admin/alice
+	ARN       string     `json:"arn,omitempty"`        // Principal ARN
+	CreatedAt *time.Time `json:"created_at,omitempty"` // Time of the creating call
+}
+
+// ApplyOwner records the creator in the resource metadata under "owner"
+// and "owner_arn".
+func (r *Resource) ApplyOwner(o Owner) {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]any)
+	}
+	r.Metadata["owner"] = o.Principal
+	r.Metadata["owner_arn"] = o.ARN
+}
+
+// =============================================================================
+// Severity Types
+// =============================================================================
+
+// Severity ranks how urgently an issue needs attention.
+type Severity string
+
+const (
+	SeverityNone     Severity = ""
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Severities lists the severities from most to least urgent.
+var Severities = []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityInfo}
+
+// Rank orders severities: 0 for none up to 5 for critical.
+func (s Severity) Rank() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityLow:
+		return 2
+	case SeverityMedium:
+		return 3
+	case SeverityHigh:
+		return 4
+	case SeverityCritical:
+		return 5
+	}
+	return 0
+}
+
+// ParseSeverity validates a severity name.
+func ParseSeverity(s string) (Severity, error) {
+	sev := Severity(strings.ToLower(s))
+	if sev.Rank() == 0 {
+		return SeverityNone, fmt.Errorf("unknown severity %q (expected info, low, medium, high or critical)", s)
+	}
+	return sev, nil
+}
+
+// Issue is a problem found on a resource during analysis.
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// AddIssue records an issue in the resource metadata under "issues" and
+// raises "severity" to the highest severity recorded.
+func (r *Resource) AddIssue(severity Severity, message string) {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]any)
+	}
+	issues, _ := r.Metadata["issues"].([]Issue)
+	// Copy so resources sharing a metadata map are not affected
+	r.Metadata["issues"] = append(slices.Clip(issues), Issue{Severity: severity, Message: message})
+	if severity.Rank() > r.Severity().Rank() {
+		r.Metadata["severity"] = severity
+	}
+}
+
+// ClearIssues removes recorded issues, before a resource is analyzed again.
+func (r *Resource) ClearIssues() {
+	delete(r.Metadata, "issues")
+	delete(r.Metadata, "severity")
+}
+
+// Issues returns the issues recorded on the resource.
+func (r *Resource) Issues() []Issue {
+	issues, _ := r.GetMetadata("issues").([]Issue)
+	return issues
+}
+
+// Severity returns the highest severity of the resource's issues.
+func (r *Resource) Severity() Severity {
+	severity, _ := r.GetMetadata("severity").(Severity)
+	return severity
 }
 
 // =============================================================================
@@ -140,12 +364,36 @@ type Action struct {
 // ActionParameter defines a parameter for an action.
 type ActionParameter struct {
 	Name        string   `json:"name"`
-	Type        string   `json:"type"` // string, int, bool, select, duration
+	Type        string   `json:"type"` // string, text (multi-line), int, bool, select, duration
 	Required    bool     `json:"required"`
 	Default     any      `json:"default,omitempty"`
 	Options     []string `json:"options,omitempty"` // For select type
 	Description string   `json:"description,omitempty"`
 	Validation  string   `json:"validation,omitempty"` // Regex pattern
+	Sensitive   bool     `json:"sensitive,omitempty"`  // Kept out of events and approval requests
+}
+
+// Redacted replaces the values of sensitive parameters.
+const Redacted = "(redacted)"
+
+// RedactParams returns params with the values of the sensitive parameters
+// among parameters replaced by Redacted. params is returned as is when it
+// holds none.
+func RedactParams(params map[string]any, parameters []ActionParameter) map[string]any {
+	var redacted map[string]any
+	for _, p := range parameters {
+		if _, ok := params[p.Name]; !ok || !p.Sensitive {
+			continue
+		}
+		if redacted == nil {
+			redacted = maps.Clone(params)
+		}
+		redacted[p.Name] = Redacted
+	}
+	if redacted == nil {
+		return params
+	}
+	return redacted
 }
 
 // ActionResult contains the result of executing an action.
@@ -203,6 +451,25 @@ const (
 	EventActionExecuted EventType = "action.executed"
 	EventActionFailed   EventType = "action.failed"
 
+	// Approval events
+	EventApprovalRequested EventType = "approval.requested"
+	EventApprovalGranted   EventType = "approval.granted"
+	EventApprovalRejected  EventType = "approval.rejected"
+	EventApprovalUsed      EventType = "approval.used"
+
+	// Freeze events, dispatched when an operator overrides a maintenance
+	// window
+	EventFreezeOverridden EventType = "freeze.overridden"
+
+	// Preflight events, dispatched when the AWS context breaks or recovers
+	EventPreflightFailed EventType = "preflight.failed"
+	EventPreflightPassed EventType = "preflight.passed"
+
+	// Component events, dispatched by the DI container
+	EventComponentStarted EventType = "component.started"
+	EventComponentStopped EventType = "component.stopped"
+	EventComponentFailed  EventType = "component.failed"
+
 	// Plugin events
 	EventPluginLoaded   EventType = "plugin.loaded"
 	EventPluginUnloaded EventType = "plugin.unloaded"
@@ -222,6 +489,22 @@ const (
 	EventInfo    EventType = "info"
 )
 
+// AllEventTypes lists every event type a9s dispatches, for hooks observing
+// all of them.
+var AllEventTypes = []EventType{
+	EventServiceRegistered, EventServiceUnregistered, EventServiceHealthCheck,
+	EventResourceListed, EventResourceGet, EventResourceCreated, EventResourceUpdated, EventResourceDeleted,
+	EventActionStarted, EventActionExecuted, EventActionFailed,
+	EventApprovalRequested, EventApprovalGranted, EventApprovalRejected, EventApprovalUsed,
+	EventFreezeOverridden,
+	EventPreflightFailed, EventPreflightPassed,
+	EventComponentStarted, EventComponentStopped, EventComponentFailed,
+	EventPluginLoaded, EventPluginUnloaded, EventPluginError,
+	EventConfigChanged, EventConfigReloaded,
+	EventViewChanged, EventViewRefresh,
+	EventError, EventWarning, EventInfo,
+}
+
 // BaseEvent is a basic implementation of the Event interface.
 type BaseEvent struct {
 	eventType EventType
@@ -280,6 +563,21 @@ type ServiceEventData struct {
 	Error       string `json:"error,omitempty"`
 }
 
+// ComponentEventData contains data for component lifecycle events.
+type ComponentEventData struct {
+	Name     string        `json:"name"`
+	Phase    string        `json:"phase"` // start or stop
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// PreflightEventData contains data for preflight events.
+type PreflightEventData struct {
+	Profile  string   `json:"profile,omitempty"`
+	Region   string   `json:"region,omitempty"`
+	Problems []string `json:"problems,omitempty"`
+}
+
 // =============================================================================
 // State Constants
 // =============================================================================
@@ -298,7 +596,6 @@ const (
 	StateAvailable  = "available"
 	StateError      = "error"
 	StateUnknown    = "unknown"
-	StateWarning    = "warning"
 )
 
 // =============================================================================
@@ -0,0 +1,175 @@
+// Package crash captures panics in the TUI and turns them into crash reports
+// that users can attach to bug reports.
+//
+// A report holds the panic value and stack, the most recent events seen by
+// the dispatcher and a summary of the configuration with secrets redacted.
+// Reports are written to the state directory and bundled by `a9s bugreport`.
+package crash
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Redacted replaces secret values in configuration summaries.
+const Redacted = "[REDACTED]"
+
+// reportPrefix and reportExt name crash report files in the state directory.
+const (
+	reportPrefix = "crash-"
+	reportExt    = ".json"
+)
+
+// =============================================================================
+// Report
+// =============================================================================
+
+// Report is a crash report written to the state directory.
+type Report struct {
+	Time      time.Time `json:"time"`
+	Version   string    `json:"version"`
+	GoVersion string    `json:"go_version"`
+	OS        string    `json:"os"`
+	Arch      string    `json:"arch"`
+	Panic     string    `json:"panic"`
+	Stack     string    `json:"stack"`
+	Events    []Event   `json:"events,omitempty"`
+	Config    any       `json:"config,omitempty"`
+}
+
+// NewReport creates a report for a recovered panic.
+func NewReport(version string, p *Panic) *Report {
+	return &Report{
+		Time:      time.Now(),
+		Version:   version,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Panic:     fmt.Sprint(p.Value),
+		Stack:     string(p.Stack),
+	}
+}
+
+// Write saves the report in dir and returns its path.
+func (r *Report) Write(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode crash report: %w", err)
+	}
+
+	path := filepath.Join(dir, reportPrefix+r.Time.Format("20060102-150405")+reportExt)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write crash report: %w", err)
+	}
+	return path, nil
+}
+
+// Reports returns the paths of crash reports in dir, newest first.
+func Reports(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, reportPrefix+"*"+reportExt))
+	if err != nil {
+		return nil, err
+	}
+	// Timestamped names sort chronologically
+	sort.Sort(sort.Reverse(sort.StringSlice(paths)))
+	return paths, nil
+}
+
+// =============================================================================
+// Redaction
+// =============================================================================
+
+// secretKeys are substrings of setting names whose values are never written.
+var secretKeys = []string{"password", "secret", "token", "api_key", "apikey", "webhook", "credential", "private_key"}
+
+func isSecret(key string) bool {
+	// Header names such as X-Api-Key spell words with dashes
+	key = strings.ReplaceAll(strings.ToLower(key), "-", "_")
+	for _, s := range secretKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Summarize converts a configuration value into plain maps and slices keyed
+// by their mapstructure names, replacing secret values with Redacted.
+func Summarize(v any) any {
+	return summarize(reflect.ValueOf(v))
+}
+
+func summarize(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return summarize(v.Elem())
+
+	case reflect.Struct:
+		t := v.Type()
+		out := make(map[string]any, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Tag.Get("mapstructure")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			out[name] = summarizeField(name, v.Field(i))
+		}
+		return out
+
+	case reflect.Map:
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			out[key] = summarizeField(key, iter.Value())
+		}
+		return out
+
+	case reflect.Slice, reflect.Array:
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = summarize(v.Index(i))
+		}
+		return out
+	}
+
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	return v.Interface()
+}
+
+func summarizeField(name string, v reflect.Value) any {
+	// Map values of type any hold the value to check
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if isSecret(name) && v.IsValid() && !v.IsZero() {
+		return Redacted
+	}
+	return summarize(v)
+}
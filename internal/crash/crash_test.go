@@ -0,0 +1,138 @@
+package crash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+type notifyConfig struct {
+	WebhookURL string        `mapstructure:"webhook_url"`
+	Channel    string        `mapstructure:"channel"`
+	Timeout    time.Duration `mapstructure:"timeout"`
+}
+
+type testConfig struct {
+	Profile  string            `mapstructure:"profile"`
+	APIToken string            `mapstructure:"api_token"`
+	Notify   *notifyConfig     `mapstructure:"notify"`
+	Plugins  []map[string]any  `mapstructure:"plugins"`
+	Headers  map[string]string `mapstructure:"headers"`
+	Internal string            `mapstructure:"-"`
+	hidden   string
+}
+
+func TestSummarize(t *testing.T) {
+	cfg := testConfig{
+		Profile:  "prod",
+		APIToken: "tok-123",
+		Notify:   &notifyConfig{WebhookURL: "https://hooks.slack.com/x", Channel: "#ops", Timeout: 5 * time.Second},
+		Plugins:  []map[string]any{{"name": "audit", "client_secret": "s3cr3t", "password": ""}},
+		Headers:  map[string]string{"X-Api-Key": "k", "Accept": "json"},
+		Internal: "internal",
+		hidden:   "hidden",
+	}
+	summary, ok := Summarize(cfg).(map[string]any)
+	if !ok {
+		t.Fatalf("Summarize() = %T, want a map", Summarize(cfg))
+	}
+
+	tests := []struct {
+		path []string
+		want any
+	}{
+		{path: []string{"profile"}, want: "prod"},
+		{path: []string{"api_token"}, want: Redacted},
+		{path: []string{"notify", "webhook_url"}, want: Redacted},
+		{path: []string{"notify", "channel"}, want: "#ops"},
+		{path: []string{"notify", "timeout"}, want: "5s"},
+		{path: []string{"headers", "X-Api-Key"}, want: Redacted},
+		{path: []string{"headers", "Accept"}, want: "json"},
+	}
+	for _, tt := range tests {
+		var got any = summary
+		for _, key := range tt.path {
+			got = got.(map[string]any)[key]
+		}
+		if got != tt.want {
+			t.Errorf("%s = %v, want %v", strings.Join(tt.path, "."), got, tt.want)
+		}
+	}
+
+	plugin := summary["plugins"].([]any)[0].(map[string]any)
+	if plugin["client_secret"] != Redacted || plugin["name"] != "audit" {
+		t.Errorf("plugin = %v, want its secret redacted", plugin)
+	}
+	// Empty secrets stay empty, so a report shows they were not set
+	if plugin["password"] != "" {
+		t.Errorf("empty password = %v, want it left empty", plugin["password"])
+	}
+	for _, key := range []string{"-", "Internal", "hidden"} {
+		if _, ok := summary[key]; ok {
+			t.Errorf("summary has %q", key)
+		}
+	}
+
+	// No secret survives in the written report
+	data, err := json.Marshal(Summarize(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"tok-123", "hooks.slack.com", "s3cr3t"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("summary %s leaks %q", data, secret)
+		}
+	}
+}
+
+func TestRecorderKeepsLastEvents(t *testing.T) {
+	r := NewRecorder(3)
+	ctx := context.Background()
+	for i := range 5 {
+		_ = r.Handle(ctx, core.NewEvent(core.EventActionExecuted, "ec2", core.ActionEventData{
+			Action:     "terminate",
+			ResourceID: fmt.Sprintf("i-%d", i),
+			Params:     map[string]any{"password": "s3cr3t"},
+		}))
+	}
+
+	var got []string
+	for _, e := range r.Events() {
+		got = append(got, e.Resource)
+	}
+	if want := []string{"i-2", "i-3", "i-4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Events() = %v, want %v", got, want)
+	}
+
+	// Action parameters are never recorded
+	data, _ := json.Marshal(r.Events())
+	if strings.Contains(string(data), "s3cr3t") {
+		t.Errorf("events %s leak action parameters", data)
+	}
+}
+
+func TestReportsNewestFirst(t *testing.T) {
+	dir := t.TempDir()
+	p := &Panic{Value: "boom", Stack: []byte("goroutine 1")}
+	for _, at := range []string{"2026-10-15T09:00:00Z", "2026-10-16T09:00:00Z", "2026-10-14T09:00:00Z"} {
+		report := NewReport("1.0.0", p)
+		report.Time, _ = time.Parse(time.RFC3339, at)
+		if _, err := report.Write(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths, err := Reports(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 3 || !strings.HasSuffix(paths[0], "crash-20261016-090000.json") || !strings.HasSuffix(paths[2], "crash-20261014-090000.json") {
+		t.Errorf("Reports() = %v, want newest first", paths)
+	}
+}
@@ -0,0 +1,106 @@
+package crash
+
+import (
+	"runtime/debug"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Panic is a recovered panic with the stack of the goroutine that raised it.
+type Panic struct {
+	Value any
+	Stack []byte
+}
+
+// panicMsg reports a panic recovered in a command goroutine.
+type panicMsg struct {
+	panic *Panic
+}
+
+// guard wraps a model so that panics in Init, Update, View and the commands
+// they return stop the program cleanly instead of leaving the terminal raw.
+type guard struct {
+	model tea.Model
+	panic *Panic
+	quit  func()
+}
+
+// Run runs model in a Bubble Tea program, recovering from panics. The
+// terminal is restored before Run returns; the recovered panic, if any, is
+// returned for reporting.
+func Run(model tea.Model, opts ...tea.ProgramOption) (p *Panic, err error) {
+	g := &guard{model: model}
+	program := tea.NewProgram(g, append(opts, tea.WithoutCatchPanics())...)
+	g.quit = func() { go program.Quit() }
+
+	// Last resort for panics inside Bubble Tea itself
+	defer func() {
+		if r := recover(); r != nil {
+			program.Kill()
+			p = &Panic{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	_, err = program.Run()
+	return g.panic, err
+}
+
+func (g *guard) recover() {
+	if r := recover(); r != nil && g.panic == nil {
+		g.panic = &Panic{Value: r, Stack: debug.Stack()}
+		g.quit()
+	}
+}
+
+// Init implements tea.Model.
+func (g *guard) Init() tea.Cmd {
+	defer g.recover()
+	return g.wrap(g.model.Init())
+}
+
+// Update implements tea.Model.
+func (g *guard) Update(msg tea.Msg) (m tea.Model, cmd tea.Cmd) {
+	if p, ok := msg.(panicMsg); ok && g.panic == nil {
+		g.panic = p.panic
+	}
+	if g.panic != nil {
+		return g, tea.Quit
+	}
+
+	// Keep returning the guard if the wrapped model panics
+	m = g
+	defer g.recover()
+	g.model, cmd = g.model.Update(msg)
+	return g, g.wrap(cmd)
+}
+
+// View implements tea.Model.
+func (g *guard) View() string {
+	if g.panic != nil {
+		return ""
+	}
+	defer g.recover()
+	return g.model.View()
+}
+
+// wrap recovers panics in a command, including the commands of a batch.
+func (g *guard) wrap(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = panicMsg{panic: &Panic{Value: r, Stack: debug.Stack()}}
+			}
+		}()
+
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for i := range batch {
+				batch[i] = g.wrap(batch[i])
+			}
+		}
+		return msg
+	}
+}
@@ -0,0 +1,138 @@
+package crash
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// DefaultRecorderSize is the number of events kept for crash reports.
+const DefaultRecorderSize = 50
+
+// Event is the summary of a dispatched event kept in crash reports.
+// Payloads are reduced to identifiers so parameters never reach the report.
+type Event struct {
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Source   string    `json:"source"`
+	Action   string    `json:"action,omitempty"`
+	Resource string    `json:"resource,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Recorder is a hook that remembers the last events in a ring buffer.
+type Recorder struct {
+	mu     sync.Mutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// NewRecorder creates a recorder keeping the last size events.
+func NewRecorder(size int) *Recorder {
+	if size <= 0 {
+		size = DefaultRecorderSize
+	}
+	return &Recorder{events: make([]Event, size)}
+}
+
+// Ensure Recorder implements core.Hook
+var _ core.Hook = (*Recorder)(nil)
+
+// Name returns the hook name.
+func (r *Recorder) Name() string {
+	return "crash-recorder"
+}
+
+// EventTypes returns the event types this hook handles.
+func (r *Recorder) EventTypes() []core.EventType {
+	return []core.EventType{
+		core.EventServiceRegistered,
+		core.EventServiceUnregistered,
+		core.EventResourceListed,
+		core.EventResourceGet,
+		core.EventResourceCreated,
+		core.EventResourceUpdated,
+		core.EventResourceDeleted,
+		core.EventActionStarted,
+		core.EventActionExecuted,
+		core.EventActionFailed,
+		core.EventApprovalRequested,
+		core.EventApprovalGranted,
+		core.EventApprovalRejected,
+		core.EventApprovalUsed,
+		core.EventFreezeOverridden,
+		core.EventPreflightFailed,
+		core.EventPreflightPassed,
+		core.EventComponentStarted,
+		core.EventComponentStopped,
+		core.EventComponentFailed,
+		core.EventConfigChanged,
+		core.EventConfigReloaded,
+		core.EventViewChanged,
+		core.EventViewRefresh,
+		core.EventError,
+		core.EventWarning,
+		core.EventInfo,
+	}
+}
+
+// Priority returns the execution priority. The recorder runs last so it
+// never delays other hooks.
+func (r *Recorder) Priority() int {
+	return -100
+}
+
+// Handle records an event.
+func (r *Recorder) Handle(_ context.Context, event core.Event) error {
+	e := Event{
+		Time:   event.Timestamp(),
+		Type:   string(event.Type()),
+		Source: event.Source(),
+	}
+
+	switch d := event.Data().(type) {
+	case core.ActionEventData:
+		e.Action = d.Action
+		e.Resource = d.ResourceID
+		e.Error = d.Error
+	case core.ResourceEventData:
+		e.Resource = d.ResourceID
+		e.Error = d.Error
+	case core.ServiceEventData:
+		e.Error = d.Error
+	case core.ComponentEventData:
+		e.Resource = d.Name
+		e.Error = d.Error
+	case core.PreflightEventData:
+		e.Error = strings.Join(d.Problems, "; ")
+	case error:
+		e.Error = d.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events[r.next] = e
+	r.next = (r.next + 1) % len(r.events)
+	if r.next == 0 {
+		r.full = true
+	}
+	return nil
+}
+
+// Events returns the recorded events, oldest first.
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]Event(nil), r.events[:r.next]...)
+	}
+	events := make([]Event, 0, len(r.events))
+	events = append(events, r.events[r.next:]...)
+	return append(events, r.events[:r.next]...)
+}
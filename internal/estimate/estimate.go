@@ -0,0 +1,80 @@
+// Package estimate computes the age and estimated monthly cost of resources
+// and records them under standard metadata keys, so every view and report
+// shows them the same way.
+//
+// Services supply the cost from their own pricing hints; estimates are
+// on-demand list prices and ignore discounts, free tiers and data transfer.
+package estimate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Standard metadata keys.
+const (
+	// AgeKey holds the age of a resource, e.g. "3mo"
+	AgeKey = "age"
+	// MonthlyCostKey holds the estimated monthly cost in USD
+	MonthlyCostKey = "monthly_cost"
+)
+
+// HoursPerMonth is the average number of hours in a month, as used by AWS
+// pricing.
+const HoursPerMonth = 730
+
+// Monthly converts an hourly price to a monthly one.
+func Monthly(hourly float64) float64 {
+	return hourly * HoursPerMonth
+}
+
+// FormatAge renders a duration in its largest unit: "45m", "5h", "12d",
+// "3mo" or "2y".
+func FormatAge(d time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", max(0, int(d/time.Minute)))
+	case d < day:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	case d < 30*day:
+		return fmt.Sprintf("%dd", int(d/day))
+	case d < 365*day:
+		return fmt.Sprintf("%dmo", int(d/(30*day)))
+	}
+	return fmt.Sprintf("%dy", int(d/(365*day)))
+}
+
+// ApplyAge records the age of a resource at now under AgeKey. Resources
+// without a creation time are left unchanged.
+func ApplyAge(resource *core.Resource, now time.Time) {
+	if resource.CreatedAt == nil {
+		return
+	}
+	if resource.Metadata == nil {
+		resource.Metadata = make(map[string]any)
+	}
+	resource.Metadata[AgeKey] = FormatAge(now.Sub(*resource.CreatedAt))
+}
+
+// ApplyCost records the estimated monthly cost of a resource under
+// MonthlyCostKey.
+func ApplyCost(resource *core.Resource, monthly float64) {
+	if resource.Metadata == nil {
+		resource.Metadata = make(map[string]any)
+	}
+	resource.Metadata[MonthlyCostKey] = monthly
+}
+
+// MonthlyCost returns the estimated monthly cost of a resource, if known.
+func MonthlyCost(resource core.Resource) (float64, bool) {
+	monthly, ok := resource.Metadata[MonthlyCostKey].(float64)
+	return monthly, ok
+}
+
+// FormatCost renders a monthly cost, e.g. "$12.34".
+func FormatCost(monthly float64) string {
+	return fmt.Sprintf("$%.2f", monthly)
+}
@@ -0,0 +1,166 @@
+// Package export writes the full description of a single resource to a
+// JSON or YAML file, for tickets, diffs and reverse engineering into
+// infrastructure as code.
+//
+// A description is the resource as the service returns it afresh, with its
+// enrichment and the heavy details its detail panel fetches, such as policy
+// documents. Details holding JSON are embedded as structured values.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/output"
+)
+
+// Formats are the formats a resource can be exported in, the default first.
+var Formats = []core.OutputFormat{core.FormatYAML, core.FormatJSON}
+
+// Document is the exported description of a resource.
+type Document struct {
+	Service    string        `json:"service"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Resource   core.Resource `json:"resource"`
+	Details    []Detail      `json:"details,omitempty"`
+	// Warnings are the parts that could not be read afresh; the listed
+	// values are exported in their place
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Detail is a section of the details fetched for a resource. Its body is
+// structured when it holds JSON, text otherwise.
+type Detail struct {
+	Title string `json:"title"`
+	Body  any    `json:"body"`
+}
+
+// Describe reads the full description of a resource listed by svc: it is
+// read again when the service supports Get, enriched again when it
+// supports enrichment, and its details are fetched when it has any. Steps
+// that fail are recorded as warnings, the listed values being exported in
+// their place.
+func Describe(ctx context.Context, svc core.AWSService, listed core.Resource) Document {
+	// Enrichment writes to the metadata, which the view's copy shares
+	listed.Metadata = maps.Clone(listed.Metadata)
+	doc := Document{
+		Service:    svc.Name(),
+		ExportedAt: time.Now().UTC(),
+		Resource:   listed,
+	}
+
+	if getter, ok := svc.(core.ResourceGetter); ok {
+		fresh, err := getter.Get(ctx, listed.ID)
+		switch {
+		case err != nil:
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("get: %v", err))
+		case fresh != nil:
+			doc.Resource = merge(*fresh, listed)
+		}
+	}
+
+	if enricher, ok := svc.(core.ResourceEnricher); ok {
+		if err := enricher.EnrichResource(ctx, &doc.Resource); err != nil {
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("enrich: %v", err))
+		}
+	}
+
+	if fetcher, ok := svc.(core.DetailFetcher); ok {
+		sections, err := fetcher.FetchDetail(ctx, &doc.Resource)
+		if err != nil {
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("details: %v", err))
+		}
+		for _, section := range sections {
+			doc.Details = append(doc.Details, Detail{Title: section.Title, Body: body(section.Body)})
+		}
+	}
+	return doc
+}
+
+// merge completes a resource read afresh with what only the listing knows:
+// metadata added by enrichment or the view, and fields Get leaves empty.
+func merge(fresh, listed core.Resource) core.Resource {
+	metadata := make(map[string]any, len(fresh.Metadata)+len(listed.Metadata))
+	for k, v := range listed.Metadata {
+		metadata[k] = v
+	}
+	for k, v := range fresh.Metadata {
+		metadata[k] = v
+	}
+	fresh.Metadata = metadata
+
+	if fresh.ARN == "" {
+		fresh.ARN = listed.ARN
+	}
+	if fresh.Region == "" {
+		fresh.Region = listed.Region
+	}
+	if len(fresh.Tags) == 0 {
+		fresh.Tags = listed.Tags
+	}
+	if fresh.CreatedAt == nil {
+		fresh.CreatedAt = listed.CreatedAt
+	}
+	return fresh
+}
+
+// body returns a detail body as structured JSON when it holds some, such
+// as a policy document, and as trimmed text otherwise.
+func body(text string) any {
+	trimmed := strings.TrimSpace(text)
+	if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+		var compact bytes.Buffer
+		if json.Compact(&compact, []byte(trimmed)) == nil {
+			return json.RawMessage(compact.Bytes())
+		}
+	}
+	return trimmed
+}
+
+// =============================================================================
+// Files
+// =============================================================================
+
+// unsafeChars are the runs of characters replaced by a dash in file names.
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._]+`)
+
+// FileName returns the default file name of an export: the resource type
+// and name, such as ec2-instance-web-1.yaml.
+func FileName(r core.Resource, format core.OutputFormat) string {
+	name := r.Name
+	if name == "" {
+		name = r.ID
+	}
+	base := unsafeChars.ReplaceAllString(r.Type+"-"+name, "-")
+	return strings.Trim(base, "-.") + "." + string(format)
+}
+
+// Write renders a document in a format.
+func Write(doc Document, format core.OutputFormat) ([]byte, error) {
+	if format != core.FormatJSON && format != core.FormatYAML {
+		return nil, core.NewValidationError("format", string(format), "must be yaml or json")
+	}
+	var b bytes.Buffer
+	if err := output.Write(&b, format, output.Data{Value: doc}); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// WriteFile writes a document to path in a format. The file is readable
+// by its owner only, since details can hold policies and configuration.
+func WriteFile(path string, doc Document, format core.OutputFormat) error {
+	data, err := Write(doc, format)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,148 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// fakeService reads roles afresh, enriches them with their last use and
+// fetches their trust policy, or fails to read them when getErr is set.
+type fakeService struct {
+	getErr error
+}
+
+func (f *fakeService) Name() string                                      { return "iam" }
+func (f *fakeService) Description() string                               { return "IAM" }
+func (f *fakeService) Icon() string                                      { return "key" }
+func (f *fakeService) Initialize(context.Context, *core.AWSConfig) error { return nil }
+func (f *fakeService) Close() error                                      { return nil }
+func (f *fakeService) HealthCheck(context.Context) error                 { return nil }
+
+func (f *fakeService) Get(_ context.Context, id string) (*core.Resource, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	return &core.Resource{ID: id, Type: "iam:role", Name: id, Metadata: map[string]any{"path": "/service/"}}, nil
+}
+
+func (f *fakeService) EnrichResource(_ context.Context, r *core.Resource) error {
+	r.Metadata["last_used"] = "2026-10-01"
+	return nil
+}
+
+func (f *fakeService) FetchDetail(context.Context, *core.Resource) ([]core.DetailSection, error) {
+	return []core.DetailSection{
+		{Title: "Trust policy", Body: "{\n  \"Version\": \"2012-10-17\"\n}\n"},
+		{Title: "Notes", Body: "no inline policies\n"},
+	}, nil
+}
+
+func listedRole() core.Resource {
+	return core.Resource{
+		ID:       "deploy",
+		Type:     "iam:role",
+		Name:     "deploy",
+		ARN:      "arn:aws:iam::123456789012:role/service/deploy",
+		Metadata: map[string]any{"policies": 2},
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	listed := listedRole()
+	doc := Describe(context.Background(), &fakeService{}, listed)
+
+	if len(doc.Warnings) != 0 {
+		t.Errorf("warnings = %v", doc.Warnings)
+	}
+	r := doc.Resource
+	if r.ARN != listed.ARN || r.Metadata["path"] != "/service/" || r.Metadata["policies"] != 2 || r.Metadata["last_used"] != "2026-10-01" {
+		t.Errorf("resource = %+v, want the fresh, listed and enriched values", r)
+	}
+	if _, ok := listed.Metadata["last_used"]; ok {
+		t.Error("enrichment wrote to the listed resource")
+	}
+	if len(doc.Details) != 2 {
+		t.Fatalf("details = %+v", doc.Details)
+	}
+	if raw, ok := doc.Details[0].Body.(json.RawMessage); !ok || string(raw) != `{"Version":"2012-10-17"}` {
+		t.Errorf("JSON detail body = %#v, want structured JSON", doc.Details[0].Body)
+	}
+	if doc.Details[1].Body != "no inline policies" {
+		t.Errorf("text detail body = %#v", doc.Details[1].Body)
+	}
+}
+
+func TestDescribeFallsBackToListing(t *testing.T) {
+	listed := listedRole()
+	doc := Describe(context.Background(), &fakeService{getErr: errors.New("AccessDenied")}, listed)
+
+	if len(doc.Warnings) != 1 || !strings.Contains(doc.Warnings[0], "AccessDenied") {
+		t.Errorf("warnings = %v, want the failed get", doc.Warnings)
+	}
+	if doc.Resource.Metadata["policies"] != 2 || doc.Resource.Metadata["last_used"] != "2026-10-01" {
+		t.Errorf("resource = %+v, want the listed values, enriched", doc.Resource)
+	}
+	if _, ok := listed.Metadata["last_used"]; ok {
+		t.Error("enrichment wrote to the listed resource")
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	doc := Describe(context.Background(), &fakeService{}, listedRole())
+	dir := t.TempDir()
+
+	for _, format := range Formats {
+		path := filepath.Join(dir, FileName(doc.Resource, format))
+		if err := WriteFile(path, doc, format); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", format, err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var back map[string]any
+		if format == core.FormatJSON {
+			err = json.Unmarshal(data, &back)
+		} else {
+			err = yaml.Unmarshal(data, &back)
+		}
+		if err != nil {
+			t.Fatalf("%s export does not parse: %v\n%s", format, err, data)
+		}
+		details, _ := back["details"].([]any)
+		first, _ := details[0].(map[string]any)
+		policy, _ := first["body"].(map[string]any)
+		if back["service"] != "iam" || policy["Version"] != "2012-10-17" {
+			t.Errorf("%s export = %v", format, back)
+		}
+	}
+
+	if _, err := Write(doc, core.FormatCSV); err == nil {
+		t.Error("Write accepted CSV")
+	}
+}
+
+func TestFileName(t *testing.T) {
+	tests := []struct {
+		r    core.Resource
+		want string
+	}{
+		{core.Resource{Type: "ec2:instance", ID: "i-0abc", Name: "web 1"}, "ec2-instance-web-1.yaml"},
+		{core.Resource{Type: "logs:log-group", ID: "/aws/lambda/resize"}, "logs-log-group-aws-lambda-resize.yaml"},
+	}
+	for _, tt := range tests {
+		if got := FileName(tt.r, core.FormatYAML); got != tt.want {
+			t.Errorf("FileName(%+v) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+}
@@ -88,6 +88,15 @@ func NewAuditHook(enabled bool, opts ...AuditOption) *AuditHook {
 			core.EventActionExecuted,
 			core.EventActionFailed,
 
+			// Two-person approvals
+			core.EventApprovalRequested,
+			core.EventApprovalGranted,
+			core.EventApprovalRejected,
+			core.EventApprovalUsed,
+
+			// Maintenance window overrides
+			core.EventFreezeOverridden,
+
 			// Resource changes
 			core.EventResourceCreated,
 			core.EventResourceUpdated,
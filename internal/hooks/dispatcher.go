@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/keanuharrell/a9s/internal/core"
 )
@@ -22,8 +23,12 @@ type Dispatcher struct {
 	hooks       map[string]core.Hook
 	byEventType map[core.EventType][]core.Hook
 	middlewares []core.HookMiddleware
+	disabled    map[string]bool
 	async       bool
 	errorChan   chan error
+
+	statsMu sync.Mutex
+	stats   map[string]*HookStats
 }
 
 // Option configures the dispatcher.
@@ -42,6 +47,8 @@ func NewDispatcher(opts ...Option) *Dispatcher {
 	d := &Dispatcher{
 		hooks:       make(map[string]core.Hook),
 		byEventType: make(map[core.EventType][]core.Hook),
+		disabled:    make(map[string]bool),
+		stats:       make(map[string]*HookStats),
 	}
 
 	for _, opt := range opts {
@@ -92,6 +99,7 @@ func (d *Dispatcher) Unregister(name string) {
 
 	d.removeFromEventTypes(hook)
 	delete(d.hooks, name)
+	delete(d.disabled, name)
 }
 
 // removeFromEventTypes removes a hook from all event type indexes.
@@ -147,11 +155,16 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event core.Event) error {
 	return d.dispatchToHooks(ctx, event, hooks, middlewares)
 }
 
-// dispatchToHooks dispatches an event to a list of hooks.
+// dispatchToHooks dispatches an event to a list of hooks, skipping the
+// disabled ones, and records each execution.
 func (d *Dispatcher) dispatchToHooks(ctx context.Context, event core.Event, hooks []core.Hook, middlewares []core.HookMiddleware) error {
 	var errs []error
 
 	for _, hook := range hooks {
+		if !d.Enabled(hook.Name()) {
+			continue
+		}
+
 		// Build handler chain with middlewares
 		handler := hook.Handle
 
@@ -161,7 +174,10 @@ func (d *Dispatcher) dispatchToHooks(ctx context.Context, event core.Event, hook
 		}
 
 		// Execute handler
-		if err := handler(ctx, event); err != nil {
+		start := time.Now()
+		err := handler(ctx, event)
+		d.record(hook.Name(), start, err)
+		if err != nil {
 			errs = append(errs, fmt.Errorf("hook %s: %w", hook.Name(), err))
 		}
 	}
@@ -228,6 +244,125 @@ func (d *Dispatcher) HasHook(name string) bool {
 	return ok
 }
 
+// =============================================================================
+// Health
+// =============================================================================
+
+// HookStats describe a registered hook and its executions since startup.
+type HookStats struct {
+	Name       string
+	EventTypes []core.EventType
+	Priority   int
+	Enabled    bool
+
+	Executions  int
+	Errors      int
+	TotalTime   time.Duration
+	LastRun     time.Time
+	LastError   string
+	LastErrorAt time.Time
+}
+
+// ErrorRate returns the share of executions that failed, from 0 to 1.
+func (s HookStats) ErrorRate() float64 {
+	if s.Executions == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Executions)
+}
+
+// AverageTime returns the average duration of an execution.
+func (s HookStats) AverageTime() time.Duration {
+	if s.Executions == 0 {
+		return 0
+	}
+	return s.TotalTime / time.Duration(s.Executions)
+}
+
+// record counts an execution of a hook started at start.
+func (d *Dispatcher) record(name string, start time.Time, err error) {
+	elapsed := time.Since(start)
+
+	d.statsMu.Lock()
+	defer d.statsMu.Unlock()
+	stats, ok := d.stats[name]
+	if !ok {
+		stats = &HookStats{}
+		d.stats[name] = stats
+	}
+	stats.Executions++
+	stats.TotalTime += elapsed
+	stats.LastRun = start
+	if err != nil {
+		stats.Errors++
+		stats.LastError = err.Error()
+		stats.LastErrorAt = start
+	}
+}
+
+// Stats returns the registered hooks with their executions, highest
+// priority first. Counts survive a hook being registered again under the
+// same name.
+func (d *Dispatcher) Stats() []HookStats {
+	d.mu.RLock()
+	result := make([]HookStats, 0, len(d.hooks))
+	for name, hook := range d.hooks {
+		result = append(result, HookStats{
+			Name:       name,
+			EventTypes: hook.EventTypes(),
+			Priority:   hook.Priority(),
+			Enabled:    !d.disabled[name],
+		})
+	}
+	d.mu.RUnlock()
+
+	d.statsMu.Lock()
+	for i := range result {
+		if stats, ok := d.stats[result[i].Name]; ok {
+			result[i].Executions = stats.Executions
+			result[i].Errors = stats.Errors
+			result[i].TotalTime = stats.TotalTime
+			result[i].LastRun = stats.LastRun
+			result[i].LastError = stats.LastError
+			result[i].LastErrorAt = stats.LastErrorAt
+		}
+	}
+	d.statsMu.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Priority != result[j].Priority {
+			return result[i].Priority > result[j].Priority
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Enabled reports whether a hook runs when its events are dispatched.
+// Hooks are enabled when registered.
+func (d *Dispatcher) Enabled(name string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return !d.disabled[name]
+}
+
+// SetEnabled enables or disables a registered hook at runtime. A disabled
+// hook stays registered but is skipped until enabled again.
+func (d *Dispatcher) SetEnabled(name string, enabled bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.hooks[name]; !ok {
+		return fmt.Errorf("hook %s is not registered", name)
+	}
+	if enabled {
+		delete(d.disabled, name)
+	} else {
+		d.disabled[name] = true
+	}
+	return nil
+}
+
 // =============================================================================
 // Error Types
 // =============================================================================
@@ -0,0 +1,56 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+func TestStatsAndSetEnabled(t *testing.T) {
+	d := NewDispatcher()
+	ran := 0
+	d.Register(NewBaseHook("audit", []core.EventType{core.EventActionExecuted}, 10, func(context.Context, core.Event) error {
+		ran++
+		if ran == 2 {
+			return errors.New("disk full")
+		}
+		return nil
+	}))
+	d.Register(NewBaseHook("badges", []core.EventType{core.EventResourceListed}, 0, nil))
+
+	event := core.NewEvent(core.EventActionExecuted, "ec2", nil)
+	_ = d.Dispatch(context.Background(), event)
+	if err := d.Dispatch(context.Background(), event); err == nil {
+		t.Fatal("Dispatch() hid the hook failure")
+	}
+
+	stats := d.Stats()
+	if len(stats) != 2 || stats[0].Name != "audit" {
+		t.Fatalf("Stats() = %+v, want audit first by priority", stats)
+	}
+	audit := stats[0]
+	if audit.Executions != 2 || audit.Errors != 1 || audit.ErrorRate() != 0.5 || audit.LastError != "disk full" {
+		t.Errorf("audit stats = %+v", audit)
+	}
+
+	if err := d.SetEnabled("audit", false); err != nil {
+		t.Fatalf("SetEnabled() error = %v", err)
+	}
+	_ = d.Dispatch(context.Background(), event)
+	if ran != 2 || d.Stats()[0].Enabled {
+		t.Errorf("disabled hook ran %d times, stats %+v", ran, d.Stats()[0])
+	}
+	if err := d.SetEnabled("audit", true); err != nil {
+		t.Fatalf("SetEnabled() error = %v", err)
+	}
+	_ = d.Dispatch(context.Background(), event)
+	if ran != 3 {
+		t.Errorf("enabled hook ran %d times, want 3", ran)
+	}
+
+	if err := d.SetEnabled("missing", false); err == nil {
+		t.Error("SetEnabled() accepted an unknown hook")
+	}
+}
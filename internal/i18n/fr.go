@@ -0,0 +1,1361 @@
+package i18n
+
+func init() {
+	Register("fr", Catalog{
+		// Application chrome
+		"Loading...":                                 "Chargement...",
+		"⏳ Loading...":                               "⏳ Chargement...",
+		"Ready":                                      "Prêt",
+		"Refreshing...":                              "Actualisation...",
+		"Switched to %s / %s":                        "Basculé vers %s / %s",
+		"Updating AWS configuration...":              "Mise à jour de la configuration AWS...",
+		"No view for %s is enabled":                  "Aucune vue pour %s n'est activée",
+		"Select AWS Profile":                         "Choisir le profil AWS",
+		"Select AWS Region":                          "Choisir la région AWS",
+		"Select View":                                "Choisir une vue",
+		"%s: %s on %s failed: %s":                    "%s : échec de %s sur %s : %s",
+		"Shortcut conflicts: %s":                     "Conflits de raccourcis : %s",
+		"Unknown summary widgets: %s":                "Widgets de résumé inconnus : %s",
+		"%s has no %q (available: %s)":               "%s n'a pas de %q (disponibles : %s)",
+		"%s: [%s] is used by %s, using [%s]":         "%s : [%s] est utilisé par %s, [%s] à la place",
+		"%s: [%s] is used by %s, press : to open it": "%s : [%s] est utilisé par %s, appuyez sur : pour l'ouvrir",
+		"No services registered.":                    "Aucun service enregistré.",
+		" [?] Help ":                                 " [?] Aide ",
+		"🚀 a9s - AWS Terminal UI  ⎔ %s  ⎔ %s":        "🚀 a9s - Terminal AWS  ⎔ %s  ⎔ %s",
+		"[r] refresh  [P] profile  [G] region  [q] quit  [?] help": "[r] actualiser  [P] profil  [G] région  [q] quitter  [?] aide",
+		`🚀 a9s - The k9s for AWS
+
+Navigation:
+  [1-9]       Switch services
+  [:]         Choose a service
+  [Tab]       Next service
+  [r]         Refresh
+  [P]         Change profile
+  [G]         Change region
+  [n]         Note on selected resource
+  [. x]       Actions on selected resource
+  [K]         Run a runbook on selected resource
+  [W]         Open selected resource in AWS console
+  [E]         Export selected resource to a file
+  [o]         Sort by severity
+  [O]         Sort by column
+  [?]         Toggle help
+  [q]         Quit
+
+EC2: [s]tart [t]stop [b]reboot [m]odify type [i]mage [S]chedule [f]ilter [Enter]details [u]ser data
+IAM: [a]udit [p]olicies [s]imulate
+S3:  [a]nalyze [d]elete [D]confirm
+Lambda: [i]nvoke [c]onfig [v]reveal env [Enter]details [R]e-analyze
+Findings: [a]rchive [Enter]details
+Approvals: [a]pprove [x]reject [Enter]details
+
+Press [?] or [Esc] to close.`: `🚀 a9s - Le k9s pour AWS
+
+Navigation :
+  [1-9]       Changer de service
+  [:]         Choisir un service
+  [Tab]       Service suivant
+  [r]         Actualiser
+  [P]         Changer de profil
+  [G]         Changer de région
+  [n]         Note sur la ressource sélectionnée
+  [. x]       Actions sur la ressource sélectionnée
+  [K]         Lancer un runbook sur la ressource sélectionnée
+  [W]         Ouvrir la ressource dans la console AWS
+  [E]         Exporter la ressource dans un fichier
+  [o]         Trier par sévérité
+  [O]         Trier par colonne
+  [?]         Afficher/masquer l'aide
+  [q]         Quitter
+
+EC2 : [s] démarrer [t] arrêter [b] redémarrer [m] changer le type [i] image [S] planning [f] filtrer [Entrée] détails [u] user data
+IAM : [a] auditer [p] politiques [s] simuler
+S3 :  [a] analyser [d] supprimer [D] confirmer
+Lambda : [i] invoquer [c] configuration [v] révéler une variable [Entrée] détails [R] ré-analyser
+Findings : [a] archiver [Entrée] détails
+Approbations : [a] approuver [x] rejeter [Entrée] détails
+
+Appuyez sur [?] ou [Échap] pour fermer.`,
+
+		// Common
+		"Error: %v":               "Erreur : %v",
+		"Action failed: %v":       "Échec de l'action : %v",
+		"Action %s not supported": "Action %s non prise en charge",
+		"Canceled":                "Annulé",
+		"No actions in this view": "Aucune action dans cette vue",
+		"Actions on %s":           "Actions sur %s",
+		"%s on %s":                "%s sur %s",
+		"Asks for: %s":            "Demande : %s",
+		"Asks for confirmation":   "Demande confirmation",
+		"Copied %s":               "%s copié",
+		"Opened %s":               "%s ouvert",
+		"Full refresh...":         "Actualisation complète...",
+		"Analyzing %s...":         "Analyse de %s...",
+		"Analyzed %s":             "%s analysé",
+		"Analyzing... %d/%d":      "Analyse... %d/%d",
+		"Analyzing... %d/%d, slowed down by %s throttling": "Analyse... %d/%d, ralentie par la limitation de %s",
+		"Throttled by %s, try again in %s":                 "Limité par %s, réessayez dans %s",
+		"Throttled by %s, backing off %s... %d/%d":         "Limité par %s, pause de %s... %d/%d",
+		"⚠ Partial results: %d %s failed, [!] for details": "⚠ Résultats partiels : %d %s en échec, [!] pour les détails",
+		"Everything else is listed. Press [r] to retry.":   "Tout le reste est listé. Appuyez sur [r] pour réessayer.",
+		"Partial results":     "Résultats partiels",
+		"sources":             "sources",
+		"checks":              "contrôles",
+		"load balancer types": "types de répartiteurs",
+		"%d/%d: %s %s failed (attempt %d), retrying in %s  [esc] stop": "%d/%d : %s %s en échec (tentative %d), nouvel essai dans %s  [esc] arrêter",
+		"%d/%d: %s %s failed  [esc] stop":                              "%d/%d : %s %s en échec  [esc] arrêter",
+		"%d/%d: %s %s done  [esc] stop":                                "%d/%d : %s %s terminé  [esc] arrêter",
+		"Stopping the batch; [R] resumes it":                           "Arrêt du lot ; [R] pour le reprendre",
+		", %d attempts":                                                ", %d tentatives",
+		"\n%d items left. Press [R] to resume.":                        "\n%d éléments restants. Appuyez sur [R] pour reprendre.",
+		"%d left":                                                      "%d restants",
+		"Interrupted batch: %d left, [R] resumes":                      "Lot interrompu : %d restants, [R] pour reprendre",
+		"Loaded %d %s":                                                 "%d %s chargés",
+		"Loaded %d %s, analyzing...":                                   "%d %s chargés, analyse...",
+		"Found %d new %s, analyzing...":                                "%d nouveaux %s, analyse...",
+		"Refreshed %d %s":                                              "%d %s actualisés",
+		"Loading %s... %d so far":                                      "Chargement des %s... %d pour l'instant",
+		"Total: %d":                                                    "Total : %d",
+		"Issues: %d":                                                   "Problèmes : %d",
+		"Unused: %d":                                                   "Inutilisés : %d",
+		"Public: %d":                                                   "Publics : %d",
+		"External: %d":                                                 "Externes : %d",
+		"Yes":                                                          "Oui",
+		"No":                                                           "Non",
+		"Name":                                                         "Nom",
+		"Created":                                                      "Créé",
+		"Region":                                                       "Région",
+		"Type":                                                         "Type",
+		"State":                                                        "État",
+		"Public":                                                       "Public",
+		"External":                                                     "Externe",
+
+		// EC2
+		"instances":                "instances",
+		"EC2 Instances":            "Instances EC2",
+		"Loading EC2 instances...": "Chargement des instances EC2...",
+		"ID":                       "ID",
+		"Public IP":                "IP publique",
+		"Private IP":               "IP privée",
+		"AZ":                       "AZ",
+		"CPU 14d":                  "CPU 14j",
+		"Idle":                     "Inactive",
+		"Suggested":                "Suggéré",
+		"Controls":                 "Contrôles",
+		"IaC":                      "IaC",
+		"%s is managed by %s; changes made here will drift from its definition": "%s est géré par %s ; les modifications faites ici divergeront de sa définition",
+		"Owner":                               "Propriétaire",
+		"Running: %d":                         "En marche : %d",
+		"Stopped: %d":                         "Arrêtées : %d",
+		"Idle: %d":                            "Inactives : %d",
+		"Filter: %s":                          "Filtre : %s",
+		"Starting %s...":                      "Démarrage de %s...",
+		"Stopping %s...":                      "Arrêt de %s...",
+		"Rebooting %s...":                     "Redémarrage de %s...",
+		"Terminating %s...":                   "Résiliation de %s...",
+		"Loading details for %s...":           "Chargement des détails de %s...",
+		"Loading sensitive details for %s...": "Chargement des détails sensibles de %s...",
+		"Press 'U' to show user data and console output of %s (may contain secrets)": "Appuyez sur 'U' pour afficher les user data et la sortie console de %s (peut contenir des secrets)",
+		"Invalid filter: %v":                   "Filtre invalide : %v",
+		"Applying filters...":                  "Application des filtres...",
+		"Resizing %s (stop, modify, start)...": "Redimensionnement de %s (arrêt, modification, démarrage)...",
+		"Creating AMI from %s...":              "Création d'une AMI depuis %s...",
+		"Updating schedule of %s...":           "Mise à jour du planning de %s...",
+		"Describe failed: %v":                  "Échec de la description : %v",
+		"Instance %s (%s)":                     "Instance %s (%s)",
+		"Filter EC2 instances":                 "Filtrer les instances EC2",
+		"Change instance type of %s (%s)":      "Changer le type d'instance de %s (%s)",
+		"Create AMI from %s":                   "Créer une AMI depuis %s",
+		"Schedule %s (current: %s)":            "Planifier %s (actuel : %s)",
+		"e.g. state=running type=t3.micro tag:Env=prod (empty clears)": "ex. state=running type=t3.micro tag:Env=prod (vide pour effacer)",
+		"\nVolumes:\n":                   "\nVolumes :\n",
+		"\nSecurity Groups:\n":           "\nGroupes de sécurité :\n",
+		"\nUser Data:\n":                 "\nUser data :\n",
+		"\n\nConsole Output (latest):\n": "\n\nSortie console (dernière) :\n",
+		"\nPress 'U' in the list to include user data and console output.\n":                                                               "\nAppuyez sur 'U' dans la liste pour inclure les user data et la sortie console.\n",
+		"[s]tart  [t]stop  [b]reboot  [m]odify type  [i]mage  [S]chedule  [f]ilter  [Enter]details  [u]ser data  [↑/↓]navigate  [r]efresh": "[s] démarrer  [t] arrêter  [b] redémarrer  [m] type  [i] image  [S] planning  [f] filtrer  [Entrée] détails  [u] user data  [↑/↓] naviguer  [r] actualiser",
+
+		// IAM
+		"roles":                             "rôles",
+		"IAM Roles":                         "Rôles IAM",
+		"Loading IAM roles...":              "Chargement des rôles IAM...",
+		"Last Used":                         "Dernière utilisation",
+		"Policies":                          "Politiques",
+		"Risk":                              "Risque",
+		"Risk Reason":                       "Motif du risque",
+		"Critical":                          "Critique",
+		"High":                              "Élevée",
+		"Medium":                            "Moyenne",
+		"Info":                              "Info",
+		"None":                              "Aucune",
+		"Severity":                          "Sévérité",
+		"Sorted by severity":                "Trié par sévérité",
+		"Listing order":                     "Ordre de la liste",
+		"Sorted by %s":                      "Trié par %s",
+		"Sort rows":                         "Trier les lignes",
+		"Descending":                        "Décroissant",
+		"Ascending":                         "Croissant",
+		"Low":                               "Faible",
+		"High Risk: %d":                     "Risque élevé : %d",
+		"Auditing %s...":                    "Audit de %s...",
+		"Loading policies for %s...":        "Chargement des politiques de %s...",
+		"Role %s":                           "Rôle %s",
+		"Policies: %s":                      "Politiques : %s",
+		"Simulation canceled":               "Simulation annulée",
+		"Simulating %s...":                  "Simulation de %s...",
+		"Simulation not supported":          "Simulation non prise en charge",
+		"Simulate policy for %s":            "Simuler la politique de %s",
+		"Policy simulation: %s":             "Simulation de politique : %s",
+		"No evaluation results returned.\n": "Aucun résultat d'évaluation.\n",
+		"[Enter]details  [a]udit  [p]olicies  [s]imulate  [u]sers report  [r]efresh  [R]e-analyze  [↑/↓]nav": "[Entrée] détails  [a] auditer  [p] politiques  [s] simuler  [u] rapport des utilisateurs  [r] actualiser  [R] ré-analyser  [↑/↓] naviguer",
+		"Generating credential report...":                    "Génération du rapport d'identifiants...",
+		"Credential report":                                  "Rapport d'identifiants",
+		"\nExport it with: a9s access-report --output csv\n": "\nExportez-le avec : a9s access-report --output csv\n",
+
+		// S3
+		"buckets":                             "buckets",
+		"S3 Buckets":                          "Buckets S3",
+		"Loading S3 buckets...":               "Chargement des buckets S3...",
+		"Tagged":                              "Tagué",
+		"Cleanup":                             "Nettoyage",
+		"Analyzed: %d/%d":                     "Analysés : %d/%d",
+		"Cleanup: %d":                         "Nettoyage : %d",
+		"Press 'D' to confirm deletion of %s": "Appuyez sur 'D' pour confirmer la suppression de %s",
+		"Deleting %s...":                      "Suppression de %s...",
+		"Bucket %s":                           "Bucket %s",
+		"[Enter]objects  [a]nalyze  [d]elete  [space]mark  [D]elete marked  [r]efresh  [R]e-analyze  [↑/↓]nav": "[Entrée] objets  [a] analyser  [d] supprimer  [espace] marquer  [D] supprimer la sélection  [r] actualiser  [R] ré-analyser  [↑/↓] naviguer",
+
+		// Lambda
+		"functions":                   "fonctions",
+		"Lambda Functions":            "Fonctions Lambda",
+		"Loading Lambda functions...": "Chargement des fonctions Lambda...",
+		"Runtime":                     "Runtime",
+		"Memory":                      "Mémoire",
+		"Timeout":                     "Délai",
+		"Last Modified":               "Modifié",
+		"Invocations":                 "Invocations",
+		"Errors":                      "Erreurs",
+		"Throttles":                   "Limitations",
+		"p95":                         "p95",
+		"Age":                         "Âge",
+		"Est. $/mo":                   "Est. $/mois",
+		"Failing: %d":                 "En échec : %d",
+		"Est. $%.2f/mo":               "Est. %.2f $/mois",
+		"Invoking %s...":              "Invocation de %s...",
+		"Loading config for %s...":    "Chargement de la configuration de %s...",
+		"Revealing %v...":             "Révélation de %v...",
+		"Configuration of %s":         "Configuration de %s",
+		"Press 'c' to load the configuration of %s first":                                           "Appuyez d'abord sur 'c' pour charger la configuration de %s",
+		"%s has no environment variables":                                                           "%s n'a pas de variables d'environnement",
+		"Action reveal_env not supported":                                                           "Action reveal_env non prise en charge",
+		"Reveal environment variable of %s (audited)":                                               "Révéler une variable d'environnement de %s (audité)",
+		"\nEnvironment:\n":                                                                          "\nEnvironnement :\n",
+		"\nPress Esc, then 'v' to reveal a value (recorded in the audit log)\n":                     "\nAppuyez sur Échap puis 'v' pour révéler une valeur (enregistré dans le journal d'audit)\n",
+		"[Enter]details  [i]nvoke  [c]onfig  [v]reveal env  [↑/↓]navigate  [r]efresh  [R]e-analyze": "[Entrée] détails  [i] invoquer  [c] configuration  [v] révéler  [↑/↓] naviguer  [r] actualiser  [R] ré-analyser",
+		"Health":                             "Santé",
+		"Reserved":                           "Réservée",
+		"Triggers":                           "Déclencheurs",
+		"failing":                            "en échec",
+		"idle":                               "inactive",
+		"healthy":                            "saine",
+		"\nStill analyzing...\n":             "\nAnalyse en cours...\n",
+		"Reserved:    none (account pool)\n": "Réservée :   aucune (pool du compte)\n",
+		"Invoke %s":                          "Invoquer %s",
+		"Invocation of %s":                   "Invocation de %s",
+		"Action invoke not supported":        "Action invoke non prise en charge",
+		"\nResponse:\n":                      "\nRéponse :\n",
+		"\nLogs (last 4 KB):\n":              "\nJournaux (4 derniers Ko) :\n",
+		"\nTriggers:\n":                      "\nDéclencheurs :\n",
+
+		// RDS
+		"RDS Databases":            "Bases de données RDS",
+		"Loading RDS databases...": "Chargement des bases de données RDS...",
+		"databases":                "bases de données",
+		"Engine":                   "Moteur",
+		"Class":                    "Classe",
+		"Multi-AZ":                 "Multi-AZ",
+		"Storage":                  "Stockage",
+		"Used":                     "Utilisé",
+		"Connections":              "Connexions",
+		"Savings $/mo":             "Économies $/mois",
+		"Savings: $%.2f/mo":        "Économies : %.2f $/mois",
+		"yes":                      "oui",
+		"Database %s":              "Base de données %s",
+		"\nNot analyzed yet. Press [a] to analyze this database.\n": "\nPas encore analysée. Appuyez sur [a] pour analyser cette base de données.\n",
+		"\nRecommendations:\n": "\nRecommandations :\n",
+		"\nRDS cannot shrink allocated storage in place: use a blue/green deployment or migrate to a new instance.\n": "\nRDS ne peut pas réduire le stockage alloué sur place : utilisez un déploiement blue/green ou migrez vers une nouvelle instance.\n",
+		"Reboot %s":                     "Redémarrer %s",
+		"Snapshot %s":                   "Snapshot de %s",
+		"Creating snapshot of %s...":    "Création d'un snapshot de %s...",
+		"%d instances":                  "%d instances",
+		"Listing snapshots of %s...":    "Liste des snapshots de %s...",
+		"Snapshots of %s":               "Snapshots de %s",
+		"No snapshots.\n":               "Aucun snapshot.\n",
+		"automated backups disabled":    "sauvegardes automatiques désactivées",
+		"%d days retention":             "rétention de %d jours",
+		"restorable up to %s":           "restaurable jusqu'au %s",
+		"Restore %s to a point in time": "Restaurer %s à un instant donné",
+		"Starting restore of %s...":     "Lancement de la restauration de %s...",
+		"Point-in-time restore is only available for standalone instances":                                                                     "La restauration à un instant donné n'est disponible que pour les instances autonomes",
+		"[s]tart  s[t]op  re[b]oot  [S]napshot  [l]ist snapshots  [p]oint-in-time restore  [a]nalyze  [Enter]details  [r]efresh  [R]e-analyze": "[s] démarrer  [t] arrêter  [b] redémarrer  [S] snapshot  [l] lister les snapshots  [p] restauration à un instant donné  [a] analyser  [Entrée] détails  [r] actualiser  [R] réanalyser",
+
+		// Access Analyzer
+		"Access Analyzer Findings":            "Findings Access Analyzer",
+		"Loading Access Analyzer findings...": "Chargement des findings Access Analyzer...",
+		"Loaded %d active findings":           "%d findings actifs chargés",
+		"Active: %d":                          "Actifs : %d",
+		"Archiving finding %s...":             "Archivage du finding %s...",
+		"Finding %s":                          "Finding %s",
+		"Resource":                            "Ressource",
+		"Principal":                           "Principal",
+		"Actions":                             "Actions",
+		"Updated":                             "Mis à jour",
+		"Principals:\n":                       "Principaux :\n",
+		"\nActions:\n":                        "\nActions :\n",
+		"\nConditions:\n":                     "\nConditions :\n",
+		"[a]rchive  [Enter]details  [↑/↓]navigate  [r]efresh": "[a] archiver  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// Security Hub
+		"Security Hub Findings":               "Findings Security Hub",
+		"Loading Security Hub findings...":    "Chargement des findings Security Hub...",
+		"Title":                               "Titre",
+		"Product":                             "Produit",
+		"Workflow":                            "Workflow",
+		"Compliance":                          "Conformité",
+		"Critical: %d  High: %d":              "Critiques : %d  Élevées : %d",
+		"Medium: %d":                          "Moyennes : %d",
+		"Mark %s as notified":                 "Marquer %s comme notifié",
+		"Resolve %s":                          "Résoudre %s",
+		"Suppress %s":                         "Supprimer %s",
+		"Updating finding %s...":              "Mise à jour du finding %s...",
+		"No view lists %s resources":          "Aucune vue ne liste les ressources %s",
+		"\nRemediation:\n":                    "\nRemédiation :\n",
+		"\nPress [v] to view the resource.\n": "\nAppuyez sur [v] pour voir la ressource.\n",
+		"[N]otify  [x] resolve  [s]uppress  [v]iew resource  [Enter]details  [↑/↓]navigate  [r]efresh": "[N] notifier  [x] résoudre  [s] supprimer  [v] voir la ressource  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// Approvals
+		"Approval Requests":            "Demandes d'approbation",
+		"Loading approval requests...": "Chargement des demandes d'approbation...",
+		"Loaded %d pending requests":   "%d demandes en attente chargées",
+		"Pending: %d":                  "En attente : %d",
+		"Yours: %d":                    "Les vôtres : %d",
+		"Action":                       "Action",
+		"Requester":                    "Demandeur",
+		"Requested":                    "Demandé",
+		"Status":                       "Statut",
+		"(you)":                        "(vous)",
+		"Approving %s...":              "Approbation de %s...",
+		"Rejecting %s...":              "Rejet de %s...",
+		"Request %s":                   "Demande %s",
+		"Reject request %s":            "Rejeter la demande %s",
+		"\nParameters:\n":              "\nParamètres :\n",
+		"[a]pprove  [x]reject  [Enter]details  [↑/↓]navigate  [r]efresh": "[a] approuver  [x] rejeter  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// Exposure
+		"Public Exposure":                       "Exposition publique",
+		"Mapping public exposure...":            "Cartographie de l'exposition publique...",
+		"Service":                               "Service",
+		"Address":                               "Adresse",
+		"Open To Internet":                      "Ouvert sur Internet",
+		"Exposure of %s":                        "Exposition de %s",
+		"Found %d internet-reachable resources": "%d ressources accessibles depuis Internet",
+		"High or critical: %d":                  "Élevée ou critique : %d",
+		"\nIssues:\n":                           "\nProblèmes :\n",
+		"[Enter]details  [↑/↓]navigate  [r]efresh": "[Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// Coverage
+		"Commitment Coverage":                            "Couverture des engagements",
+		"Loading coverage from Cost Explorer...":         "Chargement de la couverture depuis Cost Explorer...",
+		"Loaded coverage of %d instance families":        "Couverture de %d familles d'instances chargée",
+		"Utilization unavailable: %v":                    "Utilisation indisponible : %v",
+		"Family":                                         "Famille",
+		"Instance Types":                                 "Types d'instance",
+		"Hours":                                          "Heures",
+		"RI Coverage":                                    "Couverture RI",
+		"SP Coverage":                                    "Couverture SP",
+		"Uncovered $/mo":                                 "Non couvert $/mois",
+		"Uncovered: $%.2f/mo":                            "Non couvert : %.2f $/mois",
+		"RI utilization: %.0f%%":                         "Utilisation RI : %.0f %%",
+		"SP utilization: %.0f%%":                         "Utilisation SP : %.0f %%",
+		"Unused RI: %.0f h":                              "RI inutilisées : %.0f h",
+		"Unused SP: $%.2f":                               "SP inutilisés : %.2f $",
+		"Coverage of %s":                                 "Couverture de %s",
+		"Loading purchase recommendations for %s...":     "Chargement des recommandations d'achat pour %s...",
+		"Purchase recommendations for %v":                "Recommandations d'achat pour %v",
+		"No purchase recommendations for this family.\n": "Aucune recommandation d'achat pour cette famille.\n",
+		"\nOne year, no upfront, based on the last 30 days of usage.\n":                 "\nUn an, sans paiement initial, d'après les 30 derniers jours d'utilisation.\n",
+		"\nLast 30 days, from Cost Explorer. Press [p] for purchase recommendations.\n": "\n30 derniers jours, d'après Cost Explorer. Appuyez sur [p] pour les recommandations d'achat.\n",
+		"[p]urchase recommendations  [Enter]details  [↑/↓]navigate  [r]efresh":          "[p] recommandations d'achat  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// NAT
+		"NAT Gateways":            "Passerelles NAT",
+		"Loading NAT gateways...": "Chargement des passerelles NAT...",
+		"gateways":                "passerelles",
+		"VPC":                     "VPC",
+		"GB/mo":                   "Go/mois",
+		"Subnets":                 "Sous-réseaux",
+		"Cross-AZ":                "Inter-AZ",
+		"Endpoints":               "Points de terminaison",
+		"OK":                      "OK",
+		"No %s":                   "Pas de %s",
+		"Hotspots: %d":            "Points chauds : %d",
+		"Data transfer of %s":     "Transfert de données de %s",
+		"Not analyzed yet. Press [a] to analyze this gateway.\n": "Pas encore analysée. Appuyez sur [a] pour analyser cette passerelle.\n",
+		"\nRouted subnets:\n":          "\nSous-réseaux routés :\n",
+		"\nVPC endpoint candidates:\n": "\nPoints de terminaison VPC candidats :\n",
+		"[a]nalyze  [Enter]paths  [↑/↓]navigate  [r]efresh  [R]e-analyze": "[a] analyser  [Entrée] chemins  [↑/↓] naviguer  [r] actualiser  [R] réanalyser",
+
+		// ENI
+		"Network Interfaces":            "Interfaces réseau",
+		"Loading network interfaces...": "Chargement des interfaces réseau...",
+		"Loaded %d network interfaces":  "%d interfaces réseau chargées",
+		"Unattached: %d":                "Non attachées : %d",
+		"Attached To":                   "Attachée à",
+		"Subnet":                        "Sous-réseau",
+		"Security Groups":               "Groupes de sécurité",
+		"Interface %s":                  "Interface %s",
+		"Detach %s from %s":             "Détacher %s de %s",
+		"Detaching %s...":               "Détachement de %s...",
+		"de[t]ach  [d]elete  [Enter]details  [↑/↓]navigate  [r]efresh": "[t] détacher  [d] supprimer  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// Topology
+		"VPC Topology":             "Topologie des VPC",
+		"Loading VPC topology...":  "Chargement de la topologie des VPC...",
+		"Loaded %d VPCs":           "%d VPC chargés",
+		"VPCs: %d":                 "VPC : %d",
+		"Peering links: %d":        "Appairages : %d",
+		"Blackhole routes: %d":     "Routes blackhole : %d",
+		"CIDR":                     "CIDR",
+		"Route Tables":             "Tables de routage",
+		"Peerings":                 "Appairages",
+		"TGW":                      "TGW",
+		"Blackholes":               "Blackholes",
+		"Topology of %s":           "Topologie de %s",
+		"Trace route from %s":      "Tracer la route depuis %s",
+		"Tracing route from %v...": "Traçage de la route depuis %v...",
+		"Route trace":              "Trace de route",
+		"\nPress [t] to trace a route from one of these subnets.\n": "\nAppuyez sur [t] pour tracer une route depuis l'un de ces sous-réseaux.\n",
+		"[t]race route  [Enter]topology  [↑/↓]navigate  [r]efresh":  "[t] tracer une route  [Entrée] topologie  [↑/↓] naviguer  [r] actualiser",
+
+		// SQS
+		"SQS Queues":                           "Files SQS",
+		"Loading queues...":                    "Chargement des files...",
+		"Loaded %d queues":                     "%d files chargées",
+		"Dead-lettered messages: %d":           "Messages en lettre morte : %d",
+		"Messages":                             "Messages",
+		"In Flight":                            "En cours",
+		"Dead-letter":                          "Lettre morte",
+		"Redrive":                              "Renvoi",
+		"Queue %s":                             "File %s",
+		"Peek at %s":                           "Consulter %s",
+		"Peeking at %s...":                     "Consultation de %s...",
+		"Redrive %s":                           "Renvoyer %s",
+		"Starting the redrive of %s...":        "Lancement du renvoi de %s...",
+		"Canceling the redrive of %s...":       "Annulation du renvoi de %s...",
+		"No messages are visible right now.\n": "Aucun message n'est visible pour l'instant.\n",
+		"Message %d of %d in %s":               "Message %d sur %d dans %s",
+		"[←/→] message  [y] copy body  [Y] copy message as JSON": "[←/→] message  [y] copier le corps  [Y] copier le message en JSON",
+		"Message attributes:":             "Attributs du message :",
+		"System attributes:":              "Attributs système :",
+		"SNS notification from %s":        "Notification SNS de %s",
+		"Notification attributes:":        "Attributs de la notification :",
+		"Copied the body of message %s":   "Corps du message %s copié",
+		"Copied message %s as JSON":       "Message %s copié en JSON",
+		"… (%d more bytes)":               "… (%d octets de plus)",
+		"Oldest":                          "Plus ancien",
+		"Send a test message to %s":       "Envoyer un message de test à %s",
+		"Sending a test message to %s...": "Envoi d'un message de test à %s...",
+		"Purging %s...":                   "Purge de %s...",
+		"[p]eek  [s]end test  [X] purge  [m]ove back (redrive)  [w]atch redrive  [x] cancel redrive  [Enter]details  [r]efresh": "[p] consulter  [s] envoyer un test  [X] purger  [m] renvoyer  [w] suivre le renvoi  [x] annuler le renvoi  [Entrée] détails  [r] actualiser",
+
+		// ECS
+		"ECS Clusters":                        "Clusters ECS",
+		"Loading clusters...":                 "Chargement des clusters...",
+		"Loading %s...":                       "Chargement de %s...",
+		"Loaded %d clusters":                  "%d clusters chargés",
+		"Loaded %d services":                  "%d services chargés",
+		"Loaded %d tasks":                     "%d tâches chargées",
+		"Services":                            "Services",
+		"Running":                             "En cours",
+		"Pending":                             "En attente",
+		"Instances":                           "Instances",
+		"Capacity":                            "Capacité",
+		"Insights":                            "Insights",
+		"Tasks":                               "Tâches",
+		"Rollout":                             "Déploiement",
+		"%s tasks":                            "Tâches de %s",
+		"Service %s":                          "Service %s",
+		"Scale %s":                            "Mettre à l'échelle %s",
+		"Scaling %s...":                       "Mise à l'échelle de %s...",
+		"Redeploying %s...":                   "Redéploiement de %s...",
+		"Stopping task %s...":                 "Arrêt de la tâche %s...",
+		"\nDeployments:\n":                    "\nDéploiements :\n",
+		"\nEvents:\n":                         "\nÉvénements :\n",
+		"Services: %d":                        "Services : %d",
+		"Running tasks: %d":                   "Tâches en cours : %d",
+		"Deploying: %d":                       "En déploiement : %d",
+		"Degraded: %d":                        "Dégradés : %d",
+		"[Enter]services  [t]asks  [r]efresh": "[Entrée] services  [t] tâches  [r] actualiser",
+		"[Enter]tasks  [S]cale  [f]orce deployment  [i]nfo  [Esc]back  [r]efresh": "[Entrée] tâches  [S] mettre à l'échelle  [f] forcer un déploiement  [i] infos  [Échap] retour  [r] actualiser",
+		"Clusters: %d":                       "Clusters : %d",
+		"Exec enabled: %d":                   "Exec activé : %d",
+		"Task":                               "Tâche",
+		"Cluster":                            "Cluster",
+		"Definition":                         "Définition",
+		"Containers":                         "Conteneurs",
+		"Launch":                             "Lancement",
+		"Exec":                               "Exec",
+		"Task %s":                            "Tâche %s",
+		"\nContainers:\n":                    "\nConteneurs :\n",
+		"Exec in %s":                         "Exec dans %s",
+		"Starting a session in %s...":        "Ouverture d'une session dans %s...",
+		"Session in %s ended":                "Session dans %s terminée",
+		"Session in %s ended: %v":            "Session dans %s terminée : %v",
+		"ECS Exec is not enabled on task %s": "ECS Exec n'est pas activé sur la tâche %s",
+		"%s is not installed; it is needed to attach to ECS Exec sessions":        "%s n'est pas installé ; il est nécessaire pour rejoindre les sessions ECS Exec",
+		"[s]hell  [e]xec command  [x] stop  [Enter]details  [Esc]back  [r]efresh": "[s] shell  [e] exécuter une commande  [x] arrêter  [Entrée] détails  [Échap] retour  [r] actualiser",
+
+		// EKS
+		"EKS Clusters":                    "Clusters EKS",
+		"Loading EKS clusters...":         "Chargement des clusters EKS...",
+		"clusters":                        "clusters",
+		"Version":                         "Version",
+		"Endpoint":                        "Point de terminaison",
+		"Nodegroups":                      "Groupes de nœuds",
+		"Nodes":                           "Nœuds",
+		"Add-ons":                         "Modules",
+		"Add %s to kubeconfig":            "Ajouter %s au kubeconfig",
+		"Tag %s":                          "Étiqueter %s",
+		"Writing the kubeconfig of %s...": "Écriture du kubeconfig de %s...",
+		"Tagging %s...":                   "Étiquetage de %s...",
+		"Cluster %s":                      "Cluster %s",
+		"\nNodegroups:\n":                 "\nGroupes de nœuds :\n",
+		"\nAdd-ons:\n":                    "\nModules :\n",
+		"\nTags:\n":                       "\nÉtiquettes :\n",
+		"  none\n":                        "  aucun\n",
+		"Nodes: %d":                       "Nœuds : %d",
+		"Public endpoints: %d":            "Points de terminaison publics : %d",
+		"[c] kubeconfig  [t]ag  [a]nalyze  [Enter]describe  [r]efresh  [R]e-analyze": "[c] kubeconfig  [t] étiqueter  [a] analyser  [Entrée] décrire  [r] actualiser  [R] ré-analyser",
+
+		// CloudFormation
+		"CloudFormation Stacks":                  "Piles CloudFormation",
+		"Loading stacks...":                      "Chargement des piles...",
+		"Loaded %d stacks":                       "%d piles chargées",
+		"With change sets: %d":                   "Avec jeux de modifications : %d",
+		"Failed: %d":                             "En échec : %d",
+		"Drift":                                  "Dérive",
+		"Change Sets":                            "Jeux de modif.",
+		"Description":                            "Description",
+		"Stack %s":                               "Pile %s",
+		"Loading the template of %s...":          "Chargement du modèle de %s...",
+		"Loading change set %v...":               "Chargement du jeu de modifications %v...",
+		"Checking change set %v...":              "Vérification du jeu de modifications %v...",
+		"%s has no change sets":                  "%s n'a aucun jeu de modifications",
+		"%s has no change set ready to execute":  "%s n'a aucun jeu de modifications prêt à être exécuté",
+		"Preview a change set of %s":             "Prévisualiser un jeu de modifications de %s",
+		"Execute a change set of %s":             "Exécuter un jeu de modifications de %s",
+		"Change set %s":                          "Jeu de modifications %s",
+		"The stack has no change sets.\n":        "La pile n'a aucun jeu de modifications.\n",
+		"replaced":                               "remplacée",
+		"may be replaced":                        "peut être remplacée",
+		"recreation: %s":                         "recréation : %s",
+		"Detecting drift on %s...":               "Détection de la dérive de %s...",
+		"Loading the events of %s...":            "Chargement des événements de %s...",
+		"Drift of %s":                            "Dérive de %s",
+		"The stack has no events.\n":             "La pile n'a aucun événement.\n",
+		"Every resource matches the template.\n": "Toutes les ressources correspondent au modèle.\n",
+		"[t]emplate  [e]vents  [D]rift  [c]hange sets  [d]iff change set  e[x]ecute change set  [X] delete  [Enter]details  [r]efresh": "[t] modèle  [e] événements  [D] dérive  [c] jeux de modifications  [d] différences  [x] exécuter  [X] supprimer  [Entrée] détails  [r] actualiser",
+
+		// EventBridge Scheduler
+		"EventBridge Schedules": "Planifications EventBridge",
+		"Loading schedules...":  "Chargement des planifications...",
+		"Loaded %d schedules":   "%d planifications chargées",
+		"Next hour: %d":         "Dans l'heure : %d",
+		"Paused: %d":            "En pause : %d",
+		"Group":                 "Groupe",
+		"Schedule":              "Planification",
+		"Next Run":              "Prochaine exécution",
+		"Target":                "Cible",
+		"%s (in %s)":            "%s (dans %s)",
+		"Schedule %s":           "Planification %s",
+		"Pausing %s...":         "Mise en pause de %s...",
+		"Resuming %s...":        "Reprise de %s...",
+		"[p]ause  [e]nable  e[x]ecute now  [Enter]details  [r]efresh": "[p] pause  [e] activer  [x] exécuter maintenant  [Entrée] détails  [r] actualiser",
+
+		// DynamoDB
+		"Billing":  "Facturation",
+		"RCU/WCU":  "RCU/WCU",
+		"Items":    "Éléments",
+		"Size":     "Taille",
+		"GSIs":     "GSI",
+		"PITR":     "PITR",
+		"Peak use": "Pic d'usage",
+		"tables":   "tables",
+		"Enabling point-in-time recovery on %s...": "Activation de la restauration à un instant donné sur %s...",
+		"Describing %s...":                         "Description de %s...",
+		"Table %s":                                 "Table %s",
+		"Loading DynamoDB tables...":               "Chargement des tables DynamoDB...",
+		"[i]tems  [p]itr  [d]elete  [a]nalyze  [Enter]describe  [r]efresh  [R]e-analyze": "[i] éléments  [p] PITR  [d] supprimer  [a] analyser  [Entrée] décrire  [r] actualiser  [R] réanalyser",
+		"Point-in-time recovery: disabled (press [p] to enable)\n":                       "Restauration à un instant donné : désactivée (appuyez sur [p] pour l'activer)\n",
+		"\nGlobal secondary indexes:\n":                                                  "\nIndex secondaires globaux :\n",
+		"\nLocal secondary indexes:\n":                                                   "\nIndex secondaires locaux :\n",
+		"DynamoDB Tables":                                                                "Tables DynamoDB",
+		"On-demand: %d":                                                                  "À la demande : %d",
+		"Query %s":                                                                       "Requête sur %s",
+		"Querying %s...":                                                                 "Requête sur %s...",
+		"[←/→] item  [[/]] page  [y] copy as JSON  [u]pdate attribute  [x] delete item": "[←/→] élément  [[/]] page  [y] copier en JSON  [u] modifier un attribut  [x] supprimer l'élément",
+		"No items on this page.\n":                                   "Aucun élément sur cette page.\n",
+		"No items matched on this page; press ] for the next one.\n": "Aucun élément ne correspond sur cette page ; appuyez sur ] pour la suivante.\n",
+		"Page %d of %s":                   "Page %d de %s",
+		"Item %d of %d%s, page %d, in %s": "Élément %d sur %d%s, page %d, dans %s",
+		"This is the last page":           "C'est la dernière page",
+		"This is the first page":          "C'est la première page",
+		"Copied item %d as JSON":          "Élément %d copié en JSON",
+		"Deleting the item...":            "Suppression de l'élément...",
+		"Update item %d in %s":            "Modifier l'élément %d dans %s",
+		"Updating the item...":            "Modification de l'élément...",
+
+		// CloudWatch Logs view
+		"CloudWatch Log Groups":  "Groupes de journaux CloudWatch",
+		"Retention":              "Rétention",
+		"Stored":                 "Stocké",
+		"Filters":                "Filtres",
+		"never":                  "jamais",
+		"%dd":                    "%d j",
+		"Stored: %s":             "Stocké : %s",
+		"Never expire: %d":       "Sans expiration : %d",
+		"Loading log groups...":  "Chargement des groupes de journaux...",
+		"Loaded %d log groups":   "%d groupes de journaux chargés",
+		"Log group %s":           "Groupe de journaux %s",
+		"Tail %s":                "Suivre %s",
+		"Tail of %s":             "Suivi de %s",
+		"Starting to tail %s...": "Démarrage du suivi de %s...",
+		"Stopped tailing %s":     "Suivi de %s arrêté",
+		"stopped: %v":            "arrêté : %v",
+		"live":                   "en direct",
+		"polling every %s":       "interrogation toutes les %s",
+		"filter: %s":             "filtre : %s",
+		"sampled, more events matched than Live Tail sends": "échantillonné, plus d'événements correspondent que Live Tail n'en envoie",
+		"… later history skipped, live events follow":       "… historique plus récent ignoré, les événements en direct suivent",
+		"[t]ail  [Enter]details  [r]efresh":                 "[t] suivre  [Entrée] détails  [r] actualiser",
+
+		// Parameter diff
+		"Key":                    "Clé",
+		"Left":                   "Gauche",
+		"Right":                  "Droite",
+		"Key %s":                 "Clé %s",
+		"Invalid comparison: %v": "Comparaison invalide : %v",
+		"Compared %d keys":       "%d clés comparées",
+		"Press [c] to choose the prefixes to compare.":                 "Appuyez sur [c] pour choisir les préfixes à comparer.",
+		"Comparing %s with %s...":                                      "Comparaison de %s avec %s...",
+		"SSM parameters or Secrets Manager secrets":                    "Paramètres SSM ou secrets Secrets Manager",
+		"Left prefix, e.g. /app/staging":                               "Préfixe de gauche, ex. /app/staging",
+		"Right prefix, e.g. /app/prod":                                 "Préfixe de droite, ex. /app/prod",
+		"AWS profile of the left side (current when empty)":            "Profil AWS du côté gauche (l'actuel si vide)",
+		"AWS profile of the right side (current when empty)":           "Profil AWS du côté droit (l'actuel si vide)",
+		"Compare parameters or secrets":                                "Comparer des paramètres ou des secrets",
+		"missing in %s":                                                "absent de %s",
+		"differs":                                                      "différent",
+		"same":                                                         "identique",
+		"  (missing)\n":                                                "  (absent)\n",
+		"\nValues are masked; equal fingerprints mean equal values.\n": "\nLes valeurs sont masquées ; des empreintes égales signifient des valeurs égales.\n",
+		"Parameter Diff":                                               "Différences de paramètres",
+		"Secret Diff":                                                  "Différences de secrets",
+		"Missing: %d":                                                  "Absentes : %d",
+		"Differ: %d":                                                   "Différentes : %d",
+		"Same: %d":                                                     "Identiques : %d",
+		"[c]ompare  s[w]ap sides  [a]ll keys  [Enter]details  [r]efresh": "[c] comparer  [w] inverser les côtés  [a] toutes les clés  [Entrée] détails  [r] actualiser",
+
+		// Account baselines
+		"Account Baselines":            "Références du compte",
+		"Check":                        "Contrôle",
+		"Scope":                        "Portée",
+		"Current":                      "Actuel",
+		"Expected":                     "Attendu",
+		"Fix":                          "Correctif",
+		"Remediating %s...":            "Correction de %s...",
+		"Check %s":                     "Contrôle %s",
+		"Checking account settings...": "Vérification des paramètres du compte...",
+		"[f]ix  [Enter]details  [↑/↓]navigate  [r]efresh": "[f] corriger  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"Passing: %d": "Conformes : %d",
+		"Unknown: %d": "Inconnus : %d",
+
+		// VPC networking
+		"VPC Networking":       "Réseau VPC",
+		"VPCs":                 "VPC",
+		"VPC %s":               "VPC %s",
+		"Loading VPCs...":      "Chargement des VPC...",
+		"Internet Gateways":    "Passerelles Internet",
+		"Default":              "Par défaut",
+		"RTs":                  "TR",
+		"IGW":                  "IGW",
+		"NAT":                  "NAT",
+		"Unused NAT":           "NAT inutilisées",
+		"Free IPs":             "IP libres",
+		"Route Table":          "Table de routage",
+		"Main":                 "Principale",
+		"Routes":               "Routes",
+		"Default Route":        "Route par défaut",
+		"Routed":               "Routée",
+		"GB/14d":               "Go/14j",
+		"%d %s across %d VPCs": "%d %s dans %d VPC",
+		"Still analyzing VPCs, try again once done":            "Analyse des VPC en cours, réessayez une fois terminée",
+		"Unused NAT gateways: %d":                              "Passerelles NAT inutilisées : %d",
+		"NAT Est. $%.2f/mo":                                    "NAT est. %.2f $/mois",
+		"\nNot analyzed yet. Press [a] to analyze this VPC.\n": "\nPas encore analysé. Appuyez sur [a] pour analyser ce VPC.\n",
+		"\nSubnets:\n":                                         "\nSous-réseaux :\n",
+		"\nRoute tables:\n":                                    "\nTables de routage :\n",
+		"\nInternet gateways:\n":                               "\nPasserelles Internet :\n",
+		"\nNAT gateways:\n":                                    "\nPasserelles NAT :\n",
+		"[t]ype  [a]nalyze  [Enter]details  [↑/↓]navigate  [r]efresh": "[t]ype  [a]nalyser  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"[t]ype  [Enter]details  [Esc]VPCs  [↑/↓]navigate  [r]efresh": "[t]ype  [Entrée]détails  [Échap]VPC  [↑/↓]naviguer  [r]afraîchir",
+
+		// IAM cleanup
+		"IAM Cleanup":                      "Nettoyage IAM",
+		"Kind":                             "Type",
+		"Reason":                           "Raison",
+		"Mark candidates with space first": "Marquez d'abord des candidats avec espace",
+		"%d marked":                        "%d marqués",
+		"Candidate %s":                     "Candidat %s",
+		"Found %d cleanup candidates":      "%d candidats au nettoyage trouvés",
+		"Looking for unused policies and roles...": "Recherche des stratégies et rôles inutilisés...",
+		"Unattached policies: %d":                  "Stratégies non attachées : %d",
+		"Unused service-linked roles: %d":          "Rôles liés à un service inutilisés : %d",
+		"Marked: %d":                               "Marqués : %d",
+		"[space]mark  [a]ll/none  [x] delete  [D]elete marked  [Enter]details  [r]efresh": "[espace] marquer  [a] tout/rien  [x] supprimer  [D] supprimer les marqués  [Entrée]détails  [r]afraîchir",
+
+		// Load balancers
+		"Scheme":                               "Schéma",
+		"DNS Name":                             "Nom DNS",
+		"Healthy":                              "Sains",
+		"Unhealthy":                            "Défaillants",
+		"load balancers":                       "répartiteurs de charge",
+		"Load Balancers":                       "Répartiteurs de charge",
+		"Loading listeners of %s...":           "Chargement des écouteurs de %s...",
+		"Targets of %s":                        "Cibles de %s",
+		"Deregistering %s...":                  "Désinscription de %s...",
+		"Listeners of %s":                      "Écouteurs de %s",
+		"Loading load balancers...":            "Chargement des répartiteurs de charge...",
+		"Analyze %s first to list its targets": "Analysez d'abord %s pour lister ses cibles",
+		"%s has no registered targets":         "%s n'a aucune cible inscrite",
+		"Deregister a target from %s":          "Désinscrire une cible de %s",
+		"Target to deregister":                 "Cible à désinscrire",
+		"\nNot analyzed yet. Press [a] to load its targets.\n": "\nPas encore analysé. Appuyez sur [a] pour charger ses cibles.\n",
+		"\nNo target groups.\n":                                "\nAucun groupe cible.\n",
+		"  No registered targets.\n":                           "  Aucune cible inscrite.\n",
+		"No listeners.\n":                                      "Aucun écouteur.\n",
+		"With unhealthy targets: %d":                           "Avec cibles défaillantes : %d",
+		"[a]nalyze  [l]isteners  [x] deregister  [d]elete  [Enter]targets  [r]efresh  [R]e-analyze": "[a]nalyser  [l] écouteurs  [x] désinscrire  [d] supprimer  [Entrée]cibles  [r]afraîchir  [R]é-analyser",
+
+		// Security groups
+		"Ingress":                    "Entrant",
+		"Egress":                     "Sortant",
+		"Loading rules of %s...":     "Chargement des règles de %s...",
+		"Loaded %d security groups":  "%d groupes de sécurité chargés",
+		"Rules of %s":                "Règles de %s",
+		"Loading security groups...": "Chargement des groupes de sécurité...",
+		"Open to internet: %d":       "Ouverts sur Internet : %d",
+		"  No rules.\n":              "  Aucune règle.\n",
+		"[v]iew rules  [↑/↓]navigate  [r]efresh": "[v]oir les règles  [↑/↓]naviguer  [r]afraîchir",
+
+		// EBS volumes
+		"EBS Volumes":         "Volumes EBS",
+		"IOPS":                "IOPS",
+		"Volume %s":           "Volume %s",
+		"Snapshotting %s...":  "Création d'un instantané de %s...",
+		"Loaded %d volumes":   "%d volumes chargés",
+		"Loading volumes...":  "Chargement des volumes...",
+		"Cleanup: %d (%s/mo)": "À nettoyer : %d (%s/mois)",
+		"[s]napshot  [d]elete  [Enter]details  [↑/↓]navigate  [r]efresh": "[s] instantané  [d] supprimer  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+
+		// Snapshots and AMIs
+		"Used By": "Utilisé par",
+		"Orphan":  "Orphelin",
+		"Mark snapshots or AMIs with space first": "Marquez d'abord des instantanés ou des AMI avec espace",
+		"Loaded %d snapshots and AMIs":            "%d instantanés et AMI chargés",
+		"Loading snapshots and AMIs...":           "Chargement des instantanés et des AMI...",
+		"[space]mark  [a]ll candidates/none  [x] delete  [D]elete marked  [Enter]details  [r]efresh": "[espace]marquer  [a] candidats/aucun  [x] supprimer  [D] supprimer les marqués  [Entrée]détails  [r]afraîchir",
+		"Snapshots and AMIs": "Instantanés et AMI",
+		"Snapshots: %d":      "Instantanés : %d",
+		"AMIs: %d":           "AMI : %d",
+		"Orphaned: %d":       "Orphelins : %d",
+
+		// ECR
+		"Images":                                "Images",
+		"Untagged":                              "Sans tag",
+		"Scan on push":                          "Scan au push",
+		"Last push":                             "Dernier push",
+		"Findings C/H/M":                        "Vulnérabilités C/É/M",
+		"Lifecycle":                             "Cycle de vie",
+		"repositories":                          "dépôts",
+		"Deleting untagged images of %s...":     "Suppression des images sans tag de %s...",
+		"Reading scan findings of %s...":        "Lecture des résultats d'analyse de %s...",
+		"Setting the lifecycle policy of %s...": "Application de la politique de cycle de vie de %s...",
+		"Scan findings of %s":                   "Résultats d'analyse de %s",
+		"Lifecycle policy of %s":                "Politique de cycle de vie de %s",
+		"Loading ECR repositories...":           "Chargement des dépôts ECR...",
+		"[Enter]findings  [u]ntagged cleanup  [l]ifecycle  [d]elete  [a]nalyze  [r]efresh  [R]e-analyze": "[Entrée]vulnérabilités  [u] nettoyer sans tag  [l] cycle de vie  [d] supprimer  [a]nalyser  [r]afraîchir  [R]éanalyser",
+		"\nNo vulnerabilities found.\n":    "\nAucune vulnérabilité trouvée.\n",
+		"\nFindings, most severe first:\n": "\nVulnérabilités, les plus graves d'abord :\n",
+		"ECR Repositories":                 "Dépôts ECR",
+		"Images: %d":                       "Images : %d",
+		"Vulnerable: %d":                   "Vulnérables : %d",
+
+		// Secrets Manager
+		"Rotation":           "Rotation",
+		"Last Rotated":       "Dernière rotation",
+		"Next Rotation":      "Prochaine rotation",
+		"Last Accessed":      "Dernier accès",
+		"Rotating %s...":     "Rotation de %s...",
+		"Secret %s":          "Secret %s",
+		"Loaded %d secrets":  "%d secrets chargés",
+		"Value of %s":        "Valeur de %s",
+		"Loading secrets...": "Chargement des secrets...",
+		"[t] rotate  [v]alue  [Enter]details  [↑/↓]navigate  [r]efresh": "[t] rotation  [v]aleur  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"Off":                        "Désactivée",
+		"Every %dd":                  "Tous les %d j",
+		"On":                         "Activée",
+		"Binary value, in base64:\n": "Valeur binaire, en base64 :\n",
+		"Secrets Manager":            "Secrets Manager",
+		"Not rotated: %d":            "Sans rotation : %d",
+		"Overdue: %d":                "En retard : %d",
+		"Unread: %d":                 "Non lus : %d",
+
+		// SSM Parameters
+		"Tier":                           "Niveau",
+		"Modified By":                    "Modifié par",
+		"Reading %s...":                  "Lecture de %s...",
+		"Parameter %s":                   "Paramètre %s",
+		"Putting a new version of %s...": "Écriture d'une nouvelle version de %s...",
+		"Loaded %d parameters":           "%d paramètres chargés",
+		"Loading parameters...":          "Chargement des paramètres...",
+		"[v]alue  [p]ut version  [d]elete  [f]ilter path  [Enter]details  [r]efresh": "[v]aleur  [p] nouvelle version  [d] supprimer  [f]iltrer le chemin  [Entrée]détails  [r]afraîchir",
+		"e.g. /prod/ or /app/db (empty lists every parameter)":                       "ex. /prod/ ou /app/db (vide liste tous les paramètres)",
+		"Filter parameters by path":                                                  "Filtrer les paramètres par chemin",
+		"New version of %s":                                                          "Nouvelle version de %s",
+		"SSM Parameters":                                                             "Paramètres SSM",
+		"Path: %s":                                                                   "Chemin : %s",
+		"SecureString: %d":                                                           "SecureString : %d",
+		"Advanced: %d":                                                               "Avancés : %d",
+
+		// KMS
+		"Alias":                            "Alias",
+		"Key ID":                           "ID de clé",
+		"Manager":                          "Gestionnaire",
+		"Spec":                             "Spécification",
+		"Policy":                           "Stratégie",
+		"keys":                             "clés",
+		"Reading the key policy of %s...":  "Lecture de la stratégie de clé de %s...",
+		"Rotation of %s":                   "Rotation de %s",
+		"Deletion of %s":                   "Suppression de %s",
+		"Enabling rotation of %s...":       "Activation de la rotation de %s...",
+		"Scheduling the deletion of %s...": "Planification de la suppression de %s...",
+		"Key policy of %s":                 "Stratégie de clé de %s",
+		"Loading KMS keys...":              "Chargement des clés KMS...",
+		"[Enter]details  [p]olicy  ro[t]ation  [d]elete  [a]nalyze  [r]efresh  [R]e-analyze": "[Entrée]détails  [p] stratégie  ro[t]ation  [d] supprimer  [a]nalyser  [r]afraîchir  [R]éanalyser",
+		"No risky statements.\n": "Aucune déclaration risquée.\n",
+		"Risky statements:\n":    "Déclarations risquées :\n",
+		"KMS Keys":               "Clés KMS",
+		"Customer managed: %d":   "Gérées par le client : %d",
+		"No rotation: %d":        "Sans rotation : %d",
+		"Risky policies: %d":     "Stratégies risquées : %d",
+
+		// SNS
+		"Topic":                   "Rubrique",
+		"Confirmed":               "Confirmés",
+		"Protocols":               "Protocoles",
+		"Encrypted":               "Chiffré",
+		"Protocol":                "Protocole",
+		"Publishing to %s...":     "Publication dans %s...",
+		"Loaded %d topics":        "%d rubriques chargées",
+		"Loaded %d subscriptions": "%d abonnements chargés",
+		"Loading topics...":       "Chargement des rubriques...",
+		"[Enter]subscriptions  [p]ublish test message  [l]delivery logs  [i]nfo  [r]efresh": "[Entrée]abonnements  [p]ublier un message de test  [l] journaux de livraison  [i]nfos  [r]afraîchir",
+		"[Enter]settings  [d]elete subscription  [Esc]back  [r]efresh":                      "[Entrée]paramètres  [d] supprimer l'abonnement  [Échap]retour  [r]afraîchir",
+		"Topic %s":                                  "Rubrique %s",
+		"Subscription %s":                           "Abonnement %s",
+		"Reading the settings of %s...":             "Lecture des paramètres de %s...",
+		"Deleting the subscription of %s...":        "Suppression de l'abonnement de %s...",
+		"Publish to %s":                             "Publier dans %s",
+		"\nSettings:\n":                             "\nParamètres :\n",
+		"SNS Topics":                                "Rubriques SNS",
+		"Subscriptions: %d":                         "Abonnements : %d",
+		"Without subscribers: %d":                   "Sans abonnés : %d",
+		"Reading the delivery logs of %s...":        "Lecture des journaux de livraison de %s...",
+		"No deliveries were logged in that time.\n": "Aucune livraison n'a été journalisée sur cette période.\n",
+		"Delivery %d of %d for %s":                  "Livraison %d sur %d pour %s",
+		"[←/→] delivery  [y] copy provider response  [Y] copy log event": "[←/→] livraison  [y] copier la réponse du fournisseur  [Y] copier l'événement",
+		"Provider response:": "Réponse du fournisseur :",
+		"Log event:":         "Événement du journal :",
+		"Copied the provider response of delivery %s": "Réponse du fournisseur de la livraison %s copiée",
+		"Copied the log event of delivery %s":         "Événement de la livraison %s copié",
+
+		// Expiry
+		"Expiring Certificates and Keys": "Certificats et clés arrivant à expiration",
+		"Source":                         "Source",
+		"Detail":                         "Détail",
+		"Expires":                        "Expiration",
+		"Days Left":                      "Jours restants",
+		"Expiry of %s":                   "Expiration de %s",
+		"Found %d certificates and keys": "%d certificats et clés trouvés",
+		"Collecting expiry dates...":     "Collecte des dates d'expiration...",
+		"Expired: %d":                    "Expirés : %d",
+		"Within %d days: %d":             "Sous %d jours : %d",
+		"[w]arnings only  [Enter]details  [↑/↓]navigate  [r]efresh": "[w] alertes uniquement  [Entrée] détails  [↑/↓] naviguer  [r] actualiser",
+
+		// EventBridge rules
+		"Bus":                            "Bus",
+		"Trigger":                        "Déclencheur",
+		"Targets":                        "Cibles",
+		"rules":                          "règles",
+		"Enabling %s...":                 "Activation de %s...",
+		"Disabling %s...":                "Désactivation de %s...",
+		"Loading the targets of %s...":   "Chargement des cibles de %s...",
+		"Rule %s":                        "Règle %s",
+		"Sending a test event for %s...": "Envoi d'un événement de test pour %s...",
+		"Loading rules...":               "Chargement des règles...",
+		"[Enter]details  [e]nable  [d]isable  [t]argets  [s]end test event  [r]efresh  [R]e-analyze": "[Entrée] détails  [e] activer  [d] désactiver  [t] cibles  [s] envoyer un événement de test  [r] actualiser  [R] réanalyser",
+		"%s runs on a schedule and matches no events":                                                "%s s'exécute selon une planification et ne correspond à aucun événement",
+		"Test event for %s":                      "Événement de test pour %s",
+		"\nTargets:\n":                           "\nCibles :\n",
+		"No targets; matched events go nowhere.": "Aucune cible ; les événements correspondants ne vont nulle part.",
+		"Enabled: %d":                            "Activées : %d",
+		"Disabled: %d":                           "Désactivées : %d",
+		"No targets: %d":                         "Sans cible : %d",
+		"EventBridge Rules":                      "Règles EventBridge",
+
+		// API Gateway
+		"Endpoint Type":         "Type de point de terminaison",
+		"Stages":                "Étapes",
+		"Throttling":            "Limitation",
+		"APIs":                  "API",
+		"API %s":                "API %s",
+		"Deploying %s to %v...": "Déploiement de %s sur %v...",
+		"Loading APIs...":       "Chargement des API...",
+		"[Enter]details  [d]eploy stage  [X]delete  [r]efresh  [R]e-analyze": "[Entrée] détails  [d] déployer une étape  [X] supprimer  [r] actualiser  [R] réanalyser",
+		"Deploy %s":          "Déployer %s",
+		"disabled":           "désactivé",
+		"\nStages:\n":        "\nÉtapes :\n",
+		"REST: %d":           "REST : %d",
+		"HTTP/WebSocket: %d": "HTTP/WebSocket : %d",
+		"Undeployed: %d":     "Non déployées : %d",
+		"API Gateway":        "API Gateway",
+
+		// ElastiCache
+		"ElastiCache":              "ElastiCache",
+		"Node Type":                "Type de nœud",
+		"Replication Group":        "Groupe de réplication",
+		"Encryption":               "Chiffrement",
+		"Reboot nodes of %s":       "Redémarrer des nœuds de %s",
+		"Delete %s":                "Supprimer %s",
+		"Rebooting nodes of %s...": "Redémarrage des nœuds de %s...",
+		"[Enter]details  re[b]oot nodes  [X]delete  [r]efresh  [R]e-analyze": "[Entrée]détails  redémarrer nœuds [b]  [X]supprimer  [r]actualiser  [R]éanalyser",
+		"none":             "aucun",
+		"\nEncryption:\n":  "\nChiffrement :\n",
+		"\nNodes:\n":       "\nNœuds :\n",
+		"Redis/Valkey: %d": "Redis/Valkey : %d",
+		"Memcached: %d":    "Memcached : %d",
+		"Unencrypted: %d":  "Non chiffrés : %d",
+
+		// Hook health
+		"Hook health and runtime toggles": "Santé des hooks et activation à chaud",
+		"Hook health":                     "Santé des hooks",
+		"Hook %s":                         "Hook %s",
+		"Hooks: %d":                       "Hooks : %d",
+		"Refreshed":                       "Rafraîchi",
+		"Priority":                        "Priorité",
+		"Runs":                            "Exécutions",
+		"Rate":                            "Taux",
+		"Avg":                             "Moy.",
+		"Last failure":                    "Dernier échec",
+		"No hooks registered":             "Aucun hook enregistré",
+		"[Enter]details  [Space]enable/disable  [r]efresh":         "[Entrée]détails  [Espace]activer/désactiver  [r]afraîchir",
+		"%s keeps the interface up to date and cannot be disabled": "%s tient l'interface à jour et ne peut pas être désactivé",
+		"Disabled %s until enabled again or a9s restarts":          "%s désactivé jusqu'à sa réactivation ou au redémarrage d'a9s",
+		"Enabled %s":       "%s activé",
+		"\nEvent types:\n": "\nTypes d'événements :\n",
+
+		// Event console
+		"Live dispatcher events":                "Événements du dispatcher en direct",
+		"Event console":                         "Console d'événements",
+		"Event %s from %s":                      "Événement %s de %s",
+		"Filters cleared":                       "Filtres effacés",
+		"Paused; new events are still recorded": "En pause ; les nouveaux événements sont toujours enregistrés",
+		"Cleared":                               "Effacé",
+		"No events yet":                         "Aucun événement pour l'instant",
+		"[Enter]payload  [f]ilter  [x]clear filters  [p]ause  [c]lear  [End]follow": "[Entrée]contenu  [f]iltrer  [x]effacer filtres  [p]ause  [c]vider  [Fin]suivre",
+		"Filter events": "Filtrer les événements",
+		"Event type or prefix, such as action. (empty for all)":      "Type d'événement ou préfixe, par exemple action. (vide pour tous)",
+		"Part of the source, such as a service name (empty for all)": "Partie de la source, par exemple un nom de service (vide pour toutes)",
+		"Events: %d":   "Événements : %d",
+		"Type: %s*":    "Type : %s*",
+		"Source: *%s*": "Source : *%s*",
+		"PAUSED":       "EN PAUSE",
+		"following":    "suivi",
+
+		// CloudWatch alarms
+		"CloudWatch Alarms":              "Alarmes CloudWatch",
+		"Condition":                      "Condition",
+		"Since":                          "Depuis",
+		"alarms":                         "alarmes",
+		"Disabling the actions of %s...": "Désactivation des actions de %s...",
+		"Enabling the actions of %s...":  "Activation des actions de %s...",
+		"Loading the history of %s...":   "Chargement de l'historique de %s...",
+		"Alarm %s":                       "Alarme %s",
+		"Setting the state of %s...":     "Changement de l'état de %s...",
+		"Loading alarms...":              "Chargement des alarmes...",
+		"[Enter]details  [f]ilter state  [S]et state  [d]isable/[e]nable actions  [h]istory  [r]efresh": "[Entrée]détails  [f]iltrer l'état  [S]changer l'état  [d]ésactiver/[e]activer les actions  [h]istorique  [r]afraîchir",
+		"Showing all alarms":                "Toutes les alarmes",
+		"Showing alarms in %s":              "Alarmes en %s",
+		"Set the state of %s":               "Changer l'état de %s",
+		"History":                           "Historique",
+		"History of %s":                     "Historique de %s",
+		"\nActions (disabled):\n":           "\nActions (désactivées) :\n",
+		"No history in the last two weeks.": "Aucun historique ces deux dernières semaines.",
+		"In alarm: %d":                      "En alarme : %d",
+		"Insufficient data: %d":             "Données insuffisantes : %d",
+		"OK: %d":                            "OK : %d",
+		"State: %s":                         "État : %s",
+
+		// Spend by service
+		"Spend by Service":                    "Dépenses par service",
+		"Month to Date":                       "Mois en cours",
+		"Last Month to Date":                  "Mois dernier à date",
+		"Change":                              "Évolution",
+		"Last Month":                          "Mois dernier",
+		"services":                            "services",
+		"Loading the usage types of %s...":    "Chargement des types d'usage de %s...",
+		"Usage types":                         "Types d'usage",
+		"Usage types of %s":                   "Types d'usage de %s",
+		"Loading spend from Cost Explorer...": "Chargement des dépenses depuis Cost Explorer...",
+		"[u]sage types  [Enter]details  [↑/↓]navigate  [r]efresh":                                                  "[u]types d'usage  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"\nUnblended cost from Cost Explorer, all regions; today's spend is partial. Press [u] for usage types.\n": "\nCoût non mélangé depuis Cost Explorer, toutes régions ; les dépenses du jour sont partielles. Appuyez sur [u] pour les types d'usage.\n",
+		"No spend this month or last month.":                                                                       "Aucune dépense ce mois-ci ni le mois dernier.",
+		"Usage type":                                                                                               "Type d'usage",
+		"Month to date":                                                                                            "Mois en cours",
+		"Month to date: %s":                                                                                        "Mois en cours : %s",
+		"vs last month to date: %s":                                                                                "vs mois dernier à date : %s",
+		"Last month: %s":                                                                                           "Mois dernier : %s",
+		"Rising: %d":                                                                                               "En hausse : %d",
+
+		// Auto Scaling view
+		"Desired":                               "Souhaité",
+		"Min/Max":                               "Min/Max",
+		"In Service":                            "En service",
+		"Suspended":                             "Suspendus",
+		"Launch Template":                       "Modèle de lancement",
+		"groups":                                "groupes",
+		"Refresh the instances of %s":           "Renouveler les instances de %s",
+		"Suspend processes of %s":               "Suspendre des processus de %s",
+		"Resume processes of %s":                "Reprendre des processus de %s",
+		"Group %s":                              "Groupe %s",
+		"Starting an instance refresh of %s...": "Démarrage du renouvellement des instances de %s...",
+		"Suspending processes of %s...":         "Suspension de processus de %s...",
+		"Resuming processes of %s...":           "Reprise de processus de %s...",
+		"Loading Auto Scaling groups...":        "Chargement des groupes Auto Scaling...",
+		"[Enter]details  [S]cale  re[f]resh instances  [p]ause/[P]resume processes  [r]efresh": "[Entrée]détails  [S]mettre à l'échelle  [f]renouveler les instances  [p]suspendre/[P]reprendre les processus  [r]afraîchir",
+		"\nInstances:\n":      "\nInstances :\n",
+		"Groups: %d":          "Groupes : %d",
+		"Instances: %d":       "Instances : %d",
+		"Unhealthy: %d":       "Défaillantes : %d",
+		"Suspended: %d":       "Suspendus : %d",
+		"Auto Scaling Groups": "Groupes Auto Scaling",
+
+		// Runbooks
+		"No runbooks for this view":          "Aucun runbook pour cette vue",
+		"Run a runbook on %s":                "Lancer un runbook sur %s",
+		"%s:%s on %s":                        "%s:%s sur %s",
+		"Rollback: %s":                       "Retour arrière : %s",
+		"%s, step %d/%d: %s":                 "%s, étape %d/%d : %s",
+		"Skipped: no resource":               "Ignorée : aucune ressource",
+		"Runbook %s finished on %s":          "Runbook %s terminé sur %s",
+		"Runbook %s stopped at step %d/%d":   "Runbook %s arrêté à l'étape %d/%d",
+		"Runbook %s failed at step %d/%d":    "Runbook %s en échec à l'étape %d/%d",
+		"\nTo roll back, last step first:\n": "\nPour revenir en arrière, en commençant par la dernière étape :\n",
+
+		// Elastic IP view
+		"Allocation ID":          "ID d'allocation",
+		"Associated With":        "Associée à",
+		"Disassociating %s...":   "Dissociation de %s...",
+		"Releasing %s...":        "Libération de %s...",
+		"Elastic IP %s":          "IP Elastic %s",
+		"Loaded %d Elastic IPs":  "%d IP Elastic chargées",
+		"Loading Elastic IPs...": "Chargement des IP Elastic...",
+		"disassocia[t]e  [d] release  [Enter]details  [↑/↓]navigate  [r]efresh": "dissocier[t]  [d] libérer  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"Unassociated: %d (%s/mo)": "Non associées : %d (%s/mois)",
+		"Elastic IPs":              "IP Elastic",
+
+		// CloudTrail view
+		"Trail":                       "Journal",
+		"Home Region":                 "Région d'origine",
+		"Multi-Region":                "Multirégion",
+		"Validation":                  "Validation",
+		"Bucket":                      "Compartiment",
+		"Time":                        "Heure",
+		"Event":                       "Événement",
+		"User":                        "Utilisateur",
+		"Resources":                   "Ressources",
+		"Error":                       "Erreur",
+		"Source IP":                   "IP source",
+		"Looking up events for %s...": "Recherche des événements de %s...",
+		"Loaded %d trails":            "%d journaux chargés",
+		"Loaded %d events":            "%d événements chargés",
+		"Loading trails...":           "Chargement des journaux...",
+		"[l]ookup events  [Enter]details  [↑/↓]navigate  [r]efresh":             "[l] rechercher des événements  [Entrée]détails  [↑/↓]naviguer  [r]afraîchir",
+		"[Enter]details  [u]ser's events  [l]ookup again  [Esc]back  [r]efresh": "[Entrée]détails  [u] événements de l'utilisateur  [l] nouvelle recherche  [Échap]retour  [r]afraîchir",
+		"Trail %s":                  "Journal %s",
+		"Event %s":                  "Événement %s",
+		"The event names no user":   "L'événement ne nomme aucun utilisateur",
+		"Look up CloudTrail events": "Rechercher des événements CloudTrail",
+		"\nRecord:\n":               "\nEnregistrement :\n",
+		"Not logging: %d":           "Sans journalisation : %d",
+		"Failed calls: %d":          "Appels en échec : %d",
+		"CloudTrail Trails":         "Journaux CloudTrail",
+
+		// Policy confirmations
+		"Confirm %s on %s":                       "Confirmer %s sur %s",
+		"Confirm %s on %s in %s":                 "Confirmer %s sur %s en %s",
+		"Type %s to confirm":                     "Saisir %s pour confirmer",
+		"The reason is written to the audit log": "La raison est inscrite dans le journal d'audit",
+		"Press y, then Enter to run it":          "Appuyer sur y, puis Entrée pour lancer l'action",
+		"Running %s on %s...":                    "Exécution de %s sur %s...",
+
+		// Resource notes
+		"Note on %s": "Note sur %s",
+		"Local note, not written to AWS (empty to remove)": "Note locale, jamais écrite dans AWS (vide pour supprimer)",
+		"Saved note on %s":   "Note enregistrée sur %s",
+		"Removed note on %s": "Note supprimée sur %s",
+		"\n\nNote:\n":        "\n\nNote :\n",
+
+		// Resource exports
+		"Export %s":                        "Exporter %s",
+		"Format of the file":               "Format du fichier",
+		"File to write (empty for %s)":     "Fichier à écrire (vide pour %s)",
+		"Exporting %s...":                  "Export de %s...",
+		"Exported %s to %s":                "%s exporté dans %s",
+		"Exported %s to %s, partially: %s": "%s exporté dans %s, partiellement : %s",
+
+		// Metrics charts
+		"Metrics of %s": "Métriques de %s",
+		"No metrics are charted for %s resources": "Aucune métrique n'est tracée pour les ressources %s",
+		"Range:":                                 "Période :",
+		"[/] range  [c] chart style  [r] reload": "[/] période  [c] style de graphique  [r] recharger",
+		"Loading details...":                     "Chargement des détails...",
+		"Loading metrics...":                     "Chargement des métriques...",
+		"No datapoints in this range":            "Aucun point de données sur cette période",
+		"min %s  avg %s  max %s  last %s":        "min %s  moy %s  max %s  dernier %s",
+
+		// Preflight checks
+		"No AWS region is configured":                                                  "Aucune région AWS n'est configurée",
+		"Press G to pick a region, or set aws.region or AWS_REGION":                    "Appuyer sur G pour choisir une région, ou définir aws.region ou AWS_REGION",
+		"No AWS credentials found for profile %s":                                      "Aucun identifiant AWS trouvé pour le profil %s",
+		"Configure the profile or press P to pick another one":                         "Configurer le profil ou appuyer sur P pour en choisir un autre",
+		"Cannot load AWS credentials for profile %s: %v":                               "Impossible de charger les identifiants AWS du profil %s : %v",
+		"Check the profile in ~/.aws/config or press P to pick another one":            "Vérifier le profil dans ~/.aws/config ou appuyer sur P pour en choisir un autre",
+		"AWS rejected the request signature; the system clock may be wrong":            "AWS a rejeté la signature de la requête ; l'horloge système est peut-être fausse",
+		"Sync the system clock (e.g. timedatectl set-ntp true)":                        "Synchroniser l'horloge système (ex. timedatectl set-ntp true)",
+		"AWS rejected the credentials of profile %s":                                   "AWS a rejeté les identifiants du profil %s",
+		"Check the access keys or press P to pick another profile":                     "Vérifier les clés d'accès ou appuyer sur P pour choisir un autre profil",
+		"Cannot reach AWS: %v":                                                         "Impossible de joindre AWS : %v",
+		"Check the network connection and proxy settings":                              "Vérifier la connexion réseau et les paramètres de proxy",
+		"The system clock is %s off from AWS":                                          "L'horloge système est décalée de %s par rapport à AWS",
+		"The AWS session for profile %s has expired":                                   "La session AWS du profil %s a expiré",
+		"Run: aws sso login --profile %s (or refresh the session token), then press r": "Lancer : aws sso login --profile %s (ou renouveler le jeton de session), puis appuyer sur r",
+		"⚠ The AWS session for profile %s expires in %s":                               "⚠ La session AWS du profil %s expire dans %s",
+		"AWS context is usable again":                                                  "Le contexte AWS est de nouveau utilisable",
+		"⚠ AWS is unreachable with profile %s in %s":                                   "⚠ AWS est inaccessible avec le profil %s en %s",
+		"Views resume refreshing once this is fixed. Press r to check again.":          "Les vues se rafraîchiront dès que ce sera corrigé. Appuyer sur r pour revérifier.",
+
+		// Action descriptions
+		"Start a stopped instance":                                              "Démarrer une instance arrêtée",
+		"Stop a running instance":                                               "Arrêter une instance en marche",
+		"Reboot an instance":                                                    "Redémarrer une instance",
+		"Terminate an instance (permanent)":                                     "Résilier une instance (définitif)",
+		"Confirm termination":                                                   "Confirmer la résiliation",
+		"Show volumes, security groups and instance profile":                    "Afficher les volumes, groupes de sécurité et profil d'instance",
+		"Include decoded user data and console output (may contain secrets)":    "Inclure les user data décodées et la sortie console (peut contenir des secrets)",
+		"Confirm showing sensitive data":                                        "Confirmer l'affichage des données sensibles",
+		"Change instance type (stops and restarts the instance)":                "Changer le type d'instance (arrête et redémarre l'instance)",
+		"New instance type (e.g. t3.small)":                                     "Nouveau type d'instance (ex. t3.small)",
+		"Start the instance after resizing if it was running":                   "Redémarrer l'instance après redimensionnement si elle était en marche",
+		"Confirm the instance may be stopped":                                   "Confirmer que l'instance peut être arrêtée",
+		"Create an AMI from the instance":                                       "Créer une AMI depuis l'instance",
+		"AMI name":                                                              "Nom de l'AMI",
+		"AMI description":                                                       "Description de l'AMI",
+		"Skip the reboot (filesystem consistency is not guaranteed)":            "Ne pas redémarrer (cohérence du système de fichiers non garantie)",
+		"Show Reserved Instance and Savings Plan purchase recommendations":      "Afficher les recommandations d'achat de Reserved Instances et Savings Plans",
+		"Apply a stop/start schedule tag":                                       "Appliquer un tag de planning arrêt/démarrage",
+		"Perform security audit on role":                                        "Auditer la sécurité du rôle",
+		"View attached policies":                                                "Voir les politiques attachées",
+		"Report console logins, access key use and MFA of every user":           "Rapporter les connexions console, l'usage des clés d'accès et la MFA de chaque utilisateur",
+		"Describe the listeners of the load balancer":                           "Décrire les écouteurs du répartiteur de charge",
+		"Deregister a target from the load balancer":                            "Désinscrire une cible du répartiteur de charge",
+		"Target ID (instance ID, IP address or Lambda ARN)":                     "ID de la cible (ID d'instance, adresse IP ou ARN Lambda)",
+		"Target group ARN (empty for Classic load balancers)":                   "ARN du groupe cible (vide pour les répartiteurs Classic)",
+		"Target port (0 for the target group's port)":                           "Port de la cible (0 pour le port du groupe cible)",
+		"Delete the load balancer":                                              "Supprimer le répartiteur de charge",
+		"Create a snapshot of the volume":                                       "Créer un instantané du volume",
+		"Description of the snapshot":                                           "Description de l'instantané",
+		"Delete an unattached volume":                                           "Supprimer un volume non attaché",
+		"Delete the snapshot, or deregister the AMI":                            "Supprimer l'instantané, ou désenregistrer l'AMI",
+		"Also delete the snapshots of a deregistered AMI":                       "Supprimer aussi les instantanés d'une AMI désenregistrée",
+		"Delete several snapshots and deregister several AMIs":                  "Supprimer plusieurs instantanés et désenregistrer plusieurs AMI",
+		"Comma-separated snapshot and AMI IDs to delete":                        "ID d'instantanés et d'AMI à supprimer, séparés par des virgules",
+		"Resume the interrupted batch deletion":                                 "Reprendre la suppression par lot interrompue",
+		"Show the scan findings of the latest image":                            "Afficher les résultats d'analyse de la dernière image",
+		"Delete the untagged images":                                            "Supprimer les images sans tag",
+		"Replace the lifecycle policy":                                          "Remplacer la politique de cycle de vie",
+		"Expire untagged images after this many days (0 to keep them)":          "Expirer les images sans tag après ce nombre de jours (0 pour les garder)",
+		"Keep this many most recent images (0 for no limit)":                    "Garder ce nombre d'images les plus récentes (0 pour aucune limite)",
+		"Delete the repository and all its images":                              "Supprimer le dépôt et toutes ses images",
+		"Rotate the secret now":                                                 "Faire tourner le secret maintenant",
+		"Reveal the current value of the secret":                                "Révéler la valeur actuelle du secret",
+		"View the value of the parameter":                                       "Voir la valeur du paramètre",
+		"Put a new version of the parameter":                                    "Écrire une nouvelle version du paramètre",
+		"New value of the parameter":                                            "Nouvelle valeur du paramètre",
+		"Delete the parameter and all its versions":                             "Supprimer le paramètre et toutes ses versions",
+		"Publish a test message to the topic":                                   "Publier un message de test dans la rubrique",
+		"Subject (email subscriptions only)":                                    "Objet (abonnements e-mail uniquement)",
+		"Message group ID (FIFO topics only)":                                   "ID de groupe de messages (rubriques FIFO uniquement)",
+		"View the delivery settings of the subscription":                        "Voir les paramètres de livraison de l'abonnement",
+		"Delete the subscription":                                               "Supprimer l'abonnement",
+		"View the key policy and its risky statements":                          "Voir la stratégie de clé et ses déclarations risquées",
+		"Enable automatic rotation of the key material":                         "Activer la rotation automatique du matériel de clé",
+		"Days between rotations (90 to 2560)":                                   "Jours entre deux rotations (90 à 2560)",
+		"Schedule the deletion of the key":                                      "Planifier la suppression de la clé",
+		"Days to wait before deleting the key (7 to 30)":                        "Jours d'attente avant de supprimer la clé (7 à 30)",
+		"View the ingress and egress rules of the security group":               "Voir les règles entrantes et sortantes du groupe de sécurité",
+		"Simulate whether the principal may perform actions":                    "Simuler si le principal peut effectuer des actions",
+		"Comma-separated actions, e.g. s3:GetObject,s3:PutObject":               "Actions séparées par des virgules, ex. s3:GetObject,s3:PutObject",
+		"Comma-separated resource ARNs (default *)":                             "ARN de ressources séparés par des virgules (défaut *)",
+		"Analyze bucket contents and usage":                                     "Analyser le contenu et l'usage du bucket",
+		"Delete bucket and all contents":                                        "Supprimer le bucket et tout son contenu",
+		"Confirm deletion":                                                      "Confirmer la suppression",
+		"Start a stopped database":                                              "Démarrer une base de données arrêtée",
+		"Stop a database for up to 7 days":                                      "Arrêter une base de données pour 7 jours au plus",
+		"Reboot a database (brief outage)":                                      "Redémarrer une base de données (brève interruption)",
+		"Fail over to the standby (Multi-AZ instances only)":                    "Basculer vers l'instance de secours (instances Multi-AZ uniquement)",
+		"Take a manual snapshot":                                                "Prendre un snapshot manuel",
+		"Snapshot identifier (generated when empty)":                            "Identifiant du snapshot (généré si vide)",
+		"Invoke the function":                                                   "Invoquer la fonction",
+		"View function configuration":                                           "Voir la configuration de la fonction",
+		"Reveal an environment variable value":                                  "Révéler la valeur d'une variable d'environnement",
+		"Environment variable to reveal":                                        "Variable d'environnement à révéler",
+		"Archive the finding as intended access":                                "Archiver le finding comme accès prévu",
+		"Mark the finding as notified to its owner":                             "Marquer le finding comme notifié à son responsable",
+		"Mark the finding as resolved":                                          "Marquer le finding comme résolu",
+		"Suppress the finding as reviewed and accepted":                         "Supprimer le finding comme examiné et accepté",
+		"Note recorded on the finding (optional)":                               "Note enregistrée sur le finding (facultative)",
+		"Detach a secondary interface from its instance":                        "Détacher une interface secondaire de son instance",
+		"Force the detachment (the instance may not see it)":                    "Forcer le détachement (l'instance peut ne pas le voir)",
+		"Delete an unattached interface":                                        "Supprimer une interface non attachée",
+		"Trace the route from a subnet to an address or subnet":                 "Tracer la route d'un sous-réseau vers une adresse ou un sous-réseau",
+		"Source subnet ID":                                                      "ID du sous-réseau source",
+		"Destination IP address or subnet ID":                                   "Adresse IP ou ID du sous-réseau de destination",
+		"Peek at messages without removing them":                                "Consulter des messages sans les retirer",
+		"Messages to peek at (1-10)":                                            "Nombre de messages à consulter (1-10)",
+		"Send a test message":                                                   "Envoyer un message de test",
+		"Message body":                                                          "Corps du message",
+		"Message group ID (FIFO queues only)":                                   "ID du groupe de messages (files FIFO uniquement)",
+		"Delay in seconds (0-900, standard queues only)":                        "Délai en secondes (0-900, files standard uniquement)",
+		"Delete every message of the queue":                                     "Supprimer tous les messages de la file",
+		"Move dead-lettered messages back to their source queue":                "Renvoyer les messages en lettre morte vers leur file source",
+		"Destination queue name or ARN (empty for the source queues)":           "Nom ou ARN de la file de destination (vide pour les files sources)",
+		"Messages per second (0 for the fastest, up to 500)":                    "Messages par seconde (0 pour le plus rapide, jusqu'à 500)",
+		"Show the progress of the latest redrive":                               "Afficher la progression du dernier renvoi",
+		"Apply the safe fix for a failing check":                                "Appliquer le correctif sûr d'un contrôle en échec",
+		"Detect drift and report drifted resources":                             "Détecter la dérive et lister les ressources dérivées",
+		"Show the stack's most recent events":                                   "Afficher les événements récents de la pile",
+		"Delete the stack and the resources it created":                         "Supprimer la pile et les ressources qu'elle a créées",
+		"Delete the unused policy or service-linked role":                       "Supprimer la stratégie ou le rôle lié à un service inutilisé",
+		"Delete several unused policies and service-linked roles":               "Supprimer plusieurs stratégies et rôles liés à un service inutilisés",
+		"Comma-separated ARNs to delete":                                        "ARN à supprimer, séparés par des virgules",
+		"Cancel the running redrive":                                            "Annuler le renvoi en cours",
+		"Show endpoint access, networking, logging, nodegroups and add-ons":     "Afficher l'accès au point de terminaison, le réseau, la journalisation, les groupes de nœuds et les modules",
+		"Add the cluster to a kubeconfig file":                                  "Ajouter le cluster à un fichier kubeconfig",
+		"Context name (empty for the cluster ARN)":                              "Nom du contexte (vide pour l'ARN du cluster)",
+		"Kubeconfig file (empty for $KUBECONFIG or ~/.kube/config)":             "Fichier kubeconfig (vide pour $KUBECONFIG ou ~/.kube/config)",
+		"Role to assume for cluster authentication (optional)":                  "Rôle à assumer pour s'authentifier auprès du cluster (facultatif)",
+		"Make it the current context":                                           "En faire le contexte courant",
+		"Set or remove a tag on the cluster":                                    "Définir ou retirer une étiquette du cluster",
+		"Tag key":                                                               "Clé de l'étiquette",
+		"Tag value (empty to remove the tag)":                                   "Valeur de l'étiquette (vide pour la retirer)",
+		"Open an interactive shell in a container":                              "Ouvrir un shell interactif dans un conteneur",
+		"Container to run the command in (empty for the task's only container)": "Conteneur où exécuter la commande (vide pour l'unique conteneur de la tâche)",
+		"Command to run":                                                        "Commande à exécuter",
+		"Show the stack's current template as YAML":                             "Afficher le modèle actuel de la pile en YAML",
+		"List the stack's change sets":                                          "Lister les jeux de modifications de la pile",
+		"Preview the resource changes of a change set":                          "Prévisualiser les modifications de ressources d'un jeu de modifications",
+		"Change set name or ID":                                                 "Nom ou ID du jeu de modifications",
+		"Execute a change set, updating the stack":                              "Exécuter un jeu de modifications, mettant à jour la pile",
+		"Disable the schedule":                                                  "Désactiver la planification",
+		"Enable the schedule":                                                   "Activer la planification",
+		"Invoke the schedule's target once, within about a minute":              "Appeler une fois la cible de la planification, dans la minute",
+		"Show keys, indexes, backups and time to live":                          "Afficher les clés, index, sauvegardes et durée de vie",
+		"Enable point-in-time recovery":                                         "Activer la restauration à un instant donné",
+		"Delete the table and all its items":                                    "Supprimer la table et tous ses éléments",
+		"Browse items with a PartiQL SELECT":                                    "Parcourir les éléments avec un SELECT PartiQL",
+		"PartiQL SELECT (empty for every item)":                                 "SELECT PartiQL (vide pour tous les éléments)",
+		"Items evaluated per page (1-1000)":                                     "Éléments évalués par page (1-1000)",
+		"Token of the page to read":                                             "Jeton de la page à lire",
+		"Delete an item by its key":                                             "Supprimer un élément par sa clé",
+		"Key as DynamoDB JSON, such as {\"id\": {\"S\": \"42\"}}":               "Clé en JSON DynamoDB, par exemple {\"id\": {\"S\": \"42\"}}",
+		"Set one attribute of an item":                                          "Modifier un attribut d'un élément",
+		"Attribute to set":                                                      "Attribut à modifier",
+		"New value as JSON, or plain text for a string":                         "Nouvelle valeur en JSON, ou texte brut pour une chaîne",
+		"Stop the task":                                                         "Arrêter la tâche",
+		"Change the desired task count of the service":                          "Modifier le nombre de tâches souhaité du service",
+		"Desired task count":                                                    "Nombre de tâches souhaité",
+		"Replace the service's tasks with a new deployment":                     "Remplacer les tâches du service par un nouveau déploiement",
+		"Stream the events of the log group as they arrive":                     "Diffuser les événements du groupe de journaux à leur arrivée",
+		"Filter pattern, such as ERROR (empty for every event)":                 "Modèle de filtre, par exemple ERROR (vide pour tous les événements)",
+		"Minutes of history to show first":                                      "Minutes d'historique à afficher d'abord",
+		"List snapshots and the point-in-time restore window":                   "Lister les snapshots et la fenêtre de restauration à un instant donné",
+		"Restore an instance to a point in time into a new instance":            "Restaurer une instance à un instant donné dans une nouvelle instance",
+		"Identifier of the new instance":                                        "Identifiant de la nouvelle instance",
+		"Time to restore to: latest, or YYYY-MM-DD HH:MM in UTC":                "Instant à restaurer : latest, ou AAAA-MM-JJ HH:MM en UTC",
+		"Instance class of the new instance (the source's when empty)":          "Classe de la nouvelle instance (celle de la source si vide)",
+		"Deploy the new instance in Multi-AZ":                                   "Déployer la nouvelle instance en Multi-AZ",
+		"Approve the request":                                                   "Approuver la demande",
+		"Reject the request":                                                    "Rejeter la demande",
+		"Reason shown to the requester":                                         "Motif communiqué au demandeur",
+		"Enable the rule":                                                       "Activer la règle",
+		"Disable the rule":                                                      "Désactiver la règle",
+		"View the rule's targets with their input and retry settings":           "Afficher les cibles de la règle avec leur entrée et leurs nouvelles tentatives",
+		"Send a test event matching the rule to its bus":                        "Envoyer un événement de test correspondant à la règle sur son bus",
+		"Event source":                "Source de l'événement",
+		"Event detail type":           "Type de détail de l'événement",
+		"Event detail, a JSON object": "Détail de l'événement, un objet JSON",
+		"Deploy the API's current configuration to a stage":                    "Déployer la configuration actuelle de l'API sur une étape",
+		"Stage to deploy to; REST APIs create it when missing":                 "Étape cible ; les API REST la créent si elle n'existe pas",
+		"Description of the deployment":                                        "Description du déploiement",
+		"Delete the API with its stages":                                       "Supprimer l'API avec ses étapes",
+		"Reboot cache nodes (their cached data is lost)":                       "Redémarrer des nœuds de cache (leurs données en cache sont perdues)",
+		"Nodes to reboot, such as 0001,0002; empty reboots every node":         "Nœuds à redémarrer, par exemple 0001,0002 ; vide redémarre tous les nœuds",
+		"Delete the cluster and its nodes":                                     "Supprimer le cluster et ses nœuds",
+		"Name of a final snapshot to take first (not for Memcached)":           "Nom d'un instantané final à prendre d'abord (pas pour Memcached)",
+		"Set the alarm's state until its next evaluation, to test its actions": "Changer l'état de l'alarme jusqu'à sa prochaine évaluation, pour tester ses actions",
+		"State to set":                                                                     "État à appliquer",
+		"Reason recorded in the alarm's history":                                           "Raison inscrite dans l'historique de l'alarme",
+		"Stop the alarm from running its actions":                                          "Empêcher l'alarme d'exécuter ses actions",
+		"Let the alarm run its actions again":                                              "Laisser l'alarme exécuter à nouveau ses actions",
+		"Show the alarm's latest state changes, updates and actions":                       "Afficher les derniers changements d'état, mises à jour et actions de l'alarme",
+		"Break the service's month-to-date spend down by usage type":                       "Détailler les dépenses du mois en cours du service par type d'usage",
+		"Change the desired capacity of the group":                                         "Changer la capacité souhaitée du groupe",
+		"Desired instance count, between the group's minimum and maximum":                  "Nombre d'instances souhaité, entre le minimum et le maximum du groupe",
+		"Wait for the group's cooldown to end before scaling":                              "Attendre la fin du délai de récupération du groupe avant la mise à l'échelle",
+		"Replace the group's instances with its current launch template":                   "Remplacer les instances du groupe selon son modèle de lancement actuel",
+		"Percentage of the group kept in service during the refresh":                       "Pourcentage du groupe maintenu en service pendant le renouvellement",
+		"Keep instances already on the desired configuration":                              "Conserver les instances déjà dans la configuration souhaitée",
+		"Suspend scaling processes of the group":                                           "Suspendre des processus de mise à l'échelle du groupe",
+		"Processes to suspend, such as Launch,Terminate; empty suspends them all":          "Processus à suspendre, par exemple Launch,Terminate ; vide les suspend tous",
+		"Resume suspended scaling processes of the group":                                  "Reprendre les processus de mise à l'échelle suspendus du groupe",
+		"Processes to resume; empty resumes every suspended one":                           "Processus à reprendre ; vide reprend tous ceux suspendus",
+		"Disassociate the address from its instance or interface":                          "Dissocier l'adresse de son instance ou interface",
+		"Release an unassociated address back to AWS":                                      "Rendre à AWS une adresse non associée",
+		"Look up recent management events by resource name, user name or event name":       "Rechercher les événements de gestion récents par nom de ressource, d'utilisateur ou d'événement",
+		"What to match the value against":                                                  "Ce à quoi comparer la valeur",
+		"Resource name (such as a bucket), user name or event name (such as DeleteBucket)": "Nom de ressource (comme un compartiment), d'utilisateur ou d'événement (comme DeleteBucket)",
+		"Hours of history to search (1-2160)":                                              "Heures d'historique à parcourir (1-2160)",
+	})
+}
@@ -0,0 +1,127 @@
+// Package i18n provides the message catalog for user-facing strings.
+//
+// Messages are looked up by their English text, so untranslated strings
+// fall back to English and code stays readable:
+//
+//	v.Message = i18n.T("Loaded %d functions", n)
+//
+// Translations are registered per locale with Register; the active locale is
+// selected once at startup from the tui.locale setting.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the source language of all message IDs.
+const DefaultLocale = "en"
+
+// Catalog maps English message IDs to translated messages. Translations
+// must keep the format verbs of their message ID in the same order.
+type Catalog map[string]string
+
+var (
+	mu       sync.RWMutex
+	current  = DefaultLocale
+	catalogs = map[string]Catalog{
+		DefaultLocale: {},
+	}
+)
+
+// Register adds translations for a locale, merging with any already present.
+func Register(locale string, catalog Catalog) {
+	locale = normalize(locale)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	existing, ok := catalogs[locale]
+	if !ok {
+		existing = make(Catalog, len(catalog))
+		catalogs[locale] = existing
+	}
+	for id, msg := range catalog {
+		existing[id] = msg
+	}
+}
+
+// SetLocale selects the active locale. Region and encoding suffixes are
+// ignored, so "fr_FR.UTF-8" selects "fr".
+func SetLocale(locale string) error {
+	locale = normalize(locale)
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := catalogs[locale]; !ok {
+		return fmt.Errorf("unsupported locale %q (available: %s)", locale, strings.Join(locales(), ", "))
+	}
+	current = locale
+	return nil
+}
+
+// Locale returns the active locale.
+func Locale() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Locales returns the available locales in sorted order.
+func Locales() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return locales()
+}
+
+func locales() []string {
+	names := make([]string, 0, len(catalogs))
+	for name := range catalogs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Supported reports whether a locale has a registered catalog.
+func Supported(locale string) bool {
+	locale = normalize(locale)
+	if locale == "" {
+		return true
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// T returns the translation of id in the active locale, formatted with args
+// when any are given. Unknown IDs are returned untranslated.
+func T(id string, args ...any) string {
+	mu.RLock()
+	msg, ok := catalogs[current][id]
+	mu.RUnlock()
+	if !ok {
+		msg = id
+	}
+
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+func normalize(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "_-."); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
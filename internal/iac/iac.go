@@ -0,0 +1,178 @@
+// Package iac recognizes resources managed by infrastructure as code.
+//
+// CloudFormation tags every resource of a stack with
+// aws:cloudformation:stack-name, and Terraform setups conventionally tag
+// theirs through default_tags. Changing such a resource from a9s makes it
+// drift from its definition, so the guard asks the operator to confirm
+// before mutating one.
+package iac
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// Tools managing resources.
+const (
+	ToolCloudFormation = "CloudFormation"
+	ToolTerraform      = "Terraform"
+)
+
+// Management describes how a resource is managed.
+type Management struct {
+	Tool  string
+	Stack string // CloudFormation stack or Terraform workspace, when tagged
+}
+
+// String renders the management for messages, e.g. "Terraform (prod)".
+func (m Management) String() string {
+	if m.Stack == "" {
+		return m.Tool
+	}
+	return m.Tool + " (" + m.Stack + ")"
+}
+
+// managerKeys are tag keys naming the tool, compared after normalization.
+var managerKeys = []string{"managedby", "provisionedby", "createdby", "iac", "tool"}
+
+// Detect reports whether the tags mark a resource as managed by
+// CloudFormation or Terraform.
+func Detect(tags map[string]string) (Management, bool) {
+	if stack, ok := tags["aws:cloudformation:stack-name"]; ok {
+		return Management{Tool: ToolCloudFormation, Stack: stack}, true
+	}
+
+	var m Management
+	for key, value := range tags {
+		k := normalize(key)
+		v := strings.ToLower(strings.TrimSpace(value))
+		switch {
+		case k == "terraform":
+			// terraform = true, or a value naming the workspace
+			if v != "false" && v != "no" && v != "" {
+				m.Tool = ToolTerraform
+			}
+		case strings.HasPrefix(k, "terraform") || strings.HasPrefix(k, "tfworkspace"):
+			m.Tool = ToolTerraform
+			if strings.HasSuffix(k, "workspace") {
+				m.Stack = value
+			}
+		case slices.Contains(managerKeys, k):
+			switch {
+			case strings.Contains(v, "terraform"), strings.Contains(v, "terragrunt"), v == "tf":
+				m.Tool = ToolTerraform
+			case strings.Contains(v, "cloudformation"), v == "cdk":
+				if m.Tool == "" {
+					m.Tool = ToolCloudFormation
+				}
+			}
+		}
+	}
+	return m, m.Tool != ""
+}
+
+// Apply flags a resource from its tags in metadata: "iac_managed", and
+// "iac_tool" plus "iac_stack" when managed.
+func Apply(resource *core.Resource) {
+	if resource.Metadata == nil {
+		resource.Metadata = make(map[string]any)
+	}
+	m, ok := Detect(resource.Tags)
+	resource.Metadata["iac_managed"] = ok
+	if !ok {
+		delete(resource.Metadata, "iac_tool")
+		delete(resource.Metadata, "iac_stack")
+		return
+	}
+	resource.Metadata["iac_tool"] = m.Tool
+	resource.Metadata["iac_stack"] = m.Stack
+}
+
+// normalize lowercases a tag key and drops separators, so ManagedBy,
+// managed-by and managed_by compare equal.
+func normalize(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', ':', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(key))
+}
+
+// =============================================================================
+// Guard
+// =============================================================================
+
+// DefaultCategories are the action categories that change resources.
+var DefaultCategories = []string{"lifecycle", "cost"}
+
+// Guard is a core.ActionGuard asking for confirmation before actions change
+// a managed resource. Services report tags by implementing core.TagReader;
+// resources of other services, or whose tags cannot be read, are not held.
+type Guard struct {
+	services   func(name string) (core.AWSService, error)
+	categories []string
+}
+
+// Ensure Guard implements core.ActionGuard
+var _ core.ActionGuard = (*Guard)(nil)
+
+// GuardOption configures a Guard.
+type GuardOption func(*Guard)
+
+// WithCategories sets the action categories the guard checks.
+func WithCategories(categories ...string) GuardOption {
+	return func(g *Guard) {
+		g.categories = categories
+	}
+}
+
+// NewGuard creates a guard looking services up by name.
+func NewGuard(services func(name string) (core.AWSService, error), opts ...GuardOption) *Guard {
+	g := &Guard{
+		services:   services,
+		categories: DefaultCategories,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Check implements core.ActionGuard.
+func (g *Guard) Check(ctx context.Context, req core.ActionRequest) error {
+	if !slices.Contains(g.categories, req.Action.Category) {
+		return nil
+	}
+	if confirmed, _ := req.Params[core.ParamConfirmManaged].(bool); confirmed {
+		return nil
+	}
+
+	service, err := g.services(req.Service)
+	if err != nil {
+		return nil
+	}
+	reader, ok := service.(core.TagReader)
+	if !ok {
+		return nil
+	}
+	tags, err := reader.Tags(ctx, req.ResourceID)
+	if err != nil {
+		return nil
+	}
+	m, managed := Detect(tags)
+	if !managed {
+		return nil
+	}
+
+	return &core.ConfirmationError{
+		Request: req,
+		Param:   core.ParamConfirmManaged,
+		Reason:  i18n.T("%s is managed by %s; changes made here will drift from its definition", req.ResourceID, m),
+	}
+}
@@ -0,0 +1,400 @@
+// Package metrics fetches a curated set of CloudWatch metrics for a resource,
+// over a selectable time range, for charting in the terminal.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// points is roughly how many datapoints are requested per series.
+const points = 120
+
+// =============================================================================
+// Time Ranges
+// =============================================================================
+
+// Range is a time range ending now.
+type Range struct {
+	Label    string
+	Duration time.Duration
+}
+
+// Ranges are the selectable time ranges, shortest first.
+var Ranges = []Range{
+	{"1h", time.Hour},
+	{"3h", 3 * time.Hour},
+	{"12h", 12 * time.Hour},
+	{"24h", 24 * time.Hour},
+	{"3d", 3 * 24 * time.Hour},
+	{"7d", 7 * 24 * time.Hour},
+	{"14d", 14 * 24 * time.Hour},
+}
+
+// DefaultRange is the index in Ranges of the range charts open with.
+const DefaultRange = 2
+
+// period returns the CloudWatch period giving about points datapoints over
+// the range, in whole minutes, and at least min seconds.
+func (r Range) period(min int32) int32 {
+	p := int32(math.Ceil(r.Duration.Minutes()/points)) * 60
+	if p < min {
+		p = min
+	}
+	return p
+}
+
+// =============================================================================
+// Curated Metrics
+// =============================================================================
+
+// Units of a series, which decide how its values are formatted.
+const (
+	UnitPercent = "%"
+	UnitBytes   = "bytes"
+	UnitCount   = "count"
+	UnitMillis  = "ms"
+)
+
+// metric is one curated CloudWatch metric of a resource type.
+type metric struct {
+	label      string
+	name       string
+	stat       string
+	unit       string
+	dimensions []cwtypes.Dimension // Fixed dimensions besides the resource's
+}
+
+// spec describes how to chart a resource type.
+type spec struct {
+	namespace func(r *core.Resource) string
+	dimension string                        // Dimension naming the resource
+	value     func(r *core.Resource) string // Value of that dimension
+	minPeriod int32                         // Publishing period, for daily metrics
+	metrics   []metric
+}
+
+func fixed(namespace string) func(*core.Resource) string {
+	return func(*core.Resource) string { return namespace }
+}
+
+func byID(r *core.Resource) string { return r.ID }
+
+func byName(r *core.Resource) string { return r.Name }
+
+// lastField returns the part of the ID after the last sep, so ARNs and IDs
+// are both accepted.
+func lastField(sep string) func(r *core.Resource) string {
+	return func(r *core.Resource) string {
+		return r.ID[strings.LastIndex(r.ID, sep)+1:]
+	}
+}
+
+// loadBalancer returns the "app/name/id" suffix of a load balancer ARN.
+func loadBalancer(r *core.Resource) string {
+	_, suffix, _ := strings.Cut(r.ID, ":loadbalancer/")
+	return suffix
+}
+
+func elbNamespace(r *core.Resource) string {
+	if strings.HasPrefix(loadBalancer(r), "net/") {
+		return "AWS/NetworkELB"
+	}
+	return "AWS/ApplicationELB"
+}
+
+var specs = map[string]spec{
+	"ec2:instance": {
+		namespace: fixed("AWS/EC2"), dimension: "InstanceId", value: byID,
+		metrics: []metric{
+			{label: "CPU utilization", name: "CPUUtilization", stat: "Average", unit: UnitPercent},
+			{label: "Network in", name: "NetworkIn", stat: "Sum", unit: UnitBytes},
+			{label: "Network out", name: "NetworkOut", stat: "Sum", unit: UnitBytes},
+			{label: "Status check failures", name: "StatusCheckFailed", stat: "Maximum", unit: UnitCount},
+		},
+	},
+	"lambda:function": {
+		namespace: fixed("AWS/Lambda"), dimension: "FunctionName", value: byName,
+		metrics: []metric{
+			{label: "Invocations", name: "Invocations", stat: "Sum", unit: UnitCount},
+			{label: "Errors", name: "Errors", stat: "Sum", unit: UnitCount},
+			{label: "Throttles", name: "Throttles", stat: "Sum", unit: UnitCount},
+			{label: "Duration p95", name: "Duration", stat: "p95", unit: UnitMillis},
+		},
+	},
+	"rds:db": {
+		namespace: fixed("AWS/RDS"), dimension: "DBInstanceIdentifier", value: lastField(":"),
+		metrics: []metric{
+			{label: "CPU utilization", name: "CPUUtilization", stat: "Average", unit: UnitPercent},
+			{label: "Connections", name: "DatabaseConnections", stat: "Average", unit: UnitCount},
+			{label: "Free storage", name: "FreeStorageSpace", stat: "Minimum", unit: UnitBytes},
+			{label: "Read IOPS", name: "ReadIOPS", stat: "Average", unit: UnitCount},
+			{label: "Write IOPS", name: "WriteIOPS", stat: "Average", unit: UnitCount},
+		},
+	},
+	"rds:cluster": {
+		namespace: fixed("AWS/RDS"), dimension: "DBClusterIdentifier", value: byName,
+		metrics: []metric{
+			{label: "CPU utilization", name: "CPUUtilization", stat: "Average", unit: UnitPercent},
+			{label: "Connections", name: "DatabaseConnections", stat: "Average", unit: UnitCount},
+			{label: "Read IOPS", name: "ReadIOPS", stat: "Average", unit: UnitCount},
+			{label: "Write IOPS", name: "WriteIOPS", stat: "Average", unit: UnitCount},
+		},
+	},
+	"dynamodb:table": {
+		namespace: fixed("AWS/DynamoDB"), dimension: "TableName", value: byID,
+		metrics: []metric{
+			{label: "Consumed read capacity", name: "ConsumedReadCapacityUnits", stat: "Sum", unit: UnitCount},
+			{label: "Consumed write capacity", name: "ConsumedWriteCapacityUnits", stat: "Sum", unit: UnitCount},
+			{label: "Throttled requests", name: "ThrottledRequests", stat: "Sum", unit: UnitCount},
+			{label: "System errors", name: "SystemErrors", stat: "Sum", unit: UnitCount},
+		},
+	},
+	"s3:bucket": {
+		namespace: fixed("AWS/S3"), dimension: "BucketName", value: byID,
+		minPeriod: 86400,
+		metrics: []metric{
+			{label: "Size (Standard)", name: "BucketSizeBytes", stat: "Average", unit: UnitBytes,
+				dimensions: []cwtypes.Dimension{{Name: aws.String("StorageType"), Value: aws.String("StandardStorage")}}},
+			{label: "Objects", name: "NumberOfObjects", stat: "Average", unit: UnitCount,
+				dimensions: []cwtypes.Dimension{{Name: aws.String("StorageType"), Value: aws.String("AllStorageTypes")}}},
+		},
+	},
+	"ec2:natgateway": {
+		namespace: fixed("AWS/NATGateway"), dimension: "NatGatewayId", value: byID,
+		metrics: []metric{
+			{label: "Bytes to destination", name: "BytesOutToDestination", stat: "Sum", unit: UnitBytes},
+			{label: "Bytes to source", name: "BytesOutToSource", stat: "Sum", unit: UnitBytes},
+			{label: "Active connections", name: "ActiveConnectionCount", stat: "Maximum", unit: UnitCount},
+			{label: "Port allocation errors", name: "ErrorPortAllocation", stat: "Sum", unit: UnitCount},
+		},
+	},
+	"elbv2:loadbalancer": {
+		namespace: elbNamespace, dimension: "LoadBalancer", value: loadBalancer,
+		metrics: []metric{
+			{label: "Requests", name: "RequestCount", stat: "Sum", unit: UnitCount},
+			{label: "Target 5XX", name: "HTTPCode_Target_5XX_Count", stat: "Sum", unit: UnitCount},
+			{label: "Response time p95", name: "TargetResponseTime", stat: "p95", unit: "s"},
+			{label: "Processed bytes", name: "ProcessedBytes", stat: "Sum", unit: UnitBytes},
+		},
+	},
+}
+
+// Supports reports whether metrics are curated for a resource type.
+func Supports(resourceType string) bool {
+	_, ok := specs[resourceType]
+	return ok
+}
+
+// =============================================================================
+// Series
+// =============================================================================
+
+// Point is one datapoint of a series.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is one metric of a resource over a time range, oldest point first.
+type Series struct {
+	Label  string
+	Metric string // Namespace/Name, for reference
+	Stat   string
+	Unit   string
+	Start  time.Time
+	End    time.Time
+	Points []Point
+}
+
+// Stats returns the minimum, mean, maximum and latest values of the series.
+func (s Series) Stats() (min, mean, max, last float64) {
+	if len(s.Points) == 0 {
+		return 0, 0, 0, 0
+	}
+	min, max = math.Inf(1), math.Inf(-1)
+	var sum float64
+	for _, p := range s.Points {
+		min = math.Min(min, p.Value)
+		max = math.Max(max, p.Value)
+		sum += p.Value
+	}
+	return min, sum / float64(len(s.Points)), max, s.Points[len(s.Points)-1].Value
+}
+
+// Format formats a value in the series' unit.
+func (s Series) Format(v float64) string {
+	switch s.Unit {
+	case UnitPercent:
+		return fmt.Sprintf("%.1f%%", v)
+	case UnitBytes:
+		return formatBytes(v)
+	case UnitMillis:
+		return fmt.Sprintf("%.0fms", v)
+	case "s":
+		return fmt.Sprintf("%.3fs", v)
+	default:
+		return formatCount(v)
+	}
+}
+
+func formatBytes(v float64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB", "PB"}
+	i := 0
+	for math.Abs(v) >= 1024 && i < len(units)-1 {
+		v /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f%s", v, units[i])
+	}
+	return fmt.Sprintf("%.1f%s", v, units[i])
+}
+
+func formatCount(v float64) string {
+	switch {
+	case math.Abs(v) >= 1e6:
+		return fmt.Sprintf("%.1fM", v/1e6)
+	case math.Abs(v) >= 1e3:
+		return fmt.Sprintf("%.1fk", v/1e3)
+	case v == math.Trunc(v):
+		return fmt.Sprintf("%.0f", v)
+	default:
+		return fmt.Sprintf("%.2f", v)
+	}
+}
+
+// =============================================================================
+// Source
+// =============================================================================
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// Source fetches the curated metrics of resources.
+type Source struct {
+	factory *awsfactory.ClientFactory
+	client  CloudWatchAPI
+	now     func() time.Time
+}
+
+// Option configures a Source.
+type Option func(*Source)
+
+// WithClient sets the CloudWatch client, for tests.
+func WithClient(client CloudWatchAPI) Option {
+	return func(s *Source) {
+		s.client = client
+	}
+}
+
+// New creates a source reading CloudWatch in the factory's account.
+func New(factory *awsfactory.ClientFactory, opts ...Option) *Source {
+	s := &Source{factory: factory, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// api returns the CloudWatch client, fetching fresh from factory each time.
+func (s *Source) api() CloudWatchAPI {
+	if s.client != nil {
+		return s.client
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// Fetch returns the curated metrics of a resource over a time range, in the
+// order they are curated. Metrics without datapoints are returned empty.
+func (s *Source) Fetch(ctx context.Context, r *core.Resource, rng Range) ([]Series, error) {
+	sp, ok := specs[r.Type]
+	if !ok {
+		return nil, fmt.Errorf("no metrics are charted for %s resources", r.Type)
+	}
+	value := sp.value(r)
+	if value == "" {
+		return nil, fmt.Errorf("cannot tell the CloudWatch dimension of %s", r.ID)
+	}
+
+	end := s.now().Truncate(time.Minute)
+	start := end.Add(-rng.Duration)
+	period := rng.period(sp.minPeriod)
+	namespace := sp.namespace(r)
+
+	series := make([]Series, len(sp.metrics))
+	queries := make([]cwtypes.MetricDataQuery, len(sp.metrics))
+	for i, m := range sp.metrics {
+		dimensions := append([]cwtypes.Dimension{{Name: aws.String(sp.dimension), Value: aws.String(value)}}, m.dimensions...)
+		queries[i] = cwtypes.MetricDataQuery{
+			Id: aws.String(fmt.Sprintf("m%d", i)),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String(namespace),
+					MetricName: aws.String(m.name),
+					Dimensions: dimensions,
+				},
+				Period: aws.Int32(period),
+				Stat:   aws.String(m.stat),
+			},
+		}
+		series[i] = Series{
+			Label:  m.label,
+			Metric: namespace + "/" + m.name,
+			Stat:   m.stat,
+			Unit:   m.unit,
+			Start:  start,
+			End:    end,
+		}
+	}
+
+	// Metrics are published in the resource's region, which for buckets may
+	// not be the current one
+	var optFns []func(*cloudwatch.Options)
+	if region := r.Region; strings.Count(region, "-") >= 2 {
+		optFns = append(optFns, func(o *cloudwatch.Options) {
+			o.Region = region
+		})
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(start),
+		EndTime:           aws.Time(end),
+		MetricDataQueries: queries,
+		ScanBy:            cwtypes.ScanByTimestampAscending,
+	}
+	for {
+		out, err := s.api().GetMetricData(ctx, input, optFns...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metrics of %s: %w", r.ID, err)
+		}
+		for _, result := range out.MetricDataResults {
+			var i int
+			if _, err := fmt.Sscanf(aws.ToString(result.Id), "m%d", &i); err != nil || i >= len(series) {
+				continue
+			}
+			for j, t := range result.Timestamps {
+				if j < len(result.Values) {
+					series[i].Points = append(series[i].Points, Point{Time: t, Value: result.Values[j]})
+				}
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+	return series, nil
+}
@@ -0,0 +1,184 @@
+// Package notes keeps local annotations on AWS resources.
+//
+// Notes let operators record context such as "pending decommission"
+// without touching AWS tags. They live in a JSON file in the state
+// directory, keyed by resource ARN, and never leave the machine unless
+// exported.
+package notes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Note is an annotation on a resource.
+type Note struct {
+	Key       string    `json:"key"`      // Resource ARN, see Key
+	Resource  string    `json:"resource"` // Display name when the note was written
+	Text      string    `json:"text"`
+	Author    string    `json:"author,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Key returns the key notes on a resource are stored under: its ARN, or
+// type:region:id for resources listed without one.
+func Key(r *core.Resource) string {
+	if r.ARN != "" {
+		return r.ARN
+	}
+	return r.Type + ":" + r.Region + ":" + r.ID
+}
+
+// =============================================================================
+// Store
+// =============================================================================
+
+// Store persists notes in a JSON file.
+type Store struct {
+	mu   sync.Mutex
+	path string
+	now  func() time.Time
+}
+
+// NewStore creates a store backed by the file at path.
+func NewStore(path string) *Store {
+	return &Store{
+		path: path,
+		now:  time.Now,
+	}
+}
+
+// DefaultPath returns the default store location inside stateDir.
+func DefaultPath(stateDir string) string {
+	return filepath.Join(stateDir, "notes.json")
+}
+
+// Path returns the file backing the store.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns all notes ordered by key.
+func (s *Store) List() ([]Note, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notes, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Note, 0, len(notes))
+	for _, n := range notes {
+		list = append(list, n)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+	return list, nil
+}
+
+// Get returns the note stored under key, if any.
+func (s *Store) Get(key string) (Note, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notes, err := s.load()
+	if err != nil {
+		return Note{}, false, err
+	}
+	n, ok := notes[key]
+	return n, ok, nil
+}
+
+// Set writes the note for key. Setting empty text deletes the note.
+func (s *Store) Set(key, resource, text, author string) (Note, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notes, err := s.load()
+	if err != nil {
+		return Note{}, err
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		delete(notes, key)
+		return Note{}, s.save(notes)
+	}
+
+	n := Note{
+		Key:       key,
+		Resource:  resource,
+		Text:      text,
+		Author:    author,
+		UpdatedAt: s.now(),
+	}
+	notes[key] = n
+	return n, s.save(notes)
+}
+
+// Delete removes the note for key. Deleting a missing note is not an error.
+func (s *Store) Delete(key string) error {
+	_, err := s.Set(key, "", "", "")
+	return err
+}
+
+// load reads all notes, keyed by resource.
+func (s *Store) load() (map[string]Note, error) {
+	notes := make(map[string]Note)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return notes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notes: %w", err)
+	}
+
+	var list []Note
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse notes %s: %w", s.path, err)
+	}
+	for _, n := range list {
+		notes[n.Key] = n
+	}
+	return notes, nil
+}
+
+// save writes all notes atomically.
+func (s *Store) save(notes map[string]Note) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create notes directory: %w", err)
+	}
+
+	list := make([]Note, 0, len(notes))
+	for _, n := range notes {
+		list = append(list, n)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode notes: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write notes: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write notes: %w", err)
+	}
+	return nil
+}
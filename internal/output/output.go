@@ -0,0 +1,149 @@
+// Package output renders the results of headless commands in the formats
+// of core.OutputFormat.
+//
+// Commands describe their result once as Data: the value itself, encoded
+// as-is by the JSON and YAML writers, and the same result as rows for the
+// table and CSV writers. Writers are looked up by format, so a format can
+// be added or replaced with Register.
+package output
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Column is a column of tabular output.
+type Column struct {
+	Name  string // Identifier used for column selection and as CSV header
+	Title string // Table header; defaults to Name in upper case
+}
+
+// header returns the table header of a column.
+func (c Column) header() string {
+	if c.Title != "" {
+		return c.Title
+	}
+	return strings.ToUpper(c.Name)
+}
+
+// Data is the result of a command.
+type Data struct {
+	Value   any        // Encoded by the JSON and YAML writers
+	Columns []Column   // Columns of Rows
+	Rows    [][]string // Rendered by the table and CSV writers
+	Empty   string     // Printed by the table writer instead of an empty table
+}
+
+// Select keeps only the named columns, in the given order. The Value is
+// left unchanged.
+func (d Data) Select(names ...string) (Data, error) {
+	indexes := make([]int, len(names))
+	for i, name := range names {
+		indexes[i] = slices.IndexFunc(d.Columns, func(c Column) bool {
+			return strings.EqualFold(c.Name, name)
+		})
+		if indexes[i] < 0 {
+			return d, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(d.ColumnNames(), ", "))
+		}
+	}
+
+	selected := d
+	selected.Columns = make([]Column, len(indexes))
+	for i, index := range indexes {
+		selected.Columns[i] = d.Columns[index]
+	}
+	selected.Rows = make([][]string, len(d.Rows))
+	for r, row := range d.Rows {
+		selected.Rows[r] = make([]string, len(indexes))
+		for i, index := range indexes {
+			if index < len(row) {
+				selected.Rows[r][i] = row[index]
+			}
+		}
+	}
+	return selected, nil
+}
+
+// ColumnNames returns the names of the columns.
+func (d Data) ColumnNames() []string {
+	names := make([]string, len(d.Columns))
+	for i, c := range d.Columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// =============================================================================
+// Writers
+// =============================================================================
+
+// Writer renders Data in one format.
+type Writer interface {
+	Write(w io.Writer, data Data) error
+}
+
+// WriterFunc adapts a function to a Writer.
+type WriterFunc func(w io.Writer, data Data) error
+
+// Write implements Writer.
+func (f WriterFunc) Write(w io.Writer, data Data) error {
+	return f(w, data)
+}
+
+var (
+	writersMu sync.RWMutex
+	writers   = map[core.OutputFormat]Writer{
+		core.FormatJSON:  WriterFunc(writeJSON),
+		core.FormatYAML:  WriterFunc(writeYAML),
+		core.FormatCSV:   WriterFunc(writeCSV),
+		core.FormatTable: NewTableWriter(),
+	}
+)
+
+// Register sets the writer of a format, replacing any previous one.
+func Register(format core.OutputFormat, w Writer) {
+	writersMu.Lock()
+	defer writersMu.Unlock()
+	writers[format] = w
+}
+
+// Formats returns the registered formats, sorted.
+func Formats() []core.OutputFormat {
+	writersMu.RLock()
+	defer writersMu.RUnlock()
+	formats := make([]core.OutputFormat, 0, len(writers))
+	for format := range writers {
+		formats = append(formats, format)
+	}
+	slices.Sort(formats)
+	return formats
+}
+
+// For returns the writer of a format.
+func For(format core.OutputFormat) (Writer, error) {
+	writersMu.RLock()
+	w, ok := writers[format]
+	writersMu.RUnlock()
+	if !ok {
+		names := make([]string, 0, len(writers))
+		for _, f := range Formats() {
+			names = append(names, string(f))
+		}
+		return nil, fmt.Errorf("unsupported output format %q (expected %s)", format, strings.Join(names, ", "))
+	}
+	return w, nil
+}
+
+// Write renders data in a format.
+func Write(w io.Writer, format core.OutputFormat, data Data) error {
+	writer, err := For(format)
+	if err != nil {
+		return err
+	}
+	return writer.Write(w, data)
+}
@@ -0,0 +1,169 @@
+package output
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+type instance struct {
+	ID    string            `json:"id"`
+	State string            `json:"state"`
+	Tags  map[string]string `json:"tags,omitempty"`
+}
+
+func testData() Data {
+	return Data{
+		Value: []instance{
+			{ID: "i-1", State: "on", Tags: map[string]string{"Name": "web, eu"}},
+			{ID: "i-22", State: "stopped"},
+		},
+		Columns: []Column{{Name: "id", Title: "ID"}, {Name: "state"}, {Name: "name"}},
+		Rows: [][]string{
+			{"i-1", "on", "web, eu"},
+			{"i-22", "stopped", "batch\nnightly"},
+		},
+		Empty: "No instances.",
+	}
+}
+
+func TestWrite(t *testing.T) {
+	plain := NewTableWriter(WithHeaderStyle(lipgloss.NewStyle()))
+
+	tests := []struct {
+		name   string
+		writer func(w io.Writer, data Data) error
+		data   Data
+		want   string
+	}{
+		{
+			name:   "json",
+			writer: func(w io.Writer, data Data) error { return Write(w, core.FormatJSON, data) },
+			data:   testData(),
+			want: `[
+  {
+    "id": "i-1",
+    "state": "on",
+    "tags": {
+      "Name": "web, eu"
+    }
+  },
+  {
+    "id": "i-22",
+    "state": "stopped"
+  }
+]
+`,
+		},
+		{
+			// Field names follow the JSON tags; "on" stays a string
+			name:   "yaml",
+			writer: func(w io.Writer, data Data) error { return Write(w, core.FormatYAML, data) },
+			data:   testData(),
+			want: `- id: i-1
+  state: "on"
+  tags:
+    Name: web, eu
+- id: i-22
+  state: stopped
+`,
+		},
+		{
+			name:   "csv",
+			writer: func(w io.Writer, data Data) error { return Write(w, core.FormatCSV, data) },
+			data:   testData(),
+			want:   "id,state,name\ni-1,on,\"web, eu\"\ni-22,stopped,\"batch\nnightly\"\n",
+		},
+		{
+			name:   "table",
+			writer: plain.Write,
+			data:   testData(),
+			want: "ID    STATE    NAME\n" +
+				"i-1   on       web, eu\n" +
+				"i-22  stopped  batch nightly\n",
+		},
+		{
+			name:   "table with short rows",
+			writer: NewTableWriter(WithHeaderStyle(lipgloss.NewStyle()), WithGap(1)).Write,
+			data:   Data{Columns: []Column{{Name: "id"}, {Name: "état"}}, Rows: [][]string{{"i-1"}, {"i-2", "arrêté"}}},
+			want:   "ID  ÉTAT\ni-1 \ni-2 arrêté\n",
+		},
+		{
+			name:   "empty table",
+			writer: plain.Write,
+			data:   Data{Columns: testData().Columns, Empty: "No instances."},
+			want:   "No instances.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := tt.writer(&b, tt.data); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("Write() =\n%s\nwant\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		names       []string
+		wantColumns []string
+		want        [][]string
+		wantErr     bool
+	}{
+		{names: []string{"name", "ID"}, wantColumns: []string{"name", "id"}, want: [][]string{{"web, eu", "i-1"}, {"batch\nnightly", "i-22"}}},
+		{names: []string{"state"}, wantColumns: []string{"state"}, want: [][]string{{"on"}, {"stopped"}}},
+		{names: []string{"zone"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		selected, err := testData().Select(tt.names...)
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "id, state, name") {
+				t.Errorf("Select(%v) error = %v, want the available columns", tt.names, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Select(%v) error = %v", tt.names, err)
+		}
+		if !slices.Equal(selected.ColumnNames(), tt.wantColumns) {
+			t.Errorf("Select(%v) columns = %v, want %v", tt.names, selected.ColumnNames(), tt.wantColumns)
+		}
+		for i, row := range selected.Rows {
+			if !slices.Equal(row, tt.want[i]) {
+				t.Errorf("Select(%v) row %d = %v, want %v", tt.names, i, row, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	markdown := core.OutputFormat("markdown")
+	if _, err := For(markdown); err == nil || !strings.Contains(err.Error(), "csv, json, table, yaml") {
+		t.Fatalf("For(markdown) error = %v, want the supported formats", err)
+	}
+
+	Register(markdown, WriterFunc(func(w io.Writer, data Data) error {
+		_, err := io.WriteString(w, "| "+strings.Join(data.ColumnNames(), " | ")+" |\n")
+		return err
+	}))
+	t.Cleanup(func() {
+		writersMu.Lock()
+		delete(writers, markdown)
+		writersMu.Unlock()
+	})
+
+	var b strings.Builder
+	if err := Write(&b, markdown, testData()); err != nil || b.String() != "| id | state | name |\n" {
+		t.Errorf("Write(markdown) = %q, %v", b.String(), err)
+	}
+}
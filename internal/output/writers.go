@@ -0,0 +1,183 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// JSON and YAML
+// =============================================================================
+
+func writeJSON(w io.Writer, data Data) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data.Value)
+}
+
+// writeYAML converts the value through JSON so field names and omitted
+// fields match the JSON output.
+func writeYAML(w io.Writer, data Data) error {
+	raw, err := json.Marshal(data.Value)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle resets the JSON flow and quoting styles of a decoded document
+// so it is written as regular YAML. Strings YAML 1.1 parsers read as
+// booleans stay quoted.
+func blockStyle(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || node.Tag != "!!str" || !slices.Contains(yaml11Bools, strings.ToLower(node.Value)) {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// yaml11Bools are the YAML 1.1 spellings of booleans besides true and false.
+var yaml11Bools = []string{"y", "yes", "n", "no", "on", "off"}
+
+// =============================================================================
+// CSV
+// =============================================================================
+
+func writeCSV(w io.Writer, data Data) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(data.Columns))
+	for i, c := range data.Columns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(data.Rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// =============================================================================
+// Table
+// =============================================================================
+
+// TableWriter aligns rows in columns under a styled header. Styles are
+// dropped when the output is not a terminal.
+type TableWriter struct {
+	header lipgloss.Style
+	empty  lipgloss.Style
+	gap    int
+}
+
+// TableOption configures a TableWriter.
+type TableOption func(*TableWriter)
+
+// WithHeaderStyle sets the style of the header row.
+func WithHeaderStyle(style lipgloss.Style) TableOption {
+	return func(t *TableWriter) {
+		t.header = style
+	}
+}
+
+// WithEmptyStyle sets the style of the message shown instead of an empty
+// table.
+func WithEmptyStyle(style lipgloss.Style) TableOption {
+	return func(t *TableWriter) {
+		t.empty = style
+	}
+}
+
+// WithGap sets the number of spaces between columns.
+func WithGap(gap int) TableOption {
+	return func(t *TableWriter) {
+		t.gap = gap
+	}
+}
+
+// NewTableWriter creates a table writer with a bold header.
+func NewTableWriter(opts ...TableOption) *TableWriter {
+	t := &TableWriter{
+		header: lipgloss.NewStyle().Bold(true),
+		empty:  lipgloss.NewStyle(),
+		gap:    2,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Write implements Writer.
+func (t *TableWriter) Write(w io.Writer, data Data) error {
+	if len(data.Rows) == 0 && data.Empty != "" {
+		_, err := fmt.Fprintln(w, t.empty.Render(data.Empty))
+		return err
+	}
+
+	// Cells may hold multi-byte characters, so widths are measured in cells
+	widths := make([]int, len(data.Columns))
+	for i, c := range data.Columns {
+		widths[i] = lipgloss.Width(c.header())
+	}
+	for _, row := range data.Rows {
+		for i := range widths {
+			if i < len(row) {
+				widths[i] = max(widths[i], lipgloss.Width(flatten(row[i])))
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, c := range data.Columns {
+		b.WriteString(t.header.Render(c.header()))
+		t.pad(&b, widths, i, c.header())
+	}
+	b.WriteString("\n")
+	for _, row := range data.Rows {
+		for i := range widths {
+			cell := ""
+			if i < len(row) {
+				cell = flatten(row[i])
+			}
+			b.WriteString(cell)
+			t.pad(&b, widths, i, cell)
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// pad fills a cell up to its column width plus the gap, except in the last
+// column.
+func (t *TableWriter) pad(b *strings.Builder, widths []int, i int, cell string) {
+	if i == len(widths)-1 {
+		return
+	}
+	b.WriteString(strings.Repeat(" ", widths[i]-lipgloss.Width(cell)+t.gap))
+}
+
+// flatten keeps a cell on one line.
+func flatten(cell string) string {
+	return strings.ReplaceAll(cell, "\n", " ")
+}
@@ -0,0 +1,311 @@
+// Package ownership attributes resources to the IAM principal that created
+// them, using the creation events recorded by CloudTrail.
+//
+// CloudTrail event history only covers the last 90 days and LookupEvents is
+// limited to two calls per second per account and region, so lookups are
+// paced, cached for the lifetime of the resolver, and skipped for resources
+// known to be older than the retained history.
+package ownership
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+const (
+	// History is how far back CloudTrail event history goes.
+	History = 90 * 24 * time.Hour
+	// DefaultInterval paces LookupEvents calls below the API limit.
+	DefaultInterval = 600 * time.Millisecond
+	// maxPages bounds how many result pages are read per resource.
+	maxPages = 3
+)
+
+// CloudTrailAPI defines the CloudTrail client interface for mocking.
+type CloudTrailAPI interface {
+	LookupEvents(ctx context.Context, params *cloudtrail.LookupEventsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// creation describes how a resource type's creation is recorded.
+type creation struct {
+	events []string // Event names of the creating calls
+	byName bool     // Look up by resource name instead of ID
+	region string   // Region the events are recorded in, for global services
+}
+
+var creations = map[string]creation{
+	"ec2:instance":    {events: []string{"RunInstances"}},
+	"s3:bucket":       {events: []string{"CreateBucket"}, byName: true},
+	"iam:role":        {events: []string{"CreateRole"}, byName: true, region: "us-east-1"},
+	"lambda:function": {events: []string{"CreateFunction20150331", "CreateFunction"}, byName: true},
+}
+
+// =============================================================================
+// Resolver
+// =============================================================================
+
+// Resolver is a core.OwnerProvider backed by CloudTrail event history.
+type Resolver struct {
+	factory  *awsfactory.ClientFactory
+	client   CloudTrailAPI
+	interval time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	cache    map[string]cached
+	disabled bool // Set once CloudTrail denies access, to stop asking
+
+	paceMu sync.Mutex
+	next   time.Time // Earliest time of the next call
+}
+
+type cached struct {
+	owner core.Owner
+	ok    bool
+}
+
+// Ensure Resolver implements core.OwnerProvider
+var _ core.OwnerProvider = (*Resolver)(nil)
+
+// Option configures a Resolver.
+type Option func(*Resolver)
+
+// WithClient sets the CloudTrail client, for tests.
+func WithClient(client CloudTrailAPI) Option {
+	return func(r *Resolver) {
+		r.client = client
+	}
+}
+
+// WithInterval sets the minimum time between CloudTrail calls.
+func WithInterval(interval time.Duration) Option {
+	return func(r *Resolver) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// New creates a resolver for the factory's account.
+func New(factory *awsfactory.ClientFactory, opts ...Option) *Resolver {
+	r := &Resolver{
+		factory:  factory,
+		interval: DefaultInterval,
+		now:      time.Now,
+		cache:    make(map[string]cached),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// OwnerOf implements core.OwnerProvider. Resources of unsupported types or
+// created before the retained history are reported as unknown.
+func (r *Resolver) OwnerOf(ctx context.Context, resource *core.Resource) (core.Owner, bool, error) {
+	spec, supported := creations[resource.Type]
+	if !supported {
+		return core.Owner{}, false, nil
+	}
+	if resource.CreatedAt != nil && r.now().Sub(*resource.CreatedAt) > History {
+		return core.Owner{}, false, nil
+	}
+
+	name := resource.ID
+	if spec.byName {
+		name = resource.Name
+	}
+	region := spec.region
+	if region == "" && resource.Region != "unknown" {
+		region = resource.Region
+	}
+	key := resource.Type + ":" + region + ":" + name
+
+	r.mu.Lock()
+	hit, found := r.cache[key]
+	disabled := r.disabled
+	r.mu.Unlock()
+	if found {
+		return hit.owner, hit.ok, nil
+	}
+	if disabled {
+		return core.Owner{}, false, nil
+	}
+
+	owner, ok, err := r.lookup(ctx, spec, name, region, resource.CreatedAt)
+	if err != nil {
+		if denied(err) {
+			r.mu.Lock()
+			r.disabled = true
+			r.mu.Unlock()
+		}
+		return core.Owner{}, false, err
+	}
+
+	r.mu.Lock()
+	r.cache[key] = cached{owner: owner, ok: ok}
+	r.mu.Unlock()
+	return owner, ok, nil
+}
+
+// Apply records who created a resource, as reported by provider. A nil
+// provider does nothing, and lookup errors are ignored so that enrichment
+// still succeeds.
+func Apply(ctx context.Context, provider core.OwnerProvider, resource *core.Resource) {
+	if provider == nil {
+		return
+	}
+	if owner, ok, err := provider.OwnerOf(ctx, resource); err == nil && ok {
+		resource.ApplyOwner(owner)
+	}
+}
+
+// lookup searches the event history for the creating call.
+func (r *Resolver) lookup(ctx context.Context, spec creation, name, region string, createdAt *time.Time) (core.Owner, bool, error) {
+	input := &cloudtrail.LookupEventsInput{
+		LookupAttributes: []types.LookupAttribute{{
+			AttributeKey:   types.LookupAttributeKeyResourceName,
+			AttributeValue: aws.String(name),
+		}},
+	}
+	// Listed times are the creation or a later launch, never earlier
+	if createdAt != nil {
+		input.EndTime = aws.Time(createdAt.Add(time.Hour))
+	}
+
+	var optFns []func(*cloudtrail.Options)
+	if region != "" {
+		optFns = append(optFns, func(o *cloudtrail.Options) {
+			o.Region = region
+		})
+	}
+
+	for page := 0; page < maxPages; page++ {
+		if err := r.pace(ctx); err != nil {
+			return core.Owner{}, false, err
+		}
+		out, err := r.api().LookupEvents(ctx, input, optFns...)
+		if err != nil {
+			return core.Owner{}, false, fmt.Errorf("failed to look up %s in CloudTrail: %w", name, err)
+		}
+		// Events are newest first; the creation is the oldest match
+		var found *types.Event
+		for i := range out.Events {
+			if slices.Contains(spec.events, aws.ToString(out.Events[i].EventName)) {
+				found = &out.Events[i]
+			}
+		}
+		if found != nil {
+			return OwnerOfEvent(*found), true, nil
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+	return core.Owner{}, false, nil
+}
+
+// pace waits until the next call is allowed.
+func (r *Resolver) pace(ctx context.Context) error {
+	r.paceMu.Lock()
+	now := r.now()
+	wait := r.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	r.next = now.Add(wait + r.interval)
+	r.paceMu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (r *Resolver) api() CloudTrailAPI {
+	if r.client != nil {
+		return r.client
+	}
+	return r.factory.CloudTrailClient()
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// denied reports whether err means the caller may not read CloudTrail.
+func denied(err error) bool {
+	var coded interface{ ErrorCode() string }
+	if !errors.As(err, &coded) {
+		return false
+	}
+	switch coded.ErrorCode() {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation", "OperationNotPermittedException":
+		return true
+	}
+	return false
+}
+
+// userIdentity is the part of a CloudTrail record naming the caller.
+type userIdentity struct {
+	Type      string `json:"type"`
+	ARN       string `json:"arn"`
+	UserName  string `json:"userName"`
+	InvokedBy string `json:"invokedBy"`
+}
+
+// OwnerOfEvent extracts the calling principal from an event.
+func OwnerOfEvent(event types.Event) core.Owner {
+	owner := core.Owner{
+		Principal: aws.ToString(event.Username),
+		CreatedAt: event.EventTime,
+	}
+
+	var record struct {
+		UserIdentity userIdentity `json:"userIdentity"`
+	}
+	if err := json.Unmarshal([]byte(aws.ToString(event.CloudTrailEvent)), &record); err != nil {
+		return owner
+	}
+	id := record.UserIdentity
+	owner.ARN = id.ARN
+
+	switch id.Type {
+	case "IAMUser":
+		owner.Principal = id.UserName
+	case "AssumedRole":
+		// arn:aws:sts::123456789012:assumed-role/Role/session -> Role/session
+		if _, rest, ok := strings.Cut(id.ARN, ":assumed-role/"); ok {
+			owner.Principal = rest
+		}
+	case "Root":
+		owner.Principal = "root"
+	case "AWSService":
+		owner.Principal = id.InvokedBy
+	}
+	if owner.Principal == "" {
+		owner.Principal = id.ARN
+	}
+	return owner
+}
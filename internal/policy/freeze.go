@@ -0,0 +1,140 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Maintenance Windows
+// =============================================================================
+
+// MaxWindowDuration bounds how long a maintenance window lasts after each
+// start of its schedule.
+const MaxWindowDuration = 7 * 24 * time.Hour
+
+// Window is a recurring maintenance or freeze window. It opens at every
+// time its schedule fires and stays open for Duration. While open,
+// dangerous actions are blocked or, when Override is set, run only once
+// the operator gives a reason.
+type Window struct {
+	Name         string
+	Schedule     Schedule
+	Duration     time.Duration
+	Location     *time.Location // Zone of the schedule; local time when nil
+	Environments []string       // Environment names; empty applies everywhere
+	Override     bool           // Dangerous actions may run with a reason
+}
+
+// OpenAt returns when the window opened if it is open at t.
+func (w Window) OpenAt(t time.Time) (time.Time, bool) {
+	loc := w.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	t = t.In(loc)
+	minute := t.Truncate(time.Minute)
+	for start := minute; t.Sub(start) < w.Duration; start = start.Add(-time.Minute) {
+		if w.Schedule.Matches(start) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Freeze is a core.ActionGuard holding back dangerous actions during
+// maintenance windows. Overrides are dispatched as
+// core.EventFreezeOverridden events, which the audit log records.
+type Freeze struct {
+	windows      []Window
+	environments []Environment
+	context      func() (profile, region string)
+	dispatcher   core.EventDispatcher
+	now          func() time.Time
+}
+
+// Ensure Freeze implements core.ActionGuard
+var _ core.ActionGuard = (*Freeze)(nil)
+
+// NewFreeze creates a guard enforcing windows. context reports the current
+// AWS profile and region, matched against environments for windows limited
+// to some of them; dispatcher receives overrides and may be nil.
+func NewFreeze(windows []Window, environments []Environment, context func() (profile, region string), dispatcher core.EventDispatcher) *Freeze {
+	return &Freeze{
+		windows:      windows,
+		environments: environments,
+		context:      context,
+		dispatcher:   dispatcher,
+		now:          time.Now,
+	}
+}
+
+// Open returns the window open at t in the given environments, preferring
+// one that blocks over one that can be overridden, and when it opened.
+func (f *Freeze) Open(environments []string, t time.Time) (Window, time.Time, bool) {
+	var found Window
+	var opened time.Time
+	ok := false
+	for _, w := range f.windows {
+		if len(w.Environments) > 0 && !slices.ContainsFunc(w.Environments, func(env string) bool { return slices.Contains(environments, env) }) {
+			continue
+		}
+		start, open := w.OpenAt(t)
+		if !open || (ok && !found.Override) {
+			continue
+		}
+		found, opened, ok = w, start, true
+	}
+	return found, opened, ok
+}
+
+// Check implements core.ActionGuard.
+func (f *Freeze) Check(ctx context.Context, req core.ActionRequest) error {
+	if !req.Action.Dangerous {
+		return nil
+	}
+
+	profile, region := f.context()
+	var active []string
+	for _, env := range f.environments {
+		if env.Matches(profile, region) {
+			active = append(active, env.Name)
+		}
+	}
+	w, opened, ok := f.Open(active, f.now())
+	if !ok {
+		return nil
+	}
+	until := opened.Add(w.Duration).Format("Mon 15:04 MST")
+
+	if !w.Override {
+		return fmt.Errorf("%w: %s:%s is frozen by maintenance window %s until %s", core.ErrActionBlocked, req.Service, req.Action.Name, w.Name, until)
+	}
+	reason, _ := req.Params[core.ParamOverrideReason].(string)
+	if reason = strings.TrimSpace(reason); reason == "" {
+		return &core.ConfirmationError{
+			Request: req,
+			Param:   core.ParamOverrideReason,
+			Justify: true,
+			Reason:  fmt.Sprintf("Maintenance window %s holds back dangerous actions until %s; give a reason to override it", w.Name, until),
+		}
+	}
+
+	if f.dispatcher != nil {
+		_ = f.dispatcher.Dispatch(ctx, core.NewEvent(core.EventFreezeOverridden, req.Service, core.ActionEventData{
+			Action:     req.Action.Name,
+			ResourceID: req.ResourceID,
+			Params: map[string]any{
+				"window": w.Name,
+				"reason": reason,
+				"opened": opened,
+			},
+		}))
+	}
+	return nil
+}
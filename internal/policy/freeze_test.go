@@ -0,0 +1,201 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		expr    string
+		at      time.Time
+		want    bool
+		wantErr bool
+	}{
+		{expr: "0 18 * * FRI", at: time.Date(2026, 10, 16, 18, 0, 0, 0, time.UTC), want: true},
+		{expr: "0 18 * * FRI", at: time.Date(2026, 10, 17, 18, 0, 0, 0, time.UTC), want: false},
+		{expr: "*/15 9-17 * * mon-fri", at: time.Date(2026, 10, 14, 12, 45, 0, 0, time.UTC), want: true},
+		{expr: "*/15 9-17 * * mon-fri", at: time.Date(2026, 10, 14, 12, 50, 0, 0, time.UTC), want: false},
+		{expr: "0 0 24 DEC *", at: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC), want: true},
+		{expr: "0 0 * * 7", at: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), want: true},
+		// Both days restricted: either matches
+		{expr: "0 0 1 * MON", at: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), want: true},
+		{expr: "0 18 * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "0 5-1 * * *", wantErr: true},
+		{expr: "0 0 * * FUN", wantErr: true},
+		// A step past the range matches its start only
+		{expr: "59/9223372036854775807 * * * *", at: time.Date(2026, 10, 16, 10, 59, 0, 0, time.UTC), want: true},
+		{expr: "59/9223372036854775807 * * * *", at: time.Date(2026, 10, 16, 10, 31, 0, 0, time.UTC), want: false},
+		{expr: "0/9223372036854775808 * * * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSchedule(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			continue
+		}
+		if err == nil && s.Matches(tt.at) != tt.want {
+			t.Errorf("%q matches %s = %v, want %v", tt.expr, tt.at, !tt.want, tt.want)
+		}
+	}
+}
+
+// FuzzParseSchedule checks that any schedule accepted in a maintenance
+// window only allows values within the bounds of each field, that steps
+// only allow values a whole number of steps from their start, and that it
+// parses back the same from its String.
+func FuzzParseSchedule(f *testing.F) {
+	for _, seed := range []string{
+		"0 18 * * FRI",
+		"*/15 9-17 * * mon-fri",
+		"0 0 24 DEC *",
+		"0 0 1 * MON,7",
+		"59/9223372036854775807 * * * *",
+		"0/9223372036854775808 * * * *",
+		"5/0 * * * *",
+		"0 5-1 * * *",
+		"? ? ? ? ?",
+		"",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		s, err := ParseSchedule(expr)
+		if err != nil {
+			return
+		}
+		fields := []field{s.minute, s.hour, s.dom, s.month, s.dow}
+		for i, spec := range cronFields {
+			allowed := field(1)<<uint(spec.max+1) - field(1)<<uint(spec.min)
+			if fields[i]&^allowed != 0 {
+				t.Fatalf("ParseSchedule(%q) allows %s values outside %d-%d: %b", expr, spec.name, spec.min, spec.max, fields[i])
+			}
+		}
+		for i, part := range strings.Fields(expr) {
+			checkSteps(t, expr, part, i, fields[i])
+		}
+		again, err := ParseSchedule(s.String())
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) rejects its own String %q: %v", expr, s.String(), err)
+		}
+		if again != s {
+			t.Fatalf("ParseSchedule(%q) = %+v, parsing its String gives %+v", expr, s, again)
+		}
+	})
+}
+
+// checkSteps checks the values f allows for the i-th field, part, when
+// every item of it has a step: each value must be a whole number of steps
+// from the start of an item.
+func checkSteps(t *testing.T, expr, part string, i int, f field) {
+	t.Helper()
+	type item struct {
+		from int
+		step uint64
+	}
+	var items []item
+	for _, it := range strings.Split(part, ",") {
+		base, stepPart, ok := strings.Cut(it, "/")
+		if !ok {
+			return
+		}
+		n, _ := strconv.Atoi(stepPart)
+		from := cronFields[i].min
+		if base != "*" && base != "?" {
+			start, _, _ := strings.Cut(base, "-")
+			v, err := parseValue(start, i)
+			if err != nil {
+				return
+			}
+			from = v
+		}
+		items = append(items, item{from: from, step: uint64(n)})
+	}
+
+	for v := cronFields[i].min; v <= cronFields[i].max; v++ {
+		if !f.has(v) || (i == 4 && v == 0 && f.has(7)) {
+			continue
+		}
+		onStep := false
+		for _, it := range items {
+			if v >= it.from && uint64(v-it.from)%it.step == 0 {
+				onStep = true
+			}
+		}
+		if !onStep {
+			t.Fatalf("ParseSchedule(%q) allows %s %d, not a whole number of steps from %q", expr, cronFields[i].name, v, part)
+		}
+	}
+}
+
+// recorder keeps the events dispatched to it.
+type recorder struct {
+	events []core.Event
+}
+
+func (r *recorder) Dispatch(_ context.Context, event core.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recorder) Register(core.Hook)      {}
+func (r *recorder) Unregister(string)       {}
+func (r *recorder) Use(core.HookMiddleware) {}
+
+func TestFreeze(t *testing.T) {
+	weekend, _ := ParseSchedule("0 18 * * FRI")
+	nightly, _ := ParseSchedule("0 22 * * *")
+	events := &recorder{}
+	freeze := NewFreeze([]Window{
+		{Name: "weekend", Schedule: weekend, Duration: 63 * time.Hour, Location: time.UTC, Environments: []string{"prod"}},
+		{Name: "nightly", Schedule: nightly, Duration: 2 * time.Hour, Location: time.UTC, Override: true},
+	}, []Environment{{Name: "prod", Profiles: []string{"prod-*"}}}, func() (string, string) { return "dev", "eu-west-1" }, events)
+
+	terminate := core.ActionRequest{Service: "ec2", Action: core.Action{Name: "terminate", Dangerous: true}, ResourceID: "i-1"}
+
+	// Saturday noon: the weekend freeze only covers prod
+	freeze.now = func() time.Time { return time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) }
+	if err := freeze.Check(context.Background(), terminate); err != nil {
+		t.Errorf("Check() outside prod error = %v", err)
+	}
+	freeze.context = func() (string, string) { return "prod-admin", "eu-west-1" }
+	if err := freeze.Check(context.Background(), terminate); !errors.Is(err, core.ErrActionBlocked) {
+		t.Errorf("Check() during the weekend freeze error = %v, want blocked", err)
+	}
+	if err := freeze.Check(context.Background(), core.ActionRequest{Service: "ec2", Action: core.Action{Name: "describe"}}); err != nil {
+		t.Errorf("Check() on a safe action error = %v", err)
+	}
+
+	// Monday 23:30: the nightly window asks for a reason
+	freeze.now = func() time.Time { return time.Date(2026, 10, 19, 23, 30, 0, 0, time.UTC) }
+	var confirm *core.ConfirmationError
+	if err := freeze.Check(context.Background(), terminate); !errors.As(err, &confirm) || !confirm.Justify || confirm.ConfirmParam() != core.ParamOverrideReason {
+		t.Fatalf("Check() during the nightly window error = %v, want a reason asked", err)
+	}
+	terminate.Params = map[string]any{core.ParamOverrideReason: "  incident 42  "}
+	if err := freeze.Check(context.Background(), terminate); err != nil {
+		t.Fatalf("Check() with a reason error = %v", err)
+	}
+	if len(events.events) != 1 || events.events[0].Type() != core.EventFreezeOverridden {
+		t.Fatalf("events = %v, want the override", events.events)
+	}
+	data, _ := events.events[0].Data().(core.ActionEventData)
+	if data.Params["reason"] != "incident 42" || data.Params["window"] != "nightly" {
+		t.Errorf("override event data = %+v", data)
+	}
+
+	// Tuesday 00:00: the nightly window closed
+	freeze.now = func() time.Time { return time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC) }
+	terminate.Params = nil
+	if err := freeze.Check(context.Background(), terminate); err != nil {
+		t.Errorf("Check() after the window error = %v", err)
+	}
+}
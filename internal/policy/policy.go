@@ -0,0 +1,193 @@
+// Package policy decides how much confirmation an action needs before it
+// runs, depending on the environment a9s is pointed at.
+//
+// Environments are named sets of AWS profiles and regions, for example
+// "prod" for every profile matching prod-*. Rules select actions by
+// category, name or danger and assign them a level: none, confirm, double
+// (type the resource name) or block. When several rules match, the
+// strictest level applies, so a broad rule can never weaken a narrow one.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Level is how much confirmation an action needs.
+type Level string
+
+const (
+	LevelNone    Level = "none"
+	LevelConfirm Level = "confirm"
+	LevelDouble  Level = "double"
+	LevelBlock   Level = "block"
+)
+
+// levels orders the levels from least to most strict.
+var levels = []Level{LevelNone, LevelConfirm, LevelDouble, LevelBlock}
+
+// ParseLevel validates a level name.
+func ParseLevel(s string) (Level, error) {
+	l := Level(strings.ToLower(s))
+	if !slices.Contains(levels, l) {
+		return "", fmt.Errorf("unknown level %q (expected none, confirm, double or block)", s)
+	}
+	return l, nil
+}
+
+// stricter reports whether l requires more than other.
+func (l Level) stricter(other Level) bool {
+	return slices.Index(levels, l) > slices.Index(levels, other)
+}
+
+// =============================================================================
+// Environments and Rules
+// =============================================================================
+
+// Environment names a set of AWS profiles and regions.
+type Environment struct {
+	Name     string
+	Profiles []string // Profile name patterns, e.g. prod-*
+	Regions  []string // Region patterns, e.g. eu-*
+}
+
+// Matches reports whether the profile or region belongs to the environment.
+func (e Environment) Matches(profile, region string) bool {
+	return matchAny(e.Profiles, profile) || matchAny(e.Regions, region)
+}
+
+// Rule assigns a level to the actions it selects. Empty selectors match
+// everything.
+type Rule struct {
+	Environments []string // Environment names; empty applies everywhere
+	Categories   []string // Action categories, e.g. lifecycle
+	Actions      []string // service:action patterns, e.g. *:terminate
+	Dangerous    bool     // Only actions marked dangerous
+	Level        Level
+}
+
+// selects reports whether the rule applies to an action.
+func (r Rule) selects(service string, action core.Action) bool {
+	if r.Dangerous && !action.Dangerous {
+		return false
+	}
+	if len(r.Categories) > 0 && !slices.Contains(r.Categories, action.Category) {
+		return false
+	}
+	if len(r.Actions) > 0 && !matchAny(r.Actions, service+":"+action.Name) {
+		return false
+	}
+	return true
+}
+
+// =============================================================================
+// Policy
+// =============================================================================
+
+// Policy is a core.ActionGuard enforcing confirmation levels.
+type Policy struct {
+	environments []Environment
+	rules        []Rule
+	context      func() (profile, region string)
+}
+
+// Ensure Policy implements core.ActionGuard
+var _ core.ActionGuard = (*Policy)(nil)
+
+// New creates a policy. context reports the current AWS profile and region;
+// it is called on every check so profile switches take effect immediately.
+func New(environments []Environment, rules []Rule, context func() (profile, region string)) *Policy {
+	return &Policy{
+		environments: environments,
+		rules:        rules,
+		context:      context,
+	}
+}
+
+// Environments returns the names of the environments matching a context.
+func (p *Policy) Environments(profile, region string) []string {
+	var names []string
+	for _, env := range p.environments {
+		if env.Matches(profile, region) {
+			names = append(names, env.Name)
+		}
+	}
+	return names
+}
+
+// Level returns the level required for an action in the given environments
+// and the environment that imposed it, if the deciding rule named one.
+func (p *Policy) Level(environments []string, service string, action core.Action) (Level, string) {
+	level, from := LevelNone, ""
+	for _, rule := range p.rules {
+		env, ok := ruleEnvironment(rule, environments)
+		if !ok || !rule.selects(service, action) || !rule.Level.stricter(level) {
+			continue
+		}
+		level, from = rule.Level, env
+	}
+	return level, from
+}
+
+// Check implements core.ActionGuard.
+func (p *Policy) Check(_ context.Context, req core.ActionRequest) error {
+	profile, region := p.context()
+	level, env := p.Level(p.Environments(profile, region), req.Service, req.Action)
+
+	switch level {
+	case LevelBlock:
+		if env == "" {
+			return fmt.Errorf("%w: %s:%s", core.ErrActionBlocked, req.Service, req.Action.Name)
+		}
+		return fmt.Errorf("%w: %s:%s is not allowed in %s", core.ErrActionBlocked, req.Service, req.Action.Name, env)
+	case LevelDouble:
+		// Answered with the policy's parameter like LevelConfirm, so that
+		// the service still asks its own question
+		confirmed, _ := req.Params[core.ParamConfirmPolicy].(bool)
+		if typed, _ := req.Params[core.ParamConfirmResource].(string); confirmed && typed != "" && typed == req.ResourceID {
+			return nil
+		}
+		return &core.ConfirmationError{Request: req, TypeResource: true, Environment: env, Param: core.ParamConfirmPolicy}
+	case LevelConfirm:
+		// A dedicated parameter, so the operator's answer to the service's
+		// own confirmation does not also answer the policy's
+		if confirmed, _ := req.Params[core.ParamConfirmPolicy].(bool); confirmed {
+			return nil
+		}
+		return &core.ConfirmationError{Request: req, Environment: env, Param: core.ParamConfirmPolicy}
+	}
+	return nil
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// ruleEnvironment reports whether a rule applies in the active environments
+// and which of them it was written for.
+func ruleEnvironment(rule Rule, active []string) (string, bool) {
+	if len(rule.Environments) == 0 {
+		return "", true
+	}
+	for _, env := range rule.Environments {
+		if slices.Contains(active, env) {
+			return env, true
+		}
+	}
+	return "", false
+}
+
+// matchAny reports whether s matches one of the glob patterns.
+func matchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+var testEnvironments = []Environment{
+	{Name: "prod", Profiles: []string{"prod-*"}},
+	{Name: "eu", Regions: []string{"eu-*"}},
+}
+
+func TestEnvironments(t *testing.T) {
+	p := New(testEnvironments, nil, nil)
+
+	tests := []struct {
+		profile, region string
+		want            []string
+	}{
+		{profile: "prod-payments", region: "us-east-1", want: []string{"prod"}},
+		{profile: "prod-payments", region: "eu-west-1", want: []string{"prod", "eu"}},
+		{profile: "staging", region: "eu-central-1", want: []string{"eu"}},
+		{profile: "production", region: "us-east-1", want: nil},
+		{profile: "", region: "", want: nil},
+	}
+	for _, tt := range tests {
+		if got := p.Environments(tt.profile, tt.region); !slices.Equal(got, tt.want) {
+			t.Errorf("Environments(%q, %q) = %v, want %v", tt.profile, tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestLevel(t *testing.T) {
+	p := New(testEnvironments, []Rule{
+		{Categories: []string{"lifecycle"}, Level: LevelConfirm},
+		{Environments: []string{"prod"}, Dangerous: true, Level: LevelDouble},
+		{Environments: []string{"prod"}, Actions: []string{"*:terminate"}, Level: LevelBlock},
+		// A weaker rule never lowers a stricter one
+		{Environments: []string{"eu"}, Level: LevelNone},
+	}, nil)
+
+	stop := core.Action{Name: "stop", Category: "lifecycle"}
+	deleteVolume := core.Action{Name: "delete", Category: "cleanup", Dangerous: true}
+	terminate := core.Action{Name: "terminate", Category: "lifecycle", Dangerous: true}
+	describe := core.Action{Name: "describe", Category: "inspect"}
+
+	tests := []struct {
+		environments []string
+		service      string
+		action       core.Action
+		want         Level
+		wantEnv      string
+	}{
+		{service: "ec2", action: describe, want: LevelNone},
+		{service: "ec2", action: stop, want: LevelConfirm},
+		{environments: []string{"eu"}, service: "ec2", action: stop, want: LevelConfirm},
+		{service: "ebs", action: deleteVolume, want: LevelNone},
+		{environments: []string{"prod"}, service: "ebs", action: deleteVolume, want: LevelDouble, wantEnv: "prod"},
+		{environments: []string{"eu", "prod"}, service: "ec2", action: terminate, want: LevelBlock, wantEnv: "prod"},
+		{environments: []string{"eu"}, service: "ec2", action: terminate, want: LevelConfirm},
+	}
+	for _, tt := range tests {
+		level, env := p.Level(tt.environments, tt.service, tt.action)
+		if level != tt.want || env != tt.wantEnv {
+			t.Errorf("Level(%v, %s:%s) = %s, %q, want %s, %q", tt.environments, tt.service, tt.action.Name, level, env, tt.want, tt.wantEnv)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	profile := "prod-payments"
+	p := New(testEnvironments, []Rule{
+		{Categories: []string{"lifecycle"}, Level: LevelConfirm},
+		{Environments: []string{"prod"}, Dangerous: true, Level: LevelDouble},
+		{Environments: []string{"prod"}, Actions: []string{"iam:*"}, Level: LevelBlock},
+	}, func() (string, string) { return profile, "us-east-1" })
+	ctx := context.Background()
+
+	stop := core.ActionRequest{Service: "ec2", Action: core.Action{Name: "stop", Category: "lifecycle"}, ResourceID: "i-1"}
+	var confirm *core.ConfirmationError
+	if err := p.Check(ctx, stop); !errors.As(err, &confirm) || confirm.TypeResource || confirm.ConfirmParam() != core.ParamConfirmPolicy {
+		t.Fatalf("Check(stop) = %v, want a policy confirmation", err)
+	}
+	// The service's own confirmation does not answer the policy's
+	stop.Params = map[string]any{core.ParamConfirm: true}
+	if err := p.Check(ctx, stop); !errors.As(err, &confirm) {
+		t.Errorf("Check(stop) confirmed for the service = %v, want a policy confirmation", err)
+	}
+	stop.Params = map[string]any{core.ParamConfirmPolicy: true}
+	if err := p.Check(ctx, stop); err != nil {
+		t.Errorf("Check(stop) confirmed = %v", err)
+	}
+
+	terminate := core.ActionRequest{Service: "ec2", Action: core.Action{Name: "terminate", Dangerous: true}, ResourceID: "i-1"}
+	if err := p.Check(ctx, terminate); !errors.As(err, &confirm) || !confirm.TypeResource || confirm.Environment != "prod" || confirm.ConfirmParam() != core.ParamConfirmPolicy {
+		t.Fatalf("Check(terminate) = %v, want the resource typed for the policy in prod", err)
+	}
+	terminate.Params = map[string]any{core.ParamConfirmPolicy: true, core.ParamConfirmResource: "i-2"}
+	if err := p.Check(ctx, terminate); err == nil {
+		t.Error("Check(terminate) with another resource typed succeeded")
+	}
+	// The service's own typed confirmation does not answer the policy's
+	terminate.Params = map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "i-1"}
+	if err := p.Check(ctx, terminate); !errors.As(err, &confirm) {
+		t.Errorf("Check(terminate) typed for the service = %v, want a policy confirmation", err)
+	}
+	terminate.Params = map[string]any{core.ParamConfirmPolicy: true, core.ParamConfirmResource: "i-1"}
+	if err := p.Check(ctx, terminate); err != nil {
+		t.Errorf("Check(terminate) typed = %v", err)
+	}
+
+	deleteRole := core.ActionRequest{Service: "iam", Action: core.Action{Name: "delete_role"}, ResourceID: "ci"}
+	if err := p.Check(ctx, deleteRole); !errors.Is(err, core.ErrActionBlocked) {
+		t.Errorf("Check(delete_role) = %v, want blocked", err)
+	}
+
+	// Switching profile leaves prod
+	profile = "staging"
+	if err := p.Check(ctx, deleteRole); err != nil {
+		t.Errorf("Check(delete_role) outside prod = %v", err)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, s := range []string{"none", "Confirm", "DOUBLE", "block"} {
+		if _, err := ParseLevel(s); err != nil {
+			t.Errorf("ParseLevel(%q) error = %v", s, err)
+		}
+	}
+	if _, err := ParseLevel("twice"); err == nil {
+		t.Error("ParseLevel(\"twice\") succeeded")
+	}
+}
@@ -0,0 +1,161 @@
+package policy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Cron Schedules
+// =============================================================================
+
+// Schedule is a parsed five-field cron expression: minute, hour, day of
+// month, month and day of week. Fields accept *, lists, ranges and steps
+// such as 1-5 or */15, and names such as MON or JAN. As in cron, when both
+// days are restricted a time matches either of them.
+type Schedule struct {
+	expr             string
+	minute, hour     field
+	dom, month, dow  field
+	domStar, dowStar bool
+}
+
+// field holds the allowed values of a cron field as bits.
+type field uint64
+
+func (f field) has(v int) bool { return f&(1<<uint(v)) != 0 }
+
+// cronFields describes each field: its bounds and value names.
+var cronFields = []struct {
+	name     string
+	min, max int
+	names    []string // Names of the values from min, if any
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// ParseSchedule parses a five-field cron expression, such as
+// "0 18 * * FRI" for Fridays at 18:00.
+func ParseSchedule(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: expected 5 fields (minute hour day-of-month month day-of-week)", expr)
+	}
+
+	var fields [5]field
+	for i, part := range parts {
+		f, err := parseField(part, i)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+		fields[i] = f
+	}
+	// Sunday is 0 or 7
+	if fields[4].has(7) {
+		fields[4] |= 1
+	}
+
+	return Schedule{
+		expr:    strings.Join(parts, " "),
+		minute:  fields[0],
+		hour:    fields[1],
+		dom:     fields[2],
+		month:   fields[3],
+		dow:     fields[4],
+		domStar: parts[2] == "*" || parts[2] == "?",
+		dowStar: parts[4] == "*" || parts[4] == "?",
+	}, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// Matches reports whether the schedule fires at t's minute, in t's zone.
+func (s Schedule) Matches(t time.Time) bool {
+	if !s.minute.has(t.Minute()) || !s.hour.has(t.Hour()) || !s.month.has(int(t.Month())) {
+		return false
+	}
+	dom, dow := s.dom.has(t.Day()), s.dow.has(int(t.Weekday()))
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	}
+	return dom || dow
+}
+
+// parseField parses the i-th field of a cron expression.
+func parseField(s string, i int) (field, error) {
+	spec := cronFields[i]
+	var f field
+	for _, item := range strings.Split(s, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s", stepPart, spec.name)
+			}
+			// A step past the range matches its start only; capping it
+			// keeps the loop below from overflowing
+			step = min(n, spec.max-spec.min+1)
+		}
+
+		lo, hi := spec.min, spec.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(from, i); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(to, i); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s", rangePart, spec.name)
+			}
+		default:
+			v, err := parseValue(rangePart, i)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if !hasStep {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			f |= 1 << uint(v)
+		}
+	}
+	return f, nil
+}
+
+// parseValue parses a number or name of the i-th field.
+func parseValue(s string, i int) (int, error) {
+	spec := cronFields[i]
+	for j, name := range spec.names {
+		if strings.EqualFold(s, name) {
+			return spec.min + j, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < spec.min || v > spec.max {
+		return 0, fmt.Errorf("invalid %s %q (expected %d-%d)", spec.name, s, spec.min, spec.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,402 @@
+// Package preflight checks that the AWS context is usable before views
+// refresh.
+//
+// Expired SSO sessions, a missing region or a skewed system clock make every
+// AWS call fail, and each view would otherwise show its own cryptic error.
+// The checker runs before each refresh cycle and reports those problems
+// once, with a hint on how to fix them, so the TUI can show a single banner
+// and hold refreshes until the context is healthy again.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+const (
+	// DefaultMaxSkew is the clock difference tolerated before warning.
+	// AWS rejects signed requests that are more than 5 minutes off.
+	DefaultMaxSkew = 4 * time.Minute
+	// DefaultIdentityInterval is how long a successful identity check is
+	// trusted before AWS is asked again.
+	DefaultIdentityInterval = 10 * time.Minute
+	// DefaultExpiryWarning is how long before credentials expire the
+	// operator is warned, while they still work.
+	DefaultExpiryWarning = 10 * time.Minute
+	// checkTimeout bounds the identity call so a hung network does not
+	// stall the refresh cycle.
+	checkTimeout = 10 * time.Second
+)
+
+// Check names the area a problem was found in.
+type Check string
+
+const (
+	CheckRegion       Check = "region"
+	CheckCredentials  Check = "credentials"
+	CheckExpired      Check = "expired"
+	CheckClock        Check = "clock"
+	CheckConnectivity Check = "connectivity"
+)
+
+// Problem is a reason the AWS context cannot be used.
+type Problem struct {
+	Check   Check
+	Message string
+	Hint    string
+}
+
+// String renders a problem with its hint.
+func (p Problem) String() string {
+	if p.Hint == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s. %s", p.Message, p.Hint)
+}
+
+// Report is the outcome of a preflight run.
+type Report struct {
+	Profile   string
+	Region    string
+	Problems  []Problem
+	Skew      time.Duration // Local clock minus AWS clock, when known
+	Expires   time.Time     // When the credentials expire, if within the expiry warning
+	CheckedAt time.Time
+}
+
+// OK reports whether no problems were found.
+func (r Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// ExpiringSoon reports whether the credentials still work but expire within
+// the expiry warning.
+func (r Report) ExpiringSoon() bool {
+	return !r.Expires.IsZero()
+}
+
+// messages returns the problem messages.
+func (r Report) messages() []string {
+	msgs := make([]string, len(r.Problems))
+	for i, p := range r.Problems {
+		msgs[i] = p.Message
+	}
+	return msgs
+}
+
+// =============================================================================
+// Checker
+// =============================================================================
+
+// Checker runs preflight checks against the factory's current context.
+type Checker struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	maxSkew    time.Duration
+	interval   time.Duration
+	warning    time.Duration
+	now        func() time.Time
+
+	mu sync.Mutex
+	// Last identity call, reused while the context is unchanged
+	identityKey string
+	identityAt  time.Time
+	skew        time.Duration
+	// Whether the last report had problems, to dispatch only transitions
+	failing bool
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// WithDispatcher sets the dispatcher receiving preflight events.
+func WithDispatcher(dispatcher core.EventDispatcher) Option {
+	return func(c *Checker) {
+		c.dispatcher = dispatcher
+	}
+}
+
+// WithMaxSkew sets the clock difference tolerated before warning.
+func WithMaxSkew(skew time.Duration) Option {
+	return func(c *Checker) {
+		if skew > 0 {
+			c.maxSkew = skew
+		}
+	}
+}
+
+// WithIdentityInterval sets how long a successful identity check is trusted.
+func WithIdentityInterval(interval time.Duration) Option {
+	return func(c *Checker) {
+		if interval > 0 {
+			c.interval = interval
+		}
+	}
+}
+
+// WithExpiryWarning sets how long before credentials expire the operator is
+// warned.
+func WithExpiryWarning(warning time.Duration) Option {
+	return func(c *Checker) {
+		if warning > 0 {
+			c.warning = warning
+		}
+	}
+}
+
+// New creates a checker for the factory's profile and region.
+func New(factory *awsfactory.ClientFactory, opts ...Option) *Checker {
+	c := &Checker{
+		factory:  factory,
+		maxSkew:  DefaultMaxSkew,
+		interval: DefaultIdentityInterval,
+		warning:  DefaultExpiryWarning,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Run checks the current context. Local checks run every time; the call to
+// AWS is repeated only after the identity interval, after a failure, or when
+// the profile or region changed.
+func (c *Checker) Run(ctx context.Context) Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cfg := c.factory.Config()
+	profile := c.factory.Profile()
+	if profile == "" {
+		profile = "default"
+	}
+	report := Report{
+		Profile:   profile,
+		Region:    cfg.Region,
+		CheckedAt: c.now(),
+	}
+
+	if cfg.Region == "" {
+		report.Problems = append(report.Problems, Problem{
+			Check:   CheckRegion,
+			Message: i18n.T("No AWS region is configured"),
+			Hint:    i18n.T("Press G to pick a region, or set aws.region or AWS_REGION"),
+		})
+	}
+
+	if problem := c.checkCredentials(ctx, cfg.Credentials, profile, &report); problem != nil {
+		report.Problems = append(report.Problems, *problem)
+	} else if report.OK() {
+		if problem := c.checkIdentity(ctx, profile, cfg.Region, report.CheckedAt); problem != nil {
+			report.Problems = append(report.Problems, *problem)
+		}
+		report.Skew = c.skew
+	}
+
+	if !report.OK() {
+		c.identityAt = time.Time{}
+	}
+	c.dispatch(ctx, report)
+	return report
+}
+
+// checkCredentials resolves credentials locally, catching missing profiles
+// and expired cached sessions without calling AWS. Credentials expiring soon
+// are not a problem; their expiry is set on the report.
+func (c *Checker) checkCredentials(ctx context.Context, provider aws.CredentialsProvider, profile string, report *Report) *Problem {
+	missing := &Problem{
+		Check:   CheckCredentials,
+		Message: i18n.T("No AWS credentials found for profile %s", profile),
+		Hint:    i18n.T("Configure the profile or press P to pick another one"),
+	}
+	if provider == nil {
+		return missing
+	}
+
+	creds, err := provider.Retrieve(ctx)
+	if err != nil {
+		if isExpired(err) {
+			return expiredProblem(profile)
+		}
+		// The default chain ends at instance metadata; failing there means
+		// no other source had credentials
+		if strings.Contains(err.Error(), "IMDS") {
+			return missing
+		}
+		return &Problem{
+			Check:   CheckCredentials,
+			Message: i18n.T("Cannot load AWS credentials for profile %s: %v", profile, rootCause(err)),
+			Hint:    i18n.T("Check the profile in ~/.aws/config or press P to pick another one"),
+		}
+	}
+	switch expiryOf(creds, report.CheckedAt, c.warning) {
+	case expiryExpired:
+		return expiredProblem(profile)
+	case expirySoon:
+		report.Expires = creds.Expires
+	}
+	return nil
+}
+
+// checkIdentity calls STS to confirm AWS accepts the credentials and to
+// measure the clock difference.
+func (c *Checker) checkIdentity(ctx context.Context, profile, region string, now time.Time) *Problem {
+	key := profile + "/" + region
+	if key == c.identityKey && !c.identityAt.IsZero() && now.Sub(c.identityAt) < c.interval {
+		return c.skewProblem()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	out, err := c.factory.STSClient().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		switch errorCode(err) {
+		case "ExpiredToken", "ExpiredTokenException", "RequestExpired":
+			return expiredProblem(profile)
+		case "SignatureDoesNotMatch", "RequestTimeTooSkewed", "InvalidSignatureException":
+			return &Problem{
+				Check:   CheckClock,
+				Message: i18n.T("AWS rejected the request signature; the system clock may be wrong"),
+				Hint:    i18n.T("Sync the system clock (e.g. timedatectl set-ntp true)"),
+			}
+		case "InvalidClientTokenId", "UnrecognizedClientException":
+			return &Problem{
+				Check:   CheckCredentials,
+				Message: i18n.T("AWS rejected the credentials of profile %s", profile),
+				Hint:    i18n.T("Check the access keys or press P to pick another profile"),
+			}
+		case "":
+			return &Problem{
+				Check:   CheckConnectivity,
+				Message: i18n.T("Cannot reach AWS: %v", rootCause(err)),
+				Hint:    i18n.T("Check the network connection and proxy settings"),
+			}
+		}
+		// Other API errors mean AWS answered; let the views report them
+	} else if skew, ok := awsmiddleware.GetAttemptSkew(out.ResultMetadata); ok {
+		c.skew = skew
+	}
+
+	c.identityKey = key
+	c.identityAt = now
+	return c.skewProblem()
+}
+
+// skewProblem reports a clock difference beyond the tolerated skew.
+func (c *Checker) skewProblem() *Problem {
+	skew := c.skew
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew <= c.maxSkew {
+		return nil
+	}
+	return &Problem{
+		Check:   CheckClock,
+		Message: i18n.T("The system clock is %s off from AWS", skew.Round(time.Second)),
+		Hint:    i18n.T("Sync the system clock (e.g. timedatectl set-ntp true)"),
+	}
+}
+
+// dispatch reports transitions between a usable and a broken context.
+func (c *Checker) dispatch(ctx context.Context, report Report) {
+	failing := !report.OK()
+	if failing == c.failing {
+		return
+	}
+	c.failing = failing
+	if c.dispatcher == nil {
+		return
+	}
+
+	eventType := core.EventPreflightPassed
+	if failing {
+		eventType = core.EventPreflightFailed
+	}
+	_ = c.dispatcher.Dispatch(ctx, core.NewEvent(eventType, "preflight", core.PreflightEventData{
+		Profile:  report.Profile,
+		Region:   report.Region,
+		Problems: report.messages(),
+	}))
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// expiry classifies credentials by how soon they expire.
+type expiry int
+
+const (
+	expiryOK expiry = iota
+	expirySoon
+	expiryExpired
+)
+
+// expiryOf classifies credentials at now: expired once their expiry passed,
+// expiring soon within warning of it.
+func expiryOf(creds aws.Credentials, now time.Time, warning time.Duration) expiry {
+	if !creds.CanExpire || creds.Expires.IsZero() {
+		return expiryOK
+	}
+	if !creds.Expires.After(now) {
+		return expiryExpired
+	}
+	if creds.Expires.Sub(now) <= warning {
+		return expirySoon
+	}
+	return expiryOK
+}
+
+func expiredProblem(profile string) *Problem {
+	return &Problem{
+		Check:   CheckExpired,
+		Message: i18n.T("The AWS session for profile %s has expired", profile),
+		Hint:    i18n.T("Run: aws sso login --profile %s (or refresh the session token), then press r", profile),
+	}
+}
+
+// isExpired reports whether a credential error means the session expired.
+func isExpired(err error) bool {
+	switch errorCode(err) {
+	case "ExpiredToken", "ExpiredTokenException", "RequestExpired", "UnauthorizedException":
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "expired") || strings.Contains(msg, "refresh cached sso token failed")
+}
+
+// errorCode returns the AWS API error code of err, if it carries one.
+func errorCode(err error) string {
+	var coded interface{ ErrorCode() string }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return ""
+}
+
+// rootCause returns the innermost error, which carries the useful message
+// under the SDK's operation wrappers.
+func rootCause(err error) error {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
@@ -0,0 +1,89 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestSkewProblem(t *testing.T) {
+	tests := []struct {
+		name string
+		skew time.Duration
+		want bool
+	}{
+		{name: "in sync", skew: 0, want: false},
+		{name: "just under the threshold", skew: DefaultMaxSkew - time.Second, want: false},
+		{name: "at the threshold", skew: DefaultMaxSkew, want: false},
+		{name: "past the threshold", skew: DefaultMaxSkew + time.Second, want: true},
+		{name: "behind AWS past the threshold", skew: -DefaultMaxSkew - time.Second, want: true},
+		{name: "behind AWS at the threshold", skew: -DefaultMaxSkew, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Checker{maxSkew: DefaultMaxSkew, skew: tt.skew}
+			problem := c.skewProblem()
+			if got := problem != nil; got != tt.want {
+				t.Fatalf("skewProblem() with skew %s = %v, want a problem: %v", tt.skew, problem, tt.want)
+			}
+			if problem != nil && problem.Check != CheckClock {
+				t.Errorf("Check = %s, want %s", problem.Check, CheckClock)
+			}
+		})
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	expiring := func(in time.Duration) aws.CredentialsProvider {
+		return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKIA", CanExpire: true, Expires: now.Add(in)}, nil
+		})
+	}
+	failing := func(err error) aws.CredentialsProvider {
+		return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{}, err
+		})
+	}
+
+	tests := []struct {
+		name     string
+		provider aws.CredentialsProvider
+		want     Check // Empty when the credentials are usable
+		expiring bool
+	}{
+		{name: "no provider", provider: nil, want: CheckCredentials},
+		{name: "expired", provider: expiring(-time.Minute), want: CheckExpired},
+		{name: "expiring now", provider: expiring(0), want: CheckExpired},
+		{name: "expiring soon", provider: expiring(DefaultExpiryWarning - time.Minute), expiring: true},
+		{name: "expiring at the warning", provider: expiring(DefaultExpiryWarning), expiring: true},
+		{name: "ok", provider: expiring(DefaultExpiryWarning + time.Minute)},
+		{name: "long-lived keys", provider: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKIA"}, nil
+		})},
+		{name: "expired SSO token", provider: failing(errors.New("refresh cached SSO token failed")), want: CheckExpired},
+		{name: "no source", provider: failing(errors.New("failed to refresh cached credentials, no EC2 IMDS role found")), want: CheckCredentials},
+		{name: "broken profile", provider: failing(errors.New("failed to get shared config profile, dev")), want: CheckCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Checker{warning: DefaultExpiryWarning}
+			report := Report{CheckedAt: now}
+			problem := c.checkCredentials(context.Background(), tt.provider, "dev", &report)
+
+			var got Check
+			if problem != nil {
+				got = problem.Check
+			}
+			if got != tt.want {
+				t.Errorf("checkCredentials() = %v, want check %q", problem, tt.want)
+			}
+			if report.ExpiringSoon() != tt.expiring {
+				t.Errorf("ExpiringSoon() = %v (expires %s), want %v", report.ExpiringSoon(), report.Expires, tt.expiring)
+			}
+		})
+	}
+}
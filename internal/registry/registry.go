@@ -3,7 +3,10 @@
 package registry
 
 import (
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +24,12 @@ type Registry struct {
 	views     map[string]viewEntry
 	shortcuts map[string]string // shortcut -> view name
 	observers []func(core.RegistryEvent)
+
+	overrides map[string]string // lowercased view or service name -> shortcut
+	conflicts []ShortcutConflict
+
+	summaries map[string][]string // lowercased view or service name -> widgets
+	unknown   []UnknownWidget
 }
 
 type serviceEntry struct {
@@ -33,13 +42,46 @@ type viewEntry struct {
 	priority int
 }
 
+// Option configures a Registry.
+type Option func(*Registry)
+
+// WithShortcuts sets shortcut overrides keyed by view or service name
+// (case-insensitive). They take precedence over the views' own shortcuts.
+func WithShortcuts(overrides map[string]string) Option {
+	return func(r *Registry) {
+		for name, shortcut := range overrides {
+			r.overrides[strings.ToLower(name)] = shortcut
+		}
+	}
+}
+
+// DefaultSummary is the WithSummaries key of the layout used by views
+// without one of their own.
+const DefaultSummary = "default"
+
+// WithSummaries sets summary line layouts keyed by view or service name
+// (case-insensitive), or DefaultSummary: the widgets to show, in order.
+func WithSummaries(layouts map[string][]string) Option {
+	return func(r *Registry) {
+		for name, widgets := range layouts {
+			r.summaries[strings.ToLower(name)] = slices.Clone(widgets)
+		}
+	}
+}
+
 // New creates a new registry.
-func New() *Registry {
-	return &Registry{
+func New(opts ...Option) *Registry {
+	r := &Registry{
 		services:  make(map[string]serviceEntry),
 		views:     make(map[string]viewEntry),
 		shortcuts: make(map[string]string),
+		overrides: make(map[string]string),
+		summaries: make(map[string][]string),
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return r
 }
 
 // =============================================================================
@@ -179,22 +221,27 @@ func (r *Registry) RegisterViewWithPriority(view core.View, priority int) error
 	defer r.mu.Unlock()
 
 	name := view.Name()
-	shortcut := view.Shortcut()
 
 	if _, exists := r.views[name]; exists {
 		return core.ErrViewAlreadyExists
 	}
 
-	// Check for shortcut conflicts
-	if existing, exists := r.shortcuts[shortcut]; exists {
-		return core.Wrapf(core.ErrShortcutConflict, "shortcut '%s' already used by '%s'", shortcut, existing)
+	shortcut, conflict, err := r.assignShortcut(view, r.shortcuts)
+	if err != nil {
+		return err
 	}
 
 	r.views[name] = viewEntry{
 		view:     view,
 		priority: priority,
 	}
-	r.shortcuts[shortcut] = name
+	if shortcut != "" {
+		r.shortcuts[shortcut] = name
+	}
+	if conflict != nil {
+		r.conflicts = append(r.conflicts, *conflict)
+	}
+	r.applySummary(view)
 
 	r.notify(core.RegistryEvent{
 		Type:      core.RegistryEventRegistered,
@@ -217,6 +264,9 @@ func (r *Registry) UnregisterView(name string) error {
 
 	delete(r.shortcuts, entry.view.Shortcut())
 	delete(r.views, name)
+	r.conflicts = slices.DeleteFunc(r.conflicts, func(c ShortcutConflict) bool {
+		return c.View == name
+	})
 
 	r.notify(core.RegistryEvent{
 		Type:      core.RegistryEventUnregistered,
@@ -301,6 +351,14 @@ func (r *Registry) HasView(name string) bool {
 	return exists
 }
 
+// ShortcutConflicts returns the views that did not get the shortcut they
+// asked for, in registration order.
+func (r *Registry) ShortcutConflicts() []ShortcutConflict {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.conflicts)
+}
+
 // GetShortcuts returns a map of shortcuts to view names.
 func (r *Registry) GetShortcuts() map[string]string {
 	r.mu.RLock()
@@ -314,6 +372,149 @@ func (r *Registry) GetShortcuts() map[string]string {
 	return shortcuts
 }
 
+// =============================================================================
+// Shortcut Assignment
+// =============================================================================
+
+// autoShortcuts are handed out, in order, to views without a usable shortcut.
+const autoShortcuts = 9
+
+// ShortcutConflict records a view whose shortcut was already taken.
+type ShortcutConflict struct {
+	View     string // View that was moved
+	Wanted   string // Shortcut it asked for, or was configured with
+	Holder   string // View already using Wanted
+	Assigned string // Shortcut given instead; empty when all digits are taken
+}
+
+// assignShortcut decides the shortcut of a view being registered, given the
+// shortcuts already in use: its configured override, else its own, else the
+// first free digit. Shortcuts configured for other views are kept free for
+// them. Views that cannot change their shortcut keep the old behavior of
+// failing on a conflict.
+func (r *Registry) assignShortcut(view core.View, used map[string]string) (string, *ShortcutConflict, error) {
+	wanted := view.Shortcut()
+	override, configured := r.override(view)
+	if configured {
+		wanted = override
+	}
+	holder, taken := used[wanted]
+	if !taken && !configured {
+		holder, taken = r.reservedFor(wanted)
+	}
+
+	setter, settable := view.(core.ShortcutSetter)
+	if !settable {
+		if taken {
+			return "", nil, core.Wrapf(core.ErrShortcutConflict, "shortcut '%s' already used by '%s'", wanted, holder)
+		}
+		if wanted != view.Shortcut() {
+			return "", nil, core.Wrapf(core.ErrShortcutConflict, "view '%s' cannot change its shortcut to '%s'", view.Name(), wanted)
+		}
+		return wanted, nil, nil
+	}
+
+	assigned := wanted
+	if wanted == "" || taken {
+		assigned = ""
+		for i := 1; i <= autoShortcuts; i++ {
+			key := strconv.Itoa(i)
+			_, inUse := used[key]
+			_, reserved := r.reservedFor(key)
+			if !inUse && !reserved {
+				assigned = key
+				break
+			}
+		}
+	}
+	setter.SetShortcut(assigned)
+
+	if !taken {
+		return assigned, nil, nil
+	}
+	return assigned, &ShortcutConflict{
+		View:     view.Name(),
+		Wanted:   wanted,
+		Holder:   holder,
+		Assigned: assigned,
+	}, nil
+}
+
+// reservedFor returns the name a shortcut is configured for, if any.
+func (r *Registry) reservedFor(shortcut string) (string, bool) {
+	for name, configured := range r.overrides {
+		if configured == shortcut {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// override returns the configured shortcut of a view, looked up by view
+// name then service name.
+func (r *Registry) override(view core.View) (string, bool) {
+	if shortcut, ok := r.overrides[strings.ToLower(view.Name())]; ok {
+		return shortcut, true
+	}
+	shortcut, ok := r.overrides[strings.ToLower(view.ServiceName())]
+	return shortcut, ok
+}
+
+// =============================================================================
+// Summary Layouts
+// =============================================================================
+
+// UnknownWidget records a configured summary widget a view does not offer.
+type UnknownWidget struct {
+	View      string   // View the layout applies to
+	Widget    string   // Name that matched no widget
+	Available []string // Widgets the view offers
+}
+
+// UnknownWidgets returns the configured summary widgets that views do not
+// offer, in registration order.
+func (r *Registry) UnknownWidgets() []UnknownWidget {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.unknown)
+}
+
+// applySummary sets the configured summary layout of a view, looked up by
+// view name, then service name, then DefaultSummary. Names of a view's own
+// layout that it does not offer are recorded; the default layout only
+// shows what each view has.
+func (r *Registry) applySummary(view core.View) {
+	provider, ok := view.(core.SummaryProvider)
+	if !ok {
+		return
+	}
+	layout, own := r.summaries[strings.ToLower(view.Name())]
+	if !own {
+		layout, own = r.summaries[strings.ToLower(view.ServiceName())]
+	}
+	if !own {
+		var configured bool
+		if layout, configured = r.summaries[DefaultSummary]; !configured {
+			return
+		}
+	}
+	provider.SetSummaryLayout(layout)
+	if !own {
+		return
+	}
+
+	widgets := provider.SummaryWidgets()
+	available := make([]string, len(widgets))
+	for i, w := range widgets {
+		available[i] = w.Name
+	}
+	for _, name := range layout {
+		if !slices.ContainsFunc(available, func(a string) bool { return strings.EqualFold(a, name) }) {
+			r.unknown = append(r.unknown, UnknownWidget{View: view.Name(), Widget: name, Available: available})
+		}
+	}
+}
+
 // =============================================================================
 // Combined Registration
 // =============================================================================
@@ -344,6 +545,132 @@ func (r *Registry) RegisterServiceAndView(reg core.ServiceRegistration) error {
 	return nil
 }
 
+// =============================================================================
+// Hot Swap
+// =============================================================================
+
+// ReplaceService registers a service and its view, replacing the service
+// and view already registered under the same name. The old service is
+// closed once the swap is done.
+func (r *Registry) ReplaceService(reg core.ServiceRegistration) error {
+	return r.replace([]core.ServiceRegistration{reg}, false)
+}
+
+// ReplaceAll swaps the whole registry for the given registrations, as when
+// the AWS profile or region changes. Services missing from regs are
+// unregistered. Either every registration is applied or, on error, the
+// registry is left untouched.
+func (r *Registry) ReplaceAll(regs []core.ServiceRegistration) error {
+	return r.replace(regs, true)
+}
+
+// replace applies regs atomically. Views are created before taking the lock
+// so a failing view factory leaves the registry unchanged.
+func (r *Registry) replace(regs []core.ServiceRegistration, all bool) error {
+	names := make(map[string]bool, len(regs))
+	incoming := make(map[core.AWSService]bool, len(regs))
+	var views []viewEntry
+	for _, reg := range regs {
+		name := reg.Service.Name()
+		if names[name] {
+			return core.Wrapf(core.ErrServiceAlreadyExists, "%s registered twice", name)
+		}
+		names[name] = true
+		incoming[reg.Service] = true
+
+		if reg.ViewFactory == nil {
+			continue
+		}
+		view, err := reg.ViewFactory.Create(reg.Service)
+		if err != nil {
+			return core.Wrapf(err, "failed to create view for %s", name)
+		}
+		views = append(views, viewEntry{view: view, priority: reg.Priority})
+	}
+
+	r.mu.Lock()
+
+	// Views registered on their own, without a service, are kept
+	replaced := func(service string) bool {
+		_, registered := r.services[service]
+		return names[service] || (all && registered)
+	}
+
+	// Work out the resulting views first to detect conflicts
+	kept := make(map[string]viewEntry, len(r.views))
+	shortcuts := make(map[string]string, len(r.shortcuts))
+	for name, entry := range r.views {
+		if !replaced(entry.view.ServiceName()) {
+			kept[name] = entry
+			if entry.view.Shortcut() != "" {
+				shortcuts[entry.view.Shortcut()] = name
+			}
+		}
+	}
+	conflicts := slices.DeleteFunc(slices.Clone(r.conflicts), func(c ShortcutConflict) bool {
+		_, exists := kept[c.View]
+		return !exists
+	})
+	for _, entry := range views {
+		name := entry.view.Name()
+		if _, exists := kept[name]; exists {
+			r.mu.Unlock()
+			return core.Wrapf(core.ErrViewAlreadyExists, "view '%s'", name)
+		}
+		shortcut, conflict, err := r.assignShortcut(entry.view, shortcuts)
+		if err != nil {
+			r.mu.Unlock()
+			return err
+		}
+		kept[name] = entry
+		if shortcut != "" {
+			shortcuts[shortcut] = name
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+
+	var retired []core.AWSService
+	var events []core.RegistryEvent
+	now := time.Now()
+	for name, entry := range r.services {
+		if !replaced(name) {
+			continue
+		}
+		if !incoming[entry.service] {
+			retired = append(retired, entry.service)
+		}
+		if !names[name] {
+			delete(r.services, name)
+			events = append(events, core.RegistryEvent{Type: core.RegistryEventUnregistered, Name: name, Timestamp: now})
+		}
+	}
+	for _, reg := range regs {
+		name := reg.Service.Name()
+		eventType := core.RegistryEventRegistered
+		if _, exists := r.services[name]; exists {
+			eventType = core.RegistryEventUpdated
+		}
+		r.services[name] = serviceEntry{service: reg.Service, priority: reg.Priority}
+		events = append(events, core.RegistryEvent{Type: eventType, Name: name, Timestamp: now})
+	}
+	r.views = kept
+	r.shortcuts = shortcuts
+	r.conflicts = conflicts
+
+	for _, event := range events {
+		r.notify(event)
+	}
+	r.mu.Unlock()
+
+	// Close outside the lock; a slow Close must not block lookups
+	for _, service := range retired {
+		_ = service.Close()
+	}
+	return nil
+}
+
 // =============================================================================
 // Observer Pattern
 // =============================================================================
@@ -0,0 +1,337 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// fakeService records whether it was closed.
+type fakeService struct {
+	name   string
+	closed bool
+}
+
+func (s *fakeService) Name() string                                      { return s.name }
+func (s *fakeService) Description() string                               { return "" }
+func (s *fakeService) Icon() string                                      { return "" }
+func (s *fakeService) Initialize(context.Context, *core.AWSConfig) error { return nil }
+func (s *fakeService) HealthCheck(context.Context) error                 { return nil }
+
+func (s *fakeService) Close() error {
+	s.closed = true
+	return nil
+}
+
+// fakeView is a view named after its service.
+type fakeView struct {
+	name, service, shortcut string
+}
+
+func (v *fakeView) Init() tea.Cmd                       { return nil }
+func (v *fakeView) Update(tea.Msg) (tea.Model, tea.Cmd) { return v, nil }
+func (v *fakeView) View() string                        { return "" }
+func (v *fakeView) Name() string                        { return v.name }
+func (v *fakeView) Shortcut() string                    { return v.shortcut }
+func (v *fakeView) ServiceName() string                 { return v.service }
+func (v *fakeView) SetService(core.AWSService)          {}
+func (v *fakeView) SetDimensions(int, int)              {}
+func (v *fakeView) Refresh() tea.Cmd                    { return nil }
+func (v *fakeView) IsLoading() bool                     { return false }
+func (v *fakeView) Error() error                        { return nil }
+
+// fakeFactory creates fakeViews with a fixed shortcut, failing when err is
+// set.
+type fakeFactory struct {
+	shortcut string
+	err      error
+}
+
+func (f fakeFactory) ServiceName() string { return "" }
+
+func (f fakeFactory) Create(service core.AWSService) (core.View, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &fakeView{name: service.Name(), service: service.Name(), shortcut: f.shortcut}, nil
+}
+
+func registration(name, shortcut string) core.ServiceRegistration {
+	return core.ServiceRegistration{Service: &fakeService{name: name}, ViewFactory: fakeFactory{shortcut: shortcut}}
+}
+
+// state returns the registered services and the shortcut -> view bindings.
+func state(r *Registry) ([]string, map[string]string) {
+	return r.Stats().Services, r.GetShortcuts()
+}
+
+func TestReplaceAll(t *testing.T) {
+	r := New()
+	ec2, iam := registration("ec2", "1"), registration("iam", "2")
+	for _, reg := range []core.ServiceRegistration{ec2, iam} {
+		if err := r.RegisterServiceAndView(reg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A view registered on its own survives swaps
+	if err := r.RegisterView(&fakeView{name: "events", shortcut: "e"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Switching region brings new ec2 and s3 services; iam is dropped
+	ec2West, s3 := registration("ec2", "1"), registration("s3", "3")
+	if err := r.ReplaceAll([]core.ServiceRegistration{ec2West, s3}); err != nil {
+		t.Fatalf("ReplaceAll() error = %v", err)
+	}
+
+	services, shortcuts := state(r)
+	if !slices.Equal(services, []string{"ec2", "s3"}) {
+		t.Errorf("services = %v, want ec2 and s3", services)
+	}
+	want := map[string]string{"1": "ec2", "3": "s3", "e": "events"}
+	if len(shortcuts) != len(want) {
+		t.Errorf("shortcuts = %v, want %v", shortcuts, want)
+	}
+	for key, view := range want {
+		if shortcuts[key] != view {
+			t.Errorf("shortcut %s = %q, want %q", key, shortcuts[key], view)
+		}
+	}
+	if svc, _ := r.GetService("ec2"); svc != ec2West.Service {
+		t.Error("GetService(ec2) returned the old service")
+	}
+	if !ec2.Service.(*fakeService).closed || !iam.Service.(*fakeService).closed {
+		t.Error("replaced services were not closed")
+	}
+	if ec2West.Service.(*fakeService).closed {
+		t.Error("the new ec2 service was closed")
+	}
+}
+
+func TestReplaceAllIsAtomic(t *testing.T) {
+	tests := []struct {
+		name    string
+		regs    []core.ServiceRegistration
+		wantErr error
+	}{
+		{
+			name: "failing view",
+			regs: []core.ServiceRegistration{
+				registration("ec2", "1"),
+				{Service: &fakeService{name: "s3"}, ViewFactory: fakeFactory{err: errors.New("no bucket")}},
+			},
+		},
+		{
+			name:    "service twice",
+			regs:    []core.ServiceRegistration{registration("ec2", "1"), registration("ec2", "1")},
+			wantErr: core.ErrServiceAlreadyExists,
+		},
+		{
+			name:    "view taken by a standalone view",
+			regs:    []core.ServiceRegistration{registration("events", "4")},
+			wantErr: core.ErrViewAlreadyExists,
+		},
+		{
+			// fakeView cannot be moved to another shortcut
+			name:    "shortcut taken by a standalone view",
+			regs:    []core.ServiceRegistration{registration("s3", "e")},
+			wantErr: core.ErrShortcutConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New()
+			iam := registration("iam", "2")
+			if err := r.RegisterServiceAndView(iam); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.RegisterView(&fakeView{name: "events", shortcut: "e"}); err != nil {
+				t.Fatal(err)
+			}
+
+			err := r.ReplaceAll(tt.regs)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("ReplaceAll() error = %v, want %v", err, tt.wantErr)
+			}
+
+			services, shortcuts := state(r)
+			if !slices.Equal(services, []string{"iam"}) || shortcuts["2"] != "iam" || len(shortcuts) != 2 {
+				t.Errorf("after a failed swap services = %v, shortcuts = %v", services, shortcuts)
+			}
+			if iam.Service.(*fakeService).closed {
+				t.Error("a failed swap closed the registered service")
+			}
+		})
+	}
+}
+
+func TestReplaceService(t *testing.T) {
+	r := New()
+	ec2, iam := registration("ec2", "1"), registration("iam", "2")
+	for _, reg := range []core.ServiceRegistration{ec2, iam} {
+		if err := r.RegisterServiceAndView(reg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	events := make(chan core.RegistryEvent, 1)
+	r.Watch(func(e core.RegistryEvent) { events <- e })
+
+	reloaded := registration("ec2", "1")
+	if err := r.ReplaceService(reloaded); err != nil {
+		t.Fatalf("ReplaceService() error = %v", err)
+	}
+	if e := <-events; e.Type != core.RegistryEventUpdated || e.Name != "ec2" {
+		t.Errorf("event = %+v, want ec2 updated", e)
+	}
+
+	// Other services are left alone
+	services, shortcuts := state(r)
+	if !slices.Equal(services, []string{"ec2", "iam"}) || shortcuts["1"] != "ec2" || shortcuts["2"] != "iam" {
+		t.Errorf("services = %v, shortcuts = %v", services, shortcuts)
+	}
+	if !ec2.Service.(*fakeService).closed || iam.Service.(*fakeService).closed {
+		t.Error("ReplaceService() should close only the replaced service")
+	}
+}
+
+// movableView is a view the registry may move to another shortcut.
+type movableView struct {
+	fakeView
+}
+
+func (v *movableView) SetShortcut(shortcut string) { v.shortcut = shortcut }
+
+func TestShortcutAssignment(t *testing.T) {
+	movable := func(name, shortcut string) core.View {
+		return &movableView{fakeView{name: name, service: name, shortcut: shortcut}}
+	}
+	fixed := func(name, shortcut string) core.View {
+		return &fakeView{name: name, service: name, shortcut: shortcut}
+	}
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		views     []core.View
+		want      map[string]string // shortcut -> view
+		conflicts []ShortcutConflict
+		wantErr   bool
+	}{
+		{
+			name:  "own shortcuts",
+			views: []core.View{movable("ec2", "1"), movable("iam", "2")},
+			want:  map[string]string{"1": "ec2", "2": "iam"},
+		},
+		{
+			name:      "taken shortcut moves to the first free digit",
+			views:     []core.View{movable("ec2", "1"), movable("iam", "2"), movable("lambda", "1")},
+			want:      map[string]string{"1": "ec2", "2": "iam", "3": "lambda"},
+			conflicts: []ShortcutConflict{{View: "lambda", Wanted: "1", Holder: "ec2", Assigned: "3"}},
+		},
+		{
+			name:  "no shortcut gets a free digit",
+			views: []core.View{movable("ec2", "1"), movable("notes", "")},
+			want:  map[string]string{"1": "ec2", "2": "notes"},
+		},
+		{
+			name:      "override by view or service name",
+			overrides: map[string]string{"EC2": "5", "Lambda": "l"},
+			views:     []core.View{movable("ec2", "1"), movable("lambda", "3")},
+			want:      map[string]string{"5": "ec2", "l": "lambda"},
+		},
+		{
+			name:      "overridden shortcut is kept for its view",
+			overrides: map[string]string{"s3": "2"},
+			views:     []core.View{movable("iam", "2"), movable("notes", ""), movable("s3", "3")},
+			want:      map[string]string{"1": "iam", "3": "notes", "2": "s3"},
+			conflicts: []ShortcutConflict{{View: "iam", Wanted: "2", Holder: "s3", Assigned: "1"}},
+		},
+		{
+			name: "all digits taken",
+			views: []core.View{
+				movable("a", "1"), movable("b", "2"), movable("c", "3"), movable("d", "4"), movable("e", "5"),
+				movable("f", "6"), movable("g", "7"), movable("h", "8"), movable("i", "9"), movable("j", "9"),
+			},
+			want: map[string]string{
+				"1": "a", "2": "b", "3": "c", "4": "d", "5": "e", "6": "f", "7": "g", "8": "h", "9": "i",
+			},
+			conflicts: []ShortcutConflict{{View: "j", Wanted: "9", Holder: "i"}},
+		},
+		{
+			name:    "fixed view on a taken shortcut",
+			views:   []core.View{movable("ec2", "1"), fixed("iam", "1")},
+			wantErr: true,
+		},
+		{
+			name:      "fixed view with an override",
+			overrides: map[string]string{"iam": "7"},
+			views:     []core.View{fixed("iam", "2")},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(WithShortcuts(tt.overrides))
+			var err error
+			for _, view := range tt.views {
+				if err = r.RegisterView(view); err != nil {
+					break
+				}
+			}
+			if tt.wantErr {
+				if !errors.Is(err, core.ErrShortcutConflict) {
+					t.Errorf("RegisterView() error = %v, want a shortcut conflict", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RegisterView() error = %v", err)
+			}
+
+			shortcuts := r.GetShortcuts()
+			if len(shortcuts) != len(tt.want) {
+				t.Errorf("shortcuts = %v, want %v", shortcuts, tt.want)
+			}
+			for key, name := range tt.want {
+				if shortcuts[key] != name {
+					t.Errorf("shortcut %s = %q, want %q", key, shortcuts[key], name)
+				}
+				if view, err := r.GetViewByShortcut(key); err != nil || view.Shortcut() != key {
+					t.Errorf("view on %s = %v, %v", key, view, err)
+				}
+			}
+			if conflicts := r.ShortcutConflicts(); !slices.Equal(conflicts, tt.conflicts) {
+				t.Errorf("ShortcutConflicts() = %+v, want %+v", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
+
+func TestShortcutConflictsFollowViews(t *testing.T) {
+	r := New()
+	for _, name := range []string{"ec2", "lambda"} {
+		if err := r.RegisterView(&movableView{fakeView{name: name, service: name, shortcut: "1"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(r.ShortcutConflicts()) != 1 {
+		t.Fatalf("ShortcutConflicts() = %+v, want lambda moved", r.ShortcutConflicts())
+	}
+
+	// The conflict goes with the view, and its shortcut is free again
+	if err := r.UnregisterView("lambda"); err != nil {
+		t.Fatal(err)
+	}
+	if conflicts := r.ShortcutConflicts(); len(conflicts) != 0 {
+		t.Errorf("ShortcutConflicts() after unregistering = %+v", conflicts)
+	}
+	if _, err := r.GetViewByShortcut("2"); !errors.Is(err, core.ErrViewNotFound) {
+		t.Errorf("GetViewByShortcut(2) error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,217 @@
+// Package runbook runs named sequences of actions, such as decommissioning
+// an instance, on a resource. Each step is an action of any service, on the
+// resource the runbook started on or one derived from it, and is run only
+// once the operator confirmed it.
+package runbook
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Runbooks
+// =============================================================================
+
+// Step is an action a runbook runs.
+type Step struct {
+	Name    string
+	Service string
+	Action  string
+	// Template of the ID of the resource to act on; the runbook's resource
+	// when empty. The step is skipped when it renders empty.
+	Resource string
+	// Parameters of the action; string values are templates
+	Params map[string]any
+	// How to undo the step, shown before it runs and when a later one fails
+	Rollback string
+}
+
+// Runbook is a named sequence of steps.
+type Runbook struct {
+	Name        string
+	Description string
+	Services    []string // Services whose resources it starts on; empty for every service
+	Steps       []Step
+}
+
+// AppliesTo reports whether the runbook starts on resources of service.
+func (rb Runbook) AppliesTo(service string) bool {
+	return len(rb.Services) == 0 || slices.Contains(rb.Services, service)
+}
+
+// Validate checks the runbook has steps naming an action, with templates
+// that parse.
+func (rb Runbook) Validate() error {
+	if len(rb.Steps) == 0 {
+		return fmt.Errorf("runbook %s has no steps", rb.Name)
+	}
+	for i, step := range rb.Steps {
+		if step.Service == "" || step.Action == "" {
+			return fmt.Errorf("step %d: service and action are required", i+1)
+		}
+		if _, err := parse(step.Resource); err != nil {
+			return fmt.Errorf("step %d: resource: %w", i+1, err)
+		}
+		for name, value := range step.Params {
+			if s, ok := value.(string); ok {
+				if _, err := parse(s); err != nil {
+					return fmt.Errorf("step %d: params.%s: %w", i+1, name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Title returns the step's name, or its service and action.
+func (s Step) Title() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Service + ":" + s.Action
+}
+
+// =============================================================================
+// Runs
+// =============================================================================
+
+// Planned is the next step of a run, with its templates rendered.
+type Planned struct {
+	Index      int // Position of the step, from 0
+	Step       Step
+	ResourceID string // Empty when the step is skipped
+	Params     map[string]any
+}
+
+// Outcome is what became of a step of a run.
+type Outcome struct {
+	Planned
+	Skipped bool
+	Result  *core.ActionResult
+	Err     error
+}
+
+// Run is a runbook being run on a resource, one step at a time.
+type Run struct {
+	Runbook Runbook
+	Target  core.Resource
+	Done    []Outcome
+}
+
+// NewRun starts running a runbook on target.
+func NewRun(rb Runbook, target core.Resource) *Run {
+	return &Run{Runbook: rb, Target: target}
+}
+
+// Next renders the step to run next. It returns false once every step ran,
+// or a step failed. When a template fails, the step is returned with the
+// error so it can be recorded as failed.
+func (r *Run) Next() (Planned, bool, error) {
+	if r.Failed() || len(r.Done) >= len(r.Runbook.Steps) {
+		return Planned{}, false, nil
+	}
+
+	index := len(r.Done)
+	step := r.Runbook.Steps[index]
+	p := Planned{Index: index, Step: step, ResourceID: r.Target.ID}
+	if step.Resource != "" {
+		id, err := r.render(step.Resource)
+		if err != nil {
+			return p, false, fmt.Errorf("resource: %w", err)
+		}
+		p.ResourceID = strings.TrimSpace(id)
+	}
+
+	p.Params = make(map[string]any, len(step.Params))
+	for _, name := range slices.Sorted(maps.Keys(step.Params)) {
+		value := step.Params[name]
+		if s, ok := value.(string); ok {
+			rendered, err := r.render(s)
+			if err != nil {
+				return p, false, fmt.Errorf("params.%s: %w", name, err)
+			}
+			value = rendered
+		}
+		p.Params[name] = value
+	}
+	return p, true, nil
+}
+
+// Record records the outcome of the planned step. A step without a
+// resource is recorded as skipped.
+func (r *Run) Record(p Planned, result *core.ActionResult, err error) {
+	r.Done = append(r.Done, Outcome{Planned: p, Skipped: p.ResourceID == "", Result: result, Err: err})
+}
+
+// Failed reports whether a step failed, which stops the run.
+func (r *Run) Failed() bool {
+	return len(r.Done) > 0 && r.Done[len(r.Done)-1].Err != nil
+}
+
+// Finished reports whether every step ran.
+func (r *Run) Finished() bool {
+	return !r.Failed() && len(r.Done) == len(r.Runbook.Steps)
+}
+
+// Rollback returns the steps that ran and have a rollback hint, last first:
+// the order to undo them in.
+func (r *Run) Rollback() []Outcome {
+	var undo []Outcome
+	for i := len(r.Done) - 1; i >= 0; i-- {
+		o := r.Done[i]
+		if !o.Skipped && o.Err == nil && o.Step.Rollback != "" {
+			undo = append(undo, o)
+		}
+	}
+	return undo
+}
+
+// RollbackHint renders the step's rollback hint on the run's resource.
+func (r *Run) RollbackHint(p Planned) string {
+	if p.Step.Rollback == "" {
+		return ""
+	}
+	hint, err := r.render(p.Step.Rollback)
+	if err != nil {
+		return p.Step.Rollback
+	}
+	return hint
+}
+
+// render executes a template on the run's resource. Templates see the
+// resource's ID, Name, ARN, Region, Type and State, and the functions
+// meta and tag returning one of its metadata values or tags, empty when
+// unset: {{meta "public_ip"}}.
+func (r *Run) render(text string) (string, error) {
+	tmpl, err := parse(text)
+	if err != nil {
+		return "", err
+	}
+	tmpl.Funcs(template.FuncMap{
+		"meta": func(key string) string {
+			if v, ok := r.Target.Metadata[key]; ok && v != nil {
+				return fmt.Sprint(v)
+			}
+			return ""
+		},
+		"tag": func(key string) string { return r.Target.Tags[key] },
+	})
+	var b strings.Builder
+	if err := tmpl.Execute(&b, r.Target); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// parse parses a step template. The functions are bound to the resource
+// by render.
+func parse(text string) (*template.Template, error) {
+	unbound := func(string) string { return "" }
+	return template.New("step").Funcs(template.FuncMap{"meta": unbound, "tag": unbound}).Parse(text)
+}
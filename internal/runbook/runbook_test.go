@@ -0,0 +1,90 @@
+package runbook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// decommission images, detaches the address of and terminates an instance.
+var decommission = Runbook{
+	Name:     "decommission",
+	Services: []string{"ec2"},
+	Steps: []Step{
+		{Name: "Image", Service: "ec2", Action: "create_image", Params: map[string]any{"name": "{{.Name}}-final", "no_reboot": true}, Rollback: "Launch from {{.Name}}-final"},
+		{Name: "Release address", Service: "eip", Action: "release", Resource: `{{meta "allocation_id"}}`, Rollback: "Allocate {{meta \"public_ip\"}} again"},
+		{Service: "ec2", Action: "terminate"},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	if err := decommission.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := (Runbook{Name: "empty"}).Validate(); err == nil {
+		t.Error("Validate() accepted a runbook without steps")
+	}
+	broken := Runbook{Name: "broken", Steps: []Step{{Service: "ec2", Action: "stop", Resource: "{{.ID"}}}
+	if err := broken.Validate(); err == nil || !strings.Contains(err.Error(), "step 1: resource") {
+		t.Errorf("Validate() on a broken template error = %v", err)
+	}
+	if !decommission.AppliesTo("ec2") || decommission.AppliesTo("s3") {
+		t.Error("AppliesTo() does not follow Services")
+	}
+}
+
+func TestRun(t *testing.T) {
+	web := core.Resource{ID: "i-1", Name: "web", Metadata: map[string]any{"allocation_id": "eipalloc-1", "public_ip": "203.0.113.7"}}
+	run := NewRun(decommission, web)
+
+	p, ok, err := run.Next()
+	if err != nil || !ok {
+		t.Fatalf("Next() = %v, %v", ok, err)
+	}
+	if p.ResourceID != "i-1" || p.Params["name"] != "web-final" || p.Params["no_reboot"] != true {
+		t.Errorf("first step = %+v", p)
+	}
+	run.Record(p, &core.ActionResult{Success: true}, nil)
+
+	p, _, _ = run.Next()
+	if p.ResourceID != "eipalloc-1" || run.RollbackHint(p) != "Allocate 203.0.113.7 again" {
+		t.Errorf("second step = %+v, rollback %q", p, run.RollbackHint(p))
+	}
+	run.Record(p, &core.ActionResult{Success: true}, nil)
+
+	p, _, _ = run.Next()
+	run.Record(p, nil, errors.New("UnauthorizedOperation"))
+	if !run.Failed() || run.Finished() {
+		t.Fatal("a failed step did not stop the run")
+	}
+	if _, ok, _ := run.Next(); ok {
+		t.Error("Next() went on after a failed step")
+	}
+
+	undo := run.Rollback()
+	if len(undo) != 2 || undo[0].Index != 1 || undo[1].Index != 0 {
+		t.Errorf("Rollback() = %+v, want the address then the image", undo)
+	}
+}
+
+func TestRunSkipsStepsWithoutResource(t *testing.T) {
+	run := NewRun(decommission, core.Resource{ID: "i-2", Name: "batch"})
+	for {
+		p, ok, err := run.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		if !ok {
+			break
+		}
+		run.Record(p, &core.ActionResult{Success: true}, nil)
+	}
+	if !run.Finished() || !run.Done[1].Skipped || run.Done[2].Skipped {
+		t.Errorf("outcomes = %+v, want the address step skipped", run.Done)
+	}
+	if len(run.Rollback()) != 1 {
+		t.Errorf("Rollback() = %+v, want only the image", run.Rollback())
+	}
+}
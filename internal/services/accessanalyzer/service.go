@@ -0,0 +1,465 @@
+// Package accessanalyzer provides IAM Access Analyzer integration for the a9s application.
+// It lists external access findings and supplies them to other services for enrichment.
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// cacheTTL controls how long findings are reused for enrichment lookups.
+const cacheTTL = 5 * time.Minute
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements IAM Access Analyzer operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient AccessAnalyzerAPI
+
+	mu          sync.Mutex
+	cacheKey    string
+	cachedAt    time.Time
+	analyzerArn string
+	byResource  map[string][]core.Finding
+}
+
+// AccessAnalyzerAPI defines the Access Analyzer client interface for mocking.
+type AccessAnalyzerAPI interface {
+	ListAnalyzers(ctx context.Context, params *accessanalyzer.ListAnalyzersInput, optFns ...func(*accessanalyzer.Options)) (*accessanalyzer.ListAnalyzersOutput, error)
+	ListFindings(ctx context.Context, params *accessanalyzer.ListFindingsInput, optFns ...func(*accessanalyzer.Options)) (*accessanalyzer.ListFindingsOutput, error)
+	UpdateFindings(ctx context.Context, params *accessanalyzer.UpdateFindingsInput, optFns ...func(*accessanalyzer.Options)) (*accessanalyzer.UpdateFindingsOutput, error)
+}
+
+// NewService creates a new Access Analyzer service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client AccessAnalyzerAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the Access Analyzer client, fetching fresh from factory each time.
+func (s *Service) client() AccessAnalyzerAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.AccessAnalyzerClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "accessanalyzer"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "IAM Access Analyzer Findings"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "eye"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListAnalyzers(ctx, &accessanalyzer.ListAnalyzersInput{
+		MaxResults: aws.Int32(1),
+	})
+	if err != nil {
+		return core.NewServiceError("accessanalyzer", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns findings from the account analyzer.
+// Only active findings are returned unless a "status" filter is given.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	analyzerArn, err := s.analyzer(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("accessanalyzer", "list", err)
+	}
+
+	status := string(types.FindingStatusActive)
+	if v, ok := opts.Filters["status"]; ok && v != "" {
+		status = strings.ToUpper(v)
+	}
+
+	summaries, err := s.listFindings(ctx, analyzerArn, status)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("accessanalyzer", "list", err)
+	}
+
+	resources := make([]core.Resource, 0, len(summaries))
+	for _, summary := range summaries {
+		resources = append(resources, findingToResource(summary))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "accessanalyzer:finding",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// FindingsProvider Interface Implementation
+// =============================================================================
+
+// FindingsFor returns active findings for a resource ARN.
+// Findings are loaded once per account/region and cached briefly so that
+// per-resource enrichment in other views does not call the API repeatedly.
+func (s *Service) FindingsFor(ctx context.Context, resourceARN string) ([]core.Finding, error) {
+	if resourceARN == "" {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := s.currentCacheKey()
+	if s.byResource == nil || key != s.cacheKey || time.Since(s.cachedAt) > cacheTTL {
+		if err := s.refreshCacheLocked(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.byResource[resourceARN], nil
+}
+
+func (s *Service) refreshCacheLocked(ctx context.Context, key string) error {
+	// Failures are cached as "no findings" too, so a missing analyzer or
+	// denied permission costs one call per TTL rather than one per resource.
+	s.byResource = map[string][]core.Finding{}
+	s.cacheKey = key
+	s.cachedAt = time.Now()
+
+	analyzerArn, err := s.findAnalyzer(ctx)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := s.listFindings(ctx, analyzerArn, string(types.FindingStatusActive))
+	if err != nil {
+		return err
+	}
+
+	byResource := make(map[string][]core.Finding)
+	for _, summary := range summaries {
+		finding := summaryToFinding(summary)
+		byResource[finding.ResourceARN] = append(byResource[finding.ResourceARN], finding)
+	}
+
+	s.analyzerArn = analyzerArn
+	s.byResource = byResource
+	return nil
+}
+
+// invalidate drops cached findings so the next lookup reloads them.
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byResource = nil
+}
+
+func (s *Service) currentCacheKey() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Profile() + "/" + s.factory.Region()
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for Access Analyzer.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "archive",
+			Description: "Archive the finding as intended access",
+			Icon:        "archive",
+			Shortcut:    "a",
+			Dangerous:   false,
+			Category:    "security",
+		},
+	}
+}
+
+// Execute runs the specified action on a finding.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "archive":
+		result, err = s.archiveFinding(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) archiveFinding(ctx context.Context, findingID string) (*core.ActionResult, error) {
+	analyzerArn, err := s.analyzer(ctx)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("archive", findingID, err)
+	}
+
+	_, err = s.client().UpdateFindings(ctx, &accessanalyzer.UpdateFindingsInput{
+		AnalyzerArn: aws.String(analyzerArn),
+		Ids:         []string{findingID},
+		Status:      types.FindingStatusUpdateArchived,
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("archive", findingID, err)
+	}
+
+	s.invalidate()
+
+	return core.NewActionResult(true, fmt.Sprintf("Finding %s archived", findingID)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// analyzer returns the active account analyzer ARN, using the cached value when possible.
+func (s *Service) analyzer(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	if s.analyzerArn != "" && s.cacheKey == s.currentCacheKey() {
+		arn := s.analyzerArn
+		s.mu.Unlock()
+		return arn, nil
+	}
+	s.mu.Unlock()
+
+	return s.findAnalyzer(ctx)
+}
+
+// findAnalyzer looks up an active analyzer, preferring account scope over organization scope.
+func (s *Service) findAnalyzer(ctx context.Context) (string, error) {
+	var fallback string
+
+	paginator := accessanalyzer.NewListAnalyzersPaginator(s.client(), &accessanalyzer.ListAnalyzersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, analyzer := range page.Analyzers {
+			if analyzer.Status != types.AnalyzerStatusActive {
+				continue
+			}
+			switch analyzer.Type {
+			case types.TypeAccount:
+				return aws.ToString(analyzer.Arn), nil
+			case types.TypeOrganization:
+				if fallback == "" {
+					fallback = aws.ToString(analyzer.Arn)
+				}
+			}
+		}
+	}
+
+	if fallback == "" {
+		return "", fmt.Errorf("%w: no active external access analyzer in this region", core.ErrResourceNotFound)
+	}
+	return fallback, nil
+}
+
+func (s *Service) listFindings(ctx context.Context, analyzerArn, status string) ([]types.FindingSummary, error) {
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(analyzerArn),
+		Filter: map[string]types.Criterion{
+			"status": {Eq: []string{status}},
+		},
+	}
+
+	var summaries []types.FindingSummary
+	paginator := accessanalyzer.NewListFindingsPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, page.Findings...)
+	}
+
+	return summaries, nil
+}
+
+func summaryToFinding(summary types.FindingSummary) core.Finding {
+	principals := formatPrincipals(summary.Principal)
+	isPublic := aws.ToBool(summary.IsPublic)
+
+	title := "External access"
+	if isPublic {
+		title = "Public access"
+	}
+	if len(principals) > 0 {
+		title = fmt.Sprintf("%s: %s", title, strings.Join(principals, ", "))
+	}
+
+	return core.Finding{
+		ID:           aws.ToString(summary.Id),
+		Source:       "access-analyzer",
+		ResourceARN:  aws.ToString(summary.Resource),
+		ResourceType: string(summary.ResourceType),
+		Title:        title,
+		Status:       string(summary.Status),
+		Principals:   principals,
+		IsPublic:     isPublic,
+		Details: map[string]any{
+			"actions":   summary.Action,
+			"condition": summary.Condition,
+		},
+		UpdatedAt: summary.UpdatedAt,
+	}
+}
+
+func findingToResource(summary types.FindingSummary) core.Resource {
+	finding := summaryToFinding(summary)
+
+	resource := core.Resource{
+		ID:    finding.ID,
+		Type:  "accessanalyzer:finding",
+		Name:  finding.ResourceARN,
+		ARN:   finding.ResourceARN,
+		State: strings.ToLower(finding.Status),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"resource":       finding.ResourceARN,
+			"resource_type":  finding.ResourceType,
+			"principals":     finding.Principals,
+			"actions":        summary.Action,
+			"is_public":      finding.IsPublic,
+			"condition":      summary.Condition,
+			"resource_owner": aws.ToString(summary.ResourceOwnerAccount),
+			"title":          finding.Title,
+		},
+		CreatedAt: summary.CreatedAt,
+		UpdatedAt: summary.UpdatedAt,
+	}
+
+	if summary.UpdatedAt != nil {
+		resource.Metadata["updated"] = summary.UpdatedAt.Format("2006-01-02")
+	}
+	estimate.ApplyAge(&resource, time.Now())
+
+	return resource
+}
+
+// formatPrincipals renders a finding principal map as sorted "type=value" entries.
+func formatPrincipals(principal map[string]string) []string {
+	principals := make([]string, 0, len(principal))
+	for k, v := range principal {
+		principals = append(principals, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(principals)
+	return principals
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "accessanalyzer", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "accessanalyzer", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+	_ core.FindingsProvider = (*Service)(nil)
+)
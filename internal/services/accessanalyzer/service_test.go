@@ -0,0 +1,205 @@
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	orgAnalyzer     = "arn:aws:access-analyzer:us-east-1:123456789012:analyzer/org"
+	accountAnalyzer = "arn:aws:access-analyzer:us-east-1:123456789012:analyzer/account"
+	bucketARN       = "arn:aws:s3:::exports"
+)
+
+// fakeAnalyzer serves analyzers, by default an organization analyzer and an
+// account one, and two findings: a public one on the exports bucket and one,
+// archived, granting a partner account access to the same bucket. It fails
+// every call when err is set and records how often findings were listed and
+// which were archived.
+type fakeAnalyzer struct {
+	err       error
+	analyzers []types.AnalyzerSummary
+	listed    int
+	archived  []string
+}
+
+func analyzer(arn string, scope types.Type, status types.AnalyzerStatus) types.AnalyzerSummary {
+	return types.AnalyzerSummary{Arn: aws.String(arn), Type: scope, Status: status}
+}
+
+func (f *fakeAnalyzer) ListAnalyzers(context.Context, *accessanalyzer.ListAnalyzersInput, ...func(*accessanalyzer.Options)) (*accessanalyzer.ListAnalyzersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.analyzers == nil {
+		return &accessanalyzer.ListAnalyzersOutput{Analyzers: []types.AnalyzerSummary{
+			analyzer(orgAnalyzer, types.TypeOrganization, types.AnalyzerStatusActive),
+			analyzer(accountAnalyzer, types.TypeAccount, types.AnalyzerStatusActive),
+		}}, nil
+	}
+	return &accessanalyzer.ListAnalyzersOutput{Analyzers: f.analyzers}, nil
+}
+
+func (f *fakeAnalyzer) ListFindings(_ context.Context, in *accessanalyzer.ListFindingsInput, _ ...func(*accessanalyzer.Options)) (*accessanalyzer.ListFindingsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.listed++
+	findings := []types.FindingSummary{
+		{
+			Id:           aws.String("f-public"),
+			Resource:     aws.String(bucketARN),
+			ResourceType: types.ResourceTypeAwsS3Bucket,
+			Status:       types.FindingStatusActive,
+			IsPublic:     aws.Bool(true),
+			Principal:    map[string]string{"AWS": "*"},
+			Action:       []string{"s3:GetObject"},
+		},
+		{
+			Id:           aws.String("f-partner"),
+			Resource:     aws.String(bucketARN),
+			ResourceType: types.ResourceTypeAwsS3Bucket,
+			Status:       types.FindingStatusArchived,
+			IsPublic:     aws.Bool(false),
+			Principal:    map[string]string{"AWS": "210987654321", "Federated": "cognito-identity.amazonaws.com"},
+		},
+	}
+	status := in.Filter["status"].Eq[0]
+	out := &accessanalyzer.ListFindingsOutput{}
+	for _, finding := range findings {
+		if string(finding.Status) == status {
+			out.Findings = append(out.Findings, finding)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAnalyzer) UpdateFindings(_ context.Context, in *accessanalyzer.UpdateFindingsInput, _ ...func(*accessanalyzer.Options)) (*accessanalyzer.UpdateFindingsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.AnalyzerArn) != accountAnalyzer || in.Status != types.FindingStatusUpdateArchived {
+		return nil, errors.New("ValidationException: unexpected update")
+	}
+	f.archived = append(f.archived, in.Ids...)
+	return &accessanalyzer.UpdateFindingsOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against findings.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeAnalyzer{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeAnalyzer{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID: "f-public",
+		Action:     "archive",
+	})
+}
+
+func TestFindAnalyzer(t *testing.T) {
+	tests := []struct {
+		name      string
+		analyzers []types.AnalyzerSummary
+		want      string
+	}{
+		{
+			name: "account scope preferred",
+			analyzers: []types.AnalyzerSummary{
+				analyzer(orgAnalyzer, types.TypeOrganization, types.AnalyzerStatusActive),
+				analyzer(accountAnalyzer, types.TypeAccount, types.AnalyzerStatusActive),
+			},
+			want: accountAnalyzer,
+		},
+		{
+			name: "organization scope as fallback",
+			analyzers: []types.AnalyzerSummary{
+				analyzer(accountAnalyzer, types.TypeAccount, types.AnalyzerStatusDisabled),
+				analyzer(orgAnalyzer, types.TypeOrganization, types.AnalyzerStatusActive),
+			},
+			want: orgAnalyzer,
+		},
+		{
+			name: "unused access analyzers ignored",
+			analyzers: []types.AnalyzerSummary{
+				analyzer(accountAnalyzer, types.TypeAccountUnusedAccess, types.AnalyzerStatusActive),
+			},
+		},
+	}
+	for _, tt := range tests {
+		svc := NewServiceWithClient(&fakeAnalyzer{analyzers: tt.analyzers}, nil)
+		got, err := svc.findAnalyzer(context.Background())
+		if tt.want == "" {
+			if !errors.Is(err, core.ErrResourceNotFound) {
+				t.Errorf("%s: findAnalyzer() error = %v, want not found", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: findAnalyzer() = %q, %v; want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestListFiltersByStatus(t *testing.T) {
+	svc := NewServiceWithClient(&fakeAnalyzer{}, nil)
+
+	for status, want := range map[string]string{"": "f-public", "archived": "f-partner"} {
+		resources, err := svc.List(context.Background(), core.ListOptions{Filters: map[string]string{"status": status}})
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", status, err)
+		}
+		if len(resources) != 1 || resources[0].ID != want {
+			t.Errorf("List(%q) = %v, want %s", status, resources, want)
+		}
+	}
+}
+
+// TestFindingsForCaches checks that enrichment lookups share one listing
+// until a finding is archived.
+func TestFindingsForCaches(t *testing.T) {
+	fake := &fakeAnalyzer{}
+	svc := NewServiceWithClient(fake, nil)
+
+	for _, arn := range []string{bucketARN, "arn:aws:s3:::private", bucketARN} {
+		if _, err := svc.FindingsFor(context.Background(), arn); err != nil {
+			t.Fatalf("FindingsFor(%s) error = %v", arn, err)
+		}
+	}
+	findings, _ := svc.FindingsFor(context.Background(), bucketARN)
+	if fake.listed != 1 {
+		t.Errorf("findings listed %d times, want once", fake.listed)
+	}
+	if len(findings) != 1 || findings[0].Title != "Public access: AWS=*" || !findings[0].IsPublic {
+		t.Errorf("FindingsFor(%s) = %+v", bucketARN, findings)
+	}
+
+	if _, err := svc.Execute(context.Background(), "archive", "f-public", nil); err != nil {
+		t.Fatalf("archive error = %v", err)
+	}
+	if !slices.Equal(fake.archived, []string{"f-public"}) {
+		t.Errorf("archived %v", fake.archived)
+	}
+	_, _ = svc.FindingsFor(context.Background(), bucketARN)
+	if fake.listed != 2 {
+		t.Errorf("findings listed %d times after archiving, want a reload", fake.listed)
+	}
+}
+
+func TestFormatPrincipals(t *testing.T) {
+	got := formatPrincipals(map[string]string{"Federated": "cognito-identity.amazonaws.com", "AWS": "210987654321"})
+	if want := []string{"AWS=210987654321", "Federated=cognito-identity.amazonaws.com"}; !slices.Equal(got, want) {
+		t.Errorf("formatPrincipals() = %q, want %q", got, want)
+	}
+}
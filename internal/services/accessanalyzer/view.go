@@ -0,0 +1,285 @@
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Access Analyzer findings.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new Access Analyzer view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Resource"), MinWidth: 20, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 10, MaxWidth: 24, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("Principal"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Actions"), MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+		{Title: i18n.T("Public"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Updated"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Findings", "5", "accessanalyzer", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadFindings()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Archiving finding %s...", row.ID)
+				return v, v.executeAction("archive", row.ID)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Finding %s", row.ID), formatFinding(row))
+			}
+		}
+
+	case findingsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d active findings", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if msg.Action == "archive" {
+				cmds = append(cmds, v.loadFindings())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading Access Analyzer findings...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[a]rchive  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the findings.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadFindings()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type findingsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadFindings() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return findingsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return findingsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return findingsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, nil)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	rows := make([]table.Row, len(v.Resources))
+	for i, r := range v.Resources {
+		principals, _ := r.Metadata["principals"].([]string)
+		actions, _ := r.Metadata["actions"].([]string)
+
+		public := i18n.T("No")
+		if isPublic, ok := r.Metadata["is_public"].(bool); ok && isPublic {
+			public = "🔴 " + i18n.T("Yes")
+		}
+
+		rows[i] = table.Row{
+			base.TruncateString(r.GetMetadataString("resource"), 60),
+			r.GetMetadataString("resource_type"),
+			base.TruncateString(strings.Join(principals, ", "), 40),
+			base.TruncateString(strings.Join(actions, ", "), 40),
+			public,
+			r.GetMetadataString("updated"),
+			base.FormatAge(r),
+		}
+	}
+	v.SetRows(rows)
+}
+
+// formatFinding renders a finding for the detail panel.
+func formatFinding(r *core.Resource) string {
+	principals, _ := r.Metadata["principals"].([]string)
+	actions, _ := r.Metadata["actions"].([]string)
+	condition, _ := r.Metadata["condition"].(map[string]string)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Resource:  %s\n", r.GetMetadataString("resource"))
+	fmt.Fprintf(&b, "Type:      %s\n", r.GetMetadataString("resource_type"))
+	fmt.Fprintf(&b, "Owner:     %s\n", r.GetMetadataString("resource_owner"))
+	fmt.Fprintf(&b, "Status:    %s\n", r.State)
+	fmt.Fprintf(&b, "Public:    %v\n", r.Metadata["is_public"])
+	fmt.Fprintf(&b, "Updated:   %s\n\n", r.GetMetadataString("updated"))
+
+	b.WriteString(i18n.T("Principals:\n"))
+	for _, p := range principals {
+		fmt.Fprintf(&b, "  %s\n", p)
+	}
+	b.WriteString(i18n.T("\nActions:\n"))
+	for _, a := range actions {
+		fmt.Fprintf(&b, "  %s\n", a)
+	}
+	if len(condition) > 0 {
+		b.WriteString(i18n.T("\nConditions:\n"))
+		for k, val := range condition {
+			fmt.Fprintf(&b, "  %s = %s\n", k, val)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	total := len(v.Resources)
+	public := 0
+	for _, r := range v.Resources {
+		if isPublic, ok := r.Metadata["is_public"].(bool); ok && isPublic {
+			public++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "active", Text: i18n.T("Active: %d", total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "public", Text: i18n.T("Public: %d", public), Tone: core.ToneError},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Access Analyzer Findings"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "accessanalyzer" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
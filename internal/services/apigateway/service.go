@@ -0,0 +1,647 @@
+// Package apigateway provides API Gateway integration for the a9s
+// application. It lists REST, HTTP and WebSocket APIs with their endpoint
+// and stages, deploys stages and deletes APIs.
+package apigateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rest "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	resttypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// API protocols, as shown in the Protocol column.
+const (
+	ProtocolREST      = "REST"
+	ProtocolHTTP      = "HTTP"
+	ProtocolWebSocket = "WEBSOCKET"
+)
+
+// Stage is a stage of an API with the throttling applied to its routes.
+type Stage struct {
+	Name        string
+	Deployment  string
+	AutoDeploy  bool // HTTP and WebSocket stages deploying every change
+	LastUpdated time.Time
+	// RateLimit and BurstLimit are the stage's default throttling; zero
+	// when the stage sets none and the account limits apply
+	RateLimit  float64
+	BurstLimit int32
+}
+
+// Throttled reports whether the stage sets throttling of its own.
+func (s Stage) Throttled() bool {
+	return s.RateLimit != 0 || s.BurstLimit != 0
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements API Gateway operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient RestAPI
+	httpClient HTTPAPI
+}
+
+// RestAPI defines the API Gateway client interface for REST APIs.
+type RestAPI interface {
+	GetRestApis(ctx context.Context, params *rest.GetRestApisInput, optFns ...func(*rest.Options)) (*rest.GetRestApisOutput, error)
+	GetRestApi(ctx context.Context, params *rest.GetRestApiInput, optFns ...func(*rest.Options)) (*rest.GetRestApiOutput, error)
+	GetStages(ctx context.Context, params *rest.GetStagesInput, optFns ...func(*rest.Options)) (*rest.GetStagesOutput, error)
+	CreateDeployment(ctx context.Context, params *rest.CreateDeploymentInput, optFns ...func(*rest.Options)) (*rest.CreateDeploymentOutput, error)
+	DeleteRestApi(ctx context.Context, params *rest.DeleteRestApiInput, optFns ...func(*rest.Options)) (*rest.DeleteRestApiOutput, error)
+}
+
+// HTTPAPI defines the API Gateway v2 client interface for HTTP and
+// WebSocket APIs.
+type HTTPAPI interface {
+	GetApis(ctx context.Context, params *apigatewayv2.GetApisInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error)
+	GetApi(ctx context.Context, params *apigatewayv2.GetApiInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiOutput, error)
+	GetStages(ctx context.Context, params *apigatewayv2.GetStagesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error)
+	CreateDeployment(ctx context.Context, params *apigatewayv2.CreateDeploymentInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.CreateDeploymentOutput, error)
+	DeleteApi(ctx context.Context, params *apigatewayv2.DeleteApiInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.DeleteApiOutput, error)
+}
+
+// Option configures the API Gateway service.
+type Option func(*Service)
+
+// WithHTTPClient sets a custom API Gateway v2 client (for testing).
+func WithHTTPClient(client HTTPAPI) Option {
+	return func(s *Service) {
+		s.httpClient = client
+	}
+}
+
+// NewService creates a new API Gateway service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom REST API client
+// (for testing).
+func NewServiceWithClient(client RestAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the API Gateway client for REST APIs.
+func (s *Service) client() RestAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return rest.NewFromConfig(s.factory.Config())
+}
+
+// http returns the API Gateway v2 client for HTTP and WebSocket APIs.
+func (s *Service) http() HTTPAPI {
+	if s.httpClient != nil {
+		return s.httpClient
+	}
+	return apigatewayv2.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region APIs are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "apigateway"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "API Gateway"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "globe"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().GetRestApis(ctx, &rest.GetRestApisInput{Limit: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("apigateway", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the REST APIs, then the HTTP and WebSocket APIs of the
+// region. Their stages are read by EnrichResource.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := []core.Resource{}
+
+	paginator := rest.NewGetRestApisPaginator(s.client(), &rest.GetRestApisInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("apigateway", "list", err)
+		}
+		for _, api := range page.Items {
+			resources = append(resources, restToResource(api, s.region()))
+		}
+	}
+
+	var token *string
+	for {
+		page, err := s.http().GetApis(ctx, &apigatewayv2.GetApisInput{NextToken: token})
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("apigateway", "list", err)
+		}
+		for _, api := range page.Items {
+			resources = append(resources, httpToResource(api, s.region()))
+		}
+		if token = page.NextToken; token == nil {
+			break
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "apigateway:api",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get retrieves an API by ID, REST or not.
+func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
+	resource, err := s.lookup(ctx, id)
+	if err != nil {
+		return nil, core.NewServiceError("apigateway", "get", err)
+	}
+	return resource, nil
+}
+
+// lookup finds an API among the REST APIs, then the HTTP and WebSocket
+// ones: both share the ID format.
+func (s *Service) lookup(ctx context.Context, id string) (*core.Resource, error) {
+	out, err := s.client().GetRestApi(ctx, &rest.GetRestApiInput{RestApiId: aws.String(id)})
+	if err == nil {
+		resource := restToResource(resttypes.RestApi{
+			Id:                        out.Id,
+			Name:                      out.Name,
+			Description:               out.Description,
+			CreatedDate:               out.CreatedDate,
+			EndpointConfiguration:     out.EndpointConfiguration,
+			DisableExecuteApiEndpoint: out.DisableExecuteApiEndpoint,
+			Tags:                      out.Tags,
+		}, s.region())
+		return &resource, nil
+	}
+	var notFound *resttypes.NotFoundException
+	if !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	api, err := s.http().GetApi(ctx, &apigatewayv2.GetApiInput{ApiId: aws.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	resource := httpToResource(types.Api{
+		ApiId:                     api.ApiId,
+		Name:                      api.Name,
+		ProtocolType:              api.ProtocolType,
+		ApiEndpoint:               api.ApiEndpoint,
+		Description:               api.Description,
+		CreatedDate:               api.CreatedDate,
+		DisableExecuteApiEndpoint: api.DisableExecuteApiEndpoint,
+		Tags:                      api.Tags,
+	}, s.region())
+	return &resource, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds an API's stages with their throttling. APIs deployed
+// to no stage are flagged low, and stages relying on the account's
+// throttling limits are noted.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	if resource.Type != "apigateway:api" {
+		return nil
+	}
+
+	stages, err := s.stages(ctx, resource.ID, resource.GetMetadataString("protocol"))
+	if err != nil {
+		return err
+	}
+	resource.Metadata["stages"] = stages
+	resource.Metadata["stage_count"] = len(stages)
+	resource.Metadata["analyzed"] = true
+
+	resource.ClearIssues()
+	if len(stages) == 0 {
+		resource.AddIssue(core.SeverityLow, "Not deployed to any stage")
+	}
+	var unthrottled []string
+	for _, stage := range stages {
+		if !stage.Throttled() {
+			unthrottled = append(unthrottled, stage.Name)
+		}
+	}
+	if len(unthrottled) > 0 {
+		resource.AddIssue(core.SeverityInfo, fmt.Sprintf("No stage throttling on %s; only the account limits apply", strings.Join(unthrottled, ", ")))
+	}
+	return nil
+}
+
+// stages returns the stages of an API, sorted by name.
+func (s *Service) stages(ctx context.Context, id, protocol string) ([]Stage, error) {
+	var stages []Stage
+	if protocol == ProtocolREST {
+		out, err := s.client().GetStages(ctx, &rest.GetStagesInput{RestApiId: aws.String(id)})
+		if err != nil {
+			return nil, err
+		}
+		for _, st := range out.Item {
+			stage := Stage{
+				Name:        aws.ToString(st.StageName),
+				Deployment:  aws.ToString(st.DeploymentId),
+				LastUpdated: aws.ToTime(st.LastUpdatedDate),
+			}
+			// "*/*" holds the settings of every method of the stage
+			if all, ok := st.MethodSettings["*/*"]; ok {
+				stage.RateLimit, stage.BurstLimit = all.ThrottlingRateLimit, all.ThrottlingBurstLimit
+			}
+			stages = append(stages, stage)
+		}
+	} else {
+		var token *string
+		for {
+			out, err := s.http().GetStages(ctx, &apigatewayv2.GetStagesInput{ApiId: aws.String(id), NextToken: token})
+			if err != nil {
+				return nil, err
+			}
+			for _, st := range out.Items {
+				stage := Stage{
+					Name:        aws.ToString(st.StageName),
+					Deployment:  aws.ToString(st.DeploymentId),
+					AutoDeploy:  aws.ToBool(st.AutoDeploy),
+					LastUpdated: aws.ToTime(st.LastUpdatedDate),
+				}
+				if route := st.DefaultRouteSettings; route != nil {
+					stage.RateLimit, stage.BurstLimit = aws.ToFloat64(route.ThrottlingRateLimit), aws.ToInt32(route.ThrottlingBurstLimit)
+				}
+				stages = append(stages, stage)
+			}
+			if token = out.NextToken; token == nil {
+				break
+			}
+		}
+	}
+	slices.SortFunc(stages, func(a, b Stage) int { return strings.Compare(a.Name, b.Name) })
+	return stages, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for APIs.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "deploy_stage",
+			Description: "Deploy the API's current configuration to a stage",
+			Icon:        "upload",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "deploy",
+			Parameters: []core.ActionParameter{
+				{Name: "stage", Type: "string", Required: true, Description: "Stage to deploy to; REST APIs create it when missing"},
+				{Name: "description", Type: "string", Description: "Description of the deployment"},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the API with its stages",
+			Icon:        "trash",
+			Shortcut:    "X",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on an API, identified by its ID.
+// Both actions ask for confirmation through a core.ConfirmationError until
+// the "confirm" parameter is set; deleting asks to type the API's ID.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "deploy_stage":
+		stage, _ := params["stage"].(string)
+		description, _ := params["description"].(string)
+		result, err = s.deployStage(ctx, resourceID, strings.TrimSpace(stage), description, params, confirmed)
+	case "delete":
+		result, err = s.deleteAPI(ctx, resourceID, params, core.Confirmed(params, resourceID, true))
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// deployStage deploys the current routes and integrations of an API to a
+// stage once confirmed, since the stage serves them right away. REST APIs
+// create the stage when missing. HTTP and WebSocket stages must exist and
+// not deploy automatically.
+func (s *Service) deployStage(ctx context.Context, id, name, description string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("deploy_stage", id, err)
+	}
+	if name == "" {
+		return fail(core.NewValidationError("stage", name, "is required"))
+	}
+
+	api, err := s.lookup(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	protocol := api.GetMetadataString("protocol")
+	stages, err := s.stages(ctx, id, protocol)
+	if err != nil {
+		return fail(err)
+	}
+	i := slices.IndexFunc(stages, func(st Stage) bool { return st.Name == name })
+	if i < 0 && protocol != ProtocolREST {
+		return fail(core.NewValidationError("stage", name, "does not exist on "+api.Name))
+	}
+	if i >= 0 && stages[i].AutoDeploy {
+		return fail(core.NewValidationError("stage", name, "deploys every change automatically"))
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Creates stage %s of %s, serving its current configuration", name, api.Name)
+		if i >= 0 {
+			reason = fmt.Sprintf("Stage %s of %s serves the API's current configuration instead of deployment %s", name, api.Name, stages[i].Deployment)
+		}
+		return nil, core.NewConfirmationError(s, "deploy_stage", id, params, reason, false)
+	}
+
+	var deployment string
+	if protocol == ProtocolREST {
+		out, err := s.client().CreateDeployment(ctx, &rest.CreateDeploymentInput{
+			RestApiId:   aws.String(id),
+			StageName:   aws.String(name),
+			Description: optional(description),
+		})
+		if err != nil {
+			return fail(err)
+		}
+		deployment = aws.ToString(out.Id)
+	} else {
+		out, err := s.http().CreateDeployment(ctx, &apigatewayv2.CreateDeploymentInput{
+			ApiId:       aws.String(id),
+			StageName:   aws.String(name),
+			Description: optional(description),
+		})
+		if err != nil {
+			return fail(err)
+		}
+		if out.DeploymentStatus == types.DeploymentStatusFailed {
+			return fail(fmt.Errorf("deployment %s failed: %s", aws.ToString(out.DeploymentId), aws.ToString(out.DeploymentStatusMessage)))
+		}
+		deployment = aws.ToString(out.DeploymentId)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Deployed %s to stage %s as %s", api.Name, name, deployment))
+	result.Data = map[string]any{"deployment_id": deployment}
+	return result, nil
+}
+
+// deleteAPI deletes an API once confirmed by typing its ID; its stages
+// and endpoint go with it.
+func (s *Service) deleteAPI(ctx context.Context, id string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+	}
+
+	api, err := s.lookup(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	protocol := api.GetMetadataString("protocol")
+
+	if !confirmed {
+		stages, err := s.stages(ctx, id, protocol)
+		if err != nil {
+			return fail(err)
+		}
+		reason := fmt.Sprintf("Deletes %s API %s with its %d stages; %s stops answering and cannot be restored", protocol, api.Name, len(stages), api.GetMetadataString("endpoint"))
+		return nil, core.NewConfirmationError(s, "delete", id, params, reason, true)
+	}
+
+	if protocol == ProtocolREST {
+		_, err = s.client().DeleteRestApi(ctx, &rest.DeleteRestApiInput{RestApiId: aws.String(id)})
+	} else {
+		_, err = s.http().DeleteApi(ctx, &apigatewayv2.DeleteApiInput{ApiId: aws.String(id)})
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   id,
+		ResourceType: "apigateway:api",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleted API %s", api.Name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func restToResource(api resttypes.RestApi, region string) core.Resource {
+	id := aws.ToString(api.Id)
+	endpointType := "EDGE"
+	if config := api.EndpointConfiguration; config != nil && len(config.Types) > 0 {
+		endpointType = string(config.Types[0])
+	}
+	endpoint := fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com", id, region)
+	if api.DisableExecuteApiEndpoint {
+		endpoint = ""
+	}
+
+	resource := core.Resource{
+		ID:        id,
+		Type:      "apigateway:api",
+		Name:      aws.ToString(api.Name),
+		ARN:       fmt.Sprintf("arn:aws:apigateway:%s::/restapis/%s", region, id),
+		State:     "available",
+		Region:    region,
+		CreatedAt: api.CreatedDate,
+		Tags:      tagsOf(api.Tags),
+		Metadata: map[string]any{
+			"protocol":      ProtocolREST,
+			"endpoint":      endpoint,
+			"endpoint_type": endpointType,
+			"description":   aws.ToString(api.Description),
+		},
+	}
+	iac.Apply(&resource)
+	return resource
+}
+
+func httpToResource(api types.Api, region string) core.Resource {
+	id := aws.ToString(api.ApiId)
+	endpoint := aws.ToString(api.ApiEndpoint)
+	if aws.ToBool(api.DisableExecuteApiEndpoint) {
+		endpoint = ""
+	}
+
+	resource := core.Resource{
+		ID:        id,
+		Type:      "apigateway:api",
+		Name:      aws.ToString(api.Name),
+		ARN:       fmt.Sprintf("arn:aws:apigateway:%s::/apis/%s", region, id),
+		State:     "available",
+		Region:    region,
+		CreatedAt: api.CreatedDate,
+		Tags:      tagsOf(api.Tags),
+		Metadata: map[string]any{
+			"protocol":      string(api.ProtocolType),
+			"endpoint":      endpoint,
+			"endpoint_type": "REGIONAL",
+			"description":   aws.ToString(api.Description),
+		},
+	}
+	iac.Apply(&resource)
+	return resource
+}
+
+// tagsOf copies the tags of an API, never returning nil.
+func tagsOf(tags map[string]string) map[string]string {
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		out[k] = v
+	}
+	return out
+}
+
+// optional returns nil for an empty string, so optional fields are left
+// out of requests.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "apigateway", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "apigateway", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
@@ -0,0 +1,241 @@
+package apigateway
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rest "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	resttypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	restID = "a1b2c3d4e5"
+	httpID = "f6g7h8i9j0"
+)
+
+// fakeREST serves one REST API deployed to a throttled prod stage, or
+// fails every call when err is set. It records deployments and deletions.
+type fakeREST struct {
+	err      error
+	deployed []string
+	deleted  []string
+}
+
+func (f *fakeREST) GetRestApis(context.Context, *rest.GetRestApisInput, ...func(*rest.Options)) (*rest.GetRestApisOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &rest.GetRestApisOutput{Items: []resttypes.RestApi{{
+		Id:                    aws.String(restID),
+		Name:                  aws.String("orders"),
+		EndpointConfiguration: &resttypes.EndpointConfiguration{Types: []resttypes.EndpointType{resttypes.EndpointTypeRegional}},
+	}}}, nil
+}
+
+func (f *fakeREST) GetRestApi(_ context.Context, in *rest.GetRestApiInput, _ ...func(*rest.Options)) (*rest.GetRestApiOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.RestApiId) != restID {
+		return nil, &resttypes.NotFoundException{Message: aws.String("Invalid API identifier specified")}
+	}
+	return &rest.GetRestApiOutput{Id: aws.String(restID), Name: aws.String("orders")}, nil
+}
+
+func (f *fakeREST) GetStages(context.Context, *rest.GetStagesInput, ...func(*rest.Options)) (*rest.GetStagesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &rest.GetStagesOutput{Item: []resttypes.Stage{{
+		StageName:      aws.String("prod"),
+		DeploymentId:   aws.String("dep1"),
+		MethodSettings: map[string]resttypes.MethodSetting{"*/*": {ThrottlingRateLimit: 100, ThrottlingBurstLimit: 50}},
+	}}}, nil
+}
+
+func (f *fakeREST) CreateDeployment(_ context.Context, in *rest.CreateDeploymentInput, _ ...func(*rest.Options)) (*rest.CreateDeploymentOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deployed = append(f.deployed, aws.ToString(in.StageName))
+	return &rest.CreateDeploymentOutput{Id: aws.String("dep2")}, nil
+}
+
+func (f *fakeREST) DeleteRestApi(_ context.Context, in *rest.DeleteRestApiInput, _ ...func(*rest.Options)) (*rest.DeleteRestApiOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.RestApiId))
+	return &rest.DeleteRestApiOutput{}, nil
+}
+
+// fakeHTTP serves one HTTP API with an auto-deployed stage and a stage
+// deployed by hand without throttling, or fails every call when err is
+// set. It records deployments.
+type fakeHTTP struct {
+	err      error
+	deployed []string
+}
+
+func (f *fakeHTTP) GetApis(context.Context, *apigatewayv2.GetApisInput, ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &apigatewayv2.GetApisOutput{Items: []types.Api{{
+		ApiId:        aws.String(httpID),
+		Name:         aws.String("webhooks"),
+		ProtocolType: types.ProtocolTypeHttp,
+		ApiEndpoint:  aws.String("https://" + httpID + ".execute-api.us-east-1.amazonaws.com"),
+	}}}, nil
+}
+
+func (f *fakeHTTP) GetApi(_ context.Context, in *apigatewayv2.GetApiInput, _ ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.ApiId) != httpID {
+		return nil, &types.NotFoundException{Message: aws.String("Invalid API identifier specified")}
+	}
+	return &apigatewayv2.GetApiOutput{ApiId: aws.String(httpID), Name: aws.String("webhooks"), ProtocolType: types.ProtocolTypeHttp}, nil
+}
+
+func (f *fakeHTTP) GetStages(context.Context, *apigatewayv2.GetStagesInput, ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &apigatewayv2.GetStagesOutput{Items: []types.Stage{
+		{StageName: aws.String("$default"), AutoDeploy: aws.Bool(true)},
+		{StageName: aws.String("live"), DeploymentId: aws.String("abc")},
+	}}, nil
+}
+
+func (f *fakeHTTP) CreateDeployment(_ context.Context, in *apigatewayv2.CreateDeploymentInput, _ ...func(*apigatewayv2.Options)) (*apigatewayv2.CreateDeploymentOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deployed = append(f.deployed, aws.ToString(in.StageName))
+	return &apigatewayv2.CreateDeploymentOutput{DeploymentId: aws.String("def"), DeploymentStatus: types.DeploymentStatusDeployed}, nil
+}
+
+func (f *fakeHTTP) DeleteApi(context.Context, *apigatewayv2.DeleteApiInput, ...func(*apigatewayv2.Options)) (*apigatewayv2.DeleteApiOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &apigatewayv2.DeleteApiOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against APIs.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeREST{}, d, WithHTTPClient(&fakeHTTP{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			err := errors.New("AccessDenied")
+			return NewServiceWithClient(&fakeREST{err: err}, d, WithHTTPClient(&fakeHTTP{err: err}))
+		},
+		ExistingID:    restID,
+		MissingID:     "zzzzzzzzzz",
+		Action:        "deploy_stage",
+		ActionParams:  map[string]any{"stage": "prod", core.ParamConfirm: true},
+		ConfirmAction: "delete",
+	})
+}
+
+func TestListAndEnrich(t *testing.T) {
+	svc := NewServiceWithClient(&fakeREST{}, nil, WithHTTPClient(&fakeHTTP{}))
+	ctx := context.Background()
+
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() returned %d APIs, want 2", len(resources))
+	}
+	restAPI, httpAPI := resources[0], resources[1]
+	if restAPI.GetMetadataString("protocol") != ProtocolREST || restAPI.GetMetadataString("endpoint_type") != "REGIONAL" ||
+		!strings.HasPrefix(restAPI.GetMetadataString("endpoint"), "https://"+restID+".execute-api.") {
+		t.Errorf("REST API metadata = %v", restAPI.Metadata)
+	}
+	if httpAPI.GetMetadataString("protocol") != ProtocolHTTP {
+		t.Errorf("HTTP API protocol = %q", httpAPI.GetMetadataString("protocol"))
+	}
+
+	for i := range resources {
+		if err := svc.EnrichResource(ctx, &resources[i]); err != nil {
+			t.Fatalf("EnrichResource(%s) error = %v", resources[i].Name, err)
+		}
+	}
+	restAPI, httpAPI = resources[0], resources[1]
+	stages, _ := restAPI.Metadata["stages"].([]Stage)
+	if len(stages) != 1 || stages[0].RateLimit != 100 || stages[0].BurstLimit != 50 {
+		t.Errorf("REST stages = %+v, want prod throttled at 100/50", stages)
+	}
+	if len(restAPI.Issues()) != 0 {
+		t.Errorf("throttled REST API has issues %v", restAPI.Issues())
+	}
+	issues := httpAPI.Issues()
+	if len(issues) != 1 || issues[0].Severity != core.SeverityInfo || !strings.Contains(issues[0].Message, "$default, live") {
+		t.Errorf("HTTP API issues = %v, want a note on both unthrottled stages", issues)
+	}
+}
+
+func TestDeployStage(t *testing.T) {
+	restClient, httpClient := &fakeREST{}, &fakeHTTP{}
+	svc := NewServiceWithClient(restClient, nil, WithHTTPClient(httpClient))
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "deploy_stage", restID, map[string]any{"stage": "prod"})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "dep1") {
+		t.Fatalf("deploy_stage error = %v, want a confirmation naming the current deployment", err)
+	}
+	if _, err := svc.Execute(ctx, "deploy_stage", restID, map[string]any{"stage": "beta", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("deploying a new REST stage error = %v", err)
+	}
+	if len(restClient.deployed) != 1 || restClient.deployed[0] != "beta" {
+		t.Errorf("REST deployments = %v, want [beta]", restClient.deployed)
+	}
+
+	for _, stage := range []string{"$default", "missing"} {
+		if _, err := svc.Execute(ctx, "deploy_stage", httpID, map[string]any{"stage": stage, core.ParamConfirm: true}); err == nil {
+			t.Errorf("deploying HTTP stage %s succeeded", stage)
+		}
+	}
+	if _, err := svc.Execute(ctx, "deploy_stage", httpID, map[string]any{"stage": "live", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("deploying HTTP stage live error = %v", err)
+	}
+	if len(httpClient.deployed) != 1 || httpClient.deployed[0] != "live" {
+		t.Errorf("HTTP deployments = %v, want [live]", httpClient.deployed)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	restClient := &fakeREST{}
+	svc := NewServiceWithClient(restClient, nil, WithHTTPClient(&fakeHTTP{}))
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "delete", restID, nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !confirm.TypeResource {
+		t.Fatalf("delete error = %v, want a confirmation typing the ID", err)
+	}
+	if _, err := svc.Execute(ctx, "delete", restID, map[string]any{core.ParamConfirm: true}); !errors.As(err, &confirm) {
+		t.Fatalf("delete without the ID typed error = %v, want a confirmation", err)
+	}
+	if _, err := svc.Execute(ctx, "delete", restID, map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: restID}); err != nil {
+		t.Fatalf("confirmed delete error = %v", err)
+	}
+	if len(restClient.deleted) != 1 {
+		t.Errorf("deleted = %v", restClient.deleted)
+	}
+}
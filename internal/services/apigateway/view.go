@@ -0,0 +1,340 @@
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const deployFormID = "apigateway:deploy_stage"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for API Gateway APIs.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget *core.Resource // API the deploy form is open for
+}
+
+// NewView creates a new API Gateway view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("ID"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Protocol"), MinWidth: 9, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Endpoint Type"), MinWidth: 8, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Stages"), MinWidth: 6, MaxWidth: 30, Weight: 0.6, Priority: 1},
+		{Title: i18n.T("Throttling"), MinWidth: 10, MaxWidth: 20, Weight: 0.4, Priority: 2},
+		{Title: i18n.T("Endpoint"), MinWidth: 20, MaxWidth: 70, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("API Gateway", "", "apigateway", i18n.T("APIs"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openDeployForm(row)
+			}
+		case "X":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("API %s", row.Name), formatAPI(row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != deployFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == nil {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Deploying %s to %v...", v.formTarget.Name, msg.Values["stage"])
+		cmds = append(cmds, v.executeAction("deploy_stage", v.formTarget.ID, msg.Values))
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.SoftRefresh())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading APIs...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [d]eploy stage  [X]delete  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the APIs, keeping the stages already read.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// openDeployForm asks for the stage to deploy to, starting from the API's
+// only stage, or its first one deployed by hand.
+func (v *View) openDeployForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "deploy_stage")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "deploy_stage")
+		return nil
+	}
+	params := make([]core.ActionParameter, len(def.Parameters))
+	copy(params, def.Parameters)
+	stages, _ := r.Metadata["stages"].([]Stage)
+	for _, stage := range stages {
+		if stage.AutoDeploy {
+			continue
+		}
+		for i := range params {
+			if params[i].Name == "stage" {
+				params[i].Default = stage.Name
+			}
+		}
+		break
+	}
+	target := *r
+	v.formTarget = &target
+	return v.OpenForm(components.NewForm(deployFormID, i18n.T("Deploy %s", r.Name), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	stagesText, throttling := "-", "-"
+	stages, analyzed := r.Metadata["stages"].([]Stage)
+	if analyzed {
+		names := make([]string, len(stages))
+		throttled := 0
+		for i, stage := range stages {
+			names[i] = stage.Name
+			if stage.Throttled() {
+				throttled++
+			}
+		}
+		stagesText = fmt.Sprintf("%d", len(stages))
+		if len(names) > 0 {
+			stagesText += " " + strings.Join(names, ", ")
+		}
+		if len(stages) > 0 {
+			throttling = fmt.Sprintf("%d/%d stages", throttled, len(stages))
+		}
+	}
+	endpoint := r.GetMetadataString("endpoint")
+	if endpoint == "" {
+		endpoint = i18n.T("disabled")
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.TextCell(r.ID),
+		base.TextCell(r.GetMetadataString("protocol")),
+		base.TextCell(r.GetMetadataString("endpoint_type")),
+		base.LazyCell(len(stages), func() string { return stagesText }),
+		base.TextCell(throttling),
+		base.TextCell(endpoint),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+		base.AgeCell(r),
+	}
+}
+
+// formatAPI renders an API with its stages and their throttling for the
+// detail panel.
+func formatAPI(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID:          %s\n", r.ID)
+	fmt.Fprintf(&b, "Protocol:    %s (%s)\n", r.GetMetadataString("protocol"), r.GetMetadataString("endpoint_type"))
+	if desc := r.GetMetadataString("description"); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
+	if endpoint := r.GetMetadataString("endpoint"); endpoint != "" {
+		fmt.Fprintf(&b, "Endpoint:    %s\n", endpoint)
+	} else {
+		b.WriteString("Endpoint:    default endpoint disabled\n")
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:     %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if stages, ok := r.Metadata["stages"].([]Stage); ok {
+		b.WriteString(i18n.T("\nStages:\n"))
+		if len(stages) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, stage := range stages {
+			fmt.Fprintf(&b, "  %s\n", stage.Name)
+			if stage.AutoDeploy {
+				b.WriteString("    Deployment: automatic\n")
+			} else if stage.Deployment != "" {
+				fmt.Fprintf(&b, "    Deployment: %s\n", stage.Deployment)
+			}
+			if !stage.LastUpdated.IsZero() {
+				fmt.Fprintf(&b, "    Updated:    %s\n", stage.LastUpdated.Local().Format("2006-01-02 15:04"))
+			}
+			if stage.Throttled() {
+				fmt.Fprintf(&b, "    Throttling: %.0f requests/s, bursts of %d\n", stage.RateLimit, stage.BurstLimit)
+			} else {
+				b.WriteString("    Throttling: account limits\n")
+			}
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	restAPIs, undeployed := 0, 0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("protocol") == ProtocolREST {
+			restAPIs++
+		}
+		if count, ok := r.Metadata["stage_count"].(int); ok && count == 0 {
+			undeployed++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "rest", Text: i18n.T("REST: %d", restAPIs), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "http", Text: i18n.T("HTTP/WebSocket: %d", len(v.Resources)-restAPIs), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "undeployed", Text: i18n.T("Undeployed: %d", undeployed), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("API Gateway"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "apigateway" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
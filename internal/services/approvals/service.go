@@ -0,0 +1,241 @@
+// Package approvals exposes pending two-person approval requests as a service
+// so they can be reviewed and decided from the TUI.
+package approvals
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/approval"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service lists approval requests and decides them on behalf of an operator.
+type Service struct {
+	store      *approval.Store
+	operator   string
+	dispatcher core.EventDispatcher
+}
+
+// NewService creates an approvals service deciding requests as operator.
+func NewService(store *approval.Store, operator string, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		store:      store,
+		operator:   operator,
+		dispatcher: dispatcher,
+	}
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "approvals"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Two-person approval requests"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "check"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the request store is readable.
+func (s *Service) HealthCheck(_ context.Context) error {
+	if _, err := s.store.List(); err != nil {
+		return core.NewServiceError("approvals", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns approval requests, newest first. Only pending requests are
+// returned unless a "status" filter is given ("all" returns every request).
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	requests, err := s.store.List()
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("approvals", "list", err)
+	}
+
+	status := string(approval.StatusPending)
+	if v, ok := opts.Filters["status"]; ok && v != "" {
+		status = strings.ToLower(v)
+	}
+
+	resources := make([]core.Resource, 0, len(requests))
+	for _, r := range requests {
+		if status != "all" && string(r.Status) != status {
+			continue
+		}
+		resources = append(resources, s.toResource(r))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "approval:request",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+func (s *Service) toResource(r approval.Request) core.Resource {
+	requested := r.RequestedAt
+	resource := core.Resource{
+		ID:        r.ID,
+		Type:      "approval:request",
+		Name:      r.Service + ":" + r.Action,
+		State:     string(r.Status),
+		CreatedAt: &requested,
+		Metadata: map[string]any{
+			"service":     r.Service,
+			"action":      r.Action,
+			"resource_id": r.ResourceID,
+			"params":      r.Params,
+			"requester":   r.Requester,
+			"requested":   r.RequestedAt.Format("2006-01-02 15:04"),
+			"status":      string(r.Status),
+			"approver":    r.Approver,
+			"reason":      r.Reason,
+			"own":         r.Requester == s.operator,
+		},
+	}
+	estimate.ApplyAge(&resource, time.Now())
+	if r.Status == approval.StatusPending {
+		resource.AddIssue(core.SeverityInfo, "Awaiting approval")
+	}
+	if r.DecidedAt != nil {
+		resource.Metadata["decided"] = r.DecidedAt.Format("2006-01-02 15:04")
+	}
+	return resource
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for approval requests.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "approve",
+			Description: "Approve the request",
+			Icon:        "check",
+			Shortcut:    "a",
+			Dangerous:   false,
+			Category:    "approval",
+		},
+		{
+			Name:        "reject",
+			Description: "Reject the request",
+			Icon:        "x",
+			Shortcut:    "x",
+			Parameters: []core.ActionParameter{
+				{Name: "reason", Type: "string", Required: false, Description: "Reason shown to the requester"},
+			},
+			Dangerous: false,
+			Category:  "approval",
+		},
+	}
+}
+
+// Execute decides an approval request.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var approve bool
+	switch action {
+	case "approve":
+		approve = true
+	case "reject":
+		approve = false
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	reason, _ := params["reason"].(string)
+	r, err := s.store.Decide(ctx, resourceID, s.operator, approve, reason)
+	if err != nil {
+		err = core.NewActionError(action, resourceID, err)
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return core.NewActionResult(false, err.Error()), err
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Request %s %s (%s:%s on %s by %s)",
+		r.ID, r.Status, r.Service, r.Action, r.ResourceID, r.Requester))
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Helper Methods
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "approvals", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "approvals", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,140 @@
+package approvals
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/approval"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// newStore writes a store holding a request by alice to terminate i-1, one
+// by bob to stop i-2, one by alice to delete a database, already approved
+// by carol, and one filed long enough ago to have expired. Requests come
+// from the requesters' own OS accounts, so the test's may decide them.
+func newStore(t *testing.T) *approval.Store {
+	t.Helper()
+	now := time.Now()
+	decided := now.Add(-30 * time.Minute)
+	request := func(id, action, resourceID, requester string, age time.Duration) approval.Request {
+		return approval.Request{
+			ID:          id,
+			Service:     "ec2",
+			Action:      action,
+			ResourceID:  resourceID,
+			Requester:   requester,
+			Account:     requester + "-laptop",
+			RequestedAt: now.Add(-age),
+			Status:      approval.StatusPending,
+		}
+	}
+	approved := request("0c0ffee0", "delete", "orders-db", "alice", 3*time.Hour)
+	approved.Service = "rds"
+	approved.Status = approval.StatusApproved
+	approved.Approver = "carol"
+	approved.DecidedAt = &decided
+
+	data, err := json.Marshal([]approval.Request{
+		request("a1b2c3d4", "terminate", "i-1", "alice", time.Hour),
+		request("e5f6a7b8", "stop", "i-2", "bob", 2*time.Hour),
+		approved,
+		request("deadbeef", "terminate", "i-3", "alice", 30*time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "approvals.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return approval.NewStore(path)
+}
+
+// TestServiceConformance runs the core service contract against approval
+// requests, deciding them as bob.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewService(newStore(t), "bob", d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			// The store's directory is a file, so it cannot be read
+			file := filepath.Join(t.TempDir(), "state")
+			if err := os.WriteFile(file, nil, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			return NewService(approval.NewStore(filepath.Join(file, "approvals.json")), "bob", d)
+		},
+		ExistingID:   "a1b2c3d4",
+		Action:       "reject",
+		ActionParams: map[string]any{"reason": "i-1 still serves traffic"},
+	})
+}
+
+func TestListFiltersByStatus(t *testing.T) {
+	svc := NewService(newStore(t), "bob", nil)
+
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{"", []string{"a1b2c3d4", "e5f6a7b8"}},
+		{"Approved", []string{"0c0ffee0"}},
+		{"expired", []string{"deadbeef"}},
+		{"all", []string{"a1b2c3d4", "e5f6a7b8", "0c0ffee0", "deadbeef"}},
+	}
+	for _, tt := range tests {
+		resources, err := svc.List(context.Background(), core.ListOptions{Filters: map[string]string{"status": tt.status}})
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", tt.status, err)
+		}
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID)
+		}
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.status, ids, tt.want)
+		}
+	}
+
+	resources, _ := svc.List(context.Background(), core.ListOptions{})
+	for _, r := range resources {
+		own := r.ID == "e5f6a7b8"
+		if r.Metadata["own"] != own || len(r.Issues()) != 1 {
+			t.Errorf("%s own = %v with issues %v, want own %v awaiting approval", r.ID, r.Metadata["own"], r.Issues(), own)
+		}
+	}
+}
+
+func TestDecide(t *testing.T) {
+	svc := NewService(newStore(t), "bob", nil)
+
+	result, err := svc.Execute(context.Background(), "reject", "a1b2c3d4", map[string]any{"reason": "i-1 still serves traffic"})
+	if err != nil {
+		t.Fatalf("reject error = %v", err)
+	}
+	if result.Message != "Request a1b2c3d4 rejected (ec2:terminate on i-1 by alice)" {
+		t.Errorf("reject = %q", result.Message)
+	}
+	resources, _ := svc.List(context.Background(), core.ListOptions{Filters: map[string]string{"status": "rejected"}})
+	if len(resources) != 1 || resources[0].Metadata["approver"] != "bob" || resources[0].Metadata["reason"] != "i-1 still serves traffic" {
+		t.Errorf("rejected requests = %+v", resources)
+	}
+
+	for id, want := range map[string]error{
+		"a1b2c3d4": approval.ErrNotPending,
+		"e5f6a7b8": approval.ErrSelfApproval,
+		"ffffffff": approval.ErrRequestNotFound,
+	} {
+		if _, err := svc.Execute(context.Background(), "approve", id, nil); !errors.Is(err, want) {
+			t.Errorf("approve %s error = %v, want %v", id, err, want)
+		}
+	}
+}
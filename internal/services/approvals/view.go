@@ -0,0 +1,309 @@
+package approvals
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/bridge"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const rejectFormID = "approvals:reject"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for approval requests.
+type View struct {
+	*base.TableView
+
+	rejectTarget string
+}
+
+// NewView creates a new approvals view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Action"), MinWidth: 12, MaxWidth: 30, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Resource"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Requester"), MinWidth: 8, MaxWidth: 20, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Requested"), MinWidth: 16, MaxWidth: 16, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Status"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Approvals", "6", "approvals", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadRequests()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Approving %s...", row.ID)
+				return v, v.executeAction("approve", row.ID, nil)
+			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openRejectForm(row.ID)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Request %s", row.ID), formatRequest(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID == rejectFormID {
+			if msg.Canceled || v.rejectTarget == "" {
+				v.Message = i18n.T("Canceled")
+			} else {
+				v.Message = i18n.T("Rejecting %s...", v.rejectTarget)
+				cmds = append(cmds, v.executeAction("reject", v.rejectTarget, msg.Values))
+			}
+		}
+
+	case requestsLoadedMsg:
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d pending requests", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Action != "approve" && msg.Action != "reject" {
+			break
+		}
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.loadRequests())
+		}
+
+	case bridge.EventMsg:
+		// Requests raised or settled from other views show up right away
+		if msg.Is(core.EventApprovalRequested, core.EventApprovalGranted, core.EventApprovalRejected, core.EventApprovalUsed) && !v.IsLoading() {
+			cmds = append(cmds, v.loadRequests())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading approval requests...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[a]pprove  [x]reject  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the requests.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadRequests()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type requestsLoadedMsg struct {
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadRequests() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return requestsLoadedMsg{err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return requestsLoadedMsg{err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return requestsLoadedMsg{resources: resources, err: err}
+	}
+}
+
+func (v *View) openRejectForm(id string) tea.Cmd {
+	action, ok := base.FindAction(v.Service(), "reject")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "reject")
+		return nil
+	}
+	v.rejectTarget = id
+	return v.OpenForm(components.NewForm(rejectFormID, i18n.T("Reject request %s", id), action.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	rows := make([]table.Row, len(v.Resources))
+	for i, r := range v.Resources {
+		requester := r.GetMetadataString("requester")
+		if own, _ := r.Metadata["own"].(bool); own {
+			requester += " " + i18n.T("(you)")
+		}
+		rows[i] = table.Row{
+			r.ID,
+			r.Name,
+			base.TruncateString(r.GetMetadataString("resource_id"), 50),
+			requester,
+			r.GetMetadataString("requested"),
+			r.GetMetadataString("status"),
+		}
+	}
+	v.SetRows(rows)
+}
+
+// formatRequest renders a request for the detail panel.
+func formatRequest(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Action:     %s\n", r.Name)
+	fmt.Fprintf(&b, "Resource:   %s\n", r.GetMetadataString("resource_id"))
+	fmt.Fprintf(&b, "Requester:  %s\n", r.GetMetadataString("requester"))
+	fmt.Fprintf(&b, "Requested:  %s\n", r.GetMetadataString("requested"))
+	fmt.Fprintf(&b, "Status:     %s\n", r.GetMetadataString("status"))
+	if approver := r.GetMetadataString("approver"); approver != "" {
+		fmt.Fprintf(&b, "Decided by: %s (%s)\n", approver, r.GetMetadataString("decided"))
+	}
+	if reason := r.GetMetadataString("reason"); reason != "" {
+		fmt.Fprintf(&b, "Reason:     %s\n", reason)
+	}
+
+	if params, _ := r.Metadata["params"].(map[string]any); len(params) > 0 {
+		b.WriteString(i18n.T("\nParameters:\n"))
+		keys := make([]string, 0, len(params))
+		for k := range params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "  %s = %v\n", k, params[k])
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	own := 0
+	for _, r := range v.Resources {
+		if mine, _ := r.Metadata["own"].(bool); mine {
+			own++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "pending", Text: i18n.T("Pending: %d", len(v.Resources)), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "yours", Text: i18n.T("Yours: %d", own), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Approval Requests"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "approvals" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
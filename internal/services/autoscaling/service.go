@@ -0,0 +1,658 @@
+// Package autoscaling provides EC2 Auto Scaling integration for the a9s
+// application. It lists Auto Scaling groups with their capacity, instances
+// and health, sets their desired capacity, starts instance refreshes and
+// suspends or resumes their scaling processes.
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// Processes are the scaling processes of a group that can be suspended.
+var Processes = []string{
+	"Launch", "Terminate", "AddToLoadBalancer", "AlarmNotification", "AZRebalance",
+	"HealthCheck", "InstanceRefresh", "ReplaceUnhealthy", "ScheduledActions",
+}
+
+// defaultMinHealthy is the share of the group kept in service during an
+// instance refresh, in percent, unless the caller asks otherwise.
+const defaultMinHealthy = 90
+
+// Instance is an instance of a group.
+type Instance struct {
+	ID             string
+	Type           string
+	AZ             string
+	LifecycleState string
+	Health         string
+	Protected      bool // Protected from scale in
+}
+
+// InService reports whether the instance serves traffic.
+func (i Instance) InService() bool {
+	return i.LifecycleState == string(types.LifecycleStateInService)
+}
+
+// Healthy reports whether Auto Scaling considers the instance healthy.
+func (i Instance) Healthy() bool {
+	return i.Health == "Healthy"
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements Auto Scaling operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient AutoScalingAPI
+}
+
+// AutoScalingAPI defines the Auto Scaling client interface.
+type AutoScalingAPI interface {
+	DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	SetDesiredCapacity(ctx context.Context, params *autoscaling.SetDesiredCapacityInput, optFns ...func(*autoscaling.Options)) (*autoscaling.SetDesiredCapacityOutput, error)
+	StartInstanceRefresh(ctx context.Context, params *autoscaling.StartInstanceRefreshInput, optFns ...func(*autoscaling.Options)) (*autoscaling.StartInstanceRefreshOutput, error)
+	SuspendProcesses(ctx context.Context, params *autoscaling.SuspendProcessesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.SuspendProcessesOutput, error)
+	ResumeProcesses(ctx context.Context, params *autoscaling.ResumeProcessesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.ResumeProcessesOutput, error)
+}
+
+// NewService creates a new Auto Scaling service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client AutoScalingAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the Auto Scaling client.
+func (s *Service) client() AutoScalingAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return autoscaling.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "autoscaling"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Auto Scaling Groups"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "layers"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{MaxRecords: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("autoscaling", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the Auto Scaling groups of the region with their instances,
+// tags and suspended processes, which Auto Scaling returns in one call.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := []core.Resource{}
+
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(s.client(), &autoscaling.DescribeAutoScalingGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("autoscaling", "list", err)
+		}
+		for _, group := range page.AutoScalingGroups {
+			resources = append(resources, groupToResource(group))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "autoscaling:group",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get retrieves a group by name.
+func (s *Service) Get(ctx context.Context, name string) (*core.Resource, error) {
+	group, err := s.describe(ctx, name)
+	if err != nil {
+		return nil, core.NewServiceError("autoscaling", "get", err)
+	}
+	resource := groupToResource(*group)
+	return &resource, nil
+}
+
+// describe reads a group with its instances.
+func (s *Service) describe(ctx context.Context, name string) (*types.AutoScalingGroup, error) {
+	out, err := s.client().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.AutoScalingGroups) == 0 {
+		return nil, core.ErrResourceNotFound
+	}
+	return &out.AutoScalingGroups[0], nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for groups.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "set_desired",
+			Description: "Change the desired capacity of the group",
+			Icon:        "scale",
+			Shortcut:    "S",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "desired", Type: "int", Required: true, Description: "Desired instance count, between the group's minimum and maximum"},
+				{Name: "honor_cooldown", Type: "bool", Default: false, Description: "Wait for the group's cooldown to end before scaling"},
+			},
+		},
+		{
+			Name:        "start_refresh",
+			Description: "Replace the group's instances with its current launch template",
+			Icon:        "redo",
+			Shortcut:    "f",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "min_healthy", Type: "int", Default: defaultMinHealthy, Description: "Percentage of the group kept in service during the refresh"},
+				{Name: "skip_matching", Type: "bool", Default: true, Description: "Keep instances already on the desired configuration"},
+			},
+		},
+		{
+			Name:        "suspend_processes",
+			Description: "Suspend scaling processes of the group",
+			Icon:        "pause",
+			Shortcut:    "p",
+			Dangerous:   true,
+			Category:    "configuration",
+			Parameters: []core.ActionParameter{
+				{Name: "processes", Type: "string", Description: "Processes to suspend, such as Launch,Terminate; empty suspends them all"},
+			},
+		},
+		{
+			Name:        "resume_processes",
+			Description: "Resume suspended scaling processes of the group",
+			Icon:        "play",
+			Shortcut:    "P",
+			Dangerous:   false,
+			Category:    "configuration",
+			Parameters: []core.ActionParameter{
+				{Name: "processes", Type: "string", Description: "Processes to resume; empty resumes every suspended one"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a group, identified by its name.
+// Scaling, refreshing and suspending ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "set_desired":
+		desired, perr := intParam(params["desired"])
+		if perr != nil || desired < 0 {
+			return nil, core.NewValidationError("desired", params["desired"], "must be an instance count of 0 or more")
+		}
+		honor, _ := params["honor_cooldown"].(bool)
+		result, err = s.setDesired(ctx, resourceID, desired, honor, params, confirmed)
+	case "start_refresh":
+		minHealthy := defaultMinHealthy
+		if v, ok := params["min_healthy"]; ok && v != nil && v != "" {
+			n, perr := intParam(v)
+			if perr != nil || n < 0 || n > 100 {
+				return nil, core.NewValidationError("min_healthy", v, "must be a percentage between 0 and 100")
+			}
+			minHealthy = n
+		}
+		skipMatching := true
+		if v, ok := params["skip_matching"].(bool); ok {
+			skipMatching = v
+		}
+		result, err = s.startRefresh(ctx, resourceID, minHealthy, skipMatching, params, confirmed)
+	case "suspend_processes", "resume_processes":
+		list, _ := params["processes"].(string)
+		processes, perr := parseProcesses(list)
+		if perr != nil {
+			return nil, perr
+		}
+		if action == "suspend_processes" {
+			result, err = s.suspendProcesses(ctx, resourceID, processes, params, confirmed)
+		} else {
+			result, err = s.resumeProcesses(ctx, resourceID, processes)
+		}
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// setDesired changes the desired capacity of a group once confirmed, within
+// its minimum and maximum.
+func (s *Service) setDesired(ctx context.Context, name string, desired int, honorCooldown bool, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("set_desired", name, err)
+	}
+
+	group, err := s.describe(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	current := int(aws.ToInt32(group.DesiredCapacity))
+	minSize, maxSize := int(aws.ToInt32(group.MinSize)), int(aws.ToInt32(group.MaxSize))
+	if desired < minSize || desired > maxSize {
+		return fail(core.NewValidationError("desired", desired, fmt.Sprintf("must be between the group's minimum %d and maximum %d", minSize, maxSize)))
+	}
+	if current == desired {
+		return core.NewActionResult(true, fmt.Sprintf("%s already wants %d instances", name, desired)), nil
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Changes the desired capacity of %s from %d to %d", name, current, desired)
+		switch {
+		case desired == 0:
+			reason += ", terminating all its instances"
+		case desired < current:
+			reason += fmt.Sprintf(", terminating %d instance(s)", current-desired)
+		}
+		if protected := countProtected(group.Instances); protected > 0 && desired < current {
+			reason += fmt.Sprintf("; %d instance(s) are protected from scale in", protected)
+		}
+		return nil, core.NewConfirmationError(s, "set_desired", name, params, reason, false)
+	}
+
+	if _, err := s.client().SetDesiredCapacity(ctx, &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: aws.String(name),
+		DesiredCapacity:      aws.Int32(int32(desired)),
+		HonorCooldown:        aws.Bool(honorCooldown),
+	}); err != nil {
+		var activity *types.ScalingActivityInProgressFault
+		if errors.As(err, &activity) {
+			return fail(fmt.Errorf("%s is still scaling or in its cooldown: %w", name, err))
+		}
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Scaling %s from %d to %d instances", name, current, desired)), nil
+}
+
+// startRefresh starts a rolling instance refresh of a group once
+// confirmed, keeping minHealthy percent of it in service.
+func (s *Service) startRefresh(ctx context.Context, name string, minHealthy int, skipMatching bool, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("start_refresh", name, err)
+	}
+
+	group, err := s.describe(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	if len(group.Instances) == 0 {
+		return fail(core.NewValidationError("group", name, "has no instances to refresh"))
+	}
+	if suspended := suspendedProcesses(group); slices.Contains(suspended, "Launch") || slices.Contains(suspended, "Terminate") {
+		return fail(core.NewValidationError("group", name, "has Launch or Terminate suspended, which a refresh needs"))
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Replaces the %d instance(s) of %s with its current launch template, keeping %d%% in service", len(group.Instances), name, minHealthy)
+		if skipMatching {
+			reason += "; instances already up to date are kept"
+		}
+		return nil, core.NewConfirmationError(s, "start_refresh", name, params, reason, false)
+	}
+
+	out, err := s.client().StartInstanceRefresh(ctx, &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             types.RefreshStrategyRolling,
+		Preferences: &types.RefreshPreferences{
+			MinHealthyPercentage: aws.Int32(int32(minHealthy)),
+			SkipMatching:         aws.Bool(skipMatching),
+		},
+	})
+	if err != nil {
+		var inProgress *types.InstanceRefreshInProgressFault
+		if errors.As(err, &inProgress) {
+			return fail(fmt.Errorf("%s already has an instance refresh in progress: %w", name, err))
+		}
+		return fail(err)
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("Started instance refresh %s of %s", aws.ToString(out.InstanceRefreshId), name))
+	result.Data = aws.ToString(out.InstanceRefreshId)
+	return result, nil
+}
+
+// suspendProcesses suspends processes of a group once confirmed, all of
+// them when none is named.
+func (s *Service) suspendProcesses(ctx context.Context, name string, processes []string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("suspend_processes", name, err)
+	}
+
+	group, err := s.describe(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	what := strings.Join(processes, ", ")
+	if len(processes) == 0 {
+		what = "all processes"
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Suspends %s of %s until resumed", what, name)
+		if len(processes) == 0 || slices.Contains(processes, "ReplaceUnhealthy") || slices.Contains(processes, "HealthCheck") {
+			reason += "; unhealthy instances are no longer replaced"
+		}
+		if len(processes) == 0 || slices.Contains(processes, "Launch") {
+			reason += "; the group no longer scales out"
+		}
+		if already := suspendedProcesses(group); len(already) > 0 {
+			reason += fmt.Sprintf(" (already suspended: %s)", strings.Join(already, ", "))
+		}
+		return nil, core.NewConfirmationError(s, "suspend_processes", name, params, reason, false)
+	}
+
+	if _, err := s.client().SuspendProcesses(ctx, &autoscaling.SuspendProcessesInput{
+		AutoScalingGroupName: aws.String(name),
+		ScalingProcesses:     processes,
+	}); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Suspended %s of %s", what, name)), nil
+}
+
+// resumeProcesses resumes suspended processes of a group, all of them when
+// none is named.
+func (s *Service) resumeProcesses(ctx context.Context, name string, processes []string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("resume_processes", name, err)
+	}
+
+	group, err := s.describe(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	suspended := suspendedProcesses(group)
+	if len(suspended) == 0 {
+		return core.NewActionResult(true, fmt.Sprintf("%s has no suspended processes", name)), nil
+	}
+	what := strings.Join(processes, ", ")
+	if len(processes) == 0 {
+		what = strings.Join(suspended, ", ")
+	}
+
+	if _, err := s.client().ResumeProcesses(ctx, &autoscaling.ResumeProcessesInput{
+		AutoScalingGroupName: aws.String(name),
+		ScalingProcesses:     processes,
+	}); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Resumed %s of %s", what, name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// groupToResource converts a group, flagging unhealthy instances, missing
+// capacity and suspended processes.
+func groupToResource(group types.AutoScalingGroup) core.Resource {
+	name := aws.ToString(group.AutoScalingGroupName)
+	desired := int(aws.ToInt32(group.DesiredCapacity))
+
+	instances := make([]Instance, 0, len(group.Instances))
+	inService, unhealthy := 0, 0
+	for _, i := range group.Instances {
+		instance := Instance{
+			ID:             aws.ToString(i.InstanceId),
+			Type:           aws.ToString(i.InstanceType),
+			AZ:             aws.ToString(i.AvailabilityZone),
+			LifecycleState: string(i.LifecycleState),
+			Health:         aws.ToString(i.HealthStatus),
+			Protected:      aws.ToBool(i.ProtectedFromScaleIn),
+		}
+		if instance.InService() {
+			inService++
+		}
+		if !instance.Healthy() {
+			unhealthy++
+		}
+		instances = append(instances, instance)
+	}
+	suspended := suspendedProcesses(&group)
+
+	tags := make(map[string]string, len(group.Tags))
+	for _, tag := range group.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	template := aws.ToString(group.LaunchConfigurationName)
+	if lt := group.LaunchTemplate; lt != nil {
+		template = aws.ToString(lt.LaunchTemplateName) + ":" + aws.ToString(lt.Version)
+	} else if group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+		if lt := group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification; lt != nil {
+			template = aws.ToString(lt.LaunchTemplateName) + ":" + aws.ToString(lt.Version) + " (mixed)"
+		}
+	}
+
+	state := aws.ToString(group.Status)
+	if state == "" {
+		state = "active"
+	}
+
+	resource := core.Resource{
+		ID:        name,
+		Type:      "autoscaling:group",
+		Name:      name,
+		ARN:       aws.ToString(group.AutoScalingGroupARN),
+		State:     state,
+		CreatedAt: group.CreatedTime,
+		Tags:      tags,
+		Metadata: map[string]any{
+			"desired":            desired,
+			"min":                int(aws.ToInt32(group.MinSize)),
+			"max":                int(aws.ToInt32(group.MaxSize)),
+			"instances":          instances,
+			"instance_count":     len(instances),
+			"in_service":         inService,
+			"unhealthy":          unhealthy,
+			"suspended":          suspended,
+			"health_check_type":  aws.ToString(group.HealthCheckType),
+			"launch_template":    template,
+			"availability_zones": group.AvailabilityZones,
+			"target_groups":      len(group.TargetGroupARNs) + len(group.LoadBalancerNames),
+		},
+	}
+	iac.Apply(&resource)
+
+	if unhealthy > 0 {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("%d unhealthy instance(s)", unhealthy))
+	}
+	if inService < desired {
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("%d of %d desired instances in service", inService, desired))
+	}
+	if len(suspended) > 0 {
+		resource.AddIssue(core.SeverityLow, "Suspended processes: "+strings.Join(suspended, ", "))
+	}
+	return resource
+}
+
+// suspendedProcesses returns the names of the processes suspended on a
+// group, sorted.
+func suspendedProcesses(group *types.AutoScalingGroup) []string {
+	names := make([]string, 0, len(group.SuspendedProcesses))
+	for _, p := range group.SuspendedProcesses {
+		names = append(names, aws.ToString(p.ProcessName))
+	}
+	slices.Sort(names)
+	return names
+}
+
+// countProtected counts the instances protected from scale in.
+func countProtected(instances []types.Instance) int {
+	n := 0
+	for _, i := range instances {
+		if aws.ToBool(i.ProtectedFromScaleIn) {
+			n++
+		}
+	}
+	return n
+}
+
+// parseProcesses splits a comma-separated list of processes, accepting any
+// case and returning their canonical names.
+func parseProcesses(list string) ([]string, error) {
+	var processes []string
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		i := slices.IndexFunc(Processes, func(p string) bool { return strings.EqualFold(p, part) })
+		if i < 0 {
+			return nil, core.NewValidationError("processes", part, "is not a scaling process: "+strings.Join(Processes, ", "))
+		}
+		if !slices.Contains(processes, Processes[i]) {
+			processes = append(processes, Processes[i])
+		}
+	}
+	return processes, nil
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "autoscaling", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "autoscaling", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ResourceGetter = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,232 @@
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeAutoScaling serves a healthy web group of two instances and a
+// workers group missing an instance, with one unhealthy and AZRebalance
+// suspended, or fails every call when err is set. It records the calls
+// changing a group.
+type fakeAutoScaling struct {
+	err       error
+	desired   map[string]int32
+	refreshed []string
+	suspended []string
+	resumed   []string
+}
+
+func (f *fakeAutoScaling) groups() []types.AutoScalingGroup {
+	instance := func(id, health string) types.Instance {
+		return types.Instance{
+			InstanceId:       aws.String(id),
+			InstanceType:     aws.String("t3.small"),
+			AvailabilityZone: aws.String("us-east-1a"),
+			LifecycleState:   types.LifecycleStateInService,
+			HealthStatus:     aws.String(health),
+		}
+	}
+	return []types.AutoScalingGroup{
+		{
+			AutoScalingGroupName: aws.String("web"),
+			AutoScalingGroupARN:  aws.String("arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:1:autoScalingGroupName/web"),
+			MinSize:              aws.Int32(1),
+			MaxSize:              aws.Int32(4),
+			DesiredCapacity:      aws.Int32(2),
+			HealthCheckType:      aws.String("ELB"),
+			LaunchTemplate:       &types.LaunchTemplateSpecification{LaunchTemplateName: aws.String("web"), Version: aws.String("$Latest")},
+			Instances:            []types.Instance{instance("i-1", "Healthy"), instance("i-2", "Healthy")},
+			Tags:                 []types.TagDescription{{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("web")}},
+		},
+		{
+			AutoScalingGroupName: aws.String("workers"),
+			MinSize:              aws.Int32(0),
+			MaxSize:              aws.Int32(10),
+			DesiredCapacity:      aws.Int32(3),
+			Instances:            []types.Instance{instance("i-3", "Healthy"), instance("i-4", "Unhealthy")},
+			SuspendedProcesses:   []types.SuspendedProcess{{ProcessName: aws.String("AZRebalance")}},
+		},
+	}
+}
+
+func (f *fakeAutoScaling) DescribeAutoScalingGroups(_ context.Context, in *autoscaling.DescribeAutoScalingGroupsInput, _ ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	groups := f.groups()
+	if len(in.AutoScalingGroupNames) == 0 {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: groups}, nil
+	}
+	var found []types.AutoScalingGroup
+	for _, group := range groups {
+		if aws.ToString(group.AutoScalingGroupName) == in.AutoScalingGroupNames[0] {
+			found = append(found, group)
+		}
+	}
+	return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: found}, nil
+}
+
+func (f *fakeAutoScaling) SetDesiredCapacity(_ context.Context, in *autoscaling.SetDesiredCapacityInput, _ ...func(*autoscaling.Options)) (*autoscaling.SetDesiredCapacityOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.desired == nil {
+		f.desired = map[string]int32{}
+	}
+	f.desired[aws.ToString(in.AutoScalingGroupName)] = aws.ToInt32(in.DesiredCapacity)
+	return &autoscaling.SetDesiredCapacityOutput{}, nil
+}
+
+func (f *fakeAutoScaling) StartInstanceRefresh(_ context.Context, in *autoscaling.StartInstanceRefreshInput, _ ...func(*autoscaling.Options)) (*autoscaling.StartInstanceRefreshOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.refreshed = append(f.refreshed, aws.ToString(in.AutoScalingGroupName))
+	return &autoscaling.StartInstanceRefreshOutput{InstanceRefreshId: aws.String("refresh-1")}, nil
+}
+
+func (f *fakeAutoScaling) SuspendProcesses(_ context.Context, in *autoscaling.SuspendProcessesInput, _ ...func(*autoscaling.Options)) (*autoscaling.SuspendProcessesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.suspended = append(f.suspended, in.ScalingProcesses...)
+	return &autoscaling.SuspendProcessesOutput{}, nil
+}
+
+func (f *fakeAutoScaling) ResumeProcesses(_ context.Context, in *autoscaling.ResumeProcessesInput, _ ...func(*autoscaling.Options)) (*autoscaling.ResumeProcessesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.resumed = append(f.resumed, in.ScalingProcesses...)
+	return &autoscaling.ResumeProcessesOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against groups.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeAutoScaling{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeAutoScaling{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    "web",
+		MissingID:     "missing",
+		Action:        "set_desired",
+		ActionParams:  map[string]any{"desired": 3, core.ParamConfirm: true},
+		ConfirmAction: "start_refresh",
+	})
+}
+
+func TestListFlagsUnhealthyGroups(t *testing.T) {
+	svc := NewServiceWithClient(&fakeAutoScaling{}, nil)
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() returned %d groups, want 2", len(resources))
+	}
+
+	web, workers := resources[0], resources[1]
+	if len(web.Issues()) != 0 || web.GetMetadataString("launch_template") != "web:$Latest" {
+		t.Errorf("web issues = %v, template %q", web.Issues(), web.GetMetadataString("launch_template"))
+	}
+	if managed, _ := web.Metadata["iac_managed"].(bool); !managed {
+		t.Errorf("web metadata = %v, want managed by CloudFormation", web.Metadata)
+	}
+
+	var messages []string
+	for _, issue := range workers.Issues() {
+		messages = append(messages, issue.Message)
+	}
+	want := "1 unhealthy instance(s);2 of 3 desired instances in service;Suspended processes: AZRebalance"
+	if strings.Join(messages, ";") != want {
+		t.Errorf("workers issues = %v", messages)
+	}
+	if workers.Severity() != core.SeverityMedium {
+		t.Errorf("workers severity = %v, want medium", workers.Severity())
+	}
+}
+
+func TestSetDesired(t *testing.T) {
+	client := &fakeAutoScaling{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "set_desired", "web", map[string]any{"desired": 5, core.ParamConfirm: true})
+	if err == nil || !strings.Contains(err.Error(), "maximum 4") {
+		t.Errorf("set_desired above the maximum error = %v", err)
+	}
+
+	_, err = svc.Execute(ctx, "set_desired", "web", map[string]any{"desired": 1})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "terminating 1 instance(s)") {
+		t.Fatalf("set_desired error = %v, want a confirmation naming the terminations", err)
+	}
+
+	if _, err := svc.Execute(ctx, "set_desired", "web", map[string]any{"desired": "1", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("confirmed set_desired error = %v", err)
+	}
+	if client.desired["web"] != 1 {
+		t.Errorf("desired = %v, want web at 1", client.desired)
+	}
+}
+
+func TestInstanceRefresh(t *testing.T) {
+	client := &fakeAutoScaling{}
+	svc := NewServiceWithClient(client, nil)
+
+	_, err := svc.Execute(context.Background(), "start_refresh", "web", map[string]any{"min_healthy": 150, core.ParamConfirm: true})
+	if err == nil {
+		t.Error("start_refresh accepted a minimum healthy percentage of 150")
+	}
+	result, err := svc.Execute(context.Background(), "start_refresh", "web", map[string]any{core.ParamConfirm: true})
+	if err != nil {
+		t.Fatalf("start_refresh error = %v", err)
+	}
+	if len(client.refreshed) != 1 || !strings.Contains(result.Message, "refresh-1") {
+		t.Errorf("refreshed %v, message %q", client.refreshed, result.Message)
+	}
+}
+
+func TestSuspendAndResumeProcesses(t *testing.T) {
+	client := &fakeAutoScaling{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	if _, err := svc.Execute(ctx, "suspend_processes", "web", map[string]any{"processes": "launch,Sleep"}); err == nil {
+		t.Error("suspend_processes accepted an unknown process")
+	}
+	_, err := svc.Execute(ctx, "suspend_processes", "web", map[string]any{"processes": "launch, terminate"})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) {
+		t.Fatalf("suspend_processes error = %v, want a confirmation", err)
+	}
+	if _, err := svc.Execute(ctx, "suspend_processes", "web", map[string]any{"processes": "launch, terminate", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("confirmed suspend_processes error = %v", err)
+	}
+	if strings.Join(client.suspended, ",") != "Launch,Terminate" {
+		t.Errorf("suspended = %v, want canonical names", client.suspended)
+	}
+
+	result, err := svc.Execute(ctx, "resume_processes", "web", nil)
+	if err != nil || len(client.resumed) != 0 || !strings.Contains(result.Message, "no suspended processes") {
+		t.Errorf("resume on web = %v, %v", result, err)
+	}
+	result, err = svc.Execute(ctx, "resume_processes", "workers", nil)
+	if err != nil || !strings.Contains(result.Message, "AZRebalance") {
+		t.Errorf("resume on workers = %v, %v", result, err)
+	}
+}
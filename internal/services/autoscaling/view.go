@@ -0,0 +1,340 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// actionFormPrefix prefixes the IDs of the forms opened for an action,
+// followed by the action name.
+const actionFormPrefix = "autoscaling:"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Auto Scaling groups.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget *core.Resource // Group the open action form is for
+}
+
+// NewView creates a new Auto Scaling view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Desired"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Min/Max"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("In Service"), MinWidth: 10, MaxWidth: 11, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Unhealthy"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Suspended"), MinWidth: 10, MaxWidth: 30, Weight: 0.6, Priority: 2},
+		{Title: i18n.T("Launch Template"), MinWidth: 12, MaxWidth: 40, Weight: 1.0, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("Auto Scaling", "", "autoscaling", i18n.T("groups"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		row := v.GetSelectedResource()
+		switch msg.String() {
+		case "S":
+			if row != nil {
+				return v, v.openActionForm(row, "set_desired", i18n.T("Scale %s", row.Name))
+			}
+		case "f":
+			if row != nil {
+				return v, v.openActionForm(row, "start_refresh", i18n.T("Refresh the instances of %s", row.Name))
+			}
+		case "p":
+			if row != nil {
+				return v, v.openActionForm(row, "suspend_processes", i18n.T("Suspend processes of %s", row.Name))
+			}
+		case "P":
+			if row != nil {
+				return v, v.openActionForm(row, "resume_processes", i18n.T("Resume processes of %s", row.Name))
+			}
+		case "enter":
+			if row != nil {
+				v.OpenDetail(i18n.T("Group %s", row.Name), formatGroup(row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		action, ok := strings.CutPrefix(msg.ID, actionFormPrefix)
+		if !ok {
+			break
+		}
+		target := v.formTarget
+		v.formTarget = nil
+		if msg.Canceled || target == nil {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		switch action {
+		case "set_desired":
+			v.Message = i18n.T("Scaling %s...", target.Name)
+		case "start_refresh":
+			v.Message = i18n.T("Starting an instance refresh of %s...", target.Name)
+		case "suspend_processes":
+			v.Message = i18n.T("Suspending processes of %s...", target.Name)
+		default:
+			v.Message = i18n.T("Resuming processes of %s...", target.Name)
+		}
+		cmds = append(cmds, v.executeAction(action, target.ID, msg.Values))
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.Load())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading Auto Scaling groups...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [S]cale  re[f]resh instances  [p]ause/[P]resume processes  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the groups.
+func (v *View) Refresh() tea.Cmd {
+	return v.Load()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// openActionForm asks for the parameters of an action on a group. The
+// desired capacity defaults to the current one, and the processes to
+// resume to those suspended.
+func (v *View) openActionForm(r *core.Resource, action, title string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	params := append([]core.ActionParameter(nil), def.Parameters...)
+	for i := range params {
+		switch {
+		case params[i].Name == "desired":
+			params[i].Default = r.Metadata["desired"]
+		case params[i].Name == "processes" && action == "resume_processes":
+			suspended, _ := r.Metadata["suspended"].([]string)
+			params[i].Default = strings.Join(suspended, ",")
+		}
+	}
+	target := *r
+	v.formTarget = &target
+	return v.OpenForm(components.NewForm(actionFormPrefix+action, title, params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	desired, _ := r.Metadata["desired"].(int)
+	minSize, _ := r.Metadata["min"].(int)
+	maxSize, _ := r.Metadata["max"].(int)
+	inService, _ := r.Metadata["in_service"].(int)
+	count, _ := r.Metadata["instance_count"].(int)
+	unhealthy, _ := r.Metadata["unhealthy"].(int)
+	suspended, _ := r.Metadata["suspended"].([]string)
+	processes := strings.Join(suspended, ",")
+	if processes == "" {
+		processes = "-"
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.LazyCell(desired, func() string { return fmt.Sprintf("%d", desired) }),
+		base.TextCell(fmt.Sprintf("%d/%d", minSize, maxSize)),
+		base.LazyCell(inService, func() string { return fmt.Sprintf("%d/%d", inService, count) }),
+		base.LazyCell(unhealthy, func() string { return fmt.Sprintf("%d", unhealthy) }),
+		base.TextCell(processes),
+		base.TextCell(r.GetMetadataString("launch_template")),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+		base.AgeCell(r),
+	}
+}
+
+// formatGroup renders a group with its capacity and instances for the
+// detail panel.
+func formatGroup(r *core.Resource) string {
+	desired, _ := r.Metadata["desired"].(int)
+	minSize, _ := r.Metadata["min"].(int)
+	maxSize, _ := r.Metadata["max"].(int)
+	zones, _ := r.Metadata["availability_zones"].([]string)
+	targets, _ := r.Metadata["target_groups"].(int)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name:          %s\n", r.Name)
+	fmt.Fprintf(&b, "Capacity:      %d desired (min %d, max %d)\n", desired, minSize, maxSize)
+	fmt.Fprintf(&b, "Template:      %s\n", r.GetMetadataString("launch_template"))
+	fmt.Fprintf(&b, "Health check:  %s\n", r.GetMetadataString("health_check_type"))
+	fmt.Fprintf(&b, "Zones:         %s\n", strings.Join(zones, ", "))
+	fmt.Fprintf(&b, "Targets:       %d target group(s) or load balancer(s)\n", targets)
+	if suspended, _ := r.Metadata["suspended"].([]string); len(suspended) > 0 {
+		fmt.Fprintf(&b, "Suspended:     %s\n", strings.Join(suspended, ", "))
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:       %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if instances, ok := r.Metadata["instances"].([]Instance); ok {
+		b.WriteString(i18n.T("\nInstances:\n"))
+		if len(instances) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, i := range instances {
+			protected := ""
+			if i.Protected {
+				protected = "  protected"
+			}
+			fmt.Fprintf(&b, "  %-20s %-12s %-12s %-12s %s%s\n", i.ID, i.Type, i.AZ, i.LifecycleState, i.Health, protected)
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	instances, unhealthy, suspended := 0, 0, 0
+	for _, r := range v.Resources {
+		n, _ := r.Metadata["instance_count"].(int)
+		u, _ := r.Metadata["unhealthy"].(int)
+		instances, unhealthy = instances+n, unhealthy+u
+		if s, _ := r.Metadata["suspended"].([]string); len(s) > 0 {
+			suspended++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Groups: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "instances", Text: i18n.T("Instances: %d", instances), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "unhealthy", Text: i18n.T("Unhealthy: %d", unhealthy), Tone: core.ToneError},
+		core.SummaryWidget{Name: "suspended", Text: i18n.T("Suspended: %d", suspended), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Auto Scaling Groups"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "autoscaling" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
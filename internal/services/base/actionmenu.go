@@ -0,0 +1,177 @@
+package base
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Quick-Action Menu
+// =============================================================================
+
+// actionMenuKey opens the menu of actions on the selected resource. It is
+// not a per-view key so every view can offer it.
+const actionMenuKey = "."
+
+// actionMenuAltKey also opens the menu, in the views whose service has no
+// action on it.
+const actionMenuAltKey = "x"
+
+// actionParamsFormID identifies the form asking for the parameters of an
+// action chosen from the menu.
+const actionParamsFormID = "actions:params"
+
+// actionMenu lists the actions a view's service offers, see openActionMenu.
+type actionMenu struct {
+	selector *components.Selector
+	actions  []core.Action
+	// Resource the menu was opened on
+	target *core.Resource
+}
+
+// menuAction is an action chosen from the menu, waiting for its parameters.
+type menuAction struct {
+	action     core.Action
+	resourceID string
+}
+
+// opensActionMenu reports whether key opens the action menu in this view.
+func (tv *TableView) opensActionMenu(key string) bool {
+	if key == actionMenuKey {
+		return true
+	}
+	if key != actionMenuAltKey {
+		return false
+	}
+	if executor, ok := tv.Service().(core.ActionExecutor); ok {
+		for _, action := range executor.Actions() {
+			if action.Shortcut == actionMenuAltKey {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// openActionMenu lists the actions of the view's service with their
+// shortcuts, so they can be found without knowing the view's keys.
+func (tv *TableView) openActionMenu(r *core.Resource) tea.Cmd {
+	executor, ok := tv.Service().(core.ActionExecutor)
+	if !ok || len(executor.Actions()) == 0 {
+		tv.Message = i18n.T("No actions in this view")
+		return nil
+	}
+
+	menu := &actionMenu{target: r}
+	items := make([]components.SelectorItem, 0, len(executor.Actions()))
+	for _, action := range executor.Actions() {
+		action = localizeAction(action)
+		menu.actions = append(menu.actions, action)
+		items = append(items, components.SelectorItem{
+			Value:       action.Name,
+			Label:       actionLabel(action),
+			Description: actionHint(action),
+		})
+	}
+
+	tv.CloseOverlay()
+	menu.selector = components.NewSelector(i18n.T("Actions on %s", r.Name), items, "")
+	menu.selector.SetDimensions(tv.Width(), tv.overlayHeight())
+	tv.menu = menu
+	return nil
+}
+
+// chooseAction closes the menu and runs the chosen action on the resource,
+// asking for its parameters first. It does not go through the view's keys,
+// so actions the view has no key for run too; confirmations come back as
+// ConfirmationErrors and open the confirmation form.
+func (tv *TableView) chooseAction(msg components.SelectorResultMsg) tea.Cmd {
+	menu := tv.menu
+	tv.menu = nil
+	if msg.Canceled {
+		return nil
+	}
+	for _, action := range menu.actions {
+		if action.Name != msg.Value {
+			continue
+		}
+		if inputs := actionInputs(action); len(inputs) > 0 {
+			tv.menuAction = &menuAction{action: action, resourceID: menu.target.ID}
+			return tv.OpenForm(components.NewForm(actionParamsFormID,
+				i18n.T("%s on %s", action.Description, menu.target.Name), inputs))
+		}
+		return tv.runMenuAction(action.Name, menu.target.ID, nil)
+	}
+	return nil
+}
+
+// actionInputs returns the parameters to ask for before running action.
+// Confirmations are left out: the service asks for them itself, typing
+// the resource back when it needs to.
+func actionInputs(action core.Action) []core.ActionParameter {
+	var inputs []core.ActionParameter
+	for _, p := range action.Parameters {
+		if p.Name != core.ParamConfirm && p.Name != core.ParamConfirmResource {
+			inputs = append(inputs, p)
+		}
+	}
+	return inputs
+}
+
+// runChosenAction runs the action chosen from the menu once its parameters
+// are filled in.
+func (tv *TableView) runChosenAction(msg components.FormResultMsg) tea.Cmd {
+	chosen := tv.menuAction
+	tv.menuAction = nil
+	if chosen == nil || msg.Canceled {
+		return nil
+	}
+	return tv.runMenuAction(chosen.action.Name, chosen.resourceID, msg.Values)
+}
+
+// runMenuAction runs action on resourceID through the view's service.
+func (tv *TableView) runMenuAction(action, resourceID string, params map[string]any) tea.Cmd {
+	executor, ok := tv.Service().(core.ActionExecutor)
+	if !ok {
+		tv.Message = i18n.T("Error: %v", fmt.Errorf("service does not support actions"))
+		return nil
+	}
+	tv.Message = i18n.T("Running %s on %s...", action, resourceID)
+	return tv.runAction(executor, action, resourceID, params)
+}
+
+// actionLabel renders an action as its shortcut and description, flagging
+// the ones that ask for confirmation.
+func actionLabel(action core.Action) string {
+	shortcut := action.Shortcut
+	if shortcut == "" {
+		shortcut = "-"
+	}
+	label := fmt.Sprintf("[%s] %s", shortcut, action.Description)
+	if action.Dangerous {
+		label += " ⚠"
+	}
+	return label
+}
+
+// actionHint describes what an action asks for before it runs.
+func actionHint(action core.Action) string {
+	var hints []string
+	if len(action.Parameters) > 0 {
+		names := make([]string, len(action.Parameters))
+		for i, p := range action.Parameters {
+			names[i] = p.Name
+		}
+		hints = append(hints, i18n.T("Asks for: %s", strings.Join(names, ", ")))
+	}
+	if action.Dangerous {
+		hints = append(hints, i18n.T("Asks for confirmation"))
+	}
+	return strings.Join(hints, " · ")
+}
@@ -0,0 +1,84 @@
+package base
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// minBackoff is the first pause after an API throttles enrichment.
+	minBackoff = time.Second
+	// maxBackoff caps the pause between two enrichments.
+	maxBackoff = 30 * time.Second
+)
+
+// =============================================================================
+// Throttling Backoff
+// =============================================================================
+
+// apiBackoff paces enrichment per AWS API, such as "CloudWatch", once it
+// throttles. Each throttle doubles the pause before the next enrichment
+// calling that API, up to maxBackoff, and each success halves it until it
+// is gone. It is shared by every view, since they share the account's
+// request quotas.
+type apiBackoff struct {
+	mu     sync.Mutex
+	delays map[string]time.Duration
+}
+
+// backoffs paces the enrichment of every view.
+var backoffs = &apiBackoff{delays: make(map[string]time.Duration)}
+
+// throttled records that api throttled a call and returns the pause before
+// calling it again.
+func (b *apiBackoff) throttled(api string) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delay := min(max(b.delays[api]*2, minBackoff), maxBackoff)
+	b.delays[api] = delay
+	return delay
+}
+
+// succeeded records that calls to apis went through, easing their pace.
+func (b *apiBackoff) succeeded(apis []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, api := range apis {
+		if delay := b.delays[api] / 2; delay >= minBackoff {
+			b.delays[api] = delay
+		} else {
+			delete(b.delays, api)
+		}
+	}
+}
+
+// pace returns the pause before an enrichment calling apis, the longest of
+// their current delays, and the API it comes from.
+func (b *apiBackoff) pace(apis []string) (string, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var slowest string
+	var delay time.Duration
+	for _, api := range apis {
+		if b.delays[api] > delay {
+			slowest, delay = api, b.delays[api]
+		}
+	}
+	return slowest, delay
+}
+
+// sleep pauses for d, returning false when ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
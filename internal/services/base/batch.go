@@ -0,0 +1,126 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Batch Actions
+// =============================================================================
+
+// BatchProgressMsg reports an attempt on an item of a batch action a table
+// view is running.
+type BatchProgressMsg struct {
+	owner    *TableView
+	Progress batch.Progress
+	updates  <-chan batch.Progress
+}
+
+// runAction returns a command running a confirmed action. Batch actions
+// report each item as it finishes while they run, and Esc stops them, see
+// batch.Run.
+func (tv *TableView) runAction(executor core.ActionExecutor, action, resourceID string, params map[string]any) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := make(chan batch.Progress, 64)
+	tv.stopBatch = cancel
+
+	run := func() tea.Msg {
+		defer cancel()
+		defer close(updates)
+		ctx := batch.WithProgress(ctx, func(p batch.Progress) {
+			// The result lists every item, so dropping an update is harmless
+			select {
+			case updates <- p:
+			default:
+			}
+		})
+		result, err := core.ExecuteAction(ctx, executor, action, resourceID, params)
+		return ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+	return tea.Batch(run, tv.listenBatch(updates))
+}
+
+// listenBatch waits for the next progress update of a running batch.
+func (tv *TableView) listenBatch(updates <-chan batch.Progress) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return BatchProgressMsg{owner: tv, Progress: p, updates: updates}
+	}
+}
+
+// showBatchProgress shows a progress update and waits for the next one.
+func (tv *TableView) showBatchProgress(msg BatchProgressMsg) tea.Cmd {
+	p := msg.Progress
+	tv.batchRunning = true
+	switch {
+	case p.Retry > 0:
+		tv.Message = i18n.T("%d/%d: %s %s failed (attempt %d), retrying in %s  [esc] stop",
+			p.Done, p.Total, p.Item.Kind, p.Item.ID, p.Item.Attempts, p.Retry)
+	case p.Item.Status == batch.StatusFailed:
+		tv.Message = i18n.T("%d/%d: %s %s failed  [esc] stop", p.Done, p.Total, p.Item.Kind, p.Item.ID)
+	default:
+		tv.Message = i18n.T("%d/%d: %s %s done  [esc] stop", p.Done, p.Total, p.Item.Kind, p.Item.ID)
+	}
+	return tv.listenBatch(msg.updates)
+}
+
+// stopRunningBatch stops the running batch after the item in progress. It
+// reports whether a batch was running.
+func (tv *TableView) stopRunningBatch() bool {
+	if !tv.batchRunning || tv.stopBatch == nil {
+		return false
+	}
+	tv.stopBatch()
+	tv.Message = i18n.T("Stopping the batch; [R] resumes it")
+	return true
+}
+
+// PendingBatch returns the interrupted batch of the view's service, if it
+// can resume one.
+func (tv *TableView) PendingBatch() *batch.Job {
+	if r, ok := tv.Service().(batch.Resumer); ok {
+		return r.PendingBatch()
+	}
+	return nil
+}
+
+// FormatBatchReport renders the outcome of each item of a batch.
+func FormatBatchReport(job *batch.Job) string {
+	var b strings.Builder
+	for _, item := range job.Items {
+		name := item.ID
+		if item.Name != "" && item.Name != item.ID {
+			name = item.Name
+		}
+		switch item.Status {
+		case batch.StatusDone:
+			fmt.Fprintf(&b, "✅ %s %s", item.Kind, name)
+		case batch.StatusFailed:
+			fmt.Fprintf(&b, "❌ %s %s: %s", item.Kind, name, item.Error)
+		default:
+			fmt.Fprintf(&b, "⏸ %s %s", item.Kind, name)
+		}
+		if item.Detail != "" {
+			fmt.Fprintf(&b, " (%s)", item.Detail)
+		}
+		if item.Attempts > 1 {
+			b.WriteString(i18n.T(", %d attempts", item.Attempts))
+		}
+		b.WriteString("\n")
+	}
+	if pending := job.Count(batch.StatusPending); pending > 0 {
+		b.WriteString(i18n.T("\n%d items left. Press [R] to resume.", pending))
+	}
+	return b.String()
+}
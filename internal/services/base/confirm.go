@@ -0,0 +1,121 @@
+package base
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Policy Confirmations
+// =============================================================================
+
+// confirmFormID identifies the form asking the operator to confirm an action
+// held back by the confirmation policy.
+const confirmFormID = "policy:confirm"
+
+// requestConfirmation asks the operator to confirm an action a guard held
+// back. Every table view gets this through HandleOverlay.
+func (tv *TableView) requestConfirmation(confirm *core.ConfirmationError) tea.Cmd {
+	tv.pendingConfirm = confirm
+	tv.Message = ""
+	return tv.OpenForm(confirmationForm(confirmFormID, confirm))
+}
+
+// resolveConfirmation runs the held-back action again once confirmed.
+func (tv *TableView) resolveConfirmation(msg components.FormResultMsg) tea.Cmd {
+	confirm := tv.pendingConfirm
+	tv.pendingConfirm = nil
+	if confirm == nil {
+		return nil
+	}
+
+	params, confirmed := confirmedParams(confirm, msg)
+	if !confirmed {
+		tv.Message = i18n.T("Canceled")
+		return nil
+	}
+
+	executor, ok := tv.Service().(core.ActionExecutor)
+	if !ok {
+		tv.Message = i18n.T("Error: %v", fmt.Errorf("service does not support actions"))
+		return nil
+	}
+
+	req := confirm.Request
+	tv.Message = i18n.T("Running %s on %s...", req.Action.Name, req.ResourceID)
+	return tv.runAction(executor, req.Action.Name, req.ResourceID, params)
+}
+
+// confirmationForm builds the form asking to confirm an action a guard held
+// back: a yes/no, the resource name typed back or a reason.
+func confirmationForm(id string, confirm *core.ConfirmationError) *components.Form {
+	req := confirm.Request
+
+	description := i18n.T("Press y, then Enter to run it")
+	if confirm.Reason != "" {
+		description = confirm.Reason + ". " + description
+	}
+	param := core.ActionParameter{
+		Name:        confirm.ConfirmParam(),
+		Type:        "bool",
+		Description: description,
+	}
+	switch {
+	case confirm.TypeResource:
+		param = core.ActionParameter{
+			Name:        core.ParamConfirmResource,
+			Type:        "string",
+			Required:    true,
+			Description: i18n.T("Type %s to confirm", req.ResourceID),
+			Validation:  "^" + regexp.QuoteMeta(req.ResourceID) + "$",
+		}
+	case confirm.Justify:
+		param = core.ActionParameter{
+			Name:        confirm.ConfirmParam(),
+			Type:        "string",
+			Required:    true,
+			Description: confirm.Reason + ". " + i18n.T("The reason is written to the audit log"),
+			Validation:  `\S`,
+		}
+	}
+
+	title := i18n.T("Confirm %s on %s", req.Action.Name, req.ResourceID)
+	if confirm.Environment != "" {
+		title = i18n.T("Confirm %s on %s in %s", req.Action.Name, req.ResourceID, confirm.Environment)
+	}
+	return components.NewForm(id, title, []core.ActionParameter{param})
+}
+
+// confirmedParams returns the parameters to run a held-back action again
+// with, or false when the confirmation form was canceled or not confirmed.
+func confirmedParams(confirm *core.ConfirmationError, msg components.FormResultMsg) (map[string]any, bool) {
+	confirmed, _ := msg.Values[confirm.ConfirmParam()].(bool)
+	switch {
+	case confirm.TypeResource:
+		confirmed = msg.Values[core.ParamConfirmResource] == confirm.Request.ResourceID
+	case confirm.Justify:
+		reason, _ := msg.Values[confirm.ConfirmParam()].(string)
+		confirmed = strings.TrimSpace(reason) != ""
+	}
+	if msg.Canceled || !confirmed {
+		return nil, false
+	}
+
+	params := maps.Clone(confirm.Request.Params)
+	if params == nil {
+		params = make(map[string]any, 2)
+	}
+	maps.Copy(params, msg.Values)
+	if !confirm.Justify {
+		params[confirm.ConfirmParam()] = true
+	}
+	return params, true
+}
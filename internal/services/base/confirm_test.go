@@ -0,0 +1,40 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// TestConfirmedParamsKeepQuestionsApart checks that typing the resource for
+// a policy only answers the policy, so the service still asks its own
+// confirmation.
+func TestConfirmedParamsKeepQuestionsApart(t *testing.T) {
+	req := core.ActionRequest{Service: "ec2", Action: core.Action{Name: "terminate", Dangerous: true}, ResourceID: "i-1"}
+	confirm := &core.ConfirmationError{Request: req, TypeResource: true, Environment: "prod", Param: core.ParamConfirmPolicy}
+
+	params, ok := confirmedParams(confirm, components.FormResultMsg{
+		ID:     confirmFormID,
+		Values: map[string]any{core.ParamConfirmResource: "i-1"},
+	})
+	if !ok {
+		t.Fatal("confirmedParams() = not confirmed, want confirmed")
+	}
+	if confirmed, _ := params[core.ParamConfirmPolicy].(bool); !confirmed {
+		t.Errorf("params = %v, want %s set", params, core.ParamConfirmPolicy)
+	}
+	if _, set := params[core.ParamConfirm]; set {
+		t.Errorf("params = %v, want %s left to the service's confirmation", params, core.ParamConfirm)
+	}
+	if core.Confirmed(params, "i-1", true) {
+		t.Error("the policy's answer confirms the service's question")
+	}
+
+	if _, ok := confirmedParams(confirm, components.FormResultMsg{
+		ID:     confirmFormID,
+		Values: map[string]any{core.ParamConfirmResource: "i-2"},
+	}); ok {
+		t.Error("confirmedParams() with another resource typed = confirmed")
+	}
+}
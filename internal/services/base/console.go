@@ -0,0 +1,41 @@
+package base
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Console Links
+// =============================================================================
+
+// consoleRegion returns the region console links default to, for resources
+// listed without one.
+var consoleRegion func() string
+
+// UseConsole enables console links in every table view: [W] opens the AWS
+// console page of the selected resource, or copies its address where no
+// browser can be started. region returns the current region.
+func UseConsole(region func() string) {
+	consoleRegion = region
+}
+
+// openInConsole opens the console page of a resource.
+func (tv *TableView) openInConsole(r *core.Resource) tea.Cmd {
+	url, err := awsfactory.ConsoleURL(*r, consoleRegion())
+	if err != nil {
+		tv.Message = i18n.T("Error: %v", err)
+		return nil
+	}
+	if err := components.OpenURL(url); err != nil {
+		components.CopyToClipboard(url)
+		tv.Message = i18n.T("Copied %s", url)
+		return nil
+	}
+	tv.Message = i18n.T("Opened %s", url)
+	return nil
+}
@@ -0,0 +1,130 @@
+package base
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Drill-Down
+// =============================================================================
+
+// DrillLevel is a level of a hierarchical view, such as the services of a
+// cluster. Its filters select what the service lists at that level, through
+// core.ListOptions.
+type DrillLevel struct {
+	Title      string // Breadcrumb entry, such as "prod"
+	Filters    map[string]string
+	ColumnDefs []ColumnDef
+}
+
+// drillFrame is what a level keeps of the table while a deeper one is
+// shown, to come back to it as it was left.
+type drillFrame struct {
+	columnDefs []ColumnDef
+	resources  []core.Resource
+	source     func(i int) table.Row
+	cells      func(i int) Row
+	selectedID string
+	sortBy     string
+	sortDesc   bool
+	message    string
+}
+
+// DrillDown shows a deeper level of the hierarchy in place of the current
+// one, with its own columns and an empty table until the view lists it.
+// Esc comes back up, see HandleOverlay.
+func (tv *TableView) DrillDown(level DrillLevel) {
+	frame := drillFrame{
+		columnDefs: tv.ColumnDefs,
+		resources:  tv.Resources,
+		source:     tv.source,
+		cells:      tv.cells,
+		selectedID: tv.selectedID,
+		sortBy:     tv.sortBy,
+		sortDesc:   tv.sortDesc,
+		message:    tv.Message,
+	}
+	if r := tv.GetSelectedResource(); r != nil {
+		frame.selectedID = r.ID
+	}
+	tv.drill = append(tv.drill, frame)
+	tv.levels = append(tv.levels, level)
+
+	tv.Resources = nil
+	tv.sortBy, tv.sortDesc = "", false
+	tv.selectedID, tv.restoreSelected = "", ""
+	tv.cursor, tv.offset = 0, 0
+	tv.showColumns(level.ColumnDefs)
+	tv.Message = ""
+}
+
+// DrillUp comes back to the level above, as it was left. It returns false
+// at the top level.
+func (tv *TableView) DrillUp() bool {
+	n := len(tv.drill)
+	if n == 0 {
+		return false
+	}
+	frame := tv.drill[n-1]
+	tv.drill = tv.drill[:n-1]
+	tv.levels = tv.levels[:n-1]
+
+	tv.Resources = frame.resources
+	tv.sortBy, tv.sortDesc = frame.sortBy, frame.sortDesc
+	tv.restoreSelected = frame.selectedID
+	tv.cursor, tv.offset = 0, 0
+	tv.showColumns(frame.columnDefs)
+	if frame.source != nil {
+		tv.setSource(len(tv.Resources), frame.source, frame.cells)
+	}
+	tv.Message = frame.message
+	// A listing of the level left is dropped when it arrives
+	tv.SetLoading(false)
+	return true
+}
+
+// DrillDepth returns how many levels below the top the view is.
+func (tv *TableView) DrillDepth() int {
+	return len(tv.levels)
+}
+
+// DrillFilters returns the list filters of the current level, nil at the
+// top.
+func (tv *TableView) DrillFilters() map[string]string {
+	if n := len(tv.levels); n > 0 {
+		return maps.Clone(tv.levels[n-1].Filters)
+	}
+	return nil
+}
+
+// Breadcrumb returns the titles of the levels down to the current one,
+// such as "prod › web", or "" at the top.
+func (tv *TableView) Breadcrumb() string {
+	titles := make([]string, 0, len(tv.levels))
+	for _, level := range tv.levels {
+		titles = append(titles, level.Title)
+	}
+	return strings.Join(titles, " › ")
+}
+
+// showColumns swaps the table's columns, dropping the rows first since they
+// were built for the previous ones.
+func (tv *TableView) showColumns(defs []ColumnDef) {
+	tv.ColumnDefs = defs
+	tv.rowCount = 0
+	tv.order = nil
+	tv.source, tv.cells = nil, nil
+	tv.rowAt = func(int) table.Row { return nil }
+	tv.Table.SetRows(nil)
+
+	width := tv.Width()
+	if width == 0 {
+		width = 100
+	}
+	tv.setColumns(width)
+}
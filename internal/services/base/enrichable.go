@@ -0,0 +1,455 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Enrichable Table View
+// =============================================================================
+
+// RowBuilder renders a single resource as a typed table row.
+type RowBuilder func(r core.Resource) Row
+
+// EnrichableTableView is a TableView for services whose listing is cheap but
+// whose analysis is not. Resources are listed first (page by page when the
+// service is a core.ResourceStreamer), then enriched one at a time in the
+// background through core.ResourceEnricher.
+//
+// Enriched resources are cached by ID: a soft refresh only analyzes resources
+// that were not seen before, while a hard refresh re-analyzes everything.
+//
+// When AWS throttles an enrichment, the resource is retried after a pause
+// and later enrichments are paced for the throttled API, see apiBackoff.
+type EnrichableTableView struct {
+	*TableView
+
+	// ListOptions are sent to the service with every listing
+	ListOptions core.ListOptions
+
+	noun     string // Plural resource name used in status messages
+	buildRow RowBuilder
+
+	// work runs listing and enrichment; its generation identifies the
+	// current listing, and messages from older listings (or other views,
+	// since messages are broadcast) are dropped
+	work      *tasks
+	enriching bool
+	analyzed  int
+	cache     map[string]core.Resource
+	streamed  []core.Resource
+
+	// throttledAPIs are the AWS APIs that throttled this view's enrichment;
+	// their backoff paces it
+	throttledAPIs []string
+}
+
+// NewEnrichableTableView creates an enrichable table view.
+// noun is the plural resource name shown in status messages ("buckets").
+func NewEnrichableTableView(name, shortcut, serviceName, noun string, columnDefs []ColumnDef, buildRow RowBuilder) *EnrichableTableView {
+	ev := &EnrichableTableView{
+		TableView: NewTableView(name, shortcut, serviceName, columnDefs),
+		noun:      noun,
+		buildRow:  buildRow,
+		work:      newTasks(),
+		cache:     make(map[string]core.Resource),
+	}
+	return ev
+}
+
+// IsEnriching reports whether background analysis is in progress.
+func (ev *EnrichableTableView) IsEnriching() bool {
+	return ev.enriching
+}
+
+// Analyzed returns how many resources of the current listing are enriched.
+func (ev *EnrichableTableView) Analyzed() int {
+	return ev.analyzed
+}
+
+// RefreshRows rebuilds the visible table rows from Resources.
+func (ev *EnrichableTableView) RefreshRows() {
+	ev.SetCellSource(len(ev.Resources), func(i int) Row {
+		return ev.buildRow(ev.Resources[i])
+	})
+}
+
+// Reset clears all view data including the cache and stops background work.
+func (ev *EnrichableTableView) Reset() {
+	ev.TableView.Reset()
+	ev.stop()
+	ev.cache = make(map[string]core.Resource)
+	ev.analyzed = 0
+}
+
+// SaveState implements core.StatefulView, adding the listing filters.
+// Sorting happens in the view, not in the service.
+func (ev *EnrichableTableView) SaveState() core.ViewState {
+	state := ev.TableView.SaveState()
+	state.Filters = ev.ListOptions.Filters
+	return state
+}
+
+// RestoreState implements core.StatefulView.
+func (ev *EnrichableTableView) RestoreState(state core.ViewState) {
+	ev.TableView.RestoreState(state)
+	ev.ListOptions.Filters = state.Filters
+}
+
+// Close stops background work for good, once the view is discarded or the
+// application exits.
+func (ev *EnrichableTableView) Close() {
+	ev.work.Close()
+	ev.enriching = false
+	ev.streamed = nil
+}
+
+// stop cancels any listing or enrichment in progress and invalidates its
+// pending messages.
+func (ev *EnrichableTableView) stop() {
+	ev.work.Restart()
+	ev.enriching = false
+	ev.streamed = nil
+}
+
+// =============================================================================
+// Loading
+// =============================================================================
+
+type listedMsg struct {
+	owner     *EnrichableTableView
+	gen       int
+	resources []core.Resource
+	err       error
+	hard      bool
+}
+
+// pageMsg carries one update from a streaming listing.
+type pageMsg struct {
+	owner  *EnrichableTableView
+	gen    int
+	stream <-chan core.ResourceUpdate
+	update core.ResourceUpdate
+	done   bool
+}
+
+type enrichedMsg struct {
+	owner    *EnrichableTableView
+	gen      int
+	index    int
+	resource core.Resource
+	single   bool // Result of AnalyzeSelected; does not continue the sweep
+}
+
+// throttledMsg reports that AWS throttled the enrichment of a resource.
+type throttledMsg struct {
+	owner  *EnrichableTableView
+	gen    int
+	index  int
+	api    string
+	delay  time.Duration // Pause before retrying
+	single bool          // Result of AnalyzeSelected; not retried
+}
+
+type enrichmentDoneMsg struct {
+	owner *EnrichableTableView
+	gen   int
+}
+
+// Load lists resources and re-analyzes all of them (hard refresh).
+func (ev *EnrichableTableView) Load() tea.Cmd {
+	ev.stop()
+	ev.cache = make(map[string]core.Resource)
+	ev.analyzed = 0
+	ev.SetLoading(true)
+
+	service := ev.Service()
+	if streamer, ok := service.(core.ResourceStreamer); ok {
+		return ev.stream(streamer)
+	}
+	return ev.list(service, true)
+}
+
+// SoftRefresh lists resources, reusing cached analysis and only enriching
+// resources that were not seen before.
+func (ev *EnrichableTableView) SoftRefresh() tea.Cmd {
+	ev.stop()
+	ev.SetLoading(true)
+	return ev.list(ev.Service(), false)
+}
+
+func (ev *EnrichableTableView) list(service core.AWSService, hard bool) tea.Cmd {
+	owner, opts := ev, ev.listOptions()
+	return ev.work.Go(func(ctx context.Context, gen int) tea.Msg {
+		if service == nil {
+			return listedMsg{owner: owner, gen: gen, err: fmt.Errorf("service not initialized"), hard: hard}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return listedMsg{owner: owner, gen: gen, err: fmt.Errorf("service does not support listing"), hard: hard}
+		}
+		resources, err := lister.List(ctx, opts)
+		return listedMsg{owner: owner, gen: gen, resources: resources, err: err, hard: hard}
+	})
+}
+
+// stream starts a paginated listing and renders pages as they arrive.
+func (ev *EnrichableTableView) stream(streamer core.ResourceStreamer) tea.Cmd {
+	owner, opts := ev, ev.listOptions()
+	return ev.work.Go(func(ctx context.Context, gen int) tea.Msg {
+		stream, err := streamer.ListStream(ctx, opts)
+		if err != nil {
+			return listedMsg{owner: owner, gen: gen, err: err, hard: true}
+		}
+		return owner.nextPage(ctx, gen, stream)
+	})
+}
+
+// waitForPage reads the next update from a listing stream.
+func (ev *EnrichableTableView) waitForPage(stream <-chan core.ResourceUpdate) tea.Cmd {
+	return ev.work.Go(func(ctx context.Context, gen int) tea.Msg {
+		return ev.nextPage(ctx, gen, stream)
+	})
+}
+
+// nextPage blocks until the stream delivers an update or the listing is
+// cancelled.
+func (ev *EnrichableTableView) nextPage(ctx context.Context, gen int, stream <-chan core.ResourceUpdate) tea.Msg {
+	select {
+	case update, ok := <-stream:
+		return pageMsg{owner: ev, gen: gen, stream: stream, update: update, done: !ok}
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func (ev *EnrichableTableView) listOptions() core.ListOptions {
+	opts := ev.ListOptions
+	opts.Filters = maps.Clone(opts.Filters)
+	return opts
+}
+
+// =============================================================================
+// Enrichment
+// =============================================================================
+
+// AnalyzeSelected re-analyzes the selected resource, bypassing the cache.
+func (ev *EnrichableTableView) AnalyzeSelected() tea.Cmd {
+	enricher, ok := ev.Service().(core.ResourceEnricher)
+	index := ev.SelectedIndex()
+	if !ok || index < 0 {
+		return nil
+	}
+
+	owner := ev
+	resource := ev.Resources[index]
+	resource.Metadata = maps.Clone(resource.Metadata)
+	if resource.Metadata == nil {
+		resource.Metadata = make(map[string]any)
+	}
+	resource.Metadata["analyzed"] = false
+	resource.ClearIssues()
+	delete(ev.cache, resource.ID)
+	apis := slices.Clone(ev.throttledAPIs)
+	return ev.work.Go(func(ctx context.Context, gen int) tea.Msg {
+		if err := enricher.EnrichResource(ctx, &resource); err != nil {
+			if api, ok := awsfactory.Throttled(err); ok {
+				return throttledMsg{owner: owner, gen: gen, index: index, api: api, delay: backoffs.throttled(api), single: true}
+			}
+			return enrichmentDoneMsg{owner: owner, gen: gen}
+		}
+		backoffs.succeeded(apis)
+		return enrichedMsg{owner: owner, gen: gen, index: index, resource: resource, single: true}
+	})
+}
+
+// enrichFrom enriches the next unanalyzed resource at or after start.
+func (ev *EnrichableTableView) enrichFrom(start int) tea.Cmd {
+	return ev.enrichAfter(start, 0)
+}
+
+// enrichAfter waits for wait, then enriches the next unanalyzed resource at
+// or after start. Each enrichment waits for the backoff of the APIs that
+// throttled this view; a resource AWS throttles is reported through a
+// throttledMsg so it is retried rather than left unanalyzed.
+func (ev *EnrichableTableView) enrichAfter(start int, wait time.Duration) tea.Cmd {
+	enricher, ok := ev.Service().(core.ResourceEnricher)
+	if !ok {
+		ev.enriching = false
+		return nil
+	}
+	ev.enriching = true
+
+	owner, resources, apis := ev, ev.Resources, slices.Clone(ev.throttledAPIs)
+	return ev.work.Go(func(ctx context.Context, gen int) tea.Msg {
+		if !sleep(ctx, wait) {
+			return enrichmentDoneMsg{owner: owner, gen: gen}
+		}
+		paced := wait > 0 // A retry already waited out its backoff
+		for i := start; i < len(resources); i++ {
+			if analyzed, ok := resources[i].Metadata["analyzed"].(bool); ok && analyzed {
+				continue
+			}
+			if _, pace := backoffs.pace(apis); !paced && !sleep(ctx, pace) {
+				break
+			}
+			paced = false
+			// Copy metadata so the render loop never reads a map being written
+			resource := resources[i]
+			resource.Metadata = maps.Clone(resource.Metadata)
+			resource.ClearIssues()
+			err := enricher.EnrichResource(ctx, &resource)
+			if err == nil {
+				backoffs.succeeded(apis)
+				return enrichedMsg{owner: owner, gen: gen, index: i, resource: resource}
+			}
+			if api, ok := awsfactory.Throttled(err); ok {
+				return throttledMsg{owner: owner, gen: gen, index: i, api: api, delay: backoffs.throttled(api)}
+			}
+		}
+		return enrichmentDoneMsg{owner: owner, gen: gen}
+	})
+}
+
+// applyListing shows a completed listing, restoring cached analysis, and
+// starts enriching whatever is left. The listing's error is already set.
+func (ev *EnrichableTableView) applyListing(resources []core.Resource, hard bool) tea.Cmd {
+	ev.SetLoading(false)
+	ev.Resources = resources
+	ev.analyzed = 0
+
+	fresh := 0
+	for i := range ev.Resources {
+		if cached, ok := ev.cache[ev.Resources[i].ID]; ok && !hard {
+			ev.Resources[i] = cached
+			ev.analyzed++
+		} else {
+			fresh++
+		}
+	}
+	ev.RefreshRows()
+
+	switch {
+	case fresh == 0:
+		ev.Message = i18n.T("Refreshed %d %s", len(ev.Resources), ev.noun)
+		return nil
+	case hard:
+		ev.Message = i18n.T("Loaded %d %s, analyzing...", len(ev.Resources), ev.noun)
+	default:
+		ev.Message = i18n.T("Found %d new %s, analyzing...", fresh, ev.noun)
+	}
+	return ev.enrichFrom(0)
+}
+
+// =============================================================================
+// Message Handling
+// =============================================================================
+
+// HandleEnrichment processes listing and enrichment messages.
+// It returns true when the message belonged to this view.
+func (ev *EnrichableTableView) HandleEnrichment(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case listedMsg:
+		if msg.owner != ev || msg.gen != ev.work.Gen() {
+			return msg.owner == ev, nil
+		}
+		if ev.SetListError(msg.err) {
+			ev.SetLoading(false)
+			ev.Message = i18n.T("Error: %v", msg.err)
+			return true, nil
+		}
+		return true, ev.applyListing(msg.resources, msg.hard)
+
+	case pageMsg:
+		if msg.owner != ev || msg.gen != ev.work.Gen() {
+			return msg.owner == ev, nil
+		}
+		if msg.done {
+			resources := ev.streamed
+			ev.streamed = nil
+			ev.SetListError(nil)
+			return true, ev.applyListing(resources, true)
+		}
+		if msg.update.Err != nil {
+			ev.stop()
+			ev.SetLoading(false)
+			ev.SetError(msg.update.Err)
+			ev.Message = i18n.T("Error: %v", msg.update.Err)
+			return true, nil
+		}
+		// Keep showing the previous listing until the first page arrives
+		ev.streamed = append(ev.streamed, msg.update.Resources...)
+		ev.SetError(nil)
+		ev.Resources = ev.streamed
+		ev.RefreshRows()
+		ev.Message = i18n.T("Loading %s... %d so far", ev.noun, len(ev.streamed))
+		return true, ev.waitForPage(msg.stream)
+
+	case enrichedMsg:
+		if msg.owner != ev {
+			return false, nil
+		}
+		if msg.gen != ev.work.Gen() || msg.index >= len(ev.Resources) || ev.Resources[msg.index].ID != msg.resource.ID {
+			return true, nil
+		}
+		ev.Resources[msg.index] = msg.resource
+		ev.cache[msg.resource.ID] = msg.resource
+		ev.RefreshRow(msg.index)
+		if msg.single {
+			ev.Message = i18n.T("Analyzed %s", msg.resource.Name)
+			return true, nil
+		}
+		ev.analyzed++
+		ev.Message = i18n.T("Analyzing... %d/%d", ev.analyzed, len(ev.Resources))
+		if api, pace := backoffs.pace(ev.throttledAPIs); pace > 0 {
+			ev.Message = i18n.T("Analyzing... %d/%d, slowed down by %s throttling", ev.analyzed, len(ev.Resources), apiName(api))
+		}
+		return true, ev.enrichFrom(msg.index + 1)
+
+	case throttledMsg:
+		if msg.owner != ev {
+			return false, nil
+		}
+		if msg.gen != ev.work.Gen() {
+			return true, nil
+		}
+		if !slices.Contains(ev.throttledAPIs, msg.api) {
+			ev.throttledAPIs = append(ev.throttledAPIs, msg.api)
+		}
+		if msg.single {
+			ev.Message = i18n.T("Throttled by %s, try again in %s", apiName(msg.api), msg.delay)
+			return true, nil
+		}
+		ev.Message = i18n.T("Throttled by %s, backing off %s... %d/%d", apiName(msg.api), msg.delay, ev.analyzed, len(ev.Resources))
+		return true, ev.enrichAfter(msg.index, msg.delay)
+
+	case enrichmentDoneMsg:
+		if msg.owner != ev {
+			return false, nil
+		}
+		if msg.gen == ev.work.Gen() && ev.enriching {
+			ev.enriching = false
+			ev.Message = i18n.T("Loaded %d %s", len(ev.Resources), ev.noun)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// apiName names a throttled API in status messages.
+func apiName(api string) string {
+	if api == "" {
+		return "AWS"
+	}
+	return api
+}
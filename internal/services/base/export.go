@@ -0,0 +1,110 @@
+package base
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/export"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Resource Export
+// =============================================================================
+
+// exportFormID identifies the form choosing where to export a resource.
+const exportFormID = "export:resource"
+
+// exportKey exports the selected resource in every table view.
+const exportKey = "E"
+
+// exportedMsg reports an export written, or failed, in the background.
+type exportedMsg struct {
+	owner    *TableView
+	name     string
+	path     string
+	warnings []string
+	err      error
+}
+
+// openExportForm asks for the format and file to export a resource to.
+func (tv *TableView) openExportForm(r *core.Resource) tea.Cmd {
+	formats := make([]string, len(export.Formats))
+	for i, format := range export.Formats {
+		formats[i] = string(format)
+	}
+
+	target := *r
+	tv.exportTarget = &target
+	return tv.OpenForm(components.NewForm(exportFormID, i18n.T("Export %s", r.Name), []core.ActionParameter{
+		{
+			Name:        "format",
+			Type:        "select",
+			Options:     formats,
+			Default:     formats[0],
+			Description: i18n.T("Format of the file"),
+		},
+		{
+			Name:        "file",
+			Type:        "string",
+			Description: i18n.T("File to write (empty for %s)", export.FileName(*r, export.Formats[0])),
+		},
+	}))
+}
+
+// startExport describes the resource chosen in the export form and writes
+// it in the background, since its details can take several calls.
+func (tv *TableView) startExport(msg components.FormResultMsg) tea.Cmd {
+	r := tv.exportTarget
+	tv.exportTarget = nil
+	if r == nil {
+		return nil
+	}
+	if msg.Canceled {
+		tv.Message = i18n.T("Canceled")
+		return nil
+	}
+	svc := tv.Service()
+	if svc == nil {
+		tv.Message = i18n.T("Error: %v", "service not initialized")
+		return nil
+	}
+
+	format := export.Formats[0]
+	if value, _ := msg.Values["format"].(string); value != "" {
+		format = core.OutputFormat(value)
+	}
+	path, _ := msg.Values["file"].(string)
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = export.FileName(*r, format)
+	}
+
+	tv.Message = i18n.T("Exporting %s...", r.Name)
+	resource := *r
+	return func() tea.Msg {
+		doc := export.Describe(context.Background(), svc, resource)
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		err := export.WriteFile(path, doc, format)
+		return exportedMsg{owner: tv, name: resource.Name, path: path, warnings: doc.Warnings, err: err}
+	}
+}
+
+// showExport reports a finished export.
+func (tv *TableView) showExport(msg exportedMsg) {
+	switch {
+	case msg.err != nil:
+		tv.Message = i18n.T("Error: %v", msg.err)
+	case len(msg.warnings) > 0:
+		tv.Message = i18n.T("Exported %s to %s, partially: %s", msg.name, msg.path, strings.Join(msg.warnings, "; "))
+	default:
+		tv.Message = i18n.T("Exported %s to %s", msg.name, msg.path)
+	}
+}
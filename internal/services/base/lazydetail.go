@@ -0,0 +1,72 @@
+package base
+
+import (
+	"context"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Lazy Details
+// =============================================================================
+
+// lazyDetail is the state of a detail panel waiting for fetched details.
+type lazyDetail struct {
+	id      string
+	summary string
+}
+
+// detailLoadedMsg carries the details fetched for a panel.
+type detailLoadedMsg struct {
+	owner    *TableView // Details for a closed or swapped-out panel are dropped
+	id       string
+	sections []core.DetailSection
+	err      error
+}
+
+// OpenLazyDetail shows summary in the detail panel at once, then appends the
+// details the service fetches when it implements core.DetailFetcher. The
+// fetched details only live as long as the panel.
+func (tv *TableView) OpenLazyDetail(title, summary string, r *core.Resource) tea.Cmd {
+	tv.OpenDetail(title, summary)
+	fetcher, ok := tv.Service().(core.DetailFetcher)
+	if !ok {
+		return nil
+	}
+
+	tv.lazy = &lazyDetail{id: r.ID, summary: summary}
+	tv.detail.SetContent(tv.withNote(strings.TrimRight(summary, "\n") + "\n\n" + i18n.T("Loading details...") + "\n"))
+
+	resource := *r
+	return func() tea.Msg {
+		sections, err := fetcher.FetchDetail(context.Background(), &resource)
+		return detailLoadedMsg{owner: tv, id: resource.ID, sections: sections, err: err}
+	}
+}
+
+// applyDetail shows fetched details if their panel is still open.
+func (tv *TableView) applyDetail(msg detailLoadedMsg) {
+	lazy := tv.lazy
+	if lazy == nil || tv.detail == nil || msg.id != lazy.id {
+		return
+	}
+	tv.lazy = nil
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(lazy.summary, "\n"))
+	b.WriteString("\n")
+	if msg.err != nil {
+		b.WriteString("\n" + i18n.T("Error: %v", msg.err) + "\n")
+	}
+	for _, section := range msg.sections {
+		b.WriteString("\n" + section.Title + ":\n")
+		for line := range strings.SplitSeq(strings.TrimRight(section.Body, "\n"), "\n") {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	tv.detail.SetContent(tv.withNote(b.String()))
+}
@@ -0,0 +1,181 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/metrics"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Metrics Charts
+// =============================================================================
+
+// chartHeight is the height in lines of each metric's chart.
+const chartHeight = 5
+
+var metricsSource *metrics.Source
+
+// UseMetrics enables metric charts in every table view: [M] charts the
+// CloudWatch metrics of the selected resource, when curated for its type.
+func UseMetrics(source *metrics.Source) {
+	metricsSource = source
+}
+
+// metricsPane is the state of an open metrics panel.
+type metricsPane struct {
+	resource core.Resource
+	rng      int // Index in metrics.Ranges
+	style    components.ChartStyle
+	loading  bool
+	series   []metrics.Series
+	err      error
+}
+
+// metricsLoadedMsg carries the metrics fetched for a panel.
+type metricsLoadedMsg struct {
+	owner  *TableView // Results for a closed or swapped-out panel are dropped
+	id     string
+	rng    int
+	series []metrics.Series
+	err    error
+}
+
+// openMetrics shows the metrics of a resource in place of the table.
+func (tv *TableView) openMetrics(r *core.Resource) tea.Cmd {
+	if !metrics.Supports(r.Type) {
+		tv.Message = i18n.T("No metrics are charted for %s resources", r.Type)
+		return nil
+	}
+	tv.metrics = &metricsPane{resource: *r, rng: metrics.DefaultRange}
+	tv.form = nil
+	tv.lazy = nil
+	tv.detail = components.NewDetail(i18n.T("Metrics of %s", r.Name), "", tv.Width(), tv.overlayHeight())
+	return tv.fetchMetrics()
+}
+
+// fetchMetrics loads the metrics of the open panel over its range.
+func (tv *TableView) fetchMetrics() tea.Cmd {
+	pane := tv.metrics
+	pane.loading = true
+	tv.renderMetrics()
+
+	resource, rng := pane.resource, pane.rng
+	return func() tea.Msg {
+		series, err := metricsSource.Fetch(context.Background(), &resource, metrics.Ranges[rng])
+		return metricsLoadedMsg{owner: tv, id: resource.ID, rng: rng, series: series, err: err}
+	}
+}
+
+// updateMetrics handles the keys of the metrics panel. It returns false for
+// keys meant for the detail panel, such as scrolling.
+func (tv *TableView) updateMetrics(msg tea.KeyMsg) (bool, tea.Cmd) {
+	pane := tv.metrics
+	switch msg.String() {
+	case "[":
+		if pane.rng > 0 {
+			pane.rng--
+			return true, tv.fetchMetrics()
+		}
+		return true, nil
+	case "]":
+		if pane.rng < len(metrics.Ranges)-1 {
+			pane.rng++
+			return true, tv.fetchMetrics()
+		}
+		return true, nil
+	case "r":
+		return true, tv.fetchMetrics()
+	case "c":
+		if pane.style == components.ChartBraille {
+			pane.style = components.ChartBlocks
+		} else {
+			pane.style = components.ChartBraille
+		}
+		tv.renderMetrics()
+		return true, nil
+	}
+	return false, nil
+}
+
+// applyMetrics shows fetched metrics if they are still the ones asked for.
+func (tv *TableView) applyMetrics(msg metricsLoadedMsg) {
+	pane := tv.metrics
+	if pane == nil || tv.detail == nil || msg.id != pane.resource.ID || msg.rng != pane.rng {
+		return
+	}
+	pane.loading = false
+	pane.series, pane.err = msg.series, msg.err
+	tv.renderMetrics()
+}
+
+// renderMetrics redraws the metrics panel.
+func (tv *TableView) renderMetrics() {
+	pane := tv.metrics
+	if pane == nil || tv.detail == nil {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T("Range:"))
+	for i, r := range metrics.Ranges {
+		if i == pane.rng {
+			b.WriteString(" [" + r.Label + "]")
+		} else {
+			b.WriteString("  " + r.Label + " ")
+		}
+	}
+	b.WriteString("    " + i18n.T("[/] range  [c] chart style  [r] reload") + "\n\n")
+
+	switch {
+	case pane.loading:
+		b.WriteString(i18n.T("Loading metrics...") + "\n")
+	case pane.err != nil:
+		b.WriteString(i18n.T("Error: %v", pane.err) + "\n")
+	default:
+		width := tv.Width() - 2
+		for _, s := range pane.series {
+			writeSeries(&b, s, width, pane.style)
+		}
+	}
+	tv.detail.SetContent(b.String())
+}
+
+// writeSeries writes a metric's statistics and chart.
+func writeSeries(b *strings.Builder, s metrics.Series, width int, style components.ChartStyle) {
+	fmt.Fprintf(b, "%s (%s)", s.Label, s.Stat)
+	if len(s.Points) == 0 {
+		b.WriteString("  " + i18n.T("No datapoints in this range") + "\n\n")
+		return
+	}
+	low, mean, high, last := s.Stats()
+	b.WriteString("  " + i18n.T("min %s  avg %s  max %s  last %s", s.Format(low), s.Format(mean), s.Format(high), s.Format(last)) + "\n")
+
+	span := s.End.Sub(s.Start).Seconds()
+	points := make([]components.ChartPoint, len(s.Points))
+	for i, p := range s.Points {
+		points[i] = components.ChartPoint{X: p.Time.Sub(s.Start).Seconds() / span, Y: p.Value}
+	}
+	chart := components.Chart(points, width, chartHeight, style, s.Format)
+	b.WriteString(chart)
+
+	// Time axis, aligned under the plot
+	if lines := strings.SplitN(chart, "\n", 2); len(lines) > 0 {
+		indent := strings.IndexRune(lines[0], '┤')
+		if indent >= 0 {
+			indent = len([]rune(lines[0][:indent])) + 1
+			from, to := s.Start.Local().Format("01-02 15:04"), s.End.Local().Format("01-02 15:04")
+			gap := width - indent - len(from) - len(to)
+			if gap > 0 {
+				b.WriteString(strings.Repeat(" ", indent) + from + strings.Repeat(" ", gap) + to + "\n")
+			}
+		}
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,96 @@
+package base
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/notes"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Resource Notes
+// =============================================================================
+
+// noteFormID identifies the form editing the note on a resource.
+const noteFormID = "notes:edit"
+
+var (
+	noteStore  *notes.Store
+	noteAuthor string
+)
+
+// UseNotes enables resource notes in every table view: [n] edits the note on
+// the selected resource and detail panels show it. author is recorded with
+// each note.
+func UseNotes(store *notes.Store, author string) {
+	noteStore = store
+	noteAuthor = author
+}
+
+// openNoteForm edits the note on a resource.
+func (tv *TableView) openNoteForm(r *core.Resource) tea.Cmd {
+	key := notes.Key(r)
+	current, _, err := noteStore.Get(key)
+	if err != nil {
+		tv.Message = i18n.T("Error: %v", err)
+		return nil
+	}
+
+	tv.noteTarget = r
+	return tv.OpenForm(components.NewForm(noteFormID, i18n.T("Note on %s", r.Name), []core.ActionParameter{{
+		Name:        "note",
+		Type:        "string",
+		Default:     current.Text,
+		Description: i18n.T("Local note, not written to AWS (empty to remove)"),
+	}}))
+}
+
+// saveNote stores the note entered in the note form.
+func (tv *TableView) saveNote(msg components.FormResultMsg) {
+	r := tv.noteTarget
+	tv.noteTarget = nil
+	if r == nil || noteStore == nil {
+		return
+	}
+	if msg.Canceled {
+		tv.Message = i18n.T("Canceled")
+		return
+	}
+
+	text, _ := msg.Values["note"].(string)
+	if _, err := noteStore.Set(notes.Key(r), r.Name, text, noteAuthor); err != nil {
+		tv.Message = i18n.T("Error: %v", err)
+		return
+	}
+	if strings.TrimSpace(text) == "" {
+		tv.Message = i18n.T("Removed note on %s", r.Name)
+	} else {
+		tv.Message = i18n.T("Saved note on %s", r.Name)
+	}
+}
+
+// withNote appends the note on the selected resource to detail content.
+func (tv *TableView) withNote(content string) string {
+	r := tv.GetSelectedResource()
+	if noteStore == nil || r == nil {
+		return content
+	}
+	note, ok, err := noteStore.Get(notes.Key(r))
+	if err != nil || !ok {
+		return content
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(content, "\n"))
+	b.WriteString(i18n.T("\n\nNote:\n"))
+	b.WriteString("  " + strings.ReplaceAll(note.Text, "\n", "\n  ") + "\n")
+	if note.Author != "" {
+		fmt.Fprintf(&b, "  — %s, %s\n", note.Author, note.UpdatedAt.Format("2006-01-02 15:04"))
+	}
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Partial Listings
+// =============================================================================
+
+// SetListError records how the last listing failed. A core.PartialError
+// keeps the resources that were listed and shows a banner over the table
+// naming how many shards failed, with [!] for the errors; any other error
+// becomes the view's error. It reports whether the listing failed outright.
+func (tv *TableView) SetListError(err error) bool {
+	tv.partial = nil
+	var partial *core.PartialError
+	if errors.As(err, &partial) {
+		tv.partial = partial
+		err = nil
+	}
+	tv.SetError(err)
+	tv.HandleWindowSize(tea.WindowSizeMsg{})
+	return err != nil
+}
+
+// Partial returns the shards the last listing failed to list, if any.
+func (tv *TableView) Partial() *core.PartialError {
+	return tv.partial
+}
+
+// partialBanner renders the line shown over the table of a partial listing.
+func (tv *TableView) partialBanner() string {
+	return tv.Styles.Warning.Render(i18n.T("⚠ Partial results: %d %s failed, [!] for details",
+		len(tv.partial.Failures), i18n.T(tv.partial.Noun)))
+}
+
+// openPartialDetail shows why each shard of a partial listing failed.
+func (tv *TableView) openPartialDetail() {
+	var b strings.Builder
+	for _, f := range tv.partial.Failures {
+		fmt.Fprintf(&b, "%s\n  %v\n\n", f.Shard, f.Err)
+	}
+	b.WriteString(i18n.T("Everything else is listed. Press [r] to retry."))
+	tv.OpenDetail(i18n.T("Partial results"), b.String())
+}
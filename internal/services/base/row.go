@@ -0,0 +1,125 @@
+package base
+
+import (
+	"cmp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Typed Rows
+// =============================================================================
+
+// Cell is a table cell keeping the value it shows, so rows sort by size,
+// age or cost rather than by their text. The text is only formatted when
+// the row is drawn.
+type Cell struct {
+	// Value orders rows: a number, time.Duration, time.Time or string.
+	// Cells without a value sort last.
+	Value  any
+	format func() string
+}
+
+// Text formats the cell.
+func (c Cell) Text() string {
+	if c.format == nil {
+		return ""
+	}
+	return c.format()
+}
+
+// TextCell returns a cell showing s, sorted alphabetically.
+func TextCell(s string) Cell {
+	return Cell{Value: s, format: func() string { return s }}
+}
+
+// LazyCell returns a cell sorted by value and formatted when drawn.
+func LazyCell(value any, format func() string) Cell {
+	return Cell{Value: value, format: format}
+}
+
+// AgeCell returns the age of a resource, sorted by duration.
+func AgeCell(r core.Resource) Cell {
+	var value any
+	if r.CreatedAt != nil {
+		value = time.Since(*r.CreatedAt)
+	}
+	return LazyCell(value, func() string { return FormatAge(r) })
+}
+
+// CostCell returns the estimated monthly cost of a resource, sorted by
+// amount.
+func CostCell(r core.Resource) Cell {
+	var value any
+	if monthly, ok := estimate.MonthlyCost(r); ok {
+		value = monthly
+	}
+	return LazyCell(value, func() string { return FormatCost(r) })
+}
+
+// SeverityCell returns the severity of a resource, sorted by rank.
+func SeverityCell(r core.Resource) Cell {
+	return LazyCell(r.Severity().Rank(), func() string { return FormatSeverity(r) })
+}
+
+// Row is a table row of typed cells.
+type Row []Cell
+
+// Cells returns a row of text cells.
+func Cells(texts ...string) Row {
+	row := make(Row, len(texts))
+	for i, s := range texts {
+		row[i] = TextCell(s)
+	}
+	return row
+}
+
+// Strings formats the row for the table.
+func (r Row) Strings() table.Row {
+	out := make(table.Row, len(r))
+	for i, c := range r {
+		out[i] = c.Text()
+	}
+	return out
+}
+
+// compareValues orders cell values of the same kind. Values without an
+// order, and nil, compare equal.
+func compareValues(a, b any) int {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		}
+	case int:
+		if b, ok := b.(int); ok {
+			return cmp.Compare(a, b)
+		}
+	case int32:
+		if b, ok := b.(int32); ok {
+			return cmp.Compare(a, b)
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			return cmp.Compare(a, b)
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return cmp.Compare(a, b)
+		}
+	case time.Duration:
+		if b, ok := b.(time.Duration); ok {
+			return cmp.Compare(a, b)
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b)
+		}
+	}
+	return 0
+}
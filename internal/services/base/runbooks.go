@@ -0,0 +1,288 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/runbook"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Runbooks
+// =============================================================================
+
+// runbookKey starts a runbook on the selected resource in every table view.
+const runbookKey = "K"
+
+// Forms of a runbook run: choosing the runbook, confirming a step, and
+// confirming a step a guard held back.
+const (
+	runbookFormID        = "runbook:choose"
+	runbookStepFormID    = "runbook:step"
+	runbookConfirmFormID = "runbook:confirm"
+)
+
+// runbookRunParam answers the step form. It only lets the run go on: the
+// action's own confirmation, such as typing the resource name back, is
+// still asked by the confirmation form.
+const runbookRunParam = "run_step"
+
+var (
+	runbooks        []runbook.Runbook
+	runbookServices func(name string) (core.AWSService, error)
+)
+
+// UseRunbooks enables runbooks in every table view: [K] runs one of books
+// on the selected resource, a step at a time. services looks up the
+// service running each step.
+func UseRunbooks(books []runbook.Runbook, services func(name string) (core.AWSService, error)) {
+	runbooks = books
+	runbookServices = services
+}
+
+// runbookState is the runbook a table view is running.
+type runbookState struct {
+	target  *core.Resource          // Resource the runbook is chosen for
+	run     *runbook.Run            // Nil until a runbook is chosen
+	step    runbook.Planned         // Step being confirmed or run
+	confirm *core.ConfirmationError // Guard confirmation of the step, if any
+}
+
+// runbookStepMsg reports how a step of a runbook ran.
+type runbookStepMsg struct {
+	owner  *TableView
+	result *core.ActionResult
+	err    error
+}
+
+// viewRunbooks returns the runbooks starting on the view's resources.
+func (tv *TableView) viewRunbooks() []runbook.Runbook {
+	var books []runbook.Runbook
+	for _, rb := range runbooks {
+		if rb.AppliesTo(tv.ServiceName()) {
+			books = append(books, rb)
+		}
+	}
+	return books
+}
+
+// openRunbookForm asks which runbook to run on a resource.
+func (tv *TableView) openRunbookForm(r *core.Resource) tea.Cmd {
+	books := tv.viewRunbooks()
+	if len(books) == 0 {
+		tv.Message = i18n.T("No runbooks for this view")
+		return nil
+	}
+
+	names := make([]string, len(books))
+	var descriptions []string
+	for i, rb := range books {
+		names[i] = rb.Name
+		if rb.Description != "" {
+			descriptions = append(descriptions, rb.Name+": "+rb.Description)
+		}
+	}
+
+	tv.runbook = &runbookState{target: r}
+	return tv.OpenForm(components.NewForm(runbookFormID, i18n.T("Run a runbook on %s", r.Name), []core.ActionParameter{{
+		Name:        "runbook",
+		Type:        "select",
+		Options:     names,
+		Default:     names[0],
+		Description: strings.Join(descriptions, "\n"),
+	}}))
+}
+
+// startRunbook starts the runbook chosen in the runbook form.
+func (tv *TableView) startRunbook(msg components.FormResultMsg) tea.Cmd {
+	state := tv.runbook
+	if state == nil || msg.Canceled {
+		tv.runbook = nil
+		return nil
+	}
+	name, _ := msg.Values["runbook"].(string)
+	for _, rb := range tv.viewRunbooks() {
+		if rb.Name == name {
+			state.run = runbook.NewRun(rb, *state.target)
+			return tv.nextRunbookStep()
+		}
+	}
+	tv.runbook = nil
+	return nil
+}
+
+// nextRunbookStep asks to confirm the next step, skipping the ones without
+// a resource, or reports the run once every step ran.
+func (tv *TableView) nextRunbookStep() tea.Cmd {
+	state := tv.runbook
+	for {
+		p, ok, err := state.run.Next()
+		if err != nil {
+			state.run.Record(p, nil, err)
+			return tv.endRunbook(false)
+		}
+		if !ok {
+			return tv.endRunbook(false)
+		}
+		if p.ResourceID == "" {
+			state.run.Record(p, nil, nil)
+			continue
+		}
+		state.step = p
+		break
+	}
+
+	p := state.step
+	description := i18n.T("%s:%s on %s", p.Step.Service, p.Step.Action, p.ResourceID)
+	if hint := state.run.RollbackHint(p); hint != "" {
+		description += ". " + i18n.T("Rollback: %s", hint)
+	}
+	description += ". " + i18n.T("Press y, then Enter to run it")
+
+	total := len(state.run.Runbook.Steps)
+	return tv.OpenForm(components.NewForm(runbookStepFormID,
+		i18n.T("%s, step %d/%d: %s", state.run.Runbook.Name, p.Index+1, total, p.Step.Title()),
+		[]core.ActionParameter{{Name: runbookRunParam, Type: "bool", Description: description}}))
+}
+
+// runRunbookStep runs the step once the operator confirmed it, or stops the
+// run.
+func (tv *TableView) runRunbookStep(msg components.FormResultMsg) tea.Cmd {
+	if tv.runbook == nil {
+		return nil
+	}
+	if run, _ := msg.Values[runbookRunParam].(bool); msg.Canceled || !run {
+		return tv.endRunbook(true)
+	}
+	// The service and guards ask for their own confirmations, which
+	// runbookStepDone forwards to the confirmation form
+	return tv.execRunbookStep(tv.runbook.step.Params)
+}
+
+// resolveRunbookConfirmation runs the step again once the guard holding it
+// back is confirmed, or stops the run.
+func (tv *TableView) resolveRunbookConfirmation(msg components.FormResultMsg) tea.Cmd {
+	state := tv.runbook
+	if state == nil || state.confirm == nil {
+		return nil
+	}
+	params, confirmed := confirmedParams(state.confirm, msg)
+	state.confirm = nil
+	if !confirmed {
+		return tv.endRunbook(true)
+	}
+	return tv.execRunbookStep(params)
+}
+
+// execRunbookStep runs the current step with params through the service
+// named by the step, so guards apply as they do in its own view.
+func (tv *TableView) execRunbookStep(params map[string]any) tea.Cmd {
+	p := tv.runbook.step
+	tv.Message = i18n.T("Running %s on %s...", p.Step.Action, p.ResourceID)
+	return func() tea.Msg {
+		service, err := runbookServices(p.Step.Service)
+		if err != nil {
+			return runbookStepMsg{owner: tv, err: fmt.Errorf("%s: %w", p.Step.Service, err)}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return runbookStepMsg{owner: tv, err: fmt.Errorf("%s: %w", p.Step.Service, core.ErrActionNotSupported)}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, p.Step.Action, p.ResourceID, maps.Clone(params))
+		return runbookStepMsg{owner: tv, result: result, err: err}
+	}
+}
+
+// runbookStepDone records how a step ran and moves on to the next one,
+// asks to confirm the step when a guard held it back, or reports the
+// failed run.
+func (tv *TableView) runbookStepDone(msg runbookStepMsg) tea.Cmd {
+	state := tv.runbook
+	if state == nil {
+		return nil
+	}
+	var confirm *core.ConfirmationError
+	if !errors.As(msg.err, &confirm) && errors.Is(msg.err, core.ErrConfirmationRequired) {
+		// Services predating ConfirmationError only return the sentinel,
+		// answered by setting core.ParamConfirm
+		p := state.step
+		confirm = &core.ConfirmationError{Request: core.ActionRequest{
+			Service:    p.Step.Service,
+			Action:     core.Action{Name: p.Step.Action},
+			ResourceID: p.ResourceID,
+			Params:     p.Params,
+		}}
+	}
+	if confirm != nil {
+		state.confirm = confirm
+		tv.Message = ""
+		return tv.OpenForm(confirmationForm(runbookConfirmFormID, confirm))
+	}
+
+	state.run.Record(state.step, msg.result, msg.err)
+	if msg.err != nil {
+		return tv.endRunbook(false)
+	}
+	return tv.nextRunbookStep()
+}
+
+// endRunbook shows what each step did and, when the run stopped short, the
+// rollback hints of the steps that ran, last first. A run that changed
+// something refreshes the view.
+func (tv *TableView) endRunbook(stopped bool) tea.Cmd {
+	run := tv.runbook.run
+	tv.runbook = nil
+
+	var b strings.Builder
+	ran := 0
+	for _, o := range run.Done {
+		switch {
+		case o.Err != nil:
+			fmt.Fprintf(&b, "✗ %d. %s\n    %v\n", o.Index+1, o.Step.Title(), o.Err)
+		case o.Skipped:
+			fmt.Fprintf(&b, "- %d. %s\n    %s\n", o.Index+1, o.Step.Title(), i18n.T("Skipped: no resource"))
+		default:
+			ran++
+			fmt.Fprintf(&b, "✓ %d. %s\n", o.Index+1, o.Step.Title())
+			if o.Result != nil && o.Result.Message != "" {
+				fmt.Fprintf(&b, "    %s\n", o.Result.Message)
+			}
+		}
+	}
+	for _, step := range run.Runbook.Steps[len(run.Done):] {
+		fmt.Fprintf(&b, "  %s\n", step.Title())
+	}
+
+	var message string
+	switch {
+	case run.Finished():
+		message = i18n.T("Runbook %s finished on %s", run.Runbook.Name, run.Target.Name)
+	case stopped:
+		message = i18n.T("Runbook %s stopped at step %d/%d", run.Runbook.Name, len(run.Done)+1, len(run.Runbook.Steps))
+	default:
+		message = i18n.T("Runbook %s failed at step %d/%d", run.Runbook.Name, len(run.Done), len(run.Runbook.Steps))
+	}
+	if undo := run.Rollback(); len(undo) > 0 && !run.Finished() {
+		b.WriteString(i18n.T("\nTo roll back, last step first:\n"))
+		for _, o := range undo {
+			fmt.Fprintf(&b, "  %d. %s\n", o.Index+1, run.RollbackHint(o.Planned))
+		}
+	}
+
+	tv.OpenDetail(message, b.String())
+	if ran == 0 {
+		tv.Message = message
+		return nil
+	}
+	return func() tea.Msg {
+		return ActionResultMsg{Action: "runbook", Result: &core.ActionResult{Success: run.Finished(), Message: message}}
+	}
+}
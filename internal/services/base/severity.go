@@ -0,0 +1,109 @@
+package base
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Severities
+// =============================================================================
+
+// SortBySeverity is the ViewState.SortBy value of a view sorted by severity.
+const SortBySeverity = "severity"
+
+// severityColors are the terminal colors of each severity.
+var severityColors = map[core.Severity]lipgloss.Color{
+	core.SeverityCritical: lipgloss.Color("196"),
+	core.SeverityHigh:     lipgloss.Color("202"),
+	core.SeverityMedium:   lipgloss.Color("214"),
+	core.SeverityLow:      lipgloss.Color("226"),
+	core.SeverityInfo:     lipgloss.Color("86"),
+}
+
+// SeverityColor returns the color of a severity.
+func SeverityColor(s core.Severity) lipgloss.Color {
+	if c, ok := severityColors[s]; ok {
+		return c
+	}
+	return lipgloss.Color("241")
+}
+
+// SeverityIcon returns an icon for a severity. Table cells use icons since
+// colors would break column alignment.
+func SeverityIcon(s core.Severity) string {
+	switch s {
+	case core.SeverityCritical:
+		return "🟥"
+	case core.SeverityHigh:
+		return "🔴"
+	case core.SeverityMedium:
+		return "🟠"
+	case core.SeverityLow:
+		return "🟡"
+	case core.SeverityInfo:
+		return "🔵"
+	}
+	return "🟢"
+}
+
+// SeverityLabel returns the translated name of a severity.
+func SeverityLabel(s core.Severity) string {
+	switch s {
+	case core.SeverityCritical:
+		return i18n.T("Critical")
+	case core.SeverityHigh:
+		return i18n.T("High")
+	case core.SeverityMedium:
+		return i18n.T("Medium")
+	case core.SeverityLow:
+		return i18n.T("Low")
+	case core.SeverityInfo:
+		return i18n.T("Info")
+	}
+	return i18n.T("None")
+}
+
+// FormatSeverity formats the severity of a resource for a table cell: "..."
+// until it is analyzed, then an icon and the severity name.
+func FormatSeverity(r core.Resource) string {
+	if analyzed, ok := r.Metadata["analyzed"].(bool); ok && !analyzed {
+		return "..."
+	}
+	s := r.Severity()
+	return SeverityIcon(s) + " " + SeverityLabel(s)
+}
+
+// FormatIssues joins the messages of a resource's issues, most severe first.
+func FormatIssues(r core.Resource) string {
+	issues := slices.Clone(r.Issues())
+	slices.SortStableFunc(issues, func(a, b core.Issue) int {
+		return b.Severity.Rank() - a.Severity.Rank()
+	})
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// RenderSeverityCounts renders counts per severity, most severe first, each
+// in its color, e.g. "1 critical  3 high". Severities without resources
+// are left out.
+func RenderSeverityCounts(counts map[core.Severity]int) string {
+	var parts []string
+	for _, s := range core.Severities {
+		if counts[s] == 0 {
+			continue
+		}
+		style := lipgloss.NewStyle().Foreground(SeverityColor(s))
+		parts = append(parts, style.Render(fmt.Sprintf("%d %s", counts[s], strings.ToLower(SeverityLabel(s)))))
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,154 @@
+package base
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// sortFormID identifies the sort form's result message.
+const sortFormID = "base:sort"
+
+// =============================================================================
+// Sorting
+// =============================================================================
+
+// SortBy returns what rows are sorted by: "" for the listing order,
+// SortBySeverity, or a column title.
+func (tv *TableView) SortBy() string {
+	return tv.sortBy
+}
+
+// SetSort sorts rows by severity or by a column's values, most severe or
+// largest first when desc is set. An empty sortBy restores the listing
+// order. Columns can only be sorted in views built with SetCellSource.
+func (tv *TableView) SetSort(sortBy string, desc bool) {
+	tv.sortBy = sortBy
+	tv.sortDesc = desc
+	if tv.source != nil {
+		tv.setSource(tv.rowCount, tv.source, tv.cells)
+	}
+}
+
+// sortOrder returns the indexes of resources in display order, or nil for
+// the listing order. Rows keep their listing order among equals, and rows
+// without a value come last.
+func (tv *TableView) sortOrder(count int) []int {
+	if tv.sortBy == "" || count != len(tv.Resources) {
+		return nil
+	}
+
+	var key func(i int) any
+	if tv.sortBy == SortBySeverity {
+		key = func(i int) any { return tv.Resources[i].Severity().Rank() }
+	} else {
+		column := tv.sortColumn()
+		if column < 0 {
+			return nil
+		}
+		key = func(i int) any {
+			row := tv.cells(i)
+			if column >= len(row) {
+				return nil
+			}
+			return row[column].Value
+		}
+	}
+
+	keys := make([]any, count)
+	order := make([]int, count)
+	for i := range order {
+		order[i] = i
+		keys[i] = key(i)
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		switch {
+		case keys[a] == nil && keys[b] == nil:
+			return 0
+		case keys[a] == nil:
+			return 1
+		case keys[b] == nil:
+			return -1
+		}
+		if tv.sortDesc {
+			return compareValues(keys[b], keys[a])
+		}
+		return compareValues(keys[a], keys[b])
+	})
+	return order
+}
+
+// sortColumn returns the index of the column rows are sorted by, or -1.
+func (tv *TableView) sortColumn() int {
+	if tv.cells == nil {
+		return -1
+	}
+	return slices.IndexFunc(tv.ColumnDefs, func(c ColumnDef) bool {
+		return c.Title == tv.sortBy
+	})
+}
+
+// toggleSeveritySort switches between severity and listing order.
+func (tv *TableView) toggleSeveritySort() {
+	if tv.sortBy == SortBySeverity {
+		tv.SetSort("", false)
+		tv.Message = i18n.T("Listing order")
+		return
+	}
+	tv.SetSort(SortBySeverity, true)
+	tv.Message = i18n.T("Sorted by severity")
+}
+
+// openSortForm asks which column to sort by.
+func (tv *TableView) openSortForm() tea.Cmd {
+	options := []string{i18n.T("Listing order"), i18n.T("Severity")}
+	if tv.cells != nil {
+		for _, c := range tv.ColumnDefs {
+			if c.Title != "" && !slices.Contains(options, c.Title) {
+				options = append(options, c.Title)
+			}
+		}
+	}
+	current := options[0]
+	switch {
+	case tv.sortBy == SortBySeverity:
+		current = options[1]
+	case tv.sortBy != "":
+		current = tv.sortBy
+	}
+	order := i18n.T("Descending")
+	if tv.sortBy != "" && !tv.sortDesc {
+		order = i18n.T("Ascending")
+	}
+
+	return tv.OpenForm(components.NewForm(sortFormID, i18n.T("Sort rows"), []core.ActionParameter{
+		{Name: "column", Type: "select", Options: options, Default: current},
+		{Name: "order", Type: "select", Options: []string{i18n.T("Descending"), i18n.T("Ascending")}, Default: order},
+	}))
+}
+
+// applySortForm sorts rows as chosen in the sort form.
+func (tv *TableView) applySortForm(msg components.FormResultMsg) {
+	if msg.Canceled {
+		return
+	}
+	column, _ := msg.Values["column"].(string)
+	order, _ := msg.Values["order"].(string)
+	desc := order != i18n.T("Ascending")
+
+	switch column {
+	case i18n.T("Listing order"):
+		tv.SetSort("", false)
+		tv.Message = i18n.T("Listing order")
+	case i18n.T("Severity"):
+		tv.SetSort(SortBySeverity, desc)
+		tv.Message = i18n.T("Sorted by severity")
+	default:
+		tv.SetSort(column, desc)
+		tv.Message = i18n.T("Sorted by %s", column)
+	}
+}
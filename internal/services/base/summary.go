@@ -0,0 +1,105 @@
+package base
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Summary Line
+// =============================================================================
+
+// Widgets every table view offers from its badge. They are hidden unless
+// tui.summary names them, or the view shows its own widget of that name.
+const (
+	WidgetTotal  = "total"
+	WidgetIssues = "issues"
+	WidgetCost   = "cost"
+)
+
+// SetSummaryLayout implements core.SummaryProvider for views that also
+// implement SummaryWidgets. Unknown names are ignored when rendering.
+func (tv *TableView) SetSummaryLayout(names []string) {
+	tv.summaryLayout = slices.Clone(names)
+}
+
+// CommonWidgets returns the view's own widgets followed by the common
+// total, issues and cost widgets it does not show itself, hidden.
+func (tv *TableView) CommonWidgets(own ...core.SummaryWidget) []core.SummaryWidget {
+	badge := tv.Badge()
+	common := []core.SummaryWidget{
+		{Name: WidgetTotal, Text: i18n.T("Total: %d", badge.Count), Tone: core.ToneMuted},
+		{Name: WidgetIssues, Text: i18n.T("Issues: %d", badge.Flagged()), Tone: core.ToneWarning},
+		{Name: WidgetCost, Text: i18n.T("Est. $%.2f/mo", badge.Spend), Tone: core.ToneMuted},
+	}
+
+	widgets := slices.Clone(own)
+	for _, w := range common {
+		if !slices.ContainsFunc(own, func(o core.SummaryWidget) bool { return o.Name == w.Name }) {
+			w.Hidden = true
+			widgets = append(widgets, w)
+		}
+	}
+	return widgets
+}
+
+// RenderSummary renders the summary line: the title, then the widgets of
+// the configured layout in its order, or the visible widgets when none is
+// configured.
+func (tv *TableView) RenderSummary(title string, widgets []core.SummaryWidget) string {
+	shown := LayoutWidgets(widgets, tv.summaryLayout)
+
+	parts := []string{tv.Styles.Title.Render(title)}
+	for _, w := range shown {
+		if w.Text == "" {
+			continue
+		}
+		parts = append(parts, "  ", tv.Styles.tone(w.Tone).Render(w.Text))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+}
+
+// LayoutWidgets picks the widgets named by layout, in its order; names
+// matching no widget are skipped. Without a layout, the widgets not hidden
+// are kept in their order.
+func LayoutWidgets(widgets []core.SummaryWidget, layout []string) []core.SummaryWidget {
+	var shown []core.SummaryWidget
+	if layout == nil {
+		for _, w := range widgets {
+			if !w.Hidden {
+				shown = append(shown, w)
+			}
+		}
+		return shown
+	}
+	for _, name := range layout {
+		i := slices.IndexFunc(widgets, func(w core.SummaryWidget) bool {
+			return strings.EqualFold(w.Name, name)
+		})
+		if i >= 0 {
+			shown = append(shown, widgets[i])
+		}
+	}
+	return shown
+}
+
+// tone returns the style of a summary widget tone.
+func (s Styles) tone(tone core.SummaryTone) lipgloss.Style {
+	switch tone {
+	case core.ToneInfo:
+		return s.Info
+	case core.ToneSuccess:
+		return s.Success
+	case core.ToneWarning:
+		return s.Warning
+	case core.ToneError:
+		return s.Error
+	default:
+		return s.Muted
+	}
+}
@@ -2,10 +2,16 @@
 package base
 
 import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/tui/components"
 )
 
 // =============================================================================
@@ -31,14 +37,70 @@ type TableView struct {
 	Styles     Styles
 	Resources  []core.Resource
 	Message    string
+
+	// Rows are virtualized: the table only holds the visible window, built
+	// on demand from rowAt, so large listings stay cheap to navigate
+	rowCount int
+	rowAt    func(i int) table.Row
+	cursor   int // Absolute index of the selected row
+	offset   int // Absolute index of the first row in the window
+	// Indexes in ColumnDefs of the columns wide enough to be shown
+	shown []int
+
+	// Rows may be sorted, see sort.go: order maps rows to Resources indexes
+	sortBy     string // "", SortBySeverity or a column title
+	sortDesc   bool
+	order      []int
+	source     func(i int) table.Row // Row of resource i, before sorting
+	cells      func(i int) Row       // Typed row of resource i, when known
+	selectedID string                // Resource under the cursor, kept across sorts
+	// ID or ARN of a resource to select once it is listed, from a restored
+	// state or a link from another view
+	restoreSelected string
+
+	// Overlays shown in place of the table
+	form   *components.Form
+	detail *components.Detail
+	// Details being fetched for the open panel, see lazydetail.go
+	lazy *lazyDetail
+
+	// Action waiting for the operator to confirm it, see confirm.go
+	pendingConfirm *core.ConfirmationError
+	// Resource whose note is being edited, see notes.go
+	noteTarget *core.Resource
+	// Resource the export form is open for, see export.go
+	exportTarget *core.Resource
+	// Open metrics panel, shown in the detail panel, see metrics.go
+	metrics *metricsPane
+	// Open menu of the service's actions, see actionmenu.go
+	menu *actionMenu
+	// Action chosen from the menu, waiting for its parameters
+	menuAction *menuAction
+	// Runbook being chosen or run, see runbooks.go
+	runbook *runbookState
+
+	// Levels shown below the top one, and what each level above kept, see
+	// drilldown.go
+	levels []DrillLevel
+	drill  []drillFrame
+
+	// Shards the last listing failed to list, shown as a banner over the
+	// table, see partial.go
+	partial *core.PartialError
+
+	// Widgets shown on the summary line, in order; nil shows the view's
+	// defaults, see summary.go
+	summaryLayout []string
+
+	// Stops the action running, see batch.go; batchRunning is set once it
+	// reports progress as a batch
+	stopBatch    context.CancelFunc
+	batchRunning bool
 }
 
 // NewTableView creates a new table view with responsive columns.
 func NewTableView(name, shortcut, serviceName string, columnDefs []ColumnDef) *TableView {
-	columns := CalculateColumnWidths(columnDefs, 100)
-
 	t := table.New(
-		table.WithColumns(columns),
 		table.WithFocused(true),
 		table.WithHeight(10),
 	)
@@ -46,12 +108,14 @@ func NewTableView(name, shortcut, serviceName string, columnDefs []ColumnDef) *T
 	styles := DefaultStyles()
 	t.SetStyles(styles.Table)
 
-	return &TableView{
+	tv := &TableView{
 		View:       NewView(name, shortcut, serviceName),
 		Table:      t,
 		ColumnDefs: columnDefs,
 		Styles:     styles,
 	}
+	tv.setColumns(100)
+	return tv
 }
 
 // HandleWindowSize updates table dimensions based on available space.
@@ -66,55 +130,511 @@ func (tv *TableView) HandleWindowSize(_ tea.WindowSizeMsg) {
 
 	// Table gets all space minus non-table lines
 	tableHeight := height - viewNonTableLines
+	if tv.partial != nil {
+		tableHeight-- // Banner
+	}
 	if tableHeight < minTableHeight {
 		tableHeight = minTableHeight
 	}
 	tv.Table.SetHeight(tableHeight)
 
 	// Update column widths
-	columns := CalculateColumnWidths(tv.ColumnDefs, width)
+	tv.setColumns(width)
+	tv.renderWindow()
+}
+
+// setColumns lays out ColumnDefs at width. Rows are built with a cell for
+// every column, and the table drops the cells of hidden ones, see
+// renderWindow; its rows are cleared first since they may hold more cells
+// than the new columns.
+func (tv *TableView) setColumns(width int) {
+	columns, shown := layoutColumns(tv.ColumnDefs, width)
+	tv.shown = shown
+	tv.Table.SetRows(nil)
 	tv.Table.SetColumns(columns)
 }
 
-// UpdateTable passes a message to the table and returns the command.
+// UpdateTable handles navigation keys for the table and returns the command.
 func (tv *TableView) UpdateTable(msg tea.Msg) tea.Cmd {
-	var cmd tea.Cmd
-	tv.Table, cmd = tv.Table.Update(msg)
-	return cmd
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !tv.Table.Focused() {
+		return nil
+	}
+
+	// Moving by hand wins over a selection still waiting to be restored
+	tv.restoreSelected = ""
+
+	keys := tv.Table.KeyMap
+	page := tv.Table.Height()
+	switch {
+	case key.Matches(keyMsg, keys.LineUp):
+		tv.SetCursor(tv.cursor - 1)
+	case key.Matches(keyMsg, keys.LineDown):
+		tv.SetCursor(tv.cursor + 1)
+	case key.Matches(keyMsg, keys.PageUp):
+		tv.SetCursor(tv.cursor - page)
+	case key.Matches(keyMsg, keys.PageDown):
+		tv.SetCursor(tv.cursor + page)
+	case key.Matches(keyMsg, keys.HalfPageUp):
+		tv.SetCursor(tv.cursor - page/2)
+	case key.Matches(keyMsg, keys.HalfPageDown):
+		tv.SetCursor(tv.cursor + page/2)
+	case key.Matches(keyMsg, keys.GotoTop):
+		tv.SetCursor(0)
+	case key.Matches(keyMsg, keys.GotoBottom):
+		tv.SetCursor(tv.rowCount - 1)
+	}
+	return nil
 }
 
 // SetRows sets the table rows.
 func (tv *TableView) SetRows(rows []table.Row) {
-	tv.Table.SetRows(rows)
+	tv.SetRowSource(len(rows), func(i int) table.Row { return rows[i] })
+}
+
+// SetRowSource sets the number of rows and how to build a row. Only the rows
+// of the visible window are built, each time the window changes.
+func (tv *TableView) SetRowSource(count int, rowAt func(i int) table.Row) {
+	tv.setSource(count, rowAt, nil)
+}
+
+// SetCellSource sets the number of rows and how to build the typed row of
+// resource i. Rows can then be sorted by any column; cells are formatted
+// only for the visible window.
+func (tv *TableView) SetCellSource(count int, cellsAt func(i int) Row) {
+	tv.setSource(count, func(i int) table.Row { return cellsAt(i).Strings() }, cellsAt)
+}
+
+func (tv *TableView) setSource(count int, rowAt func(i int) table.Row, cells func(i int) Row) {
+	tv.rowCount = count
+	tv.source = rowAt
+	tv.cells = cells
+	tv.order = tv.sortOrder(count)
+	tv.rowAt = func(row int) table.Row {
+		return rowAt(tv.ResourceIndex(row))
+	}
+
+	// Follow the selected resource when sorting moved it
+	target := tv.restoreSelected
+	if target == "" && tv.order != nil {
+		target = tv.selectedID
+	}
+	if target != "" {
+		for row := 0; row < count && row < len(tv.Resources); row++ {
+			if r := tv.Resources[tv.ResourceIndex(row)]; r.ID == target || (r.ARN != "" && r.ARN == target) {
+				tv.cursor = row
+				tv.restoreSelected = ""
+				break
+			}
+		}
+	}
+	tv.SetCursor(tv.cursor)
+}
+
+// RefreshRow rebuilds the row of resource i if it is visible. When sorted,
+// the rows are sorted again since its values may have changed.
+func (tv *TableView) RefreshRow(i int) {
+	if tv.order != nil {
+		tv.setSource(tv.rowCount, tv.source, tv.cells)
+		return
+	}
+	if i >= tv.offset && i < tv.offset+tv.Table.Height() {
+		tv.renderWindow()
+	}
+}
+
+// ResourceIndex returns the index in Resources of the resource shown in a
+// row, which differs from the row when sorted.
+func (tv *TableView) ResourceIndex(row int) int {
+	if tv.order != nil && row >= 0 && row < len(tv.order) {
+		return tv.order[row]
+	}
+	return row
+}
+
+// RowCount returns the number of rows, visible or not.
+func (tv *TableView) RowCount() int {
+	return tv.rowCount
 }
 
 // Cursor returns the current cursor position.
 func (tv *TableView) Cursor() int {
-	return tv.Table.Cursor()
+	return tv.cursor
+}
+
+// SetCursor moves the cursor to row n, clamped to the available rows, and
+// scrolls the window to keep it visible.
+func (tv *TableView) SetCursor(n int) {
+	tv.cursor = max(0, min(n, tv.rowCount-1))
+	if r := tv.GetSelectedResource(); r != nil {
+		tv.selectedID = r.ID
+	}
+	tv.renderWindow()
+}
+
+// renderWindow builds the rows of the visible window and hands them to the
+// table.
+func (tv *TableView) renderWindow() {
+	height := max(1, tv.Table.Height())
+
+	if tv.cursor < tv.offset {
+		tv.offset = tv.cursor
+	} else if tv.cursor >= tv.offset+height {
+		tv.offset = tv.cursor - height + 1
+	}
+	tv.offset = max(0, min(tv.offset, tv.rowCount-height))
+
+	end := min(tv.rowCount, tv.offset+height)
+	rows := make([]table.Row, 0, end-tv.offset)
+	for i := tv.offset; i < end; i++ {
+		rows = append(rows, tv.shownCells(tv.rowAt(i)))
+	}
+	tv.Table.SetRows(rows)
+	tv.Table.SetCursor(tv.cursor - tv.offset)
+}
+
+// shownCells drops the cells of the columns hidden for lack of width.
+func (tv *TableView) shownCells(row table.Row) table.Row {
+	if len(tv.shown) == len(row) {
+		return row
+	}
+	cells := make(table.Row, len(tv.shown))
+	for i, column := range tv.shown {
+		if column < len(row) {
+			cells[i] = row[column]
+		}
+	}
+	return cells
 }
 
 // GetSelectedResource returns the currently selected resource.
 func (tv *TableView) GetSelectedResource() *core.Resource {
-	cursor := tv.Table.Cursor()
-	if cursor >= 0 && cursor < len(tv.Resources) {
-		return &tv.Resources[cursor]
+	if i := tv.SelectedIndex(); i >= 0 {
+		return &tv.Resources[i]
 	}
 	return nil
 }
 
+// SelectedIndex returns the index in Resources of the selected resource, or
+// -1 when there is none.
+func (tv *TableView) SelectedIndex() int {
+	if tv.cursor < 0 || tv.cursor >= tv.rowCount {
+		return -1
+	}
+	i := tv.ResourceIndex(tv.cursor)
+	if i >= len(tv.Resources) {
+		return -1
+	}
+	return i
+}
+
 // SetMessage sets the status message.
 func (tv *TableView) SetMessage(msg string) {
 	tv.Message = msg
 }
 
-// Reset clears the view data, forcing a reload on next Init.
+// Reset clears the view data, forcing a reload on next Init. Drill-down
+// views come back to their top level.
 func (tv *TableView) Reset() {
+	for tv.DrillUp() {
+	}
 	tv.Resources = nil
 	tv.Message = ""
+	tv.partial = nil
 	tv.SetRows(nil)
 }
 
+// Badge reports the resource count, how many resources have issues by their
+// highest severity, and their estimated monthly spend.
+func (tv *TableView) Badge() core.Badge {
+	badge := core.Badge{
+		Count:      len(tv.Resources),
+		Severities: make(map[core.Severity]int),
+	}
+	for i := range tv.Resources {
+		if s := tv.Resources[i].Severity(); s != core.SeverityNone {
+			badge.Severities[s]++
+		}
+		if monthly, ok := estimate.MonthlyCost(tv.Resources[i]); ok {
+			badge.Spend += monthly
+		}
+	}
+	return badge
+}
+
+// SelectResource implements core.ResourceSelector. A resource not listed yet
+// is selected when a listing contains it.
+func (tv *TableView) SelectResource(ref string) {
+	tv.restoreSelected = ref
+	if tv.source != nil {
+		tv.setSource(tv.rowCount, tv.source, tv.cells)
+	}
+}
+
+// SaveState implements core.StatefulView.
+func (tv *TableView) SaveState() core.ViewState {
+	state := core.ViewState{Selected: tv.restoreSelected}
+	if r := tv.GetSelectedResource(); r != nil {
+		state.Selected = r.ID
+	}
+	if tv.sortBy != "" {
+		state.SortBy = tv.sortBy
+		state.SortOrder = core.SortOrderAsc
+		if tv.sortDesc {
+			state.SortOrder = core.SortOrderDesc
+		}
+	}
+	return state
+}
+
+// RestoreState implements core.StatefulView. The saved resource is selected
+// when a listing contains it.
+func (tv *TableView) RestoreState(state core.ViewState) {
+	tv.restoreSelected = state.Selected
+	tv.sortBy = state.SortBy
+	tv.sortDesc = state.SortOrder == core.SortOrderDesc
+}
+
 // TableViewString returns the rendered table.
 func (tv *TableView) TableViewString() string {
 	return tv.Table.View()
 }
+
+// =============================================================================
+// Overlays
+// =============================================================================
+
+// OpenForm shows a parameter form in place of the table.
+func (tv *TableView) OpenForm(form *components.Form) tea.Cmd {
+	tv.detail = nil
+	tv.lazy = nil
+	tv.metrics = nil
+	tv.form = form
+	form.SetWidth(tv.Width())
+	return form.Init()
+}
+
+// OpenDetail shows a scrollable detail panel in place of the table.
+func (tv *TableView) OpenDetail(title, content string) {
+	tv.form = nil
+	tv.metrics = nil
+	tv.lazy = nil
+	tv.detail = components.NewDetail(title, tv.withNote(content), tv.Width(), tv.overlayHeight())
+}
+
+// CloseOverlay dismisses any open form or detail panel.
+func (tv *TableView) CloseOverlay() {
+	tv.form = nil
+	tv.detail = nil
+	tv.lazy = nil
+	tv.pendingConfirm = nil
+	tv.noteTarget = nil
+	tv.exportTarget = nil
+	tv.metrics = nil
+	tv.menu = nil
+	tv.menuAction = nil
+}
+
+// CapturingInput reports whether an overlay currently owns keyboard input.
+func (tv *TableView) CapturingInput() bool {
+	return tv.form != nil || tv.detail != nil || tv.menu != nil
+}
+
+// ShowingDetail reports whether the detail panel is open.
+func (tv *TableView) ShowingDetail() bool {
+	return tv.detail != nil
+}
+
+// HandleOverlay routes input to an open overlay and opens the overlays
+// shared by every table view: policy confirmations, resource notes, metric
+// charts, console links, exports, sorting, the action menu, runbooks and the
+// errors of a partial listing. Esc leaves a drill-down level.
+// It returns true when the message was consumed and should not reach the table.
+func (tv *TableView) HandleOverlay(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case components.FormResultMsg:
+		if tv.form != nil && tv.form.ID() == msg.ID {
+			tv.form = nil
+		}
+		if msg.ID == confirmFormID {
+			return true, tv.resolveConfirmation(msg)
+		}
+		if msg.ID == noteFormID {
+			tv.saveNote(msg)
+			return true, nil
+		}
+		if msg.ID == exportFormID {
+			return true, tv.startExport(msg)
+		}
+		if msg.ID == sortFormID {
+			tv.applySortForm(msg)
+			return true, nil
+		}
+		switch msg.ID {
+		case actionParamsFormID:
+			return true, tv.runChosenAction(msg)
+		case runbookFormID:
+			return true, tv.startRunbook(msg)
+		case runbookStepFormID:
+			return true, tv.runRunbookStep(msg)
+		case runbookConfirmFormID:
+			return true, tv.resolveRunbookConfirmation(msg)
+		}
+		return false, nil
+	case components.SelectorResultMsg:
+		if tv.menu == nil {
+			return false, nil
+		}
+		return true, tv.chooseAction(msg)
+	case BatchProgressMsg:
+		if msg.owner != tv {
+			return false, nil
+		}
+		return true, tv.showBatchProgress(msg)
+	case ActionResultMsg:
+		tv.stopBatch, tv.batchRunning = nil, false
+		var confirm *core.ConfirmationError
+		if errors.As(msg.Error, &confirm) && confirm.Request.Service == tv.ServiceName() {
+			return true, tv.requestConfirmation(confirm)
+		}
+	case runbookStepMsg:
+		if msg.owner == tv {
+			return true, tv.runbookStepDone(msg)
+		}
+		return true, nil
+	case metricsLoadedMsg:
+		if msg.owner == tv {
+			tv.applyMetrics(msg)
+		}
+		return true, nil
+	case exportedMsg:
+		if msg.owner == tv {
+			tv.showExport(msg)
+		}
+		return true, nil
+	case detailLoadedMsg:
+		if msg.owner == tv {
+			tv.applyDetail(msg)
+		}
+		return true, nil
+	case components.DetailClosedMsg:
+		tv.detail = nil
+		tv.metrics = nil
+		tv.lazy = nil
+		return true, nil
+	case tea.KeyMsg:
+		if tv.form != nil {
+			var cmd tea.Cmd
+			tv.form, cmd = tv.form.Update(msg)
+			return true, cmd
+		}
+		if tv.menu != nil {
+			var cmd tea.Cmd
+			tv.menu.selector, cmd = tv.menu.selector.Update(msg)
+			return true, cmd
+		}
+		if tv.detail != nil && tv.metrics != nil {
+			if handled, cmd := tv.updateMetrics(msg); handled {
+				return true, cmd
+			}
+		}
+		if tv.detail != nil {
+			var cmd tea.Cmd
+			tv.detail, cmd = tv.detail.Update(msg)
+			return true, cmd
+		}
+		if msg.String() == "esc" && tv.stopRunningBatch() {
+			return true, nil
+		}
+		if msg.String() == "esc" && tv.DrillUp() {
+			return true, nil
+		}
+		if msg.String() == "n" && noteStore != nil {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openNoteForm(r)
+			}
+		}
+		if msg.String() == "!" && tv.partial != nil {
+			tv.openPartialDetail()
+			return true, nil
+		}
+		if tv.opensActionMenu(msg.String()) {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openActionMenu(r)
+			}
+		}
+		if msg.String() == "W" && consoleRegion != nil {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openInConsole(r)
+			}
+		}
+		if msg.String() == exportKey {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openExportForm(r)
+			}
+		}
+		if msg.String() == runbookKey && len(runbooks) > 0 {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openRunbookForm(r)
+			}
+		}
+		if msg.String() == "M" && metricsSource != nil {
+			if r := tv.GetSelectedResource(); r != nil {
+				return true, tv.openMetrics(r)
+			}
+		}
+		switch msg.String() {
+		case "o":
+			tv.toggleSeveritySort()
+			return true, nil
+		case "O":
+			return true, tv.openSortForm()
+		}
+	case tea.WindowSizeMsg:
+		if tv.form != nil {
+			tv.form.SetWidth(tv.Width())
+		}
+		if tv.detail != nil {
+			tv.detail.SetDimensions(tv.Width(), tv.overlayHeight())
+			tv.renderMetrics()
+		}
+		if tv.menu != nil {
+			tv.menu.selector.SetDimensions(tv.Width(), tv.overlayHeight())
+		}
+	}
+	return false, nil
+}
+
+// OverlayView renders the open overlay, if any.
+func (tv *TableView) OverlayView() (string, bool) {
+	if tv.form != nil {
+		return tv.form.View(), true
+	}
+	if tv.detail != nil {
+		return tv.detail.View(), true
+	}
+	if tv.menu != nil {
+		return tv.menu.selector.View(), true
+	}
+	return "", false
+}
+
+// ContentView renders the overlay if one is open, otherwise the table,
+// under a banner when the listing is partial.
+func (tv *TableView) ContentView() string {
+	if overlay, ok := tv.OverlayView(); ok {
+		return overlay
+	}
+	if tv.partial != nil {
+		return tv.partialBanner() + "\n" + tv.TableViewString()
+	}
+	return tv.TableViewString()
+}
+
+func (tv *TableView) overlayHeight() int {
+	h := tv.Height() - viewNonTableLines + 2
+	if h < minTableHeight {
+		h = minTableHeight
+	}
+	return h
+}
@@ -0,0 +1,152 @@
+package base
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Benchmarks for the table every view renders. Run them with `make bench`
+// and compare against the previous release with benchstat.
+
+// benchRows is the size of the listings benchmarked, about what a large
+// account returns for EC2 or S3.
+const benchRows = 10000
+
+var benchColumns = []ColumnDef{
+	{Title: "ID", MinWidth: 19, MaxWidth: 21, Weight: 0.5, Priority: 0},
+	{Title: "Name", MinWidth: 10, MaxWidth: 60, Weight: 2.0, Priority: 0},
+	{Title: "State", MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+	{Title: "Type", MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	{Title: "AZ", MinWidth: 11, MaxWidth: 14, Weight: 0.3, Priority: 3},
+	{Title: "Private IP", MinWidth: 13, MaxWidth: 16, Weight: 0.3, Priority: 2},
+	{Title: "Age", MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+	{Title: "Est. $/mo", MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 4},
+	{Title: "Owner", MinWidth: 10, MaxWidth: 30, Weight: 0.6, Priority: 5},
+	{Title: "Severity", MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+}
+
+func benchResources(n int) []core.Resource {
+	created := time.Now().Add(-90 * 24 * time.Hour)
+	resources := make([]core.Resource, n)
+	for i := range resources {
+		at := created.Add(time.Duration(i) * time.Minute)
+		r := core.Resource{
+			ID:        fmt.Sprintf("i-%017x", i),
+			Name:      fmt.Sprintf("web-%05d", i),
+			Type:      "ec2:instance",
+			State:     "running",
+			CreatedAt: &at,
+			Metadata: map[string]any{
+				"instance_type": "t3.medium",
+				"az":            "us-east-1a",
+				"private_ip":    fmt.Sprintf("10.0.%d.%d", i/256%256, i%256),
+			},
+		}
+		switch i % 7 {
+		case 0:
+			r.AddIssue(core.SeverityLow, "Idle for 14 days")
+		case 3:
+			r.AddIssue(core.SeverityHigh, "SSH open to the internet")
+		}
+		resources[i] = r
+	}
+	return resources
+}
+
+func benchRow(r core.Resource) Row {
+	return Row{
+		TextCell(r.ID),
+		TextCell(TruncateString(r.Name, 60)),
+		LazyCell(r.State, func() string { return FormatState(r.State) }),
+		TextCell(r.GetMetadataString("instance_type")),
+		TextCell(r.GetMetadataString("az")),
+		TextCell(r.GetMetadataString("private_ip")),
+		AgeCell(r),
+		CostCell(r),
+		LazyCell(r.GetMetadataString("owner"), func() string { return FormatOwner(r) }),
+		SeverityCell(r),
+	}
+}
+
+// newBenchTable returns a table view sized like a full-screen terminal and
+// holding n resources.
+func newBenchTable(n int) *TableView {
+	tv := NewTableView("Bench", "", "bench", benchColumns)
+	tv.SetDimensions(200, 50)
+	tv.HandleWindowSize(tea.WindowSizeMsg{Width: 200, Height: 50})
+	tv.Resources = benchResources(n)
+	tv.SetCellSource(len(tv.Resources), func(i int) Row { return benchRow(tv.Resources[i]) })
+	return tv
+}
+
+func BenchmarkCalculateColumnWidths(b *testing.B) {
+	for _, width := range []int{80, 120, 200, 320} {
+		b.Run(fmt.Sprintf("width=%d", width), func(b *testing.B) {
+			for b.Loop() {
+				CalculateColumnWidths(benchColumns, width)
+			}
+		})
+	}
+}
+
+func BenchmarkTableViewSetCellSource(b *testing.B) {
+	tv := newBenchTable(benchRows)
+	b.ResetTimer()
+	for b.Loop() {
+		tv.SetCellSource(len(tv.Resources), func(i int) Row { return benchRow(tv.Resources[i]) })
+	}
+}
+
+func BenchmarkTableViewRender(b *testing.B) {
+	tv := newBenchTable(benchRows)
+	b.ResetTimer()
+	for b.Loop() {
+		_ = tv.ContentView()
+	}
+}
+
+func BenchmarkTableViewScroll(b *testing.B) {
+	tv := newBenchTable(benchRows)
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	b.ResetTimer()
+	for b.Loop() {
+		if tv.Cursor() == tv.RowCount()-1 {
+			tv.SetCursor(0)
+		}
+		tv.UpdateTable(down)
+		_ = tv.ContentView()
+	}
+}
+
+func BenchmarkTableViewResize(b *testing.B) {
+	tv := newBenchTable(benchRows)
+	widths := []int{80, 120, 200, 320}
+	b.ResetTimer()
+	i := 0
+	for b.Loop() {
+		width := widths[i%len(widths)]
+		tv.SetDimensions(width, 50)
+		tv.HandleWindowSize(tea.WindowSizeMsg{Width: width, Height: 50})
+		_ = tv.ContentView()
+		i++
+	}
+}
+
+func BenchmarkTableViewSort(b *testing.B) {
+	for _, sortBy := range []string{SortBySeverity, "Name", "Age"} {
+		b.Run(sortBy, func(b *testing.B) {
+			tv := newBenchTable(benchRows)
+			b.ResetTimer()
+			desc := false
+			for b.Loop() {
+				tv.SetSort(sortBy, desc)
+				desc = !desc
+			}
+		})
+	}
+}
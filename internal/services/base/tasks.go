@@ -0,0 +1,95 @@
+package base
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// closeTimeout bounds how long Close waits for cancelled work to return.
+const closeTimeout = 2 * time.Second
+
+// =============================================================================
+// Background Work
+// =============================================================================
+
+// tasks owns the background work of a view. Work is started through Go and
+// belongs to the current generation: Restart cancels it before a new listing
+// and Close cancels it for good, waiting for work in progress to return.
+//
+// Commands of a cancelled generation that Bubble Tea has not run yet do
+// nothing, so no AWS call starts after its listing was replaced.
+type tasks struct {
+	mu      sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	gen     int
+	closed  bool
+	running sync.WaitGroup
+}
+
+func newTasks() *tasks {
+	t := &tasks{}
+	t.ctx, t.cancel = context.WithCancel(context.Background())
+	return t
+}
+
+// Restart cancels the current generation and starts the next one.
+func (t *tasks) Restart() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cancel()
+	t.gen++
+	if !t.closed {
+		t.ctx, t.cancel = context.WithCancel(context.Background())
+	}
+}
+
+// Gen returns the current generation.
+func (t *tasks) Gen() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.gen
+}
+
+// Go returns a command running fn in the current generation. The command
+// returns nil without calling fn once the generation is cancelled.
+func (t *tasks) Go(fn func(ctx context.Context, gen int) tea.Msg) tea.Cmd {
+	t.mu.Lock()
+	ctx, gen := t.ctx, t.gen
+	t.mu.Unlock()
+
+	return func() tea.Msg {
+		t.mu.Lock()
+		if ctx.Err() != nil {
+			t.mu.Unlock()
+			return nil
+		}
+		t.running.Add(1)
+		t.mu.Unlock()
+		defer t.running.Done()
+
+		return fn(ctx, gen)
+	}
+}
+
+// Close cancels all work and waits, up to closeTimeout, for it to return.
+// Work started afterwards does nothing.
+func (t *tasks) Close() {
+	t.mu.Lock()
+	t.closed = true
+	t.cancel()
+	t.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		t.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(closeTimeout):
+	}
+}
@@ -3,12 +3,15 @@ package base
 
 import (
 	"context"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
 )
 
 // =============================================================================
@@ -46,6 +49,12 @@ func (v *View) Shortcut() string {
 	return v.shortcut
 }
 
+// SetShortcut changes the keyboard shortcut. Empty means the view is only
+// reachable through the view chooser.
+func (v *View) SetShortcut(shortcut string) {
+	v.shortcut = shortcut
+}
+
 // ServiceName returns the associated service name.
 func (v *View) ServiceName() string {
 	return v.serviceName
@@ -207,6 +216,13 @@ type ActionResultMsg struct {
 // RefreshMsg triggers a refresh of the current view.
 type RefreshMsg struct{}
 
+// FocusResourceMsg asks the application to show a resource in the view of
+// the service that lists it.
+type FocusResourceMsg struct {
+	Service    string
+	ResourceID string // ID or ARN of the resource
+}
+
 // =============================================================================
 // Common Commands
 // =============================================================================
@@ -226,7 +242,7 @@ func LoadResourcesCmd(viewName string, lister core.ResourceLister) tea.Cmd {
 // ExecuteActionCmd creates a command to execute an action.
 func ExecuteActionCmd(executor core.ActionExecutor, action, resourceID string, params map[string]any) tea.Cmd {
 	return func() tea.Msg {
-		result, err := executor.Execute(context.Background(), action, resourceID, params)
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
 		return ActionResultMsg{
 			Action: action,
 			Result: result,
@@ -239,6 +255,34 @@ func ExecuteActionCmd(executor core.ActionExecutor, action, resourceID string, p
 // Helper Functions
 // =============================================================================
 
+// FindAction looks up an action definition on a service by name.
+func FindAction(service core.AWSService, name string) (core.Action, bool) {
+	executor, ok := service.(core.ActionExecutor)
+	if !ok {
+		return core.Action{}, false
+	}
+	for _, action := range executor.Actions() {
+		if action.Name == name {
+			return localizeAction(action), true
+		}
+	}
+	return core.Action{}, false
+}
+
+// localizeAction translates the descriptions shown in action forms.
+func localizeAction(action core.Action) core.Action {
+	action.Description = i18n.T(action.Description)
+	params := make([]core.ActionParameter, len(action.Parameters))
+	for i, p := range action.Parameters {
+		if p.Description != "" {
+			p.Description = i18n.T(p.Description)
+		}
+		params[i] = p
+	}
+	action.Parameters = params
+	return action
+}
+
 // StateIcon returns an icon for a resource state.
 func StateIcon(state string) string {
 	switch state {
@@ -262,6 +306,55 @@ func FormatState(state string) string {
 	return StateIcon(state) + " " + state
 }
 
+// FormatOwner returns the principal that created a resource, or "-" when
+// it is unknown.
+func FormatOwner(r core.Resource) string {
+	if owner := r.GetMetadataString("owner"); owner != "" {
+		return TruncateString(owner, 30)
+	}
+	return "-"
+}
+
+// FormatControls returns the compliance controls a resource fails, or "-"
+// when it passes every check.
+func FormatControls(r core.Resource) string {
+	controls, _ := r.Metadata["compliance_controls"].([]string)
+	if len(controls) == 0 {
+		return "-"
+	}
+	return "🔴 " + strings.Join(controls, ", ")
+}
+
+// FormatIaC returns the tool managing a resource, or "-" when it is not
+// managed by infrastructure as code.
+func FormatIaC(r core.Resource) string {
+	if managed, _ := r.Metadata["iac_managed"].(bool); managed {
+		return r.GetMetadataString("iac_tool")
+	}
+	return "-"
+}
+
+// FormatAge returns the age of a resource, or "-" when its creation time is
+// unknown.
+func FormatAge(r core.Resource) string {
+	if age := r.GetMetadataString(estimate.AgeKey); age != "" {
+		return age
+	}
+	return "-"
+}
+
+// FormatCost returns the estimated monthly cost of a resource: "..." until
+// it is analyzed, then "-" when it cannot be estimated.
+func FormatCost(r core.Resource) string {
+	if monthly, ok := estimate.MonthlyCost(r); ok {
+		return estimate.FormatCost(monthly)
+	}
+	if analyzed, ok := r.Metadata["analyzed"].(bool); ok && !analyzed {
+		return "..."
+	}
+	return "-"
+}
+
 // TruncateString truncates a string to a maximum length.
 func TruncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -288,6 +381,14 @@ type ColumnDef struct {
 
 // CalculateColumnWidths calculates responsive column widths based on available space.
 func CalculateColumnWidths(defs []ColumnDef, availableWidth int) []table.Column {
+	columns, _ := layoutColumns(defs, availableWidth)
+	return columns
+}
+
+// layoutColumns calculates the columns shown at availableWidth, and the
+// index in defs of each, since low priority columns are hidden when they
+// don't fit.
+func layoutColumns(defs []ColumnDef, availableWidth int) ([]table.Column, []int) {
 	// Account for table borders and padding (roughly 4 chars)
 	availableWidth -= 4
 
@@ -330,6 +431,7 @@ func CalculateColumnWidths(defs []ColumnDef, availableWidth int) []table.Column
 
 	// Sort back to original order
 	result := make([]table.Column, 0, len(visibleDefs))
+	shown := make([]int, 0, len(visibleDefs))
 	for i := range defs {
 		for _, vd := range visibleDefs {
 			if vd.index == i {
@@ -337,6 +439,7 @@ func CalculateColumnWidths(defs []ColumnDef, availableWidth int) []table.Column
 					Title: vd.def.Title,
 					Width: vd.def.MinWidth,
 				})
+				shown = append(shown, i)
 				break
 			}
 		}
@@ -375,7 +478,7 @@ func CalculateColumnWidths(defs []ColumnDef, availableWidth int) []table.Column
 		}
 	}
 
-	return result
+	return result, shown
 }
 
 // MinTableHeight returns the minimum height for a table.
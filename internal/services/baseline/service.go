@@ -0,0 +1,795 @@
+// Package baseline checks account-wide security settings for the a9s
+// application: S3 Block Public Access, EBS encryption by default, the IAM
+// password policy, root MFA and the default VPC. Checks with a safe fix can
+// be remediated from the view.
+package baseline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	s3controltypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Check IDs, used as resource IDs.
+const (
+	CheckS3BlockPublicAccess = "s3_bpa"
+	CheckEBSEncryption       = "ebs_encryption"
+	CheckPasswordPolicy      = "password_policy"
+	CheckRootMFA             = "root_mfa"
+	CheckDefaultVPC          = "default_vpc"
+)
+
+// Check statuses.
+const (
+	StatusPass    = "pass"
+	StatusFail    = "fail"
+	StatusUnknown = "unknown"
+)
+
+// Password policy baseline, after the CIS AWS Foundations Benchmark.
+const (
+	minPasswordLength = 14
+	minPasswordReuse  = 24
+)
+
+// Service checks account-wide settings against a security baseline.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+
+	ec2Client       EC2API
+	iamClient       IAMAPI
+	stsClient       STSAPI
+	s3controlClient S3ControlAPI
+}
+
+// Option configures the baseline service.
+type Option func(*Service)
+
+// WithEC2Client sets a custom EC2 client (for testing).
+func WithEC2Client(client EC2API) Option {
+	return func(s *Service) {
+		s.ec2Client = client
+	}
+}
+
+// WithIAMClient sets a custom IAM client (for testing).
+func WithIAMClient(client IAMAPI) Option {
+	return func(s *Service) {
+		s.iamClient = client
+	}
+}
+
+// WithSTSClient sets a custom STS client (for testing).
+func WithSTSClient(client STSAPI) Option {
+	return func(s *Service) {
+		s.stsClient = client
+	}
+}
+
+// WithS3ControlClient sets a custom S3 Control client (for testing).
+func WithS3ControlClient(client S3ControlAPI) Option {
+	return func(s *Service) {
+		s.s3controlClient = client
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	GetEbsEncryptionByDefault(ctx context.Context, params *ec2.GetEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error)
+	EnableEbsEncryptionByDefault(ctx context.Context, params *ec2.EnableEbsEncryptionByDefaultInput, optFns ...func(*ec2.Options)) (*ec2.EnableEbsEncryptionByDefaultOutput, error)
+	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+}
+
+// IAMAPI defines the IAM client interface for mocking.
+type IAMAPI interface {
+	GetAccountPasswordPolicy(ctx context.Context, params *iam.GetAccountPasswordPolicyInput, optFns ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+	UpdateAccountPasswordPolicy(ctx context.Context, params *iam.UpdateAccountPasswordPolicyInput, optFns ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error)
+	GetAccountSummary(ctx context.Context, params *iam.GetAccountSummaryInput, optFns ...func(*iam.Options)) (*iam.GetAccountSummaryOutput, error)
+}
+
+// STSAPI defines the STS client interface for mocking.
+type STSAPI interface {
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// S3ControlAPI defines the S3 Control client interface for mocking.
+type S3ControlAPI interface {
+	GetPublicAccessBlock(ctx context.Context, params *s3control.GetPublicAccessBlockInput, optFns ...func(*s3control.Options)) (*s3control.GetPublicAccessBlockOutput, error)
+	PutPublicAccessBlock(ctx context.Context, params *s3control.PutPublicAccessBlockInput, optFns ...func(*s3control.Options)) (*s3control.PutPublicAccessBlockOutput, error)
+}
+
+// NewService creates a new baseline service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) ec2API() EC2API {
+	if s.ec2Client != nil {
+		return s.ec2Client
+	}
+	return s.factory.EC2Client()
+}
+
+func (s *Service) iamAPI() IAMAPI {
+	if s.iamClient != nil {
+		return s.iamClient
+	}
+	return s.factory.IAMClient()
+}
+
+func (s *Service) stsAPI() STSAPI {
+	if s.stsClient != nil {
+		return s.stsClient
+	}
+	return s.factory.STSClient()
+}
+
+func (s *Service) s3controlAPI() S3ControlAPI {
+	if s.s3controlClient != nil {
+		return s.s3controlClient
+	}
+	return s3control.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region regional checks run in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "baseline"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Account Baselines"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "clipboard-check"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	if _, err := s.stsAPI().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		return core.NewServiceError("baseline", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// check is an account-wide setting compared with the baseline.
+type check struct {
+	id       string
+	name     string
+	regional bool          // Set per region rather than for the whole account
+	severity core.Severity // Severity of a failure
+	expected string
+	run      func(context.Context) (outcome, error)
+	// fix applies the remediation, nil when there is no safe one. manual
+	// describes the steps to take by hand instead.
+	fix    func(context.Context) (string, error)
+	change string // What fix changes, shown when confirming
+	manual string
+}
+
+// outcome is what a check found.
+type outcome struct {
+	pass    bool
+	current string
+	issue   string             // Why the check fails
+	failed  []compliance.Check // Built-in checks failed, mapped to controls
+	details map[string]any
+}
+
+// checks returns the baseline checks, in display order.
+func (s *Service) checks() []check {
+	return []check{
+		{
+			id:       CheckS3BlockPublicAccess,
+			name:     "S3 Block Public Access",
+			severity: core.SeverityHigh,
+			expected: "all four settings on",
+			run:      s.checkS3BlockPublicAccess,
+			fix:      s.fixS3BlockPublicAccess,
+			change:   "Turns on the four account-level S3 Block Public Access settings; buckets or access points relying on public ACLs or policies stop being public",
+		},
+		{
+			id:       CheckEBSEncryption,
+			name:     "EBS encryption by default",
+			regional: true,
+			severity: core.SeverityMedium,
+			expected: "enabled",
+			run:      s.checkEBSEncryption,
+			fix:      s.fixEBSEncryption,
+			change:   "Encrypts new EBS volumes and snapshot copies of the region with the default KMS key; existing volumes are unchanged",
+		},
+		{
+			id:       CheckPasswordPolicy,
+			name:     "IAM password policy",
+			severity: core.SeverityMedium,
+			expected: fmt.Sprintf("length >= %d, upper, lower, numbers, symbols, reuse >= %d", minPasswordLength, minPasswordReuse),
+			run:      s.checkPasswordPolicy,
+			fix:      s.fixPasswordPolicy,
+			change:   "Raises the account password policy to the baseline, keeping stricter settings; users are held to it at their next password change",
+		},
+		{
+			id:       CheckRootMFA,
+			name:     "Root account MFA",
+			severity: core.SeverityCritical,
+			expected: "enabled",
+			run:      s.checkRootMFA,
+			manual:   "Sign in as the root user and assign an MFA device under Security credentials",
+		},
+		{
+			id:       CheckDefaultVPC,
+			name:     "Default VPC",
+			regional: true,
+			severity: core.SeverityLow,
+			expected: "absent",
+			run:      s.checkDefaultVPC,
+			manual:   "Move or delete what runs in it, then delete the VPC from the VPC console, which also removes its subnets and internet gateway",
+		},
+	}
+}
+
+// find returns the check with the given ID.
+func (s *Service) find(id string) (check, bool) {
+	for _, c := range s.checks() {
+		if c.id == id {
+			return c, true
+		}
+	}
+	return check{}, false
+}
+
+// Scan is the outcome of the baseline checks.
+type Scan struct {
+	Resources   []core.Resource  // One per check, in display order
+	Unavailable map[string]error // Checks that could not run, by name
+}
+
+// Err reports the Unavailable checks as a core.PartialError, or nil when
+// there are none.
+func (sc Scan) Err() error {
+	return core.NewPartialError("checks", sc.Unavailable)
+}
+
+// List returns one resource per check, with a core.PartialError naming the
+// checks that could not run. It only fails outright when no check could
+// run.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	scan, err := s.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scan.Resources, scan.Err()
+}
+
+// Scan runs every check. A check that cannot run, for example for lack of
+// permissions, is listed with an unknown status and recorded in
+// Unavailable.
+func (s *Service) Scan(ctx context.Context) (Scan, error) {
+	checks := s.checks()
+	scan := Scan{Resources: make([]core.Resource, 0, len(checks)), Unavailable: make(map[string]error)}
+
+	var errs []error
+	for _, c := range checks {
+		out, err := c.run(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "check_"+c.id, err)
+			scan.Unavailable[c.name] = err
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+		}
+		scan.Resources = append(scan.Resources, s.toResource(c, out, err))
+	}
+	if len(errs) == len(checks) {
+		return Scan{}, core.NewServiceError("baseline", "list", errors.Join(errs...))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "baseline",
+		Count:        len(scan.Resources),
+	})
+
+	return scan, nil
+}
+
+// toResource turns a check's outcome into a resource, flagged when the
+// check fails.
+func (s *Service) toResource(c check, out outcome, err error) core.Resource {
+	scope := "account"
+	if c.regional {
+		scope = s.region()
+	}
+	remediation := "-"
+	switch {
+	case c.fix != nil:
+		remediation = "available"
+	case c.manual != "":
+		remediation = "manual"
+	}
+
+	r := core.Resource{
+		ID:     c.id,
+		Type:   "baseline:check",
+		Name:   c.name,
+		Region: s.region(),
+		State:  core.StateActive,
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"scope":       scope,
+			"expected":    c.expected,
+			"remediation": remediation,
+			"manual":      c.manual,
+		},
+	}
+	for k, v := range out.details {
+		r.Metadata[k] = v
+	}
+
+	switch {
+	case err != nil:
+		r.State = core.StateUnknown
+		r.Metadata["status"] = StatusUnknown
+		r.Metadata["current"] = "-"
+		r.AddIssue(core.SeverityInfo, "Could not check: "+err.Error())
+	case out.pass:
+		r.Metadata["status"] = StatusPass
+		r.Metadata["current"] = out.current
+	default:
+		r.State = core.StateError
+		r.Metadata["status"] = StatusFail
+		r.Metadata["current"] = out.current
+		r.AddIssue(c.severity, out.issue)
+	}
+	compliance.Apply(&r, out.failed)
+	return r
+}
+
+// CheckCompliance returns the built-in checks an account setting fails,
+// recorded when it was listed.
+func (s *Service) CheckCompliance(_ context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	return compliance.Failed(*resource), nil
+}
+
+// Status returns a check's status: pass, fail or unknown.
+func Status(r core.Resource) string {
+	return r.GetMetadataString("status")
+}
+
+// =============================================================================
+// Checks
+// =============================================================================
+
+// accountID returns the ID of the account the credentials belong to.
+func (s *Service) accountID(ctx context.Context) (string, error) {
+	out, err := s.stsAPI().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.Account), nil
+}
+
+// checkS3BlockPublicAccess passes when the four account-level Block Public
+// Access settings are on.
+func (s *Service) checkS3BlockPublicAccess(ctx context.Context) (outcome, error) {
+	account, err := s.accountID(ctx)
+	if err != nil {
+		return outcome{}, err
+	}
+	out, err := s.s3controlAPI().GetPublicAccessBlock(ctx, &s3control.GetPublicAccessBlockInput{AccountId: aws.String(account)})
+	var missing *s3controltypes.NoSuchPublicAccessBlockConfiguration
+	switch {
+	case errors.As(err, &missing):
+		return outcome{current: "not configured", issue: "Account-level S3 Block Public Access is not configured", failed: []compliance.Check{compliance.CheckS3AccountPublicAccess}}, nil
+	case err != nil:
+		return outcome{}, err
+	}
+
+	cfg := out.PublicAccessBlockConfiguration
+	if cfg == nil {
+		cfg = &s3controltypes.PublicAccessBlockConfiguration{}
+	}
+	settings := []struct {
+		name string
+		on   bool
+	}{
+		{"BlockPublicAcls", aws.ToBool(cfg.BlockPublicAcls)},
+		{"IgnorePublicAcls", aws.ToBool(cfg.IgnorePublicAcls)},
+		{"BlockPublicPolicy", aws.ToBool(cfg.BlockPublicPolicy)},
+		{"RestrictPublicBuckets", aws.ToBool(cfg.RestrictPublicBuckets)},
+	}
+	var off []string
+	details := map[string]any{}
+	for _, setting := range settings {
+		details[setting.name] = setting.on
+		if !setting.on {
+			off = append(off, setting.name)
+		}
+	}
+	if len(off) == 0 {
+		return outcome{pass: true, current: "all on", details: details}, nil
+	}
+	return outcome{
+		current: fmt.Sprintf("%d of 4 on", len(settings)-len(off)),
+		issue:   "Account-level S3 Block Public Access is off for " + strings.Join(off, ", "),
+		failed:  []compliance.Check{compliance.CheckS3AccountPublicAccess},
+		details: details,
+	}, nil
+}
+
+// checkEBSEncryption passes when new EBS volumes of the region are
+// encrypted by default.
+func (s *Service) checkEBSEncryption(ctx context.Context) (outcome, error) {
+	out, err := s.ec2API().GetEbsEncryptionByDefault(ctx, &ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return outcome{}, err
+	}
+	if aws.ToBool(out.EbsEncryptionByDefault) {
+		return outcome{pass: true, current: "enabled"}, nil
+	}
+	return outcome{current: "disabled", issue: "New EBS volumes are not encrypted by default", failed: []compliance.Check{compliance.CheckEBSDefaultEncryption}}, nil
+}
+
+// checkPasswordPolicy passes when the account password policy is at least
+// as strict as the baseline.
+func (s *Service) checkPasswordPolicy(ctx context.Context) (outcome, error) {
+	policy, err := s.passwordPolicy(ctx)
+	if err != nil {
+		return outcome{}, err
+	}
+	if policy == nil {
+		return outcome{current: "none", issue: "No account password policy; IAM defaults allow 8-character passwords", failed: []compliance.Check{compliance.CheckPasswordPolicy}}, nil
+	}
+
+	length := int(aws.ToInt32(policy.MinimumPasswordLength))
+	reuse := int(aws.ToInt32(policy.PasswordReusePrevention))
+	details := map[string]any{
+		"min_length":     length,
+		"reuse":          reuse,
+		"require_upper":  policy.RequireUppercaseCharacters,
+		"require_lower":  policy.RequireLowercaseCharacters,
+		"require_number": policy.RequireNumbers,
+		"require_symbol": policy.RequireSymbols,
+	}
+	current := fmt.Sprintf("length %d, reuse %d", length, reuse)
+
+	var gaps []string
+	if length < minPasswordLength {
+		gaps = append(gaps, fmt.Sprintf("minimum length %d < %d", length, minPasswordLength))
+	}
+	if reuse < minPasswordReuse {
+		gaps = append(gaps, fmt.Sprintf("reuse prevention %d < %d", reuse, minPasswordReuse))
+	}
+	for _, req := range []struct {
+		name string
+		on   bool
+	}{
+		{"uppercase", policy.RequireUppercaseCharacters},
+		{"lowercase", policy.RequireLowercaseCharacters},
+		{"numbers", policy.RequireNumbers},
+		{"symbols", policy.RequireSymbols},
+	} {
+		if !req.on {
+			gaps = append(gaps, req.name+" not required")
+		}
+	}
+	if len(gaps) == 0 {
+		return outcome{pass: true, current: current, details: details}, nil
+	}
+	return outcome{current: current, issue: "Password policy below baseline: " + strings.Join(gaps, ", "), failed: []compliance.Check{compliance.CheckPasswordPolicy}, details: details}, nil
+}
+
+// passwordPolicy returns the account password policy, nil when there is
+// none.
+func (s *Service) passwordPolicy(ctx context.Context) (*iamtypes.PasswordPolicy, error) {
+	out, err := s.iamAPI().GetAccountPasswordPolicy(ctx, &iam.GetAccountPasswordPolicyInput{})
+	var missing *iamtypes.NoSuchEntityException
+	switch {
+	case errors.As(err, &missing):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return out.PasswordPolicy, nil
+}
+
+// checkRootMFA passes when the root user has an MFA device. Root access
+// keys are reported too, as they bypass MFA.
+func (s *Service) checkRootMFA(ctx context.Context) (outcome, error) {
+	out, err := s.iamAPI().GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+	if err != nil {
+		return outcome{}, err
+	}
+	mfa := out.SummaryMap[string(iamtypes.SummaryKeyTypeAccountMFAEnabled)] > 0
+	keys := out.SummaryMap[string(iamtypes.SummaryKeyTypeAccountAccessKeysPresent)] > 0
+	details := map[string]any{"root_access_keys": keys}
+
+	var failed []compliance.Check
+	if !mfa {
+		failed = append(failed, compliance.CheckRootMFA)
+	}
+	if keys {
+		failed = append(failed, compliance.CheckRootAccessKeys)
+	}
+	switch {
+	case !mfa && keys:
+		return outcome{current: "disabled, access keys present", issue: "The root user has no MFA device and has access keys", failed: failed, details: details}, nil
+	case !mfa:
+		return outcome{current: "disabled", issue: "The root user has no MFA device", failed: failed, details: details}, nil
+	case keys:
+		return outcome{current: "enabled, access keys present", issue: "The root user has access keys, which bypass MFA", failed: failed, details: details}, nil
+	}
+	return outcome{pass: true, current: "enabled", details: details}, nil
+}
+
+// checkDefaultVPC passes when the region has no default VPC. A default VPC
+// still in use is reported, as deleting it needs care.
+func (s *Service) checkDefaultVPC(ctx context.Context) (outcome, error) {
+	client := s.ec2API()
+	out, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		Filters: []ec2types.Filter{{Name: aws.String("is-default"), Values: []string{"true"}}},
+	})
+	if err != nil {
+		return outcome{}, err
+	}
+	if len(out.Vpcs) == 0 {
+		return outcome{pass: true, current: "absent"}, nil
+	}
+
+	vpcID := aws.ToString(out.Vpcs[0].VpcId)
+	enis, err := client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		Filters:    []ec2types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcID}}},
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return outcome{}, err
+	}
+	details := map[string]any{"vpc_id": vpcID}
+	if len(enis.NetworkInterfaces) > 0 {
+		details["in_use"] = true
+		return outcome{current: vpcID + " (in use)", issue: "Default VPC " + vpcID + " exists and has network interfaces", details: details}, nil
+	}
+	return outcome{current: vpcID + " (unused)", issue: "Default VPC " + vpcID + " exists but is unused", details: details}, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for baseline checks.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "remediate",
+			Description: "Apply the safe fix for a failing check",
+			Icon:        "wrench",
+			Shortcut:    "f",
+			Dangerous:   true,
+			Category:    "security",
+		},
+	}
+}
+
+// Execute runs the specified action on a check.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "remediate":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.remediate(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// remediate applies a check's fix once confirmed. Checks are run again
+// first, so passing ones are left alone.
+func (s *Service) remediate(ctx context.Context, id string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("remediate", id, err)
+	}
+
+	c, ok := s.find(id)
+	if !ok {
+		return fail(core.ErrResourceNotFound)
+	}
+	if c.fix == nil {
+		return fail(core.NewValidationError("check", c.name, "has no safe automatic remediation: "+c.manual))
+	}
+	out, err := c.run(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	if out.pass {
+		return fail(core.NewValidationError("check", c.name, "already passes"))
+	}
+
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "remediate", id, params, c.change, false)
+	}
+
+	message, err := c.fix(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, message), nil
+}
+
+// fixS3BlockPublicAccess turns on every account-level Block Public Access
+// setting.
+func (s *Service) fixS3BlockPublicAccess(ctx context.Context) (string, error) {
+	account, err := s.accountID(ctx)
+	if err != nil {
+		return "", err
+	}
+	_, err = s.s3controlAPI().PutPublicAccessBlock(ctx, &s3control.PutPublicAccessBlockInput{
+		AccountId: aws.String(account),
+		PublicAccessBlockConfiguration: &s3controltypes.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Turned on S3 Block Public Access for account %s", account), nil
+}
+
+// fixEBSEncryption turns on EBS encryption by default in the region.
+func (s *Service) fixEBSEncryption(ctx context.Context) (string, error) {
+	if _, err := s.ec2API().EnableEbsEncryptionByDefault(ctx, &ec2.EnableEbsEncryptionByDefaultInput{}); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Turned on EBS encryption by default in %s", s.region()), nil
+}
+
+// fixPasswordPolicy raises the password policy to the baseline. Settings
+// outside the baseline, and stricter values, are kept.
+func (s *Service) fixPasswordPolicy(ctx context.Context) (string, error) {
+	client := s.iamAPI()
+	current, err := s.passwordPolicy(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	input := &iam.UpdateAccountPasswordPolicyInput{
+		MinimumPasswordLength:      aws.Int32(minPasswordLength),
+		PasswordReusePrevention:    aws.Int32(minPasswordReuse),
+		RequireUppercaseCharacters: true,
+		RequireLowercaseCharacters: true,
+		RequireNumbers:             true,
+		RequireSymbols:             true,
+		AllowUsersToChangePassword: true,
+	}
+	if current != nil {
+		input.MinimumPasswordLength = aws.Int32(max(minPasswordLength, aws.ToInt32(current.MinimumPasswordLength)))
+		input.PasswordReusePrevention = aws.Int32(max(minPasswordReuse, aws.ToInt32(current.PasswordReusePrevention)))
+		input.AllowUsersToChangePassword = current.AllowUsersToChangePassword
+		input.MaxPasswordAge = current.MaxPasswordAge
+		input.HardExpiry = current.HardExpiry
+	}
+
+	if _, err := client.UpdateAccountPasswordPolicy(ctx, input); err != nil {
+		return "", err
+	}
+	return "Raised the account password policy to the baseline", nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "baseline", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "baseline", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ compliance.Checker  = (*Service)(nil)
+)
@@ -0,0 +1,268 @@
+package baseline
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	s3controltypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeAccount serves the settings of account 123456789012 to every client
+// the checks use: S3 Block Public Access with two of its settings on, no
+// EBS encryption by default, a weak password policy, a root user with
+// access keys but no MFA device, and a default VPC with a network
+// interface. It fails every call when err is set, and IAM calls when
+// iamErr is set. It records the remediations applied.
+type fakeAccount struct {
+	err    error
+	iamErr error
+
+	compliant    bool
+	policy       *iamtypes.PasswordPolicy
+	putBPA       []*s3control.PutPublicAccessBlockInput
+	ebsEnabled   bool
+	policyUpdate *iam.UpdateAccountPasswordPolicyInput
+}
+
+func (f *fakeAccount) GetCallerIdentity(context.Context, *sts.GetCallerIdentityInput, ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil
+}
+
+func (f *fakeAccount) GetPublicAccessBlock(context.Context, *s3control.GetPublicAccessBlockInput, ...func(*s3control.Options)) (*s3control.GetPublicAccessBlockOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &s3control.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: &s3controltypes.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(true),
+		IgnorePublicAcls:      aws.Bool(f.compliant),
+		BlockPublicPolicy:     aws.Bool(true),
+		RestrictPublicBuckets: aws.Bool(f.compliant),
+	}}, nil
+}
+
+func (f *fakeAccount) PutPublicAccessBlock(_ context.Context, in *s3control.PutPublicAccessBlockInput, _ ...func(*s3control.Options)) (*s3control.PutPublicAccessBlockOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.putBPA = append(f.putBPA, in)
+	return &s3control.PutPublicAccessBlockOutput{}, nil
+}
+
+func (f *fakeAccount) GetEbsEncryptionByDefault(context.Context, *ec2.GetEbsEncryptionByDefaultInput, ...func(*ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.GetEbsEncryptionByDefaultOutput{EbsEncryptionByDefault: aws.Bool(f.compliant || f.ebsEnabled)}, nil
+}
+
+func (f *fakeAccount) EnableEbsEncryptionByDefault(context.Context, *ec2.EnableEbsEncryptionByDefaultInput, ...func(*ec2.Options)) (*ec2.EnableEbsEncryptionByDefaultOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.ebsEnabled = true
+	return &ec2.EnableEbsEncryptionByDefaultOutput{}, nil
+}
+
+func (f *fakeAccount) DescribeVpcs(context.Context, *ec2.DescribeVpcsInput, ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.compliant {
+		return &ec2.DescribeVpcsOutput{}, nil
+	}
+	return &ec2.DescribeVpcsOutput{Vpcs: []ec2types.Vpc{{VpcId: aws.String("vpc-0def"), IsDefault: aws.Bool(true)}}}, nil
+}
+
+func (f *fakeAccount) DescribeNetworkInterfaces(context.Context, *ec2.DescribeNetworkInterfacesInput, ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []ec2types.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}}}, nil
+}
+
+func (f *fakeAccount) GetAccountPasswordPolicy(context.Context, *iam.GetAccountPasswordPolicyInput, ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error) {
+	if err := errors.Join(f.err, f.iamErr); err != nil {
+		return nil, err
+	}
+	switch {
+	case f.compliant:
+		return &iam.GetAccountPasswordPolicyOutput{PasswordPolicy: &iamtypes.PasswordPolicy{
+			MinimumPasswordLength:      aws.Int32(16),
+			PasswordReusePrevention:    aws.Int32(24),
+			RequireUppercaseCharacters: true,
+			RequireLowercaseCharacters: true,
+			RequireNumbers:             true,
+			RequireSymbols:             true,
+		}}, nil
+	case f.policy != nil:
+		return &iam.GetAccountPasswordPolicyOutput{PasswordPolicy: f.policy}, nil
+	}
+	return nil, &iamtypes.NoSuchEntityException{Message: aws.String("The Password Policy with domain name 123456789012 cannot be found.")}
+}
+
+func (f *fakeAccount) UpdateAccountPasswordPolicy(_ context.Context, in *iam.UpdateAccountPasswordPolicyInput, _ ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error) {
+	if err := errors.Join(f.err, f.iamErr); err != nil {
+		return nil, err
+	}
+	f.policyUpdate = in
+	return &iam.UpdateAccountPasswordPolicyOutput{}, nil
+}
+
+func (f *fakeAccount) GetAccountSummary(context.Context, *iam.GetAccountSummaryInput, ...func(*iam.Options)) (*iam.GetAccountSummaryOutput, error) {
+	if err := errors.Join(f.err, f.iamErr); err != nil {
+		return nil, err
+	}
+	if f.compliant {
+		return &iam.GetAccountSummaryOutput{SummaryMap: map[string]int32{"AccountMFAEnabled": 1}}, nil
+	}
+	return &iam.GetAccountSummaryOutput{SummaryMap: map[string]int32{"AccountMFAEnabled": 0, "AccountAccessKeysPresent": 1}}, nil
+}
+
+func newTestService(fake *fakeAccount, d core.EventDispatcher) *Service {
+	return NewService(nil, d, WithEC2Client(fake), WithIAMClient(fake), WithSTSClient(fake), WithS3ControlClient(fake))
+}
+
+// TestServiceConformance runs the core service contract against the
+// baseline checks.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return newTestService(&fakeAccount{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return newTestService(&fakeAccount{err: errors.New("ExpiredToken")}, d)
+		},
+		ExistingID:    CheckEBSEncryption,
+		Action:        "remediate",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "remediate",
+	})
+}
+
+func TestScan(t *testing.T) {
+	type result struct {
+		status string
+		issue  string
+	}
+	tests := []struct {
+		name      string
+		compliant bool
+		want      map[string]result
+	}{
+		{
+			name: "account below baseline",
+			want: map[string]result{
+				CheckS3BlockPublicAccess: {StatusFail, "Account-level S3 Block Public Access is off for IgnorePublicAcls, RestrictPublicBuckets"},
+				CheckEBSEncryption:       {StatusFail, "New EBS volumes are not encrypted by default"},
+				CheckPasswordPolicy:      {StatusFail, "No account password policy; IAM defaults allow 8-character passwords"},
+				CheckRootMFA:             {StatusFail, "The root user has no MFA device and has access keys"},
+				CheckDefaultVPC:          {StatusFail, "Default VPC vpc-0def exists and has network interfaces"},
+			},
+		},
+		{
+			name:      "compliant account",
+			compliant: true,
+			want: map[string]result{
+				CheckS3BlockPublicAccess: {status: StatusPass},
+				CheckEBSEncryption:       {status: StatusPass},
+				CheckPasswordPolicy:      {status: StatusPass},
+				CheckRootMFA:             {status: StatusPass},
+				CheckDefaultVPC:          {status: StatusPass},
+			},
+		},
+	}
+	for _, tt := range tests {
+		scan, err := newTestService(&fakeAccount{compliant: tt.compliant}, nil).Scan(context.Background())
+		if err != nil || scan.Err() != nil {
+			t.Fatalf("%s: Scan() error = %v, %v", tt.name, err, scan.Err())
+		}
+		for _, r := range scan.Resources {
+			var got result
+			got.status = Status(r)
+			if issues := r.Issues(); len(issues) > 0 {
+				got.issue = issues[0].Message
+			}
+			if got != tt.want[r.ID] {
+				t.Errorf("%s: %s = %+v, want %+v", tt.name, r.ID, got, tt.want[r.ID])
+			}
+		}
+	}
+
+	scan, _ := newTestService(&fakeAccount{}, nil).Scan(context.Background())
+	failed := compliance.Failed(scan.Resources[slices.IndexFunc(scan.Resources, func(r core.Resource) bool { return r.ID == CheckRootMFA })])
+	if !slices.Equal(failed, []compliance.Check{compliance.CheckRootMFA, compliance.CheckRootAccessKeys}) {
+		t.Errorf("root account fails %v", failed)
+	}
+}
+
+// TestScanKeepsChecksThatRan checks that checks denied by IAM are listed as
+// unknown rather than failing the whole scan.
+func TestScanKeepsChecksThatRan(t *testing.T) {
+	resources, err := newTestService(&fakeAccount{iamErr: errors.New("AccessDenied: iam:GetAccountSummary")}, nil).List(context.Background(), core.ListOptions{})
+
+	var partial *core.PartialError
+	if !errors.As(err, &partial) || len(partial.Failures) != 2 {
+		t.Fatalf("List() error = %v, want the two IAM checks as a partial error", err)
+	}
+	if len(resources) != 5 {
+		t.Fatalf("List() = %d checks, want all 5", len(resources))
+	}
+	for _, r := range resources {
+		iamCheck := r.ID == CheckPasswordPolicy || r.ID == CheckRootMFA
+		if (Status(r) == StatusUnknown) != iamCheck {
+			t.Errorf("%s status = %s", r.ID, Status(r))
+		}
+	}
+}
+
+func TestRemediate(t *testing.T) {
+	fake := &fakeAccount{policy: &iamtypes.PasswordPolicy{
+		MinimumPasswordLength:      aws.Int32(20),
+		PasswordReusePrevention:    aws.Int32(5),
+		MaxPasswordAge:             aws.Int32(90),
+		AllowUsersToChangePassword: true,
+	}}
+	svc := newTestService(fake, nil)
+	confirm := map[string]any{core.ParamConfirm: true}
+
+	if _, err := svc.Execute(context.Background(), "remediate", CheckPasswordPolicy, confirm); err != nil {
+		t.Fatalf("remediate %s error = %v", CheckPasswordPolicy, err)
+	}
+	update := fake.policyUpdate
+	if aws.ToInt32(update.MinimumPasswordLength) != 20 || aws.ToInt32(update.PasswordReusePrevention) != minPasswordReuse || aws.ToInt32(update.MaxPasswordAge) != 90 || !update.RequireSymbols {
+		t.Errorf("password policy update = %+v, want the stricter length and age kept", update)
+	}
+
+	if _, err := svc.Execute(context.Background(), "remediate", CheckS3BlockPublicAccess, confirm); err != nil {
+		t.Fatalf("remediate %s error = %v", CheckS3BlockPublicAccess, err)
+	}
+	if len(fake.putBPA) != 1 || aws.ToString(fake.putBPA[0].AccountId) != "123456789012" || !aws.ToBool(fake.putBPA[0].PublicAccessBlockConfiguration.RestrictPublicBuckets) {
+		t.Errorf("Block Public Access updates = %+v", fake.putBPA)
+	}
+
+	var validation *core.ValidationError
+	for _, id := range []string{CheckRootMFA, CheckDefaultVPC} {
+		if _, err := svc.Execute(context.Background(), "remediate", id, confirm); !errors.As(err, &validation) {
+			t.Errorf("remediate %s error = %v, want no safe remediation", id, err)
+		}
+	}
+	if _, err := newTestService(&fakeAccount{compliant: true}, nil).Execute(context.Background(), "remediate", CheckEBSEncryption, confirm); !errors.As(err, &validation) {
+		t.Errorf("remediate of a passing check error = %v, want already passes", err)
+	}
+}
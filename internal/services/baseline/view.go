@@ -0,0 +1,281 @@
+package baseline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for account baseline checks.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new account baselines view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Check"), MinWidth: 15, MaxWidth: 30, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Scope"), MinWidth: 8, MaxWidth: 15, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Status"), MinWidth: 9, MaxWidth: 11, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Current"), MinWidth: 10, MaxWidth: 35, Weight: 0.8, Priority: 1},
+		{Title: i18n.T("Expected"), MinWidth: 10, MaxWidth: 60, Weight: 1.2, Priority: 3},
+		{Title: i18n.T("Fix"), MinWidth: 9, MaxWidth: 9, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Controls"), MinWidth: 10, MaxWidth: 30, Weight: 0.6, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Baselines", "", "baseline", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadChecks()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "f":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Remediating %s...", row.Name)
+				return v, v.executeAction("remediate", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Check %s", row.Name), formatCheck(row))
+			}
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result != nil {
+			v.Message = msg.Result.Message
+			return v, v.loadChecks()
+		}
+
+	case checksLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+			break
+		}
+		v.SetListError(msg.scan.Err())
+		v.Resources = msg.scan.Resources
+		v.SetCellSource(len(v.Resources), func(i int) base.Row {
+			return buildRow(v.Resources[i])
+		})
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Checking account settings...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[f]ix  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh runs the checks again.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadChecks()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type checksLoadedMsg struct {
+	owner *View // Listings of a swapped-out view are dropped
+	scan  Scan
+	err   error
+}
+
+func (v *View) loadChecks() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service, ok := v.Service().(*Service)
+		if !ok {
+			return checksLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		scan, err := service.Scan(context.Background())
+		return checksLoadedMsg{owner: v, scan: scan, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(r.Name),
+		base.TextCell(r.GetMetadataString("scope")),
+		base.TextCell(formatStatus(Status(r))),
+		base.TextCell(base.TruncateString(r.GetMetadataString("current"), 35)),
+		base.TextCell(r.GetMetadataString("expected")),
+		base.TextCell(r.GetMetadataString("remediation")),
+		base.TextCell(base.FormatControls(r)),
+		base.SeverityCell(r),
+	}
+}
+
+// formatStatus prefixes a check's status with an icon.
+func formatStatus(status string) string {
+	switch status {
+	case StatusPass:
+		return "✅ " + status
+	case StatusFail:
+		return "❌ " + status
+	}
+	return "❔ " + status
+}
+
+// formatCheck renders a check for the detail panel.
+func formatCheck(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Check:       %s\n", r.Name)
+	fmt.Fprintf(&b, "Scope:       %s\n", r.GetMetadataString("scope"))
+	fmt.Fprintf(&b, "Status:      %s\n", formatStatus(Status(*r)))
+	fmt.Fprintf(&b, "Current:     %s\n", r.GetMetadataString("current"))
+	fmt.Fprintf(&b, "Expected:    %s\n", r.GetMetadataString("expected"))
+	switch r.GetMetadataString("remediation") {
+	case "available":
+		b.WriteString("Remediation: press f to apply the safe fix\n")
+	case "manual":
+		fmt.Fprintf(&b, "Remediation: %s\n", r.GetMetadataString("manual"))
+	}
+
+	if controls, _ := r.Metadata["compliance_controls"].([]string); len(controls) > 0 {
+		fmt.Fprintf(&b, "Controls:    %s\n", strings.Join(controls, ", "))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	pass, fail, unknown := 0, 0, 0
+	for _, r := range v.Resources {
+		switch Status(r) {
+		case StatusPass:
+			pass++
+		case StatusFail:
+			fail++
+		default:
+			unknown++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "passing", Text: i18n.T("Passing: %d", pass), Tone: core.ToneSuccess},
+		core.SummaryWidget{Name: "failing", Text: i18n.T("Failing: %d", fail), Tone: core.ToneError},
+		core.SummaryWidget{Name: "unknown", Text: i18n.T("Unknown: %d", unknown), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Account Baselines"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "baseline" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,826 @@
+// Package cloudformation provides AWS CloudFormation integration for the a9s
+// application. It lists stacks, shows their templates and events, detects
+// drift, previews the resource changes of their change sets before
+// executing them, and deletes stacks.
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements CloudFormation operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient CloudFormationAPI
+}
+
+// CloudFormationAPI defines the CloudFormation client interface for mocking.
+type CloudFormationAPI interface {
+	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+	GetTemplate(ctx context.Context, params *cloudformation.GetTemplateInput, optFns ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error)
+	ListChangeSets(ctx context.Context, params *cloudformation.ListChangeSetsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListChangeSetsOutput, error)
+	DescribeChangeSet(ctx context.Context, params *cloudformation.DescribeChangeSetInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeChangeSetOutput, error)
+	ExecuteChangeSet(ctx context.Context, params *cloudformation.ExecuteChangeSetInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ExecuteChangeSetOutput, error)
+	DetectStackDrift(ctx context.Context, params *cloudformation.DetectStackDriftInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DetectStackDriftOutput, error)
+	DescribeStackDriftDetectionStatus(ctx context.Context, params *cloudformation.DescribeStackDriftDetectionStatusInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error)
+	DescribeStackResourceDrifts(ctx context.Context, params *cloudformation.DescribeStackResourceDriftsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourceDriftsOutput, error)
+	DescribeStackEvents(ctx context.Context, params *cloudformation.DescribeStackEventsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackEventsOutput, error)
+	ListStackResources(ctx context.Context, params *cloudformation.ListStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStackResourcesOutput, error)
+	DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)
+}
+
+const (
+	// maxEvents is how many of a stack's most recent events are shown.
+	maxEvents = 100
+	// driftPollInterval is how often a running drift detection is checked.
+	driftPollInterval = 2 * time.Second
+	// driftTimeout is how long to wait for a drift detection to end.
+	driftTimeout = 5 * time.Minute
+)
+
+// NewService creates a new CloudFormation service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client CloudFormationAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the CloudFormation client for the current AWS context.
+func (s *Service) client() CloudFormationAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return cloudformation.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cloudformation"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "CloudFormation Stacks"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "layers"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeStacks(ctx, &cloudformation.DescribeStacksInput{})
+	if err != nil {
+		return core.NewServiceError("cloudformation", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the stacks of the region with their pending change sets.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	client := s.client()
+
+	var resources []core.Resource
+	paginator := cloudformation.NewDescribeStacksPaginator(client, &cloudformation.DescribeStacksInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("cloudformation", "list", err)
+		}
+		for _, stack := range page.Stacks {
+			resource := stackToResource(stack)
+			if !strings.HasSuffix(string(stack.StackStatus), "_IN_PROGRESS") {
+				if changeSets, err := s.changeSets(ctx, resource.Name); err == nil {
+					applyChangeSets(&resource, changeSets)
+				}
+			}
+			resources = append(resources, resource)
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "cloudformation:stack",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for stacks.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "template",
+			Description: "Show the stack's current template as YAML",
+			Icon:        "file",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "change_sets",
+			Description: "List the stack's change sets",
+			Icon:        "list",
+			Shortcut:    "c",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "change_set",
+			Description: "Preview the resource changes of a change set",
+			Icon:        "diff",
+			Shortcut:    "d",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "name", Type: "string", Required: true, Description: "Change set name or ID"},
+			},
+		},
+		{
+			Name:        "execute_change_set",
+			Description: "Execute a change set, updating the stack",
+			Icon:        "play",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "name", Type: "string", Required: true, Description: "Change set name or ID"},
+			},
+		},
+		{
+			Name:        "detect_drift",
+			Description: "Detect drift and report drifted resources",
+			Icon:        "search",
+			Shortcut:    "D",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "events",
+			Description: "Show the stack's most recent events",
+			Icon:        "list",
+			Shortcut:    "e",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the stack and the resources it created",
+			Icon:        "trash",
+			Shortcut:    "X",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a stack, identified by its name.
+// Executing a change set or deleting the stack asks for confirmation
+// through a core.ConfirmationError, summarizing its changes, until the
+// "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "template":
+		result, err = s.template(ctx, resourceID)
+	case "change_sets":
+		result, err = s.listChangeSets(ctx, resourceID)
+	case "change_set", "execute_change_set":
+		name, _ := params["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return nil, core.NewValidationError("name", name, "is required")
+		}
+		if action == "change_set" {
+			result, err = s.describeChangeSet(ctx, resourceID, strings.TrimSpace(name))
+		} else {
+			confirmed, _ := params[core.ParamConfirm].(bool)
+			result, err = s.executeChangeSet(ctx, resourceID, strings.TrimSpace(name), params, confirmed)
+		}
+	case "detect_drift":
+		result, err = s.detectDrift(ctx, resourceID)
+	case "events":
+		result, err = s.events(ctx, resourceID)
+	case "delete":
+		result, err = s.deleteStack(ctx, resourceID, params, core.Confirmed(params, resourceID, true))
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) template(ctx context.Context, stack string) (*core.ActionResult, error) {
+	out, err := s.client().GetTemplate(ctx, &cloudformation.GetTemplateInput{
+		StackName:     aws.String(stack),
+		TemplateStage: types.TemplateStageOriginal,
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("template", stack, err)
+	}
+
+	body, err := TemplateYAML(aws.ToString(out.TemplateBody))
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("template", stack, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Template of %s", stack))
+	result.Data = body
+	return result, nil
+}
+
+// ChangeSet is a change set of a stack.
+type ChangeSet struct {
+	Name        string
+	ID          string
+	Description string
+	Status      string // CREATE_COMPLETE once its changes are computed
+	Execution   string // AVAILABLE while it can be executed
+	Reason      string
+	CreatedAt   time.Time
+}
+
+// Executable reports whether the change set can be executed.
+func (c ChangeSet) Executable() bool {
+	return c.Execution == string(types.ExecutionStatusAvailable)
+}
+
+func (s *Service) changeSets(ctx context.Context, stack string) ([]ChangeSet, error) {
+	var changeSets []ChangeSet
+	paginator := cloudformation.NewListChangeSetsPaginator(s.client(), &cloudformation.ListChangeSetsInput{
+		StackName: aws.String(stack),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, summary := range page.Summaries {
+			changeSets = append(changeSets, ChangeSet{
+				Name:        aws.ToString(summary.ChangeSetName),
+				ID:          aws.ToString(summary.ChangeSetId),
+				Description: aws.ToString(summary.Description),
+				Status:      string(summary.Status),
+				Execution:   string(summary.ExecutionStatus),
+				Reason:      aws.ToString(summary.StatusReason),
+				CreatedAt:   aws.ToTime(summary.CreationTime),
+			})
+		}
+	}
+	return changeSets, nil
+}
+
+func (s *Service) listChangeSets(ctx context.Context, stack string) (*core.ActionResult, error) {
+	changeSets, err := s.changeSets(ctx, stack)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("change_sets", stack, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d change sets for %s", len(changeSets), stack))
+	result.Data = changeSets
+	return result, nil
+}
+
+// Diff is the preview of a change set: the changes it makes to the
+// stack's resources.
+type Diff struct {
+	ChangeSet ChangeSet
+	Changes   []ResourceChange
+}
+
+// ResourceChange is a change to a stack resource.
+type ResourceChange struct {
+	Action      string // Add, Modify, Remove, Import, Dynamic
+	LogicalID   string
+	PhysicalID  string
+	Type        string
+	Replacement string // True, False or Conditional for modifications
+	Details     []PropertyChange
+}
+
+// PropertyChange is a change to an attribute of a resource.
+type PropertyChange struct {
+	Attribute  string // Properties, Metadata, Tags...
+	Name       string
+	Recreation string // Never, Conditionally or Always
+	Source     string
+	Cause      string
+	Before     string
+	After      string
+}
+
+// Summary counts the changes of a diff by action, as "2 to add, 1 to
+// modify (1 replaced), 0 to remove".
+func (d Diff) Summary() string {
+	var add, modify, replace, remove, other int
+	for _, c := range d.Changes {
+		switch c.Action {
+		case string(types.ChangeActionAdd), string(types.ChangeActionImport):
+			add++
+		case string(types.ChangeActionModify):
+			modify++
+			if c.Replacement == string(types.ReplacementTrue) {
+				replace++
+			}
+		case string(types.ChangeActionRemove):
+			remove++
+		default:
+			other++
+		}
+	}
+	summary := fmt.Sprintf("%d to add, %d to modify (%d replaced), %d to remove", add, modify, replace, remove)
+	if other > 0 {
+		summary += fmt.Sprintf(", %d evaluated at execution", other)
+	}
+	return summary
+}
+
+func (s *Service) diff(ctx context.Context, stack, name string) (Diff, error) {
+	var diff Diff
+	input := &cloudformation.DescribeChangeSetInput{
+		StackName:             aws.String(stack),
+		ChangeSetName:         aws.String(name),
+		IncludePropertyValues: aws.Bool(true),
+	}
+	for {
+		out, err := s.client().DescribeChangeSet(ctx, input)
+		if err != nil {
+			return diff, err
+		}
+		if input.NextToken == nil {
+			diff.ChangeSet = ChangeSet{
+				Name:        aws.ToString(out.ChangeSetName),
+				ID:          aws.ToString(out.ChangeSetId),
+				Description: aws.ToString(out.Description),
+				Status:      string(out.Status),
+				Execution:   string(out.ExecutionStatus),
+				Reason:      aws.ToString(out.StatusReason),
+				CreatedAt:   aws.ToTime(out.CreationTime),
+			}
+		}
+		for _, change := range out.Changes {
+			if change.ResourceChange != nil {
+				diff.Changes = append(diff.Changes, resourceChangeOf(*change.ResourceChange))
+			}
+		}
+		if out.NextToken == nil {
+			return diff, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func (s *Service) describeChangeSet(ctx context.Context, stack, name string) (*core.ActionResult, error) {
+	diff, err := s.diff(ctx, stack, name)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("change_set", stack, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Change set %s: %s", diff.ChangeSet.Name, diff.Summary()))
+	result.Data = diff
+	return result, nil
+}
+
+func (s *Service) executeChangeSet(ctx context.Context, stack, name string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("execute_change_set", stack, err)
+	}
+
+	diff, err := s.diff(ctx, stack, name)
+	if err != nil {
+		return fail(err)
+	}
+	if !diff.ChangeSet.Executable() {
+		reason := "is " + strings.ToLower(diff.ChangeSet.Execution)
+		if diff.ChangeSet.Reason != "" {
+			reason += ": " + diff.ChangeSet.Reason
+		}
+		return fail(core.NewValidationError("name", name, reason))
+	}
+
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "execute_change_set", stack, params, fmt.Sprintf("Updates %s: %s", stack, diff.Summary()), false)
+	}
+
+	_, err = s.client().ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		StackName:     aws.String(stack),
+		ChangeSetName: aws.String(diff.ChangeSet.ID),
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Executing change set %s on %s: %s", diff.ChangeSet.Name, stack, diff.Summary())), nil
+}
+
+// DriftReport is the outcome of a drift detection.
+type DriftReport struct {
+	Stack     string
+	Status    string // DRIFTED, IN_SYNC or NOT_CHECKED
+	Drifted   int
+	Resources []ResourceDrift // Modified and deleted resources
+}
+
+// ResourceDrift is a resource that differs from the template.
+type ResourceDrift struct {
+	LogicalID   string
+	PhysicalID  string
+	Type        string
+	Status      string // MODIFIED or DELETED
+	Differences []PropertyDifference
+}
+
+// PropertyDifference is a property whose actual value differs from the
+// template's.
+type PropertyDifference struct {
+	Path     string
+	Type     string // ADD, REMOVE or NOT_EQUAL
+	Expected string
+	Actual   string
+}
+
+// detectDrift starts a drift detection, waits for it to end and reports the
+// resources that drifted.
+func (s *Service) detectDrift(ctx context.Context, stack string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("detect_drift", stack, err)
+	}
+
+	client := s.client()
+	started, err := client.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{StackName: aws.String(stack)})
+	if err != nil {
+		return fail(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, driftTimeout)
+	defer cancel()
+
+	var status *cloudformation.DescribeStackDriftDetectionStatusOutput
+	for {
+		status, err = client.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: started.StackDriftDetectionId,
+		})
+		if err != nil {
+			return fail(err)
+		}
+		if status.DetectionStatus != types.StackDriftDetectionStatusDetectionInProgress {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fail(fmt.Errorf("drift detection still running after %s", driftTimeout))
+		case <-time.After(driftPollInterval):
+		}
+	}
+	if status.DetectionStatus == types.StackDriftDetectionStatusDetectionFailed && status.StackDriftStatus == "" {
+		return fail(fmt.Errorf("drift detection failed: %s", orDash(aws.ToString(status.DetectionStatusReason))))
+	}
+
+	report := DriftReport{
+		Stack:   stack,
+		Status:  string(status.StackDriftStatus),
+		Drifted: int(aws.ToInt32(status.DriftedStackResourceCount)),
+	}
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(client, &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stack),
+		StackResourceDriftStatusFilters: []types.StackResourceDriftStatus{
+			types.StackResourceDriftStatusModified,
+			types.StackResourceDriftStatusDeleted,
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fail(err)
+		}
+		for _, drift := range page.StackResourceDrifts {
+			report.Resources = append(report.Resources, resourceDriftOf(drift))
+		}
+	}
+
+	message := fmt.Sprintf("%s is %s: %d resources drifted", stack, strings.ToLower(strings.ReplaceAll(report.Status, "_", " ")), report.Drifted)
+	if status.DetectionStatus == types.StackDriftDetectionStatusDetectionFailed {
+		// Detection fails when some resources cannot be checked, but the
+		// others are still reported
+		message += " (" + orDash(aws.ToString(status.DetectionStatusReason)) + ")"
+	}
+	result := core.NewActionResult(true, message)
+	result.Data = report
+	return result, nil
+}
+
+// StackEvent is an event of a stack or of one of its resources.
+type StackEvent struct {
+	Time       time.Time
+	LogicalID  string
+	PhysicalID string
+	Type       string
+	Status     string
+	Reason     string
+}
+
+// Failed reports whether the event is a failure.
+func (e StackEvent) Failed() bool {
+	return strings.HasSuffix(e.Status, "_FAILED")
+}
+
+// events returns the stack's most recent events, newest first.
+func (s *Service) events(ctx context.Context, stack string) (*core.ActionResult, error) {
+	var events []StackEvent
+	paginator := cloudformation.NewDescribeStackEventsPaginator(s.client(), &cloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stack),
+	})
+	for paginator.HasMorePages() && len(events) < maxEvents {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("events", stack, err)
+		}
+		for _, event := range page.StackEvents {
+			events = append(events, StackEvent{
+				Time:       aws.ToTime(event.Timestamp),
+				LogicalID:  aws.ToString(event.LogicalResourceId),
+				PhysicalID: aws.ToString(event.PhysicalResourceId),
+				Type:       aws.ToString(event.ResourceType),
+				Status:     string(event.ResourceStatus),
+				Reason:     aws.ToString(event.ResourceStatusReason),
+			})
+		}
+	}
+	if len(events) > maxEvents {
+		events = events[:maxEvents]
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d recent events of %s", len(events), stack))
+	result.Data = events
+	return result, nil
+}
+
+// deleteStack deletes a stack once its name is typed back. Stacks with
+// termination protection are refused.
+func (s *Service) deleteStack(ctx context.Context, stack string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", stack, err)
+	}
+
+	client := s.client()
+	out, err := client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{StackName: aws.String(stack)})
+	if err != nil {
+		return fail(err)
+	}
+	if len(out.Stacks) == 0 {
+		return fail(core.ErrResourceNotFound)
+	}
+	if aws.ToBool(out.Stacks[0].EnableTerminationProtection) {
+		return fail(core.NewValidationError("stack", stack, "has termination protection enabled"))
+	}
+	if out.Stacks[0].ParentId != nil {
+		return fail(core.NewValidationError("stack", stack, "is nested; delete its root stack instead"))
+	}
+
+	if !confirmed {
+		resources := 0
+		paginator := cloudformation.NewListStackResourcesPaginator(client, &cloudformation.ListStackResourcesInput{StackName: aws.String(stack)})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return fail(err)
+			}
+			resources += len(page.StackResourceSummaries)
+		}
+		reason := fmt.Sprintf("Deletes %s and its %d resources, except those with a Retain deletion policy; this cannot be undone", stack, resources)
+		return nil, core.NewConfirmationError(s, "delete", stack, params, reason, true)
+	}
+
+	if _, err := client.DeleteStack(ctx, &cloudformation.DeleteStackInput{StackName: aws.String(stack)}); err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   stack,
+		ResourceType: "cloudformation:stack",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleting stack %s", stack)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func stackToResource(stack types.Stack) core.Resource {
+	status := string(stack.StackStatus)
+	resource := core.Resource{
+		ID:    aws.ToString(stack.StackName),
+		Type:  "cloudformation:stack",
+		Name:  aws.ToString(stack.StackName),
+		ARN:   aws.ToString(stack.StackId),
+		State: strings.ToLower(status),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"status":                 status,
+			"status_reason":          aws.ToString(stack.StackStatusReason),
+			"description":            aws.ToString(stack.Description),
+			"termination_protection": aws.ToBool(stack.EnableTerminationProtection),
+			"nested":                 stack.ParentId != nil,
+			"change_sets":            0,
+		},
+		CreatedAt: stack.CreationTime,
+		UpdatedAt: stack.LastUpdatedTime,
+	}
+	for _, tag := range stack.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	if stack.DriftInformation != nil {
+		resource.Metadata["drift"] = string(stack.DriftInformation.StackDriftStatus)
+		if checked := stack.DriftInformation.LastCheckTimestamp; checked != nil {
+			resource.Metadata["drift_checked"] = *checked
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(status, "_FAILED"):
+		resource.AddIssue(core.SeverityHigh, fmt.Sprintf("Stack is %s: %s", status, orDash(aws.ToString(stack.StackStatusReason))))
+	case status == string(types.StackStatusRollbackComplete):
+		resource.AddIssue(core.SeverityMedium, "Creation rolled back: the stack must be deleted before it can be created again")
+	case status == string(types.StackStatusUpdateRollbackComplete):
+		resource.AddIssue(core.SeverityLow, "Last update was rolled back")
+	}
+	if resource.GetMetadataString("drift") == string(types.StackDriftStatusDrifted) {
+		resource.AddIssue(core.SeverityMedium, "Resources have drifted from the template")
+	}
+
+	if resource.CreatedAt != nil {
+		estimate.ApplyAge(&resource, time.Now())
+	}
+	return resource
+}
+
+// applyChangeSets records the change sets waiting to be executed.
+func applyChangeSets(r *core.Resource, changeSets []ChangeSet) {
+	pending := 0
+	for _, c := range changeSets {
+		if c.Executable() {
+			pending++
+		}
+	}
+	r.Metadata["change_sets"] = pending
+	if pending > 0 {
+		r.AddIssue(core.SeverityInfo, fmt.Sprintf("%d change sets waiting to be executed", pending))
+	}
+}
+
+func resourceChangeOf(rc types.ResourceChange) ResourceChange {
+	change := ResourceChange{
+		Action:      string(rc.Action),
+		LogicalID:   aws.ToString(rc.LogicalResourceId),
+		PhysicalID:  aws.ToString(rc.PhysicalResourceId),
+		Type:        aws.ToString(rc.ResourceType),
+		Replacement: string(rc.Replacement),
+	}
+	for _, detail := range rc.Details {
+		if detail.Target == nil {
+			continue
+		}
+		change.Details = append(change.Details, PropertyChange{
+			Attribute:  string(detail.Target.Attribute),
+			Name:       aws.ToString(detail.Target.Name),
+			Recreation: string(detail.Target.RequiresRecreation),
+			Source:     string(detail.ChangeSource),
+			Cause:      aws.ToString(detail.CausingEntity),
+			Before:     aws.ToString(detail.Target.BeforeValue),
+			After:      aws.ToString(detail.Target.AfterValue),
+		})
+	}
+	return change
+}
+
+func resourceDriftOf(drift types.StackResourceDrift) ResourceDrift {
+	rd := ResourceDrift{
+		LogicalID:  aws.ToString(drift.LogicalResourceId),
+		PhysicalID: aws.ToString(drift.PhysicalResourceId),
+		Type:       aws.ToString(drift.ResourceType),
+		Status:     string(drift.StackResourceDriftStatus),
+	}
+	for _, diff := range drift.PropertyDifferences {
+		rd.Differences = append(rd.Differences, PropertyDifference{
+			Path:     aws.ToString(diff.PropertyPath),
+			Type:     string(diff.DifferenceType),
+			Expected: aws.ToString(diff.ExpectedValue),
+			Actual:   aws.ToString(diff.ActualValue),
+		})
+	}
+	return rd
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "cloudformation", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "cloudformation", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,275 @@
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeCloudFormation serves three stacks: network, drifted and protected
+// from termination, app, whose last update rolled back and which has the
+// add-queue change set ready and the empty noop one, and broken, whose
+// creation rolled back. It fails every call when err is set and records
+// the change sets executed and the stacks deleted.
+type fakeCloudFormation struct {
+	err      error
+	executed []string
+	deleted  []string
+}
+
+func (f *fakeCloudFormation) stacks() []types.Stack {
+	return []types.Stack{
+		{
+			StackName:                   aws.String("network"),
+			StackId:                     aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/network/1"),
+			StackStatus:                 types.StackStatusUpdateComplete,
+			EnableTerminationProtection: aws.Bool(true),
+			DriftInformation:            &types.StackDriftInformation{StackDriftStatus: types.StackDriftStatusDrifted},
+		},
+		{
+			StackName:   aws.String("app"),
+			StackId:     aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/app/2"),
+			StackStatus: types.StackStatusUpdateRollbackComplete,
+		},
+		{
+			StackName:         aws.String("broken"),
+			StackId:           aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/broken/3"),
+			StackStatus:       types.StackStatusRollbackComplete,
+			StackStatusReason: aws.String("The following resource(s) failed to create: [Bucket]."),
+		},
+	}
+}
+
+func (f *fakeCloudFormation) DescribeStacks(_ context.Context, in *cloudformation.DescribeStacksInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	stacks := f.stacks()
+	if name := aws.ToString(in.StackName); name != "" {
+		i := slices.IndexFunc(stacks, func(s types.Stack) bool { return aws.ToString(s.StackName) == name })
+		if i < 0 {
+			return nil, &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id " + name + " does not exist"}
+		}
+		stacks = stacks[i : i+1]
+	}
+	return &cloudformation.DescribeStacksOutput{Stacks: stacks}, nil
+}
+
+func (f *fakeCloudFormation) GetTemplate(context.Context, *cloudformation.GetTemplateInput, ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.GetTemplateOutput{TemplateBody: aws.String(`{"Resources":{"Queue":{"Type":"AWS::SQS::Queue"}}}`)}, nil
+}
+
+func (f *fakeCloudFormation) ListChangeSets(_ context.Context, in *cloudformation.ListChangeSetsInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListChangeSetsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.StackName) != "app" {
+		return &cloudformation.ListChangeSetsOutput{}, nil
+	}
+	return &cloudformation.ListChangeSetsOutput{Summaries: []types.ChangeSetSummary{
+		{ChangeSetName: aws.String("add-queue"), ChangeSetId: aws.String("cs-1"), Status: types.ChangeSetStatusCreateComplete, ExecutionStatus: types.ExecutionStatusAvailable},
+		{ChangeSetName: aws.String("noop"), ChangeSetId: aws.String("cs-2"), Status: types.ChangeSetStatusFailed, ExecutionStatus: types.ExecutionStatusUnavailable},
+	}}, nil
+}
+
+func (f *fakeCloudFormation) DescribeChangeSet(_ context.Context, in *cloudformation.DescribeChangeSetInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeChangeSetOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.ChangeSetName) == "noop" {
+		return &cloudformation.DescribeChangeSetOutput{
+			ChangeSetName:   aws.String("noop"),
+			ChangeSetId:     aws.String("cs-2"),
+			Status:          types.ChangeSetStatusFailed,
+			ExecutionStatus: types.ExecutionStatusUnavailable,
+			StatusReason:    aws.String("The submitted information didn't contain changes."),
+		}, nil
+	}
+	// The changes come in two pages
+	if in.NextToken == nil {
+		return &cloudformation.DescribeChangeSetOutput{
+			ChangeSetName:   aws.String("add-queue"),
+			ChangeSetId:     aws.String("cs-1"),
+			Status:          types.ChangeSetStatusCreateComplete,
+			ExecutionStatus: types.ExecutionStatusAvailable,
+			Changes: []types.Change{{ResourceChange: &types.ResourceChange{
+				Action: types.ChangeActionAdd, LogicalResourceId: aws.String("Queue"), ResourceType: aws.String("AWS::SQS::Queue"),
+			}}},
+			NextToken: aws.String("page-2"),
+		}, nil
+	}
+	return &cloudformation.DescribeChangeSetOutput{
+		Changes: []types.Change{{ResourceChange: &types.ResourceChange{
+			Action: types.ChangeActionModify, LogicalResourceId: aws.String("Function"), ResourceType: aws.String("AWS::Lambda::Function"),
+			Replacement: types.ReplacementTrue,
+			Details: []types.ResourceChangeDetail{{Target: &types.ResourceTargetDefinition{
+				Attribute: types.ResourceAttributeProperties, Name: aws.String("FunctionName"), RequiresRecreation: types.RequiresRecreationAlways,
+			}}},
+		}}},
+	}, nil
+}
+
+func (f *fakeCloudFormation) ExecuteChangeSet(_ context.Context, in *cloudformation.ExecuteChangeSetInput, _ ...func(*cloudformation.Options)) (*cloudformation.ExecuteChangeSetOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.executed = append(f.executed, aws.ToString(in.ChangeSetName))
+	return &cloudformation.ExecuteChangeSetOutput{}, nil
+}
+
+func (f *fakeCloudFormation) DetectStackDrift(context.Context, *cloudformation.DetectStackDriftInput, ...func(*cloudformation.Options)) (*cloudformation.DetectStackDriftOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.DetectStackDriftOutput{StackDriftDetectionId: aws.String("drift-1")}, nil
+}
+
+func (f *fakeCloudFormation) DescribeStackDriftDetectionStatus(context.Context, *cloudformation.DescribeStackDriftDetectionStatusInput, ...func(*cloudformation.Options)) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.DescribeStackDriftDetectionStatusOutput{
+		DetectionStatus:           types.StackDriftDetectionStatusDetectionComplete,
+		StackDriftStatus:          types.StackDriftStatusDrifted,
+		DriftedStackResourceCount: aws.Int32(1),
+	}, nil
+}
+
+func (f *fakeCloudFormation) DescribeStackResourceDrifts(context.Context, *cloudformation.DescribeStackResourceDriftsInput, ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.DescribeStackResourceDriftsOutput{StackResourceDrifts: []types.StackResourceDrift{{
+		LogicalResourceId:        aws.String("Queue"),
+		ResourceType:             aws.String("AWS::SQS::Queue"),
+		StackResourceDriftStatus: types.StackResourceDriftStatusModified,
+		PropertyDifferences: []types.PropertyDifference{{
+			PropertyPath: aws.String("/VisibilityTimeout"), DifferenceType: types.DifferenceTypeNotEqual,
+			ExpectedValue: aws.String("30"), ActualValue: aws.String("120"),
+		}},
+	}}}, nil
+}
+
+func (f *fakeCloudFormation) DescribeStackEvents(context.Context, *cloudformation.DescribeStackEventsInput, ...func(*cloudformation.Options)) (*cloudformation.DescribeStackEventsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.DescribeStackEventsOutput{StackEvents: []types.StackEvent{{
+		LogicalResourceId: aws.String("app"), ResourceStatus: types.ResourceStatusUpdateRollbackComplete,
+	}}}, nil
+}
+
+func (f *fakeCloudFormation) ListStackResources(context.Context, *cloudformation.ListStackResourcesInput, ...func(*cloudformation.Options)) (*cloudformation.ListStackResourcesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudformation.ListStackResourcesOutput{StackResourceSummaries: []types.StackResourceSummary{
+		{LogicalResourceId: aws.String("Queue")},
+		{LogicalResourceId: aws.String("Function")},
+	}}, nil
+}
+
+func (f *fakeCloudFormation) DeleteStack(_ context.Context, in *cloudformation.DeleteStackInput, _ ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.StackName))
+	return &cloudformation.DeleteStackOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against stacks.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudFormation{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudFormation{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    "app",
+		Action:        "detect_drift",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestListFlagsStacks(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeCloudFormation{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"network": {"Resources have drifted from the template"},
+		"app":     {"Last update was rolled back", "1 change sets waiting to be executed"},
+		"broken":  {"Creation rolled back: the stack must be deleted before it can be created again"},
+	}
+	for _, r := range resources {
+		var messages []string
+		for _, issue := range r.Issues() {
+			messages = append(messages, issue.Message)
+		}
+		if !slices.Equal(messages, want[r.ID]) {
+			t.Errorf("%s issues = %q, want %q", r.ID, messages, want[r.ID])
+		}
+	}
+}
+
+// TestExecuteChangeSet checks that executing a change set asks for
+// confirmation with its diff, read across pages, and refuses change sets
+// that cannot be executed.
+func TestExecuteChangeSet(t *testing.T) {
+	fake := &fakeCloudFormation{}
+	svc := NewServiceWithClient(fake, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "execute_change_set", "app", map[string]any{"name": "add-queue"})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || confirm.Reason != "Updates app: 1 to add, 1 to modify (1 replaced), 0 to remove" {
+		t.Fatalf("unconfirmed execute_change_set error = %v", err)
+	}
+
+	_, err = svc.Execute(ctx, "execute_change_set", "app", map[string]any{"name": "noop", core.ParamConfirm: true})
+	if err == nil || !strings.Contains(err.Error(), "is unavailable: The submitted information didn't contain changes.") {
+		t.Errorf("execute_change_set noop error = %v", err)
+	}
+
+	if _, err := svc.Execute(ctx, "execute_change_set", "app", map[string]any{"name": "add-queue", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("execute_change_set error = %v", err)
+	}
+	if !slices.Equal(fake.executed, []string{"cs-1"}) {
+		t.Errorf("executed %v, want the change set's ID", fake.executed)
+	}
+}
+
+func TestDeleteRefusesProtectedStacks(t *testing.T) {
+	fake := &fakeCloudFormation{}
+	svc := NewServiceWithClient(fake, nil)
+
+	_, err := svc.Execute(context.Background(), "delete", "network", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "network"})
+	if err == nil || !strings.Contains(err.Error(), "termination protection") {
+		t.Errorf("delete network error = %v", err)
+	}
+
+	_, err = svc.Execute(context.Background(), "delete", "app", nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.HasPrefix(confirm.Reason, "Deletes app and its 2 resources") {
+		t.Errorf("unconfirmed delete app error = %v", err)
+	}
+	if len(fake.deleted) > 0 {
+		t.Errorf("deleted %v", fake.deleted)
+	}
+}
@@ -0,0 +1,46 @@
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// Templates
+// =============================================================================
+
+// TemplateYAML returns a template as YAML. YAML templates are returned as
+// written, comments included; JSON ones are converted, keeping their key
+// order.
+func TemplateYAML(body string) (string, error) {
+	if !json.Valid([]byte(body)) {
+		return body, nil
+	}
+
+	// JSON is YAML, so it parses into nodes that keep the key order
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(body), &doc); err != nil {
+		return "", err
+	}
+	blockStyle(&doc)
+
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n") + "\n", nil
+}
+
+// blockStyle drops the flow style and quotes of parsed JSON, leaving the
+// encoder to quote only the strings that need it.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, child := range n.Content {
+		blockStyle(child)
+	}
+}
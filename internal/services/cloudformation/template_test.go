@@ -0,0 +1,31 @@
+package cloudformation
+
+import "testing"
+
+func TestTemplateYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "json keeps key order",
+			body: `{"Resources":{"Queue":{"Type":"AWS::SQS::Queue","Properties":{"DelaySeconds":5,"QueueName":"jobs"}}},"AWSTemplateFormatVersion":"2010-09-09"}`,
+			want: "Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n    Properties:\n      DelaySeconds: 5\n      QueueName: jobs\nAWSTemplateFormatVersion: \"2010-09-09\"\n",
+		},
+		{
+			name: "yaml kept as written",
+			body: "# Queue stack\nResources:\n  Queue: {Type: AWS::SQS::Queue}\n",
+			want: "# Queue stack\nResources:\n  Queue: {Type: AWS::SQS::Queue}\n",
+		},
+	}
+	for _, tt := range tests {
+		got, err := TemplateYAML(tt.body)
+		if err != nil {
+			t.Fatalf("%s: TemplateYAML() error = %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: TemplateYAML() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,581 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const changeSetFormID = "cloudformation:change_set"
+
+var (
+	addStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
+	modifyStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	replaceStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("202")).Bold(true)
+	removeStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for CloudFormation stacks.
+type View struct {
+	*base.TableView
+
+	formTarget string // Stack the open form is for
+	formAction string // Action the change set form picks a change set for
+}
+
+// NewView creates a new CloudFormation view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Status"), MinWidth: 15, MaxWidth: 30, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Drift"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Change Sets"), MinWidth: 11, MaxWidth: 11, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Updated"), MinWidth: 16, MaxWidth: 16, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Description"), MinWidth: 10, MaxWidth: 60, Weight: 1.5, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("CloudFormation", "", "cloudformation", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadStacks()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading the template of %s...", row.Name)
+				return v, v.executeAction("template", row.ID, nil)
+			}
+		case "c":
+			if row := v.GetSelectedResource(); row != nil {
+				v.formAction = ""
+				return v, v.executeAction("change_sets", row.ID, nil)
+			}
+		case "d", "x":
+			if row := v.GetSelectedResource(); row != nil {
+				// Pick the change set once they are listed
+				v.formAction = "change_set"
+				if msg.String() == "x" {
+					v.formAction = "execute_change_set"
+				}
+				v.formTarget = row.ID
+				return v, v.executeAction("change_sets", row.ID, nil)
+			}
+		case "D":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Detecting drift on %s...", row.Name)
+				return v, v.executeAction("detect_drift", row.ID, nil)
+			}
+		case "e":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading the events of %s...", row.Name)
+				return v, v.executeAction("events", row.ID, nil)
+			}
+		case "X":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Stack %s", row.Name), formatStack(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != changeSetFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		cmds = append(cmds, v.runChangeSetAction(v.formTarget, msg.Values["name"]))
+
+	case stacksLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d stacks", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading stacks...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[t]emplate  [e]vents  [D]rift  [c]hange sets  [d]iff change set  e[x]ecute change set  [X] delete  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the stacks.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadStacks()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type stacksLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadStacks() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return stacksLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return stacksLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return stacksLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// runChangeSetAction previews or executes the named change set.
+func (v *View) runChangeSetAction(stack string, name any) tea.Cmd {
+	if v.formAction == "execute_change_set" {
+		v.Message = i18n.T("Checking change set %v...", name)
+	} else {
+		v.Message = i18n.T("Loading change set %v...", name)
+	}
+	return v.executeAction(v.formAction, stack, map[string]any{"name": name})
+}
+
+// pickChangeSet runs the pending change set action right away when the
+// stack has a single candidate, or asks which change set to use.
+func (v *View) pickChangeSet(changeSets []ChangeSet) tea.Cmd {
+	var names []string
+	for _, c := range changeSets {
+		if v.formAction == "change_set" || c.Executable() {
+			names = append(names, c.Name)
+		}
+	}
+	switch len(names) {
+	case 0:
+		if v.formAction == "execute_change_set" {
+			v.Message = i18n.T("%s has no change set ready to execute", v.formTarget)
+		} else {
+			v.Message = i18n.T("%s has no change sets", v.formTarget)
+		}
+		return nil
+	case 1:
+		return v.runChangeSetAction(v.formTarget, names[0])
+	}
+
+	def, ok := base.FindAction(v.Service(), v.formAction)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", v.formAction)
+		return nil
+	}
+	params := append([]core.ActionParameter(nil), def.Parameters...)
+	for i := range params {
+		if params[i].Name == "name" {
+			params[i].Type = "select"
+			params[i].Options = names
+			params[i].Default = names[0]
+		}
+	}
+	title := i18n.T("Preview a change set of %s", v.formTarget)
+	if v.formAction == "execute_change_set" {
+		title = i18n.T("Execute a change set of %s", v.formTarget)
+	}
+	return v.OpenForm(components.NewForm(changeSetFormID, title, params))
+}
+
+// handleResult shows an action's outcome: templates, events, drift reports
+// and diffs open in the detail panel, and listed change sets lead to the
+// action they were listed for.
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	switch data := msg.Result.Data.(type) {
+	case string:
+		if msg.Action == "template" {
+			v.OpenDetail(msg.Result.Message, components.HighlightYAML(data))
+		}
+		return nil
+	case []ChangeSet:
+		if v.formAction != "" {
+			return v.pickChangeSet(data)
+		}
+		v.OpenDetail(msg.Result.Message, formatChangeSets(data))
+		return nil
+	case Diff:
+		v.OpenDetail(i18n.T("Change set %s", data.ChangeSet.Name), formatDiff(data))
+		return nil
+	case []StackEvent:
+		v.OpenDetail(msg.Result.Message, formatEvents(data))
+		return nil
+	case DriftReport:
+		// Reload so the Drift column shows the new status
+		v.OpenDetail(i18n.T("Drift of %s", data.Stack), formatDrift(data))
+		return v.loadStacks()
+	}
+	return v.loadStacks()
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	changeSets := "-"
+	if n, _ := r.Metadata["change_sets"].(int); n > 0 {
+		changeSets = fmt.Sprint(n)
+	}
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(r.GetMetadataString("status")),
+		base.TextCell(orDash(strings.ToLower(r.GetMetadataString("drift")))),
+		base.TextCell(changeSets),
+		base.TextCell(formatUpdated(r)),
+		base.TextCell(r.GetMetadataString("description")),
+		base.SeverityCell(r),
+		base.AgeCell(r),
+	}
+}
+
+// formatStack renders a stack's status and protection for the detail panel.
+func formatStack(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", r.ARN)
+	fmt.Fprintf(&b, "Status:      %s\n", r.GetMetadataString("status"))
+	if reason := r.GetMetadataString("status_reason"); reason != "" {
+		fmt.Fprintf(&b, "Reason:      %s\n", reason)
+	}
+	if desc := r.GetMetadataString("description"); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
+	fmt.Fprintf(&b, "Drift:       %s", orDash(r.GetMetadataString("drift")))
+	if checked, ok := r.Metadata["drift_checked"].(time.Time); ok {
+		fmt.Fprintf(&b, " (checked %s)", checked.Local().Format("2006-01-02 15:04"))
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "Termination protection: %v\n", r.Metadata["termination_protection"])
+	if r.UpdatedAt != nil {
+		fmt.Fprintf(&b, "Updated:     %s\n", r.UpdatedAt.Local().Format("2006-01-02 15:04:05"))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatUpdated returns when a stack was last updated, or created when it
+// never was.
+func formatUpdated(r core.Resource) string {
+	switch {
+	case r.UpdatedAt != nil:
+		return r.UpdatedAt.Local().Format("2006-01-02 15:04")
+	case r.CreatedAt != nil:
+		return r.CreatedAt.Local().Format("2006-01-02 15:04")
+	}
+	return "-"
+}
+
+// formatEvents renders a stack's events, newest first, failures in red.
+func formatEvents(events []StackEvent) string {
+	if len(events) == 0 {
+		return i18n.T("The stack has no events.\n")
+	}
+
+	var b strings.Builder
+	for _, e := range events {
+		line := fmt.Sprintf("%s  %-28s %s (%s)", e.Time.Local().Format("2006-01-02 15:04:05"), e.Status, e.LogicalID, e.Type)
+		if e.Failed() {
+			line = removeStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+		if e.Reason != "" {
+			fmt.Fprintf(&b, "    %s\n", e.Reason)
+		}
+	}
+	return b.String()
+}
+
+// formatDrift renders the resources of a drift report with their expected
+// and actual property values.
+func formatDrift(d DriftReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d resources drifted\n\n", d.Status, d.Drifted)
+	if len(d.Resources) == 0 {
+		b.WriteString(i18n.T("Every resource matches the template.\n"))
+		return b.String()
+	}
+
+	for _, r := range d.Resources {
+		line := fmt.Sprintf("%-8s %s (%s)", r.Status, r.LogicalID, r.Type)
+		if r.PhysicalID != "" {
+			line += " " + r.PhysicalID
+		}
+		if r.Status == "DELETED" {
+			b.WriteString(removeStyle.Render(line) + "\n")
+		} else {
+			b.WriteString(modifyStyle.Render(line) + "\n")
+		}
+		for _, p := range r.Differences {
+			fmt.Fprintf(&b, "    %s  %s\n", p.Path, strings.ToLower(p.Type))
+			if p.Expected != "" {
+				b.WriteString(removeStyle.Render("      - "+p.Expected) + "\n")
+			}
+			if p.Actual != "" {
+				b.WriteString(addStyle.Render("      + "+p.Actual) + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// formatChangeSets renders a stack's change sets, newest first.
+func formatChangeSets(changeSets []ChangeSet) string {
+	if len(changeSets) == 0 {
+		return i18n.T("The stack has no change sets.\n")
+	}
+
+	var b strings.Builder
+	for i := len(changeSets) - 1; i >= 0; i-- {
+		c := changeSets[i]
+		fmt.Fprintf(&b, "%s  %s  %s / %s\n", c.CreatedAt.Local().Format("2006-01-02 15:04"), c.Name, c.Status, c.Execution)
+		if c.Description != "" {
+			fmt.Fprintf(&b, "    %s\n", c.Description)
+		}
+		if c.Reason != "" {
+			fmt.Fprintf(&b, "    %s\n", c.Reason)
+		}
+	}
+	return b.String()
+}
+
+// formatDiff renders the resource changes of a change set, with the before
+// and after values of changed properties where CloudFormation knows them.
+func formatDiff(d Diff) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s / %s  %s\n", d.ChangeSet.Status, d.ChangeSet.Execution, d.Summary())
+	if d.ChangeSet.Description != "" {
+		fmt.Fprintf(&b, "%s\n", d.ChangeSet.Description)
+	}
+	if d.ChangeSet.Reason != "" {
+		fmt.Fprintf(&b, "%s\n", d.ChangeSet.Reason)
+	}
+	b.WriteString("\n")
+
+	for _, c := range d.Changes {
+		line := fmt.Sprintf("%s %-8s %s (%s)", changeSymbol(c), c.Action, c.LogicalID, c.Type)
+		if c.PhysicalID != "" {
+			line += " " + c.PhysicalID
+		}
+		switch {
+		case c.Replacement == "True":
+			b.WriteString(replaceStyle.Render(line+"  "+i18n.T("replaced")) + "\n")
+		case c.Replacement == "Conditional":
+			b.WriteString(modifyStyle.Render(line+"  "+i18n.T("may be replaced")) + "\n")
+		default:
+			b.WriteString(changeStyle(c).Render(line) + "\n")
+		}
+
+		for _, p := range c.Details {
+			name := p.Attribute
+			if p.Name != "" {
+				name += "." + p.Name
+			}
+			fmt.Fprintf(&b, "    %s", name)
+			if p.Recreation != "" && p.Recreation != "Never" {
+				b.WriteString("  " + i18n.T("recreation: %s", p.Recreation))
+			}
+			if p.Cause != "" {
+				b.WriteString("  ← " + p.Cause)
+			}
+			b.WriteString("\n")
+			if p.Before != "" {
+				b.WriteString(removeStyle.Render("      - "+p.Before) + "\n")
+			}
+			if p.After != "" {
+				b.WriteString(addStyle.Render("      + "+p.After) + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func changeSymbol(c ResourceChange) string {
+	switch c.Action {
+	case "Add", "Import":
+		return "+"
+	case "Remove":
+		return "-"
+	case "Modify":
+		return "~"
+	}
+	return "?"
+}
+
+func changeStyle(c ResourceChange) lipgloss.Style {
+	switch c.Action {
+	case "Add", "Import":
+		return addStyle
+	case "Remove":
+		return removeStyle
+	}
+	return modifyStyle
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	pending, failed := 0, 0
+	for _, r := range v.Resources {
+		if n, _ := r.Metadata["change_sets"].(int); n > 0 {
+			pending++
+		}
+		if strings.HasSuffix(r.GetMetadataString("status"), "_FAILED") {
+			failed++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "change-sets", Text: i18n.T("With change sets: %d", pending), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "failed", Text: i18n.T("Failed: %d", failed), Tone: core.ToneError},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("CloudFormation Stacks"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "cloudformation" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,532 @@
+// Package cloudtrail provides CloudTrail integration for the a9s
+// application. It lists trails with their logging status and looks up the
+// recent management events of the event history, by resource name, user
+// name or event name, to answer questions such as who deleted a bucket.
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/ownership"
+)
+
+const (
+	// DefaultHours is how far back events are looked up unless the caller
+	// asks otherwise.
+	DefaultHours = 24
+	// maxHours is the event history CloudTrail retains.
+	maxHours = int(ownership.History / time.Hour)
+	// maxPages bounds how many pages of 50 events a lookup reads, since
+	// LookupEvents is limited to two calls per second.
+	maxPages = 5
+)
+
+// List filters selecting the events to list. Without an attribute and a
+// value, List returns trails; with them, the events matching them over the
+// last FilterHours hours.
+const (
+	FilterAttribute = "attribute"
+	FilterValue     = "value"
+	FilterHours     = "hours"
+)
+
+// lookupAttributes are the attributes events can be looked up by.
+var lookupAttributes = []string{
+	string(types.LookupAttributeKeyResourceName),
+	string(types.LookupAttributeKeyUsername),
+	string(types.LookupAttributeKeyEventName),
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements CloudTrail operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient CloudTrailAPI
+	now        func() time.Time
+}
+
+// CloudTrailAPI defines the CloudTrail client interface for mocking.
+type CloudTrailAPI interface {
+	DescribeTrails(ctx context.Context, params *cloudtrail.DescribeTrailsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error)
+	GetTrailStatus(ctx context.Context, params *cloudtrail.GetTrailStatusInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error)
+	LookupEvents(ctx context.Context, params *cloudtrail.LookupEventsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// NewService creates a new CloudTrail service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+		now:        time.Now,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client CloudTrailAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+		now:        time.Now,
+	}
+}
+
+// client returns the CloudTrail client, fetching fresh from factory each
+// time.
+func (s *Service) client() CloudTrailAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.CloudTrailClient()
+}
+
+// region returns the region events are looked up in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cloudtrail"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "CloudTrail"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "history"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{})
+	if err != nil {
+		return core.NewServiceError("cloudtrail", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the trails logging the region, or the events matching
+// FilterAttribute and FilterValue.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	var resources []core.Resource
+	var resourceType string
+	var err error
+	if value := opts.Filters[FilterValue]; value != "" {
+		resourceType = "cloudtrail:event"
+		var q query
+		q, err = parseQuery(opts.Filters[FilterAttribute], value, opts.Filters[FilterHours])
+		if err == nil {
+			resources, err = s.lookup(ctx, q)
+		}
+	} else {
+		resourceType = "cloudtrail:trail"
+		resources, err = s.listTrails(ctx)
+	}
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("cloudtrail", "list", err)
+	}
+	if resources == nil {
+		resources = []core.Resource{}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: resourceType,
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// listTrails lists the trails logging the region, including those of other
+// home regions or of the organization, with their logging status.
+func (s *Service) listTrails(ctx context.Context) ([]core.Resource, error) {
+	out, err := s.client().DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]core.Resource, 0, len(out.TrailList))
+	for _, trail := range out.TrailList {
+		resource := trailToResource(trail)
+		// Trails of other home regions are only readable by ARN
+		status, err := s.client().GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{Name: trail.TrailARN})
+		if err == nil {
+			applyStatus(&resource, status)
+		}
+		addTrailIssues(&resource)
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// query is an event lookup.
+type query struct {
+	attribute string
+	value     string
+	hours     int
+}
+
+// parseQuery validates the attribute, value and hours of a lookup. The
+// attribute defaults to the resource name and hours to DefaultHours.
+func parseQuery(attribute, value string, hours any) (query, error) {
+	q := query{attribute: attribute, value: strings.TrimSpace(value), hours: DefaultHours}
+	if q.attribute == "" {
+		q.attribute = string(types.LookupAttributeKeyResourceName)
+	}
+	if !slices.Contains(lookupAttributes, q.attribute) {
+		return query{}, core.NewValidationError("attribute", attribute, "must be one of "+strings.Join(lookupAttributes, ", "))
+	}
+	if q.value == "" {
+		return query{}, core.NewValidationError("value", value, "is required")
+	}
+	if hours != nil && hours != "" {
+		n, err := intParam(hours)
+		if err != nil || n < 1 || n > maxHours {
+			return query{}, core.NewValidationError("hours", hours, fmt.Sprintf("must be between 1 and %d", maxHours))
+		}
+		q.hours = n
+	}
+	return q, nil
+}
+
+// filters returns the List filters of the lookup.
+func (q query) filters() map[string]string {
+	return map[string]string{
+		FilterAttribute: q.attribute,
+		FilterValue:     q.value,
+		FilterHours:     strconv.Itoa(q.hours),
+	}
+}
+
+// lookup reads the events matching q, newest first, up to maxPages pages.
+func (s *Service) lookup(ctx context.Context, q query) ([]core.Resource, error) {
+	end := s.now()
+	input := &cloudtrail.LookupEventsInput{
+		LookupAttributes: []types.LookupAttribute{{
+			AttributeKey:   types.LookupAttributeKey(q.attribute),
+			AttributeValue: aws.String(q.value),
+		}},
+		StartTime:  aws.Time(end.Add(-time.Duration(q.hours) * time.Hour)),
+		EndTime:    aws.Time(end),
+		MaxResults: aws.Int32(50),
+	}
+
+	var resources []core.Resource
+	for page := 0; page < maxPages; page++ {
+		out, err := s.client().LookupEvents(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range out.Events {
+			resources = append(resources, eventToResource(event, s.region()))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for trails.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "lookup_events",
+			Description: "Look up recent management events by resource name, user name or event name",
+			Icon:        "search",
+			Shortcut:    "l",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "attribute", Type: "select", Options: lookupAttributes, Default: lookupAttributes[0], Description: "What to match the value against"},
+				{Name: "value", Type: "string", Required: true, Description: "Resource name (such as a bucket), user name or event name (such as DeleteBucket)"},
+				{Name: "hours", Type: "int", Default: DefaultHours, Description: fmt.Sprintf("Hours of history to search (1-%d)", maxHours)},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action. Event history is the account's in
+// the region, so lookup_events does not depend on the trail it is run on.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "lookup_events":
+		attribute, _ := params["attribute"].(string)
+		value, _ := params["value"].(string)
+		q, verr := parseQuery(attribute, value, params["hours"])
+		if verr != nil {
+			return nil, core.NewActionError(action, resourceID, verr)
+		}
+		result, err = s.lookupEvents(ctx, resourceID, q)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// EventLookup is the result data of the lookup_events action. Filters list
+// the same events again through List.
+type EventLookup struct {
+	Title   string
+	Filters map[string]string
+	Events  []core.Resource
+}
+
+func (s *Service) lookupEvents(ctx context.Context, resourceID string, q query) (*core.ActionResult, error) {
+	events, err := s.lookup(ctx, q)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("lookup_events", resourceID, err)
+	}
+
+	title := fmt.Sprintf("%s=%s", q.attribute, q.value)
+	result := core.NewActionResult(true, fmt.Sprintf("%d events for %s in the last %dh", len(events), title, q.hours))
+	result.Data = EventLookup{Title: title, Filters: q.filters(), Events: events}
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func trailToResource(trail types.Trail) core.Resource {
+	resource := core.Resource{
+		ID:     aws.ToString(trail.TrailARN),
+		Type:   "cloudtrail:trail",
+		Name:   aws.ToString(trail.Name),
+		ARN:    aws.ToString(trail.TrailARN),
+		State:  "unknown",
+		Region: aws.ToString(trail.HomeRegion),
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"home_region":      aws.ToString(trail.HomeRegion),
+			"multi_region":     aws.ToBool(trail.IsMultiRegionTrail),
+			"organization":     aws.ToBool(trail.IsOrganizationTrail),
+			"global_events":    aws.ToBool(trail.IncludeGlobalServiceEvents),
+			"log_validation":   aws.ToBool(trail.LogFileValidationEnabled),
+			"s3_bucket":        aws.ToString(trail.S3BucketName),
+			"s3_prefix":        aws.ToString(trail.S3KeyPrefix),
+			"kms_key":          aws.ToString(trail.KmsKeyId),
+			"log_group":        aws.ToString(trail.CloudWatchLogsLogGroupArn),
+			"event_selectors":  aws.ToBool(trail.HasCustomEventSelectors),
+			"insight_selector": aws.ToBool(trail.HasInsightSelectors),
+			"analyzed":         true,
+		},
+	}
+	iac.Apply(&resource)
+	return resource
+}
+
+// applyStatus records whether a trail is logging and how its last delivery
+// went.
+func applyStatus(resource *core.Resource, status *cloudtrail.GetTrailStatusOutput) {
+	logging := aws.ToBool(status.IsLogging)
+	resource.Metadata["logging"] = logging
+	resource.State = "stopped"
+	if logging {
+		resource.State = "logging"
+	}
+	if status.LatestDeliveryTime != nil {
+		resource.Metadata["latest_delivery"] = *status.LatestDeliveryTime
+	}
+	resource.Metadata["delivery_error"] = aws.ToString(status.LatestDeliveryError)
+}
+
+// addTrailIssues records the issues of a trail. A stopped trail or one
+// failing to deliver leaves API activity unrecorded past the 90 days of
+// event history.
+func addTrailIssues(resource *core.Resource) {
+	if logging, known := resource.Metadata["logging"].(bool); known && !logging {
+		resource.AddIssue(core.SeverityMedium, "Logging is stopped; API activity is not recorded")
+	}
+	if deliveryError := resource.GetMetadataString("delivery_error"); deliveryError != "" {
+		resource.AddIssue(core.SeverityMedium, "Log delivery failing: "+deliveryError)
+	}
+	if multi, _ := resource.Metadata["multi_region"].(bool); !multi {
+		resource.AddIssue(core.SeverityLow, "Single-region trail; activity in other regions is not recorded")
+	}
+	if validation, _ := resource.Metadata["log_validation"].(bool); !validation {
+		resource.AddIssue(core.SeverityLow, "Log file validation disabled; tampering with logs goes unnoticed")
+	}
+}
+
+// record is the part of a CloudTrail record not returned alongside it.
+type record struct {
+	SourceIPAddress string `json:"sourceIPAddress"`
+	UserAgent       string `json:"userAgent"`
+	AWSRegion       string `json:"awsRegion"`
+	ErrorCode       string `json:"errorCode"`
+	ErrorMessage    string `json:"errorMessage"`
+}
+
+// eventToResource converts an event. Events whose call failed are flagged
+// informational, since denied calls are often what is being looked for.
+func eventToResource(event types.Event, region string) core.Resource {
+	resources := make([]string, 0, len(event.Resources))
+	for _, r := range event.Resources {
+		resources = append(resources, aws.ToString(r.ResourceName))
+	}
+
+	resource := core.Resource{
+		ID:        aws.ToString(event.EventId),
+		Type:      "cloudtrail:event",
+		Name:      aws.ToString(event.EventName),
+		State:     "succeeded",
+		Region:    region,
+		CreatedAt: event.EventTime,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"event_name":   aws.ToString(event.EventName),
+			"event_source": aws.ToString(event.EventSource),
+			"username":     aws.ToString(event.Username),
+			"access_key":   aws.ToString(event.AccessKeyId),
+			"read_only":    aws.ToString(event.ReadOnly) == "true",
+			"resources":    resources,
+			"raw":          aws.ToString(event.CloudTrailEvent),
+			"analyzed":     true,
+		},
+	}
+
+	var rec record
+	if err := json.Unmarshal([]byte(aws.ToString(event.CloudTrailEvent)), &rec); err == nil {
+		resource.Metadata["source_ip"] = rec.SourceIPAddress
+		resource.Metadata["user_agent"] = rec.UserAgent
+		if rec.AWSRegion != "" {
+			resource.Region = rec.AWSRegion
+		}
+		if rec.ErrorCode != "" {
+			resource.State = "failed"
+			resource.Metadata["error_code"] = rec.ErrorCode
+			resource.Metadata["error_message"] = rec.ErrorMessage
+			resource.AddIssue(core.SeverityInfo, "Call failed: "+rec.ErrorCode)
+		}
+	}
+	// The principal is only named in the record for some identity types
+	if owner := ownership.OwnerOfEvent(event); owner.Principal != "" {
+		resource.Metadata["username"] = owner.Principal
+		resource.Metadata["principal_arn"] = owner.ARN
+	}
+	return resource
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "cloudtrail", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "cloudtrail", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,166 @@
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	orgTrail   = "arn:aws:cloudtrail:us-east-1:123456789012:trail/org"
+	localTrail = "arn:aws:cloudtrail:eu-west-1:123456789012:trail/local"
+)
+
+// fakeCloudTrail serves a logging multi-region trail and a stopped local
+// one, and the deletion of a bucket by an assumed role followed by a denied
+// call, or fails every call when err is set. It records the lookups.
+type fakeCloudTrail struct {
+	err     error
+	lookups []*cloudtrail.LookupEventsInput
+}
+
+func (f *fakeCloudTrail) DescribeTrails(_ context.Context, _ *cloudtrail.DescribeTrailsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudtrail.DescribeTrailsOutput{TrailList: []types.Trail{
+		{
+			Name:                     aws.String("org"),
+			TrailARN:                 aws.String(orgTrail),
+			HomeRegion:               aws.String("us-east-1"),
+			IsMultiRegionTrail:       aws.Bool(true),
+			LogFileValidationEnabled: aws.Bool(true),
+			S3BucketName:             aws.String("org-trail"),
+		},
+		{
+			Name:         aws.String("local"),
+			TrailARN:     aws.String(localTrail),
+			HomeRegion:   aws.String("eu-west-1"),
+			S3BucketName: aws.String("local-trail"),
+		},
+	}}, nil
+}
+
+func (f *fakeCloudTrail) GetTrailStatus(_ context.Context, in *cloudtrail.GetTrailStatusInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudtrail.GetTrailStatusOutput{IsLogging: aws.Bool(aws.ToString(in.Name) == orgTrail)}, nil
+}
+
+func (f *fakeCloudTrail) LookupEvents(_ context.Context, in *cloudtrail.LookupEventsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.lookups = append(f.lookups, in)
+	at := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	return &cloudtrail.LookupEventsOutput{Events: []types.Event{
+		{
+			EventId:     aws.String("ev-1"),
+			EventName:   aws.String("DeleteBucket"),
+			EventSource: aws.String("s3.amazonaws.com"),
+			EventTime:   aws.Time(at),
+			Username:    aws.String("session"),
+			Resources:   []types.Resource{{ResourceName: aws.String("reports"), ResourceType: aws.String("AWS::S3::Bucket")}},
+			CloudTrailEvent: aws.String(`{"userIdentity":{"type":"AssumedRole","arn":"arn:aws:sts::123456789012:assumed-role/Admin/alice"},` +
+				`"sourceIPAddress":"198.51.100.7","awsRegion":"eu-west-1"}`),
+		},
+		{
+			EventId:         aws.String("ev-2"),
+			EventName:       aws.String("DeleteBucket"),
+			EventSource:     aws.String("s3.amazonaws.com"),
+			EventTime:       aws.Time(at.Add(-time.Hour)),
+			Username:        aws.String("bob"),
+			CloudTrailEvent: aws.String(`{"userIdentity":{"type":"IAMUser","userName":"bob"},"errorCode":"AccessDenied","errorMessage":"Access Denied"}`),
+		},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against trails.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudTrail{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudTrail{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:   orgTrail,
+		Action:       "lookup_events",
+		ActionParams: map[string]any{"value": "reports"},
+	})
+}
+
+func TestListFlagsTrails(t *testing.T) {
+	svc := NewServiceWithClient(&fakeCloudTrail{}, nil)
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() returned %d trails, want 2", len(resources))
+	}
+
+	org, local := resources[0], resources[1]
+	if org.State != "logging" || len(org.Issues()) != 0 {
+		t.Errorf("org trail state %q, issues %v", org.State, org.Issues())
+	}
+	if local.State != "stopped" || local.Severity() != core.SeverityMedium || len(local.Issues()) != 3 {
+		t.Errorf("local trail state %q, issues %v", local.State, local.Issues())
+	}
+}
+
+func TestLookupEvents(t *testing.T) {
+	client := &fakeCloudTrail{}
+	svc := NewServiceWithClient(client, nil)
+	now := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := svc.Execute(ctx, "lookup_events", orgTrail, map[string]any{"value": " "}); err == nil {
+		t.Error("lookup without a value succeeded")
+	}
+	if _, err := svc.Execute(ctx, "lookup_events", orgTrail, map[string]any{"value": "reports", "hours": 5000}); err == nil {
+		t.Error("lookup past the event history succeeded")
+	}
+
+	result, err := svc.Execute(ctx, "lookup_events", orgTrail, map[string]any{"attribute": "ResourceName", "value": "reports", "hours": 48})
+	if err != nil {
+		t.Fatalf("lookup_events error = %v", err)
+	}
+	in := client.lookups[len(client.lookups)-1]
+	if got := aws.ToString(in.LookupAttributes[0].AttributeValue); got != "reports" || !in.StartTime.Equal(now.Add(-48*time.Hour)) {
+		t.Errorf("looked up %q from %v", got, in.StartTime)
+	}
+
+	lookup, ok := result.Data.(EventLookup)
+	if !ok || len(lookup.Events) != 2 || lookup.Filters[FilterHours] != "48" {
+		t.Fatalf("result data = %+v", result.Data)
+	}
+	deleted, denied := lookup.Events[0], lookup.Events[1]
+	if deleted.GetMetadataString("username") != "Admin/alice" || deleted.Region != "eu-west-1" || deleted.GetMetadataString("source_ip") != "198.51.100.7" {
+		t.Errorf("deletion by %q in %q from %q", deleted.GetMetadataString("username"), deleted.Region, deleted.GetMetadataString("source_ip"))
+	}
+	if resources, _ := deleted.Metadata["resources"].([]string); len(resources) != 1 || resources[0] != "reports" {
+		t.Errorf("deletion resources = %v", deleted.Metadata["resources"])
+	}
+	if denied.State != "failed" || !strings.Contains(denied.Issues()[0].Message, "AccessDenied") {
+		t.Errorf("denied call state %q, issues %v", denied.State, denied.Issues())
+	}
+
+	// List runs the same lookup from its filters, as the view refreshes it
+	events, err := svc.List(ctx, core.ListOptions{Filters: lookup.Filters})
+	if err != nil || len(events) != 2 {
+		t.Errorf("List(%v) = %d events, %v", lookup.Filters, len(events), err)
+	}
+}
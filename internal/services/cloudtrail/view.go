@@ -0,0 +1,503 @@
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const lookupFormID = "cloudtrail:lookup"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for CloudTrail trails, drilling down into
+// the events of a lookup.
+type View struct {
+	*base.TableView
+
+	formTarget string // Trail the lookup form is open for
+}
+
+// NewView creates a new CloudTrail view.
+func NewView() *View {
+	return &View{
+		TableView: base.NewTableView("CloudTrail", "", "cloudtrail", trailColumns()),
+	}
+}
+
+func trailColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Trail"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Home Region"), MinWidth: 11, MaxWidth: 16, Weight: 0.4, Priority: 2},
+		{Title: i18n.T("Multi-Region"), MinWidth: 12, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Validation"), MinWidth: 10, MaxWidth: 10, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Bucket"), MinWidth: 15, MaxWidth: 50, Weight: 1.0, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+}
+
+func eventColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Time"), MinWidth: 19, MaxWidth: 19, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Event"), MinWidth: 15, MaxWidth: 40, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("User"), MinWidth: 12, MaxWidth: 40, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Source"), MinWidth: 12, MaxWidth: 30, Weight: 0.6, Priority: 2},
+		{Title: i18n.T("Resources"), MinWidth: 15, MaxWidth: 60, Weight: 1.2, Priority: 1},
+		{Title: i18n.T("Error"), MinWidth: 8, MaxWidth: 30, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Source IP"), MinWidth: 9, MaxWidth: 20, Weight: 0.3, Priority: 3},
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadResources()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if cmd, handled := v.handleKey(msg); handled {
+			return v, cmd
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != lookupFormID {
+			break
+		}
+		if msg.Canceled {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		value, _ := msg.Values["value"].(string)
+		v.Message = i18n.T("Looking up events for %s...", value)
+		cmds = append(cmds, v.executeAction("lookup_events", v.formTarget, msg.Values))
+
+	case resourcesLoadedMsg:
+		// Listings of a level the operator has left are dropped
+		if msg.owner != v || msg.level != v.Breadcrumb() {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			if v.atTrails() {
+				v.Message = i18n.T("Loaded %d trails", len(msg.resources))
+			} else {
+				v.Message = i18n.T("Loaded %d events", len(msg.resources))
+			}
+		}
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		loading := i18n.T("Loading trails...")
+		if crumb := v.Breadcrumb(); crumb != "" {
+			loading = i18n.T("Loading %s...", crumb)
+		}
+		lines = append(lines, v.Styles.Muted.Render(loading))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	help := i18n.T("[l]ookup events  [Enter]details  [↑/↓]navigate  [r]efresh")
+	if !v.atTrails() {
+		help = i18n.T("[Enter]details  [u]ser's events  [l]ookup again  [Esc]back  [r]efresh")
+	}
+	lines = append(lines, v.Styles.Help.Render(help))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the current level.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadResources()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type resourcesLoadedMsg struct {
+	owner     *View  // Listings of a swapped-out view are dropped
+	level     string // Breadcrumb of the level listed
+	resources []core.Resource
+	err       error
+}
+
+// atTrails reports whether the trails are shown rather than the events of
+// a lookup.
+func (v *View) atTrails() bool {
+	return v.DrillFilters()[FilterValue] == ""
+}
+
+// handleKey handles the keys of the current level.
+func (v *View) handleKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	row := v.GetSelectedResource()
+
+	if v.atTrails() {
+		switch msg.String() {
+		case "l":
+			if row != nil {
+				v.formTarget = row.ID
+			}
+			return v.openLookupForm(nil), true
+		case "enter":
+			if row != nil {
+				v.OpenDetail(i18n.T("Trail %s", row.Name), formatTrail(*row))
+				return nil, true
+			}
+		}
+		return nil, false
+	}
+
+	switch msg.String() {
+	case "l":
+		return v.openLookupForm(v.DrillFilters()), true
+	case "enter":
+		if row != nil {
+			v.OpenDetail(i18n.T("Event %s", row.Name), formatEvent(*row))
+			return nil, true
+		}
+	case "u":
+		if row != nil {
+			user := row.GetMetadataString("username")
+			if user == "" {
+				v.Message = i18n.T("The event names no user")
+				return nil, true
+			}
+			v.Message = i18n.T("Looking up events for %s...", user)
+			hours := v.DrillFilters()[FilterHours]
+			return v.executeAction("lookup_events", v.formTarget, map[string]any{
+				"attribute": "Username",
+				"value":     user,
+				"hours":     hours,
+			}), true
+		}
+	}
+	return nil, false
+}
+
+func (v *View) loadResources() tea.Cmd {
+	v.SetLoading(true)
+	level, filters := v.Breadcrumb(), v.DrillFilters()
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{Filters: filters})
+		return resourcesLoadedMsg{owner: v, level: level, resources: resources, err: err}
+	}
+}
+
+// openLookupForm asks what to look events up by, starting from the filters
+// of the current lookup, if any.
+func (v *View) openLookupForm(current map[string]string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "lookup_events")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "lookup_events")
+		return nil
+	}
+	params := slices.Clone(def.Parameters)
+	for i, p := range params {
+		if value := current[p.Name]; value != "" {
+			params[i].Default = value
+		}
+	}
+	return v.OpenForm(components.NewForm(lookupFormID, i18n.T("Look up CloudTrail events"), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// handleResult shows the events of a lookup one level below the trails,
+// replacing the events of a previous lookup.
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+
+	lookup, ok := msg.Result.Data.(EventLookup)
+	if !ok {
+		v.Message = msg.Result.Message
+		return nil
+	}
+	if !v.atTrails() {
+		v.DrillUp()
+	}
+	v.DrillDown(base.DrillLevel{
+		Title:      lookup.Title,
+		Filters:    lookup.Filters,
+		ColumnDefs: eventColumns(),
+	})
+	v.SetError(nil)
+	v.Resources = lookup.Events
+	v.updateTable()
+	v.Message = msg.Result.Message
+	return nil
+}
+
+func (v *View) updateTable() {
+	buildRow := buildEventRow
+	if v.atTrails() {
+		buildRow = buildTrailRow
+	}
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildTrailRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(r.GetMetadataString("home_region")),
+		base.TextCell(check(r, "multi_region")),
+		base.TextCell(check(r, "log_validation")),
+		base.TextCell(base.TruncateString(r.GetMetadataString("s3_bucket"), 50)),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+func buildEventRow(r core.Resource) base.Row {
+	when := "-"
+	if r.CreatedAt != nil {
+		when = r.CreatedAt.Local().Format("2006-01-02 15:04:05")
+	}
+	resources, _ := r.Metadata["resources"].([]string)
+	return base.Row{
+		base.TextCell(when),
+		base.TextCell(r.Name),
+		base.TextCell(base.TruncateString(orDash(r.GetMetadataString("username")), 40)),
+		base.TextCell(strings.TrimSuffix(r.GetMetadataString("event_source"), ".amazonaws.com")),
+		base.TextCell(base.TruncateString(orDash(strings.Join(resources, ", ")), 60)),
+		base.TextCell(orDash(r.GetMetadataString("error_code"))),
+		base.TextCell(orDash(r.GetMetadataString("source_ip"))),
+	}
+}
+
+// check renders a boolean setting of a trail.
+func check(r core.Resource, key string) string {
+	if enabled, _ := r.Metadata[key].(bool); enabled {
+		return "✓"
+	}
+	return "-"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatTrail renders a trail for the detail panel.
+func formatTrail(r core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:             %s\n", r.ARN)
+	fmt.Fprintf(&b, "State:           %s\n", r.State)
+	fmt.Fprintf(&b, "Home region:     %s\n", r.GetMetadataString("home_region"))
+	fmt.Fprintf(&b, "Multi-region:    %s\n", check(r, "multi_region"))
+	fmt.Fprintf(&b, "Organization:    %s\n", check(r, "organization"))
+	fmt.Fprintf(&b, "Global events:   %s\n", check(r, "global_events"))
+	fmt.Fprintf(&b, "Validation:      %s\n", check(r, "log_validation"))
+	fmt.Fprintf(&b, "Bucket:          %s/%s\n", r.GetMetadataString("s3_bucket"), r.GetMetadataString("s3_prefix"))
+	if key := r.GetMetadataString("kms_key"); key != "" {
+		fmt.Fprintf(&b, "KMS key:         %s\n", key)
+	}
+	if group := r.GetMetadataString("log_group"); group != "" {
+		fmt.Fprintf(&b, "Log group:       %s\n", group)
+	}
+	if delivered, ok := r.Metadata["latest_delivery"].(time.Time); ok {
+		fmt.Fprintf(&b, "Last delivery:   %s\n", delivered.Local().Format("2006-01-02 15:04:05"))
+	}
+	if deliveryError := r.GetMetadataString("delivery_error"); deliveryError != "" {
+		fmt.Fprintf(&b, "Delivery error:  %s\n", deliveryError)
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatEvent renders an event with its full record for the detail panel.
+func formatEvent(r core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Event:       %s\n", r.Name)
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Time:        %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+	}
+	fmt.Fprintf(&b, "Source:      %s\n", r.GetMetadataString("event_source"))
+	fmt.Fprintf(&b, "User:        %s\n", r.GetMetadataString("username"))
+	if arn := r.GetMetadataString("principal_arn"); arn != "" {
+		fmt.Fprintf(&b, "Principal:   %s\n", arn)
+	}
+	if key := r.GetMetadataString("access_key"); key != "" {
+		fmt.Fprintf(&b, "Access key:  %s\n", key)
+	}
+	fmt.Fprintf(&b, "Source IP:   %s\n", r.GetMetadataString("source_ip"))
+	fmt.Fprintf(&b, "Region:      %s\n", r.Region)
+	if code := r.GetMetadataString("error_code"); code != "" {
+		fmt.Fprintf(&b, "Error:       %s: %s\n", code, r.GetMetadataString("error_message"))
+	}
+
+	var record any
+	if err := json.Unmarshal([]byte(r.GetMetadataString("raw")), &record); err == nil {
+		if pretty, err := json.MarshalIndent(record, "", "  "); err == nil {
+			b.WriteString(i18n.T("\nRecord:\n"))
+			b.Write(pretty)
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider. Trail widgets stay empty,
+// and are skipped, among events, and the other way around.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	var stopped, failed string
+	if v.atTrails() {
+		count := 0
+		for _, r := range v.Resources {
+			if r.State == "stopped" {
+				count++
+			}
+		}
+		stopped = i18n.T("Not logging: %d", count)
+	} else {
+		count := 0
+		for _, r := range v.Resources {
+			if r.State == "failed" {
+				count++
+			}
+		}
+		failed = i18n.T("Failed calls: %d", count)
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "stopped", Text: stopped, Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "failed", Text: failed, Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	title := i18n.T("CloudTrail Trails")
+	if crumb := v.Breadcrumb(); crumb != "" {
+		title = "CloudTrail › " + crumb
+	}
+	return v.RenderSummary(title, v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "cloudtrail" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
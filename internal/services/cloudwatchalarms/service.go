@@ -0,0 +1,592 @@
+// Package cloudwatchalarms provides CloudWatch alarm integration for the a9s
+// application. It lists metric and composite alarms grouped by state, sets
+// their state for testing, enables and disables their actions and shows
+// their history.
+package cloudwatchalarms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Alarm states, in the order alarms are grouped in.
+const (
+	StateAlarm            = string(types.StateValueAlarm)
+	StateInsufficientData = string(types.StateValueInsufficientData)
+	StateOK               = string(types.StateValueOk)
+)
+
+// States are the alarm states, the most urgent first.
+var States = []string{StateAlarm, StateInsufficientData, StateOK}
+
+// FilterState is the list filter restricting alarms to one state.
+const FilterState = "state"
+
+// historyRecords is the number of history items the history action reads.
+const historyRecords = 50
+
+// HistoryItem is a change of an alarm, in the result data of the
+// view_history action.
+type HistoryItem struct {
+	Time    time.Time
+	Type    string // StateUpdate, ConfigurationUpdate or Action
+	Summary string
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements CloudWatch alarm operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient CloudWatchAPI
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for alarms.
+type CloudWatchAPI interface {
+	DescribeAlarms(ctx context.Context, params *cloudwatch.DescribeAlarmsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error)
+	DescribeAlarmHistory(ctx context.Context, params *cloudwatch.DescribeAlarmHistoryInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error)
+	SetAlarmState(ctx context.Context, params *cloudwatch.SetAlarmStateInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.SetAlarmStateOutput, error)
+	EnableAlarmActions(ctx context.Context, params *cloudwatch.EnableAlarmActionsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.EnableAlarmActionsOutput, error)
+	DisableAlarmActions(ctx context.Context, params *cloudwatch.DisableAlarmActionsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.DisableAlarmActionsOutput, error)
+}
+
+// NewService creates a new CloudWatch alarms service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client CloudWatchAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the CloudWatch client.
+func (s *Service) client() CloudWatchAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cloudwatchalarms"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "CloudWatch Alarms"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "bell"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeAlarms(ctx, &cloudwatch.DescribeAlarmsInput{MaxRecords: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("cloudwatchalarms", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the metric and composite alarms of the region grouped by
+// state, alarms in ALARM first, then by name. The "state" filter lists the
+// alarms in one state only.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
+	}
+	if state := opts.Filters[FilterState]; state != "" {
+		if !slices.Contains(States, state) {
+			return nil, core.NewValidationError(FilterState, state, "must be ALARM, INSUFFICIENT_DATA or OK")
+		}
+		input.StateValue = types.StateValue(state)
+	}
+
+	resources := []core.Resource{}
+	paginator := cloudwatch.NewDescribeAlarmsPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("cloudwatchalarms", "list", err)
+		}
+		for _, alarm := range page.MetricAlarms {
+			resources = append(resources, metricAlarmToResource(alarm))
+		}
+		for _, alarm := range page.CompositeAlarms {
+			resources = append(resources, compositeAlarmToResource(alarm))
+		}
+	}
+	slices.SortStableFunc(resources, func(a, b core.Resource) int {
+		if by := slices.Index(States, a.State) - slices.Index(States, b.State); by != 0 {
+			return by
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "cloudwatch:alarm",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get retrieves an alarm by name.
+func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
+	resource, err := s.lookup(ctx, id)
+	if err != nil {
+		return nil, core.NewServiceError("cloudwatchalarms", "get", err)
+	}
+	return resource, nil
+}
+
+// lookup reads an alarm, metric or composite, by name.
+func (s *Service) lookup(ctx context.Context, name string) (*core.Resource, error) {
+	out, err := s.client().DescribeAlarms(ctx, &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resource core.Resource
+	switch {
+	case len(out.MetricAlarms) > 0:
+		resource = metricAlarmToResource(out.MetricAlarms[0])
+	case len(out.CompositeAlarms) > 0:
+		resource = compositeAlarmToResource(out.CompositeAlarms[0])
+	default:
+		return nil, core.ErrResourceNotFound
+	}
+	return &resource, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for alarms.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "set_state",
+			Description: "Set the alarm's state until its next evaluation, to test its actions",
+			Icon:        "bell",
+			Shortcut:    "S",
+			Dangerous:   true,
+			Category:    "test",
+			Parameters: []core.ActionParameter{
+				{Name: "state", Type: "select", Options: States, Default: StateAlarm, Required: true, Description: "State to set"},
+				{Name: "reason", Type: "string", Default: "Testing alarm actions from a9s", Required: true, Description: "Reason recorded in the alarm's history"},
+			},
+		},
+		{
+			Name:        "disable_actions",
+			Description: "Stop the alarm from running its actions",
+			Icon:        "pause",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "state",
+		},
+		{
+			Name:        "enable_actions",
+			Description: "Let the alarm run its actions again",
+			Icon:        "play",
+			Shortcut:    "e",
+			Category:    "state",
+		},
+		{
+			Name:        "view_history",
+			Description: "Show the alarm's latest state changes, updates and actions",
+			Icon:        "history",
+			Shortcut:    "h",
+			Category:    "inspect",
+		},
+	}
+}
+
+// Execute runs the specified action on an alarm, identified by its name.
+// Setting the state and disabling actions ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "set_state":
+		state, _ := params["state"].(string)
+		reason, _ := params["reason"].(string)
+		result, err = s.setState(ctx, resourceID, state, strings.TrimSpace(reason), params, confirmed)
+	case "disable_actions":
+		result, err = s.setActionsEnabled(ctx, resourceID, false, params, confirmed)
+	case "enable_actions":
+		result, err = s.setActionsEnabled(ctx, resourceID, true, params, true)
+	case "view_history":
+		result, err = s.viewHistory(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// setState sets the state of an alarm once confirmed. The alarm runs the
+// actions of the new state when its actions are enabled, and goes back to
+// its actual state at its next evaluation.
+func (s *Service) setState(ctx context.Context, name, state, reason string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("set_state", name, err)
+	}
+	if !slices.Contains(States, state) {
+		return fail(core.NewValidationError("state", state, "must be ALARM, INSUFFICIENT_DATA or OK"))
+	}
+	if reason == "" {
+		return fail(core.NewValidationError("reason", reason, "is required"))
+	}
+
+	alarm, err := s.lookup(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+
+	if !confirmed {
+		actions, _ := alarm.Metadata["actions"].(map[string][]string)
+		enabled, _ := alarm.Metadata["actions_enabled"].(bool)
+		text := fmt.Sprintf("Sets %s from %s to %s until its next evaluation", name, alarm.State, state)
+		switch {
+		case state == alarm.State:
+			text += "; it is already in that state, so no action runs"
+		case !enabled:
+			text += "; its actions are disabled, so none runs"
+		case len(actions[state]) == 0:
+			text += fmt.Sprintf("; it has no %s actions", state)
+		default:
+			text += fmt.Sprintf(", running its %s actions: %s", state, strings.Join(actions[state], ", "))
+		}
+		return nil, core.NewConfirmationError(s, "set_state", name, params, text, false)
+	}
+
+	if _, err := s.client().SetAlarmState(ctx, &cloudwatch.SetAlarmStateInput{
+		AlarmName:   aws.String(name),
+		StateValue:  types.StateValue(state),
+		StateReason: aws.String(reason),
+	}); err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceUpdated, core.ResourceEventData{
+		ResourceID:   name,
+		ResourceType: "cloudwatch:alarm",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Set %s to %s", name, state)), nil
+}
+
+// setActionsEnabled enables or disables the actions of an alarm. Disabling
+// asks for confirmation, since state changes then notify no one.
+func (s *Service) setActionsEnabled(ctx context.Context, name string, enabled bool, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	action := "disable_actions"
+	if enabled {
+		action = "enable_actions"
+	}
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError(action, name, err)
+	}
+
+	if !confirmed {
+		alarm, err := s.lookup(ctx, name)
+		if err != nil {
+			return fail(err)
+		}
+		text := fmt.Sprintf("%s stays %s and keeps changing state, but runs none of its %d action(s) until they are enabled again", name, alarm.State, alarm.Metadata["action_count"])
+		return nil, core.NewConfirmationError(s, action, name, params, text, false)
+	}
+
+	var err error
+	if enabled {
+		_, err = s.client().EnableAlarmActions(ctx, &cloudwatch.EnableAlarmActionsInput{AlarmNames: []string{name}})
+	} else {
+		_, err = s.client().DisableAlarmActions(ctx, &cloudwatch.DisableAlarmActionsInput{AlarmNames: []string{name}})
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceUpdated, core.ResourceEventData{
+		ResourceID:   name,
+		ResourceType: "cloudwatch:alarm",
+	})
+
+	if enabled {
+		return core.NewActionResult(true, fmt.Sprintf("Enabled the actions of %s", name)), nil
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Disabled the actions of %s", name)), nil
+}
+
+// viewHistory returns the latest history items of an alarm, newest first.
+func (s *Service) viewHistory(ctx context.Context, name string) (*core.ActionResult, error) {
+	out, err := s.client().DescribeAlarmHistory(ctx, &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:  aws.String(name),
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
+		MaxRecords: aws.Int32(historyRecords),
+		ScanBy:     types.ScanByTimestampDescending,
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_history", name, err)
+	}
+
+	items := make([]HistoryItem, len(out.AlarmHistoryItems))
+	for i, item := range out.AlarmHistoryItems {
+		items[i] = HistoryItem{
+			Time:    aws.ToTime(item.Timestamp),
+			Type:    string(item.HistoryItemType),
+			Summary: aws.ToString(item.HistorySummary),
+		}
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d history items for %s", len(items), name))
+	result.Data = items
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func metricAlarmToResource(alarm types.MetricAlarm) core.Resource {
+	condition := "metric math"
+	metric := "-"
+	if alarm.MetricName != nil {
+		metric = aws.ToString(alarm.Namespace) + " " + aws.ToString(alarm.MetricName)
+		statistic := string(alarm.Statistic)
+		if statistic == "" {
+			statistic = aws.ToString(alarm.ExtendedStatistic)
+		}
+		condition = fmt.Sprintf("%s %s %g for %d x %ds", statistic, comparison(alarm.ComparisonOperator), aws.ToFloat64(alarm.Threshold),
+			aws.ToInt32(alarm.EvaluationPeriods), aws.ToInt32(alarm.Period))
+	} else if alarm.ThresholdMetricId != nil {
+		condition = fmt.Sprintf("%s anomaly band %s", comparison(alarm.ComparisonOperator), aws.ToString(alarm.ThresholdMetricId))
+	}
+	dimensions := make([]string, len(alarm.Dimensions))
+	for i, d := range alarm.Dimensions {
+		dimensions[i] = aws.ToString(d.Name) + "=" + aws.ToString(d.Value)
+	}
+
+	return alarmResource(alarmFields{
+		name:       aws.ToString(alarm.AlarmName),
+		arn:        aws.ToString(alarm.AlarmArn),
+		kind:       "metric",
+		state:      alarm.StateValue,
+		reason:     aws.ToString(alarm.StateReason),
+		updated:    alarm.StateUpdatedTimestamp,
+		enabled:    aws.ToBool(alarm.ActionsEnabled),
+		alarm:      alarm.AlarmActions,
+		ok:         alarm.OKActions,
+		noData:     alarm.InsufficientDataActions,
+		definition: condition,
+		metadata: map[string]any{
+			"metric":      metric,
+			"dimensions":  dimensions,
+			"description": aws.ToString(alarm.AlarmDescription),
+		},
+	})
+}
+
+func compositeAlarmToResource(alarm types.CompositeAlarm) core.Resource {
+	return alarmResource(alarmFields{
+		name:       aws.ToString(alarm.AlarmName),
+		arn:        aws.ToString(alarm.AlarmArn),
+		kind:       "composite",
+		state:      alarm.StateValue,
+		reason:     aws.ToString(alarm.StateReason),
+		updated:    alarm.StateUpdatedTimestamp,
+		enabled:    aws.ToBool(alarm.ActionsEnabled),
+		alarm:      alarm.AlarmActions,
+		ok:         alarm.OKActions,
+		noData:     alarm.InsufficientDataActions,
+		definition: aws.ToString(alarm.AlarmRule),
+		metadata: map[string]any{
+			"metric":      "-",
+			"description": aws.ToString(alarm.AlarmDescription),
+		},
+	})
+}
+
+// alarmFields are the fields metric and composite alarms share.
+type alarmFields struct {
+	name, arn, kind   string
+	state             types.StateValue
+	reason            string
+	updated           *time.Time
+	enabled           bool
+	alarm, ok, noData []string
+	definition        string
+	metadata          map[string]any
+}
+
+// alarmResource converts an alarm. Alarms in ALARM are flagged medium, and
+// alarms that would notify no one, their actions disabled or without any
+// ALARM action, low.
+func alarmResource(f alarmFields) core.Resource {
+	actions := map[string][]string{
+		StateAlarm:            f.alarm,
+		StateOK:               f.ok,
+		StateInsufficientData: f.noData,
+	}
+	metadata := f.metadata
+	metadata["alarm_type"] = f.kind
+	metadata["condition"] = f.definition
+	metadata["state_reason"] = f.reason
+	metadata["actions_enabled"] = f.enabled
+	metadata["actions"] = actions
+	metadata["action_count"] = len(f.alarm) + len(f.ok) + len(f.noData)
+	if f.updated != nil {
+		metadata["state_updated"] = *f.updated
+	}
+
+	resource := core.Resource{
+		ID:       f.name,
+		Type:     "cloudwatch:alarm",
+		Name:     f.name,
+		ARN:      f.arn,
+		State:    string(f.state),
+		Tags:     map[string]string{},
+		Metadata: metadata,
+	}
+
+	if f.state == types.StateValueAlarm {
+		since := ""
+		if f.updated != nil {
+			since = " since " + f.updated.Local().Format("2006-01-02 15:04")
+		}
+		resource.AddIssue(core.SeverityMedium, "In ALARM"+since)
+	}
+	switch {
+	case !f.enabled:
+		resource.AddIssue(core.SeverityLow, "Actions disabled; state changes notify no one")
+	case len(f.alarm) == 0:
+		resource.AddIssue(core.SeverityLow, "No ALARM actions")
+	}
+	return resource
+}
+
+// comparison returns the symbol of a comparison operator.
+func comparison(op types.ComparisonOperator) string {
+	switch op {
+	case types.ComparisonOperatorGreaterThanOrEqualToThreshold:
+		return ">="
+	case types.ComparisonOperatorGreaterThanThreshold, types.ComparisonOperatorGreaterThanUpperThreshold:
+		return ">"
+	case types.ComparisonOperatorLessThanThreshold, types.ComparisonOperatorLessThanLowerThreshold:
+		return "<"
+	case types.ComparisonOperatorLessThanOrEqualToThreshold:
+		return "<="
+	case types.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold:
+		return "outside"
+	}
+	return string(op)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "cloudwatchalarms", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "cloudwatchalarms", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ResourceGetter = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,237 @@
+package cloudwatchalarms
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeCloudWatch serves an OK CPU alarm notifying a topic, a latency alarm
+// in ALARM with its actions disabled and a composite alarm without data, or
+// fails every call when err is set. It records state changes and action
+// toggles.
+type fakeCloudWatch struct {
+	err      error
+	states   []string
+	disabled []string
+	enabled  []string
+}
+
+func (f *fakeCloudWatch) DescribeAlarms(_ context.Context, in *cloudwatch.DescribeAlarmsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	updated := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	metric := []types.MetricAlarm{
+		{
+			AlarmName:          aws.String("web-cpu-high"),
+			AlarmArn:           aws.String("arn:aws:cloudwatch:us-east-1:123456789012:alarm:web-cpu-high"),
+			StateValue:         types.StateValueOk,
+			ActionsEnabled:     aws.Bool(true),
+			AlarmActions:       []string{"arn:aws:sns:us-east-1:123456789012:oncall"},
+			Namespace:          aws.String("AWS/EC2"),
+			MetricName:         aws.String("CPUUtilization"),
+			Statistic:          types.StatisticAverage,
+			ComparisonOperator: types.ComparisonOperatorGreaterThanThreshold,
+			Threshold:          aws.Float64(80),
+			EvaluationPeriods:  aws.Int32(3),
+			Period:             aws.Int32(300),
+		},
+		{
+			AlarmName:             aws.String("api-latency"),
+			AlarmArn:              aws.String("arn:aws:cloudwatch:us-east-1:123456789012:alarm:api-latency"),
+			StateValue:            types.StateValueAlarm,
+			StateUpdatedTimestamp: &updated,
+			ActionsEnabled:        aws.Bool(false),
+			AlarmActions:          []string{"arn:aws:sns:us-east-1:123456789012:oncall"},
+			Namespace:             aws.String("AWS/ApiGateway"),
+			MetricName:            aws.String("Latency"),
+			ExtendedStatistic:     aws.String("p99"),
+			ComparisonOperator:    types.ComparisonOperatorGreaterThanOrEqualToThreshold,
+			Threshold:             aws.Float64(1500),
+			EvaluationPeriods:     aws.Int32(5),
+			Period:                aws.Int32(60),
+		},
+	}
+	composite := []types.CompositeAlarm{{
+		AlarmName:      aws.String("service-health"),
+		AlarmArn:       aws.String("arn:aws:cloudwatch:us-east-1:123456789012:alarm:service-health"),
+		StateValue:     types.StateValueInsufficientData,
+		ActionsEnabled: aws.Bool(true),
+		AlarmRule:      aws.String(`ALARM("web-cpu-high") OR ALARM("api-latency")`),
+	}}
+
+	out := &cloudwatch.DescribeAlarmsOutput{}
+	for _, alarm := range metric {
+		if in.StateValue != "" && alarm.StateValue != in.StateValue {
+			continue
+		}
+		if len(in.AlarmNames) > 0 && in.AlarmNames[0] != aws.ToString(alarm.AlarmName) {
+			continue
+		}
+		out.MetricAlarms = append(out.MetricAlarms, alarm)
+	}
+	for _, alarm := range composite {
+		if in.StateValue != "" && alarm.StateValue != in.StateValue {
+			continue
+		}
+		if len(in.AlarmNames) > 0 && in.AlarmNames[0] != aws.ToString(alarm.AlarmName) {
+			continue
+		}
+		out.CompositeAlarms = append(out.CompositeAlarms, alarm)
+	}
+	return out, nil
+}
+
+func (f *fakeCloudWatch) DescribeAlarmHistory(_ context.Context, in *cloudwatch.DescribeAlarmHistoryInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DescribeAlarmHistoryOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &cloudwatch.DescribeAlarmHistoryOutput{AlarmHistoryItems: []types.AlarmHistoryItem{{
+		AlarmName:       in.AlarmName,
+		Timestamp:       aws.Time(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+		HistoryItemType: types.HistoryItemTypeStateUpdate,
+		HistorySummary:  aws.String("Alarm updated from OK to ALARM"),
+	}}}, nil
+}
+
+func (f *fakeCloudWatch) SetAlarmState(_ context.Context, in *cloudwatch.SetAlarmStateInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.SetAlarmStateOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.states = append(f.states, aws.ToString(in.AlarmName)+"="+string(in.StateValue))
+	return &cloudwatch.SetAlarmStateOutput{}, nil
+}
+
+func (f *fakeCloudWatch) EnableAlarmActions(_ context.Context, in *cloudwatch.EnableAlarmActionsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.EnableAlarmActionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.enabled = append(f.enabled, in.AlarmNames...)
+	return &cloudwatch.EnableAlarmActionsOutput{}, nil
+}
+
+func (f *fakeCloudWatch) DisableAlarmActions(_ context.Context, in *cloudwatch.DisableAlarmActionsInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.DisableAlarmActionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.disabled = append(f.disabled, in.AlarmNames...)
+	return &cloudwatch.DisableAlarmActionsOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against alarms.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudWatch{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCloudWatch{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    "web-cpu-high",
+		MissingID:     "missing",
+		Action:        "view_history",
+		ConfirmAction: "disable_actions",
+	})
+}
+
+func TestListGroupsByState(t *testing.T) {
+	svc := NewServiceWithClient(&fakeCloudWatch{}, nil)
+	ctx := context.Background()
+
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+	if strings.Join(names, ",") != "api-latency,service-health,web-cpu-high" {
+		t.Fatalf("List() order = %v, want ALARM, INSUFFICIENT_DATA then OK", names)
+	}
+
+	latency, composite, cpu := resources[0], resources[1], resources[2]
+	if got := cpu.GetMetadataString("condition"); got != "Average > 80 for 3 x 300s" {
+		t.Errorf("CPU condition = %q", got)
+	}
+	if len(cpu.Issues()) != 0 {
+		t.Errorf("OK alarm with actions has issues %v", cpu.Issues())
+	}
+	if issues := latency.Issues(); len(issues) != 2 || issues[0].Severity != core.SeverityMedium {
+		t.Errorf("latency issues = %v, want ALARM and disabled actions flagged", issues)
+	}
+	if composite.GetMetadataString("alarm_type") != "composite" || len(composite.Issues()) != 1 {
+		t.Errorf("composite = %+v, want flagged for its missing ALARM actions", composite)
+	}
+
+	alarming, err := svc.List(ctx, core.ListOptions{Filters: map[string]string{FilterState: StateAlarm}})
+	if err != nil || len(alarming) != 1 || alarming[0].Name != "api-latency" {
+		t.Errorf("List(state=ALARM) = %v, %v", alarming, err)
+	}
+	if _, err := svc.List(ctx, core.ListOptions{Filters: map[string]string{FilterState: "FIRING"}}); err == nil {
+		t.Error("List(state=FIRING) succeeded")
+	}
+}
+
+func TestSetState(t *testing.T) {
+	client := &fakeCloudWatch{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+	params := map[string]any{"state": StateAlarm, "reason": "testing the pager"}
+
+	_, err := svc.Execute(ctx, "set_state", "web-cpu-high", params)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "sns:us-east-1:123456789012:oncall") {
+		t.Fatalf("set_state error = %v, want a confirmation naming the actions to run", err)
+	}
+	_, err = svc.Execute(ctx, "set_state", "api-latency", map[string]any{"state": StateOK, "reason": "recovered"})
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "disabled") {
+		t.Errorf("set_state error = %v, want a confirmation noting disabled actions", err)
+	}
+	if _, err := svc.Execute(ctx, "set_state", "web-cpu-high", map[string]any{"state": "FIRING", "reason": "x", core.ParamConfirm: true}); err == nil {
+		t.Error("setting an unknown state succeeded")
+	}
+
+	params[core.ParamConfirm] = true
+	if _, err := svc.Execute(ctx, "set_state", "web-cpu-high", params); err != nil {
+		t.Fatalf("confirmed set_state error = %v", err)
+	}
+	if len(client.states) != 1 || client.states[0] != "web-cpu-high=ALARM" {
+		t.Errorf("states = %v", client.states)
+	}
+}
+
+func TestActionsAndHistory(t *testing.T) {
+	client := &fakeCloudWatch{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	if _, err := svc.Execute(ctx, "disable_actions", "web-cpu-high", map[string]any{core.ParamConfirm: true}); err != nil {
+		t.Fatalf("disable_actions error = %v", err)
+	}
+	if _, err := svc.Execute(ctx, "enable_actions", "api-latency", nil); err != nil {
+		t.Fatalf("enable_actions error = %v", err)
+	}
+	if len(client.disabled) != 1 || len(client.enabled) != 1 || client.enabled[0] != "api-latency" {
+		t.Errorf("disabled = %v, enabled = %v", client.disabled, client.enabled)
+	}
+
+	result, err := svc.Execute(ctx, "view_history", "api-latency", nil)
+	if err != nil {
+		t.Fatalf("view_history error = %v", err)
+	}
+	items, _ := result.Data.([]HistoryItem)
+	if len(items) != 1 || items[0].Type != "StateUpdate" {
+		t.Errorf("history = %+v", result.Data)
+	}
+}
@@ -0,0 +1,385 @@
+package cloudwatchalarms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const setStateFormID = "cloudwatchalarms:set_state"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for CloudWatch alarms.
+type View struct {
+	*base.EnrichableTableView
+
+	state      string         // State the list is restricted to, empty for all
+	formTarget *core.Resource // Alarm the set state form is open for
+}
+
+// NewView creates a new CloudWatch alarms view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 18, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Condition"), MinWidth: 15, MaxWidth: 70, Weight: 1.6, Priority: 1},
+		{Title: i18n.T("Actions"), MinWidth: 7, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Since"), MinWidth: 6, MaxWidth: 10, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("Alarms", "", "cloudwatchalarms", i18n.T("alarms"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "f":
+			return v, v.cycleState()
+		case "S":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openSetStateForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Disabling the actions of %s...", row.Name)
+				return v, v.executeAction("disable_actions", row.ID, nil)
+			}
+		case "e":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Enabling the actions of %s...", row.Name)
+				return v, v.executeAction("enable_actions", row.ID, nil)
+			}
+		case "h":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading the history of %s...", row.Name)
+				return v, v.executeAction("view_history", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Alarm %s", row.Name), formatAlarm(row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != setStateFormID {
+			break
+		}
+		target := v.formTarget
+		v.formTarget = nil
+		if msg.Canceled || target == nil {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Setting the state of %s...", target.Name)
+		cmds = append(cmds, v.executeAction("set_state", target.ID, msg.Values))
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading alarms...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [f]ilter state  [S]et state  [d]isable/[e]nable actions  [h]istory  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the alarms.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// cycleState restricts the list to the next state, ALARM first, then back
+// to all alarms, and reloads it.
+func (v *View) cycleState() tea.Cmd {
+	switch i := slices.Index(States, v.state); {
+	case v.state == "":
+		v.state = States[0]
+	case i == len(States)-1:
+		v.state = ""
+	default:
+		v.state = States[i+1]
+	}
+	if v.state == "" {
+		v.ListOptions.Filters = nil
+		v.Message = i18n.T("Showing all alarms")
+	} else {
+		v.ListOptions.Filters = map[string]string{FilterState: v.state}
+		v.Message = i18n.T("Showing alarms in %s", v.state)
+	}
+	return v.Load()
+}
+
+// openSetStateForm asks for the state to set, defaulting to OK for alarms
+// in ALARM and to ALARM otherwise.
+func (v *View) openSetStateForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "set_state")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "set_state")
+		return nil
+	}
+	params := make([]core.ActionParameter, len(def.Parameters))
+	copy(params, def.Parameters)
+	for i := range params {
+		if params[i].Name == "state" && r.State == StateAlarm {
+			params[i].Default = StateOK
+		}
+	}
+	target := *r
+	v.formTarget = &target
+	return v.OpenForm(components.NewForm(setStateFormID, i18n.T("Set the state of %s", r.Name), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	switch msg.Action {
+	case "view_history":
+		items, _ := msg.Result.Data.([]HistoryItem)
+		title := i18n.T("History")
+		if row := v.GetSelectedResource(); row != nil {
+			title = i18n.T("History of %s", row.Name)
+		}
+		v.OpenDetail(title, formatHistory(items))
+	case "set_state", "disable_actions", "enable_actions":
+		return v.SoftRefresh()
+	}
+	return nil
+}
+
+func buildRow(r core.Resource) base.Row {
+	actions := fmt.Sprintf("%d", r.Metadata["action_count"])
+	if enabled, _ := r.Metadata["actions_enabled"].(bool); !enabled {
+		actions = i18n.T("disabled")
+	}
+	var age any
+	since := "-"
+	if updated, ok := r.Metadata["state_updated"].(time.Time); ok {
+		age = time.Since(updated)
+		since = estimate.FormatAge(time.Since(updated))
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(r.State),
+		base.TextCell(r.GetMetadataString("condition")),
+		base.TextCell(actions),
+		base.LazyCell(age, func() string { return since }),
+		base.SeverityCell(r),
+	}
+}
+
+// formatAlarm renders an alarm with the reason for its state and its
+// actions per state, for the detail panel.
+func formatAlarm(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", r.ARN)
+	fmt.Fprintf(&b, "Type:        %s\n", r.GetMetadataString("alarm_type"))
+	if desc := r.GetMetadataString("description"); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
+	if metric := r.GetMetadataString("metric"); metric != "-" {
+		fmt.Fprintf(&b, "Metric:      %s\n", metric)
+	}
+	if dimensions, _ := r.Metadata["dimensions"].([]string); len(dimensions) > 0 {
+		fmt.Fprintf(&b, "Dimensions:  %s\n", strings.Join(dimensions, ", "))
+	}
+	fmt.Fprintf(&b, "Condition:   %s\n", r.GetMetadataString("condition"))
+	fmt.Fprintf(&b, "State:       %s\n", r.State)
+	if updated, ok := r.Metadata["state_updated"].(time.Time); ok {
+		fmt.Fprintf(&b, "Since:       %s\n", updated.Local().Format("2006-01-02 15:04:05"))
+	}
+	if reason := r.GetMetadataString("state_reason"); reason != "" {
+		fmt.Fprintf(&b, "Reason:      %s\n", reason)
+	}
+
+	enabled, _ := r.Metadata["actions_enabled"].(bool)
+	if enabled {
+		b.WriteString(i18n.T("\nActions:\n"))
+	} else {
+		b.WriteString(i18n.T("\nActions (disabled):\n"))
+	}
+	actions, _ := r.Metadata["actions"].(map[string][]string)
+	for _, state := range States {
+		if len(actions[state]) == 0 {
+			fmt.Fprintf(&b, "  %-18s (none)\n", state)
+			continue
+		}
+		for i, arn := range actions[state] {
+			label := ""
+			if i == 0 {
+				label = state
+			}
+			fmt.Fprintf(&b, "  %-18s %s\n", label, arn)
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatHistory renders the history of an alarm, newest first.
+func formatHistory(items []HistoryItem) string {
+	if len(items) == 0 {
+		return i18n.T("No history in the last two weeks.")
+	}
+	var b strings.Builder
+	for _, item := range items {
+		fmt.Fprintf(&b, "%s  %-19s %s\n", item.Time.Local().Format("2006-01-02 15:04:05"), item.Type, item.Summary)
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	counts := map[string]int{}
+	for _, r := range v.Resources {
+		counts[r.State]++
+	}
+
+	widgets := []core.SummaryWidget{
+		{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		{Name: "alarm", Text: i18n.T("In alarm: %d", counts[StateAlarm]), Tone: core.ToneError},
+		{Name: "insufficient-data", Text: i18n.T("Insufficient data: %d", counts[StateInsufficientData]), Tone: core.ToneWarning},
+		{Name: "ok", Text: i18n.T("OK: %d", counts[StateOK]), Tone: core.ToneSuccess},
+	}
+	if v.state != "" {
+		widgets = append(widgets, core.SummaryWidget{Name: "filter", Text: i18n.T("State: %s", v.state), Tone: core.ToneInfo})
+	}
+	return v.CommonWidgets(widgets...)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("CloudWatch Alarms"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "cloudwatchalarms" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
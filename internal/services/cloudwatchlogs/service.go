@@ -0,0 +1,338 @@
+// Package cloudwatchlogs provides Amazon CloudWatch Logs integration for the
+// a9s application. It lists log groups with their retention and stored
+// size, and tails their events through Live Tail, or by polling where Live
+// Tail is not allowed; see tail.go.
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+const (
+	// storagePricePerGB is the monthly price of archived log data in
+	// us-east-1.
+	storagePricePerGB = 0.03
+	bytesPerGB        = 1 << 30
+
+	// DefaultSince is how many minutes of history a tail shows first.
+	DefaultSince = 5
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements CloudWatch Logs operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient LogsAPI
+}
+
+// LogsAPI defines the CloudWatch Logs client interface for mocking.
+type LogsAPI interface {
+	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	FilterLogEvents(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartLiveTail(ctx context.Context, params *cloudwatchlogs.StartLiveTailInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartLiveTailOutput, error)
+}
+
+// NewService creates a new CloudWatch Logs service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client LogsAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the CloudWatch Logs client for the current AWS context.
+func (s *Service) client() LogsAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return cloudwatchlogs.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region of the current AWS context.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cloudwatchlogs"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "CloudWatch Log Groups"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "scroll"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{Limit: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("cloudwatchlogs", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the log groups of the region.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+
+	var resources []core.Resource
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(s.client(), &cloudwatchlogs.DescribeLogGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("cloudwatchlogs", "list", err)
+		}
+		for _, group := range page.LogGroups {
+			resources = append(resources, groupToResource(group, s.region(), now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "logs:log-group",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for log groups.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "tail",
+			Description: "Stream the events of the log group as they arrive",
+			Icon:        "scroll",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "filter", Type: "string", Description: "Filter pattern, such as ERROR (empty for every event)"},
+				{Name: "since", Type: "int", Default: strconv.Itoa(DefaultSince), Description: "Minutes of history to show first"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a log group, identified by name.
+// Tailing returns a *Tail as the result's Data, streaming until stopped.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "tail":
+		result, err = s.tail(ctx, resourceID, params)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// describeGroup returns the log group with the given name.
+func (s *Service) describeGroup(ctx context.Context, name string) (types.LogGroup, error) {
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(s.client(), &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(name),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return types.LogGroup{}, err
+		}
+		for _, group := range page.LogGroups {
+			if aws.ToString(group.LogGroupName) == name {
+				return group, nil
+			}
+		}
+	}
+	return types.LogGroup{}, core.ErrResourceNotFound
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func groupToResource(group types.LogGroup, region string, now time.Time) core.Resource {
+	arn := aws.ToString(group.LogGroupArn)
+	stored := aws.ToInt64(group.StoredBytes)
+	retention := int(aws.ToInt32(group.RetentionInDays))
+
+	resource := core.Resource{
+		ID:     aws.ToString(group.LogGroupName),
+		Type:   "logs:log-group",
+		Name:   aws.ToString(group.LogGroupName),
+		ARN:    arn,
+		Region: region,
+		State:  "active",
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"retention_days": retention,
+			"stored_bytes":   stored,
+			"class":          strings.ToLower(string(group.LogGroupClass)),
+			"metric_filters": int(aws.ToInt32(group.MetricFilterCount)),
+			"kms_key":        aws.ToString(group.KmsKeyId),
+		},
+	}
+	if region == "" {
+		resource.Region = regionOf(arn)
+	}
+	if group.CreationTime != nil {
+		created := time.UnixMilli(*group.CreationTime)
+		resource.CreatedAt = &created
+	}
+
+	if retention == 0 && stored > 0 {
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("Events never expire (%s stored)", formatBytes(stored)))
+	}
+
+	iac.Apply(&resource)
+	estimate.ApplyCost(&resource, float64(stored)/bytesPerGB*storagePricePerGB)
+	estimate.ApplyAge(&resource, now)
+	return resource
+}
+
+// formatBytes formats a size in binary units, as CloudWatch Logs bills them.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// regionOf returns the region of an ARN.
+func regionOf(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[3]
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "cloudwatchlogs", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "cloudwatchlogs", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
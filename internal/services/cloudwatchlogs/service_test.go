@@ -0,0 +1,196 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeLogs serves the /aws/lambda/api group, kept 14 days, and the
+// /ecs/legacy group, kept forever, along with events, from which
+// FilterLogEvents returns those at or after its start time. Live Tail is
+// denied. It fails every call when err is set.
+type fakeLogs struct {
+	err    error
+	events []types.FilteredLogEvent
+}
+
+func (f *fakeLogs) DescribeLogGroups(_ context.Context, in *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	groups := []types.LogGroup{
+		{
+			LogGroupName:    aws.String("/aws/lambda/api"),
+			LogGroupArn:     aws.String("arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/api"),
+			RetentionInDays: aws.Int32(14),
+			StoredBytes:     aws.Int64(2 << 30),
+			LogGroupClass:   types.LogGroupClassStandard,
+		},
+		{
+			LogGroupName:  aws.String("/ecs/legacy"),
+			LogGroupArn:   aws.String("arn:aws:logs:eu-west-1:123456789012:log-group:/ecs/legacy"),
+			StoredBytes:   aws.Int64(11 << 29),
+			LogGroupClass: types.LogGroupClassStandard,
+		},
+	}
+	out := &cloudwatchlogs.DescribeLogGroupsOutput{}
+	for _, group := range groups {
+		if strings.HasPrefix(aws.ToString(group.LogGroupName), aws.ToString(in.LogGroupNamePrefix)) {
+			out.LogGroups = append(out.LogGroups, group)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeLogs) FilterLogEvents(_ context.Context, in *cloudwatchlogs.FilterLogEventsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := &cloudwatchlogs.FilterLogEventsOutput{}
+	for _, e := range f.events {
+		if aws.ToInt64(e.Timestamp) >= aws.ToInt64(in.StartTime) {
+			out.Events = append(out.Events, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeLogs) StartLiveTail(context.Context, *cloudwatchlogs.StartLiveTailInput, ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartLiveTailOutput, error) {
+	return nil, errors.New("AccessDeniedException: not authorized to perform logs:StartLiveTail")
+}
+
+func event(id string, at int64, message string) types.FilteredLogEvent {
+	return types.FilteredLogEvent{
+		EventId:       aws.String(id),
+		Timestamp:     aws.Int64(at),
+		LogStreamName: aws.String("2026/01/02/[$LATEST]abc"),
+		Message:       aws.String(message + "\n"),
+	}
+}
+
+// TestServiceConformance runs the core service contract against log
+// groups. Tailing outlives the action, so it is tested on its own.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeLogs{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeLogs{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID: "/ecs/legacy",
+	})
+}
+
+func TestListFlagsUnboundedRetention(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeLogs{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() = %d groups, want 2", len(resources))
+	}
+
+	if issues := resources[0].Issues(); len(issues) != 0 {
+		t.Errorf("/aws/lambda/api issues = %v", issues)
+	}
+	legacy := resources[1]
+	if issues := legacy.Issues(); len(issues) != 1 || issues[0].Message != "Events never expire (5.5 GB stored)" {
+		t.Errorf("/ecs/legacy issues = %v", issues)
+	}
+	if cost, _ := legacy.Metadata[estimate.MonthlyCostKey].(float64); math.Abs(cost-5.5*storagePricePerGB) > 1e-9 {
+		t.Errorf("/ecs/legacy monthly cost = %v", cost)
+	}
+	if legacy.Region != "eu-west-1" {
+		t.Errorf("region = %q, want the ARN's", legacy.Region)
+	}
+}
+
+// TestTailPolls checks that a tail falls back to polling when Live Tail is
+// denied, and closes its updates once stopped.
+func TestTailPolls(t *testing.T) {
+	now := time.Now().UnixMilli()
+	fake := &fakeLogs{events: []types.FilteredLogEvent{
+		event("e1", now-60_000, "START RequestId: 1"),
+		event("e2", now-30_000, "ERROR timeout"),
+	}}
+	svc := NewServiceWithClient(fake, nil)
+
+	if _, err := svc.Execute(context.Background(), "tail", "/aws/lambda/missing", nil); !errors.Is(err, core.ErrResourceNotFound) {
+		t.Errorf("tail of a missing group error = %v", err)
+	}
+
+	result, err := svc.Execute(context.Background(), "tail", "/aws/lambda/api", map[string]any{"since": "2"})
+	if err != nil {
+		t.Fatalf("tail error = %v", err)
+	}
+	tail := result.Data.(*Tail)
+	if tail.Live || !strings.HasPrefix(result.Message, "Tailing /aws/lambda/api by polling") {
+		t.Errorf("tail = %q, live %v", result.Message, tail.Live)
+	}
+
+	select {
+	case update := <-tail.Updates():
+		if len(update.Events) != 2 || update.Events[1].Message != "ERROR timeout" {
+			t.Errorf("first update = %+v", update)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no update from the tail")
+	}
+
+	tail.Stop()
+	for range tail.Updates() {
+	}
+}
+
+// TestCursorResumes checks that reads resume after the last event, without
+// repeating those sharing its timestamp.
+func TestCursorResumes(t *testing.T) {
+	fake := &fakeLogs{events: []types.FilteredLogEvent{
+		event("e1", 1000, "one"),
+		event("e2", 2000, "two"),
+		event("e3", 2000, "three"),
+	}}
+	c := &cursor{group: "/aws/lambda/api", from: 0}
+
+	reads := [][]string{{"one", "two"}, {"three"}, nil}
+	for i, want := range reads {
+		events, more, err := c.read(context.Background(), fake, 0, 2)
+		if err != nil {
+			t.Fatalf("read %d error = %v", i, err)
+		}
+		var got []string
+		for _, e := range events {
+			got = append(got, e.Message)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") || more != (i == 0) {
+			t.Errorf("read %d = %q, more %v; want %q", i, got, more, want)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[int64]string{
+		512:      "512 B",
+		1536:     "1.5 KB",
+		5 << 20:  "5.0 MB",
+		11 << 29: "5.5 GB",
+		3 << 40:  "3.0 TB",
+	} {
+		if got := formatBytes(n); got != want {
+			t.Errorf("formatBytes(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
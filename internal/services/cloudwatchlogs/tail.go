@@ -0,0 +1,275 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+const (
+	// maxBatch bounds the events of a single tail update, so a busy group
+	// or a long history arrives in steps.
+	maxBatch = 1000
+	// pollInterval is how often a tail polls when Live Tail is unavailable.
+	pollInterval = 2 * time.Second
+	// updateBuffer is how many updates a tail holds for a slow reader.
+	updateBuffer = 64
+)
+
+// ErrTailEnded is the reason a Live Tail session stopped on its own, which
+// CloudWatch Logs does after three hours.
+var ErrTailEnded = errors.New("live tail session ended")
+
+// LogEvent is an event of a log group.
+type LogEvent struct {
+	Time    time.Time
+	Stream  string
+	Message string
+}
+
+// TailUpdate is a batch of events from a tail. The last update of a tail
+// that stopped on its own carries the reason.
+type TailUpdate struct {
+	Events    []LogEvent
+	Sampled   bool // Live Tail sent a sample of the matching events
+	Truncated bool // History past these events was skipped
+	Err       error
+}
+
+// Tail streams the events of a log group until stopped. It is the Data of
+// the result of the "tail" action.
+type Tail struct {
+	Group   string
+	Pattern string
+	Live    bool // Streamed by Live Tail rather than polled
+
+	updates chan TailUpdate
+	cancel  context.CancelFunc
+}
+
+// Updates returns the tail's updates. The channel is closed once the tail
+// stops.
+func (t *Tail) Updates() <-chan TailUpdate {
+	return t.updates
+}
+
+// Stop ends the tail.
+func (t *Tail) Stop() {
+	t.cancel()
+}
+
+// send delivers an update unless the tail was stopped.
+func (t *Tail) send(ctx context.Context, update TailUpdate) bool {
+	select {
+	case t.updates <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// =============================================================================
+// Action Implementation
+// =============================================================================
+
+// tail starts streaming the events of a log group, after the given minutes
+// of history. Live Tail pushes events as they are ingested; when it is
+// denied or unavailable, FilterLogEvents is polled instead.
+func (s *Service) tail(ctx context.Context, name string, params map[string]any) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("tail", name, err)
+	}
+
+	pattern, _ := params["filter"].(string)
+	pattern = strings.TrimSpace(pattern)
+	since := DefaultSince
+	if v := params["since"]; v != nil && v != "" {
+		n, err := intParam(v)
+		if err != nil || n < 0 {
+			return fail(core.NewValidationError("since", fmt.Sprint(v), "must be a number of minutes"))
+		}
+		since = n
+	}
+
+	group, err := s.describeGroup(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+
+	// The tail outlives the action, until the caller stops it
+	tailCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	t := &Tail{
+		Group:   name,
+		Pattern: pattern,
+		updates: make(chan TailUpdate, updateBuffer),
+		cancel:  cancel,
+	}
+
+	client := s.client()
+	now := time.Now()
+	c := &cursor{group: name, pattern: pattern, from: now.Add(-time.Duration(since) * time.Minute).UnixMilli()}
+
+	input := &cloudwatchlogs.StartLiveTailInput{
+		LogGroupIdentifiers: []string{aws.ToString(group.LogGroupArn)},
+	}
+	if pattern != "" {
+		input.LogEventFilterPattern = aws.String(pattern)
+	}
+	var result *core.ActionResult
+	out, liveErr := client.StartLiveTail(tailCtx, input)
+	if liveErr != nil {
+		go t.poll(tailCtx, client, c)
+		result = core.NewActionResult(true, fmt.Sprintf("Tailing %s by polling, Live Tail is unavailable: %v", name, liveErr))
+	} else {
+		t.Live = true
+		go t.live(tailCtx, client, c, now.UnixMilli(), out.GetStream())
+		result = core.NewActionResult(true, fmt.Sprintf("Tailing %s with Live Tail", name))
+	}
+	result.Data = t
+	return result, nil
+}
+
+// live sends the history up to the start of a Live Tail session, then the
+// events the session pushes.
+func (t *Tail) live(ctx context.Context, client LogsAPI, c *cursor, start int64, stream *cloudwatchlogs.StartLiveTailEventStream) {
+	defer close(t.updates)
+	defer stream.Close()
+
+	// A long history is cut short rather than holding back live events
+	events, more, err := c.read(ctx, client, start, maxBatch)
+	if err != nil {
+		t.send(ctx, TailUpdate{Err: err})
+		return
+	}
+	if (len(events) > 0 || more) && !t.send(ctx, TailUpdate{Events: events, Truncated: more}) {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-stream.Events():
+			if !ok {
+				err := stream.Err()
+				if err == nil {
+					err = ErrTailEnded
+				}
+				t.send(ctx, TailUpdate{Err: err})
+				return
+			}
+			session, ok := event.(*types.StartLiveTailResponseStreamMemberSessionUpdate)
+			if !ok {
+				continue
+			}
+			update := TailUpdate{}
+			if meta := session.Value.SessionMetadata; meta != nil {
+				update.Sampled = meta.Sampled
+			}
+			for _, e := range session.Value.SessionResults {
+				update.Events = append(update.Events, LogEvent{
+					Time:    time.UnixMilli(aws.ToInt64(e.Timestamp)),
+					Stream:  aws.ToString(e.LogStreamName),
+					Message: strings.TrimRight(aws.ToString(e.Message), "\n"),
+				})
+			}
+			if len(update.Events) > 0 && !t.send(ctx, update) {
+				return
+			}
+		}
+	}
+}
+
+// poll sends the history, then the events read every pollInterval.
+func (t *Tail) poll(ctx context.Context, client LogsAPI, c *cursor) {
+	defer close(t.updates)
+
+	for {
+		events, more, err := c.read(ctx, client, 0, maxBatch)
+		if err != nil {
+			if ctx.Err() == nil {
+				t.send(ctx, TailUpdate{Err: err})
+			}
+			return
+		}
+		if len(events) > 0 && !t.send(ctx, TailUpdate{Events: events}) {
+			return
+		}
+		if more {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// cursor reads the events of a group in order, resuming where the last
+// read stopped.
+type cursor struct {
+	group   string
+	pattern string
+	from    int64           // Timestamp to read from, in milliseconds
+	seen    map[string]bool // Events already read at that timestamp
+}
+
+// read returns the events from the cursor on, up to the given timestamp
+// when positive, and at most limit of them. more reports whether others
+// were left for the next read.
+func (c *cursor) read(ctx context.Context, client LogsAPI, to int64, limit int) (events []LogEvent, more bool, err error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(c.group),
+		StartTime:    aws.Int64(c.from),
+	}
+	if to > 0 {
+		input.EndTime = aws.Int64(to)
+	}
+	if c.pattern != "" {
+		input.FilterPattern = aws.String(c.pattern)
+	}
+
+	for {
+		out, err := client.FilterLogEvents(ctx, input)
+		if err != nil {
+			return events, false, err
+		}
+		for _, e := range out.Events {
+			id, at := aws.ToString(e.EventId), aws.ToInt64(e.Timestamp)
+			if c.seen[id] {
+				continue
+			}
+			if len(events) == limit {
+				return events, true, nil
+			}
+			if at > c.from || c.seen == nil {
+				c.from, c.seen = max(at, c.from), make(map[string]bool)
+			}
+			if at == c.from {
+				c.seen[id] = true
+			}
+			events = append(events, LogEvent{
+				Time:    time.UnixMilli(at),
+				Stream:  aws.ToString(e.LogStreamName),
+				Message: strings.TrimRight(aws.ToString(e.Message), "\n"),
+			})
+		}
+		if out.NextToken == nil {
+			return events, false, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
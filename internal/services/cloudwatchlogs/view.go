@@ -0,0 +1,463 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const tailFormID = "cloudwatchlogs:tail"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for CloudWatch log groups. Tailing a group
+// shows its events in a log pane in place of the table.
+type View struct {
+	*base.TableView
+
+	formTarget string // Log group the tail form was opened for
+	tail       *Tail
+	pane       *components.LogPane
+}
+
+// NewView creates a new CloudWatch Logs view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 20, MaxWidth: 70, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Retention"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Stored"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Class"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Filters"), MinWidth: 7, MaxWidth: 8, Weight: 0.1, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Logs", "", "cloudwatchlogs", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadGroups()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.updateTail(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openTailForm(row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Log group %s", row.Name), formatGroup(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != tailFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Starting to tail %s...", v.formTarget)
+		cmds = append(cmds, v.executeAction("tail", v.formTarget, msg.Values))
+
+	case groupsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d log groups", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if tail, ok := msg.Result.Data.(*Tail); ok {
+				cmds = append(cmds, v.openTail(tail))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Log pane, table or loading/error
+	if v.pane != nil {
+		lines = append(lines, v.pane.View())
+	} else if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading log groups...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	if v.pane == nil {
+		lines = append(lines, v.Styles.Help.Render(i18n.T("[t]ail  [Enter]details  [r]efresh")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the log groups.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadGroups()
+}
+
+// CapturingInput reports whether the log pane or an overlay owns keyboard
+// input.
+func (v *View) CapturingInput() bool {
+	return v.pane != nil || v.TableView.CapturingInput()
+}
+
+// Close stops the running tail, if any.
+func (v *View) Close() {
+	v.closeTail()
+}
+
+// =============================================================================
+// Log Tail
+// =============================================================================
+
+// tailMsg carries an update of a running tail.
+type tailMsg struct {
+	tail   *Tail
+	update TailUpdate
+	done   bool
+}
+
+// openTailForm asks for the filter pattern and history of a tail.
+func (v *View) openTailForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "tail")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "tail")
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(tailFormID, i18n.T("Tail %s", r.Name), def.Parameters))
+}
+
+// openTail shows the events of a started tail in the log pane.
+func (v *View) openTail(tail *Tail) tea.Cmd {
+	v.closeTail()
+	v.tail = tail
+	v.pane = components.NewLogPane(i18n.T("Tail of %s", tail.Group), v.Width(), v.paneHeight())
+	v.pane.SetStatus(v.tailStatus(false))
+	return waitForTail(tail)
+}
+
+// closeTail stops the running tail and closes its pane.
+func (v *View) closeTail() {
+	if v.tail != nil {
+		v.tail.Stop()
+	}
+	v.tail, v.pane = nil, nil
+}
+
+// waitForTail reads the next update of a tail.
+func waitForTail(tail *Tail) tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-tail.Updates()
+		return tailMsg{tail: tail, update: update, done: !ok}
+	}
+}
+
+// updateTail routes input to the log pane and appends the events of the
+// running tail. It returns true when the message was consumed.
+func (v *View) updateTail(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tailMsg:
+		// Updates of a tail that was replaced or closed are dropped
+		if msg.tail != v.tail {
+			return true, nil
+		}
+		if msg.done {
+			return true, nil
+		}
+		v.appendEvents(msg.update)
+		if msg.update.Err != nil {
+			v.pane.SetStatus(i18n.T("stopped: %v", msg.update.Err))
+		} else {
+			v.pane.SetStatus(v.tailStatus(msg.update.Sampled))
+		}
+		return true, waitForTail(msg.tail)
+	case components.LogPaneClosedMsg:
+		if v.tail != nil {
+			v.Message = i18n.T("Stopped tailing %s", v.tail.Group)
+		}
+		v.closeTail()
+		return true, nil
+	case tea.KeyMsg:
+		if v.pane == nil {
+			return false, nil
+		}
+		var cmd tea.Cmd
+		v.pane, cmd = v.pane.Update(msg)
+		return true, cmd
+	case tea.WindowSizeMsg:
+		if v.pane != nil {
+			v.pane.SetDimensions(v.Width(), v.paneHeight())
+		}
+	}
+	return false, nil
+}
+
+// appendEvents adds the events of an update to the log pane, one line per
+// event line.
+func (v *View) appendEvents(update TailUpdate) {
+	lines := make([]string, 0, len(update.Events))
+	for _, e := range update.Events {
+		prefix := e.Time.Local().Format("15:04:05.000") + " " + base.TruncateString(e.Stream, 24) + " │ "
+		for i, line := range strings.Split(e.Message, "\n") {
+			if i > 0 {
+				prefix = strings.Repeat(" ", len([]rune(prefix))-2) + "│ "
+			}
+			lines = append(lines, prefix+line)
+		}
+	}
+	if update.Truncated {
+		lines = append(lines, i18n.T("… later history skipped, live events follow"))
+	}
+	v.pane.Append(lines...)
+}
+
+// tailStatus describes how the running tail streams events.
+func (v *View) tailStatus(sampled bool) string {
+	status := i18n.T("polling every %s", pollInterval)
+	if v.tail.Live {
+		status = i18n.T("live")
+	}
+	if v.tail.Pattern != "" {
+		status += "  " + i18n.T("filter: %s", v.tail.Pattern)
+	}
+	if sampled {
+		status += "  " + i18n.T("sampled, more events matched than Live Tail sends")
+	}
+	return status
+}
+
+// paneHeight returns the rows of the log pane, between the summary and the
+// message line.
+func (v *View) paneHeight() int {
+	return max(v.Height()-3, 6)
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type groupsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadGroups() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return groupsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return groupsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return groupsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	retention, _ := r.Metadata["retention_days"].(int)
+	stored, _ := r.Metadata["stored_bytes"].(int64)
+	filters, _ := r.Metadata["metric_filters"].(int)
+
+	// Groups that never expire sort after every retention
+	retentionValue := retention
+	if retention == 0 {
+		retentionValue = int(^uint(0) >> 1)
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 70)),
+		base.LazyCell(retentionValue, func() string { return formatRetention(retention) }),
+		base.LazyCell(stored, func() string { return formatBytes(stored) }),
+		base.TextCell(r.GetMetadataString("class")),
+		base.LazyCell(filters, func() string { return fmt.Sprintf("%d", filters) }),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// formatRetention renders a retention in days, or "never" for groups whose
+// events never expire.
+func formatRetention(days int) string {
+	if days == 0 {
+		return i18n.T("never")
+	}
+	return i18n.T("%dd", days)
+}
+
+// formatGroup renders a log group's settings for the detail panel.
+func formatGroup(r *core.Resource) string {
+	retention, _ := r.Metadata["retention_days"].(int)
+	stored, _ := r.Metadata["stored_bytes"].(int64)
+	filters, _ := r.Metadata["metric_filters"].(int)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:            %s\n", r.ARN)
+	fmt.Fprintf(&b, "Class:          %s\n", r.GetMetadataString("class"))
+	fmt.Fprintf(&b, "Retention:      %s\n", formatRetention(retention))
+	fmt.Fprintf(&b, "Stored:         %s\n", formatBytes(stored))
+	fmt.Fprintf(&b, "Metric filters: %d\n", filters)
+	if key := r.GetMetadataString("kms_key"); key != "" {
+		fmt.Fprintf(&b, "KMS key:        %s\n", key)
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:        %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	var stored int64
+	forever := 0
+	for _, r := range v.Resources {
+		size, _ := r.Metadata["stored_bytes"].(int64)
+		stored += size
+		if days, _ := r.Metadata["retention_days"].(int); days == 0 {
+			forever++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "stored", Text: i18n.T("Stored: %s", formatBytes(stored)), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "never-expire", Text: i18n.T("Never expire: %d", forever), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("CloudWatch Log Groups"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "cloudwatchlogs" }
+
+var (
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.InputCapturer = (*View)(nil)
+	_ core.ViewFactory   = (*ViewFactory)(nil)
+)
@@ -0,0 +1,484 @@
+// Package cost provides spend reporting for the a9s application. It lists
+// the month-to-date spend of each AWS service from Cost Explorer, compared
+// with the previous month, and breaks a service's spend down by usage type.
+package cost
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// DefaultMinIncrease is the increase in month-to-date spend, in USD, above
+// which a service growing faster than increasePercent is flagged.
+const DefaultMinIncrease = 10.0
+
+const (
+	// increasePercent is the growth on the previous month to date above
+	// which a service is flagged.
+	increasePercent = 20
+
+	// metric is the cost metric reported, as on the bill before credits.
+	metric = "UnblendedCost"
+
+	// minAmount is the spend below which a service or usage type is left
+	// out, as rounding noise.
+	minAmount = 0.005
+)
+
+// Service implements spend reporting from Cost Explorer. Every Cost
+// Explorer request is billed, so spend is only loaded when the view is
+// opened or refreshed.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient CostExplorerAPI // Only used for testing
+	now        func() time.Time
+
+	minIncrease float64
+}
+
+// Option configures the cost service.
+type Option func(*Service)
+
+// WithMinIncrease sets the month-to-date increase, in USD, above which a
+// growing service is flagged. Non-positive values keep the default.
+func WithMinIncrease(amount float64) Option {
+	return func(s *Service) {
+		if amount > 0 {
+			s.minIncrease = amount
+		}
+	}
+}
+
+// CostExplorerAPI defines the Cost Explorer client interface for mocking.
+type CostExplorerAPI interface {
+	GetCostAndUsage(ctx context.Context, params *costexplorer.GetCostAndUsageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error)
+}
+
+// NewService creates a new cost service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:     factory,
+		dispatcher:  dispatcher,
+		now:         time.Now,
+		minIncrease: DefaultMinIncrease,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client CostExplorerAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:  client,
+		dispatcher:  dispatcher,
+		now:         time.Now,
+		minIncrease: DefaultMinIncrease,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the Cost Explorer client, fetching fresh from factory each time.
+func (s *Service) client() CostExplorerAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.CostExplorerClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cost"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Spend by Service"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "dollar"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	p := s.periods()
+	_, err := s.client().GetCostAndUsage(ctx, &costexplorer.GetCostAndUsageInput{
+		TimePeriod:  interval(p.monthStart, p.end),
+		Granularity: types.GranularityMonthly,
+		Metrics:     []string{metric},
+	})
+	if err != nil {
+		return core.NewServiceError("cost", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the month-to-date spend of each AWS service of the account,
+// across all regions, with the spend over the same days of the previous
+// month and over the whole previous month, most spent first.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	p := s.periods()
+	spend, err := s.spend(ctx, p, nil, types.DimensionService)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("cost", "list", err)
+	}
+
+	resources := make([]core.Resource, 0, len(spend))
+	for _, sp := range spend {
+		resources = append(resources, s.spendToResource(sp, p))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "cost:service",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for services.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "breakdown",
+			Description: "Break the service's month-to-date spend down by usage type",
+			Icon:        "dollar",
+			Shortcut:    "u",
+			Category:    "cost",
+		},
+	}
+}
+
+// Execute runs the specified action on a service, identified by its Cost
+// Explorer name.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "breakdown":
+		result, err = s.breakdown(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// Spend is the spend of a service or usage type in USD.
+type Spend struct {
+	Name           string  `json:"name"`
+	MonthToDate    float64 `json:"month_to_date"`
+	PreviousToDate float64 `json:"previous_to_date"` // Same days of the previous month
+	PreviousMonth  float64 `json:"previous_month"`
+}
+
+// Delta returns the change of the month-to-date spend on the previous
+// month to date.
+func (sp Spend) Delta() float64 {
+	return sp.MonthToDate - sp.PreviousToDate
+}
+
+// DeltaPercent returns the change in percent, and false when the previous
+// month to date had no spend.
+func (sp Spend) DeltaPercent() (float64, bool) {
+	if sp.PreviousToDate < minAmount {
+		return 0, false
+	}
+	return sp.Delta() / sp.PreviousToDate * 100, true
+}
+
+// breakdown returns the spend of a service by usage type, most spent
+// first, in its result data.
+func (s *Service) breakdown(ctx context.Context, service string) (*core.ActionResult, error) {
+	p := s.periods()
+	filter := &types.Expression{Dimensions: &types.DimensionValues{
+		Key:    types.DimensionService,
+		Values: []string{service},
+	}}
+	usage, err := s.spend(ctx, p, filter, types.DimensionUsageType)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("breakdown", service, err)
+	}
+
+	total := 0.0
+	for _, u := range usage {
+		total += u.MonthToDate
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("%d usage types for %s, %s month to date", len(usage), service, estimate.FormatCost(total)))
+	result.Data = usage
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// periods are the days spend is compared over. Cost Explorer dates are
+// inclusive at the start and exclusive at the end, and in UTC.
+type periods struct {
+	monthStart     time.Time // First day of the current month
+	end            time.Time // Tomorrow, so today's spend so far counts
+	previousStart  time.Time // First day of the previous month
+	previousToDate time.Time // Same day of the previous month, at most its end
+}
+
+func (s *Service) periods() periods {
+	now := s.now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	p := periods{
+		monthStart: time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC),
+		end:        today.AddDate(0, 0, 1),
+	}
+	p.previousStart = p.monthStart.AddDate(0, -1, 0)
+	p.previousToDate = p.previousStart.AddDate(0, 0, today.Day())
+	if p.previousToDate.After(p.monthStart) {
+		p.previousToDate = p.monthStart
+	}
+	return p
+}
+
+// spend returns the spend of each group of a dimension this month, the
+// previous month to date and the whole previous month, most spent this
+// month first. It makes two Cost Explorer requests.
+func (s *Service) spend(ctx context.Context, p periods, filter *types.Expression, groupBy types.Dimension) ([]Spend, error) {
+	months, err := s.costs(ctx, p.previousStart, p.end, filter, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	toDate, err := s.costs(ctx, p.previousStart, p.previousToDate, filter, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	current := months[p.monthStart]
+	previous := months[p.previousStart]
+	previousToDate := toDate[p.previousStart]
+
+	names := make(map[string]bool)
+	for _, byName := range []map[string]float64{current, previous, previousToDate} {
+		for name := range byName {
+			names[name] = true
+		}
+	}
+	var spend []Spend
+	for name := range names {
+		sp := Spend{
+			Name:           name,
+			MonthToDate:    current[name],
+			PreviousToDate: previousToDate[name],
+			PreviousMonth:  previous[name],
+		}
+		if sp.MonthToDate < minAmount && sp.PreviousMonth < minAmount {
+			continue
+		}
+		spend = append(spend, sp)
+	}
+	slices.SortFunc(spend, func(a, b Spend) int {
+		if c := cmp.Compare(b.MonthToDate, a.MonthToDate); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return spend, nil
+}
+
+// costs returns the cost of each group of a dimension over [start, end),
+// keyed by the first day of each month.
+func (s *Service) costs(ctx context.Context, start, end time.Time, filter *types.Expression, groupBy types.Dimension) (map[time.Time]map[string]float64, error) {
+	input := &costexplorer.GetCostAndUsageInput{
+		TimePeriod:  interval(start, end),
+		Granularity: types.GranularityMonthly,
+		Metrics:     []string{metric},
+		Filter:      filter,
+		GroupBy: []types.GroupDefinition{{
+			Type: types.GroupDefinitionTypeDimension,
+			Key:  aws.String(string(groupBy)),
+		}},
+	}
+
+	costs := make(map[time.Time]map[string]float64)
+	for {
+		page, err := s.client().GetCostAndUsage(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, byTime := range page.ResultsByTime {
+			if byTime.TimePeriod == nil {
+				continue
+			}
+			month, err := time.Parse("2006-01-02", aws.ToString(byTime.TimePeriod.Start))
+			if err != nil {
+				continue
+			}
+			month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+			if costs[month] == nil {
+				costs[month] = make(map[string]float64)
+			}
+			for _, group := range byTime.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+				costs[month][group.Keys[0]] += amount(group.Metrics[metric])
+			}
+		}
+		if page.NextPageToken == nil {
+			return costs, nil
+		}
+		input.NextPageToken = page.NextPageToken
+	}
+}
+
+// spendToResource converts the spend of a service. Services spending the
+// minimum increase more than the previous month to date, and over
+// increasePercent more, are flagged low, as are new services spending
+// that much.
+func (s *Service) spendToResource(sp Spend, p periods) core.Resource {
+	resource := core.Resource{
+		ID:   sp.Name,
+		Type: "cost:service",
+		Name: sp.Name,
+		Tags: make(map[string]string),
+		Metadata: map[string]any{
+			"month_to_date":    sp.MonthToDate,
+			"previous_to_date": sp.PreviousToDate,
+			"previous_month":   sp.PreviousMonth,
+			"delta":            sp.Delta(),
+			"period":           fmt.Sprintf("%s to %s", p.monthStart.Format("2006-01-02"), p.end.AddDate(0, 0, -1).Format("2006-01-02")),
+		},
+	}
+	pct, compared := sp.DeltaPercent()
+	if compared {
+		resource.Metadata["delta_percent"] = pct
+	}
+
+	if sp.Delta() >= s.minIncrease {
+		switch {
+		case !compared:
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("New spend: %s this month, none last month to date", estimate.FormatCost(sp.MonthToDate)))
+		case pct >= increasePercent:
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Up %s (+%.0f%%) on last month to date", estimate.FormatCost(sp.Delta()), pct))
+		}
+	}
+	return resource
+}
+
+// interval returns a Cost Explorer date interval.
+func interval(start, end time.Time) *types.DateInterval {
+	return &types.DateInterval{
+		Start: aws.String(start.Format("2006-01-02")),
+		End:   aws.String(end.Format("2006-01-02")),
+	}
+}
+
+// amount parses a Cost Explorer amount, which is sent as a string.
+func amount(m types.MetricValue) float64 {
+	n, err := strconv.ParseFloat(aws.ToString(m.Amount), 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "cost", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "cost", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,152 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeCostExplorer serves the spend of September and October 2026 by
+// service or, filtered on a service, by usage type, or fails every call
+// when err is set. Requests ending on September 17 are the previous month
+// to date.
+type fakeCostExplorer struct {
+	err      error
+	requests int
+}
+
+func (f *fakeCostExplorer) GetCostAndUsage(_ context.Context, in *costexplorer.GetCostAndUsageInput, _ ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.requests++
+
+	// Spend per group: September, September to date, October to date
+	spend := map[string][3]float64{
+		"Amazon Elastic Compute Cloud - Compute": {300, 170, 180},
+		"Amazon Simple Storage Service":          {40, 20, 45},
+		"AWS Lambda":                             {0, 0, 15},
+		"AWS Key Management Service":             {2, 1, 0.001},
+	}
+	if in.Filter != nil {
+		spend = map[string][3]float64{
+			"USE1-BoxUsage:t3.large": {250, 140, 150},
+			"USE1-EBS:VolumeUsage":   {50, 30, 30},
+		}
+	}
+
+	group := func(column int) types.ResultByTime {
+		start := "2026-09-01"
+		if column == 2 {
+			start = "2026-10-01"
+		}
+		result := types.ResultByTime{TimePeriod: &types.DateInterval{Start: aws.String(start)}}
+		for name, amounts := range spend {
+			result.Groups = append(result.Groups, types.Group{
+				Keys:    []string{name},
+				Metrics: map[string]types.MetricValue{metric: {Amount: aws.String(fmt.Sprint(amounts[column])), Unit: aws.String("USD")}},
+			})
+		}
+		return result
+	}
+	if aws.ToString(in.TimePeriod.End) == "2026-09-17" {
+		return &costexplorer.GetCostAndUsageOutput{ResultsByTime: []types.ResultByTime{group(1)}}, nil
+	}
+	return &costexplorer.GetCostAndUsageOutput{ResultsByTime: []types.ResultByTime{group(0), group(2)}}, nil
+}
+
+func newService(client CostExplorerAPI, d core.EventDispatcher) *Service {
+	svc := NewServiceWithClient(client, d)
+	svc.now = func() time.Time { return time.Date(2026, 10, 16, 18, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+// TestServiceConformance runs the core service contract against services.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return newService(&fakeCostExplorer{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return newService(&fakeCostExplorer{err: errors.New("AccessDenied")}, d)
+		},
+		Action: "breakdown",
+	})
+}
+
+func TestListComparesWithLastMonth(t *testing.T) {
+	client := &fakeCostExplorer{}
+	svc := newService(client, nil)
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if client.requests != 2 {
+		t.Errorf("List() made %d Cost Explorer requests, want 2", client.requests)
+	}
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+	want := "Amazon Elastic Compute Cloud - Compute,Amazon Simple Storage Service,AWS Lambda,AWS Key Management Service"
+	if strings.Join(names, ",") != want {
+		t.Fatalf("List() = %v, want most spent first", names)
+	}
+
+	ec2, s3, lambda := resources[0], resources[1], resources[2]
+	if delta, _ := ec2.Metadata["delta"].(float64); delta != 10 || len(ec2.Issues()) != 0 {
+		t.Errorf("EC2 delta = %v, issues %v, want +$10 unflagged", ec2.Metadata["delta"], ec2.Issues())
+	}
+	if issues := s3.Issues(); len(issues) != 1 || !strings.Contains(issues[0].Message, "+125%") {
+		t.Errorf("S3 issues = %v, want flagged up 125%%", issues)
+	}
+	if issues := lambda.Issues(); len(issues) != 1 || !strings.Contains(issues[0].Message, "New spend") {
+		t.Errorf("Lambda issues = %v, want flagged as new", issues)
+	}
+	if lambda.GetMetadataString("period") != "2026-10-01 to 2026-10-16" {
+		t.Errorf("period = %q", lambda.GetMetadataString("period"))
+	}
+}
+
+func TestPeriodsEndOfMonth(t *testing.T) {
+	svc := NewServiceWithClient(&fakeCostExplorer{}, nil)
+	svc.now = func() time.Time { return time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC) }
+
+	p := svc.periods()
+	if got := p.previousStart.Format("2006-01-02"); got != "2026-02-01" {
+		t.Errorf("previousStart = %s", got)
+	}
+	if got := p.previousToDate.Format("2006-01-02"); got != "2026-03-01" {
+		t.Errorf("previousToDate = %s, want the end of February", got)
+	}
+	if got := p.end.Format("2006-01-02"); got != "2026-04-01" {
+		t.Errorf("end = %s", got)
+	}
+}
+
+func TestBreakdown(t *testing.T) {
+	svc := newService(&fakeCostExplorer{}, nil)
+
+	result, err := svc.Execute(context.Background(), "breakdown", "Amazon Elastic Compute Cloud - Compute", nil)
+	if err != nil {
+		t.Fatalf("breakdown error = %v", err)
+	}
+	usage, _ := result.Data.([]Spend)
+	if len(usage) != 2 || usage[0].Name != "USE1-BoxUsage:t3.large" || usage[0].Delta() != 10 {
+		t.Errorf("usage = %+v", usage)
+	}
+	if !strings.Contains(result.Message, "$180.00") {
+		t.Errorf("message = %q, want the month-to-date total", result.Message)
+	}
+}
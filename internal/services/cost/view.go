@@ -0,0 +1,293 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for spend by service.
+type View struct {
+	*base.EnrichableTableView
+}
+
+// NewView creates a new spend view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Service"), MinWidth: 20, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Month to Date"), MinWidth: 13, MaxWidth: 16, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Last Month to Date"), MinWidth: 13, MaxWidth: 20, Weight: 0.4, Priority: 2},
+		{Title: i18n.T("Change"), MinWidth: 10, MaxWidth: 22, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Last Month"), MinWidth: 11, MaxWidth: 16, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("Cost", "", "cost", i18n.T("services"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "u":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading the usage types of %s...", row.Name)
+				return v, v.executeAction("breakdown", row.ID)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(row.Name, formatSpend(row))
+				return v, nil
+			}
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if msg.Action == "breakdown" {
+				usage, _ := msg.Result.Data.([]Spend)
+				title := i18n.T("Usage types")
+				if row := v.GetSelectedResource(); row != nil {
+					title = i18n.T("Usage types of %s", row.Name)
+				}
+				v.OpenDetail(title, formatUsage(usage))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading spend from Cost Explorer...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[u]sage types  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the spend.
+func (v *View) Refresh() tea.Cmd {
+	return v.Load()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, nil)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	mtd, _ := r.Metadata["month_to_date"].(float64)
+	previousToDate, _ := r.Metadata["previous_to_date"].(float64)
+	previous, _ := r.Metadata["previous_month"].(float64)
+	delta, _ := r.Metadata["delta"].(float64)
+	pct, compared := r.Metadata["delta_percent"].(float64)
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		costCell(mtd),
+		costCell(previousToDate),
+		base.LazyCell(delta, func() string { return formatDelta(delta, pct, compared) }),
+		costCell(previous),
+		base.SeverityCell(r),
+	}
+}
+
+// costCell returns an amount in USD, sorting by value.
+func costCell(amount float64) base.Cell {
+	return base.LazyCell(amount, func() string { return estimate.FormatCost(amount) })
+}
+
+// formatDelta renders a change with its percentage, "new" when there was
+// nothing to compare with.
+func formatDelta(delta, pct float64, compared bool) string {
+	sign := "+"
+	if delta < 0 {
+		sign = "-"
+	}
+	text := sign + estimate.FormatCost(max(delta, -delta))
+	switch {
+	case compared:
+		text += fmt.Sprintf(" (%+.0f%%)", pct)
+	case delta > 0:
+		text += " (new)"
+	}
+	return text
+}
+
+// formatSpend renders the spend of a service for the detail panel.
+func formatSpend(r *core.Resource) string {
+	mtd, _ := r.Metadata["month_to_date"].(float64)
+	previousToDate, _ := r.Metadata["previous_to_date"].(float64)
+	previous, _ := r.Metadata["previous_month"].(float64)
+	delta, _ := r.Metadata["delta"].(float64)
+	pct, compared := r.Metadata["delta_percent"].(float64)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Period:              %s (UTC)\n", r.GetMetadataString("period"))
+	fmt.Fprintf(&b, "Month to date:       %s\n", estimate.FormatCost(mtd))
+	fmt.Fprintf(&b, "Last month to date:  %s\n", estimate.FormatCost(previousToDate))
+	fmt.Fprintf(&b, "Change:              %s\n", formatDelta(delta, pct, compared))
+	fmt.Fprintf(&b, "Last month:          %s\n", estimate.FormatCost(previous))
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	b.WriteString(i18n.T("\nUnblended cost from Cost Explorer, all regions; today's spend is partial. Press [u] for usage types.\n"))
+	return b.String()
+}
+
+// formatUsage renders the usage types of a service for the detail panel.
+func formatUsage(usage []Spend) string {
+	if len(usage) == 0 {
+		return i18n.T("No spend this month or last month.")
+	}
+	width := 10
+	for _, u := range usage {
+		width = max(width, len(u.Name))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-*s %14s %20s\n", width, i18n.T("Usage type"), i18n.T("Month to date"), i18n.T("Change"))
+	for _, u := range usage {
+		pct, compared := u.DeltaPercent()
+		fmt.Fprintf(&b, "%-*s %14s %20s\n", width, u.Name, estimate.FormatCost(u.MonthToDate), formatDelta(u.Delta(), pct, compared))
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	var mtd, previousToDate, previous float64
+	rising := 0
+	for _, r := range v.Resources {
+		m, _ := r.Metadata["month_to_date"].(float64)
+		p, _ := r.Metadata["previous_to_date"].(float64)
+		l, _ := r.Metadata["previous_month"].(float64)
+		mtd, previousToDate, previous = mtd+m, previousToDate+p, previous+l
+		if r.Severity() != core.SeverityNone {
+			rising++
+		}
+	}
+
+	tone := core.ToneSuccess
+	if mtd > previousToDate {
+		tone = core.ToneError
+	}
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "month-to-date", Text: i18n.T("Month to date: %s", estimate.FormatCost(mtd)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "change", Text: i18n.T("vs last month to date: %s", formatDelta(mtd-previousToDate, (mtd-previousToDate)/max(previousToDate, minAmount)*100, previousToDate >= minAmount)), Tone: tone},
+		core.SummaryWidget{Name: "last-month", Text: i18n.T("Last month: %s", estimate.FormatCost(previous)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "rising", Text: i18n.T("Rising: %d", rising), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Spend by Service"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "cost" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
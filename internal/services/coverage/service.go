@@ -0,0 +1,627 @@
+// Package coverage provides Reserved Instance and Savings Plan coverage for
+// the a9s application. It reports, per EC2 instance family, how much usage
+// commitments cover and the on-demand spend they leave uncovered, from Cost
+// Explorer.
+package coverage
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// DefaultMinUncovered is the monthly on-demand spend of an instance family
+// above which its missing coverage is flagged.
+const DefaultMinUncovered = 100.0
+
+const (
+	// ec2Service is the Cost Explorer name of EC2 instance usage.
+	ec2Service = "Amazon Elastic Compute Cloud - Compute"
+
+	// lookbackDays is the window coverage and utilization are measured over,
+	// so uncovered spend reads as a monthly amount.
+	lookbackDays = 30
+)
+
+// Service implements Reserved Instance and Savings Plan coverage reporting.
+// Every Cost Explorer request is billed, so coverage is only loaded when
+// the view is opened or refreshed.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient CostExplorerAPI // Only used for testing
+
+	minUncovered float64
+}
+
+// Option configures the coverage service.
+type Option func(*Service)
+
+// WithMinUncovered sets the monthly on-demand spend above which a family is
+// flagged. Non-positive values keep the default.
+func WithMinUncovered(monthly float64) Option {
+	return func(s *Service) {
+		if monthly > 0 {
+			s.minUncovered = monthly
+		}
+	}
+}
+
+// CostExplorerAPI defines the Cost Explorer client interface for mocking.
+type CostExplorerAPI interface {
+	GetReservationCoverage(ctx context.Context, params *costexplorer.GetReservationCoverageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetReservationCoverageOutput, error)
+	GetReservationUtilization(ctx context.Context, params *costexplorer.GetReservationUtilizationInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetReservationUtilizationOutput, error)
+	GetReservationPurchaseRecommendation(ctx context.Context, params *costexplorer.GetReservationPurchaseRecommendationInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetReservationPurchaseRecommendationOutput, error)
+	GetSavingsPlansCoverage(ctx context.Context, params *costexplorer.GetSavingsPlansCoverageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansCoverageOutput, error)
+	GetSavingsPlansUtilization(ctx context.Context, params *costexplorer.GetSavingsPlansUtilizationInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansUtilizationOutput, error)
+	GetSavingsPlansPurchaseRecommendation(ctx context.Context, params *costexplorer.GetSavingsPlansPurchaseRecommendationInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansPurchaseRecommendationOutput, error)
+}
+
+// NewService creates a new coverage service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:      factory,
+		dispatcher:   dispatcher,
+		minUncovered: DefaultMinUncovered,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client CostExplorerAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:   client,
+		dispatcher:   dispatcher,
+		minUncovered: DefaultMinUncovered,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the Cost Explorer client, fetching fresh from factory each time.
+func (s *Service) client() CostExplorerAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.CostExplorerClient()
+}
+
+// region returns the region coverage is reported for, or "" for all.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "coverage"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Reserved Instance and Savings Plan Coverage"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "dollar"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().GetSavingsPlansUtilization(ctx, &costexplorer.GetSavingsPlansUtilizationInput{
+		TimePeriod: s.period(),
+	})
+	if err != nil && !dataUnavailable(err) {
+		return core.NewServiceError("coverage", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the coverage of each EC2 instance family in the current
+// region over the last 30 days, most uncovered spend first.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	period := s.period()
+
+	families, err := s.reservationCoverage(ctx, period)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("coverage", "list", err)
+	}
+	// Accounts without Savings Plans have no Savings Plans data
+	if err := s.savingsPlansCoverage(ctx, period, families); err != nil && !dataUnavailable(err) {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("coverage", "list", err)
+	}
+
+	resources := make([]core.Resource, 0, len(families))
+	for _, f := range families {
+		resources = append(resources, s.familyToResource(f))
+	}
+	slices.SortStableFunc(resources, func(a, b core.Resource) int {
+		ua, _ := estimate.MonthlyCost(a)
+		ub, _ := estimate.MonthlyCost(b)
+		if c := cmp.Compare(ub, ua); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "coverage:family",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// Utilization is how much of the purchased commitments was used over the
+// last 30 days. Percentages are nil when the account has no such commitment.
+type Utilization struct {
+	Reservations     *float64 `json:"reservations,omitempty"`  // Used share of Reserved Instance hours
+	UnusedHours      float64  `json:"unused_hours"`            // Reserved Instance hours paid but not used
+	SavingsPlans     *float64 `json:"savings_plans,omitempty"` // Used share of the Savings Plans commitment
+	UnusedCommitment float64  `json:"unused_commitment"`       // Savings Plans commitment paid but not used, in USD
+}
+
+// Utilization returns the utilization of Reserved Instances and Savings
+// Plans, for the whole account.
+func (s *Service) Utilization(ctx context.Context) (Utilization, error) {
+	var u Utilization
+	period := s.period()
+
+	ri, err := s.client().GetReservationUtilization(ctx, &costexplorer.GetReservationUtilizationInput{
+		TimePeriod: period,
+		Filter:     dimension(types.DimensionService, ec2Service),
+	})
+	switch {
+	case err == nil:
+		if ri.Total != nil && number(ri.Total.PurchasedHours) > 0 {
+			pct := number(ri.Total.UtilizationPercentage)
+			u.Reservations = &pct
+			u.UnusedHours = number(ri.Total.UnusedHours)
+		}
+	case !dataUnavailable(err):
+		return u, core.NewServiceError("coverage", "utilization", err)
+	}
+
+	sp, err := s.client().GetSavingsPlansUtilization(ctx, &costexplorer.GetSavingsPlansUtilizationInput{
+		TimePeriod: period,
+	})
+	switch {
+	case err == nil:
+		if sp.Total != nil && sp.Total.Utilization != nil && number(sp.Total.Utilization.TotalCommitment) > 0 {
+			pct := number(sp.Total.Utilization.UtilizationPercentage)
+			u.SavingsPlans = &pct
+			u.UnusedCommitment = number(sp.Total.Utilization.UnusedCommitment)
+		}
+	case !dataUnavailable(err):
+		return u, core.NewServiceError("coverage", "utilization", err)
+	}
+
+	return u, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for coverage.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "recommend",
+			Description: "Show Reserved Instance and Savings Plan purchase recommendations",
+			Icon:        "dollar",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "cost",
+		},
+	}
+}
+
+// Execute runs the specified action on an instance family.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "recommend":
+		result, err = s.recommend(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// Recommendation is a commitment Cost Explorer suggests buying, for one
+// year with no upfront payment, based on the last 30 days of usage.
+type Recommendation struct {
+	Kind             string  `json:"kind"` // "Reserved Instance" or "Savings Plan"
+	Target           string  `json:"target"`
+	Region           string  `json:"region"`
+	Quantity         string  `json:"quantity"` // Instances, or hourly commitment in USD
+	MonthlySavings   float64 `json:"monthly_savings"`
+	SavingsPercent   float64 `json:"savings_percent"`
+	BreakEvenMonths  string  `json:"break_even_months,omitempty"`
+	MonthlyOnDemand  float64 `json:"monthly_on_demand"`
+	RecurringMonthly float64 `json:"recurring_monthly,omitempty"`
+}
+
+// recommend returns the purchase recommendations for an instance family.
+func (s *Service) recommend(ctx context.Context, family string) (*core.ActionResult, error) {
+	var recs []Recommendation
+
+	ri, err := s.client().GetReservationPurchaseRecommendation(ctx, &costexplorer.GetReservationPurchaseRecommendationInput{
+		Service:              aws.String(ec2Service),
+		LookbackPeriodInDays: types.LookbackPeriodInDaysThirtyDays,
+		TermInYears:          types.TermInYearsOneYear,
+		PaymentOption:        types.PaymentOptionNoUpfront,
+	})
+	if err != nil && !dataUnavailable(err) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("recommend", family, err)
+	}
+	if err == nil {
+		for _, rec := range ri.Recommendations {
+			for _, d := range rec.RecommendationDetails {
+				if d.InstanceDetails == nil || d.InstanceDetails.EC2InstanceDetails == nil {
+					continue
+				}
+				ec2 := d.InstanceDetails.EC2InstanceDetails
+				if aws.ToString(ec2.Family) != family {
+					continue
+				}
+				recs = append(recs, Recommendation{
+					Kind:             "Reserved Instance",
+					Target:           aws.ToString(ec2.InstanceType) + " " + aws.ToString(ec2.Platform),
+					Region:           aws.ToString(ec2.Region),
+					Quantity:         aws.ToString(d.RecommendedNumberOfInstancesToPurchase),
+					MonthlySavings:   number(d.EstimatedMonthlySavingsAmount),
+					SavingsPercent:   number(d.EstimatedMonthlySavingsPercentage),
+					BreakEvenMonths:  aws.ToString(d.EstimatedBreakEvenInMonths),
+					MonthlyOnDemand:  number(d.EstimatedMonthlyOnDemandCost),
+					RecurringMonthly: number(d.RecurringStandardMonthlyCost),
+				})
+			}
+		}
+	}
+
+	sp, err := s.client().GetSavingsPlansPurchaseRecommendation(ctx, &costexplorer.GetSavingsPlansPurchaseRecommendationInput{
+		SavingsPlansType:     types.SupportedSavingsPlansTypeEc2InstanceSp,
+		LookbackPeriodInDays: types.LookbackPeriodInDaysThirtyDays,
+		TermInYears:          types.TermInYearsOneYear,
+		PaymentOption:        types.PaymentOptionNoUpfront,
+	})
+	if err != nil && !dataUnavailable(err) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("recommend", family, err)
+	}
+	if err == nil && sp.SavingsPlansPurchaseRecommendation != nil {
+		for _, d := range sp.SavingsPlansPurchaseRecommendation.SavingsPlansPurchaseRecommendationDetails {
+			if d.SavingsPlansDetails == nil || aws.ToString(d.SavingsPlansDetails.InstanceFamily) != family {
+				continue
+			}
+			recs = append(recs, Recommendation{
+				Kind:            "Savings Plan",
+				Target:          "EC2 Instance " + family,
+				Region:          aws.ToString(d.SavingsPlansDetails.Region),
+				Quantity:        "$" + aws.ToString(d.HourlyCommitmentToPurchase) + "/h",
+				MonthlySavings:  number(d.EstimatedMonthlySavingsAmount),
+				SavingsPercent:  number(d.EstimatedSavingsPercentage),
+				MonthlyOnDemand: number(d.EstimatedOnDemandCost),
+			})
+		}
+	}
+
+	slices.SortStableFunc(recs, func(a, b Recommendation) int {
+		return cmp.Compare(b.MonthlySavings, a.MonthlySavings)
+	})
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d recommendations for %s", len(recs), family))
+	result.Data = map[string]any{
+		"family":          family,
+		"recommendations": recs,
+	}
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// familyCoverage accumulates the coverage of one instance family.
+type familyCoverage struct {
+	name          string
+	instanceTypes []string
+
+	runningHours   float64
+	reservedHours  float64
+	onDemandHours  float64
+	onDemandCost   float64 // On-demand spend not covered by Reserved Instances
+	savingsPlans   bool    // Savings Plans coverage is known
+	spCoveredSpend float64
+	spOnDemandCost float64 // On-demand spend not covered by either commitment
+	spTotalCost    float64
+}
+
+// period returns the last 30 days, ending today. Cost Explorer dates are
+// inclusive at the start and exclusive at the end.
+func (s *Service) period() *types.DateInterval {
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -lookbackDays)
+	return &types.DateInterval{
+		Start: aws.String(start.Format("2006-01-02")),
+		End:   aws.String(end.Format("2006-01-02")),
+	}
+}
+
+// filter restricts Cost Explorer data to EC2 instance usage in the current
+// region.
+func (s *Service) filter() *types.Expression {
+	region := s.region()
+	if region == "" {
+		return dimension(types.DimensionService, ec2Service)
+	}
+	return &types.Expression{And: []types.Expression{
+		*dimension(types.DimensionService, ec2Service),
+		*dimension(types.DimensionRegion, region),
+	}}
+}
+
+// reservationCoverage returns the Reserved Instance coverage of each family,
+// summed from the coverage of its instance types.
+func (s *Service) reservationCoverage(ctx context.Context, period *types.DateInterval) (map[string]*familyCoverage, error) {
+	input := &costexplorer.GetReservationCoverageInput{
+		TimePeriod:  period,
+		Granularity: types.GranularityMonthly,
+		Filter:      s.filter(),
+		GroupBy: []types.GroupDefinition{{
+			Type: types.GroupDefinitionTypeDimension,
+			Key:  aws.String(string(types.DimensionInstanceType)),
+		}},
+	}
+
+	families := make(map[string]*familyCoverage)
+	for {
+		page, err := s.client().GetReservationCoverage(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, byTime := range page.CoveragesByTime {
+			for _, group := range byTime.Groups {
+				instanceType := groupValue(group.Attributes)
+				if instanceType == "" || group.Coverage == nil {
+					continue
+				}
+				name, _, _ := strings.Cut(instanceType, ".")
+				f := families[name]
+				if f == nil {
+					f = &familyCoverage{name: name}
+					families[name] = f
+				}
+				if !slices.Contains(f.instanceTypes, instanceType) {
+					f.instanceTypes = append(f.instanceTypes, instanceType)
+				}
+				if hours := group.Coverage.CoverageHours; hours != nil {
+					f.runningHours += number(hours.TotalRunningHours)
+					f.reservedHours += number(hours.ReservedHours)
+					f.onDemandHours += number(hours.OnDemandHours)
+				}
+				if cost := group.Coverage.CoverageCost; cost != nil {
+					f.onDemandCost += number(cost.OnDemandCost)
+				}
+			}
+		}
+		if page.NextPageToken == nil {
+			return families, nil
+		}
+		input.NextPageToken = page.NextPageToken
+	}
+}
+
+// savingsPlansCoverage adds the Savings Plans coverage of each family.
+func (s *Service) savingsPlansCoverage(ctx context.Context, period *types.DateInterval, families map[string]*familyCoverage) error {
+	input := &costexplorer.GetSavingsPlansCoverageInput{
+		TimePeriod:  period,
+		Granularity: types.GranularityMonthly,
+		Filter:      s.filter(),
+		GroupBy: []types.GroupDefinition{{
+			Type: types.GroupDefinitionTypeDimension,
+			Key:  aws.String("INSTANCE_FAMILY"),
+		}},
+	}
+
+	paginator := costexplorer.NewGetSavingsPlansCoveragePaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range page.SavingsPlansCoverages {
+			name := groupValue(c.Attributes)
+			if name == "" || c.Coverage == nil {
+				continue
+			}
+			f := families[name]
+			if f == nil {
+				f = &familyCoverage{name: name}
+				families[name] = f
+			}
+			f.savingsPlans = true
+			f.spCoveredSpend += number(c.Coverage.SpendCoveredBySavingsPlans)
+			f.spOnDemandCost += number(c.Coverage.OnDemandCost)
+			f.spTotalCost += number(c.Coverage.TotalCost)
+		}
+	}
+	return nil
+}
+
+func (s *Service) familyToResource(f *familyCoverage) core.Resource {
+	slices.Sort(f.instanceTypes)
+
+	// Savings Plans apply after Reserved Instances, so their on-demand cost
+	// is what neither commitment covers
+	uncovered := f.onDemandCost
+	if f.savingsPlans {
+		uncovered = f.spOnDemandCost
+	}
+
+	resource := core.Resource{
+		ID:     f.name,
+		Type:   "coverage:family",
+		Name:   f.name,
+		Region: s.region(),
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"instance_types":  f.instanceTypes,
+			"running_hours":   f.runningHours,
+			"reserved_hours":  f.reservedHours,
+			"on_demand_hours": f.onDemandHours,
+			"uncovered":       uncovered,
+		},
+	}
+	if f.runningHours > 0 {
+		resource.Metadata["ri_coverage"] = f.reservedHours / f.runningHours * 100
+	}
+	if f.savingsPlans && f.spTotalCost > 0 {
+		resource.Metadata["sp_coverage"] = f.spCoveredSpend / f.spTotalCost * 100
+		resource.Metadata["sp_covered_spend"] = f.spCoveredSpend
+	}
+	estimate.ApplyCost(&resource, uncovered)
+
+	if uncovered >= s.minUncovered {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("%s/mo on-demand not covered by commitments", estimate.FormatCost(uncovered)))
+	}
+
+	return resource
+}
+
+// dimension returns a filter on one dimension value.
+func dimension(key types.Dimension, value string) *types.Expression {
+	return &types.Expression{Dimensions: &types.DimensionValues{
+		Key:    key,
+		Values: []string{value},
+	}}
+}
+
+// groupValue returns the value of a single-attribute group.
+func groupValue(attributes map[string]string) string {
+	for _, v := range attributes {
+		return v
+	}
+	return ""
+}
+
+// number parses a Cost Explorer amount, which is sent as a string.
+func number(s *string) float64 {
+	n, err := strconv.ParseFloat(aws.ToString(s), 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// dataUnavailable reports whether Cost Explorer has no data for a request,
+// as for accounts without Reserved Instances or Savings Plans.
+func dataUnavailable(err error) bool {
+	var unavailable *types.DataUnavailableException
+	return errors.As(err, &unavailable)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "coverage", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "coverage", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,255 @@
+package coverage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeCostExplorer serves 30 days of coverage for the m5 family, partly
+// reserved and partly under a Savings Plan, and the c6g family, fully on
+// demand, over two pages of Reserved Instance coverage. Reserved Instances
+// are 60% used. It fails every call when err is set, and answers Savings
+// Plans requests with no data when noSavingsPlans is set.
+type fakeCostExplorer struct {
+	err            error
+	noSavingsPlans bool
+}
+
+func (f *fakeCostExplorer) spErr() error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.noSavingsPlans {
+		return &types.DataUnavailableException{Message: aws.String("No Savings Plans data")}
+	}
+	return nil
+}
+
+func coverageGroup(instanceType string, running, reserved, onDemandCost float64) types.ReservationCoverageGroup {
+	amount := func(v float64) *string { return aws.String(strconv.FormatFloat(v, 'f', -1, 64)) }
+	return types.ReservationCoverageGroup{
+		Attributes: map[string]string{"instanceType": instanceType},
+		Coverage: &types.Coverage{
+			CoverageHours: &types.CoverageHours{
+				TotalRunningHours: amount(running),
+				ReservedHours:     amount(reserved),
+				OnDemandHours:     amount(running - reserved),
+			},
+			CoverageCost: &types.CoverageCost{OnDemandCost: amount(onDemandCost)},
+		},
+	}
+}
+
+func (f *fakeCostExplorer) GetReservationCoverage(_ context.Context, in *costexplorer.GetReservationCoverageInput, _ ...func(*costexplorer.Options)) (*costexplorer.GetReservationCoverageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if in.NextPageToken == nil {
+		return &costexplorer.GetReservationCoverageOutput{
+			CoveragesByTime: []types.CoverageByTime{{Groups: []types.ReservationCoverageGroup{
+				coverageGroup("m5.large", 1000, 600, 38.40),
+				coverageGroup("c6g.xlarge", 1440, 0, 195.84),
+			}}},
+			NextPageToken: aws.String("2"),
+		}, nil
+	}
+	return &costexplorer.GetReservationCoverageOutput{
+		CoveragesByTime: []types.CoverageByTime{{Groups: []types.ReservationCoverageGroup{
+			coverageGroup("m5.xlarge", 720, 0, 138.24),
+		}}},
+	}, nil
+}
+
+func (f *fakeCostExplorer) GetSavingsPlansCoverage(context.Context, *costexplorer.GetSavingsPlansCoverageInput, ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansCoverageOutput, error) {
+	if err := f.spErr(); err != nil {
+		return nil, err
+	}
+	return &costexplorer.GetSavingsPlansCoverageOutput{SavingsPlansCoverages: []types.SavingsPlansCoverage{{
+		Attributes: map[string]string{"INSTANCE_FAMILY": "m5"},
+		Coverage: &types.SavingsPlansCoverageData{
+			SpendCoveredBySavingsPlans: aws.String("100"),
+			OnDemandCost:               aws.String("76.64"),
+			TotalCost:                  aws.String("176.64"),
+		},
+	}}}, nil
+}
+
+func (f *fakeCostExplorer) GetReservationUtilization(context.Context, *costexplorer.GetReservationUtilizationInput, ...func(*costexplorer.Options)) (*costexplorer.GetReservationUtilizationOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &costexplorer.GetReservationUtilizationOutput{Total: &types.ReservationAggregates{
+		PurchasedHours:        aws.String("1000"),
+		UtilizationPercentage: aws.String("60"),
+		UnusedHours:           aws.String("400"),
+	}}, nil
+}
+
+func (f *fakeCostExplorer) GetSavingsPlansUtilization(context.Context, *costexplorer.GetSavingsPlansUtilizationInput, ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansUtilizationOutput, error) {
+	if err := f.spErr(); err != nil {
+		return nil, err
+	}
+	return &costexplorer.GetSavingsPlansUtilizationOutput{Total: &types.SavingsPlansUtilizationAggregates{
+		Utilization: &types.SavingsPlansUtilization{
+			TotalCommitment:       aws.String("73"),
+			UtilizationPercentage: aws.String("95"),
+			UnusedCommitment:      aws.String("3.65"),
+		},
+	}}, nil
+}
+
+func (f *fakeCostExplorer) GetReservationPurchaseRecommendation(context.Context, *costexplorer.GetReservationPurchaseRecommendationInput, ...func(*costexplorer.Options)) (*costexplorer.GetReservationPurchaseRecommendationOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	detail := func(family, instanceType, savings string) types.ReservationPurchaseRecommendationDetail {
+		return types.ReservationPurchaseRecommendationDetail{
+			InstanceDetails: &types.InstanceDetails{EC2InstanceDetails: &types.EC2InstanceDetails{
+				Family:       aws.String(family),
+				InstanceType: aws.String(instanceType),
+				Platform:     aws.String("Linux/UNIX"),
+				Region:       aws.String("us-east-1"),
+			}},
+			RecommendedNumberOfInstancesToPurchase: aws.String("1"),
+			EstimatedMonthlySavingsAmount:          aws.String(savings),
+		}
+	}
+	return &costexplorer.GetReservationPurchaseRecommendationOutput{Recommendations: []types.ReservationPurchaseRecommendation{{
+		RecommendationDetails: []types.ReservationPurchaseRecommendationDetail{
+			detail("m5", "m5.large", "24.50"),
+			detail("c6g", "c6g.xlarge", "61.20"),
+		},
+	}}}, nil
+}
+
+func (f *fakeCostExplorer) GetSavingsPlansPurchaseRecommendation(context.Context, *costexplorer.GetSavingsPlansPurchaseRecommendationInput, ...func(*costexplorer.Options)) (*costexplorer.GetSavingsPlansPurchaseRecommendationOutput, error) {
+	if err := f.spErr(); err != nil {
+		return nil, err
+	}
+	return &costexplorer.GetSavingsPlansPurchaseRecommendationOutput{SavingsPlansPurchaseRecommendation: &types.SavingsPlansPurchaseRecommendation{
+		SavingsPlansPurchaseRecommendationDetails: []types.SavingsPlansPurchaseRecommendationDetail{{
+			SavingsPlansDetails:           &types.SavingsPlansDetails{InstanceFamily: aws.String("m5"), Region: aws.String("us-east-1")},
+			HourlyCommitmentToPurchase:    aws.String("0.10"),
+			EstimatedMonthlySavingsAmount: aws.String("31.00"),
+		}},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against instance
+// families.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCostExplorer{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeCostExplorer{err: errors.New("AccessDeniedException: ce:GetReservationCoverage")}, d)
+		},
+		ExistingID: "m5",
+		Action:     "recommend",
+	})
+}
+
+func TestListSumsFamilies(t *testing.T) {
+	tests := []struct {
+		name           string
+		noSavingsPlans bool
+		want           []string            // Family IDs, most uncovered first
+		uncovered      map[string]float64  // Monthly on-demand spend left uncovered
+		issues         map[string][]string // Issue messages
+	}{
+		{
+			name:      "with savings plans",
+			want:      []string{"c6g", "m5"},
+			uncovered: map[string]float64{"c6g": 195.84, "m5": 76.64},
+			issues:    map[string][]string{"c6g": {"$195.84/mo on-demand not covered by commitments"}},
+		},
+		{
+			name:           "without savings plans",
+			noSavingsPlans: true,
+			want:           []string{"c6g", "m5"},
+			uncovered:      map[string]float64{"c6g": 195.84, "m5": 176.64},
+			issues: map[string][]string{
+				"c6g": {"$195.84/mo on-demand not covered by commitments"},
+				"m5":  {"$176.64/mo on-demand not covered by commitments"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		resources, err := NewServiceWithClient(&fakeCostExplorer{noSavingsPlans: tt.noSavingsPlans}, nil).List(context.Background(), core.ListOptions{})
+		if err != nil {
+			t.Fatalf("%s: List() error = %v", tt.name, err)
+		}
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.ID)
+			if cost, _ := estimate.MonthlyCost(r); math.Abs(cost-tt.uncovered[r.ID]) > 1e-9 {
+				t.Errorf("%s: %s uncovered = %v, want %v", tt.name, r.ID, cost, tt.uncovered[r.ID])
+			}
+			var messages []string
+			for _, issue := range r.Issues() {
+				messages = append(messages, issue.Message)
+			}
+			if !slices.Equal(messages, tt.issues[r.ID]) {
+				t.Errorf("%s: %s issues = %q, want %q", tt.name, r.ID, messages, tt.issues[r.ID])
+			}
+		}
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("%s: List() = %v, want %v", tt.name, ids, tt.want)
+		}
+	}
+
+	resources, _ := NewServiceWithClient(&fakeCostExplorer{}, nil).List(context.Background(), core.ListOptions{})
+	m5 := resources[1]
+	if instanceTypes := m5.Metadata["instance_types"].([]string); !slices.Equal(instanceTypes, []string{"m5.large", "m5.xlarge"}) {
+		t.Errorf("m5 instance types = %v", instanceTypes)
+	}
+	if ri := m5.Metadata["ri_coverage"].(float64); math.Abs(ri-600.0/1720*100) > 1e-9 {
+		t.Errorf("m5 Reserved Instance coverage = %v", ri)
+	}
+}
+
+func TestUtilization(t *testing.T) {
+	u, err := NewServiceWithClient(&fakeCostExplorer{noSavingsPlans: true}, nil).Utilization(context.Background())
+	if err != nil {
+		t.Fatalf("Utilization() error = %v", err)
+	}
+	if u.Reservations == nil || *u.Reservations != 60 || u.UnusedHours != 400 {
+		t.Errorf("Reserved Instance utilization = %v, %v unused hours", u.Reservations, u.UnusedHours)
+	}
+	if u.SavingsPlans != nil {
+		t.Errorf("Savings Plans utilization = %v, want none without data", *u.SavingsPlans)
+	}
+
+	if _, err := NewServiceWithClient(&fakeCostExplorer{err: errors.New("ThrottlingException")}, nil).Utilization(context.Background()); err == nil {
+		t.Error("Utilization() error = nil, want the API error")
+	}
+}
+
+func TestRecommendFiltersByFamily(t *testing.T) {
+	result, err := NewServiceWithClient(&fakeCostExplorer{}, nil).Execute(context.Background(), "recommend", "m5", nil)
+	if err != nil {
+		t.Fatalf("recommend error = %v", err)
+	}
+	recs := result.Data.(map[string]any)["recommendations"].([]Recommendation)
+	var got []string
+	for _, rec := range recs {
+		got = append(got, rec.Kind+" "+rec.Target+" "+rec.Quantity)
+	}
+	want := []string{"Savings Plan EC2 Instance m5 $0.10/h", "Reserved Instance m5.large Linux/UNIX 1"}
+	if !slices.Equal(got, want) {
+		t.Errorf("recommendations = %q, want %q", got, want)
+	}
+}
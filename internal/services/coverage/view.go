@@ -0,0 +1,352 @@
+package coverage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Reserved Instance and Savings Plan
+// coverage.
+type View struct {
+	*base.TableView
+
+	utilization *Utilization // nil until loaded, or when it failed to load
+}
+
+// NewView creates a new coverage view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Family"), MinWidth: 8, MaxWidth: 12, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Instance Types"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 3},
+		{Title: i18n.T("Hours"), MinWidth: 7, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("RI Coverage"), MinWidth: 11, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("SP Coverage"), MinWidth: 11, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Uncovered $/mo"), MinWidth: 14, MaxWidth: 16, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Coverage", "7", "coverage", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadCoverage()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading purchase recommendations for %s...", row.ID)
+				return v, v.executeAction("recommend", row.ID)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Coverage of %s", row.ID), formatCoverage(row))
+			}
+		}
+
+	case coverageLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.utilization = msg.utilization
+			v.updateTable()
+			v.Message = i18n.T("Loaded coverage of %d instance families", len(msg.resources))
+			if msg.utilizationErr != nil {
+				v.Message = i18n.T("Utilization unavailable: %v", msg.utilizationErr)
+			}
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if msg.Action == "recommend" {
+				data, _ := msg.Result.Data.(map[string]any)
+				v.OpenDetail(i18n.T("Purchase recommendations for %v", data["family"]), formatRecommendations(msg.Result))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading coverage from Cost Explorer...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[p]urchase recommendations  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the coverage.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadCoverage()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type coverageLoadedMsg struct {
+	owner          *View // Listings of a swapped-out view are dropped
+	resources      []core.Resource
+	utilization    *Utilization
+	utilizationErr error
+	err            error
+}
+
+func (v *View) loadCoverage() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service, ok := v.Service().(*Service)
+		if !ok {
+			return coverageLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		ctx := context.Background()
+		resources, err := service.List(ctx, core.ListOptions{})
+		if err != nil {
+			return coverageLoadedMsg{owner: v, err: err}
+		}
+		// Coverage is still worth showing when utilization cannot be read
+		msg := coverageLoadedMsg{owner: v, resources: resources}
+		if u, err := service.Utilization(ctx); err != nil {
+			msg.utilizationErr = err
+		} else {
+			msg.utilization = &u
+		}
+		return msg
+	}
+}
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, nil)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	instanceTypes, _ := r.Metadata["instance_types"].([]string)
+	hours, _ := r.Metadata["running_hours"].(float64)
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(strings.Join(instanceTypes, ", "), 50)),
+		base.LazyCell(hours, func() string { return fmt.Sprintf("%.0f", hours) }),
+		percentCell(r, "ri_coverage"),
+		percentCell(r, "sp_coverage"),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// percentCell returns a coverage percentage, or "-" when unknown.
+func percentCell(r core.Resource, key string) base.Cell {
+	pct, ok := r.Metadata[key].(float64)
+	if !ok {
+		return base.LazyCell(nil, func() string { return "-" })
+	}
+	return base.LazyCell(pct, func() string { return formatPercent(pct) })
+}
+
+// formatPercent renders a percentage with the icon of its coverage level.
+func formatPercent(pct float64) string {
+	icon := "🔴"
+	switch {
+	case pct >= 80:
+		icon = "🟢"
+	case pct >= 50:
+		icon = "🟡"
+	}
+	return fmt.Sprintf("%s %.0f%%", icon, pct)
+}
+
+// formatCoverage renders the coverage of a family for the detail panel.
+func formatCoverage(r *core.Resource) string {
+	instanceTypes, _ := r.Metadata["instance_types"].([]string)
+	running, _ := r.Metadata["running_hours"].(float64)
+	reserved, _ := r.Metadata["reserved_hours"].(float64)
+	onDemand, _ := r.Metadata["on_demand_hours"].(float64)
+	uncovered, _ := r.Metadata["uncovered"].(float64)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Family:            %s\n", r.ID)
+	fmt.Fprintf(&b, "Region:            %s\n", r.Region)
+	fmt.Fprintf(&b, "Instance types:    %s\n\n", strings.Join(instanceTypes, ", "))
+	fmt.Fprintf(&b, "Running hours:     %.0f\n", running)
+	fmt.Fprintf(&b, "Reserved hours:    %.0f\n", reserved)
+	fmt.Fprintf(&b, "On-demand hours:   %.0f\n", onDemand)
+	if pct, ok := r.Metadata["ri_coverage"].(float64); ok {
+		fmt.Fprintf(&b, "RI coverage:       %.1f%%\n", pct)
+	}
+	if pct, ok := r.Metadata["sp_coverage"].(float64); ok {
+		covered, _ := r.Metadata["sp_covered_spend"].(float64)
+		fmt.Fprintf(&b, "SP coverage:       %.1f%% (%s)\n", pct, estimate.FormatCost(covered))
+	}
+	fmt.Fprintf(&b, "Uncovered spend:   %s/mo\n", estimate.FormatCost(uncovered))
+	b.WriteString(i18n.T("\nLast 30 days, from Cost Explorer. Press [p] for purchase recommendations.\n"))
+	return b.String()
+}
+
+// formatRecommendations renders purchase recommendations for the detail
+// panel.
+func formatRecommendations(result *core.ActionResult) string {
+	data, _ := result.Data.(map[string]any)
+	recs, _ := data["recommendations"].([]Recommendation)
+
+	var b strings.Builder
+	for _, rec := range recs {
+		fmt.Fprintf(&b, "%s: %s x %s (%s)\n", rec.Kind, rec.Target, rec.Quantity, rec.Region)
+		fmt.Fprintf(&b, "    saves %s/mo (%.0f%%) on %s/mo on-demand\n",
+			estimate.FormatCost(rec.MonthlySavings), rec.SavingsPercent, estimate.FormatCost(rec.MonthlyOnDemand))
+		if rec.BreakEvenMonths != "" {
+			fmt.Fprintf(&b, "    breaks even after %s months\n", rec.BreakEvenMonths)
+		}
+	}
+	if len(recs) == 0 {
+		b.WriteString(i18n.T("No purchase recommendations for this family.\n"))
+	}
+	b.WriteString(i18n.T("\nOne year, no upfront, based on the last 30 days of usage.\n"))
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	widgets := []core.SummaryWidget{
+		{Name: "uncovered", Text: i18n.T("Uncovered: $%.2f/mo", v.Badge().Spend), Tone: core.ToneWarning},
+	}
+	// Utilization widgets stay empty, and are skipped, until it is loaded
+	var riUtil, riUnused, spUtil, spUnused string
+	if u := v.utilization; u != nil {
+		if u.Reservations != nil {
+			riUtil = i18n.T("RI utilization: %.0f%%", *u.Reservations)
+		}
+		if u.UnusedHours > 0 {
+			riUnused = i18n.T("Unused RI: %.0f h", u.UnusedHours)
+		}
+		if u.SavingsPlans != nil {
+			spUtil = i18n.T("SP utilization: %.0f%%", *u.SavingsPlans)
+		}
+		if u.UnusedCommitment > 0 {
+			spUnused = i18n.T("Unused SP: $%.2f", u.UnusedCommitment)
+		}
+	}
+	widgets = append(widgets,
+		core.SummaryWidget{Name: "ri-utilization", Text: riUtil, Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "ri-unused", Text: riUnused, Tone: core.ToneError},
+		core.SummaryWidget{Name: "sp-utilization", Text: spUtil, Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "sp-unused", Text: spUnused, Tone: core.ToneError},
+	)
+	return v.CommonWidgets(widgets...)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Commitment Coverage"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "coverage" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,224 @@
+package dynamodb
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// Item Browser
+// =============================================================================
+
+const (
+	queryFormID  = "dynamodb:query"
+	updateFormID = "dynamodb:update_item"
+)
+
+// browser holds a page of query results, shown one item at a time while
+// their panel is open.
+type browser struct {
+	table     string
+	keys      []string // Key attributes, partition key first
+	statement string
+	limit     int
+
+	pages   []string // Tokens of the pages read so far, the current one last
+	pending []string // Pages once the running query succeeds
+	next    string   // Token of the next page, empty on the last one
+
+	items []Item
+	at    int
+}
+
+// openQueryForm asks for the statement and page size of a query on a table.
+func (v *View) openQueryForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "query")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "query")
+		return nil
+	}
+
+	var params []core.ActionParameter
+	for _, p := range def.Parameters {
+		switch p.Name {
+		case "statement":
+			p.Default = fmt.Sprintf("SELECT * FROM %s", quoteName(r.ID))
+			params = append(params, p)
+		case "limit":
+			params = append(params, p)
+		}
+	}
+
+	keys := []string{r.GetMetadataString("partition_key")}
+	if sortKey := r.GetMetadataString("sort_key"); sortKey != "" {
+		keys = append(keys, sortKey)
+	}
+	v.browser = &browser{table: r.ID, keys: keys}
+	return v.OpenForm(components.NewForm(queryFormID, i18n.T("Query %s", r.Name), params))
+}
+
+// readPage runs the browser's query for the page after the given ones.
+func (v *View) readPage(pages []string) tea.Cmd {
+	b := v.browser
+	b.pending = pages
+	v.Message = i18n.T("Querying %s...", b.table)
+	return v.executeAction("query", b.table, map[string]any{
+		"statement":  b.statement,
+		"limit":      b.limit,
+		"next_token": pages[len(pages)-1],
+	})
+}
+
+// showPage opens the panel on the first item of a page of results.
+func (v *View) showPage(page ItemPage) {
+	b := v.browser
+	b.statement, b.pages, b.next = page.Statement, b.pending, page.NextToken
+	b.items, b.at = page.Items, 0
+	v.showItem()
+}
+
+// showItem shows the current item as JSON.
+func (v *View) showItem() {
+	b := v.browser
+	help := i18n.T("[←/→] item  [[/]] page  [y] copy as JSON  [u]pdate attribute  [x] delete item")
+	if len(b.items) == 0 {
+		text := i18n.T("No items on this page.\n")
+		if b.next != "" {
+			text = i18n.T("No items matched on this page; press ] for the next one.\n")
+		}
+		v.OpenDetail(i18n.T("Page %d of %s", len(b.pages), b.table), help+"\n\n"+b.statement+"\n\n"+text)
+		return
+	}
+
+	more := ""
+	if b.next != "" {
+		more = "+"
+	}
+	title := i18n.T("Item %d of %d%s, page %d, in %s", b.at+1, len(b.items), more, len(b.pages), b.table)
+	v.OpenDetail(title, help+"\n\n"+b.statement+"\n\n"+b.items[b.at].JSON())
+}
+
+// updateBrowser handles the keys of the item panel. It returns false for
+// keys meant for the detail panel, such as scrolling.
+func (v *View) updateBrowser(msg tea.Msg) (bool, tea.Cmd) {
+	if _, ok := msg.(components.DetailClosedMsg); ok {
+		v.browser = nil
+		return false, nil
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || v.browser == nil {
+		return false, nil
+	}
+	b := v.browser
+	if b.pages == nil {
+		// The first page is still being read
+		return false, nil
+	}
+	if !v.CapturingInput() {
+		// The panel was replaced, by a canceled confirmation for instance
+		v.browser = nil
+		return false, nil
+	}
+	if !v.ShowingDetail() {
+		return false, nil
+	}
+
+	switch key.String() {
+	case "left", "h":
+		if b.at > 0 {
+			b.at--
+			v.showItem()
+		}
+	case "right", "l":
+		if b.at < len(b.items)-1 {
+			b.at++
+			v.showItem()
+		}
+	case "]":
+		if b.next == "" {
+			v.Message = i18n.T("This is the last page")
+			return true, nil
+		}
+		return true, v.readPage(append(b.pages[:len(b.pages):len(b.pages)], b.next))
+	case "[":
+		if len(b.pages) < 2 {
+			v.Message = i18n.T("This is the first page")
+			return true, nil
+		}
+		return true, v.readPage(b.pages[:len(b.pages)-1])
+	case "y":
+		if len(b.items) > 0 {
+			components.CopyToClipboard(b.items[b.at].JSON())
+			v.Message = i18n.T("Copied item %d as JSON", b.at+1)
+		}
+	case "x":
+		if len(b.items) > 0 {
+			v.Message = i18n.T("Deleting the item...")
+			return true, v.executeAction("delete_item", b.table, map[string]any{
+				"key": b.items[b.at].KeyJSON(b.keys...),
+			})
+		}
+	case "u":
+		if len(b.items) > 0 {
+			return true, v.openUpdateForm()
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// openUpdateForm asks for the attribute to set on the current item.
+func (v *View) openUpdateForm() tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "update_item")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "update_item")
+		return nil
+	}
+	var params []core.ActionParameter
+	for _, p := range def.Parameters {
+		if p.Name != "key" {
+			params = append(params, p)
+		}
+	}
+	return v.OpenForm(components.NewForm(updateFormID, i18n.T("Update item %d in %s", v.browser.at+1, v.browser.table), params))
+}
+
+// handleBrowserForm runs the query or update asked for by a submitted form.
+func (v *View) handleBrowserForm(msg components.FormResultMsg) tea.Cmd {
+	b := v.browser
+	if b == nil {
+		return nil
+	}
+	if msg.Canceled {
+		v.Message = i18n.T("Canceled")
+		if msg.ID == updateFormID {
+			v.showItem()
+		} else {
+			v.browser = nil
+		}
+		return nil
+	}
+
+	if msg.ID == queryFormID {
+		b.statement, _ = msg.Values["statement"].(string)
+		b.limit = DefaultPageSize
+		if limit, err := intParam(msg.Values["limit"]); err == nil && limit > 0 {
+			b.limit = limit
+		}
+		return v.readPage([]string{""})
+	}
+
+	params := map[string]any{"key": b.items[b.at].KeyJSON(b.keys...)}
+	for name, value := range msg.Values {
+		params[name] = value
+	}
+	v.Message = i18n.T("Updating the item...")
+	return v.executeAction("update_item", b.table, params)
+}
@@ -0,0 +1,472 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Items
+// =============================================================================
+
+const (
+	// DefaultPageSize is how many items a query evaluates per page.
+	DefaultPageSize = 25
+	// maxPageSize bounds the items evaluated per page.
+	maxPageSize = 1000
+)
+
+// selectTable matches the table a PartiQL SELECT reads from, quoted or not,
+// ignoring any index after it.
+var selectTable = regexp.MustCompile(`(?is)^\s*SELECT\s.+?\sFROM\s+("(?:[^"]|"")+"|[A-Za-z0-9_.-]+)`)
+
+// Item is a table item as returned by DynamoDB.
+type Item map[string]types.AttributeValue
+
+// ItemPage is the result data of the query action.
+type ItemPage struct {
+	Statement string
+	Items     []Item
+	NextToken string  // Empty on the last page
+	Consumed  float64 // Read capacity units consumed by the page
+}
+
+// JSON renders the item as indented JSON, without DynamoDB type
+// descriptors.
+func (it Item) JSON() string {
+	plain := make(map[string]any, len(it))
+	for name, value := range it {
+		plain[name] = plainValue(value)
+	}
+	out, err := json.MarshalIndent(plain, "", "  ")
+	if err != nil {
+		return fmt.Sprint(plain)
+	}
+	return string(out)
+}
+
+// KeyJSON renders the given key attributes of the item as DynamoDB JSON, as
+// the delete_item and update_item actions expect them.
+func (it Item) KeyJSON(names ...string) string {
+	key := make(map[string]any, len(names))
+	for _, name := range names {
+		if value, ok := it[name]; ok && name != "" {
+			key[name] = typedValue(value)
+		}
+	}
+	out, _ := json.Marshal(key)
+	return string(out)
+}
+
+// query runs a PartiQL SELECT on a table, one page at a time.
+func (s *Service) query(ctx context.Context, table, statement string, limit int, token string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("query", table, err)
+	}
+
+	if statement == "" {
+		statement = fmt.Sprintf("SELECT * FROM %s", quoteName(table))
+	}
+	m := selectTable.FindStringSubmatch(statement)
+	if m == nil {
+		return fail(core.NewValidationError("statement", statement, "must be a SELECT; use the item actions to change items"))
+	}
+	if from := unquoteTable(m[1]); from != table {
+		return fail(core.NewValidationError("statement", statement, fmt.Sprintf("reads %s, not %s", from, table)))
+	}
+
+	input := &dynamodb.ExecuteStatementInput{
+		Statement:              aws.String(statement),
+		Limit:                  aws.Int32(int32(limit)),
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityTotal,
+	}
+	if token != "" {
+		input.NextToken = aws.String(token)
+	}
+	out, err := s.client().ExecuteStatement(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+
+	page := ItemPage{
+		Statement: statement,
+		Items:     make([]Item, 0, len(out.Items)),
+		NextToken: aws.ToString(out.NextToken),
+	}
+	for _, item := range out.Items {
+		page.Items = append(page.Items, Item(item))
+	}
+	if out.ConsumedCapacity != nil {
+		page.Consumed = aws.ToFloat64(out.ConsumedCapacity.CapacityUnits)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Read %d items from %s (%.1f read capacity units)", len(page.Items), table, page.Consumed))
+	result.Data = page
+	return result, nil
+}
+
+// deleteItem deletes the item with the given key once confirmed.
+func (s *Service) deleteItem(ctx context.Context, table, rawKey string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete_item", table, err)
+	}
+
+	key, names, err := s.itemKey(ctx, table, rawKey)
+	if err != nil {
+		return fail(err)
+	}
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "delete_item", table, params, fmt.Sprintf("Deletes the item %s", formatKey(key, names)), false)
+	}
+
+	where, values := keyCondition(key, names)
+	_, err = s.client().ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement:  aws.String(fmt.Sprintf("DELETE FROM %s WHERE %s", quoteName(table), where)),
+		Parameters: values,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleted the item %s from %s", formatKey(key, names), table)), nil
+}
+
+// updateItem sets one attribute of an existing item once confirmed. Key
+// attributes cannot be changed.
+func (s *Service) updateItem(ctx context.Context, table, rawKey, attribute, rawValue string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("update_item", table, err)
+	}
+
+	key, names, err := s.itemKey(ctx, table, rawKey)
+	if err != nil {
+		return fail(err)
+	}
+	if _, isKey := key[attribute]; isKey {
+		return fail(core.NewValidationError("attribute", attribute, "is part of the key and cannot be changed"))
+	}
+	value := parseValue(rawValue)
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "update_item", table, params, fmt.Sprintf("Sets %s to %s on the item %s", attribute, formatValue(value), formatKey(key, names)), false)
+	}
+
+	where, values := keyCondition(key, names)
+	_, err = s.client().ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement:  aws.String(fmt.Sprintf("UPDATE %s SET %s = ? WHERE %s", quoteName(table), quoteName(attribute), where)),
+		Parameters: append([]types.AttributeValue{value}, values...),
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Set %s on the item %s in %s", attribute, formatKey(key, names), table)), nil
+}
+
+// itemKey decodes a key given as DynamoDB JSON and checks it names exactly
+// the key attributes of the table. It returns the key with its attribute
+// names, partition key first.
+func (s *Service) itemKey(ctx context.Context, table, raw string) (Item, []string, error) {
+	key, err := decodeItem(raw)
+	if err != nil {
+		return nil, nil, core.NewValidationError("key", raw, err.Error())
+	}
+
+	out, err := s.client().DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(table)})
+	if err != nil {
+		return nil, nil, err
+	}
+	partition, sortKey := keys(out.Table.KeySchema)
+	names := []string{partition}
+	if sortKey != "" {
+		names = append(names, sortKey)
+	}
+
+	if len(key) != len(names) {
+		return nil, nil, core.NewValidationError("key", raw, fmt.Sprintf("must have exactly the attributes %s", strings.Join(names, ", ")))
+	}
+	for _, name := range names {
+		if _, ok := key[name]; !ok {
+			return nil, nil, core.NewValidationError("key", raw, fmt.Sprintf("is missing the attribute %s", name))
+		}
+	}
+	return key, names, nil
+}
+
+// =============================================================================
+// Attribute Values
+// =============================================================================
+
+// keyCondition returns the WHERE clause matching a key and its parameters.
+func keyCondition(key Item, names []string) (string, []types.AttributeValue) {
+	conditions := make([]string, 0, len(names))
+	values := make([]types.AttributeValue, 0, len(names))
+	for _, name := range names {
+		conditions = append(conditions, quoteName(name)+" = ?")
+		values = append(values, key[name])
+	}
+	return strings.Join(conditions, " AND "), values
+}
+
+// quoteName quotes a table or attribute name for PartiQL.
+func quoteName(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// unquoteTable returns the table named by the FROM clause of a statement.
+func unquoteTable(from string) string {
+	if strings.HasPrefix(from, `"`) {
+		return strings.ReplaceAll(from[1:len(from)-1], `""`, `"`)
+	}
+	table, _, _ := strings.Cut(from, ".")
+	return table
+}
+
+// formatKey renders a key as name=value pairs, partition key first.
+func formatKey(key Item, names []string) string {
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"="+formatValue(key[name]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// formatValue renders a value as compact JSON.
+func formatValue(value types.AttributeValue) string {
+	out, err := json.Marshal(plainValue(value))
+	if err != nil {
+		return fmt.Sprint(plainValue(value))
+	}
+	return string(out)
+}
+
+// plainValue converts an attribute value to plain Go values: numbers keep
+// their precision and binary values are base64-encoded.
+func plainValue(value types.AttributeValue) any {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *types.AttributeValueMemberB:
+		return base64.StdEncoding.EncodeToString(v.Value)
+	case *types.AttributeValueMemberBOOL:
+		return v.Value
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberM:
+		m := make(map[string]any, len(v.Value))
+		for name, member := range v.Value {
+			m[name] = plainValue(member)
+		}
+		return m
+	case *types.AttributeValueMemberL:
+		l := make([]any, 0, len(v.Value))
+		for _, member := range v.Value {
+			l = append(l, plainValue(member))
+		}
+		return l
+	case *types.AttributeValueMemberSS:
+		return v.Value
+	case *types.AttributeValueMemberNS:
+		ns := make([]json.Number, 0, len(v.Value))
+		for _, n := range v.Value {
+			ns = append(ns, json.Number(n))
+		}
+		return ns
+	case *types.AttributeValueMemberBS:
+		bs := make([]string, 0, len(v.Value))
+		for _, b := range v.Value {
+			bs = append(bs, base64.StdEncoding.EncodeToString(b))
+		}
+		return bs
+	}
+	return nil
+}
+
+// typedValue converts an attribute value to DynamoDB JSON, such as
+// {"S": "abc"}.
+func typedValue(value types.AttributeValue) map[string]any {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]any{"S": v.Value}
+	case *types.AttributeValueMemberN:
+		return map[string]any{"N": v.Value}
+	case *types.AttributeValueMemberB:
+		return map[string]any{"B": base64.StdEncoding.EncodeToString(v.Value)}
+	case *types.AttributeValueMemberBOOL:
+		return map[string]any{"BOOL": v.Value}
+	case *types.AttributeValueMemberNULL:
+		return map[string]any{"NULL": true}
+	case *types.AttributeValueMemberM:
+		m := make(map[string]any, len(v.Value))
+		for name, member := range v.Value {
+			m[name] = typedValue(member)
+		}
+		return map[string]any{"M": m}
+	case *types.AttributeValueMemberL:
+		l := make([]any, 0, len(v.Value))
+		for _, member := range v.Value {
+			l = append(l, typedValue(member))
+		}
+		return map[string]any{"L": l}
+	case *types.AttributeValueMemberSS:
+		return map[string]any{"SS": v.Value}
+	case *types.AttributeValueMemberNS:
+		return map[string]any{"NS": v.Value}
+	case *types.AttributeValueMemberBS:
+		bs := make([]string, 0, len(v.Value))
+		for _, b := range v.Value {
+			bs = append(bs, base64.StdEncoding.EncodeToString(b))
+		}
+		return map[string]any{"BS": bs}
+	}
+	return nil
+}
+
+// decodeItem parses attributes given as DynamoDB JSON.
+func decodeItem(raw string) (Item, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return nil, fmt.Errorf("must be a JSON object: %w", err)
+	}
+	item := make(Item, len(fields))
+	for name, field := range fields {
+		value, err := decodeValue(field)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		item[name] = value
+	}
+	return item, nil
+}
+
+// decodeValue parses one value in DynamoDB JSON, such as {"N": "42"}.
+func decodeValue(raw json.RawMessage) (types.AttributeValue, error) {
+	var typed map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &typed); err != nil || len(typed) != 1 {
+		return nil, fmt.Errorf("expected a single type descriptor such as {\"S\": \"text\"}")
+	}
+	for kind, body := range typed {
+		switch kind {
+		case "S":
+			var s string
+			err := json.Unmarshal(body, &s)
+			return &types.AttributeValueMemberS{Value: s}, err
+		case "N":
+			var n string
+			err := json.Unmarshal(body, &n)
+			return &types.AttributeValueMemberN{Value: n}, err
+		case "B":
+			var b []byte // Base64 in JSON
+			err := json.Unmarshal(body, &b)
+			return &types.AttributeValueMemberB{Value: b}, err
+		case "BOOL":
+			var b bool
+			err := json.Unmarshal(body, &b)
+			return &types.AttributeValueMemberBOOL{Value: b}, err
+		case "NULL":
+			return &types.AttributeValueMemberNULL{Value: true}, nil
+		case "SS":
+			var ss []string
+			err := json.Unmarshal(body, &ss)
+			return &types.AttributeValueMemberSS{Value: ss}, err
+		case "NS":
+			var ns []string
+			err := json.Unmarshal(body, &ns)
+			return &types.AttributeValueMemberNS{Value: ns}, err
+		case "BS":
+			var bs [][]byte
+			err := json.Unmarshal(body, &bs)
+			return &types.AttributeValueMemberBS{Value: bs}, err
+		case "M":
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(body, &fields); err != nil {
+				return nil, err
+			}
+			m := make(map[string]types.AttributeValue, len(fields))
+			for name, field := range fields {
+				value, err := decodeValue(field)
+				if err != nil {
+					return nil, err
+				}
+				m[name] = value
+			}
+			return &types.AttributeValueMemberM{Value: m}, nil
+		case "L":
+			var members []json.RawMessage
+			if err := json.Unmarshal(body, &members); err != nil {
+				return nil, err
+			}
+			l := make([]types.AttributeValue, 0, len(members))
+			for _, member := range members {
+				value, err := decodeValue(member)
+				if err != nil {
+					return nil, err
+				}
+				l = append(l, value)
+			}
+			return &types.AttributeValueMemberL{Value: l}, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", kind)
+	}
+	return nil, nil
+}
+
+// parseValue reads a value typed by the operator: JSON becomes the matching
+// attribute type, anything else a string.
+func parseValue(raw string) types.AttributeValue {
+	// Decode stops after the first value, so text such as "0 }" would
+	// otherwise be read as a number
+	if !json.Valid([]byte(raw)) {
+		return &types.AttributeValueMemberS{Value: raw}
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return &types.AttributeValueMemberS{Value: raw}
+	}
+	return attributeOf(v)
+}
+
+// attributeOf converts a decoded JSON value to an attribute value.
+func attributeOf(v any) types.AttributeValue {
+	switch v := v.(type) {
+	case string:
+		return &types.AttributeValueMemberS{Value: v}
+	case json.Number:
+		return &types.AttributeValueMemberN{Value: v.String()}
+	case bool:
+		return &types.AttributeValueMemberBOOL{Value: v}
+	case []any:
+		l := make([]types.AttributeValue, 0, len(v))
+		for _, member := range v {
+			l = append(l, attributeOf(member))
+		}
+		return &types.AttributeValueMemberL{Value: l}
+	case map[string]any:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		m := make(map[string]types.AttributeValue, len(v))
+		for _, name := range names {
+			m[name] = attributeOf(v[name])
+		}
+		return &types.AttributeValueMemberM{Value: m}
+	}
+	return &types.AttributeValueMemberNULL{Value: true}
+}
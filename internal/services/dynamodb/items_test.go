@@ -0,0 +1,57 @@
+package dynamodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// FuzzParseValue checks that any value typed for an item update becomes an
+// attribute value, and that text which is not JSON is kept as a string.
+func FuzzParseValue(f *testing.F) {
+	for _, seed := range []string{
+		"", "hello", `"quoted"`, "42", "-1.5e10", "true", "null",
+		`[1, "a", false]`, `{"a": {"b": [null]}}`, `{"a": 1} trailing`, "[[[[",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		value := parseValue(raw)
+		if value == nil {
+			t.Fatalf("parseValue(%q) returned nil", raw)
+		}
+		if !json.Valid([]byte(raw)) {
+			if s, ok := value.(*types.AttributeValueMemberS); !ok || s.Value != raw {
+				t.Fatalf("parseValue(%q) = %#v, want the text as a string", raw, value)
+			}
+		}
+		_ = formatValue(value)
+	})
+}
+
+// FuzzDecodeItem checks that keys and items typed in DynamoDB JSON are
+// rejected or decoded, never panicking on unknown or malformed types.
+func FuzzDecodeItem(f *testing.F) {
+	for _, seed := range []string{
+		`{"pk": "user#1"}`,
+		`{"pk": {"S": "user#1"}, "sk": {"N": "42"}}`,
+		`{"tags": {"SS": ["a", "b"]}, "blob": {"B": "aGk="}}`,
+		`{"m": {"M": {"l": {"L": [{"NULL": true}, {"BOOL": false}]}}}}`,
+		`{"x": {"Q": 1}}`,
+		`{"x": {}}`,
+		`[]`,
+		`{`,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		item, err := decodeItem(raw)
+		if err != nil {
+			return
+		}
+		_ = item.JSON()
+	})
+}
@@ -0,0 +1,64 @@
+package dynamodb
+
+import (
+	"math"
+
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// us-east-1 prices of the Standard table class.
+const (
+	readUnitHourly  = 0.00013 // Per provisioned read capacity unit
+	writeUnitHourly = 0.00065 // Per provisioned write capacity unit
+
+	readRequestPrice  = 0.125 / 1e6 // Per on-demand read request unit
+	writeRequestPrice = 0.625 / 1e6 // Per on-demand write request unit
+
+	storagePerGB = 0.25 // Monthly, per GB stored
+)
+
+// Standard-IA tables pay more for capacity and less for storage.
+const (
+	infrequentAccessCapacityFactor = 1.25
+	infrequentAccessStoragePerGB   = 0.10
+)
+
+// secondsPerMonth matches estimate.HoursPerMonth.
+const secondsPerMonth = estimate.HoursPerMonth * 3600
+
+// capacityFactor scales capacity prices by table class.
+func capacityFactor(class string) float64 {
+	if class == classInfrequentAccess {
+		return infrequentAccessCapacityFactor
+	}
+	return 1
+}
+
+// provisionedMonthlyCost returns the monthly cost of provisioned read and
+// write capacity units.
+func provisionedMonthlyCost(read, write int64, class string) float64 {
+	hourly := float64(read)*readUnitHourly + float64(write)*writeUnitHourly
+	return estimate.Monthly(hourly) * capacityFactor(class)
+}
+
+// onDemandMonthlyCost returns the monthly cost of serving the given average
+// consumption, in capacity units per second, on demand.
+func onDemandMonthlyCost(read, write float64, class string) float64 {
+	requests := read*secondsPerMonth*readRequestPrice + write*secondsPerMonth*writeRequestPrice
+	return requests * capacityFactor(class)
+}
+
+// storageMonthlyCost returns the monthly cost of storing a table.
+func storageMonthlyCost(bytes int64, class string) float64 {
+	price := storagePerGB
+	if class == classInfrequentAccess {
+		price = infrequentAccessStoragePerGB
+	}
+	return float64(bytes) / 1e9 * price
+}
+
+// suggestedCapacity returns the capacity to provision for a peak hourly
+// average, doubled to absorb bursts within the hour.
+func suggestedCapacity(peak float64) int64 {
+	return max(1, int64(math.Ceil(peak*burstHeadroom)))
+}
@@ -0,0 +1,839 @@
+// Package dynamodb provides Amazon DynamoDB integration for the a9s
+// application. It lists tables with their size, billing mode and indexes.
+// Analysis compares provisioned capacity with 14 days of consumption and
+// estimates the savings of rightsizing or switching billing modes. Items are
+// browsed with PartiQL, see items.go.
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// DefaultCapacityUtilization is the peak share of provisioned capacity used
+// over 14 days below which a table is flagged as over-provisioned.
+const DefaultCapacityUtilization = 20.0
+
+const (
+	// metricsLookback is the usage window, long enough to cover weekly
+	// batch jobs.
+	metricsLookback = 14 * 24 * time.Hour
+
+	// metricsPeriod is the CloudWatch period; consumption is averaged per
+	// hour, so bursts within an hour are smoothed out.
+	metricsPeriod = 3600
+
+	// burstHeadroom is kept above the peak hourly consumption when
+	// suggesting a capacity.
+	burstHeadroom = 2.0
+
+	// provisionedDiscount is how much cheaper provisioned capacity must be
+	// before switching an on-demand table is suggested, as traffic spikes
+	// beyond it would be throttled.
+	provisionedDiscount = 0.5
+
+	billingProvisioned = "provisioned"
+	billingOnDemand    = "on-demand"
+
+	classInfrequentAccess = string(types.TableClassStandardInfrequentAccess)
+)
+
+// Service implements DynamoDB operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    DynamoDBAPI
+	metricsClient CloudWatchAPI
+
+	capacityUtilization float64
+}
+
+// Option configures the DynamoDB service.
+type Option func(*Service)
+
+// WithCapacityUtilization sets the peak share of provisioned capacity below
+// which a table is flagged as over-provisioned. Values outside (0, 100) keep
+// the default.
+func WithCapacityUtilization(percent float64) Option {
+	return func(s *Service) {
+		if percent > 0 && percent < 100 {
+			s.capacityUtilization = percent
+		}
+	}
+}
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// DynamoDBAPI defines the DynamoDB client interface for mocking.
+type DynamoDBAPI interface {
+	ListTables(ctx context.Context, params *dynamodb.ListTablesInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error)
+	DescribeTable(ctx context.Context, params *dynamodb.DescribeTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
+	DescribeContinuousBackups(ctx context.Context, params *dynamodb.DescribeContinuousBackupsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error)
+	UpdateContinuousBackups(ctx context.Context, params *dynamodb.UpdateContinuousBackupsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error)
+	DescribeTimeToLive(ctx context.Context, params *dynamodb.DescribeTimeToLiveInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error)
+	DeleteTable(ctx context.Context, params *dynamodb.DeleteTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteTableOutput, error)
+	ExecuteStatement(ctx context.Context, params *dynamodb.ExecuteStatementInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ExecuteStatementOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// NewService creates a new DynamoDB service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:             factory,
+		dispatcher:          dispatcher,
+		capacityUtilization: DefaultCapacityUtilization,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client DynamoDBAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:          client,
+		dispatcher:          dispatcher,
+		capacityUtilization: DefaultCapacityUtilization,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the DynamoDB client for the current AWS context.
+func (s *Service) client() DynamoDBAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return dynamodb.NewFromConfig(s.factory.Config())
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "dynamodb"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "DynamoDB Tables"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "table"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListTables(ctx, &dynamodb.ListTablesInput{Limit: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("dynamodb", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the tables of the region. Item counts and sizes are the
+// approximations DynamoDB refreshes about every six hours.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	client := s.client()
+
+	resources := make([]core.Resource, 0)
+	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("dynamodb", "list", err)
+		}
+		for _, name := range page.TableNames {
+			out, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+			if err != nil || out.Table == nil {
+				// The table may have been deleted since it was listed
+				continue
+			}
+			resources = append(resources, tableToResource(*out.Table, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "dynamodb:table",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds point-in-time recovery status and 14 days of consumed
+// capacity. Provisioned tables peaking below the utilization threshold are
+// flagged, with the savings of rightsizing them or switching to on-demand;
+// on-demand tables with steady traffic are flagged when provisioning would
+// cost less than half as much.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	backups, err := s.client().DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(resource.ID),
+	})
+	if err != nil {
+		return err
+	}
+	pitr := pitrEnabled(backups.ContinuousBackupsDescription)
+	resource.Metadata["pitr"] = pitr
+	if !pitr {
+		resource.AddIssue(core.SeverityLow, "Point-in-time recovery is disabled")
+	}
+
+	usage, err := s.getUsage(ctx, resource.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	resource.Metadata["consumed_read"] = usage.avgRead
+	resource.Metadata["consumed_write"] = usage.avgWrite
+	resource.Metadata["peak_read"] = usage.peakRead
+	resource.Metadata["peak_write"] = usage.peakWrite
+
+	class := resource.GetMetadataString("table_class")
+	read, _ := resource.Metadata["read_capacity"].(int64)
+	write, _ := resource.Metadata["write_capacity"].(int64)
+	onDemand := onDemandMonthlyCost(usage.avgRead, usage.avgWrite, class)
+
+	var recommendations []string
+	savings := 0.0
+
+	switch resource.GetMetadataString("billing_mode") {
+	case billingProvisioned:
+		if read == 0 || write == 0 {
+			break
+		}
+		readUse := usage.peakRead / float64(read) * 100
+		writeUse := usage.peakWrite / float64(write) * 100
+		resource.Metadata["read_utilization"] = readUse
+		resource.Metadata["write_utilization"] = writeUse
+		if readUse >= s.capacityUtilization && writeUse >= s.capacityUtilization {
+			break
+		}
+
+		suggestedRead, suggestedWrite := read, write
+		if readUse < s.capacityUtilization {
+			suggestedRead = min(read, suggestedCapacity(usage.peakRead))
+		}
+		if writeUse < s.capacityUtilization {
+			suggestedWrite = min(write, suggestedCapacity(usage.peakWrite))
+		}
+		current := provisionedMonthlyCost(read, write, class)
+		rightsized := provisionedMonthlyCost(suggestedRead, suggestedWrite, class)
+
+		switch {
+		case onDemand < rightsized:
+			savings = current - onDemand
+			recommendations = append(recommendations, "switch to on-demand billing")
+		case rightsized < current:
+			savings = current - rightsized
+			resource.Metadata["suggested_read"] = suggestedRead
+			resource.Metadata["suggested_write"] = suggestedWrite
+			recommendations = append(recommendations, fmt.Sprintf("provision %d read and %d write capacity units", suggestedRead, suggestedWrite))
+		}
+		if savings > 0 {
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Over-provisioned: peaks at %.0f%% of read and %.0f%% of write capacity; %s saves %s/mo", readUse, writeUse, recommendations[0], estimate.FormatCost(savings)))
+		}
+
+	case billingOnDemand:
+		size, _ := resource.Metadata["size_bytes"].(int64)
+		estimate.ApplyCost(resource, storageMonthlyCost(size, class)+onDemand)
+
+		suggestedRead, suggestedWrite := suggestedCapacity(usage.peakRead), suggestedCapacity(usage.peakWrite)
+		provisioned := provisionedMonthlyCost(suggestedRead, suggestedWrite, class)
+		if provisioned < onDemand*provisionedDiscount {
+			savings = onDemand - provisioned
+			resource.Metadata["suggested_read"] = suggestedRead
+			resource.Metadata["suggested_write"] = suggestedWrite
+			recommendations = append(recommendations, fmt.Sprintf("switch to provisioned billing with %d read and %d write capacity units", suggestedRead, suggestedWrite))
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Steady traffic: provisioned capacity saves %s/mo over on-demand", estimate.FormatCost(savings)))
+		}
+	}
+
+	resource.Metadata["recommendations"] = recommendations
+	resource.Metadata["savings_monthly"] = savings
+	resource.Metadata["analyzed"] = true
+
+	return nil
+}
+
+// tableUsage summarizes consumed capacity over the lookback window, in
+// capacity units per second.
+type tableUsage struct {
+	avgRead, avgWrite   float64
+	peakRead, peakWrite float64 // Highest hourly average
+}
+
+// getUsage fetches a table's consumed read and write capacity in a single
+// GetMetricData call.
+func (s *Service) getUsage(ctx context.Context, table string, now time.Time) (tableUsage, error) {
+	metric := func(id, name string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/DynamoDB"),
+					MetricName: aws.String(name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: aws.String("TableName"), Value: aws.String(table)},
+					},
+				},
+				Period: aws.Int32(metricsPeriod),
+				Stat:   aws.String("Sum"),
+			},
+		}
+	}
+
+	out, err := s.metrics().GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(now.Add(-metricsLookback)),
+		EndTime:   aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metric("read", "ConsumedReadCapacityUnits"),
+			metric("write", "ConsumedWriteCapacityUnits"),
+		},
+	})
+	if err != nil {
+		return tableUsage{}, err
+	}
+
+	// Hours without requests have no datapoint, so averages span the
+	// whole window
+	hours := metricsLookback.Hours()
+	var usage tableUsage
+	for _, result := range out.MetricDataResults {
+		total, peak := 0.0, 0.0
+		for _, v := range result.Values {
+			total += v
+			peak = max(peak, v/metricsPeriod)
+		}
+		switch aws.ToString(result.Id) {
+		case "read":
+			usage.avgRead, usage.peakRead = total/hours/metricsPeriod, peak
+		case "write":
+			usage.avgWrite, usage.peakWrite = total/hours/metricsPeriod, peak
+		}
+	}
+	return usage, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for tables.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "describe",
+			Description: "Show keys, indexes, backups and time to live",
+			Icon:        "info",
+			Shortcut:    "enter",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "enable_pitr",
+			Description: "Enable point-in-time recovery",
+			Icon:        "shield",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "backup",
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the table and all its items",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "query",
+			Description: "Browse items with a PartiQL SELECT",
+			Icon:        "search",
+			Shortcut:    "i",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "statement", Type: "string", Description: "PartiQL SELECT (empty for every item)"},
+				{Name: "limit", Type: "int", Default: DefaultPageSize, Description: "Items evaluated per page (1-1000)"},
+				{Name: "next_token", Type: "string", Description: "Token of the page to read"},
+			},
+		},
+		{
+			Name:        "delete_item",
+			Description: "Delete an item by its key",
+			Icon:        "trash",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "key", Type: "string", Required: true, Description: "Key as DynamoDB JSON, such as {\"id\": {\"S\": \"42\"}}"},
+			},
+		},
+		{
+			Name:        "update_item",
+			Description: "Set one attribute of an item",
+			Icon:        "edit",
+			Shortcut:    "u",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "key", Type: "string", Required: true, Description: "Key as DynamoDB JSON, such as {\"id\": {\"S\": \"42\"}}"},
+				{Name: "attribute", Type: "string", Required: true, Description: "Attribute to set"},
+				{Name: "value", Type: "string", Required: true, Description: "New value as JSON, or plain text for a string"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a table, identified by its name.
+// Deleting the table or changing an item asks for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "describe":
+		result, err = s.describeTable(ctx, resourceID)
+	case "enable_pitr":
+		result, err = s.enablePITR(ctx, resourceID)
+	case "delete":
+		result, err = s.deleteTable(ctx, resourceID, params, core.Confirmed(params, resourceID, true))
+	case "query":
+		statement, _ := params["statement"].(string)
+		token, _ := params["next_token"].(string)
+		limit := DefaultPageSize
+		if v, ok := params["limit"]; ok {
+			limit, err = intParam(v)
+			if err != nil || limit < 1 || limit > maxPageSize {
+				return nil, core.NewValidationError("limit", v, "must be between 1 and 1000")
+			}
+		}
+		result, err = s.query(ctx, resourceID, strings.TrimSpace(statement), limit, token)
+	case "delete_item":
+		key, _ := params["key"].(string)
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.deleteItem(ctx, resourceID, key, params, confirmed)
+	case "update_item":
+		key, _ := params["key"].(string)
+		attribute, _ := params["attribute"].(string)
+		value, _ := params["value"].(string)
+		if strings.TrimSpace(attribute) == "" {
+			return nil, core.NewValidationError("attribute", attribute, "is required")
+		}
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.updateItem(ctx, resourceID, key, strings.TrimSpace(attribute), value, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// TableDetail is the result data of the describe action.
+type TableDetail struct {
+	Name               string
+	Status             string
+	BillingMode        string
+	Class              string
+	PartitionKey       string
+	SortKey            string
+	Items              int64
+	SizeBytes          int64
+	ReadCapacity       int64 // Zero for on-demand tables
+	WriteCapacity      int64
+	Indexes            []Index
+	LocalIndexes       []Index
+	Stream             string // Stream view type, empty when disabled
+	Replicas           []string
+	DeletionProtection bool
+	PITR               bool
+	EarliestRestore    time.Time
+	LatestRestore      time.Time
+	TTLAttribute       string
+	TTLStatus          string
+}
+
+// Index is a secondary index of a table.
+type Index struct {
+	Name          string
+	Status        string // Empty for local indexes
+	PartitionKey  string
+	SortKey       string
+	Projection    string
+	Items         int64
+	SizeBytes     int64
+	ReadCapacity  int64
+	WriteCapacity int64
+}
+
+func (s *Service) describeTable(ctx context.Context, name string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("describe", name, err)
+	}
+
+	client := s.client()
+	out, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return fail(core.ErrResourceNotFound)
+		}
+		return fail(err)
+	}
+	detail := detailOf(*out.Table)
+
+	backups, err := client.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{TableName: aws.String(name)})
+	if err != nil {
+		return fail(err)
+	}
+	if desc := backups.ContinuousBackupsDescription; pitrEnabled(desc) {
+		detail.PITR = true
+		detail.EarliestRestore = aws.ToTime(desc.PointInTimeRecoveryDescription.EarliestRestorableDateTime)
+		detail.LatestRestore = aws.ToTime(desc.PointInTimeRecoveryDescription.LatestRestorableDateTime)
+	}
+
+	ttl, err := client.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String(name)})
+	if err != nil {
+		return fail(err)
+	}
+	if ttl.TimeToLiveDescription != nil {
+		detail.TTLAttribute = aws.ToString(ttl.TimeToLiveDescription.AttributeName)
+		detail.TTLStatus = strings.ToLower(string(ttl.TimeToLiveDescription.TimeToLiveStatus))
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Described table %s", name))
+	result.Data = detail
+	return result, nil
+}
+
+// enablePITR turns on continuous backups, restorable to any second of the
+// last 35 days.
+func (s *Service) enablePITR(ctx context.Context, name string) (*core.ActionResult, error) {
+	_, err := s.client().UpdateContinuousBackups(ctx, &dynamodb.UpdateContinuousBackupsInput{
+		TableName: aws.String(name),
+		PointInTimeRecoverySpecification: &types.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("enable_pitr", name, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Point-in-time recovery enabled on %s", name)), nil
+}
+
+// deleteTable deletes a table once confirmed by typing its name. Tables
+// with deletion protection are refused.
+func (s *Service) deleteTable(ctx context.Context, name string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", name, err)
+	}
+
+	out, err := s.client().DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	if err != nil {
+		return fail(err)
+	}
+	detail := detailOf(*out.Table)
+	if detail.DeletionProtection {
+		return fail(core.NewValidationError("table", name, "has deletion protection enabled"))
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Deletes about %d items (%s) and cannot be undone", detail.Items, formatBytes(detail.SizeBytes))
+		return nil, core.NewConfirmationError(s, "delete", name, params, reason, true)
+	}
+
+	if _, err := s.client().DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(name)}); err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   name,
+		ResourceType: "dynamodb:table",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleting table %s", name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func detailOf(td types.TableDescription) TableDetail {
+	detail := TableDetail{
+		Name:               aws.ToString(td.TableName),
+		Status:             strings.ToLower(string(td.TableStatus)),
+		BillingMode:        billingMode(td.BillingModeSummary),
+		Class:              string(types.TableClassStandard),
+		Items:              aws.ToInt64(td.ItemCount),
+		SizeBytes:          aws.ToInt64(td.TableSizeBytes),
+		DeletionProtection: aws.ToBool(td.DeletionProtectionEnabled),
+	}
+	detail.PartitionKey, detail.SortKey = keys(td.KeySchema)
+	if td.TableClassSummary != nil && td.TableClassSummary.TableClass != "" {
+		detail.Class = string(td.TableClassSummary.TableClass)
+	}
+	if detail.BillingMode == billingProvisioned && td.ProvisionedThroughput != nil {
+		detail.ReadCapacity = aws.ToInt64(td.ProvisionedThroughput.ReadCapacityUnits)
+		detail.WriteCapacity = aws.ToInt64(td.ProvisionedThroughput.WriteCapacityUnits)
+	}
+	if stream := td.StreamSpecification; stream != nil && aws.ToBool(stream.StreamEnabled) {
+		detail.Stream = string(stream.StreamViewType)
+	}
+	for _, replica := range td.Replicas {
+		detail.Replicas = append(detail.Replicas, aws.ToString(replica.RegionName))
+	}
+
+	for _, gsi := range td.GlobalSecondaryIndexes {
+		index := Index{
+			Name:      aws.ToString(gsi.IndexName),
+			Status:    strings.ToLower(string(gsi.IndexStatus)),
+			Items:     aws.ToInt64(gsi.ItemCount),
+			SizeBytes: aws.ToInt64(gsi.IndexSizeBytes),
+		}
+		index.PartitionKey, index.SortKey = keys(gsi.KeySchema)
+		if gsi.Projection != nil {
+			index.Projection = strings.ToLower(string(gsi.Projection.ProjectionType))
+		}
+		if detail.BillingMode == billingProvisioned && gsi.ProvisionedThroughput != nil {
+			index.ReadCapacity = aws.ToInt64(gsi.ProvisionedThroughput.ReadCapacityUnits)
+			index.WriteCapacity = aws.ToInt64(gsi.ProvisionedThroughput.WriteCapacityUnits)
+		}
+		detail.Indexes = append(detail.Indexes, index)
+	}
+	for _, lsi := range td.LocalSecondaryIndexes {
+		index := Index{
+			Name:      aws.ToString(lsi.IndexName),
+			Items:     aws.ToInt64(lsi.ItemCount),
+			SizeBytes: aws.ToInt64(lsi.IndexSizeBytes),
+		}
+		index.PartitionKey, index.SortKey = keys(lsi.KeySchema)
+		if lsi.Projection != nil {
+			index.Projection = strings.ToLower(string(lsi.Projection.ProjectionType))
+		}
+		detail.LocalIndexes = append(detail.LocalIndexes, index)
+	}
+	return detail
+}
+
+func tableToResource(td types.TableDescription, now time.Time) core.Resource {
+	detail := detailOf(td)
+
+	resource := core.Resource{
+		ID:    detail.Name,
+		Type:  "dynamodb:table",
+		Name:  detail.Name,
+		ARN:   aws.ToString(td.TableArn),
+		State: detail.Status,
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"billing_mode":        detail.BillingMode,
+			"table_class":         detail.Class,
+			"partition_key":       detail.PartitionKey,
+			"sort_key":            detail.SortKey,
+			"item_count":          detail.Items,
+			"size_bytes":          detail.SizeBytes,
+			"read_capacity":       detail.ReadCapacity,
+			"write_capacity":      detail.WriteCapacity,
+			"gsis":                detail.Indexes,
+			"lsi_count":           len(detail.LocalIndexes),
+			"stream":              detail.Stream,
+			"replicas":            detail.Replicas,
+			"deletion_protection": detail.DeletionProtection,
+			"analyzed":            false,
+		},
+	}
+
+	resource.CreatedAt = td.CreationDateTime
+	estimate.ApplyAge(&resource, now)
+
+	// Index storage is billed like table storage; on-demand requests are
+	// only known once analyzed
+	size, read, write := detail.SizeBytes, detail.ReadCapacity, detail.WriteCapacity
+	for _, index := range detail.Indexes {
+		size += index.SizeBytes
+		read += index.ReadCapacity
+		write += index.WriteCapacity
+	}
+	estimate.ApplyCost(&resource, storageMonthlyCost(size, detail.Class)+provisionedMonthlyCost(read, write, detail.Class))
+
+	return resource
+}
+
+// billingMode returns "provisioned" or "on-demand". Tables created before
+// on-demand billing have no summary and are provisioned.
+func billingMode(summary *types.BillingModeSummary) string {
+	if summary != nil && summary.BillingMode == types.BillingModePayPerRequest {
+		return billingOnDemand
+	}
+	return billingProvisioned
+}
+
+// keys returns the partition and sort key attributes of a key schema.
+func keys(schema []types.KeySchemaElement) (partition, sort string) {
+	for _, key := range schema {
+		switch key.KeyType {
+		case types.KeyTypeHash:
+			partition = aws.ToString(key.AttributeName)
+		case types.KeyTypeRange:
+			sort = aws.ToString(key.AttributeName)
+		}
+	}
+	return partition, sort
+}
+
+func pitrEnabled(desc *types.ContinuousBackupsDescription) bool {
+	return desc != nil && desc.PointInTimeRecoveryDescription != nil &&
+		desc.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus == types.PointInTimeRecoveryStatusEnabled
+}
+
+// formatBytes formats a size in decimal units, as DynamoDB bills them.
+func formatBytes(bytes int64) string {
+	const unit = 1000
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "kMGTPE"[exp])
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "dynamodb", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "dynamodb", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		if strings.TrimSpace(n) == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
@@ -0,0 +1,274 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeDynamoDB serves two tables: orders, provisioned with 100 read and 50
+// write capacity units, keyed by customer and order and without
+// point-in-time recovery; and events, on demand, keyed by id and protected
+// from deletion. It fails every call when err is set and records the
+// statements executed and tables deleted.
+type fakeDynamoDB struct {
+	err        error
+	statements []*dynamodb.ExecuteStatementInput
+	deleted    []string
+}
+
+func (f *fakeDynamoDB) table(name string) (*types.TableDescription, bool) {
+	key := func(name string, keyType types.KeyType) types.KeySchemaElement {
+		return types.KeySchemaElement{AttributeName: aws.String(name), KeyType: keyType}
+	}
+	switch name {
+	case "orders":
+		return &types.TableDescription{
+			TableName:             aws.String("orders"),
+			TableArn:              aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/orders"),
+			TableStatus:           types.TableStatusActive,
+			KeySchema:             []types.KeySchemaElement{key("customer", types.KeyTypeHash), key("order", types.KeyTypeRange)},
+			ItemCount:             aws.Int64(1_000_000),
+			TableSizeBytes:        aws.Int64(2e9),
+			CreationDateTime:      aws.Time(time.Now().Add(-90 * 24 * time.Hour)),
+			ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: aws.Int64(100), WriteCapacityUnits: aws.Int64(50)},
+		}, true
+	case "events":
+		return &types.TableDescription{
+			TableName:                 aws.String("events"),
+			TableArn:                  aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/events"),
+			TableStatus:               types.TableStatusActive,
+			KeySchema:                 []types.KeySchemaElement{key("id", types.KeyTypeHash)},
+			BillingModeSummary:        &types.BillingModeSummary{BillingMode: types.BillingModePayPerRequest},
+			TableSizeBytes:            aws.Int64(4e9),
+			DeletionProtectionEnabled: aws.Bool(true),
+		}, true
+	}
+	return nil, false
+}
+
+func (f *fakeDynamoDB) ListTables(context.Context, *dynamodb.ListTablesInput, ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &dynamodb.ListTablesOutput{TableNames: []string{"deleted-since", "events", "orders"}}, nil
+}
+
+func (f *fakeDynamoDB) DescribeTable(_ context.Context, in *dynamodb.DescribeTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	table, ok := f.table(aws.ToString(in.TableName))
+	if !ok {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("Requested resource not found")}
+	}
+	return &dynamodb.DescribeTableOutput{Table: table}, nil
+}
+
+func (f *fakeDynamoDB) DescribeContinuousBackups(_ context.Context, in *dynamodb.DescribeContinuousBackupsInput, _ ...func(*dynamodb.Options)) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	status := types.PointInTimeRecoveryStatusDisabled
+	if aws.ToString(in.TableName) == "events" {
+		status = types.PointInTimeRecoveryStatusEnabled
+	}
+	return &dynamodb.DescribeContinuousBackupsOutput{ContinuousBackupsDescription: &types.ContinuousBackupsDescription{
+		ContinuousBackupsStatus:        types.ContinuousBackupsStatusEnabled,
+		PointInTimeRecoveryDescription: &types.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: status},
+	}}, nil
+}
+
+func (f *fakeDynamoDB) UpdateContinuousBackups(context.Context, *dynamodb.UpdateContinuousBackupsInput, ...func(*dynamodb.Options)) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &dynamodb.UpdateContinuousBackupsOutput{}, nil
+}
+
+func (f *fakeDynamoDB) DescribeTimeToLive(context.Context, *dynamodb.DescribeTimeToLiveInput, ...func(*dynamodb.Options)) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: &types.TimeToLiveDescription{TimeToLiveStatus: types.TimeToLiveStatusDisabled}}, nil
+}
+
+func (f *fakeDynamoDB) DeleteTable(_ context.Context, in *dynamodb.DeleteTableInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteTableOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.TableName))
+	return &dynamodb.DeleteTableOutput{}, nil
+}
+
+func (f *fakeDynamoDB) ExecuteStatement(_ context.Context, in *dynamodb.ExecuteStatementInput, _ ...func(*dynamodb.Options)) (*dynamodb.ExecuteStatementOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.statements = append(f.statements, in)
+	return &dynamodb.ExecuteStatementOutput{}, nil
+}
+
+// fakeCloudWatch serves a steady consumption, in capacity units per second,
+// for every hour of the lookback window.
+type fakeCloudWatch struct {
+	read, write float64
+}
+
+func (f fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	hourly := func(perSecond float64) []float64 {
+		values := make([]float64, int(metricsLookback.Hours()))
+		for i := range values {
+			values[i] = perSecond * metricsPeriod
+		}
+		return values
+	}
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: []cwtypes.MetricDataResult{
+		{Id: aws.String("read"), Values: hourly(f.read)},
+		{Id: aws.String("write"), Values: hourly(f.write)},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against tables.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeDynamoDB{}, d, WithMetricsClient(fakeCloudWatch{read: 10, write: 5}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeDynamoDB{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    "orders",
+		Action:        "enable_pitr",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestEnrichRightsizesCapacity(t *testing.T) {
+	tests := []struct {
+		name        string
+		read, write float64
+		utilization float64
+		want        string // Issue besides disabled point-in-time recovery
+	}{
+		{
+			name: "well used", read: 60, write: 30,
+		},
+		{
+			name: "over-provisioned", read: 10, write: 5,
+			want: "Over-provisioned: peaks at 10% of read and 10% of write capacity; provision 20 read and 10 write capacity units saves $26.57/mo",
+		},
+		{
+			name: "within a lower threshold", read: 10, write: 5, utilization: 5,
+		},
+		{
+			name: "nearly idle", read: 0.1, write: 0.1,
+			want: "Over-provisioned: peaks at 0% of read and 0% of write capacity; switch to on-demand billing saves $33.02/mo",
+		},
+	}
+	for _, tt := range tests {
+		svc := NewServiceWithClient(&fakeDynamoDB{}, nil, WithMetricsClient(fakeCloudWatch{read: tt.read, write: tt.write}), WithCapacityUtilization(tt.utilization))
+		resources, err := svc.List(context.Background(), core.ListOptions{})
+		if err != nil {
+			t.Fatalf("%s: List() error = %v", tt.name, err)
+		}
+		orders := resources[slices.IndexFunc(resources, func(r core.Resource) bool { return r.ID == "orders" })]
+		if err := svc.EnrichResource(context.Background(), &orders); err != nil {
+			t.Fatalf("%s: EnrichResource() error = %v", tt.name, err)
+		}
+
+		want := []string{"Point-in-time recovery is disabled"}
+		if tt.want != "" {
+			want = append(want, tt.want)
+		}
+		var got []string
+		for _, issue := range orders.Issues() {
+			got = append(got, issue.Message)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: issues = %q, want %q", tt.name, got, want)
+		}
+	}
+}
+
+// TestEnrichCostsOnDemandTables checks that on-demand tables are costed
+// from their consumption once analyzed.
+func TestEnrichCostsOnDemandTables(t *testing.T) {
+	svc := NewServiceWithClient(&fakeDynamoDB{}, nil, WithMetricsClient(fakeCloudWatch{read: 50, write: 20}))
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	events := resources[slices.IndexFunc(resources, func(r core.Resource) bool { return r.ID == "events" })]
+	if cost := events.Metadata[estimate.MonthlyCostKey]; cost != 1.0 {
+		t.Errorf("events listed at %v/mo, want its storage only", cost)
+	}
+
+	if err := svc.EnrichResource(context.Background(), &events); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+	want := 1.0 + onDemandMonthlyCost(50, 20, string(types.TableClassStandard))
+	if cost := events.Metadata[estimate.MonthlyCostKey]; cost != want {
+		t.Errorf("events analyzed at %v/mo, want %v", cost, want)
+	}
+	if issues := events.Issues(); len(issues) != 0 {
+		t.Errorf("events issues = %v", issues)
+	}
+}
+
+func TestDeleteTable(t *testing.T) {
+	fake := &fakeDynamoDB{}
+	svc := NewServiceWithClient(fake, nil)
+
+	_, err := svc.Execute(context.Background(), "delete", "orders", map[string]any{core.ParamConfirm: true})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || confirm.Reason != "Deletes about 1000000 items (2.0 GB) and cannot be undone" {
+		t.Fatalf("delete without typing the name error = %v", err)
+	}
+
+	if _, err := svc.Execute(context.Background(), "delete", "events", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "events"}); err == nil || !strings.Contains(err.Error(), "deletion protection") {
+		t.Errorf("delete of a protected table error = %v", err)
+	}
+	if _, err := svc.Execute(context.Background(), "delete", "orders", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "orders"}); err != nil {
+		t.Fatalf("delete error = %v", err)
+	}
+	if !slices.Equal(fake.deleted, []string{"orders"}) {
+		t.Errorf("deleted %v", fake.deleted)
+	}
+}
+
+func TestItemActionsMatchTheKey(t *testing.T) {
+	fake := &fakeDynamoDB{}
+	svc := NewServiceWithClient(fake, nil)
+
+	for raw, want := range map[string]string{
+		`{"customer": {"S": "c-1"}}`:                          "must have exactly the attributes customer, order",
+		`{"customer": {"S": "c-1"}, "status": {"S": "paid"}}`: "is missing the attribute order",
+	} {
+		if _, err := svc.Execute(context.Background(), "delete_item", "orders", map[string]any{"key": raw, core.ParamConfirm: true}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("delete_item %s error = %v, want %q", raw, err, want)
+		}
+	}
+
+	params := map[string]any{"key": `{"order": {"N": "7"}, "customer": {"S": "c-1"}}`, "attribute": "status", "value": "shipped", core.ParamConfirm: true}
+	if _, err := svc.Execute(context.Background(), "update_item", "orders", params); err != nil {
+		t.Fatalf("update_item error = %v", err)
+	}
+	if len(fake.statements) != 1 || aws.ToString(fake.statements[0].Statement) != `UPDATE "orders" SET "status" = ? WHERE "customer" = ? AND "order" = ?` {
+		t.Errorf("statements = %+v", fake.statements)
+	}
+}
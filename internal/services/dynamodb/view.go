@@ -0,0 +1,372 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for DynamoDB tables.
+type View struct {
+	*base.EnrichableTableView
+
+	browser *browser // Items of the open query, see browser.go
+}
+
+// NewView creates a new DynamoDB view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Billing"), MinWidth: 11, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("RCU/WCU"), MinWidth: 9, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Items"), MinWidth: 7, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Size"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("GSIs"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 3},
+		{Title: i18n.T("PITR"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 3},
+		{Title: i18n.T("Peak use"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Savings $/mo"), MinWidth: 12, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("DynamoDB", "", "dynamodb", i18n.T("tables"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.updateBrowser(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Enabling point-in-time recovery on %s...", row.Name)
+				return v, v.executeAction("enable_pitr", row.ID, nil)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Describing %s...", row.Name)
+				return v, v.executeAction("describe", row.ID, nil)
+			}
+		case "i":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openQueryForm(row)
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID == queryFormID || msg.ID == updateFormID {
+			return v, v.handleBrowserForm(msg)
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if detail, ok := msg.Result.Data.(TableDetail); ok {
+			v.OpenDetail(i18n.T("Table %s", detail.Name), formatDetail(detail))
+			return v, nil
+		}
+		if page, ok := msg.Result.Data.(ItemPage); ok {
+			if v.browser != nil {
+				v.showPage(page)
+			}
+			return v, nil
+		}
+		// Changed items are read again, on the page they were on
+		if msg.Action == "delete_item" || msg.Action == "update_item" {
+			if v.browser != nil && v.browser.pages != nil {
+				return v, v.readPage(v.browser.pages)
+			}
+			return v, nil
+		}
+		// Analysis reads point-in-time recovery, so the table is analyzed
+		// again once enabled
+		if msg.Action == "enable_pitr" {
+			return v, v.AnalyzeSelected()
+		}
+		return v, v.SoftRefresh()
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading DynamoDB tables...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[i]tems  [p]itr  [d]elete  [a]nalyze  [Enter]describe  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the tables, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	items, _ := r.Metadata["item_count"].(int64)
+	size, _ := r.Metadata["size_bytes"].(int64)
+	read, _ := r.Metadata["read_capacity"].(int64)
+	write, _ := r.Metadata["write_capacity"].(int64)
+	indexes, _ := r.Metadata["gsis"].([]Index)
+
+	capacity := "-"
+	if r.GetMetadataString("billing_mode") == billingProvisioned {
+		capacity = fmt.Sprintf("%d/%d", read, write)
+	}
+
+	pitr, use, savings := "...", "...", "..."
+	var useValue, savingsValue any
+	if analyzed {
+		pitr = "-"
+		if enabled, _ := r.Metadata["pitr"].(bool); enabled {
+			pitr = "✓"
+		}
+		use = "-"
+		readUse, okRead := r.Metadata["read_utilization"].(float64)
+		writeUse, okWrite := r.Metadata["write_utilization"].(float64)
+		if okRead && okWrite {
+			useValue = max(readUse, writeUse)
+			use = fmt.Sprintf("%.0f%%/%.0f%%", readUse, writeUse)
+		}
+		monthly, _ := r.Metadata["savings_monthly"].(float64)
+		savingsValue = monthly
+		savings = "-"
+		if monthly > 0 {
+			savings = "💰 " + estimate.FormatCost(monthly)
+		}
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(r.GetMetadataString("billing_mode")),
+		base.LazyCell(read+write, func() string { return capacity }),
+		base.LazyCell(items, func() string { return fmt.Sprintf("%d", items) }),
+		base.LazyCell(size, func() string { return formatBytes(size) }),
+		base.LazyCell(len(indexes), func() string { return fmt.Sprintf("%d", len(indexes)) }),
+		base.TextCell(pitr),
+		base.LazyCell(useValue, func() string { return use }),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.LazyCell(savingsValue, func() string { return savings }),
+		base.SeverityCell(r),
+	}
+}
+
+// formatDetail renders a table's keys, capacity, indexes and backups for
+// the detail panel.
+func formatDetail(d TableDetail) string {
+	var b strings.Builder
+	keys := d.PartitionKey
+	if d.SortKey != "" {
+		keys += ", " + d.SortKey
+	}
+	fmt.Fprintf(&b, "Keys:        %s\n", keys)
+	fmt.Fprintf(&b, "State:       %s\n", d.Status)
+	fmt.Fprintf(&b, "Class:       %s\n", d.Class)
+	if d.BillingMode == billingProvisioned {
+		fmt.Fprintf(&b, "Billing:     provisioned, %d read and %d write capacity units\n", d.ReadCapacity, d.WriteCapacity)
+	} else {
+		fmt.Fprintf(&b, "Billing:     %s\n", d.BillingMode)
+	}
+	fmt.Fprintf(&b, "Items:       %d (%s)\n", d.Items, formatBytes(d.SizeBytes))
+	if d.Stream != "" {
+		fmt.Fprintf(&b, "Stream:      %s\n", d.Stream)
+	}
+	if len(d.Replicas) > 0 {
+		fmt.Fprintf(&b, "Replicas:    %s\n", strings.Join(d.Replicas, ", "))
+	}
+	fmt.Fprintf(&b, "Deletion protection: %t\n", d.DeletionProtection)
+
+	b.WriteString("\n")
+	if d.PITR {
+		fmt.Fprintf(&b, "Point-in-time recovery: %s to %s\n",
+			d.EarliestRestore.Local().Format("2006-01-02 15:04"), d.LatestRestore.Local().Format("2006-01-02 15:04"))
+	} else {
+		b.WriteString(i18n.T("Point-in-time recovery: disabled (press [p] to enable)\n"))
+	}
+	if d.TTLAttribute != "" {
+		fmt.Fprintf(&b, "Time to live: %s (%s)\n", d.TTLAttribute, d.TTLStatus)
+	} else {
+		fmt.Fprintf(&b, "Time to live: %s\n", d.TTLStatus)
+	}
+
+	writeIndexes := func(title string, indexes []Index) {
+		if len(indexes) == 0 {
+			return
+		}
+		b.WriteString(title)
+		for _, index := range indexes {
+			keys := index.PartitionKey
+			if index.SortKey != "" {
+				keys += ", " + index.SortKey
+			}
+			fmt.Fprintf(&b, "  %s (%s; %s)", index.Name, keys, index.Projection)
+			if index.Status != "" && index.Status != "active" {
+				fmt.Fprintf(&b, " %s", index.Status)
+			}
+			if index.ReadCapacity > 0 || index.WriteCapacity > 0 {
+				fmt.Fprintf(&b, " %d/%d RCU/WCU", index.ReadCapacity, index.WriteCapacity)
+			}
+			fmt.Fprintf(&b, ", %d items, %s\n", index.Items, formatBytes(index.SizeBytes))
+		}
+	}
+	writeIndexes(i18n.T("\nGlobal secondary indexes:\n"), d.Indexes)
+	writeIndexes(i18n.T("\nLocal secondary indexes:\n"), d.LocalIndexes)
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	savings := 0.0
+	onDemand := 0
+	for _, r := range v.Resources {
+		monthly, _ := r.Metadata["savings_monthly"].(float64)
+		savings += monthly
+		if r.GetMetadataString("billing_mode") == billingOnDemand {
+			onDemand++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "on-demand", Text: i18n.T("On-demand: %d", onDemand), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "savings", Text: i18n.T("Savings: $%.2f/mo", savings), Tone: core.ToneSuccess},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("DynamoDB Tables"), v.SummaryWidgets())
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "dynamodb" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,466 @@
+// Package ebs provides EBS volume inventory for the a9s application. It
+// lists the volumes of the region with their size, type and attachment, and
+// flags unattached volumes old enough to be forgotten as cleanup candidates.
+package ebs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// DefaultCleanupAge is how old an unattached volume must be to be flagged
+// as a cleanup candidate.
+const DefaultCleanupAge = 30 * 24 * time.Hour
+
+// Monthly prices per GB of each volume type, as in us-east-1.
+var pricePerGB = map[types.VolumeType]float64{
+	types.VolumeTypeGp3:      0.08,
+	types.VolumeTypeGp2:      0.10,
+	types.VolumeTypeIo1:      0.125,
+	types.VolumeTypeIo2:      0.125,
+	types.VolumeTypeSt1:      0.045,
+	types.VolumeTypeSc1:      0.015,
+	types.VolumeTypeStandard: 0.05,
+}
+
+// Monthly prices of provisioned performance above what a volume includes.
+const (
+	pricePerIOPS      = 0.065 // io1 and io2, per provisioned IOPS
+	pricePerGp3IOPS   = 0.005 // gp3, per IOPS above gp3BaseIOPS
+	pricePerGp3MBps   = 0.04  // gp3, per MB/s above gp3BaseThroughput
+	gp3BaseIOPS       = 3000
+	gp3BaseThroughput = 125
+)
+
+// defaultDescription describes snapshots created without a description.
+const defaultDescription = "Created by a9s"
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements EBS volume operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+
+	cleanupAge time.Duration
+}
+
+// Option configures the EBS volume service.
+type Option func(*Service)
+
+// WithCleanupAge sets how many days an unattached volume must be old to be
+// flagged as a cleanup candidate. Non-positive values keep the default.
+func WithCleanupAge(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.cleanupAge = time.Duration(days) * 24 * time.Hour
+		}
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	CreateSnapshot(ctx context.Context, params *ec2.CreateSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error)
+	DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error)
+}
+
+// NewService creates a new EBS volume service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+		cleanupAge: DefaultCleanupAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+		cleanupAge: DefaultCleanupAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// region returns the region volumes are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "ebs"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "EBS Volumes"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "disk"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("ebs", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the volumes of the region, flagging unattached ones older
+// than the cleanup age.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	resources := make([]core.Resource, 0)
+	paginator := ec2.NewDescribeVolumesPaginator(s.client(), &ec2.DescribeVolumesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("ebs", "list", err)
+		}
+		for _, vol := range page.Volumes {
+			resources = append(resources, s.volumeToResource(vol, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:volume",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for volumes.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "snapshot",
+			Description: "Create a snapshot of the volume",
+			Icon:        "camera",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "backup",
+			Parameters: []core.ActionParameter{
+				{Name: "description", Type: "string", Default: defaultDescription, Description: "Description of the snapshot"},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete an unattached volume",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a volume. Deleting asks for
+// confirmation through a core.ConfirmationError until the "confirm"
+// parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "snapshot":
+		description, _ := params["description"].(string)
+		result, err = s.createSnapshot(ctx, resourceID, description)
+	case "delete":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Deleting a volume destroys its data; snapshot it first to keep a copy", true)
+		}
+		result, err = s.deleteVolume(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// createSnapshot starts a snapshot of a volume, carrying its Name tag over
+// so the snapshot can be told apart later.
+func (s *Service) createSnapshot(ctx context.Context, volumeID, description string) (*core.ActionResult, error) {
+	vol, err := s.getVolume(ctx, volumeID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("snapshot", volumeID, err)
+	}
+	if description = strings.TrimSpace(description); description == "" {
+		description = defaultDescription
+	}
+
+	input := &ec2.CreateSnapshotInput{
+		VolumeId:    aws.String(volumeID),
+		Description: aws.String(description),
+	}
+	for _, tag := range vol.Tags {
+		if aws.ToString(tag.Key) == "Name" {
+			input.TagSpecifications = []types.TagSpecification{{
+				ResourceType: types.ResourceTypeSnapshot,
+				Tags:         []types.Tag{tag},
+			}}
+		}
+	}
+
+	out, err := s.client().CreateSnapshot(ctx, input)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("snapshot", volumeID, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Snapshot %s of %s started", aws.ToString(out.SnapshotId), volumeID))
+	result.Data = map[string]any{"snapshot_id": aws.ToString(out.SnapshotId)}
+	return result, nil
+}
+
+func (s *Service) deleteVolume(ctx context.Context, volumeID string) (*core.ActionResult, error) {
+	vol, err := s.getVolume(ctx, volumeID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", volumeID, err)
+	}
+	if vol.State != types.VolumeStateAvailable {
+		err = core.NewValidationError("volume", volumeID, fmt.Sprintf("is %s; detach it first", vol.State))
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", volumeID, err)
+	}
+
+	_, err = s.client().DeleteVolume(ctx, &ec2.DeleteVolumeInput{
+		VolumeId: aws.String(volumeID),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", volumeID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Volume %s deleted", volumeID)), nil
+}
+
+func (s *Service) getVolume(ctx context.Context, volumeID string) (types.Volume, error) {
+	out, err := s.client().DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: []string{volumeID},
+	})
+	if err != nil {
+		return types.Volume{}, err
+	}
+	if len(out.Volumes) == 0 {
+		return types.Volume{}, fmt.Errorf("%w: %s", core.ErrResourceNotFound, volumeID)
+	}
+	return out.Volumes[0], nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) volumeToResource(vol types.Volume, now time.Time) core.Resource {
+	id := aws.ToString(vol.VolumeId)
+	resource := core.Resource{
+		ID:        id,
+		Type:      "ec2:volume",
+		Name:      id,
+		State:     string(vol.State),
+		Region:    s.region(),
+		CreatedAt: vol.CreateTime,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"size_gb":           aws.ToInt32(vol.Size),
+			"volume_type":       string(vol.VolumeType),
+			"iops":              aws.ToInt32(vol.Iops),
+			"throughput":        aws.ToInt32(vol.Throughput),
+			"encrypted":         aws.ToBool(vol.Encrypted),
+			"availability_zone": aws.ToString(vol.AvailabilityZone),
+			"snapshot_id":       aws.ToString(vol.SnapshotId),
+			"attachment_state":  "detached",
+		},
+	}
+
+	var attachedTo []string
+	for _, attachment := range vol.Attachments {
+		attachedTo = append(attachedTo, aws.ToString(attachment.InstanceId))
+		resource.Metadata["attachment_state"] = string(attachment.State)
+		resource.Metadata["device"] = aws.ToString(attachment.Device)
+		resource.Metadata["delete_on_termination"] = aws.ToBool(attachment.DeleteOnTermination)
+	}
+	resource.Metadata["attached_to"] = attachedTo
+
+	for _, tag := range vol.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" && value != "" {
+			resource.Name = value
+		}
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+	estimate.ApplyCost(&resource, monthlyCost(vol))
+
+	shouldCleanup, cleanupReason := s.shouldCleanup(vol, now)
+	resource.Metadata["should_cleanup"] = shouldCleanup
+	resource.Metadata["cleanup_reason"] = cleanupReason
+	if shouldCleanup {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+cleanupReason)
+	}
+	if !aws.ToBool(vol.Encrypted) {
+		resource.AddIssue(core.SeverityMedium, "Unencrypted")
+	}
+
+	return resource
+}
+
+// shouldCleanup flags volumes attached to nothing for longer than the
+// cleanup age. Volumes are only known to be as old as their creation, so
+// recently detached old volumes are flagged too.
+func (s *Service) shouldCleanup(vol types.Volume, now time.Time) (bool, string) {
+	if vol.State != types.VolumeStateAvailable || vol.CreateTime == nil {
+		return false, ""
+	}
+	age := now.Sub(*vol.CreateTime)
+	if age < s.cleanupAge {
+		return false, ""
+	}
+
+	reasons := []string{"unattached", estimate.FormatAge(age) + " old"}
+	if len(vol.Tags) == 0 {
+		reasons = append(reasons, "untagged")
+	}
+	return true, strings.Join(reasons, ", ")
+}
+
+// monthlyCost estimates the monthly cost of a volume from its size and
+// provisioned performance.
+func monthlyCost(vol types.Volume) float64 {
+	size := float64(aws.ToInt32(vol.Size))
+	iops := float64(aws.ToInt32(vol.Iops))
+	monthly := size * pricePerGB[vol.VolumeType]
+
+	switch vol.VolumeType {
+	case types.VolumeTypeGp3:
+		monthly += max(0, iops-gp3BaseIOPS) * pricePerGp3IOPS
+		monthly += max(0, float64(aws.ToInt32(vol.Throughput))-gp3BaseThroughput) * pricePerGp3MBps
+	case types.VolumeTypeIo1, types.VolumeTypeIo2:
+		monthly += iops * pricePerIOPS
+	}
+	return monthly
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "ebs", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "ebs", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
@@ -0,0 +1,159 @@
+package ebs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves a gp3 root volume attached to an instance and an
+// unattached, untagged io1 volume created 90 days ago, or fails every call
+// when err is set. It records the snapshots requested and volumes deleted.
+type fakeEC2 struct {
+	err       error
+	snapshots []*ec2.CreateSnapshotInput
+	deleted   []string
+}
+
+func (f *fakeEC2) volumes() []types.Volume {
+	created := time.Now().Add(-90 * 24 * time.Hour)
+	return []types.Volume{
+		{
+			VolumeId:   aws.String("vol-root"),
+			Size:       aws.Int32(20),
+			VolumeType: types.VolumeTypeGp3,
+			State:      types.VolumeStateInUse,
+			Encrypted:  aws.Bool(true),
+			CreateTime: &created,
+			Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("web-root")}},
+			Attachments: []types.VolumeAttachment{{
+				InstanceId: aws.String("i-web"),
+				Device:     aws.String("/dev/xvda"),
+				State:      types.VolumeAttachmentStateAttached,
+			}},
+		},
+		{
+			VolumeId:   aws.String("vol-orphan"),
+			Size:       aws.Int32(100),
+			VolumeType: types.VolumeTypeIo1,
+			Iops:       aws.Int32(1000),
+			State:      types.VolumeStateAvailable,
+			Encrypted:  aws.Bool(false),
+			CreateTime: &created,
+		},
+	}
+}
+
+func (f *fakeEC2) DescribeVolumes(_ context.Context, in *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(in.VolumeIds) == 0 {
+		return &ec2.DescribeVolumesOutput{Volumes: f.volumes()}, nil
+	}
+	for _, vol := range f.volumes() {
+		if aws.ToString(vol.VolumeId) == in.VolumeIds[0] {
+			return &ec2.DescribeVolumesOutput{Volumes: []types.Volume{vol}}, nil
+		}
+	}
+	return nil, errors.New("InvalidVolume.NotFound")
+}
+
+func (f *fakeEC2) CreateSnapshot(_ context.Context, in *ec2.CreateSnapshotInput, _ ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.snapshots = append(f.snapshots, in)
+	return &ec2.CreateSnapshotOutput{SnapshotId: aws.String("snap-1")}, nil
+}
+
+func (f *fakeEC2) DeleteVolume(_ context.Context, in *ec2.DeleteVolumeInput, _ ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.VolumeId))
+	return &ec2.DeleteVolumeOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against volumes.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID:    "vol-orphan",
+		MissingID:     "vol-missing",
+		Action:        "snapshot",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestListFlagsOldUnattachedVolumes(t *testing.T) {
+	svc := NewServiceWithClient(&fakeEC2{}, nil)
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	root, orphan := resources[0], resources[1]
+
+	if root.Name != "web-root" || len(root.Issues()) != 0 {
+		t.Errorf("root volume %q has issues %v, want none", root.Name, root.Issues())
+	}
+	if got := orphan.GetMetadataString("cleanup_reason"); got != "unattached, 2mo old, untagged" {
+		t.Errorf("orphan cleanup reason = %q", got)
+	}
+	if len(orphan.Issues()) != 2 {
+		t.Errorf("orphan issues = %v, want cleanup and unencrypted", orphan.Issues())
+	}
+
+	// Still recent with a longer cleanup age
+	svc = NewServiceWithClient(&fakeEC2{}, nil, WithCleanupAge(120))
+	resources, _ = svc.List(context.Background(), core.ListOptions{})
+	if cleanup, _ := resources[1].Metadata["should_cleanup"].(bool); cleanup {
+		t.Error("orphan flagged before the 120 day cleanup age")
+	}
+}
+
+func TestDeleteRefusesAttachedVolume(t *testing.T) {
+	fake := &fakeEC2{}
+	svc := NewServiceWithClient(fake, nil)
+
+	params := map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "vol-root"}
+	_, err := svc.Execute(context.Background(), "delete", "vol-root", params)
+	var validation *core.ValidationError
+	if !errors.As(err, &validation) {
+		t.Errorf("delete attached volume error = %v, want a validation error", err)
+	}
+	if len(fake.deleted) > 0 {
+		t.Errorf("deleted %v", fake.deleted)
+	}
+}
+
+func TestSnapshotKeepsNameTag(t *testing.T) {
+	fake := &fakeEC2{}
+	svc := NewServiceWithClient(fake, nil)
+
+	if _, err := svc.Execute(context.Background(), "snapshot", "vol-root", map[string]any{"description": "  "}); err != nil {
+		t.Fatalf("snapshot error = %v", err)
+	}
+	in := fake.snapshots[0]
+	if aws.ToString(in.Description) != defaultDescription {
+		t.Errorf("description = %q, want the default", aws.ToString(in.Description))
+	}
+	if len(in.TagSpecifications) != 1 || aws.ToString(in.TagSpecifications[0].Tags[0].Value) != "web-root" {
+		t.Errorf("tag specifications = %+v, want the volume's Name", in.TagSpecifications)
+	}
+}
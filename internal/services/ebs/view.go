@@ -0,0 +1,355 @@
+package ebs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const snapshotFormID = "ebs:snapshot"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for EBS volumes.
+type View struct {
+	*base.TableView
+
+	formTarget string // Volume the snapshot form is open for
+}
+
+// NewView creates a new EBS volume view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 21, MaxWidth: 22, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("Size"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("IOPS"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Attached To"), MinWidth: 12, MaxWidth: 20, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("AZ"), MinWidth: 6, MaxWidth: 12, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Cleanup"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("EBS", "", "ebs", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadVolumes()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openSnapshotForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.ID)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Volume %s", row.ID), formatDetail(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != snapshotFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Snapshotting %s...", v.formTarget)
+		cmds = append(cmds, v.executeAction("snapshot", v.formTarget, msg.Values))
+
+	case volumesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d volumes", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if msg.Action == "delete" {
+				cmds = append(cmds, v.loadVolumes())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading volumes...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[s]napshot  [d]elete  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the volumes.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadVolumes()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type volumesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadVolumes() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return volumesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return volumesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return volumesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) openSnapshotForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "snapshot")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "snapshot")
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(snapshotFormID, i18n.T("Snapshot %s", r.Name), def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	size, _ := r.Metadata["size_gb"].(int32)
+	iops, _ := r.Metadata["iops"].(int32)
+	attachedTo, _ := r.Metadata["attached_to"].([]string)
+	shouldCleanup, _ := r.Metadata["should_cleanup"].(bool)
+
+	cleanupIcon := "🟢 " + i18n.T("No")
+	if shouldCleanup {
+		cleanupIcon = "🟡 " + i18n.T("Yes")
+	}
+	attached := "-"
+	if len(attachedTo) > 0 {
+		attached = strings.Join(attachedTo, ", ")
+	}
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.LazyCell(size, func() string { return fmt.Sprintf("%d GiB", size) }),
+		base.TextCell(r.GetMetadataString("volume_type")),
+		base.LazyCell(iops, func() string { return fmt.Sprintf("%d", iops) }),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(attached),
+		base.TextCell(r.GetMetadataString("availability_zone")),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.TextCell(cleanupIcon),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// formatDetail renders a volume's configuration, attachment and cost for
+// the detail panel.
+func formatDetail(r *core.Resource) string {
+	size, _ := r.Metadata["size_gb"].(int32)
+	iops, _ := r.Metadata["iops"].(int32)
+	throughput, _ := r.Metadata["throughput"].(int32)
+	encrypted, _ := r.Metadata["encrypted"].(bool)
+	attachedTo, _ := r.Metadata["attached_to"].([]string)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name:        %s\n", r.Name)
+	fmt.Fprintf(&b, "State:       %s\n", r.State)
+	fmt.Fprintf(&b, "Size:        %d GiB %s\n", size, r.GetMetadataString("volume_type"))
+	fmt.Fprintf(&b, "IOPS:        %d\n", iops)
+	if throughput > 0 {
+		fmt.Fprintf(&b, "Throughput:  %d MB/s\n", throughput)
+	}
+	fmt.Fprintf(&b, "Encrypted:   %t\n", encrypted)
+	fmt.Fprintf(&b, "AZ:          %s\n", r.GetMetadataString("availability_zone"))
+	if len(attachedTo) > 0 {
+		deleteOnTermination, _ := r.Metadata["delete_on_termination"].(bool)
+		fmt.Fprintf(&b, "Attached to: %s as %s (%s, deleted with instance: %t)\n",
+			strings.Join(attachedTo, ", "), r.GetMetadataString("device"), r.GetMetadataString("attachment_state"), deleteOnTermination)
+	}
+	if snapshot := r.GetMetadataString("snapshot_id"); snapshot != "" {
+		fmt.Fprintf(&b, "From:        %s\n", snapshot)
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:     %s (%s)\n", r.CreatedAt.Format("2006-01-02"), r.GetMetadataString(estimate.AgeKey))
+	}
+	if monthly, ok := estimate.MonthlyCost(*r); ok {
+		fmt.Fprintf(&b, "Est. cost:   %s/mo\n", estimate.FormatCost(monthly))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	unattached, cleanup := 0, 0
+	reclaimable := 0.0
+	for _, r := range v.Resources {
+		if attachedTo, _ := r.Metadata["attached_to"].([]string); len(attachedTo) == 0 {
+			unattached++
+		}
+		if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+			cleanup++
+			if monthly, ok := estimate.MonthlyCost(r); ok {
+				reclaimable += monthly
+			}
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unattached", Text: i18n.T("Unattached: %d", unattached), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cleanup", Text: i18n.T("Cleanup: %d (%s/mo)", cleanup, estimate.FormatCost(reclaimable)), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("EBS Volumes"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "ebs" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,84 @@
+package ec2
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// largeHourlyPrices are us-east-1 Linux on-demand prices of the .large size
+// of common instance families. Other sizes scale linearly.
+var largeHourlyPrices = map[string]float64{
+	"t2":  0.0928,
+	"t3":  0.0832,
+	"t3a": 0.0752,
+	"t4g": 0.0672,
+	"m5":  0.096,
+	"m5a": 0.086,
+	"m6a": 0.0864,
+	"m6g": 0.077,
+	"m6i": 0.096,
+	"m7g": 0.0816,
+	"m7i": 0.1008,
+	"c5":  0.085,
+	"c6g": 0.068,
+	"c6i": 0.085,
+	"c7g": 0.0725,
+	"c7i": 0.08925,
+	"r5":  0.126,
+	"r6g": 0.1008,
+	"r6i": 0.126,
+	"r7g": 0.1071,
+}
+
+// sizeFactors relate each size to .large; *xlarge sizes not listed are
+// parsed from their multiplier.
+var sizeFactors = map[string]float64{
+	"nano":   0.0625,
+	"micro":  0.125,
+	"small":  0.25,
+	"medium": 0.5,
+	"large":  1,
+	"xlarge": 2,
+}
+
+// hourlyPrice estimates the on-demand hourly price of an instance type, if
+// its family is known.
+func hourlyPrice(instanceType string) (float64, bool) {
+	family, size, ok := strings.Cut(instanceType, ".")
+	if !ok {
+		return 0, false
+	}
+	price, ok := largeHourlyPrices[family]
+	if !ok {
+		return 0, false
+	}
+	if factor, ok := sizeFactors[size]; ok {
+		return price * factor, true
+	}
+	multiplier, ok := strings.CutSuffix(size, "xlarge")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(multiplier)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return price * 2 * float64(n), true
+}
+
+// instanceMonthlyCost estimates the monthly compute cost of an instance.
+// Stopped instances are not billed for compute.
+func instanceMonthlyCost(instanceType, state string) (float64, bool) {
+	switch state {
+	case core.StateStopped, core.StateTerminated:
+		return 0, true
+	}
+	hourly, ok := hourlyPrice(instanceType)
+	if !ok {
+		return 0, false
+	}
+	return estimate.Monthly(hourly), true
+}
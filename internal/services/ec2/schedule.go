@@ -0,0 +1,163 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// ScheduleTagKey is the tag that assigns a stop/start schedule to an instance.
+const ScheduleTagKey = "a9s:schedule"
+
+// scheduleNone removes the schedule tag when passed to the schedule action.
+const scheduleNone = "none"
+
+// Schedule defines when a tagged instance should be running.
+type Schedule struct {
+	Name        string
+	Description string
+	Days        []time.Weekday // Days the instance runs
+	StartHour   int            // Inclusive, 0-23
+	StopHour    int            // Exclusive, 1-24
+}
+
+// Schedules are the standardized schedules accepted in the schedule tag.
+var Schedules = map[string]Schedule{
+	"office-hours": {
+		Name:        "office-hours",
+		Description: "Mon-Fri 08:00-18:00",
+		Days:        weekdays,
+		StartHour:   8,
+		StopHour:    18,
+	},
+	"weekdays": {
+		Name:        "weekdays",
+		Description: "Mon-Fri all day, stopped on weekends",
+		Days:        weekdays,
+		StartHour:   0,
+		StopHour:    24,
+	},
+	"nights-off": {
+		Name:        "nights-off",
+		Description: "Every day 07:00-22:00",
+		Days:        []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+		StartHour:   7,
+		StopHour:    22,
+	},
+}
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// ScheduleNames returns the known schedule names in sorted order.
+func ScheduleNames() []string {
+	names := make([]string, 0, len(Schedules))
+	for name := range Schedules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ShouldRun reports whether an instance on this schedule should be running at t.
+func (sch Schedule) ShouldRun(t time.Time) bool {
+	for _, day := range sch.Days {
+		if t.Weekday() == day {
+			return t.Hour() >= sch.StartHour && t.Hour() < sch.StopHour
+		}
+	}
+	return false
+}
+
+// ScheduleChange records a start or stop performed (or planned) by EnforceSchedules.
+type ScheduleChange struct {
+	InstanceID string
+	Name       string
+	Schedule   string
+	Action     string // "start" or "stop"
+	Err        error
+}
+
+// =============================================================================
+// Schedule Tagging
+// =============================================================================
+
+func (s *Service) setSchedule(ctx context.Context, instanceID, schedule string) (*core.ActionResult, error) {
+	if schedule == scheduleNone {
+		_, err := s.client().DeleteTags(ctx, &ec2.DeleteTagsInput{
+			Resources: []string{instanceID},
+			Tags:      []types.Tag{{Key: aws.String(ScheduleTagKey)}},
+		})
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("schedule", instanceID, err)
+		}
+		return core.NewActionResult(true, fmt.Sprintf("Removed schedule from %s", instanceID)), nil
+	}
+
+	sch, ok := Schedules[schedule]
+	if !ok {
+		return nil, core.NewValidationError("schedule", schedule, "unknown schedule")
+	}
+
+	_, err := s.client().CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{instanceID},
+		Tags:      []types.Tag{{Key: aws.String(ScheduleTagKey), Value: aws.String(sch.Name)}},
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("schedule", instanceID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Scheduled %s: %s (%s)", instanceID, sch.Name, sch.Description)), nil
+}
+
+// =============================================================================
+// Schedule Enforcement
+// =============================================================================
+
+// EnforceSchedules starts or stops tagged instances so their state matches
+// their schedule at now (converted to the configured schedule time zone).
+// Changes run through core.ExecuteAction, so that registered guards such as
+// maintenance windows apply; one they hold back is reported with its error.
+// With dryRun set, changes are reported but not applied.
+func (s *Service) EnforceSchedules(ctx context.Context, now time.Time, dryRun bool) ([]ScheduleChange, error) {
+	resources, err := s.List(ctx, core.ListOptions{
+		Filters: map[string]string{"tag-key": ScheduleTagKey},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	local := now.In(s.scheduleLocation)
+
+	var changes []ScheduleChange
+	for _, r := range resources {
+		sch, ok := Schedules[r.GetTag(ScheduleTagKey, "")]
+		if !ok {
+			continue
+		}
+
+		change := ScheduleChange{InstanceID: r.ID, Name: r.Name, Schedule: sch.Name}
+		shouldRun := sch.ShouldRun(local)
+		switch {
+		case shouldRun && r.State == core.StateStopped:
+			change.Action = "start"
+		case !shouldRun && r.State == core.StateRunning:
+			change.Action = "stop"
+		default:
+			continue // Already in the desired state or transitioning
+		}
+
+		if !dryRun {
+			_, change.Err = core.ExecuteAction(ctx, s, change.Action, r.ID, nil)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,48 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// blockGuard refuses every action, as a maintenance window does.
+type blockGuard struct{}
+
+func (blockGuard) Check(context.Context, core.ActionRequest) error {
+	return core.ErrActionBlocked
+}
+
+// TestEnforceSchedulesRunsGuards checks that the schedule daemon cannot
+// stop an instance a guard holds back, and reports the change as failed.
+func TestEnforceSchedulesRunsGuards(t *testing.T) {
+	t.Cleanup(core.ResetActionGuards)
+	t.Cleanup(core.ResetActionExecutions)
+	fake := newFakeEC2()
+	fake.schedule = "office-hours"
+	svc := NewServiceWithClient(fake, nil)
+	sunday := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+
+	core.RegisterActionGuard(blockGuard{})
+	changes, err := svc.EnforceSchedules(context.Background(), sunday, false)
+	if err != nil {
+		t.Fatalf("EnforceSchedules() error = %v", err)
+	}
+	if len(changes) != 1 || changes[0].Action != "stop" || !errors.Is(changes[0].Err, core.ErrActionBlocked) {
+		t.Fatalf("EnforceSchedules() = %+v, want a blocked stop", changes)
+	}
+	if fake.state != types.InstanceStateNameRunning {
+		t.Errorf("instance is %s, want it left running", fake.state)
+	}
+
+	core.ResetActionGuards()
+	changes, _ = svc.EnforceSchedules(context.Background(), sunday, false)
+	if len(changes) != 1 || changes[0].Err != nil || fake.state != types.InstanceStateNameStopped {
+		t.Errorf("EnforceSchedules() without guards = %+v, instance %s, want it stopped", changes, fake.state)
+	}
+}
@@ -3,26 +3,124 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	cotypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/ownership"
 )
 
 // =============================================================================
 // Service Implementation
 // =============================================================================
 
+// DefaultIdleCPUPercent is the average CPU utilization below which a
+// running instance is flagged as idle.
+const DefaultIdleCPUPercent = 5.0
+
+const (
+	// idleNetworkBytesPerDay is the average daily traffic (in + out) below
+	// which an instance with low CPU is considered idle.
+	idleNetworkBytesPerDay = 5 * 1024 * 1024
+
+	// metricsLookback is the utilization window used for idle detection.
+	metricsLookback = 14 * 24 * time.Hour
+
+	// minMetricDays is how many days of data are needed before an instance
+	// can be flagged, so recently launched instances are not reported.
+	minMetricDays = 7
+)
+
 // Service implements EC2 operations.
 type Service struct {
 	factory    *awsfactory.ClientFactory
 	dispatcher core.EventDispatcher
 	testClient EC2API // Only used for testing
+
+	metricsClient    CloudWatchAPI
+	optimizerClient  ComputeOptimizerAPI
+	idleCPUPercent   float64
+	computeOptimizer bool
+	scheduleLocation *time.Location
+	defaultFilters   map[string]string
+	owners           core.OwnerProvider
+}
+
+// Option configures the EC2 service.
+type Option func(*Service)
+
+// WithIdleCPUPercent sets the average CPU below which running instances are
+// flagged as idle. Non-positive values keep the default.
+func WithIdleCPUPercent(percent float64) Option {
+	return func(s *Service) {
+		if percent > 0 {
+			s.idleCPUPercent = percent
+		}
+	}
+}
+
+// WithComputeOptimizer enables Compute Optimizer rightsizing suggestions.
+// The account must be opted in to Compute Optimizer.
+func WithComputeOptimizer(enabled bool) Option {
+	return func(s *Service) {
+		s.computeOptimizer = enabled
+	}
+}
+
+// WithScheduleLocation sets the time zone schedule tags are evaluated in.
+// A nil location keeps the default (UTC).
+func WithScheduleLocation(loc *time.Location) Option {
+	return func(s *Service) {
+		if loc != nil {
+			s.scheduleLocation = loc
+		}
+	}
+}
+
+// WithDefaultFilters sets the filters views apply until the user changes them.
+// Keys use the short names accepted by List (state, type, vpc, tag:<key>, ...).
+func WithDefaultFilters(filters map[string]string) Option {
+	return func(s *Service) {
+		s.defaultFilters = filters
+	}
+}
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// WithOptimizerClient sets a custom Compute Optimizer client (for testing).
+func WithOptimizerClient(client ComputeOptimizerAPI) Option {
+	return func(s *Service) {
+		s.optimizerClient = client
+	}
+}
+
+// WithOwners attributes instances to the principal that created them, using
+// the given provider, typically CloudTrail.
+func WithOwners(provider core.OwnerProvider) Option {
+	return func(s *Service) {
+		s.owners = provider
+	}
 }
 
 // EC2API defines the EC2 client interface for mocking.
@@ -32,22 +130,52 @@ type EC2API interface {
 	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 	TerminateInstances(ctx context.Context, params *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
 	RebootInstances(ctx context.Context, params *ec2.RebootInstancesInput, optFns ...func(*ec2.Options)) (*ec2.RebootInstancesOutput, error)
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
+	DescribeInstanceAttribute(ctx context.Context, params *ec2.DescribeInstanceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
+	GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutputInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error)
+	CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, params *ec2.DeleteTagsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+	ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyInstanceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	CreateImage(ctx context.Context, params *ec2.CreateImageInput, optFns ...func(*ec2.Options)) (*ec2.CreateImageOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// ComputeOptimizerAPI defines the Compute Optimizer client interface for mocking.
+type ComputeOptimizerAPI interface {
+	GetEC2InstanceRecommendations(ctx context.Context, params *computeoptimizer.GetEC2InstanceRecommendationsInput, optFns ...func(*computeoptimizer.Options)) (*computeoptimizer.GetEC2InstanceRecommendationsOutput, error)
 }
 
 // NewService creates a new EC2 service.
-func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
-	return &Service{
-		factory:    factory,
-		dispatcher: dispatcher,
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:          factory,
+		dispatcher:       dispatcher,
+		idleCPUPercent:   DefaultIdleCPUPercent,
+		scheduleLocation: time.UTC,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // NewServiceWithClient creates a service with a custom client (for testing).
-func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher) *Service {
-	return &Service{
-		testClient: client,
-		dispatcher: dispatcher,
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:       client,
+		dispatcher:       dispatcher,
+		idleCPUPercent:   DefaultIdleCPUPercent,
+		scheduleLocation: time.UTC,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // client returns the EC2 client, fetching fresh from factory each time.
@@ -58,6 +186,27 @@ func (s *Service) client() EC2API {
 	return s.factory.EC2Client()
 }
 
+// DefaultFilters returns a copy of the configured default list filters.
+func (s *Service) DefaultFilters() map[string]string {
+	return maps.Clone(s.defaultFilters)
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// optimizer returns the Compute Optimizer client.
+func (s *Service) optimizer() ComputeOptimizerAPI {
+	if s.optimizerClient != nil {
+		return s.optimizerClient
+	}
+	return s.factory.ComputeOptimizerClient()
+}
+
 // =============================================================================
 // AWSService Interface Implementation
 // =============================================================================
@@ -104,9 +253,68 @@ func (s *Service) HealthCheck(ctx context.Context) error {
 // =============================================================================
 
 // List returns EC2 instances matching the given options.
+// All pages are fetched unless opts.NextToken is set, in which case only
+// that page is returned.
 func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
-	start := time.Now()
+	resources := make([]core.Resource, 0)
+	err := s.listPages(ctx, opts, func(page []core.Resource) error {
+		resources = append(resources, page...)
+		return nil
+	})
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("ec2", "list", err)
+	}
+
+	// Dispatch event
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:instance",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// ListStream returns a channel that streams instances one page at a time.
+func (s *Service) ListStream(ctx context.Context, opts core.ListOptions) (<-chan core.ResourceUpdate, error) {
+	updateChan := make(chan core.ResourceUpdate)
+
+	go func() {
+		defer close(updateChan)
+
+		count := 0
+		err := s.listPages(ctx, opts, func(page []core.Resource) error {
+			count += len(page)
+			select {
+			case updateChan <- core.ResourceUpdate{Type: core.UpdateTypeAppend, Resources: page}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			s.dispatchError(ctx, "list", err)
+			select {
+			case updateChan <- core.ResourceUpdate{Err: core.NewServiceError("ec2", "list", err)}:
+			case <-ctx.Done():
+			}
+			return
+		}
 
+		s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+			ResourceType: "ec2:instance",
+			Count:        count,
+		})
+	}()
+
+	return updateChan, nil
+}
+
+// listPages walks DescribeInstances pages and passes each converted page to fn.
+func (s *Service) listPages(ctx context.Context, opts core.ListOptions, fn func([]core.Resource) error) error {
 	input := &ec2.DescribeInstancesInput{}
 
 	// Apply filters
@@ -119,7 +327,7 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 		}
 	}
 
-	// Apply max results (capped to AWS limit)
+	// Apply page size (capped to AWS limit)
 	if opts.MaxResults > 0 {
 		maxResults := opts.MaxResults
 		if maxResults > 1000 {
@@ -128,34 +336,86 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 		input.MaxResults = aws.Int32(int32(maxResults)) //nolint:gosec // bounded above
 	}
 
-	// Apply pagination token
+	// Apply pagination token (single page only)
 	if opts.NextToken != "" {
 		input.NextToken = aws.String(opts.NextToken)
+		result, err := s.client().DescribeInstances(ctx, input)
+		if err != nil {
+			return err
+		}
+		return fn(reservationsToResources(result.Reservations))
 	}
 
-	result, err := s.client().DescribeInstances(ctx, input)
-	if err != nil {
-		s.dispatchError(ctx, "list", err)
-		return nil, core.NewServiceError("ec2", "list", err)
+	paginator := ec2.NewDescribeInstancesPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		if err := fn(reservationsToResources(page.Reservations)); err != nil {
+			return err
+		}
 	}
 
-	resources := make([]core.Resource, 0)
-	for _, reservation := range result.Reservations {
-		for _, instance := range reservation.Instances {
-			resources = append(resources, instanceToResource(instance))
+	return nil
+}
+
+// EnrichResource adds 14-day utilization, idle detection and (when enabled)
+// Compute Optimizer suggestions to a single instance.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	if resource.State == core.StateRunning {
+		if usage, err := s.getUtilization(ctx, resource.ID, time.Now()); err == nil {
+			usage.apply(resource, s.idleCPUPercent)
 		}
 	}
 
-	// Dispatch event
-	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
-		ResourceType: "ec2:instance",
-		Count:        len(resources),
-	})
+	if s.computeOptimizer && resource.ARN != "" {
+		if rec, err := s.getRecommendation(ctx, resource.ARN); err == nil && rec != nil {
+			resource.Metadata["optimizer_finding"] = string(rec.Finding)
+			if suggested := topRecommendation(rec); suggested != "" {
+				resource.Metadata["suggested_type"] = suggested
+			}
+		}
+	}
 
-	// Log timing
-	_ = time.Since(start)
+	if idle, _ := resource.Metadata["should_cleanup"].(bool); idle {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+resource.GetMetadataString("cleanup_reason"))
+	}
+	if checks, err := s.CheckCompliance(ctx, resource); err == nil {
+		compliance.Apply(resource, checks)
+		if len(checks) > 0 {
+			resource.AddIssue(core.SeverityMedium, "Unencrypted EBS volumes")
+		}
+	}
 
-	return resources, nil
+	ownership.Apply(ctx, s.owners, resource)
+	resource.Metadata["analyzed"] = true
+	return nil
+}
+
+// CheckCompliance implements compliance.Checker.
+func (s *Service) CheckCompliance(ctx context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	out, err := s.client().DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		Filters: []types.Filter{{
+			Name:   aws.String("attachment.instance-id"),
+			Values: []string{resource.ID},
+		}},
+	})
+	if err != nil {
+		return nil, core.NewServiceError("ec2", "compliance", err)
+	}
+
+	var unencrypted []string
+	for _, vol := range out.Volumes {
+		if !aws.ToBool(vol.Encrypted) {
+			unencrypted = append(unencrypted, aws.ToString(vol.VolumeId))
+		}
+	}
+	resource.Metadata["unencrypted_volumes"] = unencrypted
+	if len(unencrypted) > 0 {
+		return []compliance.Check{compliance.CheckEBSUnencrypted}, nil
+	}
+	return nil, nil
 }
 
 // =============================================================================
@@ -181,6 +441,15 @@ func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
 	return &resource, nil
 }
 
+// Tags returns the current tags of an instance.
+func (s *Service) Tags(ctx context.Context, id string) (map[string]string, error) {
+	resource, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Tags, nil
+}
+
 // =============================================================================
 // ActionExecutor Interface Implementation
 // =============================================================================
@@ -212,6 +481,102 @@ func (s *Service) Actions() []core.Action {
 			Dangerous:   false,
 			Category:    "lifecycle",
 		},
+		{
+			Name:        "describe",
+			Description: "Show volumes, security groups and instance profile",
+			Icon:        "info",
+			Shortcut:    "enter",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "include_sensitive",
+					Type:        "bool",
+					Default:     false,
+					Description: "Include decoded user data and console output (may contain secrets)",
+				},
+				{
+					Name:        "confirm",
+					Type:        "bool",
+					Description: "Confirm showing sensitive data",
+				},
+			},
+		},
+		{
+			Name:        "resize",
+			Description: "Change instance type (stops and restarts the instance)",
+			Icon:        "resize",
+			Shortcut:    "m",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "instance_type",
+					Type:        "string",
+					Required:    true,
+					Description: "New instance type (e.g. t3.small)",
+					Validation:  `^[a-z0-9-]+\.[a-z0-9]+$`,
+				},
+				{
+					Name:        "start",
+					Type:        "bool",
+					Default:     true,
+					Description: "Start the instance after resizing if it was running",
+				},
+				{
+					Name:        "confirm",
+					Type:        "bool",
+					Required:    true,
+					Description: "Confirm the instance may be stopped",
+				},
+			},
+		},
+		{
+			Name:        "schedule",
+			Description: "Apply a stop/start schedule tag",
+			Icon:        "clock",
+			Shortcut:    "S",
+			Dangerous:   false,
+			Category:    "cost",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "schedule",
+					Type:        "select",
+					Required:    true,
+					Default:     "office-hours",
+					Options:     append(ScheduleNames(), scheduleNone),
+					Description: "Schedule enforced by 'a9s daemon' (" + ScheduleTagKey + " tag)",
+				},
+			},
+		},
+		{
+			Name:        "create_image",
+			Description: "Create an AMI from the instance",
+			Icon:        "image",
+			Shortcut:    "i",
+			Dangerous:   false,
+			Category:    "backup",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "name",
+					Type:        "string",
+					Required:    true,
+					Description: "AMI name",
+					Validation:  `^[a-zA-Z0-9()\[\] ./'@_-]{3,128}$`,
+				},
+				{
+					Name:        "description",
+					Type:        "string",
+					Description: "AMI description",
+				},
+				{
+					Name:        "no_reboot",
+					Type:        "bool",
+					Default:     true,
+					Description: "Skip the reboot (filesystem consistency is not guaranteed)",
+				},
+			},
+		},
 		{
 			Name:        "terminate",
 			Description: "Terminate an instance (permanent)",
@@ -231,7 +596,10 @@ func (s *Service) Actions() []core.Action {
 	}
 }
 
-// Execute runs the specified action on an EC2 instance.
+// Execute runs the specified action on an EC2 instance. Resizing and
+// showing sensitive details ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set; terminating
+// also needs the instance ID typed back.
 func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
 	start := time.Now()
 
@@ -244,6 +612,7 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 
 	var result *core.ActionResult
 	var err error
+	includeSensitive, _ := params["include_sensitive"].(bool)
 
 	switch action {
 	case "start":
@@ -252,9 +621,44 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 		result, err = s.stopInstance(ctx, resourceID)
 	case "reboot":
 		result, err = s.rebootInstance(ctx, resourceID)
+	case "describe":
+		if includeSensitive && !core.Confirmed(params, resourceID, false) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "User data and console output may contain secrets", false)
+		}
+		result, err = s.describeInstance(ctx, resourceID, includeSensitive)
+	case "resize":
+		if !core.Confirmed(params, resourceID, false) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "The instance is stopped while its type changes", false)
+		}
+		instanceType, _ := params["instance_type"].(string)
+		if instanceType == "" {
+			return nil, core.NewValidationError("instance_type", nil, "is required")
+		}
+		startAfter := true
+		if v, ok := params["start"].(bool); ok {
+			startAfter = v
+		}
+		result, err = s.resizeInstance(ctx, resourceID, instanceType, startAfter)
+	case "schedule":
+		schedule, _ := params["schedule"].(string)
+		if schedule == "" {
+			return nil, core.NewValidationError("schedule", nil, "is required")
+		}
+		result, err = s.setSchedule(ctx, resourceID, schedule)
+	case "create_image":
+		name, _ := params["name"].(string)
+		if name == "" {
+			return nil, core.NewValidationError("name", nil, "is required")
+		}
+		description, _ := params["description"].(string)
+		noReboot := true
+		if v, ok := params["no_reboot"].(bool); ok {
+			noReboot = v
+		}
+		result, err = s.createImage(ctx, resourceID, name, description, noReboot)
 	case "terminate":
-		if confirmed, _ := params["confirm"].(bool); !confirmed {
-			return core.NewActionResult(false, "Termination not confirmed"), core.ErrConfirmationRequired
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Terminating an instance cannot be undone and deletes its root volume", true)
 		}
 		result, err = s.terminateInstance(ctx, resourceID)
 	default:
@@ -272,11 +676,16 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 
 	result.Duration = time.Since(start)
 
-	// Dispatch action executed event
+	// Dispatch action executed event, without the user data and console
+	// output of a sensitive describe
+	logged := *result
+	if action == "describe" && includeSensitive {
+		logged.Data = nil
+	}
 	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
 		Action:     action,
 		ResourceID: resourceID,
-		Result:     result,
+		Result:     &logged,
 	})
 
 	return result, nil
@@ -330,10 +739,473 @@ func (s *Service) terminateInstance(ctx context.Context, instanceID string) (*co
 	return core.NewActionResult(true, fmt.Sprintf("Instance %s is terminating", instanceID)), nil
 }
 
+// waitTimeout bounds how long resize waits for each state transition.
+const waitTimeout = 10 * time.Minute
+
+// resizeInstance stops the instance if needed, changes its type, and
+// restarts it when it was running before. When the type cannot be changed,
+// an instance that was running is started again on its original type.
+func (s *Service) resizeInstance(ctx context.Context, instanceID, instanceType string, startAfter bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("resize", instanceID, err)
+	}
+
+	current, err := s.Get(ctx, instanceID)
+	if err != nil {
+		return fail(err)
+	}
+	if current.GetMetadataString("instance_type") == instanceType {
+		return core.NewActionResult(true, fmt.Sprintf("Instance %s is already %s", instanceID, instanceType)), nil
+	}
+
+	wasRunning := current.State == core.StateRunning || current.State == core.StatePending
+	if current.State != core.StateStopped {
+		if wasRunning {
+			if _, err := s.client().StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: []string{instanceID}}); err != nil {
+				return fail(err)
+			}
+		}
+		waiter := ec2.NewInstanceStoppedWaiter(s.client())
+		if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}}, waitTimeout); err != nil {
+			return fail(fmt.Errorf("waiting for stop: %w", err))
+		}
+	}
+
+	_, err = s.client().ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+		InstanceId:   aws.String(instanceID),
+		InstanceType: &types.AttributeValue{Value: aws.String(instanceType)},
+	})
+	if err != nil {
+		if !wasRunning {
+			return fail(err)
+		}
+		original := current.GetMetadataString("instance_type")
+		if _, startErr := s.client().StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: []string{instanceID}}); startErr != nil {
+			return fail(fmt.Errorf("%w; restarting on %s also failed, the instance is stopped: %w", err, original, startErr))
+		}
+		return fail(fmt.Errorf("%w; restarting it on %s", err, original))
+	}
+
+	if !wasRunning || !startAfter {
+		return core.NewActionResult(true, fmt.Sprintf("Instance %s resized to %s (stopped)", instanceID, instanceType)), nil
+	}
+
+	if _, err := s.client().StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: []string{instanceID}}); err != nil {
+		return fail(fmt.Errorf("resized to %s but start failed: %w", instanceType, err))
+	}
+	waiter := ec2.NewInstanceRunningWaiter(s.client())
+	if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}}, waitTimeout); err != nil {
+		return fail(fmt.Errorf("resized to %s but waiting for start: %w", instanceType, err))
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Instance %s resized to %s and running", instanceID, instanceType)), nil
+}
+
+func (s *Service) createImage(ctx context.Context, instanceID, name, description string, noReboot bool) (*core.ActionResult, error) {
+	input := &ec2.CreateImageInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		NoReboot:   aws.Bool(noReboot),
+	}
+	if description != "" {
+		input.Description = aws.String(description)
+	}
+
+	out, err := s.client().CreateImage(ctx, input)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("create_image", instanceID, err)
+	}
+
+	imageID := aws.ToString(out.ImageId)
+	result := core.NewActionResult(true, fmt.Sprintf("Creating AMI %s from %s", imageID, instanceID))
+	result.Data = map[string]any{"image_id": imageID}
+	return result, nil
+}
+
+// consoleOutputLines limits how much console output the describe action returns.
+const consoleOutputLines = 100
+
+// InstanceDetail is the result data of the describe action.
+type InstanceDetail struct {
+	InstanceID      string
+	Name            string
+	InstanceType    string
+	State           string
+	InstanceProfile string
+	Volumes         []VolumeDetail
+	SecurityGroups  []SecurityGroupDetail
+
+	// Only populated when sensitive details were requested and confirmed
+	Sensitive     bool
+	UserData      string
+	ConsoleOutput string
+}
+
+// VolumeDetail describes an attached EBS volume.
+type VolumeDetail struct {
+	ID                  string
+	Device              string
+	Type                string
+	State               string
+	SizeGiB             int32
+	IOPS                int32
+	Encrypted           bool
+	DeleteOnTermination bool
+}
+
+// SecurityGroupDetail describes a security group and its rules.
+type SecurityGroupDetail struct {
+	ID      string
+	Name    string
+	Ingress []string
+	Egress  []string
+}
+
+func (s *Service) describeInstance(ctx context.Context, instanceID string, includeSensitive bool) (*core.ActionResult, error) {
+	out, err := s.client().DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("describe", instanceID, err)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return core.NewActionResult(false, "instance not found"), core.NewActionError("describe", instanceID, core.ErrResourceNotFound)
+	}
+
+	instance := out.Reservations[0].Instances[0]
+	resource := instanceToResource(instance)
+	detail := InstanceDetail{
+		InstanceID:   instanceID,
+		Name:         resource.Name,
+		InstanceType: string(instance.InstanceType),
+		State:        resource.State,
+	}
+	if instance.IamInstanceProfile != nil {
+		detail.InstanceProfile = aws.ToString(instance.IamInstanceProfile.Arn)
+	}
+
+	detail.Volumes, err = s.describeVolumes(ctx, instance)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("describe", instanceID, err)
+	}
+
+	detail.SecurityGroups, err = s.describeSecurityGroups(ctx, instance)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("describe", instanceID, err)
+	}
+
+	if includeSensitive {
+		detail.Sensitive = true
+		detail.UserData = s.getUserData(ctx, instanceID)
+		detail.ConsoleOutput = s.getConsoleOutput(ctx, instanceID)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Details for %s", instanceID))
+	result.Data = detail
+	return result, nil
+}
+
+func (s *Service) describeVolumes(ctx context.Context, instance types.Instance) ([]VolumeDetail, error) {
+	devices := make(map[string]types.InstanceBlockDeviceMapping)
+	var volumeIDs []string
+	for _, mapping := range instance.BlockDeviceMappings {
+		if mapping.Ebs == nil {
+			continue
+		}
+		id := aws.ToString(mapping.Ebs.VolumeId)
+		devices[id] = mapping
+		volumeIDs = append(volumeIDs, id)
+	}
+	if len(volumeIDs) == 0 {
+		return nil, nil
+	}
+
+	out, err := s.client().DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: volumeIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]VolumeDetail, 0, len(out.Volumes))
+	for _, vol := range out.Volumes {
+		id := aws.ToString(vol.VolumeId)
+		mapping := devices[id]
+		volumes = append(volumes, VolumeDetail{
+			ID:                  id,
+			Device:              aws.ToString(mapping.DeviceName),
+			Type:                string(vol.VolumeType),
+			State:               string(vol.State),
+			SizeGiB:             aws.ToInt32(vol.Size),
+			IOPS:                aws.ToInt32(vol.Iops),
+			Encrypted:           aws.ToBool(vol.Encrypted),
+			DeleteOnTermination: mapping.Ebs != nil && aws.ToBool(mapping.Ebs.DeleteOnTermination),
+		})
+	}
+	return volumes, nil
+}
+
+func (s *Service) describeSecurityGroups(ctx context.Context, instance types.Instance) ([]SecurityGroupDetail, error) {
+	groupIDs := make([]string, 0, len(instance.SecurityGroups))
+	for _, group := range instance.SecurityGroups {
+		groupIDs = append(groupIDs, aws.ToString(group.GroupId))
+	}
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	out, err := s.client().DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: groupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]SecurityGroupDetail, 0, len(out.SecurityGroups))
+	for _, group := range out.SecurityGroups {
+		detail := SecurityGroupDetail{
+			ID:   aws.ToString(group.GroupId),
+			Name: aws.ToString(group.GroupName),
+		}
+		for _, perm := range group.IpPermissions {
+			detail.Ingress = append(detail.Ingress, formatPermission(perm))
+		}
+		for _, perm := range group.IpPermissionsEgress {
+			detail.Egress = append(detail.Egress, formatPermission(perm))
+		}
+		groups = append(groups, detail)
+	}
+	return groups, nil
+}
+
+// getUserData returns the decoded user data, or a note when unavailable.
+func (s *Service) getUserData(ctx context.Context, instanceID string) string {
+	out, err := s.client().DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  types.InstanceAttributeNameUserData,
+	})
+	if err != nil {
+		return fmt.Sprintf("(unavailable: %v)", err)
+	}
+	if out.UserData == nil || aws.ToString(out.UserData.Value) == "" {
+		return "(none)"
+	}
+	return decodeBase64(aws.ToString(out.UserData.Value))
+}
+
+// getConsoleOutput returns the last lines of the instance console output.
+func (s *Service) getConsoleOutput(ctx context.Context, instanceID string) string {
+	out, err := s.client().GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+		Latest:     aws.Bool(true),
+	})
+	if err != nil {
+		// Latest is only supported on Nitro instances
+		out, err = s.client().GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+			InstanceId: aws.String(instanceID),
+		})
+	}
+	if err != nil {
+		return fmt.Sprintf("(unavailable: %v)", err)
+	}
+	if aws.ToString(out.Output) == "" {
+		return "(no output yet)"
+	}
+
+	lines := strings.Split(strings.TrimRight(decodeBase64(aws.ToString(out.Output)), "\n"), "\n")
+	if len(lines) > consoleOutputLines {
+		lines = lines[len(lines)-consoleOutputLines:]
+	}
+	return strings.Join(lines, "\n")
+}
+
 // =============================================================================
 // Helper Functions
 // =============================================================================
 
+// formatPermission renders a security group rule, e.g. "tcp 22 0.0.0.0/0".
+func formatPermission(perm types.IpPermission) string {
+	protocol := aws.ToString(perm.IpProtocol)
+	if protocol == "-1" {
+		protocol = "all"
+	}
+
+	ports := ""
+	from, to := aws.ToInt32(perm.FromPort), aws.ToInt32(perm.ToPort)
+	switch {
+	case protocol == "all":
+	case from == to:
+		ports = fmt.Sprintf(" %d", from)
+	default:
+		ports = fmt.Sprintf(" %d-%d", from, to)
+	}
+
+	var sources []string
+	for _, r := range perm.IpRanges {
+		sources = append(sources, aws.ToString(r.CidrIp))
+	}
+	for _, r := range perm.Ipv6Ranges {
+		sources = append(sources, aws.ToString(r.CidrIpv6))
+	}
+	for _, g := range perm.UserIdGroupPairs {
+		sources = append(sources, aws.ToString(g.GroupId))
+	}
+	for _, p := range perm.PrefixListIds {
+		sources = append(sources, aws.ToString(p.PrefixListId))
+	}
+
+	return fmt.Sprintf("%s%s %s", protocol, ports, strings.Join(sources, ", "))
+}
+
+func decodeBase64(encoded string) string {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return encoded
+	}
+	return string(decoded)
+}
+
+func reservationsToResources(reservations []types.Reservation) []core.Resource {
+	resources := make([]core.Resource, 0, len(reservations))
+	for _, reservation := range reservations {
+		for _, instance := range reservation.Instances {
+			resource := instanceToResource(instance)
+			if owner := aws.ToString(reservation.OwnerId); owner != "" {
+				resource.ARN = fmt.Sprintf("arn:aws:ec2:%s:%s:instance/%s", resource.Region, owner, resource.ID)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// instanceUtilization summarizes CloudWatch metrics over the lookback window.
+type instanceUtilization struct {
+	cpuAvg        float64
+	networkPerDay float64
+	days          int
+}
+
+// getUtilization fetches daily CPU and network metrics for an instance.
+func (s *Service) getUtilization(ctx context.Context, instanceID string, now time.Time) (instanceUtilization, error) {
+	metric := func(id, name, stat string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/EC2"),
+					MetricName: aws.String(name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: aws.String("InstanceId"), Value: aws.String(instanceID)},
+					},
+				},
+				Period: aws.Int32(86400),
+				Stat:   aws.String(stat),
+			},
+		}
+	}
+
+	out, err := s.metrics().GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(now.Add(-metricsLookback)),
+		EndTime:   aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metric("cpu", "CPUUtilization", "Average"),
+			metric("netin", "NetworkIn", "Sum"),
+			metric("netout", "NetworkOut", "Sum"),
+		},
+	})
+	if err != nil {
+		return instanceUtilization{}, err
+	}
+
+	var usage instanceUtilization
+	var network float64
+	for _, result := range out.MetricDataResults {
+		switch aws.ToString(result.Id) {
+		case "cpu":
+			usage.cpuAvg = mean(result.Values)
+			usage.days = len(result.Values)
+		case "netin", "netout":
+			network += mean(result.Values)
+		}
+	}
+	usage.networkPerDay = network
+
+	return usage, nil
+}
+
+// apply writes utilization and the idle assessment into resource metadata.
+func (u instanceUtilization) apply(resource *core.Resource, cpuThreshold float64) {
+	idle := u.days >= minMetricDays && u.cpuAvg < cpuThreshold && u.networkPerDay < idleNetworkBytesPerDay
+
+	reason := ""
+	if idle {
+		reason = fmt.Sprintf("idle, %.1f%% CPU and %s/day network over %d days",
+			u.cpuAvg, formatBytes(int64(u.networkPerDay)), u.days)
+	}
+
+	resource.Metadata["cpu_avg"] = u.cpuAvg
+	resource.Metadata["network_per_day"] = int64(u.networkPerDay)
+	resource.Metadata["metric_days"] = u.days
+	resource.Metadata["should_cleanup"] = idle
+	resource.Metadata["cleanup_reason"] = reason
+}
+
+// getRecommendation fetches the Compute Optimizer recommendation for an instance.
+func (s *Service) getRecommendation(ctx context.Context, instanceARN string) (*cotypes.InstanceRecommendation, error) {
+	out, err := s.optimizer().GetEC2InstanceRecommendations(ctx, &computeoptimizer.GetEC2InstanceRecommendationsInput{
+		InstanceArns: []string{instanceARN},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.InstanceRecommendations) == 0 {
+		return nil, nil
+	}
+	return &out.InstanceRecommendations[0], nil
+}
+
+// topRecommendation returns the best-ranked suggested instance type, or ""
+// when the current type is already optimal.
+func topRecommendation(rec *cotypes.InstanceRecommendation) string {
+	if rec.Finding == cotypes.FindingOptimized {
+		return ""
+	}
+	best := ""
+	bestRank := int32(0)
+	for _, opt := range rec.RecommendationOptions {
+		if best == "" || opt.Rank < bestRank {
+			best = aws.ToString(opt.InstanceType)
+			bestRank = opt.Rank
+		}
+	}
+	return best
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
 func instanceToResource(instance types.Instance) core.Resource {
 	resource := core.Resource{
 		ID:     aws.ToString(instance.InstanceId),
@@ -350,6 +1222,7 @@ func instanceToResource(instance types.Instance) core.Resource {
 			"subnet_id":         aws.ToString(instance.SubnetId),
 			"architecture":      string(instance.Architecture),
 			"platform":          aws.ToString(instance.PlatformDetails),
+			"analyzed":          false,
 		},
 	}
 
@@ -367,12 +1240,17 @@ func instanceToResource(instance types.Instance) core.Resource {
 	if resource.Name == "" {
 		resource.Name = resource.ID
 	}
+	iac.Apply(&resource)
 
 	// Set timestamps
 	if instance.LaunchTime != nil {
 		resource.CreatedAt = instance.LaunchTime
 		resource.Metadata["launch_time"] = instance.LaunchTime.Format(time.RFC3339)
 	}
+	estimate.ApplyAge(&resource, time.Now())
+	if monthly, ok := instanceMonthlyCost(string(instance.InstanceType), resource.State); ok {
+		estimate.ApplyCost(&resource, monthly)
+	}
 
 	return resource
 }
@@ -385,6 +1263,35 @@ func extractRegionFromAZ(az string) string {
 	return ""
 }
 
+// ParseFilters parses "key=value" pairs separated by spaces or commas, e.g.
+// "state=running type=t3.micro tag:Env=prod".
+func ParseFilters(input string) (map[string]string, error) {
+	filters := make(map[string]string)
+	for _, field := range strings.FieldsFunc(input, func(r rune) bool { return r == ' ' || r == ',' }) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || key == "" || value == "" {
+			return nil, core.NewValidationError("filters", field, "must be key=value")
+		}
+		filters[key] = value
+	}
+	return filters, nil
+}
+
+// FormatFilters renders filters in the form accepted by ParseFilters.
+func FormatFilters(filters map[string]string) string {
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+filters[k])
+	}
+	return strings.Join(parts, " ")
+}
+
 func filterKeyToAWS(key string) string {
 	// Map common filter keys to AWS filter names
 	filterMap := map[string]string{
@@ -420,13 +1327,22 @@ func (s *Service) dispatchError(ctx context.Context, op string, err error) {
 	}
 }
 
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
 // =============================================================================
 // Interface Assertions
 // =============================================================================
 
 var (
-	_ core.AWSService     = (*Service)(nil)
-	_ core.ResourceLister = (*Service)(nil)
-	_ core.ResourceGetter = (*Service)(nil)
-	_ core.ActionExecutor = (*Service)(nil)
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceStreamer = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.TagReader        = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+
+	_ compliance.Checker  = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
 )
@@ -0,0 +1,334 @@
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// FuzzParseFilters checks that any filter input typed with [f] is either
+// rejected or parsed into pairs ParseFilters reads back the same way.
+func FuzzParseFilters(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"state=running",
+		"state=running type=t3.micro tag:Env=prod",
+		"state=running,vpc=vpc-123",
+		"tag:Name=a=b",
+		"=running",
+		"state=",
+		"state",
+		" , ,",
+		"tag:Ünïcode=☃",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		filters, err := ParseFilters(input)
+		if err != nil {
+			return
+		}
+		for k, v := range filters {
+			if k == "" || v == "" {
+				t.Fatalf("ParseFilters(%q) returned an empty key or value: %q=%q", input, k, v)
+			}
+			if strings.ContainsAny(k, " ,=") || strings.ContainsAny(v, " ,") {
+				t.Fatalf("ParseFilters(%q) kept a separator in %q=%q", input, k, v)
+			}
+		}
+		again, err := ParseFilters(FormatFilters(filters))
+		if err != nil {
+			t.Fatalf("ParseFilters(FormatFilters(%v)) failed: %v", filters, err)
+		}
+		if !maps.Equal(filters, again) {
+			t.Fatalf("round trip changed %v into %v", filters, again)
+		}
+	})
+}
+
+// TestFormatFiltersRoundTrip checks that filters without separators
+// survive FormatFilters and ParseFilters unchanged.
+func TestFormatFiltersRoundTrip(t *testing.T) {
+	clean := func(s, drop string) string {
+		return strings.Map(func(r rune) rune {
+			if strings.ContainsRune(drop, r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+
+	property := func(raw map[string]string) bool {
+		filters := make(map[string]string, len(raw))
+		for k, v := range raw {
+			k, v = clean(k, " ,="), clean(v, " ,")
+			if k != "" && v != "" {
+				filters[k] = v
+			}
+		}
+		parsed, err := ParseFilters(FormatFilters(filters))
+		return err == nil && maps.Equal(filters, parsed)
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+const (
+	testInstance = "i-0123456789abcdef0"
+	testUserData = "#!/bin/sh\nexport DB_PASSWORD=hunter2\n"
+)
+
+// fakeEC2 serves one running t3.micro instance whose state follows the
+// start, stop and terminate calls, or fails every call when err is set.
+// modifyErr fails only ModifyInstanceAttribute. The instance is tagged with
+// schedule when set. It records the instance types set and the instances
+// terminated.
+type fakeEC2 struct {
+	err        error
+	modifyErr  error
+	schedule   string
+	state      types.InstanceStateName
+	typ        types.InstanceType
+	modified   []string
+	terminated []string
+}
+
+func newFakeEC2() *fakeEC2 {
+	return &fakeEC2{state: types.InstanceStateNameRunning, typ: types.InstanceTypeT3Micro}
+}
+
+func (f *fakeEC2) instance() types.Instance {
+	instance := types.Instance{
+		InstanceId:   aws.String(testInstance),
+		InstanceType: f.typ,
+		State:        &types.InstanceState{Name: f.state},
+		Placement:    &types.Placement{AvailabilityZone: aws.String("us-east-1a")},
+		Tags:         []types.Tag{{Key: aws.String("Name"), Value: aws.String("web")}},
+	}
+	if f.schedule != "" {
+		instance.Tags = append(instance.Tags, types.Tag{Key: aws.String(ScheduleTagKey), Value: aws.String(f.schedule)})
+	}
+	return instance
+}
+
+func (f *fakeEC2) DescribeInstances(_ context.Context, in *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(in.InstanceIds) > 0 && in.InstanceIds[0] != testInstance {
+		return nil, errors.New("InvalidInstanceID.NotFound")
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []types.Reservation{{Instances: []types.Instance{f.instance()}}}}, nil
+}
+
+func (f *fakeEC2) StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.state = types.InstanceStateNameRunning
+	return &ec2.StartInstancesOutput{}, nil
+}
+
+func (f *fakeEC2) StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.state = types.InstanceStateNameStopped
+	return &ec2.StopInstancesOutput{}, nil
+}
+
+func (f *fakeEC2) TerminateInstances(_ context.Context, in *ec2.TerminateInstancesInput, _ ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.state = types.InstanceStateNameShuttingDown
+	f.terminated = append(f.terminated, in.InstanceIds...)
+	return &ec2.TerminateInstancesOutput{}, nil
+}
+
+func (f *fakeEC2) RebootInstances(context.Context, *ec2.RebootInstancesInput, ...func(*ec2.Options)) (*ec2.RebootInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.RebootInstancesOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeVolumes(context.Context, *ec2.DescribeVolumesInput, ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeVolumesOutput{Volumes: []types.Volume{{VolumeId: aws.String("vol-1"), Encrypted: aws.Bool(true)}}}, nil
+}
+
+func (f *fakeEC2) DescribeSecurityGroups(context.Context, *ec2.DescribeSecurityGroupsInput, ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeSecurityGroupsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeInstanceAttributeOutput{
+		UserData: &types.AttributeValue{Value: aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData)))},
+	}, nil
+}
+
+func (f *fakeEC2) GetConsoleOutput(context.Context, *ec2.GetConsoleOutputInput, ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.GetConsoleOutputOutput{}, nil
+}
+
+func (f *fakeEC2) CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteTags(context.Context, *ec2.DeleteTagsInput, ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DeleteTagsOutput{}, nil
+}
+
+func (f *fakeEC2) ModifyInstanceAttribute(_ context.Context, in *ec2.ModifyInstanceAttributeInput, _ ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.modifyErr != nil {
+		return nil, f.modifyErr
+	}
+	f.typ = types.InstanceType(aws.ToString(in.InstanceType.Value))
+	f.modified = append(f.modified, string(f.typ))
+	return &ec2.ModifyInstanceAttributeOutput{}, nil
+}
+
+func (f *fakeEC2) CreateImage(context.Context, *ec2.CreateImageInput, ...func(*ec2.Options)) (*ec2.CreateImageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.CreateImageOutput{ImageId: aws.String("ami-1")}, nil
+}
+
+// fakeCloudWatch returns no datapoints, as for an instance just launched.
+type fakeCloudWatch struct{}
+
+func (fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	return &cloudwatch.GetMetricDataOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against instances.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(newFakeEC2(), d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			fake := newFakeEC2()
+			fake.err = errors.New("UnauthorizedOperation")
+			return NewServiceWithClient(fake, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		ExistingID:    testInstance,
+		MissingID:     "i-missing",
+		Action:        "reboot",
+		ConfirmAction: "terminate",
+		ConfirmTyped:  true,
+	})
+}
+
+// TestTerminateNeedsTypedID checks that terminating asks for the instance
+// ID typed back, and that a bare confirmation does not terminate it.
+func TestTerminateNeedsTypedID(t *testing.T) {
+	fake := newFakeEC2()
+	svc := NewServiceWithClient(fake, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "terminate", testInstance, map[string]any{core.ParamConfirm: true})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !confirm.TypeResource {
+		t.Fatalf("Execute(terminate) error = %v, want a ConfirmationError asking for the ID", err)
+	}
+	if len(fake.terminated) > 0 {
+		t.Fatalf("terminated %v without the ID typed back", fake.terminated)
+	}
+
+	_, err = svc.Execute(ctx, "terminate", testInstance, map[string]any{
+		core.ParamConfirm:         true,
+		core.ParamConfirmResource: testInstance,
+	})
+	if err != nil {
+		t.Fatalf("Execute(terminate) confirmed error = %v", err)
+	}
+	if !slices.Equal(fake.terminated, []string{testInstance}) {
+		t.Errorf("terminated %v, want [%s]", fake.terminated, testInstance)
+	}
+}
+
+// TestSensitiveDescribeKeepsDataOutOfEvents checks that the user data of a
+// sensitive describe is returned but never dispatched to hooks.
+func TestSensitiveDescribeKeepsDataOutOfEvents(t *testing.T) {
+	recorder := coretest.NewRecorder()
+	svc := NewServiceWithClient(newFakeEC2(), recorder)
+
+	result, err := svc.Execute(context.Background(), "describe", testInstance, map[string]any{
+		"include_sensitive": true,
+		core.ParamConfirm:   true,
+	})
+	if err != nil {
+		t.Fatalf("Execute(describe) error = %v", err)
+	}
+	if detail, ok := result.Data.(InstanceDetail); !ok || detail.UserData != testUserData {
+		t.Errorf("result data = %+v, want the user data", result.Data)
+	}
+
+	for _, event := range recorder.Events() {
+		data, ok := event.Data().(core.ActionEventData)
+		if ok && data.Result != nil && data.Result.Data != nil {
+			t.Errorf("%s event carries the result data %+v", event.Type(), data.Result.Data)
+		}
+	}
+}
+
+// TestResizeRestartsWhenModifyFails checks that an instance stopped to be
+// resized is started again on its original type when the type cannot be
+// changed, and that the result says so.
+func TestResizeRestartsWhenModifyFails(t *testing.T) {
+	fake := newFakeEC2()
+	fake.modifyErr = errors.New("InsufficientInstanceCapacity")
+	svc := NewServiceWithClient(fake, nil)
+
+	result, err := svc.Execute(context.Background(), "resize", testInstance, map[string]any{
+		"instance_type":   "m5.large",
+		core.ParamConfirm: true,
+	})
+	if err == nil {
+		t.Fatal("Execute(resize) succeeded though the type could not be changed")
+	}
+	if fake.state != types.InstanceStateNameRunning || fake.typ != types.InstanceTypeT3Micro {
+		t.Errorf("instance is %s %s, want running t3.micro", fake.state, fake.typ)
+	}
+	if result == nil || !strings.Contains(result.Message, "restarting it on t3.micro") {
+		t.Errorf("result = %+v, want a message saying the instance restarts on t3.micro", result)
+	}
+}
@@ -3,14 +3,22 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
 	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	resizeFormID   = "ec2:resize"
+	imageFormID    = "ec2:create_image"
+	scheduleFormID = "ec2:schedule"
+	filterFormID   = "ec2:filter"
 )
 
 // =============================================================================
@@ -19,23 +27,39 @@ import (
 
 // View implements the TUI view for EC2 instances.
 type View struct {
-	*base.TableView
+	*base.EnrichableTableView
+
+	// formTarget is the instance a pending action form applies to
+	formTarget string
+
+	// filters are sent to the service as server-side list filters
+	filters     map[string]string
+	filtersInit bool
 }
 
 // NewView creates a new EC2 view.
 func NewView() *View {
 	columnDefs := []base.ColumnDef{
-		{Title: "ID", MinWidth: 12, MaxWidth: 22, Weight: 1.0, Priority: 0},
-		{Title: "Name", MinWidth: 10, MaxWidth: 30, Weight: 2.0, Priority: 1},
-		{Title: "Type", MinWidth: 10, MaxWidth: 15, Weight: 0.5, Priority: 2},
-		{Title: "State", MinWidth: 10, MaxWidth: 14, Weight: 0.5, Priority: 0},
-		{Title: "Public IP", MinWidth: 12, MaxWidth: 16, Weight: 0.5, Priority: 3},
-		{Title: "Private IP", MinWidth: 12, MaxWidth: 16, Weight: 0.5, Priority: 4},
-		{Title: "AZ", MinWidth: 10, MaxWidth: 16, Weight: 0.5, Priority: 5},
+		{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 22, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 10, MaxWidth: 30, Weight: 2.0, Priority: 1},
+		{Title: i18n.T("Type"), MinWidth: 10, MaxWidth: 15, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Public IP"), MinWidth: 12, MaxWidth: 16, Weight: 0.5, Priority: 3},
+		{Title: i18n.T("Private IP"), MinWidth: 12, MaxWidth: 16, Weight: 0.5, Priority: 4},
+		{Title: i18n.T("AZ"), MinWidth: 10, MaxWidth: 16, Weight: 0.5, Priority: 5},
+		{Title: i18n.T("CPU 14d"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Idle"), MinWidth: 6, MaxWidth: 10, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Suggested"), MinWidth: 10, MaxWidth: 15, Weight: 0.5, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Controls"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Owner"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 4},
 	}
 
 	return &View{
-		TableView: base.NewTableView("EC2", "1", "ec2", columnDefs),
+		EnrichableTableView: base.NewEnrichableTableView("EC2", "1", "ec2", i18n.T("instances"), columnDefs, buildRow),
 	}
 }
 
@@ -56,45 +80,126 @@ func (v *View) Init() tea.Cmd {
 func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "s":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Starting %s...", row.ID)
+				v.Message = i18n.T("Starting %s...", row.ID)
 				return v, v.executeAction("start", row.ID)
 			}
 		case "t":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Stopping %s...", row.ID)
+				v.Message = i18n.T("Stopping %s...", row.ID)
 				return v, v.executeAction("stop", row.ID)
 			}
 		case "b":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Rebooting %s...", row.ID)
+				v.Message = i18n.T("Rebooting %s...", row.ID)
 				return v, v.executeAction("reboot", row.ID)
 			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Terminating %s...", row.ID)
+				return v, v.executeAction("terminate", row.ID)
+			}
 		case "enter":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Selected: %s (%s)", row.Name, row.ID)
+				v.Message = i18n.T("Loading details for %s...", row.ID)
+				return v, v.executeActionWithParams("describe", row.ID, nil)
+			}
+		case "m":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openActionForm(resizeFormID, "resize", i18n.T("Change instance type of %s (%s)", row.ID, row.GetMetadataString("instance_type")), row.ID)
+			}
+		case "i":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openActionForm(imageFormID, "create_image", i18n.T("Create AMI from %s", row.ID), row.ID)
+			}
+		case "S":
+			if row := v.GetSelectedResource(); row != nil {
+				title := i18n.T("Schedule %s (current: %s)", row.ID, row.GetTag(ScheduleTagKey, "none"))
+				return v, v.openActionForm(scheduleFormID, "schedule", title, row.ID)
+			}
+		case "f":
+			return v, v.openFilterForm()
+		case "u":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Press 'U' to show user data and console output of %s (may contain secrets)", row.ID)
+			}
+		case "U":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading sensitive details for %s...", row.ID)
+				return v, v.executeActionWithParams("describe", row.ID, map[string]any{
+					"include_sensitive": true,
+					"confirm":           true,
+				})
 			}
 		}
 
-	case ec2LoadedMsg:
-		v.SetLoading(false)
-		if msg.err != nil {
-			v.SetError(msg.err)
-			v.Message = fmt.Sprintf("Error: %v", msg.err)
-		} else {
-			v.SetError(nil)
-			v.Resources = msg.resources
-			v.updateTable()
-			v.Message = fmt.Sprintf("Loaded %d instances", len(msg.resources))
+	case components.FormResultMsg:
+		if msg.ID == filterFormID {
+			if msg.Canceled {
+				break
+			}
+			raw, _ := msg.Values["filters"].(string)
+			filters, err := ParseFilters(raw)
+			if err != nil {
+				v.Message = i18n.T("Invalid filter: %v", err)
+				break
+			}
+			v.filters = filters
+			v.Resources = nil
+			v.RefreshRows()
+			v.Message = i18n.T("Applying filters...")
+			cmds = append(cmds, v.loadInstances())
+			break
+		}
+		action := ""
+		switch msg.ID {
+		case resizeFormID:
+			action = "resize"
+		case imageFormID:
+			action = "create_image"
+		case scheduleFormID:
+			action = "schedule"
+		}
+		if action == "" {
+			break
 		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		switch action {
+		case "resize":
+			v.Message = i18n.T("Resizing %s (stop, modify, start)...", v.formTarget)
+		case "create_image":
+			v.Message = i18n.T("Creating AMI from %s...", v.formTarget)
+		default:
+			v.Message = i18n.T("Updating schedule of %s...", v.formTarget)
+		}
+		cmds = append(cmds, v.executeActionWithParams(action, v.formTarget, msg.Values))
 
 	case base.ActionResultMsg:
+		if msg.Action == "describe" {
+			if msg.Error != nil {
+				v.Message = i18n.T("Describe failed: %v", msg.Error)
+			} else if detail, ok := msg.Result.Data.(InstanceDetail); ok {
+				v.Message = msg.Result.Message
+				v.OpenDetail(i18n.T("Instance %s (%s)", detail.InstanceID, detail.Name), formatDetail(detail))
+			}
+			break
+		}
 		if msg.Error != nil {
-			v.Message = fmt.Sprintf("Action failed: %v", msg.Error)
+			v.Message = i18n.T("Action failed: %v", msg.Error)
 		} else if msg.Result != nil {
 			v.Message = msg.Result.Message
 		}
@@ -120,11 +225,11 @@ func (v *View) View() string {
 
 	// Lines 3-N: Table or loading/error state
 	if v.IsLoading() && len(v.Resources) == 0 {
-		lines = append(lines, v.Styles.Muted.Render("Loading EC2 instances..."))
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading EC2 instances...")))
 	} else if err := v.Error(); err != nil {
-		lines = append(lines, v.Styles.Error.Render(fmt.Sprintf("Error: %v", err)))
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
 	} else {
-		lines = append(lines, v.TableViewString())
+		lines = append(lines, v.ContentView())
 	}
 
 	// Message line (or blank)
@@ -135,7 +240,7 @@ func (v *View) View() string {
 	}
 
 	// Help line
-	lines = append(lines, v.Styles.Help.Render("[s]tart  [t]stop  [b]reboot  [↑/↓]navigate  [r]efresh"))
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[s]tart  [t]stop  [b]reboot  [x]terminate  [m]odify type  [i]mage  [S]chedule  [f]ilter  [Enter]details  [u]ser data  [↑/↓]navigate  [r]efresh")))
 
 	return strings.Join(lines, "\n")
 }
@@ -149,34 +254,70 @@ func (v *View) Refresh() tea.Cmd {
 	return v.loadInstances()
 }
 
+// SaveState implements core.StatefulView. Filters equal to the configured
+// defaults are not saved, so later changes to the defaults still apply.
+func (v *View) SaveState() core.ViewState {
+	state := v.EnrichableTableView.SaveState()
+	if ec2Svc, ok := v.Service().(*Service); ok && maps.Equal(state.Filters, ec2Svc.DefaultFilters()) {
+		state.Filters = nil
+	}
+	return state
+}
+
+// RestoreState implements core.StatefulView. Restored filters replace the
+// configured defaults.
+func (v *View) RestoreState(state core.ViewState) {
+	v.EnrichableTableView.RestoreState(state)
+	if len(state.Filters) > 0 {
+		v.filters = state.Filters
+		v.filtersInit = true
+	}
+}
+
 // =============================================================================
 // Internal Methods
 // =============================================================================
 
-type ec2LoadedMsg struct {
-	resources []core.Resource
-	err       error
-}
-
+// loadInstances lists instances with the current filters, streaming pages
+// and analyzing utilization once the listing completes.
 func (v *View) loadInstances() tea.Cmd {
-	v.SetLoading(true)
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return ec2LoadedMsg{err: fmt.Errorf("service not initialized")}
+	if !v.filtersInit {
+		if ec2Svc, ok := v.Service().(*Service); ok {
+			v.filters = ec2Svc.DefaultFilters()
 		}
+		v.filtersInit = true
+	}
+	v.ListOptions.Filters = v.filters
+	return v.Load()
+}
 
-		lister, ok := service.(core.ResourceLister)
-		if !ok {
-			return ec2LoadedMsg{err: fmt.Errorf("service does not support listing")}
-		}
+func (v *View) openFilterForm() tea.Cmd {
+	params := []core.ActionParameter{
+		{
+			Name:        "filters",
+			Type:        "string",
+			Default:     FormatFilters(v.filters),
+			Description: i18n.T("e.g. state=running type=t3.micro tag:Env=prod (empty clears)"),
+		},
+	}
+	return v.OpenForm(components.NewForm(filterFormID, i18n.T("Filter EC2 instances"), params))
+}
 
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return ec2LoadedMsg{resources: resources, err: err}
+func (v *View) openActionForm(formID, action, title, instanceID string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
 	}
+	v.formTarget = instanceID
+	return v.OpenForm(components.NewForm(formID, title, def.Parameters))
 }
 
 func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return v.executeActionWithParams(action, resourceID, nil)
+}
+
+func (v *View) executeActionWithParams(action, resourceID string, params map[string]any) tea.Cmd {
 	return func() tea.Msg {
 		service := v.Service()
 		if service == nil {
@@ -188,31 +329,109 @@ func (v *View) executeAction(action, resourceID string) tea.Cmd {
 			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
 		}
 
-		result, err := executor.Execute(context.Background(), action, resourceID, nil)
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
 		return base.ActionResultMsg{Action: action, Result: result, Error: err}
 	}
 }
 
-func (v *View) updateTable() {
-	rows := make([]table.Row, len(v.Resources))
-	for i, r := range v.Resources {
-		rows[i] = table.Row{
-			r.ID,
-			base.TruncateString(r.Name, 30),
-			r.GetMetadataString("instance_type"),
-			base.FormatState(r.State),
-			r.GetMetadataString("public_ip"),
-			r.GetMetadataString("private_ip"),
-			r.GetMetadataString("availability_zone"),
+// formatDetail renders instance details for the detail panel.
+func formatDetail(d InstanceDetail) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Type:     %s\n", d.InstanceType)
+	fmt.Fprintf(&b, "State:    %s\n", d.State)
+	profile := d.InstanceProfile
+	if profile == "" {
+		profile = "(none)"
+	}
+	fmt.Fprintf(&b, "Profile:  %s\n", profile)
+
+	b.WriteString(i18n.T("\nVolumes:\n"))
+	if len(d.Volumes) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, vol := range d.Volumes {
+		encrypted := "unencrypted"
+		if vol.Encrypted {
+			encrypted = "encrypted"
+		}
+		fmt.Fprintf(&b, "  %s  %s  %d GiB %s  %d IOPS  %s  %s\n",
+			vol.Device, vol.ID, vol.SizeGiB, vol.Type, vol.IOPS, encrypted, vol.State)
+	}
+
+	b.WriteString(i18n.T("\nSecurity Groups:\n"))
+	if len(d.SecurityGroups) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, sg := range d.SecurityGroups {
+		fmt.Fprintf(&b, "  %s (%s)\n", sg.ID, sg.Name)
+		for _, rule := range sg.Ingress {
+			fmt.Fprintf(&b, "    in:  %s\n", rule)
+		}
+		for _, rule := range sg.Egress {
+			fmt.Fprintf(&b, "    out: %s\n", rule)
+		}
+	}
+
+	if d.Sensitive {
+		b.WriteString(i18n.T("\nUser Data:\n"))
+		b.WriteString(d.UserData)
+		b.WriteString(i18n.T("\n\nConsole Output (latest):\n"))
+		b.WriteString(d.ConsoleOutput)
+		b.WriteString("\n")
+	} else {
+		b.WriteString(i18n.T("\nPress 'U' in the list to include user data and console output.\n"))
+	}
+	return b.String()
+}
+
+func buildRow(r core.Resource) base.Row {
+	cpu, idle := "-", "-"
+	if r.State == core.StateRunning {
+		cpu, idle = "...", "..."
+	}
+	var cpuValue any
+	if avg, ok := r.Metadata["cpu_avg"].(float64); ok {
+		cpuValue = avg
+		cpu = fmt.Sprintf("%.1f%%", avg)
+		idle = "🟢 " + i18n.T("No")
+		if isIdle, _ := r.Metadata["should_cleanup"].(bool); isIdle {
+			idle = "🟡 " + i18n.T("Yes")
 		}
+	} else if analyzed, _ := r.Metadata["analyzed"].(bool); analyzed {
+		cpu, idle = "-", "-"
+	}
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 30)),
+		base.TextCell(r.GetMetadataString("instance_type")),
+		base.TextCell(base.FormatState(r.State)),
+		base.AgeCell(r),
+		base.TextCell(r.GetMetadataString("public_ip")),
+		base.TextCell(r.GetMetadataString("private_ip")),
+		base.TextCell(r.GetMetadataString("availability_zone")),
+		base.LazyCell(cpuValue, func() string { return cpu }),
+		base.TextCell(idle),
+		base.TextCell(r.GetMetadataString("suggested_type")),
+		base.CostCell(r),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatControls(r)),
+		base.TextCell(base.FormatIaC(r)),
+		base.TextCell(base.FormatOwner(r)),
 	}
-	v.SetRows(rows)
 }
 
-func (v *View) renderSummary() string {
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
 	total := len(v.Resources)
 	running := 0
 	stopped := 0
+	idle := 0
+
+	filterLabel := ""
+	if len(v.filters) > 0 {
+		filterLabel = i18n.T("Filter: %s", FormatFilters(v.filters))
+	}
 
 	for _, r := range v.Resources {
 		switch r.State {
@@ -221,20 +440,24 @@ func (v *View) renderSummary() string {
 		case core.StateStopped:
 			stopped++
 		}
+		if isIdle, _ := r.Metadata["should_cleanup"].(bool); isIdle {
+			idle++
+		}
 	}
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		v.Styles.Title.Render("EC2 Instances"),
-		"  ",
-		v.Styles.Muted.Render(fmt.Sprintf("Total: %d", total)),
-		"  ",
-		v.Styles.Success.Render(fmt.Sprintf("Running: %d", running)),
-		"  ",
-		v.Styles.Error.Render(fmt.Sprintf("Stopped: %d", stopped)),
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "running", Text: i18n.T("Running: %d", running), Tone: core.ToneSuccess},
+		core.SummaryWidget{Name: "stopped", Text: i18n.T("Stopped: %d", stopped), Tone: core.ToneError},
+		core.SummaryWidget{Name: "idle", Text: i18n.T("Idle: %d", idle), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "filter", Text: filterLabel, Tone: core.ToneInfo},
 	)
 }
 
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("EC2 Instances"), v.SummaryWidgets())
+}
+
 // =============================================================================
 // View Factory
 // =============================================================================
@@ -264,7 +487,8 @@ func (f *ViewFactory) ServiceName() string {
 // =============================================================================
 
 var (
-	_ tea.Model        = (*View)(nil)
-	_ core.View        = (*View)(nil)
-	_ core.ViewFactory = (*ViewFactory)(nil)
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.BadgeProvider = (*View)(nil)
+	_ core.ViewFactory   = (*ViewFactory)(nil)
 )
@@ -0,0 +1,108 @@
+package ec2
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/runbook"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// TestRunbookTerminatesConfirmedInstance walks a runbook whose terminate
+// step asks for the instance ID: the step is only run once the ID is
+// typed back in the confirmation form.
+func TestRunbookTerminatesConfirmedInstance(t *testing.T) {
+	fake := newFakeEC2()
+	svc := NewServiceWithClient(fake, nil)
+	base.UseRunbooks([]runbook.Runbook{{
+		Name:     "decommission-instance",
+		Services: []string{"ec2"},
+		Steps:    []runbook.Step{{Service: "ec2", Action: "terminate"}},
+	}}, func(string) (core.AWSService, error) { return svc, nil })
+	t.Cleanup(func() { base.UseRunbooks(nil, nil) })
+	t.Cleanup(core.ResetActionExecutions)
+
+	v := NewView()
+	v.SetService(svc)
+	v.Resources = []core.Resource{{ID: testInstance, Name: "web", Type: "ec2:instance", State: core.StateRunning}}
+	v.RefreshRows()
+
+	// update passes msg to the view, then the messages its commands return
+	var update func(msg tea.Msg)
+	update = func(msg tea.Msg) {
+		_, cmd := v.Update(msg)
+		for _, next := range messages(cmd) {
+			update(next)
+		}
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")})
+	update(components.FormResultMsg{ID: "runbook:choose", Values: map[string]any{"runbook": "decommission-instance"}})
+	update(components.FormResultMsg{ID: "runbook:step", Values: map[string]any{"run_step": true}})
+	if len(fake.terminated) > 0 {
+		t.Fatalf("terminated %v before the ID was typed back", fake.terminated)
+	}
+
+	update(components.FormResultMsg{ID: "runbook:confirm", Values: map[string]any{core.ParamConfirmResource: testInstance}})
+	if !slices.Equal(fake.terminated, []string{testInstance}) {
+		t.Errorf("terminated %v, want [%s]; view says %q", fake.terminated, testInstance, v.Message)
+	}
+}
+
+// TestActionMenuTerminatesConfirmedInstance runs terminate from the
+// action menu: the menu dispatches it to the service, whose confirmation
+// opens the form asking for the instance ID.
+func TestActionMenuTerminatesConfirmedInstance(t *testing.T) {
+	t.Cleanup(core.ResetActionExecutions)
+	fake := newFakeEC2()
+	v := NewView()
+	v.SetService(NewServiceWithClient(fake, nil, WithMetricsClient(fakeCloudWatch{})))
+	v.Resources = []core.Resource{{ID: testInstance, Name: "web", Type: "ec2:instance", State: core.StateRunning}}
+	v.RefreshRows()
+
+	var update func(msg tea.Msg)
+	update = func(msg tea.Msg) {
+		_, cmd := v.Update(msg)
+		for _, next := range messages(cmd) {
+			update(next)
+		}
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(".")})
+	if !v.CapturingInput() {
+		t.Fatal("action menu did not open")
+	}
+	update(components.SelectorResultMsg{Value: "terminate"})
+	if len(fake.terminated) > 0 {
+		t.Fatalf("terminated %v before the ID was typed back", fake.terminated)
+	}
+
+	update(components.FormResultMsg{ID: "policy:confirm", Values: map[string]any{core.ParamConfirmResource: testInstance}})
+	if !slices.Equal(fake.terminated, []string{testInstance}) {
+		t.Errorf("terminated %v, want [%s]; view says %q", fake.terminated, testInstance, v.Message)
+	}
+}
+
+// messages runs cmd and returns the messages it produced, flattening
+// batches.
+func messages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return nil
+	case tea.BatchMsg:
+		var out []tea.Msg
+		for _, c := range msg {
+			out = append(out, messages(c)...)
+		}
+		return out
+	default:
+		return []tea.Msg{msg}
+	}
+}
@@ -0,0 +1,746 @@
+// Package ecr provides Amazon ECR integration for the a9s application. It
+// lists repositories with their scanning and tag settings; analysis counts
+// their images and reads the scan findings of the latest one. Actions
+// delete untagged images, set a lifecycle policy and delete repositories.
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+const (
+	// pricePerGB is the monthly price of a GB of image storage, as in
+	// us-east-1.
+	pricePerGB = 0.10
+
+	// deleteBatchSize is the most images BatchDeleteImage takes at once.
+	deleteBatchSize = 100
+
+	// maxFindings caps the findings shown for an image, most severe first.
+	maxFindings = 50
+
+	// Defaults of the lifecycle policy form.
+	defaultUntaggedDays = 14
+	defaultKeepImages   = 30
+)
+
+// severities orders scan finding severities, most severe first.
+var severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNDEFINED"}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements ECR operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient ECRAPI
+}
+
+// ECRAPI defines the ECR client interface for mocking.
+type ECRAPI interface {
+	DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error)
+	DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error)
+	DescribeImageScanFindings(ctx context.Context, params *ecr.DescribeImageScanFindingsInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImageScanFindingsOutput, error)
+	GetLifecyclePolicy(ctx context.Context, params *ecr.GetLifecyclePolicyInput, optFns ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+	PutLifecyclePolicy(ctx context.Context, params *ecr.PutLifecyclePolicyInput, optFns ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error)
+	BatchDeleteImage(ctx context.Context, params *ecr.BatchDeleteImageInput, optFns ...func(*ecr.Options)) (*ecr.BatchDeleteImageOutput, error)
+	DeleteRepository(ctx context.Context, params *ecr.DeleteRepositoryInput, optFns ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error)
+}
+
+// NewService creates a new ECR service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client ECRAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the ECR client for the current AWS context.
+func (s *Service) client() ECRAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return ecr.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region repositories are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "ecr"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "ECR Repositories"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "package"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeRepositories(ctx, &ecr.DescribeRepositoriesInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("ecr", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the repositories of the region. Image counts and findings
+// are only known once analyzed.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+
+	resources := make([]core.Resource, 0)
+	paginator := ecr.NewDescribeRepositoriesPaginator(s.client(), &ecr.DescribeRepositoriesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("ecr", "list", err)
+		}
+		for _, repo := range page.Repositories {
+			resources = append(resources, s.repositoryToResource(repo, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ecr:repository",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource counts a repository's images, untagged ones included, and
+// their storage, reads the scan findings summary of the latest image and
+// checks for a lifecycle policy. Critical findings are flagged high and
+// high findings medium.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	images, err := s.images(ctx, resource.ID, types.TagStatusAny)
+	if err != nil {
+		return err
+	}
+
+	var size, untaggedSize int64
+	untagged := 0
+	var latest *types.ImageDetail
+	for i, image := range images {
+		size += aws.ToInt64(image.ImageSizeInBytes)
+		if len(image.ImageTags) == 0 {
+			untagged++
+			untaggedSize += aws.ToInt64(image.ImageSizeInBytes)
+		}
+		if latest == nil || aws.ToTime(image.ImagePushedAt).After(aws.ToTime(latest.ImagePushedAt)) {
+			latest = &images[i]
+		}
+	}
+	resource.Metadata["image_count"] = len(images)
+	resource.Metadata["size_bytes"] = size
+	resource.Metadata["untagged_count"] = untagged
+	resource.Metadata["untagged_bytes"] = untaggedSize
+	estimate.ApplyCost(resource, float64(size)/1e9*pricePerGB)
+
+	counts := map[string]int32{}
+	if latest != nil {
+		resource.Metadata["latest_digest"] = aws.ToString(latest.ImageDigest)
+		resource.Metadata["latest_tags"] = latest.ImageTags
+		resource.Metadata["latest_pushed"] = aws.ToTime(latest.ImagePushedAt)
+		if latest.ImageScanStatus != nil {
+			resource.Metadata["scan_status"] = strings.ToLower(string(latest.ImageScanStatus.Status))
+		}
+		if summary := latest.ImageScanFindingsSummary; summary != nil {
+			counts = summary.FindingSeverityCounts
+		}
+	}
+	resource.Metadata["finding_counts"] = counts
+
+	policy, err := s.client().GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{RepositoryName: aws.String(resource.ID)})
+	var notFound *types.LifecyclePolicyNotFoundException
+	switch {
+	case errors.As(err, &notFound):
+		resource.Metadata["lifecycle_policy"] = false
+	case err != nil:
+		return err
+	default:
+		resource.Metadata["lifecycle_policy"] = aws.ToString(policy.LifecyclePolicyText) != ""
+	}
+
+	if n := counts["CRITICAL"]; n > 0 {
+		resource.AddIssue(core.SeverityHigh, fmt.Sprintf("Latest image has %d critical vulnerabilities", n))
+	}
+	if n := counts["HIGH"]; n > 0 {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Latest image has %d high vulnerabilities", n))
+	}
+	if scanOnPush, _ := resource.Metadata["scan_on_push"].(bool); !scanOnPush {
+		resource.AddIssue(core.SeverityLow, "Scan on push is disabled")
+	}
+	if untagged > 0 {
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("%d untagged images (%s)", untagged, formatBytes(untaggedSize)))
+	}
+	if hasPolicy, _ := resource.Metadata["lifecycle_policy"].(bool); !hasPolicy && len(images) > 0 {
+		resource.AddIssue(core.SeverityLow, "No lifecycle policy")
+	}
+
+	resource.Metadata["analyzed"] = true
+	return nil
+}
+
+// images returns the images of a repository with tagStatus.
+func (s *Service) images(ctx context.Context, repository string, tagStatus types.TagStatus) ([]types.ImageDetail, error) {
+	var images []types.ImageDetail
+	paginator := ecr.NewDescribeImagesPaginator(s.client(), &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repository),
+		Filter:         &types.DescribeImagesFilter{TagStatus: tagStatus},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, page.ImageDetails...)
+	}
+	return images, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for repositories.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "findings",
+			Description: "Show the scan findings of the latest image",
+			Icon:        "shield",
+			Shortcut:    "enter",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "delete_untagged",
+			Description: "Delete the untagged images",
+			Icon:        "trash",
+			Shortcut:    "u",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "set_lifecycle",
+			Description: "Replace the lifecycle policy",
+			Icon:        "clock",
+			Shortcut:    "l",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "untagged_days", Type: "int", Default: defaultUntaggedDays, Description: "Expire untagged images after this many days (0 to keep them)"},
+				{Name: "keep_images", Type: "int", Default: defaultKeepImages, Description: "Keep this many most recent images (0 for no limit)"},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the repository and all its images",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a repository, identified by its
+// name. Deleting images, replacing the lifecycle policy or deleting the
+// repository asks for confirmation through a core.ConfirmationError until
+// the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "findings":
+		result, err = s.findings(ctx, resourceID)
+	case "delete_untagged":
+		result, err = s.deleteUntagged(ctx, resourceID, params, confirmed)
+	case "set_lifecycle":
+		untaggedDays, keepImages := defaultUntaggedDays, defaultKeepImages
+		if v, ok := params["untagged_days"]; ok {
+			if untaggedDays, err = intParam(v); err != nil || untaggedDays < 0 {
+				return nil, core.NewValidationError("untagged_days", v, "must be a number of days")
+			}
+		}
+		if v, ok := params["keep_images"]; ok {
+			if keepImages, err = intParam(v); err != nil || keepImages < 0 {
+				return nil, core.NewValidationError("keep_images", v, "must be a number of images")
+			}
+		}
+		if untaggedDays == 0 && keepImages == 0 {
+			return nil, core.NewValidationError("keep_images", keepImages, "a policy needs at least one rule")
+		}
+		result, err = s.setLifecycle(ctx, resourceID, untaggedDays, keepImages, params, confirmed)
+	case "delete":
+		result, err = s.deleteRepository(ctx, resourceID, params, core.Confirmed(params, resourceID, true))
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// ScanReport is the result data of the findings action.
+type ScanReport struct {
+	Repository string
+	Digest     string
+	Tags       []string
+	Pushed     time.Time
+	Status     string // Scan status, such as "complete" or "unsupported_image"
+	Detail     string // Why the scan is not complete
+	Completed  time.Time
+	Counts     map[string]int32 // Findings per severity
+	Findings   []Finding        // Most severe first, up to maxFindings
+}
+
+// Finding is a vulnerability found in an image.
+type Finding struct {
+	Name     string // CVE or other identifier
+	Severity string
+	Package  string // Package and version, when known
+	FixedIn  string
+	Title    string
+	URI      string
+}
+
+// findings reads the scan findings of a repository's latest image. Basic
+// and enhanced (Amazon Inspector) scans are both read.
+func (s *Service) findings(ctx context.Context, repository string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("findings", repository, err)
+	}
+
+	images, err := s.images(ctx, repository, types.TagStatusAny)
+	if err != nil {
+		return fail(err)
+	}
+	if len(images) == 0 {
+		return fail(fmt.Errorf("%w: no image in %s", core.ErrResourceNotFound, repository))
+	}
+	latest := images[0]
+	for _, image := range images[1:] {
+		if aws.ToTime(image.ImagePushedAt).After(aws.ToTime(latest.ImagePushedAt)) {
+			latest = image
+		}
+	}
+
+	report := ScanReport{
+		Repository: repository,
+		Digest:     aws.ToString(latest.ImageDigest),
+		Tags:       latest.ImageTags,
+		Pushed:     aws.ToTime(latest.ImagePushedAt),
+	}
+	out, err := s.client().DescribeImageScanFindings(ctx, &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repository),
+		ImageId:        &types.ImageIdentifier{ImageDigest: latest.ImageDigest},
+	})
+	var notScanned *types.ScanNotFoundException
+	switch {
+	case errors.As(err, &notScanned):
+		report.Status = "not scanned"
+	case err != nil:
+		return fail(err)
+	default:
+		if out.ImageScanStatus != nil {
+			report.Status = strings.ToLower(string(out.ImageScanStatus.Status))
+			report.Detail = aws.ToString(out.ImageScanStatus.Description)
+		}
+		if findings := out.ImageScanFindings; findings != nil {
+			report.Completed = aws.ToTime(findings.ImageScanCompletedAt)
+			report.Counts = findings.FindingSeverityCounts
+			report.Findings = findingsOf(findings)
+		}
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Scan of %s: %s", repository, report.Status))
+	result.Data = report
+	return result, nil
+}
+
+// deleteUntagged deletes the images without tags of a repository, at most
+// 100 per call.
+func (s *Service) deleteUntagged(ctx context.Context, repository string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete_untagged", repository, err)
+	}
+
+	images, err := s.images(ctx, repository, types.TagStatusUntagged)
+	if err != nil {
+		return fail(err)
+	}
+	if len(images) == 0 {
+		return core.NewActionResult(true, fmt.Sprintf("No untagged images in %s", repository)), nil
+	}
+	if !confirmed {
+		var size int64
+		for _, image := range images {
+			size += aws.ToInt64(image.ImageSizeInBytes)
+		}
+		reason := fmt.Sprintf("Deletes %d untagged images (%s); images pulled by digest stop working", len(images), formatBytes(size))
+		return nil, core.NewConfirmationError(s, "delete_untagged", repository, params, reason, false)
+	}
+
+	deleted := 0
+	var failures []string
+	for chunk := range slices.Chunk(images, deleteBatchSize) {
+		ids := make([]types.ImageIdentifier, 0, len(chunk))
+		for _, image := range chunk {
+			ids = append(ids, types.ImageIdentifier{ImageDigest: image.ImageDigest})
+		}
+		out, err := s.client().BatchDeleteImage(ctx, &ecr.BatchDeleteImageInput{
+			RepositoryName: aws.String(repository),
+			ImageIds:       ids,
+		})
+		if err != nil {
+			return fail(fmt.Errorf("deleted %d of %d images: %w", deleted, len(images), err))
+		}
+		deleted += len(out.ImageIds)
+		for _, f := range out.Failures {
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.ToString(f.ImageId.ImageDigest), aws.ToString(f.FailureReason)))
+		}
+	}
+
+	message := fmt.Sprintf("Deleted %d untagged images from %s", deleted, repository)
+	if len(failures) > 0 {
+		message += fmt.Sprintf(", %d failed: %s", len(failures), strings.Join(failures, "; "))
+	}
+	return core.NewActionResult(len(failures) == 0, message), nil
+}
+
+// lifecycleRule is a rule of an ECR lifecycle policy.
+type lifecycleRule struct {
+	RulePriority int    `json:"rulePriority"`
+	Description  string `json:"description"`
+	Selection    struct {
+		TagStatus   string `json:"tagStatus"`
+		CountType   string `json:"countType"`
+		CountUnit   string `json:"countUnit,omitempty"`
+		CountNumber int    `json:"countNumber"`
+	} `json:"selection"`
+	Action struct {
+		Type string `json:"type"`
+	} `json:"action"`
+}
+
+// lifecyclePolicy builds a policy expiring untagged images after
+// untaggedDays and all but the keepImages most recent images. A zero value
+// leaves its rule out.
+func lifecyclePolicy(untaggedDays, keepImages int) (string, error) {
+	var rules []lifecycleRule
+	if untaggedDays > 0 {
+		rule := lifecycleRule{RulePriority: len(rules) + 1, Description: fmt.Sprintf("Expire untagged images after %d days", untaggedDays)}
+		rule.Selection.TagStatus = "untagged"
+		rule.Selection.CountType = "sinceImagePushed"
+		rule.Selection.CountUnit = "days"
+		rule.Selection.CountNumber = untaggedDays
+		rule.Action.Type = "expire"
+		rules = append(rules, rule)
+	}
+	// A rule on any tag status must come last
+	if keepImages > 0 {
+		rule := lifecycleRule{RulePriority: len(rules) + 1, Description: fmt.Sprintf("Keep the %d most recent images", keepImages)}
+		rule.Selection.TagStatus = "any"
+		rule.Selection.CountType = "imageCountMoreThan"
+		rule.Selection.CountNumber = keepImages
+		rule.Action.Type = "expire"
+		rules = append(rules, rule)
+	}
+	data, err := json.Marshal(map[string]any{"rules": rules})
+	return string(data), err
+}
+
+// setLifecycle replaces a repository's lifecycle policy once confirmed.
+func (s *Service) setLifecycle(ctx context.Context, repository string, untaggedDays, keepImages int, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("set_lifecycle", repository, err)
+	}
+
+	policy, err := lifecyclePolicy(untaggedDays, keepImages)
+	if err != nil {
+		return fail(err)
+	}
+	if !confirmed {
+		reason := "Replaces the current lifecycle policy; ECR expires the images it selects within a day"
+		return nil, core.NewConfirmationError(s, "set_lifecycle", repository, params, reason, false)
+	}
+
+	if _, err := s.client().PutLifecyclePolicy(ctx, &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repository),
+		LifecyclePolicyText: aws.String(policy),
+	}); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Lifecycle policy of %s set", repository)), nil
+}
+
+// deleteRepository deletes a repository and its images once confirmed by
+// typing its name.
+func (s *Service) deleteRepository(ctx context.Context, repository string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", repository, err)
+	}
+
+	if !confirmed {
+		images, err := s.images(ctx, repository, types.TagStatusAny)
+		if err != nil {
+			return fail(err)
+		}
+		reason := fmt.Sprintf("Deletes the repository and its %d images; this cannot be undone", len(images))
+		return nil, core.NewConfirmationError(s, "delete", repository, params, reason, true)
+	}
+
+	if _, err := s.client().DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+		RepositoryName: aws.String(repository),
+		Force:          true,
+	}); err != nil {
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   repository,
+		ResourceType: "ecr:repository",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleted repository %s", repository)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) repositoryToResource(repo types.Repository, now time.Time) core.Resource {
+	name := aws.ToString(repo.RepositoryName)
+	scanOnPush := repo.ImageScanningConfiguration != nil && repo.ImageScanningConfiguration.ScanOnPush
+	encryption := "AES256"
+	if repo.EncryptionConfiguration != nil {
+		encryption = string(repo.EncryptionConfiguration.EncryptionType)
+	}
+
+	resource := core.Resource{
+		ID:        name,
+		Type:      "ecr:repository",
+		Name:      name,
+		ARN:       aws.ToString(repo.RepositoryArn),
+		State:     "active",
+		Region:    s.region(),
+		CreatedAt: repo.CreatedAt,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"uri":            aws.ToString(repo.RepositoryUri),
+			"scan_on_push":   scanOnPush,
+			"tag_mutability": strings.ToLower(string(repo.ImageTagMutability)),
+			"encryption":     encryption,
+			"analyzed":       false,
+		},
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+	return resource
+}
+
+// findingsOf returns the findings of a scan, most severe first.
+func findingsOf(scan *types.ImageScanFindings) []Finding {
+	var findings []Finding
+	for _, f := range scan.Findings {
+		finding := Finding{
+			Name:     aws.ToString(f.Name),
+			Severity: string(f.Severity),
+			Title:    aws.ToString(f.Description),
+			URI:      aws.ToString(f.Uri),
+		}
+		var pkg, version string
+		for _, attr := range f.Attributes {
+			switch aws.ToString(attr.Key) {
+			case "package_name":
+				pkg = aws.ToString(attr.Value)
+			case "package_version":
+				version = aws.ToString(attr.Value)
+			}
+		}
+		finding.Package = strings.TrimSpace(pkg + " " + version)
+		findings = append(findings, finding)
+	}
+	for _, f := range scan.EnhancedFindings {
+		finding := Finding{
+			Severity: aws.ToString(f.Severity),
+			Title:    aws.ToString(f.Title),
+		}
+		if details := f.PackageVulnerabilityDetails; details != nil {
+			finding.Name = aws.ToString(details.VulnerabilityId)
+			finding.URI = aws.ToString(details.SourceUrl)
+			if len(details.VulnerablePackages) > 0 {
+				pkg := details.VulnerablePackages[0]
+				finding.Package = strings.TrimSpace(aws.ToString(pkg.Name) + " " + aws.ToString(pkg.Version))
+				finding.FixedIn = aws.ToString(pkg.FixedInVersion)
+			}
+		}
+		findings = append(findings, finding)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityRank(findings[i].Severity) < severityRank(findings[j].Severity)
+	})
+	if len(findings) > maxFindings {
+		findings = findings[:maxFindings]
+	}
+	return findings
+}
+
+// severityRank orders a finding severity, most severe first.
+func severityRank(severity string) int {
+	if i := slices.Index(severities, severity); i >= 0 {
+		return i
+	}
+	return len(severities)
+}
+
+// formatBytes formats a size in decimal units, as ECR bills storage.
+func formatBytes(bytes int64) string {
+	const unit = 1000
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "kMGTPE"[exp])
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "ecr", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "ecr", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
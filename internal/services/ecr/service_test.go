@@ -0,0 +1,211 @@
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeECR serves the api repository, without scan on push or lifecycle
+// policy, holding an untagged image and a newer tagged one with two
+// critical findings. It fails every call when err is set.
+type fakeECR struct {
+	err     error
+	deleted []types.ImageIdentifier
+}
+
+func (f *fakeECR) DescribeRepositories(context.Context, *ecr.DescribeRepositoriesInput, ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecr.DescribeRepositoriesOutput{Repositories: []types.Repository{{
+		RepositoryName: aws.String("api"),
+		RepositoryArn:  aws.String("arn:aws:ecr:us-east-1:123456789012:repository/api"),
+		RepositoryUri:  aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/api"),
+	}}}, nil
+}
+
+func (f *fakeECR) DescribeImages(_ context.Context, in *ecr.DescribeImagesInput, _ ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.RepositoryName) != "api" {
+		return nil, &types.RepositoryNotFoundException{Message: aws.String("repository not found")}
+	}
+	pushed := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	untagged := types.ImageDetail{
+		ImageDigest:      aws.String("sha256:old"),
+		ImageSizeInBytes: aws.Int64(50e6),
+		ImagePushedAt:    aws.Time(pushed),
+	}
+	if in.Filter != nil && in.Filter.TagStatus == types.TagStatusUntagged {
+		return &ecr.DescribeImagesOutput{ImageDetails: []types.ImageDetail{untagged}}, nil
+	}
+	return &ecr.DescribeImagesOutput{ImageDetails: []types.ImageDetail{
+		untagged,
+		{
+			ImageDigest:      aws.String("sha256:new"),
+			ImageTags:        []string{"v2", "latest"},
+			ImageSizeInBytes: aws.Int64(60e6),
+			ImagePushedAt:    aws.Time(pushed.Add(24 * time.Hour)),
+			ImageScanStatus:  &types.ImageScanStatus{Status: types.ScanStatusComplete},
+			ImageScanFindingsSummary: &types.ImageScanFindingsSummary{
+				FindingSeverityCounts: map[string]int32{"CRITICAL": 2},
+			},
+		},
+	}}, nil
+}
+
+func (f *fakeECR) DescribeImageScanFindings(context.Context, *ecr.DescribeImageScanFindingsInput, ...func(*ecr.Options)) (*ecr.DescribeImageScanFindingsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecr.DescribeImageScanFindingsOutput{
+		ImageScanStatus: &types.ImageScanStatus{Status: types.ScanStatusComplete},
+		ImageScanFindings: &types.ImageScanFindings{
+			FindingSeverityCounts: map[string]int32{"CRITICAL": 2},
+			Findings: []types.ImageScanFinding{
+				{Name: aws.String("CVE-2024-0002"), Severity: types.FindingSeverityCritical},
+				{Name: aws.String("CVE-2024-0001"), Severity: types.FindingSeverityCritical},
+			},
+		},
+	}, nil
+}
+
+func (f *fakeECR) GetLifecyclePolicy(context.Context, *ecr.GetLifecyclePolicyInput, ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return nil, &types.LifecyclePolicyNotFoundException{Message: aws.String("no policy")}
+}
+
+func (f *fakeECR) PutLifecyclePolicy(context.Context, *ecr.PutLifecyclePolicyInput, ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecr.PutLifecyclePolicyOutput{}, nil
+}
+
+func (f *fakeECR) BatchDeleteImage(_ context.Context, in *ecr.BatchDeleteImageInput, _ ...func(*ecr.Options)) (*ecr.BatchDeleteImageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, in.ImageIds...)
+	return &ecr.BatchDeleteImageOutput{ImageIds: in.ImageIds}, nil
+}
+
+func (f *fakeECR) DeleteRepository(context.Context, *ecr.DeleteRepositoryInput, ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecr.DeleteRepositoryOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against
+// repositories.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeECR{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeECR{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    "api",
+		MissingID:     "web",
+		Action:        "findings",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestEnrichReadsLatestImage(t *testing.T) {
+	svc := NewServiceWithClient(&fakeECR{}, nil)
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	api := resources[0]
+	if err := svc.EnrichResource(context.Background(), &api); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+	if api.GetMetadataString("latest_digest") != "sha256:new" || api.Metadata["untagged_count"] != 1 {
+		t.Errorf("latest %q, untagged %v", api.GetMetadataString("latest_digest"), api.Metadata["untagged_count"])
+	}
+	if api.Severity() != core.SeverityHigh {
+		t.Errorf("severity = %v, want high for critical findings", api.Severity())
+	}
+	var messages []string
+	for _, issue := range api.Issues() {
+		messages = append(messages, issue.Message)
+	}
+	want := []string{
+		"Latest image has 2 critical vulnerabilities",
+		"Scan on push is disabled",
+		"1 untagged images (50.0 MB)",
+		"No lifecycle policy",
+	}
+	if len(messages) != len(want) {
+		t.Fatalf("issues = %q, want %q", messages, want)
+	}
+	for i := range want {
+		if messages[i] != want[i] {
+			t.Errorf("issue %d = %q, want %q", i, messages[i], want[i])
+		}
+	}
+}
+
+func TestDeleteUntaggedKeepsTaggedImages(t *testing.T) {
+	fake := &fakeECR{}
+	svc := NewServiceWithClient(fake, nil)
+
+	if _, err := svc.Execute(context.Background(), "delete_untagged", "api", nil); !errors.Is(err, core.ErrConfirmationRequired) {
+		t.Fatalf("unconfirmed delete_untagged error = %v, want a confirmation", err)
+	}
+	result, err := svc.Execute(context.Background(), "delete_untagged", "api", map[string]any{core.ParamConfirm: true})
+	if err != nil || !result.Success {
+		t.Fatalf("delete_untagged = %+v, %v", result, err)
+	}
+	if len(fake.deleted) != 1 || aws.ToString(fake.deleted[0].ImageDigest) != "sha256:old" {
+		t.Errorf("deleted %+v, want only the untagged image", fake.deleted)
+	}
+}
+
+func TestLifecyclePolicyRuleOrder(t *testing.T) {
+	tests := []struct {
+		untaggedDays, keepImages int
+		want                     []string // tag status of each rule, by priority
+	}{
+		{14, 30, []string{"untagged", "any"}},
+		{14, 0, []string{"untagged"}},
+		{0, 30, []string{"any"}},
+	}
+	for _, tt := range tests {
+		text, err := lifecyclePolicy(tt.untaggedDays, tt.keepImages)
+		if err != nil {
+			t.Fatalf("lifecyclePolicy(%d, %d) error = %v", tt.untaggedDays, tt.keepImages, err)
+		}
+		var policy struct{ Rules []lifecycleRule }
+		if err := json.Unmarshal([]byte(text), &policy); err != nil {
+			t.Fatalf("policy %s: %v", text, err)
+		}
+		if len(policy.Rules) != len(tt.want) {
+			t.Fatalf("lifecyclePolicy(%d, %d) = %s", tt.untaggedDays, tt.keepImages, text)
+		}
+		for i, rule := range policy.Rules {
+			if rule.RulePriority != i+1 || rule.Selection.TagStatus != tt.want[i] {
+				t.Errorf("lifecyclePolicy(%d, %d) rule %d = priority %d on %s, want %s", tt.untaggedDays, tt.keepImages, i, rule.RulePriority, rule.Selection.TagStatus, tt.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const lifecycleFormID = "ecr:lifecycle"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for ECR repositories.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget string // Repository the lifecycle form is open for
+}
+
+// NewView creates a new ECR view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Images"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Untagged"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Size"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Scan on push"), MinWidth: 12, MaxWidth: 13, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Last push"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Findings C/H/M"), MinWidth: 14, MaxWidth: 16, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Lifecycle"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("ECR", "", "ecr", i18n.T("repositories"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "u":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting untagged images of %s...", row.Name)
+				return v, v.executeAction("delete_untagged", row.ID, nil)
+			}
+		case "l":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openLifecycleForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Reading scan findings of %s...", row.Name)
+				return v, v.executeAction("findings", row.ID, nil)
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != lifecycleFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Setting the lifecycle policy of %s...", v.formTarget)
+		return v, v.executeAction("set_lifecycle", v.formTarget, msg.Values)
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if report, ok := msg.Result.Data.(ScanReport); ok {
+			v.OpenDetail(i18n.T("Scan findings of %s", report.Repository), formatReport(report))
+			return v, nil
+		}
+		if msg.Action == "delete" {
+			return v, v.SoftRefresh()
+		}
+		// Image counts and the lifecycle policy come from analysis
+		return v, v.AnalyzeSelected()
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading ECR repositories...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]findings  [u]ntagged cleanup  [l]ifecycle  [d]elete  [a]nalyze  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the repositories, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	images, _ := r.Metadata["image_count"].(int)
+	untagged, _ := r.Metadata["untagged_count"].(int)
+	size, _ := r.Metadata["size_bytes"].(int64)
+	counts, _ := r.Metadata["finding_counts"].(map[string]int32)
+
+	scanOnPush := "-"
+	if enabled, _ := r.Metadata["scan_on_push"].(bool); enabled {
+		scanOnPush = "✓"
+	}
+
+	imagesText, untaggedText, sizeText := "...", "...", "..."
+	lastPush, findings, lifecycle := "...", "...", "..."
+	var imagesValue, untaggedValue, sizeValue, findingsValue any
+	if analyzed {
+		imagesValue, untaggedValue, sizeValue = images, untagged, size
+		imagesText = fmt.Sprintf("%d", images)
+		untaggedText = fmt.Sprintf("%d", untagged)
+		sizeText = formatBytes(size)
+		lastPush = "-"
+		if pushed, ok := r.Metadata["latest_pushed"].(time.Time); ok {
+			lastPush = pushed.Local().Format("2006-01-02")
+		}
+		findings = "-"
+		if r.GetMetadataString("scan_status") == "complete" || len(counts) > 0 {
+			findings = fmt.Sprintf("%d/%d/%d", counts["CRITICAL"], counts["HIGH"], counts["MEDIUM"])
+			// Sorts by critical, then high, then medium findings
+			findingsValue = int64(counts["CRITICAL"])<<40 | int64(counts["HIGH"])<<20 | int64(counts["MEDIUM"])
+		}
+		lifecycle = "-"
+		if policy, _ := r.Metadata["lifecycle_policy"].(bool); policy {
+			lifecycle = "✓"
+		}
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.LazyCell(imagesValue, func() string { return imagesText }),
+		base.LazyCell(untaggedValue, func() string { return untaggedText }),
+		base.LazyCell(sizeValue, func() string { return sizeText }),
+		base.TextCell(scanOnPush),
+		base.TextCell(lastPush),
+		base.LazyCell(findingsValue, func() string { return findings }),
+		base.TextCell(lifecycle),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// formatReport renders the scan findings of an image for the detail panel.
+func formatReport(report ScanReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Image:     %s\n", report.Digest)
+	if len(report.Tags) > 0 {
+		fmt.Fprintf(&b, "Tags:      %s\n", strings.Join(report.Tags, ", "))
+	}
+	fmt.Fprintf(&b, "Pushed:    %s\n", report.Pushed.Local().Format("2006-01-02 15:04"))
+	fmt.Fprintf(&b, "Scan:      %s\n", report.Status)
+	if report.Detail != "" {
+		fmt.Fprintf(&b, "           %s\n", report.Detail)
+	}
+	if !report.Completed.IsZero() {
+		fmt.Fprintf(&b, "Scanned:   %s\n", report.Completed.Local().Format("2006-01-02 15:04"))
+	}
+
+	if len(report.Counts) > 0 {
+		var counts []string
+		for _, severity := range severities {
+			if n := report.Counts[severity]; n > 0 {
+				counts = append(counts, fmt.Sprintf("%d %s", n, strings.ToLower(severity)))
+			}
+		}
+		fmt.Fprintf(&b, "Findings:  %s\n", strings.Join(counts, ", "))
+	}
+
+	if len(report.Findings) == 0 {
+		if report.Status == "complete" {
+			b.WriteString(i18n.T("\nNo vulnerabilities found.\n"))
+		}
+		return b.String()
+	}
+	b.WriteString(i18n.T("\nFindings, most severe first:\n"))
+	for _, f := range report.Findings {
+		fmt.Fprintf(&b, "  %-13s %s", f.Severity, f.Name)
+		if f.Package != "" {
+			fmt.Fprintf(&b, " in %s", f.Package)
+		}
+		if f.FixedIn != "" {
+			fmt.Fprintf(&b, ", fixed in %s", f.FixedIn)
+		}
+		b.WriteString("\n")
+		if f.Title != "" && f.Title != f.Name {
+			fmt.Fprintf(&b, "                %s\n", base.TruncateString(f.Title, 100))
+		}
+	}
+	return b.String()
+}
+
+func (v *View) openLifecycleForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "set_lifecycle")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "set_lifecycle")
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(lifecycleFormID, i18n.T("Lifecycle policy of %s", r.Name), def.Parameters))
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	images, vulnerable := 0, 0
+	for _, r := range v.Resources {
+		n, _ := r.Metadata["image_count"].(int)
+		images += n
+		counts, _ := r.Metadata["finding_counts"].(map[string]int32)
+		if counts["CRITICAL"] > 0 || counts["HIGH"] > 0 {
+			vulnerable++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "images", Text: i18n.T("Images: %d", images), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "vulnerable", Text: i18n.T("Vulnerable: %d", vulnerable), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("ECR Repositories"), v.SummaryWidgets())
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "ecr" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,343 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+const (
+	// describeClustersBatch is the most clusters a DescribeClusters call
+	// accepts.
+	describeClustersBatch = 100
+	// describeServicesBatch is the most services a DescribeServices call
+	// accepts.
+	describeServicesBatch = 10
+	// maxEvents bounds the service events kept for the detail panel.
+	maxEvents = 10
+)
+
+// Deployment is a deployment of a service.
+type Deployment struct {
+	ID             string
+	Status         string // primary, active or inactive
+	Rollout        string // in_progress, completed or failed
+	Reason         string
+	TaskDefinition string
+	Desired        int32
+	Running        int32
+	Pending        int32
+	Failed         int32
+	UpdatedAt      time.Time
+}
+
+// Event is a service event, such as a task failing to start.
+type Event struct {
+	At      time.Time
+	Message string
+}
+
+// =============================================================================
+// Clusters and Services
+// =============================================================================
+
+// listClusters describes every cluster in the region.
+func (s *Service) listClusters(ctx context.Context) ([]core.Resource, error) {
+	client := s.client()
+
+	var arns []string
+	paginator := ecs.NewListClustersPaginator(client, &ecs.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page.ClusterArns...)
+	}
+
+	resources := make([]core.Resource, 0, len(arns))
+	for start := 0; start < len(arns); start += describeClustersBatch {
+		out, err := client.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: arns[start:min(start+describeClustersBatch, len(arns))],
+			Include:  []types.ClusterField{types.ClusterFieldSettings, types.ClusterFieldTags},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range out.Clusters {
+			resources = append(resources, clusterToResource(cluster, s.region()))
+		}
+	}
+	return resources, nil
+}
+
+// listServices describes the services of a cluster.
+func (s *Service) listServices(ctx context.Context, cluster string) ([]core.Resource, error) {
+	client := s.client()
+
+	var arns []string
+	paginator := ecs.NewListServicesPaginator(client, &ecs.ListServicesInput{Cluster: aws.String(cluster)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page.ServiceArns...)
+	}
+
+	resources := make([]core.Resource, 0, len(arns))
+	for start := 0; start < len(arns); start += describeServicesBatch {
+		out, err := client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: arns[start:min(start+describeServicesBatch, len(arns))],
+			Include:  []types.ServiceField{types.ServiceFieldTags},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range out.Services {
+			resources = append(resources, serviceToResource(service, s.region(), time.Now()))
+		}
+	}
+	return resources, nil
+}
+
+// describeService describes a single service by ARN.
+func (s *Service) describeService(ctx context.Context, arn string) (types.Service, error) {
+	input := &ecs.DescribeServicesInput{Services: []string{arn}}
+	if cluster := clusterOf(arn); cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+	out, err := s.client().DescribeServices(ctx, input)
+	if err != nil {
+		return types.Service{}, err
+	}
+	if len(out.Services) == 0 || aws.ToString(out.Services[0].Status) == "INACTIVE" {
+		return types.Service{}, core.ErrResourceNotFound
+	}
+	return out.Services[0], nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// scale changes the desired task count of a service once confirmed.
+func (s *Service) scale(ctx context.Context, arn string, desired int, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("scale", arn, err)
+	}
+
+	service, err := s.describeService(ctx, arn)
+	if err != nil {
+		return fail(err)
+	}
+	name := aws.ToString(service.ServiceName)
+	if service.SchedulingStrategy == types.SchedulingStrategyDaemon {
+		return fail(core.NewValidationError("service", name, "runs a task on every container instance and cannot be scaled"))
+	}
+	if int(service.DesiredCount) == desired {
+		return core.NewActionResult(true, fmt.Sprintf("%s already wants %d tasks", name, desired)), nil
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Changes the desired count of %s from %d to %d", name, service.DesiredCount, desired)
+		if desired == 0 {
+			reason += ", stopping all its tasks"
+		}
+		return nil, core.NewConfirmationError(s, "scale", arn, params, reason, false)
+	}
+
+	_, err = s.client().UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      service.ClusterArn,
+		Service:      aws.String(arn),
+		DesiredCount: aws.Int32(int32(desired)),
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Scaling %s from %d to %d tasks", name, service.DesiredCount, desired)), nil
+}
+
+// forceDeployment starts a new deployment of a service with its current
+// task definition once confirmed, replacing its tasks.
+func (s *Service) forceDeployment(ctx context.Context, arn string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("force_deploy", arn, err)
+	}
+
+	service, err := s.describeService(ctx, arn)
+	if err != nil {
+		return fail(err)
+	}
+	name := aws.ToString(service.ServiceName)
+
+	if !confirmed {
+		reason := fmt.Sprintf("Replaces the %d running tasks of %s, pulling their images again", service.RunningCount, name)
+		return nil, core.NewConfirmationError(s, "force_deploy", arn, params, reason, false)
+	}
+
+	_, err = s.client().UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:            service.ClusterArn,
+		Service:            aws.String(arn),
+		ForceNewDeployment: true,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Started a new deployment of %s", name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func clusterToResource(cluster types.Cluster, region string) core.Resource {
+	arn := aws.ToString(cluster.ClusterArn)
+	if region == "" {
+		region = regionOf(arn)
+	}
+
+	insights := "disabled"
+	for _, setting := range cluster.Settings {
+		if setting.Name == types.ClusterSettingNameContainerInsights {
+			insights = aws.ToString(setting.Value)
+		}
+	}
+
+	resource := core.Resource{
+		ID:     arn,
+		Type:   "ecs:cluster",
+		Name:   aws.ToString(cluster.ClusterName),
+		ARN:    arn,
+		Region: region,
+		State:  strings.ToLower(aws.ToString(cluster.Status)),
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"services":           int(cluster.ActiveServicesCount),
+			"running":            int(cluster.RunningTasksCount),
+			"pending":            int(cluster.PendingTasksCount),
+			"instances":          int(cluster.RegisteredContainerInstancesCount),
+			"capacity_providers": cluster.CapacityProviders,
+			"container_insights": insights,
+		},
+	}
+	for _, tag := range cluster.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	iac.Apply(&resource)
+	return resource
+}
+
+func serviceToResource(service types.Service, region string, now time.Time) core.Resource {
+	arn := aws.ToString(service.ServiceArn)
+	if region == "" {
+		region = regionOf(arn)
+	}
+
+	deployments := make([]Deployment, 0, len(service.Deployments))
+	for _, d := range service.Deployments {
+		deployment := Deployment{
+			ID:             aws.ToString(d.Id),
+			Status:         strings.ToLower(aws.ToString(d.Status)),
+			Rollout:        strings.ToLower(string(d.RolloutState)),
+			Reason:         aws.ToString(d.RolloutStateReason),
+			TaskDefinition: definitionName(aws.ToString(d.TaskDefinition)),
+			Desired:        d.DesiredCount,
+			Running:        d.RunningCount,
+			Pending:        d.PendingCount,
+			Failed:         d.FailedTasks,
+		}
+		if d.UpdatedAt != nil {
+			deployment.UpdatedAt = *d.UpdatedAt
+		}
+		deployments = append(deployments, deployment)
+	}
+
+	events := make([]Event, 0, min(len(service.Events), maxEvents))
+	for _, e := range service.Events[:min(len(service.Events), maxEvents)] {
+		event := Event{Message: aws.ToString(e.Message)}
+		if e.CreatedAt != nil {
+			event.At = *e.CreatedAt
+		}
+		events = append(events, event)
+	}
+
+	launch := string(service.LaunchType)
+	if launch == "" && len(service.CapacityProviderStrategy) > 0 {
+		providers := make([]string, 0, len(service.CapacityProviderStrategy))
+		for _, p := range service.CapacityProviderStrategy {
+			providers = append(providers, aws.ToString(p.CapacityProvider))
+		}
+		launch = strings.Join(providers, ",")
+	}
+
+	resource := core.Resource{
+		ID:        arn,
+		Type:      "ecs:service",
+		Name:      aws.ToString(service.ServiceName),
+		ARN:       arn,
+		Region:    region,
+		State:     strings.ToLower(aws.ToString(service.Status)),
+		CreatedAt: service.CreatedAt,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"cluster":         clusterName(aws.ToString(service.ClusterArn)),
+			"desired":         int(service.DesiredCount),
+			"running":         int(service.RunningCount),
+			"pending":         int(service.PendingCount),
+			"task_definition": definitionName(aws.ToString(service.TaskDefinition)),
+			"launch_type":     launch,
+			"scheduling":      strings.ToLower(string(service.SchedulingStrategy)),
+			"exec_enabled":    service.EnableExecuteCommand,
+			"deployments":     deployments,
+			"events":          events,
+		},
+	}
+	for _, tag := range service.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	// The primary deployment is the one tasks are converging to
+	for _, d := range deployments {
+		if d.Status != "primary" {
+			continue
+		}
+		resource.Metadata["rollout"] = d.Rollout
+		if d.Rollout == "failed" {
+			resource.AddIssue(core.SeverityHigh, fmt.Sprintf("Deployment failed: %s", d.Reason))
+		}
+	}
+	if len(deployments) <= 1 && service.RunningCount < service.DesiredCount {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Running %d of %d desired tasks", service.RunningCount, service.DesiredCount))
+	}
+
+	iac.Apply(&resource)
+	if resource.CreatedAt != nil {
+		estimate.ApplyAge(&resource, now)
+	}
+	return resource
+}
+
+// serviceName returns the name of a service from its ARN.
+func serviceName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// definitionName returns the family and revision of a task definition from
+// its ARN, such as "web:42".
+func definitionName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
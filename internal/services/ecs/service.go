@@ -0,0 +1,625 @@
+// Package ecs provides Amazon ECS integration for the a9s application.
+// It lists clusters, their services and their running tasks, scales and
+// redeploys services, stops tasks and opens interactive shells in their
+// containers through ECS Exec.
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+const (
+	// describeBatch is the most tasks a DescribeTasks call accepts.
+	describeBatch = 100
+
+	// DefaultCommand is the command exec runs when none is given.
+	DefaultCommand = "/bin/sh"
+
+	// execAgent is the managed agent ECS Exec relies on.
+	execAgent = "ExecuteCommandAgent"
+)
+
+// List filters selecting the level of the hierarchy to list. Without a
+// cluster, List returns clusters; with one, its services, or its running
+// tasks when FilterKind is KindTasks, only those of FilterService if set.
+const (
+	FilterCluster = "cluster"
+	FilterService = "service"
+	FilterKind    = "kind"
+
+	KindTasks = "tasks"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements ECS operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient ECSAPI
+}
+
+// ECSAPI defines the ECS client interface for mocking.
+type ECSAPI interface {
+	ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error)
+	DescribeClusters(ctx context.Context, params *ecs.DescribeClustersInput, optFns ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error)
+	ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error)
+	DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error)
+	UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error)
+	ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error)
+	DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error)
+	ExecuteCommand(ctx context.Context, params *ecs.ExecuteCommandInput, optFns ...func(*ecs.Options)) (*ecs.ExecuteCommandOutput, error)
+	StopTask(ctx context.Context, params *ecs.StopTaskInput, optFns ...func(*ecs.Options)) (*ecs.StopTaskOutput, error)
+}
+
+// NewService creates a new ECS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client ECSAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the ECS client for the current AWS context.
+func (s *Service) client() ECSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return ecs.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region of the current AWS context.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "ecs"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "ECS Clusters"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "container"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListClusters(ctx, &ecs.ListClustersInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("ecs", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the clusters of the region, or the services or running tasks
+// of one, selected by the FilterCluster, FilterService and FilterKind
+// filters.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	cluster := opts.Filters[FilterCluster]
+	service := opts.Filters[FilterService]
+
+	var resources []core.Resource
+	var resourceType string
+	var err error
+	switch {
+	case cluster == "":
+		resourceType = "ecs:cluster"
+		resources, err = s.listClusters(ctx)
+	case opts.Filters[FilterKind] == KindTasks:
+		resourceType = "ecs:task"
+		var tasks []types.Task
+		tasks, err = s.runningTasks(ctx, cluster, service)
+		for _, task := range tasks {
+			resources = append(resources, taskToResource(task, s.region()))
+		}
+	default:
+		resourceType = "ecs:service"
+		resources, err = s.listServices(ctx, cluster)
+	}
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("ecs", "list", err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: resourceType,
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// runningTasks describes the running tasks of a cluster, or of one of its
+// services when service is set.
+func (s *Service) runningTasks(ctx context.Context, cluster, service string) ([]types.Task, error) {
+	client := s.client()
+
+	input := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: types.DesiredStatusRunning,
+	}
+	if service != "" {
+		input.ServiceName = aws.String(service)
+	}
+
+	var arns []string
+	paginator := ecs.NewListTasksPaginator(client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page.TaskArns...)
+	}
+
+	var tasks []types.Task
+	for start := 0; start < len(arns); start += describeBatch {
+		out, err := client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   arns[start:min(start+describeBatch, len(arns))],
+			Include: []types.TaskField{types.TaskFieldTags},
+		})
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, out.Tasks...)
+	}
+	return tasks, nil
+}
+
+// describeTask describes a single task by ARN.
+func (s *Service) describeTask(ctx context.Context, arn string) (types.Task, error) {
+	input := &ecs.DescribeTasksInput{Tasks: []string{arn}}
+	if cluster := clusterOf(arn); cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+	out, err := s.client().DescribeTasks(ctx, input)
+	if err != nil {
+		return types.Task{}, err
+	}
+	if len(out.Tasks) == 0 {
+		return types.Task{}, core.ErrResourceNotFound
+	}
+	return out.Tasks[0], nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for services and tasks.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "exec",
+			Description: "Open an interactive shell in a container",
+			Icon:        "terminal",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "access",
+			Parameters: []core.ActionParameter{
+				{Name: "container", Type: "string", Description: "Container to run the command in (empty for the task's only container)"},
+				{Name: "command", Type: "string", Default: DefaultCommand, Description: "Command to run"},
+			},
+		},
+		{
+			Name:        "stop_task",
+			Description: "Stop the task",
+			Icon:        "stop",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "scale",
+			Description: "Change the desired task count of the service",
+			Icon:        "scale",
+			Shortcut:    "S",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "desired", Type: "int", Required: true, Description: "Desired task count"},
+			},
+		},
+		{
+			Name:        "force_deploy",
+			Description: "Replace the service's tasks with a new deployment",
+			Icon:        "redo",
+			Shortcut:    "f",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a service or task, identified by its
+// ARN. Stopping, scaling and redeploying ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "exec":
+		container, _ := params["container"].(string)
+		command, _ := params["command"].(string)
+		if strings.TrimSpace(command) == "" {
+			command = DefaultCommand
+		}
+		result, err = s.exec(ctx, resourceID, strings.TrimSpace(container), command)
+	case "stop_task":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.stopTask(ctx, resourceID, params, confirmed)
+	case "scale":
+		desired, perr := intParam(params["desired"])
+		if perr != nil || desired < 0 {
+			return nil, core.NewValidationError("desired", params["desired"], "must be a task count of 0 or more")
+		}
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.scale(ctx, resourceID, desired, params, confirmed)
+	case "force_deploy":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.forceDeployment(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// exec starts an ECS Exec session in a container of a task. The session is
+// returned as the result's data, for the caller to attach to.
+func (s *Service) exec(ctx context.Context, arn, name, command string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("exec", arn, err)
+	}
+
+	task, err := s.describeTask(ctx, arn)
+	if err != nil {
+		return fail(err)
+	}
+	container, err := execContainer(task, name)
+	if err != nil {
+		return fail(err)
+	}
+
+	out, err := s.client().ExecuteCommand(ctx, &ecs.ExecuteCommandInput{
+		Cluster:     task.ClusterArn,
+		Task:        aws.String(arn),
+		Container:   container.Name,
+		Command:     aws.String(command),
+		Interactive: true,
+	})
+	if err != nil {
+		return fail(err)
+	}
+	if out.Session == nil {
+		return fail(fmt.Errorf("ECS returned no session"))
+	}
+
+	session := Session{
+		ID:        aws.ToString(out.Session.SessionId),
+		StreamURL: aws.ToString(out.Session.StreamUrl),
+		Token:     aws.ToString(out.Session.TokenValue),
+		Region:    s.region(),
+		Cluster:   clusterName(aws.ToString(task.ClusterArn)),
+		Task:      taskID(arn),
+		Container: aws.ToString(container.Name),
+		RuntimeID: aws.ToString(container.RuntimeId),
+		Command:   command,
+	}
+	if session.Region == "" {
+		session.Region = regionOf(arn)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Running %s in %s of task %s", command, session.Container, session.Task))
+	result.Data = session
+	return result, nil
+}
+
+// stopTask stops a task once confirmed. Tasks of a service are replaced by
+// the service.
+func (s *Service) stopTask(ctx context.Context, arn string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("stop_task", arn, err)
+	}
+
+	task, err := s.describeTask(ctx, arn)
+	if err != nil {
+		return fail(err)
+	}
+	service, inService := strings.CutPrefix(aws.ToString(task.Group), "service:")
+
+	if !confirmed {
+		reason := fmt.Sprintf("Stops task %s; it belongs to no service and will not be replaced", taskID(arn))
+		if inService {
+			reason = fmt.Sprintf("Stops task %s; service %s starts a replacement", taskID(arn), service)
+		}
+		return nil, core.NewConfirmationError(s, "stop_task", arn, params, reason, false)
+	}
+
+	_, err = s.client().StopTask(ctx, &ecs.StopTaskInput{
+		Cluster: task.ClusterArn,
+		Task:    aws.String(arn),
+		Reason:  aws.String("Stopped from a9s"),
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Stopping task %s", taskID(arn))), nil
+}
+
+// execContainer picks the container of a task to run a command in, checking
+// that ECS Exec can reach it.
+func execContainer(task types.Task, name string) (types.Container, error) {
+	if !task.EnableExecuteCommand {
+		return types.Container{}, core.NewValidationError("task", taskID(aws.ToString(task.TaskArn)), "does not have ECS Exec enabled; redeploy it with enableExecuteCommand")
+	}
+	if status := aws.ToString(task.LastStatus); status != "RUNNING" {
+		return types.Container{}, core.NewValidationError("task", taskID(aws.ToString(task.TaskArn)), "is "+strings.ToLower(status))
+	}
+
+	var container *types.Container
+	switch {
+	case name != "":
+		for i := range task.Containers {
+			if aws.ToString(task.Containers[i].Name) == name {
+				container = &task.Containers[i]
+			}
+		}
+		if container == nil {
+			return types.Container{}, core.NewValidationError("container", name, "is not a container of the task")
+		}
+	case len(task.Containers) == 1:
+		container = &task.Containers[0]
+	default:
+		return types.Container{}, core.NewValidationError("container", "", "is required: the task has several containers")
+	}
+
+	if status := agentStatus(*container); status != "RUNNING" {
+		return types.Container{}, core.NewValidationError("container", aws.ToString(container.Name), fmt.Sprintf("has its exec agent %s", strings.ToLower(orUnknown(status))))
+	}
+	return *container, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// Container is a container of a listed task.
+type Container struct {
+	Name   string
+	Image  string
+	Status string
+	Agent  string // Status of the exec agent, empty when exec is disabled
+}
+
+func taskToResource(task types.Task, region string) core.Resource {
+	arn := aws.ToString(task.TaskArn)
+	definition := definitionName(aws.ToString(task.TaskDefinitionArn))
+
+	containers := make([]Container, 0, len(task.Containers))
+	for _, c := range task.Containers {
+		containers = append(containers, Container{
+			Name:   aws.ToString(c.Name),
+			Image:  aws.ToString(c.Image),
+			Status: aws.ToString(c.LastStatus),
+			Agent:  agentStatus(c),
+		})
+	}
+
+	if region == "" {
+		region = regionOf(arn)
+	}
+	resource := core.Resource{
+		ID:     arn,
+		Type:   "ecs:task",
+		Name:   taskID(arn),
+		ARN:    arn,
+		Region: region,
+		State:  strings.ToLower(aws.ToString(task.LastStatus)),
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"cluster":         clusterName(aws.ToString(task.ClusterArn)),
+			"group":           aws.ToString(task.Group),
+			"service":         strings.TrimPrefix(aws.ToString(task.Group), "service:"),
+			"task_definition": definition,
+			"launch_type":     string(task.LaunchType),
+			"cpu":             aws.ToString(task.Cpu),
+			"memory":          aws.ToString(task.Memory),
+			"az":              aws.ToString(task.AvailabilityZone),
+			"exec_enabled":    task.EnableExecuteCommand,
+			"containers":      containers,
+		},
+	}
+	for _, tag := range task.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	if task.StartedAt != nil {
+		resource.CreatedAt = task.StartedAt
+	} else {
+		resource.CreatedAt = task.CreatedAt
+	}
+
+	if task.EnableExecuteCommand {
+		for _, c := range containers {
+			if c.Status == "RUNNING" && c.Agent != "RUNNING" {
+				resource.AddIssue(core.SeverityLow, fmt.Sprintf("Exec agent of %s is %s: shells cannot be opened in it", c.Name, strings.ToLower(orUnknown(c.Agent))))
+			}
+		}
+	}
+
+	iac.Apply(&resource)
+	if resource.CreatedAt != nil {
+		estimate.ApplyAge(&resource, time.Now())
+	}
+	return resource
+}
+
+// agentStatus returns the status of a container's exec agent.
+func agentStatus(c types.Container) string {
+	for _, agent := range c.ManagedAgents {
+		if agent.Name == execAgent {
+			return aws.ToString(agent.LastStatus)
+		}
+	}
+	return ""
+}
+
+// taskID returns the ID of a task from its ARN.
+func taskID(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// clusterOf returns the cluster name of a task from its ARN, of the form
+// arn:aws:ecs:region:account:task/cluster/id. Tasks with ARNs of the older
+// format, without it, belong to the default cluster.
+func clusterOf(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+// clusterName returns the name of a cluster from its ARN.
+func clusterName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// regionOf returns the region of an ARN.
+func regionOf(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[3]
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "UNKNOWN"
+	}
+	return s
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "ecs", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "ecs", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,249 @@
+package ecs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	clusterARN = "arn:aws:ecs:us-east-1:123456789012:cluster/prod"
+	webARN     = "arn:aws:ecs:us-east-1:123456789012:service/prod/web"
+	workerARN  = "arn:aws:ecs:us-east-1:123456789012:service/prod/worker"
+	taskARN    = "arn:aws:ecs:us-east-1:123456789012:task/prod/0123456789abcdef"
+)
+
+// fakeECS serves the prod cluster with the web service, running 2 of its 3
+// tasks, and the worker service, whose last deployment failed, along with
+// a running task of web whose sidecar lost its exec agent. It fails every
+// call when err is set and records the service updates.
+type fakeECS struct {
+	err     error
+	updates []*ecs.UpdateServiceInput
+}
+
+func (f *fakeECS) services() []types.Service {
+	return []types.Service{
+		{
+			ServiceArn:     aws.String(webARN),
+			ServiceName:    aws.String("web"),
+			ClusterArn:     aws.String(clusterARN),
+			Status:         aws.String("ACTIVE"),
+			DesiredCount:   3,
+			RunningCount:   2,
+			TaskDefinition: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"),
+			Deployments: []types.Deployment{
+				{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: types.DeploymentRolloutStateCompleted},
+			},
+		},
+		{
+			ServiceArn:   aws.String(workerARN),
+			ServiceName:  aws.String("worker"),
+			ClusterArn:   aws.String(clusterARN),
+			Status:       aws.String("ACTIVE"),
+			DesiredCount: 1,
+			RunningCount: 1,
+			Deployments: []types.Deployment{
+				{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: types.DeploymentRolloutStateFailed,
+					RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start.")},
+				{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), RolloutState: types.DeploymentRolloutStateCompleted},
+			},
+		},
+	}
+}
+
+func (f *fakeECS) task() types.Task {
+	return types.Task{
+		TaskArn:              aws.String(taskARN),
+		ClusterArn:           aws.String(clusterARN),
+		Group:                aws.String("service:web"),
+		LastStatus:           aws.String("RUNNING"),
+		EnableExecuteCommand: true,
+		Containers: []types.Container{
+			{Name: aws.String("app"), LastStatus: aws.String("RUNNING"), RuntimeId: aws.String("0123-app"),
+				ManagedAgents: []types.ManagedAgent{{Name: execAgent, LastStatus: aws.String("RUNNING")}}},
+			{Name: aws.String("sidecar"), LastStatus: aws.String("RUNNING"),
+				ManagedAgents: []types.ManagedAgent{{Name: execAgent, LastStatus: aws.String("STOPPED")}}},
+		},
+	}
+}
+
+func (f *fakeECS) ListClusters(context.Context, *ecs.ListClustersInput, ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.ListClustersOutput{ClusterArns: []string{clusterARN}}, nil
+}
+
+func (f *fakeECS) DescribeClusters(context.Context, *ecs.DescribeClustersInput, ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.DescribeClustersOutput{Clusters: []types.Cluster{{
+		ClusterArn:          aws.String(clusterARN),
+		ClusterName:         aws.String("prod"),
+		Status:              aws.String("ACTIVE"),
+		ActiveServicesCount: 2,
+		RunningTasksCount:   3,
+	}}}, nil
+}
+
+func (f *fakeECS) ListServices(context.Context, *ecs.ListServicesInput, ...func(*ecs.Options)) (*ecs.ListServicesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.ListServicesOutput{ServiceArns: []string{webARN, workerARN}}, nil
+}
+
+func (f *fakeECS) DescribeServices(_ context.Context, in *ecs.DescribeServicesInput, _ ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := &ecs.DescribeServicesOutput{}
+	for _, service := range f.services() {
+		if slices.Contains(in.Services, aws.ToString(service.ServiceArn)) {
+			out.Services = append(out.Services, service)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeECS) UpdateService(_ context.Context, in *ecs.UpdateServiceInput, _ ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.updates = append(f.updates, in)
+	return &ecs.UpdateServiceOutput{}, nil
+}
+
+func (f *fakeECS) ListTasks(context.Context, *ecs.ListTasksInput, ...func(*ecs.Options)) (*ecs.ListTasksOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.ListTasksOutput{TaskArns: []string{taskARN}}, nil
+}
+
+func (f *fakeECS) DescribeTasks(_ context.Context, in *ecs.DescribeTasksInput, _ ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if !slices.Contains(in.Tasks, taskARN) {
+		return &ecs.DescribeTasksOutput{}, nil
+	}
+	return &ecs.DescribeTasksOutput{Tasks: []types.Task{f.task()}}, nil
+}
+
+func (f *fakeECS) ExecuteCommand(context.Context, *ecs.ExecuteCommandInput, ...func(*ecs.Options)) (*ecs.ExecuteCommandOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.ExecuteCommandOutput{Session: &types.Session{SessionId: aws.String("ecs-execute-command-1")}}, nil
+}
+
+func (f *fakeECS) StopTask(context.Context, *ecs.StopTaskInput, ...func(*ecs.Options)) (*ecs.StopTaskOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ecs.StopTaskOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against the
+// services of a cluster, which the actions apply to.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeECS{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeECS{err: errors.New("AccessDeniedException")}, d)
+		},
+		ListOptions:   core.ListOptions{Filters: map[string]string{FilterCluster: "prod"}},
+		ExistingID:    webARN,
+		Action:        "scale",
+		ActionParams:  map[string]any{"desired": "4", core.ParamConfirm: true},
+		ConfirmAction: "force_deploy",
+	})
+}
+
+func TestListServicesFlagsRollouts(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeECS{}, nil).List(context.Background(), core.ListOptions{
+		Filters: map[string]string{FilterCluster: "prod"},
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := map[string]string{
+		"web":    "Running 2 of 3 desired tasks",
+		"worker": "Deployment failed: ECS deployment circuit breaker: tasks failed to start.",
+	}
+	for _, r := range resources {
+		if issues := r.Issues(); len(issues) != 1 || issues[0].Message != want[r.Name] {
+			t.Errorf("%s issues = %v, want %q", r.Name, issues, want[r.Name])
+		}
+	}
+	if definition := resources[0].Metadata["task_definition"]; definition != "web:42" {
+		t.Errorf("web task definition = %v", definition)
+	}
+}
+
+func TestExecContainer(t *testing.T) {
+	task := (&fakeECS{}).task()
+	disabled := task
+	disabled.EnableExecuteCommand = false
+
+	tests := []struct {
+		name      string
+		task      types.Task
+		container string
+		want      string // Error, or the container picked
+	}{
+		{"named", task, "app", "app"},
+		{"ambiguous", task, "", "is required"},
+		{"agent stopped", task, "sidecar", "exec agent stopped"},
+		{"unknown", task, "db", "not a container"},
+		{"exec disabled", disabled, "app", "ECS Exec enabled"},
+	}
+	for _, tt := range tests {
+		container, err := execContainer(tt.task, tt.container)
+		if err != nil {
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("%s: execContainer() error = %v, want %q", tt.name, err, tt.want)
+			}
+			continue
+		}
+		if aws.ToString(container.Name) != tt.want {
+			t.Errorf("%s: execContainer() = %s, want %s", tt.name, aws.ToString(container.Name), tt.want)
+		}
+	}
+}
+
+// TestStopTaskNamesItsService checks that stopping a task of a service
+// warns it is replaced, and that the cluster is read from the task ARN.
+func TestStopTaskNamesItsService(t *testing.T) {
+	svc := NewServiceWithClient(&fakeECS{}, nil)
+
+	_, err := svc.Execute(context.Background(), "stop_task", taskARN, nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "service web starts a replacement") {
+		t.Fatalf("unconfirmed stop_task error = %v", err)
+	}
+
+	for arn, want := range map[string]string{
+		taskARN: "prod",
+		"arn:aws:ecs:us-east-1:123456789012:task/0123456789abcdef": "",
+	} {
+		if got := clusterOf(arn); got != want {
+			t.Errorf("clusterOf(%q) = %q, want %q", arn, got, want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+)
+
+// =============================================================================
+// Exec Sessions
+// =============================================================================
+
+// PluginName is the Session Manager plugin that attaches to ECS Exec
+// sessions, as the AWS CLI does.
+const PluginName = "session-manager-plugin"
+
+// Session is an ECS Exec session started by the exec action.
+type Session struct {
+	ID        string
+	StreamURL string
+	Token     string
+	Region    string
+
+	Cluster   string
+	Task      string
+	Container string
+	RuntimeID string
+	Command   string
+}
+
+// PluginInstalled reports whether the Session Manager plugin is on the PATH.
+func PluginInstalled() bool {
+	_, err := exec.LookPath(PluginName)
+	return err == nil
+}
+
+// Cmd returns the command attaching the terminal to the session, passing
+// the plugin the same arguments as `aws ecs execute-command`.
+func (s Session) Cmd() *exec.Cmd {
+	session, _ := json.Marshal(map[string]string{
+		"sessionId":  s.ID,
+		"streamUrl":  s.StreamURL,
+		"tokenValue": s.Token,
+	})
+	target, _ := json.Marshal(map[string]string{
+		"Target": fmt.Sprintf("ecs:%s_%s_%s", s.Cluster, s.Task, s.RuntimeID),
+	})
+	endpoint := fmt.Sprintf("https://ssm.%s.amazonaws.com", s.Region)
+	return exec.Command(PluginName, string(session), s.Region, "StartSession", "", string(target), endpoint)
+}
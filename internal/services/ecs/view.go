@@ -0,0 +1,659 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	execFormID  = "ecs:exec"
+	scaleFormID = "ecs:scale"
+)
+
+// Levels of the view's hierarchy.
+const (
+	levelClusters = "clusters"
+	levelServices = "services"
+	levelTasks    = "tasks"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for ECS clusters, drilling down into their
+// services and tasks.
+type View struct {
+	*base.TableView
+
+	formTarget string // Service or task the open form is for
+}
+
+// NewView creates a new ECS view.
+func NewView() *View {
+	return &View{
+		TableView: base.NewTableView("ECS", "", "ecs", clusterColumns()),
+	}
+}
+
+func clusterColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Cluster"), MinWidth: 12, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Services"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Running"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Pending"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Instances"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Capacity"), MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+		{Title: i18n.T("Insights"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+}
+
+func serviceColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Service"), MinWidth: 12, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Tasks"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Pending"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Definition"), MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("Launch"), MinWidth: 8, MaxWidth: 20, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Rollout"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Exec"), MinWidth: 5, MaxWidth: 5, Weight: 0.1, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+}
+
+func taskColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Task"), MinWidth: 12, MaxWidth: 34, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Cluster"), MinWidth: 10, MaxWidth: 30, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("Service"), MinWidth: 10, MaxWidth: 40, Weight: 1.2, Priority: 1},
+		{Title: i18n.T("Definition"), MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 2},
+		{Title: i18n.T("Containers"), MinWidth: 10, MaxWidth: 50, Weight: 1.2, Priority: 2},
+		{Title: i18n.T("Launch"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Exec"), MinWidth: 5, MaxWidth: 5, Weight: 0.1, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadResources()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if cmd, handled := v.handleKey(msg); handled {
+			return v, cmd
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != execFormID && msg.ID != scaleFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		if msg.ID == scaleFormID {
+			v.Message = i18n.T("Scaling %s...", serviceName(v.formTarget))
+			cmds = append(cmds, v.executeAction("scale", v.formTarget, msg.Values))
+			break
+		}
+		cmds = append(cmds, v.startExec(v.formTarget, msg.Values))
+
+	case resourcesLoadedMsg:
+		// Listings of a level the operator has left are dropped
+		if msg.owner != v || msg.level != v.Breadcrumb() {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			switch v.level() {
+			case levelClusters:
+				v.Message = i18n.T("Loaded %d clusters", len(msg.resources))
+			case levelServices:
+				v.Message = i18n.T("Loaded %d services", len(msg.resources))
+			default:
+				v.Message = i18n.T("Loaded %d tasks", len(msg.resources))
+			}
+		}
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case execDoneMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		if msg.err != nil {
+			v.Message = i18n.T("Session in %s ended: %v", msg.container, msg.err)
+		} else {
+			v.Message = i18n.T("Session in %s ended", msg.container)
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		loading := i18n.T("Loading clusters...")
+		if crumb := v.Breadcrumb(); crumb != "" {
+			loading = i18n.T("Loading %s...", crumb)
+		}
+		lines = append(lines, v.Styles.Muted.Render(loading))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	var help string
+	switch v.level() {
+	case levelClusters:
+		help = i18n.T("[Enter]services  [t]asks  [r]efresh")
+	case levelServices:
+		help = i18n.T("[Enter]tasks  [S]cale  [f]orce deployment  [i]nfo  [Esc]back  [r]efresh")
+	default:
+		help = i18n.T("[s]hell  [e]xec command  [x] stop  [Enter]details  [Esc]back  [r]efresh")
+	}
+	lines = append(lines, v.Styles.Help.Render(help))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the current level.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadResources()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type resourcesLoadedMsg struct {
+	owner     *View  // Listings of a swapped-out view are dropped
+	level     string // Breadcrumb of the level listed
+	resources []core.Resource
+	err       error
+}
+
+// execDoneMsg reports the end of a shell session.
+type execDoneMsg struct {
+	owner     *View
+	container string
+	err       error
+}
+
+// level returns the level of the hierarchy shown.
+func (v *View) level() string {
+	filters := v.DrillFilters()
+	switch {
+	case filters[FilterCluster] == "":
+		return levelClusters
+	case filters[FilterKind] == KindTasks:
+		return levelTasks
+	}
+	return levelServices
+}
+
+// handleKey handles the keys of the current level.
+func (v *View) handleKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	row := v.GetSelectedResource()
+	if row == nil {
+		return nil, false
+	}
+
+	switch v.level() + " " + msg.String() {
+	case levelClusters + " enter":
+		return v.drillDown(row.Name, map[string]string{FilterCluster: row.ID}, serviceColumns()), true
+	case levelClusters + " t":
+		return v.drillDown(i18n.T("%s tasks", row.Name), map[string]string{FilterCluster: row.ID, FilterKind: KindTasks}, taskColumns()), true
+	case levelServices + " enter":
+		filters := v.DrillFilters()
+		filters[FilterService] = row.Name
+		filters[FilterKind] = KindTasks
+		return v.drillDown(row.Name, filters, taskColumns()), true
+	case levelServices + " S":
+		return v.openScaleForm(row), true
+	case levelServices + " f":
+		v.Message = i18n.T("Redeploying %s...", row.Name)
+		return v.executeAction("force_deploy", row.ID, nil), true
+	case levelServices + " i":
+		v.OpenDetail(i18n.T("Service %s", row.Name), formatService(row))
+		return nil, true
+	case levelTasks + " s":
+		return v.shell(row, false), true
+	case levelTasks + " e":
+		return v.shell(row, true), true
+	case levelTasks + " x":
+		v.Message = i18n.T("Stopping task %s...", row.Name)
+		return v.executeAction("stop_task", row.ID, nil), true
+	case levelTasks + " enter":
+		v.OpenDetail(i18n.T("Task %s", row.Name), formatTask(row))
+		return nil, true
+	}
+	return nil, false
+}
+
+// drillDown lists a deeper level; Esc comes back, see base.TableView.
+func (v *View) drillDown(title string, filters map[string]string, columns []base.ColumnDef) tea.Cmd {
+	v.DrillDown(base.DrillLevel{Title: title, Filters: filters, ColumnDefs: columns})
+	return v.loadResources()
+}
+
+func (v *View) loadResources() tea.Cmd {
+	v.SetLoading(true)
+	level, filters := v.Breadcrumb(), v.DrillFilters()
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{Filters: filters})
+		return resourcesLoadedMsg{owner: v, level: level, resources: resources, err: err}
+	}
+}
+
+// openScaleForm asks for the desired task count of a service.
+func (v *View) openScaleForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "scale")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "scale")
+		return nil
+	}
+	params := append([]core.ActionParameter(nil), def.Parameters...)
+	for i := range params {
+		if params[i].Name == "desired" {
+			params[i].Default = r.Metadata["desired"]
+		}
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(scaleFormID, i18n.T("Scale %s", r.Name), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// shell opens a shell in the task's only container right away, like
+// k9s does for pods, or asks for the container and command first.
+func (v *View) shell(r *core.Resource, askCommand bool) tea.Cmd {
+	if !PluginInstalled() {
+		v.Message = i18n.T("%s is not installed; it is needed to attach to ECS Exec sessions", PluginName)
+		return nil
+	}
+	if enabled, _ := r.Metadata["exec_enabled"].(bool); !enabled {
+		v.Message = i18n.T("ECS Exec is not enabled on task %s", r.Name)
+		return nil
+	}
+
+	containers, _ := r.Metadata["containers"].([]Container)
+	if len(containers) == 1 && !askCommand {
+		return v.startExec(r.ID, map[string]any{"container": containers[0].Name})
+	}
+
+	def, ok := base.FindAction(v.Service(), "exec")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "exec")
+		return nil
+	}
+	params := append([]core.ActionParameter(nil), def.Parameters...)
+	for i := range params {
+		if params[i].Name == "container" && len(containers) > 0 {
+			params[i].Type = "select"
+			params[i].Options = nil
+			for _, c := range containers {
+				params[i].Options = append(params[i].Options, c.Name)
+			}
+			params[i].Default = containers[0].Name
+		}
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(execFormID, i18n.T("Exec in %s", r.Name), params))
+}
+
+// startExec starts a session; handleResult attaches the terminal to it.
+func (v *View) startExec(task string, params map[string]any) tea.Cmd {
+	v.Message = i18n.T("Starting a session in %s...", taskID(task))
+	return v.executeAction("exec", task, params)
+}
+
+// handleResult hands the terminal over to a started session until it ends,
+// and lists the level again after other actions.
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	session, ok := msg.Result.Data.(Session)
+	if !ok {
+		return v.loadResources()
+	}
+	return tea.ExecProcess(session.Cmd(), func(err error) tea.Msg {
+		return execDoneMsg{owner: v, container: session.Container, err: err}
+	})
+}
+
+func (v *View) updateTable() {
+	buildRow := buildTaskRow
+	switch v.level() {
+	case levelClusters:
+		buildRow = buildClusterRow
+	case levelServices:
+		buildRow = buildServiceRow
+	}
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildClusterRow(r core.Resource) base.Row {
+	services, _ := r.Metadata["services"].(int)
+	running, _ := r.Metadata["running"].(int)
+	pending, _ := r.Metadata["pending"].(int)
+	instances, _ := r.Metadata["instances"].(int)
+	providers, _ := r.Metadata["capacity_providers"].([]string)
+	return base.Row{
+		base.TextCell(r.Name),
+		base.TextCell(base.FormatState(r.State)),
+		base.LazyCell(services, func() string { return fmt.Sprintf("%d", services) }),
+		base.LazyCell(running, func() string { return fmt.Sprintf("%d", running) }),
+		base.LazyCell(pending, func() string { return fmt.Sprintf("%d", pending) }),
+		base.LazyCell(instances, func() string { return fmt.Sprintf("%d", instances) }),
+		base.TextCell(strings.Join(providers, ", ")),
+		base.TextCell(r.GetMetadataString("container_insights")),
+		base.SeverityCell(r),
+	}
+}
+
+func buildServiceRow(r core.Resource) base.Row {
+	desired, _ := r.Metadata["desired"].(int)
+	running, _ := r.Metadata["running"].(int)
+	pending, _ := r.Metadata["pending"].(int)
+	exec := "-"
+	if enabled, _ := r.Metadata["exec_enabled"].(bool); enabled {
+		exec = "✓"
+	}
+	rollout := r.GetMetadataString("rollout")
+	if rollout == "" {
+		rollout = "-"
+	}
+	return base.Row{
+		base.TextCell(r.Name),
+		base.TextCell(base.FormatState(r.State)),
+		base.LazyCell(running, func() string { return fmt.Sprintf("%d/%d", running, desired) }),
+		base.LazyCell(pending, func() string { return fmt.Sprintf("%d", pending) }),
+		base.TextCell(r.GetMetadataString("task_definition")),
+		base.TextCell(r.GetMetadataString("launch_type")),
+		base.TextCell(rollout),
+		base.TextCell(exec),
+		base.SeverityCell(r),
+		base.AgeCell(r),
+	}
+}
+
+func buildTaskRow(r core.Resource) base.Row {
+	exec := "-"
+	if enabled, _ := r.Metadata["exec_enabled"].(bool); enabled {
+		exec = "✓"
+	}
+	return base.Row{
+		base.TextCell(r.Name),
+		base.TextCell(r.GetMetadataString("cluster")),
+		base.TextCell(r.GetMetadataString("service")),
+		base.TextCell(r.GetMetadataString("task_definition")),
+		base.TextCell(containerNames(r)),
+		base.TextCell(r.GetMetadataString("launch_type")),
+		base.TextCell(exec),
+		base.SeverityCell(r),
+		base.AgeCell(r),
+	}
+}
+
+func containerNames(r core.Resource) string {
+	containers, _ := r.Metadata["containers"].([]Container)
+	names := make([]string, 0, len(containers))
+	for _, c := range containers {
+		names = append(names, c.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatService renders a service's deployments and latest events for the
+// detail panel.
+func formatService(r *core.Resource) string {
+	var b strings.Builder
+	desired, _ := r.Metadata["desired"].(int)
+	running, _ := r.Metadata["running"].(int)
+	pending, _ := r.Metadata["pending"].(int)
+	fmt.Fprintf(&b, "ARN:        %s\n", r.ARN)
+	fmt.Fprintf(&b, "Cluster:    %s\n", r.GetMetadataString("cluster"))
+	fmt.Fprintf(&b, "Definition: %s\n", r.GetMetadataString("task_definition"))
+	fmt.Fprintf(&b, "Launch:     %s, %s scheduling\n", r.GetMetadataString("launch_type"), r.GetMetadataString("scheduling"))
+	fmt.Fprintf(&b, "Tasks:      %d running, %d pending, %d desired\n", running, pending, desired)
+
+	b.WriteString(i18n.T("\nDeployments:\n"))
+	deployments, _ := r.Metadata["deployments"].([]Deployment)
+	for _, d := range deployments {
+		fmt.Fprintf(&b, "  %s  %s  %s  %d/%d running", d.Status, d.TaskDefinition, orDash(d.Rollout), d.Running, d.Desired)
+		if d.Pending > 0 {
+			fmt.Fprintf(&b, ", %d pending", d.Pending)
+		}
+		if d.Failed > 0 {
+			fmt.Fprintf(&b, ", %d failed", d.Failed)
+		}
+		if !d.UpdatedAt.IsZero() {
+			fmt.Fprintf(&b, "  (%s)", d.UpdatedAt.Local().Format("2006-01-02 15:04"))
+		}
+		b.WriteString("\n")
+		if d.Reason != "" {
+			fmt.Fprintf(&b, "    %s\n", d.Reason)
+		}
+	}
+
+	if events, _ := r.Metadata["events"].([]Event); len(events) > 0 {
+		b.WriteString(i18n.T("\nEvents:\n"))
+		for _, e := range events {
+			fmt.Fprintf(&b, "  %s  %s\n", e.At.Local().Format("01-02 15:04"), e.Message)
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatTask renders a task's placement and containers for the detail panel.
+func formatTask(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:        %s\n", r.ARN)
+	fmt.Fprintf(&b, "Cluster:    %s\n", r.GetMetadataString("cluster"))
+	fmt.Fprintf(&b, "Group:      %s\n", r.GetMetadataString("group"))
+	fmt.Fprintf(&b, "Definition: %s\n", r.GetMetadataString("task_definition"))
+	fmt.Fprintf(&b, "Launch:     %s in %s\n", r.GetMetadataString("launch_type"), r.GetMetadataString("az"))
+	fmt.Fprintf(&b, "Size:       %s CPU units, %s MiB\n", r.GetMetadataString("cpu"), r.GetMetadataString("memory"))
+
+	b.WriteString(i18n.T("\nContainers:\n"))
+	containers, _ := r.Metadata["containers"].([]Container)
+	for _, c := range containers {
+		fmt.Fprintf(&b, "  %s  %s  %s\n", c.Name, strings.ToLower(c.Status), c.Image)
+		if c.Agent != "" {
+			fmt.Fprintf(&b, "    exec agent: %s\n", strings.ToLower(c.Agent))
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider. Widgets of the other
+// levels stay empty, and are skipped.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	var services, running, deploying, degraded, exec string
+	switch v.level() {
+	case levelClusters:
+		serviceCount, runningCount := 0, 0
+		for _, r := range v.Resources {
+			n, _ := r.Metadata["services"].(int)
+			serviceCount += n
+			n, _ = r.Metadata["running"].(int)
+			runningCount += n
+		}
+		services = i18n.T("Services: %d", serviceCount)
+		running = i18n.T("Running tasks: %d", runningCount)
+	case levelServices:
+		deployingCount, degradedCount := 0, 0
+		for _, r := range v.Resources {
+			if r.GetMetadataString("rollout") == "in_progress" {
+				deployingCount++
+			}
+			if r.Severity() != core.SeverityNone {
+				degradedCount++
+			}
+		}
+		deploying = i18n.T("Deploying: %d", deployingCount)
+		degraded = i18n.T("Degraded: %d", degradedCount)
+	default:
+		execEnabled := 0
+		for _, r := range v.Resources {
+			if enabled, _ := r.Metadata["exec_enabled"].(bool); enabled {
+				execEnabled++
+			}
+		}
+		exec = i18n.T("Exec enabled: %d", execEnabled)
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "services", Text: services, Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "running", Text: running, Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "deploying", Text: deploying, Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "degraded", Text: degraded, Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "exec", Text: exec, Tone: core.ToneInfo},
+	)
+}
+
+func (v *View) renderSummary() string {
+	title := i18n.T("ECS Clusters")
+	if crumb := v.Breadcrumb(); crumb != "" {
+		title = "ECS › " + crumb
+	}
+	return v.RenderSummary(title, v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "ecs" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
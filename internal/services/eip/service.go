@@ -0,0 +1,361 @@
+// Package eip provides Elastic IP inventory for the a9s application. It
+// shows what each address is associated with and flags unassociated
+// addresses, which are billed while serving nothing, as cleanup candidates.
+package eip
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// pricePerHour is the price of a public IPv4 address (us-east-1), charged
+// whether or not it is associated.
+const pricePerHour = 0.005
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements Elastic IP operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
+	DisassociateAddress(ctx context.Context, params *ec2.DisassociateAddressInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateAddressOutput, error)
+	ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddressInput, optFns ...func(*ec2.Options)) (*ec2.ReleaseAddressOutput, error)
+}
+
+// NewService creates a new Elastic IP service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "eip"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Elastic IPs"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return core.NewServiceError("eip", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the Elastic IPs of the region.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	// DescribeAddresses is not paginated: it returns every address
+	out, err := s.client().DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("eip", "list", err)
+	}
+
+	resources := make([]core.Resource, 0, len(out.Addresses))
+	for _, addr := range out.Addresses {
+		resources = append(resources, addressToResource(addr))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:elastic-ip",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for Elastic IPs.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "disassociate",
+			Description: "Disassociate the address from its instance or interface",
+			Icon:        "unlink",
+			Shortcut:    "t",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "release",
+			Description: "Release an unassociated address back to AWS",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on an Elastic IP, identified by its
+// allocation ID. Both actions ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "disassociate":
+		if confirmed, _ := params[core.ParamConfirm].(bool); !confirmed {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Traffic to the address stops reaching its instance or interface", false)
+		}
+		result, err = s.disassociateAddress(ctx, resourceID)
+	case "release":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "A released address may never be allocated to you again", true)
+		}
+		result, err = s.releaseAddress(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) disassociateAddress(ctx context.Context, allocationID string) (*core.ActionResult, error) {
+	addr, err := s.getAddress(ctx, allocationID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("disassociate", allocationID, err)
+	}
+	if addr.AssociationId == nil {
+		err = core.NewValidationError("address", aws.ToString(addr.PublicIp), "is not associated")
+		return core.NewActionResult(false, err.Error()), core.NewActionError("disassociate", allocationID, err)
+	}
+
+	_, err = s.client().DisassociateAddress(ctx, &ec2.DisassociateAddressInput{
+		AssociationId: addr.AssociationId,
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("disassociate", allocationID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Address %s disassociated from %s", aws.ToString(addr.PublicIp), associatedWith(addr))), nil
+}
+
+func (s *Service) releaseAddress(ctx context.Context, allocationID string) (*core.ActionResult, error) {
+	addr, err := s.getAddress(ctx, allocationID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("release", allocationID, err)
+	}
+	if addr.AssociationId != nil {
+		err = core.NewValidationError("address", aws.ToString(addr.PublicIp), fmt.Sprintf("is associated with %s; disassociate it first", associatedWith(addr)))
+		return core.NewActionResult(false, err.Error()), core.NewActionError("release", allocationID, err)
+	}
+
+	_, err = s.client().ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
+		AllocationId:       addr.AllocationId,
+		NetworkBorderGroup: addr.NetworkBorderGroup,
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("release", allocationID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Address %s released", aws.ToString(addr.PublicIp))), nil
+}
+
+func (s *Service) getAddress(ctx context.Context, allocationID string) (types.Address, error) {
+	out, err := s.client().DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		AllocationIds: []string{allocationID},
+	})
+	if err != nil {
+		return types.Address{}, err
+	}
+	if len(out.Addresses) == 0 {
+		return types.Address{}, fmt.Errorf("%w: %s", core.ErrResourceNotFound, allocationID)
+	}
+	return out.Addresses[0], nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func addressToResource(addr types.Address) core.Resource {
+	publicIP := aws.ToString(addr.PublicIp)
+	associated := addr.AssociationId != nil
+
+	resource := core.Resource{
+		ID:    aws.ToString(addr.AllocationId),
+		Type:  "ec2:elastic-ip",
+		Name:  publicIP,
+		State: "unassociated",
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"public_ip":            publicIP,
+			"allocation_id":        aws.ToString(addr.AllocationId),
+			"domain":               string(addr.Domain),
+			"network_border_group": aws.ToString(addr.NetworkBorderGroup),
+			"public_ipv4_pool":     aws.ToString(addr.PublicIpv4Pool),
+			"associated":           associated,
+			"should_cleanup":       !associated,
+		},
+	}
+	if associated {
+		resource.State = "associated"
+		resource.Metadata["association_id"] = aws.ToString(addr.AssociationId)
+		resource.Metadata["associated_with"] = associatedWith(addr)
+		resource.Metadata["instance_id"] = aws.ToString(addr.InstanceId)
+		resource.Metadata["network_interface_id"] = aws.ToString(addr.NetworkInterfaceId)
+		resource.Metadata["private_ip"] = aws.ToString(addr.PrivateIpAddress)
+	}
+	if resource.ID == "" {
+		resource.ID = publicIP
+	}
+
+	for _, tag := range addr.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" {
+			resource.Name = value
+		}
+	}
+
+	monthly := estimate.Monthly(pricePerHour)
+	estimate.ApplyCost(&resource, monthly)
+	if !associated {
+		resource.Metadata["cleanup_reason"] = "unassociated"
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Unassociated: billed %s/mo while serving nothing", estimate.FormatCost(monthly)))
+	}
+
+	iac.Apply(&resource)
+
+	return resource
+}
+
+// associatedWith names what an address is associated with: its instance,
+// or its network interface for addresses of NAT gateways, load balancers
+// and other interfaces without an instance.
+func associatedWith(addr types.Address) string {
+	if id := aws.ToString(addr.InstanceId); id != "" {
+		return id
+	}
+	return aws.ToString(addr.NetworkInterfaceId)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "eip", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "eip", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
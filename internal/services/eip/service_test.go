@@ -0,0 +1,156 @@
+package eip
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves an address associated with an instance, one associated
+// with a NAT gateway's interface and an unassociated one, or fails every
+// call when err is set. It records the addresses disassociated and
+// released.
+type fakeEC2 struct {
+	err           error
+	disassociated []string
+	released      []string
+}
+
+func (f *fakeEC2) addresses() []types.Address {
+	return []types.Address{
+		{
+			AllocationId:       aws.String("eipalloc-web"),
+			AssociationId:      aws.String("eipassoc-web"),
+			PublicIp:           aws.String("203.0.113.10"),
+			InstanceId:         aws.String("i-1"),
+			NetworkInterfaceId: aws.String("eni-1"),
+			PrivateIpAddress:   aws.String("10.0.0.10"),
+			Domain:             types.DomainTypeVpc,
+			Tags:               []types.Tag{{Key: aws.String("Name"), Value: aws.String("web")}},
+		},
+		{
+			AllocationId:       aws.String("eipalloc-nat"),
+			AssociationId:      aws.String("eipassoc-nat"),
+			PublicIp:           aws.String("203.0.113.20"),
+			NetworkInterfaceId: aws.String("eni-nat"),
+			Domain:             types.DomainTypeVpc,
+		},
+		{
+			AllocationId:       aws.String("eipalloc-old"),
+			PublicIp:           aws.String("203.0.113.30"),
+			NetworkBorderGroup: aws.String("us-east-1"),
+			Domain:             types.DomainTypeVpc,
+		},
+	}
+}
+
+func (f *fakeEC2) DescribeAddresses(_ context.Context, in *ec2.DescribeAddressesInput, _ ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(in.AllocationIds) == 0 {
+		return &ec2.DescribeAddressesOutput{Addresses: f.addresses()}, nil
+	}
+	for _, addr := range f.addresses() {
+		if aws.ToString(addr.AllocationId) == in.AllocationIds[0] {
+			return &ec2.DescribeAddressesOutput{Addresses: []types.Address{addr}}, nil
+		}
+	}
+	return nil, errors.New("InvalidAllocationID.NotFound")
+}
+
+func (f *fakeEC2) DisassociateAddress(_ context.Context, in *ec2.DisassociateAddressInput, _ ...func(*ec2.Options)) (*ec2.DisassociateAddressOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.disassociated = append(f.disassociated, aws.ToString(in.AssociationId))
+	return &ec2.DisassociateAddressOutput{}, nil
+}
+
+func (f *fakeEC2) ReleaseAddress(_ context.Context, in *ec2.ReleaseAddressInput, _ ...func(*ec2.Options)) (*ec2.ReleaseAddressOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.released = append(f.released, aws.ToString(in.AllocationId))
+	return &ec2.ReleaseAddressOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against addresses.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID:    "eipalloc-web",
+		MissingID:     "eipalloc-missing",
+		Action:        "disassociate",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "release",
+	})
+}
+
+func TestListFlagsUnassociatedAddresses(t *testing.T) {
+	svc := NewServiceWithClient(&fakeEC2{}, nil)
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 3 {
+		t.Fatalf("List() returned %d addresses, want 3", len(resources))
+	}
+
+	web, nat, old := resources[0], resources[1], resources[2]
+	if web.Name != "web" || web.GetMetadataString("associated_with") != "i-1" || len(web.Issues()) != 0 {
+		t.Errorf("web = %q associated with %q, issues %v", web.Name, web.GetMetadataString("associated_with"), web.Issues())
+	}
+	if nat.GetMetadataString("associated_with") != "eni-nat" {
+		t.Errorf("NAT address associated with %q, want its interface", nat.GetMetadataString("associated_with"))
+	}
+	if old.Severity() != core.SeverityMedium || !strings.Contains(old.Issues()[0].Message, "$3.65/mo") {
+		t.Errorf("unassociated address issues = %v", old.Issues())
+	}
+	if cleanup, _ := old.Metadata["should_cleanup"].(bool); !cleanup || old.State != "unassociated" {
+		t.Errorf("unassociated address metadata = %v, state %q", old.Metadata, old.State)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	client := &fakeEC2{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "release", "eipalloc-old", nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !confirm.TypeResource {
+		t.Fatalf("release error = %v, want the allocation ID typed back", err)
+	}
+
+	if _, err := svc.Execute(ctx, "release", "eipalloc-old", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "eipalloc-web"}); !errors.As(err, &confirm) {
+		t.Errorf("release with another ID typed error = %v, want a confirmation", err)
+	}
+
+	_, err = svc.Execute(ctx, "release", "eipalloc-web", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "eipalloc-web"})
+	if err == nil || !strings.Contains(err.Error(), "disassociate it first") {
+		t.Errorf("release of an associated address error = %v", err)
+	}
+
+	result, err := svc.Execute(ctx, "release", "eipalloc-old", map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "eipalloc-old"})
+	if err != nil {
+		t.Fatalf("confirmed release error = %v", err)
+	}
+	if len(client.released) != 1 || client.released[0] != "eipalloc-old" || !strings.Contains(result.Message, "203.0.113.30") {
+		t.Errorf("released %v, message %q", client.released, result.Message)
+	}
+}
@@ -0,0 +1,295 @@
+package eip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Elastic IPs.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new Elastic IP view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Public IP"), MinWidth: 9, MaxWidth: 15, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("Allocation ID"), MinWidth: 17, MaxWidth: 26, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Associated With"), MinWidth: 15, MaxWidth: 22, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Private IP"), MinWidth: 11, MaxWidth: 15, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("EIP", "", "eip", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadAddresses()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Disassociating %s...", row.Name)
+				return v, v.executeAction("disassociate", row.ID, nil)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Releasing %s...", row.Name)
+				return v, v.executeAction("release", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Elastic IP %s", row.GetMetadataString("public_ip")), formatDetail(row))
+			}
+		}
+
+	case addressesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d Elastic IPs", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.loadAddresses())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading Elastic IPs...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("disassocia[t]e  [d] release  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the addresses.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadAddresses()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type addressesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadAddresses() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return addressesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return addressesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return addressesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(r.GetMetadataString("public_ip")),
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.TextCell(r.GetMetadataString("allocation_id")),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(orDash(r.GetMetadataString("associated_with"))),
+		base.TextCell(orDash(r.GetMetadataString("private_ip"))),
+		base.CostCell(r),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatDetail renders an address's association and pool for the detail
+// panel.
+func formatDetail(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Public IP:     %s\n", r.GetMetadataString("public_ip"))
+	fmt.Fprintf(&b, "Allocation ID: %s\n", r.GetMetadataString("allocation_id"))
+	fmt.Fprintf(&b, "Pool:          %s (%s)\n", r.GetMetadataString("public_ipv4_pool"), r.GetMetadataString("network_border_group"))
+	if associated, _ := r.Metadata["associated"].(bool); associated {
+		fmt.Fprintf(&b, "Association:   %s\n", r.GetMetadataString("association_id"))
+		if instance := r.GetMetadataString("instance_id"); instance != "" {
+			fmt.Fprintf(&b, "Instance:      %s\n", instance)
+		}
+		fmt.Fprintf(&b, "Interface:     %s\n", r.GetMetadataString("network_interface_id"))
+		fmt.Fprintf(&b, "Private IP:    %s\n", r.GetMetadataString("private_ip"))
+	} else {
+		b.WriteString("Association:   none\n")
+	}
+	if monthly, ok := estimate.MonthlyCost(*r); ok {
+		fmt.Fprintf(&b, "Est. cost:     %s/mo\n", estimate.FormatCost(monthly))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	unassociated := 0
+	reclaimable := 0.0
+	for _, r := range v.Resources {
+		if cleanup, _ := r.Metadata["should_cleanup"].(bool); cleanup {
+			unassociated++
+			if monthly, ok := estimate.MonthlyCost(r); ok {
+				reclaimable += monthly
+			}
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unassociated", Text: i18n.T("Unassociated: %d (%s/mo)", unassociated, estimate.FormatCost(reclaimable)), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Elastic IPs"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "eip" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
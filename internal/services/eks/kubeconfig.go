@@ -0,0 +1,177 @@
+package eks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// Kubeconfig
+// =============================================================================
+
+// KubeconfigEntry is a cluster to add to a kubeconfig file. Its cluster,
+// user and context entries are named after the cluster ARN, as
+// `aws eks update-kubeconfig` names them, so both tools update the same
+// entries.
+type KubeconfigEntry struct {
+	ClusterARN  string
+	ClusterName string
+	Alias       string // Context name, the ARN when empty
+	Server      string
+	CAData      string // Base64-encoded certificate authority
+	Region      string
+	Profile     string // AWS profile get-token runs with, if any
+	RoleARN     string // Role get-token assumes, if any
+}
+
+// Context returns the name of the entry's context.
+func (e KubeconfigEntry) Context() string {
+	if e.Alias != "" {
+		return e.Alias
+	}
+	return e.ClusterARN
+}
+
+// DefaultKubeconfigPath returns the file kubectl reads first: the first
+// entry of $KUBECONFIG, or ~/.kube/config.
+func DefaultKubeconfigPath() string {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		if first := filepath.SplitList(env)[0]; first != "" {
+			return first
+		}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".kube", "config")
+	}
+	return filepath.Join(home, ".kube", "config")
+}
+
+// UpdateKubeconfig adds or replaces the entry's cluster, user and context
+// in a kubeconfig file, creating it when missing, and returns the path
+// written. Other entries are kept; comments are not.
+func UpdateKubeconfig(path string, entry KubeconfigEntry, makeCurrent bool) (string, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	config := map[string]any{}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return "", fmt.Errorf("parsing %s: %w", path, err)
+		}
+		if config == nil {
+			config = map[string]any{}
+		}
+	case !os.IsNotExist(err):
+		return "", err
+	}
+
+	if _, ok := config["apiVersion"]; !ok {
+		config["apiVersion"] = "v1"
+	}
+	if _, ok := config["kind"]; !ok {
+		config["kind"] = "Config"
+	}
+	if _, ok := config["preferences"]; !ok {
+		config["preferences"] = map[string]any{}
+	}
+
+	upsert(config, "clusters", entry.ClusterARN, "cluster", map[string]any{
+		"server":                     entry.Server,
+		"certificate-authority-data": entry.CAData,
+	})
+	upsert(config, "users", entry.ClusterARN, "user", map[string]any{
+		"exec": entry.exec(),
+	})
+	upsert(config, "contexts", entry.Context(), "context", map[string]any{
+		"cluster": entry.ClusterARN,
+		"user":    entry.ClusterARN,
+	})
+	if makeCurrent {
+		config["current-context"] = entry.Context()
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	if err := writePrivate(path, out); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// exec is the credential plugin kubectl runs to get a token.
+func (e KubeconfigEntry) exec() map[string]any {
+	args := []any{}
+	if e.Region != "" {
+		args = append(args, "--region", e.Region)
+	}
+	args = append(args, "eks", "get-token", "--cluster-name", e.ClusterName, "--output", "json")
+	if e.RoleARN != "" {
+		args = append(args, "--role-arn", e.RoleARN)
+	}
+
+	exec := map[string]any{
+		"apiVersion": "client.authentication.k8s.io/v1beta1",
+		"command":    "aws",
+		"args":       args,
+	}
+	if e.Profile != "" {
+		exec["env"] = []any{map[string]any{"name": "AWS_PROFILE", "value": e.Profile}}
+	}
+	return exec
+}
+
+// upsert replaces the named entry of a kubeconfig list, or appends it.
+func upsert(config map[string]any, list, name, field string, value map[string]any) {
+	entries, _ := config[list].([]any)
+	entry := map[string]any{"name": name, field: value}
+	for i, existing := range entries {
+		if m, ok := existing.(map[string]any); ok && m["name"] == name {
+			entries[i] = entry
+			config[list] = entries
+			return
+		}
+	}
+	config[list] = append(entries, entry)
+}
+
+// writePrivate replaces a file through a temporary file in the same
+// directory, readable by its owner only as kubeconfig files hold
+// credentials.
+func writePrivate(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".kubeconfig-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,69 @@
+package eks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestUpdateKubeconfigReplacesEntries checks that adding a cluster twice
+// replaces its entries, keeps those of other clusters and leaves the file
+// readable by its owner only.
+func TestUpdateKubeconfigReplacesEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kube", "config")
+	existing := "apiVersion: v1\nkind: Config\ncurrent-context: minikube\ncontexts:\n- name: minikube\n  context: {cluster: minikube, user: minikube}\n"
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(existing), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	entry := KubeconfigEntry{
+		ClusterARN:  "arn:aws:eks:us-east-1:123456789012:cluster/platform",
+		ClusterName: "platform",
+		Server:      "https://old.eks.amazonaws.com",
+		Region:      "us-east-1",
+		Profile:     "prod",
+	}
+	if _, err := UpdateKubeconfig(path, entry, false); err != nil {
+		t.Fatalf("UpdateKubeconfig() error = %v", err)
+	}
+	entry.Server = "https://new.eks.amazonaws.com"
+	entry.Alias = "platform"
+	if _, err := UpdateKubeconfig(path, entry, true); err != nil {
+		t.Fatalf("UpdateKubeconfig() again error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config struct {
+		CurrentContext string `yaml:"current-context"`
+		Clusters       []struct {
+			Name    string
+			Cluster struct{ Server string }
+		}
+		Contexts []struct{ Name string }
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		t.Fatalf("written config: %v", err)
+	}
+
+	if config.CurrentContext != "platform" {
+		t.Errorf("current context = %q, want the alias", config.CurrentContext)
+	}
+	if len(config.Clusters) != 1 || config.Clusters[0].Cluster.Server != entry.Server {
+		t.Errorf("clusters = %+v, want the new server once", config.Clusters)
+	}
+	// The first context, named after the ARN, is kept along with the alias
+	if len(config.Contexts) != 3 || config.Contexts[0].Name != "minikube" {
+		t.Errorf("contexts = %+v", config.Contexts)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, %v; want 0600", info.Mode().Perm(), err)
+	}
+}
@@ -0,0 +1,778 @@
+// Package eks provides Amazon EKS integration for the a9s application.
+// It lists clusters with their version, status and API endpoint access,
+// enriches them with their managed nodegroups and add-ons, writes kubectl
+// configuration for them and tags them.
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+const (
+	// standardHourly is the control plane price of a cluster in standard
+	// support, in USD.
+	standardHourly = 0.10
+	// extendedHourly is the control plane price of a cluster in extended
+	// support, in USD.
+	extendedHourly = 0.60
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements EKS operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EKSAPI
+}
+
+// EKSAPI defines the EKS client interface for mocking.
+type EKSAPI interface {
+	ListClusters(ctx context.Context, params *eks.ListClustersInput, optFns ...func(*eks.Options)) (*eks.ListClustersOutput, error)
+	DescribeCluster(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+	DescribeClusterVersions(ctx context.Context, params *eks.DescribeClusterVersionsInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterVersionsOutput, error)
+	ListNodegroups(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error)
+	DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
+	ListAddons(ctx context.Context, params *eks.ListAddonsInput, optFns ...func(*eks.Options)) (*eks.ListAddonsOutput, error)
+	DescribeAddon(ctx context.Context, params *eks.DescribeAddonInput, optFns ...func(*eks.Options)) (*eks.DescribeAddonOutput, error)
+	TagResource(ctx context.Context, params *eks.TagResourceInput, optFns ...func(*eks.Options)) (*eks.TagResourceOutput, error)
+	UntagResource(ctx context.Context, params *eks.UntagResourceInput, optFns ...func(*eks.Options)) (*eks.UntagResourceOutput, error)
+}
+
+// NewService creates a new EKS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EKSAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EKS client for the current AWS context.
+func (s *Service) client() EKSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return eks.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "eks"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "EKS Clusters"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "kubernetes"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListClusters(ctx, &eks.ListClustersInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("eks", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the clusters of the region, with the end of standard
+// support of their Kubernetes version when EKS publishes it.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	client := s.client()
+
+	var clusters []types.Cluster
+	paginator := eks.NewListClustersPaginator(client, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("eks", "list", err)
+		}
+		for _, name := range page.Clusters {
+			out, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)})
+			if err != nil || out.Cluster == nil {
+				// The cluster may have been deleted since it was listed
+				continue
+			}
+			clusters = append(clusters, *out.Cluster)
+		}
+	}
+
+	// Support dates are best effort: older partitions do not publish them
+	support, _ := s.supportEnds(ctx, clusters)
+
+	resources := make([]core.Resource, 0, len(clusters))
+	for _, cluster := range clusters {
+		resources = append(resources, clusterToResource(cluster, support, now))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "eks:cluster",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// supportEnds returns the end of standard support of the clusters'
+// Kubernetes versions, in a single paginated DescribeClusterVersions call.
+func (s *Service) supportEnds(ctx context.Context, clusters []types.Cluster) (map[string]time.Time, error) {
+	var versions []string
+	for _, c := range clusters {
+		if v := aws.ToString(c.Version); v != "" && !slices.Contains(versions, v) {
+			versions = append(versions, v)
+		}
+	}
+	ends := make(map[string]time.Time, len(versions))
+	if len(versions) == 0 {
+		return ends, nil
+	}
+
+	paginator := eks.NewDescribeClusterVersionsPaginator(s.client(), &eks.DescribeClusterVersionsInput{
+		ClusterVersions: versions,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range page.ClusterVersions {
+			if info.EndOfStandardSupportDate != nil {
+				ends[aws.ToString(info.ClusterVersion)] = *info.EndOfStandardSupportDate
+			}
+		}
+	}
+	return ends, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// Nodegroup is a managed nodegroup of a cluster.
+type Nodegroup struct {
+	Name          string
+	Status        string
+	Version       string
+	Release       string
+	InstanceTypes []string
+	CapacityType  string
+	AMIType       string
+	Min           int32
+	Max           int32
+	Desired       int32
+	Issues        []string
+}
+
+// Addon is an EKS add-on installed on a cluster.
+type Addon struct {
+	Name    string
+	Version string
+	Status  string
+	Issues  []string
+}
+
+// EnrichResource adds the cluster's managed nodegroups and add-ons.
+// Nodegroups behind the cluster's Kubernetes version, and unhealthy
+// nodegroups and add-ons, are flagged.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	nodegroups, err := s.nodegroups(ctx, resource.ID)
+	if err != nil {
+		return err
+	}
+	addons, err := s.addons(ctx, resource.ID)
+	if err != nil {
+		return err
+	}
+
+	version := resource.GetMetadataString("version")
+	nodes := int32(0)
+	for _, ng := range nodegroups {
+		nodes += ng.Desired
+		if len(ng.Issues) > 0 || ng.Status == string(types.NodegroupStatusDegraded) {
+			resource.AddIssue(core.SeverityHigh, fmt.Sprintf("Nodegroup %s is %s: %s", ng.Name, strings.ToLower(ng.Status), strings.Join(ng.Issues, "; ")))
+		}
+		if ng.Version != "" && version != "" && ng.Version != version {
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Nodegroup %s runs Kubernetes %s, behind the cluster's %s", ng.Name, ng.Version, version))
+		}
+	}
+	for _, addon := range addons {
+		switch types.AddonStatus(addon.Status) {
+		case types.AddonStatusDegraded, types.AddonStatusCreateFailed, types.AddonStatusUpdateFailed:
+			resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Add-on %s is %s: %s", addon.Name, strings.ToLower(strings.ReplaceAll(addon.Status, "_", " ")), strings.Join(addon.Issues, "; ")))
+		}
+	}
+
+	resource.Metadata["nodegroups"] = nodegroups
+	resource.Metadata["addons"] = addons
+	resource.Metadata["nodes"] = nodes
+	resource.Metadata["analyzed"] = true
+	return nil
+}
+
+// nodegroups describes the managed nodegroups of a cluster.
+func (s *Service) nodegroups(ctx context.Context, cluster string) ([]Nodegroup, error) {
+	client := s.client()
+
+	var nodegroups []Nodegroup
+	paginator := eks.NewListNodegroupsPaginator(client, &eks.ListNodegroupsInput{ClusterName: aws.String(cluster)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range page.Nodegroups {
+			out, err := client.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(cluster),
+				NodegroupName: aws.String(name),
+			})
+			if err != nil || out.Nodegroup == nil {
+				continue
+			}
+			nodegroups = append(nodegroups, nodegroupOf(*out.Nodegroup))
+		}
+	}
+	return nodegroups, nil
+}
+
+// addons describes the EKS add-ons of a cluster.
+func (s *Service) addons(ctx context.Context, cluster string) ([]Addon, error) {
+	client := s.client()
+
+	var addons []Addon
+	paginator := eks.NewListAddonsPaginator(client, &eks.ListAddonsInput{ClusterName: aws.String(cluster)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range page.Addons {
+			out, err := client.DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(cluster),
+				AddonName:   aws.String(name),
+			})
+			if err != nil || out.Addon == nil {
+				continue
+			}
+			addons = append(addons, addonOf(*out.Addon))
+		}
+	}
+	return addons, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for clusters.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "describe",
+			Description: "Show endpoint access, networking, logging, nodegroups and add-ons",
+			Icon:        "info",
+			Shortcut:    "enter",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "update_kubeconfig",
+			Description: "Add the cluster to a kubeconfig file",
+			Icon:        "file",
+			Shortcut:    "c",
+			Dangerous:   false,
+			Category:    "access",
+			Parameters: []core.ActionParameter{
+				{Name: "alias", Type: "string", Description: "Context name (empty for the cluster ARN)"},
+				{Name: "path", Type: "string", Description: "Kubeconfig file (empty for $KUBECONFIG or ~/.kube/config)"},
+				{Name: "role_arn", Type: "string", Description: "Role to assume for cluster authentication (optional)"},
+				{Name: "switch", Type: "bool", Default: true, Description: "Make it the current context"},
+			},
+		},
+		{
+			Name:        "tag",
+			Description: "Set or remove a tag on the cluster",
+			Icon:        "tag",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "configure",
+			Parameters: []core.ActionParameter{
+				{Name: "key", Type: "string", Required: true, Description: "Tag key"},
+				{Name: "value", Type: "string", Description: "Tag value (empty to remove the tag)"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a cluster, identified by its name.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "describe":
+		result, err = s.describe(ctx, resourceID)
+	case "update_kubeconfig":
+		alias, _ := params["alias"].(string)
+		path, _ := params["path"].(string)
+		roleARN, _ := params["role_arn"].(string)
+		makeCurrent := true
+		if v, ok := params["switch"].(bool); ok {
+			makeCurrent = v
+		}
+		result, err = s.updateKubeconfig(ctx, resourceID, strings.TrimSpace(alias), strings.TrimSpace(path), strings.TrimSpace(roleARN), makeCurrent)
+	case "tag":
+		key, _ := params["key"].(string)
+		value, _ := params["value"].(string)
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, core.NewValidationError("key", key, "cannot be empty")
+		}
+		if strings.HasPrefix(strings.ToLower(key), "aws:") {
+			return nil, core.NewValidationError("key", key, "the aws: prefix is reserved")
+		}
+		result, err = s.tag(ctx, resourceID, key, value)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// ClusterDetail is a cluster with its nodegroups and add-ons, for the
+// detail panel.
+type ClusterDetail struct {
+	Name             string
+	ARN              string
+	Status           string
+	Version          string
+	PlatformVersion  string
+	SupportType      string
+	Endpoint         string
+	PublicAccess     bool
+	PrivateAccess    bool
+	PublicCIDRs      []string
+	VPC              string
+	Subnets          []string
+	SecurityGroups   []string
+	ServiceIPv4CIDR  string
+	Role             string
+	AuthMode         string
+	SecretsEncrypted bool
+	Logging          []string // Enabled control plane log types
+	Nodegroups       []Nodegroup
+	Addons           []Addon
+	Tags             map[string]string
+}
+
+func (s *Service) describe(ctx context.Context, name string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("describe", name, err)
+	}
+
+	cluster, err := s.cluster(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	detail := detailOf(cluster)
+	if detail.Nodegroups, err = s.nodegroups(ctx, name); err != nil {
+		return fail(err)
+	}
+	if detail.Addons, err = s.addons(ctx, name); err != nil {
+		return fail(err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Described cluster %s", name))
+	result.Data = detail
+	return result, nil
+}
+
+// updateKubeconfig adds a cluster to a kubeconfig file the way
+// `aws eks update-kubeconfig` does, authenticating through
+// `aws eks get-token` with the current profile and region.
+func (s *Service) updateKubeconfig(ctx context.Context, name, alias, path, roleARN string, makeCurrent bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("update_kubeconfig", name, err)
+	}
+
+	cluster, err := s.cluster(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	if cluster.Status != types.ClusterStatusActive && cluster.Status != types.ClusterStatusUpdating {
+		return fail(core.NewValidationError("cluster", name, fmt.Sprintf("is %s; only active clusters can be reached", strings.ToLower(string(cluster.Status)))))
+	}
+	if aws.ToString(cluster.Endpoint) == "" || cluster.CertificateAuthority == nil {
+		return fail(core.NewValidationError("cluster", name, "has no API endpoint yet"))
+	}
+
+	region, profile := "", ""
+	if s.factory != nil {
+		region, profile = s.factory.Config().Region, s.factory.Profile()
+	}
+	entry := KubeconfigEntry{
+		ClusterARN:  aws.ToString(cluster.Arn),
+		ClusterName: name,
+		Alias:       alias,
+		Server:      aws.ToString(cluster.Endpoint),
+		CAData:      aws.ToString(cluster.CertificateAuthority.Data),
+		Region:      region,
+		Profile:     profile,
+		RoleARN:     roleARN,
+	}
+	if path == "" {
+		path = DefaultKubeconfigPath()
+	}
+	written, err := UpdateKubeconfig(path, entry, makeCurrent)
+	if err != nil {
+		return fail(err)
+	}
+
+	message := fmt.Sprintf("Added context %s to %s", entry.Context(), written)
+	if makeCurrent {
+		message = fmt.Sprintf("Added context %s to %s and switched to it", entry.Context(), written)
+	}
+	result := core.NewActionResult(true, message)
+	result.Data = written
+	return result, nil
+}
+
+func (s *Service) tag(ctx context.Context, name, key, value string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("tag", name, err)
+	}
+
+	cluster, err := s.cluster(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	arn := cluster.Arn
+
+	if value == "" {
+		if _, ok := cluster.Tags[key]; !ok {
+			return fail(core.NewValidationError("key", key, "is not set on the cluster"))
+		}
+		if _, err := s.client().UntagResource(ctx, &eks.UntagResourceInput{ResourceArn: arn, TagKeys: []string{key}}); err != nil {
+			return fail(err)
+		}
+		return core.NewActionResult(true, fmt.Sprintf("Removed tag %s from %s", key, name)), nil
+	}
+
+	if _, err := s.client().TagResource(ctx, &eks.TagResourceInput{ResourceArn: arn, Tags: map[string]string{key: value}}); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Tagged %s with %s=%s", name, key, value)), nil
+}
+
+// cluster describes a cluster, mapping a missing one to
+// core.ErrResourceNotFound.
+func (s *Service) cluster(ctx context.Context, name string) (types.Cluster, error) {
+	out, err := s.client().DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return types.Cluster{}, core.ErrResourceNotFound
+		}
+		return types.Cluster{}, err
+	}
+	if out.Cluster == nil {
+		return types.Cluster{}, core.ErrResourceNotFound
+	}
+	return *out.Cluster, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func clusterToResource(c types.Cluster, support map[string]time.Time, now time.Time) core.Resource {
+	name := aws.ToString(c.Name)
+	version := aws.ToString(c.Version)
+
+	resource := core.Resource{
+		ID:        name,
+		Type:      "eks:cluster",
+		Name:      name,
+		ARN:       aws.ToString(c.Arn),
+		State:     clusterState(c.Status),
+		CreatedAt: c.CreatedAt,
+		Tags:      c.Tags,
+		Metadata: map[string]any{
+			"status":           string(c.Status),
+			"version":          version,
+			"platform_version": aws.ToString(c.PlatformVersion),
+			"endpoint":         aws.ToString(c.Endpoint),
+			"endpoint_access":  endpointAccess(c.ResourcesVpcConfig),
+		},
+	}
+	if resource.Tags == nil {
+		resource.Tags = make(map[string]string)
+	}
+	if vpc := c.ResourcesVpcConfig; vpc != nil {
+		resource.Metadata["vpc_id"] = aws.ToString(vpc.VpcId)
+		resource.Metadata["public_cidrs"] = vpc.PublicAccessCidrs
+	}
+
+	hourly := standardHourly
+	if end, ok := support[version]; ok {
+		resource.Metadata["standard_support_end"] = end
+		if now.After(end) {
+			hourly = extendedHourly
+			resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Kubernetes %s left standard support on %s: extended support costs %s/mo more", version, end.Format("2006-01-02"), estimate.FormatCost(estimate.Monthly(extendedHourly-standardHourly))))
+		} else if end.Sub(now) < 60*24*time.Hour {
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Kubernetes %s leaves standard support on %s", version, end.Format("2006-01-02")))
+		}
+	}
+	estimate.ApplyCost(&resource, estimate.Monthly(hourly))
+	estimate.ApplyAge(&resource, now)
+
+	if vpc := c.ResourcesVpcConfig; vpc != nil && vpc.EndpointPublicAccess && openToWorld(vpc.PublicAccessCidrs) {
+		resource.AddIssue(core.SeverityMedium, "API endpoint is reachable from the whole internet")
+	}
+	if !secretsEncrypted(c.EncryptionConfig) {
+		resource.AddIssue(core.SeverityLow, "Kubernetes secrets are not envelope-encrypted with KMS")
+	}
+	for _, issue := range healthIssues(c.Health) {
+		resource.AddIssue(core.SeverityHigh, issue)
+	}
+	return resource
+}
+
+func clusterState(status types.ClusterStatus) string {
+	switch status {
+	case types.ClusterStatusActive:
+		return core.StateActive
+	case types.ClusterStatusCreating, types.ClusterStatusPending:
+		return core.StateCreating
+	case types.ClusterStatusUpdating:
+		return core.StateUpdating
+	case types.ClusterStatusDeleting:
+		return core.StateDeleting
+	case types.ClusterStatusFailed:
+		return core.StateError
+	}
+	return core.StateUnknown
+}
+
+// endpointAccess describes how the cluster's API endpoint is reachable.
+func endpointAccess(vpc *types.VpcConfigResponse) string {
+	if vpc == nil {
+		return ""
+	}
+	switch {
+	case vpc.EndpointPublicAccess && vpc.EndpointPrivateAccess:
+		return "Public+Private"
+	case vpc.EndpointPublicAccess:
+		return "Public"
+	case vpc.EndpointPrivateAccess:
+		return "Private"
+	}
+	return ""
+}
+
+// openToWorld reports whether public access is allowed from anywhere. EKS
+// reports 0.0.0.0/0 when no CIDR restricts it.
+func openToWorld(cidrs []string) bool {
+	return len(cidrs) == 0 || slices.Contains(cidrs, "0.0.0.0/0")
+}
+
+func secretsEncrypted(configs []types.EncryptionConfig) bool {
+	for _, c := range configs {
+		if slices.Contains(c.Resources, "secrets") {
+			return true
+		}
+	}
+	return false
+}
+
+func healthIssues(health *types.ClusterHealth) []string {
+	if health == nil {
+		return nil
+	}
+	issues := make([]string, 0, len(health.Issues))
+	for _, issue := range health.Issues {
+		issues = append(issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+	}
+	return issues
+}
+
+func detailOf(c types.Cluster) ClusterDetail {
+	detail := ClusterDetail{
+		Name:            aws.ToString(c.Name),
+		ARN:             aws.ToString(c.Arn),
+		Status:          string(c.Status),
+		Version:         aws.ToString(c.Version),
+		PlatformVersion: aws.ToString(c.PlatformVersion),
+		Endpoint:        aws.ToString(c.Endpoint),
+		Role:            aws.ToString(c.RoleArn),
+		Tags:            c.Tags,
+	}
+	if c.UpgradePolicy != nil {
+		detail.SupportType = string(c.UpgradePolicy.SupportType)
+	}
+	if vpc := c.ResourcesVpcConfig; vpc != nil {
+		detail.PublicAccess = vpc.EndpointPublicAccess
+		detail.PrivateAccess = vpc.EndpointPrivateAccess
+		detail.PublicCIDRs = vpc.PublicAccessCidrs
+		detail.VPC = aws.ToString(vpc.VpcId)
+		detail.Subnets = vpc.SubnetIds
+		detail.SecurityGroups = append([]string{aws.ToString(vpc.ClusterSecurityGroupId)}, vpc.SecurityGroupIds...)
+	}
+	if network := c.KubernetesNetworkConfig; network != nil {
+		detail.ServiceIPv4CIDR = aws.ToString(network.ServiceIpv4Cidr)
+	}
+	if c.AccessConfig != nil {
+		detail.AuthMode = string(c.AccessConfig.AuthenticationMode)
+	}
+	detail.SecretsEncrypted = secretsEncrypted(c.EncryptionConfig)
+	if c.Logging != nil {
+		for _, setup := range c.Logging.ClusterLogging {
+			if aws.ToBool(setup.Enabled) {
+				for _, t := range setup.Types {
+					detail.Logging = append(detail.Logging, string(t))
+				}
+			}
+		}
+	}
+	return detail
+}
+
+func nodegroupOf(ng types.Nodegroup) Nodegroup {
+	nodegroup := Nodegroup{
+		Name:          aws.ToString(ng.NodegroupName),
+		Status:        string(ng.Status),
+		Version:       aws.ToString(ng.Version),
+		Release:       aws.ToString(ng.ReleaseVersion),
+		InstanceTypes: ng.InstanceTypes,
+		CapacityType:  string(ng.CapacityType),
+		AMIType:       string(ng.AmiType),
+	}
+	if scaling := ng.ScalingConfig; scaling != nil {
+		nodegroup.Min = aws.ToInt32(scaling.MinSize)
+		nodegroup.Max = aws.ToInt32(scaling.MaxSize)
+		nodegroup.Desired = aws.ToInt32(scaling.DesiredSize)
+	}
+	if ng.Health != nil {
+		for _, issue := range ng.Health.Issues {
+			nodegroup.Issues = append(nodegroup.Issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+		}
+	}
+	return nodegroup
+}
+
+func addonOf(a types.Addon) Addon {
+	addon := Addon{
+		Name:    aws.ToString(a.AddonName),
+		Version: aws.ToString(a.AddonVersion),
+		Status:  string(a.Status),
+	}
+	if a.Health != nil {
+		for _, issue := range a.Health.Issues {
+			addon.Issues = append(addon.Issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+		}
+	}
+	return addon
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "eks", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "eks", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
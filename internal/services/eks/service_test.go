@@ -0,0 +1,205 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeEKS serves the platform cluster, on Kubernetes 1.28 past its
+// standard support with a public endpoint open to the internet, whose
+// general nodegroup still runs 1.27 and whose vpc-cni add-on is degraded.
+// It fails every call when err is set and records the tags set.
+type fakeEKS struct {
+	err  error
+	tags []map[string]string
+}
+
+func (f *fakeEKS) ListClusters(context.Context, *eks.ListClustersInput, ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.ListClustersOutput{Clusters: []string{"platform", "deleted-since"}}, nil
+}
+
+func (f *fakeEKS) DescribeCluster(_ context.Context, in *eks.DescribeClusterInput, _ ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Name) != "platform" {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("No cluster found")}
+	}
+	return &eks.DescribeClusterOutput{Cluster: &types.Cluster{
+		Name:                 aws.String("platform"),
+		Arn:                  aws.String("arn:aws:eks:us-east-1:123456789012:cluster/platform"),
+		Status:               types.ClusterStatusActive,
+		Version:              aws.String("1.28"),
+		Endpoint:             aws.String("https://ABCDEF.gr7.us-east-1.eks.amazonaws.com"),
+		CertificateAuthority: &types.Certificate{Data: aws.String("LS0tLS1CRUdJTg==")},
+		ResourcesVpcConfig: &types.VpcConfigResponse{
+			VpcId:                aws.String("vpc-1"),
+			EndpointPublicAccess: true,
+			PublicAccessCidrs:    []string{"0.0.0.0/0"},
+		},
+		Tags: map[string]string{"team": "platform"},
+	}}, nil
+}
+
+func (f *fakeEKS) DescribeClusterVersions(context.Context, *eks.DescribeClusterVersionsInput, ...func(*eks.Options)) (*eks.DescribeClusterVersionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.DescribeClusterVersionsOutput{ClusterVersions: []types.ClusterVersionInformation{{
+		ClusterVersion:           aws.String("1.28"),
+		EndOfStandardSupportDate: aws.Time(time.Date(2024, 11, 26, 0, 0, 0, 0, time.UTC)),
+	}}}, nil
+}
+
+func (f *fakeEKS) ListNodegroups(context.Context, *eks.ListNodegroupsInput, ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.ListNodegroupsOutput{Nodegroups: []string{"general"}}, nil
+}
+
+func (f *fakeEKS) DescribeNodegroup(context.Context, *eks.DescribeNodegroupInput, ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.DescribeNodegroupOutput{Nodegroup: &types.Nodegroup{
+		NodegroupName: aws.String("general"),
+		Status:        types.NodegroupStatusActive,
+		Version:       aws.String("1.27"),
+		ScalingConfig: &types.NodegroupScalingConfig{MinSize: aws.Int32(2), MaxSize: aws.Int32(6), DesiredSize: aws.Int32(3)},
+	}}, nil
+}
+
+func (f *fakeEKS) ListAddons(context.Context, *eks.ListAddonsInput, ...func(*eks.Options)) (*eks.ListAddonsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.ListAddonsOutput{Addons: []string{"vpc-cni"}}, nil
+}
+
+func (f *fakeEKS) DescribeAddon(context.Context, *eks.DescribeAddonInput, ...func(*eks.Options)) (*eks.DescribeAddonOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.DescribeAddonOutput{Addon: &types.Addon{
+		AddonName: aws.String("vpc-cni"),
+		Status:    types.AddonStatusDegraded,
+		Health: &types.AddonHealth{Issues: []types.AddonIssue{{
+			Code:    types.AddonIssueCodeInsufficientNumberOfReplicas,
+			Message: aws.String("1 of 3 pods ready"),
+		}}},
+	}}, nil
+}
+
+func (f *fakeEKS) TagResource(_ context.Context, in *eks.TagResourceInput, _ ...func(*eks.Options)) (*eks.TagResourceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.tags = append(f.tags, in.Tags)
+	return &eks.TagResourceOutput{}, nil
+}
+
+func (f *fakeEKS) UntagResource(context.Context, *eks.UntagResourceInput, ...func(*eks.Options)) (*eks.UntagResourceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eks.UntagResourceOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against clusters.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEKS{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEKS{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:   "platform",
+		Action:       "tag",
+		ActionParams: map[string]any{"key": "env", "value": "prod"},
+	})
+}
+
+// TestListChargesExtendedSupport checks that a cluster past the standard
+// support of its version is costed at the extended support price.
+func TestListChargesExtendedSupport(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeEKS{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 1 {
+		t.Fatalf("List() = %v, want the cluster still described", resources)
+	}
+
+	platform := resources[0]
+	if cost := platform.Metadata[estimate.MonthlyCostKey]; cost != estimate.Monthly(extendedHourly) {
+		t.Errorf("monthly cost = %v, want extended support", cost)
+	}
+	var messages []string
+	for _, issue := range platform.Issues() {
+		messages = append(messages, issue.Message)
+	}
+	want := []string{
+		"Kubernetes 1.28 left standard support on 2024-11-26: extended support costs $365.00/mo more",
+		"API endpoint is reachable from the whole internet",
+		"Kubernetes secrets are not envelope-encrypted with KMS",
+	}
+	if len(messages) != len(want) {
+		t.Fatalf("issues = %q, want %q", messages, want)
+	}
+	for i := range want {
+		if messages[i] != want[i] {
+			t.Errorf("issue %d = %q, want %q", i, messages[i], want[i])
+		}
+	}
+}
+
+func TestEnrichFlagsNodegroupsAndAddons(t *testing.T) {
+	svc := NewServiceWithClient(&fakeEKS{}, nil)
+	resource := core.Resource{ID: "platform", Metadata: map[string]any{"version": "1.28"}}
+	if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+
+	if resource.Metadata["nodes"] != int32(3) {
+		t.Errorf("nodes = %v, want the desired size", resource.Metadata["nodes"])
+	}
+	issues := resource.Issues()
+	if len(issues) != 2 {
+		t.Fatalf("issues = %v", issues)
+	}
+	if issues[0].Message != "Nodegroup general runs Kubernetes 1.27, behind the cluster's 1.28" {
+		t.Errorf("nodegroup issue = %q", issues[0].Message)
+	}
+	if issues[1].Message != "Add-on vpc-cni is degraded: InsufficientNumberOfReplicas: 1 of 3 pods ready" {
+		t.Errorf("add-on issue = %q", issues[1].Message)
+	}
+}
+
+func TestTagRejectsReservedKeys(t *testing.T) {
+	fake := &fakeEKS{}
+	svc := NewServiceWithClient(fake, nil)
+
+	for key, value := range map[string]string{"aws:cloudformation:stack": "x", " ": "x", "owner": ""} {
+		if _, err := svc.Execute(context.Background(), "tag", "platform", map[string]any{"key": key, "value": value}); err == nil {
+			t.Errorf("tag %q=%q succeeded", key, value)
+		}
+	}
+	if len(fake.tags) > 0 {
+		t.Errorf("tags set: %v", fake.tags)
+	}
+}
@@ -0,0 +1,367 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	kubeconfigFormID = "eks:kubeconfig"
+	tagFormID        = "eks:tag"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for EKS clusters.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget string // Cluster the open form is for
+}
+
+// NewView creates a new EKS view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Version"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Endpoint"), MinWidth: 8, MaxWidth: 16, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Nodegroups"), MinWidth: 10, MaxWidth: 11, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Nodes"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Add-ons"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("EKS", "", "eks", i18n.T("clusters"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "c":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(kubeconfigFormID, "update_kubeconfig", i18n.T("Add %s to kubeconfig", row.Name), row)
+			}
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(tagFormID, "tag", i18n.T("Tag %s", row.Name), row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Describing %s...", row.Name)
+				return v, v.executeAction("describe", row.ID, nil)
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != kubeconfigFormID && msg.ID != tagFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		if msg.ID == kubeconfigFormID {
+			v.Message = i18n.T("Writing the kubeconfig of %s...", v.formTarget)
+			return v, v.executeAction("update_kubeconfig", v.formTarget, msg.Values)
+		}
+		v.Message = i18n.T("Tagging %s...", v.formTarget)
+		return v, v.executeAction("tag", v.formTarget, msg.Values)
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if detail, ok := msg.Result.Data.(ClusterDetail); ok {
+			v.OpenDetail(i18n.T("Cluster %s", detail.Name), formatDetail(detail))
+			return v, nil
+		}
+		if msg.Action == "tag" {
+			return v, v.SoftRefresh()
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading EKS clusters...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[c] kubeconfig  [t]ag  [a]nalyze  [Enter]describe  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the clusters, keeping their nodegroups and add-ons.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	nodegroups, _ := r.Metadata["nodegroups"].([]Nodegroup)
+	addons, _ := r.Metadata["addons"].([]Addon)
+	nodes, _ := r.Metadata["nodes"].(int32)
+
+	groupCount, nodeCount, addonCount := "...", "...", "..."
+	var groupValue, nodeValue, addonValue any
+	if analyzed {
+		groupValue, nodeValue, addonValue = len(nodegroups), nodes, len(addons)
+		groupCount = fmt.Sprintf("%d", len(nodegroups))
+		nodeCount = fmt.Sprintf("%d", nodes)
+		addonCount = fmt.Sprintf("%d", len(addons))
+	}
+
+	endpoint := r.GetMetadataString("endpoint_access")
+	if endpoint == "" {
+		endpoint = "-"
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(r.GetMetadataString("version")),
+		base.TextCell(endpoint),
+		base.LazyCell(groupValue, func() string { return groupCount }),
+		base.LazyCell(nodeValue, func() string { return nodeCount }),
+		base.LazyCell(addonValue, func() string { return addonCount }),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// formatDetail renders a cluster's access, networking, nodegroups and
+// add-ons for the detail panel.
+func formatDetail(d ClusterDetail) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", d.ARN)
+	fmt.Fprintf(&b, "State:       %s\n", d.Status)
+	version := d.Version + " (" + d.PlatformVersion + ")"
+	if d.SupportType != "" {
+		version += ", " + strings.ToLower(d.SupportType) + " support"
+	}
+	fmt.Fprintf(&b, "Version:     %s\n", version)
+	fmt.Fprintf(&b, "Endpoint:    %s\n", d.Endpoint)
+	access := []string{}
+	if d.PublicAccess {
+		cidrs := strings.Join(d.PublicCIDRs, ", ")
+		if cidrs == "" {
+			cidrs = "0.0.0.0/0"
+		}
+		access = append(access, "public from "+cidrs)
+	}
+	if d.PrivateAccess {
+		access = append(access, "private")
+	}
+	fmt.Fprintf(&b, "Access:      %s\n", strings.Join(access, "; "))
+	if d.AuthMode != "" {
+		fmt.Fprintf(&b, "Auth mode:   %s\n", d.AuthMode)
+	}
+	fmt.Fprintf(&b, "VPC:         %s\n", d.VPC)
+	fmt.Fprintf(&b, "Subnets:     %s\n", strings.Join(d.Subnets, ", "))
+	fmt.Fprintf(&b, "Security groups: %s\n", strings.Join(d.SecurityGroups, ", "))
+	if d.ServiceIPv4CIDR != "" {
+		fmt.Fprintf(&b, "Service CIDR: %s\n", d.ServiceIPv4CIDR)
+	}
+	fmt.Fprintf(&b, "Role:        %s\n", d.Role)
+	fmt.Fprintf(&b, "Secrets encryption: %t\n", d.SecretsEncrypted)
+	logging := "disabled"
+	if len(d.Logging) > 0 {
+		logging = strings.Join(d.Logging, ", ")
+	}
+	fmt.Fprintf(&b, "Control plane logs: %s\n", logging)
+
+	b.WriteString(i18n.T("\nNodegroups:\n"))
+	if len(d.Nodegroups) == 0 {
+		b.WriteString(i18n.T("  none\n"))
+	}
+	for _, ng := range d.Nodegroups {
+		fmt.Fprintf(&b, "  %s: %s, %s %s, %d nodes (%d-%d), Kubernetes %s, %s\n",
+			ng.Name, strings.ToLower(ng.Status), ng.CapacityType, strings.Join(ng.InstanceTypes, "/"),
+			ng.Desired, ng.Min, ng.Max, ng.Version, ng.AMIType)
+		for _, issue := range ng.Issues {
+			fmt.Fprintf(&b, "    %s %s\n", base.SeverityIcon(core.SeverityHigh), issue)
+		}
+	}
+
+	b.WriteString(i18n.T("\nAdd-ons:\n"))
+	if len(d.Addons) == 0 {
+		b.WriteString(i18n.T("  none\n"))
+	}
+	for _, addon := range d.Addons {
+		fmt.Fprintf(&b, "  %s %s: %s\n", addon.Name, addon.Version, strings.ToLower(addon.Status))
+		for _, issue := range addon.Issues {
+			fmt.Fprintf(&b, "    %s %s\n", base.SeverityIcon(core.SeverityMedium), issue)
+		}
+	}
+
+	if len(d.Tags) > 0 {
+		keys := make([]string, 0, len(d.Tags))
+		for k := range d.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString(i18n.T("\nTags:\n"))
+		for _, k := range keys {
+			fmt.Fprintf(&b, "  %s = %s\n", k, d.Tags[k])
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	nodes := int32(0)
+	public := 0
+	for _, r := range v.Resources {
+		n, _ := r.Metadata["nodes"].(int32)
+		nodes += n
+		if strings.HasPrefix(r.GetMetadataString("endpoint_access"), "Public") {
+			public++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "nodes", Text: i18n.T("Nodes: %d", nodes), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "public", Text: i18n.T("Public endpoints: %d", public), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("EKS Clusters"), v.SummaryWidgets())
+}
+
+func (v *View) openForm(formID, action, title string, r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(formID, title, def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "eks" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
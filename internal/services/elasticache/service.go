@@ -0,0 +1,480 @@
+// Package elasticache provides ElastiCache integration for the a9s
+// application. It lists Redis, Valkey and Memcached clusters with their
+// nodes and encryption, reboots nodes and deletes clusters.
+package elasticache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// EngineMemcached is the engine of Memcached clusters, which keep no data
+// on disk: they have no encryption at rest and no snapshots.
+const EngineMemcached = "memcached"
+
+// Node is a cache node of a cluster.
+type Node struct {
+	ID       string
+	Status   string
+	AZ       string
+	Endpoint string
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements ElastiCache operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient ElastiCacheAPI
+}
+
+// ElastiCacheAPI defines the ElastiCache client interface.
+type ElastiCacheAPI interface {
+	DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+	ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error)
+	RebootCacheCluster(ctx context.Context, params *elasticache.RebootCacheClusterInput, optFns ...func(*elasticache.Options)) (*elasticache.RebootCacheClusterOutput, error)
+	DeleteCacheCluster(ctx context.Context, params *elasticache.DeleteCacheClusterInput, optFns ...func(*elasticache.Options)) (*elasticache.DeleteCacheClusterOutput, error)
+}
+
+// NewService creates a new ElastiCache service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client ElastiCacheAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the ElastiCache client.
+func (s *Service) client() ElastiCacheAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return elasticache.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "elasticache"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "ElastiCache"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "zap"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{MaxRecords: aws.Int32(20)})
+	if err != nil {
+		return core.NewServiceError("elasticache", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the cache clusters of the region with their nodes. The
+// members of a Redis or Valkey replication group are clusters of their own,
+// named after the group. Clusters without encryption are flagged as they
+// are listed; their tags are read by EnrichResource.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := []core.Resource{}
+
+	paginator := elasticache.NewDescribeCacheClustersPaginator(s.client(), &elasticache.DescribeCacheClustersInput{
+		ShowCacheNodeInfo: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("elasticache", "list", err)
+		}
+		for _, cluster := range page.CacheClusters {
+			resources = append(resources, clusterToResource(cluster))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "elasticache:cluster",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get retrieves a cluster by ID.
+func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
+	cluster, err := s.describe(ctx, id)
+	if err != nil {
+		return nil, core.NewServiceError("elasticache", "get", err)
+	}
+	resource := clusterToResource(*cluster)
+	return &resource, nil
+}
+
+// describe reads a cluster with its nodes.
+func (s *Service) describe(ctx context.Context, id string) (*types.CacheCluster, error) {
+	out, err := s.client().DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId:    aws.String(id),
+		ShowCacheNodeInfo: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.CacheClusters) == 0 {
+		return nil, core.ErrResourceNotFound
+	}
+	return &out.CacheClusters[0], nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds a cluster's tags, which ElastiCache only returns one
+// cluster at a time, and the infrastructure as code managing it.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	if resource.Type != "elasticache:cluster" || resource.ARN == "" {
+		return nil
+	}
+
+	out, err := s.client().ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{ResourceName: aws.String(resource.ARN)})
+	if err != nil {
+		return err
+	}
+	tags := make(map[string]string, len(out.TagList))
+	for _, tag := range out.TagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	resource.Tags = tags
+	iac.Apply(resource)
+	resource.Metadata["analyzed"] = true
+	return nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for clusters.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "reboot_node",
+			Description: "Reboot cache nodes (their cached data is lost)",
+			Icon:        "refresh",
+			Shortcut:    "b",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "nodes", Type: "string", Description: "Nodes to reboot, such as 0001,0002; empty reboots every node"},
+			},
+		},
+		{
+			Name:        "delete_cluster",
+			Description: "Delete the cluster and its nodes",
+			Icon:        "trash",
+			Shortcut:    "X",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "final_snapshot", Type: "string", Description: "Name of a final snapshot to take first (not for Memcached)"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a cluster, identified by its ID.
+// Both actions ask for confirmation through a core.ConfirmationError until
+// the "confirm" parameter is set; deleting asks to type the cluster's ID.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "reboot_node":
+		nodes, _ := params["nodes"].(string)
+		result, err = s.rebootNodes(ctx, resourceID, splitList(nodes), params, confirmed)
+	case "delete_cluster":
+		snapshot, _ := params["final_snapshot"].(string)
+		result, err = s.deleteCluster(ctx, resourceID, strings.TrimSpace(snapshot), params, core.Confirmed(params, resourceID, true))
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// rebootNodes reboots nodes of an available cluster once confirmed, every
+// node when none is named. Rebooted nodes lose their cached data.
+func (s *Service) rebootNodes(ctx context.Context, id string, nodes []string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("reboot_node", id, err)
+	}
+
+	cluster, err := s.describe(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	if status := aws.ToString(cluster.CacheClusterStatus); status != "available" {
+		return fail(core.NewValidationError("cluster", id, "is "+status+", not available"))
+	}
+	var known []string
+	for _, node := range cluster.CacheNodes {
+		known = append(known, aws.ToString(node.CacheNodeId))
+	}
+	if len(nodes) == 0 {
+		nodes = known
+	}
+	for _, node := range nodes {
+		if !slices.Contains(known, node) {
+			return fail(core.NewValidationError("nodes", node, "is not a node of "+id))
+		}
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Reboots %s node(s) %s of %s; they are unavailable for a few minutes and lose their cached data", aws.ToString(cluster.Engine), strings.Join(nodes, ", "), id)
+		return nil, core.NewConfirmationError(s, "reboot_node", id, params, reason, false)
+	}
+
+	if _, err := s.client().RebootCacheCluster(ctx, &elasticache.RebootCacheClusterInput{
+		CacheClusterId:       aws.String(id),
+		CacheNodeIdsToReboot: nodes,
+	}); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Rebooting node(s) %s of %s", strings.Join(nodes, ", "), id)), nil
+}
+
+// deleteCluster deletes a cluster once confirmed by typing its ID, after
+// a final snapshot when one is named.
+func (s *Service) deleteCluster(ctx context.Context, id, snapshot string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete_cluster", id, err)
+	}
+
+	cluster, err := s.describe(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	engine := aws.ToString(cluster.Engine)
+	if snapshot != "" && engine == EngineMemcached {
+		return fail(core.NewValidationError("final_snapshot", snapshot, "Memcached clusters have no snapshots"))
+	}
+
+	if !confirmed {
+		reason := fmt.Sprintf("Deletes %s cluster %s with its %d node(s) and cached data", engine, id, len(cluster.CacheNodes))
+		if group := aws.ToString(cluster.ReplicationGroupId); group != "" {
+			reason += fmt.Sprintf("; it belongs to replication group %s, which only lets its replicas be deleted", group)
+		}
+		if snapshot != "" {
+			reason += fmt.Sprintf(", after final snapshot %s", snapshot)
+		} else if engine != EngineMemcached {
+			reason += ", without a final snapshot"
+		}
+		return nil, core.NewConfirmationError(s, "delete_cluster", id, params, reason, true)
+	}
+
+	input := &elasticache.DeleteCacheClusterInput{CacheClusterId: aws.String(id)}
+	if snapshot != "" {
+		input.FinalSnapshotIdentifier = aws.String(snapshot)
+	}
+	if _, err := s.client().DeleteCacheCluster(ctx, input); err != nil {
+		var state *types.InvalidCacheClusterStateFault
+		if errors.As(err, &state) {
+			return fail(fmt.Errorf("cluster %s cannot be deleted in its current state: %w", id, err))
+		}
+		return fail(err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   id,
+		ResourceType: "elasticache:cluster",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleting cluster %s", id)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// clusterToResource converts a cluster, flagging missing encryption in
+// transit and, for engines keeping data on disk, at rest.
+func clusterToResource(cluster types.CacheCluster) core.Resource {
+	id := aws.ToString(cluster.CacheClusterId)
+	engine := aws.ToString(cluster.Engine)
+	transit := aws.ToBool(cluster.TransitEncryptionEnabled)
+	atRest := aws.ToBool(cluster.AtRestEncryptionEnabled)
+
+	nodes := make([]Node, 0, len(cluster.CacheNodes))
+	for _, node := range cluster.CacheNodes {
+		n := Node{
+			ID:     aws.ToString(node.CacheNodeId),
+			Status: aws.ToString(node.CacheNodeStatus),
+			AZ:     aws.ToString(node.CustomerAvailabilityZone),
+		}
+		if node.Endpoint != nil {
+			n.Endpoint = fmt.Sprintf("%s:%d", aws.ToString(node.Endpoint.Address), aws.ToInt32(node.Endpoint.Port))
+		}
+		nodes = append(nodes, n)
+	}
+	endpoint := ""
+	if cluster.ConfigurationEndpoint != nil {
+		endpoint = fmt.Sprintf("%s:%d", aws.ToString(cluster.ConfigurationEndpoint.Address), aws.ToInt32(cluster.ConfigurationEndpoint.Port))
+	} else if len(nodes) == 1 {
+		endpoint = nodes[0].Endpoint
+	}
+
+	resource := core.Resource{
+		ID:        id,
+		Type:      "elasticache:cluster",
+		Name:      id,
+		ARN:       aws.ToString(cluster.ARN),
+		State:     aws.ToString(cluster.CacheClusterStatus),
+		CreatedAt: cluster.CacheClusterCreateTime,
+		Tags:      map[string]string{},
+		Metadata: map[string]any{
+			"engine":             engine,
+			"engine_version":     aws.ToString(cluster.EngineVersion),
+			"node_type":          aws.ToString(cluster.CacheNodeType),
+			"node_count":         int(aws.ToInt32(cluster.NumCacheNodes)),
+			"nodes":              nodes,
+			"endpoint":           endpoint,
+			"replication_group":  aws.ToString(cluster.ReplicationGroupId),
+			"az":                 aws.ToString(cluster.PreferredAvailabilityZone),
+			"transit_encryption": transit,
+			"at_rest_encryption": atRest,
+			"maintenance_window": aws.ToString(cluster.PreferredMaintenanceWindow),
+		},
+	}
+
+	switch {
+	case !transit:
+		resource.AddIssue(core.SeverityMedium, "Encryption in transit disabled")
+	case cluster.TransitEncryptionMode == types.TransitEncryptionModePreferred:
+		resource.AddIssue(core.SeverityLow, "Accepts unencrypted connections (transit encryption mode preferred)")
+	}
+	if !atRest && engine != EngineMemcached {
+		resource.AddIssue(core.SeverityMedium, "Encryption at rest disabled")
+	}
+	return resource
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "elasticache", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "elasticache", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
@@ -0,0 +1,195 @@
+package elasticache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeElastiCache serves an encrypted Redis replica of the sessions group
+// and an unencrypted two-node Memcached cluster, or fails every call when
+// err is set. It records reboots and deletions.
+type fakeElastiCache struct {
+	err      error
+	rebooted []string
+	deleted  []string
+	snapshot string
+}
+
+func (f *fakeElastiCache) clusters() []types.CacheCluster {
+	return []types.CacheCluster{
+		{
+			CacheClusterId:           aws.String("sessions-001"),
+			ARN:                      aws.String("arn:aws:elasticache:us-east-1:123456789012:cluster:sessions-001"),
+			CacheClusterStatus:       aws.String("available"),
+			Engine:                   aws.String("redis"),
+			EngineVersion:            aws.String("7.1"),
+			CacheNodeType:            aws.String("cache.t4g.small"),
+			NumCacheNodes:            aws.Int32(1),
+			ReplicationGroupId:       aws.String("sessions"),
+			TransitEncryptionEnabled: aws.Bool(true),
+			AtRestEncryptionEnabled:  aws.Bool(true),
+			CacheNodes: []types.CacheNode{{
+				CacheNodeId:     aws.String("0001"),
+				CacheNodeStatus: aws.String("available"),
+				Endpoint:        &types.Endpoint{Address: aws.String("sessions-001.abc.cache.amazonaws.com"), Port: aws.Int32(6379)},
+			}},
+		},
+		{
+			CacheClusterId:        aws.String("pages"),
+			ARN:                   aws.String("arn:aws:elasticache:us-east-1:123456789012:cluster:pages"),
+			CacheClusterStatus:    aws.String("available"),
+			Engine:                aws.String(EngineMemcached),
+			EngineVersion:         aws.String("1.6.22"),
+			CacheNodeType:         aws.String("cache.m7g.large"),
+			NumCacheNodes:         aws.Int32(2),
+			ConfigurationEndpoint: &types.Endpoint{Address: aws.String("pages.abc.cfg.cache.amazonaws.com"), Port: aws.Int32(11211)},
+			CacheNodes: []types.CacheNode{
+				{CacheNodeId: aws.String("0001"), CacheNodeStatus: aws.String("available")},
+				{CacheNodeId: aws.String("0002"), CacheNodeStatus: aws.String("available")},
+			},
+		},
+	}
+}
+
+func (f *fakeElastiCache) DescribeCacheClusters(_ context.Context, in *elasticache.DescribeCacheClustersInput, _ ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	clusters := f.clusters()
+	if in.CacheClusterId == nil {
+		return &elasticache.DescribeCacheClustersOutput{CacheClusters: clusters}, nil
+	}
+	for _, cluster := range clusters {
+		if aws.ToString(cluster.CacheClusterId) == aws.ToString(in.CacheClusterId) {
+			return &elasticache.DescribeCacheClustersOutput{CacheClusters: []types.CacheCluster{cluster}}, nil
+		}
+	}
+	return nil, &types.CacheClusterNotFoundFault{Message: aws.String("CacheCluster not found")}
+}
+
+func (f *fakeElastiCache) ListTagsForResource(context.Context, *elasticache.ListTagsForResourceInput, ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elasticache.ListTagsForResourceOutput{TagList: []types.Tag{{Key: aws.String("team"), Value: aws.String("web")}}}, nil
+}
+
+func (f *fakeElastiCache) RebootCacheCluster(_ context.Context, in *elasticache.RebootCacheClusterInput, _ ...func(*elasticache.Options)) (*elasticache.RebootCacheClusterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.rebooted = append(f.rebooted, in.CacheNodeIdsToReboot...)
+	return &elasticache.RebootCacheClusterOutput{}, nil
+}
+
+func (f *fakeElastiCache) DeleteCacheCluster(_ context.Context, in *elasticache.DeleteCacheClusterInput, _ ...func(*elasticache.Options)) (*elasticache.DeleteCacheClusterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.CacheClusterId))
+	f.snapshot = aws.ToString(in.FinalSnapshotIdentifier)
+	return &elasticache.DeleteCacheClusterOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against clusters.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeElastiCache{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeElastiCache{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    "pages",
+		MissingID:     "missing",
+		Action:        "reboot_node",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "delete_cluster",
+	})
+}
+
+func TestListFlagsEncryption(t *testing.T) {
+	svc := NewServiceWithClient(&fakeElastiCache{}, nil)
+	ctx := context.Background()
+
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() returned %d clusters, want 2", len(resources))
+	}
+	redis, memcached := resources[0], resources[1]
+
+	if len(redis.Issues()) != 0 {
+		t.Errorf("encrypted Redis cluster has issues %v", redis.Issues())
+	}
+	if redis.GetMetadataString("endpoint") != "sessions-001.abc.cache.amazonaws.com:6379" {
+		t.Errorf("Redis endpoint = %q", redis.GetMetadataString("endpoint"))
+	}
+	issues := memcached.Issues()
+	if len(issues) != 1 || issues[0].Severity != core.SeverityMedium || !strings.Contains(issues[0].Message, "transit") {
+		t.Errorf("Memcached issues = %v, want only encryption in transit flagged", issues)
+	}
+	if nodes, _ := memcached.Metadata["nodes"].([]Node); len(nodes) != 2 {
+		t.Errorf("Memcached nodes = %v", memcached.Metadata["nodes"])
+	}
+
+	if err := svc.EnrichResource(ctx, &memcached); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+	if memcached.Tags["team"] != "web" {
+		t.Errorf("tags = %v", memcached.Tags)
+	}
+}
+
+func TestRebootNode(t *testing.T) {
+	client := &fakeElastiCache{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "reboot_node", "pages", map[string]any{"nodes": "0002"})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "0002") {
+		t.Fatalf("reboot_node error = %v, want a confirmation naming the node", err)
+	}
+	if _, err := svc.Execute(ctx, "reboot_node", "pages", map[string]any{"nodes": "0003", core.ParamConfirm: true}); err == nil {
+		t.Error("rebooting an unknown node succeeded")
+	}
+	if _, err := svc.Execute(ctx, "reboot_node", "pages", map[string]any{"nodes": "0002", core.ParamConfirm: true}); err != nil {
+		t.Fatalf("confirmed reboot_node error = %v", err)
+	}
+	if len(client.rebooted) != 1 || client.rebooted[0] != "0002" {
+		t.Errorf("rebooted = %v, want [0002]", client.rebooted)
+	}
+}
+
+func TestDeleteCluster(t *testing.T) {
+	client := &fakeElastiCache{}
+	svc := NewServiceWithClient(client, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "delete_cluster", "sessions-001", map[string]any{"final_snapshot": "sessions-final"})
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !confirm.TypeResource || !strings.Contains(confirm.Reason, "replication group sessions") {
+		t.Fatalf("delete_cluster error = %v, want a confirmation typing the ID and naming the group", err)
+	}
+	if _, err := svc.Execute(ctx, "delete_cluster", "pages", map[string]any{"final_snapshot": "pages-final", core.ParamConfirm: true, core.ParamConfirmResource: "pages"}); err == nil {
+		t.Error("deleting Memcached with a final snapshot succeeded")
+	}
+	if _, err := svc.Execute(ctx, "delete_cluster", "sessions-001", map[string]any{"final_snapshot": "sessions-final", core.ParamConfirm: true, core.ParamConfirmResource: "sessions-001"}); err != nil {
+		t.Fatalf("confirmed delete_cluster error = %v", err)
+	}
+	if len(client.deleted) != 1 || client.snapshot != "sessions-final" {
+		t.Errorf("deleted = %v with snapshot %q", client.deleted, client.snapshot)
+	}
+}
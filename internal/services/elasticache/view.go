@@ -0,0 +1,358 @@
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// actionFormPrefix prefixes the IDs of the forms opened for an action,
+// followed by the action name.
+const actionFormPrefix = "elasticache:"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for ElastiCache clusters.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget *core.Resource // Cluster the open action form is for
+}
+
+// NewView creates a new ElastiCache view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Engine"), MinWidth: 10, MaxWidth: 18, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Node Type"), MinWidth: 12, MaxWidth: 20, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Nodes"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Status"), MinWidth: 9, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Replication Group"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("Encryption"), MinWidth: 10, MaxWidth: 18, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Endpoint"), MinWidth: 20, MaxWidth: 70, Weight: 1.5, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("ElastiCache", "", "elasticache", i18n.T("clusters"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "b":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openActionForm(row, "reboot_node", i18n.T("Reboot nodes of %s", row.Name))
+			}
+		case "X":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openActionForm(row, "delete_cluster", i18n.T("Delete %s", row.Name))
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Cluster %s", row.Name), formatCluster(row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		action, ok := strings.CutPrefix(msg.ID, actionFormPrefix)
+		if !ok {
+			break
+		}
+		target := v.formTarget
+		v.formTarget = nil
+		if msg.Canceled || target == nil {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		if action == "reboot_node" {
+			v.Message = i18n.T("Rebooting nodes of %s...", target.Name)
+		} else {
+			v.Message = i18n.T("Deleting %s...", target.Name)
+		}
+		cmds = append(cmds, v.executeAction(action, target.ID, msg.Values))
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.SoftRefresh())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading clusters...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  re[b]oot nodes  [X]delete  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the clusters, keeping the tags already read.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// openActionForm asks for the parameters of an action on a cluster. The
+// final snapshot of a deletion defaults to one named after the cluster,
+// for engines that have snapshots.
+func (v *View) openActionForm(r *core.Resource, action, title string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	params := make([]core.ActionParameter, 0, len(def.Parameters))
+	for _, param := range def.Parameters {
+		if param.Name == "final_snapshot" {
+			if r.GetMetadataString("engine") == EngineMemcached {
+				continue
+			}
+			param.Default = r.ID + "-final"
+		}
+		params = append(params, param)
+	}
+	target := *r
+	v.formTarget = &target
+	return v.OpenForm(components.NewForm(actionFormPrefix+action, title, params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	engine := r.GetMetadataString("engine")
+	if version := r.GetMetadataString("engine_version"); version != "" {
+		engine += " " + version
+	}
+	group := r.GetMetadataString("replication_group")
+	if group == "" {
+		group = "-"
+	}
+	endpoint := r.GetMetadataString("endpoint")
+	if endpoint == "" {
+		endpoint = "-"
+	}
+	nodes, _ := r.Metadata["node_count"].(int)
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.ID, 40)),
+		base.TextCell(engine),
+		base.TextCell(r.GetMetadataString("node_type")),
+		base.LazyCell(nodes, func() string { return fmt.Sprintf("%d", nodes) }),
+		base.TextCell(r.State),
+		base.TextCell(group),
+		base.TextCell(formatEncryption(r)),
+		base.TextCell(endpoint),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+		base.AgeCell(r),
+	}
+}
+
+// formatEncryption summarizes a cluster's encryption in transit and at
+// rest, such as "transit, rest".
+func formatEncryption(r core.Resource) string {
+	var parts []string
+	if transit, _ := r.Metadata["transit_encryption"].(bool); transit {
+		parts = append(parts, "transit")
+	}
+	if atRest, _ := r.Metadata["at_rest_encryption"].(bool); atRest {
+		parts = append(parts, "rest")
+	}
+	if len(parts) == 0 {
+		return i18n.T("none")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatCluster renders a cluster with its nodes and encryption for the
+// detail panel.
+func formatCluster(r *core.Resource) string {
+	yesNo := func(key string) string {
+		if on, _ := r.Metadata[key].(bool); on {
+			return "enabled"
+		}
+		return "disabled"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID:          %s\n", r.ID)
+	fmt.Fprintf(&b, "Engine:      %s %s\n", r.GetMetadataString("engine"), r.GetMetadataString("engine_version"))
+	fmt.Fprintf(&b, "Node type:   %s\n", r.GetMetadataString("node_type"))
+	fmt.Fprintf(&b, "Status:      %s\n", r.State)
+	if group := r.GetMetadataString("replication_group"); group != "" {
+		fmt.Fprintf(&b, "Replication: %s\n", group)
+	}
+	if endpoint := r.GetMetadataString("endpoint"); endpoint != "" {
+		fmt.Fprintf(&b, "Endpoint:    %s\n", endpoint)
+	}
+	if window := r.GetMetadataString("maintenance_window"); window != "" {
+		fmt.Fprintf(&b, "Maintenance: %s\n", window)
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:     %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	b.WriteString(i18n.T("\nEncryption:\n"))
+	fmt.Fprintf(&b, "  In transit: %s\n", yesNo("transit_encryption"))
+	if r.GetMetadataString("engine") == EngineMemcached {
+		b.WriteString("  At rest:    not supported by Memcached\n")
+	} else {
+		fmt.Fprintf(&b, "  At rest:    %s\n", yesNo("at_rest_encryption"))
+	}
+
+	if nodes, ok := r.Metadata["nodes"].([]Node); ok {
+		b.WriteString(i18n.T("\nNodes:\n"))
+		if len(nodes) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, node := range nodes {
+			fmt.Fprintf(&b, "  %s  %-10s %-12s %s\n", node.ID, node.Status, node.AZ, node.Endpoint)
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	memcached, unencrypted := 0, 0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("engine") == EngineMemcached {
+			memcached++
+		}
+		if len(r.Issues()) > 0 {
+			unencrypted++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "redis", Text: i18n.T("Redis/Valkey: %d", len(v.Resources)-memcached), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "memcached", Text: i18n.T("Memcached: %d", memcached), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "unencrypted", Text: i18n.T("Unencrypted: %d", unencrypted), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("ElastiCache"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "elasticache" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
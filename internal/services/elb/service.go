@@ -0,0 +1,787 @@
+// Package elb provides load balancer operations for the a9s application.
+// It lists Classic, Application, Network and Gateway load balancers and
+// reports the health of the targets behind them.
+package elb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	classic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	classictypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// Pricing used for cost estimates (us-east-1 on-demand). Capacity units
+// depend on traffic and are not included.
+const (
+	pricePerHour        = 0.0225
+	classicPricePerHour = 0.025
+)
+
+// tagBatch is the most load balancers DescribeTags accepts at once.
+const tagBatch = 20
+
+// Load balancer types, as shown in the Type column.
+const (
+	TypeApplication = "application"
+	TypeNetwork     = "network"
+	TypeGateway     = "gateway"
+	TypeClassic     = "classic"
+)
+
+// TargetGroup is a target group of a load balancer with the health of its
+// registered targets. Classic load balancers have a single group holding
+// their instances.
+type TargetGroup struct {
+	Name     string
+	ARN      string // Empty for Classic load balancers
+	Protocol string
+	Port     int32
+	Targets  []Target
+}
+
+// Target is a registered target and its health.
+type Target struct {
+	ID     string
+	Port   int32
+	State  string // "healthy", "unhealthy", "draining"... or "InService", "OutOfService"
+	Reason string
+}
+
+// Unhealthy reports whether the target fails its health checks.
+func (t Target) Unhealthy() bool {
+	return t.State == string(types.TargetHealthStateEnumUnhealthy) || t.State == "OutOfService"
+}
+
+// Healthy reports whether the target passes its health checks.
+func (t Target) Healthy() bool {
+	return t.State == string(types.TargetHealthStateEnumHealthy) || t.State == "InService"
+}
+
+// Listener is a listener of a load balancer and where it sends requests.
+type Listener struct {
+	Protocol     string
+	Port         int32
+	Action       string
+	Certificates []string
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements load balancer operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    ELBv2API
+	classicClient ClassicAPI
+}
+
+// Option configures the load balancer service.
+type Option func(*Service)
+
+// WithClassicClient sets a custom Classic Load Balancing client (for
+// testing).
+func WithClassicClient(client ClassicAPI) Option {
+	return func(s *Service) {
+		s.classicClient = client
+	}
+}
+
+// ELBv2API defines the Elastic Load Balancing v2 client interface for
+// mocking.
+type ELBv2API interface {
+	DescribeLoadBalancers(ctx context.Context, params *elbv2.DescribeLoadBalancersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error)
+	DescribeTags(ctx context.Context, params *elbv2.DescribeTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTagsOutput, error)
+	DescribeTargetGroups(ctx context.Context, params *elbv2.DescribeTargetGroupsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error)
+	DescribeTargetHealth(ctx context.Context, params *elbv2.DescribeTargetHealthInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeListeners(ctx context.Context, params *elbv2.DescribeListenersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeListenersOutput, error)
+	DeregisterTargets(ctx context.Context, params *elbv2.DeregisterTargetsInput, optFns ...func(*elbv2.Options)) (*elbv2.DeregisterTargetsOutput, error)
+	DeleteLoadBalancer(ctx context.Context, params *elbv2.DeleteLoadBalancerInput, optFns ...func(*elbv2.Options)) (*elbv2.DeleteLoadBalancerOutput, error)
+}
+
+// ClassicAPI defines the Classic Load Balancing client interface for
+// mocking.
+type ClassicAPI interface {
+	DescribeLoadBalancers(ctx context.Context, params *classic.DescribeLoadBalancersInput, optFns ...func(*classic.Options)) (*classic.DescribeLoadBalancersOutput, error)
+	DescribeTags(ctx context.Context, params *classic.DescribeTagsInput, optFns ...func(*classic.Options)) (*classic.DescribeTagsOutput, error)
+	DescribeInstanceHealth(ctx context.Context, params *classic.DescribeInstanceHealthInput, optFns ...func(*classic.Options)) (*classic.DescribeInstanceHealthOutput, error)
+	DeregisterInstancesFromLoadBalancer(ctx context.Context, params *classic.DeregisterInstancesFromLoadBalancerInput, optFns ...func(*classic.Options)) (*classic.DeregisterInstancesFromLoadBalancerOutput, error)
+	DeleteLoadBalancer(ctx context.Context, params *classic.DeleteLoadBalancerInput, optFns ...func(*classic.Options)) (*classic.DeleteLoadBalancerOutput, error)
+}
+
+// NewService creates a new load balancer service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client ELBv2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the Elastic Load Balancing v2 client.
+func (s *Service) client() ELBv2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return elbv2.NewFromConfig(s.factory.Config())
+}
+
+// classic returns the Classic Load Balancing client.
+func (s *Service) classic() ClassicAPI {
+	if s.classicClient != nil {
+		return s.classicClient
+	}
+	return classic.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region load balancers are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "elb"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Load Balancers"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
+		PageSize: aws.Int32(1),
+	})
+	if err != nil {
+		return core.NewServiceError("elb", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the Application, Network and Gateway load balancers of the
+// region followed by the Classic ones. When only one of the two APIs can be
+// read, its load balancers are returned with a core.PartialError.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	failed := make(map[string]error)
+	resources, v2Err := s.listV2(ctx)
+	if v2Err != nil {
+		s.dispatchError(ctx, "list", v2Err)
+		failed["Application, Network and Gateway"] = v2Err
+	}
+	classicResources, classicErr := s.listClassic(ctx)
+	if classicErr != nil {
+		s.dispatchError(ctx, "list_classic", classicErr)
+		failed["Classic"] = classicErr
+	}
+	if v2Err != nil && classicErr != nil {
+		return nil, core.NewServiceError("elb", "list", errors.Join(v2Err, classicErr))
+	}
+	resources = append(resources, classicResources...)
+
+	for i := range resources {
+		iac.Apply(&resources[i])
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "elbv2:loadbalancer",
+		Count:        len(resources),
+	})
+
+	return resources, core.NewPartialError("load balancer types", failed)
+}
+
+func (s *Service) listV2(ctx context.Context) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(s.client(), &elbv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range page.LoadBalancers {
+			resources = append(resources, loadBalancerToResource(lb, s.region()))
+		}
+	}
+
+	for start := 0; start < len(resources); start += tagBatch {
+		batch := resources[start:min(start+tagBatch, len(resources))]
+		arns := make([]string, len(batch))
+		for i, r := range batch {
+			arns[i] = r.ID
+		}
+		out, err := s.client().DescribeTags(ctx, &elbv2.DescribeTagsInput{ResourceArns: arns})
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range out.TagDescriptions {
+			for i := range batch {
+				if batch[i].ID != aws.ToString(desc.ResourceArn) {
+					continue
+				}
+				for _, tag := range desc.Tags {
+					batch[i].Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (s *Service) listClassic(ctx context.Context) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := classic.NewDescribeLoadBalancersPaginator(s.classic(), &classic.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range page.LoadBalancerDescriptions {
+			resources = append(resources, s.classicToResource(lb))
+		}
+	}
+
+	for start := 0; start < len(resources); start += tagBatch {
+		batch := resources[start:min(start+tagBatch, len(resources))]
+		names := make([]string, len(batch))
+		for i, r := range batch {
+			names[i] = r.ID
+		}
+		out, err := s.classic().DescribeTags(ctx, &classic.DescribeTagsInput{LoadBalancerNames: names})
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range out.TagDescriptions {
+			for i := range batch {
+				if batch[i].ID != aws.ToString(desc.LoadBalancerName) {
+					continue
+				}
+				for _, tag := range desc.Tags {
+					batch[i].Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+// EnrichResource adds the target groups of a load balancer with the health
+// of their targets, and flags unhealthy or missing targets.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	var groups []TargetGroup
+	var err error
+	if isClassic(resource.ID) {
+		groups, err = s.classicTargets(ctx, resource.ID)
+	} else {
+		groups, err = s.targetGroups(ctx, resource.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	total, healthy, unhealthy := 0, 0, 0
+	for _, group := range groups {
+		for _, target := range group.Targets {
+			total++
+			switch {
+			case target.Healthy():
+				healthy++
+			case target.Unhealthy():
+				unhealthy++
+			}
+		}
+	}
+
+	resource.Metadata["target_groups"] = groups
+	resource.Metadata["targets"] = total
+	resource.Metadata["healthy_targets"] = healthy
+	resource.Metadata["unhealthy_targets"] = unhealthy
+	resource.Metadata["analyzed"] = true
+
+	if resource.State == string(types.LoadBalancerStateEnumActiveImpaired) {
+		resource.AddIssue(core.SeverityMedium, "Impaired: cannot scale")
+	}
+	switch {
+	case total == 0:
+		resource.AddIssue(core.SeverityLow, "No registered targets")
+	case healthy == 0 && unhealthy > 0:
+		resource.AddIssue(core.SeverityHigh, fmt.Sprintf("All %d targets unhealthy", total))
+	case unhealthy > 0:
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Unhealthy targets: %d of %d", unhealthy, total))
+	}
+
+	return nil
+}
+
+// targetGroups returns the target groups of a load balancer with their
+// target health.
+func (s *Service) targetGroups(ctx context.Context, arn string) ([]TargetGroup, error) {
+	var groups []TargetGroup
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(s.client(), &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(arn),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tg := range page.TargetGroups {
+			group := TargetGroup{
+				Name:     aws.ToString(tg.TargetGroupName),
+				ARN:      aws.ToString(tg.TargetGroupArn),
+				Protocol: string(tg.Protocol),
+				Port:     aws.ToInt32(tg.Port),
+			}
+			health, err := s.client().DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{
+				TargetGroupArn: tg.TargetGroupArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, desc := range health.TargetHealthDescriptions {
+				target := Target{}
+				if desc.Target != nil {
+					target.ID = aws.ToString(desc.Target.Id)
+					target.Port = aws.ToInt32(desc.Target.Port)
+				}
+				if desc.TargetHealth != nil {
+					target.State = string(desc.TargetHealth.State)
+					target.Reason = aws.ToString(desc.TargetHealth.Description)
+				}
+				group.Targets = append(group.Targets, target)
+			}
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// classicTargets returns the instances of a Classic load balancer as a
+// single target group.
+func (s *Service) classicTargets(ctx context.Context, name string) ([]TargetGroup, error) {
+	out, err := s.classic().DescribeInstanceHealth(ctx, &classic.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	group := TargetGroup{Name: name}
+	for _, state := range out.InstanceStates {
+		group.Targets = append(group.Targets, Target{
+			ID:     aws.ToString(state.InstanceId),
+			State:  aws.ToString(state.State),
+			Reason: aws.ToString(state.Description),
+		})
+	}
+	return []TargetGroup{group}, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for load balancers.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "listeners",
+			Description: "Describe the listeners of the load balancer",
+			Icon:        "list",
+			Shortcut:    "l",
+			Dangerous:   false,
+			Category:    "info",
+		},
+		{
+			Name:        "deregister",
+			Description: "Deregister a target from the load balancer",
+			Icon:        "unlink",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "target", Type: "string", Required: true, Description: "Target ID (instance ID, IP address or Lambda ARN)"},
+				{Name: "target_group", Type: "string", Description: "Target group ARN (empty for Classic load balancers)"},
+				{Name: "port", Type: "int", Default: 0, Description: "Target port (0 for the target group's port)"},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the load balancer",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a load balancer. Deregistering and
+// deleting ask for confirmation through a core.ConfirmationError until the
+// "confirm" parameter is set; deleting also needs the load balancer's ID
+// typed back.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "listeners":
+		result, err = s.describeListeners(ctx, resourceID)
+	case "deregister":
+		if confirmed, _ := params[core.ParamConfirm].(bool); !confirmed {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "The target stops receiving new requests", false)
+		}
+		target, _ := params["target"].(string)
+		group, _ := params["target_group"].(string)
+		port, _ := params["port"].(int)
+		result, err = s.deregisterTarget(ctx, resourceID, group, target, int32(port))
+	case "delete":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Deleting a load balancer cannot be undone and its DNS name stops resolving", true)
+		}
+		result, err = s.deleteLoadBalancer(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// describeListeners returns the listeners of a load balancer as []Listener.
+func (s *Service) describeListeners(ctx context.Context, id string) (*core.ActionResult, error) {
+	var listeners []Listener
+	if isClassic(id) {
+		out, err := s.classic().DescribeLoadBalancers(ctx, &classic.DescribeLoadBalancersInput{
+			LoadBalancerNames: []string{id},
+		})
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("listeners", id, err)
+		}
+		for _, lb := range out.LoadBalancerDescriptions {
+			listeners = append(listeners, classicListeners(lb)...)
+		}
+	} else {
+		paginator := elbv2.NewDescribeListenersPaginator(s.client(), &elbv2.DescribeListenersInput{
+			LoadBalancerArn: aws.String(id),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return core.NewActionResult(false, err.Error()), core.NewActionError("listeners", id, err)
+			}
+			for _, l := range page.Listeners {
+				listener := Listener{
+					Protocol: string(l.Protocol),
+					Port:     aws.ToInt32(l.Port),
+					Action:   describeActions(l.DefaultActions),
+				}
+				for _, cert := range l.Certificates {
+					listener.Certificates = append(listener.Certificates, aws.ToString(cert.CertificateArn))
+				}
+				listeners = append(listeners, listener)
+			}
+		}
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d listeners", len(listeners)))
+	result.Data = listeners
+	return result, nil
+}
+
+// deregisterTarget removes a target from a target group, or an instance
+// from a Classic load balancer.
+func (s *Service) deregisterTarget(ctx context.Context, id, group, target string, port int32) (*core.ActionResult, error) {
+	if target == "" {
+		err := core.NewValidationError("target", target, "is required")
+		return core.NewActionResult(false, err.Error()), core.NewActionError("deregister", id, err)
+	}
+
+	var err error
+	if isClassic(id) {
+		_, err = s.classic().DeregisterInstancesFromLoadBalancer(ctx, &classic.DeregisterInstancesFromLoadBalancerInput{
+			LoadBalancerName: aws.String(id),
+			Instances:        []classictypes.Instance{{InstanceId: aws.String(target)}},
+		})
+	} else {
+		if group == "" {
+			err := core.NewValidationError("target_group", group, "is required for this load balancer")
+			return core.NewActionResult(false, err.Error()), core.NewActionError("deregister", id, err)
+		}
+		desc := types.TargetDescription{Id: aws.String(target)}
+		if port > 0 {
+			desc.Port = aws.Int32(port)
+		}
+		_, err = s.client().DeregisterTargets(ctx, &elbv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(group),
+			Targets:        []types.TargetDescription{desc},
+		})
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("deregister", id, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Target %s is deregistering", target)), nil
+}
+
+// deleteLoadBalancer deletes a load balancer. Its target groups are kept.
+func (s *Service) deleteLoadBalancer(ctx context.Context, id string) (*core.ActionResult, error) {
+	var err error
+	if isClassic(id) {
+		_, err = s.classic().DeleteLoadBalancer(ctx, &classic.DeleteLoadBalancerInput{
+			LoadBalancerName: aws.String(id),
+		})
+	} else {
+		_, err = s.client().DeleteLoadBalancer(ctx, &elbv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: aws.String(id),
+		})
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Load balancer %s deleted", nameOf(id))), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// isClassic reports whether a resource ID is a Classic load balancer name
+// rather than an ARN.
+func isClassic(id string) bool {
+	return !strings.HasPrefix(id, "arn:")
+}
+
+// nameOf returns the name of a load balancer from its ID.
+func nameOf(id string) string {
+	if isClassic(id) {
+		return id
+	}
+	// arn:...:loadbalancer/app/<name>/<id>
+	parts := strings.Split(id, "/")
+	if len(parts) >= 3 {
+		return parts[len(parts)-2]
+	}
+	return id
+}
+
+func loadBalancerToResource(lb types.LoadBalancer, region string) core.Resource {
+	state := core.StateUnknown
+	if lb.State != nil {
+		state = string(lb.State.Code)
+	}
+	resource := core.Resource{
+		ID:        aws.ToString(lb.LoadBalancerArn),
+		ARN:       aws.ToString(lb.LoadBalancerArn),
+		Name:      aws.ToString(lb.LoadBalancerName),
+		Type:      "elbv2:loadbalancer",
+		Region:    region,
+		State:     state,
+		CreatedAt: lb.CreatedTime,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"lb_type":  string(lb.Type),
+			"scheme":   string(lb.Scheme),
+			"dns_name": aws.ToString(lb.DNSName),
+			"vpc_id":   aws.ToString(lb.VpcId),
+		},
+	}
+	if lb.State != nil && lb.State.Reason != nil {
+		resource.Metadata["state_reason"] = aws.ToString(lb.State.Reason)
+	}
+	estimate.ApplyCost(&resource, estimate.Monthly(pricePerHour))
+	return resource
+}
+
+func (s *Service) classicToResource(lb classictypes.LoadBalancerDescription) core.Resource {
+	name := aws.ToString(lb.LoadBalancerName)
+	resource := core.Resource{
+		ID:        name,
+		Name:      name,
+		Type:      "elb:loadbalancer",
+		Region:    s.region(),
+		State:     core.StateActive,
+		CreatedAt: lb.CreatedTime,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"lb_type":   TypeClassic,
+			"scheme":    aws.ToString(lb.Scheme),
+			"dns_name":  aws.ToString(lb.DNSName),
+			"vpc_id":    aws.ToString(lb.VPCId),
+			"instances": len(lb.Instances),
+		},
+	}
+	estimate.ApplyCost(&resource, estimate.Monthly(classicPricePerHour))
+	return resource
+}
+
+// classicListeners converts the listeners of a Classic load balancer.
+func classicListeners(lb classictypes.LoadBalancerDescription) []Listener {
+	var listeners []Listener
+	for _, desc := range lb.ListenerDescriptions {
+		l := desc.Listener
+		if l == nil {
+			continue
+		}
+		listener := Listener{
+			Protocol: aws.ToString(l.Protocol),
+			Port:     l.LoadBalancerPort,
+			Action:   fmt.Sprintf("forward to instances %s:%d", aws.ToString(l.InstanceProtocol), aws.ToInt32(l.InstancePort)),
+		}
+		if cert := aws.ToString(l.SSLCertificateId); cert != "" {
+			listener.Certificates = []string{cert}
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners
+}
+
+// describeActions summarizes the default actions of a listener, e.g.
+// "forward to my-targets" or "redirect to HTTPS:443".
+func describeActions(actions []types.Action) string {
+	var parts []string
+	for _, a := range actions {
+		switch a.Type {
+		case types.ActionTypeEnumForward:
+			var groups []string
+			if a.ForwardConfig != nil {
+				for _, tg := range a.ForwardConfig.TargetGroups {
+					groups = append(groups, nameOf(aws.ToString(tg.TargetGroupArn)))
+				}
+			}
+			if len(groups) == 0 && a.TargetGroupArn != nil {
+				groups = append(groups, nameOf(aws.ToString(a.TargetGroupArn)))
+			}
+			parts = append(parts, "forward to "+strings.Join(groups, ", "))
+		case types.ActionTypeEnumRedirect:
+			if rc := a.RedirectConfig; rc != nil {
+				parts = append(parts, fmt.Sprintf("redirect to %s:%s (%s)", aws.ToString(rc.Protocol), aws.ToString(rc.Port), rc.StatusCode))
+			} else {
+				parts = append(parts, "redirect")
+			}
+		case types.ActionTypeEnumFixedResponse:
+			if fr := a.FixedResponseConfig; fr != nil {
+				parts = append(parts, "fixed response "+aws.ToString(fr.StatusCode))
+			} else {
+				parts = append(parts, "fixed response")
+			}
+		default:
+			parts = append(parts, string(a.Type))
+		}
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", then ")
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "elb", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "elb", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
@@ -0,0 +1,236 @@
+package elb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	classic "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	classictypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	webARN   = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188"
+	groupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web-tg/73e2d6bc24d8a067"
+)
+
+// fakeELBv2 serves the web Application Load Balancer, whose target group
+// holds one healthy and one unhealthy instance behind an HTTPS listener,
+// or fails every call when err is set. It records the load balancers
+// deleted.
+type fakeELBv2 struct {
+	err     error
+	deleted []string
+}
+
+func (f *fakeELBv2) DescribeLoadBalancers(context.Context, *elbv2.DescribeLoadBalancersInput, ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DescribeLoadBalancersOutput{LoadBalancers: []types.LoadBalancer{{
+		LoadBalancerArn:  aws.String(webARN),
+		LoadBalancerName: aws.String("web"),
+		Type:             types.LoadBalancerTypeEnumApplication,
+		Scheme:           types.LoadBalancerSchemeEnumInternetFacing,
+		State:            &types.LoadBalancerState{Code: types.LoadBalancerStateEnumActive},
+	}}}, nil
+}
+
+func (f *fakeELBv2) DescribeTags(context.Context, *elbv2.DescribeTagsInput, ...func(*elbv2.Options)) (*elbv2.DescribeTagsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DescribeTagsOutput{TagDescriptions: []types.TagDescription{{
+		ResourceArn: aws.String(webARN),
+		Tags:        []types.Tag{{Key: aws.String("env"), Value: aws.String("prod")}},
+	}}}, nil
+}
+
+func (f *fakeELBv2) DescribeTargetGroups(context.Context, *elbv2.DescribeTargetGroupsInput, ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DescribeTargetGroupsOutput{TargetGroups: []types.TargetGroup{{
+		TargetGroupArn:  aws.String(groupARN),
+		TargetGroupName: aws.String("web-tg"),
+		Protocol:        types.ProtocolEnumHttp,
+		Port:            aws.Int32(8080),
+	}}}, nil
+}
+
+func (f *fakeELBv2) DescribeTargetHealth(context.Context, *elbv2.DescribeTargetHealthInput, ...func(*elbv2.Options)) (*elbv2.DescribeTargetHealthOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DescribeTargetHealthOutput{TargetHealthDescriptions: []types.TargetHealthDescription{
+		{
+			Target:       &types.TargetDescription{Id: aws.String("i-healthy"), Port: aws.Int32(8080)},
+			TargetHealth: &types.TargetHealth{State: types.TargetHealthStateEnumHealthy},
+		},
+		{
+			Target:       &types.TargetDescription{Id: aws.String("i-failing"), Port: aws.Int32(8080)},
+			TargetHealth: &types.TargetHealth{State: types.TargetHealthStateEnumUnhealthy, Description: aws.String("Health checks failed")},
+		},
+	}}, nil
+}
+
+func (f *fakeELBv2) DescribeListeners(context.Context, *elbv2.DescribeListenersInput, ...func(*elbv2.Options)) (*elbv2.DescribeListenersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DescribeListenersOutput{Listeners: []types.Listener{{
+		Protocol:       types.ProtocolEnumHttps,
+		Port:           aws.Int32(443),
+		Certificates:   []types.Certificate{{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/web")}},
+		DefaultActions: []types.Action{{Type: types.ActionTypeEnumForward, TargetGroupArn: aws.String(groupARN)}},
+	}}}, nil
+}
+
+func (f *fakeELBv2) DeregisterTargets(context.Context, *elbv2.DeregisterTargetsInput, ...func(*elbv2.Options)) (*elbv2.DeregisterTargetsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &elbv2.DeregisterTargetsOutput{}, nil
+}
+
+func (f *fakeELBv2) DeleteLoadBalancer(_ context.Context, in *elbv2.DeleteLoadBalancerInput, _ ...func(*elbv2.Options)) (*elbv2.DeleteLoadBalancerOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.LoadBalancerArn))
+	return &elbv2.DeleteLoadBalancerOutput{}, nil
+}
+
+// fakeClassic serves the legacy Classic load balancer with one instance in
+// service, or fails every call when err is set. It records the load
+// balancers deleted.
+type fakeClassic struct {
+	err     error
+	deleted []string
+}
+
+func (f *fakeClassic) DescribeLoadBalancers(context.Context, *classic.DescribeLoadBalancersInput, ...func(*classic.Options)) (*classic.DescribeLoadBalancersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &classic.DescribeLoadBalancersOutput{LoadBalancerDescriptions: []classictypes.LoadBalancerDescription{{
+		LoadBalancerName: aws.String("legacy"),
+		Scheme:           aws.String("internal"),
+		Instances:        []classictypes.Instance{{InstanceId: aws.String("i-legacy")}},
+		ListenerDescriptions: []classictypes.ListenerDescription{{Listener: &classictypes.Listener{
+			Protocol:         aws.String("HTTP"),
+			LoadBalancerPort: 80,
+			InstancePort:     aws.Int32(8080),
+		}}},
+	}}}, nil
+}
+
+func (f *fakeClassic) DescribeTags(context.Context, *classic.DescribeTagsInput, ...func(*classic.Options)) (*classic.DescribeTagsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &classic.DescribeTagsOutput{}, nil
+}
+
+func (f *fakeClassic) DescribeInstanceHealth(context.Context, *classic.DescribeInstanceHealthInput, ...func(*classic.Options)) (*classic.DescribeInstanceHealthOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &classic.DescribeInstanceHealthOutput{InstanceStates: []classictypes.InstanceState{{
+		InstanceId: aws.String("i-legacy"),
+		State:      aws.String("InService"),
+	}}}, nil
+}
+
+func (f *fakeClassic) DeregisterInstancesFromLoadBalancer(context.Context, *classic.DeregisterInstancesFromLoadBalancerInput, ...func(*classic.Options)) (*classic.DeregisterInstancesFromLoadBalancerOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &classic.DeregisterInstancesFromLoadBalancerOutput{}, nil
+}
+
+func (f *fakeClassic) DeleteLoadBalancer(_ context.Context, in *classic.DeleteLoadBalancerInput, _ ...func(*classic.Options)) (*classic.DeleteLoadBalancerOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.LoadBalancerName))
+	return &classic.DeleteLoadBalancerOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against both
+// kinds of load balancers.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeELBv2{}, d, WithClassicClient(&fakeClassic{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			err := errors.New("AccessDenied")
+			return NewServiceWithClient(&fakeELBv2{err: err}, d, WithClassicClient(&fakeClassic{err: err}))
+		},
+		ExistingID:    webARN,
+		MissingID:     "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/gone/1",
+		Action:        "listeners",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+// TestListKeepsClassicWhenV2Fails checks that load balancers of the API
+// that answered are listed along with a partial error naming the other.
+func TestListKeepsClassicWhenV2Fails(t *testing.T) {
+	svc := NewServiceWithClient(&fakeELBv2{err: errors.New("AccessDenied")}, nil, WithClassicClient(&fakeClassic{}))
+
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	var partial *core.PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("List() error = %v, want a partial error", err)
+	}
+	if len(resources) != 1 || resources[0].ID != "legacy" {
+		t.Errorf("List() = %v, want the Classic load balancer", resources)
+	}
+}
+
+func TestEnrichFlagsUnhealthyTargets(t *testing.T) {
+	svc := NewServiceWithClient(&fakeELBv2{}, nil, WithClassicClient(&fakeClassic{}))
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	web := resources[0]
+	if err := svc.EnrichResource(context.Background(), &web); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+	if web.Metadata["healthy_targets"] != 1 || web.Metadata["unhealthy_targets"] != 1 {
+		t.Errorf("healthy %v, unhealthy %v, want 1 and 1", web.Metadata["healthy_targets"], web.Metadata["unhealthy_targets"])
+	}
+	if issues := web.Issues(); len(issues) != 1 || issues[0].Message != "Unhealthy targets: 1 of 2" {
+		t.Errorf("issues = %v, want the unhealthy target", issues)
+	}
+}
+
+// TestDeleteUsesTheLoadBalancerAPI checks that an ARN is deleted through
+// Elastic Load Balancing v2 and a name through Classic, each once typed
+// back.
+func TestDeleteUsesTheLoadBalancerAPI(t *testing.T) {
+	v2, old := &fakeELBv2{}, &fakeClassic{}
+	svc := NewServiceWithClient(v2, nil, WithClassicClient(old))
+
+	for _, id := range []string{webARN, "legacy"} {
+		params := map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: id}
+		if _, err := svc.Execute(context.Background(), "delete", id, params); err != nil {
+			t.Fatalf("delete %s: %v", id, err)
+		}
+	}
+	if !slices.Equal(v2.deleted, []string{webARN}) || !slices.Equal(old.deleted, []string{"legacy"}) {
+		t.Errorf("deleted %v through v2 and %v through Classic", v2.deleted, old.deleted)
+	}
+}
@@ -0,0 +1,400 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const deregisterFormID = "elb:deregister"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for load balancers.
+type View struct {
+	*base.EnrichableTableView
+
+	// Load balancer the deregister form is open for, and the target each
+	// form option stands for
+	formTarget  string
+	formOptions map[string]targetRef
+}
+
+// targetRef identifies a registered target for the deregister action.
+type targetRef struct {
+	group  string
+	target string
+	port   int
+}
+
+// NewView creates a new load balancer view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 32, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 7, MaxWidth: 11, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Scheme"), MinWidth: 8, MaxWidth: 15, Weight: 0.4, Priority: 2},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 16, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("DNS Name"), MinWidth: 20, MaxWidth: 70, Weight: 2.0, Priority: 3},
+		{Title: i18n.T("Healthy"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Unhealthy"), MinWidth: 9, MaxWidth: 11, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("ELB", "", "elb", i18n.T("load balancers"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "l":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading listeners of %s...", row.Name)
+				return v, v.executeAction("listeners", row.ID, nil)
+			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openDeregisterForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Targets of %s", row.Name), formatTargets(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != deregisterFormID {
+			break
+		}
+		choice, _ := msg.Values["target"].(string)
+		ref, ok := v.formOptions[choice]
+		if msg.Canceled || v.formTarget == "" || !ok {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Deregistering %s...", ref.target)
+		cmds = append(cmds, v.executeAction("deregister", v.formTarget, map[string]any{
+			"target":       ref.target,
+			"target_group": ref.group,
+			"port":         ref.port,
+		}))
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			switch msg.Action {
+			case "listeners":
+				if row := v.GetSelectedResource(); row != nil {
+					listeners, _ := msg.Result.Data.([]Listener)
+					v.OpenDetail(i18n.T("Listeners of %s", row.Name), formatListeners(listeners))
+				}
+			case "deregister":
+				cmds = append(cmds, v.AnalyzeSelected())
+			case "delete":
+				cmds = append(cmds, v.SoftRefresh())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading load balancers...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[a]nalyze  [l]isteners  [x] deregister  [d]elete  [Enter]targets  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the load balancers, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// openDeregisterForm asks which target of an analyzed load balancer to
+// deregister, unhealthy targets first.
+func (v *View) openDeregisterForm(r *core.Resource) tea.Cmd {
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		v.Message = i18n.T("Analyze %s first to list its targets", r.Name)
+		return nil
+	}
+	groups, _ := r.Metadata["target_groups"].([]TargetGroup)
+
+	v.formOptions = make(map[string]targetRef)
+	var options []string
+	for _, unhealthy := range []bool{true, false} {
+		for _, group := range groups {
+			for _, t := range group.Targets {
+				if t.Unhealthy() != unhealthy {
+					continue
+				}
+				label := fmt.Sprintf("%s (%s, %s)", formatTarget(t), group.Name, t.State)
+				options = append(options, label)
+				v.formOptions[label] = targetRef{group: group.ARN, target: t.ID, port: int(t.Port)}
+			}
+		}
+	}
+	if len(options) == 0 {
+		v.Message = i18n.T("%s has no registered targets", r.Name)
+		return nil
+	}
+
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(deregisterFormID, i18n.T("Deregister a target from %s", r.Name), []core.ActionParameter{
+		{Name: "target", Type: "select", Options: options, Description: i18n.T("Target to deregister")},
+	}))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+
+	healthy, unhealthy := "...", "..."
+	var healthyValue, unhealthyValue any
+	if analyzed {
+		total, _ := r.Metadata["targets"].(int)
+		h, _ := r.Metadata["healthy_targets"].(int)
+		u, _ := r.Metadata["unhealthy_targets"].(int)
+		healthyValue, unhealthyValue = h, u
+		healthy = fmt.Sprintf("%d/%d", h, total)
+		unhealthy = fmt.Sprintf("%d", u)
+		if u > 0 {
+			unhealthy = fmt.Sprintf("🔴 %d", u)
+		}
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 32)),
+		base.TextCell(r.GetMetadataString("lb_type")),
+		base.TextCell(r.GetMetadataString("scheme")),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(r.GetMetadataString("dns_name")),
+		base.LazyCell(healthyValue, func() string { return healthy }),
+		base.LazyCell(unhealthyValue, func() string { return unhealthy }),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// formatTarget renders a target as "id:port".
+func formatTarget(t Target) string {
+	if t.Port == 0 {
+		return t.ID
+	}
+	return fmt.Sprintf("%s:%d", t.ID, t.Port)
+}
+
+// formatTargets renders the target groups of a load balancer and the health
+// of their targets for the detail panel.
+func formatTargets(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Type:    %s, %s\n", r.GetMetadataString("lb_type"), r.GetMetadataString("scheme"))
+	fmt.Fprintf(&b, "DNS:     %s\n", r.GetMetadataString("dns_name"))
+	fmt.Fprintf(&b, "VPC:     %s\n", r.GetMetadataString("vpc_id"))
+	if reason := r.GetMetadataString("state_reason"); reason != "" {
+		fmt.Fprintf(&b, "State:   %s (%s)\n", r.State, reason)
+	}
+
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		b.WriteString(i18n.T("\nNot analyzed yet. Press [a] to load its targets.\n"))
+		return b.String()
+	}
+	groups, _ := r.Metadata["target_groups"].([]TargetGroup)
+	if len(groups) == 0 {
+		b.WriteString(i18n.T("\nNo target groups.\n"))
+	}
+	for _, group := range groups {
+		fmt.Fprintf(&b, "\n%s", group.Name)
+		if group.Protocol != "" {
+			fmt.Fprintf(&b, "  %s:%d", group.Protocol, group.Port)
+		}
+		b.WriteString("\n")
+		if len(group.Targets) == 0 {
+			b.WriteString(i18n.T("  No registered targets.\n"))
+		}
+		for _, t := range group.Targets {
+			icon := "🟡"
+			switch {
+			case t.Healthy():
+				icon = "🟢"
+			case t.Unhealthy():
+				icon = "🔴"
+			}
+			fmt.Fprintf(&b, "  %s %-40s %s", icon, formatTarget(t), t.State)
+			if t.Reason != "" {
+				fmt.Fprintf(&b, ": %s", t.Reason)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatListeners renders the listeners of a load balancer for the detail
+// panel.
+func formatListeners(listeners []Listener) string {
+	if len(listeners) == 0 {
+		return i18n.T("No listeners.\n")
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-10s %-6s %s\n", "PROTOCOL", "PORT", "DEFAULT ACTION")
+	for _, l := range listeners {
+		fmt.Fprintf(&b, "%-10s %-6d %s\n", l.Protocol, l.Port, l.Action)
+		for _, cert := range l.Certificates {
+			fmt.Fprintf(&b, "  certificate %s\n", cert)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	unhealthy := 0
+	for _, r := range v.Resources {
+		if n, _ := r.Metadata["unhealthy_targets"].(int); n > 0 {
+			unhealthy++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unhealthy", Text: i18n.T("With unhealthy targets: %d", unhealthy), Tone: core.ToneError},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Load Balancers"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "elb" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
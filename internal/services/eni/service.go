@@ -0,0 +1,461 @@
+// Package eni provides elastic network interface inventory for the a9s
+// application. It attributes each interface to the service that owns it and
+// finds orphaned interfaces, which keep their subnet and security groups from
+// being deleted.
+package eni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// Owning services, as shown in the Owner column.
+const (
+	OwnerEC2         = "EC2"
+	OwnerLambda      = "Lambda"
+	OwnerNAT         = "NAT"
+	OwnerALB         = "ALB"
+	OwnerNLB         = "NLB"
+	OwnerGWLB        = "GWLB"
+	OwnerELB         = "ELB"
+	OwnerRDS         = "RDS"
+	OwnerEFS         = "EFS"
+	OwnerECS         = "ECS"
+	OwnerVPCEndpoint = "VPC endpoint"
+	OwnerTransit     = "Transit gateway"
+)
+
+// interfaceOwners attributes interface types that name their owner.
+var interfaceOwners = map[types.NetworkInterfaceType]string{
+	types.NetworkInterfaceTypeNatGateway:                  OwnerNAT,
+	"nat_gateway":                                         OwnerNAT,
+	types.NetworkInterfaceTypeLambda:                      OwnerLambda,
+	types.NetworkInterfaceTypeNetworkLoadBalancer:         OwnerNLB,
+	types.NetworkInterfaceTypeGatewayLoadBalancer:         OwnerGWLB,
+	types.NetworkInterfaceTypeVpcEndpoint:                 OwnerVPCEndpoint,
+	types.NetworkInterfaceTypeGatewayLoadBalancerEndpoint: OwnerVPCEndpoint,
+	types.NetworkInterfaceTypeTransitGateway:              OwnerTransit,
+	"efs":                                                 OwnerEFS,
+}
+
+// descriptionOwners attributes interfaces by the description their owner
+// gives them, for types AWS reports as plain "interface". Longer prefixes
+// come first.
+var descriptionOwners = []struct {
+	prefix string
+	owner  string
+}{
+	{"AWS Lambda VPC ENI", OwnerLambda},
+	{"Interface for NAT Gateway", OwnerNAT},
+	{"ELB app/", OwnerALB},
+	{"ELB net/", OwnerNLB},
+	{"ELB gwy/", OwnerGWLB},
+	{"ELB ", OwnerELB},
+	{"RDSNetworkInterface", OwnerRDS},
+	{"EFS mount target", OwnerEFS},
+	{"arn:aws:ecs:", OwnerECS},
+	{"VPC Endpoint Interface", OwnerVPCEndpoint},
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements network interface operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	DetachNetworkInterface(ctx context.Context, params *ec2.DetachNetworkInterfaceInput, optFns ...func(*ec2.Options)) (*ec2.DetachNetworkInterfaceOutput, error)
+	DeleteNetworkInterface(ctx context.Context, params *ec2.DeleteNetworkInterfaceInput, optFns ...func(*ec2.Options)) (*ec2.DeleteNetworkInterfaceOutput, error)
+}
+
+// NewService creates a new network interface service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "eni"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Elastic Network Interfaces"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("eni", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the network interfaces of the region.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(s.client(), &ec2.DescribeNetworkInterfacesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("eni", "list", err)
+		}
+		for _, ni := range page.NetworkInterfaces {
+			resources = append(resources, interfaceToResource(ni))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:network-interface",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for network interfaces.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "detach",
+			Description: "Detach a secondary interface from its instance",
+			Icon:        "unlink",
+			Shortcut:    "t",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "force", Type: "bool", Default: false, Description: "Force the detachment (the instance may not see it)"},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete an unattached interface",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a network interface. Both actions
+// ask for confirmation through a core.ConfirmationError until the
+// "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "detach":
+		if confirmed, _ := params[core.ParamConfirm].(bool); !confirmed {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "The instance loses the addresses of this interface", false)
+		}
+		force, _ := params["force"].(bool)
+		result, err = s.detachInterface(ctx, resourceID, force)
+	case "delete":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Deleting a network interface cannot be undone", true)
+		}
+		result, err = s.deleteInterface(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) detachInterface(ctx context.Context, interfaceID string, force bool) (*core.ActionResult, error) {
+	ni, err := s.getInterface(ctx, interfaceID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("detach", interfaceID, err)
+	}
+
+	attachment := ni.Attachment
+	switch {
+	case attachment == nil || attachment.AttachmentId == nil:
+		err = core.NewValidationError("interface", interfaceID, "is not attached")
+	case aws.ToBool(ni.RequesterManaged) || aws.ToString(attachment.InstanceId) == "":
+		err = core.NewValidationError("interface", interfaceID, fmt.Sprintf("is managed by %s and cannot be detached", ownerOf(ni)))
+	case aws.ToInt32(attachment.DeviceIndex) == 0:
+		err = core.NewValidationError("interface", interfaceID, fmt.Sprintf("is the primary interface of %s and cannot be detached", aws.ToString(attachment.InstanceId)))
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("detach", interfaceID, err)
+	}
+
+	_, err = s.client().DetachNetworkInterface(ctx, &ec2.DetachNetworkInterfaceInput{
+		AttachmentId: attachment.AttachmentId,
+		Force:        aws.Bool(force),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("detach", interfaceID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Interface %s is detaching from %s", interfaceID, aws.ToString(attachment.InstanceId))), nil
+}
+
+func (s *Service) deleteInterface(ctx context.Context, interfaceID string) (*core.ActionResult, error) {
+	ni, err := s.getInterface(ctx, interfaceID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", interfaceID, err)
+	}
+	if ni.Status != types.NetworkInterfaceStatusAvailable {
+		err = core.NewValidationError("interface", interfaceID, fmt.Sprintf("is %s; detach it first", ni.Status))
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", interfaceID, err)
+	}
+
+	_, err = s.client().DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(interfaceID),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", interfaceID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Interface %s deleted", interfaceID)), nil
+}
+
+func (s *Service) getInterface(ctx context.Context, interfaceID string) (types.NetworkInterface, error) {
+	out, err := s.client().DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{interfaceID},
+	})
+	if err != nil {
+		return types.NetworkInterface{}, err
+	}
+	if len(out.NetworkInterfaces) == 0 {
+		return types.NetworkInterface{}, fmt.Errorf("%w: %s", core.ErrResourceNotFound, interfaceID)
+	}
+	return out.NetworkInterfaces[0], nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func interfaceToResource(ni types.NetworkInterface) core.Resource {
+	id := aws.ToString(ni.NetworkInterfaceId)
+	description := aws.ToString(ni.Description)
+
+	groups := make([]string, 0, len(ni.Groups))
+	for _, group := range ni.Groups {
+		groups = append(groups, aws.ToString(group.GroupId))
+	}
+
+	resource := core.Resource{
+		ID:    id,
+		Type:  "ec2:network-interface",
+		Name:  description,
+		State: string(ni.Status),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"description":       description,
+			"interface_type":    string(ni.InterfaceType),
+			"owner_service":     ownerOf(ni),
+			"requester_managed": aws.ToBool(ni.RequesterManaged),
+			"requester_id":      aws.ToString(ni.RequesterId),
+			"vpc_id":            aws.ToString(ni.VpcId),
+			"subnet_id":         aws.ToString(ni.SubnetId),
+			"availability_zone": aws.ToString(ni.AvailabilityZone),
+			"private_ip":        aws.ToString(ni.PrivateIpAddress),
+			"security_groups":   groups,
+			"orphaned":          ni.Status == types.NetworkInterfaceStatusAvailable,
+			"should_cleanup":    false,
+		},
+	}
+
+	if ni.Association != nil {
+		resource.Metadata["public_ip"] = aws.ToString(ni.Association.PublicIp)
+	}
+	if attachment := ni.Attachment; attachment != nil {
+		attachedTo := aws.ToString(attachment.InstanceId)
+		if attachedTo == "" {
+			attachedTo = aws.ToString(attachment.InstanceOwnerId)
+		}
+		resource.Metadata["attachment_id"] = aws.ToString(attachment.AttachmentId)
+		resource.Metadata["attached_to"] = attachedTo
+		resource.Metadata["device_index"] = aws.ToInt32(attachment.DeviceIndex)
+		resource.Metadata["delete_on_termination"] = aws.ToBool(attachment.DeleteOnTermination)
+		if attachment.AttachTime != nil {
+			resource.Metadata["attached_at"] = attachment.AttachTime.Format("2006-01-02")
+		}
+	}
+
+	for _, tag := range ni.TagSet {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" {
+			resource.Name = value
+		}
+	}
+	if resource.Name == "" {
+		resource.Name = id
+	}
+
+	if ni.Status == types.NetworkInterfaceStatusAvailable {
+		blocks := fmt.Sprintf("blocks deleting subnet %s", aws.ToString(ni.SubnetId))
+		if len(groups) > 0 {
+			blocks += fmt.Sprintf(" and security groups %s", strings.Join(groups, ", "))
+		}
+		if aws.ToBool(ni.RequesterManaged) {
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Unattached %s interface: %s until AWS releases it", ownerOf(ni), blocks))
+		} else {
+			// AWS releases the interfaces it manages; only the others are
+			// left to clean up
+			resource.Metadata["should_cleanup"] = true
+			resource.Metadata["cleanup_reason"] = "unattached, " + blocks
+			resource.AddIssue(core.SeverityMedium, "Orphaned: "+blocks)
+		}
+	}
+
+	iac.Apply(&resource)
+
+	return resource
+}
+
+// ownerOf names the service an interface belongs to: its owner for managed
+// interfaces, EC2 for interfaces attached to instances, or "-".
+func ownerOf(ni types.NetworkInterface) string {
+	if owner, ok := interfaceOwners[ni.InterfaceType]; ok {
+		return owner
+	}
+	description := aws.ToString(ni.Description)
+	for _, d := range descriptionOwners {
+		if strings.HasPrefix(description, d.prefix) {
+			return d.owner
+		}
+	}
+	if ni.Attachment != nil && aws.ToString(ni.Attachment.InstanceId) != "" {
+		return OwnerEC2
+	}
+	if aws.ToBool(ni.RequesterManaged) {
+		return aws.ToString(ni.RequesterId)
+	}
+	return "-"
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "eni", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "eni", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
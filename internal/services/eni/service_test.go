@@ -0,0 +1,168 @@
+package eni
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves the primary and a secondary interface of an instance, the
+// interface of a NAT gateway and an orphaned interface, or fails every call
+// when err is set. It records the attachments detached.
+type fakeEC2 struct {
+	err      error
+	detached []string
+}
+
+func attached(id, attachment string, index int32) types.NetworkInterface {
+	return types.NetworkInterface{
+		NetworkInterfaceId: aws.String(id),
+		Status:             types.NetworkInterfaceStatusInUse,
+		InterfaceType:      types.NetworkInterfaceTypeInterface,
+		SubnetId:           aws.String("subnet-a"),
+		Attachment: &types.NetworkInterfaceAttachment{
+			AttachmentId: aws.String(attachment),
+			InstanceId:   aws.String("i-web"),
+			DeviceIndex:  aws.Int32(index),
+		},
+	}
+}
+
+func (f *fakeEC2) interfaces() []types.NetworkInterface {
+	return []types.NetworkInterface{
+		attached("eni-primary", "eni-attach-0", 0),
+		attached("eni-secondary", "eni-attach-1", 1),
+		{
+			NetworkInterfaceId: aws.String("eni-nat"),
+			Status:             types.NetworkInterfaceStatusInUse,
+			InterfaceType:      types.NetworkInterfaceTypeNatGateway,
+			RequesterManaged:   aws.Bool(true),
+			Description:        aws.String("Interface for NAT Gateway nat-1"),
+			Attachment:         &types.NetworkInterfaceAttachment{AttachmentId: aws.String("ela-attach-1")},
+		},
+		{
+			NetworkInterfaceId: aws.String("eni-orphan"),
+			Status:             types.NetworkInterfaceStatusAvailable,
+			InterfaceType:      types.NetworkInterfaceTypeInterface,
+			SubnetId:           aws.String("subnet-b"),
+			Groups:             []types.GroupIdentifier{{GroupId: aws.String("sg-1")}},
+		},
+	}
+}
+
+func (f *fakeEC2) DescribeNetworkInterfaces(_ context.Context, in *ec2.DescribeNetworkInterfacesInput, _ ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(in.NetworkInterfaceIds) == 0 {
+		return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: f.interfaces()}, nil
+	}
+	for _, ni := range f.interfaces() {
+		if aws.ToString(ni.NetworkInterfaceId) == in.NetworkInterfaceIds[0] {
+			return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []types.NetworkInterface{ni}}, nil
+		}
+	}
+	return nil, errors.New("InvalidNetworkInterfaceID.NotFound")
+}
+
+func (f *fakeEC2) DetachNetworkInterface(_ context.Context, in *ec2.DetachNetworkInterfaceInput, _ ...func(*ec2.Options)) (*ec2.DetachNetworkInterfaceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.detached = append(f.detached, aws.ToString(in.AttachmentId))
+	return &ec2.DetachNetworkInterfaceOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteNetworkInterface(context.Context, *ec2.DeleteNetworkInterfaceInput, ...func(*ec2.Options)) (*ec2.DeleteNetworkInterfaceOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DeleteNetworkInterfaceOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against network
+// interfaces.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID:    "eni-secondary",
+		MissingID:     "eni-missing",
+		Action:        "detach",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestOwnerOf(t *testing.T) {
+	tests := []struct {
+		name string
+		ni   types.NetworkInterface
+		want string
+	}{
+		{"typed", types.NetworkInterface{InterfaceType: types.NetworkInterfaceTypeLambda}, OwnerLambda},
+		{"application load balancer", types.NetworkInterface{Description: aws.String("ELB app/web/50dc6c495c0c9188")}, OwnerALB},
+		{"classic load balancer", types.NetworkInterface{Description: aws.String("ELB legacy")}, OwnerELB},
+		{"ecs task", types.NetworkInterface{Description: aws.String("arn:aws:ecs:us-east-1:123456789012:attachment/1")}, OwnerECS},
+		{"instance", attached("eni-1", "eni-attach-1", 0), OwnerEC2},
+		{"other requester", types.NetworkInterface{RequesterManaged: aws.Bool(true), RequesterId: aws.String("amazon-elasticsearch")}, "amazon-elasticsearch"},
+		{"unknown", types.NetworkInterface{}, "-"},
+	}
+	for _, tt := range tests {
+		if got := ownerOf(tt.ni); got != tt.want {
+			t.Errorf("%s: ownerOf() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestListFlagsOrphanedInterface(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeEC2{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	for _, r := range resources {
+		cleanup, _ := r.Metadata["should_cleanup"].(bool)
+		if cleanup != (r.ID == "eni-orphan") {
+			t.Errorf("%s should_cleanup = %v", r.ID, cleanup)
+		}
+	}
+	orphan := resources[3]
+	if want := "unattached, blocks deleting subnet subnet-b and security groups sg-1"; orphan.GetMetadataString("cleanup_reason") != want {
+		t.Errorf("cleanup reason = %q, want %q", orphan.GetMetadataString("cleanup_reason"), want)
+	}
+}
+
+// TestDetachRefusesPrimaryAndManaged checks that only secondary interfaces
+// of instances are detached.
+func TestDetachRefusesPrimaryAndManaged(t *testing.T) {
+	fake := &fakeEC2{}
+	svc := NewServiceWithClient(fake, nil)
+	confirmed := map[string]any{core.ParamConfirm: true}
+
+	for id, reason := range map[string]string{
+		"eni-primary": "primary interface of i-web",
+		"eni-nat":     "managed by NAT",
+		"eni-orphan":  "not attached",
+	} {
+		_, err := svc.Execute(context.Background(), "detach", id, confirmed)
+		if err == nil || !strings.Contains(err.Error(), reason) {
+			t.Errorf("detach %s error = %v, want %q", id, err, reason)
+		}
+	}
+	if len(fake.detached) > 0 {
+		t.Errorf("detached %v", fake.detached)
+	}
+}
@@ -0,0 +1,323 @@
+package eni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const detachFormID = "eni:detach"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for network interfaces.
+type View struct {
+	*base.TableView
+
+	formTarget string // Interface the detach form is open for
+}
+
+// NewView creates a new network interface view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 21, MaxWidth: 22, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 1},
+		{Title: i18n.T("Owner"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Attached To"), MinWidth: 12, MaxWidth: 20, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("Subnet"), MinWidth: 15, MaxWidth: 24, Weight: 0.5, Priority: 3},
+		{Title: i18n.T("Private IP"), MinWidth: 11, MaxWidth: 15, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Public IP"), MinWidth: 9, MaxWidth: 15, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Security Groups"), MinWidth: 15, MaxWidth: 40, Weight: 0.8, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("ENI", "", "eni", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadInterfaces()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openDetachForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.ID)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Interface %s", row.ID), formatDetail(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != detachFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Detaching %s...", v.formTarget)
+		cmds = append(cmds, v.executeAction("detach", v.formTarget, msg.Values))
+
+	case interfacesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d network interfaces", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.loadInterfaces())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading network interfaces...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("de[t]ach  [d]elete  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the interfaces.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadInterfaces()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type interfacesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadInterfaces() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return interfacesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return interfacesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return interfacesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) openDetachForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "detach")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "detach")
+		return nil
+	}
+	v.formTarget = r.ID
+	title := i18n.T("Detach %s from %s", r.ID, r.GetMetadataString("attached_to"))
+	return v.OpenForm(components.NewForm(detachFormID, title, def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	groups, _ := r.Metadata["security_groups"].([]string)
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(r.GetMetadataString("owner_service")),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(orDash(r.GetMetadataString("attached_to"))),
+		base.TextCell(r.GetMetadataString("subnet_id")),
+		base.TextCell(r.GetMetadataString("private_ip")),
+		base.TextCell(orDash(r.GetMetadataString("public_ip"))),
+		base.TextCell(strings.Join(groups, ", ")),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatDetail renders an interface's ownership, placement and addresses
+// for the detail panel.
+func formatDetail(r *core.Resource) string {
+	groups, _ := r.Metadata["security_groups"].([]string)
+	managed, _ := r.Metadata["requester_managed"].(bool)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Description: %s\n", r.GetMetadataString("description"))
+	fmt.Fprintf(&b, "Owner:       %s (type %s, managed by AWS: %t)\n", r.GetMetadataString("owner_service"), r.GetMetadataString("interface_type"), managed)
+	fmt.Fprintf(&b, "Status:      %s\n", r.State)
+	if attachedTo := r.GetMetadataString("attached_to"); attachedTo != "" {
+		index, _ := r.Metadata["device_index"].(int32)
+		fmt.Fprintf(&b, "Attached to: %s (device %d, since %s)\n", attachedTo, index, r.GetMetadataString("attached_at"))
+	}
+	fmt.Fprintf(&b, "VPC:         %s\n", r.GetMetadataString("vpc_id"))
+	fmt.Fprintf(&b, "Subnet:      %s (%s)\n", r.GetMetadataString("subnet_id"), r.GetMetadataString("availability_zone"))
+	fmt.Fprintf(&b, "Private IP:  %s\n", r.GetMetadataString("private_ip"))
+	if public := r.GetMetadataString("public_ip"); public != "" {
+		fmt.Fprintf(&b, "Public IP:   %s\n", public)
+	}
+	fmt.Fprintf(&b, "Security groups: %s\n", strings.Join(groups, ", "))
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	orphaned := 0
+	for _, r := range v.Resources {
+		if o, _ := r.Metadata["orphaned"].(bool); o {
+			orphaned++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unattached", Text: i18n.T("Unattached: %d", orphaned), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Network Interfaces"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "eni" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,737 @@
+// Package eventbridge provides EventBridge rules integration for the a9s
+// application. It lists the rules of every event bus with their schedule or
+// event pattern and targets, enables and disables them and sends test
+// events.
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+const (
+	// defaultBus is the event bus AWS services send their events to.
+	defaultBus = "default"
+
+	// testSource and testDetailType are sent in test events when the
+	// rule's pattern does not name one.
+	testSource     = "a9s.test"
+	testDetailType = "a9s test event"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements EventBridge rule operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EventBridgeAPI
+}
+
+// EventBridgeAPI defines the EventBridge client interface for mocking.
+type EventBridgeAPI interface {
+	ListEventBuses(ctx context.Context, params *eventbridge.ListEventBusesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListEventBusesOutput, error)
+	ListRules(ctx context.Context, params *eventbridge.ListRulesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRulesOutput, error)
+	DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)
+	ListTargetsByRule(ctx context.Context, params *eventbridge.ListTargetsByRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTargetsByRuleOutput, error)
+	EnableRule(ctx context.Context, params *eventbridge.EnableRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.EnableRuleOutput, error)
+	DisableRule(ctx context.Context, params *eventbridge.DisableRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DisableRuleOutput, error)
+	TestEventPattern(ctx context.Context, params *eventbridge.TestEventPatternInput, optFns ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error)
+	PutEvents(ctx context.Context, params *eventbridge.PutEventsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error)
+}
+
+// NewService creates a new EventBridge service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EventBridgeAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EventBridge client for the current AWS context.
+func (s *Service) client() EventBridgeAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return eventbridge.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region rules are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "eventbridge"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "EventBridge Rules"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "zap"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListEventBuses(ctx, &eventbridge.ListEventBusesInput{Limit: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("eventbridge", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the rules of every event bus of the region. Their targets
+// are counted by EnrichResource.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	buses, err := s.buses(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("eventbridge", "list", err)
+	}
+
+	resources := []core.Resource{}
+	for _, bus := range buses {
+		var token *string
+		for {
+			page, err := s.client().ListRules(ctx, &eventbridge.ListRulesInput{
+				EventBusName: aws.String(bus),
+				NextToken:    token,
+			})
+			if err != nil {
+				s.dispatchError(ctx, "list", err)
+				return nil, core.NewServiceError("eventbridge", "list", err)
+			}
+			for _, rule := range page.Rules {
+				resources = append(resources, ruleToResource(rule, s.region()))
+			}
+			if token = page.NextToken; token == nil {
+				break
+			}
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "events:rule",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// buses returns the names of the region's event buses, the default one
+// first.
+func (s *Service) buses(ctx context.Context) ([]string, error) {
+	var names []string
+	var token *string
+	for {
+		page, err := s.client().ListEventBuses(ctx, &eventbridge.ListEventBusesInput{NextToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, bus := range page.EventBuses {
+			names = append(names, aws.ToString(bus.Name))
+		}
+		if token = page.NextToken; token == nil {
+			break
+		}
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		if (a == defaultBus) != (b == defaultBus) {
+			if a == defaultBus {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	return names, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get retrieves a rule by ARN.
+func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
+	bus, name, err := parseRuleID(id)
+	if err != nil {
+		return nil, core.NewServiceError("eventbridge", "get", err)
+	}
+	out, err := s.client().DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name:         aws.String(name),
+		EventBusName: aws.String(bus),
+	})
+	if err != nil {
+		return nil, core.NewServiceError("eventbridge", "get", err)
+	}
+
+	resource := ruleToResource(types.Rule{
+		Arn:                out.Arn,
+		Name:               out.Name,
+		EventBusName:       out.EventBusName,
+		EventPattern:       out.EventPattern,
+		ScheduleExpression: out.ScheduleExpression,
+		State:              out.State,
+		Description:        out.Description,
+		ManagedBy:          out.ManagedBy,
+		RoleArn:            out.RoleArn,
+	}, s.region())
+	return &resource, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds a rule's targets. Enabled rules without targets are
+// flagged low, since the events they match go nowhere.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	if resource.Type != "events:rule" {
+		return nil
+	}
+
+	targets, err := s.targets(ctx, resource.ID)
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(targets))
+	for i, target := range targets {
+		names[i] = targetName(aws.ToString(target.Arn))
+	}
+	resource.Metadata["target_count"] = len(targets)
+	resource.Metadata["targets"] = names
+	resource.Metadata["analyzed"] = true
+
+	resource.ClearIssues()
+	if len(targets) == 0 && resource.State != "disabled" {
+		resource.AddIssue(core.SeverityLow, "Enabled with no targets; matched events go nowhere")
+	}
+	return nil
+}
+
+// targets returns the targets of a rule.
+func (s *Service) targets(ctx context.Context, id string) ([]types.Target, error) {
+	bus, name, err := parseRuleID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []types.Target
+	var token *string
+	for {
+		page, err := s.client().ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+			Rule:         aws.String(name),
+			EventBusName: aws.String(bus),
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, page.Targets...)
+		if token = page.NextToken; token == nil {
+			break
+		}
+	}
+	return targets, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for rules.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "enable",
+			Description: "Enable the rule",
+			Icon:        "play",
+			Shortcut:    "e",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "disable",
+			Description: "Disable the rule",
+			Icon:        "pause",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "view_targets",
+			Description: "View the rule's targets with their input and retry settings",
+			Icon:        "eye",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "send_test_event",
+			Description: "Send a test event matching the rule to its bus",
+			Icon:        "send",
+			Shortcut:    "s",
+			Dangerous:   true,
+			Category:    "test",
+			Parameters: []core.ActionParameter{
+				{Name: "source", Type: "string", Required: true, Default: testSource, Description: "Event source"},
+				{Name: "detail_type", Type: "string", Required: true, Default: testDetailType, Description: "Event detail type"},
+				{Name: "detail", Type: "text", Default: "{}", Description: "Event detail, a JSON object"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a rule, identified by its ARN.
+// Disabling a rule and sending a test event ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "enable":
+		result, err = s.setState(ctx, resourceID, types.RuleStateEnabled, params, true)
+	case "disable":
+		result, err = s.setState(ctx, resourceID, types.RuleStateDisabled, params, confirmed)
+	case "view_targets":
+		result, err = s.viewTargets(ctx, resourceID)
+	case "send_test_event":
+		result, err = s.sendTestEvent(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// describe returns the rule behind an ID.
+func (s *Service) describe(ctx context.Context, id string) (*eventbridge.DescribeRuleOutput, error) {
+	bus, name, err := parseRuleID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.client().DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name:         aws.String(name),
+		EventBusName: aws.String(bus),
+	})
+}
+
+// setState enables or disables a rule. Rules managed by another AWS
+// service, such as those behind Step Functions or AWS Config, are left to
+// it. Disabling stops the rule's targets from receiving anything, so it is
+// confirmed first.
+func (s *Service) setState(ctx context.Context, id string, state types.RuleState, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	action := "enable"
+	if state == types.RuleStateDisabled {
+		action = "disable"
+	}
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError(action, id, err)
+	}
+
+	rule, err := s.describe(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	name := aws.ToString(rule.Name)
+	if managedBy := aws.ToString(rule.ManagedBy); managedBy != "" {
+		return fail(core.NewValidationError("rule", name, "is managed by "+managedBy))
+	}
+	if (rule.State == types.RuleStateDisabled) == (state == types.RuleStateDisabled) {
+		return fail(core.NewValidationError("rule", name, "is already "+strings.ToLower(string(state))))
+	}
+
+	if !confirmed {
+		targets, err := s.targets(ctx, id)
+		if err != nil {
+			return fail(err)
+		}
+		return nil, core.NewConfirmationError(s, action, id, params, fmt.Sprintf("%s stops sending events to its %d targets until enabled again", name, len(targets)), false)
+	}
+
+	if state == types.RuleStateDisabled {
+		_, err = s.client().DisableRule(ctx, &eventbridge.DisableRuleInput{Name: rule.Name, EventBusName: rule.EventBusName})
+	} else {
+		_, err = s.client().EnableRule(ctx, &eventbridge.EnableRuleInput{Name: rule.Name, EventBusName: rule.EventBusName})
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	if state == types.RuleStateDisabled {
+		return core.NewActionResult(true, fmt.Sprintf("Disabled %s", name)), nil
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Enabled %s", name)), nil
+}
+
+// Target is a rule target, in the result data of the view_targets action.
+type Target struct {
+	ID    string
+	ARN   string
+	Input string // Constant input, input path or transformer template
+	Role  string
+	DLQ   string
+	// Retries is the retry policy, empty for the default of 185 attempts
+	// over 24 hours
+	Retries string
+}
+
+// viewTargets lists a rule's targets with what they receive.
+func (s *Service) viewTargets(ctx context.Context, id string) (*core.ActionResult, error) {
+	targets, err := s.targets(ctx, id)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_targets", id, err)
+	}
+
+	out := make([]Target, len(targets))
+	for i, t := range targets {
+		target := Target{
+			ID:   aws.ToString(t.Id),
+			ARN:  aws.ToString(t.Arn),
+			Role: aws.ToString(t.RoleArn),
+		}
+		switch {
+		case t.Input != nil:
+			target.Input = "constant: " + aws.ToString(t.Input)
+		case t.InputPath != nil:
+			target.Input = "path: " + aws.ToString(t.InputPath)
+		case t.InputTransformer != nil:
+			target.Input = "transformer: " + aws.ToString(t.InputTransformer.InputTemplate)
+		}
+		if t.DeadLetterConfig != nil {
+			target.DLQ = aws.ToString(t.DeadLetterConfig.Arn)
+		}
+		if p := t.RetryPolicy; p != nil {
+			target.Retries = fmt.Sprintf("%d attempts over %ds", aws.ToInt32(p.MaximumRetryAttempts), aws.ToInt32(p.MaximumEventAgeInSeconds))
+		}
+		out[i] = target
+	}
+
+	_, name, _ := parseRuleID(id)
+	result := core.NewActionResult(true, fmt.Sprintf("%d targets of %s", len(out), name))
+	result.Data = out
+	return result, nil
+}
+
+// sendTestEvent puts an event on the rule's bus once confirmed, since the
+// targets of every rule it matches receive it. The confirmation tells
+// whether the rule's own pattern matches the event, checked with
+// TestEventPattern.
+func (s *Service) sendTestEvent(ctx context.Context, id string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("send_test_event", id, err)
+	}
+
+	rule, err := s.describe(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	name := aws.ToString(rule.Name)
+	if rule.EventPattern == nil {
+		return fail(core.NewValidationError("rule", name, "runs on a schedule and matches no events"))
+	}
+
+	source, _ := params["source"].(string)
+	detailType, _ := params["detail_type"].(string)
+	detail, _ := params["detail"].(string)
+	source, detailType, detail = strings.TrimSpace(source), strings.TrimSpace(detailType), strings.TrimSpace(detail)
+	if source == "" {
+		source = testSource
+	}
+	if detailType == "" {
+		detailType = testDetailType
+	}
+	if detail == "" {
+		detail = "{}"
+	}
+	var object map[string]any
+	if err := json.Unmarshal([]byte(detail), &object); err != nil {
+		return fail(core.NewValidationError("detail", detail, "must be a JSON object"))
+	}
+
+	if !confirmed {
+		arn, _ := core.ParseARN(aws.ToString(rule.Arn))
+		event, err := json.Marshal(map[string]any{
+			"id":          "a9s-test",
+			"version":     "0",
+			"source":      source,
+			"detail-type": detailType,
+			"account":     arn.AccountID,
+			"region":      arn.Region,
+			"time":        time.Now().UTC().Format(time.RFC3339),
+			"resources":   []string{},
+			"detail":      object,
+		})
+		if err != nil {
+			return fail(err)
+		}
+		test, err := s.client().TestEventPattern(ctx, &eventbridge.TestEventPatternInput{
+			Event:        aws.String(string(event)),
+			EventPattern: rule.EventPattern,
+		})
+		if err != nil {
+			return fail(err)
+		}
+		reason := fmt.Sprintf("Puts a %q event from %s on bus %s; %s's pattern matches it, so its targets receive it, as do those of other matching rules", detailType, source, aws.ToString(rule.EventBusName), name)
+		if !test.Result {
+			reason = fmt.Sprintf("Puts a %q event from %s on bus %s; %s's pattern does NOT match it, only other matching rules receive it", detailType, source, aws.ToString(rule.EventBusName), name)
+		}
+		return nil, core.NewConfirmationError(s, "send_test_event", id, params, reason, false)
+	}
+
+	out, err := s.client().PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []types.PutEventsRequestEntry{{
+			EventBusName: rule.EventBusName,
+			Source:       aws.String(source),
+			DetailType:   aws.String(detailType),
+			Detail:       aws.String(detail),
+		}},
+	})
+	if err != nil {
+		return fail(err)
+	}
+	if out.FailedEntryCount > 0 && len(out.Entries) > 0 {
+		entry := out.Entries[0]
+		return fail(fmt.Errorf("%s: %s", aws.ToString(entry.ErrorCode), aws.ToString(entry.ErrorMessage)))
+	}
+
+	eventID := ""
+	if len(out.Entries) > 0 {
+		eventID = aws.ToString(out.Entries[0].EventId)
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("Sent event %s to bus %s", eventID, aws.ToString(rule.EventBusName)))
+	result.Data = map[string]any{"event_id": eventID}
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func ruleToResource(rule types.Rule, region string) core.Resource {
+	bus := aws.ToString(rule.EventBusName)
+	if bus == "" {
+		bus = defaultBus
+	}
+	pattern := aws.ToString(rule.EventPattern)
+	schedule := aws.ToString(rule.ScheduleExpression)
+
+	resource := core.Resource{
+		ID:     aws.ToString(rule.Arn),
+		Type:   "events:rule",
+		Name:   aws.ToString(rule.Name),
+		ARN:    aws.ToString(rule.Arn),
+		State:  ruleState(rule.State),
+		Region: region,
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"bus":         bus,
+			"schedule":    schedule,
+			"pattern":     pattern,
+			"trigger":     trigger(schedule, pattern),
+			"description": aws.ToString(rule.Description),
+			"managed_by":  aws.ToString(rule.ManagedBy),
+			"role_arn":    aws.ToString(rule.RoleArn),
+		},
+	}
+	if source, detailType := patternDefaults(pattern); source != "" || detailType != "" {
+		resource.Metadata["pattern_source"] = source
+		resource.Metadata["pattern_detail_type"] = detailType
+	}
+	return resource
+}
+
+// ruleState returns the state shown for a rule: enabled, disabled, or
+// enabled for all CloudTrail management events.
+func ruleState(state types.RuleState) string {
+	if state == types.RuleStateEnabledWithAllCloudtrailManagementEvents {
+		return "enabled+trail"
+	}
+	return strings.ToLower(string(state))
+}
+
+// trigger summarizes what fires a rule: its schedule, or the sources and
+// detail types of its pattern, such as "aws.ec2: EC2 Instance
+// State-change Notification".
+func trigger(schedule, pattern string) string {
+	if schedule != "" {
+		return schedule
+	}
+	var fields map[string]any
+	if json.Unmarshal([]byte(pattern), &fields) != nil {
+		return pattern
+	}
+	sources := patternValues(fields["source"])
+	detailTypes := patternValues(fields["detail-type"])
+	switch {
+	case len(sources) == 0 && len(detailTypes) == 0:
+		return "pattern"
+	case len(detailTypes) == 0:
+		return strings.Join(sources, ", ")
+	case len(sources) == 0:
+		return strings.Join(detailTypes, ", ")
+	}
+	return strings.Join(sources, ", ") + ": " + strings.Join(detailTypes, ", ")
+}
+
+// patternDefaults returns the first source and detail type a pattern
+// matches exactly, to prefill test events.
+func patternDefaults(pattern string) (source, detailType string) {
+	var fields map[string]any
+	if json.Unmarshal([]byte(pattern), &fields) != nil {
+		return "", ""
+	}
+	if values := patternValues(fields["source"]); len(values) > 0 {
+		source = values[0]
+	}
+	if values := patternValues(fields["detail-type"]); len(values) > 0 {
+		detailType = values[0]
+	}
+	return source, detailType
+}
+
+// patternValues returns the exact string values a pattern field matches,
+// leaving out content filters such as prefix or anything-but.
+func patternValues(field any) []string {
+	list, _ := field.([]any)
+	var values []string
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// parseRuleID returns the bus and name of a rule from its ARN. Rules of
+// the default bus are rule/name, others rule/bus/name; rule names never
+// contain a slash, while partner bus names do.
+func parseRuleID(id string) (bus, name string, err error) {
+	arn, err := core.ParseARN(id)
+	if err != nil || arn.Service != "events" || arn.ResourceType() != "rule" {
+		return "", "", core.NewValidationError("rule", id, "is not an EventBridge rule ARN")
+	}
+	path := arn.ResourceID()
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return defaultBus, path, nil
+	}
+	return path[:i], path[i+1:], nil
+}
+
+// targetName describes a target ARN as service and resource name, such as
+// "lambda:my-function".
+func targetName(arn string) string {
+	parsed, err := core.ParseARN(arn)
+	if err != nil {
+		return arn
+	}
+	return parsed.Service + ":" + parsed.ResourceID()
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "eventbridge", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "eventbridge", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
@@ -0,0 +1,305 @@
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	testRule       = "arn:aws:events:us-east-1:123456789012:rule/orders-created"
+	testSchedule   = "arn:aws:events:us-east-1:123456789012:rule/nightly-report"
+	testUntargeted = "arn:aws:events:us-east-1:123456789012:rule/orders/audit"
+)
+
+// fakeEventBridge serves the default bus with a pattern rule targeting a
+// queue and a schedule, and an orders bus with an untargeted rule, or
+// fails every call when err is set. It records the events put.
+type fakeEventBridge struct {
+	err    error
+	put    []types.PutEventsRequestEntry
+	states map[string]types.RuleState
+}
+
+func newFake() *fakeEventBridge {
+	return &fakeEventBridge{states: map[string]types.RuleState{}}
+}
+
+var testRules = map[string][]types.Rule{
+	defaultBus: {
+		{
+			Arn:          aws.String(testRule),
+			Name:         aws.String("orders-created"),
+			EventBusName: aws.String(defaultBus),
+			EventPattern: aws.String(`{"source":["shop.orders"],"detail-type":["Order Created"]}`),
+			State:        types.RuleStateEnabled,
+		},
+		{
+			Arn:                aws.String(testSchedule),
+			Name:               aws.String("nightly-report"),
+			EventBusName:       aws.String(defaultBus),
+			ScheduleExpression: aws.String("cron(0 2 * * ? *)"),
+			State:              types.RuleStateEnabled,
+		},
+	},
+	"orders": {
+		{
+			Arn:          aws.String(testUntargeted),
+			Name:         aws.String("audit"),
+			EventBusName: aws.String("orders"),
+			EventPattern: aws.String(`{"source":[{"prefix":"shop."}]}`),
+			State:        types.RuleStateEnabled,
+		},
+	},
+}
+
+func (f *fakeEventBridge) rule(bus, name string) (types.Rule, bool) {
+	for _, rule := range testRules[bus] {
+		if aws.ToString(rule.Name) == name {
+			if state, ok := f.states[name]; ok {
+				rule.State = state
+			}
+			return rule, true
+		}
+	}
+	return types.Rule{}, false
+}
+
+func (f *fakeEventBridge) ListEventBuses(context.Context, *eventbridge.ListEventBusesInput, ...func(*eventbridge.Options)) (*eventbridge.ListEventBusesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eventbridge.ListEventBusesOutput{EventBuses: []types.EventBus{
+		{Name: aws.String("orders")},
+		{Name: aws.String(defaultBus)},
+	}}, nil
+}
+
+func (f *fakeEventBridge) ListRules(_ context.Context, in *eventbridge.ListRulesInput, _ ...func(*eventbridge.Options)) (*eventbridge.ListRulesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &eventbridge.ListRulesOutput{Rules: testRules[aws.ToString(in.EventBusName)]}, nil
+}
+
+func (f *fakeEventBridge) DescribeRule(_ context.Context, in *eventbridge.DescribeRuleInput, _ ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	rule, ok := f.rule(aws.ToString(in.EventBusName), aws.ToString(in.Name))
+	if !ok {
+		return nil, errors.New("ResourceNotFoundException: rule does not exist")
+	}
+	return &eventbridge.DescribeRuleOutput{
+		Arn:                rule.Arn,
+		Name:               rule.Name,
+		EventBusName:       rule.EventBusName,
+		EventPattern:       rule.EventPattern,
+		ScheduleExpression: rule.ScheduleExpression,
+		State:              rule.State,
+	}, nil
+}
+
+func (f *fakeEventBridge) ListTargetsByRule(_ context.Context, in *eventbridge.ListTargetsByRuleInput, _ ...func(*eventbridge.Options)) (*eventbridge.ListTargetsByRuleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Rule) != "orders-created" {
+		return &eventbridge.ListTargetsByRuleOutput{}, nil
+	}
+	return &eventbridge.ListTargetsByRuleOutput{Targets: []types.Target{{
+		Id:               aws.String("queue"),
+		Arn:              aws.String("arn:aws:sqs:us-east-1:123456789012:orders"),
+		InputPath:        aws.String("$.detail"),
+		DeadLetterConfig: &types.DeadLetterConfig{Arn: aws.String("arn:aws:sqs:us-east-1:123456789012:orders-dlq")},
+	}}}, nil
+}
+
+func (f *fakeEventBridge) EnableRule(_ context.Context, in *eventbridge.EnableRuleInput, _ ...func(*eventbridge.Options)) (*eventbridge.EnableRuleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.states[aws.ToString(in.Name)] = types.RuleStateEnabled
+	return &eventbridge.EnableRuleOutput{}, nil
+}
+
+func (f *fakeEventBridge) DisableRule(_ context.Context, in *eventbridge.DisableRuleInput, _ ...func(*eventbridge.Options)) (*eventbridge.DisableRuleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.states[aws.ToString(in.Name)] = types.RuleStateDisabled
+	return &eventbridge.DisableRuleOutput{}, nil
+}
+
+// TestEventPattern matches the source and detail type lists only, which
+// is all the test rules use exactly.
+func (f *fakeEventBridge) TestEventPattern(_ context.Context, in *eventbridge.TestEventPatternInput, _ ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var event map[string]any
+	if err := json.Unmarshal([]byte(aws.ToString(in.Event)), &event); err != nil {
+		return nil, errors.New("InvalidEventPatternException: invalid event")
+	}
+	var pattern map[string]any
+	_ = json.Unmarshal([]byte(aws.ToString(in.EventPattern)), &pattern)
+	match := true
+	for _, field := range []string{"source", "detail-type"} {
+		if values := patternValues(pattern[field]); len(values) > 0 {
+			match = match && values[0] == event[field]
+		}
+	}
+	return &eventbridge.TestEventPatternOutput{Result: match}, nil
+}
+
+func (f *fakeEventBridge) PutEvents(_ context.Context, in *eventbridge.PutEventsInput, _ ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.put = append(f.put, in.Entries...)
+	return &eventbridge.PutEventsOutput{Entries: []types.PutEventsResultEntry{{EventId: aws.String("6a7e8feb")}}}, nil
+}
+
+// TestServiceConformance runs the core service contract against rules.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(newFake(), d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEventBridge{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    testRule,
+		MissingID:     "arn:aws:events:us-east-1:123456789012:rule/missing",
+		Action:        "view_targets",
+		ConfirmAction: "disable",
+	})
+}
+
+func TestListAndEnrich(t *testing.T) {
+	svc := NewServiceWithClient(newFake(), nil)
+	ctx := context.Background()
+
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 3 || resources[0].ID != testRule || resources[2].GetMetadataString("bus") != "orders" {
+		t.Fatalf("List() = %+v, want the default bus first", resources)
+	}
+
+	for i := range resources {
+		if err := svc.EnrichResource(ctx, &resources[i]); err != nil {
+			t.Fatalf("EnrichResource(%s) error = %v", resources[i].Name, err)
+		}
+	}
+	orders, schedule, audit := resources[0], resources[1], resources[2]
+	if got := orders.GetMetadataString("trigger"); got != "shop.orders: Order Created" {
+		t.Errorf("trigger = %q", got)
+	}
+	if got := schedule.GetMetadataString("trigger"); got != "cron(0 2 * * ? *)" {
+		t.Errorf("schedule trigger = %q", got)
+	}
+	if names, _ := orders.Metadata["targets"].([]string); len(names) != 1 || names[0] != "sqs:orders" {
+		t.Errorf("targets = %v, want [sqs:orders]", names)
+	}
+	if len(orders.Issues()) > 0 {
+		t.Errorf("targeted rule has issues %v", orders.Issues())
+	}
+	if len(audit.Issues()) == 0 {
+		t.Error("enabled rule without targets has no issue")
+	}
+}
+
+func TestDisable(t *testing.T) {
+	fake := newFake()
+	svc := NewServiceWithClient(fake, nil)
+	ctx := context.Background()
+
+	_, err := svc.Execute(ctx, "disable", testRule, nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "1 targets") {
+		t.Fatalf("disable error = %v, want a confirmation naming the targets", err)
+	}
+	if _, err := svc.Execute(ctx, "disable", testRule, map[string]any{core.ParamConfirm: true}); err != nil {
+		t.Fatalf("confirmed disable error = %v", err)
+	}
+	if fake.states["orders-created"] != types.RuleStateDisabled {
+		t.Error("rule was not disabled")
+	}
+	if _, err := svc.Execute(ctx, "disable", testRule, map[string]any{core.ParamConfirm: true}); err == nil {
+		t.Error("disabling a disabled rule succeeded")
+	}
+	if _, err := svc.Execute(ctx, "enable", testRule, nil); err != nil {
+		t.Fatalf("enable error = %v", err)
+	}
+}
+
+func TestSendTestEvent(t *testing.T) {
+	fake := newFake()
+	svc := NewServiceWithClient(fake, nil)
+	ctx := context.Background()
+	params := map[string]any{"source": "shop.orders", "detail_type": "Order Created", "detail": `{"id": 7}`}
+
+	if _, err := svc.Execute(ctx, "send_test_event", testSchedule, params); err == nil {
+		t.Error("test event for a schedule succeeded")
+	}
+	bad := map[string]any{"source": "shop.orders", "detail_type": "Order Created", "detail": "[1]"}
+	if _, err := svc.Execute(ctx, "send_test_event", testRule, bad); err == nil {
+		t.Error("test event with a non-object detail succeeded")
+	}
+
+	_, err := svc.Execute(ctx, "send_test_event", testRule, params)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || strings.Contains(confirm.Reason, "NOT") {
+		t.Fatalf("send_test_event error = %v, want a confirmation saying the rule matches", err)
+	}
+	params["detail_type"] = "Order Shipped"
+	_, err = svc.Execute(ctx, "send_test_event", testRule, params)
+	if !errors.As(err, &confirm) || !strings.Contains(confirm.Reason, "NOT") {
+		t.Fatalf("send_test_event error = %v, want a confirmation saying the rule does not match", err)
+	}
+	if len(fake.put) != 0 {
+		t.Fatal("events put before confirmation")
+	}
+
+	params[core.ParamConfirm] = true
+	result, err := svc.Execute(ctx, "send_test_event", testRule, params)
+	if err != nil {
+		t.Fatalf("confirmed send_test_event error = %v", err)
+	}
+	if len(fake.put) != 1 || aws.ToString(fake.put[0].EventBusName) != defaultBus || aws.ToString(fake.put[0].Detail) != `{"id": 7}` {
+		t.Errorf("put %+v", fake.put)
+	}
+	if data, _ := result.Data.(map[string]any); data["event_id"] != "6a7e8feb" {
+		t.Errorf("result data = %v", result.Data)
+	}
+}
+
+func TestParseRuleID(t *testing.T) {
+	tests := []struct {
+		id, bus, name string
+	}{
+		{testRule, defaultBus, "orders-created"},
+		{testUntargeted, "orders", "audit"},
+		{"arn:aws:events:us-east-1:123456789012:rule/aws.partner/saas.com/123/sync", "aws.partner/saas.com/123", "sync"},
+	}
+	for _, tt := range tests {
+		bus, name, err := parseRuleID(tt.id)
+		if err != nil || bus != tt.bus || name != tt.name {
+			t.Errorf("parseRuleID(%q) = %q, %q, %v, want %q, %q", tt.id, bus, name, err, tt.bus, tt.name)
+		}
+	}
+	if _, _, err := parseRuleID("arn:aws:sns:us-east-1:123456789012:orders"); err == nil {
+		t.Error("parseRuleID accepted a topic ARN")
+	}
+}
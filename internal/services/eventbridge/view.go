@@ -0,0 +1,382 @@
+package eventbridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const testEventFormID = "eventbridge:send_test_event"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for EventBridge rules.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget *core.Resource // Rule the test event form is open for
+}
+
+// NewView creates a new EventBridge rules view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Bus"), MinWidth: 8, MaxWidth: 30, Weight: 0.6, Priority: 2},
+		{Title: i18n.T("Trigger"), MinWidth: 15, MaxWidth: 60, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Targets"), MinWidth: 7, MaxWidth: 40, Weight: 0.8, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("EventBridge", "", "eventbridge", i18n.T("rules"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "e":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Enabling %s...", row.Name)
+				return v, v.executeAction("enable", row.ID, nil)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Disabling %s...", row.Name)
+				return v, v.executeAction("disable", row.ID, nil)
+			}
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading the targets of %s...", row.Name)
+				return v, v.executeAction("view_targets", row.ID, nil)
+			}
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openTestEventForm(row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Rule %s", row.Name), formatRule(row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != testEventFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == nil {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Sending a test event for %s...", v.formTarget.Name)
+		cmds = append(cmds, v.executeAction("send_test_event", v.formTarget.ID, msg.Values))
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading rules...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [e]nable  [d]isable  [t]argets  [s]end test event  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the rules, keeping the targets already counted.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// openTestEventForm asks for the event to send, starting from the source
+// and detail type the rule's pattern matches.
+func (v *View) openTestEventForm(r *core.Resource) tea.Cmd {
+	if r.GetMetadataString("pattern") == "" {
+		v.Message = i18n.T("%s runs on a schedule and matches no events", r.Name)
+		return nil
+	}
+	def, ok := base.FindAction(v.Service(), "send_test_event")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "send_test_event")
+		return nil
+	}
+	params := make([]core.ActionParameter, len(def.Parameters))
+	copy(params, def.Parameters)
+	for i := range params {
+		switch params[i].Name {
+		case "source":
+			if source := r.GetMetadataString("pattern_source"); source != "" {
+				params[i].Default = source
+			}
+		case "detail_type":
+			if detailType := r.GetMetadataString("pattern_detail_type"); detailType != "" {
+				params[i].Default = detailType
+			}
+		}
+	}
+	target := *r
+	v.formTarget = &target
+	return v.OpenForm(components.NewForm(testEventFormID, i18n.T("Test event for %s", r.Name), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	switch msg.Action {
+	case "view_targets":
+		targets, _ := msg.Result.Data.([]Target)
+		title := i18n.T("Targets")
+		if row := v.GetSelectedResource(); row != nil {
+			title = i18n.T("Targets of %s", row.Name)
+		}
+		v.OpenDetail(title, formatTargets(targets))
+	case "enable", "disable":
+		return v.SoftRefresh()
+	}
+	return nil
+}
+
+func buildRow(r core.Resource) base.Row {
+	targets := "-"
+	count, analyzed := r.Metadata["target_count"].(int)
+	if analyzed {
+		targets = fmt.Sprintf("%d", count)
+		if names, _ := r.Metadata["targets"].([]string); len(names) > 0 {
+			targets += " " + strings.Join(names, ", ")
+		}
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(r.GetMetadataString("bus")),
+		base.TextCell(r.GetMetadataString("trigger")),
+		base.TextCell(r.State),
+		base.LazyCell(count, func() string { return targets }),
+		base.SeverityCell(r),
+	}
+}
+
+// formatRule renders a rule with its pattern, pretty-printed, for the
+// detail panel.
+func formatRule(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", r.ARN)
+	fmt.Fprintf(&b, "Bus:         %s\n", r.GetMetadataString("bus"))
+	if desc := r.GetMetadataString("description"); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
+	fmt.Fprintf(&b, "State:       %s\n", r.State)
+	if managedBy := r.GetMetadataString("managed_by"); managedBy != "" {
+		fmt.Fprintf(&b, "Managed by:  %s\n", managedBy)
+	}
+	if role := r.GetMetadataString("role_arn"); role != "" {
+		fmt.Fprintf(&b, "Role:        %s\n", role)
+	}
+	if schedule := r.GetMetadataString("schedule"); schedule != "" {
+		fmt.Fprintf(&b, "Schedule:    %s\n", schedule)
+	}
+	if pattern := r.GetMetadataString("pattern"); pattern != "" {
+		var indented bytes.Buffer
+		if json.Indent(&indented, []byte(pattern), "  ", "  ") == nil {
+			pattern = indented.String()
+		}
+		fmt.Fprintf(&b, "\nPattern:\n  %s\n", pattern)
+	}
+
+	if names, ok := r.Metadata["targets"].([]string); ok {
+		b.WriteString(i18n.T("\nTargets:\n"))
+		if len(names) == 0 {
+			b.WriteString("  (none)\n")
+		}
+		for _, name := range names {
+			fmt.Fprintf(&b, "  %s\n", name)
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatTargets renders the targets of a rule with what they receive and
+// how failed deliveries are retried.
+func formatTargets(targets []Target) string {
+	if len(targets) == 0 {
+		return i18n.T("No targets; matched events go nowhere.")
+	}
+	var b strings.Builder
+	for i, t := range targets {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s\n", t.ID)
+		fmt.Fprintf(&b, "  ARN:     %s\n", t.ARN)
+		if t.Role != "" {
+			fmt.Fprintf(&b, "  Role:    %s\n", t.Role)
+		}
+		if t.Input != "" {
+			fmt.Fprintf(&b, "  Input:   %s\n", t.Input)
+		} else {
+			b.WriteString("  Input:   matched event\n")
+		}
+		if t.Retries != "" {
+			fmt.Fprintf(&b, "  Retries: %s\n", t.Retries)
+		} else {
+			b.WriteString("  Retries: default (185 attempts over 24h)\n")
+		}
+		if t.DLQ != "" {
+			fmt.Fprintf(&b, "  Dead-letter queue: %s\n", t.DLQ)
+		} else {
+			b.WriteString("  Dead-letter queue: none\n")
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	disabled, untargeted := 0, 0
+	for _, r := range v.Resources {
+		if r.State == "disabled" {
+			disabled++
+		} else if count, ok := r.Metadata["target_count"].(int); ok && count == 0 {
+			untargeted++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "enabled", Text: i18n.T("Enabled: %d", len(v.Resources)-disabled), Tone: core.ToneSuccess},
+		core.SummaryWidget{Name: "disabled", Text: i18n.T("Disabled: %d", disabled), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "no-targets", Text: i18n.T("No targets: %d", untargeted), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("EventBridge Rules"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "eventbridge" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
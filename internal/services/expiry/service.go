@@ -0,0 +1,581 @@
+// Package expiry collects what is about to expire across services for the
+// a9s application: ACM certificates, IAM server certificates, KMS keys
+// scheduled for deletion and IAM access keys due for rotation, in a single
+// table sorted by days remaining.
+package expiry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Sources of expiring items, as shown in the Source column.
+const (
+	SourceACM       = "ACM"
+	SourceIAMCert   = "IAM"
+	SourceKMS       = "KMS"
+	SourceAccessKey = "Access key"
+)
+
+const (
+	// defaultWarnDays is how close to its expiry an item is flagged medium.
+	defaultWarnDays = 30
+	// defaultCriticalDays is how close to its expiry an item is flagged
+	// high.
+	defaultCriticalDays = 7
+	// defaultAccessKeyMaxAge is the age in days access keys are due for
+	// rotation at.
+	defaultAccessKeyMaxAge = 90
+)
+
+// Service lists expiring certificates and keys across services.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+
+	warnDays        int
+	criticalDays    int
+	accessKeyMaxAge int
+
+	acmClient ACMAPI
+	iamClient IAMAPI
+	kmsClient KMSAPI
+}
+
+// Option configures the expiry service.
+type Option func(*Service)
+
+// WithWarnDays sets how many days before its expiry an item is flagged
+// medium. Non-positive values keep the default.
+func WithWarnDays(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.warnDays = days
+		}
+	}
+}
+
+// WithCriticalDays sets how many days before its expiry an item is flagged
+// high. Non-positive values keep the default.
+func WithCriticalDays(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.criticalDays = days
+		}
+	}
+}
+
+// WithAccessKeyMaxAge sets the age in days access keys are due for rotation
+// at. Non-positive values keep the default.
+func WithAccessKeyMaxAge(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.accessKeyMaxAge = days
+		}
+	}
+}
+
+// WithACMClient sets a custom ACM client (for testing).
+func WithACMClient(client ACMAPI) Option {
+	return func(s *Service) {
+		s.acmClient = client
+	}
+}
+
+// WithIAMClient sets a custom IAM client (for testing).
+func WithIAMClient(client IAMAPI) Option {
+	return func(s *Service) {
+		s.iamClient = client
+	}
+}
+
+// WithKMSClient sets a custom KMS client (for testing).
+func WithKMSClient(client KMSAPI) Option {
+	return func(s *Service) {
+		s.kmsClient = client
+	}
+}
+
+// ACMAPI defines the ACM client interface for mocking.
+type ACMAPI interface {
+	ListCertificates(ctx context.Context, params *acm.ListCertificatesInput, optFns ...func(*acm.Options)) (*acm.ListCertificatesOutput, error)
+}
+
+// IAMAPI defines the IAM client interface for mocking.
+type IAMAPI interface {
+	ListServerCertificates(ctx context.Context, params *iam.ListServerCertificatesInput, optFns ...func(*iam.Options)) (*iam.ListServerCertificatesOutput, error)
+	ListUsers(ctx context.Context, params *iam.ListUsersInput, optFns ...func(*iam.Options)) (*iam.ListUsersOutput, error)
+	ListAccessKeys(ctx context.Context, params *iam.ListAccessKeysInput, optFns ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
+}
+
+// KMSAPI defines the KMS client interface for mocking.
+type KMSAPI interface {
+	ListKeys(ctx context.Context, params *kms.ListKeysInput, optFns ...func(*kms.Options)) (*kms.ListKeysOutput, error)
+	DescribeKey(ctx context.Context, params *kms.DescribeKeyInput, optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+}
+
+// NewService creates a new expiry service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:         factory,
+		dispatcher:      dispatcher,
+		warnDays:        defaultWarnDays,
+		criticalDays:    defaultCriticalDays,
+		accessKeyMaxAge: defaultAccessKeyMaxAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) acmAPI() ACMAPI {
+	if s.acmClient != nil {
+		return s.acmClient
+	}
+	return acm.NewFromConfig(s.factory.Config())
+}
+
+func (s *Service) iamAPI() IAMAPI {
+	if s.iamClient != nil {
+		return s.iamClient
+	}
+	return s.factory.IAMClient()
+}
+
+func (s *Service) kmsAPI() KMSAPI {
+	if s.kmsClient != nil {
+		return s.kmsClient
+	}
+	return kms.NewFromConfig(s.factory.Config())
+}
+
+// Thresholds returns the warning and critical thresholds, in days.
+func (s *Service) Thresholds() (warn, critical int) {
+	return s.warnDays, s.criticalDays
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "expiry"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Expiring Certificates and Keys"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "hourglass"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.acmAPI().ListCertificates(ctx, &acm.ListCertificatesInput{MaxItems: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("expiry", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// Scan is the expiring items found across sources.
+type Scan struct {
+	Resources   []core.Resource  // Items, soonest to expire first
+	Unavailable map[string]error // Sources that could not be read
+}
+
+// Err reports the Unavailable sources as a core.PartialError, or nil when
+// there are none.
+func (sc Scan) Err() error {
+	return core.NewPartialError("sources", sc.Unavailable)
+}
+
+// List returns the items of every source that could be read, soonest to
+// expire first, with a core.PartialError naming the others. It only fails
+// outright when every source fails.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	scan, err := s.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scan.Resources, scan.Err()
+}
+
+// Scan lists expiring items source by source. A source that cannot be
+// read, for example for lack of permissions, is recorded in Unavailable
+// and the others are still listed.
+func (s *Service) Scan(ctx context.Context) (Scan, error) {
+	sources := []struct {
+		name string
+		list func(context.Context) ([]item, error)
+	}{
+		{SourceACM, s.listCertificates},
+		{SourceIAMCert, s.listServerCertificates},
+		{SourceKMS, s.listKeyDeletions},
+		{SourceAccessKey, s.listAccessKeys},
+	}
+
+	now := time.Now()
+	scan := Scan{Resources: make([]core.Resource, 0), Unavailable: make(map[string]error)}
+	var errs []error
+	for _, source := range sources {
+		found, err := source.list(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list_"+strings.ToLower(strings.ReplaceAll(source.name, " ", "_")), err)
+			scan.Unavailable[source.name] = err
+			errs = append(errs, fmt.Errorf("%s: %w", source.name, err))
+			continue
+		}
+		for _, it := range found {
+			scan.Resources = append(scan.Resources, s.toResource(it, now))
+		}
+	}
+	if len(errs) == len(sources) {
+		return Scan{}, core.NewServiceError("expiry", "list", errors.Join(errs...))
+	}
+
+	slices.SortStableFunc(scan.Resources, func(a, b core.Resource) int {
+		return DaysLeft(a) - DaysLeft(b)
+	})
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "expiry",
+		Count:        len(scan.Resources),
+	})
+
+	return scan, nil
+}
+
+// item is something with an expiry date, before thresholds are applied.
+type item struct {
+	source    string
+	kind      string // What happens at the date: expires, deleted, rotation due
+	id        string
+	arn       string
+	name      string
+	detail    string
+	expires   time.Time
+	created   *time.Time
+	autoRenew bool // ACM renews the certificate on its own
+	inUse     bool
+	metadata  map[string]any
+}
+
+// listCertificates returns the issued and expired ACM certificates of the
+// region, whatever their key algorithm.
+func (s *Service) listCertificates(ctx context.Context) ([]item, error) {
+	input := &acm.ListCertificatesInput{
+		CertificateStatuses: []acmtypes.CertificateStatus{acmtypes.CertificateStatusIssued, acmtypes.CertificateStatusExpired},
+		// Only RSA 2048 certificates are listed unless asked otherwise
+		Includes: &acmtypes.Filters{KeyTypes: acmtypes.KeyAlgorithm("").Values()},
+	}
+
+	var items []item
+	paginator := acm.NewListCertificatesPaginator(s.acmAPI(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range page.CertificateSummaryList {
+			if cert.NotAfter == nil {
+				continue
+			}
+			arn := aws.ToString(cert.CertificateArn)
+			items = append(items, item{
+				source:  SourceACM,
+				kind:    "expires",
+				id:      arn,
+				arn:     arn,
+				name:    aws.ToString(cert.DomainName),
+				detail:  strings.ToLower(strings.ReplaceAll(string(cert.Type), "_", " ")),
+				expires: *cert.NotAfter,
+				created: cert.CreatedAt,
+				autoRenew: cert.Type == acmtypes.CertificateTypeAmazonIssued &&
+					cert.RenewalEligibility == acmtypes.RenewalEligibilityEligible,
+				inUse: aws.ToBool(cert.InUse),
+				metadata: map[string]any{
+					"status":    string(cert.Status),
+					"algorithm": string(cert.KeyAlgorithm),
+				},
+			})
+		}
+	}
+	return items, nil
+}
+
+// listServerCertificates returns the certificates uploaded to IAM, which
+// older load balancers and CloudFront distributions may still serve.
+func (s *Service) listServerCertificates(ctx context.Context) ([]item, error) {
+	var items []item
+	paginator := iam.NewListServerCertificatesPaginator(s.iamAPI(), &iam.ListServerCertificatesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range page.ServerCertificateMetadataList {
+			if cert.Expiration == nil {
+				continue
+			}
+			items = append(items, item{
+				source:   SourceIAMCert,
+				kind:     "expires",
+				id:       aws.ToString(cert.ServerCertificateId),
+				arn:      aws.ToString(cert.Arn),
+				name:     aws.ToString(cert.ServerCertificateName),
+				detail:   "server certificate",
+				expires:  *cert.Expiration,
+				created:  cert.UploadDate,
+				inUse:    true, // IAM does not tell
+				metadata: map[string]any{"path": aws.ToString(cert.Path)},
+			})
+		}
+	}
+	return items, nil
+}
+
+// listKeyDeletions returns the KMS keys of the region scheduled for
+// deletion. ListKeys carries no state, so every key is described.
+func (s *Service) listKeyDeletions(ctx context.Context) ([]item, error) {
+	client := s.kmsAPI()
+
+	var items []item
+	paginator := kms.NewListKeysPaginator(client, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range page.Keys {
+			out, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
+			if err != nil || out.KeyMetadata == nil || out.KeyMetadata.DeletionDate == nil {
+				// Keys of other accounts' grants cannot be described
+				continue
+			}
+			meta := out.KeyMetadata
+			name := aws.ToString(meta.Description)
+			if name == "" {
+				name = aws.ToString(meta.KeyId)
+			}
+			items = append(items, item{
+				source:   SourceKMS,
+				kind:     "deleted",
+				id:       aws.ToString(meta.KeyId),
+				arn:      aws.ToString(meta.Arn),
+				name:     name,
+				detail:   strings.ToLower(string(meta.KeyManager)) + " key",
+				expires:  *meta.DeletionDate,
+				created:  meta.CreationDate,
+				inUse:    true,
+				metadata: map[string]any{"key_state": string(meta.KeyState)},
+			})
+		}
+	}
+	return items, nil
+}
+
+// listAccessKeys returns the active access keys of every IAM user, due for
+// rotation once they reach the maximum age.
+func (s *Service) listAccessKeys(ctx context.Context) ([]item, error) {
+	client := s.iamAPI()
+	maxAge := time.Duration(s.accessKeyMaxAge) * 24 * time.Hour
+
+	var items []item
+	users := iam.NewListUsersPaginator(client, &iam.ListUsersInput{})
+	for users.HasMorePages() {
+		page, err := users.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range page.Users {
+			keys, err := client.ListAccessKeys(ctx, &iam.ListAccessKeysInput{UserName: user.UserName})
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys.AccessKeyMetadata {
+				if key.Status != iamtypes.StatusTypeActive || key.CreateDate == nil {
+					continue
+				}
+				items = append(items, item{
+					source:   SourceAccessKey,
+					kind:     "rotation due",
+					id:       aws.ToString(key.AccessKeyId),
+					name:     aws.ToString(user.UserName) + "/" + aws.ToString(key.AccessKeyId),
+					detail:   "user " + aws.ToString(user.UserName),
+					expires:  key.CreateDate.Add(maxAge),
+					created:  key.CreateDate,
+					inUse:    true,
+					metadata: map[string]any{"user": aws.ToString(user.UserName)},
+				})
+			}
+		}
+	}
+	return items, nil
+}
+
+// =============================================================================
+// Thresholds
+// =============================================================================
+
+// DaysLeft returns the whole days left before an item expires, negative
+// once it has.
+func DaysLeft(r core.Resource) int {
+	days, _ := r.Metadata["days_left"].(int)
+	return days
+}
+
+// Expires returns the date an item expires.
+func Expires(r core.Resource) time.Time {
+	t, _ := r.Metadata["expires"].(time.Time)
+	return t
+}
+
+// toResource turns an item into a resource flagged against the thresholds.
+func (s *Service) toResource(it item, now time.Time) core.Resource {
+	days := int(math.Floor(it.expires.Sub(now).Hours() / 24))
+
+	metadata := map[string]any{
+		"source":     it.source,
+		"kind":       it.kind,
+		"detail":     it.detail,
+		"expires":    it.expires,
+		"days_left":  days,
+		"auto_renew": it.autoRenew,
+		"in_use":     it.inUse,
+	}
+	for k, v := range it.metadata {
+		metadata[k] = v
+	}
+
+	r := core.Resource{
+		ID:        it.id,
+		Type:      "expiry:" + strings.ToLower(strings.ReplaceAll(it.source, " ", "_")),
+		Name:      it.name,
+		ARN:       it.arn,
+		State:     core.StateActive,
+		CreatedAt: it.created,
+		Tags:      make(map[string]string),
+		Metadata:  metadata,
+	}
+	switch {
+	case days < 0:
+		r.State = core.StateInactive
+	case it.source == SourceKMS:
+		r.State = core.StateDeleting
+	}
+	s.flag(&r, it, days)
+	return r
+}
+
+// flag raises an item's issue: expired certificates are critical, overdue
+// access keys high, and items within the critical or warning threshold high
+// or medium. Certificates ACM renews on its own, and unused ones, are only
+// noted.
+func (s *Service) flag(r *core.Resource, it item, days int) {
+	when := it.expires.UTC().Format("2006-01-02")
+
+	switch {
+	case days < 0 && it.source == SourceAccessKey:
+		r.AddIssue(core.SeverityHigh, fmt.Sprintf("Not rotated for %d days, past the %d-day maximum age", s.accessKeyMaxAge-days, s.accessKeyMaxAge))
+	case days < 0:
+		r.AddIssue(core.SeverityCritical, fmt.Sprintf("%s on %s", past(it.kind), when))
+	case days > s.warnDays:
+		return
+	case it.autoRenew:
+		r.AddIssue(core.SeverityLow, fmt.Sprintf("Expires on %s; ACM renews it automatically", when))
+	case !it.inUse:
+		r.AddIssue(core.SeverityLow, fmt.Sprintf("Expires on %s but is not in use", when))
+	case days <= s.criticalDays:
+		r.AddIssue(core.SeverityHigh, fmt.Sprintf("%s in %d days, on %s", present(it.kind), days, when))
+	default:
+		r.AddIssue(core.SeverityMedium, fmt.Sprintf("%s in %d days, on %s", present(it.kind), days, when))
+	}
+}
+
+// present phrases what happens to an item in the future.
+func present(kind string) string {
+	switch kind {
+	case "deleted":
+		return "Key is deleted"
+	case "rotation due":
+		return "Rotation due"
+	}
+	return "Expires"
+}
+
+// past phrases what happened to an item.
+func past(kind string) string {
+	if kind == "deleted" {
+		return "Key was deleted"
+	}
+	return "Expired"
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "expiry", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "expiry", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+)
@@ -0,0 +1,227 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const apiCert = "arn:aws:acm:us-east-1:123456789012:certificate/api"
+
+// fakeSources serves, relative to now, ACM certificates expiring in 5 days
+// (renewed by ACM), 3 days, 20 days (unused) and 200 days and one expired 2
+// days ago; an IAM server certificate expiring in 15 days; a KMS key
+// deleted in 6 days; and an access key of alice created 100 days ago. Days
+// are offset by half a day, so they floor the same whenever the test runs.
+// It fails every call when err is set, and KMS calls when kmsErr is set.
+type fakeSources struct {
+	err    error
+	kmsErr error
+	now    time.Time
+}
+
+func (f *fakeSources) in(days int) *time.Time {
+	t := f.now.Add(time.Duration(days)*24*time.Hour + 12*time.Hour)
+	return &t
+}
+
+func (f *fakeSources) ListCertificates(context.Context, *acm.ListCertificatesInput, ...func(*acm.Options)) (*acm.ListCertificatesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	cert := func(name string, days int, certType acmtypes.CertificateType, inUse bool) acmtypes.CertificateSummary {
+		return acmtypes.CertificateSummary{
+			CertificateArn:     aws.String("arn:aws:acm:us-east-1:123456789012:certificate/" + name),
+			DomainName:         aws.String(name + ".example.com"),
+			NotAfter:           f.in(days),
+			Type:               certType,
+			RenewalEligibility: acmtypes.RenewalEligibilityEligible,
+			InUse:              aws.Bool(inUse),
+		}
+	}
+	pending := cert("pending", 0, acmtypes.CertificateTypeAmazonIssued, false)
+	pending.NotAfter = nil
+	return &acm.ListCertificatesOutput{CertificateSummaryList: []acmtypes.CertificateSummary{
+		cert("api", 5, acmtypes.CertificateTypeAmazonIssued, true),
+		cert("legacy", 3, acmtypes.CertificateTypeImported, true),
+		cert("old", 20, acmtypes.CertificateTypeImported, false),
+		cert("far", 200, acmtypes.CertificateTypeImported, true),
+		cert("expired", -3, acmtypes.CertificateTypeImported, true),
+		pending,
+	}}, nil
+}
+
+func (f *fakeSources) ListServerCertificates(context.Context, *iam.ListServerCertificatesInput, ...func(*iam.Options)) (*iam.ListServerCertificatesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListServerCertificatesOutput{ServerCertificateMetadataList: []iamtypes.ServerCertificateMetadata{{
+		ServerCertificateId:   aws.String("ASCA1"),
+		ServerCertificateName: aws.String("cdn-2019"),
+		Arn:                   aws.String("arn:aws:iam::123456789012:server-certificate/cdn-2019"),
+		Path:                  aws.String("/cloudfront/"),
+		Expiration:            f.in(15),
+	}}}, nil
+}
+
+func (f *fakeSources) ListUsers(context.Context, *iam.ListUsersInput, ...func(*iam.Options)) (*iam.ListUsersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListUsersOutput{Users: []iamtypes.User{{UserName: aws.String("alice")}}}, nil
+}
+
+func (f *fakeSources) ListAccessKeys(context.Context, *iam.ListAccessKeysInput, ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListAccessKeysOutput{AccessKeyMetadata: []iamtypes.AccessKeyMetadata{
+		{AccessKeyId: aws.String("AKIAOLD"), Status: iamtypes.StatusTypeActive, CreateDate: f.in(-100)},
+		{AccessKeyId: aws.String("AKIAOFF"), Status: iamtypes.StatusTypeInactive, CreateDate: f.in(-400)},
+	}}, nil
+}
+
+func (f *fakeSources) ListKeys(context.Context, *kms.ListKeysInput, ...func(*kms.Options)) (*kms.ListKeysOutput, error) {
+	if err := errors.Join(f.err, f.kmsErr); err != nil {
+		return nil, err
+	}
+	return &kms.ListKeysOutput{Keys: []kmstypes.KeyListEntry{
+		{KeyId: aws.String("k-1")}, {KeyId: aws.String("k-2")}, {KeyId: aws.String("k-other")},
+	}}, nil
+}
+
+func (f *fakeSources) DescribeKey(_ context.Context, in *kms.DescribeKeyInput, _ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	switch aws.ToString(in.KeyId) {
+	case "k-1":
+		return &kms.DescribeKeyOutput{KeyMetadata: &kmstypes.KeyMetadata{
+			KeyId:        aws.String("k-1"),
+			Description:  aws.String("old signing key"),
+			KeyManager:   kmstypes.KeyManagerTypeCustomer,
+			KeyState:     kmstypes.KeyStatePendingDeletion,
+			DeletionDate: f.in(6),
+		}}, nil
+	case "k-2":
+		return &kms.DescribeKeyOutput{KeyMetadata: &kmstypes.KeyMetadata{KeyId: aws.String("k-2"), KeyState: kmstypes.KeyStateEnabled}}, nil
+	}
+	return nil, errors.New("AccessDeniedException")
+}
+
+func newTestService(fake *fakeSources, d core.EventDispatcher, opts ...Option) *Service {
+	if fake.now.IsZero() {
+		fake.now = time.Now()
+	}
+	opts = append([]Option{WithACMClient(fake), WithIAMClient(fake), WithKMSClient(fake)}, opts...)
+	return NewService(nil, d, opts...)
+}
+
+// TestServiceConformance runs the core service contract against expiring
+// items.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return newTestService(&fakeSources{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return newTestService(&fakeSources{err: errors.New("ExpiredToken")}, d)
+		},
+		ExistingID: apiCert,
+	})
+}
+
+func TestScanFlagsByThreshold(t *testing.T) {
+	fake := &fakeSources{now: time.Now()}
+	on := func(days int) string { return fake.in(days).UTC().Format("2006-01-02") }
+
+	scan, err := newTestService(fake, nil).Scan(context.Background())
+	if err != nil || scan.Err() != nil {
+		t.Fatalf("Scan() error = %v, %v", err, scan.Err())
+	}
+
+	want := []struct {
+		name  string
+		issue string
+	}{
+		{"alice/AKIAOLD", "Not rotated for 100 days, past the 90-day maximum age"},
+		{"expired.example.com", "Expired on " + on(-3)},
+		{"legacy.example.com", "Expires in 3 days, on " + on(3)},
+		{"api.example.com", "Expires on " + on(5) + "; ACM renews it automatically"},
+		{"old signing key", "Key is deleted in 6 days, on " + on(6)},
+		{"cdn-2019", "Expires in 15 days, on " + on(15)},
+		{"old.example.com", "Expires on " + on(20) + " but is not in use"},
+		{"far.example.com", ""},
+	}
+	if len(scan.Resources) != len(want) {
+		t.Fatalf("Scan() = %d items, want %d", len(scan.Resources), len(want))
+	}
+	for i, r := range scan.Resources {
+		var issue string
+		if issues := r.Issues(); len(issues) > 0 {
+			issue = issues[0].Message
+		}
+		if r.Name != want[i].name || issue != want[i].issue {
+			t.Errorf("item %d = %s %q, want %s %q", i, r.Name, issue, want[i].name, want[i].issue)
+		}
+	}
+}
+
+func TestThresholdOptions(t *testing.T) {
+	svc := newTestService(&fakeSources{}, nil, WithWarnDays(10), WithCriticalDays(2), WithAccessKeyMaxAge(365))
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	severities := make(map[string]core.Severity)
+	for _, r := range resources {
+		for _, issue := range r.Issues() {
+			severities[r.Name] = issue.Severity
+		}
+	}
+	for name, want := range map[string]core.Severity{
+		"legacy.example.com": core.SeverityMedium, // Past the critical threshold
+		"old signing key":    core.SeverityMedium,
+	} {
+		if severities[name] != want {
+			t.Errorf("%s severity = %v, want %v", name, severities[name], want)
+		}
+	}
+	for _, name := range []string{"cdn-2019", "alice/AKIAOLD"} {
+		if _, flagged := severities[name]; flagged {
+			t.Errorf("%s flagged outside the thresholds", name)
+		}
+	}
+}
+
+// TestScanKeepsReadableSources checks that a source denied is reported
+// while the others are still listed.
+func TestScanKeepsReadableSources(t *testing.T) {
+	resources, err := newTestService(&fakeSources{kmsErr: errors.New("AccessDeniedException: kms:ListKeys")}, nil).List(context.Background(), core.ListOptions{})
+
+	var partial *core.PartialError
+	if !errors.As(err, &partial) || len(partial.Failures) != 1 || partial.Failures[0].Shard != SourceKMS {
+		t.Fatalf("List() error = %v, want KMS as a partial error", err)
+	}
+	sources := make([]string, 0, len(resources))
+	for _, r := range resources {
+		if source := r.GetMetadataString("source"); !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+	slices.Sort(sources)
+	if want := []string{SourceACM, SourceAccessKey, SourceIAMCert}; !slices.Equal(sources, want) {
+		t.Errorf("sources listed = %v, want %v", sources, want)
+	}
+}
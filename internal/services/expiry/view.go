@@ -0,0 +1,281 @@
+package expiry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for expiring certificates and keys.
+type View struct {
+	*base.TableView
+
+	all          []core.Resource // Every item; Resources holds the shown ones
+	warningsOnly bool
+}
+
+// NewView creates a new expiry view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Source"), MinWidth: 10, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Detail"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 2},
+		{Title: i18n.T("Expires"), MinWidth: 10, MaxWidth: 10, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Days Left"), MinWidth: 9, MaxWidth: 9, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Expiry", "", "expiry", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.all) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadExpiry()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "w":
+			v.warningsOnly = !v.warningsOnly
+			v.applyVisibility()
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Expiry of %s", row.Name), formatExpiry(row))
+			}
+		}
+
+	case expiryLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+			break
+		}
+		v.SetListError(msg.scan.Err())
+		v.all = msg.scan.Resources
+		v.applyVisibility()
+		v.Message = i18n.T("Found %d certificates and keys", len(msg.scan.Resources))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.all) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Collecting expiry dates...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[w]arnings only  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh collects the expiry dates again.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadExpiry()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type expiryLoadedMsg struct {
+	owner *View // Listings of a swapped-out view are dropped
+	scan  Scan
+	err   error
+}
+
+func (v *View) loadExpiry() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service, ok := v.Service().(*Service)
+		if !ok {
+			return expiryLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		scan, err := service.Scan(context.Background())
+		return expiryLoadedMsg{owner: v, scan: scan, err: err}
+	}
+}
+
+// applyVisibility shows every item, or only those with an issue.
+func (v *View) applyVisibility() {
+	if !v.warningsOnly {
+		v.Resources = v.all
+	} else {
+		v.Resources = make([]core.Resource, 0, len(v.all))
+		for _, r := range v.all {
+			if len(r.Issues()) > 0 {
+				v.Resources = append(v.Resources, r)
+			}
+		}
+	}
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(r.GetMetadataString("source")),
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(r.GetMetadataString("detail")),
+		base.TextCell(Expires(r).Format("2006-01-02")),
+		base.TextCell(fmt.Sprint(DaysLeft(r))),
+		base.TextCell(base.FormatState(r.State)),
+		base.SeverityCell(r),
+	}
+}
+
+// formatExpiry renders an item's dates for the detail panel.
+func formatExpiry(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Source:    %s\n", r.GetMetadataString("source"))
+	fmt.Fprintf(&b, "ID:        %s\n", r.ID)
+	if r.ARN != "" && r.ARN != r.ID {
+		fmt.Fprintf(&b, "ARN:       %s\n", r.ARN)
+	}
+	fmt.Fprintf(&b, "Detail:    %s\n", r.GetMetadataString("detail"))
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:   %s\n", r.CreatedAt.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	fmt.Fprintf(&b, "%-10s %s (%d days)\n", dateLabel(r.GetMetadataString("kind")), Expires(*r).UTC().Format("2006-01-02 15:04 MST"), DaysLeft(*r))
+	if status := r.GetMetadataString("status"); status != "" {
+		fmt.Fprintf(&b, "Status:    %s\n", status)
+	}
+	if renews, _ := r.Metadata["auto_renew"].(bool); renews {
+		b.WriteString("Renewal:   managed by ACM\n")
+	}
+	if r.GetMetadataString("source") == SourceACM {
+		inUse, _ := r.Metadata["in_use"].(bool)
+		fmt.Fprintf(&b, "In use:    %t\n", inUse)
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// dateLabel names an item's date after what happens then.
+func dateLabel(kind string) string {
+	switch kind {
+	case "deleted":
+		return "Deleted:"
+	case "rotation due":
+		return "Rotate by:"
+	}
+	return "Expires:"
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	warn, critical := defaultWarnDays, defaultCriticalDays
+	if service, ok := v.Service().(*Service); ok {
+		warn, critical = service.Thresholds()
+	}
+
+	expired, soon, later := 0, 0, 0
+	for _, r := range v.all {
+		switch days := DaysLeft(r); {
+		case days < 0:
+			expired++
+		case days <= critical:
+			soon++
+		case days <= warn:
+			later++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "expired", Text: i18n.T("Expired: %d", expired), Tone: core.ToneError},
+		core.SummaryWidget{Name: "critical", Text: i18n.T("Within %d days: %d", critical, soon), Tone: core.ToneError},
+		core.SummaryWidget{Name: "warning", Text: i18n.T("Within %d days: %d", warn, later), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Expiring Certificates and Keys"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "expiry" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
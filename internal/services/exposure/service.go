@@ -0,0 +1,664 @@
+// Package exposure maps the external attack surface of an account for the
+// a9s application: EC2 instances with public IPs behind open security
+// groups, public S3 buckets, publicly accessible RDS databases and
+// internet-facing load balancers, in a single view.
+package exposure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Sources of exposed resources, as shown in the Service column.
+const (
+	SourceEC2 = "EC2"
+	SourceS3  = "S3"
+	SourceRDS = "RDS"
+	SourceELB = "ELB"
+)
+
+// adminPorts are remote administration ports that should never be open to
+// the internet.
+var adminPorts = []int32{22, 3389, 5985, 5986}
+
+// dataPorts are database and cache ports that should never be open to the
+// internet.
+var dataPorts = []int32{1433, 1521, 3306, 5432, 6379, 9200, 11211, 27017}
+
+// webPorts are expected to be open on web-facing resources.
+var webPorts = []int32{80, 443}
+
+// publicGrantees are the S3 ACL groups that make a bucket public.
+var publicGrantees = []string{
+	"http://acs.amazonaws.com/groups/global/AllUsers",
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers",
+}
+
+// Service lists internet-reachable resources across services.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+
+	ec2Client EC2API
+	s3Client  S3API
+	rdsClient RDSAPI
+	elbClient ELBAPI
+}
+
+// Option configures the exposure service.
+type Option func(*Service)
+
+// WithEC2Client sets a custom EC2 client (for testing).
+func WithEC2Client(client EC2API) Option {
+	return func(s *Service) {
+		s.ec2Client = client
+	}
+}
+
+// WithS3Client sets a custom S3 client (for testing).
+func WithS3Client(client S3API) Option {
+	return func(s *Service) {
+		s.s3Client = client
+	}
+}
+
+// WithRDSClient sets a custom RDS client (for testing).
+func WithRDSClient(client RDSAPI) Option {
+	return func(s *Service) {
+		s.rdsClient = client
+	}
+}
+
+// WithELBClient sets a custom Elastic Load Balancing client (for testing).
+func WithELBClient(client ELBAPI) Option {
+	return func(s *Service) {
+		s.elbClient = client
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
+}
+
+// S3API defines the S3 client interface for mocking.
+type S3API interface {
+	ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+	GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+	GetBucketPolicyStatus(ctx context.Context, params *s3.GetBucketPolicyStatusInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyStatusOutput, error)
+	GetBucketAcl(ctx context.Context, params *s3.GetBucketAclInput, optFns ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)
+	GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
+}
+
+// RDSAPI defines the RDS client interface for mocking.
+type RDSAPI interface {
+	DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// ELBAPI defines the Elastic Load Balancing v2 client interface for mocking.
+type ELBAPI interface {
+	DescribeLoadBalancers(ctx context.Context, params *elb.DescribeLoadBalancersInput, optFns ...func(*elb.Options)) (*elb.DescribeLoadBalancersOutput, error)
+	DescribeListeners(ctx context.Context, params *elb.DescribeListenersInput, optFns ...func(*elb.Options)) (*elb.DescribeListenersOutput, error)
+}
+
+// NewService creates a new exposure service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) ec2API() EC2API {
+	if s.ec2Client != nil {
+		return s.ec2Client
+	}
+	return s.factory.EC2Client()
+}
+
+func (s *Service) s3API() S3API {
+	if s.s3Client != nil {
+		return s.s3Client
+	}
+	return s.factory.S3Client()
+}
+
+func (s *Service) rdsAPI() RDSAPI {
+	if s.rdsClient != nil {
+		return s.rdsClient
+	}
+	return rds.NewFromConfig(s.factory.Config())
+}
+
+func (s *Service) elbAPI() ELBAPI {
+	if s.elbClient != nil {
+		return s.elbClient
+	}
+	return elb.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "exposure"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Public Exposure"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "globe"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.ec2API().DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("exposure", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// Scan is the exposure found across sources.
+type Scan struct {
+	Resources   []core.Resource  // Exposed resources, most severe first
+	Unavailable map[string]error // Sources that could not be read
+}
+
+// Err reports the Unavailable sources as a core.PartialError, or nil when
+// there are none.
+func (sc Scan) Err() error {
+	return core.NewPartialError("sources", sc.Unavailable)
+}
+
+// List returns the internet-reachable resources of every source that could
+// be read, most severe first, with a core.PartialError naming the others.
+// It only fails outright when every source fails.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	scan, err := s.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scan.Resources, scan.Err()
+}
+
+// Scan lists exposed resources source by source. A source that cannot be
+// read, for example for lack of permissions, is recorded in Unavailable
+// and the others are still listed.
+func (s *Service) Scan(ctx context.Context) (Scan, error) {
+	sources := []struct {
+		name string
+		list func(context.Context, map[string][]portRange) ([]core.Resource, error)
+	}{
+		{SourceEC2, s.listInstances},
+		{SourceS3, s.listBuckets},
+		{SourceRDS, s.listDatabases},
+		{SourceELB, s.listLoadBalancers},
+	}
+
+	// Security groups decide the exposure of instances, databases and load
+	// balancers
+	openPorts, sgErr := s.openSecurityGroups(ctx)
+
+	scan := Scan{Resources: make([]core.Resource, 0), Unavailable: make(map[string]error)}
+	var errs []error
+	for _, source := range sources {
+		var found []core.Resource
+		err := sgErr
+		if err == nil || source.name == SourceS3 {
+			found, err = source.list(ctx, openPorts)
+		}
+		if err != nil {
+			s.dispatchError(ctx, "list_"+strings.ToLower(source.name), err)
+			scan.Unavailable[source.name] = err
+			errs = append(errs, fmt.Errorf("%s: %w", source.name, err))
+			continue
+		}
+		scan.Resources = append(scan.Resources, found...)
+	}
+	if len(errs) == len(sources) {
+		return Scan{}, core.NewServiceError("exposure", "list", errors.Join(errs...))
+	}
+
+	now := time.Now()
+	for i := range scan.Resources {
+		estimate.ApplyAge(&scan.Resources[i], now)
+	}
+	slices.SortStableFunc(scan.Resources, func(a, b core.Resource) int {
+		return b.Severity().Rank() - a.Severity().Rank()
+	})
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "exposure",
+		Count:        len(scan.Resources),
+	})
+
+	return scan, nil
+}
+
+// openSecurityGroups returns, for every security group of the region, the
+// port ranges it opens to the whole internet.
+func (s *Service) openSecurityGroups(ctx context.Context) (map[string][]portRange, error) {
+	open := make(map[string][]portRange)
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(s.ec2API(), &ec2.DescribeSecurityGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range page.SecurityGroups {
+			var ports []portRange
+			for _, perm := range group.IpPermissions {
+				if openToWorld(perm) {
+					ports = append(ports, newPortRange(perm))
+				}
+			}
+			open[aws.ToString(group.GroupId)] = ports
+		}
+	}
+	return open, nil
+}
+
+// listInstances returns running instances with a public IP behind a
+// security group open to the internet.
+func (s *Service) listInstances(ctx context.Context, openPorts map[string][]portRange) ([]core.Resource, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"pending", "running"},
+		}},
+	}
+
+	var resources []core.Resource
+	paginator := ec2.NewDescribeInstancesPaginator(s.ec2API(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				ip := aws.ToString(instance.PublicIpAddress)
+				if ip == "" {
+					continue
+				}
+				groups := make([]string, 0, len(instance.SecurityGroups))
+				for _, group := range instance.SecurityGroups {
+					groups = append(groups, aws.ToString(group.GroupId))
+				}
+				ports := portsOf(groups, openPorts)
+				if len(ports) == 0 {
+					continue
+				}
+
+				name := aws.ToString(instance.InstanceId)
+				for _, tag := range instance.Tags {
+					if aws.ToString(tag.Key) == "Name" && aws.ToString(tag.Value) != "" {
+						name = aws.ToString(tag.Value)
+					}
+				}
+				r := newExposure(aws.ToString(instance.InstanceId), "ec2:instance", name, SourceEC2, ip, string(instance.State.Name))
+				r.Metadata["open_ports"] = formatPorts(ports)
+				r.Metadata["security_groups"] = groups
+				switch {
+				case exposesAny(ports, adminPorts) || exposesAny(ports, dataPorts):
+					r.AddIssue(core.SeverityHigh, fmt.Sprintf("Admin or database ports open to the internet: %s", strings.Join(formatPorts(ports), ", ")))
+				case onlyWeb(ports):
+					r.AddIssue(core.SeverityInfo, "Web ports open to the internet")
+				default:
+					r.AddIssue(core.SeverityMedium, fmt.Sprintf("Ports open to the internet: %s", strings.Join(formatPorts(ports), ", ")))
+				}
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+// listBuckets returns buckets whose policy or ACL grants public access.
+func (s *Service) listBuckets(ctx context.Context, _ map[string][]portRange) ([]core.Resource, error) {
+	out, err := s.s3API().ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []core.Resource
+	for _, bucket := range out.Buckets {
+		name := aws.ToString(bucket.Name)
+		inRegion := s.bucketRegion(ctx, name)
+
+		var reasons []string
+		status, err := s.s3API().GetBucketPolicyStatus(ctx, &s3.GetBucketPolicyStatusInput{Bucket: aws.String(name)}, inRegion)
+		if err == nil && status.PolicyStatus != nil && aws.ToBool(status.PolicyStatus.IsPublic) {
+			reasons = append(reasons, "bucket policy")
+		}
+		if s.publicACL(ctx, name, inRegion) {
+			reasons = append(reasons, "ACL")
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+
+		r := newExposure(name, "s3:bucket", name, SourceS3, "s3://"+name, core.StateAvailable)
+		r.ARN = "arn:aws:s3:::" + name
+		r.CreatedAt = bucket.CreationDate
+		r.Metadata["public_via"] = reasons
+		r.AddIssue(core.SeverityCritical, fmt.Sprintf("Public through its %s", strings.Join(reasons, " and ")))
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// bucketRegion returns a client option addressing a bucket in its own
+// region. Requests to buckets of other regions fail otherwise.
+func (s *Service) bucketRegion(ctx context.Context, bucket string) func(*s3.Options) {
+	region := ""
+	if out, err := s.s3API().GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: aws.String(bucket)}); err == nil {
+		region = string(out.LocationConstraint)
+		if region == "" {
+			region = "us-east-1"
+		}
+	}
+	return func(o *s3.Options) {
+		if region != "" {
+			o.Region = region
+		}
+	}
+}
+
+// publicACL reports whether a bucket ACL grants access to everyone, unless
+// Block Public Access ignores public ACLs.
+func (s *Service) publicACL(ctx context.Context, bucket string, inRegion func(*s3.Options)) bool {
+	block, err := s.s3API().GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: aws.String(bucket)}, inRegion)
+	if err == nil && block.PublicAccessBlockConfiguration != nil && aws.ToBool(block.PublicAccessBlockConfiguration.IgnorePublicAcls) {
+		return false
+	}
+	acl, err := s.s3API().GetBucketAcl(ctx, &s3.GetBucketAclInput{Bucket: aws.String(bucket)}, inRegion)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(acl.Grants, func(g s3types.Grant) bool {
+		return g.Grantee != nil && slices.Contains(publicGrantees, aws.ToString(g.Grantee.URI))
+	})
+}
+
+// listDatabases returns publicly accessible databases.
+func (s *Service) listDatabases(ctx context.Context, openPorts map[string][]portRange) ([]core.Resource, error) {
+	var resources []core.Resource
+	paginator := rds.NewDescribeDBInstancesPaginator(s.rdsAPI(), &rds.DescribeDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range page.DBInstances {
+			if !aws.ToBool(db.PubliclyAccessible) {
+				continue
+			}
+			id := aws.ToString(db.DBInstanceIdentifier)
+			address := ""
+			if db.Endpoint != nil {
+				address = fmt.Sprintf("%s:%d", aws.ToString(db.Endpoint.Address), aws.ToInt32(db.Endpoint.Port))
+			}
+			groups := make([]string, 0, len(db.VpcSecurityGroups))
+			for _, group := range db.VpcSecurityGroups {
+				groups = append(groups, aws.ToString(group.VpcSecurityGroupId))
+			}
+			ports := portsOf(groups, openPorts)
+
+			r := newExposure(id, "rds:db", id, SourceRDS, address, aws.ToString(db.DBInstanceStatus))
+			r.ARN = aws.ToString(db.DBInstanceArn)
+			r.CreatedAt = db.InstanceCreateTime
+			r.Metadata["open_ports"] = formatPorts(ports)
+			r.Metadata["security_groups"] = groups
+			if len(ports) > 0 {
+				r.AddIssue(core.SeverityCritical, fmt.Sprintf("Publicly accessible database open to the internet: %s", strings.Join(formatPorts(ports), ", ")))
+			} else {
+				r.AddIssue(core.SeverityMedium, "Publicly accessible database; security groups restrict sources")
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// listLoadBalancers returns internet-facing load balancers with their
+// listeners.
+func (s *Service) listLoadBalancers(ctx context.Context, openPorts map[string][]portRange) ([]core.Resource, error) {
+	var resources []core.Resource
+	paginator := elb.NewDescribeLoadBalancersPaginator(s.elbAPI(), &elb.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range page.LoadBalancers {
+			if lb.Scheme != elbtypes.LoadBalancerSchemeEnumInternetFacing {
+				continue
+			}
+			arn := aws.ToString(lb.LoadBalancerArn)
+			listeners, err := s.elbAPI().DescribeListeners(ctx, &elb.DescribeListenersInput{LoadBalancerArn: aws.String(arn)})
+			if err != nil {
+				return nil, err
+			}
+
+			state := core.StateUnknown
+			if lb.State != nil {
+				state = string(lb.State.Code)
+			}
+			r := newExposure(arn, "elbv2:loadbalancer", aws.ToString(lb.LoadBalancerName), SourceELB, aws.ToString(lb.DNSName), state)
+			r.ARN = arn
+			r.CreatedAt = lb.CreatedTime
+			r.Metadata["lb_type"] = string(lb.Type)
+			r.Metadata["security_groups"] = lb.SecurityGroups
+
+			var ports, plainHTTP []string
+			for _, listener := range listeners.Listeners {
+				port := fmt.Sprintf("%s/%d", strings.ToLower(string(listener.Protocol)), aws.ToInt32(listener.Port))
+				ports = append(ports, port)
+				if listener.Protocol == elbtypes.ProtocolEnumHttp && !redirects(listener.DefaultActions) {
+					plainHTTP = append(plainHTTP, port)
+				}
+			}
+			r.Metadata["open_ports"] = ports
+			r.Metadata["listeners"] = len(listeners.Listeners)
+
+			switch {
+			case len(lb.SecurityGroups) > 0 && len(portsOf(lb.SecurityGroups, openPorts)) == 0:
+				r.AddIssue(core.SeverityInfo, "Internet-facing; security groups restrict sources")
+			case len(plainHTTP) > 0:
+				r.AddIssue(core.SeverityMedium, fmt.Sprintf("Serves plain HTTP without redirecting to HTTPS: %s", strings.Join(plainHTTP, ", ")))
+			default:
+				r.AddIssue(core.SeverityInfo, "Internet-facing")
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// newExposure builds an exposed resource. The ID of the original resource
+// is kept so it can be found in its own view.
+func newExposure(id, resourceType, name, source, address, state string) core.Resource {
+	return core.Resource{
+		ID:    id,
+		Type:  resourceType,
+		Name:  name,
+		State: state,
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"source":  source,
+			"address": address,
+		},
+	}
+}
+
+// openToWorld reports whether a rule allows any IPv4 or IPv6 address.
+func openToWorld(perm ec2types.IpPermission) bool {
+	for _, r := range perm.IpRanges {
+		if aws.ToString(r.CidrIp) == "0.0.0.0/0" {
+			return true
+		}
+	}
+	for _, r := range perm.Ipv6Ranges {
+		if aws.ToString(r.CidrIpv6) == "::/0" {
+			return true
+		}
+	}
+	return false
+}
+
+// portRange is a range of ports a security group rule opens.
+type portRange struct {
+	protocol string // "tcp", "udp", "icmp" or "-1" for all traffic
+	from, to int32
+}
+
+func newPortRange(perm ec2types.IpPermission) portRange {
+	return portRange{
+		protocol: aws.ToString(perm.IpProtocol),
+		from:     aws.ToInt32(perm.FromPort),
+		to:       aws.ToInt32(perm.ToPort),
+	}
+}
+
+// String renders the range, e.g. "tcp/22", "tcp/8000-8080" or "all".
+func (p portRange) String() string {
+	switch {
+	case p.protocol == "-1":
+		return "all"
+	case p.from == p.to:
+		return fmt.Sprintf("%s/%d", p.protocol, p.from)
+	}
+	return fmt.Sprintf("%s/%d-%d", p.protocol, p.from, p.to)
+}
+
+// covers reports whether the range includes a TCP port.
+func (p portRange) covers(port int32) bool {
+	return p.protocol == "-1" || (p.protocol == "tcp" && p.from <= port && port <= p.to)
+}
+
+func formatPorts(ports []portRange) []string {
+	formatted := make([]string, len(ports))
+	for i, p := range ports {
+		formatted[i] = p.String()
+	}
+	return formatted
+}
+
+// portsOf returns the port ranges the given security groups open to the
+// internet, without duplicates.
+func portsOf(groups []string, openPorts map[string][]portRange) []portRange {
+	var ports []portRange
+	for _, group := range groups {
+		for _, port := range openPorts[group] {
+			if !slices.Contains(ports, port) {
+				ports = append(ports, port)
+			}
+		}
+	}
+	return ports
+}
+
+// exposesAny reports whether any range covers one of the given ports.
+func exposesAny(ports []portRange, wanted []int32) bool {
+	return slices.ContainsFunc(ports, func(p portRange) bool {
+		return slices.ContainsFunc(wanted, p.covers)
+	})
+}
+
+// onlyWeb reports whether every range is a single web port.
+func onlyWeb(ports []portRange) bool {
+	for _, p := range ports {
+		if p.protocol != "tcp" || p.from != p.to || !slices.Contains(webPorts, p.from) {
+			return false
+		}
+	}
+	return true
+}
+
+// redirects reports whether a listener's default action redirects, as
+// HTTP listeners do when they only send clients to HTTPS.
+func redirects(actions []elbtypes.Action) bool {
+	return slices.ContainsFunc(actions, func(a elbtypes.Action) bool {
+		return a.Type == elbtypes.ActionTypeEnumRedirect
+	})
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "exposure", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "exposure", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+)
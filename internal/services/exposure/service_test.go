@@ -0,0 +1,355 @@
+package exposure
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves four security groups: sg-web opens HTTP and HTTPS to the
+// internet, sg-ssh opens SSH to every IPv6 address, sg-alt opens a range of
+// ports and sg-internal only opens PostgreSQL to the VPC. Its instances
+// are web, bastion and i-app behind them, and two that cannot be reached:
+// one without a public IP, one only behind sg-internal. It fails every
+// call when err is set, and security group calls when sgErr is set.
+type fakeEC2 struct {
+	err   error
+	sgErr error
+}
+
+func (f *fakeEC2) DescribeSecurityGroups(context.Context, *ec2.DescribeSecurityGroupsInput, ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	if err := errors.Join(f.err, f.sgErr); err != nil {
+		return nil, err
+	}
+	rule := func(from, to int32, cidr string) ec2types.IpPermission {
+		perm := ec2types.IpPermission{IpProtocol: aws.String("tcp"), FromPort: aws.Int32(from), ToPort: aws.Int32(to)}
+		if cidr == "::/0" {
+			perm.Ipv6Ranges = []ec2types.Ipv6Range{{CidrIpv6: aws.String(cidr)}}
+		} else {
+			perm.IpRanges = []ec2types.IpRange{{CidrIp: aws.String(cidr)}}
+		}
+		return perm
+	}
+	return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: []ec2types.SecurityGroup{
+		{GroupId: aws.String("sg-web"), IpPermissions: []ec2types.IpPermission{rule(80, 80, "0.0.0.0/0"), rule(443, 443, "0.0.0.0/0")}},
+		{GroupId: aws.String("sg-ssh"), IpPermissions: []ec2types.IpPermission{rule(22, 22, "::/0")}},
+		{GroupId: aws.String("sg-alt"), IpPermissions: []ec2types.IpPermission{rule(8000, 8080, "0.0.0.0/0")}},
+		{GroupId: aws.String("sg-internal"), IpPermissions: []ec2types.IpPermission{rule(5432, 5432, "10.0.0.0/16")}},
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeInstances(context.Context, *ec2.DescribeInstancesInput, ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	instance := func(id, name, ip string, groups ...string) ec2types.Instance {
+		i := ec2types.Instance{
+			InstanceId: aws.String(id),
+			State:      &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning},
+		}
+		if ip != "" {
+			i.PublicIpAddress = aws.String(ip)
+		}
+		if name != "" {
+			i.Tags = []ec2types.Tag{{Key: aws.String("Name"), Value: aws.String(name)}}
+		}
+		for _, group := range groups {
+			i.SecurityGroups = append(i.SecurityGroups, ec2types.GroupIdentifier{GroupId: aws.String(group)})
+		}
+		return i
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{{Instances: []ec2types.Instance{
+		instance("i-web", "web", "203.0.113.10", "sg-web"),
+		instance("i-bastion", "bastion", "203.0.113.11", "sg-ssh", "sg-web"),
+		instance("i-app", "", "203.0.113.12", "sg-alt", "sg-web"),
+		instance("i-private", "private", "", "sg-ssh"),
+		instance("i-db", "db", "203.0.113.13", "sg-internal"),
+	}}}}, nil
+}
+
+// fakeS3 serves the buckets assets, public through its policy, legacy, in
+// eu-west-1 and public through its ACL, blocked, whose public ACL Block
+// Public Access ignores, and private, whose location cannot be read. It
+// fails every call when err is set and records the region each bucket's
+// policy status was requested in.
+type fakeS3 struct {
+	err     error
+	regions map[string]string
+}
+
+func (f *fakeS3) ListBuckets(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var buckets []s3types.Bucket
+	for _, name := range []string{"assets", "legacy", "blocked", "private"} {
+		buckets = append(buckets, s3types.Bucket{Name: aws.String(name)})
+	}
+	return &s3.ListBucketsOutput{Buckets: buckets}, nil
+}
+
+func (f *fakeS3) GetBucketLocation(_ context.Context, in *s3.GetBucketLocationInput, _ ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	switch aws.ToString(in.Bucket) {
+	case "private":
+		return nil, errors.New("AccessDenied")
+	case "legacy":
+		return &s3.GetBucketLocationOutput{LocationConstraint: s3types.BucketLocationConstraintEuWest1}, nil
+	}
+	return &s3.GetBucketLocationOutput{}, nil
+}
+
+func (f *fakeS3) GetBucketPolicyStatus(_ context.Context, in *s3.GetBucketPolicyStatusInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyStatusOutput, error) {
+	var o s3.Options
+	for _, fn := range optFns {
+		fn(&o)
+	}
+	if f.regions == nil {
+		f.regions = make(map[string]string)
+	}
+	f.regions[aws.ToString(in.Bucket)] = o.Region
+	if aws.ToString(in.Bucket) != "assets" {
+		return nil, errors.New("NoSuchBucketPolicy")
+	}
+	return &s3.GetBucketPolicyStatusOutput{PolicyStatus: &s3types.PolicyStatus{IsPublic: aws.Bool(true)}}, nil
+}
+
+func (f *fakeS3) GetBucketAcl(_ context.Context, in *s3.GetBucketAclInput, _ ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+	out := &s3.GetBucketAclOutput{Grants: []s3types.Grant{{
+		Grantee:    &s3types.Grantee{Type: s3types.TypeCanonicalUser, ID: aws.String("owner")},
+		Permission: s3types.PermissionFullControl,
+	}}}
+	if bucket := aws.ToString(in.Bucket); bucket == "legacy" || bucket == "blocked" {
+		out.Grants = append(out.Grants, s3types.Grant{
+			Grantee:    &s3types.Grantee{Type: s3types.TypeGroup, URI: aws.String(publicGrantees[0])},
+			Permission: s3types.PermissionRead,
+		})
+	}
+	return out, nil
+}
+
+func (f *fakeS3) GetPublicAccessBlock(_ context.Context, in *s3.GetPublicAccessBlockInput, _ ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	if aws.ToString(in.Bucket) != "blocked" {
+		return nil, errors.New("NoSuchPublicAccessBlockConfiguration")
+	}
+	return &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{IgnorePublicAcls: aws.Bool(true)}}, nil
+}
+
+// fakeRDS serves two publicly accessible databases, analytics behind
+// sg-ssh and reports behind sg-internal, and a private one. It fails every
+// call when err is set.
+type fakeRDS struct {
+	err error
+}
+
+func (f *fakeRDS) DescribeDBInstances(context.Context, *rds.DescribeDBInstancesInput, ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	db := func(id string, public bool, group string) rdstypes.DBInstance {
+		return rdstypes.DBInstance{
+			DBInstanceIdentifier: aws.String(id),
+			DBInstanceArn:        aws.String("arn:aws:rds:us-east-1:123456789012:db:" + id),
+			DBInstanceStatus:     aws.String("available"),
+			PubliclyAccessible:   aws.Bool(public),
+			Endpoint:             &rdstypes.Endpoint{Address: aws.String(id + ".abc.us-east-1.rds.amazonaws.com"), Port: aws.Int32(5432)},
+			VpcSecurityGroups:    []rdstypes.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String(group)}},
+		}
+	}
+	return &rds.DescribeDBInstancesOutput{DBInstances: []rdstypes.DBInstance{
+		db("analytics", true, "sg-ssh"),
+		db("reports", true, "sg-internal"),
+		db("orders", false, "sg-internal"),
+	}}, nil
+}
+
+// fakeELB serves four internet-facing load balancers: public-alb, which
+// redirects HTTP to HTTPS, legacy-alb, which serves plain HTTP, nlb,
+// without security groups, and restricted-alb, behind sg-internal. An
+// internal one is also listed. It fails every call when err is set.
+type fakeELB struct {
+	err error
+}
+
+func (f *fakeELB) DescribeLoadBalancers(context.Context, *elb.DescribeLoadBalancersInput, ...func(*elb.Options)) (*elb.DescribeLoadBalancersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	lb := func(name string, scheme elbtypes.LoadBalancerSchemeEnum, groups ...string) elbtypes.LoadBalancer {
+		return elbtypes.LoadBalancer{
+			LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/" + name + "/1"),
+			LoadBalancerName: aws.String(name),
+			DNSName:          aws.String(name + ".us-east-1.elb.amazonaws.com"),
+			Scheme:           scheme,
+			Type:             elbtypes.LoadBalancerTypeEnumApplication,
+			State:            &elbtypes.LoadBalancerState{Code: elbtypes.LoadBalancerStateEnumActive},
+			SecurityGroups:   groups,
+		}
+	}
+	return &elb.DescribeLoadBalancersOutput{LoadBalancers: []elbtypes.LoadBalancer{
+		lb("public-alb", elbtypes.LoadBalancerSchemeEnumInternetFacing, "sg-web"),
+		lb("legacy-alb", elbtypes.LoadBalancerSchemeEnumInternetFacing, "sg-web"),
+		lb("nlb", elbtypes.LoadBalancerSchemeEnumInternetFacing),
+		lb("restricted-alb", elbtypes.LoadBalancerSchemeEnumInternetFacing, "sg-internal"),
+		lb("internal-alb", elbtypes.LoadBalancerSchemeEnumInternal, "sg-web"),
+	}}, nil
+}
+
+func (f *fakeELB) DescribeListeners(_ context.Context, in *elb.DescribeListenersInput, _ ...func(*elb.Options)) (*elb.DescribeListenersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	listener := func(protocol elbtypes.ProtocolEnum, port int32, action elbtypes.ActionTypeEnum) elbtypes.Listener {
+		return elbtypes.Listener{Protocol: protocol, Port: aws.Int32(port), DefaultActions: []elbtypes.Action{{Type: action}}}
+	}
+	https := listener(elbtypes.ProtocolEnumHttps, 443, elbtypes.ActionTypeEnumForward)
+	switch arn := aws.ToString(in.LoadBalancerArn); {
+	case arn == "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/legacy-alb/1":
+		return &elb.DescribeListenersOutput{Listeners: []elbtypes.Listener{listener(elbtypes.ProtocolEnumHttp, 80, elbtypes.ActionTypeEnumForward), https}}, nil
+	case arn == "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/nlb/1":
+		return &elb.DescribeListenersOutput{Listeners: []elbtypes.Listener{listener(elbtypes.ProtocolEnumTcp, 443, elbtypes.ActionTypeEnumForward)}}, nil
+	}
+	return &elb.DescribeListenersOutput{Listeners: []elbtypes.Listener{listener(elbtypes.ProtocolEnumHttp, 80, elbtypes.ActionTypeEnumRedirect), https}}, nil
+}
+
+func newTestService(ec2Client *fakeEC2, s3Client *fakeS3, d core.EventDispatcher) *Service {
+	return NewService(nil, d,
+		WithEC2Client(ec2Client),
+		WithS3Client(s3Client),
+		WithRDSClient(&fakeRDS{err: ec2Client.err}),
+		WithELBClient(&fakeELB{err: ec2Client.err}),
+	)
+}
+
+// TestServiceConformance runs the core service contract against exposed
+// resources.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return newTestService(&fakeEC2{}, &fakeS3{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			err := errors.New("UnauthorizedOperation")
+			return newTestService(&fakeEC2{err: err}, &fakeS3{err: err}, d)
+		},
+		ExistingID: "i-bastion",
+	})
+}
+
+func TestScan(t *testing.T) {
+	scan, err := newTestService(&fakeEC2{}, &fakeS3{}, nil).Scan(context.Background())
+	if err != nil || scan.Err() != nil {
+		t.Fatalf("Scan() error = %v, %v", err, scan.Err())
+	}
+
+	// Most severe first, in the order of their sources
+	want := []struct {
+		name     string
+		severity core.Severity
+		message  string
+	}{
+		{"assets", core.SeverityCritical, "Public through its bucket policy"},
+		{"legacy", core.SeverityCritical, "Public through its ACL"},
+		{"analytics", core.SeverityCritical, "Publicly accessible database open to the internet: tcp/22"},
+		{"bastion", core.SeverityHigh, "Admin or database ports open to the internet: tcp/22, tcp/80, tcp/443"},
+		{"i-app", core.SeverityMedium, "Ports open to the internet: tcp/8000-8080, tcp/80, tcp/443"},
+		{"reports", core.SeverityMedium, "Publicly accessible database; security groups restrict sources"},
+		{"legacy-alb", core.SeverityMedium, "Serves plain HTTP without redirecting to HTTPS: http/80"},
+		{"web", core.SeverityInfo, "Web ports open to the internet"},
+		{"public-alb", core.SeverityInfo, "Internet-facing"},
+		{"nlb", core.SeverityInfo, "Internet-facing"},
+		{"restricted-alb", core.SeverityInfo, "Internet-facing; security groups restrict sources"},
+	}
+	if len(scan.Resources) != len(want) {
+		t.Fatalf("Scan() = %d resources, want %d", len(scan.Resources), len(want))
+	}
+	for i, r := range scan.Resources {
+		issues := r.Issues()
+		if r.Name != want[i].name || len(issues) != 1 || issues[0].Severity != want[i].severity || issues[0].Message != want[i].message {
+			t.Errorf("resource %d = %s %v, want %s %v %q", i, r.Name, issues, want[i].name, want[i].severity, want[i].message)
+		}
+	}
+}
+
+// TestScanWithoutSecurityGroups checks that the sources relying on security
+// groups are unavailable when they cannot be read, and buckets still listed.
+func TestScanWithoutSecurityGroups(t *testing.T) {
+	scan, err := newTestService(&fakeEC2{sgErr: errors.New("UnauthorizedOperation")}, &fakeS3{}, nil).Scan(context.Background())
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	unavailable := make([]string, 0, len(scan.Unavailable))
+	for source := range scan.Unavailable {
+		unavailable = append(unavailable, source)
+	}
+	slices.Sort(unavailable)
+	if want := []string{SourceEC2, SourceELB, SourceRDS}; !slices.Equal(unavailable, want) {
+		t.Errorf("unavailable = %v, want %v", unavailable, want)
+	}
+	for _, r := range scan.Resources {
+		if r.Metadata["source"] != SourceS3 {
+			t.Errorf("%s listed from %v", r.Name, r.Metadata["source"])
+		}
+	}
+	var partial *core.PartialError
+	if !errors.As(scan.Err(), &partial) {
+		t.Errorf("Err() = %v, want a partial error", scan.Err())
+	}
+}
+
+func TestBucketsAddressedInTheirRegion(t *testing.T) {
+	fake := &fakeS3{}
+	if _, err := newTestService(&fakeEC2{}, fake, nil).Scan(context.Background()); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	// Buckets without a location constraint are in us-east-1; those whose
+	// location cannot be read keep the client's region.
+	want := map[string]string{"assets": "us-east-1", "legacy": "eu-west-1", "blocked": "us-east-1", "private": ""}
+	for bucket, region := range want {
+		if got := fake.regions[bucket]; got != region {
+			t.Errorf("%s requested in %q, want %q", bucket, got, region)
+		}
+	}
+}
+
+func TestPortRange(t *testing.T) {
+	tests := []struct {
+		port   portRange
+		want   string
+		covers []int32
+	}{
+		{portRange{"tcp", 22, 22}, "tcp/22", []int32{22}},
+		{portRange{"tcp", 3000, 3400}, "tcp/3000-3400", []int32{3389, 3306}},
+		{portRange{"udp", 0, 65535}, "udp/0-65535", nil},
+		{portRange{"-1", 0, 0}, "all", slices.Concat(adminPorts, dataPorts)},
+	}
+	for _, tt := range tests {
+		if got := tt.port.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+		var covered []int32
+		for _, port := range slices.Concat(adminPorts, dataPorts, webPorts) {
+			if tt.port.covers(port) && !slices.Contains(webPorts, port) {
+				covered = append(covered, port)
+			}
+		}
+		if !slices.Equal(covered, tt.covers) {
+			t.Errorf("%s covers %v, want %v", tt.want, covered, tt.covers)
+		}
+	}
+}
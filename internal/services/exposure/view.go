@@ -0,0 +1,241 @@
+package exposure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for the external attack surface.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new exposure view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Service"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Address"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 1},
+		{Title: i18n.T("Open To Internet"), MinWidth: 16, MaxWidth: 40, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Exposure", "0", "exposure", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadExposure()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "enter" {
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Exposure of %s", row.Name), formatExposure(row))
+			}
+		}
+
+	case exposureLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+			break
+		}
+		v.SetListError(msg.scan.Err())
+		v.Resources = msg.scan.Resources
+		v.updateTable()
+		v.Message = i18n.T("Found %d internet-reachable resources", len(msg.scan.Resources))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Mapping public exposure...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh maps the exposure again.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadExposure()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type exposureLoadedMsg struct {
+	owner *View // Listings of a swapped-out view are dropped
+	scan  Scan
+	err   error
+}
+
+func (v *View) loadExposure() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service, ok := v.Service().(*Service)
+		if !ok {
+			return exposureLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		scan, err := service.Scan(context.Background())
+		return exposureLoadedMsg{owner: v, scan: scan, err: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(r.GetMetadataString("source")),
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.TextCell(r.GetMetadataString("address")),
+		base.TextCell(formatOpen(r)),
+		base.TextCell(base.FormatState(r.State)),
+		base.AgeCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// formatOpen summarizes how a resource is reachable: its open ports, or how
+// a bucket is made public.
+func formatOpen(r core.Resource) string {
+	if via, ok := r.Metadata["public_via"].([]string); ok {
+		return strings.Join(via, ", ")
+	}
+	ports, _ := r.Metadata["open_ports"].([]string)
+	if len(ports) == 0 {
+		return "-"
+	}
+	return strings.Join(ports, ", ")
+}
+
+// formatExposure renders how a resource is exposed for the detail panel.
+func formatExposure(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service:   %s\n", r.GetMetadataString("source"))
+	fmt.Fprintf(&b, "ID:        %s\n", r.ID)
+	fmt.Fprintf(&b, "Address:   %s\n", r.GetMetadataString("address"))
+	fmt.Fprintf(&b, "Open:      %s\n", formatOpen(*r))
+	if groups, _ := r.Metadata["security_groups"].([]string); len(groups) > 0 {
+		fmt.Fprintf(&b, "Security groups: %s\n", strings.Join(groups, ", "))
+	}
+
+	b.WriteString(i18n.T("\nIssues:\n"))
+	for _, issue := range r.Issues() {
+		fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	counts := make(map[string]int)
+	severe := 0
+	for _, r := range v.Resources {
+		counts[r.GetMetadataString("source")]++
+		if r.Severity().Rank() >= core.SeverityHigh.Rank() {
+			severe++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "sources", Text: fmt.Sprintf("EC2: %d  S3: %d  RDS: %d  ELB: %d", counts[SourceEC2], counts[SourceS3], counts[SourceRDS], counts[SourceELB]), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "severe", Text: i18n.T("High or critical: %d", severe), Tone: core.ToneError},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Public Exposure"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "exposure" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,233 @@
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+const (
+	// reportPollInterval is how often a credential report being generated
+	// is checked.
+	reportPollInterval = 2 * time.Second
+	// reportTimeout is how long to wait for a credential report.
+	reportTimeout = time.Minute
+
+	// rootUser is the name of the root user in the credential report.
+	rootUser = "<root_account>"
+)
+
+// UserCredentials is a user's row of the IAM credential report: console
+// access, access key use and MFA enrollment.
+type UserCredentials struct {
+	User             string           `json:"user"`
+	ARN              string           `json:"arn"`
+	Created          *time.Time       `json:"created,omitempty"`
+	ConsoleAccess    bool             `json:"console_access"`
+	LastConsoleLogin *time.Time       `json:"last_console_login,omitempty"`
+	MFA              bool             `json:"mfa"`
+	AccessKeys       []AccessKeyUsage `json:"access_keys,omitempty"`
+	Flags            []string         `json:"flags,omitempty"` // What an access review should look at
+}
+
+// AccessKeyUsage is the use of one of a user's two access keys.
+type AccessKeyUsage struct {
+	Slot            int        `json:"slot"`
+	Active          bool       `json:"active"`
+	LastRotated     *time.Time `json:"last_rotated,omitempty"`
+	LastUsed        *time.Time `json:"last_used,omitempty"`
+	LastUsedService string     `json:"last_used_service,omitempty"`
+}
+
+// IsRoot reports whether the row is the account's root user.
+func (u UserCredentials) IsRoot() bool {
+	return u.User == rootUser
+}
+
+// CredentialReport generates the account's IAM credential report, waiting
+// for it when needed, and returns one row per user, root first. Users idle
+// for longer than the unused threshold are flagged.
+func (s *Service) CredentialReport(ctx context.Context) ([]UserCredentials, error) {
+	content, err := s.fetchCredentialReport(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "credential_report", err)
+		return nil, err
+	}
+	users, err := parseCredentialReport(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential report: %w", err)
+	}
+
+	now := time.Now()
+	for i := range users {
+		users[i].Flags = reviewFlags(users[i], s.unusedThreshold, now)
+	}
+	return users, nil
+}
+
+// fetchCredentialReport returns the content of the credential report. AWS
+// keeps a report for four hours, so it is only generated when missing or
+// expired.
+func (s *Service) fetchCredentialReport(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+	defer cancel()
+
+	for {
+		out, err := s.client().GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+		if err == nil {
+			return out.Content, nil
+		}
+		if !isReportPending(err) {
+			return nil, err
+		}
+		if _, err := s.client().GenerateCredentialReport(ctx, &iam.GenerateCredentialReportInput{}); err != nil {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("credential report not ready after %s", reportTimeout)
+		case <-time.After(reportPollInterval):
+		}
+	}
+}
+
+// isReportPending reports whether GetCredentialReport failed because the
+// report does not exist yet, has expired or is being generated.
+func isReportPending(err error) bool {
+	var notPresent *types.CredentialReportNotPresentException
+	var expired *types.CredentialReportExpiredException
+	var inProgress *types.CredentialReportNotReadyException
+	return errors.As(err, &notPresent) || errors.As(err, &expired) || errors.As(err, &inProgress)
+}
+
+// parseCredentialReport reads the CSV credential report by column name,
+// since AWS may add columns.
+func parseCredentialReport(content []byte) ([]UserCredentials, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty report")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	users := make([]UserCredentials, 0, len(records)-1)
+	for _, record := range records[1:] {
+		user := UserCredentials{
+			User:             field(record, "user"),
+			ARN:              field(record, "arn"),
+			Created:          reportTime(field(record, "user_creation_time")),
+			ConsoleAccess:    field(record, "password_enabled") == "true",
+			LastConsoleLogin: reportTime(field(record, "password_last_used")),
+			MFA:              field(record, "mfa_active") == "true",
+		}
+		// The root user always has a password, enabled or not
+		if user.IsRoot() {
+			user.ConsoleAccess = true
+		}
+		for slot := 1; slot <= 2; slot++ {
+			prefix := fmt.Sprintf("access_key_%d_", slot)
+			key := AccessKeyUsage{
+				Slot:            slot,
+				Active:          field(record, prefix+"active") == "true",
+				LastRotated:     reportTime(field(record, prefix+"last_rotated")),
+				LastUsed:        reportTime(field(record, prefix+"last_used_date")),
+				LastUsedService: reportValue(field(record, prefix+"last_used_service")),
+			}
+			// A key that was never created has no rotation date
+			if key.Active || key.LastRotated != nil {
+				user.AccessKeys = append(user.AccessKeys, key)
+			}
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// reviewFlags lists what an access review should look at for a user.
+func reviewFlags(u UserCredentials, threshold time.Duration, now time.Time) []string {
+	var flags []string
+	days := int(threshold.Hours() / 24)
+	idle := func(t *time.Time) bool {
+		return t == nil || now.Sub(*t) > threshold
+	}
+
+	if u.ConsoleAccess && !u.MFA {
+		flags = append(flags, "console without MFA")
+	}
+	// A user created recently cannot have been idle for the threshold
+	if u.ConsoleAccess && !u.IsRoot() && idle(u.LastConsoleLogin) && idle(u.Created) {
+		if u.LastConsoleLogin == nil {
+			flags = append(flags, "console never used")
+		} else {
+			flags = append(flags, fmt.Sprintf("no console login in %dd", days))
+		}
+	}
+	for _, key := range u.AccessKeys {
+		if !key.Active {
+			continue
+		}
+		switch {
+		case u.IsRoot():
+			flags = append(flags, fmt.Sprintf("root access key %d", key.Slot))
+		case key.LastUsed == nil && idle(key.LastRotated):
+			flags = append(flags, fmt.Sprintf("key %d never used", key.Slot))
+		case key.LastUsed != nil && idle(key.LastUsed):
+			flags = append(flags, fmt.Sprintf("key %d unused in %dd", key.Slot, days))
+		}
+	}
+	return flags
+}
+
+// reportTime parses a credential report date, nil for "N/A",
+// "no_information" and "not_supported".
+func reportTime(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// reportValue returns a credential report value, empty for "N/A".
+func reportValue(value string) string {
+	if value == "N/A" {
+		return ""
+	}
+	return value
+}
+
+// FormatReportTime formats an optional credential report date for tables
+// and CSV exports, "never" when missing.
+func FormatReportTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.UTC().Format("2006-01-02")
+}
+
+// FormatFlags joins a user's review flags, "-" when there are none.
+func FormatFlags(flags []string) string {
+	if len(flags) == 0 {
+		return "-"
+	}
+	return strings.Join(flags, "; ")
+}
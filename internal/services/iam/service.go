@@ -2,16 +2,24 @@
 package iam
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/ownership"
 )
 
 // =============================================================================
@@ -25,15 +33,51 @@ var highRiskPolicies = []string{
 	"SecurityAudit",
 }
 
+// DefaultUnusedThreshold is how long a role may go without being assumed
+// before it is flagged as a cleanup candidate.
+const DefaultUnusedThreshold = 90 * 24 * time.Hour
+
 // =============================================================================
 // Service Implementation
 // =============================================================================
 
 // Service implements IAM operations.
 type Service struct {
-	factory    *awsfactory.ClientFactory
-	dispatcher core.EventDispatcher
-	testClient IAMAPI
+	factory         *awsfactory.ClientFactory
+	dispatcher      core.EventDispatcher
+	testClient      IAMAPI
+	unusedThreshold time.Duration
+	findings        core.FindingsProvider
+	owners          core.OwnerProvider
+}
+
+// Option configures the IAM service.
+type Option func(*Service)
+
+// WithUnusedThreshold sets the inactivity period after which a role is
+// considered unused. Non-positive values keep the default.
+func WithUnusedThreshold(d time.Duration) Option {
+	return func(s *Service) {
+		if d > 0 {
+			s.unusedThreshold = d
+		}
+	}
+}
+
+// WithAccessFindings enriches roles with external access findings from the
+// given provider, typically IAM Access Analyzer.
+func WithAccessFindings(provider core.FindingsProvider) Option {
+	return func(s *Service) {
+		s.findings = provider
+	}
+}
+
+// WithOwners attributes roles to the principal that created them, using
+// the given provider, typically CloudTrail.
+func WithOwners(provider core.OwnerProvider) Option {
+	return func(s *Service) {
+		s.owners = provider
+	}
 }
 
 // IAMAPI defines the IAM client interface for mocking.
@@ -41,23 +85,44 @@ type IAMAPI interface {
 	ListRoles(ctx context.Context, params *iam.ListRolesInput, optFns ...func(*iam.Options)) (*iam.ListRolesOutput, error)
 	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
 	ListRolePolicies(ctx context.Context, params *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, params *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
 	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	SimulatePrincipalPolicy(ctx context.Context, params *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error)
+	GenerateCredentialReport(ctx context.Context, params *iam.GenerateCredentialReportInput, optFns ...func(*iam.Options)) (*iam.GenerateCredentialReportOutput, error)
+	GetCredentialReport(ctx context.Context, params *iam.GetCredentialReportInput, optFns ...func(*iam.Options)) (*iam.GetCredentialReportOutput, error)
 }
 
 // NewService creates a new IAM service.
-func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
-	return &Service{
-		factory:    factory,
-		dispatcher: dispatcher,
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:         factory,
+		dispatcher:      dispatcher,
+		unusedThreshold: DefaultUnusedThreshold,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // NewServiceWithClient creates a service with a custom client (for testing).
-func NewServiceWithClient(client IAMAPI, dispatcher core.EventDispatcher) *Service {
-	return &Service{
-		testClient: client,
-		dispatcher: dispatcher,
+func NewServiceWithClient(client IAMAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:      client,
+		dispatcher:      dispatcher,
+		unusedThreshold: DefaultUnusedThreshold,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// UnusedThreshold returns the inactivity period used to flag unused roles.
+func (s *Service) UnusedThreshold() time.Duration {
+	return s.unusedThreshold
 }
 
 // client returns the IAM client, fetching fresh from factory each time.
@@ -130,24 +195,28 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 		return nil, core.NewServiceError("iam", "list", err)
 	}
 
+	now := time.Now()
 	resources := make([]core.Resource, 0, len(result.Roles))
 	for _, role := range result.Roles {
 		roleName := aws.ToString(role.RoleName)
 
+		// Roles are identified by name, as Get and the actions look them up
 		resource := core.Resource{
-			ID:    aws.ToString(role.RoleId),
+			ID:    roleName,
 			Type:  "iam:role",
 			Name:  roleName,
 			ARN:   aws.ToString(role.Arn),
 			State: core.StatePending, // Not analyzed yet
 			Tags:  make(map[string]string),
 			Metadata: map[string]any{
-				"policies":     []string{},
-				"policy_count": 0,
-				"is_high_risk": false,
-				"risk_reason":  "",
-				"path":         aws.ToString(role.Path),
-				"analyzed":     false,
+				"role_id":        aws.ToString(role.RoleId),
+				"policy_count":   0,
+				"is_high_risk":   false,
+				"risk_reason":    "",
+				"path":           aws.ToString(role.Path),
+				"last_used":      "",
+				"should_cleanup": false,
+				"analyzed":       false,
 			},
 		}
 
@@ -155,6 +224,7 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 			resource.CreatedAt = role.CreateDate
 			resource.Metadata["create_date"] = role.CreateDate.Format("2006-01-02")
 		}
+		estimate.ApplyAge(&resource, now)
 
 		// Extract tags
 		for _, tag := range role.Tags {
@@ -186,19 +256,30 @@ func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) e
 	// Assess risk
 	isHighRisk, riskReason := assessRisk(policies)
 
-	// Determine state based on risk
-	state := core.StateActive
-	if isHighRisk {
-		state = core.StateWarning
+	// RoleLastUsed and tags are only returned by GetRole, not ListRoles
+	usage := roleUsage{}
+	if out, err := s.client().GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err == nil && out.Role != nil {
+		usage = assessUsage(out.Role, s.unusedThreshold, time.Now())
+		if resource.Tags == nil {
+			resource.Tags = make(map[string]string, len(out.Role.Tags))
+		}
+		for _, tag := range out.Role.Tags {
+			resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
 	}
 
 	// Update resource
-	resource.State = state
-	resource.Metadata["policies"] = policies
 	resource.Metadata["policy_count"] = len(policies)
 	resource.Metadata["is_high_risk"] = isHighRisk
 	resource.Metadata["risk_reason"] = riskReason
+	usage.apply(resource)
+	iac.Apply(resource)
+	compliance.Apply(resource, roleChecks(policies))
+	s.applyFindings(ctx, resource)
+	addIssues(resource, policies, riskReason, usage)
+	ownership.Apply(ctx, s.owners, resource)
 	resource.Metadata["analyzed"] = true
+	resource.State = core.StateActive
 
 	return nil
 }
@@ -219,21 +300,17 @@ func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
 	role := result.Role
 	policies, _ := s.getAttachedPolicies(ctx, aws.ToString(role.RoleName))
 	isHighRisk, riskReason := assessRisk(policies)
-
-	state := core.StateActive
-	if isHighRisk {
-		state = core.StateWarning
-	}
+	usage := assessUsage(role, s.unusedThreshold, time.Now())
 
 	resource := &core.Resource{
-		ID:    aws.ToString(role.RoleId),
+		ID:    aws.ToString(role.RoleName),
 		Type:  "iam:role",
 		Name:  aws.ToString(role.RoleName),
 		ARN:   aws.ToString(role.Arn),
-		State: state,
+		State: core.StateActive,
 		Tags:  make(map[string]string),
 		Metadata: map[string]any{
-			"policies":     policies,
+			"role_id":      aws.ToString(role.RoleId),
 			"policy_count": len(policies),
 			"is_high_risk": isHighRisk,
 			"risk_reason":  riskReason,
@@ -245,10 +322,102 @@ func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
 	if role.CreateDate != nil {
 		resource.CreatedAt = role.CreateDate
 	}
+	estimate.ApplyAge(resource, time.Now())
+	for _, tag := range role.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	usage.apply(resource)
+	iac.Apply(resource)
+	compliance.Apply(resource, roleChecks(policies))
+	s.applyFindings(ctx, resource)
+	addIssues(resource, policies, riskReason, usage)
 
 	return resource, nil
 }
 
+// =============================================================================
+// DetailFetcher Interface Implementation
+// =============================================================================
+
+// FetchDetail returns a role's trust policy and the documents of its inline
+// and customer managed policies. AWS managed policies are only named, their
+// documents being public and often large.
+func (s *Service) FetchDetail(ctx context.Context, resource *core.Resource) ([]core.DetailSection, error) {
+	roleName := aws.String(resource.Name)
+
+	role, err := s.client().GetRole(ctx, &iam.GetRoleInput{RoleName: roleName})
+	if err != nil {
+		return nil, core.NewServiceError("iam", "detail", err)
+	}
+	sections := []core.DetailSection{{
+		Title: "Trust policy",
+		Body:  policyDocument(aws.ToString(role.Role.AssumeRolePolicyDocument)),
+	}}
+
+	attached, err := s.client().ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: roleName})
+	if err != nil {
+		return sections, core.NewServiceError("iam", "detail", err)
+	}
+	var managed []string
+	for _, policy := range attached.AttachedPolicies {
+		arn := aws.ToString(policy.PolicyArn)
+		if strings.Contains(arn, ":iam::aws:policy/") {
+			managed = append(managed, arn)
+			continue
+		}
+		document, err := s.policyVersion(ctx, arn)
+		if err != nil {
+			document = fmt.Sprintf("Error: %v", err)
+		}
+		sections = append(sections, core.DetailSection{
+			Title: fmt.Sprintf("Policy %s", aws.ToString(policy.PolicyName)),
+			Body:  document,
+		})
+	}
+	if len(managed) > 0 {
+		sections = append(sections, core.DetailSection{
+			Title: "AWS managed policies",
+			Body:  strings.Join(managed, "\n"),
+		})
+	}
+
+	inline, err := s.client().ListRolePolicies(ctx, &iam.ListRolePoliciesInput{RoleName: roleName})
+	if err != nil {
+		return sections, core.NewServiceError("iam", "detail", err)
+	}
+	for _, name := range inline.PolicyNames {
+		document := ""
+		out, err := s.client().GetRolePolicy(ctx, &iam.GetRolePolicyInput{RoleName: roleName, PolicyName: aws.String(name)})
+		if err != nil {
+			document = fmt.Sprintf("Error: %v", err)
+		} else {
+			document = policyDocument(aws.ToString(out.PolicyDocument))
+		}
+		sections = append(sections, core.DetailSection{
+			Title: fmt.Sprintf("Inline policy %s", name),
+			Body:  document,
+		})
+	}
+
+	return sections, nil
+}
+
+// policyVersion returns the document of a managed policy's default version.
+func (s *Service) policyVersion(ctx context.Context, arn string) (string, error) {
+	policy, err := s.client().GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(arn)})
+	if err != nil {
+		return "", err
+	}
+	version, err := s.client().GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: policy.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		return "", err
+	}
+	return policyDocument(aws.ToString(version.PolicyVersion.Document)), nil
+}
+
 // =============================================================================
 // ActionExecutor Interface Implementation
 // =============================================================================
@@ -272,6 +441,38 @@ func (s *Service) Actions() []core.Action {
 			Dangerous:   false,
 			Category:    "info",
 		},
+		{
+			Name:        "simulate",
+			Description: "Simulate whether the principal may perform actions",
+			Icon:        "check",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "security",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "actions",
+					Type:        "string",
+					Required:    true,
+					Description: "Comma-separated actions, e.g. s3:GetObject,s3:PutObject",
+					Validation:  `^[\w*-]+:[\w*]+(\s*,\s*[\w*-]+:[\w*]+)*$`,
+				},
+				{
+					Name:        "resources",
+					Type:        "string",
+					Required:    false,
+					Default:     "*",
+					Description: "Comma-separated resource ARNs (default *)",
+				},
+			},
+		},
+		{
+			Name:        "credential_report",
+			Description: "Report console logins, access key use and MFA of every user",
+			Icon:        "users",
+			Shortcut:    "u",
+			Dangerous:   false,
+			Category:    "security",
+		},
 	}
 }
 
@@ -293,6 +494,10 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 		result, err = s.auditRole(ctx, resourceID)
 	case "view_policies":
 		result, err = s.viewPolicies(ctx, resourceID)
+	case "simulate":
+		result, err = s.simulatePolicy(ctx, resourceID, params)
+	case "credential_report":
+		result, err = s.credentialReport(ctx)
 	default:
 		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
 	}
@@ -321,6 +526,15 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 // Action Implementations
 // =============================================================================
 
+// CheckCompliance implements compliance.Checker.
+func (s *Service) CheckCompliance(ctx context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	policies, err := s.getAttachedPolicies(ctx, resource.Name)
+	if err != nil {
+		return nil, core.NewServiceError("iam", "compliance", err)
+	}
+	return roleChecks(policies), nil
+}
+
 func (s *Service) auditRole(ctx context.Context, roleName string) (*core.ActionResult, error) {
 	policies, err := s.getAttachedPolicies(ctx, roleName)
 	if err != nil {
@@ -330,16 +544,41 @@ func (s *Service) auditRole(ctx context.Context, roleName string) (*core.ActionR
 	isHighRisk, riskReason := assessRisk(policies)
 
 	result := core.NewActionResult(true, fmt.Sprintf("Audit complete for %s", roleName))
-	result.Data = map[string]any{
+	data := map[string]any{
 		"role_name":    roleName,
 		"policies":     policies,
 		"is_high_risk": isHighRisk,
 		"risk_reason":  riskReason,
+		"controls":     compliance.References(roleChecks(policies)),
+	}
+	if out, err := s.client().GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err == nil && out.Role != nil {
+		usage := assessUsage(out.Role, s.unusedThreshold, time.Now())
+		data["should_cleanup"] = usage.unused
+		data["cleanup_reason"] = usage.reason
 	}
+	result.Data = data
 
 	return result, nil
 }
 
+// credentialReport returns the credential report as []UserCredentials. The
+// resource ID is ignored since the report covers the whole account.
+func (s *Service) credentialReport(ctx context.Context) (*core.ActionResult, error) {
+	users, err := s.CredentialReport(ctx)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), err
+	}
+	flagged := 0
+	for _, u := range users {
+		if len(u.Flags) > 0 {
+			flagged++
+		}
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("%d users, %d to review", len(users), flagged))
+	result.Data = users
+	return result, nil
+}
+
 func (s *Service) viewPolicies(ctx context.Context, roleName string) (*core.ActionResult, error) {
 	policies, err := s.getAttachedPolicies(ctx, roleName)
 	if err != nil {
@@ -355,6 +594,128 @@ func (s *Service) viewPolicies(ctx context.Context, roleName string) (*core.Acti
 	return result, nil
 }
 
+// SimulationResult is the outcome of simulating one action on one resource.
+type SimulationResult struct {
+	Action         string   `json:"action"`
+	Resource       string   `json:"resource"`
+	Decision       string   `json:"decision"`
+	Allowed        bool     `json:"allowed"`
+	Statements     []string `json:"statements,omitempty"`
+	MissingContext []string `json:"missing_context,omitempty"`
+}
+
+// simulatePolicy evaluates the principal's policies against the requested
+// actions and resources. The principal may be a role name or any IAM ARN,
+// which allows simulating users as well as roles.
+func (s *Service) simulatePolicy(ctx context.Context, principal string, params map[string]any) (*core.ActionResult, error) {
+	actions := splitList(params["actions"])
+	if len(actions) == 0 {
+		err := core.NewValidationError("actions", nil, "at least one action is required")
+		return core.NewActionResult(false, err.Error()), core.NewActionError("simulate", principal, err)
+	}
+	resources := splitList(params["resources"])
+
+	principalArn := principal
+	if !strings.HasPrefix(principal, "arn:") {
+		out, err := s.client().GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(principal)})
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("simulate", principal, err)
+		}
+		principalArn = aws.ToString(out.Role.Arn)
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalArn),
+		ActionNames:     actions,
+	}
+	if len(resources) > 0 {
+		input.ResourceArns = resources
+	}
+
+	var results []SimulationResult
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("simulate", principal, err)
+		}
+		for _, eval := range page.EvaluationResults {
+			results = append(results, evaluationToResults(eval)...)
+		}
+	}
+
+	allowed := 0
+	for _, r := range results {
+		if r.Allowed {
+			allowed++
+		}
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Simulation: %d allowed, %d denied", allowed, len(results)-allowed))
+	result.Data = map[string]any{
+		"principal":   principalArn,
+		"evaluations": results,
+	}
+
+	return result, nil
+}
+
+// evaluationToResults flattens an evaluation into per-resource results.
+func evaluationToResults(eval types.EvaluationResult) []SimulationResult {
+	action := aws.ToString(eval.EvalActionName)
+
+	if len(eval.ResourceSpecificResults) == 0 {
+		return []SimulationResult{{
+			Action:         action,
+			Resource:       aws.ToString(eval.EvalResourceName),
+			Decision:       string(eval.EvalDecision),
+			Allowed:        eval.EvalDecision == types.PolicyEvaluationDecisionTypeAllowed,
+			Statements:     formatStatements(eval.MatchedStatements),
+			MissingContext: eval.MissingContextValues,
+		}}
+	}
+
+	results := make([]SimulationResult, 0, len(eval.ResourceSpecificResults))
+	for _, rr := range eval.ResourceSpecificResults {
+		results = append(results, SimulationResult{
+			Action:         action,
+			Resource:       aws.ToString(rr.EvalResourceName),
+			Decision:       string(rr.EvalResourceDecision),
+			Allowed:        rr.EvalResourceDecision == types.PolicyEvaluationDecisionTypeAllowed,
+			Statements:     formatStatements(rr.MatchedStatements),
+			MissingContext: rr.MissingContextValues,
+		})
+	}
+	return results
+}
+
+func formatStatements(statements []types.Statement) []string {
+	formatted := make([]string, 0, len(statements))
+	for _, st := range statements {
+		entry := aws.ToString(st.SourcePolicyId)
+		if st.SourcePolicyType != "" {
+			entry = fmt.Sprintf("%s [%s]", entry, st.SourcePolicyType)
+		}
+		if st.StartPosition != nil {
+			entry = fmt.Sprintf("%s line %d", entry, st.StartPosition.Line)
+		}
+		formatted = append(formatted, entry)
+	}
+	return formatted
+}
+
+// splitList turns a comma-separated parameter into a trimmed slice.
+func splitList(v any) []string {
+	raw, _ := v.(string)
+	var items []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			items = append(items, part)
+		}
+	}
+	return items
+}
+
 // =============================================================================
 // Helper Functions
 // =============================================================================
@@ -385,6 +746,20 @@ func (s *Service) getAttachedPolicies(ctx context.Context, roleName string) ([]s
 	return policies, nil
 }
 
+// policyDocument decodes a policy document, which IAM returns URL-encoded,
+// and indents it.
+func policyDocument(encoded string) string {
+	document, err := url.QueryUnescape(encoded)
+	if err != nil {
+		document = encoded
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(document), "", "  "); err != nil {
+		return document
+	}
+	return out.String()
+}
+
 func assessRisk(policies []string) (bool, string) {
 	for _, policy := range policies {
 		for _, highRisk := range highRiskPolicies {
@@ -401,6 +776,101 @@ func assessRisk(policies []string) (bool, string) {
 	return false, ""
 }
 
+// roleChecks returns the checks a role with the given policies fails.
+func roleChecks(policies []string) []compliance.Check {
+	for _, policy := range policies {
+		if policy == "AdministratorAccess" || strings.Contains(policy, "*") {
+			return []compliance.Check{compliance.CheckIAMFullAdmin}
+		}
+	}
+	return nil
+}
+
+// addIssues records a role's issues: critical for full admin rights, high
+// for other risky policies and low when unused.
+func addIssues(resource *core.Resource, policies []string, riskReason string, usage roleUsage) {
+	switch {
+	case len(roleChecks(policies)) > 0:
+		resource.AddIssue(core.SeverityCritical, riskReason)
+	case riskReason != "":
+		resource.AddIssue(core.SeverityHigh, riskReason)
+	}
+	if usage.unused {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+usage.reason)
+	}
+}
+
+// roleUsage summarizes when a role was last assumed.
+type roleUsage struct {
+	lastUsed  *time.Time
+	region    string
+	idleDays  int
+	unused    bool
+	reason    string
+	evaluated bool
+}
+
+// assessUsage flags a role as unused when it has not been assumed within the
+// threshold. Roles that were never used are measured from their creation date
+// so freshly created roles are not reported.
+func assessUsage(role *types.Role, threshold time.Duration, now time.Time) roleUsage {
+	usage := roleUsage{evaluated: true}
+
+	reference := role.CreateDate
+	if role.RoleLastUsed != nil && role.RoleLastUsed.LastUsedDate != nil {
+		usage.lastUsed = role.RoleLastUsed.LastUsedDate
+		usage.region = aws.ToString(role.RoleLastUsed.Region)
+		reference = usage.lastUsed
+	}
+	if reference == nil {
+		return usage
+	}
+
+	idle := now.Sub(*reference)
+	usage.idleDays = int(idle.Hours() / 24)
+	if threshold > 0 && idle > threshold {
+		usage.unused = true
+		if usage.lastUsed == nil {
+			usage.reason = fmt.Sprintf("never used (created %d days ago)", usage.idleDays)
+		} else {
+			usage.reason = fmt.Sprintf("not used in %d days", usage.idleDays)
+		}
+	}
+
+	return usage
+}
+
+// apply writes the usage assessment into resource metadata.
+func (u roleUsage) apply(resource *core.Resource) {
+	if !u.evaluated {
+		return
+	}
+	lastUsed := "Never"
+	if u.lastUsed != nil {
+		lastUsed = u.lastUsed.Format("2006-01-02")
+	}
+	resource.Metadata["last_used"] = lastUsed
+	resource.Metadata["last_used_region"] = u.region
+	resource.Metadata["idle_days"] = u.idleDays
+	resource.Metadata["should_cleanup"] = u.unused
+	resource.Metadata["cleanup_reason"] = u.reason
+}
+
+// applyFindings attaches external access findings to the role and reports
+// whether any were found. Lookup errors (e.g. no analyzer in the region)
+// are ignored so enrichment still succeeds.
+func (s *Service) applyFindings(ctx context.Context, resource *core.Resource) bool {
+	if s.findings == nil || resource.ARN == "" {
+		return false
+	}
+	findings, err := s.findings.FindingsFor(ctx, resource.ARN)
+	if err != nil {
+		return false
+	}
+	resource.ApplyFindings(findings)
+	return len(findings) > 0
+}
+
 func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
 	if s.dispatcher != nil {
 		event := core.NewEvent(eventType, "iam", data)
@@ -418,13 +888,21 @@ func (s *Service) dispatchError(ctx context.Context, op string, err error) {
 	}
 }
 
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
 // =============================================================================
 // Interface Assertions
 // =============================================================================
 
 var (
-	_ core.AWSService     = (*Service)(nil)
-	_ core.ResourceLister = (*Service)(nil)
-	_ core.ResourceGetter = (*Service)(nil)
-	_ core.ActionExecutor = (*Service)(nil)
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.DetailFetcher    = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+
+	_ compliance.Checker  = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
 )
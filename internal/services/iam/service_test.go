@@ -0,0 +1,217 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeIAM serves the deployer role, holding AdministratorAccess and assumed
+// yesterday, and the reports role, read-only and never assumed in the 200
+// days since its creation, along with a credential report. It fails every
+// call when err is set.
+type fakeIAM struct {
+	err    error
+	report string
+}
+
+func (f *fakeIAM) roles() []types.Role {
+	now := time.Now()
+	return []types.Role{
+		{
+			RoleName:     aws.String("deployer"),
+			RoleId:       aws.String("AROADEPLOYER"),
+			Arn:          aws.String("arn:aws:iam::123456789012:role/deployer"),
+			CreateDate:   aws.Time(now.Add(-400 * 24 * time.Hour)),
+			RoleLastUsed: &types.RoleLastUsed{LastUsedDate: aws.Time(now.Add(-24 * time.Hour)), Region: aws.String("us-east-1")},
+		},
+		{
+			RoleName:   aws.String("reports"),
+			RoleId:     aws.String("AROAREPORTS"),
+			Arn:        aws.String("arn:aws:iam::123456789012:role/reports"),
+			CreateDate: aws.Time(now.Add(-200 * 24 * time.Hour)),
+		},
+	}
+}
+
+func (f *fakeIAM) ListRoles(context.Context, *iam.ListRolesInput, ...func(*iam.Options)) (*iam.ListRolesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListRolesOutput{Roles: f.roles()}, nil
+}
+
+func (f *fakeIAM) ListAttachedRolePolicies(_ context.Context, in *iam.ListAttachedRolePoliciesInput, _ ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	policy := "ReadOnlyAccess"
+	if aws.ToString(in.RoleName) == "deployer" {
+		policy = "AdministratorAccess"
+	}
+	return &iam.ListAttachedRolePoliciesOutput{AttachedPolicies: []types.AttachedPolicy{{
+		PolicyName: aws.String(policy),
+		PolicyArn:  aws.String("arn:aws:iam::aws:policy/" + policy),
+	}}}, nil
+}
+
+func (f *fakeIAM) ListRolePolicies(context.Context, *iam.ListRolePoliciesInput, ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListRolePoliciesOutput{}, nil
+}
+
+func (f *fakeIAM) GetRolePolicy(context.Context, *iam.GetRolePolicyInput, ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error) {
+	return nil, &types.NoSuchEntityException{Message: aws.String("no inline policy")}
+}
+
+func (f *fakeIAM) GetPolicy(context.Context, *iam.GetPolicyInput, ...func(*iam.Options)) (*iam.GetPolicyOutput, error) {
+	return nil, &types.NoSuchEntityException{Message: aws.String("no customer policy")}
+}
+
+func (f *fakeIAM) GetPolicyVersion(context.Context, *iam.GetPolicyVersionInput, ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error) {
+	return nil, &types.NoSuchEntityException{Message: aws.String("no customer policy")}
+}
+
+func (f *fakeIAM) GetRole(_ context.Context, in *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, role := range f.roles() {
+		if aws.ToString(role.RoleName) == aws.ToString(in.RoleName) {
+			return &iam.GetRoleOutput{Role: &role}, nil
+		}
+	}
+	return nil, &types.NoSuchEntityException{Message: aws.String("role not found")}
+}
+
+func (f *fakeIAM) SimulatePrincipalPolicy(context.Context, *iam.SimulatePrincipalPolicyInput, ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.SimulatePrincipalPolicyOutput{}, nil
+}
+
+func (f *fakeIAM) GenerateCredentialReport(context.Context, *iam.GenerateCredentialReportInput, ...func(*iam.Options)) (*iam.GenerateCredentialReportOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.GenerateCredentialReportOutput{State: types.ReportStateTypeComplete}, nil
+}
+
+func (f *fakeIAM) GetCredentialReport(context.Context, *iam.GetCredentialReportInput, ...func(*iam.Options)) (*iam.GetCredentialReportOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.GetCredentialReportOutput{Content: []byte(f.report)}, nil
+}
+
+// TestServiceConformance runs the core service contract against roles.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeIAM{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeIAM{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID: "deployer",
+		MissingID:  "ghost",
+		Action:     "audit",
+	})
+}
+
+// TestListedRolesResolve checks that the listed ID of a role is what Get
+// and the actions look it up by.
+func TestListedRolesResolve(t *testing.T) {
+	svc := NewServiceWithClient(&fakeIAM{}, nil)
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for _, r := range resources {
+		got, err := svc.Get(context.Background(), r.ID)
+		if err != nil || got.Name != r.Name {
+			t.Errorf("Get(%q) = %v, %v", r.ID, got, err)
+		}
+		if _, err := svc.Execute(context.Background(), "view_policies", r.ID, nil); err != nil {
+			t.Errorf("view_policies on %q: %v", r.ID, err)
+		}
+	}
+	if id := resources[0].Metadata["role_id"]; id != "AROADEPLOYER" {
+		t.Errorf("role_id = %v", id)
+	}
+}
+
+func TestEnrichFlagsAdminAndUnusedRoles(t *testing.T) {
+	svc := NewServiceWithClient(&fakeIAM{}, nil)
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := map[string]struct {
+		severity core.Severity
+		issue    string
+	}{
+		"deployer": {core.SeverityCritical, "Has AdministratorAccess policy"},
+		"reports":  {core.SeverityLow, "Cleanup candidate: never used (created 200 days ago)"},
+	}
+	for i := range resources {
+		r := &resources[i]
+		if err := svc.EnrichResource(context.Background(), r); err != nil {
+			t.Fatalf("EnrichResource(%s) error = %v", r.ID, err)
+		}
+		issues := r.Issues()
+		if len(issues) != 1 || issues[0].Severity != want[r.ID].severity || issues[0].Message != want[r.ID].issue {
+			t.Errorf("%s issues = %v, want %q", r.ID, issues, want[r.ID].issue)
+		}
+	}
+}
+
+func TestCredentialReportFlags(t *testing.T) {
+	now := time.Now().UTC()
+	ago := func(days int) string { return now.AddDate(0, 0, -days).Format(time.RFC3339) }
+	header := "user,arn,user_creation_time,password_enabled,password_last_used,mfa_active," +
+		"access_key_1_active,access_key_1_last_rotated,access_key_1_last_used_date,access_key_1_last_used_service," +
+		"access_key_2_active,access_key_2_last_rotated,access_key_2_last_used_date,access_key_2_last_used_service"
+	rows := []string{
+		fmt.Sprintf("<root_account>,arn:aws:iam::123456789012:root,%s,not_supported,%s,true,true,%s,N/A,N/A,false,N/A,N/A,N/A", ago(900), ago(3), ago(900)),
+		fmt.Sprintf("alice,arn:aws:iam::123456789012:user/alice,%s,true,%s,false,false,N/A,N/A,N/A,false,N/A,N/A,N/A", ago(300), ago(2)),
+		fmt.Sprintf("ci,arn:aws:iam::123456789012:user/ci,%s,false,N/A,false,true,%s,%s,s3,true,%s,N/A,N/A", ago(300), ago(300), ago(200), ago(120)),
+		fmt.Sprintf("bob,arn:aws:iam::123456789012:user/bob,%s,true,N/A,true,false,N/A,N/A,N/A,false,N/A,N/A,N/A", ago(5)),
+	}
+	svc := NewServiceWithClient(&fakeIAM{report: header + "\n" + strings.Join(rows, "\n")}, nil)
+
+	users, err := svc.CredentialReport(context.Background())
+	if err != nil {
+		t.Fatalf("CredentialReport() error = %v", err)
+	}
+	want := map[string][]string{
+		"<root_account>": {"root access key 1"},
+		"alice":          {"console without MFA"},
+		"ci":             {"key 1 unused in 90d", "key 2 never used"},
+		"bob":            nil,
+	}
+	if len(users) != len(want) {
+		t.Fatalf("users = %+v", users)
+	}
+	for _, u := range users {
+		if !slices.Equal(u.Flags, want[u.User]) {
+			t.Errorf("%s flags = %q, want %q", u.User, u.Flags, want[u.User])
+		}
+	}
+}
@@ -5,40 +5,45 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
 	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
 )
 
+const simulateFormID = "iam:simulate"
+
 // =============================================================================
 // View Implementation
 // =============================================================================
 
 // View implements the TUI view for IAM roles.
 type View struct {
-	*base.TableView
-	enriching  bool
-	analyzed   int
-	cancelFunc context.CancelFunc
-	cache      map[string]*core.Resource
+	*base.EnrichableTableView
+
+	// simulateTarget is the role a pending simulation form applies to
+	simulateTarget string
 }
 
 // NewView creates a new IAM view.
 func NewView() *View {
 	columnDefs := []base.ColumnDef{
-		{Title: "Name", MinWidth: 15, MaxWidth: 40, Weight: 2.0, Priority: 0},
-		{Title: "Created", MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 3},
-		{Title: "Policies", MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 1},
-		{Title: "Risk", MinWidth: 8, MaxWidth: 12, Weight: 0.2, Priority: 0},
-		{Title: "Risk Reason", MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 2},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Created"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Last Used"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Policies"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Risk"), MinWidth: 8, MaxWidth: 14, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Risk Reason"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 2},
+		{Title: i18n.T("Controls"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Owner"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 4},
 	}
 
 	return &View{
-		TableView: base.NewTableView("IAM", "2", "iam", columnDefs),
-		cache:     make(map[string]*core.Resource),
+		EnrichableTableView: base.NewEnrichableTableView("IAM", "2", "iam", i18n.T("roles"), columnDefs, buildRow),
 	}
 }
 
@@ -52,91 +57,73 @@ func (v *View) Init() tea.Cmd {
 	if len(v.Resources) > 0 || v.IsLoading() {
 		return nil
 	}
-	return v.loadRoles()
+	return v.Load()
 }
 
 // Update handles messages and updates the view state.
 func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openSimulateForm(row.Name)
+			}
 		case "R":
-			v.Message = "Full refresh..."
-			return v, v.hardRefresh()
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
 		case "a":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Auditing %s...", row.Name)
-				return v, v.analyzeSelected()
+				v.Message = i18n.T("Auditing %s...", row.Name)
+				return v, v.AnalyzeSelected()
 			}
 		case "p":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Loading policies for %s...", row.Name)
+				v.Message = i18n.T("Loading policies for %s...", row.Name)
 				return v, v.executeAction("view_policies", row.Name)
 			}
+		case "u":
+			v.Message = i18n.T("Generating credential report...")
+			return v, v.executeAction("credential_report", "account")
 		case "enter":
 			if row := v.GetSelectedResource(); row != nil {
-				policies, _ := row.Metadata["policies"].([]string)
-				v.Message = fmt.Sprintf("%s: %d policies", row.Name, len(policies))
+				return v, v.OpenLazyDetail(i18n.T("Role %s", row.Name), formatRole(row), row)
 			}
 		}
 
-	case iamLoadedMsg:
-		v.SetLoading(false)
-		if msg.err != nil {
-			v.SetError(msg.err)
-			v.Message = fmt.Sprintf("Error: %v", msg.err)
-		} else {
-			v.SetError(nil)
-			if msg.hardRefresh {
-				v.cache = make(map[string]*core.Resource)
-				v.analyzed = 0
-				v.Resources = msg.resources
-				v.updateTable()
-				v.Message = fmt.Sprintf("Loaded %d roles, analyzing...", len(msg.resources))
-				cmds = append(cmds, v.startEnrichment())
+	case components.FormResultMsg:
+		if msg.ID == simulateFormID {
+			if msg.Canceled || v.simulateTarget == "" {
+				v.Message = i18n.T("Simulation canceled")
 			} else {
-				newCount := 0
-				v.Resources = msg.resources
-				for i := range v.Resources {
-					if cached, ok := v.cache[v.Resources[i].Name]; ok {
-						v.Resources[i] = *cached
-					} else {
-						newCount++
-					}
-				}
-				v.updateTable()
-				if newCount > 0 {
-					v.Message = fmt.Sprintf("Found %d new roles, analyzing...", newCount)
-					cmds = append(cmds, v.startEnrichmentForNew())
-				} else {
-					v.Message = fmt.Sprintf("Refreshed %d roles", len(msg.resources))
-				}
+				v.Message = i18n.T("Simulating %s...", v.simulateTarget)
+				cmds = append(cmds, v.executeActionWithParams("simulate", v.simulateTarget, msg.Values))
 			}
 		}
 
-	case iamResourceEnrichedMsg:
-		if msg.index >= 0 && msg.index < len(v.Resources) {
-			v.Resources[msg.index] = msg.resource
-			v.cache[msg.resource.Name] = &v.Resources[msg.index]
-			v.analyzed++
-			v.updateTableRow(msg.index)
-			v.Message = fmt.Sprintf("Analyzing... %d/%d", v.analyzed, len(v.Resources))
-			cmds = append(cmds, v.continueEnrichment())
-		}
-
-	case iamEnrichmentDoneMsg:
-		v.enriching = false
-		v.Message = fmt.Sprintf("Loaded %d roles", len(v.Resources))
-
 	case base.ActionResultMsg:
 		if msg.Error != nil {
-			v.Message = fmt.Sprintf("Action failed: %v", msg.Error)
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Action == "simulate" && msg.Result != nil {
+			v.Message = msg.Result.Message
+			v.OpenDetail(i18n.T("Policy simulation: %s", v.simulateTarget), formatSimulation(msg.Result))
+		} else if msg.Action == "credential_report" && msg.Result != nil {
+			users, _ := msg.Result.Data.([]UserCredentials)
+			v.Message = msg.Result.Message
+			v.OpenDetail(i18n.T("Credential report"), formatCredentialReport(users))
 		} else if msg.Result != nil {
 			if data, ok := msg.Result.Data.(map[string]any); ok {
 				if policies, ok := data["policies"].([]string); ok {
-					v.Message = fmt.Sprintf("Policies: %s", strings.Join(policies, ", "))
+					v.Message = i18n.T("Policies: %s", strings.Join(policies, ", "))
 				} else {
 					v.Message = msg.Result.Message
 				}
@@ -164,11 +151,11 @@ func (v *View) View() string {
 
 	// Table or loading/error
 	if v.IsLoading() && len(v.Resources) == 0 {
-		lines = append(lines, v.Styles.Muted.Render("Loading IAM roles..."))
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading IAM roles...")))
 	} else if err := v.Error(); err != nil {
-		lines = append(lines, v.Styles.Error.Render(fmt.Sprintf("Error: %v", err)))
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
 	} else {
-		lines = append(lines, v.TableViewString())
+		lines = append(lines, v.ContentView())
 	}
 
 	// Message or blank
@@ -179,7 +166,7 @@ func (v *View) View() string {
 	}
 
 	// Help
-	lines = append(lines, v.Styles.Help.Render("[a]udit  [p]olicies  [r]efresh  [R]e-analyze  [↑/↓]nav"))
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [a]udit  [p]olicies  [s]imulate  [u]sers report  [r]efresh  [R]e-analyze  [↑/↓]nav")))
 	return strings.Join(lines, "\n")
 }
 
@@ -189,264 +176,136 @@ func (v *View) View() string {
 
 // Refresh does a soft refresh.
 func (v *View) Refresh() tea.Cmd {
-	return v.softRefresh()
-}
-
-// Reset clears all view data including cache.
-func (v *View) Reset() {
-	v.TableView.Reset()
-	v.cache = make(map[string]*core.Resource)
-	v.analyzed = 0
-	v.enriching = false
-	if v.cancelFunc != nil {
-		v.cancelFunc()
-		v.cancelFunc = nil
-	}
-}
-
-func (v *View) softRefresh() tea.Cmd {
-	v.SetLoading(true)
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return iamLoadedMsg{err: fmt.Errorf("service not initialized"), hardRefresh: false}
-		}
-		lister, ok := service.(core.ResourceLister)
-		if !ok {
-			return iamLoadedMsg{err: fmt.Errorf("service does not support listing"), hardRefresh: false}
-		}
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return iamLoadedMsg{resources: resources, err: err, hardRefresh: false}
-	}
-}
-
-func (v *View) hardRefresh() tea.Cmd {
-	v.cache = make(map[string]*core.Resource)
-	v.analyzed = 0
-	return v.loadRoles()
-}
-
-func (v *View) analyzeSelected() tea.Cmd {
-	cursor := v.Cursor()
-	if cursor < 0 || cursor >= len(v.Resources) {
-		return nil
-	}
-	service := v.Service()
-	if service == nil {
-		return nil
-	}
-	iamSvc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	index := cursor
-	return func() tea.Msg {
-		resource := v.Resources[index]
-		delete(v.cache, resource.Name)
-		resource.Metadata["analyzed"] = false
-		if err := iamSvc.EnrichResource(context.Background(), &resource); err == nil {
-			return iamResourceEnrichedMsg{index: index, resource: resource}
-		}
-		return iamEnrichmentDoneMsg{}
-	}
+	return v.SoftRefresh()
 }
 
 // =============================================================================
 // Internal Methods
 // =============================================================================
 
-type iamLoadedMsg struct {
-	resources   []core.Resource
-	err         error
-	hardRefresh bool
+func (v *View) openSimulateForm(roleName string) tea.Cmd {
+	action, ok := base.FindAction(v.Service(), "simulate")
+	if !ok {
+		v.Message = i18n.T("Simulation not supported")
+		return nil
+	}
+	v.simulateTarget = roleName
+	return v.OpenForm(components.NewForm(simulateFormID, i18n.T("Simulate policy for %s", roleName), action.Parameters))
 }
 
-type iamResourceEnrichedMsg struct {
-	index    int
-	resource core.Resource
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return v.executeActionWithParams(action, resourceID, nil)
 }
 
-type iamEnrichmentDoneMsg struct{}
-
-func (v *View) loadRoles() tea.Cmd {
-	if v.cancelFunc != nil {
-		v.cancelFunc()
-	}
-	v.SetLoading(true)
-	v.enriching = false
-
+func (v *View) executeActionWithParams(action, resourceID string, params map[string]any) tea.Cmd {
 	return func() tea.Msg {
 		service := v.Service()
 		if service == nil {
-			return iamLoadedMsg{err: fmt.Errorf("service not initialized"), hardRefresh: true}
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
 		}
-		lister, ok := service.(core.ResourceLister)
+		executor, ok := service.(core.ActionExecutor)
 		if !ok {
-			return iamLoadedMsg{err: fmt.Errorf("service does not support listing"), hardRefresh: true}
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
 		}
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return iamLoadedMsg{resources: resources, err: err, hardRefresh: true}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
 	}
 }
 
-func (v *View) startEnrichment() tea.Cmd {
-	service := v.Service()
-	if service == nil {
-		return nil
+// formatRole renders what is known of a role for the detail panel, before
+// its policy documents are fetched.
+func formatRole(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:       %s\n", r.ARN)
+	fmt.Fprintf(&b, "Path:      %s\n", r.GetMetadataString("path"))
+	if created := r.GetMetadataString("create_date"); created != "" {
+		fmt.Fprintf(&b, "Created:   %s\n", created)
 	}
-	iamSvc, ok := service.(*Service)
-	if !ok {
-		return nil
+	if lastUsed := r.GetMetadataString("last_used"); lastUsed != "" {
+		fmt.Fprintf(&b, "Last used: %s\n", lastUsed)
+	}
+	if count, ok := r.Metadata["policy_count"].(int); ok {
+		fmt.Fprintf(&b, "Policies:  %d\n", count)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	v.cancelFunc = cancel
-	v.enriching = true
-
-	return func() tea.Msg {
-		for i := range v.Resources {
-			select {
-			case <-ctx.Done():
-				return iamEnrichmentDoneMsg{}
-			default:
-				resource := v.Resources[i]
-				if err := iamSvc.EnrichResource(ctx, &resource); err == nil {
-					return iamResourceEnrichedMsg{index: i, resource: resource}
-				}
-			}
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
 		}
-		return iamEnrichmentDoneMsg{}
 	}
+	return b.String()
 }
 
-func (v *View) startEnrichmentForNew() tea.Cmd {
-	service := v.Service()
-	if service == nil {
-		return nil
+// formatSimulation renders simulation results for the detail panel.
+func formatSimulation(result *core.ActionResult) string {
+	data, _ := result.Data.(map[string]any)
+	evaluations, _ := data["evaluations"].([]SimulationResult)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Principal: %v\n\n", data["principal"])
+	for _, eval := range evaluations {
+		icon := "🔴"
+		if eval.Allowed {
+			icon = "🟢"
+		}
+		fmt.Fprintf(&b, "%s %s on %s: %s\n", icon, eval.Action, eval.Resource, eval.Decision)
+		for _, st := range eval.Statements {
+			fmt.Fprintf(&b, "    matched: %s\n", st)
+		}
+		if len(eval.MissingContext) > 0 {
+			fmt.Fprintf(&b, "    missing context: %s\n", strings.Join(eval.MissingContext, ", "))
+		}
 	}
-	iamSvc, ok := service.(*Service)
-	if !ok {
-		return nil
+	if len(evaluations) == 0 {
+		b.WriteString(i18n.T("No evaluation results returned.\n"))
 	}
+	return b.String()
+}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	v.cancelFunc = cancel
-	v.enriching = true
-
-	return func() tea.Msg {
-		for i := range v.Resources {
-			if _, inCache := v.cache[v.Resources[i].Name]; inCache {
+// formatCredentialReport renders the credential report for the detail
+// panel, users to review first.
+func formatCredentialReport(users []UserCredentials) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-28s %-7s %-11s %-4s %s\n", "USER", "CONSOLE", "LAST LOGIN", "MFA", "ACCESS KEYS")
+	for _, flagged := range []bool{true, false} {
+		for _, u := range users {
+			if (len(u.Flags) > 0) != flagged {
 				continue
 			}
-			if analyzed, ok := v.Resources[i].Metadata["analyzed"].(bool); ok && analyzed {
-				continue
+			console, login := "no", "-"
+			if u.ConsoleAccess {
+				console, login = "yes", FormatReportTime(u.LastConsoleLogin)
 			}
-			select {
-			case <-ctx.Done():
-				return iamEnrichmentDoneMsg{}
-			default:
-				resource := v.Resources[i]
-				if err := iamSvc.EnrichResource(ctx, &resource); err == nil {
-					return iamResourceEnrichedMsg{index: i, resource: resource}
+			mfa := "no"
+			if u.MFA {
+				mfa = "yes"
+			}
+			var keys []string
+			for _, key := range u.AccessKeys {
+				if key.Active {
+					keys = append(keys, fmt.Sprintf("#%d used %s", key.Slot, FormatReportTime(key.LastUsed)))
 				}
 			}
+			if len(keys) == 0 {
+				keys = append(keys, "-")
+			}
+			fmt.Fprintf(&b, "%-28s %-7s %-11s %-4s %s\n", base.TruncateString(u.User, 28), console, login, mfa, strings.Join(keys, ", "))
+			if flagged {
+				fmt.Fprintf(&b, "  ⚠ %s\n", FormatFlags(u.Flags))
+			}
 		}
-		return iamEnrichmentDoneMsg{}
-	}
-}
-
-func (v *View) continueEnrichment() tea.Cmd {
-	service := v.Service()
-	if service == nil || !v.enriching {
-		return nil
-	}
-	iamSvc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	nextIndex := -1
-	for i, r := range v.Resources {
-		if analyzed, ok := r.Metadata["analyzed"].(bool); !ok || !analyzed {
-			nextIndex = i
-			break
-		}
-	}
-
-	if nextIndex == -1 {
-		v.enriching = false
-		return func() tea.Msg { return iamEnrichmentDoneMsg{} }
-	}
-
-	ctx := context.Background()
-	if v.cancelFunc != nil {
-		ctx, v.cancelFunc = context.WithCancel(context.Background())
-	}
-
-	return func() tea.Msg {
-		resource := v.Resources[nextIndex]
-		if err := iamSvc.EnrichResource(ctx, &resource); err == nil {
-			return iamResourceEnrichedMsg{index: nextIndex, resource: resource}
-		}
-		return iamEnrichmentDoneMsg{}
-	}
-}
-
-func (v *View) executeAction(action, resourceID string) tea.Cmd {
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
-		}
-		executor, ok := service.(core.ActionExecutor)
-		if !ok {
-			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
-		}
-		result, err := executor.Execute(context.Background(), action, resourceID, nil)
-		return base.ActionResultMsg{Action: action, Result: result, Error: err}
-	}
-}
-
-func (v *View) updateTable() {
-	rows := make([]table.Row, len(v.Resources))
-	for i := range v.Resources {
-		rows[i] = v.buildRow(i)
 	}
-	v.SetRows(rows)
+	b.WriteString(i18n.T("\nExport it with: a9s access-report --output csv\n"))
+	return b.String()
 }
 
-func (v *View) updateTableRow(index int) {
-	if index < 0 || index >= len(v.Resources) {
-		return
-	}
-	rows := v.Table.Rows()
-	if index < len(rows) {
-		rows[index] = v.buildRow(index)
-		v.SetRows(rows)
-	}
-}
-
-func (v *View) buildRow(index int) table.Row {
-	r := v.Resources[index]
-
+func buildRow(r core.Resource) base.Row {
 	policyCount := 0
 	if count, ok := r.Metadata["policy_count"].(int); ok {
 		policyCount = count
 	}
 
-	riskLevel := "Low"
-	riskIcon := "🟢"
-	if isHighRisk, ok := r.Metadata["is_high_risk"].(bool); ok && isHighRisk {
-		riskLevel = "HIGH"
-		riskIcon = "🔴"
-	}
-
-	riskReason := ""
-	if reason, ok := r.Metadata["risk_reason"].(string); ok {
-		riskReason = reason
-	}
-
 	createDate := ""
 	if date, ok := r.Metadata["create_date"].(string); ok {
 		createDate = date
@@ -458,40 +317,58 @@ func (v *View) buildRow(index int) table.Row {
 	}
 
 	policyStr := "..."
-	riskStr := "..."
+	lastUsedStr := "..."
+	var policyValue any
 	if analyzed {
+		policyValue = policyCount
 		policyStr = fmt.Sprintf("%d", policyCount)
-		riskStr = riskIcon + " " + riskLevel
+		lastUsedStr = r.GetMetadataString("last_used")
 	}
 
-	return table.Row{
-		base.TruncateString(r.Name, 40),
-		createDate,
-		policyStr,
-		riskStr,
-		base.TruncateString(riskReason, 50),
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.TextCell(createDate),
+		base.AgeCell(r),
+		base.TextCell(lastUsedStr),
+		base.LazyCell(policyValue, func() string { return policyStr }),
+		base.SeverityCell(r),
+		base.TextCell(base.TruncateString(base.FormatIssues(r), 50)),
+		base.TextCell(base.FormatControls(r)),
+		base.TextCell(base.FormatIaC(r)),
+		base.TextCell(base.FormatOwner(r)),
 	}
 }
 
-func (v *View) renderSummary() string {
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
 	total := len(v.Resources)
 	highRisk := 0
+	unused := 0
+	external := 0
 	for _, r := range v.Resources {
 		if isHighRisk, ok := r.Metadata["is_high_risk"].(bool); ok && isHighRisk {
 			highRisk++
 		}
+		if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+			unused++
+		}
+		if isExternal, ok := r.Metadata["external_access"].(bool); ok && isExternal {
+			external++
+		}
 	}
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		v.Styles.Title.Render("IAM Roles"),
-		"  ",
-		v.Styles.Muted.Render(fmt.Sprintf("Total: %d", total)),
-		"  ",
-		v.Styles.Error.Render(fmt.Sprintf("High Risk: %d", highRisk)),
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "high-risk", Text: i18n.T("High Risk: %d", highRisk), Tone: core.ToneError},
+		core.SummaryWidget{Name: "unused", Text: i18n.T("Unused: %d", unused), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "external", Text: i18n.T("External: %d", external), Tone: core.ToneWarning},
 	)
 }
 
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("IAM Roles"), v.SummaryWidgets())
+}
+
 // =============================================================================
 // View Factory
 // =============================================================================
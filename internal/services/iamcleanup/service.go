@@ -0,0 +1,674 @@
+// Package iamcleanup finds IAM leftovers for the a9s application: customer
+// managed policies attached to nothing and service-linked roles of services
+// no longer in use, and deletes them one by one or in bulk.
+package iamcleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Kinds of cleanup candidates, as shown in the Kind column.
+const (
+	KindPolicy = "policy"
+	KindRole   = "service-linked role"
+)
+
+const (
+	// DefaultUnusedThreshold is how long a service-linked role may go without
+	// being used before it is a cleanup candidate.
+	DefaultUnusedThreshold = 90 * 24 * time.Hour
+	// serviceLinkedPath is the path of every service-linked role.
+	serviceLinkedPath = "/aws-service-role/"
+	// deletionPollInterval is how often a service-linked role deletion is
+	// checked.
+	deletionPollInterval = 2 * time.Second
+	// deletionTimeout is how long to wait for a service-linked role
+	// deletion to end.
+	deletionTimeout = 30 * time.Second
+)
+
+// Service finds unused IAM policies and service-linked roles.
+type Service struct {
+	factory         *awsfactory.ClientFactory
+	dispatcher      core.EventDispatcher
+	testClient      IAMAPI
+	unusedThreshold time.Duration
+	batches         *batch.Store
+}
+
+// Option configures the IAM cleanup service.
+type Option func(*Service)
+
+// WithUnusedThreshold sets how long a service-linked role may go unused
+// before it is a candidate. Non-positive values keep the default.
+func WithUnusedThreshold(d time.Duration) Option {
+	return func(s *Service) {
+		if d > 0 {
+			s.unusedThreshold = d
+		}
+	}
+}
+
+// WithBatchStore keeps the state of batch deletions in store, so an
+// interrupted one can be resumed.
+func WithBatchStore(store *batch.Store) Option {
+	return func(s *Service) {
+		s.batches = store
+	}
+}
+
+// WithClient sets a custom IAM client (for testing).
+func WithClient(client IAMAPI) Option {
+	return func(s *Service) {
+		s.testClient = client
+	}
+}
+
+// IAMAPI defines the IAM client interface for mocking.
+type IAMAPI interface {
+	ListPolicies(ctx context.Context, params *iam.ListPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListPoliciesOutput, error)
+	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	ListPolicyVersions(ctx context.Context, params *iam.ListPolicyVersionsInput, optFns ...func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
+	DeletePolicyVersion(ctx context.Context, params *iam.DeletePolicyVersionInput, optFns ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	DeletePolicy(ctx context.Context, params *iam.DeletePolicyInput, optFns ...func(*iam.Options)) (*iam.DeletePolicyOutput, error)
+	ListRoles(ctx context.Context, params *iam.ListRolesInput, optFns ...func(*iam.Options)) (*iam.ListRolesOutput, error)
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	DeleteServiceLinkedRole(ctx context.Context, params *iam.DeleteServiceLinkedRoleInput, optFns ...func(*iam.Options)) (*iam.DeleteServiceLinkedRoleOutput, error)
+	GetServiceLinkedRoleDeletionStatus(ctx context.Context, params *iam.GetServiceLinkedRoleDeletionStatusInput, optFns ...func(*iam.Options)) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error)
+}
+
+// NewService creates a new IAM cleanup service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:         factory,
+		dispatcher:      dispatcher,
+		unusedThreshold: DefaultUnusedThreshold,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the IAM client, fetching fresh from factory each time.
+func (s *Service) client() IAMAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.IAMClient()
+}
+
+// UnusedThreshold returns the inactivity period used to flag service-linked
+// roles.
+func (s *Service) UnusedThreshold() time.Duration {
+	return s.unusedThreshold
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "iamcleanup"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "IAM Cleanup"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "trash"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListPolicies(ctx, &iam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal, MaxItems: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("iamcleanup", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the cleanup candidates: customer managed policies attached
+// to no user, group or role and used as no permissions boundary, then
+// service-linked roles unused for longer than the threshold.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+
+	policies, err := s.unattachedPolicies(ctx, now)
+	if err != nil {
+		s.dispatchError(ctx, "list_policies", err)
+		return nil, core.NewServiceError("iamcleanup", "list", err)
+	}
+	roles, err := s.unusedServiceLinkedRoles(ctx, now)
+	if err != nil {
+		s.dispatchError(ctx, "list_roles", err)
+		return nil, core.NewServiceError("iamcleanup", "list", err)
+	}
+	resources := append(policies, roles...)
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "iamcleanup",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+func (s *Service) unattachedPolicies(ctx context.Context, now time.Time) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := iam.NewListPoliciesPaginator(s.client(), &iam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range page.Policies {
+			if aws.ToInt32(policy.AttachmentCount) > 0 || aws.ToInt32(policy.PermissionsBoundaryUsageCount) > 0 {
+				continue
+			}
+			resources = append(resources, policyToResource(policy, now))
+		}
+	}
+	return resources, nil
+}
+
+func policyToResource(policy types.Policy, now time.Time) core.Resource {
+	r := core.Resource{
+		ID:        aws.ToString(policy.Arn),
+		Type:      "iam:policy",
+		Name:      aws.ToString(policy.PolicyName),
+		ARN:       aws.ToString(policy.Arn),
+		State:     core.StateInactive,
+		CreatedAt: policy.CreateDate,
+		UpdatedAt: policy.UpdateDate,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"kind":            KindPolicy,
+			"path":            aws.ToString(policy.Path),
+			"default_version": aws.ToString(policy.DefaultVersionId),
+			"reason":          "Attached to nothing",
+			"should_cleanup":  true,
+			"cleanup_reason":  "attached to nothing",
+		},
+	}
+	for _, tag := range policy.Tags {
+		r.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	estimate.ApplyAge(&r, now)
+	r.AddIssue(core.SeverityLow, "Customer managed policy attached to no user, group or role")
+	return r
+}
+
+// unusedServiceLinkedRoles returns the service-linked roles not used within
+// the threshold. Their last use is only returned by GetRole.
+func (s *Service) unusedServiceLinkedRoles(ctx context.Context, now time.Time) ([]core.Resource, error) {
+	client := s.client()
+
+	resources := make([]core.Resource, 0)
+	paginator := iam.NewListRolesPaginator(client, &iam.ListRolesInput{PathPrefix: aws.String(serviceLinkedPath)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, listed := range page.Roles {
+			out, err := client.GetRole(ctx, &iam.GetRoleInput{RoleName: listed.RoleName})
+			if err != nil || out.Role == nil {
+				continue
+			}
+			if r, unused := s.roleToResource(*out.Role, now); unused {
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+// roleToResource turns a service-linked role into a candidate, and reports
+// whether it went unused for longer than the threshold. Roles never used
+// are measured from their creation, so new ones are not reported.
+func (s *Service) roleToResource(role types.Role, now time.Time) (core.Resource, bool) {
+	reference := role.CreateDate
+	lastUsed := "Never"
+	if role.RoleLastUsed != nil && role.RoleLastUsed.LastUsedDate != nil {
+		reference = role.RoleLastUsed.LastUsedDate
+		lastUsed = reference.Format("2006-01-02")
+	}
+	if reference == nil || now.Sub(*reference) <= s.unusedThreshold {
+		return core.Resource{}, false
+	}
+	days := int(now.Sub(*reference).Hours() / 24)
+
+	reason := fmt.Sprintf("Not used in %d days", days)
+	if lastUsed == "Never" {
+		reason = fmt.Sprintf("Never used (created %d days ago)", days)
+	}
+
+	r := core.Resource{
+		ID:        aws.ToString(role.Arn),
+		Type:      "iam:role",
+		Name:      aws.ToString(role.RoleName),
+		ARN:       aws.ToString(role.Arn),
+		State:     core.StateInactive,
+		CreatedAt: role.CreateDate,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"kind":           KindRole,
+			"path":           aws.ToString(role.Path),
+			"service":        linkedService(aws.ToString(role.Path)),
+			"last_used":      lastUsed,
+			"idle_days":      days,
+			"reason":         reason,
+			"should_cleanup": true,
+			"cleanup_reason": strings.ToLower(reason[:1]) + reason[1:],
+		},
+	}
+	if role.RoleLastUsed != nil {
+		r.Metadata["last_used_region"] = aws.ToString(role.RoleLastUsed.Region)
+	}
+	for _, tag := range role.Tags {
+		r.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	estimate.ApplyAge(&r, now)
+	r.AddIssue(core.SeverityLow, fmt.Sprintf("Service-linked role of %s: %s", r.GetMetadataString("service"), r.GetMetadataString("cleanup_reason")))
+	return r, true
+}
+
+// linkedService returns the service a service-linked role belongs to, from
+// its path, such as /aws-service-role/elasticbeanstalk.amazonaws.com/.
+func linkedService(path string) string {
+	return strings.Trim(strings.TrimPrefix(path, serviceLinkedPath), "/")
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for cleanup candidates.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "delete",
+			Description: "Delete the unused policy or service-linked role",
+			Icon:        "trash",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "delete_batch",
+			Description: "Delete several unused policies and service-linked roles",
+			Icon:        "trash",
+			Shortcut:    "D",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "ids", Type: "string", Required: true, Description: "Comma-separated ARNs to delete"},
+			},
+		},
+		{
+			Name:        "resume_batch",
+			Description: "Resume the interrupted batch deletion",
+			Icon:        "play",
+			Shortcut:    "R",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action. Deletions ask for confirmation through
+// a core.ConfirmationError until the "confirm" parameter is set. A batch
+// deletion's resource ID only labels it; the ARNs are in the "ids"
+// parameter.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "delete":
+		result, err = s.deleteOne(ctx, resourceID, params, confirmed)
+	case "delete_batch":
+		raw, _ := params["ids"].(string)
+		var ids []string
+		for _, id := range strings.Split(raw, ",") {
+			if id = strings.TrimSpace(id); id != "" && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, core.NewValidationError("ids", raw, "at least one ARN is required")
+		}
+		result, err = s.deleteBatch(ctx, resourceID, ids, params, confirmed)
+	case "resume_batch":
+		result, err = s.resumeBatch(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// PendingBatch returns the batch deletion that was interrupted, if any.
+func (s *Service) PendingBatch() *batch.Job {
+	job, _ := s.batches.Pending(s.batchScope())
+	return job
+}
+
+// batchScope returns the scope of batch deletions: the profile, IAM being global.
+func (s *Service) batchScope() string {
+	profile := ""
+	if s.factory != nil {
+		profile = s.factory.Profile()
+	}
+	return batch.Scope(s.Name(), profile, "")
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) deleteOne(ctx context.Context, arn string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", arn, err)
+	}
+
+	kind, name, err := parseARN(arn)
+	if err != nil {
+		return fail(err)
+	}
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "delete", arn, params, deletionReason(kind, name), false)
+	}
+
+	if err := s.delete(ctx, arn); err != nil {
+		return fail(err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Deleted %s %s", kind, name)), nil
+}
+
+// deleteBatch deletes every candidate in turn once confirmed, as a
+// resumable batch going on after failures.
+func (s *Service) deleteBatch(ctx context.Context, label string, arns []string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	policies, roles := 0, 0
+	items := make([]batch.Item, 0, len(arns))
+	for _, arn := range arns {
+		kind, name, err := parseARN(arn)
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("delete_batch", label, err)
+		}
+		if kind == KindPolicy {
+			policies++
+		} else {
+			roles++
+		}
+		items = append(items, batch.Item{ID: arn, Name: name, Kind: kind})
+	}
+	if !confirmed {
+		reason := fmt.Sprintf("Deletes %d policies and %d service-linked roles; policies cannot be restored and roles are only deleted by services that no longer use them", policies, roles)
+		return nil, core.NewConfirmationError(s, "delete_batch", label, params, reason, false)
+	}
+
+	return s.runBatch(ctx, batch.NewJob(s.batchScope(), "delete_batch", items, nil)), nil
+}
+
+// resumeBatch deletes the candidates an interrupted batch did not get to.
+func (s *Service) resumeBatch(ctx context.Context, label string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	job, err := s.batches.Pending(s.batchScope())
+	if err == nil && job == nil {
+		err = fmt.Errorf("no interrupted batch to resume")
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("resume_batch", label, err)
+	}
+	if !confirmed {
+		reason := fmt.Sprintf("Resumes the batch started %s: %d of %d candidates are left",
+			job.Started.Local().Format("2006-01-02 15:04"), job.Count(batch.StatusPending), len(job.Items))
+		return nil, core.NewConfirmationError(s, "resume_batch", label, params, reason, false)
+	}
+	return s.runBatch(ctx, job), nil
+}
+
+// runBatch deletes the pending candidates of job. The result's data is the
+// job.
+func (s *Service) runBatch(ctx context.Context, job *batch.Job) *core.ActionResult {
+	err := batch.Run(ctx, job, func(ctx context.Context, item *batch.Item) error {
+		return s.delete(ctx, item.ID)
+	}, batch.WithStore(s.batches))
+
+	result := core.NewActionResult(err == nil && job.Count(batch.StatusFailed) == 0, job.Summary())
+	result.Data = job
+	return result
+}
+
+// delete deletes a policy or a service-linked role.
+func (s *Service) delete(ctx context.Context, arn string) error {
+	kind, name, err := parseARN(arn)
+	if err != nil {
+		return err
+	}
+	if kind == KindPolicy {
+		err = s.deletePolicy(ctx, arn)
+	} else {
+		err = s.deleteServiceLinkedRole(ctx, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   arn,
+		ResourceType: "iam:" + strings.ReplaceAll(kind, " ", "_"),
+	})
+	return nil
+}
+
+// deletePolicy deletes a policy after checking it is still attached to
+// nothing. IAM only deletes policies without other versions than the
+// default, so those are deleted first.
+func (s *Service) deletePolicy(ctx context.Context, arn string) error {
+	client := s.client()
+
+	out, err := client.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(arn)})
+	if err != nil {
+		return err
+	}
+	if out.Policy != nil && (aws.ToInt32(out.Policy.AttachmentCount) > 0 || aws.ToInt32(out.Policy.PermissionsBoundaryUsageCount) > 0) {
+		return core.NewValidationError("policy", aws.ToString(out.Policy.PolicyName), "has been attached since it was listed")
+	}
+
+	versions, err := client.ListPolicyVersions(ctx, &iam.ListPolicyVersionsInput{PolicyArn: aws.String(arn)})
+	if err != nil {
+		return err
+	}
+	for _, v := range versions.Versions {
+		if v.IsDefaultVersion {
+			continue
+		}
+		if _, err := client.DeletePolicyVersion(ctx, &iam.DeletePolicyVersionInput{PolicyArn: aws.String(arn), VersionId: v.VersionId}); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.DeletePolicy(ctx, &iam.DeletePolicyInput{PolicyArn: aws.String(arn)})
+	return err
+}
+
+// deleteServiceLinkedRole submits a role's deletion and waits for it. The
+// linked service refuses it while resources still use the role, and tells
+// which.
+func (s *Service) deleteServiceLinkedRole(ctx context.Context, name string) error {
+	client := s.client()
+
+	out, err := client.DeleteServiceLinkedRole(ctx, &iam.DeleteServiceLinkedRoleInput{RoleName: aws.String(name)})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deletionTimeout)
+	defer cancel()
+	for {
+		status, err := client.GetServiceLinkedRoleDeletionStatus(ctx, &iam.GetServiceLinkedRoleDeletionStatusInput{
+			DeletionTaskId: out.DeletionTaskId,
+		})
+		if err != nil {
+			return err
+		}
+		switch status.Status {
+		case types.DeletionTaskStatusTypeSucceeded:
+			return nil
+		case types.DeletionTaskStatusTypeFailed:
+			return deletionFailure(status.Reason)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deletion still running after %s; refresh to check", deletionTimeout)
+		case <-time.After(deletionPollInterval):
+		}
+	}
+}
+
+// deletionFailure explains why a service refused to delete its role.
+func deletionFailure(reason *types.DeletionTaskFailureReasonType) error {
+	if reason == nil {
+		return errors.New("the service refused to delete the role")
+	}
+	msg := aws.ToString(reason.Reason)
+	if msg == "" {
+		msg = "the service refused to delete the role"
+	}
+	var usage []string
+	for _, u := range reason.RoleUsageList {
+		usage = append(usage, u.Resources...)
+		if len(u.Resources) == 0 && u.Region != nil {
+			usage = append(usage, aws.ToString(u.Region))
+		}
+	}
+	if len(usage) > 0 {
+		msg += "; still used by " + strings.Join(usage, ", ")
+	}
+	return errors.New(msg)
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// parseARN returns the kind and name of a candidate from its ARN.
+func parseARN(arn string) (kind, name string, err error) {
+	parsed, err := core.ParseARN(arn)
+	if err != nil || parsed.Service != "iam" {
+		return "", "", core.NewValidationError("arn", arn, "is not an IAM ARN")
+	}
+	resource := parsed.Resource
+	name = resource[strings.LastIndex(resource, "/")+1:]
+	switch {
+	case strings.HasPrefix(resource, "policy/"):
+		return KindPolicy, name, nil
+	case strings.HasPrefix(resource, "role"+serviceLinkedPath):
+		return KindRole, name, nil
+	}
+	return "", "", core.NewValidationError("arn", arn, "is neither a customer managed policy nor a service-linked role")
+}
+
+// deletionReason describes what deleting a candidate does.
+func deletionReason(kind, name string) string {
+	if kind == KindPolicy {
+		return fmt.Sprintf("Deletes policy %s and all its versions; it cannot be restored", name)
+	}
+	return fmt.Sprintf("Asks the linked service to delete role %s; it refuses while resources still use the role", name)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "iamcleanup", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "iamcleanup", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ batch.Resumer       = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
@@ -0,0 +1,310 @@
+package iamcleanup
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	unusedPolicy   = "arn:aws:iam::123456789012:policy/deploy-2021"
+	attachedPolicy = "arn:aws:iam::123456789012:policy/ci"
+	beanstalkRole  = "arn:aws:iam::123456789012:role/aws-service-role/elasticbeanstalk.amazonaws.com/AWSServiceRoleForElasticBeanstalk"
+	supportRole    = "arn:aws:iam::123456789012:role/aws-service-role/support.amazonaws.com/AWSServiceRoleForSupport"
+)
+
+// fakeIAM serves three customer managed policies: deploy-2021, attached to
+// nothing and with three versions, ci, attached to two roles, and
+// boundary, used as a permissions boundary. Its service-linked roles are
+// those of Elastic Beanstalk, last used 200 days ago, ECS, used 3 days
+// ago, Support, never used in the 400 days since it was created, and
+// Config, created 10 days ago. A fifth role cannot be read. Elastic
+// Beanstalk refuses to delete its role while an environment uses it. It
+// fails every call when err is set and records what it deletes.
+type fakeIAM struct {
+	err            error
+	attachedSince  bool // deploy-2021 was attached after being listed
+	deletedVersion []string
+	deleted        []string
+}
+
+// daysAgo returns the time days and a half ago, which lists as days ago
+// however long the test takes.
+func daysAgo(days int) *time.Time {
+	t := time.Now().Add(-time.Duration(days)*24*time.Hour - 12*time.Hour)
+	return &t
+}
+
+func (f *fakeIAM) policies() []types.Policy {
+	policy := func(name string, attachments, boundaries int32) types.Policy {
+		return types.Policy{
+			Arn:                           aws.String("arn:aws:iam::123456789012:policy/" + name),
+			PolicyName:                    aws.String(name),
+			Path:                          aws.String("/"),
+			DefaultVersionId:              aws.String("v3"),
+			AttachmentCount:               aws.Int32(attachments),
+			PermissionsBoundaryUsageCount: aws.Int32(boundaries),
+			CreateDate:                    daysAgo(900),
+		}
+	}
+	return []types.Policy{policy("deploy-2021", 0, 0), policy("ci", 2, 0), policy("boundary", 0, 1)}
+}
+
+func (f *fakeIAM) ListPolicies(context.Context, *iam.ListPoliciesInput, ...func(*iam.Options)) (*iam.ListPoliciesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListPoliciesOutput{Policies: f.policies()}, nil
+}
+
+func (f *fakeIAM) GetPolicy(_ context.Context, in *iam.GetPolicyInput, _ ...func(*iam.Options)) (*iam.GetPolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, policy := range f.policies() {
+		if aws.ToString(policy.Arn) == aws.ToString(in.PolicyArn) {
+			if f.attachedSince {
+				policy.AttachmentCount = aws.Int32(1)
+			}
+			return &iam.GetPolicyOutput{Policy: &policy}, nil
+		}
+	}
+	return nil, errors.New("NoSuchEntity")
+}
+
+func (f *fakeIAM) ListPolicyVersions(context.Context, *iam.ListPolicyVersionsInput, ...func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.ListPolicyVersionsOutput{Versions: []types.PolicyVersion{
+		{VersionId: aws.String("v3"), IsDefaultVersion: true},
+		{VersionId: aws.String("v2")},
+		{VersionId: aws.String("v1")},
+	}}, nil
+}
+
+func (f *fakeIAM) DeletePolicyVersion(_ context.Context, in *iam.DeletePolicyVersionInput, _ ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deletedVersion = append(f.deletedVersion, aws.ToString(in.VersionId))
+	return &iam.DeletePolicyVersionOutput{}, nil
+}
+
+func (f *fakeIAM) DeletePolicy(_ context.Context, in *iam.DeletePolicyInput, _ ...func(*iam.Options)) (*iam.DeletePolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.PolicyArn))
+	return &iam.DeletePolicyOutput{}, nil
+}
+
+func (f *fakeIAM) ListRoles(context.Context, *iam.ListRolesInput, ...func(*iam.Options)) (*iam.ListRolesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var roles []types.Role
+	for _, name := range []string{"AWSServiceRoleForElasticBeanstalk", "AWSServiceRoleForECS", "AWSServiceRoleForSupport", "AWSServiceRoleForConfig", "AWSServiceRoleForGone"} {
+		roles = append(roles, types.Role{RoleName: aws.String(name)})
+	}
+	return &iam.ListRolesOutput{Roles: roles}, nil
+}
+
+func (f *fakeIAM) GetRole(_ context.Context, in *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	role := func(service string, created int, lastUsed *time.Time) *types.Role {
+		r := &types.Role{
+			RoleName:   in.RoleName,
+			Arn:        aws.String("arn:aws:iam::123456789012:role/aws-service-role/" + service + "/" + aws.ToString(in.RoleName)),
+			Path:       aws.String("/aws-service-role/" + service + "/"),
+			CreateDate: daysAgo(created),
+		}
+		if lastUsed != nil {
+			r.RoleLastUsed = &types.RoleLastUsed{LastUsedDate: lastUsed, Region: aws.String("eu-west-1")}
+		}
+		return r
+	}
+	switch aws.ToString(in.RoleName) {
+	case "AWSServiceRoleForElasticBeanstalk":
+		return &iam.GetRoleOutput{Role: role("elasticbeanstalk.amazonaws.com", 700, daysAgo(200))}, nil
+	case "AWSServiceRoleForECS":
+		return &iam.GetRoleOutput{Role: role("ecs.amazonaws.com", 700, daysAgo(3))}, nil
+	case "AWSServiceRoleForSupport":
+		return &iam.GetRoleOutput{Role: role("support.amazonaws.com", 400, nil)}, nil
+	case "AWSServiceRoleForConfig":
+		return &iam.GetRoleOutput{Role: role("config.amazonaws.com", 10, nil)}, nil
+	}
+	return nil, errors.New("NoSuchEntity")
+}
+
+func (f *fakeIAM) DeleteServiceLinkedRole(_ context.Context, in *iam.DeleteServiceLinkedRoleInput, _ ...func(*iam.Options)) (*iam.DeleteServiceLinkedRoleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &iam.DeleteServiceLinkedRoleOutput{DeletionTaskId: in.RoleName}, nil
+}
+
+func (f *fakeIAM) GetServiceLinkedRoleDeletionStatus(_ context.Context, in *iam.GetServiceLinkedRoleDeletionStatusInput, _ ...func(*iam.Options)) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.DeletionTaskId) == "AWSServiceRoleForElasticBeanstalk" {
+		return &iam.GetServiceLinkedRoleDeletionStatusOutput{
+			Status: types.DeletionTaskStatusTypeFailed,
+			Reason: &types.DeletionTaskFailureReasonType{
+				Reason: aws.String("Role is in use"),
+				RoleUsageList: []types.RoleUsageType{{
+					Region:    aws.String("eu-west-1"),
+					Resources: []string{"arn:aws:elasticbeanstalk:eu-west-1:123456789012:environment/shop/prod"},
+				}},
+			},
+		}, nil
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.DeletionTaskId))
+	return &iam.GetServiceLinkedRoleDeletionStatusOutput{Status: types.DeletionTaskStatusTypeSucceeded}, nil
+}
+
+// TestServiceConformance runs the core service contract against cleanup
+// candidates.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewService(nil, d, WithClient(&fakeIAM{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewService(nil, d, WithClient(&fakeIAM{err: errors.New("AccessDenied")}))
+		},
+		ExistingID:    unusedPolicy,
+		MissingID:     attachedPolicy,
+		Action:        "delete",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "delete",
+	})
+}
+
+func TestListCandidates(t *testing.T) {
+	tests := []struct {
+		threshold time.Duration
+		want      map[string]string // Issue of each candidate, by name
+	}{
+		{
+			threshold: DefaultUnusedThreshold,
+			want: map[string]string{
+				"deploy-2021":                       "Customer managed policy attached to no user, group or role",
+				"AWSServiceRoleForElasticBeanstalk": "Service-linked role of elasticbeanstalk.amazonaws.com: not used in 200 days",
+				"AWSServiceRoleForSupport":          "Service-linked role of support.amazonaws.com: never used (created 400 days ago)",
+			},
+		},
+		{
+			threshold: 365 * 24 * time.Hour,
+			want: map[string]string{
+				"deploy-2021":              "Customer managed policy attached to no user, group or role",
+				"AWSServiceRoleForSupport": "Service-linked role of support.amazonaws.com: never used (created 400 days ago)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		resources, err := NewService(nil, nil, WithClient(&fakeIAM{}), WithUnusedThreshold(tt.threshold)).List(context.Background(), core.ListOptions{})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		got := make(map[string]string)
+		for _, r := range resources {
+			if issues := r.Issues(); len(issues) == 1 {
+				got[r.Name] = issues[0].Message
+			}
+		}
+		if len(got) != len(tt.want) || len(resources) != len(tt.want) {
+			t.Errorf("threshold %v: candidates = %v, want %v", tt.threshold, got, tt.want)
+			continue
+		}
+		for name, issue := range tt.want {
+			if got[name] != issue {
+				t.Errorf("threshold %v: %s issue = %q, want %q", tt.threshold, name, got[name], issue)
+			}
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	confirm := map[string]any{core.ParamConfirm: true}
+
+	fake := &fakeIAM{}
+	svc := NewService(nil, nil, WithClient(fake))
+	if _, err := svc.Execute(context.Background(), "delete", unusedPolicy, confirm); err != nil {
+		t.Fatalf("delete policy error = %v", err)
+	}
+	if !slices.Equal(fake.deletedVersion, []string{"v2", "v1"}) || !slices.Equal(fake.deleted, []string{unusedPolicy}) {
+		t.Errorf("deleted versions %v, then %v", fake.deletedVersion, fake.deleted)
+	}
+
+	fake.attachedSince = true
+	var validation *core.ValidationError
+	if _, err := svc.Execute(context.Background(), "delete", unusedPolicy, confirm); !errors.As(err, &validation) {
+		t.Errorf("delete of a policy attached since error = %v", err)
+	}
+
+	_, err := svc.Execute(context.Background(), "delete", beanstalkRole, confirm)
+	if err == nil || !strings.Contains(err.Error(), "Role is in use; still used by arn:aws:elasticbeanstalk:eu-west-1:123456789012:environment/shop/prod") {
+		t.Errorf("delete of a role in use error = %v", err)
+	}
+
+	if _, err := svc.Execute(context.Background(), "delete", "arn:aws:iam::123456789012:role/admin", confirm); !errors.As(err, &validation) {
+		t.Errorf("delete of a regular role error = %v", err)
+	}
+}
+
+// TestResumeBatch checks that an interrupted batch deletion is resumed from
+// the store, skipping the candidates it already deleted.
+func TestResumeBatch(t *testing.T) {
+	store := batch.NewStore(filepath.Join(t.TempDir(), "batches.json"))
+	fake := &fakeIAM{}
+	svc := NewService(nil, nil, WithClient(fake), WithBatchStore(store))
+
+	job := batch.NewJob(svc.batchScope(), "delete_batch", []batch.Item{
+		{ID: unusedPolicy, Name: "deploy-2021", Kind: KindPolicy},
+		{ID: supportRole, Name: "AWSServiceRoleForSupport", Kind: KindRole},
+		{ID: beanstalkRole, Name: "AWSServiceRoleForElasticBeanstalk", Kind: KindRole},
+	}, nil)
+	job.Items[0].Status = batch.StatusDone
+	if err := store.Save(job); err != nil {
+		t.Fatal(err)
+	}
+	if svc.PendingBatch() == nil {
+		t.Fatal("PendingBatch() = nil, want the saved batch")
+	}
+
+	_, err := svc.Execute(context.Background(), "resume_batch", "marked", nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.HasSuffix(confirm.Reason, ": 2 of 3 candidates are left") {
+		t.Fatalf("unconfirmed resume_batch error = %v", err)
+	}
+
+	result, err := svc.Execute(context.Background(), "resume_batch", "marked", map[string]any{core.ParamConfirm: true})
+	if err != nil {
+		t.Fatalf("resume_batch error = %v", err)
+	}
+	if result.Success || result.Message != "Deleted 2 of 3, 1 failed" {
+		t.Errorf("resume_batch = %q, success %v", result.Message, result.Success)
+	}
+	if !slices.Equal(fake.deleted, []string{"AWSServiceRoleForSupport"}) {
+		t.Errorf("deleted %v", fake.deleted)
+	}
+	if svc.PendingBatch() != nil {
+		t.Error("batch still pending once finished")
+	}
+}
@@ -0,0 +1,370 @@
+package iamcleanup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for IAM cleanup candidates.
+type View struct {
+	*base.TableView
+
+	marked  map[string]bool // ARNs marked for a batch deletion
+	pending *batch.Job      // Interrupted batch deletion, as of the last listing
+}
+
+// NewView creates a new IAM cleanup view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: "", MinWidth: 2, MaxWidth: 2, Weight: 0.1, Priority: 0},
+		{Title: i18n.T("Kind"), MinWidth: 8, MaxWidth: 19, Weight: 0.4, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Service"), MinWidth: 10, MaxWidth: 40, Weight: 0.8, Priority: 2},
+		{Title: i18n.T("Last Used"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Reason"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 2},
+	}
+
+	return &View{
+		TableView: base.NewTableView("IAM Cleanup", "", "iamcleanup", columnDefs),
+		marked:    make(map[string]bool),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadCandidates()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case " ":
+			if row := v.GetSelectedResource(); row != nil {
+				v.marked[row.ID] = !v.marked[row.ID]
+				if !v.marked[row.ID] {
+					delete(v.marked, row.ID)
+				}
+				v.updateTable()
+			}
+			// Space also pages down in tables
+			return v, nil
+		case "a":
+			if len(v.marked) > 0 {
+				v.marked = make(map[string]bool)
+			} else {
+				for _, r := range v.Resources {
+					v.marked[r.ID] = true
+				}
+			}
+			v.updateTable()
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "D":
+			ids := v.markedIDs()
+			if len(ids) == 0 {
+				v.Message = i18n.T("Mark candidates with space first")
+				break
+			}
+			label := i18n.T("%d marked", len(ids))
+			return v, v.executeAction("delete_batch", label, map[string]any{"ids": strings.Join(ids, ",")})
+		case "R":
+			if job := v.PendingBatch(); job != nil {
+				label := i18n.T("%d left", job.Count(batch.StatusPending))
+				return v, v.executeAction("resume_batch", label, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Candidate %s", row.Name), formatCandidate(row))
+			}
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if job, ok := msg.Result.Data.(*batch.Job); ok {
+			v.OpenDetail(msg.Result.Message, base.FormatBatchReport(job))
+		}
+		v.marked = make(map[string]bool)
+		return v, v.loadCandidates()
+
+	case candidatesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+			break
+		}
+		v.SetError(nil)
+		v.Resources = msg.resources
+		v.pruneMarks()
+		v.pending = v.PendingBatch()
+		v.updateTable()
+		if v.Message == "" {
+			v.Message = i18n.T("Found %d cleanup candidates", len(msg.resources))
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Looking for unused policies and roles...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[space]mark  [a]ll/none  [x] delete  [D]elete marked  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh looks for candidates again.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadCandidates()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type candidatesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadCandidates() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return candidatesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return candidatesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return candidatesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// markedIDs returns the marked candidates in table order.
+func (v *View) markedIDs() []string {
+	var ids []string
+	for _, r := range v.Resources {
+		if v.marked[r.ID] {
+			ids = append(ids, r.ID)
+		}
+	}
+	return ids
+}
+
+// pruneMarks drops the marks of candidates no longer listed.
+func (v *View) pruneMarks() {
+	listed := make(map[string]bool, len(v.Resources))
+	for _, r := range v.Resources {
+		listed[r.ID] = true
+	}
+	for id := range v.marked {
+		if !listed[id] {
+			delete(v.marked, id)
+		}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		r := v.Resources[i]
+		mark := ""
+		if v.marked[r.ID] {
+			mark = "✓"
+		}
+		return append(base.Row{base.TextCell(mark)}, buildRow(r)...)
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	service := r.GetMetadataString("service")
+	if service == "" {
+		service = r.GetMetadataString("path")
+	}
+	lastUsed := r.GetMetadataString("last_used")
+	if lastUsed == "" {
+		lastUsed = "-"
+	}
+	return base.Row{
+		base.TextCell(r.GetMetadataString("kind")),
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(service),
+		base.TextCell(lastUsed),
+		base.AgeCell(r),
+		base.TextCell(r.GetMetadataString("reason")),
+	}
+}
+
+// formatCandidate renders a candidate for the detail panel.
+func formatCandidate(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Kind:      %s\n", r.GetMetadataString("kind"))
+	fmt.Fprintf(&b, "ARN:       %s\n", r.ARN)
+	fmt.Fprintf(&b, "Path:      %s\n", r.GetMetadataString("path"))
+	if service := r.GetMetadataString("service"); service != "" {
+		fmt.Fprintf(&b, "Service:   %s\n", service)
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:   %s\n", r.CreatedAt.Local().Format("2006-01-02"))
+	}
+	if r.UpdatedAt != nil {
+		fmt.Fprintf(&b, "Updated:   %s\n", r.UpdatedAt.Local().Format("2006-01-02"))
+	}
+	if version := r.GetMetadataString("default_version"); version != "" {
+		fmt.Fprintf(&b, "Version:   %s\n", version)
+	}
+	if lastUsed := r.GetMetadataString("last_used"); lastUsed != "" {
+		fmt.Fprintf(&b, "Last used: %s", lastUsed)
+		if region := r.GetMetadataString("last_used_region"); region != "" {
+			fmt.Fprintf(&b, " in %s", region)
+		}
+		b.WriteString("\n")
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	policies, roles := 0, 0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("kind") == KindPolicy {
+			policies++
+		} else {
+			roles++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "policies", Text: i18n.T("Unattached policies: %d", policies), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "roles", Text: i18n.T("Unused service-linked roles: %d", roles), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "marked", Text: i18n.T("Marked: %d", len(v.marked)), Tone: core.ToneInfo},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("IAM Cleanup"), v.SummaryWidgets()) + v.renderPendingBatch()
+}
+
+// renderPendingBatch names how many items an interrupted batch has left.
+func (v *View) renderPendingBatch() string {
+	if v.pending == nil {
+		return ""
+	}
+	return "  " + v.Styles.Warning.Render(i18n.T("Interrupted batch: %d left, [R] resumes", v.pending.Count(batch.StatusPending)))
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "iamcleanup" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,135 @@
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Key Policy Assessment
+// =============================================================================
+
+// PolicyFinding is a risky statement of a key policy.
+type PolicyFinding struct {
+	Severity core.Severity
+	Sid      string
+	Message  string
+}
+
+// statement is a key policy statement. Principal, Action and their
+// negations may each be a string, a list or, for Principal, a map.
+type statement struct {
+	Sid       string          `json:"Sid"`
+	Effect    string          `json:"Effect"`
+	Principal json.RawMessage `json:"Principal"`
+	Action    json.RawMessage `json:"Action"`
+	NotAction json.RawMessage `json:"NotAction"`
+	Condition json.RawMessage `json:"Condition"`
+}
+
+// assessPolicy reports the Allow statements of a key policy that open the
+// key to any principal, critical without conditions and medium with them,
+// and those granting every KMS action to principals other than the account
+// root, high. The root grant is the default policy, which delegates access
+// to IAM.
+func assessPolicy(document, account string) ([]PolicyFinding, error) {
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("invalid key policy: %w", err)
+	}
+	var statements []statement
+	if err := json.Unmarshal(policy.Statement, &statements); err != nil {
+		var single statement
+		if err := json.Unmarshal(policy.Statement, &single); err != nil {
+			return nil, fmt.Errorf("invalid key policy statements: %w", err)
+		}
+		statements = []statement{single}
+	}
+
+	var findings []PolicyFinding
+	for i, st := range statements {
+		if !strings.EqualFold(st.Effect, "Allow") {
+			continue
+		}
+		sid := st.Sid
+		if sid == "" {
+			sid = fmt.Sprintf("#%d", i+1)
+		}
+		principals := principalsOf(st.Principal)
+		conditioned := len(st.Condition) > 0 && string(st.Condition) != "null" && string(st.Condition) != "{}"
+
+		if slices.Contains(principals, "*") {
+			if conditioned {
+				findings = append(findings, PolicyFinding{core.SeverityMedium, sid, "Allows any principal, limited by conditions"})
+			} else {
+				findings = append(findings, PolicyFinding{core.SeverityCritical, sid, "Allows any principal"})
+			}
+			continue
+		}
+
+		actions := stringsOf(st.Action)
+		if !slices.ContainsFunc(actions, isFullAccess) && len(st.NotAction) == 0 {
+			continue
+		}
+		var others []string
+		for _, p := range principals {
+			if !isAccountRoot(p, account) {
+				others = append(others, p)
+			}
+		}
+		if len(others) > 0 {
+			findings = append(findings, PolicyFinding{core.SeverityHigh, sid, fmt.Sprintf("Grants kms:* to %s", strings.Join(others, ", "))})
+		}
+	}
+	return findings, nil
+}
+
+// principalsOf flattens a Principal element: "*", {"AWS": ...} or
+// {"Service": ...}.
+func principalsOf(raw json.RawMessage) []string {
+	if s := stringsOf(raw); s != nil {
+		return s
+	}
+	var byType map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byType); err != nil {
+		return nil
+	}
+	var out []string
+	for _, values := range byType {
+		out = append(out, stringsOf(values)...)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// stringsOf reads a policy element given as a string or a list of strings.
+func stringsOf(raw json.RawMessage) []string {
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}
+	}
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		return many
+	}
+	return nil
+}
+
+// isFullAccess reports whether an action pattern matches every KMS action.
+func isFullAccess(action string) bool {
+	return action == "*" || strings.EqualFold(action, "kms:*")
+}
+
+// isAccountRoot reports whether a principal is the key's own account.
+func isAccountRoot(principal, account string) bool {
+	if account == "" {
+		return false
+	}
+	return principal == account || strings.HasSuffix(principal, ":"+account+":root")
+}
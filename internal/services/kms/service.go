@@ -0,0 +1,577 @@
+// Package kms provides KMS integration for the a9s application. It lists
+// keys with their state and rotation, audits key policies for wildcard
+// principals and grants, enables rotation and schedules deletions.
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// pricePerKey is the monthly price of a customer managed key, as in
+// us-east-1. AWS managed keys are free.
+const pricePerKey = 1.0
+
+// Rotation periods KMS accepts, in days.
+const (
+	defaultRotationDays = 365
+	minRotationDays     = 90
+	maxRotationDays     = 2560
+)
+
+// Waiting periods KMS accepts before deleting a key, in days.
+const (
+	defaultPendingDays = 30
+	minPendingDays     = 7
+	maxPendingDays     = 30
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements KMS operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient KMSAPI
+}
+
+// KMSAPI defines the KMS client interface for mocking.
+type KMSAPI interface {
+	ListKeys(ctx context.Context, params *kms.ListKeysInput, optFns ...func(*kms.Options)) (*kms.ListKeysOutput, error)
+	ListAliases(ctx context.Context, params *kms.ListAliasesInput, optFns ...func(*kms.Options)) (*kms.ListAliasesOutput, error)
+	DescribeKey(ctx context.Context, params *kms.DescribeKeyInput, optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+	GetKeyRotationStatus(ctx context.Context, params *kms.GetKeyRotationStatusInput, optFns ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error)
+	GetKeyPolicy(ctx context.Context, params *kms.GetKeyPolicyInput, optFns ...func(*kms.Options)) (*kms.GetKeyPolicyOutput, error)
+	EnableKeyRotation(ctx context.Context, params *kms.EnableKeyRotationInput, optFns ...func(*kms.Options)) (*kms.EnableKeyRotationOutput, error)
+	ScheduleKeyDeletion(ctx context.Context, params *kms.ScheduleKeyDeletionInput, optFns ...func(*kms.Options)) (*kms.ScheduleKeyDeletionOutput, error)
+}
+
+// NewService creates a new KMS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client KMSAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the KMS client for the current AWS context.
+func (s *Service) client() KMSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return kms.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region keys are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "kms"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "KMS Keys"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "key"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListKeys(ctx, &kms.ListKeysInput{Limit: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("kms", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the keys of the region with their aliases and state.
+// ListKeys carries no state, so every key is described. Rotation and the
+// key policy are only known once analyzed.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	client := s.client()
+
+	aliases, err := s.aliases(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("kms", "list", err)
+	}
+
+	resources := make([]core.Resource, 0)
+	paginator := kms.NewListKeysPaginator(client, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("kms", "list", err)
+		}
+		for _, key := range page.Keys {
+			out, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
+			if err != nil || out.KeyMetadata == nil {
+				// Keys of other accounts' grants cannot be described
+				continue
+			}
+			resources = append(resources, s.keyToResource(*out.KeyMetadata, aliases[aws.ToString(key.KeyId)], now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "kms:key",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// aliases returns the alias names of the region's keys, by key ID.
+func (s *Service) aliases(ctx context.Context) (map[string][]string, error) {
+	aliases := make(map[string][]string)
+	paginator := kms.NewListAliasesPaginator(s.client(), &kms.ListAliasesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, alias := range page.Aliases {
+			if id := aws.ToString(alias.TargetKeyId); id != "" {
+				aliases[id] = append(aliases[id], aws.ToString(alias.AliasName))
+			}
+		}
+	}
+	for id := range aliases {
+		slices.Sort(aliases[id])
+	}
+	return aliases, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource reads the rotation status of keys that can rotate and
+// audits the key policy of customer managed keys. Policies open to any
+// principal are flagged critical, kms:* granted beyond the account high,
+// and customer managed symmetric keys without rotation medium.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	client := s.client()
+	id := aws.String(resource.ID)
+	state := resource.GetMetadataString("key_state")
+
+	if rotatable, _ := resource.Metadata["rotatable"].(bool); rotatable && state != string(types.KeyStatePendingDeletion) {
+		out, err := client.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: id})
+		if err != nil {
+			return err
+		}
+		resource.Metadata["rotation_enabled"] = out.KeyRotationEnabled
+		if days := aws.ToInt32(out.RotationPeriodInDays); days > 0 {
+			resource.Metadata["rotation_days"] = days
+		}
+		if out.NextRotationDate != nil {
+			resource.Metadata["next_rotation"] = *out.NextRotationDate
+		}
+	}
+
+	var findings []PolicyFinding
+	if resource.GetMetadataString("key_manager") == "customer" {
+		out, err := client.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{KeyId: id})
+		if err != nil {
+			return err
+		}
+		findings, err = assessPolicy(aws.ToString(out.Policy), resource.GetMetadataString("account"))
+		if err != nil {
+			return err
+		}
+	}
+	resource.Metadata["policy_findings"] = findings
+
+	resource.Metadata["analyzed"] = true
+	addIssues(resource)
+	compliance.Apply(resource, keyChecks(*resource))
+	return nil
+}
+
+// CheckCompliance implements compliance.Checker. The key is analyzed to
+// know its rotation and policy.
+func (s *Service) CheckCompliance(ctx context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	if analyzed, _ := resource.Metadata["analyzed"].(bool); !analyzed {
+		resource.ClearIssues()
+		if err := s.EnrichResource(ctx, resource); err != nil {
+			return nil, core.NewServiceError("kms", "compliance", err)
+		}
+	}
+	return compliance.Failed(*resource), nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for keys.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "policy",
+			Description: "View the key policy and its risky statements",
+			Icon:        "shield",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "security",
+		},
+		{
+			Name:        "enable_rotation",
+			Description: "Enable automatic rotation of the key material",
+			Icon:        "refresh",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "security",
+			Parameters: []core.ActionParameter{
+				{Name: "rotation_days", Type: "int", Default: defaultRotationDays, Description: "Days between rotations (90 to 2560)"},
+			},
+		},
+		{
+			Name:        "schedule_deletion",
+			Description: "Schedule the deletion of the key",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "pending_days", Type: "int", Default: defaultPendingDays, Description: "Days to wait before deleting the key (7 to 30)"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a key, identified by its ID.
+// Scheduling a deletion asks for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "policy":
+		result, err = s.policy(ctx, resourceID)
+	case "enable_rotation":
+		days := defaultRotationDays
+		if v, ok := params["rotation_days"]; ok {
+			if days, err = intParam(v); err != nil || days < minRotationDays || days > maxRotationDays {
+				return nil, core.NewValidationError("rotation_days", v, "must be between 90 and 2560 days")
+			}
+		}
+		result, err = s.enableRotation(ctx, resourceID, days)
+	case "schedule_deletion":
+		days := defaultPendingDays
+		if v, ok := params["pending_days"]; ok {
+			if days, err = intParam(v); err != nil || days < minPendingDays || days > maxPendingDays {
+				return nil, core.NewValidationError("pending_days", v, "must be between 7 and 30 days")
+			}
+		}
+		if !core.Confirmed(params, resourceID, true) {
+			reason := fmt.Sprintf("Data encrypted under the key can no longer be decrypted once it is deleted in %d days; the deletion can be canceled until then", days)
+			return nil, core.NewConfirmationError(s, action, resourceID, params, reason, true)
+		}
+		result, err = s.scheduleDeletion(ctx, resourceID, days)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// KeyPolicy is the result data of the policy action.
+type KeyPolicy struct {
+	KeyID    string
+	Document string // Indented JSON
+	Findings []PolicyFinding
+}
+
+// policy reads and audits the key policy of a key.
+func (s *Service) policy(ctx context.Context, keyID string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("policy", keyID, err)
+	}
+
+	described, err := s.client().DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return fail(err)
+	}
+	out, err := s.client().GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return fail(err)
+	}
+
+	document := aws.ToString(out.Policy)
+	findings, err := assessPolicy(document, aws.ToString(described.KeyMetadata.AWSAccountId))
+	if err != nil {
+		return fail(err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Key policy of %s: %d risky statements", keyID, len(findings)))
+	result.Data = KeyPolicy{KeyID: keyID, Document: indentJSON(document), Findings: findings}
+	return result, nil
+}
+
+// enableRotation turns on automatic rotation every days days.
+func (s *Service) enableRotation(ctx context.Context, keyID string, days int) (*core.ActionResult, error) {
+	if _, err := s.client().EnableKeyRotation(ctx, &kms.EnableKeyRotationInput{
+		KeyId:                aws.String(keyID),
+		RotationPeriodInDays: aws.Int32(int32(days)),
+	}); err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("enable_rotation", keyID, err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceUpdated, core.ResourceEventData{
+		ResourceID:   keyID,
+		ResourceType: "kms:key",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Rotation of %s enabled every %d days", keyID, days)), nil
+}
+
+// scheduleDeletion schedules the deletion of a key after a waiting period.
+func (s *Service) scheduleDeletion(ctx context.Context, keyID string, days int) (*core.ActionResult, error) {
+	out, err := s.client().ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyID),
+		PendingWindowInDays: aws.Int32(int32(days)),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("schedule_deletion", keyID, err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceUpdated, core.ResourceEventData{
+		ResourceID:   keyID,
+		ResourceType: "kms:key",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Key %s will be deleted on %s",
+		keyID, aws.ToTime(out.DeletionDate).Local().Format("2006-01-02"))), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) keyToResource(meta types.KeyMetadata, aliases []string, now time.Time) core.Resource {
+	id := aws.ToString(meta.KeyId)
+	name := id
+	if len(aliases) > 0 {
+		name = strings.TrimPrefix(aliases[0], "alias/")
+	}
+	manager := strings.ToLower(string(meta.KeyManager))
+
+	resource := core.Resource{
+		ID:        id,
+		Type:      "kms:key",
+		Name:      name,
+		ARN:       aws.ToString(meta.Arn),
+		State:     strings.ToLower(string(meta.KeyState)),
+		Region:    s.region(),
+		CreatedAt: meta.CreationDate,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"aliases":      aliases,
+			"description":  aws.ToString(meta.Description),
+			"account":      aws.ToString(meta.AWSAccountId),
+			"key_state":    string(meta.KeyState),
+			"key_manager":  manager,
+			"key_spec":     string(meta.KeySpec),
+			"key_usage":    string(meta.KeyUsage),
+			"origin":       string(meta.Origin),
+			"multi_region": aws.ToBool(meta.MultiRegion),
+			// Only symmetric keys with key material from KMS rotate
+			"rotatable": meta.KeySpec == types.KeySpecSymmetricDefault && meta.Origin == types.OriginTypeAwsKms && meta.CustomKeyStoreId == nil,
+		},
+	}
+	if meta.DeletionDate != nil {
+		resource.Metadata["deletion_date"] = *meta.DeletionDate
+	}
+
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+	if manager == "customer" && meta.KeyState != types.KeyStatePendingDeletion {
+		estimate.ApplyCost(&resource, pricePerKey)
+	}
+	addIssues(&resource)
+	return resource
+}
+
+// addIssues records a key's issues from what is known of it: its state
+// when listed, then its rotation and policy once analyzed.
+func addIssues(resource *core.Resource) {
+	switch resource.GetMetadataString("key_state") {
+	case string(types.KeyStatePendingDeletion):
+		if at, ok := resource.Metadata["deletion_date"].(time.Time); ok {
+			resource.AddIssue(core.SeverityInfo, fmt.Sprintf("Scheduled for deletion on %s", at.Local().Format("2006-01-02")))
+		}
+		return
+	case string(types.KeyStateDisabled):
+		if resource.GetMetadataString("key_manager") == "customer" {
+			resource.AddIssue(core.SeverityLow, "Disabled but still billed")
+		}
+	}
+
+	if needsRotation(*resource) {
+		resource.AddIssue(core.SeverityMedium, "Automatic rotation disabled")
+	}
+	findings, _ := resource.Metadata["policy_findings"].([]PolicyFinding)
+	for _, f := range findings {
+		resource.AddIssue(f.Severity, fmt.Sprintf("Key policy statement %s: %s", f.Sid, f.Message))
+	}
+}
+
+// needsRotation reports whether an analyzed customer managed key that can
+// rotate does not.
+func needsRotation(r core.Resource) bool {
+	rotatable, _ := r.Metadata["rotatable"].(bool)
+	enabled, known := r.Metadata["rotation_enabled"].(bool)
+	return rotatable && known && !enabled && r.GetMetadataString("key_manager") == "customer"
+}
+
+// keyChecks returns the checks an analyzed key fails.
+func keyChecks(r core.Resource) []compliance.Check {
+	var failed []compliance.Check
+	if needsRotation(r) && r.GetMetadataString("key_state") == string(types.KeyStateEnabled) {
+		failed = append(failed, compliance.CheckKMSRotation)
+	}
+	findings, _ := r.Metadata["policy_findings"].([]PolicyFinding)
+	if slices.ContainsFunc(findings, func(f PolicyFinding) bool { return f.Severity == core.SeverityCritical }) {
+		failed = append(failed, compliance.CheckKMSPublic)
+	}
+	return failed
+}
+
+// indentJSON indents a JSON document, or returns it as is when invalid.
+func indentJSON(document string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(document), "", "  "); err != nil {
+		return document
+	}
+	return out.String()
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "kms", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "kms", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+	_ compliance.Checker    = (*Service)(nil)
+)
@@ -0,0 +1,207 @@
+package kms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	testKey     = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	testAccount = "123456789012"
+)
+
+// defaultPolicy is the key policy KMS creates: the account root may do
+// anything, which delegates access to IAM.
+const defaultPolicy = `{"Statement": [{"Sid": "Enable IAM User Permissions", "Effect": "Allow",
+	"Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "kms:*", "Resource": "*"}]}`
+
+// fakeKMS serves the orders customer key, with the default policy and
+// rotation disabled, or fails every call when err is set. It records the
+// pending windows of the deletions scheduled.
+type fakeKMS struct {
+	err       error
+	scheduled []int32
+}
+
+func (f *fakeKMS) ListKeys(context.Context, *kms.ListKeysInput, ...func(*kms.Options)) (*kms.ListKeysOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.ListKeysOutput{Keys: []types.KeyListEntry{{KeyId: aws.String(testKey)}}}, nil
+}
+
+func (f *fakeKMS) ListAliases(context.Context, *kms.ListAliasesInput, ...func(*kms.Options)) (*kms.ListAliasesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.ListAliasesOutput{Aliases: []types.AliasListEntry{
+		{AliasName: aws.String("alias/orders"), TargetKeyId: aws.String(testKey)},
+		{AliasName: aws.String("alias/aws/s3")},
+	}}, nil
+}
+
+func (f *fakeKMS) DescribeKey(_ context.Context, in *kms.DescribeKeyInput, _ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.KeyId) != testKey {
+		return nil, &types.NotFoundException{Message: aws.String("key not found")}
+	}
+	return &kms.DescribeKeyOutput{KeyMetadata: &types.KeyMetadata{
+		KeyId:        aws.String(testKey),
+		Arn:          aws.String("arn:aws:kms:us-east-1:123456789012:key/" + testKey),
+		AWSAccountId: aws.String(testAccount),
+		KeyState:     types.KeyStateEnabled,
+		KeyManager:   types.KeyManagerTypeCustomer,
+		KeySpec:      types.KeySpecSymmetricDefault,
+		KeyUsage:     types.KeyUsageTypeEncryptDecrypt,
+		Origin:       types.OriginTypeAwsKms,
+	}}, nil
+}
+
+func (f *fakeKMS) GetKeyRotationStatus(context.Context, *kms.GetKeyRotationStatusInput, ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: false}, nil
+}
+
+func (f *fakeKMS) GetKeyPolicy(context.Context, *kms.GetKeyPolicyInput, ...func(*kms.Options)) (*kms.GetKeyPolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.GetKeyPolicyOutput{Policy: aws.String(defaultPolicy)}, nil
+}
+
+func (f *fakeKMS) EnableKeyRotation(context.Context, *kms.EnableKeyRotationInput, ...func(*kms.Options)) (*kms.EnableKeyRotationOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.EnableKeyRotationOutput{}, nil
+}
+
+func (f *fakeKMS) ScheduleKeyDeletion(_ context.Context, in *kms.ScheduleKeyDeletionInput, _ ...func(*kms.Options)) (*kms.ScheduleKeyDeletionOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.scheduled = append(f.scheduled, aws.ToInt32(in.PendingWindowInDays))
+	return &kms.ScheduleKeyDeletionOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against keys.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeKMS{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeKMS{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    testKey,
+		MissingID:     "00000000-0000-0000-0000-000000000000",
+		Action:        "enable_rotation",
+		ActionParams:  map[string]any{"rotation_days": "180"},
+		ConfirmAction: "schedule_deletion",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestAssessPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     []PolicyFinding
+	}{
+		{"default", defaultPolicy, nil},
+		{
+			"public",
+			`{"Statement": {"Sid": "Open", "Effect": "Allow", "Principal": "*", "Action": "kms:Decrypt"}}`,
+			[]PolicyFinding{{core.SeverityCritical, "Open", "Allows any principal"}},
+		},
+		{
+			"public within the organization",
+			`{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "kms:Decrypt",
+				"Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-1"}}}]}`,
+			[]PolicyFinding{{core.SeverityMedium, "#1", "Limited by conditions"}},
+		},
+		{
+			"full access to a role",
+			`{"Statement": [{"Sid": "Admin", "Effect": "Allow",
+				"Principal": {"AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:role/admin"]}, "Action": "*"}]}`,
+			[]PolicyFinding{{core.SeverityHigh, "Admin", "Grants kms:* to arn:aws:iam::123456789012:role/admin"}},
+		},
+		{
+			"denied",
+			`{"Statement": [{"Effect": "Deny", "Principal": "*", "Action": "kms:*"}]}`,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		got, err := assessPolicy(tt.document, testAccount)
+		if err != nil {
+			t.Errorf("%s: assessPolicy() error = %v", tt.name, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: assessPolicy() = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i].Severity != tt.want[i].Severity || got[i].Sid != tt.want[i].Sid {
+				t.Errorf("%s: finding %d = %v, want %v", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	if _, err := assessPolicy(`{"Statement": 42}`, testAccount); err == nil {
+		t.Error("assessPolicy() accepted a malformed policy")
+	}
+}
+
+func TestEnrichFlagsRotationDisabled(t *testing.T) {
+	svc := NewServiceWithClient(&fakeKMS{}, nil)
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	key := resources[0]
+	if key.Name != "orders" {
+		t.Errorf("Name = %q, want the alias", key.Name)
+	}
+	if err := svc.EnrichResource(context.Background(), &key); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+	if issues := key.Issues(); len(issues) != 1 || issues[0].Message != "Automatic rotation disabled" {
+		t.Errorf("issues = %v, want rotation disabled only", issues)
+	}
+}
+
+// TestScheduleDeletionChecksWindow checks that an out of range window is
+// refused before the deletion is confirmed, and a valid one passed on.
+func TestScheduleDeletionChecksWindow(t *testing.T) {
+	fake := &fakeKMS{}
+	svc := NewServiceWithClient(fake, nil)
+
+	_, err := svc.Execute(context.Background(), "schedule_deletion", testKey, map[string]any{"pending_days": 3})
+	var validation *core.ValidationError
+	if !errors.As(err, &validation) {
+		t.Errorf("3 day window error = %v, want a validation error", err)
+	}
+
+	params := map[string]any{"pending_days": "7", core.ParamConfirm: true, core.ParamConfirmResource: testKey}
+	if _, err := svc.Execute(context.Background(), "schedule_deletion", testKey, params); err != nil {
+		t.Fatalf("schedule_deletion error = %v", err)
+	}
+	if len(fake.scheduled) != 1 || fake.scheduled[0] != 7 {
+		t.Errorf("scheduled windows %v, want [7]", fake.scheduled)
+	}
+}
@@ -0,0 +1,384 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	rotationFormID = "kms:rotation"
+	deletionFormID = "kms:deletion"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for KMS keys.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget string // Key the rotation or deletion form is open for
+}
+
+// NewView creates a new KMS view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Alias"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Key ID"), MinWidth: 12, MaxWidth: 36, Weight: 0.8, Priority: 2},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 16, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Manager"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Spec"), MinWidth: 8, MaxWidth: 18, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Rotation"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Policy"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("KMS", "", "kms", i18n.T("keys"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Reading the key policy of %s...", row.Name)
+				return v, v.executeAction("policy", row.ID, nil)
+			}
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(rotationFormID, "enable_rotation", i18n.T("Rotation of %s", row.Name), row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(deletionFormID, "schedule_deletion", i18n.T("Deletion of %s", row.Name), row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Key %s", row.Name), formatKey(*row))
+				return v, nil
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != rotationFormID && msg.ID != deletionFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		if msg.ID == rotationFormID {
+			v.Message = i18n.T("Enabling rotation of %s...", v.formTarget)
+			return v, v.executeAction("enable_rotation", v.formTarget, msg.Values)
+		}
+		v.Message = i18n.T("Scheduling the deletion of %s...", v.formTarget)
+		return v, v.executeAction("schedule_deletion", v.formTarget, msg.Values)
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if policy, ok := msg.Result.Data.(KeyPolicy); ok {
+			v.OpenDetail(i18n.T("Key policy of %s", policy.KeyID), formatPolicy(policy))
+			return v, nil
+		}
+		if msg.Action == "schedule_deletion" {
+			return v, v.SoftRefresh()
+		}
+		// Rotation comes from analysis
+		return v, v.AnalyzeSelected()
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading KMS keys...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [p]olicy  ro[t]ation  [d]elete  [a]nalyze  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the keys, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	rotatable, _ := r.Metadata["rotatable"].(bool)
+
+	rotation, policy := "...", "..."
+	if analyzed {
+		rotation = "-"
+		if enabled, known := r.Metadata["rotation_enabled"].(bool); known {
+			rotation = "✗"
+			if enabled {
+				rotation = "✓"
+				if days, _ := r.Metadata["rotation_days"].(int32); days > 0 {
+					rotation = fmt.Sprintf("%dd", days)
+				}
+			}
+		}
+		policy = "-"
+		if r.GetMetadataString("key_manager") == "customer" {
+			policy = policyRisk(r)
+		}
+	}
+	if !rotatable {
+		rotation = "-"
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(r.ID),
+		base.TextCell(r.State),
+		base.TextCell(r.GetMetadataString("key_manager")),
+		base.TextCell(r.GetMetadataString("key_spec")),
+		base.TextCell(rotation),
+		base.TextCell(policy),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// policyRisk returns the severity of the riskiest statement of an analyzed
+// key's policy, or "ok".
+func policyRisk(r core.Resource) string {
+	findings, _ := r.Metadata["policy_findings"].([]PolicyFinding)
+	if len(findings) == 0 {
+		return "ok"
+	}
+	worst := findings[0].Severity
+	for _, f := range findings[1:] {
+		if f.Severity.Rank() > worst.Rank() {
+			worst = f.Severity
+		}
+	}
+	return string(worst)
+}
+
+// formatKey renders a key for the detail panel.
+func formatKey(r core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Key ID:       %s\n", r.ID)
+	fmt.Fprintf(&b, "ARN:          %s\n", r.ARN)
+	if aliases, _ := r.Metadata["aliases"].([]string); len(aliases) > 0 {
+		fmt.Fprintf(&b, "Aliases:      %s\n", strings.Join(aliases, ", "))
+	}
+	if description := r.GetMetadataString("description"); description != "" {
+		fmt.Fprintf(&b, "Description:  %s\n", description)
+	}
+	fmt.Fprintf(&b, "State:        %s\n", r.GetMetadataString("key_state"))
+	if at, ok := r.Metadata["deletion_date"].(time.Time); ok {
+		fmt.Fprintf(&b, "Deletion:     %s\n", at.Local().Format("2006-01-02 15:04"))
+	}
+	fmt.Fprintf(&b, "Manager:      %s\n", r.GetMetadataString("key_manager"))
+	fmt.Fprintf(&b, "Spec:         %s\n", r.GetMetadataString("key_spec"))
+	fmt.Fprintf(&b, "Usage:        %s\n", r.GetMetadataString("key_usage"))
+	fmt.Fprintf(&b, "Origin:       %s\n", r.GetMetadataString("origin"))
+	if multi, _ := r.Metadata["multi_region"].(bool); multi {
+		b.WriteString("Multi-Region: yes\n")
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:      %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if enabled, known := r.Metadata["rotation_enabled"].(bool); known {
+		if enabled {
+			days, _ := r.Metadata["rotation_days"].(int32)
+			fmt.Fprintf(&b, "Rotation:     every %d days", days)
+			if next, ok := r.Metadata["next_rotation"].(time.Time); ok {
+				fmt.Fprintf(&b, ", next on %s", next.Local().Format("2006-01-02"))
+			}
+			b.WriteString("\n")
+		} else {
+			b.WriteString("Rotation:     disabled\n")
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatPolicy renders a key policy and its risky statements for the
+// detail panel.
+func formatPolicy(policy KeyPolicy) string {
+	var b strings.Builder
+	if len(policy.Findings) == 0 {
+		b.WriteString(i18n.T("No risky statements.\n"))
+	} else {
+		b.WriteString(i18n.T("Risky statements:\n"))
+		for _, f := range policy.Findings {
+			fmt.Fprintf(&b, "  %-9s %s: %s\n", f.Severity, f.Sid, f.Message)
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(policy.Document)
+	b.WriteString("\n")
+	return b.String()
+}
+
+func (v *View) openForm(id, action, title string, r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(id, title, def.Parameters))
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	customer, noRotation, risky := 0, 0, 0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("key_manager") == "customer" {
+			customer++
+		}
+		if needsRotation(r) {
+			noRotation++
+		}
+		if findings, _ := r.Metadata["policy_findings"].([]PolicyFinding); len(findings) > 0 {
+			risky++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "customer-managed", Text: i18n.T("Customer managed: %d", customer), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "no-rotation", Text: i18n.T("No rotation: %d", noRotation), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "risky-policies", Text: i18n.T("Risky policies: %d", risky), Tone: core.ToneError},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("KMS Keys"), v.SummaryWidgets())
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "kms" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
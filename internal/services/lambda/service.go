@@ -3,26 +3,82 @@ package lambda
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/ownership"
 )
 
 // =============================================================================
 // Service Implementation
 // =============================================================================
 
+// Pricing used for cost estimates (us-east-1 on-demand, excluding free tier).
+const (
+	pricePerRequest     = 0.20 / 1_000_000
+	pricePerGBSecondX86 = 0.0000166667
+	pricePerGBSecondARM = 0.0000133334
+)
+
+const (
+	// metricsLookback is the window used for usage metrics.
+	metricsLookback = 24 * time.Hour
+
+	// failingErrorRate is the error ratio at which a function is flagged.
+	failingErrorRate = 0.05
+
+	// maskedValue replaces environment variable values until revealed.
+	maskedValue = "********"
+)
+
+// Function health, from the last 24h of metrics, the reserved concurrency
+// and the event source mappings.
+const (
+	HealthHealthy = "healthy"
+	HealthIdle    = "idle"
+	HealthFailing = "failing"
+)
+
 // Service implements Lambda operations.
 type Service struct {
-	factory    *awsfactory.ClientFactory
-	dispatcher core.EventDispatcher
-	testClient LambdaAPI
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    LambdaAPI
+	metricsClient CloudWatchAPI
+	owners        core.OwnerProvider
+}
+
+// Option configures the Lambda service.
+type Option func(*Service)
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// WithOwners attributes functions to the principal that created them, using
+// the given provider, typically CloudTrail.
+func WithOwners(provider core.OwnerProvider) Option {
+	return func(s *Service) {
+		s.owners = provider
+	}
 }
 
 // LambdaAPI defines the Lambda client interface for mocking.
@@ -30,22 +86,38 @@ type LambdaAPI interface {
 	ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error)
 	GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error)
 	Invoke(ctx context.Context, params *lambda.InvokeInput, optFns ...func(*lambda.Options)) (*lambda.InvokeOutput, error)
+	ListTags(ctx context.Context, params *lambda.ListTagsInput, optFns ...func(*lambda.Options)) (*lambda.ListTagsOutput, error)
+	GetFunctionConcurrency(ctx context.Context, params *lambda.GetFunctionConcurrencyInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionConcurrencyOutput, error)
+	ListEventSourceMappings(ctx context.Context, params *lambda.ListEventSourceMappingsInput, optFns ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
 }
 
 // NewService creates a new Lambda service.
-func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
-	return &Service{
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
 		factory:    factory,
 		dispatcher: dispatcher,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // NewServiceWithClient creates a service with a custom client (for testing).
-func NewServiceWithClient(client LambdaAPI, dispatcher core.EventDispatcher) *Service {
-	return &Service{
+func NewServiceWithClient(client LambdaAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
 		testClient: client,
 		dispatcher: dispatcher,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // client returns the Lambda client, fetching fresh from factory each time.
@@ -56,6 +128,14 @@ func (s *Service) client() LambdaAPI {
 	return lambda.NewFromConfig(s.factory.Config())
 }
 
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
 // =============================================================================
 // AWSService Interface Implementation
 // =============================================================================
@@ -101,9 +181,9 @@ func (s *Service) HealthCheck(ctx context.Context) error {
 // =============================================================================
 
 // List returns Lambda functions.
+// All pages are fetched unless opts.NextToken is set, in which case only
+// that page is returned.
 func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
-	start := time.Now()
-
 	input := &lambda.ListFunctionsInput{}
 	if opts.MaxResults > 0 {
 		maxResults := opts.MaxResults
@@ -113,29 +193,92 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 		input.MaxItems = aws.Int32(int32(maxResults)) //nolint:gosec // bounded above
 	}
 
-	result, err := s.client().ListFunctions(ctx, input)
+	resources := make([]core.Resource, 0)
+	err := s.listPages(ctx, input, opts.NextToken, func(page []types.FunctionConfiguration) {
+		for _, fn := range page {
+			resources = append(resources, s.functionToResource(fn))
+		}
+	})
 	if err != nil {
 		s.dispatchError(ctx, "list", err)
 		return nil, core.NewServiceError("lambda", "list", err)
 	}
 
-	resources := make([]core.Resource, 0, len(result.Functions))
-	for _, fn := range result.Functions {
-		resource := s.functionToResource(fn)
-		resources = append(resources, resource)
-	}
-
 	// Dispatch event
 	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
 		ResourceType: "lambda:function",
 		Count:        len(resources),
 	})
 
-	_ = time.Since(start)
-
 	return resources, nil
 }
 
+// listPages walks ListFunctions pages using the Marker token.
+func (s *Service) listPages(ctx context.Context, input *lambda.ListFunctionsInput, marker string, fn func([]types.FunctionConfiguration)) error {
+	// Apply pagination token (single page only)
+	if marker != "" {
+		input.Marker = aws.String(marker)
+		result, err := s.client().ListFunctions(ctx, input)
+		if err != nil {
+			return err
+		}
+		fn(result.Functions)
+		return nil
+	}
+
+	paginator := lambda.NewListFunctionsPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		fn(page.Functions)
+	}
+
+	return nil
+}
+
+// ListWithEnrichment returns a channel that streams enriched resources.
+func (s *Service) ListWithEnrichment(ctx context.Context, opts core.ListOptions) (<-chan core.ResourceUpdate, error) {
+	// First get basic list
+	resources, err := s.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	updateChan := make(chan core.ResourceUpdate, len(resources)+1)
+
+	go func() {
+		defer close(updateChan)
+
+		// Send all basic resources first
+		updateChan <- core.ResourceUpdate{
+			Type:      core.UpdateTypeBatch,
+			Resources: resources,
+		}
+
+		// Then enrich copies so the batch sent above is never mutated
+		for i := range resources {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				resource := resources[i]
+				resource.Metadata = maps.Clone(resource.Metadata)
+				if err := s.EnrichResource(ctx, &resource); err == nil {
+					updateChan <- core.ResourceUpdate{
+						Type:     core.UpdateTypeSingle,
+						Resource: &resource,
+						Index:    i,
+					}
+				}
+			}
+		}
+	}()
+
+	return updateChan, nil
+}
+
 func (s *Service) functionToResource(fn types.FunctionConfiguration) core.Resource {
 	runtime := string(fn.Runtime)
 	if runtime == "" {
@@ -167,12 +310,288 @@ func (s *Service) functionToResource(fn types.FunctionConfiguration) core.Resour
 			"code_size":     fn.CodeSize,
 			"description":   aws.ToString(fn.Description),
 			"last_modified": aws.ToString(fn.LastModified),
+			"architecture":  functionArchitecture(fn.Architectures),
 		},
 	}
 
 	return resource
 }
 
+// EnrichResource adds last-24h invocation, error, throttle and duration
+// metrics plus an estimated monthly cost to a single function, along with
+// its reserved concurrency, its event source mappings and an overall
+// health. Concurrency and mappings are skipped when they cannot be read.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	usage, err := s.getUsage(ctx, resource.Name, time.Now())
+	if err != nil {
+		return err
+	}
+
+	memoryMB, _ := resource.Metadata["memory_mb"].(int32)
+	arch := resource.GetMetadataString("architecture")
+
+	resource.Metadata["invocations_24h"] = int64(usage.invocations)
+	resource.Metadata["errors_24h"] = int64(usage.errors)
+	resource.Metadata["throttles_24h"] = int64(usage.throttles)
+	resource.Metadata["duration_p95_ms"] = usage.durationP95
+	resource.Metadata["error_rate"] = usage.errorRate()
+	estimate.ApplyCost(resource, usage.monthlyCost(memoryMB, arch))
+	resource.Metadata["is_unused"] = usage.invocations == 0
+	// ListFunctions does not return tags
+	if out, err := s.client().ListTags(ctx, &lambda.ListTagsInput{Resource: aws.String(resource.ARN)}); err == nil {
+		if resource.Tags == nil {
+			resource.Tags = make(map[string]string, len(out.Tags))
+		}
+		maps.Copy(resource.Tags, out.Tags)
+	}
+	iac.Apply(resource)
+	ownership.Apply(ctx, s.owners, resource)
+
+	failing := usage.errorRate() >= failingErrorRate || usage.throttles > 0
+	delete(resource.Metadata, "reserved_concurrency")
+	if out, err := s.client().GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(resource.Name),
+	}); err == nil && out.ReservedConcurrentExecutions != nil {
+		reserved := *out.ReservedConcurrentExecutions
+		resource.Metadata["reserved_concurrency"] = reserved
+		failing = failing || reserved == 0
+	}
+	sources, err := s.eventSources(ctx, resource.Name)
+	if err == nil {
+		resource.Metadata["event_sources"] = sources
+		for _, source := range sources {
+			failing = failing || source.Failing()
+		}
+	}
+	resource.Metadata["is_failing"] = failing
+	resource.Metadata["health"] = functionHealth(usage, failing)
+	resource.Metadata["analyzed"] = true
+
+	resource.State = core.StateActive
+	if usage.invocations == 0 {
+		resource.AddIssue(core.SeverityLow, "No invocations in 24h")
+	}
+	if usage.errorRate() >= failingErrorRate {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("%.0f%% of invocations failing", usage.errorRate()*100))
+	}
+	if usage.throttles > 0 {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("%.0f throttles in 24h", usage.throttles))
+	}
+	if reserved, ok := resource.Metadata["reserved_concurrency"].(int32); ok && reserved == 0 {
+		resource.AddIssue(core.SeverityHigh, "Reserved concurrency is 0, every invocation is throttled")
+	}
+	for _, source := range sources {
+		switch {
+		case source.Failing():
+			resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Trigger %s: %s", source.Source, source.LastResult))
+		case source.State == "Disabled":
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Trigger %s is disabled", source.Source))
+		}
+	}
+
+	return nil
+}
+
+// functionHealth sums up a function's state: failing when invocations
+// error or get throttled, or a trigger cannot deliver; idle when nothing
+// invoked it.
+func functionHealth(usage functionUsage, failing bool) string {
+	switch {
+	case failing:
+		return HealthFailing
+	case usage.invocations == 0:
+		return HealthIdle
+	default:
+		return HealthHealthy
+	}
+}
+
+// EventSource is an event source mapping invoking a function, such as an
+// SQS queue, a DynamoDB or Kinesis stream or a Kafka topic.
+type EventSource struct {
+	Source     string // Service and name of the source, e.g. sqs:orders
+	State      string // Enabled, Disabled, Creating, ...
+	LastResult string // OK, No records processed or PROBLEM: <reason>
+}
+
+// Failing reports whether the last poll of the source failed.
+func (e EventSource) Failing() bool {
+	return strings.HasPrefix(e.LastResult, "PROBLEM")
+}
+
+// eventSources lists the event source mappings of a function.
+func (s *Service) eventSources(ctx context.Context, functionName string) ([]EventSource, error) {
+	var sources []EventSource
+	paginator := lambda.NewListEventSourceMappingsPaginator(s.client(), &lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range page.EventSourceMappings {
+			sources = append(sources, EventSource{
+				Source:     eventSourceName(m),
+				State:      aws.ToString(m.State),
+				LastResult: aws.ToString(m.LastProcessingResult),
+			})
+		}
+	}
+	return sources, nil
+}
+
+// eventSourceName shortens a mapping's source ARN to its service and name,
+// e.g. sqs:orders or dynamodb:table/orders.
+func eventSourceName(m types.EventSourceMappingConfiguration) string {
+	arn := aws.ToString(m.EventSourceArn)
+	if arn == "" {
+		if m.SelfManagedEventSource != nil {
+			return "kafka:" + strings.Join(m.Topics, ",")
+		}
+		return aws.ToString(m.UUID)
+	}
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return arn
+	}
+	name := parts[5]
+	// Stream ARNs end with the stream's creation time
+	if i := strings.Index(name, "/stream/"); i >= 0 {
+		name = name[:i]
+	}
+	return parts[2] + ":" + name
+}
+
+// functionUsage summarizes CloudWatch metrics over the lookback window.
+type functionUsage struct {
+	invocations float64
+	errors      float64
+	throttles   float64
+	durationAvg float64 // milliseconds
+	durationP95 float64 // milliseconds
+}
+
+func (u functionUsage) errorRate() float64 {
+	if u.invocations == 0 {
+		return 0
+	}
+	return u.errors / u.invocations
+}
+
+// monthlyCost extrapolates the last 24h to a 30-day on-demand cost in USD.
+func (u functionUsage) monthlyCost(memoryMB int32, arch string) float64 {
+	pricePerGBSecond := pricePerGBSecondX86
+	if arch == string(types.ArchitectureArm64) {
+		pricePerGBSecond = pricePerGBSecondARM
+	}
+
+	gbSeconds := u.invocations * (u.durationAvg / 1000) * (float64(memoryMB) / 1024)
+	daily := u.invocations*pricePerRequest + gbSeconds*pricePerGBSecond
+	return daily * 30
+}
+
+// getUsage fetches last-24h metrics for a function in a single GetMetricData call.
+func (s *Service) getUsage(ctx context.Context, functionName string, now time.Time) (functionUsage, error) {
+	metric := func(id, name, stat string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/Lambda"),
+					MetricName: aws.String(name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: aws.String("FunctionName"), Value: aws.String(functionName)},
+					},
+				},
+				Period: aws.Int32(86400),
+				Stat:   aws.String(stat),
+			},
+		}
+	}
+
+	out, err := s.metrics().GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(now.Add(-metricsLookback)),
+		EndTime:   aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metric("invocations", "Invocations", "Sum"),
+			metric("errors", "Errors", "Sum"),
+			metric("throttles", "Throttles", "Sum"),
+			metric("duration_avg", "Duration", "Average"),
+			metric("duration_p95", "Duration", "p95"),
+		},
+	})
+	if err != nil {
+		return functionUsage{}, err
+	}
+
+	var usage functionUsage
+	for _, result := range out.MetricDataResults {
+		total := 0.0
+		for _, v := range result.Values {
+			total += v
+		}
+		switch aws.ToString(result.Id) {
+		case "invocations":
+			usage.invocations = total
+		case "errors":
+			usage.errors = total
+		case "throttles":
+			usage.throttles = total
+		case "duration_avg":
+			usage.durationAvg = maxValue(result.Values)
+		case "duration_p95":
+			usage.durationP95 = maxValue(result.Values)
+		}
+	}
+
+	return usage, nil
+}
+
+// maxValue returns the largest datapoint; a 24h window spans at most two
+// daily periods, so this picks the most pessimistic one.
+func maxValue(values []float64) float64 {
+	highest := 0.0
+	for _, v := range values {
+		if v > highest {
+			highest = v
+		}
+	}
+	return highest
+}
+
+// maskEnvironment returns the function's environment variables with masked values.
+func maskEnvironment(env *types.EnvironmentResponse) map[string]string {
+	masked := make(map[string]string)
+	if env == nil {
+		return masked
+	}
+	for key := range env.Variables {
+		masked[key] = maskedValue
+	}
+	return masked
+}
+
+// environmentKeys returns the environment variable names in sorted order.
+func environmentKeys(env *types.EnvironmentResponse) []string {
+	if env == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(env.Variables))
+	for key := range env.Variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func functionArchitecture(archs []types.Architecture) string {
+	if len(archs) == 0 {
+		return string(types.ArchitectureX8664)
+	}
+	return string(archs[0])
+}
+
 // =============================================================================
 // ResourceGetter Interface Implementation
 // =============================================================================
@@ -204,6 +623,8 @@ func (s *Service) Get(ctx context.Context, name string) (*core.Resource, error)
 			"last_modified": aws.ToString(config.LastModified),
 		},
 	}
+	maps.Copy(resource.Tags, result.Tags)
+	iac.Apply(resource)
 
 	return resource, nil
 }
@@ -222,6 +643,9 @@ func (s *Service) Actions() []core.Action {
 			Shortcut:    "i",
 			Dangerous:   false,
 			Category:    "execute",
+			Parameters: []core.ActionParameter{
+				{Name: "payload", Type: "text", Default: "{}", Description: "JSON event sent to the function"},
+			},
 		},
 		{
 			Name:        "view_config",
@@ -231,6 +655,17 @@ func (s *Service) Actions() []core.Action {
 			Dangerous:   false,
 			Category:    "info",
 		},
+		{
+			Name:        "reveal_env",
+			Description: "Reveal an environment variable value",
+			Icon:        "eye",
+			Shortcut:    "v",
+			Dangerous:   false,
+			Category:    "info",
+			Parameters: []core.ActionParameter{
+				{Name: "key", Type: "select", Required: true, Description: "Environment variable to reveal"},
+			},
+		},
 	}
 }
 
@@ -252,6 +687,8 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 		result, err = s.invokeFunction(ctx, resourceID, params)
 	case "view_config":
 		result, err = s.viewConfig(ctx, resourceID)
+	case "reveal_env":
+		result, err = s.revealEnv(ctx, resourceID, params)
 	default:
 		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
 	}
@@ -267,10 +704,14 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 
 	result.Duration = time.Since(start)
 
+	logged := *result
+	if action == "reveal_env" {
+		logged.Data = nil
+	}
 	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
 		Action:     action,
 		ResourceID: resourceID,
-		Result:     result,
+		Result:     &logged,
 	})
 
 	return result, nil
@@ -283,10 +724,22 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 func (s *Service) invokeFunction(ctx context.Context, functionName string, params map[string]any) (*core.ActionResult, error) {
 	input := &lambda.InvokeInput{
 		FunctionName: aws.String(functionName),
+		LogType:      types.LogTypeTail,
 	}
 
 	// Add payload if provided
-	if payload, ok := params["payload"].([]byte); ok {
+	var payload []byte
+	switch p := params["payload"].(type) {
+	case []byte:
+		payload = p
+	case string:
+		payload = []byte(strings.TrimSpace(p))
+	}
+	if len(payload) > 0 {
+		if !json.Valid(payload) {
+			err := core.NewValidationError("payload", string(payload), "must be valid JSON")
+			return core.NewActionResult(false, err.Error()), err
+		}
 		input.Payload = payload
 	}
 
@@ -295,10 +748,22 @@ func (s *Service) invokeFunction(ctx context.Context, functionName string, param
 		return core.NewActionResult(false, err.Error()), err
 	}
 
+	// The log tail is best effort: a function without log permissions
+	// still returns its response
+	logs, _ := base64.StdEncoding.DecodeString(aws.ToString(result.LogResult))
+	functionError := aws.ToString(result.FunctionError)
+
 	actionResult := core.NewActionResult(true, fmt.Sprintf("Function invoked successfully, status: %d", result.StatusCode))
+	if functionError != "" {
+		actionResult.Message = fmt.Sprintf("Function returned an error (%s), status: %d", functionError, result.StatusCode)
+	}
 	actionResult.Data = map[string]any{
-		"status_code": result.StatusCode,
-		"payload":     string(result.Payload),
+		"function":         functionName,
+		"status_code":      result.StatusCode,
+		"payload":          string(result.Payload),
+		"function_error":   functionError,
+		"executed_version": aws.ToString(result.ExecutedVersion),
+		"logs":             string(logs),
 	}
 
 	return actionResult, nil
@@ -315,11 +780,49 @@ func (s *Service) viewConfig(ctx context.Context, functionName string) (*core.Ac
 	config := result.Configuration
 	actionResult := core.NewActionResult(true, fmt.Sprintf("Configuration for %s", aws.ToString(config.FunctionName)))
 	actionResult.Data = map[string]any{
+		"function":    aws.ToString(config.FunctionName),
 		"runtime":     string(config.Runtime),
 		"handler":     aws.ToString(config.Handler),
 		"memory_mb":   config.MemorySize,
 		"timeout_sec": config.Timeout,
 		"description": aws.ToString(config.Description),
+		"role":        aws.ToString(config.Role),
+		"environment": maskEnvironment(config.Environment),
+		"env_keys":    environmentKeys(config.Environment),
+	}
+
+	return actionResult, nil
+}
+
+// revealEnv returns the plain value of a single environment variable.
+// Only the key is recorded in the action event, so the audit log shows
+// who revealed what without storing the secret itself.
+func (s *Service) revealEnv(ctx context.Context, functionName string, params map[string]any) (*core.ActionResult, error) {
+	key, _ := params["key"].(string)
+	if key == "" {
+		return nil, core.NewValidationError("key", key, "environment variable name is required")
+	}
+
+	result, err := s.client().GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), err
+	}
+
+	var vars map[string]string
+	if env := result.Configuration.Environment; env != nil {
+		vars = env.Variables
+	}
+	value, ok := vars[key]
+	if !ok {
+		return nil, core.NewValidationError("key", key, "environment variable not found")
+	}
+
+	actionResult := core.NewActionResult(true, fmt.Sprintf("Revealed %s of %s", key, functionName))
+	actionResult.Data = map[string]any{
+		"key":   key,
+		"value": value,
 	}
 
 	return actionResult, nil
@@ -351,8 +854,9 @@ func (s *Service) dispatchError(ctx context.Context, op string, err error) {
 // =============================================================================
 
 var (
-	_ core.AWSService     = (*Service)(nil)
-	_ core.ResourceLister = (*Service)(nil)
-	_ core.ResourceGetter = (*Service)(nil)
-	_ core.ActionExecutor = (*Service)(nil)
+	_ core.AWSService      = (*Service)(nil)
+	_ core.ResourceLister  = (*Service)(nil)
+	_ core.EnrichingLister = (*Service)(nil)
+	_ core.ResourceGetter  = (*Service)(nil)
+	_ core.ActionExecutor  = (*Service)(nil)
 )
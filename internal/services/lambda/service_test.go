@@ -0,0 +1,189 @@
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const testFunctionARN = "arn:aws:lambda:us-east-1:123456789012:function:resize-images"
+
+// fakeLambda serves two functions, or fails every call when err is set.
+type fakeLambda struct {
+	err error
+}
+
+var testFunctions = []types.FunctionConfiguration{
+	{
+		FunctionName: aws.String("resize-images"),
+		FunctionArn:  aws.String(testFunctionARN),
+		Runtime:      types.RuntimePython312,
+		Handler:      aws.String("app.handler"),
+		MemorySize:   aws.Int32(512),
+		Timeout:      aws.Int32(30),
+		Environment:  &types.EnvironmentResponse{Variables: map[string]string{"BUCKET": "images"}},
+	},
+	{
+		FunctionName: aws.String("nightly-report"),
+		FunctionArn:  aws.String("arn:aws:lambda:us-east-1:123456789012:function:nightly-report"),
+		Runtime:      types.RuntimeNodejs20x,
+		Handler:      aws.String("index.handler"),
+		MemorySize:   aws.Int32(128),
+		Timeout:      aws.Int32(300),
+	},
+}
+
+// function finds a function by name or ARN, as the Lambda API does.
+func (f *fakeLambda) function(nameOrARN *string) (types.FunctionConfiguration, error) {
+	if f.err != nil {
+		return types.FunctionConfiguration{}, f.err
+	}
+	for _, fn := range testFunctions {
+		if aws.ToString(fn.FunctionName) == aws.ToString(nameOrARN) || aws.ToString(fn.FunctionArn) == aws.ToString(nameOrARN) {
+			return fn, nil
+		}
+	}
+	return types.FunctionConfiguration{}, &types.ResourceNotFoundException{Message: aws.String("Function not found")}
+}
+
+func (f *fakeLambda) ListFunctions(context.Context, *lambda.ListFunctionsInput, ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &lambda.ListFunctionsOutput{Functions: testFunctions}, nil
+}
+
+func (f *fakeLambda) GetFunction(_ context.Context, in *lambda.GetFunctionInput, _ ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error) {
+	fn, err := f.function(in.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+	return &lambda.GetFunctionOutput{Configuration: &fn, Tags: map[string]string{"team": "media"}}, nil
+}
+
+func (f *fakeLambda) Invoke(_ context.Context, in *lambda.InvokeInput, _ ...func(*lambda.Options)) (*lambda.InvokeOutput, error) {
+	if _, err := f.function(in.FunctionName); err != nil {
+		return nil, err
+	}
+	out := &lambda.InvokeOutput{StatusCode: 200, Payload: []byte(`{"ok":true}`)}
+	if in.LogType == types.LogTypeTail {
+		out.LogResult = aws.String(base64.StdEncoding.EncodeToString([]byte("START RequestId: 1\nEND RequestId: 1\n")))
+	}
+	if len(in.Payload) > 0 {
+		out.Payload = in.Payload
+	}
+	return out, nil
+}
+
+func (f *fakeLambda) ListTags(context.Context, *lambda.ListTagsInput, ...func(*lambda.Options)) (*lambda.ListTagsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &lambda.ListTagsOutput{Tags: map[string]string{"team": "media"}}, nil
+}
+
+func (f *fakeLambda) GetFunctionConcurrency(_ context.Context, in *lambda.GetFunctionConcurrencyInput, _ ...func(*lambda.Options)) (*lambda.GetFunctionConcurrencyOutput, error) {
+	fn, err := f.function(in.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+	out := &lambda.GetFunctionConcurrencyOutput{}
+	if aws.ToString(fn.FunctionArn) == testFunctionARN {
+		out.ReservedConcurrentExecutions = aws.Int32(10)
+	}
+	return out, nil
+}
+
+func (f *fakeLambda) ListEventSourceMappings(_ context.Context, in *lambda.ListEventSourceMappingsInput, _ ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error) {
+	fn, err := f.function(in.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+	out := &lambda.ListEventSourceMappingsOutput{}
+	if aws.ToString(fn.FunctionArn) == testFunctionARN {
+		out.EventSourceMappings = []types.EventSourceMappingConfiguration{{
+			UUID:                 aws.String("a1b2c3d4"),
+			EventSourceArn:       aws.String("arn:aws:sqs:us-east-1:123456789012:uploads"),
+			State:                aws.String("Enabled"),
+			LastProcessingResult: aws.String("OK"),
+		}}
+	}
+	return out, nil
+}
+
+// fakeCloudWatch returns no datapoints, as for functions never invoked.
+type fakeCloudWatch struct{}
+
+func (fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	return &cloudwatch.GetMetricDataOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against functions.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeLambda{}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeLambda{err: errors.New("AccessDeniedException")}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		ExistingID: testFunctionARN,
+		MissingID:  "missing-function",
+		Action:     "view_config",
+	})
+}
+
+// TestInvoke checks that invoke sends the payload and returns the
+// response with its decoded log tail.
+func TestInvoke(t *testing.T) {
+	svc := NewServiceWithClient(&fakeLambda{}, coretest.NewRecorder())
+
+	result, err := svc.Execute(context.Background(), "invoke", "resize-images", map[string]any{"payload": ` {"key": "a.png"} `})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	data, _ := result.Data.(map[string]any)
+	if got := data["payload"]; got != `{"key": "a.png"}` {
+		t.Errorf("payload = %v, want the event echoed back", got)
+	}
+	if got := data["logs"]; got != "START RequestId: 1\nEND RequestId: 1\n" {
+		t.Errorf("logs = %q, want the decoded log tail", got)
+	}
+
+	_, err = svc.Execute(context.Background(), "invoke", "resize-images", map[string]any{"payload": "{not json"})
+	var validation *core.ValidationError
+	if !errors.As(err, &validation) {
+		t.Errorf("Execute() with invalid JSON error = %v, want a validation error", err)
+	}
+}
+
+// TestRevealEnvKeepsValueOutOfEvents checks that the revealed value is
+// returned but never dispatched to hooks.
+func TestRevealEnvKeepsValueOutOfEvents(t *testing.T) {
+	recorder := coretest.NewRecorder()
+	svc := NewServiceWithClient(&fakeLambda{}, recorder)
+
+	result, err := svc.Execute(context.Background(), "reveal_env", "resize-images", map[string]any{"key": "BUCKET", core.ParamConfirm: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if data, _ := result.Data.(map[string]any); data["value"] != "images" {
+		t.Errorf("result data = %v, want the value", result.Data)
+	}
+
+	for _, event := range recorder.Events() {
+		data, ok := event.Data().(core.ActionEventData)
+		if ok && data.Result != nil && data.Result.Data != nil {
+			t.Errorf("%s event carries the result data %v", event.Type(), data.Result.Data)
+		}
+	}
+}
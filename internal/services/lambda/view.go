@@ -1,16 +1,23 @@
 package lambda
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
 	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	revealFormID = "lambda:reveal_env"
+	invokeFormID = "lambda:invoke"
 )
 
 // =============================================================================
@@ -18,20 +25,43 @@ import (
 // =============================================================================
 
 type View struct {
-	*base.TableView
+	*base.EnrichableTableView
+
+	// config is the last loaded configuration, shown with env values masked
+	// except for keys revealed on demand
+	configFn string
+	config   map[string]any
+	revealed map[string]string
+
+	// payloads is the last payload sent to each function, offered again
+	// the next time it is invoked
+	invokeFn string
+	payloads map[string]string
 }
 
 func NewView() *View {
 	columnDefs := []base.ColumnDef{
-		{Title: "Name", MinWidth: 15, MaxWidth: 40, Weight: 2.0, Priority: 0},
-		{Title: "Runtime", MinWidth: 10, MaxWidth: 18, Weight: 0.5, Priority: 1},
-		{Title: "Memory", MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
-		{Title: "Timeout", MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 3},
-		{Title: "Last Modified", MinWidth: 12, MaxWidth: 20, Weight: 0.5, Priority: 4},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Health"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Runtime"), MinWidth: 10, MaxWidth: 18, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Memory"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Timeout"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Last Modified"), MinWidth: 12, MaxWidth: 20, Weight: 0.5, Priority: 4},
+		{Title: i18n.T("Invocations"), MinWidth: 11, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Errors"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Throttles"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("p95"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Reserved"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Triggers"), MinWidth: 8, MaxWidth: 24, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Owner"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 4},
 	}
 
 	return &View{
-		TableView: base.NewTableView("Lambda", "4", "lambda", columnDefs),
+		EnrichableTableView: base.NewEnrichableTableView("Lambda", "4", "lambda", i18n.T("functions"), columnDefs, buildRow),
+		payloads:            make(map[string]string),
 	}
 }
 
@@ -44,46 +74,75 @@ func (v *View) Init() tea.Cmd {
 	if len(v.Resources) > 0 || v.IsLoading() {
 		return nil
 	}
-	return v.loadFunctions()
+	return v.Load()
 }
 
 func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
 		case "i":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Invoking %s...", row.Name)
-				return v, v.executeAction("invoke", row.Name)
+				return v, v.openInvokeForm(row.Name)
 			}
 		case "c":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Loading config for %s...", row.Name)
+				v.Message = i18n.T("Loading config for %s...", row.Name)
 				return v, v.executeAction("view_config", row.Name)
 			}
+		case "v":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openRevealForm(row.Name)
+			}
 		case "enter":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("%s: %s", row.Name, row.GetMetadataString("runtime"))
+				v.OpenDetail(row.Name, formatFunction(row))
+				return v, nil
 			}
 		}
 
-	case lambdaLoadedMsg:
-		v.SetLoading(false)
-		if msg.err != nil {
-			v.SetError(msg.err)
-			v.Message = fmt.Sprintf("Error: %v", msg.err)
-		} else {
-			v.SetError(nil)
-			v.Resources = msg.resources
-			v.updateTable()
-			v.Message = fmt.Sprintf("Loaded %d functions", len(msg.resources))
+	case components.FormResultMsg:
+		if msg.ID != revealFormID && msg.ID != invokeFormID {
+			break
+		}
+		if msg.Canceled {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		if msg.ID == invokeFormID {
+			payload, _ := msg.Values["payload"].(string)
+			v.payloads[v.invokeFn] = payload
+			v.Message = i18n.T("Invoking %s...", v.invokeFn)
+			cmds = append(cmds, v.executeActionWithParams("invoke", v.invokeFn, msg.Values))
+			break
 		}
+		v.Message = i18n.T("Revealing %v...", msg.Values["key"])
+		cmds = append(cmds, v.executeActionWithParams("reveal_env", v.configFn, msg.Values))
 
 	case base.ActionResultMsg:
+		if msg.Error == nil && msg.Result != nil && v.handleConfigResult(msg) {
+			break
+		}
+		if msg.Error == nil && msg.Result != nil && msg.Action == "invoke" {
+			data, _ := msg.Result.Data.(map[string]any)
+			v.Message = msg.Result.Message
+			v.OpenDetail(i18n.T("Invocation of %s", v.invokeFn), formatInvocation(data))
+			break
+		}
 		if msg.Error != nil {
-			v.Message = fmt.Sprintf("Action failed: %v", msg.Error)
+			v.Message = i18n.T("Action failed: %v", msg.Error)
 		} else if msg.Result != nil {
 			v.Message = msg.Result.Message
 		}
@@ -106,11 +165,11 @@ func (v *View) View() string {
 
 	// Table or loading/error
 	if v.IsLoading() && len(v.Resources) == 0 {
-		lines = append(lines, v.Styles.Muted.Render("Loading Lambda functions..."))
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading Lambda functions...")))
 	} else if err := v.Error(); err != nil {
-		lines = append(lines, v.Styles.Error.Render(fmt.Sprintf("Error: %v", err)))
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
 	} else {
-		lines = append(lines, v.TableViewString())
+		lines = append(lines, v.ContentView())
 	}
 
 	// Message or blank
@@ -121,7 +180,7 @@ func (v *View) View() string {
 	}
 
 	// Help
-	lines = append(lines, v.Styles.Help.Render("[i]nvoke  [c]onfig  [↑/↓]navigate  [r]efresh"))
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]details  [i]nvoke  [c]onfig  [v]reveal env  [↑/↓]navigate  [r]efresh  [R]e-analyze")))
 	return strings.Join(lines, "\n")
 }
 
@@ -130,35 +189,91 @@ func (v *View) View() string {
 // =============================================================================
 
 func (v *View) Refresh() tea.Cmd {
-	return v.loadFunctions()
+	return v.SoftRefresh()
 }
 
 // =============================================================================
 // Internal Methods
 // =============================================================================
 
-type lambdaLoadedMsg struct {
-	resources []core.Resource
-	err       error
+// handleConfigResult updates the configuration panel from view_config and
+// reveal_env results. It returns false for other actions.
+func (v *View) handleConfigResult(msg base.ActionResultMsg) bool {
+	data, _ := msg.Result.Data.(map[string]any)
+	switch msg.Action {
+	case "view_config":
+		v.config = data
+		v.configFn, _ = data["function"].(string)
+		v.revealed = make(map[string]string)
+	case "reveal_env":
+		if v.config == nil {
+			return false
+		}
+		key, _ := data["key"].(string)
+		value, _ := data["value"].(string)
+		v.revealed[key] = value
+	default:
+		return false
+	}
+
+	v.Message = msg.Result.Message
+	v.OpenDetail(i18n.T("Configuration of %s", v.configFn), formatConfig(v.config, v.revealed))
+	return true
 }
 
-func (v *View) loadFunctions() tea.Cmd {
-	v.SetLoading(true)
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return lambdaLoadedMsg{err: fmt.Errorf("service not initialized")}
+func (v *View) openRevealForm(functionName string) tea.Cmd {
+	if v.configFn != functionName || v.config == nil {
+		v.Message = i18n.T("Press 'c' to load the configuration of %s first", functionName)
+		return nil
+	}
+	keys, _ := v.config["env_keys"].([]string)
+	if len(keys) == 0 {
+		v.Message = i18n.T("%s has no environment variables", functionName)
+		return nil
+	}
+
+	def, ok := base.FindAction(v.Service(), "reveal_env")
+	if !ok {
+		v.Message = i18n.T("Action reveal_env not supported")
+		return nil
+	}
+	params := make([]core.ActionParameter, len(def.Parameters))
+	copy(params, def.Parameters)
+	for i := range params {
+		if params[i].Name == "key" {
+			params[i].Options = keys
+			params[i].Default = keys[0]
 		}
-		lister, ok := service.(core.ResourceLister)
-		if !ok {
-			return lambdaLoadedMsg{err: fmt.Errorf("service does not support listing")}
+	}
+	return v.OpenForm(components.NewForm(revealFormID, i18n.T("Reveal environment variable of %s (audited)", functionName), params))
+}
+
+// openInvokeForm asks for the event to invoke a function with, starting
+// from the last one sent to it.
+func (v *View) openInvokeForm(functionName string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "invoke")
+	if !ok {
+		v.Message = i18n.T("Action invoke not supported")
+		return nil
+	}
+	params := make([]core.ActionParameter, len(def.Parameters))
+	copy(params, def.Parameters)
+	if payload, ok := v.payloads[functionName]; ok {
+		for i := range params {
+			if params[i].Name == "payload" {
+				params[i].Default = payload
+			}
 		}
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return lambdaLoadedMsg{resources: resources, err: err}
 	}
+	v.invokeFn = functionName
+	return v.OpenForm(components.NewForm(invokeFormID, i18n.T("Invoke %s", functionName), params))
 }
 
 func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return v.executeActionWithParams(action, resourceID, nil)
+}
+
+func (v *View) executeActionWithParams(action, resourceID string, params map[string]any) tea.Cmd {
 	return func() tea.Msg {
 		service := v.Service()
 		if service == nil {
@@ -168,58 +283,241 @@ func (v *View) executeAction(action, resourceID string) tea.Cmd {
 		if !ok {
 			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
 		}
-		result, err := executor.Execute(context.Background(), action, resourceID, nil)
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
 		return base.ActionResultMsg{Action: action, Result: result, Error: err}
 	}
 }
 
-func (v *View) updateTable() {
-	rows := make([]table.Row, len(v.Resources))
-	for i, r := range v.Resources {
-		runtime := r.GetMetadataString("runtime")
+func buildRow(r core.Resource) base.Row {
+	runtime := r.GetMetadataString("runtime")
+
+	memory, _ := r.Metadata["memory_mb"].(int32)
+	memoryMB := fmt.Sprintf("%d MB", memory)
+
+	timeout, _ := r.Metadata["timeout_sec"].(int32)
+	timeoutSec := fmt.Sprintf("%d s", timeout)
 
-		memoryMB := "0 MB"
-		if m, ok := r.Metadata["memory_mb"].(int32); ok {
-			memoryMB = fmt.Sprintf("%d MB", m)
+	lastModified := r.GetMetadataString("last_modified")
+	if len(lastModified) > 19 {
+		lastModified = lastModified[:19]
+	}
+
+	health, reserved, triggers := "...", "...", "..."
+	invocations, errors, throttles, p95 := "...", "...", "...", "..."
+	var healthValue, reservedValue, triggersValue any
+	var invValue, errValue, thrValue, p95Value any
+	if analyzed, _ := r.Metadata["analyzed"].(bool); analyzed {
+		healthValue, health = formatHealth(r.GetMetadataString("health"))
+		reserved = "-"
+		if n, ok := r.Metadata["reserved_concurrency"].(int32); ok {
+			reservedValue, reserved = n, fmt.Sprintf("%d", n)
+			if n == 0 {
+				reserved = "🔴 0"
+			}
+		}
+		sources, _ := r.Metadata["event_sources"].([]EventSource)
+		triggersValue, triggers = len(sources), formatTriggers(sources)
+
+		inv, _ := r.Metadata["invocations_24h"].(int64)
+		errCount, _ := r.Metadata["errors_24h"].(int64)
+		thr, _ := r.Metadata["throttles_24h"].(int64)
+		dur, _ := r.Metadata["duration_p95_ms"].(float64)
+		invValue, errValue, thrValue, p95Value = inv, errCount, thr, dur
+
+		invocations = fmt.Sprintf("%d", inv)
+		if inv == 0 {
+			invocations = "🟡 0"
+		}
+		errors = fmt.Sprintf("%d", errCount)
+		if failing, _ := r.Metadata["is_failing"].(bool); failing && errCount > 0 {
+			errors = fmt.Sprintf("🔴 %d", errCount)
 		}
+		throttles = fmt.Sprintf("%d", thr)
+		if thr > 0 {
+			throttles = fmt.Sprintf("🔴 %d", thr)
+		}
+		p95 = fmt.Sprintf("%.0f ms", dur)
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.LazyCell(healthValue, func() string { return health }),
+		base.TextCell(runtime),
+		base.LazyCell(memory, func() string { return memoryMB }),
+		base.LazyCell(timeout, func() string { return timeoutSec }),
+		base.TextCell(lastModified),
+		base.LazyCell(invValue, func() string { return invocations }),
+		base.LazyCell(errValue, func() string { return errors }),
+		base.LazyCell(thrValue, func() string { return throttles }),
+		base.LazyCell(p95Value, func() string { return p95 }),
+		base.LazyCell(reservedValue, func() string { return reserved }),
+		base.LazyCell(triggersValue, func() string { return triggers }),
+		base.CostCell(r),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+		base.TextCell(base.FormatOwner(r)),
+	}
+}
 
-		timeoutSec := "0 s"
-		if t, ok := r.Metadata["timeout_sec"].(int32); ok {
-			timeoutSec = fmt.Sprintf("%d s", t)
+// formatHealth returns the sort rank and icon of a function health, worst
+// first.
+func formatHealth(health string) (int, string) {
+	switch health {
+	case HealthFailing:
+		return 2, "🔴 " + i18n.T("failing")
+	case HealthIdle:
+		return 1, "🟡 " + i18n.T("idle")
+	default:
+		return 0, "🟢 " + i18n.T("healthy")
+	}
+}
+
+// formatTriggers names the sources of a function, flagging failing ones.
+func formatTriggers(sources []EventSource) string {
+	if len(sources) == 0 {
+		return "-"
+	}
+	names := make([]string, len(sources))
+	for i, source := range sources {
+		names[i] = source.Source
+		if source.Failing() {
+			names[i] = "🔴 " + source.Source
 		}
+	}
+	return base.TruncateString(strings.Join(names, ", "), 24)
+}
+
+// formatFunction renders a function's health, concurrency and triggers for
+// the detail panel.
+func formatFunction(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", r.ARN)
+	fmt.Fprintf(&b, "Runtime:     %s\n", r.GetMetadataString("runtime"))
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		b.WriteString(i18n.T("\nStill analyzing...\n"))
+		return b.String()
+	}
+
+	_, health := formatHealth(r.GetMetadataString("health"))
+	fmt.Fprintf(&b, "Health:      %s\n", health)
+	inv, _ := r.Metadata["invocations_24h"].(int64)
+	errCount, _ := r.Metadata["errors_24h"].(int64)
+	thr, _ := r.Metadata["throttles_24h"].(int64)
+	fmt.Fprintf(&b, "Last 24h:    %d invocations, %d errors, %d throttles\n", inv, errCount, thr)
+	if n, ok := r.Metadata["reserved_concurrency"].(int32); ok {
+		fmt.Fprintf(&b, "Reserved:    %d concurrent executions\n", n)
+	} else {
+		b.WriteString(i18n.T("Reserved:    none (account pool)\n"))
+	}
 
-		lastModified := r.GetMetadataString("last_modified")
-		if len(lastModified) > 19 {
-			lastModified = lastModified[:19]
+	b.WriteString(i18n.T("\nTriggers:\n"))
+	sources, _ := r.Metadata["event_sources"].([]EventSource)
+	if len(sources) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, source := range sources {
+		fmt.Fprintf(&b, "  %s  %s  %s\n", source.Source, source.State, source.LastResult)
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
 		}
+	}
+	return b.String()
+}
+
+// formatConfig renders a function configuration for the detail panel.
+// Environment values stay masked unless present in revealed.
+func formatConfig(config map[string]any, revealed map[string]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Runtime:     %v\n", config["runtime"])
+	fmt.Fprintf(&b, "Handler:     %v\n", config["handler"])
+	fmt.Fprintf(&b, "Memory:      %v MB\n", config["memory_mb"])
+	fmt.Fprintf(&b, "Timeout:     %v s\n", config["timeout_sec"])
+	fmt.Fprintf(&b, "Role:        %v\n", config["role"])
+	if desc, _ := config["description"].(string); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
 
-		rows[i] = table.Row{
-			base.TruncateString(r.Name, 40),
-			runtime,
-			memoryMB,
-			timeoutSec,
-			lastModified,
+	b.WriteString(i18n.T("\nEnvironment:\n"))
+	keys, _ := config["env_keys"].([]string)
+	env, _ := config["environment"].(map[string]string)
+	if len(keys) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, key := range keys {
+		value := env[key]
+		if plain, ok := revealed[key]; ok {
+			value = plain
 		}
+		fmt.Fprintf(&b, "  %s=%s\n", key, value)
+	}
+	if len(keys) > 0 {
+		b.WriteString(i18n.T("\nPress Esc, then 'v' to reveal a value (recorded in the audit log)\n"))
 	}
-	v.SetRows(rows)
+	return b.String()
 }
 
-func (v *View) renderSummary() string {
+// formatInvocation renders an invoke result for the detail panel: the
+// response, pretty-printed when it is JSON, then the tail of the logs.
+func formatInvocation(data map[string]any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Status:      %v\n", data["status_code"])
+	if version, _ := data["executed_version"].(string); version != "" {
+		fmt.Fprintf(&b, "Version:     %s\n", version)
+	}
+	if functionError, _ := data["function_error"].(string); functionError != "" {
+		fmt.Fprintf(&b, "Error:       🔴 %s\n", functionError)
+	}
+
+	b.WriteString(i18n.T("\nResponse:\n"))
+	payload, _ := data["payload"].(string)
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, []byte(payload), "", "  ") == nil {
+		payload = pretty.String()
+	}
+	if payload == "" {
+		payload = "(empty)"
+	}
+	b.WriteString(payload)
+	b.WriteString("\n")
+
+	b.WriteString(i18n.T("\nLogs (last 4 KB):\n"))
+	logs, _ := data["logs"].(string)
+	if strings.TrimSpace(logs) == "" {
+		b.WriteString("  (none)\n")
+	}
+	b.WriteString(logs)
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
 	total := len(v.Resources)
-	runtimes := make(map[string]int)
+	unused, failing := 0, 0
 	for _, r := range v.Resources {
-		runtime := r.GetMetadataString("runtime")
-		runtimes[runtime]++
+		if isUnused, ok := r.Metadata["is_unused"].(bool); ok && isUnused {
+			unused++
+		}
+		if isFailing, ok := r.Metadata["is_failing"].(bool); ok && isFailing {
+			failing++
+		}
 	}
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		v.Styles.Title.Render("Lambda Functions"),
-		"  ",
-		v.Styles.Muted.Render(fmt.Sprintf("Total: %d", total)),
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unused", Text: i18n.T("Unused: %d", unused), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "failing", Text: i18n.T("Failing: %d", failing), Tone: core.ToneError},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
 	)
 }
 
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Lambda Functions"), v.SummaryWidgets())
+}
+
 // =============================================================================
 // View Factory
 // =============================================================================
@@ -237,7 +535,8 @@ func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
 func (f *ViewFactory) ServiceName() string { return "lambda" }
 
 var (
-	_ tea.Model        = (*View)(nil)
-	_ core.View        = (*View)(nil)
-	_ core.ViewFactory = (*ViewFactory)(nil)
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.BadgeProvider = (*View)(nil)
+	_ core.ViewFactory   = (*ViewFactory)(nil)
 )
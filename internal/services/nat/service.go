@@ -0,0 +1,512 @@
+// Package nat provides NAT gateway data-transfer analysis for the a9s
+// application. It correlates each gateway's CloudWatch traffic with the
+// subnets routed through it to find the most expensive paths and the
+// traffic VPC endpoints could take off it.
+package nat
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Pricing used for cost estimates (us-east-1 on-demand).
+const (
+	pricePerHour = 0.045
+	pricePerGB   = 0.045
+)
+
+// DefaultHotspotMonthly is the monthly data processing cost above which a
+// gateway is flagged as a hotspot.
+const DefaultHotspotMonthly = 100.0
+
+const (
+	// metricsLookback is the traffic window, long enough to cover weekly
+	// batch jobs.
+	metricsLookback = 14 * 24 * time.Hour
+
+	// idleBytes is the traffic over the lookback below which a gateway is
+	// flagged as idle.
+	idleBytes = 1 << 30
+
+	bytesPerGB = 1 << 30
+)
+
+// gatewayEndpoints are the services reachable through free gateway VPC
+// endpoints instead of the NAT gateway.
+var gatewayEndpoints = []string{"s3", "dynamodb"}
+
+// Service implements NAT gateway operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    EC2API
+	metricsClient CloudWatchAPI
+
+	hotspotMonthly float64
+}
+
+// Option configures the NAT gateway service.
+type Option func(*Service)
+
+// WithHotspotMonthly sets the monthly data processing cost above which a
+// gateway is flagged. Non-positive values keep the default.
+func WithHotspotMonthly(monthly float64) Option {
+	return func(s *Service) {
+		if monthly > 0 {
+			s.hotspotMonthly = monthly
+		}
+	}
+}
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error)
+	DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+	DescribeVpcEndpoints(ctx context.Context, params *ec2.DescribeVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcEndpointsOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// NewService creates a new NAT gateway service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:        factory,
+		dispatcher:     dispatcher,
+		hotspotMonthly: DefaultHotspotMonthly,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:     client,
+		dispatcher:     dispatcher,
+		hotspotMonthly: DefaultHotspotMonthly,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "nat"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "NAT Gateway Data Transfer"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("nat", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the NAT gateways that are not deleted.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: []types.Filter{{
+			Name:   aws.String("state"),
+			Values: []string{"pending", "available", "failed", "deleting"},
+		}},
+	}
+
+	now := time.Now()
+	resources := make([]core.Resource, 0)
+	paginator := ec2.NewDescribeNatGatewaysPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("nat", "list", err)
+		}
+		for _, gw := range page.NatGateways {
+			resources = append(resources, gatewayToResource(gw, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:natgateway",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// EnrichResource adds the gateway's traffic over 14 days, the subnets routed
+// through it, missing gateway endpoints and an estimated monthly cost.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	traffic, err := s.getTraffic(ctx, resource.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	paths, err := s.getPaths(ctx, resource.ID, resource.GetMetadataString("vpc_id"), resource.GetMetadataString("subnet_id"))
+	if err != nil {
+		return err
+	}
+
+	monthlyGB := traffic.total() / bytesPerGB * 30 / (metricsLookback.Hours() / 24)
+	processing := monthlyGB * pricePerGB
+
+	resource.Metadata["bytes_out_destination"] = traffic.toDestination
+	resource.Metadata["bytes_out_source"] = traffic.toSource
+	resource.Metadata["monthly_gb"] = monthlyGB
+	resource.Metadata["processing_monthly"] = processing
+	resource.Metadata["availability_zone"] = paths.zone
+	resource.Metadata["routed_subnets"] = paths.routed
+	resource.Metadata["cross_az_subnets"] = paths.crossAZ
+	resource.Metadata["missing_endpoints"] = paths.missingEndpoints
+	estimate.ApplyCost(resource, estimate.Monthly(pricePerHour)+processing)
+	resource.Metadata["analyzed"] = true
+
+	// An available gateway nothing routes through, or that stays quiet,
+	// only costs its hourly price
+	cleanupReason := ""
+	switch {
+	case resource.State != string(types.NatGatewayStateAvailable):
+	case len(paths.routed) == 0:
+		cleanupReason = "no route table sends traffic to it"
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+cleanupReason)
+	case traffic.total() < idleBytes:
+		cleanupReason = "idle, under 1 GB in 14 days"
+	}
+	resource.Metadata["should_cleanup"] = cleanupReason != ""
+	resource.Metadata["cleanup_reason"] = cleanupReason
+
+	switch {
+	case traffic.total() < idleBytes:
+		resource.AddIssue(core.SeverityLow, "Idle: under 1 GB in 14 days")
+	case processing >= s.hotspotMonthly:
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Processes %.0f GB/mo (%s/mo)", monthlyGB, estimate.FormatCost(processing)))
+		if len(paths.missingEndpoints) > 0 {
+			resource.AddIssue(core.SeverityMedium, fmt.Sprintf("No gateway endpoint for %s: that traffic is billed through the NAT", strings.Join(paths.missingEndpoints, ", ")))
+		}
+	}
+	if len(paths.crossAZ) > 0 && traffic.total() >= idleBytes {
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("Subnets in other AZs route through it: %d (cross-AZ transfer)", len(paths.crossAZ)))
+	}
+
+	return nil
+}
+
+// gatewayTraffic sums a gateway's CloudWatch byte counts.
+type gatewayTraffic struct {
+	toDestination float64 // Bytes sent out to the internet or peered networks
+	toSource      float64 // Bytes sent back to clients in the VPC
+}
+
+// total returns the bytes processed by the gateway.
+func (t gatewayTraffic) total() float64 {
+	return t.toDestination + t.toSource
+}
+
+// getTraffic fetches a gateway's BytesOut metrics in a single GetMetricData call.
+func (s *Service) getTraffic(ctx context.Context, gatewayID string, now time.Time) (gatewayTraffic, error) {
+	metric := func(id, name string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/NATGateway"),
+					MetricName: aws.String(name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: aws.String("NatGatewayId"), Value: aws.String(gatewayID)},
+					},
+				},
+				Period: aws.Int32(86400),
+				Stat:   aws.String("Sum"),
+			},
+		}
+	}
+
+	out, err := s.metrics().GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(now.Add(-metricsLookback)),
+		EndTime:   aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metric("to_destination", "BytesOutToDestination"),
+			metric("to_source", "BytesOutToSource"),
+		},
+	})
+	if err != nil {
+		return gatewayTraffic{}, err
+	}
+
+	var traffic gatewayTraffic
+	for _, result := range out.MetricDataResults {
+		total := 0.0
+		for _, v := range result.Values {
+			total += v
+		}
+		switch aws.ToString(result.Id) {
+		case "to_destination":
+			traffic.toDestination = total
+		case "to_source":
+			traffic.toSource = total
+		}
+	}
+	return traffic, nil
+}
+
+// gatewayPaths describes the subnets whose traffic goes through a gateway.
+type gatewayPaths struct {
+	zone             string   // Availability zone of the gateway
+	routed           []string // Subnets with a route to the gateway
+	crossAZ          []string // Routed subnets in another zone
+	missingEndpoints []string // Gateway endpoints missing from a routed subnet
+}
+
+// getPaths finds the subnets routed through a gateway from the route tables
+// of its VPC. Subnets without an explicit association use the main table.
+func (s *Service) getPaths(ctx context.Context, gatewayID, vpcID, gatewaySubnet string) (gatewayPaths, error) {
+	vpcFilter := []types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcID}}}
+
+	var tables []types.RouteTable
+	tablePages := ec2.NewDescribeRouteTablesPaginator(s.client(), &ec2.DescribeRouteTablesInput{Filters: vpcFilter})
+	for tablePages.HasMorePages() {
+		page, err := tablePages.NextPage(ctx)
+		if err != nil {
+			return gatewayPaths{}, err
+		}
+		tables = append(tables, page.RouteTables...)
+	}
+
+	zones := make(map[string]string) // subnet ID -> availability zone
+	var subnets []string
+	subnetPages := ec2.NewDescribeSubnetsPaginator(s.client(), &ec2.DescribeSubnetsInput{Filters: vpcFilter})
+	for subnetPages.HasMorePages() {
+		page, err := subnetPages.NextPage(ctx)
+		if err != nil {
+			return gatewayPaths{}, err
+		}
+		for _, subnet := range page.Subnets {
+			zones[aws.ToString(subnet.SubnetId)] = aws.ToString(subnet.AvailabilityZone)
+			subnets = append(subnets, aws.ToString(subnet.SubnetId))
+		}
+	}
+
+	var endpoints []types.VpcEndpoint
+	endpointPages := ec2.NewDescribeVpcEndpointsPaginator(s.client(), &ec2.DescribeVpcEndpointsInput{Filters: vpcFilter})
+	for endpointPages.HasMorePages() {
+		page, err := endpointPages.NextPage(ctx)
+		if err != nil {
+			return gatewayPaths{}, err
+		}
+		endpoints = append(endpoints, page.VpcEndpoints...)
+	}
+
+	tableOf, routesToGateway := routeTables(tables, gatewayID)
+	paths := gatewayPaths{zone: zones[gatewaySubnet]}
+	routedTables := make(map[string]bool)
+	for _, subnet := range subnets {
+		table := tableOf(subnet)
+		if !routesToGateway[table] {
+			continue
+		}
+		routedTables[table] = true
+		paths.routed = append(paths.routed, subnet)
+		if zones[subnet] != paths.zone {
+			paths.crossAZ = append(paths.crossAZ, subnet)
+		}
+	}
+
+	for _, service := range gatewayEndpoints {
+		covered := make(map[string]bool)
+		for _, ep := range endpoints {
+			if ep.VpcEndpointType == types.VpcEndpointTypeGateway && strings.HasSuffix(aws.ToString(ep.ServiceName), "."+service) {
+				for _, table := range ep.RouteTableIds {
+					covered[table] = true
+				}
+			}
+		}
+		for table := range routedTables {
+			if !covered[table] {
+				paths.missingEndpoints = append(paths.missingEndpoints, service)
+				break
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// routeTables returns the route table of each subnet and the tables with a
+// route to the gateway.
+func routeTables(tables []types.RouteTable, gatewayID string) (func(subnet string) string, map[string]bool) {
+	explicit := make(map[string]string)
+	routesToGateway := make(map[string]bool)
+	var main string
+	for _, table := range tables {
+		id := aws.ToString(table.RouteTableId)
+		for _, assoc := range table.Associations {
+			if aws.ToBool(assoc.Main) {
+				main = id
+			} else if subnet := aws.ToString(assoc.SubnetId); subnet != "" {
+				explicit[subnet] = id
+			}
+		}
+		routesToGateway[id] = slices.ContainsFunc(table.Routes, func(r types.Route) bool {
+			return aws.ToString(r.NatGatewayId) == gatewayID
+		})
+	}
+
+	return func(subnet string) string {
+		if table, ok := explicit[subnet]; ok {
+			return table
+		}
+		return main
+	}, routesToGateway
+}
+
+func gatewayToResource(gw types.NatGateway, now time.Time) core.Resource {
+	resource := core.Resource{
+		ID:        aws.ToString(gw.NatGatewayId),
+		Type:      "ec2:natgateway",
+		State:     string(gw.State),
+		Tags:      make(map[string]string),
+		CreatedAt: gw.CreateTime,
+		Metadata: map[string]any{
+			"vpc_id":       aws.ToString(gw.VpcId),
+			"subnet_id":    aws.ToString(gw.SubnetId),
+			"connectivity": string(gw.ConnectivityType),
+			"analyzed":     false,
+		},
+	}
+
+	for _, addr := range gw.NatGatewayAddresses {
+		if aws.ToBool(addr.IsPrimary) || len(gw.NatGatewayAddresses) == 1 {
+			resource.Metadata["public_ip"] = aws.ToString(addr.PublicIp)
+			resource.Metadata["private_ip"] = aws.ToString(addr.PrivateIp)
+		}
+	}
+
+	for _, tag := range gw.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" {
+			resource.Name = value
+		}
+	}
+	if resource.Name == "" {
+		resource.Name = resource.ID
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+
+	return resource
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "nat", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "nat", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.CleanupFlagger   = (*Service)(nil)
+)
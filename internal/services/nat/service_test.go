@@ -0,0 +1,221 @@
+package nat
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves nat-a, in the public subnet of us-east-1a, and nat-b,
+// which no route table uses. The main route table sends subnet-b, in
+// us-east-1b, through nat-a and so does the table of subnet-a, the only
+// one with an S3 gateway endpoint. subnet-c goes out through an internet
+// gateway. It fails every call when err is set.
+type fakeEC2 struct {
+	err error
+}
+
+func (f *fakeEC2) DescribeNatGateways(context.Context, *ec2.DescribeNatGatewaysInput, ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	gateway := func(id string) types.NatGateway {
+		return types.NatGateway{
+			NatGatewayId:     aws.String(id),
+			VpcId:            aws.String("vpc-1"),
+			SubnetId:         aws.String("subnet-public"),
+			State:            types.NatGatewayStateAvailable,
+			ConnectivityType: types.ConnectivityTypePublic,
+			NatGatewayAddresses: []types.NatGatewayAddress{
+				{PublicIp: aws.String("203.0.113.10"), PrivateIp: aws.String("10.0.0.10")},
+			},
+		}
+	}
+	named := gateway("nat-a")
+	named.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("egress-a")}}
+	return &ec2.DescribeNatGatewaysOutput{NatGateways: []types.NatGateway{named, gateway("nat-b")}}, nil
+}
+
+func (f *fakeEC2) DescribeRouteTables(context.Context, *ec2.DescribeRouteTablesInput, ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	table := func(id, subnet, nat string) types.RouteTable {
+		assoc := types.RouteTableAssociation{SubnetId: aws.String(subnet)}
+		if subnet == "" {
+			assoc = types.RouteTableAssociation{Main: aws.Bool(true)}
+		}
+		route := types.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")}
+		if nat != "" {
+			route = types.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String(nat)}
+		}
+		return types.RouteTable{RouteTableId: aws.String(id), Associations: []types.RouteTableAssociation{assoc}, Routes: []types.Route{route}}
+	}
+	return &ec2.DescribeRouteTablesOutput{RouteTables: []types.RouteTable{
+		table("rtb-main", "", "nat-a"),
+		table("rtb-a", "subnet-a", "nat-a"),
+		table("rtb-public", "subnet-public", ""),
+		table("rtb-c", "subnet-c", ""),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeSubnets(context.Context, *ec2.DescribeSubnetsInput, ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	subnet := func(id, zone string) types.Subnet {
+		return types.Subnet{SubnetId: aws.String(id), AvailabilityZone: aws.String(zone)}
+	}
+	return &ec2.DescribeSubnetsOutput{Subnets: []types.Subnet{
+		subnet("subnet-public", "us-east-1a"),
+		subnet("subnet-a", "us-east-1a"),
+		subnet("subnet-b", "us-east-1b"),
+		subnet("subnet-c", "us-east-1c"),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeVpcEndpoints(context.Context, *ec2.DescribeVpcEndpointsInput, ...func(*ec2.Options)) (*ec2.DescribeVpcEndpointsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []types.VpcEndpoint{{
+		VpcEndpointId:   aws.String("vpce-s3"),
+		VpcEndpointType: types.VpcEndpointTypeGateway,
+		ServiceName:     aws.String("com.amazonaws.us-east-1.s3"),
+		RouteTableIds:   []string{"rtb-a"},
+	}}}, nil
+}
+
+// fakeCloudWatch reports gib GiB sent out by every gateway over the
+// lookback, split evenly across its days.
+type fakeCloudWatch struct {
+	gib float64
+}
+
+func (f fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	daily := f.gib * bytesPerGB / 14
+	values := make([]float64, 14)
+	for i := range values {
+		values[i] = daily
+	}
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: []cwtypes.MetricDataResult{
+		{Id: aws.String("to_destination"), Values: values},
+		{Id: aws.String("to_source"), Values: []float64{0}},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against NAT
+// gateways.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d, WithMetricsClient(fakeCloudWatch{gib: 100}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		ExistingID: "nat-a",
+	})
+}
+
+func TestEnrichFlagsTraffic(t *testing.T) {
+	tests := []struct {
+		name    string
+		gib     float64
+		hotspot float64
+		issues  []string
+		cleanup bool
+	}{
+		{
+			name:    "idle",
+			gib:     0.5,
+			issues:  []string{"Idle: under 1 GB in 14 days"},
+			cleanup: true,
+		},
+		{
+			name:   "moderate",
+			gib:    100,
+			issues: []string{"Subnets in other AZs route through it: 1 (cross-AZ transfer)"},
+		},
+		{
+			name: "hotspot",
+			gib:  1400,
+			issues: []string{
+				"Processes 3000 GB/mo ($135.00/mo)",
+				"No gateway endpoint for s3, dynamodb: that traffic is billed through the NAT",
+				"Subnets in other AZs route through it: 1 (cross-AZ transfer)",
+			},
+		},
+		{
+			name:    "lower hotspot threshold",
+			gib:     100,
+			hotspot: 5,
+			issues: []string{
+				"Processes 214 GB/mo ($9.64/mo)",
+				"No gateway endpoint for s3, dynamodb: that traffic is billed through the NAT",
+				"Subnets in other AZs route through it: 1 (cross-AZ transfer)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		svc := NewServiceWithClient(&fakeEC2{}, nil, WithMetricsClient(fakeCloudWatch{gib: tt.gib}), WithHotspotMonthly(tt.hotspot))
+		resource := gatewayResource(t, svc, "nat-a")
+		if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+			t.Fatalf("%s: EnrichResource() error = %v", tt.name, err)
+		}
+
+		var messages []string
+		for _, issue := range resource.Issues() {
+			messages = append(messages, issue.Message)
+		}
+		if !slices.Equal(messages, tt.issues) {
+			t.Errorf("%s: issues = %q, want %q", tt.name, messages, tt.issues)
+		}
+		if resource.Metadata["should_cleanup"] != tt.cleanup {
+			t.Errorf("%s: should_cleanup = %v", tt.name, resource.Metadata["should_cleanup"])
+		}
+		if routed := resource.Metadata["routed_subnets"]; !slices.Equal(routed.([]string), []string{"subnet-a", "subnet-b"}) {
+			t.Errorf("%s: routed subnets = %v", tt.name, routed)
+		}
+	}
+}
+
+func TestEnrichFlagsUnroutedGateway(t *testing.T) {
+	svc := NewServiceWithClient(&fakeEC2{}, nil, WithMetricsClient(fakeCloudWatch{gib: 0}))
+	resource := gatewayResource(t, svc, "nat-b")
+	if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+
+	if resource.Metadata["cleanup_reason"] != "no route table sends traffic to it" {
+		t.Errorf("cleanup reason = %v", resource.Metadata["cleanup_reason"])
+	}
+	if missing := resource.Metadata["missing_endpoints"].([]string); len(missing) != 0 {
+		t.Errorf("missing endpoints = %v, want none without routed subnets", missing)
+	}
+}
+
+// gatewayResource lists the gateways and returns the one with the given ID.
+func gatewayResource(t *testing.T, svc *Service, id string) core.Resource {
+	t.Helper()
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	i := slices.IndexFunc(resources, func(r core.Resource) bool { return r.ID == id })
+	if i < 0 {
+		t.Fatalf("List() = %v, want %s", resources, id)
+	}
+	return resources[i]
+}
@@ -0,0 +1,262 @@
+package nat
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for NAT gateway data transfer.
+type View struct {
+	*base.EnrichableTableView
+}
+
+// NewView creates a new NAT gateway view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 24, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 10, MaxWidth: 30, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("VPC"), MinWidth: 12, MaxWidth: 22, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("AZ"), MinWidth: 6, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("GB/mo"), MinWidth: 7, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Subnets"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Cross-AZ"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Endpoints"), MinWidth: 9, MaxWidth: 18, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("NAT", "8", "nat", i18n.T("gateways"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Data transfer of %s", row.Name), formatPaths(row))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading NAT gateways...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[a]nalyze  [Enter]paths  [↑/↓]navigate  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the gateways, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	routed, _ := r.Metadata["routed_subnets"].([]string)
+	crossAZ, _ := r.Metadata["cross_az_subnets"].([]string)
+	missing, _ := r.Metadata["missing_endpoints"].([]string)
+
+	gb, subnets, cross, endpoints := "...", "...", "...", "..."
+	var gbValue, subnetsValue, crossValue any
+	if analyzed {
+		monthlyGB, _ := r.Metadata["monthly_gb"].(float64)
+		gbValue, subnetsValue, crossValue = monthlyGB, len(routed), len(crossAZ)
+		gb = fmt.Sprintf("%.0f", monthlyGB)
+		subnets = fmt.Sprintf("%d", len(routed))
+		cross = fmt.Sprintf("%d", len(crossAZ))
+		if len(crossAZ) > 0 {
+			cross = fmt.Sprintf("🟡 %d", len(crossAZ))
+		}
+		endpoints = "🟢 " + i18n.T("OK")
+		if len(missing) > 0 {
+			endpoints = "🟡 " + i18n.T("No %s", strings.Join(missing, ", "))
+		}
+	}
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 30)),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(r.GetMetadataString("vpc_id")),
+		base.TextCell(r.GetMetadataString("availability_zone")),
+		base.AgeCell(r),
+		base.LazyCell(gbValue, func() string { return gb }),
+		base.CostCell(r),
+		base.LazyCell(subnetsValue, func() string { return subnets }),
+		base.LazyCell(crossValue, func() string { return cross }),
+		base.TextCell(endpoints),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// formatPaths renders the traffic and routed subnets of a gateway for the
+// detail panel.
+func formatPaths(r *core.Resource) string {
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		return i18n.T("Not analyzed yet. Press [a] to analyze this gateway.\n")
+	}
+	routed, _ := r.Metadata["routed_subnets"].([]string)
+	crossAZ, _ := r.Metadata["cross_az_subnets"].([]string)
+	missing, _ := r.Metadata["missing_endpoints"].([]string)
+	toDestination, _ := r.Metadata["bytes_out_destination"].(float64)
+	toSource, _ := r.Metadata["bytes_out_source"].(float64)
+	monthlyGB, _ := r.Metadata["monthly_gb"].(float64)
+	processing, _ := r.Metadata["processing_monthly"].(float64)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Gateway:         %s (%s)\n", r.ID, r.GetMetadataString("availability_zone"))
+	fmt.Fprintf(&b, "VPC:             %s\n", r.GetMetadataString("vpc_id"))
+	fmt.Fprintf(&b, "Public IP:       %s\n\n", r.GetMetadataString("public_ip"))
+	fmt.Fprintf(&b, "Out to internet: %.1f GB (14 days)\n", toDestination/bytesPerGB)
+	fmt.Fprintf(&b, "Back to VPC:     %.1f GB (14 days)\n", toSource/bytesPerGB)
+	fmt.Fprintf(&b, "Processed:       %.0f GB/mo, %s/mo\n", monthlyGB, estimate.FormatCost(processing))
+
+	b.WriteString(i18n.T("\nRouted subnets:\n"))
+	if len(routed) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, subnet := range routed {
+		suffix := ""
+		for _, cross := range crossAZ {
+			if cross == subnet {
+				suffix = "  (cross-AZ)"
+			}
+		}
+		fmt.Fprintf(&b, "  %s%s\n", subnet, suffix)
+	}
+
+	if len(missing) > 0 {
+		b.WriteString(i18n.T("\nVPC endpoint candidates:\n"))
+		for _, service := range missing {
+			fmt.Fprintf(&b, "  %s gateway endpoint (free): add it to the route tables of the subnets above\n", service)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	total := len(v.Resources)
+	hotspots := 0
+	for _, r := range v.Resources {
+		if r.Severity().Rank() >= core.SeverityMedium.Rank() {
+			hotspots++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "hotspots", Text: i18n.T("Hotspots: %d", hotspots), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("NAT Gateways"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "nat" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
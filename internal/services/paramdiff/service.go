@@ -0,0 +1,587 @@
+// Package paramdiff compares configuration between environments for the a9s
+// application. It diffs SSM parameters or Secrets Manager secrets under two
+// prefixes, such as /app/staging and /app/prod, optionally read through two
+// AWS profiles to compare accounts. Values are never shown: each side is
+// summarized by its length and a keyed fingerprint, enough to tell whether
+// two values match.
+package paramdiff
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// Sources of compared values.
+const (
+	SourceSSM     = "ssm"
+	SourceSecrets = "secrets"
+)
+
+// Statuses of a compared key, set as the resource State.
+const (
+	StatusSame         = "same"
+	StatusDiffers      = "differs"
+	StatusMissingLeft  = "missing-left"  // Only under the right prefix
+	StatusMissingRight = "missing-right" // Only under the left prefix
+)
+
+// Filter keys describing a comparison in core.ListOptions.Filters.
+const (
+	FilterSource       = "source"
+	FilterLeft         = "left"
+	FilterRight        = "right"
+	FilterLeftProfile  = "left_profile"
+	FilterRightProfile = "right_profile"
+)
+
+// secretKeySeparator joins a secret's name and one of its JSON fields. It
+// cannot appear in secret names.
+const secretKeySeparator = "#"
+
+// =============================================================================
+// Comparison
+// =============================================================================
+
+// Side is one environment of a comparison.
+type Side struct {
+	Prefix  string
+	Profile string // AWS profile to read through; the current one when empty
+}
+
+// Label names the side in the view, with its profile when it has one.
+func (s Side) Label() string {
+	if s.Profile == "" {
+		return s.Prefix
+	}
+	return s.Profile + ":" + s.Prefix
+}
+
+// Comparison describes what to compare.
+type Comparison struct {
+	Source string // SourceSSM or SourceSecrets
+	Left   Side
+	Right  Side
+}
+
+// ParseComparison reads a comparison from list filters.
+func ParseComparison(filters map[string]string) (Comparison, error) {
+	c := Comparison{
+		Source: strings.ToLower(strings.TrimSpace(filters[FilterSource])),
+		Left:   Side{Prefix: strings.TrimSpace(filters[FilterLeft]), Profile: strings.TrimSpace(filters[FilterLeftProfile])},
+		Right:  Side{Prefix: strings.TrimSpace(filters[FilterRight]), Profile: strings.TrimSpace(filters[FilterRightProfile])},
+	}
+	if c.Source == "" {
+		c.Source = SourceSSM
+	}
+	return c, c.Validate()
+}
+
+// Filters renders the comparison as list filters.
+func (c Comparison) Filters() map[string]string {
+	filters := map[string]string{
+		FilterSource: c.Source,
+		FilterLeft:   c.Left.Prefix,
+		FilterRight:  c.Right.Prefix,
+	}
+	if c.Left.Profile != "" {
+		filters[FilterLeftProfile] = c.Left.Profile
+	}
+	if c.Right.Profile != "" {
+		filters[FilterRightProfile] = c.Right.Profile
+	}
+	return filters
+}
+
+// IsZero reports whether no prefixes are set.
+func (c Comparison) IsZero() bool {
+	return c.Left.Prefix == "" && c.Right.Prefix == ""
+}
+
+// Validate checks that the comparison can be run.
+func (c Comparison) Validate() error {
+	if c.Source != SourceSSM && c.Source != SourceSecrets {
+		return core.NewValidationError(FilterSource, c.Source, `must be "ssm" or "secrets"`)
+	}
+	for _, side := range []struct {
+		field  string
+		prefix string
+	}{{FilterLeft, c.Left.Prefix}, {FilterRight, c.Right.Prefix}} {
+		if side.prefix == "" {
+			return core.NewValidationError(side.field, nil, "a prefix is required")
+		}
+		if c.Source == SourceSSM && !strings.HasPrefix(side.prefix, "/") {
+			return core.NewValidationError(side.field, side.prefix, "parameter paths start with /")
+		}
+	}
+	if c.Left == c.Right {
+		return core.NewValidationError(FilterRight, c.Right.Prefix, "compares the left side with itself")
+	}
+	return nil
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service compares parameters or secrets between two prefixes.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	defaults   Comparison
+
+	// fingerprintKey keys value fingerprints, so that they only compare
+	// values within one run and cannot be looked up
+	fingerprintKey []byte
+
+	ssmClient     SSMAPI
+	secretsClient SecretsAPI
+}
+
+// Option configures the comparison service.
+type Option func(*Service)
+
+// WithDefaultComparison sets the comparison run when the view opens.
+func WithDefaultComparison(c Comparison) Option {
+	return func(s *Service) {
+		s.defaults = c
+	}
+}
+
+// WithSSMClient sets a custom SSM client for both sides (for testing).
+func WithSSMClient(client SSMAPI) Option {
+	return func(s *Service) {
+		s.ssmClient = client
+	}
+}
+
+// WithSecretsClient sets a custom Secrets Manager client for both sides
+// (for testing).
+func WithSecretsClient(client SecretsAPI) Option {
+	return func(s *Service) {
+		s.secretsClient = client
+	}
+}
+
+// SSMAPI defines the SSM client interface for mocking.
+type SSMAPI interface {
+	GetParametersByPath(ctx context.Context, params *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error)
+}
+
+// SecretsAPI defines the Secrets Manager client interface for mocking.
+type SecretsAPI interface {
+	ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// NewService creates a new comparison service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	s := &Service{
+		factory:        factory,
+		dispatcher:     dispatcher,
+		fingerprintKey: key,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// DefaultComparison returns the comparison run when the view opens, if any.
+func (s *Service) DefaultComparison() Comparison {
+	return s.defaults
+}
+
+// ssmAPI returns the SSM client reading through a profile.
+func (s *Service) ssmAPI(ctx context.Context, profile string) (SSMAPI, error) {
+	if s.ssmClient != nil {
+		return s.ssmClient, nil
+	}
+	cfg, err := s.factory.ProfileConfig(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return ssm.NewFromConfig(cfg), nil
+}
+
+// secretsAPI returns the Secrets Manager client reading through a profile.
+func (s *Service) secretsAPI(ctx context.Context, profile string) (SecretsAPI, error) {
+	if s.secretsClient != nil {
+		return s.secretsClient, nil
+	}
+	cfg, err := s.factory.ProfileConfig(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return secretsmanager.NewFromConfig(cfg), nil
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "paramdiff"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Parameter and Secret Diff"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "diff"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	client, err := s.ssmAPI(ctx, "")
+	if err == nil {
+		_, err = client.GetParametersByPath(ctx, &ssm.GetParametersByPathInput{
+			Path:       aws.String("/"),
+			MaxResults: aws.Int32(1),
+		})
+	}
+	if err != nil {
+		return core.NewServiceError("paramdiff", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List compares the two sides described by opts.Filters (see
+// ParseComparison), or the default comparison when no filters are given.
+// Each key found on either side is one resource, identified by its name
+// relative to the prefixes; differences come first.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	c := s.defaults
+	if len(opts.Filters) > 0 {
+		var err error
+		if c, err = ParseComparison(opts.Filters); err != nil {
+			return nil, core.NewServiceError("paramdiff", "list", err)
+		}
+	} else if err := c.Validate(); err != nil {
+		return nil, core.NewServiceError("paramdiff", "list", err)
+	}
+
+	left, err := s.load(ctx, c.Source, c.Left)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("paramdiff", "list", fmt.Errorf("%s: %w", c.Left.Label(), err))
+	}
+	right, err := s.load(ctx, c.Source, c.Right)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("paramdiff", "list", fmt.Errorf("%s: %w", c.Right.Label(), err))
+	}
+
+	resources := Compare(c, left, right)
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "paramdiff:key",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// Loading
+// =============================================================================
+
+// Entry is a value found on one side, summarized without the value itself.
+type Entry struct {
+	Name         string // Full parameter or secret name
+	Type         string // String, StringList, SecureString, or secret/json for secrets
+	Length       int    // Characters of the value
+	Fingerprint  string // Keyed hash of the value
+	Version      int64
+	LastModified *time.Time
+}
+
+// load returns the entries under a side's prefix, keyed by their name
+// relative to the prefix.
+func (s *Service) load(ctx context.Context, source string, side Side) (map[string]Entry, error) {
+	if source == SourceSecrets {
+		return s.loadSecrets(ctx, side)
+	}
+	return s.loadParameters(ctx, side)
+}
+
+// loadParameters reads the parameters under a path, decrypting SecureString
+// values to fingerprint them.
+func (s *Service) loadParameters(ctx context.Context, side Side) (map[string]Entry, error) {
+	client, err := s.ssmAPI(ctx, side.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]Entry)
+	paginator := ssm.NewGetParametersByPathPaginator(client, &ssm.GetParametersByPathInput{
+		Path:           aws.String(side.Prefix),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range page.Parameters {
+			name := aws.ToString(p.Name)
+			value := aws.ToString(p.Value)
+			entries[relativeKey(name, side.Prefix)] = Entry{
+				Name:         name,
+				Type:         string(p.Type),
+				Length:       len([]rune(value)),
+				Fingerprint:  s.fingerprint(value),
+				Version:      p.Version,
+				LastModified: p.LastModifiedDate,
+			}
+		}
+	}
+	return entries, nil
+}
+
+// loadSecrets reads the secrets whose name starts with a prefix. Secrets
+// holding a JSON object are split into one entry per field, named
+// secret#field, so that a field missing on one side shows as such.
+func (s *Service) loadSecrets(ctx context.Context, side Side) (map[string]Entry, error) {
+	client, err := s.secretsAPI(ctx, side.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []smtypes.SecretListEntry
+	paginator := secretsmanager.NewListSecretsPaginator(client, &secretsmanager.ListSecretsInput{
+		Filters: []smtypes.Filter{{Key: smtypes.FilterNameStringTypeName, Values: []string{side.Prefix}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range page.SecretList {
+			// The name filter ignores case
+			if strings.HasPrefix(aws.ToString(secret.Name), side.Prefix) {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+
+	entries := make(map[string]Entry)
+	for _, secret := range secrets {
+		name := aws.ToString(secret.Name)
+		out, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: secret.ARN})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		key := relativeKey(name, side.Prefix)
+		modified := secret.LastChangedDate
+		value := aws.ToString(out.SecretString)
+		if out.SecretString == nil {
+			value = string(out.SecretBinary)
+		}
+
+		var fields map[string]any
+		if json.Unmarshal([]byte(value), &fields) == nil && len(fields) > 0 {
+			for field, v := range fields {
+				text, ok := v.(string)
+				if !ok {
+					raw, _ := json.Marshal(v)
+					text = string(raw)
+				}
+				entries[key+secretKeySeparator+field] = Entry{
+					Name:         name + secretKeySeparator + field,
+					Type:         "secret/json",
+					Length:       len([]rune(text)),
+					Fingerprint:  s.fingerprint(text),
+					LastModified: modified,
+				}
+			}
+			continue
+		}
+		entries[key] = Entry{
+			Name:         name,
+			Type:         "secret",
+			Length:       len([]rune(value)),
+			Fingerprint:  s.fingerprint(value),
+			LastModified: modified,
+		}
+	}
+	return entries, nil
+}
+
+// fingerprint returns a short keyed hash of a value. The key is random per
+// run, so low-entropy values cannot be recovered from their fingerprint.
+func (s *Service) fingerprint(value string) string {
+	mac := hmac.New(sha256.New, s.fingerprintKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))[:12]
+}
+
+// =============================================================================
+// Comparison
+// =============================================================================
+
+// Compare builds one resource per key found on either side, sorted with
+// missing keys first, then differing values, then by key.
+func Compare(c Comparison, left, right map[string]Entry) []core.Resource {
+	keys := slices.Collect(maps.Keys(left))
+	for key := range right {
+		if _, ok := left[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	resources := make([]core.Resource, 0, len(keys))
+	for _, key := range keys {
+		l, inLeft := left[key]
+		r, inRight := right[key]
+		resources = append(resources, compareKey(c, key, l, inLeft, r, inRight))
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		ri, rj := statusRank(resources[i].State), statusRank(resources[j].State)
+		if ri != rj {
+			return ri < rj
+		}
+		return resources[i].ID < resources[j].ID
+	})
+	return resources
+}
+
+// compareKey builds the resource of one key.
+func compareKey(c Comparison, key string, l Entry, inLeft bool, r Entry, inRight bool) core.Resource {
+	resource := core.Resource{
+		ID:       key,
+		Type:     "paramdiff:key",
+		Name:     key,
+		Tags:     make(map[string]string),
+		Metadata: map[string]any{"source": c.Source},
+	}
+	if inLeft {
+		resource.Metadata["left"] = l
+	}
+	if inRight {
+		resource.Metadata["right"] = r
+	}
+
+	switch {
+	case !inLeft:
+		resource.State = StatusMissingLeft
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Missing in %s", c.Left.Label()))
+	case !inRight:
+		resource.State = StatusMissingRight
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Missing in %s", c.Right.Label()))
+	case l.Fingerprint != r.Fingerprint:
+		resource.State = StatusDiffers
+		resource.AddIssue(core.SeverityInfo, "Values differ")
+	default:
+		resource.State = StatusSame
+	}
+
+	// A secret stored in plain text on one side only is worth a look
+	if inLeft && inRight && l.Type != r.Type && (l.Type == "SecureString" || r.Type == "SecureString") {
+		plain := c.Left.Label()
+		if l.Type == "SecureString" {
+			plain = c.Right.Label()
+		}
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Not encrypted in %s (%s vs %s)", plain, l.Type, r.Type))
+	}
+	return resource
+}
+
+// statusRank orders statuses with missing keys first.
+func statusRank(status string) int {
+	switch status {
+	case StatusMissingLeft, StatusMissingRight:
+		return 0
+	case StatusDiffers:
+		return 1
+	}
+	return 2
+}
+
+// relativeKey returns a name relative to a prefix, without a leading
+// separator.
+func relativeKey(name, prefix string) string {
+	key := strings.TrimPrefix(name, prefix)
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return name
+	}
+	return key
+}
+
+// EntryOf returns the entry of a side stored on a resource built by Compare.
+func EntryOf(r *core.Resource, side string) (Entry, bool) {
+	e, ok := r.Metadata[side].(Entry)
+	return e, ok
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "paramdiff", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "paramdiff", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+)
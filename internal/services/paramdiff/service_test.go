@@ -0,0 +1,229 @@
+package paramdiff
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// stagingVsProd compares the parameters of the staging and production
+// environments of the app.
+var stagingVsProd = Comparison{
+	Source: SourceSSM,
+	Left:   Side{Prefix: "/app/staging"},
+	Right:  Side{Prefix: "/app/prod"},
+}
+
+// fakeSSM serves parameters by name, returning those under the requested
+// path. It fails every call when err is set.
+type fakeSSM struct {
+	err        error
+	parameters []ssmtypes.Parameter
+}
+
+func newFakeSSM() *fakeSSM {
+	parameter := func(name, value string, parameterType ssmtypes.ParameterType) ssmtypes.Parameter {
+		return ssmtypes.Parameter{Name: aws.String(name), Value: aws.String(value), Type: parameterType, Version: 1}
+	}
+	return &fakeSSM{parameters: []ssmtypes.Parameter{
+		parameter("/app/staging/db/host", "db.staging.internal", ssmtypes.ParameterTypeString),
+		parameter("/app/staging/db/password", "hunter2", ssmtypes.ParameterTypeSecureString),
+		parameter("/app/staging/feature/beta", "on", ssmtypes.ParameterTypeString),
+		parameter("/app/staging/log_level", "info", ssmtypes.ParameterTypeString),
+		parameter("/app/prod/db/host", "db.prod.internal", ssmtypes.ParameterTypeString),
+		parameter("/app/prod/db/password", "hunter2", ssmtypes.ParameterTypeString),
+		parameter("/app/prod/log_level", "info", ssmtypes.ParameterTypeString),
+		parameter("/app/prod/region", "eu-west-1", ssmtypes.ParameterTypeString),
+	}}
+}
+
+func (f *fakeSSM) GetParametersByPath(_ context.Context, in *ssm.GetParametersByPathInput, _ ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := &ssm.GetParametersByPathOutput{}
+	for _, p := range f.parameters {
+		if strings.HasPrefix(aws.ToString(p.Name), aws.ToString(in.Path)+"/") {
+			out.Parameters = append(out.Parameters, p)
+		}
+	}
+	return out, nil
+}
+
+// fakeSecrets serves staging/api and prod/api, holding JSON objects,
+// staging/cert, holding a certificate, and STAGING/legacy, which the name
+// filter matches regardless of case. It fails every call when err is set.
+type fakeSecrets struct {
+	err error
+}
+
+var secretValues = map[string]string{
+	"staging/api":    `{"token":"abc123","url":"https://api.staging","retries":3}`,
+	"prod/api":       `{"token":"xyz789","retries":3}`,
+	"staging/cert":   "-----BEGIN CERTIFICATE-----",
+	"STAGING/legacy": "old",
+}
+
+func (f *fakeSecrets) ListSecrets(_ context.Context, in *secretsmanager.ListSecretsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	prefix := strings.ToLower(in.Filters[0].Values[0])
+	out := &secretsmanager.ListSecretsOutput{}
+	for _, name := range slices.Sorted(maps.Keys(secretValues)) {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			out.SecretList = append(out.SecretList, smtypes.SecretListEntry{
+				Name: aws.String(name),
+				ARN:  aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:" + name),
+			})
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSecrets) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	name := strings.TrimPrefix(aws.ToString(in.SecretId), "arn:aws:secretsmanager:us-east-1:123456789012:secret:")
+	value, ok := secretValues[name]
+	if !ok {
+		return nil, errors.New("ResourceNotFoundException")
+	}
+	return &secretsmanager.GetSecretValueOutput{Name: aws.String(name), SecretString: aws.String(value)}, nil
+}
+
+// TestServiceConformance runs the core service contract against a
+// comparison of parameters.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewService(nil, d, WithSSMClient(newFakeSSM()))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewService(nil, d, WithSSMClient(&fakeSSM{err: errors.New("AccessDeniedException")}))
+		},
+		ListOptions: core.ListOptions{Filters: stagingVsProd.Filters()},
+		ExistingID:  "db/host",
+	})
+}
+
+func TestListComparesParameters(t *testing.T) {
+	svc := NewService(nil, nil, WithSSMClient(newFakeSSM()), WithDefaultComparison(stagingVsProd))
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []struct {
+		key    string
+		status string
+		issues []string
+	}{
+		{"feature/beta", StatusMissingRight, []string{"Missing in /app/prod"}},
+		{"region", StatusMissingLeft, []string{"Missing in /app/staging"}},
+		{"db/host", StatusDiffers, []string{"Values differ"}},
+		{"db/password", StatusSame, []string{"Not encrypted in /app/prod (SecureString vs String)"}},
+		{"log_level", StatusSame, nil},
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("List() = %d keys, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		var issues []string
+		for _, issue := range r.Issues() {
+			issues = append(issues, issue.Message)
+		}
+		if r.ID != want[i].key || r.State != want[i].status || !slices.Equal(issues, want[i].issues) {
+			t.Errorf("key %d = %s %s %q, want %s %s %q", i, r.ID, r.State, issues, want[i].key, want[i].status, want[i].issues)
+		}
+	}
+
+	host := resources[2]
+	left, _ := EntryOf(&host, "left")
+	right, _ := EntryOf(&host, "right")
+	if left.Name != "/app/staging/db/host" || left.Length != len("db.staging.internal") || right.Length != len("db.prod.internal") {
+		t.Errorf("db/host entries = %+v, %+v", left, right)
+	}
+	if strings.Contains(left.Fingerprint, "staging") || len(left.Fingerprint) != 12 {
+		t.Errorf("fingerprint %q", left.Fingerprint)
+	}
+}
+
+// TestListSplitsJSONSecrets checks that the fields of JSON secrets are
+// compared one by one, and that names matching the prefix in another case
+// are left out.
+func TestListSplitsJSONSecrets(t *testing.T) {
+	c := Comparison{
+		Source: SourceSecrets,
+		Left:   Side{Prefix: "staging/"},
+		Right:  Side{Prefix: "prod/", Profile: "prod-account"},
+	}
+	resources, err := NewService(nil, nil, WithSecretsClient(&fakeSecrets{})).List(context.Background(), core.ListOptions{Filters: c.Filters()})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	got := make(map[string]string)
+	for _, r := range resources {
+		got[r.ID] = r.State
+	}
+	want := map[string]string{
+		"api#token":   StatusDiffers,
+		"api#url":     StatusMissingRight,
+		"api#retries": StatusSame,
+		"cert":        StatusMissingRight,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+	for key, status := range want {
+		if got[key] != status {
+			t.Errorf("%s = %q, want %q", key, got[key], status)
+		}
+	}
+	if issues := resources[0].Issues(); len(issues) != 1 || issues[0].Message != "Missing in prod-account:prod/" {
+		t.Errorf("%s issues = %v", resources[0].ID, issues)
+	}
+}
+
+func TestParseComparison(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters map[string]string
+		source  string // Source of a valid comparison
+		field   string // Field of the validation error
+	}{
+		{"ssm by default", map[string]string{FilterLeft: "/app/staging", FilterRight: "/app/prod"}, SourceSSM, ""},
+		{"unknown source", map[string]string{FilterSource: "parameters", FilterLeft: "/a", FilterRight: "/b"}, "", FilterSource},
+		{"missing prefix", map[string]string{FilterLeft: "/app/staging"}, "", FilterRight},
+		{"relative path", map[string]string{FilterLeft: "app/staging", FilterRight: "/app/prod"}, "", FilterLeft},
+		{"secrets need no slash", map[string]string{FilterSource: "Secrets", FilterLeft: "staging/", FilterRight: "prod/"}, SourceSecrets, ""},
+		{"same side", map[string]string{FilterLeft: "/app", FilterRight: " /app "}, "", FilterRight},
+		{"same prefix in two accounts", map[string]string{FilterLeft: "/app", FilterRight: "/app", FilterRightProfile: "prod"}, SourceSSM, ""},
+	}
+	for _, tt := range tests {
+		c, err := ParseComparison(tt.filters)
+		var validation *core.ValidationError
+		switch {
+		case tt.field == "" && err != nil:
+			t.Errorf("%s: ParseComparison() error = %v", tt.name, err)
+		case tt.field != "" && (!errors.As(err, &validation) || validation.Field != tt.field):
+			t.Errorf("%s: ParseComparison() error = %v, want one on %s", tt.name, err, tt.field)
+		case c.Source != tt.source && tt.field == "":
+			t.Errorf("%s: source = %q, want %q", tt.name, c.Source, tt.source)
+		}
+	}
+}
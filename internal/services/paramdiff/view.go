@@ -0,0 +1,421 @@
+package paramdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const compareFormID = "paramdiff:compare"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view comparing parameters or secrets between two
+// prefixes. Identical keys are hidden until [a] shows them.
+type View struct {
+	*base.TableView
+
+	comparison     Comparison
+	comparisonInit bool
+	all            []core.Resource // Every compared key; Resources holds the shown ones
+	showSame       bool
+}
+
+// NewView creates a new comparison view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Key"), MinWidth: 16, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Status"), MinWidth: 14, MaxWidth: 40, Weight: 1.0, Priority: 0},
+		{Title: i18n.T("Left"), MinWidth: 12, MaxWidth: 24, Weight: 0.6, Priority: 1},
+		{Title: i18n.T("Right"), MinWidth: 12, MaxWidth: 24, Weight: 0.6, Priority: 1},
+		{Title: i18n.T("Type"), MinWidth: 8, MaxWidth: 26, Weight: 0.5, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Diff", "", "paramdiff", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and runs the configured comparison, or asks
+// for one.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.all) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.compare()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "c":
+			return v, v.openCompareForm()
+		case "w":
+			if !v.comparison.IsZero() {
+				v.comparison.Left, v.comparison.Right = v.comparison.Right, v.comparison.Left
+				v.clear()
+				return v, v.compare()
+			}
+		case "a":
+			v.showSame = !v.showSame
+			v.applyVisibility()
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Key %s", row.ID), formatDetail(row, v.comparison))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != compareFormID {
+			break
+		}
+		if msg.Canceled {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		filters := make(map[string]string, len(msg.Values))
+		for k, val := range msg.Values {
+			filters[k] = fmt.Sprint(val)
+		}
+		c, err := ParseComparison(filters)
+		if err != nil {
+			v.Message = i18n.T("Invalid comparison: %v", err)
+			break
+		}
+		v.comparison = c
+		v.clear()
+		cmds = append(cmds, v.compare())
+
+	case comparedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.all = msg.resources
+			v.applyVisibility()
+			v.Message = i18n.T("Compared %d keys", len(msg.resources))
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.comparison.IsZero() {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Press [c] to choose the prefixes to compare.")))
+	} else if v.IsLoading() && len(v.all) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Comparing %s with %s...", v.comparison.Left.Label(), v.comparison.Right.Label())))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[c]ompare  s[w]ap sides  [a]ll keys  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh runs the comparison again.
+func (v *View) Refresh() tea.Cmd {
+	return v.compare()
+}
+
+// SaveState implements core.StatefulView. A comparison equal to the
+// configured default is not saved, so later changes to it still apply.
+func (v *View) SaveState() core.ViewState {
+	state := v.TableView.SaveState()
+	if v.comparison.IsZero() {
+		return state
+	}
+	if svc, ok := v.Service().(*Service); !ok || v.comparison != svc.DefaultComparison() {
+		state.Filters = v.comparison.Filters()
+	}
+	return state
+}
+
+// RestoreState implements core.StatefulView. A restored comparison replaces
+// the configured default.
+func (v *View) RestoreState(state core.ViewState) {
+	v.TableView.RestoreState(state)
+	if len(state.Filters) == 0 {
+		return
+	}
+	if c, err := ParseComparison(state.Filters); err == nil {
+		v.comparison = c
+		v.comparisonInit = true
+	}
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type comparedMsg struct {
+	owner     *View // Comparisons of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+// compare runs the current comparison, starting from the configured one.
+func (v *View) compare() tea.Cmd {
+	if !v.comparisonInit {
+		if svc, ok := v.Service().(*Service); ok {
+			v.comparison = svc.DefaultComparison()
+		}
+		v.comparisonInit = true
+	}
+	if v.comparison.IsZero() {
+		return nil
+	}
+
+	filters := v.comparison.Filters()
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return comparedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return comparedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{Filters: filters})
+		return comparedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+// openCompareForm asks for the source, prefixes and profiles to compare,
+// starting from the current comparison.
+func (v *View) openCompareForm() tea.Cmd {
+	c := v.comparison
+	sources := []string{SourceSSM, SourceSecrets}
+	if c.Source == SourceSecrets {
+		sources = []string{SourceSecrets, SourceSSM}
+	}
+	params := []core.ActionParameter{
+		{Name: FilterSource, Type: "select", Options: sources, Description: i18n.T("SSM parameters or Secrets Manager secrets")},
+		{Name: FilterLeft, Type: "string", Required: true, Default: c.Left.Prefix, Description: i18n.T("Left prefix, e.g. /app/staging")},
+		{Name: FilterRight, Type: "string", Required: true, Default: c.Right.Prefix, Description: i18n.T("Right prefix, e.g. /app/prod")},
+		{Name: FilterLeftProfile, Type: "string", Default: c.Left.Profile, Description: i18n.T("AWS profile of the left side (current when empty)")},
+		{Name: FilterRightProfile, Type: "string", Default: c.Right.Profile, Description: i18n.T("AWS profile of the right side (current when empty)")},
+	}
+	return v.OpenForm(components.NewForm(compareFormID, i18n.T("Compare parameters or secrets"), params))
+}
+
+// clear drops the keys of the previous comparison.
+func (v *View) clear() {
+	v.all = nil
+	v.applyVisibility()
+}
+
+// applyVisibility shows every compared key, or only the differing ones.
+func (v *View) applyVisibility() {
+	if v.showSame {
+		v.Resources = v.all
+	} else {
+		v.Resources = make([]core.Resource, 0, len(v.all))
+		for _, r := range v.all {
+			if r.State != StatusSame {
+				v.Resources = append(v.Resources, r)
+			}
+		}
+	}
+	c := v.comparison
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i], c)
+	})
+}
+
+func buildRow(r core.Resource, c Comparison) base.Row {
+	left, inLeft := EntryOf(&r, "left")
+	right, inRight := EntryOf(&r, "right")
+
+	types := left.Type
+	if inLeft && inRight && left.Type != right.Type {
+		types = left.Type + " → " + right.Type
+	} else if !inLeft {
+		types = right.Type
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.ID, 60)),
+		base.LazyCell(statusRank(r.State), func() string { return formatStatus(r.State, c) }),
+		base.TextCell(formatEntry(left, inLeft)),
+		base.TextCell(formatEntry(right, inRight)),
+		base.TextCell(types),
+		base.SeverityCell(r),
+	}
+}
+
+// formatStatus describes the status of a key with the side labels.
+func formatStatus(status string, c Comparison) string {
+	switch status {
+	case StatusMissingLeft:
+		return "🔴 " + i18n.T("missing in %s", c.Left.Label())
+	case StatusMissingRight:
+		return "🔴 " + i18n.T("missing in %s", c.Right.Label())
+	case StatusDiffers:
+		return "🟡 " + i18n.T("differs")
+	}
+	return "🟢 " + i18n.T("same")
+}
+
+// formatEntry renders a masked value: its length and fingerprint.
+func formatEntry(e Entry, ok bool) string {
+	if !ok {
+		return "—"
+	}
+	return fmt.Sprintf("•••• %d · %s", e.Length, e.Fingerprint[:6])
+}
+
+// formatDetail renders both sides of a key for the detail panel, values
+// masked.
+func formatDetail(r *core.Resource, c Comparison) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Status:  %s\n", formatStatus(r.State, c))
+	for _, side := range []struct {
+		key   string
+		label string
+	}{{"left", c.Left.Label()}, {"right", c.Right.Label()}} {
+		fmt.Fprintf(&b, "\n%s\n", side.label)
+		e, ok := EntryOf(r, side.key)
+		if !ok {
+			b.WriteString(i18n.T("  (missing)\n"))
+			continue
+		}
+		fmt.Fprintf(&b, "  Name:         %s\n", e.Name)
+		fmt.Fprintf(&b, "  Type:         %s\n", e.Type)
+		fmt.Fprintf(&b, "  Length:       %d\n", e.Length)
+		fmt.Fprintf(&b, "  Fingerprint:  %s\n", e.Fingerprint)
+		if e.Version > 0 {
+			fmt.Fprintf(&b, "  Version:      %d\n", e.Version)
+		}
+		if e.LastModified != nil {
+			fmt.Fprintf(&b, "  Modified:     %s\n", e.LastModified.Local().Format("2006-01-02 15:04"))
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	b.WriteString(i18n.T("\nValues are masked; equal fingerprints mean equal values.\n"))
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider. Widgets stay empty, and
+// are skipped, until a comparison is set.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	missing, differs, same := 0, 0, 0
+	for _, r := range v.all {
+		switch r.State {
+		case StatusMissingLeft, StatusMissingRight:
+			missing++
+		case StatusDiffers:
+			differs++
+		default:
+			same++
+		}
+	}
+
+	widgets := []core.SummaryWidget{
+		{Name: "sides", Tone: core.ToneMuted},
+		{Name: "missing", Tone: core.ToneError},
+		{Name: "differ", Tone: core.ToneWarning},
+		{Name: "same", Tone: core.ToneMuted},
+	}
+	if !v.comparison.IsZero() {
+		widgets[0].Text = v.comparison.Left.Label() + " ↔ " + v.comparison.Right.Label()
+		widgets[1].Text = i18n.T("Missing: %d", missing)
+		widgets[2].Text = i18n.T("Differ: %d", differs)
+		widgets[3].Text = i18n.T("Same: %d", same)
+	}
+	return v.CommonWidgets(widgets...)
+}
+
+func (v *View) renderSummary() string {
+	title := i18n.T("Parameter Diff")
+	if v.comparison.Source == SourceSecrets {
+		title = i18n.T("Secret Diff")
+	}
+	return v.RenderSummary(title, v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "paramdiff" }
+
+var (
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.StatefulView  = (*View)(nil)
+	_ core.InputCapturer = (*View)(nil)
+	_ core.ViewFactory   = (*ViewFactory)(nil)
+)
@@ -0,0 +1,278 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// RestoreLatest is the restore time that restores to the latest restorable
+// time of the source instance.
+const RestoreLatest = "latest"
+
+// restoreTimeLayouts are the accepted restore times besides RestoreLatest,
+// read as UTC when they carry no zone.
+var restoreTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04"}
+
+// Snapshot is a manual or automated snapshot of a database.
+type Snapshot struct {
+	ID        string
+	Type      string // manual, automated, shared, ...
+	Status    string
+	Created   *time.Time
+	SizeGB    int32
+	Engine    string
+	Encrypted bool
+	Progress  int32 // Percent, while the snapshot is being created
+}
+
+// SnapshotList is the Data of the result of the "list_snapshots" action.
+type SnapshotList struct {
+	Database         string
+	Snapshots        []Snapshot // Newest first
+	RetentionDays    int32      // Automated backup retention; 0 when disabled
+	LatestRestorable *time.Time // Latest point-in-time restore target
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// listSnapshots returns the snapshots of an instance or cluster with its
+// point-in-time restore window.
+func (s *Service) listSnapshots(ctx context.Context, id string) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("list_snapshots", id, err)
+	}
+
+	name, isCluster := splitID(id)
+	client := s.client()
+	list := SnapshotList{Database: name}
+
+	if isCluster {
+		out, err := client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(name)})
+		if err != nil {
+			return fail(err)
+		}
+		if len(out.DBClusters) == 0 {
+			return fail(core.ErrResourceNotFound)
+		}
+		list.RetentionDays = aws.ToInt32(out.DBClusters[0].BackupRetentionPeriod)
+		list.LatestRestorable = out.DBClusters[0].LatestRestorableTime
+
+		paginator := rds.NewDescribeDBClusterSnapshotsPaginator(client, &rds.DescribeDBClusterSnapshotsInput{
+			DBClusterIdentifier: aws.String(name),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return fail(err)
+			}
+			for _, snap := range page.DBClusterSnapshots {
+				list.Snapshots = append(list.Snapshots, Snapshot{
+					ID:        aws.ToString(snap.DBClusterSnapshotIdentifier),
+					Type:      aws.ToString(snap.SnapshotType),
+					Status:    aws.ToString(snap.Status),
+					Created:   snap.SnapshotCreateTime,
+					SizeGB:    aws.ToInt32(snap.AllocatedStorage),
+					Engine:    strings.TrimSpace(aws.ToString(snap.Engine) + " " + aws.ToString(snap.EngineVersion)),
+					Encrypted: aws.ToBool(snap.StorageEncrypted),
+					Progress:  aws.ToInt32(snap.PercentProgress),
+				})
+			}
+		}
+	} else {
+		db, err := s.describeInstance(ctx, name)
+		if err != nil {
+			return fail(err)
+		}
+		list.RetentionDays = aws.ToInt32(db.BackupRetentionPeriod)
+		list.LatestRestorable = db.LatestRestorableTime
+
+		paginator := rds.NewDescribeDBSnapshotsPaginator(client, &rds.DescribeDBSnapshotsInput{
+			DBInstanceIdentifier: aws.String(name),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return fail(err)
+			}
+			for _, snap := range page.DBSnapshots {
+				list.Snapshots = append(list.Snapshots, Snapshot{
+					ID:        aws.ToString(snap.DBSnapshotIdentifier),
+					Type:      aws.ToString(snap.SnapshotType),
+					Status:    aws.ToString(snap.Status),
+					Created:   snap.SnapshotCreateTime,
+					SizeGB:    aws.ToInt32(snap.AllocatedStorage),
+					Engine:    strings.TrimSpace(aws.ToString(snap.Engine) + " " + aws.ToString(snap.EngineVersion)),
+					Encrypted: aws.ToBool(snap.Encrypted),
+					Progress:  aws.ToInt32(snap.PercentProgress),
+				})
+			}
+		}
+	}
+
+	// Snapshots still being created have no time yet and come first
+	slices.SortFunc(list.Snapshots, func(a, b Snapshot) int {
+		switch {
+		case a.Created == nil && b.Created == nil:
+			return 0
+		case a.Created == nil:
+			return -1
+		case b.Created == nil:
+			return 1
+		}
+		return b.Created.Compare(*a.Created)
+	})
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d snapshots of %s", len(list.Snapshots), name))
+	result.Data = list
+	return result, nil
+}
+
+// restoreDatabase starts a point-in-time restore of an instance into a new
+// instance, in the source's subnet group and security groups and with its
+// tags. The time is RestoreLatest or a UTC time within the backup window.
+func (s *Service) restoreDatabase(ctx context.Context, id string, params map[string]any) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("restore", id, err)
+	}
+
+	name, isCluster := splitID(id)
+	if isCluster {
+		return fail(errors.New("point-in-time restore is only supported for DB instances"))
+	}
+
+	target, _ := params["target"].(string)
+	target = strings.TrimSpace(target)
+	if !validInstanceName(target) {
+		return fail(core.NewValidationError("target", target, "must be 1 to 63 letters, digits and single hyphens, starting with a letter"))
+	}
+	at, latest, err := parseRestoreTime(params["time"])
+	if err != nil {
+		return fail(err)
+	}
+
+	db, err := s.describeInstance(ctx, name)
+	if err != nil {
+		return fail(err)
+	}
+	if cluster := aws.ToString(db.DBClusterIdentifier); cluster != "" {
+		return fail(fmt.Errorf("%s is a member of cluster %s, whose backups belong to the cluster", name, cluster))
+	}
+	if aws.ToInt32(db.BackupRetentionPeriod) == 0 {
+		return fail(fmt.Errorf("automated backups of %s are disabled", name))
+	}
+	if !latest && db.LatestRestorableTime != nil && at.After(*db.LatestRestorableTime) {
+		return fail(core.NewValidationError("time", at.Format(time.RFC3339), fmt.Sprintf("is after the latest restorable time %s", db.LatestRestorableTime.UTC().Format(time.RFC3339))))
+	}
+
+	input := &rds.RestoreDBInstanceToPointInTimeInput{
+		SourceDBInstanceIdentifier: aws.String(name),
+		TargetDBInstanceIdentifier: aws.String(target),
+		DBInstanceClass:            db.DBInstanceClass,
+		MultiAZ:                    db.MultiAZ,
+		PubliclyAccessible:         db.PubliclyAccessible,
+		StorageType:                db.StorageType,
+		CopyTagsToSnapshot:         db.CopyTagsToSnapshot,
+		Tags:                       db.TagList,
+	}
+	if latest {
+		input.UseLatestRestorableTime = aws.Bool(true)
+	} else {
+		input.RestoreTime = aws.Time(at)
+	}
+	if class, _ := params["instance_class"].(string); strings.TrimSpace(class) != "" {
+		input.DBInstanceClass = aws.String(strings.TrimSpace(class))
+	}
+	if multiAZ, ok := params["multi_az"].(bool); ok {
+		input.MultiAZ = aws.Bool(multiAZ)
+	}
+	// Provisioned IOPS storage needs its IOPS restated
+	if strings.HasPrefix(aws.ToString(db.StorageType), "io") {
+		input.Iops = db.Iops
+	}
+	if db.DBSubnetGroup != nil {
+		input.DBSubnetGroupName = db.DBSubnetGroup.DBSubnetGroupName
+	}
+	for _, group := range db.VpcSecurityGroups {
+		input.VpcSecurityGroupIds = append(input.VpcSecurityGroupIds, aws.ToString(group.VpcSecurityGroupId))
+	}
+
+	if _, err := s.client().RestoreDBInstanceToPointInTime(ctx, input); err != nil {
+		return fail(err)
+	}
+
+	when := "the latest restorable time"
+	if !latest {
+		when = at.UTC().Format("2006-01-02 15:04:05 UTC")
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("Restoring %s into %s as of %s", name, target, when))
+	result.Data = map[string]any{"instance_id": target}
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+// describeInstance returns the DB instance with the given identifier.
+func (s *Service) describeInstance(ctx context.Context, name string) (types.DBInstance, error) {
+	out, err := s.client().DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(name),
+	})
+	if err != nil {
+		var notFound *types.DBInstanceNotFoundFault
+		if errors.As(err, &notFound) {
+			return types.DBInstance{}, core.ErrResourceNotFound
+		}
+		return types.DBInstance{}, err
+	}
+	if len(out.DBInstances) == 0 {
+		return types.DBInstance{}, core.ErrResourceNotFound
+	}
+	return out.DBInstances[0], nil
+}
+
+// parseRestoreTime reads a restore time parameter. latest is true for
+// RestoreLatest or an empty value.
+func parseRestoreTime(v any) (at time.Time, latest bool, err error) {
+	raw, _ := v.(string)
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.EqualFold(raw, RestoreLatest) {
+		return time.Time{}, true, nil
+	}
+	for _, layout := range restoreTimeLayouts {
+		if at, err := time.ParseInLocation(layout, raw, time.UTC); err == nil {
+			return at, false, nil
+		}
+	}
+	return time.Time{}, false, core.NewValidationError("time", raw, `must be "latest", RFC 3339 or "YYYY-MM-DD HH:MM" in UTC`)
+}
+
+// restoreName generates the identifier of a restored instance such as
+// "orders-pitr-20240314-0930", cutting the source name to fit 63
+// characters.
+func restoreName(id string, now time.Time) string {
+	name, _ := splitID(id)
+	suffix := "-pitr-" + now.UTC().Format("20060102-1504")
+	if len(name) > 63-len(suffix) {
+		name = strings.TrimRight(name[:63-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// validInstanceName reports whether a name is a valid DB instance
+// identifier: a snapshot identifier of at most 63 characters.
+func validInstanceName(name string) bool {
+	return len(name) <= 63 && validSnapshotName(name)
+}
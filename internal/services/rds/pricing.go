@@ -0,0 +1,97 @@
+package rds
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// largeHourlyPrices are us-east-1 single-AZ MySQL and PostgreSQL on-demand
+// prices of the .large size of common instance classes. Other sizes scale
+// linearly; Multi-AZ deployments cost twice as much.
+var largeHourlyPrices = map[string]float64{
+	"db.t3":  0.136,
+	"db.t4g": 0.129,
+	"db.m5":  0.171,
+	"db.m6g": 0.152,
+	"db.m6i": 0.171,
+	"db.m7g": 0.168,
+	"db.r5":  0.24,
+	"db.r6g": 0.215,
+	"db.r6i": 0.24,
+	"db.r7g": 0.239,
+}
+
+// storagePricesPerGB are us-east-1 single-AZ monthly prices per GiB of
+// allocated storage, by storage type.
+var storagePricesPerGB = map[string]float64{
+	"gp2":      0.115,
+	"gp3":      0.115,
+	"io1":      0.125,
+	"io2":      0.125,
+	"standard": 0.10,
+}
+
+// sizeFactors relate each size to .large; *xlarge sizes not listed are
+// parsed from their multiplier.
+var sizeFactors = map[string]float64{
+	"micro":  0.125,
+	"small":  0.25,
+	"medium": 0.5,
+	"large":  1,
+	"xlarge": 2,
+}
+
+// hourlyPrice estimates the on-demand hourly price of an instance class, if
+// its family is known.
+func hourlyPrice(class string, multiAZ bool) (float64, bool) {
+	i := strings.LastIndex(class, ".")
+	if i < 0 {
+		return 0, false
+	}
+	price, ok := largeHourlyPrices[class[:i]]
+	if !ok {
+		return 0, false
+	}
+	size := class[i+1:]
+	factor, ok := sizeFactors[size]
+	if !ok {
+		multiplier, found := strings.CutSuffix(size, "xlarge")
+		n, err := strconv.Atoi(multiplier)
+		if !found || err != nil || n <= 0 {
+			return 0, false
+		}
+		factor = 2 * float64(n)
+	}
+	if multiAZ {
+		factor *= 2
+	}
+	return price * factor, true
+}
+
+// computeMonthlyCost estimates the monthly instance cost of a database.
+// Stopped databases are not billed for compute.
+func computeMonthlyCost(class string, multiAZ bool, status string) (float64, bool) {
+	if status == statusStopped {
+		return 0, true
+	}
+	hourly, ok := hourlyPrice(class, multiAZ)
+	if !ok {
+		return 0, false
+	}
+	return estimate.Monthly(hourly), true
+}
+
+// storageMonthlyCost estimates the monthly cost of allocated storage, which
+// is billed whether the database runs or not.
+func storageMonthlyCost(storageType string, allocatedGB int32, multiAZ bool) float64 {
+	price, ok := storagePricesPerGB[storageType]
+	if !ok {
+		return 0
+	}
+	if multiAZ {
+		price *= 2
+	}
+	return price * float64(allocatedGB)
+}
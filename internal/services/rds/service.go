@@ -0,0 +1,905 @@
+// Package rds provides RDS service implementation for the a9s application.
+// It lists DB instances and clusters with their lifecycle actions, snapshots
+// and point-in-time restores (see backups.go). Analysis flags idle databases
+// and over-provisioned storage, with the savings of stopping or downsizing
+// them.
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Thresholds used by analysis unless overridden.
+const (
+	// DefaultIdleConnections is the average connection count over 14 days
+	// below which a database is flagged as idle.
+	DefaultIdleConnections = 1.0
+
+	// DefaultUnusedStoragePercent is the share of allocated storage left
+	// free above which storage is flagged as over-provisioned.
+	DefaultUnusedStoragePercent = 50.0
+)
+
+const (
+	// metricsLookback is the usage window, long enough to cover weekly
+	// batch jobs.
+	metricsLookback = 14 * 24 * time.Hour
+
+	// storageHeadroom is kept above used storage when suggesting a size.
+	storageHeadroom = 1.25
+
+	// minStorageGB is the smallest allocated storage RDS accepts for most
+	// engines.
+	minStorageGB = 20
+
+	statusAvailable = "available"
+	statusStopped   = "stopped"
+
+	// clusterPrefix marks the IDs of DB clusters, whose identifiers do not
+	// share a namespace with DB instances.
+	clusterPrefix = "cluster/"
+
+	bytesPerGB = 1 << 30
+)
+
+// Service implements RDS operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    RDSAPI
+	metricsClient CloudWatchAPI
+
+	idleConnections      float64
+	unusedStoragePercent float64
+}
+
+// Option configures the RDS service.
+type Option func(*Service)
+
+// WithIdleConnections sets the average connection count below which a
+// database is flagged as idle. Non-positive values keep the default.
+func WithIdleConnections(connections float64) Option {
+	return func(s *Service) {
+		if connections > 0 {
+			s.idleConnections = connections
+		}
+	}
+}
+
+// WithUnusedStoragePercent sets the share of free storage above which
+// storage is flagged as over-provisioned. Values outside (0, 100) keep the
+// default.
+func WithUnusedStoragePercent(percent float64) Option {
+	return func(s *Service) {
+		if percent > 0 && percent < 100 {
+			s.unusedStoragePercent = percent
+		}
+	}
+}
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// RDSAPI defines the RDS client interface for mocking.
+type RDSAPI interface {
+	DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+	StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error)
+	RebootDBInstance(ctx context.Context, params *rds.RebootDBInstanceInput, optFns ...func(*rds.Options)) (*rds.RebootDBInstanceOutput, error)
+	CreateDBSnapshot(ctx context.Context, params *rds.CreateDBSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBSnapshotOutput, error)
+	DescribeDBSnapshots(ctx context.Context, params *rds.DescribeDBSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error)
+	RestoreDBInstanceToPointInTime(ctx context.Context, params *rds.RestoreDBInstanceToPointInTimeInput, optFns ...func(*rds.Options)) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
+	DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+	StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error)
+	RebootDBCluster(ctx context.Context, params *rds.RebootDBClusterInput, optFns ...func(*rds.Options)) (*rds.RebootDBClusterOutput, error)
+	CreateDBClusterSnapshot(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error)
+	DescribeDBClusterSnapshots(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// NewService creates a new RDS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:              factory,
+		dispatcher:           dispatcher,
+		idleConnections:      DefaultIdleConnections,
+		unusedStoragePercent: DefaultUnusedStoragePercent,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client RDSAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient:           client,
+		dispatcher:           dispatcher,
+		idleConnections:      DefaultIdleConnections,
+		unusedStoragePercent: DefaultUnusedStoragePercent,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the RDS client, fetching fresh from factory each time.
+func (s *Service) client() RDSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return rds.NewFromConfig(s.factory.Config())
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "rds"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Amazon RDS Databases"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "database"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		MaxRecords: aws.Int32(20),
+	})
+	if err != nil {
+		return core.NewServiceError("rds", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the RDS DB clusters followed by the DB instances.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	client := s.client()
+	resources := make([]core.Resource, 0)
+
+	clusters := rds.NewDescribeDBClustersPaginator(client, &rds.DescribeDBClustersInput{})
+	for clusters.HasMorePages() {
+		page, err := clusters.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("rds", "list", err)
+		}
+		for _, cluster := range page.DBClusters {
+			resources = append(resources, clusterToResource(cluster, now))
+		}
+	}
+
+	instances := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
+	for instances.HasMorePages() {
+		page, err := instances.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("rds", "list", err)
+		}
+		for _, db := range page.DBInstances {
+			resources = append(resources, instanceToResource(db, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "rds:db",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ResourceGetter Interface Implementation
+// =============================================================================
+
+// Get returns a DB instance by identifier, or a DB cluster by its
+// "cluster/" ID.
+func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
+	name, isCluster := splitID(id)
+	now := time.Now()
+
+	if isCluster {
+		out, err := s.client().DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(name),
+		})
+		if err != nil {
+			var notFound *types.DBClusterNotFoundFault
+			if errors.As(err, &notFound) {
+				return nil, core.ErrResourceNotFound
+			}
+			return nil, core.NewServiceError("rds", "get", err)
+		}
+		if len(out.DBClusters) == 0 {
+			return nil, core.ErrResourceNotFound
+		}
+		resource := clusterToResource(out.DBClusters[0], now)
+		return &resource, nil
+	}
+
+	out, err := s.client().DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(name),
+	})
+	if err != nil {
+		var notFound *types.DBInstanceNotFoundFault
+		if errors.As(err, &notFound) {
+			return nil, core.ErrResourceNotFound
+		}
+		return nil, core.NewServiceError("rds", "get", err)
+	}
+	if len(out.DBInstances) == 0 {
+		return nil, core.ErrResourceNotFound
+	}
+	resource := instanceToResource(out.DBInstances[0], now)
+	return &resource, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds connection and storage usage over 14 days, flags idle
+// databases and over-provisioned storage, and estimates the monthly savings
+// of stopping or downsizing them.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	name, isCluster := splitID(resource.ID)
+	dimension := "DBInstanceIdentifier"
+	if isCluster {
+		dimension = "DBClusterIdentifier"
+	}
+	usage, err := s.getUsage(ctx, dimension, name, time.Now())
+	if err != nil {
+		return err
+	}
+
+	class := resource.GetMetadataString("instance_class")
+	storageType := resource.GetMetadataString("storage_type")
+	multiAZ, _ := resource.Metadata["multi_az"].(bool)
+	allocated, _ := resource.Metadata["allocated_storage_gb"].(int32)
+
+	resource.Metadata["avg_connections"] = usage.avgConnections
+	resource.Metadata["peak_connections"] = usage.peakConnections
+
+	var recommendations []string
+	savings := 0.0
+	idle := resource.State == statusAvailable && usage.avgConnections < s.idleConnections
+	cleanupReason := ""
+	if idle {
+		cleanupReason = fmt.Sprintf("idle, %.1f connections on average over 14 days", usage.avgConnections)
+	}
+
+	// Cluster compute is billed through its instances, which are analyzed
+	// on their own rows
+	if isCluster && idle {
+		recommendations = append(recommendations, "stop the cluster (storage is still billed)")
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("Idle: %.1f connections on average over 14 days", usage.avgConnections))
+	}
+
+	if !isCluster && idle {
+		compute, _ := computeMonthlyCost(class, multiAZ, resource.State)
+		savings += compute
+		recommendations = append(recommendations, "stop the database (storage is still billed)")
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("Idle: %.1f connections on average over 14 days; stopping saves %s/mo", usage.avgConnections, estimate.FormatCost(compute)))
+	}
+
+	// Aurora storage belongs to the cluster and grows with the data
+	if !isCluster && usage.hasFreeStorage && !isAurora(resource.GetMetadataString("engine")) && allocated > 0 {
+		freeGB := usage.minFreeStorage / bytesPerGB
+		usedGB := max(0, float64(allocated)-freeGB)
+		resource.Metadata["free_storage_gb"] = freeGB
+		resource.Metadata["used_storage_gb"] = usedGB
+
+		suggested := max(int32(minStorageGB), int32(math.Ceil(usedGB*storageHeadroom)))
+		if freeGB/float64(allocated)*100 >= s.unusedStoragePercent && suggested < allocated {
+			saved := storageMonthlyCost(storageType, allocated-suggested, multiAZ)
+			savings += saved
+			resource.Metadata["suggested_storage_gb"] = suggested
+			recommendations = append(recommendations, fmt.Sprintf("downsize storage to %d GiB", suggested))
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Over-provisioned storage: %.0f of %d GiB used; %d GiB saves %s/mo", usedGB, allocated, suggested, estimate.FormatCost(saved)))
+		}
+	}
+
+	resource.Metadata["recommendations"] = recommendations
+	resource.Metadata["should_cleanup"] = idle
+	resource.Metadata["cleanup_reason"] = cleanupReason
+	resource.Metadata["savings_monthly"] = savings
+	resource.Metadata["analyzed"] = true
+
+	return nil
+}
+
+// databaseUsage summarizes CloudWatch metrics over the lookback window.
+type databaseUsage struct {
+	avgConnections  float64
+	peakConnections float64
+	minFreeStorage  float64 // bytes
+	hasFreeStorage  bool
+}
+
+// getUsage fetches a database's connection and storage metrics in a single
+// GetMetricData call. The dimension names the instance or cluster.
+func (s *Service) getUsage(ctx context.Context, dimension, dbID string, now time.Time) (databaseUsage, error) {
+	metric := func(id, name, stat string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/RDS"),
+					MetricName: aws.String(name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: aws.String(dimension), Value: aws.String(dbID)},
+					},
+				},
+				Period: aws.Int32(86400),
+				Stat:   aws.String(stat),
+			},
+		}
+	}
+
+	out, err := s.metrics().GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(now.Add(-metricsLookback)),
+		EndTime:   aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metric("connections_avg", "DatabaseConnections", "Average"),
+			metric("connections_max", "DatabaseConnections", "Maximum"),
+			metric("free_storage", "FreeStorageSpace", "Minimum"),
+		},
+	})
+	if err != nil {
+		return databaseUsage{}, err
+	}
+
+	var usage databaseUsage
+	for _, result := range out.MetricDataResults {
+		switch aws.ToString(result.Id) {
+		case "connections_avg":
+			usage.avgConnections = mean(result.Values)
+		case "connections_max":
+			for _, v := range result.Values {
+				usage.peakConnections = max(usage.peakConnections, v)
+			}
+		case "free_storage":
+			for i, v := range result.Values {
+				if i == 0 || v < usage.minFreeStorage {
+					usage.minFreeStorage = v
+				}
+			}
+			usage.hasFreeStorage = len(result.Values) > 0
+		}
+	}
+
+	return usage, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for RDS.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "start",
+			Description: "Start a stopped database",
+			Icon:        "play",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "stop",
+			Description: "Stop a database for up to 7 days",
+			Icon:        "stop",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "reboot",
+			Description: "Reboot a database (brief outage)",
+			Icon:        "refresh",
+			Shortcut:    "b",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "failover",
+					Type:        "bool",
+					Default:     false,
+					Description: "Fail over to the standby (Multi-AZ instances only)",
+				},
+			},
+		},
+		{
+			Name:        "snapshot",
+			Description: "Take a manual snapshot",
+			Icon:        "camera",
+			Shortcut:    "S",
+			Dangerous:   false,
+			Category:    "backup",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "name",
+					Type:        "string",
+					Description: "Snapshot identifier (generated when empty)",
+					Validation:  `^([a-zA-Z](-?[a-zA-Z0-9])*)?$`,
+				},
+			},
+		},
+		{
+			Name:        "list_snapshots",
+			Description: "List snapshots and the point-in-time restore window",
+			Icon:        "list",
+			Shortcut:    "l",
+			Dangerous:   false,
+			Category:    "backup",
+		},
+		{
+			Name:        "restore",
+			Description: "Restore an instance to a point in time into a new instance",
+			Icon:        "history",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "backup",
+			Parameters: []core.ActionParameter{
+				{
+					Name:        "target",
+					Type:        "string",
+					Required:    true,
+					Description: "Identifier of the new instance",
+					Validation:  `^[a-zA-Z](-?[a-zA-Z0-9])*$`,
+				},
+				{
+					Name:        "time",
+					Type:        "string",
+					Default:     RestoreLatest,
+					Description: "Time to restore to: latest, or YYYY-MM-DD HH:MM in UTC",
+				},
+				{
+					Name:        "instance_class",
+					Type:        "string",
+					Description: "Instance class of the new instance (the source's when empty)",
+				},
+				{
+					Name:        "multi_az",
+					Type:        "bool",
+					Default:     false,
+					Description: "Deploy the new instance in Multi-AZ",
+				},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action on a database.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "start":
+		result, err = s.startDatabase(ctx, resourceID)
+	case "stop":
+		result, err = s.stopDatabase(ctx, resourceID)
+	case "reboot":
+		failover, _ := params["failover"].(bool)
+		if !core.Confirmed(params, resourceID, false) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, rebootReason(resourceID, failover), false)
+		}
+		result, err = s.rebootDatabase(ctx, resourceID, failover)
+	case "snapshot":
+		name, _ := params["name"].(string)
+		if name == "" {
+			name = snapshotName(resourceID, time.Now())
+		} else if !validSnapshotName(name) {
+			return nil, core.NewValidationError("name", name, "must start with a letter and contain only letters, digits and single hyphens")
+		}
+		result, err = s.snapshotDatabase(ctx, resourceID, name)
+	case "list_snapshots":
+		result, err = s.listSnapshots(ctx, resourceID)
+	case "restore":
+		result, err = s.restoreDatabase(ctx, resourceID, params)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) startDatabase(ctx context.Context, id string) (*core.ActionResult, error) {
+	name, isCluster := splitID(id)
+	var err error
+	if isCluster {
+		_, err = s.client().StartDBCluster(ctx, &rds.StartDBClusterInput{
+			DBClusterIdentifier: aws.String(name),
+		})
+	} else {
+		_, err = s.client().StartDBInstance(ctx, &rds.StartDBInstanceInput{
+			DBInstanceIdentifier: aws.String(name),
+		})
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("start", id, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Database %s is starting", name)), nil
+}
+
+// stopDatabase stops a database. RDS starts it again automatically after
+// 7 days.
+func (s *Service) stopDatabase(ctx context.Context, id string) (*core.ActionResult, error) {
+	name, isCluster := splitID(id)
+	var err error
+	if isCluster {
+		_, err = s.client().StopDBCluster(ctx, &rds.StopDBClusterInput{
+			DBClusterIdentifier: aws.String(name),
+		})
+	} else {
+		_, err = s.client().StopDBInstance(ctx, &rds.StopDBInstanceInput{
+			DBInstanceIdentifier: aws.String(name),
+		})
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("stop", id, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Database %s is stopping (restarted by AWS after 7 days)", name)), nil
+}
+
+// rebootDatabase reboots an instance, optionally failing over to its
+// standby, or every instance of a cluster.
+func (s *Service) rebootDatabase(ctx context.Context, id string, failover bool) (*core.ActionResult, error) {
+	name, isCluster := splitID(id)
+	var err error
+	if isCluster {
+		_, err = s.client().RebootDBCluster(ctx, &rds.RebootDBClusterInput{
+			DBClusterIdentifier: aws.String(name),
+		})
+	} else {
+		input := &rds.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(name)}
+		if failover {
+			input.ForceFailover = aws.Bool(true)
+		}
+		_, err = s.client().RebootDBInstance(ctx, input)
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("reboot", id, err)
+	}
+
+	if failover && !isCluster {
+		return core.NewActionResult(true, fmt.Sprintf("Database %s is rebooting with failover", name)), nil
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Database %s is rebooting", name)), nil
+}
+
+// rebootReason describes the outage a reboot causes, asked to confirm.
+func rebootReason(id string, failover bool) string {
+	name, isCluster := splitID(id)
+	switch {
+	case isCluster:
+		return fmt.Sprintf("Reboots every instance of cluster %s; it is unavailable until they are back", name)
+	case failover:
+		return fmt.Sprintf("Fails %s over to its standby; connections drop while it switches", name)
+	}
+	return fmt.Sprintf("Reboots %s; it is unavailable until it is back", name)
+}
+
+// snapshotDatabase takes a manual snapshot of an instance or cluster.
+func (s *Service) snapshotDatabase(ctx context.Context, id, snapshot string) (*core.ActionResult, error) {
+	name, isCluster := splitID(id)
+	var err error
+	if isCluster {
+		_, err = s.client().CreateDBClusterSnapshot(ctx, &rds.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(name),
+			DBClusterSnapshotIdentifier: aws.String(snapshot),
+		})
+	} else {
+		_, err = s.client().CreateDBSnapshot(ctx, &rds.CreateDBSnapshotInput{
+			DBInstanceIdentifier: aws.String(name),
+			DBSnapshotIdentifier: aws.String(snapshot),
+		})
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("snapshot", id, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Creating snapshot %s of %s", snapshot, name))
+	result.Data = map[string]any{"snapshot_id": snapshot}
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func instanceToResource(db types.DBInstance, now time.Time) core.Resource {
+	multiAZ := aws.ToBool(db.MultiAZ)
+	allocated := aws.ToInt32(db.AllocatedStorage)
+
+	resource := core.Resource{
+		ID:    aws.ToString(db.DBInstanceIdentifier),
+		Type:  "rds:db",
+		Name:  aws.ToString(db.DBInstanceIdentifier),
+		ARN:   aws.ToString(db.DBInstanceArn),
+		State: aws.ToString(db.DBInstanceStatus),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"engine":                aws.ToString(db.Engine),
+			"engine_version":        aws.ToString(db.EngineVersion),
+			"instance_class":        aws.ToString(db.DBInstanceClass),
+			"multi_az":              multiAZ,
+			"storage_type":          aws.ToString(db.StorageType),
+			"allocated_storage_gb":  allocated,
+			"publicly_accessible":   aws.ToBool(db.PubliclyAccessible),
+			"availability_zone":     aws.ToString(db.AvailabilityZone),
+			"cluster_id":            aws.ToString(db.DBClusterIdentifier),
+			"backup_retention_days": aws.ToInt32(db.BackupRetentionPeriod),
+			"analyzed":              false,
+		},
+	}
+	if db.LatestRestorableTime != nil {
+		resource.Metadata["latest_restorable"] = *db.LatestRestorableTime
+	}
+	if db.Endpoint != nil {
+		resource.Metadata["endpoint"] = fmt.Sprintf("%s:%d", aws.ToString(db.Endpoint.Address), aws.ToInt32(db.Endpoint.Port))
+	}
+	if db.DBSubnetGroup != nil {
+		resource.Metadata["vpc_id"] = aws.ToString(db.DBSubnetGroup.VpcId)
+	}
+	groups := make([]string, 0, len(db.VpcSecurityGroups))
+	for _, group := range db.VpcSecurityGroups {
+		groups = append(groups, aws.ToString(group.VpcSecurityGroupId))
+	}
+	resource.Metadata["security_groups"] = groups
+
+	for _, tag := range db.TagList {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	iac.Apply(&resource)
+
+	resource.CreatedAt = db.InstanceCreateTime
+	estimate.ApplyAge(&resource, now)
+	if compute, ok := computeMonthlyCost(aws.ToString(db.DBInstanceClass), multiAZ, resource.State); ok {
+		estimate.ApplyCost(&resource, compute+storageMonthlyCost(aws.ToString(db.StorageType), allocated, multiAZ))
+	}
+
+	return resource
+}
+
+func clusterToResource(cluster types.DBCluster, now time.Time) core.Resource {
+	name := aws.ToString(cluster.DBClusterIdentifier)
+	engine := aws.ToString(cluster.Engine)
+
+	members := make([]string, 0, len(cluster.DBClusterMembers))
+	writer := ""
+	for _, member := range cluster.DBClusterMembers {
+		id := aws.ToString(member.DBInstanceIdentifier)
+		members = append(members, id)
+		if aws.ToBool(member.IsClusterWriter) {
+			writer = id
+		}
+	}
+
+	resource := core.Resource{
+		ID:    clusterPrefix + name,
+		Type:  "rds:cluster",
+		Name:  name,
+		ARN:   aws.ToString(cluster.DBClusterArn),
+		State: aws.ToString(cluster.Status),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"engine":              engine,
+			"engine_version":      aws.ToString(cluster.EngineVersion),
+			"engine_mode":         aws.ToString(cluster.EngineMode),
+			"instance_class":      aws.ToString(cluster.DBClusterInstanceClass),
+			"multi_az":            aws.ToBool(cluster.MultiAZ),
+			"storage_type":        aws.ToString(cluster.StorageType),
+			"publicly_accessible": aws.ToBool(cluster.PubliclyAccessible),
+			"members":             members,
+			"writer":              writer,
+			"cluster":             true,
+			"analyzed":            false,
+		},
+	}
+	// Aurora reports a placeholder; its storage grows with the data
+	if !isAurora(engine) {
+		resource.Metadata["allocated_storage_gb"] = aws.ToInt32(cluster.AllocatedStorage)
+	}
+	if cluster.Endpoint != nil {
+		resource.Metadata["endpoint"] = fmt.Sprintf("%s:%d", aws.ToString(cluster.Endpoint), aws.ToInt32(cluster.Port))
+	}
+	if cluster.ReaderEndpoint != nil {
+		resource.Metadata["reader_endpoint"] = fmt.Sprintf("%s:%d", aws.ToString(cluster.ReaderEndpoint), aws.ToInt32(cluster.Port))
+	}
+	groups := make([]string, 0, len(cluster.VpcSecurityGroups))
+	for _, group := range cluster.VpcSecurityGroups {
+		groups = append(groups, aws.ToString(group.VpcSecurityGroupId))
+	}
+	resource.Metadata["security_groups"] = groups
+
+	for _, tag := range cluster.TagList {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	iac.Apply(&resource)
+
+	resource.CreatedAt = cluster.ClusterCreateTime
+	estimate.ApplyAge(&resource, now)
+
+	return resource
+}
+
+// splitID returns the identifier behind a resource ID and whether it
+// names a DB cluster.
+func splitID(id string) (string, bool) {
+	if name, ok := strings.CutPrefix(id, clusterPrefix); ok {
+		return name, true
+	}
+	return id, false
+}
+
+// snapshotName generates a manual snapshot identifier such as
+// "orders-a9s-20240314-0930".
+func snapshotName(id string, now time.Time) string {
+	name, _ := splitID(id)
+	return fmt.Sprintf("%s-a9s-%s", name, now.UTC().Format("20060102-1504"))
+}
+
+// validSnapshotName reports whether a name is a valid snapshot identifier:
+// 1 to 255 letters, digits and hyphens, starting with a letter, without
+// consecutive or trailing hyphens.
+func validSnapshotName(name string) bool {
+	if name == "" || len(name) > 255 || strings.Contains(name, "--") || strings.HasSuffix(name, "-") {
+		return false
+	}
+	for i, c := range name {
+		letter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if i == 0 && !letter {
+			return false
+		}
+		if !letter && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAurora reports whether an engine stores data at the cluster level.
+func isAurora(engine string) bool {
+	return strings.HasPrefix(engine, "aurora")
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "rds", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "rds", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceGetter   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+	_ core.CleanupFlagger   = (*Service)(nil)
+)
@@ -0,0 +1,255 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeRDS serves the orders PostgreSQL instance, a db.m5.large with 500 GiB
+// of gp3 storage, and the analytics Aurora cluster, or fails every call
+// when err is set. It records the calls changing a database, as the API
+// name followed by the identifier.
+type fakeRDS struct {
+	err   error
+	calls []string
+}
+
+func (f *fakeRDS) record(api string, id *string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.calls = append(f.calls, api+" "+aws.ToString(id))
+	return nil
+}
+
+func (f *fakeRDS) DescribeDBInstances(_ context.Context, in *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if id := aws.ToString(in.DBInstanceIdentifier); id != "" && id != "orders" {
+		return nil, &types.DBInstanceNotFoundFault{Message: aws.String("DBInstance " + id + " not found")}
+	}
+	return &rds.DescribeDBInstancesOutput{DBInstances: []types.DBInstance{{
+		DBInstanceIdentifier:  aws.String("orders"),
+		DBInstanceArn:         aws.String("arn:aws:rds:us-east-1:123456789012:db:orders"),
+		DBInstanceStatus:      aws.String(statusAvailable),
+		DBInstanceClass:       aws.String("db.m5.large"),
+		Engine:                aws.String("postgres"),
+		StorageType:           aws.String("gp3"),
+		AllocatedStorage:      aws.Int32(500),
+		BackupRetentionPeriod: aws.Int32(7),
+	}}}, nil
+}
+
+func (f *fakeRDS) DescribeDBClusters(_ context.Context, in *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if id := aws.ToString(in.DBClusterIdentifier); id != "" && id != "analytics" {
+		return nil, &types.DBClusterNotFoundFault{Message: aws.String("DBCluster " + id + " not found")}
+	}
+	return &rds.DescribeDBClustersOutput{DBClusters: []types.DBCluster{{
+		DBClusterIdentifier: aws.String("analytics"),
+		DBClusterArn:        aws.String("arn:aws:rds:us-east-1:123456789012:cluster:analytics"),
+		Status:              aws.String(statusAvailable),
+		Engine:              aws.String("aurora-postgresql"),
+		AllocatedStorage:    aws.Int32(1),
+		DBClusterMembers:    []types.DBClusterMember{{DBInstanceIdentifier: aws.String("analytics-1"), IsClusterWriter: aws.Bool(true)}},
+	}}}, nil
+}
+
+func (f *fakeRDS) StartDBInstance(_ context.Context, in *rds.StartDBInstanceInput, _ ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	return &rds.StartDBInstanceOutput{}, f.record("StartDBInstance", in.DBInstanceIdentifier)
+}
+
+func (f *fakeRDS) StopDBInstance(_ context.Context, in *rds.StopDBInstanceInput, _ ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	return &rds.StopDBInstanceOutput{}, f.record("StopDBInstance", in.DBInstanceIdentifier)
+}
+
+func (f *fakeRDS) RebootDBInstance(_ context.Context, in *rds.RebootDBInstanceInput, _ ...func(*rds.Options)) (*rds.RebootDBInstanceOutput, error) {
+	return &rds.RebootDBInstanceOutput{}, f.record("RebootDBInstance", in.DBInstanceIdentifier)
+}
+
+func (f *fakeRDS) CreateDBSnapshot(_ context.Context, in *rds.CreateDBSnapshotInput, _ ...func(*rds.Options)) (*rds.CreateDBSnapshotOutput, error) {
+	return &rds.CreateDBSnapshotOutput{}, f.record("CreateDBSnapshot", in.DBInstanceIdentifier)
+}
+
+func (f *fakeRDS) DescribeDBSnapshots(context.Context, *rds.DescribeDBSnapshotsInput, ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &rds.DescribeDBSnapshotsOutput{}, nil
+}
+
+func (f *fakeRDS) RestoreDBInstanceToPointInTime(_ context.Context, in *rds.RestoreDBInstanceToPointInTimeInput, _ ...func(*rds.Options)) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
+	return &rds.RestoreDBInstanceToPointInTimeOutput{}, f.record("RestoreDBInstanceToPointInTime", in.TargetDBInstanceIdentifier)
+}
+
+func (f *fakeRDS) StartDBCluster(_ context.Context, in *rds.StartDBClusterInput, _ ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	return &rds.StartDBClusterOutput{}, f.record("StartDBCluster", in.DBClusterIdentifier)
+}
+
+func (f *fakeRDS) StopDBCluster(_ context.Context, in *rds.StopDBClusterInput, _ ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	return &rds.StopDBClusterOutput{}, f.record("StopDBCluster", in.DBClusterIdentifier)
+}
+
+func (f *fakeRDS) RebootDBCluster(_ context.Context, in *rds.RebootDBClusterInput, _ ...func(*rds.Options)) (*rds.RebootDBClusterOutput, error) {
+	return &rds.RebootDBClusterOutput{}, f.record("RebootDBCluster", in.DBClusterIdentifier)
+}
+
+func (f *fakeRDS) CreateDBClusterSnapshot(_ context.Context, in *rds.CreateDBClusterSnapshotInput, _ ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+	return &rds.CreateDBClusterSnapshotOutput{}, f.record("CreateDBClusterSnapshot", in.DBClusterIdentifier)
+}
+
+func (f *fakeRDS) DescribeDBClusterSnapshots(context.Context, *rds.DescribeDBClusterSnapshotsInput, ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &rds.DescribeDBClusterSnapshotsOutput{}, nil
+}
+
+// fakeCloudWatch reports a database averaging 0.2 connections, with a peak
+// of 3, that never had less than 450 GiB of free storage.
+type fakeCloudWatch struct{}
+
+func (fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: []cwtypes.MetricDataResult{
+		{Id: aws.String("connections_avg"), Values: []float64{0, 0.4, 0.2}},
+		{Id: aws.String("connections_max"), Values: []float64{0, 3, 1}},
+		{Id: aws.String("free_storage"), Values: []float64{460 * bytesPerGB, 450 * bytesPerGB}},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against databases.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeRDS{}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeRDS{err: errors.New("AccessDenied")}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		ExistingID:    "orders",
+		MissingID:     "cluster/gone",
+		Action:        "snapshot",
+		ActionParams:  map[string]any{"name": "orders-before-upgrade"},
+		ConfirmAction: "reboot",
+	})
+}
+
+func TestHourlyPrice(t *testing.T) {
+	tests := []struct {
+		class   string
+		multiAZ bool
+		want    float64
+		ok      bool
+	}{
+		{"db.m5.large", false, 0.171, true},
+		{"db.m5.large", true, 0.342, true},
+		{"db.t3.micro", false, 0.017, true},
+		{"db.r6g.4xlarge", false, 1.72, true},
+		{"db.x2g.large", false, 0, false},
+		{"db.m5.hugexlarge", false, 0, false},
+		{"serverless", false, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := hourlyPrice(tt.class, tt.multiAZ)
+		if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("hourlyPrice(%q, %v) = %v, %v; want %v, %v", tt.class, tt.multiAZ, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// TestEnrichFlagsIdleInstance checks that an idle instance with mostly free
+// storage is flagged for both, with the savings of stopping it and of
+// storage cut to its use plus headroom.
+func TestEnrichFlagsIdleInstance(t *testing.T) {
+	svc := NewServiceWithClient(&fakeRDS{}, nil, WithMetricsClient(fakeCloudWatch{}))
+	orders, err := svc.Get(context.Background(), "orders")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := svc.EnrichResource(context.Background(), orders); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+
+	if orders.Metadata["peak_connections"] != 3.0 || orders.Metadata["should_cleanup"] != true {
+		t.Errorf("peak %v, cleanup %v", orders.Metadata["peak_connections"], orders.Metadata["should_cleanup"])
+	}
+	// 50 GiB used, with 25% headroom
+	if suggested := orders.Metadata["suggested_storage_gb"]; suggested != int32(63) {
+		t.Errorf("suggested storage = %v, want 63 GiB", suggested)
+	}
+	compute, _ := computeMonthlyCost("db.m5.large", false, statusAvailable)
+	want := compute + storageMonthlyCost("gp3", 500-63, false)
+	if savings := orders.Metadata["savings_monthly"].(float64); math.Abs(savings-want) > 0.01 {
+		t.Errorf("savings = %.2f, want %.2f", savings, want)
+	}
+	if len(orders.Issues()) != 2 {
+		t.Errorf("issues = %v, want idle and storage", orders.Issues())
+	}
+
+	// Aurora storage is never suggested a size
+	cluster, _ := svc.Get(context.Background(), "cluster/analytics")
+	if err := svc.EnrichResource(context.Background(), cluster); err != nil {
+		t.Fatalf("EnrichResource(cluster) error = %v", err)
+	}
+	if _, ok := cluster.Metadata["suggested_storage_gb"]; ok || len(cluster.Issues()) != 1 {
+		t.Errorf("cluster issues = %v, want idle only", cluster.Issues())
+	}
+}
+
+// TestActionsUseTheClusterAPI checks that "cluster/" IDs reach the DB
+// cluster calls with the prefix cut, and other IDs the DB instance calls.
+func TestActionsUseTheClusterAPI(t *testing.T) {
+	fake := &fakeRDS{}
+	svc := NewServiceWithClient(fake, nil)
+
+	confirm := map[string]any{core.ParamConfirm: true}
+	for _, id := range []string{"orders", "cluster/analytics"} {
+		for _, action := range []string{"stop", "start", "reboot"} {
+			if _, err := svc.Execute(context.Background(), action, id, confirm); err != nil {
+				t.Fatalf("%s %s: %v", action, id, err)
+			}
+		}
+	}
+	want := []string{
+		"StopDBInstance orders", "StartDBInstance orders", "RebootDBInstance orders",
+		"StopDBCluster analytics", "StartDBCluster analytics", "RebootDBCluster analytics",
+	}
+	if !slices.Equal(fake.calls, want) {
+		t.Errorf("calls = %q, want %q", fake.calls, want)
+	}
+}
+
+func TestSnapshotNames(t *testing.T) {
+	now := time.Date(2024, 3, 14, 9, 30, 0, 0, time.UTC)
+	if got := snapshotName("cluster/analytics", now); got != "analytics-a9s-20240314-0930" {
+		t.Errorf("snapshotName() = %q", got)
+	}
+
+	for name, valid := range map[string]bool{
+		"orders-1":  true,
+		"1orders":   false,
+		"orders--1": false,
+		"orders-":   false,
+		"orders_1":  false,
+	} {
+		if validSnapshotName(name) != valid {
+			t.Errorf("validSnapshotName(%q) = %v", name, !valid)
+		}
+	}
+}
@@ -0,0 +1,484 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// Form IDs for actions with parameters.
+const (
+	rebootFormID   = "rds:reboot"
+	snapshotFormID = "rds:snapshot"
+	restoreFormID  = "rds:restore"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for RDS databases.
+type View struct {
+	*base.EnrichableTableView
+
+	formTarget string // Database the open action form applies to
+}
+
+// NewView creates a new RDS view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 40, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Engine"), MinWidth: 10, MaxWidth: 24, Weight: 0.6, Priority: 2},
+		{Title: i18n.T("Class"), MinWidth: 12, MaxWidth: 18, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 16, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Multi-AZ"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Storage"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Used"), MinWidth: 6, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Connections"), MinWidth: 11, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Savings $/mo"), MinWidth: 12, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("RDS", "9", "rds", i18n.T("databases"), columnDefs, buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "R":
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
+		case "a":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Starting %s...", row.ID)
+				return v, v.executeAction("start", row.ID)
+			}
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Stopping %s...", row.ID)
+				return v, v.executeAction("stop", row.ID)
+			}
+		case "b":
+			if row := v.GetSelectedResource(); row != nil {
+				// Only Multi-AZ instances have a standby to fail over to.
+				// Others go straight to the service, which asks to confirm
+				if multiAZ, _ := row.Metadata["multi_az"].(bool); multiAZ && row.Type == "rds:db" {
+					return v, v.openActionForm(rebootFormID, "reboot", i18n.T("Reboot %s", row.ID), row.ID)
+				}
+				return v, v.executeAction("reboot", row.ID)
+			}
+		case "S":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openActionForm(snapshotFormID, "snapshot", i18n.T("Snapshot %s", row.ID), row.ID)
+			}
+		case "l":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Listing snapshots of %s...", row.Name)
+				return v, v.executeAction("list_snapshots", row.ID)
+			}
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openRestoreForm(row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Database %s", row.ID), formatDetail(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		action := ""
+		switch msg.ID {
+		case rebootFormID:
+			action = "reboot"
+		case snapshotFormID:
+			action = "snapshot"
+		case restoreFormID:
+			action = "restore"
+		}
+		if action == "" {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		switch action {
+		case "snapshot":
+			v.Message = i18n.T("Creating snapshot of %s...", v.formTarget)
+		case "restore":
+			v.Message = i18n.T("Starting restore of %s...", v.formTarget)
+		}
+		return v, v.executeActionWithParams(action, v.formTarget, msg.Values)
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if list, ok := msg.Result.Data.(SnapshotList); ok {
+				v.OpenDetail(i18n.T("Snapshots of %s", list.Database), formatSnapshots(list))
+				return v, nil
+			}
+			return v, v.SoftRefresh()
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading RDS databases...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[s]tart  s[t]op  re[b]oot  [S]napshot  [l]ist snapshots  [p]oint-in-time restore  [a]nalyze  [Enter]details  [r]efresh  [R]e-analyze")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the databases, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	multiAZ, _ := r.Metadata["multi_az"].(bool)
+	allocated, _ := r.Metadata["allocated_storage_gb"].(int32)
+
+	engine := r.GetMetadataString("engine")
+	if version := r.GetMetadataString("engine_version"); version != "" {
+		engine += " " + version
+	}
+	multiAZText := "-"
+	if multiAZ {
+		multiAZText = i18n.T("yes")
+	}
+	class := r.GetMetadataString("instance_class")
+	if members, ok := r.Metadata["members"].([]string); ok && class == "" {
+		class = i18n.T("%d instances", len(members))
+	}
+	storage := "-"
+	if allocated > 0 {
+		storage = fmt.Sprintf("%d GiB", allocated)
+	}
+
+	used, connections, savings := "...", "...", "..."
+	var usedValue, connectionsValue, savingsValue any
+	if analyzed {
+		connectionsValue, _ = r.Metadata["avg_connections"].(float64)
+		connections = fmt.Sprintf("%.1f", connectionsValue)
+		used = "-"
+		if usedGB, ok := r.Metadata["used_storage_gb"].(float64); ok && allocated > 0 {
+			usedValue = usedGB / float64(allocated)
+			used = fmt.Sprintf("%.0f%%", usedGB/float64(allocated)*100)
+		}
+		monthly, _ := r.Metadata["savings_monthly"].(float64)
+		savingsValue = monthly
+		savings = "-"
+		if monthly > 0 {
+			savings = "💰 " + estimate.FormatCost(monthly)
+		}
+	}
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(engine),
+		base.TextCell(class),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(multiAZText),
+		base.LazyCell(allocated, func() string { return storage }),
+		base.LazyCell(usedValue, func() string { return used }),
+		base.LazyCell(connectionsValue, func() string { return connections }),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.LazyCell(savingsValue, func() string { return savings }),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// formatDetail renders a database's configuration, usage and
+// recommendations for the detail panel.
+func formatDetail(r *core.Resource) string {
+	multiAZ, _ := r.Metadata["multi_az"].(bool)
+	public, _ := r.Metadata["publicly_accessible"].(bool)
+	allocated, _ := r.Metadata["allocated_storage_gb"].(int32)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Engine:      %s %s\n", r.GetMetadataString("engine"), r.GetMetadataString("engine_version"))
+	if class := r.GetMetadataString("instance_class"); class != "" {
+		fmt.Fprintf(&b, "Class:       %s (Multi-AZ: %t)\n", class, multiAZ)
+	}
+	if allocated > 0 {
+		fmt.Fprintf(&b, "Storage:     %d GiB %s\n", allocated, r.GetMetadataString("storage_type"))
+	}
+	fmt.Fprintf(&b, "Endpoint:    %s (public: %t)\n", r.GetMetadataString("endpoint"), public)
+	if reader := r.GetMetadataString("reader_endpoint"); reader != "" {
+		fmt.Fprintf(&b, "Reader:      %s\n", reader)
+	}
+	if members, ok := r.Metadata["members"].([]string); ok {
+		fmt.Fprintf(&b, "Members:     %s (writer: %s)\n", strings.Join(members, ", "), r.GetMetadataString("writer"))
+	} else if cluster := r.GetMetadataString("cluster_id"); cluster != "" {
+		fmt.Fprintf(&b, "Cluster:     %s\n", cluster)
+	}
+	if vpc := r.GetMetadataString("vpc_id"); vpc != "" {
+		fmt.Fprintf(&b, "VPC:         %s\n", vpc)
+	}
+	if retention, ok := r.Metadata["backup_retention_days"].(int32); ok {
+		fmt.Fprintf(&b, "Backups:     %s\n", formatBackups(retention, r.Metadata["latest_restorable"]))
+	}
+
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		b.WriteString(i18n.T("\nNot analyzed yet. Press [a] to analyze this database.\n"))
+		return b.String()
+	}
+
+	avg, _ := r.Metadata["avg_connections"].(float64)
+	peak, _ := r.Metadata["peak_connections"].(float64)
+	fmt.Fprintf(&b, "\nConnections: %.1f average, %.0f peak (14 days)\n", avg, peak)
+	if used, ok := r.Metadata["used_storage_gb"].(float64); ok {
+		fmt.Fprintf(&b, "Used:        %.1f of %d GiB at the fullest\n", used, allocated)
+	}
+
+	recommendations, _ := r.Metadata["recommendations"].([]string)
+	b.WriteString(i18n.T("\nRecommendations:\n"))
+	if len(recommendations) == 0 {
+		b.WriteString("  (none)\n")
+		return b.String()
+	}
+	for _, rec := range recommendations {
+		fmt.Fprintf(&b, "  %s\n", rec)
+	}
+	savings, _ := r.Metadata["savings_monthly"].(float64)
+	fmt.Fprintf(&b, "  Estimated savings: %s/mo\n", estimate.FormatCost(savings))
+	if _, ok := r.Metadata["suggested_storage_gb"]; ok {
+		b.WriteString(i18n.T("\nRDS cannot shrink allocated storage in place: use a blue/green deployment or migrate to a new instance.\n"))
+	}
+	return b.String()
+}
+
+// formatBackups describes the automated backups of a database.
+func formatBackups(retention int32, latest any) string {
+	if retention == 0 {
+		return i18n.T("automated backups disabled")
+	}
+	text := i18n.T("%d days retention", retention)
+	if at, ok := latest.(time.Time); ok {
+		text += ", " + i18n.T("restorable up to %s", at.Local().Format("2006-01-02 15:04:05"))
+	}
+	return text
+}
+
+// formatSnapshots renders the snapshots of a database for the detail panel.
+func formatSnapshots(list SnapshotList) string {
+	var latest any
+	if list.LatestRestorable != nil {
+		latest = *list.LatestRestorable
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Backups: %s\n\n", formatBackups(list.RetentionDays, latest))
+	if len(list.Snapshots) == 0 {
+		b.WriteString(i18n.T("No snapshots.\n"))
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "%-40s  %-10s  %-13s  %-16s  %9s  %s\n", "Snapshot", "Type", "Status", "Created", "Size", "Engine")
+	for _, snap := range list.Snapshots {
+		created := "-"
+		if snap.Created != nil {
+			created = snap.Created.Local().Format("2006-01-02 15:04")
+		}
+		status := snap.Status
+		if snap.Progress > 0 && snap.Progress < 100 {
+			status = fmt.Sprintf("%s %d%%", status, snap.Progress)
+		}
+		engine := snap.Engine
+		if snap.Encrypted {
+			engine = strings.TrimSpace(engine + " 🔒")
+		}
+		fmt.Fprintf(&b, "%-40s  %-10s  %-13s  %-16s  %5d GiB  %s\n",
+			base.TruncateString(snap.ID, 40), snap.Type, status, created, snap.SizeGB, engine)
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	savings := 0.0
+	clusters := 0
+	for _, r := range v.Resources {
+		monthly, _ := r.Metadata["savings_monthly"].(float64)
+		savings += monthly
+		if r.Type == "rds:cluster" {
+			clusters++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "clusters", Text: i18n.T("Clusters: %d", clusters), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "cost", Text: i18n.T("Est. $%.2f/mo", v.Badge().Spend), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "savings", Text: i18n.T("Savings: $%.2f/mo", savings), Tone: core.ToneSuccess},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("RDS Databases"), v.SummaryWidgets())
+}
+
+// openRestoreForm asks for the target and time of a point-in-time restore,
+// suggesting a new identifier and the source's class.
+func (v *View) openRestoreForm(r *core.Resource) tea.Cmd {
+	if r.Type != "rds:db" || r.GetMetadataString("cluster_id") != "" {
+		v.Message = i18n.T("Point-in-time restore is only available for standalone instances")
+		return nil
+	}
+	def, ok := base.FindAction(v.Service(), "restore")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "restore")
+		return nil
+	}
+
+	params := make([]core.ActionParameter, 0, len(def.Parameters))
+	for _, p := range def.Parameters {
+		switch p.Name {
+		case "target":
+			p.Default = restoreName(r.ID, time.Now())
+		case "instance_class":
+			p.Default = r.GetMetadataString("instance_class")
+		case "multi_az":
+			p.Default, _ = r.Metadata["multi_az"].(bool)
+		}
+		params = append(params, p)
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(restoreFormID, i18n.T("Restore %s to a point in time", r.ID), params))
+}
+
+func (v *View) openActionForm(formID, action, title, resourceID string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	v.formTarget = resourceID
+	return v.OpenForm(components.NewForm(formID, title, def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return v.executeActionWithParams(action, resourceID, nil)
+}
+
+func (v *View) executeActionWithParams(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "rds" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -3,16 +3,24 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/compliance"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+	"github.com/keanuharrell/a9s/internal/ownership"
 )
 
 // =============================================================================
@@ -24,6 +32,36 @@ type Service struct {
 	factory    *awsfactory.ClientFactory
 	dispatcher core.EventDispatcher
 	testClient S3API
+	findings   core.FindingsProvider
+	owners     core.OwnerProvider
+	batches    *batch.Store
+}
+
+// Option configures the S3 service.
+type Option func(*Service)
+
+// WithAccessFindings enriches buckets with external access findings from the
+// given provider, typically IAM Access Analyzer.
+func WithAccessFindings(provider core.FindingsProvider) Option {
+	return func(s *Service) {
+		s.findings = provider
+	}
+}
+
+// WithOwners attributes buckets to the principal that created them, using
+// the given provider, typically CloudTrail.
+func WithOwners(provider core.OwnerProvider) Option {
+	return func(s *Service) {
+		s.owners = provider
+	}
+}
+
+// WithBatchStore keeps the state of batch deletions in store, so an
+// interrupted one can be resumed.
+func WithBatchStore(store *batch.Store) Option {
+	return func(s *Service) {
+		s.batches = store
+	}
 }
 
 // S3API defines the S3 client interface for mocking.
@@ -38,19 +76,27 @@ type S3API interface {
 }
 
 // NewService creates a new S3 service.
-func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
-	return &Service{
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
 		factory:    factory,
 		dispatcher: dispatcher,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // NewServiceWithClient creates a service with a custom client (for testing).
-func NewServiceWithClient(client S3API, dispatcher core.EventDispatcher) *Service {
-	return &Service{
+func NewServiceWithClient(client S3API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
 		testClient: client,
 		dispatcher: dispatcher,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // client returns the S3 client, fetching fresh from factory each time.
@@ -112,6 +158,7 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 		return nil, core.NewServiceError("s3", "list", err)
 	}
 
+	now := time.Now()
 	resources := make([]core.Resource, 0, len(result.Buckets))
 	for _, bucket := range result.Buckets {
 		bucketName := aws.ToString(bucket.Name)
@@ -120,6 +167,7 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 			ID:     bucketName,
 			Type:   "s3:bucket",
 			Name:   bucketName,
+			ARN:    "arn:aws:s3:::" + bucketName,
 			Region: "loading...",
 			State:  core.StatePending, // Not analyzed yet
 			Tags:   make(map[string]string),
@@ -140,6 +188,7 @@ func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resou
 			resource.CreatedAt = bucket.CreationDate
 			resource.Metadata["created_date"] = bucket.CreationDate.Format("2006-01-02")
 		}
+		estimate.ApplyAge(&resource, now)
 
 		resources = append(resources, resource)
 	}
@@ -160,25 +209,33 @@ func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) e
 	// Get bucket details (3 API calls per bucket - no ListObjectsV2 to avoid costs)
 	region := s.getBucketRegion(ctx, bucketName)
 	isPublic := s.isBucketPublic(ctx, bucketName)
-	hasTags := s.hasTags(ctx, bucketName)
+	tags, _ := s.Tags(ctx, bucketName)
+	hasTags := len(tags) > 0
 
 	// Determine cleanup status
 	shouldCleanup, cleanupReason := s.shouldCleanup(isPublic, hasTags)
 
-	// Determine state
-	state := core.StateActive
-	if shouldCleanup {
-		state = core.StateWarning
-	}
-
 	// Update resource
 	resource.Region = region
-	resource.State = state
+	if tags != nil {
+		resource.Tags = tags
+	}
+	iac.Apply(resource)
 	resource.Metadata["is_public"] = isPublic
 	resource.Metadata["has_tags"] = hasTags
 	resource.Metadata["should_cleanup"] = shouldCleanup
 	resource.Metadata["cleanup_reason"] = cleanupReason
+	compliance.Apply(resource, bucketChecks(isPublic))
+	s.applyFindings(ctx, resource)
+	if isPublic {
+		resource.AddIssue(core.SeverityHigh, "Public access not blocked")
+	}
+	if shouldCleanup {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+cleanupReason)
+	}
+	ownership.Apply(ctx, s.owners, resource)
 	resource.Metadata["analyzed"] = true
+	resource.State = core.StateActive
 
 	return nil
 }
@@ -223,6 +280,50 @@ func (s *Service) ListWithEnrichment(ctx context.Context, opts core.ListOptions)
 	return updateChan, nil
 }
 
+// =============================================================================
+// DetailFetcher Interface Implementation
+// =============================================================================
+
+// maxDetailObjects caps the objects and prefixes listed in a bucket's
+// details.
+const maxDetailObjects = 100
+
+// FetchDetail lists the top level of a bucket: its first prefixes and
+// objects, with their size and last modification.
+func (s *Service) FetchDetail(ctx context.Context, resource *core.Resource) ([]core.DetailSection, error) {
+	var optFns []func(*s3.Options)
+	if resource.Region != "" && resource.Region != "loading..." {
+		region := resource.Region
+		optFns = append(optFns, func(o *s3.Options) { o.Region = region })
+	}
+
+	out, err := s.client().ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(resource.Name),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int32(maxDetailObjects),
+	}, optFns...)
+	if err != nil {
+		return nil, core.NewServiceError("s3", "detail", err)
+	}
+
+	var b strings.Builder
+	for _, prefix := range out.CommonPrefixes {
+		fmt.Fprintf(&b, "%-60s %10s\n", aws.ToString(prefix.Prefix), "-")
+	}
+	for _, object := range out.Contents {
+		fmt.Fprintf(&b, "%-60s %10s  %s\n", aws.ToString(object.Key),
+			formatBytes(aws.ToInt64(object.Size)), aws.ToTime(object.LastModified).Local().Format("2006-01-02 15:04"))
+	}
+	if b.Len() == 0 {
+		b.WriteString("Empty\n")
+	}
+	if aws.ToBool(out.IsTruncated) {
+		fmt.Fprintf(&b, "... first %d shown\n", maxDetailObjects)
+	}
+
+	return []core.DetailSection{{Title: "Objects", Body: b.String()}}, nil
+}
+
 // =============================================================================
 // ResourceGetter Interface Implementation
 // =============================================================================
@@ -248,37 +349,46 @@ func (s *Service) Get(ctx context.Context, id string) (*core.Resource, error) {
 // ResourceMutator Interface Implementation
 // =============================================================================
 
-// Delete removes an S3 bucket.
+// Delete removes an S3 bucket, after deleting its objects page by page.
 func (s *Service) Delete(ctx context.Context, id string) error {
 	// First, delete all objects
-	listResult, err := s.client().ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+	paginator := s3.NewListObjectsV2Paginator(s.client(), &s3.ListObjectsV2Input{
 		Bucket: aws.String(id),
 	})
-	if err != nil {
-		return core.NewServiceError("s3", "delete", err)
-	}
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return core.NewServiceError("s3", "delete", err)
+		}
+		if len(page.Contents) == 0 {
+			continue
+		}
 
-	if len(listResult.Contents) > 0 {
-		var objectIDs []types.ObjectIdentifier
-		for _, obj := range listResult.Contents {
+		objectIDs := make([]types.ObjectIdentifier, 0, len(page.Contents))
+		for _, obj := range page.Contents {
 			objectIDs = append(objectIDs, types.ObjectIdentifier{
 				Key: obj.Key,
 			})
 		}
 
-		_, err = s.client().DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		out, err := s.client().DeleteObjects(ctx, &s3.DeleteObjectsInput{
 			Bucket: aws.String(id),
 			Delete: &types.Delete{
 				Objects: objectIDs,
+				Quiet:   aws.Bool(true),
 			},
 		})
+		if err == nil && len(out.Errors) > 0 {
+			first := out.Errors[0]
+			err = fmt.Errorf("%d objects not deleted, %s: %s", len(out.Errors), aws.ToString(first.Key), aws.ToString(first.Message))
+		}
 		if err != nil {
 			return core.NewServiceError("s3", "delete_objects", err)
 		}
 	}
 
 	// Then delete the bucket
-	_, err = s.client().DeleteBucket(ctx, &s3.DeleteBucketInput{
+	_, err := s.client().DeleteBucket(ctx, &s3.DeleteBucketInput{
 		Bucket: aws.String(id),
 	})
 	if err != nil {
@@ -324,10 +434,33 @@ func (s *Service) Actions() []core.Action {
 				},
 			},
 		},
+		{
+			Name:        "delete_batch",
+			Description: "Delete several buckets and all their contents",
+			Icon:        "trash",
+			Shortcut:    "D",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "ids", Type: "string", Required: true, Description: "Comma-separated names of the buckets to delete"},
+			},
+		},
+		{
+			Name:        "resume_batch",
+			Description: "Resume the interrupted batch deletion",
+			Icon:        "play",
+			Shortcut:    "R",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
 	}
 }
 
-// Execute runs the specified action on an S3 bucket.
+// Execute runs the specified action on an S3 bucket. Deleting asks for
+// confirmation through a core.ConfirmationError until the "confirm"
+// parameter is set and, for a single bucket, its name is typed back. A
+// batch deletion's resource ID only labels it; the bucket names are in the
+// "ids" parameter.
 func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
 	start := time.Now()
 
@@ -344,10 +477,26 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 	case "analyze":
 		result, err = s.analyzeBucket(ctx, resourceID)
 	case "delete":
-		if confirmed, _ := params["confirm"].(bool); !confirmed {
-			return core.NewActionResult(false, "Deletion not confirmed"), core.ErrConfirmationRequired
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Deleting a bucket cannot be undone", true)
 		}
 		result, err = s.deleteBucket(ctx, resourceID)
+	case "delete_batch":
+		raw, _ := params["ids"].(string)
+		var names []string
+		for _, name := range strings.Split(raw, ",") {
+			if name = strings.TrimSpace(name); name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, core.NewValidationError("ids", raw, "at least one bucket name is required")
+		}
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.deleteBatch(ctx, resourceID, names, params, confirmed)
+	case "resume_batch":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.resumeBatch(ctx, resourceID, params, confirmed)
 	default:
 		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
 	}
@@ -372,24 +521,76 @@ func (s *Service) Execute(ctx context.Context, action string, resourceID string,
 	return result, nil
 }
 
+// PendingBatch returns the batch deletion that was interrupted, if any.
+func (s *Service) PendingBatch() *batch.Job {
+	job, _ := s.batches.Pending(s.batchScope())
+	return job
+}
+
+// batchScope returns the scope of batch deletions: the profile, buckets
+// being listed across regions.
+func (s *Service) batchScope() string {
+	profile := ""
+	if s.factory != nil {
+		profile = s.factory.Profile()
+	}
+	return batch.Scope(s.Name(), profile, "")
+}
+
+// =============================================================================
+// Compliance
+// =============================================================================
+
+// CheckCompliance implements compliance.Checker.
+func (s *Service) CheckCompliance(ctx context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	// Listings leave the region to enrichment
+	resource.Region = s.getBucketRegion(ctx, resource.Name)
+	return bucketChecks(s.isBucketPublic(ctx, resource.Name)), nil
+}
+
+// bucketChecks returns the checks a bucket fails.
+func bucketChecks(isPublic bool) []compliance.Check {
+	if isPublic {
+		return []compliance.Check{compliance.CheckS3PublicBucket}
+	}
+	return nil
+}
+
 // =============================================================================
 // Action Implementations
 // =============================================================================
 
 func (s *Service) analyzeBucket(ctx context.Context, bucketName string) (*core.ActionResult, error) {
 	isPublic := s.isBucketPublic(ctx, bucketName)
-	hasTags := s.hasTags(ctx, bucketName)
+	tags, _ := s.Tags(ctx, bucketName)
+	hasTags := len(tags) > 0
 	shouldCleanup, cleanupReason := s.shouldCleanup(isPublic, hasTags)
 
 	result := core.NewActionResult(true, fmt.Sprintf("Analysis complete for %s", bucketName))
-	result.Data = map[string]any{
+	data := map[string]any{
 		"bucket_name":    bucketName,
 		"is_public":      isPublic,
 		"has_tags":       hasTags,
 		"should_cleanup": shouldCleanup,
 		"cleanup_reason": cleanupReason,
+		"controls":       compliance.References(bucketChecks(isPublic)),
 	}
 
+	// Name who to ask before cleaning up
+	if s.owners != nil {
+		bucket := &core.Resource{
+			ID:     bucketName,
+			Type:   "s3:bucket",
+			Name:   bucketName,
+			Region: s.getBucketRegion(ctx, bucketName),
+		}
+		if owner, ok, err := s.owners.OwnerOf(ctx, bucket); err == nil && ok {
+			data["owner"] = owner.Principal
+			data["owner_arn"] = owner.ARN
+		}
+	}
+	result.Data = data
+
 	return result, nil
 }
 
@@ -401,6 +602,50 @@ func (s *Service) deleteBucket(ctx context.Context, bucketName string) (*core.Ac
 	return core.NewActionResult(true, fmt.Sprintf("Bucket %s deleted successfully", bucketName)), nil
 }
 
+// deleteBatch deletes every bucket in turn with its objects once confirmed,
+// as a resumable batch going on after failures.
+func (s *Service) deleteBatch(ctx context.Context, label string, names []string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	if !confirmed {
+		reason := fmt.Sprintf("Deletes %d buckets and all their objects; none can be restored", len(names))
+		return nil, core.NewConfirmationError(s, "delete_batch", label, params, reason, false)
+	}
+
+	items := make([]batch.Item, 0, len(names))
+	for _, name := range names {
+		items = append(items, batch.Item{ID: name, Kind: "bucket"})
+	}
+	return s.runBatch(ctx, batch.NewJob(s.batchScope(), "delete_batch", items, nil)), nil
+}
+
+// resumeBatch deletes the buckets an interrupted batch did not get to.
+func (s *Service) resumeBatch(ctx context.Context, label string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	job, err := s.batches.Pending(s.batchScope())
+	if err == nil && job == nil {
+		err = fmt.Errorf("no interrupted batch to resume")
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("resume_batch", label, err)
+	}
+	if !confirmed {
+		reason := fmt.Sprintf("Resumes the batch started %s: %d of %d buckets are left",
+			job.Started.Local().Format("2006-01-02 15:04"), job.Count(batch.StatusPending), len(job.Items))
+		return nil, core.NewConfirmationError(s, "resume_batch", label, params, reason, false)
+	}
+	return s.runBatch(ctx, job), nil
+}
+
+// runBatch deletes the pending buckets of job. The result's data is the
+// job.
+func (s *Service) runBatch(ctx context.Context, job *batch.Job) *core.ActionResult {
+	err := batch.Run(ctx, job, func(ctx context.Context, item *batch.Item) error {
+		return s.Delete(ctx, item.ID)
+	}, batch.WithStore(s.batches))
+
+	result := core.NewActionResult(err == nil && job.Count(batch.StatusFailed) == 0, job.Summary())
+	result.Data = job
+	return result
+}
+
 // =============================================================================
 // Helper Functions
 // =============================================================================
@@ -427,11 +672,24 @@ func (s *Service) isBucketPublic(ctx context.Context, bucketName string) bool {
 	return err != nil
 }
 
-func (s *Service) hasTags(ctx context.Context, bucketName string) bool {
-	tags, err := s.client().GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+// Tags returns the tags of a bucket. A bucket without tags yields an empty
+// map rather than the NoSuchTagSet error S3 returns.
+func (s *Service) Tags(ctx context.Context, bucketName string) (map[string]string, error) {
+	out, err := s.client().GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
 		Bucket: aws.String(bucketName),
 	})
-	return err == nil && len(tags.TagSet) > 0
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchTagSet" {
+			return map[string]string{}, nil
+		}
+		return nil, core.NewServiceError("s3", "tags", err)
+	}
+	tags := make(map[string]string, len(out.TagSet))
+	for _, tag := range out.TagSet {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
 }
 
 func (s *Service) shouldCleanup(isPublic, hasTags bool) (bool, string) {
@@ -452,6 +710,34 @@ func (s *Service) shouldCleanup(isPublic, hasTags bool) (bool, string) {
 	return false, ""
 }
 
+// applyFindings attaches external access findings to the bucket and reports
+// whether any were found. Lookup errors are ignored so enrichment still succeeds.
+func (s *Service) applyFindings(ctx context.Context, resource *core.Resource) bool {
+	if s.findings == nil || resource.ARN == "" {
+		return false
+	}
+	findings, err := s.findings.FindingsFor(ctx, resource.ARN)
+	if err != nil {
+		return false
+	}
+	resource.ApplyFindings(findings)
+	return len(findings) > 0
+}
+
+// formatBytes formats a size in decimal units, as S3 bills storage.
+func formatBytes(bytes int64) string {
+	const unit = 1000
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "kMGTPE"[exp])
+}
+
 func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
 	if s.dispatcher != nil {
 		event := core.NewEvent(eventType, "s3", data)
@@ -469,13 +755,23 @@ func (s *Service) dispatchError(ctx context.Context, op string, err error) {
 	}
 }
 
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
 // =============================================================================
 // Interface Assertions
 // =============================================================================
 
 var (
-	_ core.AWSService     = (*Service)(nil)
-	_ core.ResourceLister = (*Service)(nil)
-	_ core.ResourceGetter = (*Service)(nil)
-	_ core.ActionExecutor = (*Service)(nil)
+	_ core.AWSService      = (*Service)(nil)
+	_ core.ResourceLister  = (*Service)(nil)
+	_ core.EnrichingLister = (*Service)(nil)
+	_ core.ResourceGetter  = (*Service)(nil)
+	_ core.DetailFetcher   = (*Service)(nil)
+	_ core.TagReader       = (*Service)(nil)
+	_ core.ActionExecutor  = (*Service)(nil)
+
+	_ compliance.Checker  = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+	_ batch.Resumer       = (*Service)(nil)
 )
@@ -0,0 +1,242 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeS3 serves two buckets: logs, holding two objects, untagged and
+// without a public access block, and assets, tagged and blocked in
+// eu-west-1. It fails every call when err is set, and records the objects
+// and buckets deleted. Deleting the bucket named notEmpty fails as S3 does
+// for a bucket with objects left.
+type fakeS3 struct {
+	err            error
+	notEmpty       string
+	deletedObjects []string
+	deletedBuckets []string
+}
+
+func (f *fakeS3) ListBuckets(context.Context, *s3.ListBucketsInput, ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &s3.ListBucketsOutput{Buckets: []types.Bucket{
+		{Name: aws.String("logs")},
+		{Name: aws.String("assets")},
+	}}, nil
+}
+
+func (f *fakeS3) GetBucketLocation(_ context.Context, in *s3.GetBucketLocationInput, _ ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Bucket) == "assets" {
+		return &s3.GetBucketLocationOutput{LocationConstraint: types.BucketLocationConstraintEuWest1}, nil
+	}
+	return &s3.GetBucketLocationOutput{}, nil
+}
+
+func (f *fakeS3) ListObjectsV2(_ context.Context, in *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Bucket) != "logs" {
+		return &s3.ListObjectsV2Output{}, nil
+	}
+	return &s3.ListObjectsV2Output{Contents: []types.Object{
+		{Key: aws.String("2024/01/app.log"), Size: aws.Int64(2048)},
+		{Key: aws.String("2024/02/app.log"), Size: aws.Int64(4096)},
+	}}, nil
+}
+
+func (f *fakeS3) GetPublicAccessBlock(_ context.Context, in *s3.GetPublicAccessBlockInput, _ ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Bucket) != "assets" {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchPublicAccessBlockConfiguration"}
+	}
+	return &s3.GetPublicAccessBlockOutput{}, nil
+}
+
+func (f *fakeS3) GetBucketTagging(_ context.Context, in *s3.GetBucketTaggingInput, _ ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Bucket) != "assets" {
+		return nil, &smithy.GenericAPIError{Code: "NoSuchTagSet"}
+	}
+	return &s3.GetBucketTaggingOutput{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String("web")}}}, nil
+}
+
+func (f *fakeS3) DeleteObjects(_ context.Context, in *s3.DeleteObjectsInput, _ ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, object := range in.Delete.Objects {
+		f.deletedObjects = append(f.deletedObjects, aws.ToString(object.Key))
+	}
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func (f *fakeS3) DeleteBucket(_ context.Context, in *s3.DeleteBucketInput, _ ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.Bucket) == f.notEmpty {
+		return nil, &smithy.GenericAPIError{Code: "BucketNotEmpty", Message: "The bucket you tried to delete is not empty"}
+	}
+	f.deletedBuckets = append(f.deletedBuckets, aws.ToString(in.Bucket))
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against buckets.
+// Deleting is the checked action: analyzing reports a bucket it cannot
+// inspect as public rather than failing.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeS3{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeS3{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    "logs",
+		MissingID:     "no-such-bucket",
+		Action:        "delete",
+		ActionParams:  map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "logs"},
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestDeleteEmptiesBucketFirst(t *testing.T) {
+	fake := &fakeS3{}
+	svc := NewServiceWithClient(fake, nil)
+
+	if err := svc.Delete(context.Background(), "logs"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if want := []string{"2024/01/app.log", "2024/02/app.log"}; !slices.Equal(fake.deletedObjects, want) {
+		t.Errorf("deleted objects %v, want %v", fake.deletedObjects, want)
+	}
+	if !slices.Equal(fake.deletedBuckets, []string{"logs"}) {
+		t.Errorf("deleted buckets %v, want [logs]", fake.deletedBuckets)
+	}
+}
+
+// TestDeleteBatch checks that marked buckets are deleted one at a time once
+// confirmed, going on after a bucket that cannot be deleted.
+func TestDeleteBatch(t *testing.T) {
+	store := batch.NewStore(filepath.Join(t.TempDir(), "batches.json"))
+	fake := &fakeS3{notEmpty: "assets"}
+	svc := NewServiceWithClient(fake, nil, WithBatchStore(store))
+	params := map[string]any{"ids": "assets, logs,assets"}
+
+	_, err := svc.Execute(context.Background(), "delete_batch", "2 marked", params)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.HasPrefix(confirm.Reason, "Deletes 2 buckets") {
+		t.Fatalf("unconfirmed delete_batch error = %v", err)
+	}
+	if len(fake.deletedBuckets) > 0 {
+		t.Fatalf("deleted %v before the confirmation", fake.deletedBuckets)
+	}
+
+	params[core.ParamConfirm] = true
+	result, err := svc.Execute(context.Background(), "delete_batch", "2 marked", params)
+	if err != nil {
+		t.Fatalf("delete_batch error = %v", err)
+	}
+	if result.Success || result.Message != "Deleted 1 of 2, 1 failed" {
+		t.Errorf("delete_batch = %q, success %v", result.Message, result.Success)
+	}
+	job, _ := result.Data.(*batch.Job)
+	if job == nil || job.Items[0].Status != batch.StatusFailed || !strings.Contains(job.Items[0].Error, "BucketNotEmpty") {
+		t.Errorf("job = %+v, want assets failed as not empty", job)
+	}
+	if !slices.Equal(fake.deletedBuckets, []string{"logs"}) {
+		t.Errorf("deleted buckets %v, want [logs]", fake.deletedBuckets)
+	}
+	if svc.PendingBatch() != nil {
+		t.Error("batch still pending once finished")
+	}
+}
+
+// TestResumeBatch checks that an interrupted batch only deletes the buckets
+// it did not get to.
+func TestResumeBatch(t *testing.T) {
+	store := batch.NewStore(filepath.Join(t.TempDir(), "batches.json"))
+	fake := &fakeS3{}
+	svc := NewServiceWithClient(fake, nil, WithBatchStore(store))
+
+	job := batch.NewJob(svc.batchScope(), "delete_batch", []batch.Item{
+		{ID: "logs", Kind: "bucket"},
+		{ID: "assets", Kind: "bucket"},
+	}, nil)
+	job.Items[0].Status = batch.StatusDone
+	if err := store.Save(job); err != nil {
+		t.Fatal(err)
+	}
+	if svc.PendingBatch() == nil {
+		t.Fatal("PendingBatch() = nil, want the saved batch")
+	}
+
+	_, err := svc.Execute(context.Background(), "resume_batch", "1 left", nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || !strings.HasSuffix(confirm.Reason, ": 1 of 2 buckets are left") {
+		t.Fatalf("unconfirmed resume_batch error = %v", err)
+	}
+
+	result, err := svc.Execute(context.Background(), "resume_batch", "1 left", map[string]any{core.ParamConfirm: true})
+	if err != nil {
+		t.Fatalf("resume_batch error = %v", err)
+	}
+	if !result.Success || result.Message != "Deleted 2 of 2, 0 failed" {
+		t.Errorf("resume_batch = %q, success %v", result.Message, result.Success)
+	}
+	if !slices.Equal(fake.deletedBuckets, []string{"assets"}) {
+		t.Errorf("deleted buckets %v, want [assets]", fake.deletedBuckets)
+	}
+	if svc.PendingBatch() != nil {
+		t.Error("batch still pending once finished")
+	}
+}
+
+func TestEnrichFlagsPublicUntaggedBucket(t *testing.T) {
+	svc := NewServiceWithClient(&fakeS3{}, nil)
+	resources, err := svc.List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for i := range resources {
+		if err := svc.EnrichResource(context.Background(), &resources[i]); err != nil {
+			t.Fatalf("EnrichResource(%q) error = %v", resources[i].ID, err)
+		}
+	}
+	logs, assets := resources[0], resources[1]
+
+	logsPublic, _ := logs.Metadata["is_public"].(bool)
+	if logs.Region != "us-east-1" || !logsPublic || logs.GetMetadataString("cleanup_reason") != "public without tags, untagged" {
+		t.Errorf("logs in %s, public %v, cleanup %q", logs.Region, logsPublic, logs.GetMetadataString("cleanup_reason"))
+	}
+	assetsPublic, _ := assets.Metadata["is_public"].(bool)
+	assetsCleanup, _ := assets.Metadata["should_cleanup"].(bool)
+	if assets.Region != "eu-west-1" || assetsPublic || assetsCleanup || assets.Tags["team"] != "web" {
+		t.Errorf("assets in %s, public %v, cleanup %v, tags %v", assets.Region, assetsPublic, assetsCleanup, assets.Tags)
+	}
+}
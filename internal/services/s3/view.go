@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 
+	"github.com/keanuharrell/a9s/internal/batch"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
 	"github.com/keanuharrell/a9s/internal/services/base"
 )
 
@@ -19,28 +19,33 @@ import (
 
 // View implements the TUI view for S3 buckets.
 type View struct {
-	*base.TableView
-	enriching  bool
-	analyzed   int
-	cancelFunc context.CancelFunc
-	cache      map[string]*core.Resource
+	*base.EnrichableTableView
+
+	marked  map[string]bool // Buckets marked for a batch deletion
+	pending *batch.Job      // Interrupted batch deletion, as of the last refresh
 }
 
 // NewView creates a new S3 view.
 func NewView() *View {
 	columnDefs := []base.ColumnDef{
-		{Title: "Name", MinWidth: 20, MaxWidth: 50, Weight: 2.0, Priority: 0},
-		{Title: "Region", MinWidth: 10, MaxWidth: 16, Weight: 0.5, Priority: 1},
-		{Title: "Created", MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 3},
-		{Title: "Public", MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
-		{Title: "Tagged", MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
-		{Title: "Cleanup", MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
-	}
-
-	return &View{
-		TableView: base.NewTableView("S3", "3", "s3", columnDefs),
-		cache:     make(map[string]*core.Resource),
-	}
+		{Title: "", MinWidth: 2, MaxWidth: 2, Weight: 0.1, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 20, MaxWidth: 50, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Region"), MinWidth: 10, MaxWidth: 16, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Created"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Public"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("External"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Tagged"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Cleanup"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Controls"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Owner"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 4},
+	}
+
+	v := &View{marked: make(map[string]bool)}
+	v.EnrichableTableView = base.NewEnrichableTableView("S3", "3", "s3", i18n.T("buckets"), columnDefs, v.buildMarkedRow)
+	return v
 }
 
 // =============================================================================
@@ -52,94 +57,81 @@ func (v *View) Init() tea.Cmd {
 	if len(v.Resources) > 0 || v.IsLoading() {
 		return nil
 	}
-	return v.loadBuckets()
+	v.pending = v.PendingBatch()
+	return v.Load()
 }
 
 func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
+		case " ":
+			if row := v.GetSelectedResource(); row != nil {
+				v.marked[row.ID] = !v.marked[row.ID]
+				if !v.marked[row.ID] {
+					delete(v.marked, row.ID)
+				}
+				v.RefreshRows()
+			}
+			// Space also pages down in tables
+			return v, nil
 		case "R":
-			v.Message = "Full refresh..."
-			return v, v.hardRefresh()
+			// An interrupted batch deletion is resumed before anything else
+			if job := v.PendingBatch(); job != nil {
+				label := i18n.T("%d left", job.Count(batch.StatusPending))
+				return v, v.executeAction("resume_batch", label, nil)
+			}
+			v.Message = i18n.T("Full refresh...")
+			return v, v.Load()
 		case "a":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Analyzing %s...", row.Name)
-				return v, v.analyzeSelected()
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
 			}
 		case "d":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Press 'D' to confirm deletion of %s", row.Name)
+				v.Message = i18n.T("Press 'D' to confirm deletion of %s", row.Name)
 			}
 		case "D":
+			if ids := v.markedIDs(); len(ids) > 0 {
+				label := i18n.T("%d marked", len(ids))
+				return v, v.executeAction("delete_batch", label, map[string]any{"ids": strings.Join(ids, ",")})
+			}
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("Deleting %s...", row.Name)
-				return v, v.executeAction("delete", row.Name)
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.Name, nil)
 			}
 		case "enter":
 			if row := v.GetSelectedResource(); row != nil {
-				v.Message = fmt.Sprintf("%s: %s", row.Name, row.GetMetadataString("size_human"))
-			}
-		}
-
-	case s3LoadedMsg:
-		v.SetLoading(false)
-		if msg.err != nil {
-			v.SetError(msg.err)
-			v.Message = fmt.Sprintf("Error: %v", msg.err)
-		} else {
-			v.SetError(nil)
-			if msg.hardRefresh {
-				v.cache = make(map[string]*core.Resource)
-				v.analyzed = 0
-				v.Resources = msg.resources
-				v.updateTable()
-				v.Message = fmt.Sprintf("Loaded %d buckets, analyzing...", len(msg.resources))
-				cmds = append(cmds, v.startEnrichment())
-			} else {
-				newCount := 0
-				v.Resources = msg.resources
-				for i := range v.Resources {
-					if cached, ok := v.cache[v.Resources[i].Name]; ok {
-						v.Resources[i] = *cached
-					} else {
-						newCount++
-					}
-				}
-				v.updateTable()
-				if newCount > 0 {
-					v.Message = fmt.Sprintf("Found %d new buckets, analyzing...", newCount)
-					cmds = append(cmds, v.startEnrichmentForNew())
-				} else {
-					v.Message = fmt.Sprintf("Refreshed %d buckets", len(msg.resources))
-				}
+				return v, v.OpenLazyDetail(i18n.T("Bucket %s", row.Name), formatBucket(row), row)
 			}
 		}
 
-	case s3ResourceEnrichedMsg:
-		if msg.index >= 0 && msg.index < len(v.Resources) {
-			v.Resources[msg.index] = msg.resource
-			v.cache[msg.resource.Name] = &v.Resources[msg.index]
-			v.analyzed++
-			v.updateTableRow(msg.index)
-			v.Message = fmt.Sprintf("Analyzing... %d/%d", v.analyzed, len(v.Resources))
-			cmds = append(cmds, v.continueEnrichment())
-		}
-
-	case s3EnrichmentDoneMsg:
-		v.enriching = false
-		v.Message = fmt.Sprintf("Loaded %d buckets", len(v.Resources))
-
 	case base.ActionResultMsg:
 		if msg.Error != nil {
-			v.Message = fmt.Sprintf("Action failed: %v", msg.Error)
+			v.Message = i18n.T("Action failed: %v", msg.Error)
 		} else if msg.Result != nil {
 			v.Message = msg.Result.Message
 		}
-		if msg.Action == "delete" {
-			cmds = append(cmds, v.loadBuckets())
+		if msg.Result != nil {
+			if job, ok := msg.Result.Data.(*batch.Job); ok {
+				v.OpenDetail(msg.Result.Message, base.FormatBatchReport(job))
+				v.marked = make(map[string]bool)
+			}
+		}
+		switch msg.Action {
+		case "delete", "delete_batch", "resume_batch":
+			v.pending = v.PendingBatch()
+			cmds = append(cmds, v.SoftRefresh())
 		}
 
 	case tea.WindowSizeMsg:
@@ -160,11 +152,11 @@ func (v *View) View() string {
 
 	// Table or loading/error
 	if v.IsLoading() && len(v.Resources) == 0 {
-		lines = append(lines, v.Styles.Muted.Render("Loading S3 buckets..."))
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading S3 buckets...")))
 	} else if err := v.Error(); err != nil {
-		lines = append(lines, v.Styles.Error.Render(fmt.Sprintf("Error: %v", err)))
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
 	} else {
-		lines = append(lines, v.TableViewString())
+		lines = append(lines, v.ContentView())
 	}
 
 	// Message or blank
@@ -175,7 +167,7 @@ func (v *View) View() string {
 	}
 
 	// Help
-	lines = append(lines, v.Styles.Help.Render("[a]nalyze  [d]elete  [r]efresh  [R]e-analyze  [↑/↓]nav"))
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[Enter]objects  [a]nalyze  [d]elete  [space]mark  [D]elete marked  [r]efresh  [R]e-analyze  [↑/↓]nav")))
 	return strings.Join(lines, "\n")
 }
 
@@ -184,286 +176,139 @@ func (v *View) View() string {
 // =============================================================================
 
 func (v *View) Refresh() tea.Cmd {
-	return v.softRefresh()
-}
-
-// Reset clears all view data including cache.
-func (v *View) Reset() {
-	v.TableView.Reset()
-	v.cache = make(map[string]*core.Resource)
-	v.analyzed = 0
-	v.enriching = false
-	if v.cancelFunc != nil {
-		v.cancelFunc()
-		v.cancelFunc = nil
-	}
-}
-
-func (v *View) softRefresh() tea.Cmd {
-	v.SetLoading(true)
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return s3LoadedMsg{err: fmt.Errorf("service not initialized"), hardRefresh: false}
-		}
-		lister, ok := service.(core.ResourceLister)
-		if !ok {
-			return s3LoadedMsg{err: fmt.Errorf("service does not support listing"), hardRefresh: false}
-		}
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return s3LoadedMsg{resources: resources, err: err, hardRefresh: false}
-	}
-}
-
-func (v *View) hardRefresh() tea.Cmd {
-	v.cache = make(map[string]*core.Resource)
-	v.analyzed = 0
-	return v.loadBuckets()
-}
-
-func (v *View) analyzeSelected() tea.Cmd {
-	cursor := v.Cursor()
-	if cursor < 0 || cursor >= len(v.Resources) {
-		return nil
-	}
-	service := v.Service()
-	if service == nil {
-		return nil
-	}
-	s3Svc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	index := cursor
-	return func() tea.Msg {
-		resource := v.Resources[index]
-		delete(v.cache, resource.Name)
-		resource.Metadata["analyzed"] = false
-		if err := s3Svc.EnrichResource(context.Background(), &resource); err == nil {
-			return s3ResourceEnrichedMsg{index: index, resource: resource}
-		}
-		return s3EnrichmentDoneMsg{}
-	}
+	v.pruneMarks()
+	v.pending = v.PendingBatch()
+	return v.SoftRefresh()
 }
 
 // =============================================================================
 // Internal Methods
 // =============================================================================
 
-type s3LoadedMsg struct {
-	resources   []core.Resource
-	err         error
-	hardRefresh bool
-}
-
-type s3ResourceEnrichedMsg struct {
-	index    int
-	resource core.Resource
-}
-
-type s3EnrichmentDoneMsg struct{}
-
-func (v *View) loadBuckets() tea.Cmd {
-	if v.cancelFunc != nil {
-		v.cancelFunc()
-	}
-	v.SetLoading(true)
-	v.enriching = false
-
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
 	return func() tea.Msg {
 		service := v.Service()
 		if service == nil {
-			return s3LoadedMsg{err: fmt.Errorf("service not initialized"), hardRefresh: true}
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
 		}
-		lister, ok := service.(core.ResourceLister)
+		executor, ok := service.(core.ActionExecutor)
 		if !ok {
-			return s3LoadedMsg{err: fmt.Errorf("service does not support listing"), hardRefresh: true}
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
 		}
-		resources, err := lister.List(context.Background(), core.ListOptions{})
-		return s3LoadedMsg{resources: resources, err: err, hardRefresh: true}
-	}
-}
-
-func (v *View) startEnrichment() tea.Cmd {
-	service := v.Service()
-	if service == nil {
-		return nil
-	}
-	s3Svc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	v.cancelFunc = cancel
-	v.enriching = true
-
-	return func() tea.Msg {
-		for i := range v.Resources {
-			select {
-			case <-ctx.Done():
-				return s3EnrichmentDoneMsg{}
-			default:
-				resource := v.Resources[i]
-				if err := s3Svc.EnrichResource(ctx, &resource); err == nil {
-					return s3ResourceEnrichedMsg{index: i, resource: resource}
-				}
-			}
+		if params == nil {
+			params = map[string]any{}
 		}
-		return s3EnrichmentDoneMsg{}
-	}
-}
-
-func (v *View) startEnrichmentForNew() tea.Cmd {
-	service := v.Service()
-	if service == nil {
-		return nil
-	}
-	s3Svc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	v.cancelFunc = cancel
-	v.enriching = true
-
-	return func() tea.Msg {
-		for i := range v.Resources {
-			if _, inCache := v.cache[v.Resources[i].Name]; inCache {
-				continue
-			}
-			if analyzed, ok := v.Resources[i].Metadata["analyzed"].(bool); ok && analyzed {
-				continue
-			}
-			select {
-			case <-ctx.Done():
-				return s3EnrichmentDoneMsg{}
-			default:
-				resource := v.Resources[i]
-				if err := s3Svc.EnrichResource(ctx, &resource); err == nil {
-					return s3ResourceEnrichedMsg{index: i, resource: resource}
-				}
-			}
+		if action == "delete" {
+			params["confirm"] = true
 		}
-		return s3EnrichmentDoneMsg{}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
 	}
 }
 
-func (v *View) continueEnrichment() tea.Cmd {
-	service := v.Service()
-	if service == nil || !v.enriching {
-		return nil
-	}
-	s3Svc, ok := service.(*Service)
-	if !ok {
-		return nil
-	}
-
-	nextIndex := -1
-	for i, r := range v.Resources {
-		if analyzed, ok := r.Metadata["analyzed"].(bool); !ok || !analyzed {
-			nextIndex = i
-			break
+// markedIDs returns the marked buckets in table order.
+func (v *View) markedIDs() []string {
+	var ids []string
+	for _, r := range v.Resources {
+		if v.marked[r.ID] {
+			ids = append(ids, r.ID)
 		}
 	}
+	return ids
+}
 
-	if nextIndex == -1 {
-		v.enriching = false
-		return func() tea.Msg { return s3EnrichmentDoneMsg{} }
-	}
-
-	ctx := context.Background()
-	if v.cancelFunc != nil {
-		ctx, v.cancelFunc = context.WithCancel(context.Background())
+// pruneMarks drops the marks of buckets no longer listed.
+func (v *View) pruneMarks() {
+	listed := make(map[string]bool, len(v.Resources))
+	for _, r := range v.Resources {
+		listed[r.ID] = true
 	}
-
-	return func() tea.Msg {
-		resource := v.Resources[nextIndex]
-		if err := s3Svc.EnrichResource(ctx, &resource); err == nil {
-			return s3ResourceEnrichedMsg{index: nextIndex, resource: resource}
+	for id := range v.marked {
+		if !listed[id] {
+			delete(v.marked, id)
 		}
-		return s3EnrichmentDoneMsg{}
 	}
 }
 
-func (v *View) executeAction(action, resourceID string) tea.Cmd {
-	return func() tea.Msg {
-		service := v.Service()
-		if service == nil {
-			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
-		}
-		executor, ok := service.(core.ActionExecutor)
-		if !ok {
-			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
-		}
-		params := map[string]any{}
-		if action == "delete" {
-			params["confirm"] = true
-		}
-		result, err := executor.Execute(context.Background(), action, resourceID, params)
-		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+// buildMarkedRow renders a bucket preceded by whether it is marked.
+func (v *View) buildMarkedRow(r core.Resource) base.Row {
+	mark := ""
+	if v.marked[r.ID] {
+		mark = "✓"
 	}
+	return append(base.Row{base.TextCell(mark)}, buildRow(r)...)
 }
 
-func (v *View) updateTable() {
-	rows := make([]table.Row, len(v.Resources))
-	for i := range v.Resources {
-		rows[i] = v.buildRow(i)
+// formatBucket renders what is known of a bucket for the detail panel,
+// before its objects are listed.
+func formatBucket(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:     %s\n", r.ARN)
+	fmt.Fprintf(&b, "Region:  %s\n", r.Region)
+	if created := r.GetMetadataString("created_date"); created != "" {
+		fmt.Fprintf(&b, "Created: %s\n", created)
 	}
-	v.SetRows(rows)
-}
 
-func (v *View) updateTableRow(index int) {
-	if index < 0 || index >= len(v.Resources) {
-		return
-	}
-	rows := v.Table.Rows()
-	if index < len(rows) {
-		rows[index] = v.buildRow(index)
-		v.SetRows(rows)
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
 	}
+	return b.String()
 }
 
-func (v *View) buildRow(index int) table.Row {
-	r := v.Resources[index]
-
+func buildRow(r core.Resource) base.Row {
 	isPublic, _ := r.Metadata["is_public"].(bool)
 	hasTags, _ := r.Metadata["has_tags"].(bool)
 	shouldCleanup, _ := r.Metadata["should_cleanup"].(bool)
+	external, externalKnown := r.Metadata["external_access"].(bool)
 	createdDate, _ := r.Metadata["created_date"].(string)
 	analyzed, _ := r.Metadata["analyzed"].(bool)
 
-	publicIcon, taggedIcon, cleanupIcon := "...", "...", "..."
+	publicIcon, externalIcon, taggedIcon, cleanupIcon := "...", "...", "...", "..."
 	if analyzed {
-		publicIcon = "🟢 No"
+		publicIcon = "🟢 " + i18n.T("No")
 		if isPublic {
-			publicIcon = "🔴 Yes"
+			publicIcon = "🔴 " + i18n.T("Yes")
+		}
+		externalIcon = "-"
+		if externalKnown {
+			externalIcon = "🟢 " + i18n.T("No")
+			if external {
+				externalIcon = "🔴 " + i18n.T("Yes")
+			}
 		}
-		taggedIcon = "🔴 No"
+		taggedIcon = "🔴 " + i18n.T("No")
 		if hasTags {
-			taggedIcon = "🟢 Yes"
+			taggedIcon = "🟢 " + i18n.T("Yes")
 		}
-		cleanupIcon = "🟢 No"
+		cleanupIcon = "🟢 " + i18n.T("No")
 		if shouldCleanup {
-			cleanupIcon = "🟡 Yes"
+			cleanupIcon = "🟡 " + i18n.T("Yes")
 		}
 	}
 
-	return table.Row{
-		base.TruncateString(r.Name, 50),
-		r.Region,
-		createdDate,
-		publicIcon,
-		taggedIcon,
-		cleanupIcon,
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(r.Region),
+		base.TextCell(createdDate),
+		base.AgeCell(r),
+		base.TextCell(publicIcon),
+		base.TextCell(externalIcon),
+		base.TextCell(taggedIcon),
+		base.TextCell(cleanupIcon),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatControls(r)),
+		base.TextCell(base.FormatIaC(r)),
+		base.TextCell(base.FormatOwner(r)),
 	}
 }
 
-func (v *View) renderSummary() string {
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
 	total := len(v.Resources)
-	public, cleanup, analyzed := 0, 0, 0
+	public, external, cleanup, analyzed := 0, 0, 0, 0
 
 	for _, r := range v.Resources {
 		if isAnalyzed, ok := r.Metadata["analyzed"].(bool); ok && isAnalyzed {
@@ -472,23 +317,35 @@ func (v *View) renderSummary() string {
 		if isPublic, ok := r.Metadata["is_public"].(bool); ok && isPublic {
 			public++
 		}
+		if isExternal, ok := r.Metadata["external_access"].(bool); ok && isExternal {
+			external++
+		}
 		if shouldCleanup, ok := r.Metadata["should_cleanup"].(bool); ok && shouldCleanup {
 			cleanup++
 		}
 	}
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		v.Styles.Title.Render("S3 Buckets"),
-		"  ",
-		v.Styles.Muted.Render(fmt.Sprintf("Analyzed: %d/%d", analyzed, total)),
-		"  ",
-		v.Styles.Error.Render(fmt.Sprintf("Public: %d", public)),
-		"  ",
-		v.Styles.Warning.Render(fmt.Sprintf("Cleanup: %d", cleanup)),
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "analyzed", Text: i18n.T("Analyzed: %d/%d", analyzed, total), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "public", Text: i18n.T("Public: %d", public), Tone: core.ToneError},
+		core.SummaryWidget{Name: "external", Text: i18n.T("External: %d", external), Tone: core.ToneError},
+		core.SummaryWidget{Name: "cleanup", Text: i18n.T("Cleanup: %d", cleanup), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "marked", Text: i18n.T("Marked: %d", len(v.marked)), Tone: core.ToneInfo},
 	)
 }
 
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("S3 Buckets"), v.SummaryWidgets()) + v.renderPendingBatch()
+}
+
+// renderPendingBatch names how many buckets an interrupted batch has left.
+func (v *View) renderPendingBatch() string {
+	if v.pending == nil {
+		return ""
+	}
+	return "  " + v.Styles.Warning.Render(i18n.T("Interrupted batch: %d left, [R] resumes", v.pending.Count(batch.StatusPending)))
+}
+
 // =============================================================================
 // View Factory
 // =============================================================================
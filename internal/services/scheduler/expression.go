@@ -0,0 +1,330 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Schedule Expressions
+// =============================================================================
+
+// Kinds of schedule expressions.
+const (
+	KindOneOff = "one-off"
+	KindRate   = "rate"
+	KindCron   = "cron"
+)
+
+// cronHorizon bounds how far ahead the next run of a cron expression is
+// looked for.
+const cronHorizon = 5 * 366
+
+// Expression is a parsed at(), rate() or cron() schedule expression.
+type Expression struct {
+	Kind string
+
+	at   time.Time     // One-off: wall clock time, in the schedule's zone
+	rate time.Duration // Rate
+	cron *cronSpec     // Cron
+}
+
+// ParseExpression parses a schedule expression.
+func ParseExpression(expr string) (Expression, error) {
+	expr = strings.TrimSpace(expr)
+	open, end := strings.IndexByte(expr, '('), strings.LastIndexByte(expr, ')')
+	if open < 0 || end != len(expr)-1 {
+		return Expression{}, fmt.Errorf("invalid schedule expression %q", expr)
+	}
+	body := strings.TrimSpace(expr[open+1 : end])
+
+	switch expr[:open] {
+	case "at":
+		t, err := time.Parse("2006-01-02T15:04:05", body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("invalid at() expression %q: %w", expr, err)
+		}
+		return Expression{Kind: KindOneOff, at: t}, nil
+	case "rate":
+		fields := strings.Fields(body)
+		if len(fields) != 2 {
+			return Expression{}, fmt.Errorf("invalid rate() expression %q", expr)
+		}
+		n, err := strconv.Atoi(fields[0])
+		if err != nil || n < 1 {
+			return Expression{}, fmt.Errorf("invalid rate() value %q", fields[0])
+		}
+		var unit time.Duration
+		switch strings.TrimSuffix(fields[1], "s") {
+		case "minute":
+			unit = time.Minute
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		default:
+			return Expression{}, fmt.Errorf("invalid rate() unit %q", fields[1])
+		}
+		if int64(n) > math.MaxInt64/int64(unit) {
+			return Expression{}, fmt.Errorf("invalid rate() value %q", fields[0])
+		}
+		return Expression{Kind: KindRate, rate: time.Duration(n) * unit}, nil
+	case "cron":
+		spec, err := parseCron(body)
+		if err != nil {
+			return Expression{}, fmt.Errorf("invalid cron() expression %q: %w", expr, err)
+		}
+		return Expression{Kind: KindCron, cron: spec}, nil
+	}
+	return Expression{}, fmt.Errorf("invalid schedule expression %q", expr)
+}
+
+// Next returns the first run strictly after a time, in loc. Rate schedules
+// count from anchor, the start of the schedule. It returns false when the
+// schedule does not run again.
+func (e Expression) Next(after, anchor time.Time, loc *time.Location) (time.Time, bool) {
+	switch e.Kind {
+	case KindOneOff:
+		at := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, loc)
+		return at, at.After(after)
+	case KindRate:
+		if anchor.After(after) {
+			return anchor, true
+		}
+		periods := after.Sub(anchor)/e.rate + 1
+		return anchor.Add(periods * e.rate), true
+	case KindCron:
+		return e.cron.next(after.In(loc))
+	}
+	return time.Time{}, false
+}
+
+// =============================================================================
+// Cron Expressions
+// =============================================================================
+
+// cronSpec is a cron expression of the form
+// "minutes hours day-of-month month day-of-week year".
+type cronSpec struct {
+	minutes, hours, months, years []bool // Indexed by value
+	dom, dow                      func(day time.Time) bool
+}
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+// Days of the week run from 1 (Sunday) to 7 (Saturday).
+var dayNames = map[string]int{
+	"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+}
+
+func parseCron(body string) (*cronSpec, error) {
+	fields := strings.Fields(body)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected 6 fields, got %d", len(fields))
+	}
+	if (fields[2] == "?") == (fields[4] == "?") {
+		return nil, fmt.Errorf("exactly one of day-of-month and day-of-week must be ?")
+	}
+
+	spec := &cronSpec{}
+	var err error
+	if spec.minutes, err = parseSet(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if spec.hours, err = parseSet(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if spec.dom, err = parseDayOfMonth(fields[2]); err != nil {
+		return nil, err
+	}
+	if spec.months, err = parseSet(fields[3], 1, 12, monthNames); err != nil {
+		return nil, err
+	}
+	if spec.dow, err = parseDayOfWeek(fields[4]); err != nil {
+		return nil, err
+	}
+	if spec.years, err = parseSet(fields[5], 1970, 2199, nil); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// parseSet parses a field of values, ranges and steps such as "0,30",
+// "MON-FRI" or "*/15" into the set of values it matches.
+func parseSet(field string, lo, hi int, names map[string]int) ([]bool, error) {
+	set := make([]bool, hi+1)
+	value := func(s string) (int, error) {
+		if n, ok := names[strings.ToUpper(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < lo || n > hi {
+			return 0, fmt.Errorf("value %q out of range %d-%d", s, lo, hi)
+		}
+		return n, nil
+	}
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, s, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step %q", s)
+			}
+			// A step past the range matches its start only; capping it
+			// keeps the loop below from overflowing
+			part, step = base, min(n, hi-lo+1)
+		}
+
+		from, to := lo, hi
+		switch {
+		case part == "*" || part == "?":
+		case strings.Contains(part, "-"):
+			a, b, _ := strings.Cut(part, "-")
+			var err error
+			if from, err = value(a); err != nil {
+				return nil, err
+			}
+			if to, err = value(b); err != nil {
+				return nil, err
+			}
+		default:
+			n, err := value(part)
+			if err != nil {
+				return nil, err
+			}
+			from = n
+			if step == 1 {
+				to = n
+			}
+		}
+		for v := from; v <= to; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// parseDayOfMonth parses the day-of-month field, including "L" (last day),
+// "LW" (last weekday) and "15W" (weekday nearest the 15th).
+func parseDayOfMonth(field string) (func(time.Time) bool, error) {
+	switch {
+	case field == "?":
+		return func(time.Time) bool { return true }, nil
+	case field == "L":
+		return func(d time.Time) bool { return d.Day() == daysIn(d) }, nil
+	case field == "LW":
+		return func(d time.Time) bool { return d.Day() == nearestWeekday(d, daysIn(d)) }, nil
+	case strings.HasSuffix(field, "W"):
+		n, err := strconv.Atoi(strings.TrimSuffix(field, "W"))
+		if err != nil || n < 1 || n > 31 {
+			return nil, fmt.Errorf("invalid day of month %q", field)
+		}
+		return func(d time.Time) bool { return n <= daysIn(d) && d.Day() == nearestWeekday(d, n) }, nil
+	}
+	set, err := parseSet(field, 1, 31, nil)
+	if err != nil {
+		return nil, err
+	}
+	return func(d time.Time) bool { return set[d.Day()] }, nil
+}
+
+// parseDayOfWeek parses the day-of-week field, including "6L" (last
+// Friday of the month) and "2#1" (first Monday of the month).
+func parseDayOfWeek(field string) (func(time.Time) bool, error) {
+	weekday := func(s string) (int, error) {
+		if n, ok := dayNames[strings.ToUpper(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 || n > 7 {
+			return 0, fmt.Errorf("invalid day of week %q", s)
+		}
+		return n, nil
+	}
+
+	switch {
+	case field == "?":
+		return func(time.Time) bool { return true }, nil
+	case strings.Contains(field, "#"):
+		day, nth, _ := strings.Cut(field, "#")
+		n, err := weekday(day)
+		if err != nil {
+			return nil, err
+		}
+		k, err := strconv.Atoi(nth)
+		if err != nil || k < 1 || k > 5 {
+			return nil, fmt.Errorf("invalid occurrence %q", nth)
+		}
+		return func(d time.Time) bool { return int(d.Weekday())+1 == n && (d.Day()-1)/7+1 == k }, nil
+	case len(field) > 1 && strings.HasSuffix(field, "L"):
+		n, err := weekday(strings.TrimSuffix(field, "L"))
+		if err != nil {
+			return nil, err
+		}
+		return func(d time.Time) bool { return int(d.Weekday())+1 == n && d.Day()+7 > daysIn(d) }, nil
+	}
+	set, err := parseSet(field, 1, 7, dayNames)
+	if err != nil {
+		return nil, err
+	}
+	return func(d time.Time) bool { return set[int(d.Weekday())+1] }, nil
+}
+
+// next returns the first time after t matching the expression, in t's zone.
+func (c *cronSpec) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	for i := 0; i < cronHorizon; i++ {
+		d := day.AddDate(0, 0, i)
+		if d.Year() >= len(c.years) {
+			break
+		}
+		if !c.years[d.Year()] || !c.months[d.Month()] || !c.dom(d) || !c.dow(d) {
+			continue
+		}
+		for h := range c.hours {
+			if !c.hours[h] {
+				continue
+			}
+			for m := range c.minutes {
+				if !c.minutes[m] {
+					continue
+				}
+				if run := time.Date(d.Year(), d.Month(), d.Day(), h, m, 0, 0, loc); run.After(t) {
+					return run, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// daysIn returns the number of days in the month of d.
+func daysIn(d time.Time) int {
+	return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday of d's month nearest to its nth day,
+// without leaving the month.
+func nearestWeekday(d time.Time, n int) int {
+	switch time.Date(d.Year(), d.Month(), n, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return 3
+		}
+		return n - 1
+	case time.Sunday:
+		if n == daysIn(d) {
+			return n - 2
+		}
+		return n + 1
+	}
+	return n
+}
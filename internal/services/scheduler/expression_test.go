@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+// FuzzParseExpression checks that no schedule expression typed in a form
+// panics the parser, and that parsed expressions find their next run.
+func FuzzParseExpression(f *testing.F) {
+	for _, seed := range []string{
+		"at(2025-03-14T09:30:00)",
+		"rate(5 minutes)",
+		"rate(1 day)",
+		"cron(0 9 ? * MON-FRI *)",
+		"cron(*/15 * * * ? *)",
+		"cron(0 18 L * ? *)",
+		"cron(0 8 15W * ? *)",
+		"cron(0 10 ? * 6L *)",
+		"cron(0 10 ? * 2#1 2025-2030)",
+		"cron(1/9223372036854775807 * * * ? *)",
+		"rate(9223372036854775807 days)",
+		"cron(",
+		")",
+		"",
+	} {
+		f.Add(seed)
+	}
+
+	after := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	anchor := after.Add(-36 * time.Hour)
+	f.Fuzz(func(t *testing.T, expr string) {
+		e, err := ParseExpression(expr)
+		if err != nil {
+			return
+		}
+		next, ok := e.Next(after, anchor, time.UTC)
+		if ok && !next.After(after) {
+			t.Fatalf("%q: next run %v is not after %v", expr, next, after)
+		}
+	})
+}
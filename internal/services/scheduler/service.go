@@ -0,0 +1,467 @@
+// Package scheduler provides Amazon EventBridge Scheduler integration for
+// the a9s application. It lists one-off, rate and cron schedules with their
+// next run, pauses and resumes them, and runs their target on demand.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+const (
+	// runDelay is how far ahead the one-off schedule behind "run now" is
+	// set, leaving Scheduler time to pick it up.
+	runDelay = time.Minute
+
+	// maxNameLength is the longest schedule name Scheduler accepts.
+	maxNameLength = 64
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements EventBridge Scheduler operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient SchedulerAPI
+}
+
+// SchedulerAPI defines the EventBridge Scheduler client interface for mocking.
+type SchedulerAPI interface {
+	ListSchedules(ctx context.Context, params *scheduler.ListSchedulesInput, optFns ...func(*scheduler.Options)) (*scheduler.ListSchedulesOutput, error)
+	GetSchedule(ctx context.Context, params *scheduler.GetScheduleInput, optFns ...func(*scheduler.Options)) (*scheduler.GetScheduleOutput, error)
+	UpdateSchedule(ctx context.Context, params *scheduler.UpdateScheduleInput, optFns ...func(*scheduler.Options)) (*scheduler.UpdateScheduleOutput, error)
+	CreateSchedule(ctx context.Context, params *scheduler.CreateScheduleInput, optFns ...func(*scheduler.Options)) (*scheduler.CreateScheduleOutput, error)
+}
+
+// NewService creates a new EventBridge Scheduler service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SchedulerAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the Scheduler client for the current AWS context.
+func (s *Service) client() SchedulerAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return scheduler.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "scheduler"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "EventBridge Schedules"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "clock"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListSchedules(ctx, &scheduler.ListSchedulesInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("scheduler", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the schedules of every group with their next run.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	client := s.client()
+	now := time.Now()
+
+	var resources []core.Resource
+	paginator := scheduler.NewListSchedulesPaginator(client, &scheduler.ListSchedulesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("scheduler", "list", err)
+		}
+		for _, summary := range page.Schedules {
+			schedule, err := client.GetSchedule(ctx, &scheduler.GetScheduleInput{
+				Name:      summary.Name,
+				GroupName: summary.GroupName,
+			})
+			if err != nil {
+				// The schedule may have been deleted since it was listed
+				continue
+			}
+			resources = append(resources, scheduleToResource(schedule, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "scheduler:schedule",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for schedules.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "pause",
+			Description: "Disable the schedule",
+			Icon:        "pause",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "resume",
+			Description: "Enable the schedule",
+			Icon:        "play",
+			Shortcut:    "e",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "run_now",
+			Description: "Invoke the schedule's target once, within about a minute",
+			Icon:        "zap",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a schedule, identified as
+// "group/name". Running a schedule now asks for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "pause":
+		result, err = s.setState(ctx, resourceID, types.ScheduleStateDisabled)
+	case "resume":
+		result, err = s.setState(ctx, resourceID, types.ScheduleStateEnabled)
+	case "run_now":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.runNow(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) getSchedule(ctx context.Context, id string) (*scheduler.GetScheduleOutput, error) {
+	group, name, ok := strings.Cut(id, "/")
+	if !ok {
+		return nil, core.NewValidationError("schedule", id, "must be of the form group/name")
+	}
+	return s.client().GetSchedule(ctx, &scheduler.GetScheduleInput{
+		Name:      aws.String(name),
+		GroupName: aws.String(group),
+	})
+}
+
+// setState enables or disables a schedule. UpdateSchedule replaces the
+// whole definition, so the current one is sent back with the new state.
+func (s *Service) setState(ctx context.Context, id string, state types.ScheduleState) (*core.ActionResult, error) {
+	action := "pause"
+	if state == types.ScheduleStateEnabled {
+		action = "resume"
+	}
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError(action, id, err)
+	}
+
+	schedule, err := s.getSchedule(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	if schedule.State == state {
+		return fail(core.NewValidationError("schedule", id, "is already "+strings.ToLower(string(state))))
+	}
+
+	_, err = s.client().UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+		Name:                       schedule.Name,
+		GroupName:                  schedule.GroupName,
+		ScheduleExpression:         schedule.ScheduleExpression,
+		ScheduleExpressionTimezone: schedule.ScheduleExpressionTimezone,
+		StartDate:                  schedule.StartDate,
+		EndDate:                    schedule.EndDate,
+		Description:                schedule.Description,
+		FlexibleTimeWindow:         schedule.FlexibleTimeWindow,
+		Target:                     schedule.Target,
+		KmsKeyArn:                  schedule.KmsKeyArn,
+		ActionAfterCompletion:      schedule.ActionAfterCompletion,
+		State:                      state,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	verb := "Paused"
+	if state == types.ScheduleStateEnabled {
+		verb = "Resumed"
+	}
+	return core.NewActionResult(true, fmt.Sprintf("%s %s", verb, aws.ToString(schedule.Name))), nil
+}
+
+// runNow invokes a schedule's target once. Scheduler has no API for this,
+// so a one-off schedule with the same target, role and input is created to
+// fire shortly and delete itself afterwards.
+func (s *Service) runNow(ctx context.Context, id string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("run_now", id, err)
+	}
+
+	schedule, err := s.getSchedule(ctx, id)
+	if err != nil {
+		return fail(err)
+	}
+	if schedule.Target == nil {
+		return fail(core.NewValidationError("schedule", id, "has no target"))
+	}
+	target := targetName(aws.ToString(schedule.Target.Arn))
+
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "run_now", id, params, fmt.Sprintf("Invokes %s with the input of %s within about a minute", target, aws.ToString(schedule.Name)), false)
+	}
+
+	now := time.Now().UTC()
+	suffix := "-run-" + now.Format("20060102150405")
+	name := aws.ToString(schedule.Name)
+	if len(name)+len(suffix) > maxNameLength {
+		name = name[:maxNameLength-len(suffix)]
+	}
+	name += suffix
+
+	_, err = s.client().CreateSchedule(ctx, &scheduler.CreateScheduleInput{
+		Name:                       aws.String(name),
+		GroupName:                  schedule.GroupName,
+		ScheduleExpression:         aws.String("at(" + now.Add(runDelay).Format("2006-01-02T15:04:05") + ")"),
+		ScheduleExpressionTimezone: aws.String("UTC"),
+		Description:                aws.String(fmt.Sprintf("One-off run of %s started from a9s", aws.ToString(schedule.Name))),
+		FlexibleTimeWindow:         &types.FlexibleTimeWindow{Mode: types.FlexibleTimeWindowModeOff},
+		Target:                     schedule.Target,
+		KmsKeyArn:                  schedule.KmsKeyArn,
+		ActionAfterCompletion:      types.ActionAfterCompletionDelete,
+		State:                      types.ScheduleStateEnabled,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Invoking %s within about a minute through schedule %s", target, name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func scheduleToResource(schedule *scheduler.GetScheduleOutput, now time.Time) core.Resource {
+	group, name := aws.ToString(schedule.GroupName), aws.ToString(schedule.Name)
+	expression := aws.ToString(schedule.ScheduleExpression)
+	zone := aws.ToString(schedule.ScheduleExpressionTimezone)
+
+	resource := core.Resource{
+		ID:        group + "/" + name,
+		Type:      "scheduler:schedule",
+		Name:      name,
+		ARN:       aws.ToString(schedule.Arn),
+		State:     strings.ToLower(string(schedule.State)),
+		Tags:      make(map[string]string),
+		CreatedAt: schedule.CreationDate,
+		UpdatedAt: schedule.LastModificationDate,
+		Metadata: map[string]any{
+			"group":       group,
+			"expression":  expression,
+			"timezone":    zone,
+			"description": aws.ToString(schedule.Description),
+			"after_run":   string(schedule.ActionAfterCompletion),
+		},
+	}
+	if schedule.Target != nil {
+		resource.Metadata["target_arn"] = aws.ToString(schedule.Target.Arn)
+		resource.Metadata["target"] = targetName(aws.ToString(schedule.Target.Arn))
+		resource.Metadata["role_arn"] = aws.ToString(schedule.Target.RoleArn)
+		if schedule.Target.DeadLetterConfig != nil {
+			resource.Metadata["dlq_arn"] = aws.ToString(schedule.Target.DeadLetterConfig.Arn)
+		}
+	}
+	if window := schedule.FlexibleTimeWindow; window != nil && window.Mode == types.FlexibleTimeWindowModeFlexible {
+		resource.Metadata["window"] = time.Duration(aws.ToInt32(window.MaximumWindowInMinutes)) * time.Minute
+	}
+	if schedule.StartDate != nil {
+		resource.Metadata["start"] = *schedule.StartDate
+	}
+	if schedule.EndDate != nil {
+		resource.Metadata["end"] = *schedule.EndDate
+	}
+
+	expr, err := ParseExpression(expression)
+	if err != nil {
+		resource.AddIssue(core.SeverityLow, err.Error())
+	} else {
+		resource.Metadata["kind"] = expr.Kind
+		if next, ok := nextRun(expr, schedule, now); ok {
+			resource.Metadata["next_run"] = next
+		} else if schedule.State == types.ScheduleStateEnabled {
+			resource.AddIssue(core.SeverityLow, "Schedule is enabled but will not run again")
+		}
+	}
+
+	if resource.CreatedAt != nil {
+		estimate.ApplyAge(&resource, now)
+	}
+	return resource
+}
+
+// nextRun returns the next run of an enabled schedule within its start and
+// end dates.
+func nextRun(expr Expression, schedule *scheduler.GetScheduleOutput, now time.Time) (time.Time, bool) {
+	if schedule.State != types.ScheduleStateEnabled {
+		return time.Time{}, false
+	}
+	loc := time.UTC
+	if zone := aws.ToString(schedule.ScheduleExpressionTimezone); zone != "" {
+		if l, err := time.LoadLocation(zone); err == nil {
+			loc = l
+		}
+	}
+
+	after := now
+	anchor := aws.ToTime(schedule.CreationDate)
+	if schedule.StartDate != nil {
+		anchor = *schedule.StartDate
+		if anchor.After(after) {
+			after = anchor.Add(-time.Second)
+		}
+	}
+
+	next, ok := expr.Next(after, anchor, loc)
+	if !ok || (schedule.EndDate != nil && next.After(*schedule.EndDate)) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// targetName describes a target ARN as service and resource name, such as
+// "lambda:my-function", or the API a universal target calls, such as
+// "aws-sdk:sqs:sendMessage".
+func targetName(arn string) string {
+	parsed, err := core.ParseARN(arn)
+	if err != nil {
+		return arn
+	}
+	if parsed.Service == "scheduler" {
+		return parsed.Resource
+	}
+	return parsed.Service + ":" + parsed.ResourceID()
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "scheduler", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "scheduler", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,203 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// longName is a schedule name too long to take the suffix of a run.
+const longName = "reconcile-customer-invoices-against-the-ledger-nightly"
+
+// fakeScheduler serves four schedules: default/nightly, a cron invoking a
+// Lambda function at 03:00 UTC; default/launch, a one-off that already ran
+// but is still enabled; ops/drain, a disabled rate sending SQS messages;
+// and default/<longName>. Schedules deleted since they were listed are not
+// found. It fails every call when err is set and records the updates and
+// created schedules.
+type fakeScheduler struct {
+	err     error
+	updates []*scheduler.UpdateScheduleInput
+	created []*scheduler.CreateScheduleInput
+}
+
+func (f *fakeScheduler) schedules() []*scheduler.GetScheduleOutput {
+	schedule := func(group, name, expression string, state types.ScheduleState, target string) *scheduler.GetScheduleOutput {
+		return &scheduler.GetScheduleOutput{
+			Arn:                aws.String("arn:aws:scheduler:us-east-1:123456789012:schedule/" + group + "/" + name),
+			GroupName:          aws.String(group),
+			Name:               aws.String(name),
+			ScheduleExpression: aws.String(expression),
+			State:              state,
+			CreationDate:       aws.Time(time.Now().Add(-48 * time.Hour)),
+			FlexibleTimeWindow: &types.FlexibleTimeWindow{Mode: types.FlexibleTimeWindowModeOff},
+			Target: &types.Target{
+				Arn:     aws.String(target),
+				RoleArn: aws.String("arn:aws:iam::123456789012:role/scheduler"),
+				Input:   aws.String(`{"source":"a9s"}`),
+			},
+		}
+	}
+	return []*scheduler.GetScheduleOutput{
+		schedule("default", "nightly", "cron(0 3 * * ? *)", types.ScheduleStateEnabled, "arn:aws:lambda:us-east-1:123456789012:function:report"),
+		schedule("default", "launch", "at(2024-01-01T09:00:00)", types.ScheduleStateEnabled, "arn:aws:lambda:us-east-1:123456789012:function:announce"),
+		schedule("ops", "drain", "rate(5 minutes)", types.ScheduleStateDisabled, "arn:aws:scheduler:::aws-sdk:sqs:sendMessage"),
+		schedule("default", longName, "rate(1 day)", types.ScheduleStateEnabled, "arn:aws:lambda:us-east-1:123456789012:function:reconcile"),
+	}
+}
+
+func (f *fakeScheduler) ListSchedules(context.Context, *scheduler.ListSchedulesInput, ...func(*scheduler.Options)) (*scheduler.ListSchedulesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := &scheduler.ListSchedulesOutput{Schedules: []types.ScheduleSummary{
+		{GroupName: aws.String("default"), Name: aws.String("deleted-since")},
+	}}
+	for _, schedule := range f.schedules() {
+		out.Schedules = append(out.Schedules, types.ScheduleSummary{GroupName: schedule.GroupName, Name: schedule.Name})
+	}
+	return out, nil
+}
+
+func (f *fakeScheduler) GetSchedule(_ context.Context, in *scheduler.GetScheduleInput, _ ...func(*scheduler.Options)) (*scheduler.GetScheduleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, schedule := range f.schedules() {
+		if aws.ToString(schedule.GroupName) == aws.ToString(in.GroupName) && aws.ToString(schedule.Name) == aws.ToString(in.Name) {
+			return schedule, nil
+		}
+	}
+	return nil, &types.ResourceNotFoundException{Message: aws.String("Schedule " + aws.ToString(in.Name) + " does not exist.")}
+}
+
+func (f *fakeScheduler) UpdateSchedule(_ context.Context, in *scheduler.UpdateScheduleInput, _ ...func(*scheduler.Options)) (*scheduler.UpdateScheduleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.updates = append(f.updates, in)
+	return &scheduler.UpdateScheduleOutput{}, nil
+}
+
+func (f *fakeScheduler) CreateSchedule(_ context.Context, in *scheduler.CreateScheduleInput, _ ...func(*scheduler.Options)) (*scheduler.CreateScheduleOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.created = append(f.created, in)
+	return &scheduler.CreateScheduleOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against schedules.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeScheduler{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeScheduler{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    "default/nightly",
+		Action:        "pause",
+		ConfirmAction: "run_now",
+	})
+}
+
+func TestListComputesNextRun(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeScheduler{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 4 {
+		t.Fatalf("List() = %d schedules, want 4 without the deleted one", len(resources))
+	}
+	byID := make(map[string]core.Resource)
+	for _, r := range resources {
+		byID[r.ID] = r
+	}
+
+	nightly := byID["default/nightly"]
+	next, _ := nightly.Metadata["next_run"].(time.Time)
+	if next.Hour() != 3 || next.Minute() != 0 || !next.After(time.Now()) || time.Until(next) > 24*time.Hour {
+		t.Errorf("nightly next run = %v, want the coming 03:00 UTC", next)
+	}
+	if nightly.Metadata["target"] != "lambda:report" || nightly.Metadata["kind"] != KindCron {
+		t.Errorf("nightly targets %v as %v", nightly.Metadata["target"], nightly.Metadata["kind"])
+	}
+
+	launch := byID["default/launch"]
+	if issues := launch.Issues(); len(issues) != 1 || issues[0].Message != "Schedule is enabled but will not run again" {
+		t.Errorf("launch issues = %v", issues)
+	}
+
+	drain := byID["ops/drain"]
+	if _, ok := drain.Metadata["next_run"]; ok || drain.State != "disabled" || len(drain.Issues()) != 0 {
+		t.Errorf("drain = %s with next run %v and issues %v", drain.State, drain.Metadata["next_run"], drain.Issues())
+	}
+	if drain.Metadata["target"] != "aws-sdk:sqs:sendMessage" {
+		t.Errorf("drain target = %v, want the universal target's API", drain.Metadata["target"])
+	}
+}
+
+// TestSetStateSendsDefinitionBack checks that pausing keeps the rest of the
+// schedule, since UpdateSchedule replaces it whole.
+func TestSetStateSendsDefinitionBack(t *testing.T) {
+	fake := &fakeScheduler{}
+	svc := NewServiceWithClient(fake, nil)
+
+	result, err := svc.Execute(context.Background(), "pause", "default/nightly", nil)
+	if err != nil {
+		t.Fatalf("pause error = %v", err)
+	}
+	if result.Message != "Paused nightly" {
+		t.Errorf("pause = %q", result.Message)
+	}
+	update := fake.updates[0]
+	if update.State != types.ScheduleStateDisabled || aws.ToString(update.ScheduleExpression) != "cron(0 3 * * ? *)" || aws.ToString(update.Target.Input) != `{"source":"a9s"}` {
+		t.Errorf("update = %+v", update)
+	}
+
+	for id, want := range map[string]string{
+		"default/nightly": "is already enabled",
+		"nightly":         "must be of the form group/name",
+	} {
+		if _, err := svc.Execute(context.Background(), "resume", id, nil); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("resume %s error = %v, want %q", id, err, want)
+		}
+	}
+	if len(fake.updates) != 1 {
+		t.Errorf("%d updates, want the pause only", len(fake.updates))
+	}
+}
+
+func TestRunNowCreatesOneOffSchedule(t *testing.T) {
+	fake := &fakeScheduler{}
+	svc := NewServiceWithClient(fake, nil)
+
+	result, err := svc.Execute(context.Background(), "run_now", "default/"+longName, map[string]any{core.ParamConfirm: true})
+	if err != nil {
+		t.Fatalf("run_now error = %v", err)
+	}
+	created := fake.created[0]
+	name := aws.ToString(created.Name)
+	if len(name) != maxNameLength || !strings.HasPrefix(name, longName[:40]) || !strings.Contains(name, "-run-") {
+		t.Errorf("one-off schedule name = %q", name)
+	}
+	if !strings.HasPrefix(aws.ToString(created.ScheduleExpression), "at(") || created.ActionAfterCompletion != types.ActionAfterCompletionDelete {
+		t.Errorf("one-off schedule runs %s, then %s", aws.ToString(created.ScheduleExpression), created.ActionAfterCompletion)
+	}
+	if aws.ToString(created.Target.Arn) != "arn:aws:lambda:us-east-1:123456789012:function:reconcile" {
+		t.Errorf("one-off schedule target = %s", aws.ToString(created.Target.Arn))
+	}
+	if !strings.HasPrefix(result.Message, "Invoking lambda:reconcile within about a minute") {
+		t.Errorf("run_now = %q", result.Message)
+	}
+}
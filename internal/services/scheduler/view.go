@@ -0,0 +1,323 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for EventBridge schedules.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new EventBridge Scheduler view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("Group"), MinWidth: 8, MaxWidth: 30, Weight: 0.6, Priority: 3},
+		{Title: i18n.T("Schedule"), MinWidth: 12, MaxWidth: 40, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("Next Run"), MinWidth: 12, MaxWidth: 24, Weight: 0.6, Priority: 0},
+		{Title: i18n.T("Target"), MinWidth: 12, MaxWidth: 50, Weight: 1.2, Priority: 1},
+		{Title: i18n.T("State"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Schedules", "", "scheduler", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadSchedules()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Pausing %s...", row.Name)
+				return v, v.executeAction("pause", row.ID)
+			}
+		case "e":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Resuming %s...", row.Name)
+				return v, v.executeAction("resume", row.ID)
+			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.executeAction("run_now", row.ID)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Schedule %s", row.Name), formatSchedule(row))
+			}
+		}
+
+	case schedulesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d schedules", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if msg.Action != "run_now" {
+				cmds = append(cmds, v.loadSchedules())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading schedules...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[p]ause  [e]nable  e[x]ecute now  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the schedules.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadSchedules()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type schedulesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadSchedules() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return schedulesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return schedulesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return schedulesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, nil)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(r.GetMetadataString("group")),
+		base.TextCell(r.GetMetadataString("expression")),
+		nextRunCell(r),
+		base.TextCell(r.GetMetadataString("target")),
+		base.TextCell(r.State),
+		base.SeverityCell(r),
+		base.AgeCell(r),
+	}
+}
+
+// nextRunCell shows when a schedule runs next, sorted by time.
+func nextRunCell(r core.Resource) base.Cell {
+	next, ok := r.Metadata["next_run"].(time.Time)
+	if !ok {
+		return base.LazyCell(nil, func() string { return "-" })
+	}
+	return base.LazyCell(next, func() string { return formatNextRun(next) })
+}
+
+// formatNextRun renders a run time with how long until it, such as
+// "03-14 09:00 (in 5h)".
+func formatNextRun(next time.Time) string {
+	return i18n.T("%s (in %s)", next.Local().Format("01-02 15:04"), estimate.FormatAge(time.Until(next)))
+}
+
+// formatSchedule renders a schedule's timing and target for the detail
+// panel.
+func formatSchedule(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:        %s\n", r.ARN)
+	if desc := r.GetMetadataString("description"); desc != "" {
+		fmt.Fprintf(&b, "Description: %s\n", desc)
+	}
+	fmt.Fprintf(&b, "Schedule:   %s", r.GetMetadataString("expression"))
+	if zone := r.GetMetadataString("timezone"); zone != "" {
+		fmt.Fprintf(&b, " (%s)", zone)
+	}
+	b.WriteString("\n")
+	if window, ok := r.Metadata["window"].(time.Duration); ok {
+		fmt.Fprintf(&b, "Window:     flexible, up to %s\n", window)
+	}
+	if start, ok := r.Metadata["start"].(time.Time); ok {
+		fmt.Fprintf(&b, "Starts:     %s\n", start.Local().Format("2006-01-02 15:04"))
+	}
+	if end, ok := r.Metadata["end"].(time.Time); ok {
+		fmt.Fprintf(&b, "Ends:       %s\n", end.Local().Format("2006-01-02 15:04"))
+	}
+	if next, ok := r.Metadata["next_run"].(time.Time); ok {
+		fmt.Fprintf(&b, "Next run:   %s\n", formatNextRun(next))
+	}
+	if after := r.GetMetadataString("after_run"); after == "DELETE" {
+		b.WriteString("Deleted after its run\n")
+	}
+	fmt.Fprintf(&b, "State:      %s\n", r.State)
+
+	fmt.Fprintf(&b, "\nTarget:     %s\n", r.GetMetadataString("target_arn"))
+	fmt.Fprintf(&b, "Role:       %s\n", r.GetMetadataString("role_arn"))
+	if dlq := r.GetMetadataString("dlq_arn"); dlq != "" {
+		fmt.Fprintf(&b, "Dead-letter queue: %s\n", dlq)
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	disabled, soon := 0, 0
+	for _, r := range v.Resources {
+		if r.State == "disabled" {
+			disabled++
+		}
+		if next, ok := r.Metadata["next_run"].(time.Time); ok && time.Until(next) < time.Hour {
+			soon++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "next-hour", Text: i18n.T("Next hour: %d", soon), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "paused", Text: i18n.T("Paused: %d", disabled), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("EventBridge Schedules"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "scheduler" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
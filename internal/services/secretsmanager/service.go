@@ -0,0 +1,480 @@
+// Package secretsmanager provides Secrets Manager integration for the a9s
+// application. It lists secrets with their rotation and access dates, never
+// their values, rotates them and reveals a value only once confirmed.
+package secretsmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// DefaultUnusedAge is how long a secret must go unread to be flagged as a
+// cleanup candidate.
+const DefaultUnusedAge = 90 * 24 * time.Hour
+
+// pricePerSecret is the monthly price of a secret, as in us-east-1.
+const pricePerSecret = 0.40
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements Secrets Manager operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient SecretsManagerAPI
+
+	unusedAge time.Duration
+}
+
+// Option configures the Secrets Manager service.
+type Option func(*Service)
+
+// WithUnusedAge sets how many days a secret must go unread to be flagged as
+// a cleanup candidate. Non-positive values keep the default.
+func WithUnusedAge(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.unusedAge = time.Duration(days) * 24 * time.Hour
+		}
+	}
+}
+
+// SecretsManagerAPI defines the Secrets Manager client interface for mocking.
+type SecretsManagerAPI interface {
+	ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+	ListSecretVersionIds(ctx context.Context, params *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error)
+	GetResourcePolicy(ctx context.Context, params *secretsmanager.GetResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error)
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	RotateSecret(ctx context.Context, params *secretsmanager.RotateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.RotateSecretOutput, error)
+}
+
+// NewService creates a new Secrets Manager service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+		unusedAge:  DefaultUnusedAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SecretsManagerAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+		unusedAge:  DefaultUnusedAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the Secrets Manager client for the current AWS context.
+func (s *Service) client() SecretsManagerAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return secretsmanager.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region secrets are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "secretsmanager"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Secrets Manager"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "key"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListSecrets(ctx, &secretsmanager.ListSecretsInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("secretsmanager", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the secrets of the region with their rotation and access
+// dates. Secrets scheduled for deletion are included.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	now := time.Now()
+	resources := make([]core.Resource, 0)
+	paginator := secretsmanager.NewListSecretsPaginator(s.client(), &secretsmanager.ListSecretsInput{
+		IncludePlannedDeletion: aws.Bool(true),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("secretsmanager", "list", err)
+		}
+		for _, secret := range page.SecretList {
+			resources = append(resources, s.secretToResource(secret, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "secretsmanager:secret",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// DetailFetcher Interface Implementation
+// =============================================================================
+
+// FetchDetail returns a secret's versions with their staging labels and its
+// resource policy. Values are never fetched.
+func (s *Service) FetchDetail(ctx context.Context, resource *core.Resource) ([]core.DetailSection, error) {
+	id := aws.String(resource.ARN)
+
+	var versions strings.Builder
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(s.client(), &secretsmanager.ListSecretVersionIdsInput{SecretId: id})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, core.NewServiceError("secretsmanager", "detail", err)
+		}
+		for _, version := range page.Versions {
+			fmt.Fprintf(&versions, "%s  %s  %s\n", aws.ToString(version.VersionId),
+				aws.ToTime(version.CreatedDate).Local().Format("2006-01-02 15:04"), strings.Join(version.VersionStages, ", "))
+		}
+	}
+	sections := []core.DetailSection{{Title: "Versions", Body: versions.String()}}
+
+	policy, err := s.client().GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{SecretId: id})
+	if err != nil {
+		return sections, core.NewServiceError("secretsmanager", "detail", err)
+	}
+	body := "None"
+	if document := aws.ToString(policy.ResourcePolicy); document != "" {
+		body = indentJSON(document)
+	}
+	sections = append(sections, core.DetailSection{Title: "Resource policy", Body: body})
+
+	return sections, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for secrets.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "rotate",
+			Description: "Rotate the secret now",
+			Icon:        "refresh",
+			Shortcut:    "t",
+			Dangerous:   true,
+			Category:    "security",
+		},
+		{
+			Name:        "reveal",
+			Description: "Reveal the current value of the secret",
+			Icon:        "eye",
+			Shortcut:    "v",
+			Dangerous:   true,
+			Category:    "security",
+		},
+	}
+}
+
+// Execute runs the specified action on a secret, identified by its name.
+// Rotating and revealing ask for confirmation through a
+// core.ConfirmationError until the "confirm" parameter is set. Revealed
+// values are left out of the events hooks receive.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "rotate":
+		if !confirmed {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Rotation replaces the current value; clients caching it fail until they read it again", false)
+		}
+		result, err = s.rotate(ctx, resourceID)
+	case "reveal":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Shows the secret value on screen", true)
+		}
+		result, err = s.reveal(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	logged := *result
+	if action == "reveal" {
+		logged.Data = nil
+	}
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     &logged,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// SecretValue is the result data of the reveal action.
+type SecretValue struct {
+	Name      string
+	VersionID string
+	Stages    []string
+	Created   time.Time
+	Value     string // The string value, or the binary value in base64
+	Binary    bool
+}
+
+// rotate starts a rotation with the secret's rotation function.
+func (s *Service) rotate(ctx context.Context, name string) (*core.ActionResult, error) {
+	out, err := s.client().RotateSecret(ctx, &secretsmanager.RotateSecretInput{SecretId: aws.String(name)})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("rotate", name, err)
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Rotation of %s started, version %s",
+		aws.ToString(out.Name), aws.ToString(out.VersionId))), nil
+}
+
+// reveal reads the current value of a secret.
+func (s *Service) reveal(ctx context.Context, name string) (*core.ActionResult, error) {
+	out, err := s.client().GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(name)})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("reveal", name, err)
+	}
+
+	value := SecretValue{
+		Name:      aws.ToString(out.Name),
+		VersionID: aws.ToString(out.VersionId),
+		Stages:    out.VersionStages,
+		Created:   aws.ToTime(out.CreatedDate),
+		Value:     aws.ToString(out.SecretString),
+	}
+	if out.SecretString == nil {
+		value.Value = base64.StdEncoding.EncodeToString(out.SecretBinary)
+		value.Binary = true
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Value of %s revealed", value.Name))
+	result.Data = value
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) secretToResource(secret types.SecretListEntry, now time.Time) core.Resource {
+	name := aws.ToString(secret.Name)
+	rotation := aws.ToBool(secret.RotationEnabled)
+	state := core.StateActive
+	if secret.DeletedDate != nil {
+		state = "pending deletion"
+	}
+
+	resource := core.Resource{
+		ID:        name,
+		Type:      "secretsmanager:secret",
+		Name:      name,
+		ARN:       aws.ToString(secret.ARN),
+		State:     state,
+		Region:    s.region(),
+		CreatedAt: secret.CreatedDate,
+		UpdatedAt: secret.LastChangedDate,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"description":      aws.ToString(secret.Description),
+			"kms_key":          aws.ToString(secret.KmsKeyId),
+			"rotation_enabled": rotation,
+			"rotation_lambda":  aws.ToString(secret.RotationLambdaARN),
+			"owning_service":   aws.ToString(secret.OwningService),
+		},
+	}
+	if secret.LastRotatedDate != nil {
+		resource.Metadata["last_rotated"] = *secret.LastRotatedDate
+	}
+	if secret.NextRotationDate != nil {
+		resource.Metadata["next_rotation"] = *secret.NextRotationDate
+	}
+	if secret.LastAccessedDate != nil {
+		resource.Metadata["last_accessed"] = *secret.LastAccessedDate
+	}
+	if rules := secret.RotationRules; rules != nil {
+		if days := aws.ToInt64(rules.AutomaticallyAfterDays); days > 0 {
+			resource.Metadata["rotation_days"] = days
+		}
+		if expr := aws.ToString(rules.ScheduleExpression); expr != "" {
+			resource.Metadata["rotation_schedule"] = expr
+		}
+	}
+	if secret.DeletedDate != nil {
+		resource.Metadata["deleted_date"] = *secret.DeletedDate
+	}
+
+	for _, tag := range secret.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+	estimate.ApplyCost(&resource, pricePerSecret)
+
+	shouldCleanup, cleanupReason := s.shouldCleanup(secret, now)
+	resource.Metadata["should_cleanup"] = shouldCleanup
+	resource.Metadata["cleanup_reason"] = cleanupReason
+
+	switch {
+	case secret.DeletedDate != nil:
+		resource.AddIssue(core.SeverityInfo, "Scheduled for deletion")
+	case !rotation:
+		resource.AddIssue(core.SeverityLow, "Rotation disabled")
+	case secret.NextRotationDate != nil && secret.NextRotationDate.Before(now):
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Rotation overdue since %s", secret.NextRotationDate.Local().Format("2006-01-02")))
+	}
+	if shouldCleanup {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+cleanupReason)
+	}
+
+	return resource
+}
+
+// shouldCleanup flags secrets unread for longer than the unused age.
+// Secrets Manager records the day of the last read, and secrets never read
+// are only known to be as old as their creation.
+func (s *Service) shouldCleanup(secret types.SecretListEntry, now time.Time) (bool, string) {
+	if secret.DeletedDate != nil {
+		return false, ""
+	}
+	days := int(s.unusedAge.Hours() / 24)
+	if secret.LastAccessedDate == nil {
+		if created := secret.CreatedDate; created != nil && now.Sub(*created) > s.unusedAge {
+			return true, fmt.Sprintf("Never read in %d+ days", days)
+		}
+		return false, ""
+	}
+	if now.Sub(*secret.LastAccessedDate) > s.unusedAge {
+		return true, fmt.Sprintf("Not read in %d+ days", days)
+	}
+	return false, ""
+}
+
+// indentJSON indents a JSON document, or returns it as is when invalid.
+func indentJSON(document string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(document), "", "  "); err != nil {
+		return document
+	}
+	return out.String()
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "secretsmanager", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "secretsmanager", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.DetailFetcher  = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
@@ -0,0 +1,166 @@
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const dbARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf"
+
+// fakeSecrets serves the rotated prod/db secret, with two versions and no
+// resource policy, and the binary legacy/cert secret, or fails every call
+// when err is set.
+type fakeSecrets struct {
+	err error
+}
+
+func (f *fakeSecrets) ListSecrets(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	now := time.Now()
+	return &secretsmanager.ListSecretsOutput{SecretList: []types.SecretListEntry{
+		{
+			Name:             aws.String("prod/db"),
+			ARN:              aws.String(dbARN),
+			RotationEnabled:  aws.Bool(true),
+			RotationRules:    &types.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+			NextRotationDate: aws.Time(now.Add(72 * time.Hour)),
+			LastAccessedDate: aws.Time(now.Add(-24 * time.Hour)),
+			CreatedDate:      aws.Time(now.Add(-400 * 24 * time.Hour)),
+		},
+		{
+			Name:        aws.String("legacy/cert"),
+			ARN:         aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:legacy/cert-GhIjKl"),
+			CreatedDate: aws.Time(now.Add(-30 * 24 * time.Hour)),
+		},
+	}}, nil
+}
+
+func (f *fakeSecrets) ListSecretVersionIds(context.Context, *secretsmanager.ListSecretVersionIdsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	created := time.Date(2024, 6, 1, 12, 0, 0, 0, time.Local)
+	return &secretsmanager.ListSecretVersionIdsOutput{Versions: []types.SecretVersionsListEntry{
+		{VersionId: aws.String("v2"), CreatedDate: aws.Time(created), VersionStages: []string{"AWSCURRENT"}},
+		{VersionId: aws.String("v1"), CreatedDate: aws.Time(created.AddDate(0, -1, 0)), VersionStages: []string{"AWSPREVIOUS"}},
+	}}, nil
+}
+
+func (f *fakeSecrets) GetResourcePolicy(context.Context, *secretsmanager.GetResourcePolicyInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &secretsmanager.GetResourcePolicyOutput{}, nil
+}
+
+func (f *fakeSecrets) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := &secretsmanager.GetSecretValueOutput{Name: in.SecretId, VersionId: aws.String("v2")}
+	if aws.ToString(in.SecretId) == "legacy/cert" {
+		out.SecretBinary = []byte{0x30, 0x82}
+	} else {
+		out.SecretString = aws.String(`{"password":"hunter2"}`)
+	}
+	return out, nil
+}
+
+func (f *fakeSecrets) RotateSecret(_ context.Context, in *secretsmanager.RotateSecretInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.RotateSecretOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &secretsmanager.RotateSecretOutput{Name: in.SecretId, VersionId: aws.String("v3")}, nil
+}
+
+// TestServiceConformance runs the core service contract against secrets.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSecrets{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSecrets{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    "prod/db",
+		Action:        "rotate",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "reveal",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestShouldCleanup(t *testing.T) {
+	now := time.Now()
+	days := func(n int) *time.Time { return aws.Time(now.Add(-time.Duration(n) * 24 * time.Hour)) }
+
+	tests := []struct {
+		name   string
+		secret types.SecretListEntry
+		want   string
+	}{
+		{"read recently", types.SecretListEntry{CreatedDate: days(400), LastAccessedDate: days(2)}, ""},
+		{"unread", types.SecretListEntry{CreatedDate: days(400), LastAccessedDate: days(120)}, "Not read in 90+ days"},
+		{"never read", types.SecretListEntry{CreatedDate: days(120)}, "Never read in 90+ days"},
+		{"new", types.SecretListEntry{CreatedDate: days(10)}, ""},
+		{"scheduled for deletion", types.SecretListEntry{CreatedDate: days(400), DeletedDate: days(1)}, ""},
+	}
+	svc := NewServiceWithClient(&fakeSecrets{}, nil)
+	for _, tt := range tests {
+		cleanup, reason := svc.shouldCleanup(tt.secret, now)
+		if cleanup != (tt.want != "") || reason != tt.want {
+			t.Errorf("%s: shouldCleanup() = %v, %q, want %q", tt.name, cleanup, reason, tt.want)
+		}
+	}
+
+	// A longer unused age keeps the unread secret
+	svc = NewServiceWithClient(&fakeSecrets{}, nil, WithUnusedAge(180))
+	if cleanup, _ := svc.shouldCleanup(tests[1].secret, now); cleanup {
+		t.Error("secret unread for 120 days flagged with a 180 day unused age")
+	}
+}
+
+func TestFetchDetailListsVersions(t *testing.T) {
+	svc := NewServiceWithClient(&fakeSecrets{}, nil)
+	resource := core.Resource{ID: "prod/db", ARN: dbARN}
+
+	sections, err := svc.FetchDetail(context.Background(), &resource)
+	if err != nil {
+		t.Fatalf("FetchDetail() error = %v", err)
+	}
+	if len(sections) != 2 {
+		t.Fatalf("sections = %+v, want versions and policy", sections)
+	}
+	versions := strings.Split(strings.TrimSpace(sections[0].Body), "\n")
+	if len(versions) != 2 || versions[0] != "v2  2024-06-01 12:00  AWSCURRENT" {
+		t.Errorf("versions = %q", versions)
+	}
+	if sections[1].Body != "None" {
+		t.Errorf("resource policy = %q, want None", sections[1].Body)
+	}
+}
+
+func TestRevealEncodesBinarySecrets(t *testing.T) {
+	svc := NewServiceWithClient(&fakeSecrets{}, nil)
+
+	params := map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "legacy/cert"}
+	result, err := svc.Execute(context.Background(), "reveal", "legacy/cert", params)
+	if err != nil {
+		t.Fatalf("reveal error = %v", err)
+	}
+	if value := result.Data.(SecretValue); !value.Binary || value.Value != "MII=" {
+		t.Errorf("revealed %+v, want the binary value in base64", value)
+	}
+}
@@ -0,0 +1,364 @@
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Secrets Manager secrets.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new Secrets Manager view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Rotation"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Last Rotated"), MinWidth: 12, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Next Rotation"), MinWidth: 13, MaxWidth: 13, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Last Accessed"), MinWidth: 13, MaxWidth: 13, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Cleanup"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Secrets", "", "secretsmanager", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadSecrets()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Rotating %s...", row.Name)
+				return v, v.executeAction("rotate", row.ID, nil)
+			}
+		case "v":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.executeAction("reveal", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.OpenLazyDetail(i18n.T("Secret %s", row.Name), formatDetail(row), row)
+			}
+		}
+
+	case secretsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d secrets", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if value, ok := msg.Result.Data.(SecretValue); ok {
+				v.OpenDetail(i18n.T("Value of %s", value.Name), formatValue(value))
+			}
+			if msg.Action == "rotate" {
+				cmds = append(cmds, v.loadSecrets())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading secrets...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[t] rotate  [v]alue  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the secrets.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadSecrets()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type secretsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadSecrets() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return secretsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return secretsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return secretsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	shouldCleanup, _ := r.Metadata["should_cleanup"].(bool)
+
+	cleanupIcon := "🟢 " + i18n.T("No")
+	if shouldCleanup {
+		cleanupIcon = "🟡 " + i18n.T("Yes")
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(formatRotation(&r)),
+		dateCell(r, "last_rotated"),
+		dateCell(r, "next_rotation"),
+		dateCell(r, "last_accessed"),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.TextCell(cleanupIcon),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// dateCell returns the day of a time kept in metadata, sorted by time.
+func dateCell(r core.Resource, key string) base.Cell {
+	t, ok := r.Metadata[key].(time.Time)
+	if !ok {
+		return base.LazyCell(nil, func() string { return "-" })
+	}
+	return base.LazyCell(t, func() string { return t.Local().Format("2006-01-02") })
+}
+
+// formatRotation describes a secret's rotation schedule.
+func formatRotation(r *core.Resource) string {
+	if enabled, _ := r.Metadata["rotation_enabled"].(bool); !enabled {
+		return i18n.T("Off")
+	}
+	if days, ok := r.Metadata["rotation_days"].(int64); ok {
+		return i18n.T("Every %dd", days)
+	}
+	return i18n.T("On")
+}
+
+// formatDetail renders a secret's rotation, access and encryption for the
+// detail panel, before its versions and resource policy are fetched.
+func formatDetail(r *core.Resource) string {
+	date := func(key string) string {
+		if t, ok := r.Metadata[key].(time.Time); ok {
+			return t.Local().Format("2006-01-02 15:04")
+		}
+		return "-"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:           %s\n", r.ARN)
+	if description := r.GetMetadataString("description"); description != "" {
+		fmt.Fprintf(&b, "Description:   %s\n", description)
+	}
+	fmt.Fprintf(&b, "State:         %s\n", r.State)
+	if deleted, ok := r.Metadata["deleted_date"].(time.Time); ok {
+		fmt.Fprintf(&b, "Deleted on:    %s\n", deleted.Local().Format("2006-01-02"))
+	}
+	kms := r.GetMetadataString("kms_key")
+	if kms == "" {
+		kms = "aws/secretsmanager"
+	}
+	fmt.Fprintf(&b, "KMS key:       %s\n", kms)
+	if owner := r.GetMetadataString("owning_service"); owner != "" {
+		fmt.Fprintf(&b, "Managed by:    %s\n", owner)
+	}
+
+	fmt.Fprintf(&b, "\nRotation:      %s\n", formatRotation(r))
+	if schedule := r.GetMetadataString("rotation_schedule"); schedule != "" {
+		fmt.Fprintf(&b, "Schedule:      %s\n", schedule)
+	}
+	if lambda := r.GetMetadataString("rotation_lambda"); lambda != "" {
+		fmt.Fprintf(&b, "Function:      %s\n", lambda)
+	}
+	fmt.Fprintf(&b, "Last rotated:  %s\n", date("last_rotated"))
+	fmt.Fprintf(&b, "Next rotation: %s\n", date("next_rotation"))
+	fmt.Fprintf(&b, "Last accessed: %s\n", date("last_accessed"))
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:       %s (%s)\n", r.CreatedAt.Format("2006-01-02"), r.GetMetadataString(estimate.AgeKey))
+	}
+	if r.UpdatedAt != nil {
+		fmt.Fprintf(&b, "Changed:       %s\n", r.UpdatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatValue renders a revealed value for the detail panel.
+func formatValue(value SecretValue) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Version: %s (%s)\n", value.VersionID, strings.Join(value.Stages, ", "))
+	if !value.Created.IsZero() {
+		fmt.Fprintf(&b, "Created: %s\n", value.Created.Local().Format("2006-01-02 15:04"))
+	}
+	if value.Binary {
+		b.WriteString(i18n.T("Binary value, in base64:\n"))
+	}
+	b.WriteString("\n" + value.Value + "\n")
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	unrotated, overdue, cleanup := 0, 0, 0
+	for _, r := range v.Resources {
+		if enabled, _ := r.Metadata["rotation_enabled"].(bool); !enabled {
+			unrotated++
+		}
+		if next, ok := r.Metadata["next_rotation"].(time.Time); ok && next.Before(time.Now()) {
+			overdue++
+		}
+		if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+			cleanup++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "not-rotated", Text: i18n.T("Not rotated: %d", unrotated), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "overdue", Text: i18n.T("Overdue: %d", overdue), Tone: core.ToneError},
+		core.SummaryWidget{Name: "unread", Text: i18n.T("Unread: %d", cleanup), Tone: core.ToneInfo},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Secrets Manager"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "secretsmanager" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
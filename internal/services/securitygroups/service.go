@@ -0,0 +1,464 @@
+// Package securitygroups provides a security group audit for the a9s
+// application. It lists the security groups of the region with their rules
+// and flags rules opening sensitive ports to the whole internet.
+package securitygroups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// adminPorts are remote administration ports that should never be open to
+// the internet.
+var adminPorts = []int32{22, 3389}
+
+// highRiskPorts are the other ports AWS Security Hub considers high risk
+// when open to the internet: file sharing, mail, remote management,
+// databases, caches and search engines.
+var highRiskPorts = []int32{
+	20, 21, 23, 25, 110, 135, 143, 445, 1433, 1434, 1521, 3000, 3306, 4333,
+	5000, 5432, 5500, 5601, 5985, 5986, 6379, 8080, 8088, 8888, 9200, 9300,
+	11211, 27017,
+}
+
+// webPorts are expected to be open on web-facing resources.
+var webPorts = []int32{80, 443}
+
+// Rule is an ingress or egress rule of a security group, one per protocol
+// and port range.
+type Rule struct {
+	Protocol    string   // "tcp", "udp", "icmp" or "all"
+	Ports       string   // "22", "8000-8080" or "all"
+	Peers       []string // CIDRs, security groups and prefix lists
+	Description string
+	Open        bool // Allows 0.0.0.0/0 or ::/0
+
+	from, to int32
+}
+
+// covers reports whether the rule includes a TCP port.
+func (r Rule) covers(port int32) bool {
+	return r.Protocol == "all" || (r.Protocol == "tcp" && r.from <= port && port <= r.to)
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements security group operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
+}
+
+// NewService creates a new security group service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// region returns the region security groups are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "securitygroups"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Security Groups"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "shield"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("securitygroups", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the security groups of the region with their rules, flagging
+// those open to the internet.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(s.client(), &ec2.DescribeSecurityGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("securitygroups", "list", err)
+		}
+		for _, group := range page.SecurityGroups {
+			resources = append(resources, s.groupToResource(group))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:securitygroup",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// CheckCompliance implements compliance.Checker. Checks are run when
+// listing, so the recorded ones are returned.
+func (s *Service) CheckCompliance(_ context.Context, resource *core.Resource) ([]compliance.Check, error) {
+	return compliance.Failed(*resource), nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for security groups.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "view_rules",
+			Description: "View the ingress and egress rules of the security group",
+			Icon:        "list",
+			Shortcut:    "v",
+			Dangerous:   false,
+			Category:    "info",
+		},
+	}
+}
+
+// Execute runs the specified action on a security group.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "view_rules":
+		result, err = s.viewRules(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// GroupRules holds the rules of a security group, as returned by the
+// view_rules action.
+type GroupRules struct {
+	Group   string
+	Name    string
+	Ingress []Rule
+	Egress  []Rule
+}
+
+// viewRules describes a security group again so the rules shown are
+// current, and returns them as GroupRules.
+func (s *Service) viewRules(ctx context.Context, groupID string) (*core.ActionResult, error) {
+	out, err := s.client().DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: []string{groupID},
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_rules", groupID, err)
+	}
+	if len(out.SecurityGroups) == 0 {
+		err := fmt.Errorf("security group %s not found", groupID)
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_rules", groupID, err)
+	}
+
+	group := out.SecurityGroups[0]
+	rules := GroupRules{
+		Group:   groupID,
+		Name:    aws.ToString(group.GroupName),
+		Ingress: rulesOf(group.IpPermissions),
+		Egress:  rulesOf(group.IpPermissionsEgress),
+	}
+	result := core.NewActionResult(true, fmt.Sprintf("%s: %d ingress, %d egress rules", rules.Name, len(rules.Ingress), len(rules.Egress)))
+	result.Data = rules
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) groupToResource(group types.SecurityGroup) core.Resource {
+	resource := core.Resource{
+		ID:     aws.ToString(group.GroupId),
+		Name:   aws.ToString(group.GroupName),
+		Type:   "ec2:securitygroup",
+		Region: s.region(),
+		State:  core.StateAvailable,
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"vpc_id":      aws.ToString(group.VpcId),
+			"description": aws.ToString(group.Description),
+			"owner_id":    aws.ToString(group.OwnerId),
+		},
+	}
+	if resource.Region != "" {
+		resource.ARN = fmt.Sprintf("arn:aws:ec2:%s:%s:security-group/%s", resource.Region, aws.ToString(group.OwnerId), resource.ID)
+	}
+	for _, tag := range group.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	iac.Apply(&resource)
+
+	ingress := rulesOf(group.IpPermissions)
+	egress := rulesOf(group.IpPermissionsEgress)
+	resource.Metadata["ingress"] = ingress
+	resource.Metadata["egress"] = egress
+	resource.Metadata["default"] = resource.Name == "default"
+
+	var open []string
+	for _, rule := range ingress {
+		if rule.Open {
+			open = append(open, rule.Protocol+"/"+rule.Ports)
+		}
+	}
+	resource.Metadata["open_ports"] = open
+
+	failed := assess(&resource, ingress, egress)
+	compliance.Apply(&resource, failed)
+	return resource
+}
+
+// assess records the issues of a security group and returns the checks it
+// fails.
+func assess(resource *core.Resource, ingress, egress []Rule) []compliance.Check {
+	var failed []compliance.Check
+	var all, admin, highRisk, other, web []string
+	for _, rule := range ingress {
+		if !rule.Open {
+			continue
+		}
+		label := rule.Protocol + "/" + rule.Ports
+		switch {
+		case rule.Protocol == "all":
+			all = append(all, label)
+		case exposes(rule, adminPorts):
+			admin = append(admin, label)
+		case exposes(rule, highRiskPorts):
+			highRisk = append(highRisk, label)
+		case exposesOnly(rule, webPorts):
+			web = append(web, label)
+		default:
+			other = append(other, label)
+		}
+	}
+
+	if len(all) > 0 {
+		resource.AddIssue(core.SeverityCritical, "All traffic open to the internet")
+		failed = append(failed, compliance.CheckSGAdminPorts, compliance.CheckSGHighRiskPorts)
+	}
+	if len(admin) > 0 {
+		resource.AddIssue(core.SeverityHigh, fmt.Sprintf("Admin ports open to the internet: %s", strings.Join(admin, ", ")))
+		if !slices.Contains(failed, compliance.CheckSGAdminPorts) {
+			failed = append(failed, compliance.CheckSGAdminPorts)
+		}
+	}
+	if len(highRisk) > 0 {
+		resource.AddIssue(core.SeverityHigh, fmt.Sprintf("High-risk ports open to the internet: %s", strings.Join(highRisk, ", ")))
+		if !slices.Contains(failed, compliance.CheckSGHighRiskPorts) {
+			failed = append(failed, compliance.CheckSGHighRiskPorts)
+		}
+	}
+	if len(other) > 0 {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Ports open to the internet: %s", strings.Join(other, ", ")))
+	}
+	if len(web) > 0 {
+		resource.AddIssue(core.SeverityInfo, "Web ports open to the internet")
+	}
+
+	if isDefault, _ := resource.Metadata["default"].(bool); isDefault && len(ingress)+len(egress) > 0 {
+		resource.AddIssue(core.SeverityLow, "Default security group allows traffic; use dedicated groups instead")
+		failed = append(failed, compliance.CheckSGDefaultOpen)
+	}
+	return failed
+}
+
+// rulesOf converts the permissions of a security group into rules.
+func rulesOf(perms []types.IpPermission) []Rule {
+	rules := make([]Rule, 0, len(perms))
+	for _, perm := range perms {
+		rule := Rule{
+			Protocol: aws.ToString(perm.IpProtocol),
+			from:     aws.ToInt32(perm.FromPort),
+			to:       aws.ToInt32(perm.ToPort),
+		}
+		switch {
+		case rule.Protocol == "-1":
+			rule.Protocol, rule.Ports = "all", "all"
+		case rule.Protocol == "icmp" || rule.Protocol == "icmpv6" || (rule.from == 0 && rule.to == 65535) || (rule.from == -1 && rule.to == -1):
+			rule.Ports = "all"
+		case rule.from == rule.to:
+			rule.Ports = fmt.Sprintf("%d", rule.from)
+		default:
+			rule.Ports = fmt.Sprintf("%d-%d", rule.from, rule.to)
+		}
+
+		for _, r := range perm.IpRanges {
+			cidr := aws.ToString(r.CidrIp)
+			rule.Open = rule.Open || cidr == "0.0.0.0/0"
+			rule.Peers = append(rule.Peers, cidr)
+			rule.Description = firstNonEmpty(rule.Description, aws.ToString(r.Description))
+		}
+		for _, r := range perm.Ipv6Ranges {
+			cidr := aws.ToString(r.CidrIpv6)
+			rule.Open = rule.Open || cidr == "::/0"
+			rule.Peers = append(rule.Peers, cidr)
+			rule.Description = firstNonEmpty(rule.Description, aws.ToString(r.Description))
+		}
+		for _, pair := range perm.UserIdGroupPairs {
+			rule.Peers = append(rule.Peers, aws.ToString(pair.GroupId))
+			rule.Description = firstNonEmpty(rule.Description, aws.ToString(pair.Description))
+		}
+		for _, pl := range perm.PrefixListIds {
+			rule.Peers = append(rule.Peers, aws.ToString(pl.PrefixListId))
+			rule.Description = firstNonEmpty(rule.Description, aws.ToString(pl.Description))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// exposes reports whether a rule opens any of the given TCP ports.
+func exposes(rule Rule, ports []int32) bool {
+	return slices.ContainsFunc(ports, rule.covers)
+}
+
+// exposesOnly reports whether a TCP rule opens nothing but the given ports.
+func exposesOnly(rule Rule, ports []int32) bool {
+	return rule.Protocol == "tcp" && rule.from == rule.to && slices.Contains(ports, rule.from)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "securitygroups", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "securitygroups", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ compliance.Checker  = (*Service)(nil)
+)
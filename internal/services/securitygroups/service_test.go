@@ -0,0 +1,171 @@
+package securitygroups
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves the web group, opening HTTPS to the internet and SSH to
+// the VPC, and the default group, letting its members talk to each other.
+// It fails every call when err is set.
+type fakeEC2 struct {
+	err error
+}
+
+func (f *fakeEC2) DescribeSecurityGroups(_ context.Context, in *ec2.DescribeSecurityGroupsInput, _ ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	groups := []types.SecurityGroup{
+		{
+			GroupId:   aws.String("sg-web"),
+			GroupName: aws.String("web"),
+			VpcId:     aws.String("vpc-1"),
+			IpPermissions: []types.IpPermission{
+				tcp(443, 443, "0.0.0.0/0"),
+				tcp(22, 22, "10.0.0.0/8"),
+			},
+			IpPermissionsEgress: []types.IpPermission{
+				{IpProtocol: aws.String("-1"), IpRanges: []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+			},
+		},
+		{
+			GroupId:   aws.String("sg-default"),
+			GroupName: aws.String("default"),
+			VpcId:     aws.String("vpc-1"),
+			IpPermissions: []types.IpPermission{
+				{IpProtocol: aws.String("-1"), UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-default")}}},
+			},
+		},
+	}
+	if len(in.GroupIds) > 0 {
+		groups = slices.DeleteFunc(groups, func(g types.SecurityGroup) bool {
+			return !slices.Contains(in.GroupIds, aws.ToString(g.GroupId))
+		})
+	}
+	return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: groups}, nil
+}
+
+// tcp builds a permission opening a TCP port range to a CIDR, IPv6 ones
+// included.
+func tcp(from, to int32, cidr string) types.IpPermission {
+	perm := types.IpPermission{IpProtocol: aws.String("tcp"), FromPort: aws.Int32(from), ToPort: aws.Int32(to)}
+	if cidr == "::/0" {
+		perm.Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: aws.String(cidr)}}
+	} else {
+		perm.IpRanges = []types.IpRange{{CidrIp: aws.String(cidr)}}
+	}
+	return perm
+}
+
+// TestServiceConformance runs the core service contract against security
+// groups.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID: "sg-web",
+		Action:     "view_rules",
+	})
+}
+
+func TestAssess(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    string
+		perms    []types.IpPermission
+		severity core.Severity
+		issue    string
+		failed   []compliance.Check
+	}{
+		{
+			name:     "web only",
+			perms:    []types.IpPermission{tcp(80, 80, "0.0.0.0/0"), tcp(443, 443, "::/0")},
+			severity: core.SeverityInfo,
+			issue:    "Web ports open to the internet",
+		},
+		{
+			name:     "ssh",
+			perms:    []types.IpPermission{tcp(22, 22, "0.0.0.0/0")},
+			severity: core.SeverityHigh,
+			issue:    "Admin ports open to the internet: tcp/22",
+			failed:   []compliance.Check{compliance.CheckSGAdminPorts},
+		},
+		{
+			name:     "range over a database port",
+			perms:    []types.IpPermission{tcp(5000, 5500, "::/0")},
+			severity: core.SeverityHigh,
+			issue:    "High-risk ports open to the internet: tcp/5000-5500",
+			failed:   []compliance.Check{compliance.CheckSGHighRiskPorts},
+		},
+		{
+			name:     "other port",
+			perms:    []types.IpPermission{tcp(8443, 8443, "0.0.0.0/0")},
+			severity: core.SeverityMedium,
+			issue:    "Ports open to the internet: tcp/8443",
+		},
+		{
+			name:     "all traffic",
+			perms:    []types.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+			severity: core.SeverityCritical,
+			issue:    "All traffic open to the internet",
+			failed:   []compliance.Check{compliance.CheckSGAdminPorts, compliance.CheckSGHighRiskPorts},
+		},
+		{
+			name:     "default with a private rule",
+			group:    "default",
+			perms:    []types.IpPermission{tcp(5432, 5432, "10.0.0.0/16")},
+			severity: core.SeverityLow,
+			issue:    "Default security group allows traffic; use dedicated groups instead",
+			failed:   []compliance.Check{compliance.CheckSGDefaultOpen},
+		},
+	}
+
+	svc := NewServiceWithClient(&fakeEC2{}, nil)
+	for _, tt := range tests {
+		group := types.SecurityGroup{GroupId: aws.String("sg-1"), GroupName: aws.String(tt.group), IpPermissions: tt.perms}
+		resource := svc.groupToResource(group)
+
+		issues := resource.Issues()
+		if len(issues) != 1 || issues[0].Severity != tt.severity || issues[0].Message != tt.issue {
+			t.Errorf("%s: issues = %v, want %q", tt.name, issues, tt.issue)
+		}
+		if failed := compliance.Failed(resource); !slices.Equal(failed, tt.failed) {
+			t.Errorf("%s: failed checks = %v, want %v", tt.name, failed, tt.failed)
+		}
+	}
+}
+
+func TestRulesOfFormatsPorts(t *testing.T) {
+	rules := rulesOf([]types.IpPermission{
+		tcp(8000, 8080, "10.0.0.0/8"),
+		tcp(0, 65535, "10.0.0.0/8"),
+		{IpProtocol: aws.String("icmp"), FromPort: aws.Int32(8), ToPort: aws.Int32(0)},
+		{IpProtocol: aws.String("udp"), FromPort: aws.Int32(53), ToPort: aws.Int32(53),
+			PrefixListIds: []types.PrefixListId{{PrefixListId: aws.String("pl-1"), Description: aws.String("resolvers")}}},
+	})
+
+	want := []string{"tcp/8000-8080", "tcp/all", "icmp/all", "udp/53"}
+	for i, rule := range rules {
+		if got := rule.Protocol + "/" + rule.Ports; got != want[i] {
+			t.Errorf("rule %d = %s, want %s", i, got, want[i])
+		}
+	}
+	if rules[3].Peers[0] != "pl-1" || rules[3].Description != "resolvers" {
+		t.Errorf("prefix list rule = %+v", rules[3])
+	}
+}
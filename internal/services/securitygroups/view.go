@@ -0,0 +1,309 @@
+package securitygroups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for security groups.
+type View struct {
+	*base.TableView
+}
+
+// NewView creates a new security group view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 20, MaxWidth: 22, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("VPC"), MinWidth: 12, MaxWidth: 22, Weight: 0.5, Priority: 3},
+		{Title: i18n.T("Ingress"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Egress"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Open To Internet"), MinWidth: 16, MaxWidth: 40, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("Risk"), MinWidth: 8, MaxWidth: 14, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Risk Reason"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 2},
+		{Title: i18n.T("Controls"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Security Groups", "", "securitygroups", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadGroups()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "v", "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Loading rules of %s...", row.Name)
+				return v, v.executeAction("view_rules", row.ID)
+			}
+		}
+
+	case groupsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d security groups", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if rules, ok := msg.Result.Data.(GroupRules); ok {
+				v.OpenDetail(i18n.T("Rules of %s", rules.Name), v.formatRules(rules))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading security groups...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[v]iew rules  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the security groups.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadGroups()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type groupsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadGroups() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return groupsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return groupsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return groupsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, nil)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	ingress, _ := r.Metadata["ingress"].([]Rule)
+	egress, _ := r.Metadata["egress"].([]Rule)
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.TextCell(r.GetMetadataString("vpc_id")),
+		base.LazyCell(len(ingress), func() string { return fmt.Sprintf("%d", len(ingress)) }),
+		base.LazyCell(len(egress), func() string { return fmt.Sprintf("%d", len(egress)) }),
+		base.TextCell(formatOpen(r)),
+		base.SeverityCell(r),
+		base.TextCell(base.TruncateString(base.FormatIssues(r), 50)),
+		base.TextCell(base.FormatControls(r)),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// formatOpen lists the rules of a group open to the internet.
+func formatOpen(r core.Resource) string {
+	open, _ := r.Metadata["open_ports"].([]string)
+	if len(open) == 0 {
+		return "-"
+	}
+	return strings.Join(open, ", ")
+}
+
+// formatRules renders the ingress and egress rules of a group for the detail
+// panel, with the issues found when listing it.
+func (v *View) formatRules(rules GroupRules) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s)\n", rules.Name, rules.Group)
+	for _, section := range []struct {
+		title   string
+		peer    string
+		rules   []Rule
+		inbound bool
+	}{
+		{i18n.T("Ingress"), "SOURCE", rules.Ingress, true},
+		{i18n.T("Egress"), "DESTINATION", rules.Egress, false},
+	} {
+		fmt.Fprintf(&b, "\n%s:\n", section.title)
+		if len(section.rules) == 0 {
+			b.WriteString(i18n.T("  No rules.\n"))
+			continue
+		}
+		fmt.Fprintf(&b, "  %-2s %-6s %-12s %-30s %s\n", "", "PROTO", "PORTS", section.peer, "DESCRIPTION")
+		for _, rule := range section.rules {
+			// Egress open to the internet is the default and not flagged
+			marker := ""
+			if rule.Open && section.inbound {
+				marker = "⚠"
+			}
+			peers := "-"
+			if len(rule.Peers) > 0 {
+				peers = strings.Join(rule.Peers, ", ")
+			}
+			fmt.Fprintf(&b, "  %-2s %-6s %-12s %-30s %s\n", marker, rule.Protocol, rule.Ports, base.TruncateString(peers, 30), rule.Description)
+		}
+	}
+
+	for _, r := range v.Resources {
+		if r.ID != rules.Group || len(r.Issues()) == 0 {
+			continue
+		}
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range r.Issues() {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	open, severe := 0, 0
+	for _, r := range v.Resources {
+		if ports, _ := r.Metadata["open_ports"].([]string); len(ports) > 0 {
+			open++
+		}
+		if r.Severity().Rank() >= core.SeverityHigh.Rank() {
+			severe++
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "open", Text: i18n.T("Open to internet: %d", open), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "severe", Text: i18n.T("High or critical: %d", severe), Tone: core.ToneError},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Security Groups"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "securitygroups" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
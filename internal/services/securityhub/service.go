@@ -0,0 +1,464 @@
+// Package securityhub provides AWS Security Hub integration for the a9s application.
+// It lists active findings, links them to the affected resources and updates
+// their workflow status.
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// maxFindings bounds a listing; findings are requested most severe first so
+// the ones left out are the least severe.
+const maxFindings = 1000
+
+// noteAuthor is recorded as the author of notes added with workflow updates.
+const noteAuthor = "a9s"
+
+// resourceViews maps Security Hub resource types to the service whose view
+// lists them.
+var resourceViews = map[string]string{
+	"AwsEc2Instance":    "ec2",
+	"AwsS3Bucket":       "s3",
+	"AwsIamRole":        "iam",
+	"AwsLambdaFunction": "lambda",
+	"AwsRdsDbInstance":  "rds",
+}
+
+// workflowActions maps action names to the workflow status they set.
+var workflowActions = map[string]types.WorkflowStatus{
+	"notify":   types.WorkflowStatusNotified,
+	"resolve":  types.WorkflowStatusResolved,
+	"suppress": types.WorkflowStatusSuppressed,
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements Security Hub operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient SecurityHubAPI
+
+	// Product ARNs of listed findings, which workflow updates require
+	mu       sync.Mutex
+	products map[string]string
+}
+
+// SecurityHubAPI defines the Security Hub client interface for mocking.
+type SecurityHubAPI interface {
+	DescribeHub(ctx context.Context, params *securityhub.DescribeHubInput, optFns ...func(*securityhub.Options)) (*securityhub.DescribeHubOutput, error)
+	GetFindings(ctx context.Context, params *securityhub.GetFindingsInput, optFns ...func(*securityhub.Options)) (*securityhub.GetFindingsOutput, error)
+	BatchUpdateFindings(ctx context.Context, params *securityhub.BatchUpdateFindingsInput, optFns ...func(*securityhub.Options)) (*securityhub.BatchUpdateFindingsOutput, error)
+}
+
+// NewService creates a new Security Hub service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SecurityHubAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the Security Hub client for the current AWS context.
+func (s *Service) client() SecurityHubAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return securityhub.NewFromConfig(s.factory.Config())
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "securityhub"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Security Hub Findings"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "shield"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies Security Hub is enabled and reachable.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	if _, err := s.client().DescribeHub(ctx, &securityhub.DescribeHubInput{}); err != nil {
+		return core.NewServiceError("securityhub", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns active findings, most severe first. Resolved and suppressed
+// findings are left out unless a "workflow_status" filter asks for them.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	findings, err := s.getFindings(ctx, findingFilters(opts.Filters["workflow_status"]))
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("securityhub", "list", err)
+	}
+
+	now := time.Now()
+	products := make(map[string]string, len(findings))
+	resources := make([]core.Resource, 0, len(findings))
+	for _, finding := range findings {
+		products[aws.ToString(finding.Id)] = aws.ToString(finding.ProductArn)
+		resources = append(resources, findingToResource(finding, now))
+	}
+
+	s.mu.Lock()
+	s.products = products
+	s.mu.Unlock()
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "securityhub:finding",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// findingFilters selects active findings in the given workflow status, or
+// in any status but RESOLVED and SUPPRESSED.
+func findingFilters(status string) *types.AwsSecurityFindingFilters {
+	filters := &types.AwsSecurityFindingFilters{
+		RecordState: []types.StringFilter{
+			{Value: aws.String(string(types.RecordStateActive)), Comparison: types.StringFilterComparisonEquals},
+		},
+	}
+	if status != "" {
+		filters.WorkflowStatus = []types.StringFilter{
+			{Value: aws.String(strings.ToUpper(status)), Comparison: types.StringFilterComparisonEquals},
+		}
+		return filters
+	}
+	filters.WorkflowStatus = []types.StringFilter{
+		{Value: aws.String(string(types.WorkflowStatusResolved)), Comparison: types.StringFilterComparisonNotEquals},
+		{Value: aws.String(string(types.WorkflowStatusSuppressed)), Comparison: types.StringFilterComparisonNotEquals},
+	}
+	return filters
+}
+
+func (s *Service) getFindings(ctx context.Context, filters *types.AwsSecurityFindingFilters) ([]types.AwsSecurityFinding, error) {
+	input := &securityhub.GetFindingsInput{
+		Filters: filters,
+		SortCriteria: []types.SortCriterion{
+			{Field: aws.String("SeverityNormalized"), SortOrder: types.SortOrderDescending},
+		},
+		MaxResults: aws.Int32(100),
+	}
+
+	var findings []types.AwsSecurityFinding
+	paginator := securityhub.NewGetFindingsPaginator(s.client(), input)
+	for paginator.HasMorePages() && len(findings) < maxFindings {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, page.Findings...)
+	}
+
+	if len(findings) > maxFindings {
+		findings = findings[:maxFindings]
+	}
+	return findings, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for Security Hub.
+func (s *Service) Actions() []core.Action {
+	note := []core.ActionParameter{
+		{Name: "note", Type: "string", Description: "Note recorded on the finding (optional)"},
+	}
+	return []core.Action{
+		{
+			Name:        "notify",
+			Description: "Mark the finding as notified to its owner",
+			Icon:        "bell",
+			Shortcut:    "N",
+			Dangerous:   false,
+			Category:    "security",
+			Parameters:  note,
+		},
+		{
+			Name:        "resolve",
+			Description: "Mark the finding as resolved",
+			Icon:        "check",
+			Shortcut:    "x",
+			Dangerous:   false,
+			Category:    "security",
+			Parameters:  note,
+		},
+		{
+			Name:        "suppress",
+			Description: "Suppress the finding as reviewed and accepted",
+			Icon:        "mute",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "security",
+			Parameters:  note,
+		},
+	}
+}
+
+// Execute runs the specified action on a finding.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	status, ok := workflowActions[action]
+	if !ok {
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	note, _ := params["note"].(string)
+	result, err := s.setWorkflowStatus(ctx, action, resourceID, status, strings.TrimSpace(note))
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) setWorkflowStatus(ctx context.Context, action, findingID string, status types.WorkflowStatus, note string) (*core.ActionResult, error) {
+	productArn, err := s.productOf(ctx, findingID)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError(action, findingID, err)
+	}
+
+	input := &securityhub.BatchUpdateFindingsInput{
+		FindingIdentifiers: []types.AwsSecurityFindingIdentifier{
+			{Id: aws.String(findingID), ProductArn: aws.String(productArn)},
+		},
+		Workflow: &types.WorkflowUpdate{Status: status},
+	}
+	if note != "" {
+		input.Note = &types.NoteUpdate{Text: aws.String(note), UpdatedBy: aws.String(noteAuthor)}
+	}
+
+	out, err := s.client().BatchUpdateFindings(ctx, input)
+	if err == nil && len(out.UnprocessedFindings) > 0 {
+		unprocessed := out.UnprocessedFindings[0]
+		err = fmt.Errorf("%s: %s", aws.ToString(unprocessed.ErrorCode), aws.ToString(unprocessed.ErrorMessage))
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError(action, findingID, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Finding %s set to %s", findingID, status)), nil
+}
+
+// productOf returns the product ARN of a finding, from the last listing or
+// looked up when the finding was not listed.
+func (s *Service) productOf(ctx context.Context, findingID string) (string, error) {
+	s.mu.Lock()
+	productArn, ok := s.products[findingID]
+	s.mu.Unlock()
+	if ok && productArn != "" {
+		return productArn, nil
+	}
+
+	out, err := s.client().GetFindings(ctx, &securityhub.GetFindingsInput{
+		Filters: &types.AwsSecurityFindingFilters{
+			Id: []types.StringFilter{
+				{Value: aws.String(findingID), Comparison: types.StringFilterComparisonEquals},
+			},
+		},
+		MaxResults: aws.Int32(1),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Findings) == 0 {
+		return "", fmt.Errorf("%w: finding %s", core.ErrResourceNotFound, findingID)
+	}
+	return aws.ToString(out.Findings[0].ProductArn), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func findingToResource(finding types.AwsSecurityFinding, now time.Time) core.Resource {
+	id := aws.ToString(finding.Id)
+	status := types.WorkflowStatusNew
+	if finding.Workflow != nil && finding.Workflow.Status != "" {
+		status = finding.Workflow.Status
+	}
+
+	resource := core.Resource{
+		ID:    id,
+		Type:  "securityhub:finding",
+		Name:  aws.ToString(finding.Title),
+		State: strings.ToLower(string(status)),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"title":           aws.ToString(finding.Title),
+			"description":     aws.ToString(finding.Description),
+			"product":         aws.ToString(finding.ProductName),
+			"product_arn":     aws.ToString(finding.ProductArn),
+			"generator":       aws.ToString(finding.GeneratorId),
+			"account":         aws.ToString(finding.AwsAccountId),
+			"workflow_status": string(status),
+		},
+	}
+
+	if finding.Compliance != nil && finding.Compliance.Status != "" {
+		resource.Metadata["compliance"] = string(finding.Compliance.Status)
+	}
+	if finding.Remediation != nil && finding.Remediation.Recommendation != nil {
+		resource.Metadata["remediation"] = aws.ToString(finding.Remediation.Recommendation.Text)
+		resource.Metadata["remediation_url"] = aws.ToString(finding.Remediation.Recommendation.Url)
+	}
+
+	// The first resource is the primary one; others are context
+	if len(finding.Resources) > 0 {
+		affected := finding.Resources[0]
+		resourceType := aws.ToString(affected.Type)
+		resourceID := aws.ToString(affected.Id)
+		resource.ARN = resourceID
+		resource.Metadata["resource_type"] = resourceType
+		resource.Metadata["resource_id"] = resourceID
+		resource.Metadata["resource_region"] = aws.ToString(affected.Region)
+		if service, ok := resourceViews[resourceType]; ok {
+			resource.Metadata["target_service"] = service
+			resource.Metadata["target_id"] = targetID(resourceType, resourceID)
+		}
+	}
+
+	if finding.Severity != nil {
+		resource.AddIssue(severityOf(finding.Severity.Label), aws.ToString(finding.Title))
+	}
+
+	if created, err := time.Parse(time.RFC3339, aws.ToString(finding.CreatedAt)); err == nil {
+		resource.CreatedAt = &created
+	}
+	if updated, err := time.Parse(time.RFC3339, aws.ToString(finding.UpdatedAt)); err == nil {
+		resource.UpdatedAt = &updated
+		resource.Metadata["updated"] = updated.Format("2006-01-02")
+	}
+	estimate.ApplyAge(&resource, now)
+
+	return resource
+}
+
+// severityOf maps a Security Hub severity label to a core severity.
+func severityOf(label types.SeverityLabel) core.Severity {
+	switch label {
+	case types.SeverityLabelCritical:
+		return core.SeverityCritical
+	case types.SeverityLabelHigh:
+		return core.SeverityHigh
+	case types.SeverityLabelMedium:
+		return core.SeverityMedium
+	case types.SeverityLabelLow:
+		return core.SeverityLow
+	default:
+		return core.SeverityInfo
+	}
+}
+
+// targetID returns how the view of a resource type refers to the resource.
+// EC2 and RDS list instances by identifier; other views know their ARNs.
+func targetID(resourceType, resourceID string) string {
+	switch resourceType {
+	case "AwsEc2Instance", "AwsRdsDbInstance":
+		if i := strings.LastIndexAny(resourceID, "/:"); i >= 0 {
+			return resourceID[i+1:]
+		}
+	}
+	return resourceID
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "securityhub", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "securityhub", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
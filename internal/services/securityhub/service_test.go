@@ -0,0 +1,191 @@
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	instanceFinding = "arn:aws:securityhub:us-east-1:123456789012:subscription/aws-foundational-security-best-practices/v/1.0.0/EC2.8/finding/1"
+	bucketFinding   = "arn:aws:securityhub:us-east-1:123456789012:subscription/aws-foundational-security-best-practices/v/1.0.0/S3.8/finding/2"
+	productARN      = "arn:aws:securityhub:us-east-1::product/aws/securityhub"
+)
+
+// fakeHub serves a high severity finding on an EC2 instance and a low one,
+// already notified, on an S3 bucket. It fails every call when err is set,
+// records the filters of every GetFindings call and the workflow updates,
+// and reports the updates of unprocessed findings as failed.
+type fakeHub struct {
+	err         error
+	unprocessed bool
+	filters     []*types.AwsSecurityFindingFilters
+	updates     []*securityhub.BatchUpdateFindingsInput
+}
+
+func (f *fakeHub) findings() []types.AwsSecurityFinding {
+	return []types.AwsSecurityFinding{
+		{
+			Id:         aws.String(instanceFinding),
+			ProductArn: aws.String(productARN),
+			Title:      aws.String("EC2 instances should use IMDSv2"),
+			Severity:   &types.Severity{Label: types.SeverityLabelHigh},
+			Compliance: &types.Compliance{Status: types.ComplianceStatusFailed},
+			CreatedAt:  aws.String("2026-01-02T03:04:05Z"),
+			UpdatedAt:  aws.String("2026-02-03T04:05:06Z"),
+			Resources: []types.Resource{{
+				Type:   aws.String("AwsEc2Instance"),
+				Id:     aws.String("arn:aws:ec2:us-east-1:123456789012:instance/i-0abc"),
+				Region: aws.String("us-east-1"),
+			}},
+		},
+		{
+			Id:         aws.String(bucketFinding),
+			ProductArn: aws.String(productARN),
+			Title:      aws.String("S3 buckets should block public access"),
+			Severity:   &types.Severity{Label: types.SeverityLabelLow},
+			Workflow:   &types.Workflow{Status: types.WorkflowStatusNotified},
+			Resources: []types.Resource{{
+				Type: aws.String("AwsS3Bucket"),
+				Id:   aws.String("arn:aws:s3:::logs"),
+			}},
+		},
+	}
+}
+
+func (f *fakeHub) DescribeHub(context.Context, *securityhub.DescribeHubInput, ...func(*securityhub.Options)) (*securityhub.DescribeHubOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &securityhub.DescribeHubOutput{}, nil
+}
+
+func (f *fakeHub) GetFindings(_ context.Context, in *securityhub.GetFindingsInput, _ ...func(*securityhub.Options)) (*securityhub.GetFindingsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.filters = append(f.filters, in.Filters)
+	out := &securityhub.GetFindingsOutput{}
+	for _, finding := range f.findings() {
+		if len(in.Filters.Id) == 0 || aws.ToString(in.Filters.Id[0].Value) == aws.ToString(finding.Id) {
+			out.Findings = append(out.Findings, finding)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeHub) BatchUpdateFindings(_ context.Context, in *securityhub.BatchUpdateFindingsInput, _ ...func(*securityhub.Options)) (*securityhub.BatchUpdateFindingsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.updates = append(f.updates, in)
+	if f.unprocessed {
+		return &securityhub.BatchUpdateFindingsOutput{UnprocessedFindings: []types.BatchUpdateFindingsUnprocessedFinding{{
+			FindingIdentifier: &in.FindingIdentifiers[0],
+			ErrorCode:         aws.String("FindingNotFound"),
+			ErrorMessage:      aws.String("Finding not found"),
+		}}}, nil
+	}
+	return &securityhub.BatchUpdateFindingsOutput{ProcessedFindings: in.FindingIdentifiers}, nil
+}
+
+// TestServiceConformance runs the core service contract against findings.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeHub{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeHub{err: errors.New("InvalidAccessException: Security Hub is not enabled")}, d)
+		},
+		ExistingID:   bucketFinding,
+		Action:       "resolve",
+		ActionParams: map[string]any{"note": "public access blocked"},
+	})
+}
+
+func TestListLinksAffectedResources(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeHub{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []struct {
+		service, target, state string
+		severity               core.Severity
+	}{
+		{"ec2", "i-0abc", "new", core.SeverityHigh},
+		{"s3", "arn:aws:s3:::logs", "notified", core.SeverityLow},
+	}
+	for i, r := range resources {
+		if r.Metadata["target_service"] != want[i].service || r.Metadata["target_id"] != want[i].target {
+			t.Errorf("%s targets %v %v, want %s %s", r.Name, r.Metadata["target_service"], r.Metadata["target_id"], want[i].service, want[i].target)
+		}
+		if r.State != want[i].state {
+			t.Errorf("%s state = %q, want %q", r.Name, r.State, want[i].state)
+		}
+		if issues := r.Issues(); len(issues) != 1 || issues[0].Severity != want[i].severity || issues[0].Message != r.Name {
+			t.Errorf("%s issues = %v", r.Name, issues)
+		}
+	}
+}
+
+func TestFindingFilters(t *testing.T) {
+	tests := []struct {
+		status string
+		want   []string // Comparison and value of each workflow status filter
+	}{
+		{"", []string{"NOT_EQUALS RESOLVED", "NOT_EQUALS SUPPRESSED"}},
+		{"notified", []string{"EQUALS NOTIFIED"}},
+	}
+	for _, tt := range tests {
+		filters := findingFilters(tt.status)
+		var got []string
+		for _, f := range filters.WorkflowStatus {
+			got = append(got, string(f.Comparison)+" "+aws.ToString(f.Value))
+		}
+		if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+			t.Errorf("findingFilters(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+		if len(filters.RecordState) != 1 || aws.ToString(filters.RecordState[0].Value) != "ACTIVE" {
+			t.Errorf("findingFilters(%q) record state = %+v", tt.status, filters.RecordState)
+		}
+	}
+}
+
+// TestWorkflowUpdates checks that updating a finding that was not listed
+// looks up its product first, and that unprocessed findings fail.
+func TestWorkflowUpdates(t *testing.T) {
+	fake := &fakeHub{}
+	svc := NewServiceWithClient(fake, nil)
+
+	if _, err := svc.Execute(context.Background(), "suppress", instanceFinding, map[string]any{"note": " accepted risk "}); err != nil {
+		t.Fatalf("suppress error = %v", err)
+	}
+	if len(fake.filters) != 1 || aws.ToString(fake.filters[0].Id[0].Value) != instanceFinding {
+		t.Errorf("GetFindings filters = %+v, want a lookup of the finding", fake.filters)
+	}
+	update := fake.updates[0]
+	if aws.ToString(update.FindingIdentifiers[0].ProductArn) != productARN || update.Workflow.Status != types.WorkflowStatusSuppressed {
+		t.Errorf("update = %+v", update)
+	}
+	if update.Note == nil || aws.ToString(update.Note.Text) != "accepted risk" || aws.ToString(update.Note.UpdatedBy) != noteAuthor {
+		t.Errorf("note = %+v", update.Note)
+	}
+
+	if _, err := svc.Execute(context.Background(), "resolve", "arn:aws:securityhub:us-east-1:123456789012:finding/gone", nil); !errors.Is(err, core.ErrResourceNotFound) {
+		t.Errorf("resolve of an unknown finding error = %v", err)
+	}
+	fake.unprocessed = true
+	if _, err := svc.Execute(context.Background(), "resolve", instanceFinding, nil); err == nil || !strings.Contains(err.Error(), "FindingNotFound") {
+		t.Errorf("resolve of an unprocessed finding error = %v", err)
+	}
+}
@@ -0,0 +1,331 @@
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const workflowFormID = "securityhub:workflow"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for Security Hub findings.
+type View struct {
+	*base.TableView
+
+	// Finding and action of the open workflow form
+	formTarget string
+	formAction string
+}
+
+// NewView creates a new Security Hub view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Title"), MinWidth: 20, MaxWidth: 70, Weight: 2.5, Priority: 0},
+		{Title: i18n.T("Resource"), MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("Type"), MinWidth: 10, MaxWidth: 24, Weight: 0.5, Priority: 3},
+		{Title: i18n.T("Product"), MinWidth: 10, MaxWidth: 20, Weight: 0.4, Priority: 3},
+		{Title: i18n.T("Workflow"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Compliance"), MinWidth: 10, MaxWidth: 16, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Updated"), MinWidth: 10, MaxWidth: 12, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 2},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Security Hub", "", "securityhub", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadFindings()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "N":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openWorkflowForm("notify", i18n.T("Mark %s as notified", row.Name), row.ID)
+			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openWorkflowForm("resolve", i18n.T("Resolve %s", row.Name), row.ID)
+			}
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openWorkflowForm("suppress", i18n.T("Suppress %s", row.Name), row.ID)
+			}
+		case "v":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.focusAffected(row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Finding %s", row.Name), formatFinding(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != workflowFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Updating finding %s...", v.formTarget)
+		cmds = append(cmds, v.executeAction(v.formAction, v.formTarget, msg.Values))
+
+	case findingsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d active findings", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			cmds = append(cmds, v.loadFindings())
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading Security Hub findings...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[N]otify  [x] resolve  [s]uppress  [v]iew resource  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the findings.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadFindings()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type findingsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadFindings() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return findingsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return findingsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return findingsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) openWorkflowForm(action, title, findingID string) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	v.formTarget = findingID
+	v.formAction = action
+	return v.OpenForm(components.NewForm(workflowFormID, title, def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// focusAffected asks the application to show the resource a finding is
+// about in the view of its service.
+func (v *View) focusAffected(r *core.Resource) tea.Cmd {
+	service := r.GetMetadataString("target_service")
+	if service == "" {
+		v.Message = i18n.T("No view lists %s resources", r.GetMetadataString("resource_type"))
+		return nil
+	}
+	target := base.FocusResourceMsg{Service: service, ResourceID: r.GetMetadataString("target_id")}
+	return func() tea.Msg { return target }
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.SeverityCell(r),
+		base.TextCell(base.TruncateString(r.Name, 70)),
+		base.TextCell(base.TruncateString(r.GetMetadataString("resource_id"), 50)),
+		base.TextCell(r.GetMetadataString("resource_type")),
+		base.TextCell(r.GetMetadataString("product")),
+		base.TextCell(r.GetMetadataString("workflow_status")),
+		base.TextCell(r.GetMetadataString("compliance")),
+		base.TextCell(r.GetMetadataString("updated")),
+		base.AgeCell(r),
+	}
+}
+
+// formatFinding renders a finding for the detail panel.
+func formatFinding(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID:         %s\n", r.ID)
+	fmt.Fprintf(&b, "Product:    %s\n", r.GetMetadataString("product"))
+	fmt.Fprintf(&b, "Severity:   %s\n", r.Severity())
+	fmt.Fprintf(&b, "Workflow:   %s\n", r.GetMetadataString("workflow_status"))
+	if compliance := r.GetMetadataString("compliance"); compliance != "" {
+		fmt.Fprintf(&b, "Compliance: %s\n", compliance)
+	}
+	fmt.Fprintf(&b, "Resource:   %s (%s)\n", r.GetMetadataString("resource_id"), r.GetMetadataString("resource_type"))
+	if region := r.GetMetadataString("resource_region"); region != "" {
+		fmt.Fprintf(&b, "Region:     %s\n", region)
+	}
+	fmt.Fprintf(&b, "Updated:    %s\n", r.GetMetadataString("updated"))
+
+	if description := r.GetMetadataString("description"); description != "" {
+		fmt.Fprintf(&b, "\n%s\n", description)
+	}
+	if remediation := r.GetMetadataString("remediation"); remediation != "" {
+		b.WriteString(i18n.T("\nRemediation:\n"))
+		fmt.Fprintf(&b, "  %s\n", remediation)
+		if url := r.GetMetadataString("remediation_url"); url != "" {
+			fmt.Fprintf(&b, "  %s\n", url)
+		}
+	}
+	if r.GetMetadataString("target_service") != "" {
+		b.WriteString(i18n.T("\nPress [v] to view the resource.\n"))
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	counts := make(map[core.Severity]int)
+	for _, r := range v.Resources {
+		counts[r.Severity()]++
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "active", Text: i18n.T("Active: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "severe", Text: i18n.T("Critical: %d  High: %d", counts[core.SeverityCritical], counts[core.SeverityHigh]), Tone: core.ToneError},
+		core.SummaryWidget{Name: "medium", Text: i18n.T("Medium: %d", counts[core.SeverityMedium]), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Security Hub Findings"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "securityhub" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
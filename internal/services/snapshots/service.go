@@ -0,0 +1,743 @@
+// Package snapshots provides EBS snapshot and AMI inventory for the a9s
+// application. It lists the snapshots and images the account owns, finds
+// snapshots left behind by deregistered AMIs and deleted volumes and AMIs
+// no instance runs, and deletes them one by one or in bulk.
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// Kinds of resources, as shown in the Kind column.
+const (
+	KindSnapshot = "snapshot"
+	KindAMI      = "AMI"
+)
+
+// DefaultCleanupAge is how old an orphaned snapshot or unused AMI must be
+// to be flagged as a cleanup candidate.
+const DefaultCleanupAge = 90 * 24 * time.Hour
+
+// Monthly prices per GB of snapshot storage, as in us-east-1. Snapshots are
+// incremental, so the full volume size is an upper bound.
+const (
+	pricePerGB        = 0.05
+	pricePerArchiveGB = 0.0125
+)
+
+// createdForAMI finds the AMI a snapshot was created for in the description
+// EC2 gives it, such as "Created by CreateImage(i-0abc) for ami-0def".
+var createdForAMI = regexp.MustCompile(`\bfor (?:DestinationAmi )?(ami-[0-9a-f]+)`)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements snapshot and AMI operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+
+	cleanupAge time.Duration
+	batches    *batch.Store
+}
+
+// Option configures the snapshot service.
+type Option func(*Service)
+
+// WithCleanupAge sets how many days an orphaned snapshot or unused AMI must
+// be old to be flagged as a cleanup candidate. Non-positive values keep the
+// default.
+func WithCleanupAge(days int) Option {
+	return func(s *Service) {
+		if days > 0 {
+			s.cleanupAge = time.Duration(days) * 24 * time.Hour
+		}
+	}
+}
+
+// WithBatchStore keeps the state of batch deletions in store, so an
+// interrupted one can be resumed.
+func WithBatchStore(store *batch.Store) Option {
+	return func(s *Service) {
+		s.batches = store
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeSnapshots(ctx context.Context, params *ec2.DescribeSnapshotsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	DescribeImages(ctx context.Context, params *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	DeleteSnapshot(ctx context.Context, params *ec2.DeleteSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	DeregisterImage(ctx context.Context, params *ec2.DeregisterImageInput, optFns ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
+}
+
+// NewService creates a new snapshot service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+		cleanupAge: DefaultCleanupAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+		cleanupAge: DefaultCleanupAge,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// region returns the region snapshots and AMIs are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "snapshots"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "Snapshots and AMIs"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "camera"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+		OwnerIds:   []string{"self"},
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("snapshots", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// inventory is what deciding whether a snapshot or AMI is orphaned takes:
+// the account's AMIs, the volumes that still exist and the AMIs instances
+// run.
+type inventory struct {
+	images    []types.Image
+	volumes   map[string]bool
+	instances map[string]int // Instances per AMI
+}
+
+// List returns the AMIs the account owns, then its snapshots, flagging
+// orphaned snapshots and unused AMIs older than the cleanup age.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	inv, err := s.inventory(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("snapshots", "list", err)
+	}
+	snapshots, err := s.listSnapshots(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("snapshots", "list", err)
+	}
+
+	now := time.Now()
+	sizes := make(map[string]int32, len(snapshots))
+	for _, snap := range snapshots {
+		sizes[aws.ToString(snap.SnapshotId)] = aws.ToInt32(snap.VolumeSize)
+	}
+	resources := make([]core.Resource, 0, len(inv.images)+len(snapshots))
+	for _, image := range inv.images {
+		resources = append(resources, s.imageToResource(image, inv, sizes, now))
+	}
+	for _, snap := range snapshots {
+		resources = append(resources, s.snapshotToResource(snap, inv, now))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:snapshot",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+func (s *Service) inventory(ctx context.Context) (inventory, error) {
+	client := s.client()
+	inv := inventory{volumes: make(map[string]bool), instances: make(map[string]int)}
+
+	images := ec2.NewDescribeImagesPaginator(client, &ec2.DescribeImagesInput{Owners: []string{"self"}})
+	for images.HasMorePages() {
+		page, err := images.NextPage(ctx)
+		if err != nil {
+			return inventory{}, err
+		}
+		inv.images = append(inv.images, page.Images...)
+	}
+
+	volumes := ec2.NewDescribeVolumesPaginator(client, &ec2.DescribeVolumesInput{})
+	for volumes.HasMorePages() {
+		page, err := volumes.NextPage(ctx)
+		if err != nil {
+			return inventory{}, err
+		}
+		for _, vol := range page.Volumes {
+			inv.volumes[aws.ToString(vol.VolumeId)] = true
+		}
+	}
+
+	instances := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{})
+	for instances.HasMorePages() {
+		page, err := instances.NextPage(ctx)
+		if err != nil {
+			return inventory{}, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.State == nil || instance.State.Name != types.InstanceStateNameTerminated {
+					inv.instances[aws.ToString(instance.ImageId)]++
+				}
+			}
+		}
+	}
+	return inv, nil
+}
+
+func (s *Service) listSnapshots(ctx context.Context) ([]types.Snapshot, error) {
+	var snapshots []types.Snapshot
+	paginator := ec2.NewDescribeSnapshotsPaginator(s.client(), &ec2.DescribeSnapshotsInput{OwnerIds: []string{"self"}})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, page.Snapshots...)
+	}
+	return snapshots, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for snapshots and AMIs.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "delete",
+			Description: "Delete the snapshot, or deregister the AMI",
+			Icon:        "trash",
+			Shortcut:    "x",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "delete_snapshots", Type: "bool", Default: true, Description: "Also delete the snapshots of a deregistered AMI"},
+			},
+		},
+		{
+			Name:        "delete_batch",
+			Description: "Delete several snapshots and deregister several AMIs",
+			Icon:        "trash",
+			Shortcut:    "D",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "ids", Type: "string", Required: true, Description: "Comma-separated snapshot and AMI IDs to delete"},
+				{Name: "delete_snapshots", Type: "bool", Default: true, Description: "Also delete the snapshots of a deregistered AMI"},
+			},
+		},
+		{
+			Name:        "resume_batch",
+			Description: "Resume the interrupted batch deletion",
+			Icon:        "play",
+			Shortcut:    "R",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action. Deletions ask for confirmation through
+// a core.ConfirmationError until the "confirm" parameter is set. A batch
+// deletion's resource ID only labels it; the IDs are in the "ids"
+// parameter.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	withSnapshots := true
+	if v, ok := params["delete_snapshots"].(bool); ok {
+		withSnapshots = v
+	}
+	switch action {
+	case "delete":
+		result, err = s.deleteOne(ctx, resourceID, params, withSnapshots, core.Confirmed(params, resourceID, true))
+	case "delete_batch":
+		raw, _ := params["ids"].(string)
+		var ids []string
+		for _, id := range strings.Split(raw, ",") {
+			if id = strings.TrimSpace(id); id != "" && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, core.NewValidationError("ids", raw, "at least one snapshot or AMI ID is required")
+		}
+		result, err = s.deleteBatch(ctx, resourceID, ids, params, withSnapshots, confirmed)
+	case "resume_batch":
+		result, err = s.resumeBatch(ctx, resourceID, params, confirmed)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// PendingBatch returns the batch deletion that was interrupted, if any.
+func (s *Service) PendingBatch() *batch.Job {
+	job, _ := s.batches.Pending(s.batchScope())
+	return job
+}
+
+// batchScope returns the scope of batch deletions: the profile and region.
+func (s *Service) batchScope() string {
+	profile := ""
+	if s.factory != nil {
+		profile = s.factory.Profile()
+	}
+	return batch.Scope(s.Name(), profile, s.region())
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) deleteOne(ctx context.Context, id string, params map[string]any, withSnapshots, confirmed bool) (*core.ActionResult, error) {
+	kind, err := kindOf(id)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+	}
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "delete", id, params, deletionReason(kind, withSnapshots), true)
+	}
+
+	if kind == KindSnapshot {
+		if err := s.deleteSnapshot(ctx, id); err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+		}
+		return core.NewActionResult(true, fmt.Sprintf("Deleted %s", id)), nil
+	}
+
+	snapshots, err := s.deregisterImage(ctx, id, withSnapshots)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+	}
+	for i, snapshot := range snapshots {
+		if err := s.deleteSnapshot(ctx, snapshot); err != nil {
+			err = fmt.Errorf("deregistered %s and deleted %d snapshots, but snapshot %s: %w", id, i, snapshot, err)
+			return core.NewActionResult(false, err.Error()), core.NewActionError("delete", id, err)
+		}
+	}
+	return core.NewActionResult(true, fmt.Sprintf("Deregistered %s and deleted %d snapshots", id, len(snapshots))), nil
+}
+
+// deleteBatch deregisters the AMIs, then deletes the snapshots, theirs
+// included, as a resumable batch.
+func (s *Service) deleteBatch(ctx context.Context, label string, ids []string, params map[string]any, withSnapshots, confirmed bool) (*core.ActionResult, error) {
+	var amis, snapshots []batch.Item
+	for _, id := range ids {
+		kind, err := kindOf(id)
+		if err != nil {
+			return core.NewActionResult(false, err.Error()), core.NewActionError("delete_batch", label, err)
+		}
+		if kind == KindAMI {
+			amis = append(amis, batch.Item{ID: id, Kind: kind})
+		} else {
+			snapshots = append(snapshots, batch.Item{ID: id, Kind: kind})
+		}
+	}
+	if !confirmed {
+		reason := fmt.Sprintf("Deletes %d snapshots and deregisters %d AMIs; none can be restored", len(snapshots), len(amis))
+		if withSnapshots && len(amis) > 0 {
+			reason = fmt.Sprintf("Deletes %d snapshots and deregisters %d AMIs with their snapshots; none can be restored", len(snapshots), len(amis))
+		}
+		return nil, core.NewConfirmationError(s, "delete_batch", label, params, reason, false)
+	}
+
+	job := batch.NewJob(s.batchScope(), "delete_batch", append(amis, snapshots...), map[string]any{"delete_snapshots": withSnapshots})
+	return s.runBatch(ctx, job), nil
+}
+
+// resumeBatch runs the items an interrupted batch did not get to.
+func (s *Service) resumeBatch(ctx context.Context, label string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	job, err := s.batches.Pending(s.batchScope())
+	if err == nil && job == nil {
+		err = fmt.Errorf("no interrupted batch to resume")
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("resume_batch", label, err)
+	}
+	if !confirmed {
+		reason := fmt.Sprintf("Resumes the batch started %s: %d of %d snapshots and AMIs are left",
+			job.Started.Local().Format("2006-01-02 15:04"), job.Count(batch.StatusPending), len(job.Items))
+		return nil, core.NewConfirmationError(s, "resume_batch", label, params, reason, false)
+	}
+	return s.runBatch(ctx, job), nil
+}
+
+// runBatch deletes the pending items of job, queueing the snapshots of each
+// AMI it deregisters after the other items. The result's data is the job.
+func (s *Service) runBatch(ctx context.Context, job *batch.Job) *core.ActionResult {
+	withSnapshots := true
+	if v, ok := job.Params["delete_snapshots"].(bool); ok {
+		withSnapshots = v
+	}
+
+	err := batch.Run(ctx, job, func(ctx context.Context, item *batch.Item) error {
+		if item.Kind == KindSnapshot {
+			return s.deleteSnapshot(ctx, item.ID)
+		}
+		snapshots, err := s.deregisterImage(ctx, item.ID, withSnapshots)
+		if err != nil {
+			return err
+		}
+		for _, snapshot := range snapshots {
+			if !slices.ContainsFunc(job.Items, func(i batch.Item) bool { return i.ID == snapshot }) {
+				job.Items = append(job.Items, batch.Item{ID: snapshot, Kind: KindSnapshot, Status: batch.StatusPending})
+			}
+		}
+		if len(snapshots) > 0 {
+			item.Detail = "snapshots " + strings.Join(snapshots, ", ")
+		}
+		return nil
+	}, batch.WithStore(s.batches))
+
+	result := core.NewActionResult(err == nil && job.Count(batch.StatusFailed) == 0, job.Summary())
+	result.Data = job
+	return result
+}
+
+// deregisterImage deregisters an AMI, returning its snapshots to delete
+// when withSnapshots is set. They are only known while it is registered.
+func (s *Service) deregisterImage(ctx context.Context, id string, withSnapshots bool) ([]string, error) {
+	var snapshots []string
+	if withSnapshots {
+		out, err := s.client().DescribeImages(ctx, &ec2.DescribeImagesInput{ImageIds: []string{id}})
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range out.Images {
+			snapshots = imageSnapshots(image)
+		}
+	}
+
+	if _, err := s.client().DeregisterImage(ctx, &ec2.DeregisterImageInput{ImageId: aws.String(id)}); err != nil {
+		return nil, err
+	}
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   id,
+		ResourceType: "ec2:image",
+	})
+	return snapshots, nil
+}
+
+func (s *Service) deleteSnapshot(ctx context.Context, id string) error {
+	if _, err := s.client().DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(id)}); err != nil {
+		return err
+	}
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   id,
+		ResourceType: "ec2:snapshot",
+	})
+	return nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) imageToResource(image types.Image, inv inventory, sizes map[string]int32, now time.Time) core.Resource {
+	id := aws.ToString(image.ImageId)
+	snapshots := imageSnapshots(image)
+	var size int32
+	for _, snapshot := range snapshots {
+		size += sizes[snapshot]
+	}
+
+	resource := core.Resource{
+		ID:     id,
+		Type:   "ec2:image",
+		Name:   aws.ToString(image.Name),
+		State:  string(image.State),
+		Region: s.region(),
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"kind":        KindAMI,
+			"description": aws.ToString(image.Description),
+			"size_gb":     size,
+			"snapshots":   snapshots,
+			"instances":   inv.instances[id],
+			"public":      aws.ToBool(image.Public),
+			"platform":    aws.ToString(image.PlatformDetails),
+		},
+	}
+	if created, err := time.Parse(time.RFC3339, aws.ToString(image.CreationDate)); err == nil {
+		resource.CreatedAt = &created
+	}
+	for _, tag := range image.Tags {
+		resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	if resource.Name == "" {
+		resource.Name = id
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+
+	var reasons []string
+	if inv.instances[id] == 0 && resource.CreatedAt != nil && now.Sub(*resource.CreatedAt) >= s.cleanupAge {
+		reasons = append(reasons, "no instance runs it", estimate.FormatAge(now.Sub(*resource.CreatedAt))+" old")
+	}
+	s.applyCleanup(&resource, reasons)
+	if aws.ToBool(image.Public) {
+		resource.AddIssue(core.SeverityHigh, "AMI is public")
+	}
+	return resource
+}
+
+func (s *Service) snapshotToResource(snap types.Snapshot, inv inventory, now time.Time) core.Resource {
+	id := aws.ToString(snap.SnapshotId)
+	volumeID := aws.ToString(snap.VolumeId)
+	description := aws.ToString(snap.Description)
+
+	var referencedBy []string
+	sourceAMI := ""
+	if m := createdForAMI.FindStringSubmatch(description); m != nil {
+		sourceAMI = m[1]
+	}
+	sourceRegistered := false
+	for _, image := range inv.images {
+		imageID := aws.ToString(image.ImageId)
+		if slices.Contains(imageSnapshots(image), id) {
+			referencedBy = append(referencedBy, imageID)
+		}
+		sourceRegistered = sourceRegistered || imageID == sourceAMI
+	}
+
+	// Copied snapshots point at the placeholder vol-ffffffff
+	orphanReason := ""
+	switch {
+	case len(referencedBy) > 0:
+	case sourceAMI != "" && !sourceRegistered:
+		orphanReason = fmt.Sprintf("AMI %s deregistered", sourceAMI)
+	case volumeID != "" && volumeID != "vol-ffffffff" && !inv.volumes[volumeID]:
+		orphanReason = "source volume deleted"
+	}
+
+	resource := core.Resource{
+		ID:        id,
+		Type:      "ec2:snapshot",
+		Name:      id,
+		State:     string(snap.State),
+		Region:    s.region(),
+		CreatedAt: snap.StartTime,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"kind":          KindSnapshot,
+			"description":   description,
+			"size_gb":       aws.ToInt32(snap.VolumeSize),
+			"volume_id":     volumeID,
+			"storage_tier":  string(snap.StorageTier),
+			"encrypted":     aws.ToBool(snap.Encrypted),
+			"referenced_by": referencedBy,
+			"source_ami":    sourceAMI,
+			"orphaned":      orphanReason != "",
+			"orphan_reason": orphanReason,
+		},
+	}
+	for _, tag := range snap.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" && value != "" {
+			resource.Name = value
+		}
+	}
+	iac.Apply(&resource)
+	estimate.ApplyAge(&resource, now)
+	price := pricePerGB
+	if snap.StorageTier == types.StorageTierArchive {
+		price = pricePerArchiveGB
+	}
+	estimate.ApplyCost(&resource, float64(aws.ToInt32(snap.VolumeSize))*price)
+
+	var reasons []string
+	if orphanReason != "" && snap.StartTime != nil && now.Sub(*snap.StartTime) >= s.cleanupAge {
+		reasons = append(reasons, orphanReason, estimate.FormatAge(now.Sub(*snap.StartTime))+" old")
+	}
+	s.applyCleanup(&resource, reasons)
+	if !aws.ToBool(snap.Encrypted) {
+		resource.AddIssue(core.SeverityMedium, "Unencrypted")
+	}
+	return resource
+}
+
+// applyCleanup records whether a resource should be cleaned up and why, as
+// S3 buckets do.
+func (s *Service) applyCleanup(resource *core.Resource, reasons []string) {
+	reason := strings.Join(reasons, ", ")
+	resource.Metadata["should_cleanup"] = reason != ""
+	resource.Metadata["cleanup_reason"] = reason
+	if reason != "" {
+		resource.AddIssue(core.SeverityLow, "Cleanup candidate: "+reason)
+	}
+}
+
+// imageSnapshots returns the EBS snapshots backing an AMI.
+func imageSnapshots(image types.Image) []string {
+	var snapshots []string
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+			snapshots = append(snapshots, aws.ToString(mapping.Ebs.SnapshotId))
+		}
+	}
+	return snapshots
+}
+
+// kindOf returns whether an ID is a snapshot's or an AMI's.
+func kindOf(id string) (string, error) {
+	switch {
+	case strings.HasPrefix(id, "snap-"):
+		return KindSnapshot, nil
+	case strings.HasPrefix(id, "ami-"):
+		return KindAMI, nil
+	}
+	return "", core.NewValidationError("id", id, "is neither a snapshot nor an AMI ID")
+}
+
+// deletionReason describes what deleting a snapshot or AMI does.
+func deletionReason(kind string, withSnapshots bool) string {
+	switch {
+	case kind == KindSnapshot:
+		return "Deleting a snapshot cannot be undone; AWS refuses while an AMI uses it"
+	case withSnapshots:
+		return "Deregisters the AMI and deletes its snapshots; launch templates and Auto Scaling groups using it stop working"
+	}
+	return "Deregisters the AMI and keeps its snapshots; launch templates and Auto Scaling groups using it stop working"
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "snapshots", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "snapshots", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// FlagsCleanup implements core.CleanupFlagger.
+func (s *Service) FlagsCleanup() {}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+	_ batch.Resumer       = (*Service)(nil)
+	_ core.CleanupFlagger = (*Service)(nil)
+)
@@ -0,0 +1,246 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeEC2 serves two AMIs: ami-0a11, which an instance runs, and the public
+// ami-0b1d, which none has run in the 200 days since it was created. Their
+// snapshots are snap-0a11 and snap-0b1d. snap-0c1d was created for the
+// deregistered ami-0dead, snap-0d1e is an unencrypted archive of a deleted
+// volume and snap-0e1f backs up a live volume. It fails every call when err
+// is set and records the snapshots deleted and AMIs deregistered.
+type fakeEC2 struct {
+	err          error
+	deleted      []string
+	deregistered []string
+}
+
+func daysAgo(days int) time.Time {
+	return time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+func (f *fakeEC2) images() []types.Image {
+	image := func(id, name string, days int, snapshot string) types.Image {
+		return types.Image{
+			ImageId:      aws.String(id),
+			Name:         aws.String(name),
+			State:        types.ImageStateAvailable,
+			CreationDate: aws.String(daysAgo(days).UTC().Format(time.RFC3339)),
+			BlockDeviceMappings: []types.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &types.EbsBlockDevice{SnapshotId: aws.String(snapshot)}},
+			},
+		}
+	}
+	legacy := image("ami-0b1d", "legacy", 200, "snap-0b1d")
+	legacy.Public = aws.Bool(true)
+	return []types.Image{image("ami-0a11", "app", 300, "snap-0a11"), legacy}
+}
+
+func (f *fakeEC2) DescribeSnapshots(context.Context, *ec2.DescribeSnapshotsInput, ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	snapshot := func(id, volume, description string, days int, size int32) types.Snapshot {
+		return types.Snapshot{
+			SnapshotId:  aws.String(id),
+			VolumeId:    aws.String(volume),
+			Description: aws.String(description),
+			State:       types.SnapshotStateCompleted,
+			StartTime:   aws.Time(daysAgo(days)),
+			VolumeSize:  aws.Int32(size),
+			StorageTier: types.StorageTierStandard,
+			Encrypted:   aws.Bool(true),
+		}
+	}
+	archived := snapshot("snap-0d1e", "vol-gone", "nightly", 30, 100)
+	archived.StorageTier = types.StorageTierArchive
+	archived.Encrypted = aws.Bool(false)
+	return &ec2.DescribeSnapshotsOutput{Snapshots: []types.Snapshot{
+		snapshot("snap-0a11", "vol-ffffffff", "Created by CreateImage(i-1) for ami-0a11", 300, 8),
+		snapshot("snap-0b1d", "vol-ffffffff", "Created by CreateImage(i-2) for ami-0b1d", 200, 8),
+		snapshot("snap-0c1d", "vol-ffffffff", "Created by CreateImage(i-3) for ami-0dead", 120, 20),
+		archived,
+		snapshot("snap-0e1f", "vol-live", "nightly", 10, 50),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeImages(_ context.Context, in *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	images := f.images()
+	if len(in.ImageIds) > 0 {
+		images = slices.DeleteFunc(images, func(image types.Image) bool {
+			return !slices.Contains(in.ImageIds, aws.ToString(image.ImageId))
+		})
+	}
+	return &ec2.DescribeImagesOutput{Images: images}, nil
+}
+
+func (f *fakeEC2) DescribeVolumes(context.Context, *ec2.DescribeVolumesInput, ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeVolumesOutput{Volumes: []types.Volume{{VolumeId: aws.String("vol-live")}}}, nil
+}
+
+func (f *fakeEC2) DescribeInstances(context.Context, *ec2.DescribeInstancesInput, ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []types.Reservation{{Instances: []types.Instance{
+		{InstanceId: aws.String("i-1"), ImageId: aws.String("ami-0a11"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+		{InstanceId: aws.String("i-2"), ImageId: aws.String("ami-0b1d"), State: &types.InstanceState{Name: types.InstanceStateNameTerminated}},
+	}}}}, nil
+}
+
+func (f *fakeEC2) DeleteSnapshot(_ context.Context, in *ec2.DeleteSnapshotInput, _ ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, aws.ToString(in.SnapshotId))
+	return &ec2.DeleteSnapshotOutput{}, nil
+}
+
+func (f *fakeEC2) DeregisterImage(_ context.Context, in *ec2.DeregisterImageInput, _ ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deregistered = append(f.deregistered, aws.ToString(in.ImageId))
+	return &ec2.DeregisterImageOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against snapshots
+// and AMIs.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID:    "snap-0c1d",
+		Action:        "delete",
+		ActionParams:  map[string]any{core.ParamConfirm: true, core.ParamConfirmResource: "snap-0c1d"},
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestListFlagsOrphans(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeEC2{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"ami-0a11":  nil,
+		"ami-0b1d":  {"Cleanup candidate: no instance runs it, 6mo old", "AMI is public"},
+		"snap-0a11": nil,
+		"snap-0b1d": nil,
+		"snap-0c1d": {"Cleanup candidate: AMI ami-0dead deregistered, 4mo old"},
+		// Orphaned, but younger than the cleanup age
+		"snap-0d1e": {"Unencrypted"},
+		"snap-0e1f": nil,
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("List() = %d resources, want %d", len(resources), len(want))
+	}
+	for _, r := range resources {
+		var messages []string
+		for _, issue := range r.Issues() {
+			messages = append(messages, issue.Message)
+		}
+		if !slices.Equal(messages, want[r.ID]) {
+			t.Errorf("%s issues = %q, want %q", r.ID, messages, want[r.ID])
+		}
+
+		switch r.ID {
+		case "ami-0b1d":
+			if r.Metadata["size_gb"] != int32(8) {
+				t.Errorf("ami-0b1d size = %v, want its snapshot's", r.Metadata["size_gb"])
+			}
+		case "snap-0b1d":
+			if referenced := r.Metadata["referenced_by"].([]string); !slices.Equal(referenced, []string{"ami-0b1d"}) {
+				t.Errorf("snap-0b1d referenced by %v", referenced)
+			}
+		case "snap-0d1e":
+			if r.Metadata["orphan_reason"] != "source volume deleted" || r.Metadata[estimate.MonthlyCostKey] != 1.25 {
+				t.Errorf("snap-0d1e orphaned as %q, costing %v", r.Metadata["orphan_reason"], r.Metadata[estimate.MonthlyCostKey])
+			}
+		}
+	}
+}
+
+func TestListHonorsCleanupAge(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeEC2{}, nil, WithCleanupAge(7)).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	i := slices.IndexFunc(resources, func(r core.Resource) bool { return r.ID == "snap-0d1e" })
+	if reason := resources[i].Metadata["cleanup_reason"]; reason != "source volume deleted, 1mo old" {
+		t.Errorf("snap-0d1e cleanup reason = %q", reason)
+	}
+}
+
+func TestDeleteAMI(t *testing.T) {
+	for _, withSnapshots := range []bool{true, false} {
+		fake := &fakeEC2{}
+		svc := NewServiceWithClient(fake, nil)
+
+		_, err := svc.Execute(context.Background(), "delete", "ami-0b1d", map[string]any{
+			core.ParamConfirm:         true,
+			core.ParamConfirmResource: "ami-0b1d",
+			"delete_snapshots":        withSnapshots,
+		})
+		if err != nil {
+			t.Fatalf("delete_snapshots=%v: delete error = %v", withSnapshots, err)
+		}
+		if !slices.Equal(fake.deregistered, []string{"ami-0b1d"}) {
+			t.Errorf("delete_snapshots=%v: deregistered %v", withSnapshots, fake.deregistered)
+		}
+		if deleted := len(fake.deleted) > 0; deleted != withSnapshots || (deleted && fake.deleted[0] != "snap-0b1d") {
+			t.Errorf("delete_snapshots=%v: deleted %v", withSnapshots, fake.deleted)
+		}
+	}
+}
+
+// TestDeleteBatchDeregistersAMIsFirst checks that a batch deregisters its
+// AMIs before deleting snapshots, theirs queued last.
+func TestDeleteBatchDeregistersAMIsFirst(t *testing.T) {
+	fake := &fakeEC2{}
+	svc := NewServiceWithClient(fake, nil)
+	params := map[string]any{"ids": "snap-0c1d, ami-0b1d, snap-0c1d"}
+
+	_, err := svc.Execute(context.Background(), "delete_batch", "marked", params)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || confirm.Reason != "Deletes 1 snapshots and deregisters 1 AMIs with their snapshots; none can be restored" {
+		t.Fatalf("unconfirmed delete_batch error = %v", err)
+	}
+
+	params[core.ParamConfirm] = true
+	result, err := svc.Execute(context.Background(), "delete_batch", "marked", params)
+	if err != nil {
+		t.Fatalf("delete_batch error = %v", err)
+	}
+	if result.Message != "Deleted 3 of 3, 0 failed" {
+		t.Errorf("delete_batch = %q", result.Message)
+	}
+	if !slices.Equal(fake.deleted, []string{"snap-0c1d", "snap-0b1d"}) {
+		t.Errorf("deleted %v, want the AMI's snapshot last", fake.deleted)
+	}
+}
@@ -0,0 +1,431 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/batch"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for snapshots and AMIs.
+type View struct {
+	*base.TableView
+
+	marked  map[string]bool // IDs marked for a batch deletion
+	pending *batch.Job      // Interrupted batch deletion, as of the last listing
+}
+
+// NewView creates a new snapshot and AMI view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: "", MinWidth: 2, MaxWidth: 2, Weight: 0.1, Priority: 0},
+		{Title: i18n.T("Kind"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("ID"), MinWidth: 21, MaxWidth: 22, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 40, Weight: 1.5, Priority: 1},
+		{Title: i18n.T("Size"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Used By"), MinWidth: 8, MaxWidth: 22, Weight: 0.5, Priority: 2},
+		{Title: i18n.T("Orphan"), MinWidth: 10, MaxWidth: 30, Weight: 0.8, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Cleanup"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Snapshots", "", "snapshots", columnDefs),
+		marked:    make(map[string]bool),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadResources()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case " ":
+			if row := v.GetSelectedResource(); row != nil {
+				v.marked[row.ID] = !v.marked[row.ID]
+				if !v.marked[row.ID] {
+					delete(v.marked, row.ID)
+				}
+				v.updateTable()
+			}
+			// Space also pages down in tables
+			return v, nil
+		case "a":
+			// Marks every cleanup candidate, not every snapshot
+			if len(v.marked) > 0 {
+				v.marked = make(map[string]bool)
+			} else {
+				for _, r := range v.Resources {
+					if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+						v.marked[r.ID] = true
+					}
+				}
+			}
+			v.updateTable()
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "D":
+			ids := v.markedIDs()
+			if len(ids) == 0 {
+				v.Message = i18n.T("Mark snapshots or AMIs with space first")
+				break
+			}
+			label := i18n.T("%d marked", len(ids))
+			return v, v.executeAction("delete_batch", label, map[string]any{"ids": strings.Join(ids, ",")})
+		case "R":
+			if job := v.PendingBatch(); job != nil {
+				label := i18n.T("%d left", job.Count(batch.StatusPending))
+				return v, v.executeAction("resume_batch", label, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(fmt.Sprintf("%s %s", row.GetMetadataString("kind"), row.ID), formatDetail(row))
+			}
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+			break
+		}
+		if msg.Result == nil {
+			break
+		}
+		v.Message = msg.Result.Message
+		if job, ok := msg.Result.Data.(*batch.Job); ok {
+			v.OpenDetail(msg.Result.Message, base.FormatBatchReport(job))
+		}
+		v.marked = make(map[string]bool)
+		return v, v.loadResources()
+
+	case resourcesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if v.SetListError(msg.err) {
+			v.Message = i18n.T("Error: %v", msg.err)
+			break
+		}
+		v.Resources = msg.resources
+		v.pruneMarks()
+		v.pending = v.PendingBatch()
+		v.updateTable()
+		if v.Message == "" {
+			v.Message = i18n.T("Loaded %d snapshots and AMIs", len(msg.resources))
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading snapshots and AMIs...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[space]mark  [a]ll candidates/none  [x] delete  [D]elete marked  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the snapshots and AMIs.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadResources()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type resourcesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadResources() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return resourcesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return resourcesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return resourcesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// markedIDs returns the marked snapshots and AMIs in table order.
+func (v *View) markedIDs() []string {
+	var ids []string
+	for _, r := range v.Resources {
+		if v.marked[r.ID] {
+			ids = append(ids, r.ID)
+		}
+	}
+	return ids
+}
+
+// pruneMarks drops the marks of snapshots and AMIs no longer listed.
+func (v *View) pruneMarks() {
+	listed := make(map[string]bool, len(v.Resources))
+	for _, r := range v.Resources {
+		listed[r.ID] = true
+	}
+	for id := range v.marked {
+		if !listed[id] {
+			delete(v.marked, id)
+		}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		r := v.Resources[i]
+		mark := ""
+		if v.marked[r.ID] {
+			mark = "✓"
+		}
+		return append(base.Row{base.TextCell(mark)}, buildRow(r)...)
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	size, _ := r.Metadata["size_gb"].(int32)
+	shouldCleanup, _ := r.Metadata["should_cleanup"].(bool)
+
+	cleanupIcon := "🟢 " + i18n.T("No")
+	if shouldCleanup {
+		cleanupIcon = "🟡 " + i18n.T("Yes")
+	}
+	orphan := r.GetMetadataString("orphan_reason")
+	if orphan == "" {
+		orphan = "-"
+	}
+
+	return base.Row{
+		base.TextCell(r.GetMetadataString("kind")),
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 40)),
+		base.LazyCell(size, func() string { return fmt.Sprintf("%d GiB", size) }),
+		base.TextCell(usedBy(r)),
+		base.TextCell(orphan),
+		base.AgeCell(r),
+		base.CostCell(r),
+		base.TextCell(cleanupIcon),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+// usedBy names what keeps a snapshot or AMI in use: the AMIs registered
+// from a snapshot, or how many instances run an AMI.
+func usedBy(r core.Resource) string {
+	if r.GetMetadataString("kind") == KindAMI {
+		instances, _ := r.Metadata["instances"].(int)
+		if instances == 0 {
+			return "-"
+		}
+		return i18n.T("%d instances", instances)
+	}
+	referencedBy, _ := r.Metadata["referenced_by"].([]string)
+	if len(referencedBy) == 0 {
+		return "-"
+	}
+	return strings.Join(referencedBy, ", ")
+}
+
+// formatDetail renders a snapshot or AMI, what uses it and why it may be
+// cleaned up for the detail panel.
+func formatDetail(r *core.Resource) string {
+	size, _ := r.Metadata["size_gb"].(int32)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Kind:        %s\n", r.GetMetadataString("kind"))
+	fmt.Fprintf(&b, "ID:          %s\n", r.ID)
+	fmt.Fprintf(&b, "Name:        %s\n", r.Name)
+	fmt.Fprintf(&b, "State:       %s\n", r.State)
+	fmt.Fprintf(&b, "Size:        %d GiB\n", size)
+	if description := r.GetMetadataString("description"); description != "" {
+		fmt.Fprintf(&b, "Description: %s\n", description)
+	}
+	if r.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:     %s\n", r.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if r.GetMetadataString("kind") == KindAMI {
+		snapshots, _ := r.Metadata["snapshots"].([]string)
+		public, _ := r.Metadata["public"].(bool)
+		fmt.Fprintf(&b, "Platform:    %s\n", r.GetMetadataString("platform"))
+		fmt.Fprintf(&b, "Public:      %t\n", public)
+		fmt.Fprintf(&b, "Instances:   %s\n", usedBy(*r))
+		if len(snapshots) > 0 {
+			fmt.Fprintf(&b, "Snapshots:   %s\n", strings.Join(snapshots, ", "))
+		}
+	} else {
+		encrypted, _ := r.Metadata["encrypted"].(bool)
+		fmt.Fprintf(&b, "Volume:      %s\n", r.GetMetadataString("volume_id"))
+		if tier := r.GetMetadataString("storage_tier"); tier != "" {
+			fmt.Fprintf(&b, "Tier:        %s\n", tier)
+		}
+		fmt.Fprintf(&b, "Encrypted:   %t\n", encrypted)
+		if ami := r.GetMetadataString("source_ami"); ami != "" {
+			fmt.Fprintf(&b, "Source AMI:  %s\n", ami)
+		}
+		fmt.Fprintf(&b, "Used by:     %s\n", usedBy(*r))
+		if monthly, ok := estimate.MonthlyCost(*r); ok {
+			fmt.Fprintf(&b, "Est. cost:   %s/mo\n", estimate.FormatCost(monthly))
+		}
+	}
+
+	if reason := r.GetMetadataString("cleanup_reason"); reason != "" {
+		fmt.Fprintf(&b, "\nCleanup:     %s\n", reason)
+	}
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	amis, orphans, cleanup := 0, 0, 0
+	reclaimable := 0.0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("kind") == KindAMI {
+			amis++
+		}
+		if orphaned, _ := r.Metadata["orphaned"].(bool); orphaned {
+			orphans++
+		}
+		if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+			cleanup++
+			if monthly, ok := estimate.MonthlyCost(r); ok {
+				reclaimable += monthly
+			}
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "snapshots", Text: i18n.T("Snapshots: %d", len(v.Resources)-amis), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "amis", Text: i18n.T("AMIs: %d", amis), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "orphaned", Text: i18n.T("Orphaned: %d", orphans), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "cleanup", Text: i18n.T("Cleanup: %d (%s/mo)", cleanup, estimate.FormatCost(reclaimable)), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "marked", Text: i18n.T("Marked: %d", len(v.marked)), Tone: core.ToneInfo},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("Snapshots and AMIs"), v.SummaryWidgets()) + v.renderPendingBatch()
+}
+
+// renderPendingBatch names how many items an interrupted batch has left.
+func (v *View) renderPendingBatch() string {
+	if v.pending == nil {
+		return ""
+	}
+	return "  " + v.Styles.Warning.Render(i18n.T("Interrupted batch: %d left, [R] resumes", v.pending.Count(batch.StatusPending)))
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "snapshots" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,201 @@
+package sns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Delivery Logs
+// =============================================================================
+
+const (
+	// DefaultDeliverySince is how many minutes of delivery logs are read
+	// unless another window is given.
+	DefaultDeliverySince = 60
+	// maxDeliveries is the number of delivery attempts read per log group.
+	maxDeliveries = 50
+)
+
+// Delivery is an attempt by SNS to deliver a message to a subscription, as
+// recorded by delivery status logging. SNS does not log message bodies.
+type Delivery struct {
+	ID               string
+	MessageID        string
+	Time             time.Time
+	Status           string // SUCCESS or FAILURE
+	Destination      string
+	StatusCode       int
+	ProviderResponse string
+	DwellTime        time.Duration // From publication to the last attempt
+	Attempts         int
+	Event            string // The log event, as written by SNS
+}
+
+// deliveryEvent is a delivery status log event.
+type deliveryEvent struct {
+	Notification struct {
+		MessageID string `json:"messageId"`
+		Timestamp string `json:"timestamp"`
+	} `json:"notification"`
+	Delivery struct {
+		DeliveryID       string `json:"deliveryId"`
+		Destination      string `json:"destination"`
+		ProviderResponse string `json:"providerResponse"`
+		DwellTimeMs      int64  `json:"dwellTimeMs"`
+		Attempts         int    `json:"attempts"`
+		StatusCode       int    `json:"statusCode"`
+	} `json:"delivery"`
+	Status string `json:"status"`
+}
+
+// deliveryGroups returns the log groups SNS writes the successful and the
+// failed deliveries of a topic to: sns/<region>/<account>/<topic>, and the
+// same followed by /Failure.
+func deliveryGroups(topicARN string) (success, failure string) {
+	// arn:aws:sns:<region>:<account>:<topic>
+	parts := strings.SplitN(topicARN, ":", 6)
+	if len(parts) < 6 {
+		return "", ""
+	}
+	success = fmt.Sprintf("sns/%s/%s/%s", parts[3], parts[4], parts[5])
+	return success, success + "/Failure"
+}
+
+// deliveries reads the delivery attempts of the last minutes from the
+// delivery status logs of a topic, newest first. A topic whose deliveries
+// are not logged has no log groups; it is not an error.
+func (s *Service) deliveries(ctx context.Context, topicARN string, params map[string]any) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("deliveries", topicARN, err)
+	}
+
+	since := DefaultDeliverySince
+	if v := params["since"]; v != nil && v != "" {
+		n, err := intParam(v)
+		if err != nil || n <= 0 {
+			return fail(core.NewValidationError("since", fmt.Sprint(v), "must be a number of minutes"))
+		}
+		since = n
+	}
+	success, failure := deliveryGroups(topicARN)
+	if success == "" {
+		return fail(core.NewValidationError("topic", topicARN, "is not a topic ARN"))
+	}
+
+	start := time.Now().Add(-time.Duration(since) * time.Minute)
+	var deliveries []Delivery
+	logged := 0
+	for _, group := range []string{success, failure} {
+		out, err := s.logs().FilterLogEvents(ctx, &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName: aws.String(group),
+			StartTime:    aws.Int64(start.UnixMilli()),
+			Limit:        aws.Int32(maxDeliveries),
+		})
+		var notFound *logtypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			continue
+		}
+		if err != nil {
+			return fail(err)
+		}
+		logged++
+		for _, event := range out.Events {
+			deliveries = append(deliveries, deliveryOf(event))
+		}
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool { return deliveries[i].Time.After(deliveries[j].Time) })
+
+	result := core.NewActionResult(true, fmt.Sprintf("%d deliveries of %s in the last %d minutes", len(deliveries), topicName(topicARN), since))
+	if logged == 0 {
+		result.Message = fmt.Sprintf("Delivery status logging is not enabled for %s", topicName(topicARN))
+	}
+	result.Data = deliveries
+	return result, nil
+}
+
+// deliveryOf parses a delivery status log event. Events that cannot be
+// parsed keep their text, and the time they were logged.
+func deliveryOf(event logtypes.FilteredLogEvent) Delivery {
+	d := Delivery{
+		Time:  time.UnixMilli(aws.ToInt64(event.Timestamp)),
+		Event: aws.ToString(event.Message),
+	}
+	var e deliveryEvent
+	if err := json.Unmarshal([]byte(d.Event), &e); err != nil {
+		return d
+	}
+	d.ID = e.Delivery.DeliveryID
+	d.MessageID = e.Notification.MessageID
+	d.Status = e.Status
+	d.Destination = e.Delivery.Destination
+	d.StatusCode = e.Delivery.StatusCode
+	d.ProviderResponse = e.Delivery.ProviderResponse
+	d.DwellTime = time.Duration(e.Delivery.DwellTimeMs) * time.Millisecond
+	d.Attempts = e.Delivery.Attempts
+	return d
+}
+
+// prettyJSON indents a JSON document, or returns text unchanged if it is not
+// one.
+func prettyJSON(text string) string {
+	var b bytes.Buffer
+	if err := json.Indent(&b, []byte(strings.TrimSpace(text)), "", "  "); err != nil {
+		return text
+	}
+	return b.String()
+}
+
+// formatDelivery renders a delivery attempt: its outcome, then the provider
+// response and the log event pretty-printed.
+func formatDelivery(d Delivery) string {
+	var b strings.Builder
+	if d.ID != "" {
+		fmt.Fprintf(&b, "Delivery:    %s\n", d.ID)
+	}
+	if d.MessageID != "" {
+		fmt.Fprintf(&b, "Message:     %s\n", d.MessageID)
+	}
+	fmt.Fprintf(&b, "Time:        %s\n", d.Time.Local().Format("2006-01-02 15:04:05"))
+	if d.Status != "" {
+		fmt.Fprintf(&b, "Status:      %s (%d)\n", d.Status, d.StatusCode)
+		fmt.Fprintf(&b, "Destination: %s\n", d.Destination)
+		fmt.Fprintf(&b, "Attempts:    %d\n", d.Attempts)
+		fmt.Fprintf(&b, "Dwell time:  %s\n", d.DwellTime)
+	}
+	if d.ProviderResponse != "" {
+		b.WriteString("\n" + i18n.T("Provider response:") + "\n")
+		fmt.Fprintf(&b, "%s\n", prettyJSON(d.ProviderResponse))
+	}
+	b.WriteString("\n" + i18n.T("Log event:") + "\n")
+	fmt.Fprintf(&b, "%s\n", prettyJSON(d.Event))
+	return b.String()
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
@@ -0,0 +1,610 @@
+// Package sns provides SNS integration for the a9s application. It lists
+// topics with their subscriptions by protocol, publishes test messages,
+// reads delivery status logs and removes subscriptions.
+package sns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+const (
+	// testMessage is the default body of test messages.
+	testMessage = "Test message from a9s"
+	// testGroup is the default message group of test messages to FIFO topics.
+	testGroup = "a9s-test"
+
+	// pendingConfirmation is the subscription ARN SNS reports until the
+	// endpoint confirms.
+	pendingConfirmation = "PendingConfirmation"
+)
+
+// List filters selecting the level of the hierarchy to list. Without a
+// topic, List returns topics; with one, the topic's subscriptions.
+const (
+	FilterTopic = "topic"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements SNS operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient SNSAPI
+	logsClient LogsAPI
+}
+
+// Option configures the SNS service.
+type Option func(*Service)
+
+// WithLogsClient sets a custom CloudWatch Logs client (for testing).
+func WithLogsClient(client LogsAPI) Option {
+	return func(s *Service) {
+		s.logsClient = client
+	}
+}
+
+// SNSAPI defines the SNS client interface for mocking.
+type SNSAPI interface {
+	ListTopics(ctx context.Context, params *sns.ListTopicsInput, optFns ...func(*sns.Options)) (*sns.ListTopicsOutput, error)
+	GetTopicAttributes(ctx context.Context, params *sns.GetTopicAttributesInput, optFns ...func(*sns.Options)) (*sns.GetTopicAttributesOutput, error)
+	ListSubscriptionsByTopic(ctx context.Context, params *sns.ListSubscriptionsByTopicInput, optFns ...func(*sns.Options)) (*sns.ListSubscriptionsByTopicOutput, error)
+	GetSubscriptionAttributes(ctx context.Context, params *sns.GetSubscriptionAttributesInput, optFns ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error)
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+	Unsubscribe(ctx context.Context, params *sns.UnsubscribeInput, optFns ...func(*sns.Options)) (*sns.UnsubscribeOutput, error)
+}
+
+// LogsAPI defines the CloudWatch Logs client interface delivery status
+// logs are read with, for mocking.
+type LogsAPI interface {
+	FilterLogEvents(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error)
+}
+
+// NewService creates a new SNS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SNSAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the SNS client for the current AWS context.
+func (s *Service) client() SNSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return sns.NewFromConfig(s.factory.Config())
+}
+
+// logs returns the CloudWatch Logs client for the current AWS context.
+func (s *Service) logs() LogsAPI {
+	if s.logsClient != nil {
+		return s.logsClient
+	}
+	return cloudwatchlogs.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region topics are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "sns"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "SNS Topics"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "bell"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListTopics(ctx, &sns.ListTopicsInput{})
+	if err != nil {
+		return core.NewServiceError("sns", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the topics of the region, or the subscriptions of the topic
+// in FilterTopic. ListTopics only returns ARNs, so each topic is enriched
+// with its subscription counts and protocols.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	var resources []core.Resource
+	var resourceType string
+	var err error
+	if topic := opts.Filters[FilterTopic]; topic != "" {
+		resourceType = "sns:subscription"
+		var subscriptions []types.Subscription
+		subscriptions, err = s.subscriptions(ctx, topic)
+		for _, sub := range subscriptions {
+			resources = append(resources, subscriptionToResource(sub, s.region()))
+		}
+	} else {
+		resourceType = "sns:topic"
+		resources, err = s.listTopics(ctx)
+	}
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("sns", "list", err)
+	}
+	if resources == nil {
+		resources = []core.Resource{}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: resourceType,
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// listTopics lists and enriches the region's topics.
+func (s *Service) listTopics(ctx context.Context) ([]core.Resource, error) {
+	var resources []core.Resource
+	paginator := sns.NewListTopicsPaginator(s.client(), &sns.ListTopicsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, topic := range page.Topics {
+			resource := topicToResource(aws.ToString(topic.TopicArn), s.region())
+			if err := s.EnrichResource(ctx, &resource); err != nil {
+				// The topic may have been deleted since it was listed
+				continue
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// subscriptions returns the subscriptions of a topic.
+func (s *Service) subscriptions(ctx context.Context, topicARN string) ([]types.Subscription, error) {
+	var subscriptions []types.Subscription
+	paginator := sns.NewListSubscriptionsByTopicPaginator(s.client(), &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, page.Subscriptions...)
+	}
+	return subscriptions, nil
+}
+
+// =============================================================================
+// ResourceEnricher Interface Implementation
+// =============================================================================
+
+// EnrichResource adds a topic's subscription counts, encryption and the
+// protocols it delivers to. Topics without subscriptions are flagged low,
+// since what is published to them is dropped.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	if resource.Type != "sns:topic" {
+		return nil
+	}
+
+	out, err := s.client().GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: aws.String(resource.ID)})
+	if err != nil {
+		return err
+	}
+	subscriptions, err := s.subscriptions(ctx, resource.ID)
+	if err != nil {
+		return err
+	}
+
+	attributes := out.Attributes
+	resource.Metadata["display_name"] = attributes["DisplayName"]
+	resource.Metadata["owner"] = attributes["Owner"]
+	resource.Metadata["kms_key"] = attributes["KmsMasterKeyId"]
+	resource.Metadata["encrypted"] = attributes["KmsMasterKeyId"] != ""
+	resource.Metadata["confirmed"] = atoi(attributes["SubscriptionsConfirmed"])
+	resource.Metadata["pending"] = atoi(attributes["SubscriptionsPending"])
+	resource.Metadata["protocols"] = protocols(subscriptions)
+	resource.Metadata["analyzed"] = true
+
+	resource.ClearIssues()
+	addTopicIssues(resource)
+	return nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for topics and their
+// subscriptions.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "publish",
+			Description: "Publish a test message to the topic",
+			Icon:        "send",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "test",
+			Parameters: []core.ActionParameter{
+				{Name: "message", Type: "string", Required: true, Default: testMessage, Description: "Message body"},
+				{Name: "subject", Type: "string", Default: "", Description: "Subject (email subscriptions only)"},
+				{Name: "group", Type: "string", Default: testGroup, Description: "Message group ID (FIFO topics only)"},
+			},
+		},
+		{
+			Name:        "deliveries",
+			Description: "Read the delivery status logs of the topic",
+			Icon:        "list",
+			Shortcut:    "l",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "since", Type: "int", Default: DefaultDeliverySince, Description: "Minutes of logs to read"},
+			},
+		},
+		{
+			Name:        "view_subscription",
+			Description: "View the delivery settings of the subscription",
+			Icon:        "eye",
+			Shortcut:    "enter",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "unsubscribe",
+			Description: "Delete the subscription",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a topic or subscription, identified
+// by its ARN. Publishing and deleting subscriptions ask for confirmation
+// through a core.ConfirmationError until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "publish":
+		message, _ := params["message"].(string)
+		if strings.TrimSpace(message) == "" {
+			message = testMessage
+		}
+		subject, _ := params["subject"].(string)
+		group, _ := params["group"].(string)
+		result, err = s.publish(ctx, resourceID, message, strings.TrimSpace(subject), strings.TrimSpace(group), params, confirmed)
+	case "deliveries":
+		result, err = s.deliveries(ctx, resourceID, params)
+	case "view_subscription":
+		result, err = s.viewSubscription(ctx, resourceID)
+	case "unsubscribe":
+		if !isSubscriptionARN(resourceID) {
+			return nil, core.NewValidationError("subscription", resourceID, "is pending confirmation and cannot be deleted until confirmed")
+		}
+		if !confirmed {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "The endpoint stops receiving the topic's messages; subscribing again needs a new confirmation", false)
+		}
+		result, err = s.unsubscribe(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// publish publishes a test message once confirmed, since it reaches every
+// confirmed subscriber, people included. FIFO topics need a message group
+// and get a unique deduplication ID, so repeated test messages are all
+// delivered.
+func (s *Service) publish(ctx context.Context, topicARN, message, subject, group string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("publish", topicARN, err)
+	}
+
+	if !confirmed {
+		subscriptions, err := s.subscriptions(ctx, topicARN)
+		if err != nil {
+			return fail(err)
+		}
+		confirmedCount := 0
+		for _, sub := range subscriptions {
+			if isSubscriptionARN(aws.ToString(sub.SubscriptionArn)) {
+				confirmedCount++
+			}
+		}
+		reason := fmt.Sprintf("Delivers the message to %d confirmed subscriptions of %s", confirmedCount, topicName(topicARN))
+		if p := protocols(subscriptions); len(p) > 0 {
+			reason += " (" + strings.Join(p, ", ") + ")"
+		}
+		return nil, core.NewConfirmationError(s, "publish", topicARN, params, reason, false)
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(topicARN),
+		Message:  aws.String(message),
+	}
+	if subject != "" {
+		input.Subject = aws.String(subject)
+	}
+	if strings.HasSuffix(topicARN, ".fifo") {
+		if group == "" {
+			group = testGroup
+		}
+		input.MessageGroupId = aws.String(group)
+		input.MessageDeduplicationId = aws.String(strconv.FormatInt(time.Now().UnixNano(), 36))
+	}
+
+	out, err := s.client().Publish(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Published message %s to %s", aws.ToString(out.MessageId), topicName(topicARN)))
+	result.Data = map[string]any{"message_id": aws.ToString(out.MessageId)}
+	return result, nil
+}
+
+// SubscriptionDetail is the result data of the view_subscription action.
+type SubscriptionDetail struct {
+	ARN        string
+	Attributes map[string]string
+}
+
+// viewSubscription reads the delivery settings of a subscription, such as
+// its filter policy, raw delivery and dead-letter queue.
+func (s *Service) viewSubscription(ctx context.Context, subscriptionARN string) (*core.ActionResult, error) {
+	if !isSubscriptionARN(subscriptionARN) {
+		err := core.NewValidationError("subscription", subscriptionARN, "is pending confirmation and has no settings yet")
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_subscription", subscriptionARN, err)
+	}
+
+	out, err := s.client().GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{
+		SubscriptionArn: aws.String(subscriptionARN),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view_subscription", subscriptionARN, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Settings of %s", subscriptionARN))
+	result.Data = SubscriptionDetail{ARN: subscriptionARN, Attributes: out.Attributes}
+	return result, nil
+}
+
+// unsubscribe deletes a subscription.
+func (s *Service) unsubscribe(ctx context.Context, subscriptionARN string) (*core.ActionResult, error) {
+	if _, err := s.client().Unsubscribe(ctx, &sns.UnsubscribeInput{
+		SubscriptionArn: aws.String(subscriptionARN),
+	}); err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("unsubscribe", subscriptionARN, err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   subscriptionARN,
+		ResourceType: "sns:subscription",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Subscription %s deleted", subscriptionARN)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func topicToResource(arn, region string) core.Resource {
+	resource := core.Resource{
+		ID:     arn,
+		Type:   "sns:topic",
+		Name:   topicName(arn),
+		ARN:    arn,
+		State:  core.StateAvailable,
+		Region: region,
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"fifo": strings.HasSuffix(arn, ".fifo"),
+		},
+	}
+	iac.Apply(&resource)
+	return resource
+}
+
+// subscriptionToResource converts a subscription. Pending subscriptions
+// have no ARN yet, so they are identified by protocol and endpoint.
+func subscriptionToResource(sub types.Subscription, region string) core.Resource {
+	arn := aws.ToString(sub.SubscriptionArn)
+	protocol, endpoint := aws.ToString(sub.Protocol), aws.ToString(sub.Endpoint)
+
+	resource := core.Resource{
+		ID:     arn,
+		Type:   "sns:subscription",
+		Name:   endpoint,
+		State:  "confirmed",
+		Region: region,
+		Tags:   make(map[string]string),
+		Metadata: map[string]any{
+			"protocol": protocol,
+			"endpoint": endpoint,
+			"owner":    aws.ToString(sub.Owner),
+			"topic":    aws.ToString(sub.TopicArn),
+			// ListSubscriptionsByTopic returns all there is to show
+			"analyzed": true,
+		},
+	}
+	if isSubscriptionARN(arn) {
+		resource.ARN = arn
+	} else {
+		resource.ID = arn + ":" + protocol + ":" + endpoint
+		resource.State = "pending"
+		resource.AddIssue(core.SeverityInfo, "Pending confirmation by the endpoint")
+	}
+	return resource
+}
+
+// addTopicIssues records the issues of an enriched topic.
+func addTopicIssues(resource *core.Resource) {
+	confirmed, _ := resource.Metadata["confirmed"].(int)
+	pending, _ := resource.Metadata["pending"].(int)
+	switch {
+	case confirmed == 0 && pending == 0:
+		resource.AddIssue(core.SeverityLow, "No subscriptions; published messages are dropped")
+	case confirmed == 0:
+		resource.AddIssue(core.SeverityLow, fmt.Sprintf("No confirmed subscriptions, %d pending", pending))
+	case pending > 0:
+		resource.AddIssue(core.SeverityInfo, fmt.Sprintf("%d subscriptions pending confirmation", pending))
+	}
+}
+
+// protocols returns the distinct protocols of subscriptions, sorted.
+func protocols(subscriptions []types.Subscription) []string {
+	var out []string
+	for _, sub := range subscriptions {
+		if p := aws.ToString(sub.Protocol); p != "" && !slices.Contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// isSubscriptionARN reports whether a subscription has been confirmed and
+// has an ARN.
+func isSubscriptionARN(id string) bool {
+	return strings.HasPrefix(id, "arn:")
+}
+
+// topicName returns the name of a topic from its ARN.
+func topicName(arn string) string {
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "sns", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "sns", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+	_ core.ActionExecutor   = (*Service)(nil)
+)
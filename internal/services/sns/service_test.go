@@ -0,0 +1,201 @@
+package sns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	testTopic        = "arn:aws:sns:us-east-1:123456789012:orders"
+	testSubscription = testTopic + ":3f2c6e1a-0b9d-4c5e-8a71-2d4f6b8c9e01"
+)
+
+// fakeSNS serves one topic with a confirmed and a pending subscription, or
+// fails every call when err is set.
+type fakeSNS struct {
+	err error
+}
+
+func (f *fakeSNS) ListTopics(context.Context, *sns.ListTopicsInput, ...func(*sns.Options)) (*sns.ListTopicsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sns.ListTopicsOutput{Topics: []types.Topic{{TopicArn: aws.String(testTopic)}}}, nil
+}
+
+func (f *fakeSNS) GetTopicAttributes(_ context.Context, in *sns.GetTopicAttributesInput, _ ...func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.TopicArn) != testTopic {
+		return nil, errors.New("NotFound: topic does not exist")
+	}
+	return &sns.GetTopicAttributesOutput{Attributes: map[string]string{
+		"DisplayName":            "Orders",
+		"SubscriptionsConfirmed": "1",
+		"SubscriptionsPending":   "1",
+	}}, nil
+}
+
+func (f *fakeSNS) ListSubscriptionsByTopic(_ context.Context, in *sns.ListSubscriptionsByTopicInput, _ ...func(*sns.Options)) (*sns.ListSubscriptionsByTopicOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.TopicArn) != testTopic {
+		return nil, errors.New("NotFound: topic does not exist")
+	}
+	return &sns.ListSubscriptionsByTopicOutput{Subscriptions: []types.Subscription{
+		{SubscriptionArn: aws.String(testSubscription), TopicArn: in.TopicArn, Protocol: aws.String("sqs"), Endpoint: aws.String("arn:aws:sqs:us-east-1:123456789012:orders")},
+		{SubscriptionArn: aws.String(pendingConfirmation), TopicArn: in.TopicArn, Protocol: aws.String("email"), Endpoint: aws.String("ops@example.com")},
+	}}, nil
+}
+
+func (f *fakeSNS) GetSubscriptionAttributes(_ context.Context, in *sns.GetSubscriptionAttributesInput, _ ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sns.GetSubscriptionAttributesOutput{Attributes: map[string]string{"SubscriptionArn": aws.ToString(in.SubscriptionArn)}}, nil
+}
+
+func (f *fakeSNS) Publish(_ context.Context, in *sns.PublishInput, _ ...func(*sns.Options)) (*sns.PublishOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if !strings.HasPrefix(aws.ToString(in.TopicArn), "arn:aws:sns:") {
+		return nil, errors.New("InvalidParameter: invalid topic ARN")
+	}
+	return &sns.PublishOutput{MessageId: aws.String("5b7c0d3e")}, nil
+}
+
+func (f *fakeSNS) Unsubscribe(context.Context, *sns.UnsubscribeInput, ...func(*sns.Options)) (*sns.UnsubscribeOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sns.UnsubscribeOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against topics.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSNS{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSNS{err: errors.New("AccessDenied")}, d)
+		},
+		ExistingID:    testTopic,
+		Action:        "publish",
+		ActionParams:  map[string]any{core.ParamConfirm: true},
+		ConfirmAction: "publish",
+	})
+}
+
+// TestSubscriptionConformance runs the core service contract against the
+// subscriptions of a topic.
+func TestSubscriptionConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSNS{}, d)
+		},
+		ListOptions:   core.ListOptions{Filters: map[string]string{FilterTopic: testTopic}},
+		ExistingID:    testSubscription,
+		Action:        "view_subscription",
+		ConfirmAction: "unsubscribe",
+	})
+}
+
+// fakeLogs serves delivery status log events by log group; other groups do
+// not exist.
+type fakeLogs struct {
+	groups map[string][]logtypes.FilteredLogEvent
+	read   []string
+}
+
+func (f *fakeLogs) FilterLogEvents(_ context.Context, in *cloudwatchlogs.FilterLogEventsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	group := aws.ToString(in.LogGroupName)
+	f.read = append(f.read, group)
+	events, ok := f.groups[group]
+	if !ok {
+		return nil, &logtypes.ResourceNotFoundException{Message: aws.String("The specified log group does not exist.")}
+	}
+	return &cloudwatchlogs.FilterLogEventsOutput{Events: events}, nil
+}
+
+func logEvent(at time.Time, message string) logtypes.FilteredLogEvent {
+	return logtypes.FilteredLogEvent{Timestamp: aws.Int64(at.UnixMilli()), Message: aws.String(message)}
+}
+
+// TestDeliveries checks that the attempts logged to both delivery status
+// log groups of a topic are parsed and returned newest first.
+func TestDeliveries(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	logs := &fakeLogs{groups: map[string][]logtypes.FilteredLogEvent{
+		"sns/us-east-1/123456789012/orders": {
+			logEvent(now.Add(-10*time.Minute), `{"notification":{"messageId":"m-1"},"delivery":{"deliveryId":"d-1","destination":"arn:aws:sqs:us-east-1:123456789012:orders","providerResponse":"{\"sqsRequestId\":\"r-1\"}","dwellTimeMs":42,"attempts":1,"statusCode":200},"status":"SUCCESS"}`),
+		},
+		"sns/us-east-1/123456789012/orders/Failure": {
+			logEvent(now.Add(-time.Minute), `{"notification":{"messageId":"m-2"},"delivery":{"deliveryId":"d-2","destination":"https://example.com/hook","providerResponse":"Connection refused","dwellTimeMs":61000,"attempts":3,"statusCode":0},"status":"FAILURE"}`),
+			logEvent(now.Add(-2*time.Minute), "not JSON"),
+		},
+	}}
+	svc := NewServiceWithClient(&fakeSNS{}, nil, WithLogsClient(logs))
+
+	result, err := svc.Execute(context.Background(), "deliveries", testTopic, nil)
+	if err != nil {
+		t.Fatalf("Execute(deliveries) error = %v", err)
+	}
+	deliveries, ok := result.Data.([]Delivery)
+	if !ok || len(deliveries) != 3 {
+		t.Fatalf("result data = %+v, want 3 deliveries", result.Data)
+	}
+
+	failed := deliveries[0]
+	if failed.ID != "d-2" || failed.MessageID != "m-2" || failed.Status != "FAILURE" || failed.Attempts != 3 || failed.DwellTime != 61*time.Second || failed.ProviderResponse != "Connection refused" {
+		t.Errorf("newest delivery = %+v, want the failed delivery d-2", failed)
+	}
+	if deliveries[1].Status != "" || deliveries[1].Event != "not JSON" || !deliveries[1].Time.Equal(now.Add(-2*time.Minute)) {
+		t.Errorf("unparsed event = %+v, want its text and time kept", deliveries[1])
+	}
+	if deliveries[2].ID != "d-1" || deliveries[2].StatusCode != 200 {
+		t.Errorf("oldest delivery = %+v, want the successful delivery d-1", deliveries[2])
+	}
+	if text := formatDelivery(deliveries[2]); !strings.Contains(text, `"sqsRequestId": "r-1"`) {
+		t.Errorf("formatDelivery() = %q, want the provider response pretty-printed", text)
+	}
+}
+
+// TestDeliveriesNotLogged checks that a topic without delivery status logs
+// reads as such rather than failing.
+func TestDeliveriesNotLogged(t *testing.T) {
+	logs := &fakeLogs{}
+	svc := NewServiceWithClient(&fakeSNS{}, nil, WithLogsClient(logs))
+
+	result, err := svc.Execute(context.Background(), "deliveries", testTopic, map[string]any{"since": "15"})
+	if err != nil {
+		t.Fatalf("Execute(deliveries) error = %v", err)
+	}
+	if deliveries, _ := result.Data.([]Delivery); len(deliveries) != 0 || !strings.Contains(result.Message, "not enabled") {
+		t.Errorf("result = %+v, want no deliveries and logging reported disabled", result)
+	}
+	want := []string{"sns/us-east-1/123456789012/orders", "sns/us-east-1/123456789012/orders/Failure"}
+	if strings.Join(logs.read, ",") != strings.Join(want, ",") {
+		t.Errorf("read log groups %v, want %v", logs.read, want)
+	}
+
+	var validation *core.ValidationError
+	if _, err := svc.Execute(context.Background(), "deliveries", testTopic, map[string]any{"since": "an hour"}); !errors.As(err, &validation) {
+		t.Errorf("Execute(deliveries) with an invalid window error = %v, want a validation error", err)
+	}
+}
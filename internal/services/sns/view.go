@@ -0,0 +1,555 @@
+package sns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const publishFormID = "sns:publish"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for SNS topics, drilling down into their
+// subscriptions.
+type View struct {
+	*base.TableView
+
+	formTarget string // Topic the publish form is open for
+
+	// Delivery attempts read from the logs, shown one at a time while their
+	// panel is open
+	deliveries   []Delivery
+	deliveryAt   int
+	deliveryFrom string
+}
+
+// NewView creates a new SNS view.
+func NewView() *View {
+	return &View{
+		TableView: base.NewTableView("SNS", "", "sns", topicColumns()),
+	}
+}
+
+func topicColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Topic"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 8, MaxWidth: 8, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Confirmed"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Pending"), MinWidth: 7, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Protocols"), MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 1},
+		{Title: i18n.T("Encrypted"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+}
+
+func subscriptionColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("Endpoint"), MinWidth: 15, MaxWidth: 80, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Protocol"), MinWidth: 8, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("State"), MinWidth: 9, MaxWidth: 10, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Owner"), MinWidth: 12, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 2},
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadResources()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled := v.updateInspector(msg); handled {
+		return v, nil
+	}
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if cmd, handled := v.handleKey(msg); handled {
+			return v, cmd
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != publishFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Publishing to %s...", topicName(v.formTarget))
+		cmds = append(cmds, v.executeAction("publish", v.formTarget, msg.Values))
+
+	case resourcesLoadedMsg:
+		// Listings of a level the operator has left are dropped
+		if msg.owner != v || msg.level != v.Breadcrumb() {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			if v.atTopics() {
+				v.Message = i18n.T("Loaded %d topics", len(msg.resources))
+			} else {
+				v.Message = i18n.T("Loaded %d subscriptions", len(msg.resources))
+			}
+		}
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		loading := i18n.T("Loading topics...")
+		if crumb := v.Breadcrumb(); crumb != "" {
+			loading = i18n.T("Loading %s...", crumb)
+		}
+		lines = append(lines, v.Styles.Muted.Render(loading))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	help := i18n.T("[Enter]subscriptions  [p]ublish test message  [l]delivery logs  [i]nfo  [r]efresh")
+	if !v.atTopics() {
+		help = i18n.T("[Enter]settings  [d]elete subscription  [Esc]back  [r]efresh")
+	}
+	lines = append(lines, v.Styles.Help.Render(help))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the current level.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadResources()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type resourcesLoadedMsg struct {
+	owner     *View  // Listings of a swapped-out view are dropped
+	level     string // Breadcrumb of the level listed
+	resources []core.Resource
+	err       error
+}
+
+// atTopics reports whether the topics are shown rather than the
+// subscriptions of one.
+func (v *View) atTopics() bool {
+	return v.DrillFilters()[FilterTopic] == ""
+}
+
+// handleKey handles the keys of the current level.
+func (v *View) handleKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	row := v.GetSelectedResource()
+	if row == nil {
+		return nil, false
+	}
+
+	if v.atTopics() {
+		switch msg.String() {
+		case "enter":
+			v.DrillDown(base.DrillLevel{
+				Title:      row.Name,
+				Filters:    map[string]string{FilterTopic: row.ID},
+				ColumnDefs: subscriptionColumns(),
+			})
+			return v.loadResources(), true
+		case "p":
+			return v.openPublishForm(row), true
+		case "l":
+			v.Message = i18n.T("Reading the delivery logs of %s...", row.Name)
+			return v.executeAction("deliveries", row.ID, nil), true
+		case "i":
+			v.deliveries = nil
+			v.OpenDetail(i18n.T("Topic %s", row.Name), formatTopic(*row))
+			return nil, true
+		}
+		return nil, false
+	}
+
+	switch msg.String() {
+	case "enter":
+		if !isSubscriptionARN(row.ID) {
+			v.OpenDetail(i18n.T("Subscription %s", row.Name), formatSubscription(*row, nil))
+			return nil, true
+		}
+		v.Message = i18n.T("Reading the settings of %s...", row.Name)
+		return v.executeAction("view_subscription", row.ID, nil), true
+	case "d":
+		v.Message = i18n.T("Deleting the subscription of %s...", row.Name)
+		return v.executeAction("unsubscribe", row.ID, nil), true
+	}
+	return nil, false
+}
+
+func (v *View) loadResources() tea.Cmd {
+	v.SetLoading(true)
+	level, filters := v.Breadcrumb(), v.DrillFilters()
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return resourcesLoadedMsg{owner: v, level: level, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{Filters: filters})
+		return resourcesLoadedMsg{owner: v, level: level, resources: resources, err: err}
+	}
+}
+
+// openPublishForm asks for the test message to publish to a topic.
+func (v *View) openPublishForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "publish")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "publish")
+		return nil
+	}
+	params := def.Parameters
+	if fifo, _ := r.Metadata["fifo"].(bool); !fifo {
+		params = slices.DeleteFunc(slices.Clone(params), func(p core.ActionParameter) bool { return p.Name == "group" })
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(publishFormID, i18n.T("Publish to %s", r.Name), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	if deliveries, ok := msg.Result.Data.([]Delivery); ok {
+		v.inspect(msg.Result.Message, deliveries)
+		return nil
+	}
+	if detail, ok := msg.Result.Data.(SubscriptionDetail); ok {
+		v.deliveries = nil
+		for _, r := range v.Resources {
+			if r.ID == detail.ARN {
+				v.OpenDetail(i18n.T("Subscription %s", r.Name), formatSubscription(r, detail.Attributes))
+				break
+			}
+		}
+		return nil
+	}
+	if msg.Action == "unsubscribe" {
+		return v.loadResources()
+	}
+	return nil
+}
+
+// inspect opens the panel showing delivery attempts, newest first.
+func (v *View) inspect(title string, deliveries []Delivery) {
+	if len(deliveries) == 0 {
+		v.deliveries = nil
+		v.OpenDetail(title, i18n.T("No deliveries were logged in that time.\n"))
+		return
+	}
+	topic := ""
+	if row := v.GetSelectedResource(); row != nil {
+		topic = row.Name
+	}
+	v.deliveries, v.deliveryAt, v.deliveryFrom = deliveries, 0, topic
+	v.showDelivery()
+}
+
+// showDelivery shows the current delivery attempt.
+func (v *View) showDelivery() {
+	d := v.deliveries[v.deliveryAt]
+	help := i18n.T("[←/→] delivery  [y] copy provider response  [Y] copy log event")
+	v.OpenDetail(i18n.T("Delivery %d of %d for %s", v.deliveryAt+1, len(v.deliveries), v.deliveryFrom), help+"\n\n"+formatDelivery(d))
+}
+
+// updateInspector handles the keys of the delivery panel. It returns false
+// for keys meant for the detail panel, such as scrolling.
+func (v *View) updateInspector(msg tea.Msg) bool {
+	if _, ok := msg.(components.DetailClosedMsg); ok {
+		v.deliveries = nil
+		return false
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || len(v.deliveries) == 0 || !v.CapturingInput() {
+		return false
+	}
+
+	d := v.deliveries[v.deliveryAt]
+	switch key.String() {
+	case "left", "h":
+		if v.deliveryAt > 0 {
+			v.deliveryAt--
+			v.showDelivery()
+		}
+	case "right", "l":
+		if v.deliveryAt < len(v.deliveries)-1 {
+			v.deliveryAt++
+			v.showDelivery()
+		}
+	case "y":
+		components.CopyToClipboard(d.ProviderResponse)
+		v.Message = i18n.T("Copied the provider response of delivery %s", d.ID)
+	case "Y":
+		components.CopyToClipboard(prettyJSON(d.Event))
+		v.Message = i18n.T("Copied the log event of delivery %s", d.ID)
+	default:
+		return false
+	}
+	return true
+}
+
+func (v *View) updateTable() {
+	buildRow := buildSubscriptionRow
+	if v.atTopics() {
+		buildRow = buildTopicRow
+	}
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildTopicRow(r core.Resource) base.Row {
+	confirmed, _ := r.Metadata["confirmed"].(int)
+	pending, _ := r.Metadata["pending"].(int)
+	protocols, _ := r.Metadata["protocols"].([]string)
+
+	kind := "Standard"
+	if fifo, _ := r.Metadata["fifo"].(bool); fifo {
+		kind = "FIFO"
+	}
+	encrypted := "-"
+	if enabled, _ := r.Metadata["encrypted"].(bool); enabled {
+		encrypted = "✓"
+	}
+	protocolsText := "-"
+	if len(protocols) > 0 {
+		protocolsText = strings.Join(protocols, ", ")
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(kind),
+		base.LazyCell(confirmed, func() string { return fmt.Sprintf("%d", confirmed) }),
+		base.LazyCell(pending, func() string { return fmt.Sprintf("%d", pending) }),
+		base.TextCell(protocolsText),
+		base.TextCell(encrypted),
+		base.SeverityCell(r),
+	}
+}
+
+func buildSubscriptionRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 80)),
+		base.TextCell(r.GetMetadataString("protocol")),
+		base.TextCell(r.State),
+		base.TextCell(r.GetMetadataString("owner")),
+		base.SeverityCell(r),
+	}
+}
+
+// formatTopic renders a topic for the detail panel.
+func formatTopic(r core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:           %s\n", r.ARN)
+	if name := r.GetMetadataString("display_name"); name != "" {
+		fmt.Fprintf(&b, "Display name:  %s\n", name)
+	}
+	fmt.Fprintf(&b, "Owner:         %s\n", r.GetMetadataString("owner"))
+	confirmed, _ := r.Metadata["confirmed"].(int)
+	pending, _ := r.Metadata["pending"].(int)
+	fmt.Fprintf(&b, "Subscriptions: %d confirmed, %d pending\n", confirmed, pending)
+	if protocols, _ := r.Metadata["protocols"].([]string); len(protocols) > 0 {
+		fmt.Fprintf(&b, "Protocols:     %s\n", strings.Join(protocols, ", "))
+	}
+	if key := r.GetMetadataString("kms_key"); key != "" {
+		fmt.Fprintf(&b, "KMS key:       %s\n", key)
+	} else {
+		b.WriteString("KMS key:       none\n")
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// subscriptionSettings are the subscription attributes worth showing, in
+// order; the others repeat the listing.
+var subscriptionSettings = []string{
+	"RawMessageDelivery",
+	"FilterPolicyScope",
+	"FilterPolicy",
+	"RedrivePolicy",
+	"DeliveryPolicy",
+	"SubscriptionRoleArn",
+	"ConfirmationWasAuthenticated",
+}
+
+// formatSubscription renders a subscription with its settings, when read,
+// for the detail panel.
+func formatSubscription(r core.Resource, attributes map[string]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Endpoint:  %s\n", r.GetMetadataString("endpoint"))
+	fmt.Fprintf(&b, "Protocol:  %s\n", r.GetMetadataString("protocol"))
+	fmt.Fprintf(&b, "Topic:     %s\n", r.GetMetadataString("topic"))
+	fmt.Fprintf(&b, "State:     %s\n", r.State)
+	if r.ARN != "" {
+		fmt.Fprintf(&b, "ARN:       %s\n", r.ARN)
+	}
+
+	if len(attributes) > 0 {
+		b.WriteString(i18n.T("\nSettings:\n"))
+		for _, key := range subscriptionSettings {
+			if value := attributes[key]; value != "" {
+				fmt.Fprintf(&b, "  %s: %s\n", key, value)
+			}
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider. Topic widgets stay empty,
+// and are skipped, among subscriptions, and the other way around.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	var subscriptions, unsubscribed, pending string
+	if v.atTopics() {
+		confirmedCount, unsubscribedCount := 0, 0
+		for _, r := range v.Resources {
+			confirmed, _ := r.Metadata["confirmed"].(int)
+			confirmedCount += confirmed
+			if confirmed == 0 {
+				unsubscribedCount++
+			}
+		}
+		subscriptions = i18n.T("Subscriptions: %d", confirmedCount)
+		unsubscribed = i18n.T("Without subscribers: %d", unsubscribedCount)
+	} else {
+		pendingCount := 0
+		for _, r := range v.Resources {
+			if r.State == "pending" {
+				pendingCount++
+			}
+		}
+		pending = i18n.T("Pending: %d", pendingCount)
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "subscriptions", Text: subscriptions, Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unsubscribed", Text: unsubscribed, Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "pending", Text: pending, Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	title := i18n.T("SNS Topics")
+	if crumb := v.Breadcrumb(); crumb != "" {
+		title = "SNS › " + crumb
+	}
+	return v.RenderSummary(title, v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "sns" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
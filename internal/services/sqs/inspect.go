@@ -0,0 +1,127 @@
+package sqs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keanuharrell/a9s/internal/i18n"
+)
+
+// =============================================================================
+// Message Inspection
+// =============================================================================
+
+// snsNotification is the envelope SNS wraps messages in when delivering to
+// a queue without raw message delivery.
+type snsNotification struct {
+	Type              string
+	MessageId         string
+	TopicArn          string
+	Subject           string
+	Message           string
+	Timestamp         string
+	MessageAttributes map[string]struct {
+		Type  string
+		Value string
+	}
+}
+
+// parseNotification returns the SNS envelope of a body, if it is one.
+func parseNotification(body string) (snsNotification, bool) {
+	var n snsNotification
+	if err := json.Unmarshal([]byte(body), &n); err != nil {
+		return n, false
+	}
+	return n, n.Type == "Notification" && n.TopicArn != ""
+}
+
+// prettyJSON indents a JSON document, or returns text unchanged if it is not
+// one.
+func prettyJSON(text string) string {
+	var b bytes.Buffer
+	if err := json.Indent(&b, []byte(strings.TrimSpace(text)), "", "  "); err != nil {
+		return text
+	}
+	return b.String()
+}
+
+// formatMessage renders a peeked message: its attributes, then its body
+// pretty-printed, unwrapped from its SNS envelope if it has one.
+func formatMessage(m Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID:       %s\n", m.ID)
+	fmt.Fprintf(&b, "Sent:     %s\n", m.SentAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(&b, "Receives: %d\n", m.ReceiveCount)
+	if m.Source != "" {
+		fmt.Fprintf(&b, "From:     %s\n", m.Source)
+	}
+	writeAttributes(&b, i18n.T("Message attributes:"), m.Attributes)
+	writeAttributes(&b, i18n.T("System attributes:"), m.System)
+
+	body := prettyJSON(m.Body)
+	if n, ok := parseNotification(m.Body); ok {
+		b.WriteString("\n" + i18n.T("SNS notification from %s", arnName(n.TopicArn)) + "\n")
+		fmt.Fprintf(&b, "  MessageId = %s\n", n.MessageId)
+		if t, err := time.Parse(time.RFC3339, n.Timestamp); err == nil {
+			fmt.Fprintf(&b, "  Published = %s\n", t.Local().Format("2006-01-02 15:04:05"))
+		}
+		if n.Subject != "" {
+			fmt.Fprintf(&b, "  Subject = %s\n", n.Subject)
+		}
+		attributes := make(map[string]string, len(n.MessageAttributes))
+		for key, value := range n.MessageAttributes {
+			attributes[key] = value.Value
+		}
+		writeAttributes(&b, i18n.T("Notification attributes:"), attributes)
+		body = prettyJSON(n.Message)
+	}
+
+	if len(body) > maxBody {
+		body = body[:maxBody] + i18n.T("… (%d more bytes)", len(body)-maxBody)
+	}
+	fmt.Fprintf(&b, "\n%s\n", body)
+	return b.String()
+}
+
+func writeAttributes(b *strings.Builder, title string, attributes map[string]string) {
+	if len(attributes) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	b.WriteString(title + "\n")
+	for _, key := range keys {
+		fmt.Fprintf(b, "  %s = %s\n", key, attributes[key])
+	}
+}
+
+// messageJSON renders a message as the JSON document copied by [Y], with a
+// JSON body embedded as is.
+func messageJSON(m Message) string {
+	doc := struct {
+		MessageId         string            `json:"MessageId"`
+		SentTimestamp     time.Time         `json:"SentTimestamp"`
+		ReceiveCount      int               `json:"ApproximateReceiveCount"`
+		Source            string            `json:"DeadLetterQueueSource,omitempty"`
+		MessageAttributes map[string]string `json:"MessageAttributes,omitempty"`
+		Attributes        map[string]string `json:"Attributes,omitempty"`
+		Body              any               `json:"Body"`
+	}{m.ID, m.SentAt.UTC(), m.ReceiveCount, m.Source, m.Attributes, m.System, m.Body}
+	if json.Valid([]byte(m.Body)) {
+		doc.Body = json.RawMessage(m.Body)
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return m.Body
+	}
+	return string(out)
+}
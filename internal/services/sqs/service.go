@@ -0,0 +1,989 @@
+// Package sqs provides Amazon SQS integration for the a9s application.
+// It lists queues with their depth, the age of their oldest message and
+// their dead-letter queue relationships, peeks at messages, sends test
+// messages, purges queues and redrives dead-lettered messages to their
+// source queues.
+package sqs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+const (
+	// maxPeek is the most messages a single ReceiveMessage call returns.
+	maxPeek = 10
+	// maxRedriveRate is the highest rate StartMessageMoveTask accepts.
+	maxRedriveRate = 500
+	// maxDelay is the longest delay SendMessage accepts, in seconds.
+	maxDelay = 900
+
+	// ageLookback covers a few of the 5-minute periods SQS publishes its
+	// metrics at, so a late datapoint still shows up.
+	ageLookback = 30 * time.Minute
+	// agePeriod is the CloudWatch period of the oldest message's age.
+	agePeriod = 300
+	// maxMetricQueries is the most queries one GetMetricData call takes.
+	maxMetricQueries = 500
+	// expiringShare is the share of its retention period past which the
+	// oldest message of a queue is about to expire, in percent.
+	expiringShare = 75
+
+	// testBody is the default body of test messages.
+	testBody = `{"source":"a9s","test":true}`
+	// testGroup is the default message group of test messages sent to FIFO
+	// queues.
+	testGroup = "a9s-test"
+)
+
+// Message move task statuses.
+const (
+	TaskRunning    = "RUNNING"
+	TaskCompleted  = "COMPLETED"
+	TaskCancelling = "CANCELLING"
+	TaskCancelled  = "CANCELLED"
+	TaskFailed     = "FAILED"
+)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements SQS operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    SQSAPI
+	metricsClient CloudWatchAPI
+}
+
+// Option configures the SQS service.
+type Option func(*Service)
+
+// WithMetricsClient sets the CloudWatch client the age of queues' oldest
+// message is read from (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// SQSAPI defines the SQS client interface for mocking.
+type SQSAPI interface {
+	ListQueues(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+	ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error)
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	StartMessageMoveTask(ctx context.Context, params *sqs.StartMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error)
+	ListMessageMoveTasks(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)
+	CancelMessageMoveTask(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)
+	PurgeQueue(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)
+	SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// NewService creates a new SQS service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SQSAPI, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the SQS client for the current AWS context.
+func (s *Service) client() SQSAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return sqs.NewFromConfig(s.factory.Config())
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "sqs"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "SQS Queues"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "queue"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().ListQueues(ctx, &sqs.ListQueuesInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("sqs", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the queues of the region with the age of their oldest
+// message. Dead-letter queues carry their source queues and their latest
+// redrive.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	client := s.client()
+
+	var resources []core.Resource
+	paginator := sqs.NewListQueuesPaginator(client, &sqs.ListQueuesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("sqs", "list", err)
+		}
+		for _, url := range page.QueueUrls {
+			attributes, err := s.attributes(ctx, url)
+			if err != nil {
+				// The queue may have been deleted since it was listed
+				continue
+			}
+			resources = append(resources, queueToResource(url, attributes))
+		}
+	}
+
+	linkDeadLetterQueues(resources)
+
+	// Ages are best effort: without CloudWatch access the column stays empty
+	if ages, err := s.oldestAges(ctx, resources, time.Now()); err == nil {
+		for i := range resources {
+			if age, ok := ages[resources[i].Name]; ok {
+				resources[i].Metadata["oldest_age"] = age
+				flagExpiring(&resources[i])
+			}
+		}
+	}
+
+	for i := range resources {
+		r := &resources[i]
+		if sources, _ := r.Metadata["sources"].([]string); len(sources) == 0 {
+			continue
+		}
+		if task, ok, err := s.latestTask(ctx, r.ARN); err == nil && ok {
+			task.apply(r)
+		}
+		flagDeadLetterQueue(r)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "sqs:queue",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for queues.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "peek",
+			Description: "Peek at messages without removing them",
+			Icon:        "eye",
+			Shortcut:    "p",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "count", Type: "int", Default: maxPeek, Description: "Messages to peek at (1-10)"},
+			},
+		},
+		{
+			Name:        "send",
+			Description: "Send a test message",
+			Icon:        "send",
+			Shortcut:    "s",
+			Dangerous:   false,
+			Category:    "test",
+			Parameters: []core.ActionParameter{
+				{Name: "body", Type: "string", Required: true, Default: testBody, Description: "Message body"},
+				{Name: "group", Type: "string", Default: testGroup, Description: "Message group ID (FIFO queues only)"},
+				{Name: "delay", Type: "int", Default: 0, Description: "Delay in seconds (0-900, standard queues only)"},
+			},
+		},
+		{
+			Name:        "purge",
+			Description: "Delete every message of the queue",
+			Icon:        "trash",
+			Shortcut:    "X",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+		{
+			Name:        "redrive",
+			Description: "Move dead-lettered messages back to their source queue",
+			Icon:        "redo",
+			Shortcut:    "m",
+			Dangerous:   true,
+			Category:    "lifecycle",
+			Parameters: []core.ActionParameter{
+				{Name: "destination", Type: "string", Description: "Destination queue name or ARN (empty for the source queues)"},
+				{Name: "rate", Type: "int", Default: 0, Description: "Messages per second (0 for the fastest, up to 500)"},
+			},
+		},
+		{
+			Name:        "redrive_status",
+			Description: "Show the progress of the latest redrive",
+			Icon:        "hourglass",
+			Shortcut:    "w",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "cancel_redrive",
+			Description: "Cancel the running redrive",
+			Icon:        "stop",
+			Shortcut:    "x",
+			Dangerous:   false,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a queue, identified by its URL.
+// Purges and redrives ask for confirmation through a core.ConfirmationError
+// until the "confirm" parameter is set.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "peek":
+		count := maxPeek
+		if v, ok := params["count"]; ok {
+			count, err = intParam(v)
+			if err != nil || count < 1 || count > maxPeek {
+				return nil, core.NewValidationError("count", v, "must be between 1 and 10")
+			}
+		}
+		result, err = s.peek(ctx, resourceID, count)
+	case "send":
+		body, _ := params["body"].(string)
+		if strings.TrimSpace(body) == "" {
+			return nil, core.NewValidationError("body", body, "cannot be empty")
+		}
+		group, _ := params["group"].(string)
+		delay := 0
+		if v, ok := params["delay"]; ok {
+			delay, err = intParam(v)
+			if err != nil || delay < 0 || delay > maxDelay {
+				return nil, core.NewValidationError("delay", v, "must be between 0 and 900")
+			}
+		}
+		result, err = s.send(ctx, resourceID, body, strings.TrimSpace(group), delay)
+	case "purge":
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.purge(ctx, resourceID, params, confirmed)
+	case "redrive":
+		destination, _ := params["destination"].(string)
+		rate := 0
+		if v, ok := params["rate"]; ok {
+			rate, err = intParam(v)
+			if err != nil || rate < 0 || rate > maxRedriveRate {
+				return nil, core.NewValidationError("rate", v, "must be between 0 and 500")
+			}
+		}
+		confirmed, _ := params[core.ParamConfirm].(bool)
+		result, err = s.redrive(ctx, resourceID, strings.TrimSpace(destination), rate, params, confirmed)
+	case "redrive_status":
+		result, err = s.redriveStatus(ctx, resourceID)
+	case "cancel_redrive":
+		result, err = s.cancelRedrive(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// Message is a peeked message.
+type Message struct {
+	ID           string
+	Body         string
+	SentAt       time.Time
+	ReceiveCount int
+	Source       string            // Queue the message was dead-lettered from, when known
+	Attributes   map[string]string // Message attributes, binary ones base64-encoded
+	System       map[string]string // Remaining system attributes, such as SenderId
+}
+
+// peek receives messages with a zero visibility timeout, so they stay
+// visible to consumers. Receiving counts toward a queue's maxReceiveCount,
+// so queues that dead-letter their own messages are not peeked.
+func (s *Service) peek(ctx context.Context, url string, count int) (*core.ActionResult, error) {
+	attributes, err := s.attributes(ctx, url)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("peek", url, err)
+	}
+	if target, _ := redrivePolicy(attributes); target != "" {
+		err = core.NewValidationError("queue", queueName(url), fmt.Sprintf("sends messages to %s after repeated receives; peeking could dead-letter them", arnName(target)))
+		return core.NewActionResult(false, err.Error()), core.NewActionError("peek", url, err)
+	}
+
+	out, err := s.client().ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:                    aws.String(url),
+		MaxNumberOfMessages:         int32(count),
+		VisibilityTimeout:           0,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
+		MessageAttributeNames:       []string{"All"},
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("peek", url, err)
+	}
+
+	messages := make([]Message, 0, len(out.Messages))
+	for _, m := range out.Messages {
+		messages = append(messages, messageOf(m))
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Peeked at %d messages in %s", len(messages), queueName(url)))
+	result.Data = messages
+	return result, nil
+}
+
+// send sends a test message. FIFO queues need a message group and get a
+// unique deduplication ID, so repeated test messages are all delivered;
+// they do not support per-message delays.
+func (s *Service) send(ctx context.Context, url, body, group string, delay int) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("send", url, err)
+	}
+
+	attributes, err := s.attributes(ctx, url)
+	if err != nil {
+		return fail(err)
+	}
+	name := queueName(url)
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(url),
+		MessageBody: aws.String(body),
+	}
+	if attributes[string(types.QueueAttributeNameFifoQueue)] == "true" {
+		if delay > 0 {
+			return fail(core.NewValidationError("delay", delay, "is not supported by FIFO queues"))
+		}
+		if group == "" {
+			group = testGroup
+		}
+		input.MessageGroupId = aws.String(group)
+		input.MessageDeduplicationId = aws.String(fmt.Sprintf("a9s-%d", time.Now().UnixNano()))
+	} else {
+		input.DelaySeconds = int32(delay)
+	}
+
+	out, err := s.client().SendMessage(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Sent message %s to %s", aws.ToString(out.MessageId), name))
+	result.Data = aws.ToString(out.MessageId)
+	return result, nil
+}
+
+// purge deletes every message of a queue. SQS allows one purge per queue
+// every 60 seconds and may take that long to delete the messages.
+func (s *Service) purge(ctx context.Context, url string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("purge", url, err)
+	}
+
+	attributes, err := s.attributes(ctx, url)
+	if err != nil {
+		return fail(err)
+	}
+	name := queueName(url)
+	messages := atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)]) +
+		atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesNotVisible)]) +
+		atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesDelayed)])
+	if messages == 0 {
+		return fail(core.NewValidationError("queue", name, "has no messages to purge"))
+	}
+
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "purge", url, params, fmt.Sprintf("Deletes about %d messages from %s, in flight and delayed ones included; they cannot be recovered", messages, name), false)
+	}
+
+	if _, err := s.client().PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: aws.String(url)}); err != nil {
+		var inProgress *types.PurgeQueueInProgress
+		if errors.As(err, &inProgress) {
+			err = core.NewValidationError("queue", name, "was purged less than 60 seconds ago")
+		}
+		return fail(err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Purging about %d messages from %s", messages, name)), nil
+}
+
+func (s *Service) redrive(ctx context.Context, url, destination string, rate int, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("redrive", url, err)
+	}
+
+	attributes, err := s.attributes(ctx, url)
+	if err != nil {
+		return fail(err)
+	}
+	arn := attributes[string(types.QueueAttributeNameQueueArn)]
+	name := queueName(url)
+
+	sources, err := s.sourceQueues(ctx, url)
+	if err != nil {
+		return fail(err)
+	}
+	if len(sources) == 0 && destination == "" {
+		return fail(core.NewValidationError("queue", name, "is not the dead-letter queue of any queue; choose a destination"))
+	}
+	messages := atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)])
+	if messages == 0 {
+		return fail(core.NewValidationError("queue", name, "has no messages to redrive"))
+	}
+	if task, ok, err := s.latestTask(ctx, arn); err == nil && ok && task.Active() {
+		return fail(core.NewValidationError("queue", name, fmt.Sprintf("already has a redrive %s", strings.ToLower(task.Status))))
+	}
+
+	target := strings.Join(sources, ", ")
+	var destinationARN *string
+	if destination != "" {
+		if !strings.HasPrefix(destination, "arn:") {
+			destination = arn[:strings.LastIndex(arn, ":")+1] + destination
+		}
+		destinationARN = aws.String(destination)
+		target = arnName(destination)
+	}
+
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "redrive", url, params, fmt.Sprintf("Moves about %d messages from %s to %s", messages, name, target), false)
+	}
+
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn:      aws.String(arn),
+		DestinationArn: destinationARN,
+	}
+	if rate > 0 {
+		input.MaxNumberOfMessagesPerSecond = aws.Int32(int32(rate))
+	}
+	out, err := s.client().StartMessageMoveTask(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Redriving about %d messages from %s to %s", messages, name, target))
+	result.Data = aws.ToString(out.TaskHandle)
+	return result, nil
+}
+
+func (s *Service) redriveStatus(ctx context.Context, url string) (*core.ActionResult, error) {
+	task, err := s.taskOf(ctx, url)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("redrive_status", url, err)
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("Redrive of %s: %s", queueName(url), task.Progress()))
+	result.Data = task
+	return result, nil
+}
+
+func (s *Service) cancelRedrive(ctx context.Context, url string) (*core.ActionResult, error) {
+	task, err := s.taskOf(ctx, url)
+	if err == nil && task.Status != TaskRunning {
+		err = core.NewValidationError("queue", queueName(url), "has no running redrive")
+	}
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("cancel_redrive", url, err)
+	}
+
+	out, err := s.client().CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(task.Handle),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("cancel_redrive", url, err)
+	}
+
+	return core.NewActionResult(true, fmt.Sprintf("Canceled the redrive of %s after %d messages", queueName(url), out.ApproximateNumberOfMessagesMoved)), nil
+}
+
+// =============================================================================
+// Redrive Tasks
+// =============================================================================
+
+// RedriveTask is a message move task out of a dead-letter queue.
+type RedriveTask struct {
+	Handle      string
+	Status      string
+	Moved       int64
+	ToMove      int64 // Zero when unknown
+	Destination string
+	Failure     string
+	Started     time.Time
+}
+
+// Active reports whether the task is still moving messages.
+func (t RedriveTask) Active() bool {
+	return t.Status == TaskRunning || t.Status == TaskCancelling
+}
+
+// Progress describes the task's status and how many messages it moved.
+func (t RedriveTask) Progress() string {
+	progress := fmt.Sprintf("%s, %d", strings.ToLower(t.Status), t.Moved)
+	if t.ToMove > 0 {
+		progress += fmt.Sprintf(" of %d", t.ToMove)
+	}
+	progress += " messages moved"
+	if t.Failure != "" {
+		progress += ": " + t.Failure
+	}
+	return progress
+}
+
+// apply records the task in a queue's metadata.
+func (t RedriveTask) apply(r *core.Resource) {
+	r.Metadata["redrive_status"] = t.Status
+	r.Metadata["redrive_progress"] = t.Progress()
+	r.Metadata["redrive_moved"] = t.Moved
+	r.Metadata["redrive_to_move"] = t.ToMove
+}
+
+// taskOf returns the latest redrive out of a queue.
+func (s *Service) taskOf(ctx context.Context, url string) (RedriveTask, error) {
+	attributes, err := s.attributes(ctx, url)
+	if err != nil {
+		return RedriveTask{}, err
+	}
+	task, ok, err := s.latestTask(ctx, attributes[string(types.QueueAttributeNameQueueArn)])
+	if err != nil {
+		return RedriveTask{}, err
+	}
+	if !ok {
+		return RedriveTask{}, core.NewValidationError("queue", queueName(url), "has never been redriven")
+	}
+	return task, nil
+}
+
+// latestTask returns the most recent message move task out of a queue.
+func (s *Service) latestTask(ctx context.Context, arn string) (RedriveTask, bool, error) {
+	out, err := s.client().ListMessageMoveTasks(ctx, &sqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String(arn),
+		MaxResults: aws.Int32(1),
+	})
+	if err != nil || len(out.Results) == 0 {
+		return RedriveTask{}, false, err
+	}
+	entry := out.Results[0]
+	return RedriveTask{
+		Handle:      aws.ToString(entry.TaskHandle),
+		Status:      aws.ToString(entry.Status),
+		Moved:       entry.ApproximateNumberOfMessagesMoved,
+		ToMove:      aws.ToInt64(entry.ApproximateNumberOfMessagesToMove),
+		Destination: aws.ToString(entry.DestinationArn),
+		Failure:     aws.ToString(entry.FailureReason),
+		Started:     time.UnixMilli(entry.StartedTimestamp),
+	}, true, nil
+}
+
+// =============================================================================
+// Oldest Messages
+// =============================================================================
+
+// oldestAges returns the age in seconds of the oldest message of each queue
+// holding messages, keyed by queue name, from the
+// ApproximateAgeOfOldestMessage metric. Queues are batched into as few
+// GetMetricData calls as possible.
+func (s *Service) oldestAges(ctx context.Context, resources []core.Resource, now time.Time) (map[string]int, error) {
+	var names []string
+	for _, r := range resources {
+		if messages, _ := r.Metadata["messages"].(int); messages > 0 {
+			names = append(names, r.Name)
+		}
+	}
+
+	ages := make(map[string]int, len(names))
+	for start := 0; start < len(names); start += maxMetricQueries {
+		batch := names[start:min(start+maxMetricQueries, len(names))]
+		queries := make([]cwtypes.MetricDataQuery, 0, len(batch))
+		for i, name := range batch {
+			queries = append(queries, cwtypes.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("q%d", i)),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Namespace:  aws.String("AWS/SQS"),
+						MetricName: aws.String("ApproximateAgeOfOldestMessage"),
+						Dimensions: []cwtypes.Dimension{
+							{Name: aws.String("QueueName"), Value: aws.String(name)},
+						},
+					},
+					Period: aws.Int32(agePeriod),
+					Stat:   aws.String("Maximum"),
+				},
+			})
+		}
+
+		paginator := cloudwatch.NewGetMetricDataPaginator(s.metrics(), &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(now.Add(-ageLookback)),
+			EndTime:           aws.Time(now),
+			MetricDataQueries: queries,
+			ScanBy:            cwtypes.ScanByTimestampDescending,
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, result := range page.MetricDataResults {
+				i := atoi(strings.TrimPrefix(aws.ToString(result.Id), "q"))
+				if len(result.Values) == 0 || i >= len(batch) {
+					continue
+				}
+				// Values come newest first
+				if _, seen := ages[batch[i]]; !seen {
+					ages[batch[i]] = int(result.Values[0])
+				}
+			}
+		}
+	}
+	return ages, nil
+}
+
+// flagExpiring raises an issue when a queue's oldest message is close to
+// the end of its retention period, as it is then deleted unprocessed.
+func flagExpiring(r *core.Resource) {
+	age, _ := r.Metadata["oldest_age"].(int)
+	retention, _ := r.Metadata["retention"].(int)
+	if retention == 0 || age*100 < retention*expiringShare {
+		return
+	}
+	r.AddIssue(core.SeverityMedium, fmt.Sprintf("Oldest message is %s old, close to the %s retention: messages expire unprocessed", formatAge(age), formatDuration(retention)))
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) attributes(ctx context.Context, url string) (map[string]string, error) {
+	out, err := s.client().GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(url),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameAll},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Attributes, nil
+}
+
+// sourceQueues returns the names of the queues dead-lettering to a queue.
+func (s *Service) sourceQueues(ctx context.Context, url string) ([]string, error) {
+	var names []string
+	paginator := sqs.NewListDeadLetterSourceQueuesPaginator(s.client(), &sqs.ListDeadLetterSourceQueuesInput{
+		QueueUrl: aws.String(url),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, source := range page.QueueUrls {
+			names = append(names, queueName(source))
+		}
+	}
+	return names, nil
+}
+
+func queueToResource(url string, attributes map[string]string) core.Resource {
+	name := queueName(url)
+	fifo := attributes[string(types.QueueAttributeNameFifoQueue)] == "true"
+
+	resource := core.Resource{
+		ID:    url,
+		Type:  "sqs:queue",
+		Name:  name,
+		ARN:   attributes[string(types.QueueAttributeNameQueueArn)],
+		State: core.StateAvailable,
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"url":       url,
+			"fifo":      fifo,
+			"messages":  atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)]),
+			"in_flight": atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesNotVisible)]),
+			"delayed":   atoi(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesDelayed)]),
+			"retention": atoi(attributes[string(types.QueueAttributeNameMessageRetentionPeriod)]),
+		},
+	}
+	if fifo {
+		resource.Metadata["queue_type"] = "FIFO"
+	} else {
+		resource.Metadata["queue_type"] = "Standard"
+	}
+	if target, maxReceives := redrivePolicy(attributes); target != "" {
+		resource.Metadata["dlq_arn"] = target
+		resource.Metadata["dlq"] = arnName(target)
+		resource.Metadata["max_receive_count"] = maxReceives
+	}
+	if created := atoi(attributes[string(types.QueueAttributeNameCreatedTimestamp)]); created > 0 {
+		t := time.Unix(int64(created), 0)
+		resource.CreatedAt = &t
+		estimate.ApplyAge(&resource, time.Now())
+	}
+	return resource
+}
+
+// linkDeadLetterQueues records on each dead-letter queue the queues that
+// send it their messages.
+func linkDeadLetterQueues(resources []core.Resource) {
+	byARN := make(map[string]*core.Resource, len(resources))
+	for i := range resources {
+		byARN[resources[i].ARN] = &resources[i]
+	}
+	for _, r := range resources {
+		target, _ := r.Metadata["dlq_arn"].(string)
+		dlq, ok := byARN[target]
+		if !ok {
+			continue
+		}
+		sources, _ := dlq.Metadata["sources"].([]string)
+		dlq.Metadata["sources"] = append(sources, r.Name)
+		retention, _ := r.Metadata["retention"].(int)
+		if current, _ := dlq.Metadata["source_retention"].(int); retention > current {
+			dlq.Metadata["source_retention"] = retention
+		}
+	}
+}
+
+// flagDeadLetterQueue raises the issues of a dead-letter queue.
+func flagDeadLetterQueue(r *core.Resource) {
+	if messages, _ := r.Metadata["messages"].(int); messages > 0 {
+		r.AddIssue(core.SeverityMedium, fmt.Sprintf("%d dead-lettered messages", messages))
+	}
+	// Messages keep their original enqueue time in a standard dead-letter
+	// queue, so a shorter retention expires them early
+	retention, _ := r.Metadata["retention"].(int)
+	sourceRetention, _ := r.Metadata["source_retention"].(int)
+	if fifo, _ := r.Metadata["fifo"].(bool); !fifo && retention <= sourceRetention {
+		r.AddIssue(core.SeverityLow, fmt.Sprintf("Retention of %s does not exceed its source queues' %s: messages may expire before they are redriven", formatDuration(retention), formatDuration(sourceRetention)))
+	}
+}
+
+// redrivePolicy returns the dead-letter queue and receive limit of a queue.
+func redrivePolicy(attributes map[string]string) (string, int) {
+	raw := attributes[string(types.QueueAttributeNameRedrivePolicy)]
+	if raw == "" {
+		return "", 0
+	}
+	var policy struct {
+		DeadLetterTargetArn string          `json:"deadLetterTargetArn"`
+		MaxReceiveCount     json.RawMessage `json:"maxReceiveCount"`
+	}
+	if err := json.Unmarshal([]byte(raw), &policy); err != nil {
+		return "", 0
+	}
+	// The limit is a number or a string depending on how it was set
+	return policy.DeadLetterTargetArn, atoi(strings.Trim(string(policy.MaxReceiveCount), `"`))
+}
+
+func messageOf(m types.Message) Message {
+	message := Message{
+		ID:         aws.ToString(m.MessageId),
+		Body:       aws.ToString(m.Body),
+		Attributes: make(map[string]string),
+		System:     make(map[string]string),
+	}
+	for key, value := range m.Attributes {
+		switch key {
+		case string(types.MessageSystemAttributeNameSentTimestamp):
+			message.SentAt = time.UnixMilli(int64(atoi(value)))
+		case string(types.MessageSystemAttributeNameApproximateReceiveCount):
+			message.ReceiveCount = atoi(value)
+		case string(types.MessageSystemAttributeNameDeadLetterQueueSourceArn):
+			message.Source = arnName(value)
+		case string(types.MessageSystemAttributeNameApproximateFirstReceiveTimestamp):
+			message.System[key] = time.UnixMilli(int64(atoi(value))).UTC().Format(time.RFC3339)
+		default:
+			message.System[key] = value
+		}
+	}
+	for key, value := range m.MessageAttributes {
+		if value.BinaryValue != nil {
+			message.Attributes[key] = base64.StdEncoding.EncodeToString(value.BinaryValue)
+		} else {
+			message.Attributes[key] = aws.ToString(value.StringValue)
+		}
+	}
+	return message
+}
+
+func queueName(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+func arnName(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func intParam(v any) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		return int(n), nil
+	case string:
+		if strings.TrimSpace(n) == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+// formatDuration formats a retention period in days or hours.
+func formatDuration(seconds int) string {
+	d := time.Duration(seconds) * time.Second
+	if d >= 24*time.Hour {
+		return fmt.Sprintf("%gd", d.Hours()/24)
+	}
+	return fmt.Sprintf("%gh", d.Hours())
+}
+
+// formatAge formats the age of a message in its largest unit.
+func formatAge(seconds int) string {
+	switch d := time.Duration(seconds) * time.Second; {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "sqs", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "sqs", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
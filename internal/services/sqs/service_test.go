@@ -0,0 +1,248 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+const (
+	ordersURL = "https://sqs.us-east-1.amazonaws.com/123456789012/orders"
+	dlqURL    = "https://sqs.us-east-1.amazonaws.com/123456789012/orders-dlq"
+	dlqARN    = "arn:aws:sqs:us-east-1:123456789012:orders-dlq"
+)
+
+// fakeSQS serves the orders queue, holding 3 messages and dead-lettering
+// to orders-dlq after 5 receives, and orders-dlq, holding 12 messages.
+// Both keep messages 4 days. It fails every call when err is set, returns
+// tasks as the redrives of orders-dlq and records the messages received.
+type fakeSQS struct {
+	err      error
+	tasks    []types.ListMessageMoveTasksResultEntry
+	received []string
+}
+
+func (f *fakeSQS) ListQueues(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sqs.ListQueuesOutput{QueueUrls: []string{ordersURL, dlqURL}}, nil
+}
+
+func (f *fakeSQS) GetQueueAttributes(_ context.Context, in *sqs.GetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	switch aws.ToString(in.QueueUrl) {
+	case ordersURL:
+		return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+			"QueueArn":                    "arn:aws:sqs:us-east-1:123456789012:orders",
+			"ApproximateNumberOfMessages": "3",
+			"MessageRetentionPeriod":      "345600",
+			"RedrivePolicy":               `{"deadLetterTargetArn":"` + dlqARN + `","maxReceiveCount":"5"}`,
+		}}, nil
+	case dlqURL:
+		return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+			"QueueArn":                    dlqARN,
+			"ApproximateNumberOfMessages": "12",
+			"MessageRetentionPeriod":      "345600",
+		}}, nil
+	}
+	return nil, &types.QueueDoesNotExist{Message: aws.String("The specified queue does not exist.")}
+}
+
+func (f *fakeSQS) ListDeadLetterSourceQueues(_ context.Context, in *sqs.ListDeadLetterSourceQueuesInput, _ ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.QueueUrl) != dlqURL {
+		return &sqs.ListDeadLetterSourceQueuesOutput{}, nil
+	}
+	return &sqs.ListDeadLetterSourceQueuesOutput{QueueUrls: []string{ordersURL}}, nil
+}
+
+func (f *fakeSQS) ReceiveMessage(_ context.Context, in *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.received = append(f.received, aws.ToString(in.QueueUrl))
+	return &sqs.ReceiveMessageOutput{Messages: []types.Message{{
+		MessageId: aws.String("m-1"),
+		Body:      aws.String(`{"order":42}`),
+		Attributes: map[string]string{
+			"ApproximateReceiveCount":  "5",
+			"DeadLetterQueueSourceArn": "arn:aws:sqs:us-east-1:123456789012:orders",
+		},
+	}}}, nil
+}
+
+func (f *fakeSQS) StartMessageMoveTask(context.Context, *sqs.StartMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sqs.StartMessageMoveTaskOutput{TaskHandle: aws.String("task-1")}, nil
+}
+
+func (f *fakeSQS) ListMessageMoveTasks(_ context.Context, in *sqs.ListMessageMoveTasksInput, _ ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if aws.ToString(in.SourceArn) != dlqARN {
+		return &sqs.ListMessageMoveTasksOutput{}, nil
+	}
+	return &sqs.ListMessageMoveTasksOutput{Results: f.tasks}, nil
+}
+
+func (f *fakeSQS) CancelMessageMoveTask(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sqs.CancelMessageMoveTaskOutput{}, nil
+}
+
+func (f *fakeSQS) PurgeQueue(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sqs.PurgeQueueOutput{}, nil
+}
+
+func (f *fakeSQS) SendMessage(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sqs.SendMessageOutput{MessageId: aws.String("m-2")}, nil
+}
+
+// fakeCloudWatch reports the oldest message of orders, the first queue
+// holding messages, 3.5 days old and that of orders-dlq a minute old.
+type fakeCloudWatch struct{}
+
+func (fakeCloudWatch) GetMetricData(context.Context, *cloudwatch.GetMetricDataInput, ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: []cwtypes.MetricDataResult{
+		{Id: aws.String("q0"), Values: []float64{302400, 302100}},
+		{Id: aws.String("q1"), Values: []float64{60}},
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against queues.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSQS{}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSQS{err: errors.New("AccessDenied")}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		ExistingID:    dlqURL,
+		Action:        "peek",
+		ActionParams:  map[string]any{"count": "2"},
+		ConfirmAction: "redrive",
+	})
+}
+
+func TestListLinksDeadLetterQueues(t *testing.T) {
+	fake := &fakeSQS{tasks: []types.ListMessageMoveTasksResultEntry{{
+		TaskHandle:                        aws.String("task-0"),
+		Status:                            aws.String(TaskCompleted),
+		ApproximateNumberOfMessagesMoved:  40,
+		ApproximateNumberOfMessagesToMove: aws.Int64(40),
+	}}}
+	resources, err := NewServiceWithClient(fake, nil, WithMetricsClient(fakeCloudWatch{})).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("List() = %d queues, want 2", len(resources))
+	}
+	orders, dlq := resources[0], resources[1]
+
+	if orders.Metadata["dlq"] != "orders-dlq" || orders.Metadata["max_receive_count"] != 5 {
+		t.Errorf("orders dead-letter queue = %v after %v receives", orders.Metadata["dlq"], orders.Metadata["max_receive_count"])
+	}
+	if issues := orders.Issues(); len(issues) != 1 || !strings.HasPrefix(issues[0].Message, "Oldest message is 3d old, close to the 4d retention") {
+		t.Errorf("orders issues = %v, want its messages about to expire", issues)
+	}
+
+	if sources, _ := dlq.Metadata["sources"].([]string); len(sources) != 1 || sources[0] != "orders" {
+		t.Errorf("orders-dlq sources = %v", dlq.Metadata["sources"])
+	}
+	if dlq.Metadata["redrive_progress"] != "completed, 40 of 40 messages moved" {
+		t.Errorf("orders-dlq redrive = %v", dlq.Metadata["redrive_progress"])
+	}
+	want := []string{
+		"12 dead-lettered messages",
+		"Retention of 4d does not exceed its source queues' 4d: messages may expire before they are redriven",
+	}
+	issues := dlq.Issues()
+	if len(issues) != len(want) {
+		t.Fatalf("orders-dlq issues = %v, want %q", issues, want)
+	}
+	for i := range want {
+		if issues[i].Message != want[i] {
+			t.Errorf("orders-dlq issue %d = %q, want %q", i, issues[i].Message, want[i])
+		}
+	}
+}
+
+// TestPeekSkipsRedrivenQueues checks that a queue with a redrive policy is
+// not peeked at, as receiving its messages counts toward dead-lettering
+// them.
+func TestPeekSkipsRedrivenQueues(t *testing.T) {
+	fake := &fakeSQS{}
+	svc := NewServiceWithClient(fake, nil)
+
+	if _, err := svc.Execute(context.Background(), "peek", ordersURL, nil); err == nil || !strings.Contains(err.Error(), "sends messages to orders-dlq") {
+		t.Errorf("peek orders error = %v", err)
+	}
+	result, err := svc.Execute(context.Background(), "peek", dlqURL, nil)
+	if err != nil {
+		t.Fatalf("peek orders-dlq error = %v", err)
+	}
+	if len(fake.received) != 1 || fake.received[0] != dlqURL {
+		t.Errorf("received from %v, want orders-dlq only", fake.received)
+	}
+	if messages := result.Data.([]Message); messages[0].Source != "orders" || messages[0].ReceiveCount != 5 {
+		t.Errorf("peeked %+v", messages[0])
+	}
+}
+
+func TestRedriveWaitsForRunningTask(t *testing.T) {
+	fake := &fakeSQS{tasks: []types.ListMessageMoveTasksResultEntry{{
+		TaskHandle:                       aws.String("task-0"),
+		Status:                           aws.String(TaskRunning),
+		ApproximateNumberOfMessagesMoved: 7,
+	}}}
+	svc := NewServiceWithClient(fake, nil)
+
+	_, err := svc.Execute(context.Background(), "redrive", dlqURL, map[string]any{core.ParamConfirm: true})
+	if err == nil || !strings.Contains(err.Error(), "already has a redrive running") {
+		t.Errorf("redrive error = %v", err)
+	}
+	result, err := svc.Execute(context.Background(), "redrive_status", dlqURL, nil)
+	if err != nil || result.Message != "Redrive of orders-dlq: running, 7 messages moved" {
+		t.Errorf("redrive_status = %v, %v", result, err)
+	}
+}
+
+func TestFormatMessageUnwrapsNotifications(t *testing.T) {
+	body := `{"Type":"Notification","MessageId":"n-1","TopicArn":"arn:aws:sns:us-east-1:123456789012:alerts",` +
+		`"Subject":"Disk","Message":"{\"level\":\"warn\"}","Timestamp":"2026-01-02T03:04:05Z"}`
+	text := formatMessage(Message{ID: "m-1", Body: body})
+
+	for _, want := range []string{"SNS notification from alerts", "Subject = Disk", "\"level\": \"warn\""} {
+		if !strings.Contains(text, want) {
+			t.Errorf("formatMessage() = %q, want %q in it", text, want)
+		}
+	}
+}
@@ -0,0 +1,524 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	peekFormID    = "sqs:peek"
+	sendFormID    = "sqs:send"
+	redriveFormID = "sqs:redrive"
+
+	// pollInterval paces redrive progress checks.
+	pollInterval = 5 * time.Second
+	// maxBody bounds how much of a message body the peek panel shows.
+	maxBody = 2000
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for SQS queues.
+type View struct {
+	*base.TableView
+
+	formTarget string // Queue the open form is for
+	watching   string // Queue whose redrive progress is polled
+
+	// Peeked messages, shown one at a time while their panel is open
+	peeked    []Message
+	peekedAt  int
+	peekQueue string
+}
+
+// NewView creates a new SQS view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 60, Weight: 2.0, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 8, MaxWidth: 8, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Messages"), MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("In Flight"), MinWidth: 9, MaxWidth: 10, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Oldest"), MinWidth: 6, MaxWidth: 8, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("Dead-letter"), MinWidth: 12, MaxWidth: 50, Weight: 1.2, Priority: 1},
+		{Title: i18n.T("Redrive"), MinWidth: 10, MaxWidth: 40, Weight: 0.8, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+	}
+
+	return &View{
+		TableView: base.NewTableView("SQS", "", "sqs", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadQueues()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled := v.updateInspector(msg); handled {
+		return v, nil
+	}
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(peekFormID, "peek", i18n.T("Peek at %s", row.Name), row)
+			}
+		case "s":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(sendFormID, "send", i18n.T("Send a test message to %s", row.Name), row)
+			}
+		case "X":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Purging %s...", row.Name)
+				return v, v.executeAction("purge", row.ID, nil)
+			}
+		case "m":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openForm(redriveFormID, "redrive", i18n.T("Redrive %s", row.Name), row)
+			}
+		case "x":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Canceling the redrive of %s...", row.Name)
+				return v, v.executeAction("cancel_redrive", row.ID, nil)
+			}
+		case "w":
+			if row := v.GetSelectedResource(); row != nil {
+				v.watching = row.ID
+				return v, v.executeAction("redrive_status", row.ID, nil)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.peeked = nil
+				v.OpenDetail(i18n.T("Queue %s", row.Name), formatQueue(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != peekFormID && msg.ID != sendFormID && msg.ID != redriveFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		switch msg.ID {
+		case peekFormID:
+			v.Message = i18n.T("Peeking at %s...", queueName(v.formTarget))
+			cmds = append(cmds, v.executeAction("peek", v.formTarget, msg.Values))
+		case sendFormID:
+			v.Message = i18n.T("Sending a test message to %s...", queueName(v.formTarget))
+			cmds = append(cmds, v.executeAction("send", v.formTarget, msg.Values))
+		default:
+			v.Message = i18n.T("Starting the redrive of %s...", queueName(v.formTarget))
+			cmds = append(cmds, v.executeAction("redrive", v.formTarget, msg.Values))
+		}
+
+	case redrivePollMsg:
+		if msg.owner == v && msg.queue == v.watching {
+			cmds = append(cmds, v.executeAction("redrive_status", msg.queue, nil))
+		}
+
+	case queuesLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d queues", len(msg.resources))
+			cmds = append(cmds, v.watchRunning())
+		}
+
+	case base.ActionResultMsg:
+		cmds = append(cmds, v.handleResult(msg))
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading queues...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[p]eek  [s]end test  [X] purge  [m]ove back (redrive)  [w]atch redrive  [x] cancel redrive  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the queues.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadQueues()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type queuesLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+// redrivePollMsg asks for the progress of the watched redrive.
+type redrivePollMsg struct {
+	owner *View
+	queue string
+}
+
+func (v *View) loadQueues() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return queuesLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return queuesLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return queuesLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) openForm(formID, action, title string, r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), action)
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", action)
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(formID, title, def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+// handleResult shows an action's outcome and keeps polling a running
+// redrive until it ends.
+func (v *View) handleResult(msg base.ActionResultMsg) tea.Cmd {
+	if msg.Error != nil {
+		if msg.Action == "redrive_status" {
+			v.watching = ""
+		}
+		v.Message = i18n.T("Action failed: %v", msg.Error)
+		return nil
+	}
+	if msg.Result == nil {
+		return nil
+	}
+	v.Message = msg.Result.Message
+
+	switch msg.Action {
+	case "peek":
+		if messages, ok := msg.Result.Data.([]Message); ok {
+			v.inspect(msg.Result.Message, queueName(v.formTarget), messages)
+		}
+		return nil
+	case "redrive":
+		v.watching = v.formTarget
+		return v.poll()
+	case "redrive_status":
+		task, ok := msg.Result.Data.(RedriveTask)
+		if !ok {
+			return nil
+		}
+		v.showTask(task)
+		if task.Active() {
+			return v.poll()
+		}
+		v.watching = ""
+		return v.loadQueues()
+	}
+	return v.loadQueues()
+}
+
+// poll schedules the next progress check of the watched redrive.
+func (v *View) poll() tea.Cmd {
+	if v.watching == "" {
+		return nil
+	}
+	msg := redrivePollMsg{owner: v, queue: v.watching}
+	return tea.Tick(pollInterval, func(time.Time) tea.Msg { return msg })
+}
+
+// watchRunning polls the first listed redrive still running, when none is
+// watched yet.
+func (v *View) watchRunning() tea.Cmd {
+	if v.watching != "" {
+		return nil
+	}
+	for _, r := range v.Resources {
+		if r.GetMetadataString("redrive_status") == TaskRunning {
+			v.watching = r.ID
+			return v.poll()
+		}
+	}
+	return nil
+}
+
+// showTask updates the row of the watched queue with its redrive progress.
+func (v *View) showTask(task RedriveTask) {
+	for i := range v.Resources {
+		if v.Resources[i].ID == v.watching {
+			task.apply(&v.Resources[i])
+			v.RefreshRow(i)
+			return
+		}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 60)),
+		base.TextCell(r.GetMetadataString("queue_type")),
+		base.TextCell(fmt.Sprint(r.Metadata["messages"])),
+		base.TextCell(fmt.Sprint(r.Metadata["in_flight"])),
+		base.TextCell(oldest(r)),
+		base.TextCell(deadLetter(r)),
+		base.TextCell(orDash(r.GetMetadataString("redrive_progress"))),
+		base.SeverityCell(r),
+		base.AgeCell(r),
+	}
+}
+
+// deadLetter describes a queue's dead-letter relationship: where it sends
+// failed messages, or which queues send theirs to it.
+func deadLetter(r core.Resource) string {
+	if sources, _ := r.Metadata["sources"].([]string); len(sources) > 0 {
+		return "← " + strings.Join(sources, ", ")
+	}
+	if dlq := r.GetMetadataString("dlq"); dlq != "" {
+		return "→ " + dlq
+	}
+	return "-"
+}
+
+// oldest returns the age of a queue's oldest message, or a dash when the
+// queue is empty or CloudWatch has no datapoint for it.
+func oldest(r core.Resource) string {
+	age, ok := r.Metadata["oldest_age"].(int)
+	if !ok {
+		return "-"
+	}
+	return formatAge(age)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatQueue renders a queue's counters and dead-letter relationship for
+// the detail panel.
+func formatQueue(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "URL:        %s\n", r.ID)
+	fmt.Fprintf(&b, "ARN:        %s\n", r.ARN)
+	fmt.Fprintf(&b, "Type:       %s\n", r.GetMetadataString("queue_type"))
+	fmt.Fprintf(&b, "Messages:   %v available, %v in flight, %v delayed\n", r.Metadata["messages"], r.Metadata["in_flight"], r.Metadata["delayed"])
+	if _, ok := r.Metadata["oldest_age"].(int); ok {
+		fmt.Fprintf(&b, "Oldest:     %s\n", oldest(*r))
+	}
+	retention, _ := r.Metadata["retention"].(int)
+	fmt.Fprintf(&b, "Retention:  %s\n", formatDuration(retention))
+	if dlq := r.GetMetadataString("dlq"); dlq != "" {
+		fmt.Fprintf(&b, "Dead-letter queue: %s after %v receives\n", dlq, r.Metadata["max_receive_count"])
+	}
+	if sources, _ := r.Metadata["sources"].([]string); len(sources) > 0 {
+		fmt.Fprintf(&b, "Dead-letter queue of: %s\n", strings.Join(sources, ", "))
+	}
+	if progress := r.GetMetadataString("redrive_progress"); progress != "" {
+		fmt.Fprintf(&b, "Latest redrive: %s\n", progress)
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// inspect opens the panel showing peeked messages, oldest first.
+func (v *View) inspect(title, queue string, messages []Message) {
+	if len(messages) == 0 {
+		v.peeked = nil
+		v.OpenDetail(title, i18n.T("No messages are visible right now.\n"))
+		return
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].SentAt.Before(messages[j].SentAt) })
+	v.peeked, v.peekedAt, v.peekQueue = messages, 0, queue
+	v.showMessage()
+}
+
+// showMessage shows the current peeked message.
+func (v *View) showMessage() {
+	m := v.peeked[v.peekedAt]
+	help := i18n.T("[←/→] message  [y] copy body  [Y] copy message as JSON")
+	v.OpenDetail(i18n.T("Message %d of %d in %s", v.peekedAt+1, len(v.peeked), v.peekQueue), help+"\n\n"+formatMessage(m))
+}
+
+// updateInspector handles the keys of the peeked messages panel. It returns
+// false for keys meant for the detail panel, such as scrolling.
+func (v *View) updateInspector(msg tea.Msg) bool {
+	if _, ok := msg.(components.DetailClosedMsg); ok {
+		v.peeked = nil
+		return false
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || len(v.peeked) == 0 || !v.CapturingInput() {
+		return false
+	}
+
+	m := v.peeked[v.peekedAt]
+	switch key.String() {
+	case "left", "h":
+		if v.peekedAt > 0 {
+			v.peekedAt--
+			v.showMessage()
+		}
+	case "right", "l":
+		if v.peekedAt < len(v.peeked)-1 {
+			v.peekedAt++
+			v.showMessage()
+		}
+	case "y":
+		components.CopyToClipboard(m.Body)
+		v.Message = i18n.T("Copied the body of message %s", m.ID)
+	case "Y":
+		components.CopyToClipboard(messageJSON(m))
+		v.Message = i18n.T("Copied message %s as JSON", m.ID)
+	default:
+		return false
+	}
+	return true
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	deadLettered := 0
+	for _, r := range v.Resources {
+		if sources, _ := r.Metadata["sources"].([]string); len(sources) > 0 {
+			n, _ := r.Metadata["messages"].(int)
+			deadLettered += n
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "dead-lettered", Text: i18n.T("Dead-lettered messages: %d", deadLettered), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("SQS Queues"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "sqs" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -0,0 +1,419 @@
+// Package ssm provides SSM Parameter Store integration for the a9s
+// application. It lists parameters with their type, tier and last change,
+// optionally under a path prefix, shows values (SecureString values only
+// once confirmed), puts new versions and deletes parameters.
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// FilterPath is the list filter selecting the parameters whose name begins
+// with its value, such as "/prod/" or "/app/db".
+const FilterPath = "path"
+
+// pricePerAdvanced is the monthly price of an advanced parameter, as in
+// us-east-1. Standard parameters are free.
+const pricePerAdvanced = 0.05
+
+// secretName matches names of parameters likely to hold a secret.
+var secretName = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[_-]?key|private[_-]?key|credential)`)
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements SSM Parameter Store operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient SSMAPI
+}
+
+// SSMAPI defines the SSM client interface for mocking.
+type SSMAPI interface {
+	DescribeParameters(ctx context.Context, params *ssm.DescribeParametersInput, optFns ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
+	GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+	DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
+}
+
+// NewService creates a new SSM Parameter Store service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client SSMAPI, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the SSM client for the current AWS context.
+func (s *Service) client() SSMAPI {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return ssm.NewFromConfig(s.factory.Config())
+}
+
+// region returns the region parameters are listed in.
+func (s *Service) region() string {
+	if s.factory == nil {
+		return ""
+	}
+	return s.factory.Region()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "ssm"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "SSM Parameters"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "sliders"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeParameters(ctx, &ssm.DescribeParametersInput{MaxResults: aws.Int32(1)})
+	if err != nil {
+		return core.NewServiceError("ssm", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the parameters of the region, only those whose name begins
+// with the FilterPath filter when set. Values are not listed.
+func (s *Service) List(ctx context.Context, opts core.ListOptions) ([]core.Resource, error) {
+	input := &ssm.DescribeParametersInput{}
+	if path := strings.TrimSpace(opts.Filters[FilterPath]); path != "" && path != "/" {
+		input.ParameterFilters = []types.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("BeginsWith"),
+			Values: []string{path},
+		}}
+	}
+
+	now := time.Now()
+	resources := make([]core.Resource, 0)
+	paginator := ssm.NewDescribeParametersPaginator(s.client(), input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("ssm", "list", err)
+		}
+		for _, param := range page.Parameters {
+			resources = append(resources, s.parameterToResource(param, now))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ssm:parameter",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for parameters.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "view",
+			Description: "View the value of the parameter",
+			Icon:        "eye",
+			Shortcut:    "v",
+			Dangerous:   false,
+			Category:    "inspect",
+		},
+		{
+			Name:        "put",
+			Description: "Put a new version of the parameter",
+			Icon:        "edit",
+			Shortcut:    "p",
+			Dangerous:   true,
+			Category:    "configuration",
+			Parameters: []core.ActionParameter{
+				{Name: "value", Type: "string", Required: true, Description: "New value of the parameter", Sensitive: true},
+			},
+		},
+		{
+			Name:        "delete",
+			Description: "Delete the parameter and all its versions",
+			Icon:        "trash",
+			Shortcut:    "d",
+			Dangerous:   true,
+			Category:    "lifecycle",
+		},
+	}
+}
+
+// Execute runs the specified action on a parameter, identified by its name.
+// Viewing a SecureString value, putting a version and deleting ask for
+// confirmation through a core.ConfirmationError until the "confirm"
+// parameter is set. Values are left out of the events hooks receive.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     s.redact(action, params),
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	confirmed, _ := params[core.ParamConfirm].(bool)
+	switch action {
+	case "view":
+		result, err = s.view(ctx, resourceID, params, confirmed)
+	case "put":
+		value, _ := params["value"].(string)
+		if value == "" {
+			return nil, core.NewValidationError("value", value, "must not be empty")
+		}
+		result, err = s.put(ctx, resourceID, value, params, confirmed)
+	case "delete":
+		if !core.Confirmed(params, resourceID, true) {
+			return nil, core.NewConfirmationError(s, action, resourceID, params, "Deletes every version of the parameter; this cannot be undone", true)
+		}
+		result, err = s.delete(ctx, resourceID)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+	result.Duration = time.Since(start)
+
+	logged := *result
+	logged.Data = nil
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     &logged,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+// ParameterValue is the result data of the view action.
+type ParameterValue struct {
+	Name     string
+	Type     string
+	Version  int64
+	Modified time.Time
+	DataType string
+	Value    string
+}
+
+// view reads the value of a parameter. SecureString values are decrypted
+// once confirmed.
+func (s *Service) view(ctx context.Context, name string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	fail := func(err error) (*core.ActionResult, error) {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("view", name, err)
+	}
+
+	out, err := s.client().GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name)})
+	if err != nil {
+		return fail(err)
+	}
+	param := out.Parameter
+	if param.Type == types.ParameterTypeSecureString {
+		if !confirmed {
+			return nil, core.NewConfirmationError(s, "view", name, params, "Shows the decrypted SecureString value on screen", false)
+		}
+		out, err = s.client().GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name), WithDecryption: aws.Bool(true)})
+		if err != nil {
+			return fail(err)
+		}
+		param = out.Parameter
+	}
+
+	result := core.NewActionResult(true, fmt.Sprintf("%s, version %d", name, param.Version))
+	result.Data = ParameterValue{
+		Name:     name,
+		Type:     string(param.Type),
+		Version:  param.Version,
+		Modified: aws.ToTime(param.LastModifiedDate),
+		DataType: aws.ToString(param.DataType),
+		Value:    aws.ToString(param.Value),
+	}
+	return result, nil
+}
+
+// put writes a new version of a parameter, keeping its type, once
+// confirmed.
+func (s *Service) put(ctx context.Context, name, value string, params map[string]any, confirmed bool) (*core.ActionResult, error) {
+	if !confirmed {
+		return nil, core.NewConfirmationError(s, "put", name, params, "Clients reading the latest version get the new value", false)
+	}
+
+	out, err := s.client().PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Value:     aws.String(value),
+		Overwrite: aws.Bool(true),
+	})
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("put", name, err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceUpdated, core.ResourceEventData{
+		ResourceID:   name,
+		ResourceType: "ssm:parameter",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Put version %d of %s", out.Version, name)), nil
+}
+
+func (s *Service) delete(ctx context.Context, name string) (*core.ActionResult, error) {
+	if _, err := s.client().DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String(name)}); err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("delete", name, err)
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceDeleted, core.ResourceEventData{
+		ResourceID:   name,
+		ResourceType: "ssm:parameter",
+	})
+
+	return core.NewActionResult(true, fmt.Sprintf("Deleted %s", name)), nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) parameterToResource(param types.ParameterMetadata, now time.Time) core.Resource {
+	name := aws.ToString(param.Name)
+	resource := core.Resource{
+		ID:        name,
+		Type:      "ssm:parameter",
+		Name:      name,
+		ARN:       aws.ToString(param.ARN),
+		State:     core.StateActive,
+		Region:    s.region(),
+		UpdatedAt: param.LastModifiedDate,
+		Tags:      make(map[string]string),
+		Metadata: map[string]any{
+			"type":          string(param.Type),
+			"tier":          string(param.Tier),
+			"version":       param.Version,
+			"data_type":     aws.ToString(param.DataType),
+			"description":   aws.ToString(param.Description),
+			"kms_key":       aws.ToString(param.KeyId),
+			"modified_by":   aws.ToString(param.LastModifiedUser),
+			"policy_count":  len(param.Policies),
+			"allowed_regex": aws.ToString(param.AllowedPattern),
+		},
+	}
+	// Parameters only record their last change
+	if param.LastModifiedDate != nil {
+		resource.Metadata["last_modified"] = *param.LastModifiedDate
+	}
+	estimate.ApplyAge(&resource, now)
+	if param.Tier == types.ParameterTierAdvanced {
+		estimate.ApplyCost(&resource, pricePerAdvanced)
+	}
+
+	if param.Type != types.ParameterTypeSecureString && secretName.MatchString(name) {
+		resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Unencrypted %s parameter named like a secret", param.Type))
+	}
+
+	return resource
+}
+
+// redact hides the values of the action's sensitive parameters, such as
+// the value of put, from events.
+func (s *Service) redact(action string, params map[string]any) map[string]any {
+	for _, a := range s.Actions() {
+		if a.Name == action {
+			return core.RedactParams(params, a.Parameters)
+		}
+	}
+	return params
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "ssm", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "ssm", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,153 @@
+package ssm
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeSSM serves /app/db/host as a String, /app/db/password as an
+// advanced SecureString and /app/api_token as a plain String, or fails
+// every call when err is set. It records the list filters and whether
+// each GetParameter call asked for decryption.
+type fakeSSM struct {
+	err       error
+	decrypted []bool
+	filters   []types.ParameterStringFilter
+}
+
+func (f *fakeSSM) parameters() []types.ParameterMetadata {
+	modified := time.Now().Add(-48 * time.Hour)
+	return []types.ParameterMetadata{
+		{Name: aws.String("/app/db/host"), Type: types.ParameterTypeString, Tier: types.ParameterTierStandard, Version: 3, LastModifiedDate: &modified},
+		{Name: aws.String("/app/db/password"), Type: types.ParameterTypeSecureString, Tier: types.ParameterTierAdvanced, Version: 1, LastModifiedDate: &modified},
+		{Name: aws.String("/app/api_token"), Type: types.ParameterTypeString, Tier: types.ParameterTierStandard, Version: 1, LastModifiedDate: &modified},
+	}
+}
+
+func (f *fakeSSM) DescribeParameters(_ context.Context, in *ssm.DescribeParametersInput, _ ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.filters = append(f.filters, in.ParameterFilters...)
+	return &ssm.DescribeParametersOutput{Parameters: f.parameters()}, nil
+}
+
+func (f *fakeSSM) GetParameter(_ context.Context, in *ssm.GetParameterInput, _ ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	decrypt := aws.ToBool(in.WithDecryption)
+	f.decrypted = append(f.decrypted, decrypt)
+	for _, meta := range f.parameters() {
+		if aws.ToString(meta.Name) != aws.ToString(in.Name) {
+			continue
+		}
+		value := "db.internal"
+		if meta.Type == types.ParameterTypeSecureString {
+			value = "AQICAHh-ciphertext"
+			if decrypt {
+				value = "hunter2"
+			}
+		}
+		return &ssm.GetParameterOutput{Parameter: &types.Parameter{
+			Name:    meta.Name,
+			Type:    meta.Type,
+			Version: meta.Version,
+			Value:   aws.String(value),
+		}}, nil
+	}
+	return nil, &types.ParameterNotFound{}
+}
+
+func (f *fakeSSM) PutParameter(context.Context, *ssm.PutParameterInput, ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ssm.PutParameterOutput{Version: 4}, nil
+}
+
+func (f *fakeSSM) DeleteParameter(context.Context, *ssm.DeleteParameterInput, ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ssm.DeleteParameterOutput{}, nil
+}
+
+// TestServiceConformance runs the core service contract against
+// parameters.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSSM{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeSSM{err: errors.New("AccessDeniedException")}, d)
+		},
+		ExistingID:    "/app/db/host",
+		MissingID:     "/app/db/port",
+		Action:        "view",
+		ConfirmAction: "delete",
+		ConfirmTyped:  true,
+	})
+}
+
+func TestListFlagsPlainSecrets(t *testing.T) {
+	fake := &fakeSSM{}
+	resources, err := NewServiceWithClient(fake, nil).List(context.Background(), core.ListOptions{
+		Filters: map[string]string{FilterPath: "/app/"},
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(fake.filters) != 1 || fake.filters[0].Values[0] != "/app/" {
+		t.Errorf("filters = %+v, want names beginning with /app/", fake.filters)
+	}
+
+	for _, r := range resources {
+		flagged := len(r.Issues()) > 0
+		if flagged != (r.ID == "/app/api_token") {
+			t.Errorf("%s issues = %v", r.ID, r.Issues())
+		}
+	}
+	if cost := resources[1].Metadata[estimate.MonthlyCostKey]; cost != pricePerAdvanced {
+		t.Errorf("advanced parameter costs %v, want %v", cost, pricePerAdvanced)
+	}
+}
+
+// TestViewDecryptsOnceConfirmed checks that a SecureString value is only
+// read with decryption after confirmation, while a String is shown at once.
+func TestViewDecryptsOnceConfirmed(t *testing.T) {
+	fake := &fakeSSM{}
+	svc := NewServiceWithClient(fake, nil)
+
+	result, err := svc.Execute(context.Background(), "view", "/app/db/host", nil)
+	if err != nil || result.Data.(ParameterValue).Value != "db.internal" {
+		t.Fatalf("view String = %+v, %v", result, err)
+	}
+
+	_, err = svc.Execute(context.Background(), "view", "/app/db/password", nil)
+	var confirm *core.ConfirmationError
+	if !errors.As(err, &confirm) || confirm.TypeResource {
+		t.Fatalf("unconfirmed view error = %v, want a plain confirmation", err)
+	}
+
+	result, err = svc.Execute(context.Background(), "view", "/app/db/password", map[string]any{core.ParamConfirm: true})
+	if err != nil || result.Data.(ParameterValue).Value != "hunter2" {
+		t.Fatalf("confirmed view = %+v, %v", result, err)
+	}
+	// Read once without decryption for its type, then decrypted
+	if want := []bool{false, false, false, true}; !slices.Equal(fake.decrypted, want) {
+		t.Errorf("decryption requested %v, want %v", fake.decrypted, want)
+	}
+}
@@ -0,0 +1,426 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const (
+	putFormID    = "ssm:put"
+	filterFormID = "ssm:filter"
+)
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for SSM parameters.
+type View struct {
+	*base.TableView
+
+	path       string // Name prefix parameters are listed under, see FilterPath
+	formTarget string // Parameter the put form is open for
+}
+
+// NewView creates a new SSM parameters view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("Name"), MinWidth: 20, MaxWidth: 80, Weight: 2.5, Priority: 0},
+		{Title: i18n.T("Type"), MinWidth: 12, MaxWidth: 12, Weight: 0.3, Priority: 0},
+		{Title: i18n.T("Tier"), MinWidth: 8, MaxWidth: 18, Weight: 0.3, Priority: 2},
+		{Title: i18n.T("Version"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Last Modified"), MinWidth: 16, MaxWidth: 16, Weight: 0.4, Priority: 1},
+		{Title: i18n.T("Modified By"), MinWidth: 12, MaxWidth: 40, Weight: 0.8, Priority: 3},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Parameters", "", "ssm", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadParameters()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "v":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Reading %s...", row.Name)
+				return v, v.executeAction("view", row.ID, nil)
+			}
+		case "p":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openPutForm(row)
+			}
+		case "d":
+			if row := v.GetSelectedResource(); row != nil {
+				v.Message = i18n.T("Deleting %s...", row.Name)
+				return v, v.executeAction("delete", row.ID, nil)
+			}
+		case "f":
+			return v, v.openFilterForm()
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Parameter %s", row.Name), formatDetail(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		switch msg.ID {
+		case filterFormID:
+			if msg.Canceled {
+				break
+			}
+			path, _ := msg.Values["path"].(string)
+			v.path = strings.TrimSpace(path)
+			v.Resources = nil
+			v.updateTable()
+			cmds = append(cmds, v.loadParameters())
+		case putFormID:
+			if msg.Canceled || v.formTarget == "" {
+				v.Message = i18n.T("Canceled")
+				break
+			}
+			v.Message = i18n.T("Putting a new version of %s...", v.formTarget)
+			cmds = append(cmds, v.executeAction("put", v.formTarget, msg.Values))
+		}
+
+	case parametersLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d parameters", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if value, ok := msg.Result.Data.(ParameterValue); ok {
+				v.OpenDetail(i18n.T("Value of %s", value.Name), formatValue(value))
+			}
+			if msg.Action == "put" || msg.Action == "delete" {
+				cmds = append(cmds, v.loadParameters())
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading parameters...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[v]alue  [p]ut version  [d]elete  [f]ilter path  [Enter]details  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the parameters.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadParameters()
+}
+
+// SaveState implements core.StatefulView, adding the path prefix.
+func (v *View) SaveState() core.ViewState {
+	state := v.TableView.SaveState()
+	if v.path != "" {
+		state.Filters = map[string]string{FilterPath: v.path}
+	}
+	return state
+}
+
+// RestoreState implements core.StatefulView.
+func (v *View) RestoreState(state core.ViewState) {
+	v.TableView.RestoreState(state)
+	v.path = state.Filters[FilterPath]
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type parametersLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+// loadParameters lists the parameters under the current path prefix.
+func (v *View) loadParameters() tea.Cmd {
+	v.SetLoading(true)
+	filters := map[string]string{}
+	if v.path != "" {
+		filters[FilterPath] = v.path
+	}
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return parametersLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return parametersLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{Filters: maps.Clone(filters)})
+		return parametersLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+func (v *View) openFilterForm() tea.Cmd {
+	params := []core.ActionParameter{
+		{
+			Name:        "path",
+			Type:        "string",
+			Default:     v.path,
+			Description: i18n.T("e.g. /prod/ or /app/db (empty lists every parameter)"),
+		},
+	}
+	return v.OpenForm(components.NewForm(filterFormID, i18n.T("Filter parameters by path"), params))
+}
+
+func (v *View) openPutForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "put")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "put")
+		return nil
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(putFormID, i18n.T("New version of %s", r.Name), def.Parameters))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	version, _ := r.Metadata["version"].(int64)
+	modified, ok := r.Metadata["last_modified"].(time.Time)
+	var modifiedValue any
+	if ok {
+		modifiedValue = modified
+	}
+
+	return base.Row{
+		base.TextCell(base.TruncateString(r.Name, 80)),
+		base.TextCell(r.GetMetadataString("type")),
+		base.TextCell(r.GetMetadataString("tier")),
+		base.LazyCell(version, func() string { return fmt.Sprintf("%d", version) }),
+		base.LazyCell(modifiedValue, func() string {
+			if !ok {
+				return "-"
+			}
+			return modified.Local().Format("2006-01-02 15:04")
+		}),
+		base.TextCell(modifiedBy(r.GetMetadataString("modified_by"))),
+		base.CostCell(r),
+		base.SeverityCell(r),
+	}
+}
+
+// modifiedBy shortens the ARN of the principal that last changed a
+// parameter to its name.
+func modifiedBy(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
+
+// formatDetail renders a parameter's metadata for the detail panel. The
+// value is only read with [v].
+func formatDetail(r *core.Resource) string {
+	version, _ := r.Metadata["version"].(int64)
+	policies, _ := r.Metadata["policy_count"].(int)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ARN:         %s\n", r.ARN)
+	if description := r.GetMetadataString("description"); description != "" {
+		fmt.Fprintf(&b, "Description: %s\n", description)
+	}
+	fmt.Fprintf(&b, "Type:        %s (%s)\n", r.GetMetadataString("type"), r.GetMetadataString("data_type"))
+	fmt.Fprintf(&b, "Tier:        %s\n", r.GetMetadataString("tier"))
+	if key := r.GetMetadataString("kms_key"); key != "" {
+		fmt.Fprintf(&b, "KMS key:     %s\n", key)
+	}
+	if pattern := r.GetMetadataString("allowed_regex"); pattern != "" {
+		fmt.Fprintf(&b, "Pattern:     %s\n", pattern)
+	}
+	fmt.Fprintf(&b, "Version:     %d\n", version)
+	if modified, ok := r.Metadata["last_modified"].(time.Time); ok {
+		fmt.Fprintf(&b, "Modified:    %s by %s\n", modified.Local().Format("2006-01-02 15:04"), r.GetMetadataString("modified_by"))
+	}
+	if policies > 0 {
+		fmt.Fprintf(&b, "Policies:    %d\n", policies)
+	}
+	if monthly, ok := estimate.MonthlyCost(*r); ok {
+		fmt.Fprintf(&b, "Est. cost:   %s/mo\n", estimate.FormatCost(monthly))
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatValue renders a parameter value for the detail panel.
+func formatValue(value ParameterValue) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Type:     %s\n", value.Type)
+	fmt.Fprintf(&b, "Version:  %d\n", value.Version)
+	if !value.Modified.IsZero() {
+		fmt.Fprintf(&b, "Modified: %s\n", value.Modified.Local().Format("2006-01-02 15:04"))
+	}
+	b.WriteString("\n")
+	if value.Type == "StringList" {
+		for item := range strings.SplitSeq(value.Value, ",") {
+			b.WriteString("  " + item + "\n")
+		}
+		return b.String()
+	}
+	b.WriteString(value.Value + "\n")
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	secure, advanced := 0, 0
+	for _, r := range v.Resources {
+		if r.GetMetadataString("type") == "SecureString" {
+			secure++
+		}
+		if r.GetMetadataString("tier") == "Advanced" {
+			advanced++
+		}
+	}
+
+	path := v.path
+	if path == "" {
+		path = "/"
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "path", Text: i18n.T("Path: %s", path), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "securestring", Text: i18n.T("SecureString: %d", secure), Tone: core.ToneInfo},
+		core.SummaryWidget{Name: "advanced", Text: i18n.T("Advanced: %d", advanced), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("SSM Parameters"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "ssm" }
+
+var (
+	_ tea.Model         = (*View)(nil)
+	_ core.View         = (*View)(nil)
+	_ core.StatefulView = (*View)(nil)
+	_ core.ViewFactory  = (*ViewFactory)(nil)
+)
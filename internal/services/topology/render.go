@@ -0,0 +1,121 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+)
+
+// =============================================================================
+// ASCII Rendering
+// =============================================================================
+
+// RenderVPC draws a VPC as an ASCII tree: each route table with the subnets
+// using it and its routes, then the VPC's peerings and transit gateway
+// attachments.
+func RenderVPC(vpc VPC) string {
+	var b strings.Builder
+	b.WriteString(join(vpc.ID, label(vpc.Name), "["+strings.Join(vpc.CIDRs, ", ")+"]") + "\n")
+
+	type section struct {
+		title string
+		lines []string
+	}
+	var sections []section
+
+	for _, table := range vpc.RouteTables {
+		s := section{title: join("Route table", table.ID, label(table.Name))}
+		if table.Main {
+			s.title += " (main)"
+		}
+		for _, subnet := range vpc.Subnets {
+			if subnet.RouteTable == table.ID {
+				s.lines = append(s.lines, join("subnet", subnet.ID, label(subnet.Name), subnet.Zone, strings.Join(subnet.CIDRs, ", ")))
+			}
+		}
+		for _, route := range table.Routes {
+			line := fmt.Sprintf("%-20s -> %s", route.Destination, route.Target)
+			if route.Blackhole() {
+				line += "  !! BLACKHOLE"
+			}
+			s.lines = append(s.lines, line)
+		}
+		sections = append(sections, s)
+	}
+
+	for _, peering := range vpc.Peerings {
+		peer, cidrs, owner := peering.Peer(vpc.ID)
+		s := section{title: fmt.Sprintf("Peering %s <==> %s [%s] (%s)", peering.ID, peer, strings.Join(cidrs, ", "), peering.Status)}
+		if owner != "" {
+			s.lines = append(s.lines, "account "+owner)
+		}
+		sections = append(sections, s)
+	}
+
+	for _, a := range vpc.Attachments {
+		s := section{title: fmt.Sprintf("Transit gateway %s via %s (%s)", a.TransitGateway, a.ID, a.State)}
+		for _, subnet := range a.Subnets {
+			s.lines = append(s.lines, "subnet "+subnet)
+		}
+		sections = append(sections, s)
+	}
+
+	for i, s := range sections {
+		branch, indent := "├── ", "│   "
+		if i == len(sections)-1 {
+			branch, indent = "└── ", "    "
+		}
+		b.WriteString(branch + s.title + "\n")
+		for j, line := range s.lines {
+			leaf := "├── "
+			if j == len(s.lines)-1 {
+				leaf = "└── "
+			}
+			b.WriteString(indent + leaf + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// RenderTrace draws a trace as a numbered list of hops ending with its
+// verdict.
+func RenderTrace(tr Trace) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s -> %s\n\n", tr.From, tr.To)
+	hop := 0
+	for _, step := range tr.Steps {
+		switch step.Status {
+		case StepInfo:
+			fmt.Fprintf(&b, "      i  %s\n", step.Text)
+		case StepFail:
+			hop++
+			fmt.Fprintf(&b, "  %2d. ✗  %s\n", hop, step.Text)
+		default:
+			hop++
+			fmt.Fprintf(&b, "  %2d. ✓  %s\n", hop, step.Text)
+		}
+	}
+	if tr.Reachable {
+		b.WriteString("\nREACHABLE\n")
+	} else {
+		b.WriteString("\nNOT REACHABLE\n")
+	}
+	return b.String()
+}
+
+// join joins the non-empty parts with spaces.
+func join(parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		if part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+func label(name string) string {
+	if name == "" {
+		return ""
+	}
+	return "(" + name + ")"
+}
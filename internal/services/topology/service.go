@@ -0,0 +1,517 @@
+// Package topology provides a network topology view for the a9s application.
+// It maps the route tables, peering connections and transit gateway
+// attachments of each VPC and traces why one subnet can or cannot reach
+// another.
+package topology
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// livePeerings are the peering states worth mapping; deleted, rejected and
+// expired connections are left out.
+var livePeerings = []string{"initiating-request", "pending-acceptance", "provisioning", "active", "failed"}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements network topology operations.
+type Service struct {
+	factory    *awsfactory.ClientFactory
+	dispatcher core.EventDispatcher
+	testClient EC2API
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+	DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeVpcPeeringConnections(ctx context.Context, params *ec2.DescribeVpcPeeringConnectionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+	DescribeTransitGatewayVpcAttachments(ctx context.Context, params *ec2.DescribeTransitGatewayVpcAttachmentsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error)
+}
+
+// NewService creates a new topology service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher) *Service {
+	return &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "topology"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "VPC Routing Topology"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("topology", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns one resource per VPC, carrying its topology under the "vpc"
+// metadata key.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	topology, err := s.Topology(ctx)
+	if err != nil {
+		s.dispatchError(ctx, "list", err)
+		return nil, core.NewServiceError("topology", "list", err)
+	}
+
+	vpcs := topology.sortedVPCs()
+	resources := make([]core.Resource, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		resources = append(resources, vpcToResource(vpc))
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:vpc",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// Topology maps the VPCs of the region with their subnets, route tables,
+// peering connections and transit gateway attachments.
+func (s *Service) Topology(ctx context.Context) (*Topology, error) {
+	client := s.client()
+	topology := &Topology{
+		VPCs:     make(map[string]*VPC),
+		Peerings: make(map[string]Peering),
+	}
+
+	vpcs := ec2.NewDescribeVpcsPaginator(client, &ec2.DescribeVpcsInput{})
+	for vpcs.HasMorePages() {
+		page, err := vpcs.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range page.Vpcs {
+			vpc := &VPC{ID: aws.ToString(v.VpcId), Name: nameTag(v.Tags), Default: aws.ToBool(v.IsDefault)}
+			for _, assoc := range v.CidrBlockAssociationSet {
+				vpc.CIDRs = append(vpc.CIDRs, aws.ToString(assoc.CidrBlock))
+			}
+			for _, assoc := range v.Ipv6CidrBlockAssociationSet {
+				vpc.CIDRs = append(vpc.CIDRs, aws.ToString(assoc.Ipv6CidrBlock))
+			}
+			topology.VPCs[vpc.ID] = vpc
+		}
+	}
+
+	mainTables := make(map[string]string)
+	tables := ec2.NewDescribeRouteTablesPaginator(client, &ec2.DescribeRouteTablesInput{})
+	for tables.HasMorePages() {
+		page, err := tables.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, rt := range page.RouteTables {
+			vpc, ok := topology.VPCs[aws.ToString(rt.VpcId)]
+			if !ok {
+				continue
+			}
+			table := routeTableOf(rt)
+			if table.Main {
+				mainTables[vpc.ID] = table.ID
+			}
+			vpc.RouteTables = append(vpc.RouteTables, table)
+		}
+	}
+
+	explicit := make(map[string]string)
+	for _, vpc := range topology.VPCs {
+		for _, table := range vpc.RouteTables {
+			for _, subnet := range table.Subnets {
+				explicit[subnet] = table.ID
+			}
+		}
+	}
+
+	subnets := ec2.NewDescribeSubnetsPaginator(client, &ec2.DescribeSubnetsInput{})
+	for subnets.HasMorePages() {
+		page, err := subnets.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, sn := range page.Subnets {
+			vpc, ok := topology.VPCs[aws.ToString(sn.VpcId)]
+			if !ok {
+				continue
+			}
+			subnet := Subnet{
+				ID:   aws.ToString(sn.SubnetId),
+				Name: nameTag(sn.Tags),
+				Zone: aws.ToString(sn.AvailabilityZone),
+			}
+			if cidr := aws.ToString(sn.CidrBlock); cidr != "" {
+				subnet.CIDRs = append(subnet.CIDRs, cidr)
+			}
+			for _, assoc := range sn.Ipv6CidrBlockAssociationSet {
+				subnet.CIDRs = append(subnet.CIDRs, aws.ToString(assoc.Ipv6CidrBlock))
+			}
+			subnet.RouteTable = explicit[subnet.ID]
+			if subnet.RouteTable == "" {
+				subnet.RouteTable = mainTables[vpc.ID]
+			}
+			vpc.Subnets = append(vpc.Subnets, subnet)
+		}
+	}
+
+	peerings := ec2.NewDescribeVpcPeeringConnectionsPaginator(client, &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []types.Filter{{Name: aws.String("status-code"), Values: livePeerings}},
+	})
+	for peerings.HasMorePages() {
+		page, err := peerings.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pcx := range page.VpcPeeringConnections {
+			peering := peeringOf(pcx)
+			topology.Peerings[peering.ID] = peering
+			for _, id := range []string{peering.RequesterVPC, peering.AccepterVPC} {
+				if vpc, ok := topology.VPCs[id]; ok {
+					vpc.Peerings = append(vpc.Peerings, peering)
+				}
+			}
+		}
+	}
+
+	attachments := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(client, &ec2.DescribeTransitGatewayVpcAttachmentsInput{})
+	for attachments.HasMorePages() {
+		page, err := attachments.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.TransitGatewayVpcAttachments {
+			if a.State == types.TransitGatewayAttachmentStateDeleted || a.State == types.TransitGatewayAttachmentStateDeleting {
+				continue
+			}
+			vpc, ok := topology.VPCs[aws.ToString(a.VpcId)]
+			if !ok {
+				continue
+			}
+			vpc.Attachments = append(vpc.Attachments, Attachment{
+				ID:             aws.ToString(a.TransitGatewayAttachmentId),
+				TransitGateway: aws.ToString(a.TransitGatewayId),
+				VPC:            vpc.ID,
+				Subnets:        a.SubnetIds,
+				State:          string(a.State),
+			})
+		}
+	}
+
+	return topology, nil
+}
+
+// =============================================================================
+// ActionExecutor Interface Implementation
+// =============================================================================
+
+// Actions returns the list of available actions for the topology.
+func (s *Service) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        "trace",
+			Description: "Trace the route from a subnet to an address or subnet",
+			Icon:        "route",
+			Shortcut:    "t",
+			Dangerous:   false,
+			Category:    "inspect",
+			Parameters: []core.ActionParameter{
+				{Name: "from", Type: "string", Required: true, Description: "Source subnet ID", Validation: `^subnet-[0-9a-f]+$`},
+				{Name: "to", Type: "string", Required: true, Description: "Destination IP address or subnet ID"},
+			},
+		},
+	}
+}
+
+// Execute runs the specified action. The trace is returned as the result's
+// Data.
+func (s *Service) Execute(ctx context.Context, action string, resourceID string, params map[string]any) (*core.ActionResult, error) {
+	start := time.Now()
+
+	s.dispatchEvent(ctx, core.EventActionStarted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Params:     params,
+	})
+
+	var result *core.ActionResult
+	var err error
+
+	switch action {
+	case "trace":
+		from, _ := params["from"].(string)
+		to, _ := params["to"].(string)
+		if from == "" || to == "" {
+			return nil, core.NewValidationError("from/to", nil, "are required")
+		}
+		result, err = s.trace(ctx, from, to)
+	default:
+		return nil, core.NewActionError(action, resourceID, core.ErrActionNotFound)
+	}
+
+	if err != nil {
+		s.dispatchEvent(ctx, core.EventActionFailed, core.ActionEventData{
+			Action:     action,
+			ResourceID: resourceID,
+			Error:      err.Error(),
+		})
+		return result, err
+	}
+
+	result.Duration = time.Since(start)
+
+	s.dispatchEvent(ctx, core.EventActionExecuted, core.ActionEventData{
+		Action:     action,
+		ResourceID: resourceID,
+		Result:     result,
+	})
+
+	return result, nil
+}
+
+// =============================================================================
+// Action Implementations
+// =============================================================================
+
+func (s *Service) trace(ctx context.Context, from, to string) (*core.ActionResult, error) {
+	topology, err := s.Topology(ctx)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("trace", from, err)
+	}
+
+	trace, err := topology.Trace(from, to)
+	if err != nil {
+		return core.NewActionResult(false, err.Error()), core.NewActionError("trace", from, err)
+	}
+
+	message := fmt.Sprintf("%s cannot reach %s", from, to)
+	if trace.Reachable {
+		message = fmt.Sprintf("%s can reach %s", from, to)
+	}
+	result := core.NewActionResult(true, message)
+	result.Data = trace
+	return result, nil
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func vpcToResource(vpc *VPC) core.Resource {
+	resource := core.Resource{
+		ID:    vpc.ID,
+		Type:  "ec2:vpc",
+		Name:  vpc.Name,
+		State: "available",
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"vpc":         *vpc,
+			"cidrs":       vpc.CIDRs,
+			"subnets":     len(vpc.Subnets),
+			"routes":      len(vpc.RouteTables),
+			"peerings":    len(vpc.Peerings),
+			"attachments": len(vpc.Attachments),
+			"default":     vpc.Default,
+		},
+	}
+	if resource.Name == "" {
+		resource.Name = vpc.ID
+	}
+
+	blackholes := 0
+	for _, table := range vpc.RouteTables {
+		for _, route := range table.Routes {
+			if route.Blackhole() {
+				blackholes++
+				resource.AddIssue(core.SeverityMedium, fmt.Sprintf("Blackhole route in %s: %s via %s, which no longer exists", table.ID, route.Destination, route.Target))
+			}
+		}
+	}
+	resource.Metadata["blackholes"] = blackholes
+
+	for _, peering := range vpc.Peerings {
+		if peering.Status != "active" {
+			peer, _, _ := peering.Peer(vpc.ID)
+			resource.AddIssue(core.SeverityLow, fmt.Sprintf("Peering %s with %s is %s", peering.ID, peer, peering.Status))
+		}
+	}
+
+	iac.Apply(&resource)
+	return resource
+}
+
+func routeTableOf(rt types.RouteTable) RouteTable {
+	table := RouteTable{ID: aws.ToString(rt.RouteTableId), Name: nameTag(rt.Tags)}
+	for _, assoc := range rt.Associations {
+		if aws.ToBool(assoc.Main) {
+			table.Main = true
+		}
+		if subnet := aws.ToString(assoc.SubnetId); subnet != "" {
+			table.Subnets = append(table.Subnets, subnet)
+		}
+	}
+	for _, r := range rt.Routes {
+		table.Routes = append(table.Routes, Route{
+			Destination: routeDestination(r),
+			Target:      routeTarget(r),
+			State:       string(r.State),
+		})
+	}
+	return table
+}
+
+func routeDestination(r types.Route) string {
+	for _, dest := range []*string{r.DestinationCidrBlock, r.DestinationIpv6CidrBlock, r.DestinationPrefixListId} {
+		if v := aws.ToString(dest); v != "" {
+			return v
+		}
+	}
+	return "-"
+}
+
+func routeTarget(r types.Route) string {
+	for _, target := range []*string{
+		r.GatewayId, r.NatGatewayId, r.TransitGatewayId, r.VpcPeeringConnectionId,
+		r.NetworkInterfaceId, r.InstanceId, r.EgressOnlyInternetGatewayId,
+		r.LocalGatewayId, r.CarrierGatewayId, r.CoreNetworkArn,
+	} {
+		if v := aws.ToString(target); v != "" {
+			return v
+		}
+	}
+	return "-"
+}
+
+func peeringOf(pcx types.VpcPeeringConnection) Peering {
+	peering := Peering{ID: aws.ToString(pcx.VpcPeeringConnectionId)}
+	if pcx.Status != nil {
+		peering.Status = string(pcx.Status.Code)
+	}
+	if info := pcx.RequesterVpcInfo; info != nil {
+		peering.RequesterVPC = aws.ToString(info.VpcId)
+		peering.RequesterCIDRs = peeringCIDRs(info)
+		peering.RequesterOwner = aws.ToString(info.OwnerId)
+	}
+	if info := pcx.AccepterVpcInfo; info != nil {
+		peering.AccepterVPC = aws.ToString(info.VpcId)
+		peering.AccepterCIDRs = peeringCIDRs(info)
+		peering.AccepterOwner = aws.ToString(info.OwnerId)
+	}
+	return peering
+}
+
+// peeringCIDRs returns the CIDRs of one side of a peering, which may be
+// fewer than its VPC's when some were not shared.
+func peeringCIDRs(info *types.VpcPeeringConnectionVpcInfo) []string {
+	var cidrs []string
+	for _, block := range info.CidrBlockSet {
+		cidrs = append(cidrs, aws.ToString(block.CidrBlock))
+	}
+	if len(cidrs) == 0 && info.CidrBlock != nil {
+		cidrs = append(cidrs, aws.ToString(info.CidrBlock))
+	}
+	for _, block := range info.Ipv6CidrBlockSet {
+		cidrs = append(cidrs, aws.ToString(block.Ipv6CidrBlock))
+	}
+	return cidrs
+}
+
+func nameTag(tags []types.Tag) string {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return ""
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "topology", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "topology", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService     = (*Service)(nil)
+	_ core.ResourceLister = (*Service)(nil)
+	_ core.ActionExecutor = (*Service)(nil)
+)
@@ -0,0 +1,230 @@
+package topology
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+)
+
+// fakeEC2 serves three VPCs. vpc-app peers with vpc-data through pcx-data
+// and shares tgw-1 with vpc-shared, which routes replies to tgw-2 instead.
+// subnet-app1 uses rtb-app, which also has a blackhole route through a
+// deleted peering; subnet-app2 falls back to the main table, which only
+// routes locally. vpc-data has a pending peering request with a VPC of
+// another account. It fails every call when err is set.
+type fakeEC2 struct {
+	err error
+}
+
+func vpc(id, cidr string) types.Vpc {
+	return types.Vpc{
+		VpcId:                   aws.String(id),
+		CidrBlockAssociationSet: []types.VpcCidrBlockAssociation{{CidrBlock: aws.String(cidr)}},
+		Tags:                    []types.Tag{{Key: aws.String("Name"), Value: aws.String(id[len("vpc-"):])}},
+	}
+}
+
+func subnet(id, vpcID, cidr string) types.Subnet {
+	return types.Subnet{SubnetId: aws.String(id), VpcId: aws.String(vpcID), CidrBlock: aws.String(cidr), AvailabilityZone: aws.String("us-east-1a")}
+}
+
+// routeTable returns a table of a VPC, associated with a subnet or else
+// main, routing the VPC's CIDR locally before the given routes.
+func routeTable(id, vpcID, vpcCIDR, subnet string, routes ...types.Route) types.RouteTable {
+	local := route(vpcCIDR, via("local"))
+	rt := types.RouteTable{RouteTableId: aws.String(id), VpcId: aws.String(vpcID), Routes: append([]types.Route{local}, routes...)}
+	if subnet == "" {
+		rt.Associations = []types.RouteTableAssociation{{Main: aws.Bool(true)}}
+	} else {
+		rt.Associations = []types.RouteTableAssociation{{SubnetId: aws.String(subnet)}}
+	}
+	return rt
+}
+
+func route(cidr string, target func(*types.Route)) types.Route {
+	r := types.Route{DestinationCidrBlock: aws.String(cidr), State: types.RouteStateActive}
+	target(&r)
+	return r
+}
+
+func via(id string) func(*types.Route) {
+	return func(r *types.Route) {
+		switch id[:3] {
+		case "pcx":
+			r.VpcPeeringConnectionId = aws.String(id)
+		case "tgw":
+			r.TransitGatewayId = aws.String(id)
+		default:
+			r.GatewayId = aws.String(id)
+		}
+	}
+}
+
+func (f *fakeEC2) DescribeVpcs(context.Context, *ec2.DescribeVpcsInput, ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{
+		vpc("vpc-app", "10.0.0.0/16"),
+		vpc("vpc-data", "10.1.0.0/16"),
+		vpc("vpc-shared", "172.16.0.0/16"),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeSubnets(context.Context, *ec2.DescribeSubnetsInput, ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeSubnetsOutput{Subnets: []types.Subnet{
+		subnet("subnet-app1", "vpc-app", "10.0.1.0/24"),
+		subnet("subnet-app2", "vpc-app", "10.0.2.0/24"),
+		subnet("subnet-data", "vpc-data", "10.1.1.0/24"),
+		subnet("subnet-shared", "vpc-shared", "172.16.1.0/24"),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeRouteTables(context.Context, *ec2.DescribeRouteTablesInput, ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	blackhole := route("10.9.0.0/16", via("pcx-gone"))
+	blackhole.State = types.RouteStateBlackhole
+	tables := []types.RouteTable{
+		routeTable("rtb-app", "vpc-app", "10.0.0.0/16", "subnet-app1",
+			route("10.1.0.0/16", via("pcx-data")),
+			route("172.16.0.0/16", via("tgw-1")),
+			route("0.0.0.0/0", via("igw-1")),
+			blackhole,
+		),
+		routeTable("rtb-app-main", "vpc-app", "10.0.0.0/16", ""),
+		routeTable("rtb-data", "vpc-data", "10.1.0.0/16", "subnet-data", route("10.0.0.0/16", via("pcx-data"))),
+		routeTable("rtb-shared", "vpc-shared", "172.16.0.0/16", "subnet-shared", route("10.0.0.0/8", via("tgw-2"))),
+	}
+	return &ec2.DescribeRouteTablesOutput{RouteTables: tables}, nil
+}
+
+func (f *fakeEC2) DescribeVpcPeeringConnections(context.Context, *ec2.DescribeVpcPeeringConnectionsInput, ...func(*ec2.Options)) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	peering := func(id, requester, accepter, accepterCIDR string, status types.VpcPeeringConnectionStateReasonCode) types.VpcPeeringConnection {
+		return types.VpcPeeringConnection{
+			VpcPeeringConnectionId: aws.String(id),
+			Status:                 &types.VpcPeeringConnectionStateReason{Code: status},
+			RequesterVpcInfo:       &types.VpcPeeringConnectionVpcInfo{VpcId: aws.String(requester), OwnerId: aws.String("123456789012")},
+			AccepterVpcInfo:        &types.VpcPeeringConnectionVpcInfo{VpcId: aws.String(accepter), CidrBlock: aws.String(accepterCIDR), OwnerId: aws.String("210987654321")},
+		}
+	}
+	return &ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []types.VpcPeeringConnection{
+		peering("pcx-data", "vpc-app", "vpc-data", "10.1.0.0/16", types.VpcPeeringConnectionStateReasonCodeActive),
+		peering("pcx-ext", "vpc-data", "vpc-ext", "192.168.0.0/16", types.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeTransitGatewayVpcAttachments(context.Context, *ec2.DescribeTransitGatewayVpcAttachmentsInput, ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	attachment := func(id, vpcID string, state types.TransitGatewayAttachmentState) types.TransitGatewayVpcAttachment {
+		return types.TransitGatewayVpcAttachment{
+			TransitGatewayAttachmentId: aws.String(id),
+			TransitGatewayId:           aws.String("tgw-1"),
+			VpcId:                      aws.String(vpcID),
+			State:                      state,
+		}
+	}
+	return &ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+		attachment("tgw-attach-app", "vpc-app", types.TransitGatewayAttachmentStateAvailable),
+		attachment("tgw-attach-shared", "vpc-shared", types.TransitGatewayAttachmentStateAvailable),
+		attachment("tgw-attach-data", "vpc-data", types.TransitGatewayAttachmentStateDeleted),
+	}}, nil
+}
+
+// TestServiceConformance runs the core service contract against VPCs.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d)
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{err: errors.New("UnauthorizedOperation")}, d)
+		},
+		ExistingID:   "vpc-app",
+		Action:       "trace",
+		ActionParams: map[string]any{"from": "subnet-app1", "to": "subnet-data"},
+	})
+}
+
+func TestListFlagsBrokenConnections(t *testing.T) {
+	resources, err := NewServiceWithClient(&fakeEC2{}, nil).List(context.Background(), core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"vpc-app":    {"Blackhole route in rtb-app: 10.9.0.0/16 via pcx-gone, which no longer exists"},
+		"vpc-data":   {"Peering pcx-ext with vpc-ext is pending-acceptance"},
+		"vpc-shared": nil,
+	}
+	for _, r := range resources {
+		var messages []string
+		for _, issue := range r.Issues() {
+			messages = append(messages, issue.Message)
+		}
+		if !slices.Equal(messages, want[r.ID]) {
+			t.Errorf("%s issues = %q, want %q", r.ID, messages, want[r.ID])
+		}
+	}
+
+	app := resources[0].Metadata["vpc"].(VPC)
+	if app.Subnets[1].RouteTable != "rtb-app-main" || len(app.Attachments) != 1 {
+		t.Errorf("vpc-app = %+v, want subnet-app2 on the main table", app)
+	}
+	if data := resources[1].Metadata["vpc"].(VPC); len(data.Attachments) != 0 {
+		t.Errorf("vpc-data attachments = %v, want the deleted one left out", data.Attachments)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	topology, err := NewServiceWithClient(&fakeEC2{}, nil).Topology(context.Background())
+	if err != nil {
+		t.Fatalf("Topology() error = %v", err)
+	}
+
+	tests := []struct {
+		from, to  string
+		reachable bool
+		last      string // Last step before the caveats
+	}{
+		{"subnet-app1", "10.0.2.5", true, "rtb-app delivers 10.0.0.0/16 locally within vpc-app"},
+		{"subnet-app1", "subnet-data", true, "Return path: rtb-data (used by subnet-data) sends 10.0.0.0/16 back through pcx-data"},
+		{"subnet-app1", "172.16.1.10", false, "Return path: rtb-shared (used by subnet-shared) sends 10.0.0.0/8 to tgw-2, not tgw-1"},
+		{"subnet-app1", "10.9.0.1", false, "rtb-app sends 10.9.0.0/16 to pcx-gone, which no longer exists (blackhole)"},
+		{"subnet-app1", "8.8.8.8", true, "rtb-app sends 0.0.0.0/0 to igw-1"},
+		{"subnet-app2", "subnet-data", false, "rtb-app-main has no route to 10.1.1.0"},
+		{"subnet-data", "192.168.4.4", false, "rtb-data has no route to 192.168.4.4"},
+	}
+	for _, tt := range tests {
+		trace, err := topology.Trace(tt.from, tt.to)
+		if err != nil {
+			t.Fatalf("Trace(%s, %s) error = %v", tt.from, tt.to, err)
+		}
+		steps := slices.DeleteFunc(slices.Clone(trace.Steps), func(s TraceStep) bool { return s.Status == StepInfo })
+		last := steps[len(steps)-1]
+		if trace.Reachable != tt.reachable || last.Text != tt.last {
+			t.Errorf("Trace(%s, %s) = %v ending %q, want %v ending %q", tt.from, tt.to, trace.Reachable, last.Text, tt.reachable, tt.last)
+		}
+	}
+
+	if _, err := topology.Trace("subnet-app1", "db.internal"); err == nil {
+		t.Error("Trace() to a host name error = nil, want a destination error")
+	}
+}
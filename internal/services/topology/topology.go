@@ -0,0 +1,390 @@
+package topology
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// =============================================================================
+// Topology Model
+// =============================================================================
+
+// Topology is a snapshot of the VPCs of a region and what connects them.
+type Topology struct {
+	VPCs     map[string]*VPC
+	Peerings map[string]Peering
+}
+
+// VPC is a VPC with its subnets, route tables and connections.
+type VPC struct {
+	ID          string
+	Name        string
+	CIDRs       []string
+	Default     bool
+	Subnets     []Subnet
+	RouteTables []RouteTable
+	Peerings    []Peering    // Peering connections with this VPC on either side
+	Attachments []Attachment // Transit gateway attachments
+}
+
+// Subnet is a subnet and the route table it uses.
+type Subnet struct {
+	ID         string
+	Name       string
+	CIDRs      []string
+	Zone       string
+	RouteTable string // Explicitly associated table, else the main one
+}
+
+// RouteTable is a route table and the subnets explicitly associated with it.
+type RouteTable struct {
+	ID      string
+	Name    string
+	Main    bool
+	Subnets []string
+	Routes  []Route
+}
+
+// Route is a route of a route table.
+type Route struct {
+	Destination string // CIDR or prefix list ID
+	Target      string // "local", or the ID of a gateway, peering, interface...
+	State       string // "active" or "blackhole"
+}
+
+// Blackhole reports whether the route's target no longer exists.
+func (r Route) Blackhole() bool {
+	return r.State == "blackhole"
+}
+
+// Peering is a VPC peering connection.
+type Peering struct {
+	ID             string
+	RequesterVPC   string
+	RequesterCIDRs []string
+	RequesterOwner string
+	AccepterVPC    string
+	AccepterCIDRs  []string
+	AccepterOwner  string
+	Status         string
+}
+
+// Peer returns the VPC on the other side of the peering from vpcID.
+func (p Peering) Peer(vpcID string) (id string, cidrs []string, owner string) {
+	if p.RequesterVPC == vpcID {
+		return p.AccepterVPC, p.AccepterCIDRs, p.AccepterOwner
+	}
+	return p.RequesterVPC, p.RequesterCIDRs, p.RequesterOwner
+}
+
+// Attachment is a transit gateway attachment of a VPC.
+type Attachment struct {
+	ID             string
+	TransitGateway string
+	VPC            string
+	Subnets        []string
+	State          string
+}
+
+// subnet returns the subnet with the given ID and its VPC.
+func (t *Topology) subnet(id string) (*VPC, *Subnet) {
+	for _, vpc := range t.VPCs {
+		for i := range vpc.Subnets {
+			if vpc.Subnets[i].ID == id {
+				return vpc, &vpc.Subnets[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// subnetFor returns the subnet containing an address.
+func (t *Topology) subnetFor(addr netip.Addr) (*VPC, *Subnet) {
+	for _, vpc := range t.VPCs {
+		for i := range vpc.Subnets {
+			if containsAddr(vpc.Subnets[i].CIDRs, addr) {
+				return vpc, &vpc.Subnets[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// routeTable returns the route table of a VPC with the given ID.
+func (v *VPC) routeTable(id string) *RouteTable {
+	for i := range v.RouteTables {
+		if v.RouteTables[i].ID == id {
+			return &v.RouteTables[i]
+		}
+	}
+	return nil
+}
+
+// attachment returns the VPC's attachment to a transit gateway.
+func (v *VPC) attachment(transitGateway string) (Attachment, bool) {
+	for _, a := range v.Attachments {
+		if a.TransitGateway == transitGateway {
+			return a, true
+		}
+	}
+	return Attachment{}, false
+}
+
+// lookup returns the most specific route of a table matching an address.
+// Prefix list destinations are not resolved and never match.
+func (rt *RouteTable) lookup(addr netip.Addr) (Route, bool) {
+	var best Route
+	bestBits := -1
+	for _, route := range rt.Routes {
+		prefix, err := netip.ParsePrefix(route.Destination)
+		if err != nil || !prefix.Contains(addr) {
+			continue
+		}
+		if prefix.Bits() > bestBits {
+			best, bestBits = route, prefix.Bits()
+		}
+	}
+	return best, bestBits >= 0
+}
+
+func containsAddr(cidrs []string, addr netip.Addr) bool {
+	for _, cidr := range cidrs {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstAddr returns the network address of the first IPv4 or IPv6 CIDR,
+// which stands for a whole subnet in traces.
+func firstAddr(cidrs []string, ipv6 bool) (netip.Addr, bool) {
+	for _, cidr := range cidrs {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil && prefix.Addr().Is6() == ipv6 {
+			return prefix.Masked().Addr(), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+// =============================================================================
+// Path Tracing
+// =============================================================================
+
+// Step outcomes of a trace.
+const (
+	StepOK   = "ok"
+	StepFail = "fail"
+	StepInfo = "info"
+)
+
+// TraceStep is one hop or check of a trace.
+type TraceStep struct {
+	Status string
+	Text   string
+}
+
+// Trace explains whether traffic from a subnet reaches a destination, hop by
+// hop, as far as route tables and peering connections tell. Security groups,
+// network ACLs and transit gateway route tables are not evaluated.
+type Trace struct {
+	From      string
+	To        string
+	Reachable bool
+	Steps     []TraceStep
+}
+
+func (tr *Trace) ok(format string, args ...any) {
+	tr.Steps = append(tr.Steps, TraceStep{Status: StepOK, Text: fmt.Sprintf(format, args...)})
+}
+
+func (tr *Trace) info(format string, args ...any) {
+	tr.Steps = append(tr.Steps, TraceStep{Status: StepInfo, Text: fmt.Sprintf(format, args...)})
+}
+
+func (tr *Trace) fail(format string, args ...any) Trace {
+	tr.Steps = append(tr.Steps, TraceStep{Status: StepFail, Text: fmt.Sprintf(format, args...)})
+	tr.Reachable = false
+	return *tr
+}
+
+func (tr *Trace) reach(format string, args ...any) Trace {
+	tr.ok(format, args...)
+	tr.Reachable = true
+	return *tr
+}
+
+// Trace follows the routes from a subnet to a destination, given as an IP
+// address or a subnet ID.
+func (t *Topology) Trace(fromSubnet, to string) (Trace, error) {
+	tr := Trace{From: fromSubnet, To: to}
+
+	srcVPC, src := t.subnet(fromSubnet)
+	if src == nil {
+		return tr, fmt.Errorf("subnet %s not found in this region", fromSubnet)
+	}
+
+	dstVPC, dst, addr, err := t.destination(to)
+	if err != nil {
+		return tr, err
+	}
+	srcAddr, ok := firstAddr(src.CIDRs, addr.Is6())
+	if !ok {
+		return tr.fail("%s has no %s CIDR", src.ID, family(addr)), nil
+	}
+
+	table := srcVPC.routeTable(src.RouteTable)
+	if table == nil {
+		return tr.fail("%s has no route table", src.ID), nil
+	}
+	tr.ok("%s (%s) uses route table %s", src.ID, srcVPC.ID, table.ID)
+
+	route, ok := table.lookup(addr)
+	if !ok {
+		return tr.fail("%s has no route to %s", table.ID, addr), nil
+	}
+	if route.Blackhole() {
+		return tr.fail("%s sends %s to %s, which no longer exists (blackhole)", table.ID, route.Destination, route.Target), nil
+	}
+
+	switch {
+	case route.Target == "local":
+		if dstVPC != srcVPC {
+			return tr.fail("%s is in none of the subnets of %s", addr, srcVPC.ID), nil
+		}
+		tr.reach("%s delivers %s locally within %s", table.ID, route.Destination, srcVPC.ID)
+
+	case strings.HasPrefix(route.Target, "pcx-"):
+		t.tracePeering(&tr, srcVPC, dstVPC, dst, srcAddr, addr, table.ID, route)
+
+	case strings.HasPrefix(route.Target, "tgw-"):
+		t.traceTransitGateway(&tr, srcVPC, dstVPC, dst, srcAddr, table.ID, route)
+
+	default:
+		if dstVPC != nil {
+			return tr.fail("%s sends %s to %s, which leaves %s: traffic does not reach %s in %s", table.ID, route.Destination, route.Target, srcVPC.ID, addr, dstVPC.ID), nil
+		}
+		tr.reach("%s sends %s to %s", table.ID, route.Destination, route.Target)
+	}
+
+	if tr.Reachable {
+		tr.info("Security groups and network ACLs are not checked")
+	}
+	return tr, nil
+}
+
+// destination resolves the destination of a trace to an address and, when it
+// is in a known subnet, that subnet and its VPC.
+func (t *Topology) destination(to string) (*VPC, *Subnet, netip.Addr, error) {
+	if strings.HasPrefix(to, "subnet-") {
+		vpc, subnet := t.subnet(to)
+		if subnet == nil {
+			return nil, nil, netip.Addr{}, fmt.Errorf("subnet %s not found in this region", to)
+		}
+		addr, ok := firstAddr(subnet.CIDRs, false)
+		if !ok {
+			addr, _ = firstAddr(subnet.CIDRs, true)
+		}
+		return vpc, subnet, addr, nil
+	}
+
+	addr, err := netip.ParseAddr(to)
+	if err != nil {
+		return nil, nil, netip.Addr{}, fmt.Errorf("destination must be an IP address or a subnet ID: %q", to)
+	}
+	vpc, subnet := t.subnetFor(addr)
+	return vpc, subnet, addr, nil
+}
+
+func (t *Topology) tracePeering(tr *Trace, srcVPC, dstVPC *VPC, dst *Subnet, srcAddr, addr netip.Addr, tableID string, route Route) {
+	peering, ok := t.Peerings[route.Target]
+	if !ok {
+		tr.fail("%s sends %s to %s, which is not an active peering connection", tableID, route.Destination, route.Target)
+		return
+	}
+	if peering.Status != "active" {
+		tr.fail("%s sends %s to %s, which is %s", tableID, route.Destination, peering.ID, peering.Status)
+		return
+	}
+	peerID, peerCIDRs, owner := peering.Peer(srcVPC.ID)
+	tr.ok("%s sends %s through %s to %s", tableID, route.Destination, peering.ID, peerID)
+
+	if dstVPC == nil {
+		if !containsAddr(peerCIDRs, addr) {
+			tr.fail("%s is not in %s (%s): peering is not transitive", addr, peerID, strings.Join(peerCIDRs, ", "))
+			return
+		}
+		tr.reach("%s is in account %s or another region; its return route is not checked", peerID, owner)
+		return
+	}
+	if dstVPC.ID != peerID {
+		tr.fail("%s is in %s, not in the peer %s: peering is not transitive", addr, dstVPC.ID, peerID)
+		return
+	}
+	t.traceReturn(tr, dstVPC, dst, srcAddr, peering.ID)
+}
+
+func (t *Topology) traceTransitGateway(tr *Trace, srcVPC, dstVPC *VPC, dst *Subnet, srcAddr netip.Addr, tableID string, route Route) {
+	if _, ok := srcVPC.attachment(route.Target); !ok {
+		tr.fail("%s sends %s to %s, but %s is not attached to it", tableID, route.Destination, route.Target, srcVPC.ID)
+		return
+	}
+	tr.ok("%s sends %s to transit gateway %s", tableID, route.Destination, route.Target)
+
+	if dstVPC == nil {
+		tr.reach("The destination is outside the VPCs of this region; transit gateway route tables decide the rest")
+		return
+	}
+	attachment, ok := dstVPC.attachment(route.Target)
+	if !ok {
+		tr.fail("%s is not attached to %s", dstVPC.ID, route.Target)
+		return
+	}
+	tr.ok("%s is attached to %s (%s)", dstVPC.ID, route.Target, attachment.ID)
+	tr.info("Transit gateway route tables are not checked")
+	t.traceReturn(tr, dstVPC, dst, srcAddr, route.Target)
+}
+
+// traceReturn checks that the destination subnet routes replies back through
+// the same peering or transit gateway.
+func (t *Topology) traceReturn(tr *Trace, dstVPC *VPC, dst *Subnet, srcAddr netip.Addr, via string) {
+	if dst == nil {
+		tr.reach("%s is in %s but in no known subnet; its return route is not checked", srcAddr, dstVPC.ID)
+		return
+	}
+	table := dstVPC.routeTable(dst.RouteTable)
+	if table == nil {
+		tr.fail("Return path: %s has no route table", dst.ID)
+		return
+	}
+	back, ok := table.lookup(srcAddr)
+	switch {
+	case !ok:
+		tr.fail("Return path: %s (used by %s) has no route back to %s", table.ID, dst.ID, srcAddr)
+	case back.Blackhole():
+		tr.fail("Return path: %s sends %s to %s, which no longer exists (blackhole)", table.ID, back.Destination, back.Target)
+	case back.Target != via:
+		tr.fail("Return path: %s (used by %s) sends %s to %s, not %s", table.ID, dst.ID, back.Destination, back.Target, via)
+	default:
+		tr.reach("Return path: %s (used by %s) sends %s back through %s", table.ID, dst.ID, back.Destination, via)
+	}
+}
+
+func family(addr netip.Addr) string {
+	if addr.Is6() {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+// sortedVPCs returns the VPCs ordered by ID.
+func (t *Topology) sortedVPCs() []*VPC {
+	vpcs := make([]*VPC, 0, len(t.VPCs))
+	for _, vpc := range t.VPCs {
+		vpcs = append(vpcs, vpc)
+	}
+	slices.SortFunc(vpcs, func(a, b *VPC) int { return strings.Compare(a.ID, b.ID) })
+	return vpcs
+}
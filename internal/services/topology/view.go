@@ -0,0 +1,307 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+const traceFormID = "topology:trace"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for VPC routing topology.
+type View struct {
+	*base.TableView
+
+	formTarget string // VPC the trace form is open for
+}
+
+// NewView creates a new topology view.
+func NewView() *View {
+	columnDefs := []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 21, Weight: 0.5, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 15, MaxWidth: 50, Weight: 2.0, Priority: 1},
+		{Title: i18n.T("CIDR"), MinWidth: 12, MaxWidth: 40, Weight: 0.8, Priority: 1},
+		{Title: i18n.T("Subnets"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Route Tables"), MinWidth: 7, MaxWidth: 12, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("Peerings"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("TGW"), MinWidth: 4, MaxWidth: 5, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Blackholes"), MinWidth: 8, MaxWidth: 10, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+	}
+
+	return &View{
+		TableView: base.NewTableView("Topology", "", "topology", columnDefs),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.loadVPCs()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if row := v.GetSelectedResource(); row != nil {
+				return v, v.openTraceForm(row)
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				v.OpenDetail(i18n.T("Topology of %s", row.ID), formatTopology(row))
+			}
+		}
+
+	case components.FormResultMsg:
+		if msg.ID != traceFormID {
+			break
+		}
+		if msg.Canceled || v.formTarget == "" {
+			v.Message = i18n.T("Canceled")
+			break
+		}
+		v.Message = i18n.T("Tracing route from %v...", msg.Values["from"])
+		cmds = append(cmds, v.executeAction("trace", v.formTarget, msg.Values))
+
+	case vpcsLoadedMsg:
+		if msg.owner != v {
+			return v, nil
+		}
+		v.SetLoading(false)
+		if msg.err != nil {
+			v.SetError(msg.err)
+			v.Message = i18n.T("Error: %v", msg.err)
+		} else {
+			v.SetError(nil)
+			v.Resources = msg.resources
+			v.updateTable()
+			v.Message = i18n.T("Loaded %d VPCs", len(msg.resources))
+		}
+
+	case base.ActionResultMsg:
+		if msg.Error != nil {
+			v.Message = i18n.T("Action failed: %v", msg.Error)
+		} else if msg.Result != nil {
+			v.Message = msg.Result.Message
+			if trace, ok := msg.Result.Data.(Trace); ok {
+				v.OpenDetail(i18n.T("Route trace"), RenderTrace(trace))
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	cmds = append(cmds, v.UpdateTable(msg))
+	return v, tea.Batch(cmds...)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading VPC topology...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	lines = append(lines, v.Styles.Help.Render(i18n.T("[t]race route  [Enter]topology  [↑/↓]navigate  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh reloads the topology.
+func (v *View) Refresh() tea.Cmd {
+	return v.loadVPCs()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+type vpcsLoadedMsg struct {
+	owner     *View // Listings of a swapped-out view are dropped
+	resources []core.Resource
+	err       error
+}
+
+func (v *View) loadVPCs() tea.Cmd {
+	v.SetLoading(true)
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return vpcsLoadedMsg{owner: v, err: fmt.Errorf("service not initialized")}
+		}
+		lister, ok := service.(core.ResourceLister)
+		if !ok {
+			return vpcsLoadedMsg{owner: v, err: fmt.Errorf("service does not support listing")}
+		}
+		resources, err := lister.List(context.Background(), core.ListOptions{})
+		return vpcsLoadedMsg{owner: v, resources: resources, err: err}
+	}
+}
+
+// openTraceForm opens the trace form with the VPC's first subnet as source.
+func (v *View) openTraceForm(r *core.Resource) tea.Cmd {
+	def, ok := base.FindAction(v.Service(), "trace")
+	if !ok {
+		v.Message = i18n.T("Action %s not supported", "trace")
+		return nil
+	}
+	params := append([]core.ActionParameter(nil), def.Parameters...)
+	if vpc, ok := r.Metadata["vpc"].(VPC); ok && len(vpc.Subnets) > 0 {
+		for i := range params {
+			if params[i].Name == "from" {
+				params[i].Default = vpc.Subnets[0].ID
+			}
+		}
+	}
+	v.formTarget = r.ID
+	return v.OpenForm(components.NewForm(traceFormID, i18n.T("Trace route from %s", r.ID), params))
+}
+
+func (v *View) executeAction(action, resourceID string, params map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		service := v.Service()
+		if service == nil {
+			return base.ActionResultMsg{Error: fmt.Errorf("service not initialized")}
+		}
+		executor, ok := service.(core.ActionExecutor)
+		if !ok {
+			return base.ActionResultMsg{Error: fmt.Errorf("service does not support actions")}
+		}
+		result, err := core.ExecuteAction(context.Background(), executor, action, resourceID, params)
+		return base.ActionResultMsg{Action: action, Result: result, Error: err}
+	}
+}
+
+func (v *View) updateTable() {
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildRow(v.Resources[i])
+	})
+}
+
+func buildRow(r core.Resource) base.Row {
+	cidrs, _ := r.Metadata["cidrs"].([]string)
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 50)),
+		base.TextCell(strings.Join(cidrs, ", ")),
+		base.TextCell(fmt.Sprint(r.Metadata["subnets"])),
+		base.TextCell(fmt.Sprint(r.Metadata["routes"])),
+		base.TextCell(fmt.Sprint(r.Metadata["peerings"])),
+		base.TextCell(fmt.Sprint(r.Metadata["attachments"])),
+		base.TextCell(fmt.Sprint(r.Metadata["blackholes"])),
+		base.SeverityCell(r),
+	}
+}
+
+// formatTopology renders a VPC's topology and issues for the detail panel.
+func formatTopology(r *core.Resource) string {
+	vpc, ok := r.Metadata["vpc"].(VPC)
+	if !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(RenderVPC(vpc))
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	b.WriteString(i18n.T("\nPress [t] to trace a route from one of these subnets.\n"))
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	peerings, blackholes := 0, 0
+	for _, r := range v.Resources {
+		p, _ := r.Metadata["peerings"].(int)
+		bh, _ := r.Metadata["blackholes"].(int)
+		peerings += p
+		blackholes += bh
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "vpcs", Text: i18n.T("VPCs: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "peering-links", Text: i18n.T("Peering links: %d", peerings), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "blackhole-routes", Text: i18n.T("Blackhole routes: %d", blackholes), Tone: core.ToneWarning},
+	)
+}
+
+func (v *View) renderSummary() string {
+	return v.RenderSummary(i18n.T("VPC Topology"), v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "topology" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
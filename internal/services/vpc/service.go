@@ -0,0 +1,562 @@
+// Package vpc provides a VPC networking view for the a9s application. It
+// lists VPCs and enriches each one with its subnets, route tables, internet
+// gateways and NAT gateways, flagging NAT gateways billed by the hour that
+// carry no traffic.
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/iac"
+)
+
+// Resource kinds shown by the view, the VPCs themselves and the children
+// surfaced by enrichment.
+const (
+	KindVPCs             = "vpcs"
+	KindSubnets          = "subnets"
+	KindRouteTables      = "route_tables"
+	KindInternetGateways = "internet_gateways"
+	KindNATGateways      = "nat_gateways"
+)
+
+// NAT gateway hourly price used for cost estimates (us-east-1 on-demand).
+const natPricePerHour = 0.045
+
+const (
+	// trafficLookback is the window a NAT gateway must stay quiet to be
+	// flagged, long enough to cover weekly batch jobs.
+	trafficLookback = 14 * 24 * time.Hour
+
+	// idleBytes is the traffic over the lookback below which a NAT gateway
+	// is flagged as unused.
+	idleBytes = 1 << 30
+)
+
+// Subnet is a subnet of a VPC.
+type Subnet struct {
+	ID           string
+	Name         string
+	CIDR         string
+	Zone         string
+	AvailableIPs int32
+	RouteTable   string // Explicitly associated table, or the main one
+	Public       bool   // Default route through an internet gateway
+}
+
+// RouteTable is a route table of a VPC.
+type RouteTable struct {
+	ID           string
+	Name         string
+	Main         bool
+	Subnets      []string // Explicitly associated subnets
+	Routes       int
+	DefaultRoute string // Target of 0.0.0.0/0, empty when there is none
+}
+
+// InternetGateway is an internet gateway attached to a VPC.
+type InternetGateway struct {
+	ID    string
+	Name  string
+	State string
+}
+
+// NATGateway is a NAT gateway of a VPC.
+type NATGateway struct {
+	ID        string
+	Name      string
+	State     string
+	SubnetID  string
+	Zone      string
+	PublicIP  string
+	CreatedAt *time.Time
+
+	Routed       bool    // A route table of the VPC sends traffic to it
+	Bytes        float64 // Bytes processed over the lookback
+	TrafficKnown bool    // Bytes could be read from CloudWatch
+	Monthly      float64 // Estimated hourly charge per month
+	Unused       string  // Why it looks unused, empty when it is used
+}
+
+// =============================================================================
+// Service Implementation
+// =============================================================================
+
+// Service implements VPC networking operations.
+type Service struct {
+	factory       *awsfactory.ClientFactory
+	dispatcher    core.EventDispatcher
+	testClient    EC2API
+	metricsClient CloudWatchAPI
+}
+
+// Option configures the VPC service.
+type Option func(*Service)
+
+// WithMetricsClient sets a custom CloudWatch client (for testing).
+func WithMetricsClient(client CloudWatchAPI) Option {
+	return func(s *Service) {
+		s.metricsClient = client
+	}
+}
+
+// EC2API defines the EC2 client interface for mocking.
+type EC2API interface {
+	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+	DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeInternetGateways(ctx context.Context, params *ec2.DescribeInternetGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInternetGatewaysOutput, error)
+	DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error)
+}
+
+// CloudWatchAPI defines the CloudWatch client interface for mocking.
+type CloudWatchAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// NewService creates a new VPC service.
+func NewService(factory *awsfactory.ClientFactory, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		factory:    factory,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewServiceWithClient creates a service with a custom client (for testing).
+func NewServiceWithClient(client EC2API, dispatcher core.EventDispatcher, opts ...Option) *Service {
+	s := &Service{
+		testClient: client,
+		dispatcher: dispatcher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// client returns the EC2 client, fetching fresh from factory each time.
+func (s *Service) client() EC2API {
+	if s.testClient != nil {
+		return s.testClient
+	}
+	return s.factory.EC2Client()
+}
+
+// metrics returns the CloudWatch client.
+func (s *Service) metrics() CloudWatchAPI {
+	if s.metricsClient != nil {
+		return s.metricsClient
+	}
+	return s.factory.CloudWatchClient()
+}
+
+// =============================================================================
+// AWSService Interface Implementation
+// =============================================================================
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "vpc"
+}
+
+// Description returns the service description.
+func (s *Service) Description() string {
+	return "VPC Networking"
+}
+
+// Icon returns the service icon.
+func (s *Service) Icon() string {
+	return "network"
+}
+
+// Initialize sets up the service.
+func (s *Service) Initialize(_ context.Context, _ *core.AWSConfig) error {
+	return nil
+}
+
+// Close releases service resources.
+func (s *Service) Close() error {
+	return nil
+}
+
+// HealthCheck verifies the service can communicate with AWS.
+func (s *Service) HealthCheck(ctx context.Context) error {
+	_, err := s.client().DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		MaxResults: aws.Int32(5),
+	})
+	if err != nil {
+		return core.NewServiceError("vpc", "health_check", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// ResourceLister Interface Implementation
+// =============================================================================
+
+// List returns the VPCs of the region. Their networking is added by
+// EnrichResource.
+func (s *Service) List(ctx context.Context, _ core.ListOptions) ([]core.Resource, error) {
+	resources := make([]core.Resource, 0)
+	paginator := ec2.NewDescribeVpcsPaginator(s.client(), &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.dispatchError(ctx, "list", err)
+			return nil, core.NewServiceError("vpc", "list", err)
+		}
+		for _, v := range page.Vpcs {
+			resources = append(resources, vpcToResource(v))
+		}
+	}
+
+	s.dispatchEvent(ctx, core.EventResourceListed, core.ResourceEventData{
+		ResourceType: "ec2:vpc",
+		Count:        len(resources),
+	})
+
+	return resources, nil
+}
+
+// EnrichResource adds the subnets, route tables, internet gateways and NAT
+// gateways of a VPC, and flags NAT gateways no route uses or that processed
+// under 1 GB in 14 days.
+func (s *Service) EnrichResource(ctx context.Context, resource *core.Resource) error {
+	vpcFilter := []types.Filter{{Name: aws.String("vpc-id"), Values: []string{resource.ID}}}
+
+	var tables []RouteTable
+	var mainTable string
+	natRouted := make(map[string]bool)
+	tablePages := ec2.NewDescribeRouteTablesPaginator(s.client(), &ec2.DescribeRouteTablesInput{Filters: vpcFilter})
+	for tablePages.HasMorePages() {
+		page, err := tablePages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, rt := range page.RouteTables {
+			table := routeTableOf(rt)
+			if table.Main {
+				mainTable = table.ID
+			}
+			for _, route := range rt.Routes {
+				if id := aws.ToString(route.NatGatewayId); id != "" {
+					natRouted[id] = true
+				}
+			}
+			tables = append(tables, table)
+		}
+	}
+
+	explicit := make(map[string]string)
+	public := make(map[string]bool)
+	for _, table := range tables {
+		for _, subnet := range table.Subnets {
+			explicit[subnet] = table.ID
+		}
+		public[table.ID] = strings.HasPrefix(table.DefaultRoute, "igw-")
+	}
+
+	var subnets []Subnet
+	subnetPages := ec2.NewDescribeSubnetsPaginator(s.client(), &ec2.DescribeSubnetsInput{Filters: vpcFilter})
+	for subnetPages.HasMorePages() {
+		page, err := subnetPages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, sn := range page.Subnets {
+			subnet := Subnet{
+				ID:           aws.ToString(sn.SubnetId),
+				Name:         nameTag(sn.Tags),
+				CIDR:         aws.ToString(sn.CidrBlock),
+				Zone:         aws.ToString(sn.AvailabilityZone),
+				AvailableIPs: aws.ToInt32(sn.AvailableIpAddressCount),
+				RouteTable:   mainTable,
+			}
+			if table, ok := explicit[subnet.ID]; ok {
+				subnet.RouteTable = table
+			}
+			subnet.Public = public[subnet.RouteTable]
+			subnets = append(subnets, subnet)
+		}
+	}
+	slices.SortFunc(subnets, func(a, b Subnet) int {
+		return strings.Compare(a.Zone+a.CIDR, b.Zone+b.CIDR)
+	})
+
+	var igws []InternetGateway
+	igwPages := ec2.NewDescribeInternetGatewaysPaginator(s.client(), &ec2.DescribeInternetGatewaysInput{
+		Filters: []types.Filter{{Name: aws.String("attachment.vpc-id"), Values: []string{resource.ID}}},
+	})
+	for igwPages.HasMorePages() {
+		page, err := igwPages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, igw := range page.InternetGateways {
+			state := ""
+			for _, attachment := range igw.Attachments {
+				if aws.ToString(attachment.VpcId) == resource.ID {
+					state = string(attachment.State)
+				}
+			}
+			igws = append(igws, InternetGateway{ID: aws.ToString(igw.InternetGatewayId), Name: nameTag(igw.Tags), State: state})
+		}
+	}
+
+	zones := make(map[string]string, len(subnets))
+	for _, subnet := range subnets {
+		zones[subnet.ID] = subnet.Zone
+	}
+	var nats []NATGateway
+	natPages := ec2.NewDescribeNatGatewaysPaginator(s.client(), &ec2.DescribeNatGatewaysInput{
+		Filter: []types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{resource.ID}},
+			{Name: aws.String("state"), Values: []string{"pending", "available", "failed", "deleting"}},
+		},
+	})
+	for natPages.HasMorePages() {
+		page, err := natPages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, gw := range page.NatGateways {
+			nat := natGatewayOf(gw)
+			nat.Zone = zones[nat.SubnetID]
+			nat.Routed = natRouted[nat.ID]
+			nats = append(nats, nat)
+		}
+	}
+
+	// Without traffic figures, gateways are only judged by their routes
+	if traffic, err := s.getTraffic(ctx, nats, time.Now()); err == nil {
+		for i := range nats {
+			nats[i].Bytes = traffic[nats[i].ID]
+			nats[i].TrafficKnown = true
+		}
+	}
+
+	monthly := 0.0
+	for i := range nats {
+		nat := &nats[i]
+		if nat.State != string(types.NatGatewayStateAvailable) {
+			continue
+		}
+		nat.Monthly = estimate.Monthly(natPricePerHour)
+		monthly += nat.Monthly
+		switch {
+		case !nat.Routed:
+			nat.Unused = "No route table sends traffic to it"
+		case nat.TrafficKnown && nat.Bytes < idleBytes:
+			nat.Unused = "Under 1 GB in 14 days"
+		}
+		if nat.Unused != "" {
+			resource.AddIssue(core.SeverityMedium, fmt.Sprintf("NAT gateway %s unused: %s (%s/mo)", nat.ID, nat.Unused, estimate.FormatCost(nat.Monthly)))
+		}
+	}
+
+	resource.Metadata[KindSubnets] = subnets
+	resource.Metadata[KindRouteTables] = tables
+	resource.Metadata[KindInternetGateways] = igws
+	resource.Metadata[KindNATGateways] = nats
+	estimate.ApplyCost(resource, monthly)
+	resource.Metadata["analyzed"] = true
+
+	return nil
+}
+
+// getTraffic returns the bytes processed by each gateway over the lookback,
+// in a single GetMetricData call.
+func (s *Service) getTraffic(ctx context.Context, nats []NATGateway, now time.Time) (map[string]float64, error) {
+	traffic := make(map[string]float64, len(nats))
+	if len(nats) == 0 {
+		return traffic, nil
+	}
+
+	var queries []cwtypes.MetricDataQuery
+	ids := make(map[string]string) // query ID -> gateway ID
+	for i, nat := range nats {
+		for _, name := range []string{"BytesOutToDestination", "BytesOutToSource"} {
+			id := fmt.Sprintf("%s_%d", strings.ToLower(name[len("BytesOutTo"):]), i)
+			ids[id] = nat.ID
+			queries = append(queries, cwtypes.MetricDataQuery{
+				Id: aws.String(id),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Namespace:  aws.String("AWS/NATGateway"),
+						MetricName: aws.String(name),
+						Dimensions: []cwtypes.Dimension{
+							{Name: aws.String("NatGatewayId"), Value: aws.String(nat.ID)},
+						},
+					},
+					Period: aws.Int32(86400),
+					Stat:   aws.String("Sum"),
+				},
+			})
+		}
+	}
+
+	paginator := cloudwatch.NewGetMetricDataPaginator(s.metrics(), &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(now.Add(-trafficLookback)),
+		EndTime:           aws.Time(now),
+		MetricDataQueries: queries,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range page.MetricDataResults {
+			for _, v := range result.Values {
+				traffic[ids[aws.ToString(result.Id)]] += v
+			}
+		}
+	}
+	return traffic, nil
+}
+
+func vpcToResource(v types.Vpc) core.Resource {
+	resource := core.Resource{
+		ID:    aws.ToString(v.VpcId),
+		Type:  "ec2:vpc",
+		State: string(v.State),
+		Tags:  make(map[string]string),
+		Metadata: map[string]any{
+			"cidr":     aws.ToString(v.CidrBlock),
+			"default":  aws.ToBool(v.IsDefault),
+			"tenancy":  string(v.InstanceTenancy),
+			"owner_id": aws.ToString(v.OwnerId),
+			"analyzed": false,
+		},
+	}
+
+	var cidrs []string
+	for _, assoc := range v.CidrBlockAssociationSet {
+		cidrs = append(cidrs, aws.ToString(assoc.CidrBlock))
+	}
+	for _, assoc := range v.Ipv6CidrBlockAssociationSet {
+		cidrs = append(cidrs, aws.ToString(assoc.Ipv6CidrBlock))
+	}
+	resource.Metadata["cidrs"] = cidrs
+
+	for _, tag := range v.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		resource.Tags[key] = value
+		if key == "Name" {
+			resource.Name = value
+		}
+	}
+	if resource.Name == "" {
+		resource.Name = resource.ID
+	}
+	iac.Apply(&resource)
+
+	return resource
+}
+
+func routeTableOf(rt types.RouteTable) RouteTable {
+	table := RouteTable{
+		ID:     aws.ToString(rt.RouteTableId),
+		Name:   nameTag(rt.Tags),
+		Routes: len(rt.Routes),
+	}
+	for _, assoc := range rt.Associations {
+		if aws.ToBool(assoc.Main) {
+			table.Main = true
+		} else if subnet := aws.ToString(assoc.SubnetId); subnet != "" {
+			table.Subnets = append(table.Subnets, subnet)
+		}
+	}
+	for _, route := range rt.Routes {
+		if aws.ToString(route.DestinationCidrBlock) == "0.0.0.0/0" {
+			table.DefaultRoute = routeTarget(route)
+		}
+	}
+	return table
+}
+
+// routeTarget returns the ID of whatever a route sends traffic to.
+func routeTarget(r types.Route) string {
+	for _, target := range []*string{
+		r.GatewayId, r.NatGatewayId, r.TransitGatewayId, r.VpcPeeringConnectionId,
+		r.NetworkInterfaceId, r.InstanceId, r.EgressOnlyInternetGatewayId,
+	} {
+		if id := aws.ToString(target); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func natGatewayOf(gw types.NatGateway) NATGateway {
+	nat := NATGateway{
+		ID:        aws.ToString(gw.NatGatewayId),
+		Name:      nameTag(gw.Tags),
+		State:     string(gw.State),
+		SubnetID:  aws.ToString(gw.SubnetId),
+		CreatedAt: gw.CreateTime,
+	}
+	for _, addr := range gw.NatGatewayAddresses {
+		if aws.ToBool(addr.IsPrimary) || len(gw.NatGatewayAddresses) == 1 {
+			nat.PublicIP = aws.ToString(addr.PublicIp)
+		}
+	}
+	return nat
+}
+
+func nameTag(tags []types.Tag) string {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return ""
+}
+
+// =============================================================================
+// Helper Functions
+// =============================================================================
+
+func (s *Service) dispatchEvent(ctx context.Context, eventType core.EventType, data any) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(eventType, "vpc", data)
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+func (s *Service) dispatchError(ctx context.Context, op string, err error) {
+	if s.dispatcher != nil {
+		event := core.NewEvent(core.EventError, "vpc", map[string]string{
+			"operation": op,
+			"error":     err.Error(),
+		})
+		_ = s.dispatcher.Dispatch(ctx, event)
+	}
+}
+
+// =============================================================================
+// Interface Assertions
+// =============================================================================
+
+var (
+	_ core.AWSService       = (*Service)(nil)
+	_ core.ResourceLister   = (*Service)(nil)
+	_ core.ResourceEnricher = (*Service)(nil)
+)
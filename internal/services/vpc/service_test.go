@@ -0,0 +1,195 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/core/coretest"
+	"github.com/keanuharrell/a9s/internal/estimate"
+)
+
+// fakeEC2 serves vpc-main with a public subnet routed through its internet
+// gateway and two private subnets, one on the main route table through
+// nat-busy and one routed through nat-quiet. A third NAT gateway,
+// nat-orphan, has no route. It fails every call when err is set.
+type fakeEC2 struct {
+	err error
+}
+
+func (f *fakeEC2) DescribeVpcs(context.Context, *ec2.DescribeVpcsInput, ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeVpcsOutput{Vpcs: []types.Vpc{{
+		VpcId:     aws.String("vpc-main"),
+		CidrBlock: aws.String("10.0.0.0/16"),
+		State:     types.VpcStateAvailable,
+		Tags:      []types.Tag{{Key: aws.String("Name"), Value: aws.String("main")}},
+	}}}, nil
+}
+
+func (f *fakeEC2) DescribeSubnets(context.Context, *ec2.DescribeSubnetsInput, ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeSubnetsOutput{Subnets: []types.Subnet{
+		{SubnetId: aws.String("subnet-private"), CidrBlock: aws.String("10.0.2.0/24"), AvailabilityZone: aws.String("us-east-1a")},
+		{SubnetId: aws.String("subnet-batch"), CidrBlock: aws.String("10.0.3.0/24"), AvailabilityZone: aws.String("us-east-1b")},
+		{SubnetId: aws.String("subnet-public"), CidrBlock: aws.String("10.0.1.0/24"), AvailabilityZone: aws.String("us-east-1a")},
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeRouteTables(context.Context, *ec2.DescribeRouteTablesInput, ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	table := func(id, subnet string, target types.Route) types.RouteTable {
+		target.DestinationCidrBlock = aws.String("0.0.0.0/0")
+		assoc := types.RouteTableAssociation{Main: aws.Bool(subnet == "")}
+		if subnet != "" {
+			assoc.SubnetId = aws.String(subnet)
+		}
+		return types.RouteTable{
+			RouteTableId: aws.String(id),
+			Associations: []types.RouteTableAssociation{assoc},
+			Routes:       []types.Route{{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")}, target},
+		}
+	}
+	return &ec2.DescribeRouteTablesOutput{RouteTables: []types.RouteTable{
+		table("rtb-main", "", types.Route{NatGatewayId: aws.String("nat-busy")}),
+		table("rtb-public", "subnet-public", types.Route{GatewayId: aws.String("igw-main")}),
+		table("rtb-batch", "subnet-batch", types.Route{NatGatewayId: aws.String("nat-quiet")}),
+	}}, nil
+}
+
+func (f *fakeEC2) DescribeInternetGateways(context.Context, *ec2.DescribeInternetGatewaysInput, ...func(*ec2.Options)) (*ec2.DescribeInternetGatewaysOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ec2.DescribeInternetGatewaysOutput{InternetGateways: []types.InternetGateway{{
+		InternetGatewayId: aws.String("igw-main"),
+		Attachments:       []types.InternetGatewayAttachment{{VpcId: aws.String("vpc-main"), State: types.AttachmentStatusAttached}},
+	}}}, nil
+}
+
+func (f *fakeEC2) DescribeNatGateways(context.Context, *ec2.DescribeNatGatewaysInput, ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var gateways []types.NatGateway
+	for _, id := range []string{"nat-busy", "nat-quiet", "nat-orphan"} {
+		gateways = append(gateways, types.NatGateway{
+			NatGatewayId: aws.String(id),
+			State:        types.NatGatewayStateAvailable,
+			SubnetId:     aws.String("subnet-public"),
+		})
+	}
+	return &ec2.DescribeNatGatewaysOutput{NatGateways: gateways}, nil
+}
+
+// fakeCloudWatch reports 5 GB a day out of the first NAT gateway queried
+// and nothing out of the others, or fails when err is set.
+type fakeCloudWatch struct {
+	err error
+}
+
+func (f fakeCloudWatch) GetMetricData(_ context.Context, in *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var results []cwtypes.MetricDataResult
+	for _, query := range in.MetricDataQueries {
+		result := cwtypes.MetricDataResult{Id: query.Id}
+		if strings.HasSuffix(aws.ToString(query.Id), "_0") {
+			result.Values = []float64{5e9, 5e9}
+		}
+		results = append(results, result)
+	}
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, nil
+}
+
+// TestServiceConformance runs the core service contract against VPCs.
+func TestServiceConformance(t *testing.T) {
+	coretest.RunServiceTests(t, coretest.Fixture{
+		New: func(d core.EventDispatcher) core.AWSService {
+			return NewServiceWithClient(&fakeEC2{}, d, WithMetricsClient(fakeCloudWatch{}))
+		},
+		NewFailing: func(d core.EventDispatcher) core.AWSService {
+			err := errors.New("UnauthorizedOperation")
+			return NewServiceWithClient(&fakeEC2{err: err}, d, WithMetricsClient(fakeCloudWatch{err: err}))
+		},
+		ExistingID: "vpc-main",
+	})
+}
+
+func TestEnrichRoutesSubnets(t *testing.T) {
+	svc := NewServiceWithClient(&fakeEC2{}, nil, WithMetricsClient(fakeCloudWatch{}))
+	resource := core.Resource{ID: "vpc-main", Metadata: map[string]any{}}
+	if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+		t.Fatalf("EnrichResource() error = %v", err)
+	}
+
+	subnets := resource.Metadata[KindSubnets].([]Subnet)
+	want := []struct {
+		id, table string
+		public    bool
+	}{
+		{"subnet-public", "rtb-public", true},
+		{"subnet-private", "rtb-main", false},
+		{"subnet-batch", "rtb-batch", false},
+	}
+	if len(subnets) != len(want) {
+		t.Fatalf("subnets = %+v", subnets)
+	}
+	for i, w := range want {
+		if s := subnets[i]; s.ID != w.id || s.RouteTable != w.table || s.Public != w.public {
+			t.Errorf("subnet %d = %s on %s, public %v; want %s on %s, public %v", i, s.ID, s.RouteTable, s.Public, w.id, w.table, w.public)
+		}
+	}
+}
+
+// TestEnrichFlagsUnusedNATGateways checks that gateways without a route are
+// always flagged, and quiet ones only when their traffic could be read.
+func TestEnrichFlagsUnusedNATGateways(t *testing.T) {
+	tests := []struct {
+		name    string
+		metrics fakeCloudWatch
+		unused  map[string]string
+	}{
+		{"with traffic", fakeCloudWatch{}, map[string]string{
+			"nat-quiet":  "Under 1 GB in 14 days",
+			"nat-orphan": "No route table sends traffic to it",
+		}},
+		{"without traffic", fakeCloudWatch{err: errors.New("AccessDenied")}, map[string]string{
+			"nat-orphan": "No route table sends traffic to it",
+		}},
+	}
+	for _, tt := range tests {
+		svc := NewServiceWithClient(&fakeEC2{}, nil, WithMetricsClient(tt.metrics))
+		resource := core.Resource{ID: "vpc-main", Metadata: map[string]any{}}
+		if err := svc.EnrichResource(context.Background(), &resource); err != nil {
+			t.Fatalf("%s: EnrichResource() error = %v", tt.name, err)
+		}
+
+		for _, nat := range resource.Metadata[KindNATGateways].([]NATGateway) {
+			if nat.Unused != tt.unused[nat.ID] {
+				t.Errorf("%s: %s unused = %q, want %q", tt.name, nat.ID, nat.Unused, tt.unused[nat.ID])
+			}
+		}
+		if issues := resource.Issues(); len(issues) != len(tt.unused) {
+			t.Errorf("%s: issues = %v", tt.name, issues)
+		}
+		if cost := resource.Metadata[estimate.MonthlyCostKey]; cost != 3*estimate.Monthly(natPricePerHour) {
+			t.Errorf("%s: monthly cost = %v, want three gateways", tt.name, cost)
+		}
+	}
+}
@@ -0,0 +1,606 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/estimate"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+)
+
+// kinds is the order [t] cycles through.
+var kinds = []string{KindVPCs, KindSubnets, KindRouteTables, KindInternetGateways, KindNATGateways}
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View implements the TUI view for VPC networking. VPCs are listed and
+// enriched first; their subnets, route tables, internet gateways and NAT
+// gateways are then shown as a level of their own, see showKind.
+type View struct {
+	*base.EnrichableTableView
+}
+
+// NewView creates a new VPC view.
+func NewView() *View {
+	return &View{
+		EnrichableTableView: base.NewEnrichableTableView("VPC", "", "vpc", i18n.T("VPCs"), vpcColumns(), buildRow),
+	}
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init initializes the view and starts loading data.
+func (v *View) Init() tea.Cmd {
+	// Don't reload if we already have data or are currently loading
+	if len(v.Resources) > 0 || v.IsLoading() {
+		return nil
+	}
+	return v.Load()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if handled, cmd := v.HandleOverlay(msg); handled {
+		return v, cmd
+	}
+	if handled, cmd := v.HandleEnrichment(msg); handled {
+		return v, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "t":
+			if v.IsEnriching() {
+				v.Message = i18n.T("Still analyzing VPCs, try again once done")
+				break
+			}
+			v.showKind(v.nextKind())
+		case "a":
+			if row := v.GetSelectedResource(); row != nil && v.kind() == KindVPCs {
+				v.Message = i18n.T("Analyzing %s...", row.Name)
+				return v, v.AnalyzeSelected()
+			}
+		case "enter":
+			if row := v.GetSelectedResource(); row != nil {
+				if v.kind() == KindVPCs {
+					v.OpenDetail(i18n.T("VPC %s", row.Name), formatVPC(row))
+				} else {
+					v.OpenDetail(row.Name, formatChild(row))
+				}
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		v.HandleWindowSize(msg)
+	}
+
+	return v, v.UpdateTable(msg)
+}
+
+// View renders the view.
+func (v *View) View() string {
+	var lines []string
+
+	// Line 1: Summary
+	lines = append(lines, v.renderSummary())
+	// Line 2: Blank
+	lines = append(lines, "")
+
+	// Table or loading/error
+	if v.IsLoading() && len(v.Resources) == 0 {
+		lines = append(lines, v.Styles.Muted.Render(i18n.T("Loading VPCs...")))
+	} else if err := v.Error(); err != nil {
+		lines = append(lines, v.Styles.Error.Render(i18n.T("Error: %v", err)))
+	} else {
+		lines = append(lines, v.ContentView())
+	}
+
+	// Message or blank
+	if v.Message != "" {
+		lines = append(lines, v.Styles.Info.Render(v.Message))
+	} else {
+		lines = append(lines, "")
+	}
+
+	// Help
+	if v.kind() == KindVPCs {
+		lines = append(lines, v.Styles.Help.Render(i18n.T("[t]ype  [a]nalyze  [Enter]details  [↑/↓]navigate  [r]efresh")))
+	} else {
+		lines = append(lines, v.Styles.Help.Render(i18n.T("[t]ype  [Enter]details  [Esc]VPCs  [↑/↓]navigate  [r]efresh")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Refresh comes back to the VPCs and reloads them, keeping their analysis.
+func (v *View) Refresh() tea.Cmd {
+	for v.DrillUp() {
+	}
+	return v.SoftRefresh()
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// kind returns the kind of resource shown.
+func (v *View) kind() string {
+	if kind := v.DrillFilters()["kind"]; kind != "" {
+		return kind
+	}
+	return KindVPCs
+}
+
+// nextKind returns the kind shown after the current one.
+func (v *View) nextKind() string {
+	for i, kind := range kinds {
+		if kind == v.kind() {
+			return kinds[(i+1)%len(kinds)]
+		}
+	}
+	return KindVPCs
+}
+
+// showKind shows the VPCs, or the children of every analyzed VPC of a kind
+// as a level below them. Esc comes back to the VPCs, see base.TableView.
+func (v *View) showKind(kind string) {
+	for v.DrillUp() {
+	}
+	if kind == KindVPCs {
+		return
+	}
+
+	vpcs := v.Resources
+	v.DrillDown(base.DrillLevel{
+		Title:      kindTitle(kind),
+		Filters:    map[string]string{"kind": kind},
+		ColumnDefs: kindColumns(kind),
+	})
+	v.Resources = children(vpcs, kind)
+	v.SetCellSource(len(v.Resources), func(i int) base.Row {
+		return buildChildRow(kind, v.Resources[i])
+	})
+	v.Message = i18n.T("%d %s across %d VPCs", len(v.Resources), strings.ToLower(kindTitle(kind)), len(vpcs))
+}
+
+// kindTitle returns the display name of a kind.
+func kindTitle(kind string) string {
+	switch kind {
+	case KindSubnets:
+		return i18n.T("Subnets")
+	case KindRouteTables:
+		return i18n.T("Route Tables")
+	case KindInternetGateways:
+		return i18n.T("Internet Gateways")
+	case KindNATGateways:
+		return i18n.T("NAT Gateways")
+	}
+	return i18n.T("VPCs")
+}
+
+func vpcColumns() []base.ColumnDef {
+	return []base.ColumnDef{
+		{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 22, Weight: 0.8, Priority: 0},
+		{Title: i18n.T("Name"), MinWidth: 10, MaxWidth: 30, Weight: 1.5, Priority: 0},
+		{Title: i18n.T("CIDR"), MinWidth: 10, MaxWidth: 18, Weight: 0.5, Priority: 1},
+		{Title: i18n.T("Default"), MinWidth: 7, MaxWidth: 8, Weight: 0.2, Priority: 3},
+		{Title: i18n.T("Subnets"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 2},
+		{Title: i18n.T("RTs"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 3},
+		{Title: i18n.T("IGW"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 3},
+		{Title: i18n.T("NAT"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 1},
+		{Title: i18n.T("Unused NAT"), MinWidth: 10, MaxWidth: 12, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("IaC"), MinWidth: 6, MaxWidth: 16, Weight: 0.4, Priority: 4},
+	}
+}
+
+func kindColumns(kind string) []base.ColumnDef {
+	id := base.ColumnDef{Title: i18n.T("ID"), MinWidth: 12, MaxWidth: 26, Weight: 0.8, Priority: 0}
+	name := base.ColumnDef{Title: i18n.T("Name"), MinWidth: 10, MaxWidth: 30, Weight: 1.2, Priority: 1}
+	vpc := base.ColumnDef{Title: i18n.T("VPC"), MinWidth: 12, MaxWidth: 30, Weight: 0.8, Priority: 2}
+
+	switch kind {
+	case KindSubnets:
+		return []base.ColumnDef{id, name, vpc,
+			{Title: i18n.T("AZ"), MinWidth: 6, MaxWidth: 12, Weight: 0.3, Priority: 2},
+			{Title: i18n.T("CIDR"), MinWidth: 10, MaxWidth: 18, Weight: 0.5, Priority: 0},
+			{Title: i18n.T("Free IPs"), MinWidth: 8, MaxWidth: 9, Weight: 0.2, Priority: 1},
+			{Title: i18n.T("Route Table"), MinWidth: 12, MaxWidth: 24, Weight: 0.6, Priority: 3},
+			{Title: i18n.T("Public"), MinWidth: 6, MaxWidth: 7, Weight: 0.2, Priority: 1},
+		}
+	case KindRouteTables:
+		return []base.ColumnDef{id, name, vpc,
+			{Title: i18n.T("Main"), MinWidth: 4, MaxWidth: 5, Weight: 0.1, Priority: 2},
+			{Title: i18n.T("Subnets"), MinWidth: 7, MaxWidth: 9, Weight: 0.2, Priority: 1},
+			{Title: i18n.T("Routes"), MinWidth: 6, MaxWidth: 7, Weight: 0.2, Priority: 3},
+			{Title: i18n.T("Default Route"), MinWidth: 13, MaxWidth: 26, Weight: 0.7, Priority: 0},
+		}
+	case KindInternetGateways:
+		return []base.ColumnDef{id, name, vpc,
+			{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 0},
+		}
+	}
+	return []base.ColumnDef{id, name, vpc,
+		{Title: i18n.T("AZ"), MinWidth: 6, MaxWidth: 12, Weight: 0.3, Priority: 3},
+		{Title: i18n.T("State"), MinWidth: 10, MaxWidth: 14, Weight: 0.4, Priority: 2},
+		{Title: i18n.T("Routed"), MinWidth: 6, MaxWidth: 7, Weight: 0.2, Priority: 1},
+		{Title: i18n.T("GB/14d"), MinWidth: 6, MaxWidth: 9, Weight: 0.2, Priority: 0},
+		{Title: i18n.T("Est. $/mo"), MinWidth: 9, MaxWidth: 12, Weight: 0.3, Priority: 1},
+		{Title: i18n.T("Age"), MinWidth: 5, MaxWidth: 6, Weight: 0.2, Priority: 4},
+		{Title: i18n.T("Severity"), MinWidth: 10, MaxWidth: 14, Weight: 0.3, Priority: 0},
+	}
+}
+
+func buildRow(r core.Resource) base.Row {
+	analyzed, _ := r.Metadata["analyzed"].(bool)
+	subnets, _ := r.Metadata[KindSubnets].([]Subnet)
+	tables, _ := r.Metadata[KindRouteTables].([]RouteTable)
+	igws, _ := r.Metadata[KindInternetGateways].([]InternetGateway)
+	nats, _ := r.Metadata[KindNATGateways].([]NATGateway)
+
+	def := ""
+	if isDefault, _ := r.Metadata["default"].(bool); isDefault {
+		def = "✓"
+	}
+
+	count := func(n int) base.Cell {
+		if !analyzed {
+			return base.TextCell("...")
+		}
+		return base.LazyCell(n, func() string { return fmt.Sprintf("%d", n) })
+	}
+	unused := count(unusedNATs(nats))
+	if n := unusedNATs(nats); analyzed && n > 0 {
+		unused = base.LazyCell(n, func() string { return fmt.Sprintf("🟡 %d", n) })
+	}
+
+	return base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(r.Name, 30)),
+		base.TextCell(r.GetMetadataString("cidr")),
+		base.TextCell(def),
+		count(len(subnets)),
+		count(len(tables)),
+		count(len(igws)),
+		count(len(nats)),
+		unused,
+		base.CostCell(r),
+		base.SeverityCell(r),
+		base.TextCell(base.FormatIaC(r)),
+	}
+}
+
+func buildChildRow(kind string, r core.Resource) base.Row {
+	row := base.Row{
+		base.TextCell(r.ID),
+		base.TextCell(base.TruncateString(displayName(r), 30)),
+		base.TextCell(r.GetMetadataString("vpc")),
+	}
+
+	switch kind {
+	case KindSubnets:
+		free, _ := r.Metadata["available_ips"].(int32)
+		public := ""
+		if p, _ := r.Metadata["public"].(bool); p {
+			public = "✓"
+		}
+		return append(row,
+			base.TextCell(r.GetMetadataString("zone")),
+			base.TextCell(r.GetMetadataString("cidr")),
+			base.LazyCell(free, func() string { return fmt.Sprintf("%d", free) }),
+			base.TextCell(r.GetMetadataString("route_table")),
+			base.TextCell(public),
+		)
+	case KindRouteTables:
+		subnets, _ := r.Metadata["subnets"].([]string)
+		routes, _ := r.Metadata["routes"].(int)
+		main := ""
+		if m, _ := r.Metadata["main"].(bool); m {
+			main = "✓"
+		}
+		defaultRoute := r.GetMetadataString("default_route")
+		if defaultRoute == "" {
+			defaultRoute = "-"
+		}
+		return append(row,
+			base.TextCell(main),
+			base.LazyCell(len(subnets), func() string { return fmt.Sprintf("%d", len(subnets)) }),
+			base.LazyCell(routes, func() string { return fmt.Sprintf("%d", routes) }),
+			base.TextCell(defaultRoute),
+		)
+	case KindInternetGateways:
+		return append(row, base.TextCell(base.FormatState(r.State)))
+	}
+
+	routed := "✓"
+	if ok, _ := r.Metadata["routed"].(bool); !ok {
+		routed = "🟡 ✗"
+	}
+	gb := "-"
+	var gbValue any
+	if known, _ := r.Metadata["traffic_known"].(bool); known {
+		bytes, _ := r.Metadata["bytes"].(float64)
+		gbValue = bytes
+		gb = fmt.Sprintf("%.1f", bytes/(1<<30))
+	}
+	return append(row,
+		base.TextCell(r.GetMetadataString("zone")),
+		base.TextCell(base.FormatState(r.State)),
+		base.TextCell(routed),
+		base.LazyCell(gbValue, func() string { return gb }),
+		base.CostCell(r),
+		base.AgeCell(r),
+		base.SeverityCell(r),
+	)
+}
+
+// children returns the children of a kind of every analyzed VPC as
+// resources of their own, so they can be sorted and inspected.
+func children(vpcs []core.Resource, kind string) []core.Resource {
+	now := time.Now()
+	var resources []core.Resource
+	for _, vpc := range vpcs {
+		child := func(id, name, typ, state string, metadata map[string]any) core.Resource {
+			metadata["vpc"] = vpc.ID
+			metadata["vpc_name"] = vpc.Name
+			if name == "" {
+				name = id
+			}
+			return core.Resource{ID: id, Name: name, Type: typ, State: state, Region: vpc.Region, Metadata: metadata}
+		}
+
+		switch kind {
+		case KindSubnets:
+			subnets, _ := vpc.Metadata[KindSubnets].([]Subnet)
+			for _, s := range subnets {
+				resources = append(resources, child(s.ID, s.Name, "ec2:subnet", "", map[string]any{
+					"cidr":          s.CIDR,
+					"zone":          s.Zone,
+					"available_ips": s.AvailableIPs,
+					"route_table":   s.RouteTable,
+					"public":        s.Public,
+				}))
+			}
+		case KindRouteTables:
+			tables, _ := vpc.Metadata[KindRouteTables].([]RouteTable)
+			for _, t := range tables {
+				resources = append(resources, child(t.ID, t.Name, "ec2:routetable", "", map[string]any{
+					"main":          t.Main,
+					"subnets":       t.Subnets,
+					"routes":        t.Routes,
+					"default_route": t.DefaultRoute,
+				}))
+			}
+		case KindInternetGateways:
+			igws, _ := vpc.Metadata[KindInternetGateways].([]InternetGateway)
+			for _, g := range igws {
+				resources = append(resources, child(g.ID, g.Name, "ec2:internetgateway", g.State, map[string]any{}))
+			}
+		case KindNATGateways:
+			nats, _ := vpc.Metadata[KindNATGateways].([]NATGateway)
+			for _, n := range nats {
+				reason := n.Unused
+				if reason != "" {
+					reason = strings.ToLower(reason[:1]) + reason[1:]
+				}
+				r := child(n.ID, n.Name, "ec2:natgateway", n.State, map[string]any{
+					"subnet_id":      n.SubnetID,
+					"zone":           n.Zone,
+					"public_ip":      n.PublicIP,
+					"routed":         n.Routed,
+					"bytes":          n.Bytes,
+					"traffic_known":  n.TrafficKnown,
+					"should_cleanup": n.Unused != "",
+					"cleanup_reason": reason,
+				})
+				r.CreatedAt = n.CreatedAt
+				estimate.ApplyAge(&r, now)
+				if n.Monthly > 0 {
+					estimate.ApplyCost(&r, n.Monthly)
+				}
+				if n.Unused != "" {
+					r.AddIssue(core.SeverityMedium, fmt.Sprintf("Unused: %s (%s/mo)", n.Unused, estimate.FormatCost(n.Monthly)))
+				}
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources
+}
+
+// unusedNATs counts the NAT gateways flagged as unused.
+func unusedNATs(nats []NATGateway) int {
+	n := 0
+	for _, nat := range nats {
+		if nat.Unused != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// displayName returns a child's name, or "-" when it has none.
+func displayName(r core.Resource) string {
+	if r.Name == r.ID {
+		return "-"
+	}
+	return r.Name
+}
+
+// formatVPC renders a VPC and its networking for the detail panel.
+func formatVPC(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "VPC:     %s\n", r.ID)
+	fmt.Fprintf(&b, "Name:    %s\n", r.Name)
+	if cidrs, _ := r.Metadata["cidrs"].([]string); len(cidrs) > 0 {
+		fmt.Fprintf(&b, "CIDRs:   %s\n", strings.Join(cidrs, ", "))
+	}
+	fmt.Fprintf(&b, "Tenancy: %s\n", r.GetMetadataString("tenancy"))
+
+	if analyzed, _ := r.Metadata["analyzed"].(bool); !analyzed {
+		b.WriteString(i18n.T("\nNot analyzed yet. Press [a] to analyze this VPC.\n"))
+		return b.String()
+	}
+
+	subnets, _ := r.Metadata[KindSubnets].([]Subnet)
+	b.WriteString(i18n.T("\nSubnets:\n"))
+	if len(subnets) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, s := range subnets {
+		scope := "private"
+		if s.Public {
+			scope = "public"
+		}
+		fmt.Fprintf(&b, "  %-24s %-18s %-12s %-7s %d free IPs\n", s.ID, s.CIDR, s.Zone, scope, s.AvailableIPs)
+	}
+
+	tables, _ := r.Metadata[KindRouteTables].([]RouteTable)
+	b.WriteString(i18n.T("\nRoute tables:\n"))
+	for _, t := range tables {
+		main := ""
+		if t.Main {
+			main = " (main)"
+		}
+		target := t.DefaultRoute
+		if target == "" {
+			target = "no default route"
+		}
+		fmt.Fprintf(&b, "  %s%s: %d subnets, 0.0.0.0/0 → %s\n", t.ID, main, len(t.Subnets), target)
+	}
+
+	igws, _ := r.Metadata[KindInternetGateways].([]InternetGateway)
+	b.WriteString(i18n.T("\nInternet gateways:\n"))
+	if len(igws) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, g := range igws {
+		fmt.Fprintf(&b, "  %s (%s)\n", g.ID, g.State)
+	}
+
+	nats, _ := r.Metadata[KindNATGateways].([]NATGateway)
+	b.WriteString(i18n.T("\nNAT gateways:\n"))
+	if len(nats) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	for _, n := range nats {
+		fmt.Fprintf(&b, "  %s in %s (%s)", n.ID, n.Zone, n.State)
+		if n.Unused != "" {
+			fmt.Fprintf(&b, ": unused, %s", strings.ToLower(n.Unused))
+		}
+		b.WriteString("\n")
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// formatChild renders a subnet, route table or gateway for the detail panel.
+func formatChild(r *core.Resource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID:   %s\n", r.ID)
+	fmt.Fprintf(&b, "Name: %s\n", displayName(*r))
+	fmt.Fprintf(&b, "VPC:  %s (%s)\n", r.GetMetadataString("vpc"), r.GetMetadataString("vpc_name"))
+	if r.State != "" {
+		fmt.Fprintf(&b, "State: %s\n", r.State)
+	}
+
+	switch r.Type {
+	case "ec2:subnet":
+		fmt.Fprintf(&b, "CIDR: %s in %s\n", r.GetMetadataString("cidr"), r.GetMetadataString("zone"))
+		fmt.Fprintf(&b, "Route table: %s\n", r.GetMetadataString("route_table"))
+	case "ec2:routetable":
+		if subnets, _ := r.Metadata["subnets"].([]string); len(subnets) > 0 {
+			fmt.Fprintf(&b, "Subnets: %s\n", strings.Join(subnets, ", "))
+		}
+		if target := r.GetMetadataString("default_route"); target != "" {
+			fmt.Fprintf(&b, "Default route: %s\n", target)
+		}
+	case "ec2:natgateway":
+		fmt.Fprintf(&b, "Subnet: %s in %s\n", r.GetMetadataString("subnet_id"), r.GetMetadataString("zone"))
+		fmt.Fprintf(&b, "Public IP: %s\n", r.GetMetadataString("public_ip"))
+		if known, _ := r.Metadata["traffic_known"].(bool); known {
+			bytes, _ := r.Metadata["bytes"].(float64)
+			fmt.Fprintf(&b, "Processed: %.1f GB (14 days)\n", bytes/(1<<30))
+		}
+	}
+
+	if issues := r.Issues(); len(issues) > 0 {
+		b.WriteString(i18n.T("\nIssues:\n"))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "  %s %s\n", base.SeverityIcon(issue.Severity), issue.Message)
+		}
+	}
+	return b.String()
+}
+
+// SummaryWidgets implements core.SummaryProvider.
+func (v *View) SummaryWidgets() []core.SummaryWidget {
+	unused, spend := 0, 0.0
+	for _, r := range v.Resources {
+		if r.Type == "ec2:vpc" {
+			nats, _ := r.Metadata[KindNATGateways].([]NATGateway)
+			unused += unusedNATs(nats)
+		} else if shouldCleanup, _ := r.Metadata["should_cleanup"].(bool); shouldCleanup {
+			unused++
+		}
+		if monthly, ok := estimate.MonthlyCost(r); ok {
+			spend += monthly
+		}
+	}
+
+	return v.CommonWidgets(
+		core.SummaryWidget{Name: "total", Text: i18n.T("Total: %d", len(v.Resources)), Tone: core.ToneMuted},
+		core.SummaryWidget{Name: "unused-nat", Text: i18n.T("Unused NAT gateways: %d", unused), Tone: core.ToneWarning},
+		core.SummaryWidget{Name: "nat-cost", Text: i18n.T("NAT Est. $%.2f/mo", spend), Tone: core.ToneMuted},
+	)
+}
+
+func (v *View) renderSummary() string {
+	title := i18n.T("VPC Networking")
+	if crumb := v.Breadcrumb(); crumb != "" {
+		title += " › " + crumb
+	}
+	return v.RenderSummary(title, v.SummaryWidgets())
+}
+
+// =============================================================================
+// View Factory
+// =============================================================================
+
+type ViewFactory struct{}
+
+func NewViewFactory() *ViewFactory { return &ViewFactory{} }
+
+func (f *ViewFactory) Create(service core.AWSService) (core.View, error) {
+	view := NewView()
+	view.SetService(service)
+	return view, nil
+}
+
+func (f *ViewFactory) ServiceName() string { return "vpc" }
+
+var (
+	_ tea.Model        = (*View)(nil)
+	_ core.View        = (*View)(nil)
+	_ core.ViewFactory = (*ViewFactory)(nil)
+)
@@ -5,8 +5,11 @@ package tui
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,9 +18,17 @@ import (
 	awsfactory "github.com/keanuharrell/a9s/internal/aws"
 	"github.com/keanuharrell/a9s/internal/config"
 	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/hooks"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/preflight"
 	"github.com/keanuharrell/a9s/internal/registry"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/bridge"
 	"github.com/keanuharrell/a9s/internal/tui/components"
+	"github.com/keanuharrell/a9s/internal/tui/console"
+	"github.com/keanuharrell/a9s/internal/tui/hookpanel"
 	"github.com/keanuharrell/a9s/internal/tui/theme"
+	"github.com/keanuharrell/a9s/internal/uistate"
 )
 
 // =============================================================================
@@ -40,6 +51,7 @@ const (
 	SelectorNone SelectorType = iota
 	SelectorProfile
 	SelectorRegion
+	SelectorView
 )
 
 // App is the main TUI application model.
@@ -51,6 +63,11 @@ type App struct {
 	theme    *theme.Theme
 	factory  *awsfactory.ClientFactory
 
+	// Preflight checks gate refreshes; report is the latest outcome
+	preflight       *preflight.Checker
+	preflightReport *preflight.Report
+	warnedExpiry    time.Time // Expiry already warned about, to warn once
+
 	// State
 	currentView core.View
 	viewIndex   int
@@ -66,8 +83,20 @@ type App struct {
 	selectorType SelectorType
 	selector     *components.Selector
 
-	// Event dispatcher
+	// Event dispatcher, and the bridge delivering its events as messages
 	dispatcher core.EventDispatcher
+	events     *bridge.Bridge
+	// Hidden views chosen by name from the view chooser, such as the
+	// "events" console streaming every dispatcher event
+	panels []panel
+
+	// listed holds the latest EventResourceListed count per service;
+	// written from service goroutines, read while rendering tabs
+	listedMu sync.Mutex
+	listed   map[string]int
+
+	// Render durations and dropped frames, see frames.go
+	frames *frameStats
 
 	// Callback for config changes (set by root.go)
 	OnConfigChange func(profile, region string) error
@@ -82,10 +111,29 @@ func NewApp(reg *registry.Registry, cfg *config.Config, dispatcher core.EventDis
 		shortcuts:    make(map[string]core.View),
 		dispatcher:   dispatcher,
 		selectorType: SelectorNone,
+		listed:       make(map[string]int),
+		frames:       newFrameStats(cfg.TUI.FrameBudget),
+	}
+
+	// Track listings for the tab badges, and let views react to events
+	// from background subsystems
+	if dispatcher != nil {
+		dispatcher.Register(hooks.NewBaseHook("tui-badges",
+			[]core.EventType{core.EventResourceListed}, 0, app.handleResourceListed))
+		app.events = bridge.New()
+		dispatcher.Register(app.events)
+		recorder := console.NewRecorder(console.DefaultSize)
+		dispatcher.Register(recorder)
+		app.panels = append(app.panels, panel{console.NewView(recorder), i18n.T("Live dispatcher events")})
+		if source, ok := dispatcher.(hookpanel.Source); ok {
+			app.panels = append(app.panels, panel{hookpanel.NewView(source, "tui-badges", app.events.Name()), i18n.T("Hook health and runtime toggles")})
+		}
 	}
 
 	// Load initial views
 	app.refreshViews()
+	app.reportShortcutConflicts()
+	app.reportUnknownWidgets()
 
 	// Watch for registry changes
 	reg.Watch(func(_ core.RegistryEvent) {
@@ -95,23 +143,95 @@ func NewApp(reg *registry.Registry, cfg *config.Config, dispatcher core.EventDis
 	return app
 }
 
+// handleResourceListed records the resource count of a completed listing.
+func (a *App) handleResourceListed(_ context.Context, event core.Event) error {
+	data, ok := event.Data().(core.ResourceEventData)
+	if !ok {
+		return nil
+	}
+	a.listedMu.Lock()
+	a.listed[event.Source()] = data.Count
+	a.listedMu.Unlock()
+	return nil
+}
+
 // SetFactory sets the AWS client factory for dynamic config changes.
 func (a *App) SetFactory(factory *awsfactory.ClientFactory) {
 	a.factory = factory
 }
 
+// SetPreflight sets the checker run before each refresh cycle. While it
+// reports problems, views are not refreshed and a banner replaces them.
+func (a *App) SetPreflight(checker *preflight.Checker) {
+	a.preflight = checker
+}
+
 // SetOnConfigChange sets the callback for config changes.
 func (a *App) SetOnConfigChange(fn func(profile, region string) error) {
 	a.OnConfigChange = fn
 }
 
+// RestoreUIState reopens the view and restores the per-view state saved by
+// a previous run. Call it before the program starts.
+func (a *App) RestoreUIState(state uistate.State) {
+	for i, view := range a.views {
+		if stateful, ok := view.(core.StatefulView); ok {
+			if saved, ok := state.Views[view.Name()]; ok {
+				stateful.RestoreState(saved)
+			}
+		}
+		if view.Name() == state.View {
+			a.currentView = view
+			a.viewIndex = i
+		}
+	}
+}
+
+// UIState returns saved updated with the current UI state. Views not
+// registered in this run keep their saved state.
+func (a *App) UIState(saved uistate.State) uistate.State {
+	state := uistate.State{Views: make(map[string]core.ViewState, len(saved.Views))}
+	maps.Copy(state.Views, saved.Views)
+	if a.currentView != nil {
+		state.View = a.currentView.Name()
+	}
+	for _, view := range a.views {
+		if stateful, ok := view.(core.StatefulView); ok {
+			state.Views[view.Name()] = stateful.SaveState()
+		}
+	}
+	return state
+}
+
 // refreshViews updates the view list from registry.
 func (a *App) refreshViews() {
+	previous := a.views
 	a.views = a.registry.ListViewsOrdered()
+	// Views swapped out by a reload no longer receive messages
+	for _, view := range previous {
+		if !slices.Contains(a.views, view) {
+			closeView(view)
+		}
+	}
 	a.shortcuts = make(map[string]core.View)
 
 	for _, view := range a.views {
-		a.shortcuts[view.Shortcut()] = view
+		if view.Shortcut() != "" {
+			a.shortcuts[view.Shortcut()] = view
+		}
+	}
+
+	// Follow the current view to its replacement after a hot swap
+	if a.currentView != nil && !a.isPanel(a.currentView) {
+		current := a.currentView.Name()
+		a.currentView = nil
+		for i, view := range a.views {
+			if view.Name() == current {
+				a.currentView = view
+				a.viewIndex = i
+				break
+			}
+		}
 	}
 
 	// Set current view if not set
@@ -121,6 +241,61 @@ func (a *App) refreshViews() {
 	}
 }
 
+// closeView stops the background work of a view, when it has any.
+func closeView(view core.View) {
+	if closer, ok := view.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// reportShortcutConflicts tells the user which views were moved to another
+// shortcut at startup.
+func (a *App) reportShortcutConflicts() {
+	conflicts := a.registry.ShortcutConflicts()
+	if len(conflicts) == 0 {
+		return
+	}
+	parts := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		if c.Assigned == "" {
+			parts[i] = i18n.T("%s: [%s] is used by %s, press : to open it", c.View, c.Wanted, c.Holder)
+		} else {
+			parts[i] = i18n.T("%s: [%s] is used by %s, using [%s]", c.View, c.Wanted, c.Holder, c.Assigned)
+		}
+	}
+	a.setMessage(i18n.T("Shortcut conflicts: %s", strings.Join(parts, "; ")))
+}
+
+// reportUnknownWidgets tells the user which configured summary widgets no
+// view offers, with the ones it does.
+func (a *App) reportUnknownWidgets() {
+	unknown := a.registry.UnknownWidgets()
+	if len(unknown) == 0 {
+		return
+	}
+	parts := make([]string, len(unknown))
+	for i, u := range unknown {
+		parts[i] = i18n.T("%s has no %q (available: %s)", u.View, u.Widget, strings.Join(u.Available, ", "))
+	}
+	a.setMessage(i18n.T("Unknown summary widgets: %s", strings.Join(parts, "; ")))
+}
+
+// reportBackgroundFailure surfaces actions that failed outside the current
+// view, which would otherwise go unnoticed.
+func (a *App) reportBackgroundFailure(event core.Event) {
+	if event.Type() != core.EventActionFailed {
+		return
+	}
+	if a.currentView != nil && a.currentView.ServiceName() == event.Source() {
+		return
+	}
+	data, ok := event.Data().(core.ActionEventData)
+	if !ok {
+		return
+	}
+	a.setMessage(i18n.T("%s: %s on %s failed: %s", event.Source(), data.Action, data.ResourceID, data.Error))
+}
+
 // contentHeight returns the available height for view content
 func (a *App) contentHeight() int {
 	h := a.height - chromeHeight
@@ -139,6 +314,23 @@ func (a *App) contentWidth() int {
 	return w
 }
 
+// panel is a hidden view: outside the tabs, chosen by name from the view
+// chooser, and readable without an AWS context.
+type panel struct {
+	view        core.View
+	description string
+}
+
+// isPanel reports whether a view is one of the hidden panels.
+func (a *App) isPanel(view core.View) bool {
+	for _, p := range a.panels {
+		if p.view == view {
+			return true
+		}
+	}
+	return false
+}
+
 // updateViewDimensions updates all views with current dimensions
 func (a *App) updateViewDimensions() {
 	w := a.contentWidth()
@@ -146,6 +338,9 @@ func (a *App) updateViewDimensions() {
 	for _, view := range a.views {
 		view.SetDimensions(w, h)
 	}
+	for _, p := range a.panels {
+		p.view.SetDimensions(w, h)
+	}
 }
 
 // =============================================================================
@@ -155,6 +350,12 @@ func (a *App) updateViewDimensions() {
 // tickMsg is sent periodically for auto-refresh.
 type tickMsg time.Time
 
+// preflightMsg carries a preflight report; next runs when it passed.
+type preflightMsg struct {
+	report preflight.Report
+	next   func() tea.Cmd
+}
+
 // viewChangedMsg signals a view change.
 type viewChangedMsg struct {
 	view core.View
@@ -171,9 +372,16 @@ func (a *App) Init() tea.Cmd {
 	// Start tick timer
 	cmds = append(cmds, a.tick())
 
-	// Initialize current view
+	// Start delivering dispatcher events
+	if a.events != nil {
+		cmds = append(cmds, a.events.Listen())
+	}
+
+	// Initialize current view once the AWS context checks out
 	if a.currentView != nil {
-		cmds = append(cmds, a.currentView.Init())
+		cmds = append(cmds, a.afterPreflight(func() tea.Cmd {
+			return a.currentView.Init()
+		}))
 	}
 
 	return tea.Batch(cmds...)
@@ -208,18 +416,48 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Don't return - forward to views
 
 	case tea.KeyMsg:
+		if capturer, ok := a.currentView.(core.InputCapturer); ok && capturer.CapturingInput() && msg.String() != "ctrl+c" {
+			return a, a.updateCurrentView(msg)
+		}
 		cmd := a.handleKeyPress(msg)
 		if cmd != nil {
 			return a, cmd
 		}
+		// Keys only go to the active view
+		return a, a.updateCurrentView(msg)
 
 	case tickMsg:
 		cmds = append(cmds, a.tick())
 		if a.currentView != nil && a.config.TUI.RefreshInterval > 0 {
-			cmds = append(cmds, a.currentView.Refresh())
+			cmds = append(cmds, a.afterPreflight(func() tea.Cmd {
+				return a.currentView.Refresh()
+			}))
 		}
 		return a, tea.Batch(cmds...)
 
+	case preflightMsg:
+		recovered := a.preflightReport != nil && !a.preflightReport.OK() && msg.report.OK()
+		a.preflightReport = &msg.report
+		if !msg.report.OK() {
+			return a, nil
+		}
+		if recovered {
+			a.setMessage(i18n.T("AWS context is usable again"))
+		} else if msg.report.ExpiringSoon() && !msg.report.Expires.Equal(a.warnedExpiry) {
+			a.warnedExpiry = msg.report.Expires
+			a.setMessage(i18n.T("⚠ The AWS session for profile %s expires in %s", msg.report.Profile,
+				time.Until(msg.report.Expires).Round(time.Minute)))
+		}
+		if msg.next != nil {
+			return a, msg.next()
+		}
+		return a, nil
+
+	case bridge.EventMsg:
+		// Keep listening, and let every view see the event below
+		cmds = append(cmds, a.events.Listen())
+		a.reportBackgroundFailure(msg.Event)
+
 	case viewChangedMsg:
 		a.currentView = msg.view
 		return a, a.currentView.Init()
@@ -229,21 +467,44 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if profile == "" {
 			profile = "default"
 		}
-		a.setMessage(fmt.Sprintf("Switched to %s / %s", profile, a.config.AWS.Region))
+		a.setMessage(i18n.T("Switched to %s / %s", profile, a.config.AWS.Region))
+		if msg.err != nil {
+			a.setMessage(i18n.T("Error: %v", msg.err))
+		}
 
+		a.listedMu.Lock()
+		a.listed = make(map[string]int)
+		a.listedMu.Unlock()
+
+		// Reset stops background work of views that were swapped out and
+		// clears those that were kept
 		for _, view := range a.views {
 			if resettable, ok := view.(interface{ Reset() }); ok {
 				resettable.Reset()
 			}
 		}
-
-		for _, view := range a.views {
-			cmds = append(cmds, view.Init())
+		a.refreshViews()
+		if a.width > 0 {
+			a.updateViewDimensions()
 		}
-		return a, tea.Batch(cmds...)
+
+		return a, a.afterPreflight(func() tea.Cmd {
+			inits := make([]tea.Cmd, 0, len(a.views))
+			for _, view := range a.views {
+				inits = append(inits, view.Init())
+			}
+			return tea.Batch(inits...)
+		})
 
 	case components.SelectorResultMsg:
+		if a.selectorType == SelectorNone {
+			// A selector the active view opened, such as its action menu
+			return a, a.updateCurrentView(msg)
+		}
 		return a.handleSelectorResult(msg)
+
+	case base.FocusResourceMsg:
+		return a, a.focusResource(msg)
 	}
 
 	// Forward message to ALL views
@@ -262,16 +523,41 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		cmds = append(cmds, cmd)
 	}
+	for _, p := range a.panels {
+		_, cmd := p.view.Update(msg)
+		cmds = append(cmds, cmd)
+	}
 
 	return a, tea.Batch(cmds...)
 }
 
+// updateCurrentView forwards a message to the active view only.
+func (a *App) updateCurrentView(msg tea.Msg) tea.Cmd {
+	if a.currentView == nil {
+		return nil
+	}
+	model, cmd := a.currentView.Update(msg)
+	if v, ok := model.(core.View); ok {
+		for i, existing := range a.views {
+			if existing == a.currentView {
+				a.views[i] = v
+				break
+			}
+		}
+		a.currentView = v
+	}
+	return cmd
+}
+
 // handleKeyPress processes keyboard input.
 func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
 
 	switch key {
 	case "q", "ctrl+c":
+		for _, view := range a.views {
+			closeView(view)
+		}
 		return tea.Quit
 
 	case "?":
@@ -284,10 +570,15 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 	case "G":
 		return a.showRegionSelector()
 
+	case ":":
+		return a.showViewSelector()
+
 	case "r":
 		if a.currentView != nil {
-			a.setMessage("Refreshing...")
-			return a.currentView.Refresh()
+			a.setMessage(i18n.T("Refreshing..."))
+			return a.afterPreflight(func() tea.Cmd {
+				return a.currentView.Refresh()
+			})
 		}
 		return nil
 
@@ -321,6 +612,7 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 type configChangedMsg struct {
 	profile string
 	region  string
+	err     error // Set when the services could not be rebuilt
 }
 
 func (a *App) showProfileSelector() tea.Cmd {
@@ -332,7 +624,7 @@ func (a *App) showProfileSelector() tea.Cmd {
 		current = "default"
 	}
 
-	a.selector = components.NewSelector("Select AWS Profile", items, current)
+	a.selector = components.NewSelector(i18n.T("Select AWS Profile"), items, current)
 	a.selector.SetDimensions(a.width, a.height)
 	a.selectorType = SelectorProfile
 
@@ -350,13 +642,43 @@ func (a *App) showRegionSelector() tea.Cmd {
 		current = "us-east-1"
 	}
 
-	a.selector = components.NewSelector("Select AWS Region", items, current)
+	a.selector = components.NewSelector(i18n.T("Select AWS Region"), items, current)
 	a.selector.SetDimensions(a.width, a.height)
 	a.selectorType = SelectorRegion
 
 	return nil
 }
 
+// showViewSelector lists every view, including those beyond the digit
+// shortcuts.
+func (a *App) showViewSelector() tea.Cmd {
+	if len(a.views) == 0 {
+		return nil
+	}
+	items := make([]components.SelectorItem, len(a.views))
+	for i, view := range a.views {
+		label := view.Name()
+		if view.Shortcut() != "" {
+			label = fmt.Sprintf("[%s] %s", view.Shortcut(), view.Name())
+		}
+		items[i] = components.SelectorItem{Value: view.Name(), Label: label}
+	}
+	for _, p := range a.panels {
+		items = append(items, components.SelectorItem{Value: p.view.Name(), Label: p.view.Name(), Description: p.description})
+	}
+
+	current := ""
+	if a.currentView != nil {
+		current = a.currentView.Name()
+	}
+
+	a.selector = components.NewSelector(i18n.T("Select View"), items, current)
+	a.selector.SetDimensions(a.width, a.height)
+	a.selectorType = SelectorView
+
+	return nil
+}
+
 func (a *App) handleSelectorResult(msg components.SelectorResultMsg) (tea.Model, tea.Cmd) {
 	selectorType := a.selectorType
 	a.selectorType = SelectorNone
@@ -366,6 +688,20 @@ func (a *App) handleSelectorResult(msg components.SelectorResultMsg) (tea.Model,
 		return a, nil
 	}
 
+	if selectorType == SelectorView {
+		for _, p := range a.panels {
+			if p.view.Name() == msg.Value && p.view != a.currentView {
+				return a, a.switchToView(p.view)
+			}
+		}
+		for _, view := range a.views {
+			if view.Name() == msg.Value && view != a.currentView {
+				return a, a.switchToView(view)
+			}
+		}
+		return a, nil
+	}
+
 	profile := a.config.AWS.Profile
 	region := a.config.AWS.Region
 
@@ -387,13 +723,13 @@ func (a *App) handleSelectorResult(msg components.SelectorResultMsg) (tea.Model,
 	a.config.AWS.Region = region
 
 	if a.factory != nil {
-		a.setMessage("Updating AWS configuration...")
+		a.setMessage(i18n.T("Updating AWS configuration..."))
 		return a, a.updateAWSConfig(profile, region)
 	}
 
 	if a.OnConfigChange != nil {
 		if err := a.OnConfigChange(profile, region); err != nil {
-			a.setMessage(fmt.Sprintf("Error: %v", err))
+			a.setMessage(i18n.T("Error: %v", err))
 			return a, nil
 		}
 	}
@@ -403,11 +739,18 @@ func (a *App) handleSelectorResult(msg components.SelectorResultMsg) (tea.Model,
 	}
 }
 
+// updateAWSConfig points the factory at the new context, then lets
+// OnConfigChange swap in services built for it.
 func (a *App) updateAWSConfig(profile, region string) tea.Cmd {
+	onChange := a.OnConfigChange
 	return func() tea.Msg {
 		ctx := context.Background()
 		_ = a.factory.UpdateConfig(ctx, profile, region)
-		return configChangedMsg{profile: profile, region: region}
+		msg := configChangedMsg{profile: profile, region: region}
+		if onChange != nil {
+			msg.err = onChange(profile, region)
+		}
+		return msg
 	}
 }
 
@@ -424,6 +767,25 @@ func (a *App) switchToView(view core.View) tea.Cmd {
 	return view.Init()
 }
 
+// focusResource switches to the view of a service and selects a resource in
+// it, for views linking to resources of other services.
+func (a *App) focusResource(msg base.FocusResourceMsg) tea.Cmd {
+	for _, view := range a.views {
+		if view.ServiceName() != msg.Service {
+			continue
+		}
+		if selector, ok := view.(core.ResourceSelector); ok {
+			selector.SelectResource(msg.ResourceID)
+		}
+		if view == a.currentView {
+			return nil
+		}
+		return a.switchToView(view)
+	}
+	a.setMessage(i18n.T("No view for %s is enabled", msg.Service))
+	return nil
+}
+
 func (a *App) nextView() tea.Cmd {
 	if len(a.views) == 0 {
 		return nil
@@ -440,6 +802,18 @@ func (a *App) prevView() tea.Cmd {
 	return a.switchToView(a.views[a.viewIndex])
 }
 
+// afterPreflight runs the preflight checks and then next, if they passed.
+// next is called from Update, so it may touch view state.
+func (a *App) afterPreflight(next func() tea.Cmd) tea.Cmd {
+	if a.preflight == nil {
+		return next()
+	}
+	checker := a.preflight
+	return func() tea.Msg {
+		return preflightMsg{report: checker.Run(context.Background()), next: next}
+	}
+}
+
 func (a *App) tick() tea.Cmd {
 	interval := a.config.TUI.RefreshInterval
 	if interval == 0 {
@@ -460,8 +834,15 @@ func (a *App) setMessage(msg string) {
 // =============================================================================
 
 func (a *App) View() string {
+	start := time.Now()
+	defer func() { a.frames.record(time.Since(start)) }()
+
+	return a.render()
+}
+
+func (a *App) render() string {
 	if a.width == 0 {
-		return "Loading..."
+		return i18n.T("Loading...")
 	}
 
 	if a.selectorType != SelectorNone && a.selector != nil {
@@ -491,7 +872,10 @@ func (a *App) renderHeader() string {
 		region = "us-east-1"
 	}
 
-	title := fmt.Sprintf("🚀 a9s - AWS Terminal UI  ⎔ %s  ⎔ %s", profile, region)
+	title := i18n.T("🚀 a9s - AWS Terminal UI  ⎔ %s  ⎔ %s", profile, region)
+	if counts := a.severityCounts(); len(counts) > 0 {
+		title += "  ⚠ " + base.RenderSeverityCounts(counts)
+	}
 
 	style := lipgloss.NewStyle().
 		Bold(true).
@@ -505,6 +889,21 @@ func (a *App) renderHeader() string {
 	return style.Render(title)
 }
 
+// severityCounts sums the flagged resources of all views by severity.
+func (a *App) severityCounts() map[core.Severity]int {
+	counts := make(map[core.Severity]int)
+	for _, view := range a.views {
+		provider, ok := view.(core.BadgeProvider)
+		if !ok {
+			continue
+		}
+		for s, n := range provider.Badge().Severities {
+			counts[s] += n
+		}
+	}
+	return counts
+}
+
 func (a *App) renderTabs() string {
 	if len(a.views) == 0 {
 		return ""
@@ -512,33 +911,78 @@ func (a *App) renderTabs() string {
 
 	sortedViews := make([]core.View, len(a.views))
 	copy(sortedViews, a.views)
-	sort.Slice(sortedViews, func(i, j int) bool {
-		return sortedViews[i].Shortcut() < sortedViews[j].Shortcut()
+	// Views without a shortcut come last, in priority order
+	sort.SliceStable(sortedViews, func(i, j int) bool {
+		si, sj := sortedViews[i].Shortcut(), sortedViews[j].Shortcut()
+		if si == "" || sj == "" {
+			return si != "" && sj == ""
+		}
+		return si < sj
 	})
 
 	var parts []string
 	for _, view := range sortedViews {
-		label := fmt.Sprintf(" [%s] %s ", view.Shortcut(), view.Name())
+		label := fmt.Sprintf(" %s%s ", view.Name(), a.tabBadge(view))
+		if view.Shortcut() != "" {
+			label = fmt.Sprintf(" [%s] %s%s ", view.Shortcut(), view.Name(), a.tabBadge(view))
+		}
 		if view == a.currentView {
 			parts = append(parts, a.theme.TabActive.Render(label))
 		} else {
 			parts = append(parts, a.theme.TabInactive.Render(label))
 		}
 	}
-	parts = append(parts, a.theme.TabInactive.Render(" [?] Help "))
+	if a.isPanel(a.currentView) {
+		parts = append(parts, a.theme.TabActive.Render(" "+a.currentView.Name()+" "))
+	}
+	parts = append(parts, a.theme.TabInactive.Render(i18n.T(" [?] Help ")))
 
 	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 }
 
+// tabBadge renders the resource count, flagged resources and spend of a
+// view. The flagged count takes the color of the highest severity.
+// Nothing is shown until the view's service has reported a listing.
+func (a *App) tabBadge(view core.View) string {
+	a.listedMu.Lock()
+	count, ok := a.listed[view.ServiceName()]
+	a.listedMu.Unlock()
+	if !ok {
+		return ""
+	}
+
+	badge := core.Badge{Count: count}
+	if provider, ok := view.(core.BadgeProvider); ok {
+		badge = provider.Badge()
+		// Show the last complete listing while a new one is still filling the view
+		if view.IsLoading() {
+			badge.Count = count
+		}
+	}
+
+	label := fmt.Sprintf(" (%d", badge.Count)
+	if flagged := badge.Flagged(); flagged > 0 {
+		style := lipgloss.NewStyle().Foreground(base.SeverityColor(badge.Highest()))
+		label += " " + style.Render(fmt.Sprintf("⚠%d", flagged))
+	}
+	if badge.Spend > 0 {
+		label += fmt.Sprintf(" $%.0f/mo", badge.Spend)
+	}
+	return label + ")"
+}
+
 func (a *App) renderContent() string {
 	h := a.contentHeight()
 	w := a.contentWidth()
 
 	var content string
-	if a.currentView != nil {
+	// Panels stay readable while the AWS context is broken, to see why
+	if a.preflightReport != nil && !a.preflightReport.OK() && !a.isPanel(a.currentView) {
+		content = a.renderPreflight()
+	} else if a.currentView != nil {
 		content = a.currentView.View()
 	} else {
-		content = a.theme.Muted.Render("No services registered.")
+		content = a.theme.Muted.Render(i18n.T("No services registered."))
 	}
 
 	// IMPORTANT: lipgloss.Height() does NOT truncate content!
@@ -562,15 +1006,39 @@ func (a *App) renderContent() string {
 	return strings.Join(lines, "\n")
 }
 
+// renderPreflight renders the banner shown while the AWS context is unusable.
+func (a *App) renderPreflight() string {
+	report := a.preflightReport
+	region := report.Region
+	if region == "" {
+		region = "-"
+	}
+	lines := []string{
+		a.theme.Error.Render(i18n.T("⚠ AWS is unreachable with profile %s in %s", report.Profile, region)),
+		"",
+	}
+	for _, p := range report.Problems {
+		lines = append(lines, a.theme.Warning.Render("• "+p.Message))
+		if p.Hint != "" {
+			lines = append(lines, a.theme.Muted.Render("  "+p.Hint))
+		}
+	}
+	lines = append(lines, "", a.theme.Help.Render(i18n.T("Views resume refreshing once this is fixed. Press r to check again.")))
+	return strings.Join(lines, "\n")
+}
+
 func (a *App) renderFooter() string {
-	status := "Ready"
+	status := i18n.T("Ready")
 	if a.currentView != nil && a.currentView.IsLoading() {
-		status = "⏳ Loading..."
+		status = i18n.T("⏳ Loading...")
 	} else if a.message != "" && time.Since(a.msgTime) < 3*time.Second {
 		status = a.message
 	}
 
-	help := "[r] refresh  [P] profile  [G] region  [q] quit  [?] help"
+	help := i18n.T("[r] refresh  [P] profile  [G] region  [q] quit  [?] help")
+	if a.config.TUI.FrameStats {
+		help = a.frames.String() + "  │  " + help
+	}
 
 	style := lipgloss.NewStyle().
 		Foreground(a.theme.MutedColor).
@@ -594,25 +1062,38 @@ func (a *App) renderWithSelector() string {
 	return bgStyle.Render(selectorContent)
 }
 
-func (a *App) renderHelp() string {
-	help := `🚀 a9s - The k9s for AWS
+// helpText is the message ID of the help screen.
+const helpText = `🚀 a9s - The k9s for AWS
 
 Navigation:
-  [1-4]       Switch services
+  [1-9]       Switch services
+  [:]         Choose a service
   [Tab]       Next service
   [r]         Refresh
   [P]         Change profile
   [G]         Change region
+  [n]         Note on selected resource
+  [. x]       Actions on selected resource
+  [K]         Run a runbook on selected resource
+  [W]         Open selected resource in AWS console
+  [E]         Export selected resource to a file
+  [o]         Sort by severity
+  [O]         Sort by column
   [?]         Toggle help
   [q]         Quit
 
-EC2: [s]tart [t]stop [b]reboot
-IAM: [a]udit [p]olicies
+EC2: [s]tart [t]stop [b]reboot [m]odify type [i]mage [S]chedule [f]ilter [Enter]details [u]ser data
+IAM: [a]udit [p]olicies [s]imulate
 S3:  [a]nalyze [d]elete [D]confirm
-Lambda: [i]nvoke [c]onfig
+Lambda: [i]nvoke [c]onfig [v]reveal env [Enter]details [R]e-analyze
+Findings: [a]rchive [Enter]details
+Approvals: [a]pprove [x]reject [Enter]details
 
 Press [?] or [Esc] to close.`
 
+func (a *App) renderHelp() string {
+	help := i18n.T(helpText)
+
 	style := lipgloss.NewStyle().
 		Width(a.width-4).
 		Height(a.height-2).
@@ -0,0 +1,150 @@
+// Package bridge delivers dispatcher events to the running TUI as Bubble Tea
+// messages.
+//
+// Services and background subsystems report what they do through the event
+// dispatcher, which runs hooks on arbitrary goroutines. Views cannot be
+// touched from there, so the bridge queues events and hands them to the
+// program one at a time as EventMsg, which the app broadcasts to every view
+// like any other message.
+package bridge
+
+import (
+	"context"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// DefaultBuffer is how many events may wait for the program before new
+// ones are dropped.
+const DefaultBuffer = 64
+
+// DefaultEventTypes are the events forwarded when none are configured:
+// resource changes, action results, approvals, health and preflight changes.
+var DefaultEventTypes = []core.EventType{
+	core.EventResourceListed,
+	core.EventResourceCreated,
+	core.EventResourceUpdated,
+	core.EventResourceDeleted,
+	core.EventActionExecuted,
+	core.EventActionFailed,
+	core.EventApprovalRequested,
+	core.EventApprovalGranted,
+	core.EventApprovalRejected,
+	core.EventApprovalUsed,
+	core.EventServiceHealthCheck,
+	core.EventPreflightFailed,
+	core.EventPreflightPassed,
+	core.EventComponentFailed,
+}
+
+// EventMsg carries a dispatcher event into the program.
+type EventMsg struct {
+	Event core.Event
+}
+
+// Is reports whether the message carries one of the event types.
+func (m EventMsg) Is(types ...core.EventType) bool {
+	for _, t := range types {
+		if m.Event.Type() == t {
+			return true
+		}
+	}
+	return false
+}
+
+// =============================================================================
+// Bridge
+// =============================================================================
+
+// Bridge is a core.Hook queueing events for the program.
+type Bridge struct {
+	eventTypes []core.EventType
+	events     chan core.Event
+	dropped    atomic.Int64
+}
+
+// Ensure Bridge implements core.Hook
+var _ core.Hook = (*Bridge)(nil)
+
+// Option configures a Bridge.
+type Option func(*bridgeOptions)
+
+type bridgeOptions struct {
+	eventTypes []core.EventType
+	buffer     int
+}
+
+// WithEventTypes sets the events forwarded to the program.
+func WithEventTypes(types ...core.EventType) Option {
+	return func(o *bridgeOptions) {
+		o.eventTypes = types
+	}
+}
+
+// WithBuffer sets how many events may wait for the program.
+func WithBuffer(size int) Option {
+	return func(o *bridgeOptions) {
+		if size > 0 {
+			o.buffer = size
+		}
+	}
+}
+
+// New creates a bridge. Register it with the dispatcher and start Listen
+// from the program's Init.
+func New(opts ...Option) *Bridge {
+	o := bridgeOptions{
+		eventTypes: DefaultEventTypes,
+		buffer:     DefaultBuffer,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Bridge{
+		eventTypes: o.eventTypes,
+		events:     make(chan core.Event, o.buffer),
+	}
+}
+
+// Name implements core.Hook.
+func (b *Bridge) Name() string {
+	return "tui-bridge"
+}
+
+// EventTypes implements core.Hook.
+func (b *Bridge) EventTypes() []core.EventType {
+	return b.eventTypes
+}
+
+// Priority implements core.Hook. The bridge runs after other hooks so they
+// have seen an event before views react to it.
+func (b *Bridge) Priority() int {
+	return -100
+}
+
+// Handle implements core.Hook. It never blocks the dispatcher: when the
+// program falls behind, the event is dropped.
+func (b *Bridge) Handle(_ context.Context, event core.Event) error {
+	select {
+	case b.events <- event:
+	default:
+		b.dropped.Add(1)
+	}
+	return nil
+}
+
+// Dropped returns how many events were dropped because the queue was full.
+func (b *Bridge) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// Listen waits for the next event. The receiver of EventMsg must call Listen
+// again to keep events flowing.
+func (b *Bridge) Listen() tea.Cmd {
+	return func() tea.Msg {
+		return EventMsg{Event: <-b.events}
+	}
+}
@@ -0,0 +1,37 @@
+package components
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// =============================================================================
+// Browser
+// =============================================================================
+
+// errNoBrowser is returned where no browser can be started, as over SSH.
+var errNoBrowser = errors.New("no browser available")
+
+// OpenURL opens a URL in the default browser without waiting for it.
+func OpenURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return errNoBrowser
+		}
+		cmd = exec.Command("xdg-open", url)
+	}
+	if err := cmd.Start(); err != nil {
+		return errNoBrowser
+	}
+	// Reap the opener once it hands the URL over
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
@@ -0,0 +1,161 @@
+package components
+
+import (
+	"math"
+	"strings"
+)
+
+// =============================================================================
+// Chart Component
+// =============================================================================
+
+// ChartStyle selects how a chart is drawn.
+type ChartStyle int
+
+const (
+	// ChartBraille draws a line with braille dots, 2x4 per character.
+	ChartBraille ChartStyle = iota
+	// ChartBlocks draws columns of block characters, for terminals whose
+	// font lacks braille.
+	ChartBlocks
+)
+
+// ChartPoint is a point of a chart. X runs from 0 (left) to 1 (right).
+type ChartPoint struct {
+	X float64
+	Y float64
+}
+
+// blocks are the eighths of a character cell, from empty to full.
+var blocks = []rune(" ▁▂▃▄▅▆▇█")
+
+// braille dot bits by column and row within a cell, top row first.
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// Chart renders points as a chart of width x height characters including
+// its y axis, labeled with format. The axis starts at zero unless values are
+// negative. Points closer together than a gap in the data are joined.
+func Chart(points []ChartPoint, width, height int, style ChartStyle, format func(float64) string) string {
+	lo, hi := 0.0, 0.0
+	for i, p := range points {
+		if i == 0 || p.Y > hi {
+			hi = p.Y
+		}
+		lo = math.Min(lo, p.Y)
+	}
+	if hi <= lo {
+		hi = lo + 1
+	}
+
+	top, bottom := format(hi), format(lo)
+	labelWidth := max(len([]rune(top)), len([]rune(bottom)))
+	plotWidth := width - labelWidth - 1
+	if plotWidth < 4 || height < 2 {
+		return ""
+	}
+
+	var rows []string
+	if style == ChartBlocks {
+		rows = blockRows(points, plotWidth, height, lo, hi)
+	} else {
+		rows = brailleRows(points, plotWidth, height, lo, hi)
+	}
+
+	var b strings.Builder
+	for i, row := range rows {
+		label := ""
+		switch i {
+		case 0:
+			label = top
+		case len(rows) - 1:
+			label = bottom
+		}
+		b.WriteString(strings.Repeat(" ", labelWidth-len([]rune(label))) + label + "┤" + row + "\n")
+	}
+	b.WriteString(strings.Repeat(" ", labelWidth) + "└" + strings.Repeat("─", plotWidth) + "\n")
+	return b.String()
+}
+
+// brailleRows plots the points as a line on a grid of braille dots.
+func brailleRows(points []ChartPoint, width, height int, lo, hi float64) []string {
+	cols, dots := width*2, height*4
+	grid := make([][]rune, height)
+	for i := range grid {
+		grid[i] = make([]rune, width)
+	}
+	set := func(x, y int) {
+		row := dots - 1 - y
+		grid[row/4][x/2] |= brailleDots[x%2][row%4]
+	}
+
+	// Points further apart than twice the average spacing are a gap in
+	// the data and left unjoined
+	maxGap := 2
+	if len(points) > 1 {
+		maxGap = max(maxGap, 2*cols/len(points))
+	}
+
+	prevX, prevY := -1, 0
+	for _, p := range points {
+		x := int(math.Round(p.X * float64(cols-1)))
+		y := int(math.Round((p.Y - lo) / (hi - lo) * float64(dots-1)))
+		x, y = clamp(x, 0, cols-1), clamp(y, 0, dots-1)
+		if prevX >= 0 && x > prevX && x-prevX <= maxGap {
+			for cx := prevX; cx <= x; cx++ {
+				cy := prevY + (y-prevY)*(cx-prevX)/(x-prevX)
+				set(cx, cy)
+				// Fill steep segments so the line stays continuous
+				next := prevY + (y-prevY)*(cx+1-prevX)/(x-prevX)
+				if cx < x {
+					for fy := min(cy, next); fy <= max(cy, next); fy++ {
+						set(cx, fy)
+					}
+				}
+			}
+		} else {
+			set(x, y)
+		}
+		prevX, prevY = x, y
+	}
+
+	rows := make([]string, height)
+	for i, line := range grid {
+		for j, r := range line {
+			line[j] = 0x2800 + r
+		}
+		rows[i] = string(line)
+	}
+	return rows
+}
+
+// blockRows plots the highest point of each column as a bar.
+func blockRows(points []ChartPoint, width, height int, lo, hi float64) []string {
+	levels := make([]int, width)
+	for i := range levels {
+		levels[i] = -1
+	}
+	eighths := height * 8
+	for _, p := range points {
+		x := clamp(int(math.Round(p.X*float64(width-1))), 0, width-1)
+		level := clamp(int(math.Round((p.Y-lo)/(hi-lo)*float64(eighths))), 1, eighths)
+		levels[x] = max(levels[x], level)
+	}
+
+	rows := make([]string, height)
+	for i := range rows {
+		floor := (height - 1 - i) * 8
+		line := make([]rune, width)
+		for x, level := range levels {
+			line[x] = blocks[clamp(level-floor, 0, 8)]
+		}
+		rows[i] = string(line)
+	}
+	return rows
+}
+
+func clamp(v, lo, hi int) int {
+	return max(lo, min(v, hi))
+}
@@ -0,0 +1,19 @@
+package components
+
+import (
+	"github.com/atotto/clipboard"
+	"github.com/muesli/termenv"
+)
+
+// =============================================================================
+// Clipboard
+// =============================================================================
+
+// CopyToClipboard copies text to the system clipboard. Where no clipboard
+// utility is available, as over SSH, it asks the terminal to copy it with an
+// OSC 52 sequence, which most terminals support.
+func CopyToClipboard(text string) {
+	if err := clipboard.WriteAll(text); err != nil {
+		termenv.Copy(text)
+	}
+}
@@ -0,0 +1,91 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// =============================================================================
+// Detail Component
+// =============================================================================
+
+// Detail is a scrollable panel for showing multi-line resource details
+// or action output.
+type Detail struct {
+	title    string
+	viewport viewport.Model
+
+	titleStyle lipgloss.Style
+	helpStyle  lipgloss.Style
+}
+
+// DetailClosedMsg is sent when a detail panel is dismissed.
+type DetailClosedMsg struct{}
+
+// NewDetail creates a detail panel with the given content.
+func NewDetail(title, content string, width, height int) *Detail {
+	d := &Detail{
+		title: title,
+		titleStyle: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FF79C6")),
+		helpStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#6272A4")),
+	}
+	d.viewport = viewport.New(width, detailBodyHeight(height))
+	d.viewport.SetContent(content)
+	return d
+}
+
+// detailBodyHeight returns the viewport height leaving room for title and help.
+func detailBodyHeight(height int) int {
+	h := height - 3
+	if h < 3 {
+		h = 3
+	}
+	return h
+}
+
+// SetDimensions resizes the panel.
+func (d *Detail) SetDimensions(width, height int) {
+	d.viewport.Width = width
+	d.viewport.Height = detailBodyHeight(height)
+}
+
+// SetContent replaces the panel content.
+func (d *Detail) SetContent(content string) {
+	d.viewport.SetContent(content)
+}
+
+// Update handles scrolling and dismissal.
+func (d *Detail) Update(msg tea.Msg) (*Detail, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "esc", "q", "enter":
+			return d, func() tea.Msg { return DetailClosedMsg{} }
+		case "g", "home":
+			d.viewport.GotoTop()
+			return d, nil
+		case "G", "end":
+			d.viewport.GotoBottom()
+			return d, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	d.viewport, cmd = d.viewport.Update(msg)
+	return d, cmd
+}
+
+// View renders the panel.
+func (d *Detail) View() string {
+	lines := []string{
+		d.titleStyle.Render(d.title),
+		d.viewport.View(),
+		d.helpStyle.Render("[↑/↓/PgUp/PgDn] scroll  [Esc] close"),
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,394 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// =============================================================================
+// Form Component
+// =============================================================================
+
+// formField is a single editable field backed by an action parameter.
+type formField struct {
+	param    core.ActionParameter
+	input    textinput.Model
+	area     textarea.Model
+	boolVal  bool
+	selected int
+}
+
+// Form collects action parameters from the user.
+// It is built from core.ActionParameter definitions so services describe
+// their inputs once and every view gets the same editing experience.
+type Form struct {
+	id     string
+	title  string
+	fields []*formField
+	cursor int
+	err    string
+	width  int
+
+	// Styles
+	titleStyle   lipgloss.Style
+	labelStyle   lipgloss.Style
+	focusedStyle lipgloss.Style
+	descStyle    lipgloss.Style
+	errorStyle   lipgloss.Style
+	borderStyle  lipgloss.Style
+}
+
+// FormResultMsg is sent when a form is submitted or canceled.
+type FormResultMsg struct {
+	ID       string
+	Values   map[string]any
+	Canceled bool
+}
+
+// NewForm creates a form for the given parameters.
+// The id is echoed back in FormResultMsg so views can match results.
+func NewForm(id, title string, params []core.ActionParameter) *Form {
+	f := &Form{
+		id:    id,
+		title: title,
+		width: 60,
+	}
+
+	for _, p := range params {
+		field := &formField{param: p}
+
+		switch p.Type {
+		case "bool":
+			if b, ok := p.Default.(bool); ok {
+				field.boolVal = b
+			}
+		case "select":
+			if d, ok := p.Default.(string); ok {
+				for i, opt := range p.Options {
+					if opt == d {
+						field.selected = i
+						break
+					}
+				}
+			}
+		case "text":
+			ta := textarea.New()
+			ta.Prompt = ""
+			ta.ShowLineNumbers = true
+			ta.CharLimit = 0
+			ta.MaxHeight = 0
+			ta.SetHeight(8)
+			ta.Placeholder = p.Description
+			if p.Default != nil {
+				ta.SetValue(fmt.Sprintf("%v", p.Default))
+			}
+			field.area = ta
+		default:
+			ti := textinput.New()
+			ti.Prompt = ""
+			ti.CharLimit = 2048
+			ti.Placeholder = p.Description
+			if p.Default != nil {
+				ti.SetValue(fmt.Sprintf("%v", p.Default))
+			}
+			field.input = ti
+		}
+
+		f.fields = append(f.fields, field)
+	}
+
+	f.titleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FF79C6")).
+		MarginBottom(1)
+
+	f.labelStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#F8F8F2"))
+
+	f.focusedStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#50FA7B")).
+		Bold(true)
+
+	f.descStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#6272A4")).
+		PaddingLeft(2)
+
+	f.errorStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF5555"))
+
+	f.borderStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#BD93F9")).
+		Padding(1, 2)
+
+	f.SetWidth(f.width)
+	f.focus(0)
+	return f
+}
+
+// ID returns the form identifier.
+func (f *Form) ID() string {
+	return f.id
+}
+
+// SetWidth sets the rendered width of the form.
+func (f *Form) SetWidth(width int) {
+	f.width = width
+	for _, field := range f.fields {
+		if field.isArea() {
+			field.area.SetWidth(max(f.boxWidth()-8, 20))
+		}
+	}
+}
+
+func (f *Form) focus(index int) {
+	for i, field := range f.fields {
+		switch {
+		case field.isArea() && i == index:
+			field.area.Focus()
+		case field.isArea():
+			field.area.Blur()
+		case !field.isText():
+		case i == index:
+			field.input.Focus()
+		default:
+			field.input.Blur()
+		}
+	}
+	f.cursor = index
+}
+
+func (field *formField) isText() bool {
+	return field.param.Type != "bool" && field.param.Type != "select" && field.param.Type != "text"
+}
+
+// isArea reports whether the field is a multi-line text area, which keeps
+// Enter and the arrow keys for editing.
+func (field *formField) isArea() bool {
+	return field.param.Type == "text"
+}
+
+// editingArea reports whether the focused field is a text area.
+func (f *Form) editingArea() bool {
+	return f.cursor >= 0 && f.cursor < len(f.fields) && f.fields[f.cursor].isArea()
+}
+
+// =============================================================================
+// tea.Model Implementation
+// =============================================================================
+
+// Init initializes the form.
+func (f *Form) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles input.
+func (f *Form) Update(msg tea.Msg) (*Form, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return f, nil
+	}
+
+	key := keyMsg.String()
+	if f.editingArea() && (key == "enter" || key == "up" || key == "down") {
+		// Text areas use these to edit; Tab and Ctrl+S still leave
+		key = ""
+	}
+
+	switch key {
+	case "esc":
+		id := f.id
+		return f, func() tea.Msg { return FormResultMsg{ID: id, Canceled: true} }
+	case "tab", "down":
+		if len(f.fields) > 0 {
+			f.focus((f.cursor + 1) % len(f.fields))
+		}
+		return f, nil
+	case "shift+tab", "up":
+		if len(f.fields) > 0 {
+			f.focus((f.cursor - 1 + len(f.fields)) % len(f.fields))
+		}
+		return f, nil
+	case "enter", "ctrl+s":
+		values, err := f.Values()
+		if err != nil {
+			f.err = err.Error()
+			return f, nil
+		}
+		f.err = ""
+		id := f.id
+		return f, func() tea.Msg { return FormResultMsg{ID: id, Values: values} }
+	}
+
+	if f.cursor < 0 || f.cursor >= len(f.fields) {
+		return f, nil
+	}
+
+	field := f.fields[f.cursor]
+	switch field.param.Type {
+	case "bool":
+		switch keyMsg.String() {
+		case " ", "left", "right", "y", "n":
+			field.boolVal = keyMsg.String() == "y" || (keyMsg.String() != "n" && !field.boolVal)
+		}
+		return f, nil
+	case "select":
+		if len(field.param.Options) == 0 {
+			return f, nil
+		}
+		switch keyMsg.String() {
+		case "left", "h":
+			field.selected = (field.selected - 1 + len(field.param.Options)) % len(field.param.Options)
+		case "right", "l", " ":
+			field.selected = (field.selected + 1) % len(field.param.Options)
+		}
+		return f, nil
+	case "text":
+		var cmd tea.Cmd
+		field.area, cmd = field.area.Update(msg)
+		return f, cmd
+	}
+
+	var cmd tea.Cmd
+	field.input, cmd = field.input.Update(msg)
+	return f, cmd
+}
+
+// Values validates the form and returns the typed parameter values.
+func (f *Form) Values() (map[string]any, error) {
+	values := make(map[string]any, len(f.fields))
+
+	for _, field := range f.fields {
+		p := field.param
+		switch p.Type {
+		case "bool":
+			values[p.Name] = field.boolVal
+			continue
+		case "select":
+			if len(p.Options) > 0 {
+				values[p.Name] = p.Options[field.selected]
+			}
+			continue
+		}
+
+		raw := strings.TrimSpace(field.input.Value())
+		if field.isArea() {
+			raw = strings.TrimSpace(field.area.Value())
+		}
+		if raw == "" {
+			if p.Required {
+				return nil, core.NewValidationError(p.Name, nil, "is required")
+			}
+			continue
+		}
+
+		if p.Validation != "" {
+			re, err := regexp.Compile(p.Validation)
+			if err == nil && !re.MatchString(raw) {
+				return nil, core.NewValidationError(p.Name, raw, "does not match "+p.Validation)
+			}
+		}
+
+		switch p.Type {
+		case "int":
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, core.NewValidationError(p.Name, raw, "must be a number")
+			}
+			values[p.Name] = n
+		case "duration":
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return nil, core.NewValidationError(p.Name, raw, "must be a duration (e.g. 30m)")
+			}
+			values[p.Name] = d
+		default:
+			values[p.Name] = raw
+		}
+	}
+
+	return values, nil
+}
+
+// View renders the form.
+func (f *Form) View() string {
+	var b strings.Builder
+
+	b.WriteString(f.titleStyle.Render(f.title))
+	b.WriteString("\n\n")
+
+	for i, field := range f.fields {
+		label := field.param.Name
+		if field.param.Required {
+			label += " *"
+		}
+
+		style := f.labelStyle
+		prefix := "  "
+		if i == f.cursor {
+			style = f.focusedStyle
+			prefix = "→ "
+		}
+
+		var value string
+		switch field.param.Type {
+		case "bool":
+			value = "[ ]"
+			if field.boolVal {
+				value = "[x]"
+			}
+		case "select":
+			if len(field.param.Options) > 0 {
+				value = "< " + field.param.Options[field.selected] + " >"
+			}
+		case "text":
+			value = "\n" + field.area.View()
+		default:
+			value = field.input.View()
+		}
+
+		b.WriteString(style.Render(fmt.Sprintf("%s%s: ", prefix, label)))
+		b.WriteString(value)
+		b.WriteString("\n")
+
+		if i == f.cursor && field.param.Description != "" {
+			b.WriteString(f.descStyle.Render(field.param.Description))
+			b.WriteString("\n")
+		}
+	}
+
+	if f.err != "" {
+		b.WriteString("\n")
+		b.WriteString(f.errorStyle.Render(f.err))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#6272A4"))
+	if f.editingArea() {
+		b.WriteString(helpStyle.Render("[Tab] field  [Enter] new line  [Ctrl+S] submit  [Esc] cancel"))
+	} else {
+		b.WriteString(helpStyle.Render("[Tab/↑/↓] field  [Space/←/→] toggle  [Enter] submit  [Esc] cancel"))
+	}
+
+	return f.borderStyle.Width(f.boxWidth()).Render(b.String())
+}
+
+// boxWidth returns the width of the form border box.
+func (f *Form) boxWidth() int {
+	boxWidth := f.width - 4
+	if boxWidth < 40 {
+		boxWidth = 40
+	}
+	return boxWidth
+}
@@ -0,0 +1,82 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+var fuzzParams = []core.ActionParameter{
+	{Name: "name", Type: "string", Required: true, Validation: `^[a-z][a-z0-9-]*$`},
+	{Name: "count", Type: "int", Default: 3},
+	{Name: "wait", Type: "duration"},
+	{Name: "force", Type: "bool"},
+	{Name: "mode", Type: "select", Options: []string{"fast", "safe"}},
+	{Name: "note", Type: "string"},
+	{Name: "body", Type: "text"},
+}
+
+// FuzzForm types arbitrary text into every field of a form, tab moving to
+// the next one, and checks that submitting it never panics and yields
+// values of the parameter's type.
+func FuzzForm(f *testing.F) {
+	for _, seed := range []string{
+		"web\t5\t30m\t \t \tok",
+		"Web!\tfive\tsoon",
+		"\t\t\t\t\t",
+		"a\t-9223372036854775809\t-1h",
+		"a\t0x10\t1.5µs",
+		"a\t\t\t\t\t\x00\x1b[31m",
+		"a\t\t\t\t\t\t{\n  \"ok\": true\n}",
+		"☃\t٣\t1d",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		form := NewForm("fuzz", "Fuzz", fuzzParams)
+		form.SetWidth(60)
+		for i, text := range strings.Split(input, "\t") {
+			if i > 0 {
+				form, _ = form.Update(tea.KeyMsg{Type: tea.KeyTab})
+			}
+			if text != "" {
+				form, _ = form.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+			}
+		}
+		_ = form.View()
+
+		values, err := form.Values()
+		if err != nil {
+			return
+		}
+		if _, ok := values["name"].(string); !ok {
+			t.Fatalf("required name missing from %v", values)
+		}
+		if v, ok := values["count"]; ok {
+			if _, ok := v.(int); !ok {
+				t.Fatalf("count is %T, want int", v)
+			}
+		}
+		if v, ok := values["wait"]; ok {
+			if _, ok := v.(time.Duration); !ok {
+				t.Fatalf("wait is %T, want time.Duration", v)
+			}
+		}
+		if _, ok := values["force"].(bool); !ok {
+			t.Fatalf("force is %T, want bool", values["force"])
+		}
+		if v, ok := values["body"]; ok {
+			if _, ok := v.(string); !ok {
+				t.Fatalf("body is %T, want string", v)
+			}
+		}
+		if mode := values["mode"]; mode != "fast" && mode != "safe" {
+			t.Fatalf("mode is %v, want one of the options", mode)
+		}
+	})
+}
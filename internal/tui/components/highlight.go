@@ -0,0 +1,131 @@
+package components
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// =============================================================================
+// YAML Highlighting
+// =============================================================================
+
+var (
+	yamlKeyStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#8BE9FD"))
+	yamlStringStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#F1FA8C"))
+	yamlTagStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF79C6"))
+	yamlLiteralStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#BD93F9"))
+	yamlCommentStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#6272A4"))
+)
+
+// yamlKey matches the indent, list marker and key of a mapping line.
+var yamlKey = regexp.MustCompile(`^(\s*)(- )?("[^"]*"|'[^']*'|[^\s#'"](?:[^:#]|:\S)*?):(\s|$)`)
+
+// yamlLiteral matches scalars that are not strings.
+var yamlLiteral = regexp.MustCompile(`^(true|false|null|~|-?[0-9][0-9._]*(e[+-]?[0-9]+)?)$`)
+
+// HighlightYAML colors the keys, values, tags and comments of a YAML
+// document line by line. Block scalars are colored as strings.
+func HighlightYAML(src string) string {
+	lines := strings.Split(src, "\n")
+	block := -1 // Indent of the key owning the open block scalar, if any
+
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+
+		if block >= 0 {
+			if trimmed == "" || indent > block {
+				lines[i] = yamlStringStyle.Render(line)
+				continue
+			}
+			block = -1
+		}
+
+		switch {
+		case trimmed == "":
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			lines[i] = yamlCommentStyle.Render(line)
+			continue
+		case trimmed == "---" || trimmed == "...":
+			lines[i] = yamlCommentStyle.Render(line)
+			continue
+		}
+
+		if m := yamlKey.FindStringSubmatchIndex(line); m != nil {
+			key, value := line[m[6]:m[7]], line[m[7]+1:]
+			if isBlockIndicator(value) {
+				block = m[6]
+			}
+			lines[i] = line[:m[6]] + yamlKeyStyle.Render(key) + ":" + highlightValue(value)
+			continue
+		}
+
+		// List items and continuation lines
+		if strings.HasPrefix(trimmed, "- ") {
+			lines[i] = line[:indent+2] + highlightValue(trimmed[2:])
+			continue
+		}
+		lines[i] = line[:indent] + highlightValue(trimmed)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// highlightValue colors a scalar value with its tag and trailing comment.
+func highlightValue(value string) string {
+	trimmed := strings.TrimLeft(value, " ")
+	lead := value[:len(value)-len(trimmed)]
+	if trimmed == "" {
+		return value
+	}
+
+	var out strings.Builder
+	out.WriteString(lead)
+
+	// Tags such as !Ref and !GetAtt
+	if strings.HasPrefix(trimmed, "!") {
+		end := strings.IndexByte(trimmed, ' ')
+		if end < 0 {
+			return out.String() + yamlTagStyle.Render(trimmed)
+		}
+		out.WriteString(yamlTagStyle.Render(trimmed[:end]) + " ")
+		trimmed = strings.TrimLeft(trimmed[end:], " ")
+	}
+
+	comment := ""
+	if !strings.ContainsAny(trimmed, `"'`) {
+		if at := strings.Index(trimmed, " #"); at >= 0 {
+			trimmed, comment = trimmed[:at], trimmed[at:]
+		}
+	}
+
+	switch {
+	case yamlLiteral.MatchString(trimmed), isBlockIndicator(trimmed):
+		out.WriteString(yamlLiteralStyle.Render(trimmed))
+	case strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{"):
+		out.WriteString(trimmed)
+	default:
+		out.WriteString(yamlStringStyle.Render(trimmed))
+	}
+	if comment != "" {
+		out.WriteString(yamlCommentStyle.Render(comment))
+	}
+	return out.String()
+}
+
+// isBlockIndicator reports whether a value opens a literal or folded block
+// scalar, such as "|" or ">-".
+func isBlockIndicator(value string) bool {
+	v := strings.TrimSpace(value)
+	if i := strings.Index(v, " #"); i >= 0 {
+		v = strings.TrimSpace(v[:i])
+	}
+	if strings.HasPrefix(v, "!") {
+		if i := strings.IndexByte(v, ' '); i >= 0 {
+			v = strings.TrimSpace(v[i:])
+		}
+	}
+	return v != "" && (v[0] == '|' || v[0] == '>') && strings.Trim(v[1:], "+-0123456789") == ""
+}
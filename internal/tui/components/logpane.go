@@ -0,0 +1,237 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// =============================================================================
+// Log Pane Component
+// =============================================================================
+
+// DefaultLogLines is how many lines a log pane keeps before dropping the
+// oldest ones.
+const DefaultLogLines = 5000
+
+var (
+	logErrorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555"))
+	logWarnStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFB86C"))
+	logDebugStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#6272A4"))
+)
+
+// LogPane is a scrollable pane of streaming text, such as tailed log
+// events. It follows new lines while scrolled to the bottom and holds still
+// while the operator reads further up.
+type LogPane struct {
+	title  string
+	status string
+	lines  []string
+	limit  int
+
+	width  int
+	height int
+	offset int  // Rows scrolled up from the bottom; 0 follows new lines
+	unseen int  // Lines appended while scrolled up
+	wrap   bool // Wrap long lines rather than cutting them
+
+	titleStyle  lipgloss.Style
+	statusStyle lipgloss.Style
+	helpStyle   lipgloss.Style
+}
+
+// LogPaneClosedMsg is sent when a log pane is dismissed.
+type LogPaneClosedMsg struct{}
+
+// NewLogPane creates an empty log pane following new lines.
+func NewLogPane(title string, width, height int) *LogPane {
+	return &LogPane{
+		title:  title,
+		limit:  DefaultLogLines,
+		width:  width,
+		height: height,
+		wrap:   true,
+		titleStyle: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FF79C6")),
+		statusStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#8BE9FD")),
+		helpStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#6272A4")),
+	}
+}
+
+// SetDimensions resizes the pane.
+func (p *LogPane) SetDimensions(width, height int) {
+	p.width = width
+	p.height = height
+	p.clampOffset()
+}
+
+// SetStatus sets the text shown next to the title, such as how the lines
+// are streamed.
+func (p *LogPane) SetStatus(status string) {
+	p.status = status
+}
+
+// Append adds lines at the bottom. Lines with newlines are split. When the
+// pane is scrolled up the rows in view stay in place.
+func (p *LogPane) Append(lines ...string) {
+	var added []string
+	for _, line := range lines {
+		added = append(added, strings.Split(strings.TrimRight(line, "\n"), "\n")...)
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	if p.offset > 0 {
+		p.offset += p.rowCount(added)
+		p.unseen += len(added)
+	}
+	p.lines = append(p.lines, added...)
+	if drop := len(p.lines) - p.limit; drop > 0 {
+		p.lines = append(p.lines[:0:0], p.lines[drop:]...)
+	}
+	p.clampOffset()
+}
+
+// Len returns the number of lines kept.
+func (p *LogPane) Len() int {
+	return len(p.lines)
+}
+
+// Following reports whether the pane shows new lines as they arrive.
+func (p *LogPane) Following() bool {
+	return p.offset == 0
+}
+
+// Update handles scrolling and dismissal.
+func (p *LogPane) Update(msg tea.Msg) (*LogPane, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	page := max(1, p.bodyHeight()-1)
+	switch keyMsg.String() {
+	case "esc", "q":
+		return p, func() tea.Msg { return LogPaneClosedMsg{} }
+	case "up", "k":
+		p.offset++
+	case "down", "j":
+		p.offset--
+	case "pgup", "b":
+		p.offset += page
+	case "pgdown", "f", " ":
+		p.offset -= page
+	case "g", "home":
+		p.offset = p.maxOffset()
+	case "G", "end":
+		p.offset = 0
+	case "w":
+		p.wrap = !p.wrap
+		p.offset = 0
+	case "c":
+		p.lines = nil
+		p.offset = 0
+	}
+	p.clampOffset()
+	if p.offset == 0 {
+		p.unseen = 0
+	}
+	return p, nil
+}
+
+// View renders the pane.
+func (p *LogPane) View() string {
+	header := p.titleStyle.Render(p.title)
+	status := p.status
+	if !p.Following() {
+		paused := "paused"
+		if p.unseen > 0 {
+			paused = fmt.Sprintf("paused, %d new lines below", p.unseen)
+		}
+		status = strings.TrimPrefix(status+"  "+paused, "  ")
+	}
+	if status != "" {
+		header += "  " + p.statusStyle.Render(status)
+	}
+
+	rows := p.rows(p.lines)
+	height := p.bodyHeight()
+	end := len(rows) - p.offset
+	start := max(0, end-height)
+
+	body := make([]string, 0, height)
+	for _, row := range rows[start:end] {
+		body = append(body, highlightLogLine(row))
+	}
+	for len(body) < height {
+		body = append(body, "")
+	}
+
+	wrap := "[w]rap"
+	if p.wrap {
+		wrap = "[w] cut lines"
+	}
+	help := "[↑/↓/PgUp/PgDn] scroll  [g/G] top/follow  " + wrap + "  [c]lear  [Esc] stop"
+	return strings.Join([]string{header, strings.Join(body, "\n"), p.helpStyle.Render(help)}, "\n")
+}
+
+// bodyHeight returns the rows left for lines below the title and above the
+// help.
+func (p *LogPane) bodyHeight() int {
+	return detailBodyHeight(p.height)
+}
+
+// rows splits lines into the rows they take at the pane's width, wrapped
+// or cut.
+func (p *LogPane) rows(lines []string) []string {
+	width := max(p.width, 10)
+	rows := make([]string, 0, len(lines))
+	for _, line := range lines {
+		runes := []rune(strings.ReplaceAll(line, "\t", "    "))
+		if !p.wrap {
+			if len(runes) > width {
+				runes = append(runes[:width-1], '…')
+			}
+			rows = append(rows, string(runes))
+			continue
+		}
+		for len(runes) > width {
+			rows = append(rows, string(runes[:width]))
+			runes = runes[width:]
+		}
+		rows = append(rows, string(runes))
+	}
+	return rows
+}
+
+func (p *LogPane) rowCount(lines []string) int {
+	return len(p.rows(lines))
+}
+
+func (p *LogPane) maxOffset() int {
+	return max(0, p.rowCount(p.lines)-p.bodyHeight())
+}
+
+func (p *LogPane) clampOffset() {
+	p.offset = min(max(p.offset, 0), p.maxOffset())
+}
+
+// highlightLogLine colors a line by the level it mentions.
+func highlightLogLine(line string) string {
+	upper := strings.ToUpper(line)
+	switch {
+	case strings.Contains(upper, "ERROR"), strings.Contains(upper, "FATAL"), strings.Contains(upper, "PANIC"):
+		return logErrorStyle.Render(line)
+	case strings.Contains(upper, "WARN"):
+		return logWarnStyle.Render(line)
+	case strings.Contains(upper, "DEBUG"), strings.Contains(upper, "TRACE"):
+		return logDebugStyle.Render(line)
+	}
+	return line
+}
@@ -0,0 +1,59 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+func record(t *testing.T, r *Recorder, eventType core.EventType, source string, data any) {
+	t.Helper()
+	if err := r.Handle(context.Background(), core.NewEvent(eventType, source, data)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecorderKeepsLatest(t *testing.T) {
+	r := NewRecorder(2)
+	record(t, r, core.EventResourceListed, "ec2", core.ResourceEventData{Count: 3})
+	record(t, r, core.EventActionFailed, "s3", core.ActionEventData{Action: "delete", Error: "AccessDenied"})
+	record(t, r, core.EventError, "hooks", errors.New("hook audit: disk full"))
+
+	entries := r.Entries()
+	if len(entries) != 2 || entries[0].Source != "s3" || entries[1].Source != "hooks" {
+		t.Fatalf("entries = %+v, want the last two, oldest first", entries)
+	}
+	if entries[1].Data != "hook audit: disk full" {
+		t.Errorf("error payload = %q", entries[1].Data)
+	}
+
+	r.Clear()
+	if len(r.Entries()) != 0 {
+		t.Error("Clear() kept entries")
+	}
+}
+
+func TestViewFilters(t *testing.T) {
+	r := NewRecorder(DefaultSize)
+	record(t, r, core.EventActionStarted, "ec2", core.ActionEventData{Action: "stop"})
+	record(t, r, core.EventActionFailed, "ec2", core.ActionEventData{Action: "stop"})
+	record(t, r, core.EventActionFailed, "plugin:cost", core.ActionEventData{Action: "report"})
+	record(t, r, core.EventResourceListed, "ec2", core.ResourceEventData{Count: 1})
+
+	v := NewView(r)
+	v.Update(components.FormResultMsg{ID: filterFormID, Values: map[string]any{"type": "action.", "source": "ec2"}})
+	if len(v.entries) != 2 {
+		t.Fatalf("filtered entries = %+v, want the two ec2 actions", v.entries)
+	}
+
+	// New matching events are followed, others filtered out
+	record(t, r, core.EventActionExecuted, "ec2", core.ActionEventData{Action: "start"})
+	record(t, r, core.EventResourceListed, "ec2", core.ResourceEventData{Count: 1})
+	v.Update(RecordedMsg{})
+	if len(v.entries) != 3 || v.entries[v.cursor].Type != core.EventActionExecuted {
+		t.Errorf("cursor on %+v of %d entries, want the newest action", v.entries[v.cursor], len(v.entries))
+	}
+}
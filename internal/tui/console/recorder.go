@@ -0,0 +1,148 @@
+// Package console provides the live event console: a hidden view, opened
+// from the view chooser as "events", streaming every dispatcher event as it
+// happens, for debugging hooks, plugins and services.
+//
+// A Recorder registered with the dispatcher keeps the latest events from
+// startup on, so the console shows what happened before it was opened.
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// DefaultSize is the number of events kept by a recorder.
+const DefaultSize = 1000
+
+// Entry is a recorded event. Its payload is rendered as JSON when it is
+// recorded, since payloads may change once dispatched.
+type Entry struct {
+	Seq    int64
+	Time   time.Time
+	Type   core.EventType
+	Source string
+	Data   string
+}
+
+// Recorder is a hook keeping the last events of every type in a ring
+// buffer and signaling each new one to the console.
+type Recorder struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	seq     int64
+	changed chan struct{}
+}
+
+// Ensure Recorder implements core.Hook
+var _ core.Hook = (*Recorder)(nil)
+
+// NewRecorder creates a recorder keeping the last size events.
+func NewRecorder(size int) *Recorder {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Recorder{
+		entries: make([]Entry, size),
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Name returns the hook name.
+func (r *Recorder) Name() string {
+	return "event-console"
+}
+
+// EventTypes returns the event types this hook handles: all of them.
+func (r *Recorder) EventTypes() []core.EventType {
+	return core.AllEventTypes
+}
+
+// Priority returns the execution priority. The recorder runs first, so
+// events show up even when a later hook fails or blocks.
+func (r *Recorder) Priority() int {
+	return 1000
+}
+
+// Handle records an event. It never blocks the dispatcher.
+func (r *Recorder) Handle(_ context.Context, event core.Event) error {
+	entry := Entry{
+		Time:   event.Timestamp(),
+		Type:   event.Type(),
+		Source: event.Source(),
+		Data:   payload(event.Data()),
+	}
+
+	r.mu.Lock()
+	r.seq++
+	entry.Seq = r.seq
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	r.mu.Unlock()
+
+	select {
+	case r.changed <- struct{}{}:
+	default:
+		// The console has yet to read the previous signal
+	}
+	return nil
+}
+
+// Entries returns the recorded events, oldest first.
+func (r *Recorder) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]Entry, 0, len(r.entries))
+	for i := range r.entries {
+		if e := r.entries[(r.next+i)%len(r.entries)]; e.Seq != 0 {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Clear forgets the recorded events.
+func (r *Recorder) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.entries)
+	r.next = 0
+}
+
+// RecordedMsg tells the console new events were recorded.
+type RecordedMsg struct{}
+
+// Listen waits for the next recorded event. The receiver of RecordedMsg
+// must call Listen again to keep being told.
+func (r *Recorder) Listen() tea.Cmd {
+	return func() tea.Msg {
+		<-r.changed
+		return RecordedMsg{}
+	}
+}
+
+// payload renders an event payload as compact JSON, errors by their
+// message.
+func payload(data any) string {
+	switch d := data.(type) {
+	case nil:
+		return ""
+	case error:
+		return d.Error()
+	case string:
+		return d
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("%+v", data)
+	}
+	return string(b)
+}
@@ -0,0 +1,367 @@
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// ViewName is the name the console is chosen by in the view chooser.
+const ViewName = "events"
+
+// filterFormID identifies the form filtering the console.
+const filterFormID = "console:filter"
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View streams the events of a Recorder, newest at the bottom. It follows
+// new events while its cursor is on the last one.
+type View struct {
+	recorder *Recorder
+	styles   base.Styles
+	width    int
+	height   int
+
+	listening bool
+	entries   []Entry // Recorded events matching the filters
+	cursor    int
+	offset    int // Index of the first event shown
+	follow    bool
+	paused    bool
+
+	// Filters: an event type or its prefix, such as "action.", and a
+	// part of the source
+	typeFilter   string
+	sourceFilter string
+
+	form    *components.Form
+	detail  *components.Detail
+	message string
+}
+
+// NewView creates a console showing the events of recorder.
+func NewView(recorder *Recorder) *View {
+	v := &View{
+		recorder: recorder,
+		styles:   base.DefaultStyles(),
+		follow:   true,
+	}
+	v.reload()
+	return v
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init starts listening for new events, once.
+func (v *View) Init() tea.Cmd {
+	v.reload()
+	if v.listening {
+		return nil
+	}
+	v.listening = true
+	return v.recorder.Listen()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case RecordedMsg:
+		if !v.paused {
+			v.reload()
+		}
+		return v, v.recorder.Listen()
+
+	case components.FormResultMsg:
+		if msg.ID != filterFormID {
+			return v, nil
+		}
+		v.form = nil
+		if msg.Canceled {
+			return v, nil
+		}
+		v.typeFilter, _ = msg.Values["type"].(string)
+		v.sourceFilter, _ = msg.Values["source"].(string)
+		v.typeFilter, v.sourceFilter = strings.TrimSpace(v.typeFilter), strings.TrimSpace(v.sourceFilter)
+		v.follow = true
+		v.reload()
+		return v, nil
+
+	case components.DetailClosedMsg:
+		v.detail = nil
+		return v, nil
+
+	case tea.KeyMsg:
+		return v, v.handleKey(msg)
+	}
+	return v, nil
+}
+
+// handleKey handles the keys of the open overlay, or of the event list.
+func (v *View) handleKey(msg tea.KeyMsg) tea.Cmd {
+	if v.form != nil {
+		var cmd tea.Cmd
+		v.form, cmd = v.form.Update(msg)
+		return cmd
+	}
+	if v.detail != nil {
+		var cmd tea.Cmd
+		v.detail, cmd = v.detail.Update(msg)
+		return cmd
+	}
+
+	last := len(v.entries) - 1
+	switch msg.String() {
+	case "up", "k":
+		v.moveTo(v.cursor - 1)
+	case "down", "j":
+		v.moveTo(v.cursor + 1)
+	case "pgup":
+		v.moveTo(v.cursor - v.rows())
+	case "pgdown":
+		v.moveTo(v.cursor + v.rows())
+	case "home", "g":
+		v.moveTo(0)
+	case "end":
+		v.moveTo(last)
+	case "enter":
+		if v.cursor >= 0 && v.cursor <= last {
+			e := v.entries[v.cursor]
+			v.detail = components.NewDetail(i18n.T("Event %s from %s", e.Type, e.Source), formatEntry(e), v.width, v.height)
+		}
+	case "f", "/":
+		v.openFilterForm()
+	case "x":
+		v.typeFilter, v.sourceFilter = "", ""
+		v.follow = true
+		v.reload()
+		v.message = i18n.T("Filters cleared")
+	case "p":
+		v.paused = !v.paused
+		if v.paused {
+			v.message = i18n.T("Paused; new events are still recorded")
+		} else {
+			v.message = ""
+			v.reload()
+		}
+	case "c":
+		v.recorder.Clear()
+		v.reload()
+		v.message = i18n.T("Cleared")
+	}
+	return nil
+}
+
+// View renders the view.
+func (v *View) View() string {
+	if v.form != nil {
+		return v.form.View()
+	}
+	if v.detail != nil {
+		return v.detail.View()
+	}
+
+	var lines []string
+	lines = append(lines, v.renderSummary(), "")
+
+	rows := v.rows()
+	if len(v.entries) == 0 {
+		lines = append(lines, v.styles.Muted.Render(i18n.T("No events yet")))
+		rows--
+	}
+	v.scroll(rows)
+	start := v.offset
+	end := min(len(v.entries), start+rows)
+	for i := start; i < end; i++ {
+		lines = append(lines, v.renderEntry(i))
+	}
+	for i := end - start; i < rows; i++ {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, v.styles.Info.Render(v.message))
+	lines = append(lines, v.styles.Help.Render(i18n.T("[Enter]payload  [f]ilter  [x]clear filters  [p]ause  [c]lear  [End]follow")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Name returns the view name.
+func (v *View) Name() string { return ViewName }
+
+// Shortcut returns no shortcut: the console is only reachable from the
+// view chooser.
+func (v *View) Shortcut() string { return "" }
+
+// ServiceName returns no service: the console shows events of all of them.
+func (v *View) ServiceName() string { return "" }
+
+// SetService is a no-op.
+func (v *View) SetService(core.AWSService) {}
+
+// SetDimensions sets the view dimensions.
+func (v *View) SetDimensions(width, height int) {
+	v.width, v.height = width, height
+	if v.detail != nil {
+		v.detail.SetDimensions(width, height)
+	}
+	if v.form != nil {
+		v.form.SetWidth(width)
+	}
+}
+
+// Refresh reloads the recorded events, even while paused.
+func (v *View) Refresh() tea.Cmd {
+	v.reload()
+	return nil
+}
+
+// IsLoading reports false: events are recorded in memory.
+func (v *View) IsLoading() bool { return false }
+
+// Error reports no error.
+func (v *View) Error() error { return nil }
+
+// CapturingInput implements core.InputCapturer.
+func (v *View) CapturingInput() bool {
+	return v.form != nil || v.detail != nil
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+// reload reads the recorded events matching the filters. The cursor stays
+// on the last event when following, on the same event otherwise.
+func (v *View) reload() {
+	var selected int64
+	if !v.follow && v.cursor < len(v.entries) {
+		selected = v.entries[v.cursor].Seq
+	}
+
+	entries := make([]Entry, 0, len(v.entries))
+	v.cursor = -1
+	for _, e := range v.recorder.Entries() {
+		if !v.matches(e) {
+			continue
+		}
+		if e.Seq <= selected {
+			v.cursor = len(entries)
+		}
+		entries = append(entries, e)
+	}
+	v.entries = entries
+	if v.follow || selected == 0 {
+		v.cursor = len(v.entries) - 1
+	}
+	v.cursor = max(v.cursor, 0)
+}
+
+// matches reports whether an event passes the filters.
+func (v *View) matches(e Entry) bool {
+	if v.typeFilter != "" && !strings.HasPrefix(string(e.Type), v.typeFilter) {
+		return false
+	}
+	return v.sourceFilter == "" || strings.Contains(e.Source, v.sourceFilter)
+}
+
+// moveTo moves the cursor, following new events once on the last one.
+func (v *View) moveTo(i int) {
+	v.cursor = max(0, min(i, len(v.entries)-1))
+	v.follow = v.cursor >= len(v.entries)-1
+}
+
+// scroll moves the window of events shown so it holds the cursor.
+func (v *View) scroll(rows int) {
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	}
+	if v.cursor >= v.offset+rows {
+		v.offset = v.cursor - rows + 1
+	}
+	v.offset = max(0, min(v.offset, len(v.entries)-rows))
+}
+
+// rows returns how many events fit between the summary and the help.
+func (v *View) rows() int {
+	return max(1, v.height-4)
+}
+
+func (v *View) openFilterForm() {
+	v.form = components.NewForm(filterFormID, i18n.T("Filter events"), []core.ActionParameter{
+		{Name: "type", Type: "string", Default: v.typeFilter, Description: i18n.T("Event type or prefix, such as action. (empty for all)")},
+		{Name: "source", Type: "string", Default: v.sourceFilter, Description: i18n.T("Part of the source, such as a service name (empty for all)")},
+	})
+	v.form.SetWidth(v.width)
+}
+
+func (v *View) renderSummary() string {
+	parts := []string{v.styles.Title.Render(i18n.T("Event console")), i18n.T("Events: %d", len(v.entries))}
+	if v.typeFilter != "" {
+		parts = append(parts, i18n.T("Type: %s*", v.typeFilter))
+	}
+	if v.sourceFilter != "" {
+		parts = append(parts, i18n.T("Source: *%s*", v.sourceFilter))
+	}
+	if v.paused {
+		parts = append(parts, v.styles.Warning.Render(i18n.T("PAUSED")))
+	} else if v.follow {
+		parts = append(parts, v.styles.Muted.Render(i18n.T("following")))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// renderEntry renders an event on one line, failures and errors in red and
+// warnings in yellow.
+func (v *View) renderEntry(i int) string {
+	e := v.entries[i]
+	line := fmt.Sprintf("%s  %-20s %-16s %s", e.Time.Local().Format("15:04:05.000"), e.Type, base.TruncateString(e.Source, 16), e.Data)
+	line = base.TruncateString(line, max(v.width, 20))
+
+	switch {
+	case i == v.cursor:
+		return v.styles.Table.Selected.Render(line)
+	case e.Type == core.EventError || strings.HasSuffix(string(e.Type), ".failed") || e.Type == core.EventPluginError:
+		return v.styles.Error.Render(line)
+	case e.Type == core.EventWarning:
+		return v.styles.Warning.Render(line)
+	}
+	return line
+}
+
+// formatEntry renders an event with its indented payload for the detail
+// panel.
+func formatEntry(e Entry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Time:   %s\n", e.Time.Local().Format("2006-01-02 15:04:05.000"))
+	fmt.Fprintf(&b, "Type:   %s\n", e.Type)
+	fmt.Fprintf(&b, "Source: %s\n\n", e.Source)
+
+	var indented bytes.Buffer
+	if json.Indent(&indented, []byte(e.Data), "", "  ") == nil {
+		b.Write(indented.Bytes())
+	} else {
+		b.WriteString(e.Data)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+var (
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.InputCapturer = (*View)(nil)
+)
@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// Frame Budget
+// =============================================================================
+
+// defaultFrameBudget is how long a render may take before frames are
+// dropped, at the 60 frames per second Bubble Tea draws at.
+const defaultFrameBudget = time.Second / 60
+
+// frameStats measures how long the App takes to render. Bubble Tea draws
+// the latest render on a fixed tick, so a render taking longer than the
+// budget skips the ticks it overlaps: those are counted as dropped frames.
+// Renders only happen on the program's goroutine, so no lock is needed.
+type frameStats struct {
+	budget  time.Duration
+	frames  int
+	dropped int
+	last    time.Duration
+	worst   time.Duration
+	total   time.Duration
+}
+
+func newFrameStats(budget time.Duration) *frameStats {
+	if budget <= 0 {
+		budget = defaultFrameBudget
+	}
+	return &frameStats{budget: budget}
+}
+
+// record adds a render that took d.
+func (f *frameStats) record(d time.Duration) {
+	f.frames++
+	f.last = d
+	f.total += d
+	f.worst = max(f.worst, d)
+	if d > f.budget {
+		f.dropped += int(d / f.budget)
+	}
+}
+
+// average returns the mean render duration.
+func (f *frameStats) average() time.Duration {
+	if f.frames == 0 {
+		return 0
+	}
+	return f.total / time.Duration(f.frames)
+}
+
+// String renders the stats for the footer, as of the previous render.
+func (f *frameStats) String() string {
+	return fmt.Sprintf("⏱ %s avg %s max %s  dropped %d",
+		formatFrame(f.last), formatFrame(f.average()), formatFrame(f.worst), f.dropped)
+}
+
+// formatFrame rounds a render duration to a readable precision.
+func formatFrame(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+	return d.Round(100 * time.Microsecond).String()
+}
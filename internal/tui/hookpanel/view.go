@@ -0,0 +1,350 @@
+// Package hookpanel provides the hook health panel: a hidden view, opened
+// from the view chooser as "hooks", listing the hooks registered with the
+// dispatcher, plugin hooks included, with their execution counts, error
+// rates and last failure, and enabling or disabling them at runtime.
+package hookpanel
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	"github.com/keanuharrell/a9s/internal/hooks"
+	"github.com/keanuharrell/a9s/internal/i18n"
+	"github.com/keanuharrell/a9s/internal/services/base"
+	"github.com/keanuharrell/a9s/internal/tui/components"
+)
+
+// ViewName is the name the panel is chosen by in the view chooser.
+const ViewName = "hooks"
+
+// refreshInterval is how often the counts are read again.
+const refreshInterval = 2 * time.Second
+
+// Source provides the hooks and their counts, and toggles them. The
+// dispatcher of package hooks implements it.
+type Source interface {
+	Stats() []hooks.HookStats
+	SetEnabled(name string, enabled bool) error
+}
+
+var _ Source = (*hooks.Dispatcher)(nil)
+
+// tickMsg reads the counts again.
+type tickMsg struct{}
+
+// =============================================================================
+// View Implementation
+// =============================================================================
+
+// View lists the hooks of a Source, highest priority first.
+type View struct {
+	source Source
+	pinned []string // Hooks the interface relies on, which stay enabled
+	styles base.Styles
+	width  int
+	height int
+
+	ticking bool
+	stats   []hooks.HookStats
+	cursor  int
+	offset  int // Index of the first hook shown
+
+	detail  *components.Detail
+	message string
+}
+
+// NewView creates a panel showing the hooks of source. The pinned hooks
+// cannot be disabled from the panel.
+func NewView(source Source, pinned ...string) *View {
+	v := &View{
+		source: source,
+		pinned: pinned,
+		styles: base.DefaultStyles(),
+	}
+	v.reload()
+	return v
+}
+
+// =============================================================================
+// tea.Model Interface Implementation
+// =============================================================================
+
+// Init reads the counts and starts refreshing them, once.
+func (v *View) Init() tea.Cmd {
+	v.reload()
+	if v.ticking {
+		return nil
+	}
+	v.ticking = true
+	return tick()
+}
+
+// Update handles messages and updates the view state.
+func (v *View) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tickMsg:
+		v.reload()
+		return v, tick()
+
+	case components.DetailClosedMsg:
+		v.detail = nil
+		return v, nil
+
+	case tea.KeyMsg:
+		return v, v.handleKey(msg)
+	}
+	return v, nil
+}
+
+// handleKey handles the keys of the open detail, or of the hook list.
+func (v *View) handleKey(msg tea.KeyMsg) tea.Cmd {
+	if v.detail != nil {
+		var cmd tea.Cmd
+		v.detail, cmd = v.detail.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		v.moveTo(v.cursor - 1)
+	case "down", "j":
+		v.moveTo(v.cursor + 1)
+	case "home", "g":
+		v.moveTo(0)
+	case "end", "G":
+		v.moveTo(len(v.stats) - 1)
+	case "enter":
+		if s, ok := v.selected(); ok {
+			v.detail = components.NewDetail(i18n.T("Hook %s", s.Name), formatStats(s), v.width, v.height)
+		}
+	case " ", "t":
+		v.toggle()
+	case "r":
+		v.reload()
+		v.message = i18n.T("Refreshed")
+	}
+	return nil
+}
+
+// View renders the view.
+func (v *View) View() string {
+	if v.detail != nil {
+		return v.detail.View()
+	}
+
+	var lines []string
+	lines = append(lines, v.renderSummary(), "")
+
+	rows := v.rows()
+	lines = append(lines, v.styles.Muted.Render(fmt.Sprintf("%-3s %-28s %8s %8s %8s %7s %9s  %s",
+		"", i18n.T("Name"), i18n.T("Priority"), i18n.T("Runs"), i18n.T("Errors"), i18n.T("Rate"), i18n.T("Avg"), i18n.T("Last failure"))))
+	if len(v.stats) == 0 {
+		lines = append(lines, v.styles.Muted.Render(i18n.T("No hooks registered")))
+		rows--
+	}
+	v.scroll(rows)
+	end := min(len(v.stats), v.offset+rows)
+	for i := v.offset; i < end; i++ {
+		lines = append(lines, v.renderHook(i))
+	}
+	for i := end - v.offset; i < rows; i++ {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, v.styles.Info.Render(v.message))
+	lines = append(lines, v.styles.Help.Render(i18n.T("[Enter]details  [Space]enable/disable  [r]efresh")))
+	return strings.Join(lines, "\n")
+}
+
+// =============================================================================
+// core.View Interface Implementation
+// =============================================================================
+
+// Name returns the view name.
+func (v *View) Name() string { return ViewName }
+
+// Shortcut returns no shortcut: the panel is only reachable from the view
+// chooser.
+func (v *View) Shortcut() string { return "" }
+
+// ServiceName returns no service: the panel shows hooks of all of them.
+func (v *View) ServiceName() string { return "" }
+
+// SetService is a no-op.
+func (v *View) SetService(core.AWSService) {}
+
+// SetDimensions sets the view dimensions.
+func (v *View) SetDimensions(width, height int) {
+	v.width, v.height = width, height
+	if v.detail != nil {
+		v.detail.SetDimensions(width, height)
+	}
+}
+
+// Refresh reads the counts again.
+func (v *View) Refresh() tea.Cmd {
+	v.reload()
+	return nil
+}
+
+// IsLoading reports false: counts are kept in memory.
+func (v *View) IsLoading() bool { return false }
+
+// Error reports no error.
+func (v *View) Error() error { return nil }
+
+// CapturingInput implements core.InputCapturer.
+func (v *View) CapturingInput() bool {
+	return v.detail != nil
+}
+
+// =============================================================================
+// Internal Methods
+// =============================================================================
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(time.Time) tea.Msg { return tickMsg{} })
+}
+
+// reload reads the hooks, keeping the cursor on the same one.
+func (v *View) reload() {
+	current, _ := v.selected()
+	v.stats = v.source.Stats()
+	v.cursor = max(0, slices.IndexFunc(v.stats, func(s hooks.HookStats) bool { return s.Name == current.Name }))
+}
+
+// selected returns the hook under the cursor.
+func (v *View) selected() (hooks.HookStats, bool) {
+	if v.cursor < 0 || v.cursor >= len(v.stats) {
+		return hooks.HookStats{}, false
+	}
+	return v.stats[v.cursor], true
+}
+
+// toggle enables or disables the hook under the cursor, unless pinned.
+func (v *View) toggle() {
+	s, ok := v.selected()
+	if !ok {
+		return
+	}
+	if slices.Contains(v.pinned, s.Name) {
+		v.message = i18n.T("%s keeps the interface up to date and cannot be disabled", s.Name)
+		return
+	}
+	if err := v.source.SetEnabled(s.Name, !s.Enabled); err != nil {
+		v.message = i18n.T("Error: %v", err)
+		return
+	}
+	if s.Enabled {
+		v.message = i18n.T("Disabled %s until enabled again or a9s restarts", s.Name)
+	} else {
+		v.message = i18n.T("Enabled %s", s.Name)
+	}
+	v.reload()
+}
+
+func (v *View) moveTo(i int) {
+	v.cursor = max(0, min(i, len(v.stats)-1))
+}
+
+// scroll moves the window of hooks shown so it holds the cursor.
+func (v *View) scroll(rows int) {
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	}
+	if v.cursor >= v.offset+rows {
+		v.offset = v.cursor - rows + 1
+	}
+	v.offset = max(0, min(v.offset, len(v.stats)-rows))
+}
+
+// rows returns how many hooks fit between the header and the help.
+func (v *View) rows() int {
+	return max(1, v.height-5)
+}
+
+func (v *View) renderSummary() string {
+	disabled, failing := 0, 0
+	for _, s := range v.stats {
+		if !s.Enabled {
+			disabled++
+		}
+		if s.Errors > 0 {
+			failing++
+		}
+	}
+	parts := []string{v.styles.Title.Render(i18n.T("Hook health")), i18n.T("Hooks: %d", len(v.stats))}
+	if disabled > 0 {
+		parts = append(parts, v.styles.Warning.Render(i18n.T("Disabled: %d", disabled)))
+	}
+	if failing > 0 {
+		parts = append(parts, v.styles.Error.Render(i18n.T("Failing: %d", failing)))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// renderHook renders a hook on one line, disabled hooks muted and hooks
+// that failed in red.
+func (v *View) renderHook(i int) string {
+	s := v.stats[i]
+	state := "on"
+	if !s.Enabled {
+		state = "off"
+	}
+	lastFailure := "-"
+	if s.Errors > 0 {
+		lastFailure = base.TruncateString(fmt.Sprintf("%s %s", s.LastErrorAt.Local().Format("15:04:05"), s.LastError), 60)
+	}
+	line := fmt.Sprintf("%-3s %-28s %8d %8d %8d %6.1f%% %9s  %s", state, base.TruncateString(s.Name, 28), s.Priority,
+		s.Executions, s.Errors, s.ErrorRate()*100, s.AverageTime().Round(time.Microsecond), lastFailure)
+	line = base.TruncateString(line, max(v.width, 20))
+
+	switch {
+	case i == v.cursor:
+		return v.styles.Table.Selected.Render(line)
+	case !s.Enabled:
+		return v.styles.Muted.Render(line)
+	case s.Errors > 0:
+		return v.styles.Error.Render(line)
+	}
+	return line
+}
+
+// formatStats renders a hook with its event types and last failure for the
+// detail panel.
+func formatStats(s hooks.HookStats) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Enabled:    %t\n", s.Enabled)
+	fmt.Fprintf(&b, "Priority:   %d\n", s.Priority)
+	fmt.Fprintf(&b, "Executions: %d\n", s.Executions)
+	fmt.Fprintf(&b, "Errors:     %d (%.1f%%)\n", s.Errors, s.ErrorRate()*100)
+	fmt.Fprintf(&b, "Average:    %s\n", s.AverageTime())
+	if !s.LastRun.IsZero() {
+		fmt.Fprintf(&b, "Last run:   %s\n", s.LastRun.Local().Format("2006-01-02 15:04:05"))
+	}
+	if s.LastError != "" {
+		fmt.Fprintf(&b, "\nLast failure at %s:\n  %s\n", s.LastErrorAt.Local().Format("2006-01-02 15:04:05"), s.LastError)
+	}
+
+	b.WriteString(i18n.T("\nEvent types:\n"))
+	types := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		types[i] = string(t)
+	}
+	slices.Sort(types)
+	for _, t := range types {
+		fmt.Fprintf(&b, "  %s\n", t)
+	}
+	return b.String()
+}
+
+var (
+	_ tea.Model          = (*View)(nil)
+	_ core.View          = (*View)(nil)
+	_ core.InputCapturer = (*View)(nil)
+)
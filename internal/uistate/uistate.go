@@ -0,0 +1,83 @@
+// Package uistate keeps the TUI's state across restarts: the view that was
+// open and, per view, the selected resource, filters and sort order.
+package uistate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// State is the saved UI state.
+type State struct {
+	View  string                    `json:"view,omitempty"` // Name of the open view
+	Views map[string]core.ViewState `json:"views,omitempty"`
+}
+
+// =============================================================================
+// Store
+// =============================================================================
+
+// Store persists the UI state in a JSON file.
+type Store struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewStore creates a store backed by the file at path.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultPath returns the default store location inside stateDir.
+func DefaultPath(stateDir string) string {
+	return filepath.Join(stateDir, "ui.json")
+}
+
+// Load reads the saved state. A missing file yields an empty state.
+func (s *Store) Load() (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var state State
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("failed to read UI state: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return State{}, fmt.Errorf("failed to parse UI state %s: %w", s.path, err)
+	}
+	return state, nil
+}
+
+// Save writes the state atomically.
+func (s *Store) Save(state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode UI state: %w", err)
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write UI state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write UI state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+// Package integration runs the services against LocalStack through their
+// real AWS code paths, with the endpoint override pointing every client at
+// the emulator. Tests only build with the integration tag:
+//
+//	make test-integration
+//
+// starts LocalStack with docker compose, runs them and stops it. Against an
+// emulator already running elsewhere, set A9S_INTEGRATION_ENDPOINT and run
+//
+//	go test -tags integration -count=1 ./test/integration/
+package integration
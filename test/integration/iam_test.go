@@ -0,0 +1,111 @@
+//go:build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/keanuharrell/a9s/internal/compliance"
+	"github.com/keanuharrell/a9s/internal/core"
+	iamsvc "github.com/keanuharrell/a9s/internal/services/iam"
+)
+
+const (
+	adminPolicyARN    = "arn:aws:iam::aws:policy/AdministratorAccess"
+	readOnlyPolicyARN = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+)
+
+// lambdaTrust lets Lambda assume a role.
+const lambdaTrust = `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": {"Service": "lambda.amazonaws.com"}, "Action": "sts:AssumeRole"}]
+}`
+
+// createRole creates a role Lambda can assume with the given managed
+// policies, deleted when the test ends.
+func createRole(t *testing.T, name string, policies ...string) string {
+	t.Helper()
+	ctx := t.Context()
+	client := factory.IAMClient()
+
+	out, err := client.CreateRole(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String(name),
+		AssumeRolePolicyDocument: aws.String(lambdaTrust),
+	})
+	if err != nil {
+		t.Fatalf("create role %s: %v", name, err)
+	}
+	for _, arn := range policies {
+		if _, err := client.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(name),
+			PolicyArn: aws.String(arn),
+		}); err != nil {
+			t.Fatalf("attach %s to %s: %v", arn, name, err)
+		}
+	}
+	t.Cleanup(func() {
+		ctx := t.Context()
+		for _, arn := range policies {
+			_, _ = client.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{RoleName: aws.String(name), PolicyArn: aws.String(arn)})
+		}
+		_, _ = client.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(name)})
+	})
+	return aws.ToString(out.Role.Arn)
+}
+
+func TestIAM(t *testing.T) {
+	ctx := t.Context()
+	admin, reader := uniqueName("admin"), uniqueName("reader")
+	createRole(t, admin, adminPolicyARN)
+	createRole(t, reader, readOnlyPolicyARN)
+
+	svc := iamsvc.NewService(factory, nil)
+	resources, err := svc.List(ctx, core.ListOptions{MaxResults: 1000})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	t.Run("enrich admin role", func(t *testing.T) {
+		r := find(t, resources, admin)
+		if err := svc.EnrichResource(ctx, &r); err != nil {
+			t.Fatalf("EnrichResource: %v", err)
+		}
+		if risky, _ := r.Metadata["is_high_risk"].(bool); !risky {
+			t.Errorf("is_high_risk = false, want true for AdministratorAccess")
+		}
+		if r.Severity() != core.SeverityCritical {
+			t.Errorf("severity = %q, want critical", r.Severity())
+		}
+		failed := compliance.Failed(r)
+		if len(failed) != 1 || failed[0] != compliance.CheckIAMFullAdmin {
+			t.Errorf("failed checks = %v, want [%s]", failed, compliance.CheckIAMFullAdmin)
+		}
+	})
+
+	t.Run("enrich read-only role", func(t *testing.T) {
+		r := find(t, resources, reader)
+		if err := svc.EnrichResource(ctx, &r); err != nil {
+			t.Fatalf("EnrichResource: %v", err)
+		}
+		if risky, _ := r.Metadata["is_high_risk"].(bool); risky {
+			t.Errorf("is_high_risk = true (%s), want false for ReadOnlyAccess", r.GetMetadataString("risk_reason"))
+		}
+		if count, _ := r.Metadata["policy_count"].(int); count != 1 {
+			t.Errorf("policy_count = %d, want 1", count)
+		}
+	})
+
+	t.Run("audit", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "audit", admin, nil)
+		if err != nil {
+			t.Fatalf("Execute audit: %v", err)
+		}
+		data, _ := result.Data.(map[string]any)
+		if risky, _ := data["is_high_risk"].(bool); !risky {
+			t.Errorf("audit data = %v, want is_high_risk", data)
+		}
+	})
+}
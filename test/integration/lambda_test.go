@@ -0,0 +1,110 @@
+//go:build integration
+
+package integration
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	lambdasvc "github.com/keanuharrell/a9s/internal/services/lambda"
+)
+
+// handler is the code of the seeded function.
+const handler = `def handler(event, context):
+    return {"ok": True, "echo": event}
+`
+
+// functionZip packages handler as index.py.
+func functionZip(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, err := w.Create("index.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(handler)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestLambda(t *testing.T) {
+	ctx := t.Context()
+	client := lambda.NewFromConfig(factory.Config())
+
+	name := uniqueName("fn")
+	role := createRole(t, name+"-role")
+	if _, err := client.CreateFunction(ctx, &lambda.CreateFunctionInput{
+		FunctionName: aws.String(name),
+		Runtime:      types.RuntimePython312,
+		Handler:      aws.String("index.handler"),
+		Role:         aws.String(role),
+		Code:         &types.FunctionCode{ZipFile: functionZip(t)},
+		MemorySize:   aws.Int32(256),
+		Environment:  &types.Environment{Variables: map[string]string{"STAGE": "integration"}},
+	}); err != nil {
+		t.Fatalf("create function: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = client.DeleteFunction(t.Context(), &lambda.DeleteFunctionInput{FunctionName: aws.String(name)})
+	})
+	waiter := lambda.NewFunctionActiveV2Waiter(client)
+	if err := waiter.Wait(ctx, &lambda.GetFunctionInput{FunctionName: aws.String(name)}, 2*time.Minute); err != nil {
+		t.Fatalf("wait for function: %v", err)
+	}
+
+	svc := lambdasvc.NewService(factory, nil)
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	r := find(t, resources, name)
+	if memory, _ := r.Metadata["memory_mb"].(int32); memory != 256 {
+		t.Errorf("memory_mb = %d, want 256", memory)
+	}
+
+	t.Run("enrich", func(t *testing.T) {
+		r := r
+		if err := svc.EnrichResource(ctx, &r); err != nil {
+			t.Fatalf("EnrichResource: %v", err)
+		}
+		if _, ok := r.Metadata["invocations_24h"].(int64); !ok {
+			t.Errorf("invocations_24h missing from %v", r.Metadata)
+		}
+	})
+
+	t.Run("view config", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "view_config", r.ID, nil)
+		if err != nil {
+			t.Fatalf("Execute view_config: %v", err)
+		}
+		data, _ := result.Data.(map[string]any)
+		if data["handler"] != "index.handler" || data["runtime"] != string(types.RuntimePython312) {
+			t.Errorf("config = %v, want index.handler on %s", data, types.RuntimePython312)
+		}
+	})
+
+	t.Run("invoke", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "invoke", r.ID, map[string]any{"payload": []byte(`{"ping": 1}`)})
+		if err != nil {
+			t.Fatalf("Execute invoke: %v", err)
+		}
+		data, _ := result.Data.(map[string]any)
+		payload, _ := data["payload"].(string)
+		if !strings.Contains(payload, `"ok": true`) || !strings.Contains(payload, `"ping": 1`) {
+			t.Errorf("payload = %s, want the handler's echo", payload)
+		}
+	})
+}
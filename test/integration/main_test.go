@@ -0,0 +1,89 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	awsfactory "github.com/keanuharrell/a9s/internal/aws"
+	"github.com/keanuharrell/a9s/internal/core"
+)
+
+// defaultEndpoint is where docker-compose.yml exposes LocalStack.
+const defaultEndpoint = "http://localhost:4566"
+
+// factory builds the clients of every service under test.
+var factory *awsfactory.ClientFactory
+
+func TestMain(m *testing.M) {
+	endpoint := os.Getenv("A9S_INTEGRATION_ENDPOINT")
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	// LocalStack accepts any credentials; never let a real profile through
+	os.Setenv("AWS_ACCESS_KEY_ID", "test")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	os.Unsetenv("AWS_SESSION_TOKEN")
+	os.Unsetenv("AWS_PROFILE")
+
+	var err error
+	factory, err = awsfactory.NewClientFactory(&core.AWSConfig{Region: "us-east-1", Endpoint: endpoint})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integration: %v\n", err)
+		os.Exit(1)
+	}
+	if err := waitReady(endpoint); err != nil {
+		fmt.Fprintf(os.Stderr, "integration: LocalStack not reachable at %s: %v\n", endpoint, err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+// waitReady waits for the emulator to answer STS, which it serves once
+// started.
+func waitReady(endpoint string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	client := sts.NewFromConfig(factory.Config())
+	for {
+		_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// uniqueName returns a resource name unlikely to clash with earlier runs
+// against the same emulator.
+func uniqueName(kind string) string {
+	return fmt.Sprintf("a9s-it-%s-%d", kind, time.Now().UnixNano()%1_000_000_000)
+}
+
+// find returns the resource named name, failing the test when missing.
+func find(t *testing.T, resources []core.Resource, name string) core.Resource {
+	t.Helper()
+	for _, r := range resources {
+		if r.Name == name {
+			return r
+		}
+	}
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+	t.Fatalf("%s not listed among %s", name, strings.Join(names, ", "))
+	return core.Resource{}
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	s3svc "github.com/keanuharrell/a9s/internal/services/s3"
+)
+
+func TestS3(t *testing.T) {
+	ctx := t.Context()
+	client := factory.S3Client()
+
+	open, blocked := uniqueName("open"), uniqueName("blocked")
+	for _, bucket := range []string{open, blocked} {
+		if _, err := client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+			t.Fatalf("create bucket %s: %v", bucket, err)
+		}
+	}
+	if _, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(open),
+		Key:    aws.String("hello.txt"),
+		Body:   strings.NewReader("hello"),
+	}); err != nil {
+		t.Fatalf("put object: %v", err)
+	}
+	if _, err := client.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(blocked),
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}); err != nil {
+		t.Fatalf("put public access block: %v", err)
+	}
+	if _, err := client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(blocked),
+		Tagging: &types.Tagging{TagSet: []types.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}},
+	}); err != nil {
+		t.Fatalf("put bucket tagging: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = client.DeleteBucket(t.Context(), &s3.DeleteBucketInput{Bucket: aws.String(blocked)})
+	})
+
+	svc := s3svc.NewService(factory, nil)
+	resources, err := svc.List(ctx, core.ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	t.Run("enrich open bucket", func(t *testing.T) {
+		r := find(t, resources, open)
+		if err := svc.EnrichResource(ctx, &r); err != nil {
+			t.Fatalf("EnrichResource: %v", err)
+		}
+		if public, _ := r.Metadata["is_public"].(bool); !public {
+			t.Errorf("is_public = false, want true without a public access block")
+		}
+		if r.Severity() != core.SeverityHigh {
+			t.Errorf("severity = %q, want high", r.Severity())
+		}
+	})
+
+	t.Run("enrich blocked bucket", func(t *testing.T) {
+		r := find(t, resources, blocked)
+		if err := svc.EnrichResource(ctx, &r); err != nil {
+			t.Fatalf("EnrichResource: %v", err)
+		}
+		if public, _ := r.Metadata["is_public"].(bool); public {
+			t.Errorf("is_public = true, want false with a public access block")
+		}
+		if r.Tags["team"] != "platform" {
+			t.Errorf("tags = %v, want team=platform", r.Tags)
+		}
+	})
+
+	t.Run("delete requires confirmation", func(t *testing.T) {
+		if _, err := svc.Execute(ctx, "delete", open, nil); err == nil {
+			t.Fatal("delete ran without confirmation")
+		}
+		if _, err := client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(open)}); err != nil {
+			t.Fatalf("bucket gone after an unconfirmed delete: %v", err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "delete", open, map[string]any{"confirm": true})
+		if err != nil {
+			t.Fatalf("Execute delete: %v", err)
+		}
+		if !result.Success {
+			t.Fatalf("delete failed: %s", result.Message)
+		}
+		if _, err := client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(open)}); err == nil {
+			t.Error("bucket still exists after delete")
+		}
+	})
+}
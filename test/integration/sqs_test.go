@@ -0,0 +1,89 @@
+//go:build integration
+
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/keanuharrell/a9s/internal/core"
+	sqssvc "github.com/keanuharrell/a9s/internal/services/sqs"
+)
+
+func TestSQS(t *testing.T) {
+	ctx := t.Context()
+	client := sqs.NewFromConfig(factory.Config())
+
+	name := uniqueName("queue")
+	out, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String(name)})
+	if err != nil {
+		t.Fatalf("create queue: %v", err)
+	}
+	url := aws.ToString(out.QueueUrl)
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(t.Context(), &sqs.DeleteQueueInput{QueueUrl: aws.String(url)})
+	})
+	for range 3 {
+		if _, err := client.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: aws.String(url), MessageBody: aws.String("seed")}); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	svc := sqssvc.NewService(factory, nil)
+
+	t.Run("list", func(t *testing.T) {
+		resources, err := svc.List(ctx, core.ListOptions{})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		r := find(t, resources, name)
+		if r.ID != url {
+			t.Errorf("ID = %s, want the queue URL %s", r.ID, url)
+		}
+		if messages, _ := r.Metadata["messages"].(int); messages != 3 {
+			t.Errorf("messages = %d, want 3", messages)
+		}
+	})
+
+	t.Run("send", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "send", url, map[string]any{"body": "from a9s"})
+		if err != nil {
+			t.Fatalf("Execute send: %v", err)
+		}
+		if !result.Success {
+			t.Fatalf("send failed: %s", result.Message)
+		}
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "peek", url, map[string]any{"count": 10})
+		if err != nil {
+			t.Fatalf("Execute peek: %v", err)
+		}
+		messages, _ := result.Data.([]sqssvc.Message)
+		if len(messages) == 0 {
+			t.Error("peek returned no messages")
+		}
+	})
+
+	t.Run("purge requires confirmation", func(t *testing.T) {
+		_, err := svc.Execute(ctx, "purge", url, nil)
+		var confirmation *core.ConfirmationError
+		if !errors.As(err, &confirmation) {
+			t.Fatalf("purge error = %v, want a confirmation request", err)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		result, err := svc.Execute(ctx, "purge", url, map[string]any{core.ParamConfirm: true})
+		if err != nil {
+			t.Fatalf("Execute purge: %v", err)
+		}
+		if !result.Success {
+			t.Fatalf("purge failed: %s", result.Message)
+		}
+	})
+}